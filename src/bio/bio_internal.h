@@ -186,6 +186,9 @@ struct bio_dma_buffer {
 	X(bdh_avail_spare_thres, "reliability/avail_spare_threshold",	\
 	  "Threshold for available spare value",			\
 	  "%", D_TM_GAUGE)						\
+	X(bdh_percent_used, "reliability/percentage_used",		\
+	  "Estimate of the percentage of device life used",		\
+	  "%", D_TM_GAUGE)						\
 	X(bdh_avail_spare_warn, "reliability/avail_spare_warn",		\
 	  "Set to 1 when available spare has fallen below threshold",	\
 	  "", D_TM_GAUGE)						\
@@ -137,6 +137,37 @@ bio_get_dev_state(struct nvme_stats *state, struct bio_xs_context *xs)
 	return rc;
 }
 
+/* Collect blobstore usage and the clusters used by the xstream's own blobs */
+int
+bio_get_bs_usage(struct bio_xs_context *xs, struct bio_bs_usage *usage)
+{
+	struct bio_blobstore	*bbs;
+	struct bio_io_context	*ioc;
+
+	D_ASSERT(xs != NULL && usage != NULL);
+	memset(usage, 0, sizeof(*usage));
+
+	bbs = xs->bxc_blobstore;
+	if (bbs == NULL || bbs->bb_bs == NULL)
+		return -DER_NONEXIST;
+
+	uuid_copy(usage->bbu_dev_id, bbs->bb_dev->bb_uuid);
+	usage->bbu_cluster_sz = spdk_bs_get_cluster_size(bbs->bb_bs);
+	usage->bbu_total_clusters = spdk_bs_total_data_cluster_count(bbs->bb_bs);
+	usage->bbu_free_clusters = spdk_bs_free_cluster_count(bbs->bb_bs);
+
+	/* The io contexts are only added/removed by the owning xstream */
+	d_list_for_each_entry(ioc, &xs->bxc_io_ctxts, bic_link) {
+		/* Blob is being opened or closed */
+		if (ioc->bic_blob == NULL)
+			continue;
+		usage->bbu_used_clusters += spdk_blob_get_num_clusters(ioc->bic_blob);
+		usage->bbu_blobs++;
+	}
+
+	return 0;
+}
+
 /*
  * Copy out the internal BIO blobstore device state.
  */
@@ -479,8 +510,12 @@ populate_health_stats(struct bio_dev_health *bdh)
 	d_tm_set_gauge(bdh->bdh_temp_warn, dev_state->temp_warn);
 
 	/** reliability */
+	dev_state->avail_spare		= page->available_spare;
 	d_tm_set_gauge(bdh->bdh_avail_spare, page->available_spare);
+	dev_state->avail_spare_thres	= page->available_spare_threshold;
 	d_tm_set_gauge(bdh->bdh_avail_spare_thres, page->available_spare_threshold);
+	dev_state->percent_used		= page->percentage_used;
+	d_tm_set_gauge(bdh->bdh_percent_used, page->percentage_used);
 	dev_state->avail_spare_warn	= cw.bits.available_spare ? true
 								  : false;
 	d_tm_set_gauge(bdh->bdh_avail_spare_warn, dev_state->avail_spare_warn);
@@ -501,6 +536,16 @@ populate_health_stats(struct bio_dev_health *bdh)
 	dev_state->err_log_entries = page->num_error_info_log_entries[0];
 }
 
+/*
+ * Extended SMART attributes are provided in the Intel log page format by Intel
+ * and some Samsung SSDs.
+ */
+static inline bool
+vendor_smart_log_supported(uint16_t vid)
+{
+	return vid == SPDK_PCI_VID_INTEL || vid == SPDK_PCI_VID_SAMSUNG;
+}
+
 static void
 get_spdk_intel_smart_log_completion(struct spdk_bdev_io *bdev_io, bool success,
 				    void *cb_arg)
@@ -518,20 +563,20 @@ get_spdk_intel_smart_log_completion(struct spdk_bdev_io *bdev_io, bool success,
 
 	D_ASSERT(dev_health->bdh_inflights == 1);
 
-	/* Additional NVMe status information */
-	spdk_bdev_io_get_nvme_status(bdev_io, &cdw0, &sct, &sc);
-	if (sc) {
-		D_ERROR("NVMe status code/type: %d/%d\n", sc, sct);
-		dev_health->bdh_inflights--;
-		goto out;
-	}
-
 	D_ASSERT(dev_health->bdh_io_channel != NULL);
 	bdev = spdk_bdev_desc_get_bdev(dev_health->bdh_desc);
 	D_ASSERT(bdev != NULL);
 
-	/* Store Intel SMART stats in in-memory health state log. */
-	if (dev_health->bdh_vendor_id == SPDK_PCI_VID_INTEL)
+	/*
+	 * Vendor log page support varies between device models, so a failure
+	 * here only means that the extended SMART attributes are unavailable.
+	 */
+	spdk_bdev_io_get_nvme_status(bdev_io, &cdw0, &sct, &sc);
+	if (sc)
+		D_DEBUG(DB_MGMT, "Vendor SMART log unavailable, NVMe status code/type: %d/%d\n",
+			sc, sct);
+	else if (vendor_smart_log_supported(dev_health->bdh_vendor_id))
+		/* Store vendor SMART stats in in-memory health state log. */
 		populate_intel_smart_stats(dev_health);
 
 	/* Prep NVMe command to get controller data */
@@ -595,8 +640,8 @@ get_spdk_health_info_completion(struct spdk_bdev_io *bdev_io, bool success,
 	dev_health->bdh_health_state.timestamp = daos_wallclock_secs();
 	populate_health_stats(dev_health);
 
-	/* Prep NVMe command to get SPDK Intel NVMe SSD Smart Attributes */
-	if (dev_health->bdh_vendor_id != SPDK_PCI_VID_INTEL) {
+	/* Prep NVMe command to get vendor NVMe SSD Smart Attributes */
+	if (!vendor_smart_log_supported(dev_health->bdh_vendor_id)) {
 		get_spdk_intel_smart_log_completion(bdev_io, true, ctxt);
 		return;
 	}
@@ -623,7 +668,7 @@ get_spdk_health_info_completion(struct spdk_bdev_io *bdev_io, bool success,
 					   get_spdk_intel_smart_log_completion,
 					   ctxt);
 	if (rc) {
-		D_ERROR("NVMe admin passthru (Intel smart log), rc:%d\n", rc);
+		D_ERROR("NVMe admin passthru (vendor smart log), rc:%d\n", rc);
 		dev_health->bdh_inflights--;
 	}
 
@@ -708,6 +708,15 @@ bio_bdev_event_cb(enum spdk_bdev_event_type type, struct spdk_bdev *bdev,
 	D_ASSERT(d_bdev->bb_desc != NULL);
 	d_bdev->bb_removed = true;
 
+	/* Rank will be populated automatically */
+	if (ds_notify_ras_eventf != NULL)
+		ds_notify_ras_eventf(RAS_DEVICE_UNPLUGGED, RAS_TYPE_INFO, RAS_SEV_NOTICE,
+				     d_bdev->bb_name /* hwid */, NULL /* rank */, NULL /* inc */,
+				     NULL /* jobid */, NULL /* pool */, NULL /* cont */,
+				     NULL /* objid */, NULL /* ctlop */, NULL /* data */,
+				     "Device "DF_UUID"(%s) was hot removed",
+				     DP_UUID(d_bdev->bb_uuid), d_bdev->bb_name);
+
 	/* The bio_bdev is still under construction */
 	if (d_list_empty(&d_bdev->bb_link)) {
 		D_ASSERT(d_bdev->bb_blobstore == NULL);
@@ -1570,6 +1579,17 @@ scan_bio_bdevs(struct bio_xs_context *ctxt, uint64_t now)
 			break;
 		}
 
+		/* Rank will be populated automatically */
+		if (ds_notify_ras_eventf != NULL)
+			ds_notify_ras_eventf(RAS_DEVICE_PLUGGED, RAS_TYPE_INFO,
+					     RAS_SEV_NOTICE,
+					     (char *)spdk_bdev_get_name(bdev) /* hwid */,
+					     NULL /* rank */, NULL /* inc */, NULL /* jobid */,
+					     NULL /* pool */, NULL /* cont */, NULL /* objid */,
+					     NULL /* ctlop */, NULL /* data */,
+					     "Device %s was hot plugged",
+					     spdk_bdev_get_name(bdev));
+
 		/*
 		 * The plugged device is a new device, or teardown procedure for
 		 * old bio_bdev isn't finished.
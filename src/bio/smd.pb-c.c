@@ -769,7 +769,7 @@ const ProtobufCMessageDescriptor ctl__bio_health_req__descriptor =
   (ProtobufCMessageInit) ctl__bio_health_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor ctl__bio_health_resp__field_descriptors[43] =
+static const ProtobufCFieldDescriptor ctl__bio_health_resp__field_descriptors[46] =
 {
   {
     "timestamp",
@@ -1287,9 +1287,47 @@ static const ProtobufCFieldDescriptor ctl__bio_health_resp__field_descriptors[43
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "avail_spare",
+    47,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BioHealthResp, avail_spare),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "avail_spare_threshold",
+    48,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BioHealthResp, avail_spare_threshold),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "percentage_used",
+    49,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BioHealthResp, percentage_used),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned ctl__bio_health_resp__field_indices_by_name[] = {
   22,   /* field[22] = avail_bytes */
+  43,   /* field[43] = avail_spare */
+  44,   /* field[44] = avail_spare_threshold */
   15,   /* field[15] = avail_spare_warn */
   9,   /* field[9] = bio_read_errs */
   11,   /* field[11] = bio_unmap_errs */
@@ -1310,6 +1348,7 @@ static const unsigned ctl__bio_health_resp__field_indices_by_name[] = {
   7,   /* field[7] = media_errs */
   33,   /* field[33] = media_wear_raw */
   40,   /* field[40] = nand_bytes_written */
+  45,   /* field[45] = percentage_used */
   39,   /* field[39] = pll_lock_loss_cnt */
   4,   /* field[4] = power_cycles */
   5,   /* field[5] = power_on_hours */
@@ -1337,7 +1376,7 @@ static const ProtobufCIntRange ctl__bio_health_resp__number_ranges[2 + 1] =
 {
   { 3, 0 },
   { 5, 1 },
-  { 0, 43 }
+  { 0, 46 }
 };
 const ProtobufCMessageDescriptor ctl__bio_health_resp__descriptor =
 {
@@ -1347,7 +1386,7 @@ const ProtobufCMessageDescriptor ctl__bio_health_resp__descriptor =
   "Ctl__BioHealthResp",
   "ctl",
   sizeof(Ctl__BioHealthResp),
-  43,
+  46,
   ctl__bio_health_resp__field_descriptors,
   ctl__bio_health_resp__field_indices_by_name,
   2,  ctl__bio_health_resp__number_ranges,
@@ -196,10 +196,25 @@ struct  _Ctl__BioHealthResp
    * blobstore cluster size in bytes
    */
   uint64_t cluster_size;
+  /*
+   * Standard SMART wear and spare attributes
+   */
+  /*
+   * remaining spare capacity, percent
+   */
+  uint32_t avail_spare;
+  /*
+   * spare capacity warning threshold, percent
+   */
+  uint32_t avail_spare_threshold;
+  /*
+   * estimated device life used, percent
+   */
+  uint32_t percentage_used;
 };
 #define CTL__BIO_HEALTH_RESP__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&ctl__bio_health_resp__descriptor) \
-    , 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, (char *)protobuf_c_empty_string, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0 }
+    , 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, (char *)protobuf_c_empty_string, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0 }
 
 
 /*
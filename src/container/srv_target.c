@@ -52,7 +52,8 @@ agg_rate_ctl(void *arg)
 	struct sched_request	*req = cont2req(cont, param->ap_vos_agg);
 
 	/* Abort current round of aggregation */
-	if (dss_ult_exiting(req) || pool->sp_reclaim == DAOS_RECLAIM_DISABLED)
+	if (dss_ult_exiting(req) || pool->sp_reclaim == DAOS_RECLAIM_DISABLED ||
+	    pool->sp_agg_paused)
 		return -1;
 
 	/* System is idle, let aggregation run in tight mode */
@@ -233,6 +234,11 @@ cont_aggregate_runnable(struct ds_cont_child *cont, struct sched_request *req,
 		return false;
 	}
 
+	if (pool->sp_agg_paused) {
+		D_DEBUG(DB_EPC, "Pool aggregation is paused by policy\n");
+		return false;
+	}
+
 	if (pool->sp_reclaim == DAOS_RECLAIM_LAZY && dss_xstream_is_busy() &&
 	    sched_req_space_check(req) == SCHED_SPACE_PRESS_NONE) {
 		D_DEBUG(DB_EPC, "Pool reclaim strategy is lazy, service is "
@@ -430,6 +436,9 @@ out:
 	if (rc == 0 && epoch_min == 0)
 		param->ap_full_scan_hlc = hlc;
 
+	if (rc == 0 && param->ap_vos_agg)
+		cont->sc_agg_last_hlc = d_hlc_get();
+
 	D_DEBUG(DB_EPC, DF_CONT"[%d]: Aggregating finished. "DF_RC"\n",
 		DP_CONT(cont->sc_pool->spc_uuid, cont->sc_uuid), tgt_id, DP_RC(rc));
 free:
@@ -466,7 +475,11 @@ cont_aggregate_interval(struct ds_cont_child *cont, cont_aggregate_cb_t cb,
 		if (!cont_aggregate_runnable(cont, req, param->ap_vos_agg))
 			goto next;
 
+		if (param->ap_vos_agg)
+			cont->sc_vos_aggregating = 1;
 		rc = cont_child_aggregate(cont, cb, param);
+		if (param->ap_vos_agg)
+			cont->sc_vos_aggregating = 0;
 		if (rc == -DER_SHUTDOWN) {
 			break;	/* pool destroyed */
 		} else if (rc < 0) {
@@ -1653,6 +1666,298 @@ ds_cont_tgt_open(uuid_t pool_uuid, uuid_t cont_hdl_uuid,
 	return rc;
 }
 
+struct cont_agg_query_xs_arg {
+	uuid_t				aqx_pool_uuid;
+	struct ds_cont_agg_status	aqx_status;
+};
+
+static void
+cont_agg_query_xs_reduce(void *agg_arg, void *xs_arg)
+{
+	struct cont_agg_query_xs_arg	*a_arg = agg_arg;
+	struct cont_agg_query_xs_arg	*x_arg = xs_arg;
+	struct ds_cont_agg_status	*a_st = &a_arg->aqx_status;
+	struct ds_cont_agg_status	*x_st = &x_arg->aqx_status;
+
+	if (x_st->cas_hae != 0 && (a_st->cas_hae == 0 || x_st->cas_hae < a_st->cas_hae))
+		a_st->cas_hae = x_st->cas_hae;
+	if (x_st->cas_last_hlc > a_st->cas_last_hlc)
+		a_st->cas_last_hlc = x_st->cas_last_hlc;
+	a_st->cas_running |= x_st->cas_running;
+	a_st->cas_paused |= x_st->cas_paused;
+}
+
+static int
+cont_agg_query_xs_arg_alloc(struct dss_stream_arg_type *xs, void *agg_arg)
+{
+	struct cont_agg_query_xs_arg	*x_arg, *a_arg = agg_arg;
+
+	D_ALLOC_PTR(x_arg);
+	if (x_arg == NULL)
+		return -DER_NOMEM;
+
+	xs->st_arg = x_arg;
+	uuid_copy(x_arg->aqx_pool_uuid, a_arg->aqx_pool_uuid);
+	return 0;
+}
+
+static void
+cont_agg_query_xs_arg_free(struct dss_stream_arg_type *xs)
+{
+	D_ASSERT(xs->st_arg != NULL);
+	D_FREE(xs->st_arg);
+}
+
+static int
+cont_agg_query_one(void *vin)
+{
+	struct dss_coll_stream_args	*reduce = vin;
+	struct dss_stream_arg_type	*streams = reduce->csa_streams;
+	int				 tid = dss_get_module_info()->dmi_tgt_id;
+	struct cont_agg_query_xs_arg	*x_arg = streams[tid].st_arg;
+	struct ds_cont_agg_status	*status = &x_arg->aqx_status;
+	struct ds_pool_child		*pool_child;
+	struct ds_pool			*pool;
+	struct ds_cont_child		*cont;
+	uint64_t			 hae;
+
+	pool_child = ds_pool_child_lookup(x_arg->aqx_pool_uuid);
+	if (pool_child == NULL)
+		return -DER_NO_HDL;
+
+	/* Same conditions under which cont_aggregate_runnable() skips VOS aggregation */
+	pool = pool_child->spc_pool;
+	if (pool->sp_reclaim == DAOS_RECLAIM_DISABLED || pool->sp_agg_paused ||
+	    pool->sp_reintegrating)
+		status->cas_paused = 1;
+
+	d_list_for_each_entry(cont, &pool_child->spc_cont_list, sc_link) {
+		if (cont->sc_vos_aggregating)
+			status->cas_running = 1;
+		if (cont->sc_agg_last_hlc > status->cas_last_hlc)
+			status->cas_last_hlc = cont->sc_agg_last_hlc;
+
+		hae = get_hae(cont, true);
+		if (hae != 0 && (status->cas_hae == 0 || hae < status->cas_hae))
+			status->cas_hae = hae;
+	}
+
+	ds_pool_child_put(pool_child);
+	return 0;
+}
+
+/**
+ * Query the state of VOS aggregation of all of a pool's containers on the
+ * targets of the local engine.
+ *
+ * \param[in]	pool_uuid	UUID of the pool.
+ * \param[out]	status		Aggregation status reduced across the targets.
+ *
+ * \return	0 if Success, negative if failed.
+ */
+int
+ds_cont_tgt_agg_query(uuid_t pool_uuid, struct ds_cont_agg_status *status)
+{
+	struct dss_coll_ops		coll_ops = { 0 };
+	struct dss_coll_args		coll_args = { 0 };
+	struct cont_agg_query_xs_arg	agg_arg = { 0 };
+	int				rc;
+
+	D_ASSERT(status != NULL);
+
+	/* collective operations */
+	coll_ops.co_func		= cont_agg_query_one;
+	coll_ops.co_reduce		= cont_agg_query_xs_reduce;
+	coll_ops.co_reduce_arg_alloc	= cont_agg_query_xs_arg_alloc;
+	coll_ops.co_reduce_arg_free	= cont_agg_query_xs_arg_free;
+
+	/* packing arguments for aggregator args */
+	uuid_copy(agg_arg.aqx_pool_uuid, pool_uuid);
+
+	/* setting aggregator args */
+	coll_args.ca_aggregator		= &agg_arg;
+	coll_args.ca_func_args		= &coll_args.ca_stream_args;
+
+	rc = ds_pool_get_failed_tgt_idx(pool_uuid, &coll_args.ca_exclude_tgts,
+					&coll_args.ca_exclude_tgts_cnt);
+	if (rc) {
+		D_ERROR(DF_UUID": failed to get index : rc "DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		return rc;
+	}
+
+	rc = dss_thread_collective_reduce(&coll_ops, &coll_args, 0);
+	D_FREE(coll_args.ca_exclude_tgts);
+	if (rc) {
+		D_ERROR("Aggregation query on pool "DF_UUID" failed, "DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		return rc;
+	}
+
+	*status = agg_arg.aqx_status;
+	return 0;
+}
+
+struct cont_tgt_list_xs_arg {
+	uuid_t		 tlx_pool_uuid;
+	uuid_t		*tlx_conts;
+	int		 tlx_conts_nr;
+	int		 tlx_rc;
+};
+
+/* Add \a uuid to the \a conts array unless it is there already */
+static int
+cont_uuid_array_add(uuid_t **conts, int *conts_nr, const uuid_t uuid)
+{
+	uuid_t	*new_conts;
+	int	 i;
+
+	for (i = 0; i < *conts_nr; i++)
+		if (uuid_compare((*conts)[i], uuid) == 0)
+			return 0;
+
+	D_REALLOC_ARRAY(new_conts, *conts, *conts_nr, *conts_nr + 1);
+	if (new_conts == NULL)
+		return -DER_NOMEM;
+
+	uuid_copy(new_conts[*conts_nr], uuid);
+	*conts = new_conts;
+	(*conts_nr)++;
+	return 0;
+}
+
+static void
+cont_tgt_list_xs_reduce(void *agg_arg, void *xs_arg)
+{
+	struct cont_tgt_list_xs_arg	*a_arg = agg_arg;
+	struct cont_tgt_list_xs_arg	*x_arg = xs_arg;
+	int				 i;
+	int				 rc;
+
+	for (i = 0; i < x_arg->tlx_conts_nr; i++) {
+		rc = cont_uuid_array_add(&a_arg->tlx_conts, &a_arg->tlx_conts_nr,
+					 x_arg->tlx_conts[i]);
+		if (rc != 0) {
+			a_arg->tlx_rc = rc;
+			return;
+		}
+	}
+}
+
+static int
+cont_tgt_list_xs_arg_alloc(struct dss_stream_arg_type *xs, void *agg_arg)
+{
+	struct cont_tgt_list_xs_arg	*x_arg, *a_arg = agg_arg;
+
+	D_ALLOC_PTR(x_arg);
+	if (x_arg == NULL)
+		return -DER_NOMEM;
+
+	xs->st_arg = x_arg;
+	uuid_copy(x_arg->tlx_pool_uuid, a_arg->tlx_pool_uuid);
+	return 0;
+}
+
+static void
+cont_tgt_list_xs_arg_free(struct dss_stream_arg_type *xs)
+{
+	struct cont_tgt_list_xs_arg	*x_arg = xs->st_arg;
+
+	D_ASSERT(x_arg != NULL);
+	D_FREE(x_arg->tlx_conts);
+	D_FREE(xs->st_arg);
+}
+
+static int
+cont_tgt_list_cb(daos_handle_t ih, vos_iter_entry_t *entry, vos_iter_type_t type,
+		 vos_iter_param_t *iter_param, void *data, unsigned *acts)
+{
+	struct cont_tgt_list_xs_arg	*x_arg = data;
+
+	return cont_uuid_array_add(&x_arg->tlx_conts, &x_arg->tlx_conts_nr, entry->ie_couuid);
+}
+
+static int
+cont_tgt_list_one(void *vin)
+{
+	struct dss_coll_stream_args	*reduce = vin;
+	struct dss_stream_arg_type	*streams = reduce->csa_streams;
+	int				 tid = dss_get_module_info()->dmi_tgt_id;
+	struct cont_tgt_list_xs_arg	*x_arg = streams[tid].st_arg;
+	struct ds_pool_child		*pool_child;
+	vos_iter_param_t		 param = { 0 };
+	struct vos_iter_anchors		 anchor = { 0 };
+	int				 rc;
+
+	pool_child = ds_pool_child_lookup(x_arg->tlx_pool_uuid);
+	if (pool_child == NULL)
+		return -DER_NO_HDL;
+
+	param.ip_hdl = pool_child->spc_hdl;
+	rc = vos_iterate(&param, VOS_ITER_COUUID, false, &anchor, cont_tgt_list_cb, NULL,
+			 x_arg, NULL);
+
+	ds_pool_child_put(pool_child);
+	return rc;
+}
+
+/**
+ * List the VOS containers of a pool on the targets of the local engine.
+ *
+ * \param[in]	pool_uuid	UUID of the pool.
+ * \param[out]	conts		UUIDs of the containers found on any of the
+ *				targets, allocated, caller must free.
+ * \param[out]	conts_nr	Number of entries in \a conts.
+ *
+ * \return	0 if Success, negative if failed.
+ */
+int
+ds_cont_tgt_list(uuid_t pool_uuid, uuid_t **conts, int *conts_nr)
+{
+	struct dss_coll_ops		coll_ops = { 0 };
+	struct dss_coll_args		coll_args = { 0 };
+	struct cont_tgt_list_xs_arg	list_arg = { 0 };
+	int				rc;
+
+	D_ASSERT(conts != NULL && conts_nr != NULL);
+
+	/* collective operations */
+	coll_ops.co_func		= cont_tgt_list_one;
+	coll_ops.co_reduce		= cont_tgt_list_xs_reduce;
+	coll_ops.co_reduce_arg_alloc	= cont_tgt_list_xs_arg_alloc;
+	coll_ops.co_reduce_arg_free	= cont_tgt_list_xs_arg_free;
+
+	/* packing arguments for aggregator args */
+	uuid_copy(list_arg.tlx_pool_uuid, pool_uuid);
+
+	/* setting aggregator args */
+	coll_args.ca_aggregator		= &list_arg;
+	coll_args.ca_func_args		= &coll_args.ca_stream_args;
+
+	rc = ds_pool_get_failed_tgt_idx(pool_uuid, &coll_args.ca_exclude_tgts,
+					&coll_args.ca_exclude_tgts_cnt);
+	if (rc) {
+		D_ERROR(DF_UUID": failed to get index : rc "DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		return rc;
+	}
+
+	rc = dss_thread_collective_reduce(&coll_ops, &coll_args, 0);
+	D_FREE(coll_args.ca_exclude_tgts);
+	if (rc == 0)
+		rc = list_arg.tlx_rc;
+	if (rc) {
+		D_ERROR("Container list on pool "DF_UUID" failed, "DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		D_FREE(list_arg.tlx_conts);
+		return rc;
+	}
+
+	*conts = list_arg.tlx_conts;
+	*conts_nr = list_arg.tlx_conts_nr;
+	return 0;
+}
+
 /* Close a single per-thread open container handle */
 static int
 cont_close_hdl(uuid_t cont_hdl_uuid)
@@ -0,0 +1,64 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package build
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// VersionInfo describes the build of a DAOS component binary in a form
+// that may be consumed by support tooling to check compatibility.
+type VersionInfo struct {
+	Name          string            `json:"name"`
+	Component     Component         `json:"component"`
+	Version       string            `json:"version"`
+	APIVersion    string            `json:"api_version"`
+	BuildHash     string            `json:"build_hash"`
+	GoVersion     string            `json:"go_version"`
+	MaxMinorDelta int               `json:"max_minor_delta"`
+	Dependencies  map[string]string `json:"dependencies"`
+	Features      []string          `json:"features"`
+}
+
+func (vi *VersionInfo) String() string {
+	return fmt.Sprintf("%s v%s", vi.Name, vi.Version)
+}
+
+// Features returns the sorted list of optional features compiled into the
+// binary.
+func Features() []string {
+	features := []string{}
+	for _, tag := range strings.Split(BuildTags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			features = append(features, tag)
+		}
+	}
+	sort.Strings(features)
+
+	return features
+}
+
+// NewVersionInfo returns the version information for the named component.
+func NewVersionInfo(name string, comp Component) *VersionInfo {
+	return &VersionInfo{
+		Name:          name,
+		Component:     comp,
+		Version:       DaosVersion,
+		APIVersion:    APIVersion,
+		BuildHash:     BuildHash,
+		GoVersion:     runtime.Version(),
+		MaxMinorDelta: MaxMinorDelta,
+		Dependencies: map[string]string{
+			"spdk":      SpdkVersion,
+			"libfabric": LibfabricVersion,
+		},
+		Features: Features(),
+	}
+}
@@ -0,0 +1,86 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package build_test
+
+import (
+	"encoding/json"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+)
+
+func TestBuild_Features(t *testing.T) {
+	for name, tc := range map[string]struct {
+		tags     string
+		expFeats []string
+	}{
+		"no tags": {
+			expFeats: []string{},
+		},
+		"sorted": {
+			tags:     "ucx,spdk,pprof",
+			expFeats: []string{"pprof", "spdk", "ucx"},
+		},
+		"empty entries": {
+			tags:     " spdk,,firmware ",
+			expFeats: []string{"firmware", "spdk"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			origTags := build.BuildTags
+			build.BuildTags = tc.tags
+			defer func() { build.BuildTags = origTags }()
+
+			if diff := cmp.Diff(tc.expFeats, build.Features()); diff != "" {
+				t.Fatalf("unexpected features (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestBuild_NewVersionInfo(t *testing.T) {
+	origVersion, origHash, origTags := build.DaosVersion, build.BuildHash, build.BuildTags
+	build.DaosVersion, build.BuildHash, build.BuildTags = "2.3.100", "abc1234", "spdk"
+	defer func() {
+		build.DaosVersion, build.BuildHash, build.BuildTags = origVersion, origHash, origTags
+	}()
+
+	vi := build.NewVersionInfo(build.AgentName, build.ComponentAgent)
+	if vi.String() != "DAOS Agent v2.3.100" {
+		t.Fatalf("unexpected string %q", vi.String())
+	}
+
+	data, err := json.Marshal(vi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := map[string]interface{}{
+		"name":            "DAOS Agent",
+		"component":       "agent",
+		"version":         "2.3.100",
+		"api_version":     build.APIVersion,
+		"build_hash":      "abc1234",
+		"go_version":      runtime.Version(),
+		"max_minor_delta": float64(build.MaxMinorDelta),
+		"dependencies": map[string]interface{}{
+			"spdk":      build.SpdkVersion,
+			"libfabric": build.LibfabricVersion,
+		},
+		"features": []interface{}{"spdk"},
+	}
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("unexpected JSON (-want, +got):\n%s\n", diff)
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,6 +12,19 @@ var (
 	ConfigDir string = "./"
 	// DaosVersion should be set via linker flag using the value of DAOS_VERSION.
 	DaosVersion string = "unset"
+	// APIVersion should be set via linker flag using the value of API_VERSION.
+	APIVersion string = "unset"
+	// BuildHash should be set via linker flag using the source commit hash.
+	BuildHash string = "unset"
+	// BuildTags should be set via linker flag using the comma-separated
+	// list of go build tags.
+	BuildTags string = ""
+	// SpdkVersion should be set via linker flag using the SPDK version
+	// the build was configured with.
+	SpdkVersion string = "unset"
+	// LibfabricVersion should be set via linker flag using the libfabric
+	// version the build was configured with.
+	LibfabricVersion string = "unset"
 	// ControlPlaneName defines a consistent name for the control plane server.
 	ControlPlaneName = "DAOS Control Server"
 	// DataPlaneName defines a consistent name for the engine.
@@ -15,7 +15,6 @@ import (
 	"strings"
 	"unsafe"
 
-	"github.com/dustin/go-humanize"
 	"github.com/google/uuid"
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
@@ -23,6 +22,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/lib/units"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -768,7 +768,7 @@ func printContainerInfo(out io.Writer, ci *containerInfo, verbose bool) error {
 			rows = append(rows, txtfmt.TableRow{"Hints": ci.CHints})
 		}
 		if ci.ChunkSize > 0 {
-			rows = append(rows, txtfmt.TableRow{"Chunk Size": humanize.IBytes(ci.ChunkSize)})
+			rows = append(rows, txtfmt.TableRow{"Chunk Size": units.FormatIBytes(ci.ChunkSize)})
 		}
 	}
 	_, err := fmt.Fprintln(out, txtfmt.FormatEntity("", rows))
@@ -11,8 +11,9 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/units"
 )
 
 /*
@@ -97,7 +98,7 @@ func (f *ChunkSizeFlag) UnmarshalFlag(fv string) error {
 		return errors.New("empty chunk size")
 	}
 
-	size, err := humanize.ParseBytes(fv)
+	size, err := units.ParseBytes(fv)
 	if err != nil {
 		return err
 	}
@@ -108,7 +109,7 @@ func (f *ChunkSizeFlag) UnmarshalFlag(fv string) error {
 }
 
 func (f *ChunkSizeFlag) String() string {
-	return humanize.IBytes(uint64(f.Size))
+	return units.FormatIBytes(uint64(f.Size))
 }
 
 type ObjClassFlag struct {
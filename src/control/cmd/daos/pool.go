@@ -188,11 +188,15 @@ func convertPoolRebuildStatus(in *C.struct_daos_rebuild_status) *mgmtpb.PoolRebu
 	}
 
 	out := &mgmtpb.PoolRebuildStatus{
-		Status: int32(in.rs_errno),
+		Status:  int32(in.rs_errno),
+		Version: uint32(in.rs_version),
+		Seconds: uint32(in.rs_seconds),
 	}
 	if out.Status == 0 {
 		out.Objects = uint64(in.rs_obj_nr)
 		out.Records = uint64(in.rs_rec_nr)
+		out.TotalObjects = uint64(in.rs_toberb_obj_nr)
+		out.Size = uint64(in.rs_size)
 		switch {
 		case in.rs_version == 0:
 			out.State = mgmtpb.PoolRebuildStatus_IDLE
@@ -201,6 +205,8 @@ func convertPoolRebuildStatus(in *C.struct_daos_rebuild_status) *mgmtpb.PoolRebu
 		default:
 			out.State = mgmtpb.PoolRebuildStatus_BUSY
 		}
+	} else {
+		out.FailRank = int32(in.rs_fail_rank)
 	}
 
 	return out
@@ -243,21 +249,20 @@ const (
 	dpiQueryAll     = C.uint64_t(^uint64(0)) // DPI_ALL is -1
 )
 
-func generateRankSet(ranklist *C.d_rank_list_t) string {
-	if ranklist.rl_nr == 0 {
-		return ""
+// rankSetFromC returns a RankSet containing the ranks in the supplied
+// d_rank_list_t.
+func rankSetFromC(cRankList *C.d_rank_list_t) *ranklist.RankSet {
+	rs := ranklist.NewRankSet()
+	if cRankList == nil {
+		return rs
 	}
-	ranks := uintptr(unsafe.Pointer(ranklist.rl_ranks))
+
+	ranks := uintptr(unsafe.Pointer(cRankList.rl_ranks))
 	const size = unsafe.Sizeof(uint32(0))
-	rankset := "["
-	for i := 0; i < int(ranklist.rl_nr); i++ {
-		if i > 0 {
-			rankset += ","
-		}
-		rankset += fmt.Sprint(*(*uint32)(unsafe.Pointer(ranks + uintptr(i)*size)))
+	for i := 0; i < int(cRankList.rl_nr); i++ {
+		rs.Add(ranklist.Rank(*(*uint32)(unsafe.Pointer(ranks + uintptr(i)*size))))
 	}
-	rankset += "]"
-	return rankset
+	return rs
 }
 
 func (cmd *poolQueryCmd) Execute(_ []string) error {
@@ -298,10 +303,10 @@ func (cmd *poolQueryCmd) Execute(_ []string) error {
 
 	if rlPtr != nil {
 		if cmd.ShowEnabledRanks {
-			pqr.EnabledRanks = ranklist.MustCreateRankSet(generateRankSet(rl))
+			pqr.EnabledRanks = rankSetFromC(rl)
 		}
 		if cmd.ShowDisabledRanks {
-			pqr.DisabledRanks = ranklist.MustCreateRankSet(generateRankSet(rl))
+			pqr.DisabledRanks = rankSetFromC(rl)
 		}
 	}
 
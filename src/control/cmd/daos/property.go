@@ -15,13 +15,13 @@ import (
 	"strings"
 	"unsafe"
 
-	"github.com/dustin/go-humanize"
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/lib/units"
 )
 
 /*
@@ -166,7 +166,7 @@ var propHdlrs = propHdlrMap{
 		C.DAOS_PROP_CO_CSUM_CHUNK_SIZE,
 		"Checksum Chunk Size",
 		func(_ *propHdlr, e *C.struct_daos_prop_entry, v string) error {
-			size, err := humanize.ParseBytes(v)
+			size, err := units.ParseBytes(v)
 			if err != nil {
 				return propError("invalid cksum_size %q (try N<unit>)", v)
 			}
@@ -245,7 +245,7 @@ var propHdlrs = propHdlrMap{
 		C.DAOS_PROP_CO_DEDUP_THRESHOLD,
 		"Dedupe Threshold",
 		func(_ *propHdlr, e *C.struct_daos_prop_entry, v string) error {
-			size, err := humanize.ParseBytes(v)
+			size, err := units.ParseBytes(v)
 			if err != nil {
 				return propError("invalid dedup_threshold %q (try N<unit>)", v)
 			}
@@ -413,7 +413,7 @@ var propHdlrs = propHdlrMap{
 		C.DAOS_PROP_CO_EC_CELL_SZ,
 		"EC Cell Size",
 		func(_ *propHdlr, e *C.struct_daos_prop_entry, v string) error {
-			size, err := humanize.ParseBytes(v)
+			size, err := units.ParseBytes(v)
 			if err != nil {
 				return propError("invalid EC cell size %q (try N<unit>)", v)
 			}
@@ -775,7 +775,7 @@ func humanSizeStringer(e *C.struct_daos_prop_entry, name string) string {
 		return propNotFound(name)
 	}
 
-	return humanize.IBytes(uint64(C.get_dpe_val(e)))
+	return units.FormatIBytes(uint64(C.get_dpe_val(e)))
 }
 
 var hssFn = humanSizeStringer
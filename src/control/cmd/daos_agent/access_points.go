@@ -0,0 +1,96 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// accessPointRefreshInterval is the period between checks for changes
+	// to the set of MS replicas.
+	accessPointRefreshInterval = 5 * time.Minute
+)
+
+// accessPointMonitor keeps the agent's control client pointed at the current
+// MS replicas, so that replicas replaced at runtime do not require the agent
+// to be reconfigured and restarted.
+type accessPointMonitor struct {
+	log     logging.Logger
+	invoker control.Invoker
+	ctlCfg  *control.Config
+}
+
+func newAccessPointMonitor(log logging.Logger, invoker control.Invoker, ctlCfg *control.Config) *accessPointMonitor {
+	return &accessPointMonitor{
+		log:     log,
+		invoker: invoker,
+		ctlCfg:  ctlCfg,
+	}
+}
+
+func sameAccessPoints(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sa := append([]string{}, a...)
+	sb := append([]string{}, b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// refresh queries the MS for the current set of replicas and updates the
+// control client configuration if it has changed. Failures are not fatal, as
+// the configured access points remain usable until the next refresh.
+func (apm *accessPointMonitor) refresh(ctx context.Context) {
+	resp, err := control.LeaderQuery(ctx, apm.invoker, &control.LeaderQueryReq{})
+	if err != nil {
+		apm.log.Debugf("unable to refresh access points: %s", err)
+		return
+	}
+
+	if len(resp.Replicas) == 0 || sameAccessPoints(apm.ctlCfg.HostList, resp.Replicas) {
+		return
+	}
+
+	newCfg := *apm.ctlCfg
+	newCfg.HostList = append([]string{}, resp.Replicas...)
+	sort.Strings(newCfg.HostList)
+	apm.invoker.SetConfig(&newCfg)
+	apm.ctlCfg = &newCfg
+
+	apm.log.Noticef("MS access points updated: %s", strings.Join(newCfg.HostList, ","))
+}
+
+// Run periodically refreshes the access points until the context is canceled.
+func (apm *accessPointMonitor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			apm.refresh(ctx)
+		}
+	}
+}
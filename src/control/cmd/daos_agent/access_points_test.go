@@ -0,0 +1,94 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type configRecordingInvoker struct {
+	*control.MockInvoker
+	cfg *control.Config
+}
+
+func (i *configRecordingInvoker) SetConfig(cfg *control.Config) {
+	i.cfg = cfg
+}
+
+func TestAgent_accessPointMonitor_refresh(t *testing.T) {
+	for name, tc := range map[string]struct {
+		hostList    []string
+		resp        *control.UnaryResponse
+		expHostList []string
+	}{
+		"query fails": {
+			hostList: []string{"10.0.0.1:10001"},
+			resp:     control.MockMSResponse("", errors.New("no leader"), nil),
+		},
+		"no replicas": {
+			hostList: []string{"10.0.0.1:10001"},
+			resp:     control.MockMSResponse("", nil, &mgmtpb.LeaderQueryResp{}),
+		},
+		"unchanged": {
+			hostList: []string{"10.0.0.2:10001", "10.0.0.1:10001"},
+			resp: control.MockMSResponse("", nil, &mgmtpb.LeaderQueryResp{
+				CurrentLeader: "10.0.0.1:10001",
+				Replicas:      []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+			}),
+		},
+		"replica replaced": {
+			hostList: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+			resp: control.MockMSResponse("", nil, &mgmtpb.LeaderQueryResp{
+				CurrentLeader: "10.0.0.2:10001",
+				Replicas:      []string{"10.0.0.3:10001", "10.0.0.2:10001"},
+			}),
+			expHostList: []string{"10.0.0.2:10001", "10.0.0.3:10001"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			invoker := &configRecordingInvoker{
+				MockInvoker: control.NewMockInvoker(log, &control.MockInvokerConfig{
+					UnaryResponse: tc.resp,
+				}),
+			}
+			ctlCfg := control.DefaultConfig()
+			ctlCfg.HostList = tc.hostList
+
+			apm := newAccessPointMonitor(log, invoker, ctlCfg)
+			apm.refresh(context.TODO())
+
+			if tc.expHostList == nil {
+				if invoker.cfg != nil {
+					t.Fatalf("unexpected config update: %+v", invoker.cfg)
+				}
+				return
+			}
+
+			if invoker.cfg == nil {
+				t.Fatal("expected config update")
+			}
+			if diff := cmp.Diff(tc.expHostList, invoker.cfg.HostList); diff != "" {
+				t.Fatalf("unexpected host list (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expHostList, apm.ctlCfg.HostList); diff != "" {
+				t.Fatalf("unexpected monitor host list (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +9,7 @@ package main
 import (
 	"fmt"
 	"io/ioutil"
+	"time"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
@@ -34,9 +35,12 @@ type Config struct {
 	LogLevel            common.ControlLogLevel    `yaml:"control_log_mask,omitempty"`
 	TransportConfig     *security.TransportConfig `yaml:"transport_config"`
 	DisableCache        bool                      `yaml:"disable_caching,omitempty"`
+	CacheExpiration     time.Duration             `yaml:"cache_expiration,omitempty"`
 	DisableAutoEvict    bool                      `yaml:"disable_auto_evict,omitempty"`
 	ExcludeFabricIfaces common.StringSet          `yaml:"exclude_fabric_ifaces,omitempty"`
 	FabricInterfaces    []*NUMAFabricConfig       `yaml:"fabric_ifaces,omitempty"`
+	IdleTimeout         time.Duration             `yaml:"idle_timeout,omitempty"`
+	TelemetryPort       int                       `yaml:"telemetry_port,omitempty"`
 }
 
 // NUMAFabricConfig defines a list of fabric interfaces that belong to a NUMA
@@ -66,6 +70,11 @@ func LoadConfig(cfgPath string) (*Config, error) {
 	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
 		return nil, err
 	}
+	if cfg.TransportConfig != nil {
+		if err := cfg.TransportConfig.Grpc.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid grpc config")
+		}
+	}
 	return cfg, nil
 }
 
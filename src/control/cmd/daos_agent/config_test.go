@@ -8,6 +8,7 @@ package main
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -43,7 +44,10 @@ runtime_dir: /tmp/runtime
 log_file: /home/frodo/logfile
 control_log_mask: debug
 disable_caching: true
+cache_expiration: 10m
 disable_auto_evict: true
+idle_timeout: 15m
+telemetry_port: 9192
 transport_config:
   allow_insecure: true
 exclude_fabric_ifaces: ["ib3"]
@@ -128,7 +132,10 @@ transport_config:
 				LogFile:          "/home/frodo/logfile",
 				LogLevel:         common.ControlLogLevelDebug,
 				DisableCache:     true,
+				CacheExpiration:  10 * time.Minute,
 				DisableAutoEvict: true,
+				IdleTimeout:      15 * time.Minute,
+				TelemetryPort:    9192,
 				TransportConfig: &security.TransportConfig{
 					AllowInsecure:     true,
 					CertificateConfig: DefaultConfig().TransportConfig.CertificateConfig,
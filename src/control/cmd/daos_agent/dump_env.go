@@ -0,0 +1,165 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/hardware/hwprov"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+const (
+	agentDrpcDirEnv = "DAOS_AGENT_DRPC_DIR"
+	defaultJobIDEnv = "DAOS_JOBID"
+)
+
+type dumpEnvCmd struct {
+	cmdutil.LogCmd
+	configCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	JobID    string `long:"jobid" description:"Job ID to be set in the client environment"`
+	NUMANode uint   `short:"n" long:"numa-node" description:"NUMA node of the client process, used to select the fabric interface"`
+	Output   string `short:"o" long:"output" default:"stdout" description:"Dump output to this location"`
+}
+
+// clientEnv returns the sorted list of environment variables that libdaos
+// would set in a client process based on the supplied GetAttachInfo response.
+// Variables derived from the network hint take precedence over any
+// server-supplied client environment variables of the same name.
+func clientEnv(resp *mgmtpb.GetAttachInfoResp, runtimeDir, jobID string) ([]string, error) {
+	hint := resp.GetClientNetHint()
+	if hint == nil {
+		return nil, errors.New("GetAttachInfo response contained no client network hint")
+	}
+
+	netVars := []string{
+		"CRT_PHY_ADDR_STR=" + hint.Provider,
+		fmt.Sprintf("CRT_CTX_SHARE_ADDR=%d", hint.CrtCtxShareAddr),
+		fmt.Sprintf("CRT_TIMEOUT=%d", hint.CrtTimeout),
+		"OFI_INTERFACE=" + hint.Interface,
+		"OFI_DOMAIN=" + hint.Domain,
+	}
+	if hint.SrvSrxSet != -1 {
+		netVars = append(netVars, fmt.Sprintf("FI_OFI_RXM_USE_SRX=%d", hint.SrvSrxSet))
+	}
+	if runtimeDir != "" {
+		netVars = append(netVars, agentDrpcDirEnv+"="+runtimeDir)
+	}
+	if jobID != "" {
+		netVars = append(netVars, defaultJobIDEnv+"="+jobID)
+	}
+
+	env := common.MergeEnvVars(hint.EnvVars, netVars)
+	sort.Strings(env)
+
+	return env, nil
+}
+
+// shellQuote wraps the value in single quotes so that it is not subject to
+// expansion when sourced by a shell.
+func shellQuote(val string) string {
+	return "'" + strings.ReplaceAll(val, "'", `'\''`) + "'"
+}
+
+func writeClientEnvShell(out io.Writer, sys string, env []string) error {
+	ew := txtfmt.NewErrWriter(out)
+	fmt.Fprintf(ew, "# DAOS client environment for system %s\n", sys)
+	for _, pair := range env {
+		kv := strings.SplitN(pair, "=", 2)
+		fmt.Fprintf(ew, "export %s=%s\n", kv[0], shellQuote(kv[1]))
+	}
+
+	return ew.Err
+}
+
+func clientEnvMap(env []string) map[string]string {
+	envMap := make(map[string]string)
+	for _, pair := range env {
+		kv := strings.SplitN(pair, "=", 2)
+		envMap[kv[0]] = kv[1]
+	}
+
+	return envMap
+}
+
+func (cmd *dumpEnvCmd) getAttachInfo(ctx context.Context) (*mgmtpb.GetAttachInfoResp, error) {
+	hwprovFini, err := hwprov.Init(cmd.Logger)
+	if err != nil {
+		return nil, err
+	}
+	defer hwprovFini()
+
+	fabricCache := newLocalFabricCache(cmd.Logger, true).WithConfig(cmd.cfg)
+	if len(cmd.cfg.FabricInterfaces) > 0 {
+		nf := NUMAFabricFromConfig(cmd.Logger, cmd.cfg.FabricInterfaces)
+		fabricCache.Cache(ctx, nf)
+	}
+
+	// Resolve the attach info in the same way as the running agent would
+	// for a client process on the requested NUMA node.
+	mod := &mgmtModule{
+		log:            cmd.Logger,
+		sys:            cmd.cfg.SystemName,
+		ctlInvoker:     cmd.ctlInvoker,
+		attachInfo:     newAttachInfoCache(cmd.Logger, false),
+		fabricInfo:     fabricCache,
+		fabricScanner:  hwprov.DefaultFabricScanner(cmd.Logger),
+		devClassGetter: hwprov.DefaultNetDevClassProvider(cmd.Logger),
+		devStateGetter: hwprov.DefaultNetDevStateProvider(cmd.Logger),
+	}
+
+	return mod.getAttachInfo(ctx, int(cmd.NUMANode), cmd.cfg.SystemName)
+}
+
+// Execute is run when dumpEnvCmd activates.
+//
+// Write the environment that a client process would receive from the agent
+// to a shell-sourceable or JSON file.
+func (cmd *dumpEnvCmd) Execute(_ []string) error {
+	resp, err := cmd.getAttachInfo(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "GetAttachInfo failed")
+	}
+
+	env, err := clientEnv(resp, cmd.cfg.RuntimeDir, cmd.JobID)
+	if err != nil {
+		return err
+	}
+
+	// Build the complete output before touching the destination so that
+	// a failure doesn't leave behind an empty or partially-written file.
+	var buf bytes.Buffer
+	if cmd.jsonOutputEnabled() {
+		err = cmd.outputJSON(&buf, clientEnvMap(env))
+	} else {
+		err = writeClientEnvShell(&buf, cmd.cfg.SystemName, env)
+	}
+	if err != nil {
+		return err
+	}
+
+	if cmd.Output == "stdout" {
+		_, err = buf.WriteTo(os.Stdout)
+		return err
+	}
+
+	return errors.Wrapf(common.WriteFileAtomic(cmd.Output, buf.Bytes(), 0644),
+		"failed to write %q", cmd.Output)
+}
@@ -0,0 +1,133 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestAgent_clientEnv(t *testing.T) {
+	testHint := func() *mgmtpb.ClientNetHint {
+		return &mgmtpb.ClientNetHint{
+			Provider:        "ofi+tcp",
+			Interface:       "eth0",
+			Domain:          "eth0",
+			CrtCtxShareAddr: 1,
+			CrtTimeout:      30,
+			SrvSrxSet:       -1,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		hint       *mgmtpb.ClientNetHint
+		runtimeDir string
+		jobID      string
+		expEnv     []string
+		expErr     error
+	}{
+		"no hint": {
+			expErr: errors.New("no client network hint"),
+		},
+		"network hint only": {
+			hint: testHint(),
+			expEnv: []string{
+				"CRT_CTX_SHARE_ADDR=1",
+				"CRT_PHY_ADDR_STR=ofi+tcp",
+				"CRT_TIMEOUT=30",
+				"OFI_DOMAIN=eth0",
+				"OFI_INTERFACE=eth0",
+			},
+		},
+		"server srx setting": {
+			hint: func() *mgmtpb.ClientNetHint {
+				h := testHint()
+				h.SrvSrxSet = 0
+				return h
+			}(),
+			expEnv: []string{
+				"CRT_CTX_SHARE_ADDR=1",
+				"CRT_PHY_ADDR_STR=ofi+tcp",
+				"CRT_TIMEOUT=30",
+				"FI_OFI_RXM_USE_SRX=0",
+				"OFI_DOMAIN=eth0",
+				"OFI_INTERFACE=eth0",
+			},
+		},
+		"server env vars, runtime dir and job ID": {
+			hint: func() *mgmtpb.ClientNetHint {
+				h := testHint()
+				h.EnvVars = []string{"D_LOG_MASK=DEBUG", "CRT_TIMEOUT=5", "D_PROVIDER_AUTH_KEY=secret"}
+				return h
+			}(),
+			runtimeDir: "/var/run/daos_agent",
+			jobID:      "job42",
+			expEnv: []string{
+				"CRT_CTX_SHARE_ADDR=1",
+				"CRT_PHY_ADDR_STR=ofi+tcp",
+				"CRT_TIMEOUT=30",
+				"DAOS_AGENT_DRPC_DIR=/var/run/daos_agent",
+				"DAOS_JOBID=job42",
+				"D_LOG_MASK=DEBUG",
+				"D_PROVIDER_AUTH_KEY=secret",
+				"OFI_DOMAIN=eth0",
+				"OFI_INTERFACE=eth0",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := &mgmtpb.GetAttachInfoResp{ClientNetHint: tc.hint}
+
+			gotEnv, gotErr := clientEnv(resp, tc.runtimeDir, tc.jobID)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expEnv, gotEnv); diff != "" {
+				t.Fatalf("unexpected env (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_writeClientEnvShell(t *testing.T) {
+	env := []string{
+		"CRT_PHY_ADDR_STR=ofi+tcp",
+		"DAOS_JOBID=it's a job",
+		"OFI_INTERFACE=eth0",
+	}
+
+	var bld strings.Builder
+	if err := writeClientEnvShell(&bld, "daos_server", env); err != nil {
+		t.Fatal(err)
+	}
+
+	expOut := `# DAOS client environment for system daos_server
+export CRT_PHY_ADDR_STR='ofi+tcp'
+export DAOS_JOBID='it'\''s a job'
+export OFI_INTERFACE='eth0'
+`
+	if diff := cmp.Diff(expOut, bld.String()); diff != "" {
+		t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+	}
+
+	expMap := map[string]string{
+		"CRT_PHY_ADDR_STR": "ofi+tcp",
+		"DAOS_JOBID":       "it's a job",
+		"OFI_INTERFACE":    "eth0",
+	}
+	if diff := cmp.Diff(expMap, clientEnvMap(env)); diff != "" {
+		t.Fatalf("unexpected env map (-want, +got):\n%s\n", diff)
+	}
+}
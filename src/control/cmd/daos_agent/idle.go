@@ -0,0 +1,90 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// idleCheckMaxInterval is the longest period between idle checks.
+	idleCheckMaxInterval = 10 * time.Second
+)
+
+type (
+	// sessionTracker reports whether any client sessions are open and
+	// when the last one was closed.
+	sessionTracker interface {
+		IdleSince() (time.Time, bool)
+	}
+
+	// processCounter reports the number of monitored client processes.
+	processCounter interface {
+		NumMonitored(context.Context) int
+	}
+
+	// idleMonitor determines when the agent has had no client activity for
+	// longer than the configured timeout.
+	idleMonitor struct {
+		log      logging.Logger
+		timeout  time.Duration
+		sessions sessionTracker
+		procs    processCounter
+	}
+)
+
+func newIdleMonitor(log logging.Logger, timeout time.Duration, sessions sessionTracker, procs processCounter) *idleMonitor {
+	return &idleMonitor{
+		log:      log,
+		timeout:  timeout,
+		sessions: sessions,
+		procs:    procs,
+	}
+}
+
+// isIdle returns true if no client sessions are open, no client processes
+// hold pool handles and the last session was closed at least the idle timeout
+// before the supplied time.
+func (im *idleMonitor) isIdle(ctx context.Context, now time.Time) bool {
+	since, noSessions := im.sessions.IdleSince()
+	if !noSessions || now.Sub(since) < im.timeout {
+		return false
+	}
+
+	// Processes with open pool handles need the agent to clean up after
+	// them if they exit uncleanly.
+	return im.procs.NumMonitored(ctx) == 0
+}
+
+// Run periodically checks for idleness and calls the handler once the agent
+// has been idle for the configured timeout, or returns when the context is
+// canceled.
+func (im *idleMonitor) Run(ctx context.Context, onIdle func()) {
+	interval := im.timeout / 2
+	if interval > idleCheckMaxInterval {
+		interval = idleCheckMaxInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if im.isIdle(ctx, now) {
+				im.log.Infof("no client activity for %s; shutting down", im.timeout)
+				onIdle()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockSessionTracker struct {
+	since time.Time
+	idle  bool
+}
+
+func (m *mockSessionTracker) IdleSince() (time.Time, bool) {
+	return m.since, m.idle
+}
+
+type mockProcessCounter int
+
+func (m mockProcessCounter) NumMonitored(_ context.Context) int {
+	return int(m)
+}
+
+func TestAgent_idleMonitor_isIdle(t *testing.T) {
+	now := time.Now()
+	timeout := time.Minute
+
+	for name, tc := range map[string]struct {
+		sessions *mockSessionTracker
+		procs    mockProcessCounter
+		expIdle  bool
+	}{
+		"open session": {
+			sessions: &mockSessionTracker{since: now.Add(-time.Hour)},
+		},
+		"recent session": {
+			sessions: &mockSessionTracker{since: now.Add(-time.Second), idle: true},
+		},
+		"monitored processes": {
+			sessions: &mockSessionTracker{since: now.Add(-time.Hour), idle: true},
+			procs:    2,
+		},
+		"idle": {
+			sessions: &mockSessionTracker{since: now.Add(-timeout), idle: true},
+			expIdle:  true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			im := newIdleMonitor(log, timeout, tc.sessions, tc.procs)
+
+			test.AssertEqual(t, tc.expIdle, im.isIdle(context.Background(), now), "")
+		})
+	}
+}
+
+func TestAgent_idleMonitor_Run(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sessions := &mockSessionTracker{since: time.Now().Add(-time.Hour), idle: true}
+	im := newIdleMonitor(log, 10*time.Millisecond, sessions, mockProcessCounter(0))
+
+	idled := make(chan struct{})
+	im.Run(ctx, func() { close(idled) })
+
+	select {
+	case <-idled:
+	default:
+		t.Fatal("expected idle handler to be called")
+	}
+}
@@ -9,12 +9,15 @@ package main
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/clock"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/logging"
 )
@@ -33,6 +36,7 @@ func newAttachInfoCache(log logging.Logger, enabled bool) *attachInfoCache {
 	return &attachInfoCache{
 		log:     log,
 		enabled: atm.NewBool(enabled),
+		clock:   clock.New(),
 	}
 }
 
@@ -42,15 +46,38 @@ type attachInfoCache struct {
 	log         logging.Logger
 	enabled     atm.Bool
 	initialized atm.Bool
+	clock       clock.Clock
+
+	// length of time after which the cached response is refreshed, if set
+	expiration time.Duration
+	lastCached time.Time
 
 	// cached response from remote server
 	attachInfo *mgmtpb.GetAttachInfoResp
 }
 
+// WithExpiration sets the length of time for which a cached response is used
+// before it is refreshed from the remote server. Zero disables expiration.
+func (c *attachInfoCache) WithExpiration(expiration time.Duration) *attachInfoCache {
+	c.expiration = expiration
+	return c
+}
+
+// WithClock sets the clock used to determine whether the cached response has
+// expired.
+func (c *attachInfoCache) WithClock(clk clock.Clock) *attachInfoCache {
+	c.clock = clk
+	return c
+}
+
 func (c *attachInfoCache) isCached() bool {
 	return c.initialized.IsTrue()
 }
 
+func (c *attachInfoCache) isExpired() bool {
+	return c.expiration > 0 && c.clock.Since(c.lastCached) > c.expiration
+}
+
 func (c *attachInfoCache) isEnabled() bool {
 	return c.enabled.IsTrue()
 }
@@ -73,7 +100,10 @@ func (c *attachInfoCache) Get(ctx context.Context, numaNode int, sys string, get
 	defer c.mutex.Unlock()
 
 	if c.isEnabled() && c.isCached() {
-		return c.getAttachInfoResp()
+		if !c.isExpired() {
+			return c.getAttachInfoResp()
+		}
+		c.log.Debugf("cached GetAttachInfo response older than %s, refreshing", c.expiration)
 	}
 
 	attachInfo, err := getRemote(ctx, numaNode, sys)
@@ -86,11 +116,30 @@ func (c *attachInfoCache) Get(ctx context.Context, numaNode int, sys string, get
 	}
 
 	c.attachInfo = attachInfo
+	c.lastCached = c.clock.Now()
 	c.initialized.SetTrue()
 
 	return c.getAttachInfoResp()
 }
 
+// Invalidate discards the cached response so that the next request is served
+// from the remote server, e.g. after the system membership has changed.
+func (c *attachInfoCache) Invalidate() {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.isCached() {
+		return
+	}
+
+	c.log.Debug("invalidating cached GetAttachInfo response")
+	c.initialized.SetFalse()
+}
+
 func newLocalFabricCache(log logging.Logger, enabled bool) *localFabricCache {
 	return &localFabricCache{
 		log:             log,
@@ -109,6 +158,10 @@ type localFabricCache struct {
 
 	// cached fabric interfaces organized by NUMA affinity
 	localNUMAFabric *NUMAFabric
+	// user-defined fabric interfaces are never invalidated
+	userDefined bool
+	// providers whose cached fabric interfaces may be out of date
+	staleProviders common.StringSet
 }
 
 // WithConfig adds a config file for the cache to use.
@@ -135,6 +188,19 @@ func (c *localFabricCache) IsCached() bool {
 	return c.initialized.IsTrue()
 }
 
+// IsCachedFor reports whether there is up-to-date data in the cache for the
+// given provider.
+func (c *localFabricCache) IsCachedFor(provider string) bool {
+	if c == nil {
+		return false
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.IsCached() && !c.staleProviders.Has(provider)
+}
+
 // Cache caches the results of a fabric scan locally.
 func (c *localFabricCache) CacheScan(ctx context.Context, scan *hardware.FabricInterfaceSet) {
 	if c == nil {
@@ -158,6 +224,7 @@ func (c *localFabricCache) Cache(ctx context.Context, nf *NUMAFabric) {
 	defer c.mutex.Unlock()
 
 	c.setCache(nf)
+	c.userDefined = c.IsCached()
 }
 
 func (c *localFabricCache) setCache(nf *NUMAFabric) {
@@ -171,6 +238,7 @@ func (c *localFabricCache) setCache(nf *NUMAFabric) {
 		c.localNUMAFabric = nf.WithIgnoredDevices(c.cfg.ExcludeFabricIfaces)
 	}
 
+	c.staleProviders = common.NewStringSet()
 	c.initialized.SetTrue()
 	c.log.Debugf("cached:\n%+v", c.localNUMAFabric.numaMap)
 }
@@ -189,3 +257,37 @@ func (c *localFabricCache) GetDevice(numaNode int, netDevClass hardware.NetDevCl
 	}
 	return c.localNUMAFabric.GetDevice(numaNode, netDevClass, provider)
 }
+
+// Invalidate marks the cached data for the providers supported by a network
+// interface as out of date. If the interface isn't known to the cache, e.g. it
+// was hotplugged after the last scan, the entire cache is invalidated.
+func (c *localFabricCache) Invalidate(iface string) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.IsCached() || c.userDefined {
+		return
+	}
+
+	providers := common.NewStringSet()
+	for _, devs := range c.localNUMAFabric.numaMap {
+		for _, fi := range devs {
+			if fi.Name == iface && fi.hw != nil {
+				providers.Add(fi.Providers()...)
+			}
+		}
+	}
+
+	if len(providers) == 0 {
+		c.log.Debugf("unknown fabric interface %q changed, invalidating fabric cache", iface)
+		c.initialized.SetFalse()
+		return
+	}
+
+	c.log.Debugf("fabric interface %q changed, invalidating cached providers: %s", iface, providers)
+	c.staleProviders.Add(providers.ToSlice()...)
+}
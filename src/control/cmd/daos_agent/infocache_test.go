@@ -8,7 +8,9 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -18,6 +20,7 @@ import (
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/clock"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/logging"
 )
@@ -61,6 +64,7 @@ func TestAgent_newAttachInfoCache(t *testing.T) {
 }
 
 func TestAgent_attachInfoCache_Get(t *testing.T) {
+	now := time.Now()
 	srvResp := &mgmtpb.GetAttachInfoResp{
 		Status: -1000,
 		RankUris: []*mgmtpb.GetAttachInfoResp_RankUri{
@@ -91,6 +95,25 @@ func TestAgent_attachInfoCache_Get(t *testing.T) {
 			cache:     srvResp,
 			expCached: true,
 		},
+		"cached; not expired": {
+			aic: &attachInfoCache{
+				enabled:    atm.NewBool(true),
+				expiration: time.Hour,
+				lastCached: now.Add(-time.Minute),
+			},
+			cache:     srvResp,
+			expCached: true,
+		},
+		"cached; expired": {
+			aic: &attachInfoCache{
+				enabled:    atm.NewBool(true),
+				expiration: time.Minute,
+				lastCached: now.Add(-time.Hour),
+			},
+			cache:     srvResp,
+			expRemote: true,
+			expCached: true,
+		},
 		"remote fails": {
 			aic:       &attachInfoCache{enabled: atm.NewBool(true)},
 			expRemote: true,
@@ -99,6 +122,9 @@ func TestAgent_attachInfoCache_Get(t *testing.T) {
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
 			if tc.cache != nil {
 				tc.aic.attachInfo = tc.cache
 				tc.aic.initialized.SetTrue()
@@ -107,6 +133,8 @@ func TestAgent_attachInfoCache_Get(t *testing.T) {
 			if tc.aic == nil {
 				return
 			}
+			tc.aic.log = log
+			tc.aic.clock = clock.NewMock(now)
 
 			numaNode := 42
 			sysName := "snekSezSyss"
@@ -137,6 +165,78 @@ func TestAgent_attachInfoCache_Get(t *testing.T) {
 	}
 }
 
+func TestAgent_attachInfoCache_Invalidate(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	srvResp := &mgmtpb.GetAttachInfoResp{
+		RankUris: []*mgmtpb.GetAttachInfoResp_RankUri{
+			{Rank: 1, Uri: "firsturi"},
+		},
+	}
+	var remoteCalls int
+	getFn := func(_ context.Context, _ int, _ string) (*mgmtpb.GetAttachInfoResp, error) {
+		remoteCalls++
+		return srvResp, nil
+	}
+
+	var nilCache *attachInfoCache
+	nilCache.Invalidate()
+
+	aic := newAttachInfoCache(log, true)
+	aic.Invalidate()
+	test.AssertFalse(t, aic.isCached(), "uncached after invalidating empty cache")
+
+	for i := 0; i < 2; i++ {
+		if _, err := aic.Get(context.Background(), 0, "", getFn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	test.AssertEqual(t, 1, remoteCalls, "remote calls before invalidation")
+
+	aic.Invalidate()
+	test.AssertFalse(t, aic.isCached(), "uncached after invalidation")
+
+	if _, err := aic.Get(context.Background(), 0, "", getFn); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 2, remoteCalls, "remote calls after invalidation")
+	test.AssertTrue(t, aic.isCached(), "cached after refresh")
+}
+
+func TestAgent_attachInfoCache_expiration(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	var remoteCalls int
+	getFn := func(_ context.Context, _ int, _ string) (*mgmtpb.GetAttachInfoResp, error) {
+		remoteCalls++
+		return &mgmtpb.GetAttachInfoResp{}, nil
+	}
+
+	mc := clock.NewMock(time.Now())
+	aic := newAttachInfoCache(log, true).WithExpiration(time.Minute).WithClock(mc)
+
+	for i, step := range []struct {
+		advance  time.Duration
+		expCalls int
+	}{
+		{expCalls: 1},
+		{advance: 30 * time.Second, expCalls: 1},
+		{advance: 30 * time.Second, expCalls: 1},
+		{advance: time.Second, expCalls: 2},
+		{advance: 59 * time.Second, expCalls: 2},
+		{advance: time.Hour, expCalls: 3},
+	} {
+		mc.Advance(step.advance)
+		if _, err := aic.Get(context.Background(), 0, "", getFn); err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEqual(t, step.expCalls, remoteCalls,
+			fmt.Sprintf("remote calls at step %d", i))
+	}
+}
+
 func TestAgent_newLocalFabricCache(t *testing.T) {
 	for name, tc := range map[string]struct {
 		enabled bool
@@ -564,3 +664,87 @@ func TestAgent_localFabricCache_GetDevice(t *testing.T) {
 		})
 	}
 }
+
+func TestAgent_localFabricCache_Invalidate(t *testing.T) {
+	testScan := func() *hardware.FabricInterfaceSet {
+		return hardware.NewFabricInterfaceSet(
+			&hardware.FabricInterface{
+				Providers:     testFabricProviderSet("ofi+tcp", "ofi+verbs"),
+				Name:          "mlx5_0",
+				NetInterfaces: common.NewStringSet("ib0"),
+				DeviceClass:   hardware.Infiniband,
+			},
+			&hardware.FabricInterface{
+				Providers:     testFabricProviderSet("ofi+tcp"),
+				Name:          "eth0",
+				NetInterfaces: common.NewStringSet("eth0"),
+				DeviceClass:   hardware.Ether,
+			},
+		)
+	}
+
+	for name, tc := range map[string]struct {
+		userDefined  bool
+		iface        string
+		expCached    bool
+		expCachedFor map[string]bool
+	}{
+		"known interface": {
+			iface:     "eth0",
+			expCached: true,
+			expCachedFor: map[string]bool{
+				"ofi+tcp":   false,
+				"ofi+verbs": true,
+			},
+		},
+		"known interface with multiple providers": {
+			iface:     "ib0",
+			expCached: true,
+			expCachedFor: map[string]bool{
+				"ofi+tcp":   false,
+				"ofi+verbs": false,
+			},
+		},
+		"unknown interface": {
+			iface: "ib1",
+			expCachedFor: map[string]bool{
+				"ofi+tcp":   false,
+				"ofi+verbs": false,
+			},
+		},
+		"user-defined": {
+			userDefined: true,
+			iface:       "ib1",
+			expCached:   true,
+			expCachedFor: map[string]bool{
+				"ofi+tcp":   true,
+				"ofi+verbs": true,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			lfc := newLocalFabricCache(log, true)
+			if tc.userDefined {
+				lfc.Cache(context.TODO(), NUMAFabricFromScan(context.TODO(), log, testScan()))
+			} else {
+				lfc.CacheScan(context.TODO(), testScan())
+			}
+
+			lfc.Invalidate(tc.iface)
+
+			test.AssertEqual(t, tc.expCached, lfc.IsCached(), "IsCached()")
+			for prov, exp := range tc.expCachedFor {
+				test.AssertEqual(t, exp, lfc.IsCachedFor(prov), "IsCachedFor("+prov+")")
+			}
+
+			// A new scan should make all providers available again.
+			lfc.CacheScan(context.TODO(), testScan())
+			for prov := range tc.expCachedFor {
+				test.AssertTrue(t, lfc.IsCachedFor(prov), "IsCachedFor("+prov+") after rescan")
+			}
+		})
+	}
+}
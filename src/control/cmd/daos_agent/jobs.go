@@ -0,0 +1,228 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type (
+	// jobInfo describes a job registered by a job scheduler prolog.
+	jobInfo struct {
+		id      string
+		uid     uint32
+		nodes   *hostlist.HostSet
+		started time.Time
+	}
+
+	// jobTracker keeps track of the scheduler jobs running on the local
+	// node, and of the users whose jobs have all ended. Once a user's last
+	// job has ended, the agent stops issuing credentials to that user's
+	// processes until another job is started for them, so that processes
+	// left behind by a killed job can't reconnect to DAOS.
+	jobTracker struct {
+		sync.RWMutex
+		log     logging.Logger
+		active  map[string]*jobInfo
+		revoked map[uint32]struct{}
+	}
+)
+
+func newJobTracker(log logging.Logger) *jobTracker {
+	return &jobTracker{
+		log:     log,
+		active:  make(map[string]*jobInfo),
+		revoked: make(map[uint32]struct{}),
+	}
+}
+
+// Start registers a job and allows credentials to be issued to its user.
+func (jt *jobTracker) Start(id string, uid uint32, nodes string) error {
+	if id == "" {
+		return errors.New("empty job ID")
+	}
+
+	hs, err := hostlist.CreateSet(nodes)
+	if err != nil {
+		return errors.Wrapf(err, "invalid node list for job %s", id)
+	}
+
+	jt.Lock()
+	defer jt.Unlock()
+
+	if extant, found := jt.active[id]; found && extant.uid != uid {
+		return errors.Errorf("job %s already registered for uid %d", id, extant.uid)
+	}
+
+	jt.active[id] = &jobInfo{
+		id:      id,
+		uid:     uid,
+		nodes:   hs,
+		started: time.Now(),
+	}
+	delete(jt.revoked, uid)
+
+	jt.log.Debugf("job %s started for uid %d on %s", id, uid, hs)
+	return nil
+}
+
+// End deregisters a job. If the job's user has no other jobs running on the
+// node, credentials are no longer issued to them. The supplied uid is used if
+// the job was not registered (e.g. the agent was restarted while it was
+// running). The root user is never denied credentials.
+func (jt *jobTracker) End(id string, uid uint32) {
+	jt.Lock()
+	defer jt.Unlock()
+
+	if job, found := jt.active[id]; found {
+		delete(jt.active, id)
+		uid = job.uid
+		jt.log.Debugf("job %s for uid %d ended after %s", id, uid, time.Since(job.started))
+	} else {
+		jt.log.Debugf("job %s for uid %d ended but was not registered", id, uid)
+	}
+
+	if uid != 0 && !jt.uidHasJobs(uid) {
+		jt.revoked[uid] = struct{}{}
+	}
+}
+
+func (jt *jobTracker) uidHasJobs(uid uint32) bool {
+	for _, job := range jt.active {
+		if job.uid == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// CredentialsAllowed indicates whether credentials may be issued to the
+// supplied user.
+func (jt *jobTracker) CredentialsAllowed(uid uint32) bool {
+	if jt == nil {
+		return true
+	}
+
+	jt.RLock()
+	defer jt.RUnlock()
+
+	_, revoked := jt.revoked[uid]
+	return !revoked
+}
+
+// jobCmd is the struct representing the top-level job subcommand.
+type jobCmd struct {
+	Start jobStartCmd `command:"start" description:"Notify the local agent that a job is starting (e.g. from a scheduler prolog)"`
+	End   jobEndCmd   `command:"end" description:"Notify the local agent that a job has ended (e.g. from a scheduler epilog)"`
+}
+
+type jobNotifyCmd struct {
+	cmdutil.LogCmd
+	configCmd
+	jsonOutputCmd
+	JobID string `long:"jobid" required:"1" description:"Scheduler job ID"`
+	UID   uint32 `long:"uid" description:"UID of the user running the job"`
+}
+
+// notify sends the job notification to the running agent via its dRPC socket.
+func (cmd *jobNotifyCmd) notify(method drpc.Method, req *mgmtpb.JobNotifyReq) (*mgmtpb.JobNotifyResp, error) {
+	req.Sys = cmd.cfg.SystemName
+	req.Jobid = cmd.JobID
+	req.Uid = cmd.UID
+
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+
+	client := drpc.NewClientConnection(filepath.Join(cmd.cfg.RuntimeDir, agentSockName))
+	if err := client.Connect(); err != nil {
+		return nil, errors.Wrap(err, "failed to connect to daos_agent")
+	}
+	defer client.Close()
+
+	dResp, err := client.SendMsg(&drpc.Call{
+		Module: method.Module().ID(),
+		Method: method.ID(),
+		Body:   body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if dResp.Status != drpc.Status_SUCCESS {
+		return nil, errors.Errorf("%s failed: dRPC status %s", method, dResp.Status)
+	}
+
+	resp := new(mgmtpb.JobNotifyResp)
+	if err := proto.Unmarshal(dResp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal response")
+	}
+	if resp.Status != 0 {
+		return nil, errors.Wrapf(daos.Status(resp.Status), "%s failed", method)
+	}
+
+	return resp, nil
+}
+
+type jobStartCmd struct {
+	jobNotifyCmd
+	Nodes string `long:"nodes" description:"Hostlist of the nodes allocated to the job"`
+}
+
+// Execute is run when jobStartCmd activates.
+func (cmd *jobStartCmd) Execute(_ []string) error {
+	resp, err := cmd.notify(drpc.MethodNotifyJobStart, &mgmtpb.JobNotifyReq{
+		Nodes: cmd.Nodes,
+	})
+	if err != nil {
+		return err
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, resp)
+	}
+
+	cmd.Debugf("job %s started", cmd.JobID)
+	return nil
+}
+
+type jobEndCmd struct {
+	jobNotifyCmd
+}
+
+// Execute is run when jobEndCmd activates.
+//
+// Notify the agent that the job has ended, so that any pool handles left open
+// by the job's processes are evicted.
+func (cmd *jobEndCmd) Execute(_ []string) error {
+	resp, err := cmd.notify(drpc.MethodNotifyJobEnd, &mgmtpb.JobNotifyReq{})
+	if err != nil {
+		return err
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, resp)
+	}
+
+	if resp.EvictedHandles > 0 {
+		cmd.Infof("job %s ended; evicted %d pool handles", cmd.JobID, resp.EvictedHandles)
+	}
+	return nil
+}
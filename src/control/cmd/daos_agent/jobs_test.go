@@ -0,0 +1,124 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_jobTracker(t *testing.T) {
+	type jobEvent struct {
+		start bool
+		id    string
+		uid   uint32
+		nodes string
+	}
+
+	for name, tc := range map[string]struct {
+		events     []jobEvent
+		expErr     error
+		expAllowed map[uint32]bool
+	}{
+		"empty job ID": {
+			events: []jobEvent{{start: true, uid: 1000}},
+			expErr: errors.New("empty job ID"),
+		},
+		"bad node list": {
+			events: []jobEvent{{start: true, id: "1", uid: 1000, nodes: "node[1-"}},
+			expErr: errors.New("invalid node list"),
+		},
+		"job registered for another user": {
+			events: []jobEvent{
+				{start: true, id: "1", uid: 1000},
+				{start: true, id: "1", uid: 1001},
+			},
+			expErr: errors.New("already registered for uid 1000"),
+		},
+		"no jobs": {
+			expAllowed: map[uint32]bool{0: true, 1000: true},
+		},
+		"job running": {
+			events: []jobEvent{
+				{start: true, id: "1", uid: 1000, nodes: "node[1-4]"},
+			},
+			expAllowed: map[uint32]bool{1000: true},
+		},
+		"last job ended": {
+			events: []jobEvent{
+				{start: true, id: "1", uid: 1000},
+				{id: "1"},
+			},
+			expAllowed: map[uint32]bool{1000: false, 1001: true},
+		},
+		"other job still running": {
+			events: []jobEvent{
+				{start: true, id: "1", uid: 1000},
+				{start: true, id: "2", uid: 1000},
+				{id: "1"},
+			},
+			expAllowed: map[uint32]bool{1000: true},
+		},
+		"unregistered job ended": {
+			events: []jobEvent{
+				{id: "1", uid: 1000},
+			},
+			expAllowed: map[uint32]bool{1000: false},
+		},
+		"new job started after end": {
+			events: []jobEvent{
+				{start: true, id: "1", uid: 1000},
+				{id: "1"},
+				{start: true, id: "2", uid: 1000},
+			},
+			expAllowed: map[uint32]bool{1000: true},
+		},
+		"root never revoked": {
+			events: []jobEvent{
+				{start: true, id: "1", uid: 0},
+				{id: "1"},
+			},
+			expAllowed: map[uint32]bool{0: true},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			jt := newJobTracker(log)
+
+			var gotErr error
+			for _, ev := range tc.events {
+				if ev.start {
+					if gotErr = jt.Start(ev.id, ev.uid, ev.nodes); gotErr != nil {
+						break
+					}
+					continue
+				}
+				jt.End(ev.id, ev.uid)
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			for uid, expAllowed := range tc.expAllowed {
+				test.AssertEqual(t, expAllowed, jt.CredentialsAllowed(uid),
+					"unexpected credential permission")
+			}
+		})
+	}
+}
+
+func TestAgent_jobTracker_Nil(t *testing.T) {
+	var jt *jobTracker
+	test.AssertTrue(t, jt.CredentialsAllowed(1000), "nil tracker should allow credentials")
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2018-2022 Intel Corporation.
+// (C) Copyright 2018-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -36,8 +36,10 @@ type cliOptions struct {
 	Start      startCmd               `command:"start" description:"Start daos_agent daemon (default behavior)"`
 	Version    versionCmd             `command:"version" description:"Print daos_agent version"`
 	DumpInfo   dumpAttachInfoCmd      `command:"dump-attachinfo" description:"Dump system attachinfo"`
+	DumpEnv    dumpEnvCmd             `command:"dump-env" description:"Dump the environment a client process would receive"`
 	DumpTopo   hwprov.DumpTopologyCmd `command:"dump-topology" description:"Dump system topology"`
 	NetScan    netScanCmd             `command:"net-scan" description:"Perform local network fabric scan"`
+	Job        jobCmd                 `command:"job" description:"Notify the agent of job scheduler events"`
 }
 
 type (
@@ -102,9 +104,15 @@ func versionString() string {
 	return fmt.Sprintf("%s v%s", build.AgentName, build.DaosVersion)
 }
 
-type versionCmd struct{}
+type versionCmd struct {
+	jsonOutputCmd
+}
 
 func (cmd *versionCmd) Execute(_ []string) error {
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, build.NewVersionInfo(build.AgentName, build.ComponentAgent))
+	}
+
 	_, err := fmt.Println(versionString())
 	return err
 }
@@ -114,6 +122,17 @@ func exitWithError(log logging.Logger, err error) {
 	os.Exit(1)
 }
 
+// controlConfig generates a control config based on the loaded agent config.
+func controlConfig(cfg *Config) *control.Config {
+	ctlCfg := control.DefaultConfig()
+	ctlCfg.TransportConfig = cfg.TransportConfig
+	ctlCfg.HostList = cfg.AccessPoints
+	ctlCfg.SystemName = cfg.SystemName
+	ctlCfg.ControlPort = cfg.ControlPort
+
+	return ctlCfg
+}
+
 func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *logging.LeveledLogger) error {
 	p := flags.NewParser(opts, flags.Default)
 	p.Options ^= flags.PrintErrors // Don't allow the library to print errors
@@ -220,14 +239,7 @@ func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *lo
 		}
 
 		if ctlCmd, ok := cmd.(ctlInvoker); ok {
-			// Generate a control config based on the loaded agent config.
-			ctlCfg := control.DefaultConfig()
-			ctlCfg.TransportConfig = cfg.TransportConfig
-			ctlCfg.HostList = cfg.AccessPoints
-			ctlCfg.SystemName = cfg.SystemName
-			ctlCfg.ControlPort = cfg.ControlPort
-
-			invoker.SetConfig(ctlCfg)
+			invoker.SetConfig(controlConfig(cfg))
 			ctlCmd.setInvoker(invoker)
 		}
 
@@ -248,6 +260,7 @@ func main() {
 
 	ctlInvoker := control.NewClient(
 		control.WithClientLogger(log),
+		control.WithUnreachableHostLogger(log),
 	)
 
 	if err := parseOpts(os.Args[1:], &opts, ctlInvoker, log); err != nil {
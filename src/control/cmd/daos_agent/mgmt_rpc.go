@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2019-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -39,6 +39,8 @@ type mgmtModule struct {
 	attachInfo     *attachInfoCache
 	fabricInfo     *localFabricCache
 	monitor        *procMon
+	jobs           *jobTracker
+	metrics        *agentMetrics
 	useDefaultNUMA bool
 
 	numaGetter     hardware.ProcessNUMAProvider
@@ -71,6 +73,7 @@ func (mod *mgmtModule) HandleCall(ctx context.Context, session *drpc.Session, me
 		return nil, drpc.NewFailureWithMessage("agent is shutting down")
 	}
 
+	mod.metrics.requestReceived(method.String())
 	switch method {
 	case drpc.MethodGetAttachInfo:
 		return mod.handleGetAttachInfo(ctx, req, cred.Pid)
@@ -83,6 +86,13 @@ func (mod *mgmtModule) HandleCall(ctx context.Context, session *drpc.Session, me
 		// call the disconnect handler and return success.
 		mod.handleNotifyExit(ctx, cred.Pid)
 		return nil, nil
+	case drpc.MethodNotifyJobStart, drpc.MethodNotifyJobEnd:
+		// Only the job scheduler (running as root) may report jobs.
+		if cred.Uid != 0 {
+			mod.log.Errorf("%s: rejecting request from uid %d", method, cred.Uid)
+			return nil, drpc.NewFailureWithMessage("job notifications must be sent by root")
+		}
+		return mod.handleNotifyJob(ctx, req, method)
 	}
 
 	return nil, drpc.UnknownMethodFailure()
@@ -104,6 +114,8 @@ func (mod *mgmtModule) ID() drpc.ModuleID {
 // The use of cached data may be disabled by exporting
 // "DAOS_AGENT_DISABLE_CACHE=true" in the environment running the daos_agent.
 func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid int32) ([]byte, error) {
+	defer mod.metrics.attachInfoHandled(time.Now())
+
 	pbReq := new(mgmtpb.GetAttachInfoReq)
 	if err := proto.Unmarshal(reqb, pbReq); err != nil {
 		return nil, drpc.UnmarshalingPayloadFailure()
@@ -186,7 +198,17 @@ func (mod *mgmtModule) getAttachInfo(ctx context.Context, numaNode int, sys stri
 }
 
 func (mod *mgmtModule) getAttachInfoResp(ctx context.Context, numaNode int, sys string) (*mgmtpb.GetAttachInfoResp, error) {
-	return mod.attachInfo.Get(ctx, numaNode, sys, mod.getAttachInfoRemote)
+	var remote bool
+	resp, err := mod.attachInfo.Get(ctx, numaNode, sys,
+		func(ctx context.Context, numaNode int, sys string) (*mgmtpb.GetAttachInfoResp, error) {
+			remote = true
+			return mod.getAttachInfoRemote(ctx, numaNode, sys)
+		})
+	if err == nil {
+		mod.metrics.attachInfoCached(!remote)
+	}
+
+	return resp, err
 }
 
 func (mod *mgmtModule) getAttachInfoRemote(ctx context.Context, numaNode int, sys string) (*mgmtpb.GetAttachInfoResp, error) {
@@ -195,7 +217,9 @@ func (mod *mgmtModule) getAttachInfoRemote(ctx context.Context, numaNode int, sy
 	req := new(control.GetAttachInfoReq)
 	req.SetSystem(sys)
 	req.AllRanks = true
+	start := time.Now()
 	resp, err := control.GetAttachInfo(ctx, mod.ctlInvoker, req)
+	mod.metrics.msRPCDone("GetAttachInfo", start)
 	if err != nil {
 		return nil, errors.Wrapf(err, "GetAttachInfo %+v", req)
 	}
@@ -216,7 +240,7 @@ func (mod *mgmtModule) getFabricInterface(ctx context.Context, numaNode int, net
 	mod.attachInfoMutex.Lock()
 	defer mod.attachInfoMutex.Unlock()
 
-	if mod.fabricInfo.IsCached() {
+	if mod.fabricInfo.IsCachedFor(provider) {
 		return mod.fabricInfo.GetDevice(numaNode, netDevClass, provider)
 	}
 
@@ -287,3 +311,42 @@ func (mod *mgmtModule) handleNotifyPoolDisconnect(ctx context.Context, reqb []by
 func (mod *mgmtModule) handleNotifyExit(ctx context.Context, pid int32) {
 	mod.monitor.NotifyExit(ctx, pid)
 }
+
+// handleNotifyJob processes job start and end notifications sent by a job
+// scheduler prolog or epilog. When a job ends, any pool handles left open by
+// its processes are evicted, and credentials are no longer issued to its user
+// if they have no other jobs running on the node.
+func (mod *mgmtModule) handleNotifyJob(ctx context.Context, reqb []byte, method drpc.Method) ([]byte, error) {
+	pbReq := new(mgmtpb.JobNotifyReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	resp := new(mgmtpb.JobNotifyResp)
+	if pbReq.Sys != "" && pbReq.Sys != mod.sys {
+		mod.log.Errorf("%s: %s: unknown system name", method, pbReq.Sys)
+		resp.Status = int32(daos.InvalidInput)
+		return drpc.Marshal(resp)
+	}
+
+	switch method {
+	case drpc.MethodNotifyJobStart:
+		if err := mod.jobs.Start(pbReq.Jobid, pbReq.Uid, pbReq.Nodes); err != nil {
+			mod.log.Errorf("%s: %s", method, err)
+			resp.Status = int32(daos.InvalidInput)
+		}
+	case drpc.MethodNotifyJobEnd:
+		if pbReq.Jobid == "" {
+			resp.Status = int32(daos.InvalidInput)
+			break
+		}
+		mod.jobs.End(pbReq.Jobid, pbReq.Uid)
+		resp.EvictedHandles = uint32(mod.monitor.FlushJobHandles(ctx, pbReq.Jobid))
+		if resp.EvictedHandles > 0 {
+			mod.log.Noticef("evicted %d pool handles left open by job %s",
+				resp.EvictedHandles, pbReq.Jobid)
+		}
+	}
+
+	return drpc.Marshal(resp)
+}
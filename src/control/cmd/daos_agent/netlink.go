@@ -0,0 +1,109 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const linkEventBufSize = 64 * 1024
+
+// linkEventMonitor listens for network interface hotplug and state change
+// events from the kernel via netlink.
+type linkEventMonitor struct {
+	log  logging.Logger
+	sock *os.File
+}
+
+// newLinkEventMonitor opens a netlink socket subscribed to link events.
+func newLinkEventMonitor(log logging.Logger) (*linkEventMonitor, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK,
+		unix.NETLINK_ROUTE)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating netlink socket")
+	}
+
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{
+		Family: unix.AF_NETLINK,
+		Groups: unix.RTMGRP_LINK,
+	}); err != nil {
+		unix.Close(fd)
+		return nil, errors.Wrap(err, "binding netlink socket")
+	}
+
+	return &linkEventMonitor{
+		log:  log,
+		sock: os.NewFile(uintptr(fd), "netlink"),
+	}, nil
+}
+
+// Run calls the handler with the name of each network interface that is
+// added, removed or changed, until the context is canceled.
+func (m *linkEventMonitor) Run(ctx context.Context, handler func(iface string)) {
+	go func() {
+		<-ctx.Done()
+		m.sock.Close()
+	}()
+
+	buf := make([]byte, linkEventBufSize)
+	for {
+		n, err := m.sock.Read(buf)
+		if err != nil {
+			if ctx.Err() == nil {
+				m.log.Errorf("reading link events: %s", err)
+			}
+			return
+		}
+
+		ifaces, err := parseLinkEvents(buf[:n])
+		if err != nil {
+			m.log.Errorf("parsing link events: %s", err)
+			continue
+		}
+
+		for _, iface := range ifaces {
+			handler(iface)
+		}
+	}
+}
+
+// parseLinkEvents extracts the interface names from a buffer of netlink
+// link messages.
+func parseLinkEvents(buf []byte) ([]string, error) {
+	msgs, err := syscall.ParseNetlinkMessage(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces []string
+	for i := range msgs {
+		if msgs[i].Header.Type != unix.RTM_NEWLINK && msgs[i].Header.Type != unix.RTM_DELLINK {
+			continue
+		}
+
+		attrs, err := syscall.ParseNetlinkRouteAttr(&msgs[i])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, attr := range attrs {
+			if attr.Attr.Type == unix.IFLA_IFNAME {
+				ifaces = append(ifaces, strings.TrimRight(string(attr.Value), "\x00"))
+			}
+		}
+	}
+
+	return ifaces, nil
+}
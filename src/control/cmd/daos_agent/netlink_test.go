@@ -0,0 +1,71 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func nlAlign(n int) int {
+	return (n + unix.NLMSG_ALIGNTO - 1) & ^(unix.NLMSG_ALIGNTO - 1)
+}
+
+func testLinkMsg(msgType uint16, iface string) []byte {
+	attrLen := unix.SizeofRtAttr + len(iface) + 1
+	msgLen := unix.NLMSG_HDRLEN + unix.SizeofIfInfomsg + nlAlign(attrLen)
+
+	buf := make([]byte, msgLen)
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(msgLen))
+	binary.LittleEndian.PutUint16(buf[4:6], msgType)
+
+	attr := buf[unix.NLMSG_HDRLEN+unix.SizeofIfInfomsg:]
+	binary.LittleEndian.PutUint16(attr[0:2], uint16(attrLen))
+	binary.LittleEndian.PutUint16(attr[2:4], unix.IFLA_IFNAME)
+	copy(attr[unix.SizeofRtAttr:], iface)
+
+	return buf
+}
+
+func TestAgent_parseLinkEvents(t *testing.T) {
+	for name, tc := range map[string]struct {
+		buf       []byte
+		expIfaces []string
+		expErr    error
+	}{
+		"empty": {},
+		"truncated": {
+			buf:    testLinkMsg(unix.RTM_NEWLINK, "eth0")[:unix.NLMSG_HDRLEN+4],
+			expErr: unix.EINVAL,
+		},
+		"new link": {
+			buf:       testLinkMsg(unix.RTM_NEWLINK, "eth0"),
+			expIfaces: []string{"eth0"},
+		},
+		"multiple events": {
+			buf: append(append(
+				testLinkMsg(unix.RTM_NEWLINK, "ib0"),
+				testLinkMsg(unix.RTM_NEWADDR, "ib1")...),
+				testLinkMsg(unix.RTM_DELLINK, "eth10")...),
+			expIfaces: []string{"ib0", "eth10"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ifaces, err := parseLinkEvents(tc.buf)
+			test.CmpErr(t, tc.expErr, err)
+
+			if diff := cmp.Diff(tc.expIfaces, ifaces); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 const (
 	// Agent-internal methods not linked to engine handlers.
 	flushAllHandles drpc.MgmtMethod = drpc.MgmtMethod(^uint32(0) >> 1)
+	countProcesses  drpc.MgmtMethod = flushAllHandles - 1
+	flushJobHandles drpc.MgmtMethod = flushAllHandles - 2
 )
 
 type procMonRequest struct {
@@ -33,10 +35,16 @@ type procMonRequest struct {
 	poolUUID string
 	// The UUID of the pool handle associated with this request
 	poolHandleUUID string
+	// The ID of the job that the process belongs to
+	jobID string
 	// If the request should be blocking, the caller should
 	// supply a channel to be closed when the request is
 	// complete.
 	doneChan chan struct{}
+	// Set to the number of monitored processes if action is countProcesses
+	numProcs int
+	// Set to the number of handles flushed if action is flushJobHandles
+	numHandles int
 }
 
 type procMonResponse struct {
@@ -58,6 +66,7 @@ func (phm poolHandleMap) add(poolUUID, handleUUID string) {
 type procInfo struct {
 	log       logging.Logger
 	pid       int32
+	jobID     string
 	cancelCtx func()
 	response  chan *procMonResponse
 	handles   poolHandleMap
@@ -141,6 +150,7 @@ func (p *procMon) AddPoolHandle(ctx context.Context, Pid int32, poolReq *mgmtpb.
 		action:         drpc.MethodNotifyPoolConnect,
 		poolUUID:       poolReq.PoolUUID,
 		poolHandleUUID: poolReq.PoolHandleUUID,
+		jobID:          poolReq.Jobid,
 	}
 	p.submitRequest(ctx, req)
 }
@@ -178,6 +188,46 @@ func (p *procMon) FlushAllHandles(ctx context.Context) {
 	<-done
 }
 
+// FlushJobHandles submits a request to flush (evict and remove) all known open
+// pool handles for local DAOS client processes belonging to a job, and stops
+// monitoring those processes. Returns the number of handles flushed.
+func (p *procMon) FlushJobHandles(ctx context.Context, jobID string) int {
+	p.log.Infof("Flushing open local pool handles for job %s", jobID)
+
+	done := make(chan struct{})
+	req := &procMonRequest{
+		action:   flushJobHandles,
+		jobID:    jobID,
+		doneChan: done,
+	}
+	p.submitRequest(ctx, req)
+
+	select {
+	case <-ctx.Done():
+		return 0
+	case <-done:
+		return req.numHandles
+	}
+}
+
+// NumMonitored returns the number of local DAOS client processes with open
+// pool handles that are currently being monitored.
+func (p *procMon) NumMonitored(ctx context.Context) int {
+	done := make(chan struct{})
+	req := &procMonRequest{
+		action:   countProcesses,
+		doneChan: done,
+	}
+	p.submitRequest(ctx, req)
+
+	select {
+	case <-ctx.Done():
+		return 0
+	case <-done:
+		return req.numProcs
+	}
+}
+
 func (p *procMon) submitRequest(ctx context.Context, request *procMonRequest) {
 	select {
 	case <-ctx.Done():
@@ -195,6 +245,7 @@ func (p *procMon) handleNotifyPoolConnect(ctx context.Context, request *procMonR
 		info = &procInfo{
 			log:       p.log,
 			pid:       request.pid,
+			jobID:     request.jobID,
 			cancelCtx: cancel,
 			response:  p.response,
 			handles:   make(poolHandleMap),
@@ -286,6 +337,28 @@ func (p *procMon) flushAllHandles(ctx context.Context) {
 	p.cleanupLeakedHandles(ctx, &procInfo{handles: allPoolHandles})
 }
 
+func (p *procMon) flushJobHandles(ctx context.Context, request *procMonRequest) {
+	jobPoolHandles := make(poolHandleMap)
+
+	for pid, info := range p.procs {
+		if info.jobID != request.jobID {
+			continue
+		}
+
+		for pool, handles := range info.handles {
+			for handle := range handles {
+				jobPoolHandles.add(pool, handle)
+				request.numHandles++
+			}
+		}
+
+		info.cancelCtx()
+		delete(p.procs, pid)
+	}
+
+	p.cleanupLeakedHandles(ctx, &procInfo{handles: jobPoolHandles})
+}
+
 func (p *procMon) handleRequests(ctx context.Context) {
 	for {
 		select {
@@ -301,6 +374,10 @@ func (p *procMon) handleRequests(ctx context.Context) {
 				p.handleNotifyExit(ctx, request)
 			case flushAllHandles:
 				p.flushAllHandles(ctx)
+			case flushJobHandles:
+				p.flushJobHandles(ctx, request)
+			case countProcesses:
+				request.numProcs = len(p.procs)
 			default:
 				p.log.Debugf("Received request with invalid action type %s", request.action)
 			}
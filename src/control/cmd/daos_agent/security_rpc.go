@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2018-2022 Intel Corporation.
+// (C) Copyright 2018-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +9,7 @@ package main
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/lib/daos"
@@ -19,9 +20,11 @@ import (
 
 // SecurityModule is the security drpc module struct
 type SecurityModule struct {
-	log    logging.Logger
-	ext    auth.UserExt
-	config *security.TransportConfig
+	log     logging.Logger
+	ext     auth.UserExt
+	config  *security.TransportConfig
+	jobs    *jobTracker
+	metrics *agentMetrics
 }
 
 // NewSecurityModule creates a new module with the given initialized TransportConfig
@@ -40,6 +43,7 @@ func (m *SecurityModule) HandleCall(_ context.Context, session *drpc.Session, me
 		return nil, drpc.UnknownMethodFailure()
 	}
 
+	m.metrics.requestReceived(method.String())
 	return m.getCredential(session)
 }
 
@@ -57,6 +61,11 @@ func (m *SecurityModule) getCredential(session *drpc.Session) ([]byte, error) {
 		return m.credRespWithStatus(daos.MiscError)
 	}
 
+	if !m.jobs.CredentialsAllowed(info.Uid()) {
+		m.log.Errorf("Refusing credentials for uid %d: no scheduler jobs running", info.Uid())
+		return m.credRespWithStatus(daos.NoPermission)
+	}
+
 	signingKey, err := m.config.PrivateKey()
 	if err != nil {
 		m.log.Error(err.Error())
@@ -64,7 +73,9 @@ func (m *SecurityModule) getCredential(session *drpc.Session) ([]byte, error) {
 		return m.credRespWithStatus(daos.InvalidInput)
 	}
 
+	start := time.Now()
 	cred, err := auth.AuthSysRequestFromCreds(m.ext, info, signingKey)
+	m.metrics.credentialSigned(start)
 	if err != nil {
 		m.log.Errorf("Failed to get AuthSys struct: %s", err)
 		return m.credRespWithStatus(daos.MiscError)
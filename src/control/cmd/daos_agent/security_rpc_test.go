@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"net"
+	"os"
 	"testing"
 
 	"google.golang.org/protobuf/proto"
@@ -188,3 +189,23 @@ func TestAgentSecurityModule_RequestCreds_BadUid(t *testing.T) {
 
 	expectCredResp(t, respBytes, int32(daos.MiscError), false)
 }
+
+func TestAgentSecurityModule_RequestCreds_JobEnded(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	// Set up a real unix socket so we can make a real connection
+	conn, cleanup := setupTestUnixConn(t)
+	defer cleanup()
+
+	mod := NewSecurityModule(log, defaultTestTransportConfig())
+	mod.jobs = newJobTracker(log)
+	mod.jobs.revoked[uint32(os.Getuid())] = struct{}{}
+	respBytes, err := callRequestCreds(mod, t, log, conn)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %+v", err)
+	}
+
+	expectCredResp(t, respBytes, int32(daos.NoPermission), false)
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,11 +11,14 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/lib/atm"
@@ -65,10 +68,37 @@ func (cmd *startCmd) Execute(_ []string) error {
 		return err
 	}
 
+	activatedLis, err := systemd.Listener()
+	if err != nil {
+		return errors.Wrap(err, "unable to use systemd-activated socket")
+	}
+	if activatedLis != nil {
+		sockPath = activatedLis.Addr().String()
+		cmd.Debugf("Using systemd-activated socket %s", sockPath)
+		drpcServer.SetListener(activatedLis)
+	} else if cmd.cfg.IdleTimeout > 0 {
+		cmd.Notice("idle_timeout is set but the agent was not socket-activated; " +
+			"clients will be unable to connect after an idle shutdown")
+	}
+
+	if activatedLis == nil {
+		// Refuse to take over the socket of a running agent, but remove one
+		// left behind by an agent that exited uncleanly.
+		removed, err := common.RemoveStaleSocket(sockPath)
+		if err != nil {
+			return errors.Wrap(err, "unable to start agent")
+		}
+		if removed {
+			cmd.Debugf("removed stale socket %s", sockPath)
+		}
+	}
+
 	aicEnabled := !cmd.attachInfoCacheDisabled()
 	if !aicEnabled {
 		cmd.Debug("GetAttachInfo agent caching has been disabled")
 	}
+	attachInfoCache := newAttachInfoCache(cmd.Logger, aicEnabled).
+		WithExpiration(cmd.cfg.CacheExpiration)
 
 	ficEnabled := !cmd.fabricCacheDisabled()
 	if !ficEnabled {
@@ -84,26 +114,57 @@ func (cmd *startCmd) Execute(_ []string) error {
 	procmon := NewProcMon(cmd.Logger, cmd.ctlInvoker, cmd.cfg.SystemName)
 	procmon.startMonitoring(ctx)
 
+	apMon := newAccessPointMonitor(cmd.Logger, cmd.ctlInvoker, controlConfig(cmd.cfg))
+	go apMon.Run(ctx, accessPointRefreshInterval)
+
+	if aicEnabled {
+		sysEvtMon := newSystemEventMonitor(cmd.Logger, cmd.ctlInvoker)
+		go sysEvtMon.Run(ctx, systemEventRetryInterval, attachInfoCache.Invalidate)
+	}
+
 	fabricCache := newLocalFabricCache(cmd.Logger, ficEnabled).WithConfig(cmd.cfg)
 	if len(cmd.cfg.FabricInterfaces) > 0 {
 		// Cache is required to use user-defined fabric interfaces
 		fabricCache.enabled.SetTrue()
 		nf := NUMAFabricFromConfig(cmd.Logger, cmd.cfg.FabricInterfaces)
 		fabricCache.Cache(ctx, nf)
+	} else if ficEnabled {
+		linkMon, err := newLinkEventMonitor(cmd.Logger)
+		if err != nil {
+			cmd.Errorf("Unable to monitor network interface changes: %s", err)
+		} else {
+			go linkMon.Run(ctx, fabricCache.Invalidate)
+		}
+	}
+
+	var metrics *agentMetrics
+	if cmd.cfg.TelemetryPort > 0 {
+		metrics = newAgentMetrics()
+		reg := prometheus.NewRegistry()
+		if err := metrics.register(reg); err != nil {
+			return errors.Wrap(err, "unable to register agent metrics")
+		}
+		defer startPrometheusExporter(cmd.Logger, cmd.cfg.TelemetryPort, reg)()
 	}
 
-	drpcServer.RegisterRPCModule(NewSecurityModule(cmd.Logger, cmd.cfg.TransportConfig))
+	jobs := newJobTracker(cmd.Logger)
+	secMod := NewSecurityModule(cmd.Logger, cmd.cfg.TransportConfig)
+	secMod.jobs = jobs
+	secMod.metrics = metrics
+	drpcServer.RegisterRPCModule(secMod)
 	drpcServer.RegisterRPCModule(&mgmtModule{
 		log:            cmd.Logger,
 		sys:            cmd.cfg.SystemName,
 		ctlInvoker:     cmd.ctlInvoker,
-		attachInfo:     newAttachInfoCache(cmd.Logger, aicEnabled),
+		attachInfo:     attachInfoCache,
 		fabricInfo:     fabricCache,
 		numaGetter:     hwprov.DefaultProcessNUMAProvider(cmd.Logger),
 		fabricScanner:  hwprov.DefaultFabricScanner(cmd.Logger),
 		devClassGetter: hwprov.DefaultNetDevClassProvider(cmd.Logger),
 		devStateGetter: hwprov.DefaultNetDevStateProvider(cmd.Logger),
 		monitor:        procmon,
+		jobs:           jobs,
+		metrics:        metrics,
 	})
 
 	// Cache hwloc data in context on startup, since it'll be used extensively at runtime.
@@ -129,6 +190,21 @@ func (cmd *startCmd) Execute(_ []string) error {
 	// Setup signal handlers so we can block till we get SIGINT or SIGTERM
 	signals := make(chan os.Signal)
 	finish := make(chan struct{})
+	var shutdownRcvd time.Time
+	var finishOnce sync.Once
+	stop := func() {
+		finishOnce.Do(func() { close(finish) })
+	}
+
+	if cmd.cfg.IdleTimeout > 0 {
+		cmd.Debugf("Agent will shut down after %s without client activity", cmd.cfg.IdleTimeout)
+		idleMon := newIdleMonitor(cmd.Logger, cmd.cfg.IdleTimeout, drpcServer, procmon)
+		go idleMon.Run(ctx, func() {
+			shutdownRcvd = time.Now()
+			shuttingDown.SetTrue()
+			stop()
+		})
+	}
 
 	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGPIPE, syscall.SIGUSR1)
 	// Anonymous goroutine to wait on the signals channel and tell the
@@ -137,7 +213,6 @@ func (cmd *startCmd) Execute(_ []string) error {
 	// SIGPIPE is caught and logged to avoid killing the agent.
 	// The syntax looks odd but <- Channel means wait on any input on the
 	// channel.
-	var shutdownRcvd time.Time
 	go func() {
 		for sig := range signals {
 			switch sig {
@@ -153,7 +228,7 @@ func (cmd *startCmd) Execute(_ []string) error {
 				if !cmd.cfg.DisableAutoEvict {
 					procmon.FlushAllHandles(ctx)
 				}
-				close(finish)
+				stop()
 				return
 			}
 		}
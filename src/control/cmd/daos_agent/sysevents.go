@@ -0,0 +1,77 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// systemEventRetryInterval is the period to wait before subscribing to
+	// system events again after the event stream has ended.
+	systemEventRetryInterval = 30 * time.Second
+)
+
+// isMembershipEvent reports whether the event indicates a change to the
+// system membership that may be reflected in a GetAttachInfo response.
+func isMembershipEvent(evt *events.RASEvent) bool {
+	switch evt.ID {
+	case events.RASEngineJoined, events.RASEngineDied, events.RASSwimRankDead,
+		events.RASSystemReplicasUpdated:
+		return true
+	}
+	return false
+}
+
+// systemEventMonitor subscribes to the RAS events received by the MS leader
+// in order to notify the agent of changes to the system membership.
+type systemEventMonitor struct {
+	log     logging.Logger
+	invoker control.Invoker
+	stream  func(context.Context, control.Invoker, *control.SystemEventStreamReq, control.SystemEventHandler) error
+}
+
+func newSystemEventMonitor(log logging.Logger, invoker control.Invoker) *systemEventMonitor {
+	return &systemEventMonitor{
+		log:     log,
+		invoker: invoker,
+		stream:  control.SystemEventStream,
+	}
+}
+
+// Run streams system events until the context is canceled, invoking the
+// supplied callback for each membership change. The callback is also invoked
+// whenever the stream ends, as events may have been missed before it is
+// re-established.
+func (sem *systemEventMonitor) Run(ctx context.Context, interval time.Duration, onChange func()) {
+	for {
+		err := sem.stream(ctx, sem.invoker, new(control.SystemEventStreamReq),
+			func(evt *events.RASEvent) error {
+				if isMembershipEvent(evt) {
+					sem.log.Debugf("system membership changed (%s: rank %d)", evt.ID, evt.Rank)
+					onChange()
+				}
+				return nil
+			})
+		if ctx.Err() != nil {
+			return
+		}
+		sem.log.Debugf("system event stream ended: %v", err)
+		onChange()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,78 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_systemEventMonitor_Run(t *testing.T) {
+	for name, tc := range map[string]struct {
+		events     []*events.RASEvent
+		expChanges int
+	}{
+		"no events": {
+			expChanges: 1,
+		},
+		"membership events": {
+			events: []*events.RASEvent{
+				{ID: events.RASEngineJoined, Rank: 1},
+				{ID: events.RASEngineDied, Rank: 2},
+				{ID: events.RASSwimRankDead, Rank: 3},
+				{ID: events.RASSystemReplicasUpdated},
+			},
+			expChanges: 5,
+		},
+		"other events": {
+			events: []*events.RASEvent{
+				{ID: events.RASPoolRepsUpdate},
+				{ID: events.RASEngineUnresponsive, Rank: 1},
+			},
+			expChanges: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var streamCalls int
+			sem := newSystemEventMonitor(log, nil)
+			sem.stream = func(ctx context.Context, _ control.Invoker, _ *control.SystemEventStreamReq, handler control.SystemEventHandler) error {
+				streamCalls++
+				if streamCalls > 1 {
+					cancel()
+					return ctx.Err()
+				}
+
+				for _, evt := range tc.events {
+					if err := handler(evt); err != nil {
+						return err
+					}
+				}
+				return errors.New("stream interrupted")
+			}
+
+			var gotChanges int
+			sem.Run(ctx, time.Millisecond, func() { gotChanges++ })
+
+			test.AssertEqual(t, 2, streamCalls, "stream calls")
+			test.AssertEqual(t, tc.expChanges, gotChanges, "membership changes")
+		})
+	}
+}
@@ -0,0 +1,150 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const agentMetricsNamespace = "agent"
+
+// agentMetrics holds the metrics collected by the agent for export on its
+// telemetry endpoint. All methods may be called on a nil *agentMetrics, in
+// which case nothing is recorded.
+type agentMetrics struct {
+	requests          *prometheus.CounterVec
+	attachInfoLatency prometheus.Histogram
+	cacheHits         prometheus.Counter
+	cacheMisses       prometheus.Counter
+	msRPCLatency      *prometheus.HistogramVec
+	credSignLatency   prometheus.Histogram
+}
+
+func newAgentMetrics() *agentMetrics {
+	return &agentMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: agentMetricsNamespace,
+			Name:      "drpc_requests_total",
+			Help:      "Number of dRPC requests received from clients",
+		}, []string{"method"}),
+		attachInfoLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: agentMetricsNamespace,
+			Name:      "get_attach_info_latency_seconds",
+			Help:      "Time taken to handle a GetAttachInfo request",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 10),
+		}),
+		cacheHits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: agentMetricsNamespace,
+			Name:      "attach_info_cache_hits_total",
+			Help:      "Number of GetAttachInfo requests served from the cache",
+		}),
+		cacheMisses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: agentMetricsNamespace,
+			Name:      "attach_info_cache_misses_total",
+			Help:      "Number of GetAttachInfo requests forwarded to the MS",
+		}),
+		msRPCLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: agentMetricsNamespace,
+			Name:      "ms_rpc_latency_seconds",
+			Help:      "Time taken by RPCs sent to the MS",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 8),
+		}, []string{"method"}),
+		credSignLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: agentMetricsNamespace,
+			Name:      "credential_sign_seconds",
+			Help:      "Time taken to sign a client credential",
+			Buckets:   prometheus.ExponentialBuckets(0.00001, 4, 10),
+		}),
+	}
+}
+
+// register adds the agent's metrics to the registry.
+func (m *agentMetrics) register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{
+		m.requests, m.attachInfoLatency, m.cacheHits, m.cacheMisses,
+		m.msRPCLatency, m.credSignLatency,
+	} {
+		if err := reg.Register(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *agentMetrics) requestReceived(method string) {
+	if m == nil {
+		return
+	}
+	m.requests.WithLabelValues(method).Inc()
+}
+
+func (m *agentMetrics) attachInfoHandled(start time.Time) {
+	if m == nil {
+		return
+	}
+	m.attachInfoLatency.Observe(time.Since(start).Seconds())
+}
+
+func (m *agentMetrics) attachInfoCached(hit bool) {
+	if m == nil {
+		return
+	}
+	if hit {
+		m.cacheHits.Inc()
+		return
+	}
+	m.cacheMisses.Inc()
+}
+
+func (m *agentMetrics) msRPCDone(method string, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.msRPCLatency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func (m *agentMetrics) credentialSigned(start time.Time) {
+	if m == nil {
+		return
+	}
+	m.credSignLatency.Observe(time.Since(start).Seconds())
+}
+
+// startPrometheusExporter serves the metrics in the registry on the given port
+// and returns a function to shut the exporter down.
+func startPrometheusExporter(log logging.Logger, port int, reg *prometheus.Registry) func() {
+	listenAddress := fmt.Sprintf("0.0.0.0:%d", port)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	srv := http.Server{Addr: listenAddress, Handler: mux}
+
+	go func() {
+		log.Infof("Telemetry listening on %s", listenAddress)
+		err := srv.ListenAndServe()
+		log.Infof("Prometheus web exporter stopped: %s", err.Error())
+	}()
+
+	return func() {
+		log.Debug("Shutting down Prometheus web exporter")
+
+		timedCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(timedCtx); err != nil {
+			log.Noticef("HTTP server didn't shut down within timeout: %s", err.Error())
+		}
+	}
+}
@@ -0,0 +1,74 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestAgent_agentMetrics(t *testing.T) {
+	// Recording on nil metrics should be a no-op.
+	var nilMetrics *agentMetrics
+	nilMetrics.requestReceived("test")
+	nilMetrics.attachInfoCached(true)
+	nilMetrics.msRPCDone("test", time.Now())
+
+	m := newAgentMetrics()
+	reg := prometheus.NewRegistry()
+	if err := m.register(reg); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	m.requestReceived("GetAttachInfo")
+	m.requestReceived("GetAttachInfo")
+	m.requestReceived("request agent credentials")
+	m.attachInfoCached(false)
+	m.attachInfoCached(true)
+	m.attachInfoCached(true)
+	m.attachInfoHandled(start)
+	m.msRPCDone("GetAttachInfo", start)
+	m.credentialSigned(start)
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotVals := make(map[string]float64)
+	for _, mf := range families {
+		for _, pm := range mf.GetMetric() {
+			name := mf.GetName()
+			for _, lp := range pm.GetLabel() {
+				name += "/" + lp.GetValue()
+			}
+			switch {
+			case pm.Counter != nil:
+				gotVals[name] = pm.Counter.GetValue()
+			case pm.Histogram != nil:
+				gotVals[name] = float64(pm.Histogram.GetSampleCount())
+			}
+		}
+	}
+
+	expVals := map[string]float64{
+		"agent_drpc_requests_total/GetAttachInfo":             2,
+		"agent_drpc_requests_total/request agent credentials": 1,
+		"agent_attach_info_cache_hits_total":                  2,
+		"agent_attach_info_cache_misses_total":                1,
+		"agent_get_attach_info_latency_seconds":               1,
+		"agent_ms_rpc_latency_seconds/GetAttachInfo":          1,
+		"agent_credential_sign_seconds":                       1,
+	}
+	if diff := cmp.Diff(expVals, gotVals); diff != "" {
+		t.Fatalf("unexpected metrics (-want, +got):\n%s\n", diff)
+	}
+}
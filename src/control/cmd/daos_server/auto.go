@@ -26,7 +26,8 @@ import (
 
 // configCmd is the struct representing the top-level config subcommand.
 type configCmd struct {
-	Generate configGenCmd `command:"generate" alias:"gen" description:"Generate DAOS server configuration file based on discoverable locally-attached hardware devices"`
+	Generate configGenCmd     `command:"generate" alias:"gen" description:"Generate DAOS server configuration file based on discoverable locally-attached hardware devices"`
+	Convert  configConvertCmd `command:"convert" description:"Convert legacy parameters in DAOS server configuration file to the current format"`
 }
 
 type configGenCmd struct {
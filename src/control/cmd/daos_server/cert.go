@@ -0,0 +1,345 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const (
+	// defaultCertOwner is the owner of the installed key and certificate
+	// when run as root, matching utils/certs/gen_certificates.sh.
+	defaultCertOwner = "daos_server"
+	// certEnrollTimeout limits the time taken to obtain a signed certificate.
+	certEnrollTimeout = time.Minute
+	// maxCertRespSize limits the size of the response from a CA endpoint.
+	maxCertRespSize = 1 << 20
+
+	certKeyPerm = 0400
+	certPerm    = 0644
+)
+
+type certCmdRoot struct {
+	Request certRequestCmd `command:"request" description:"Generate a private key and certificate signing request for this host"`
+	Enroll  certEnrollCmd  `command:"enroll" description:"Obtain a signed certificate for this host and install it"`
+}
+
+// certCmd contains the options common to the cert subcommands. The locations
+// of the private key, certificate and CA certificate are taken from the
+// transport_config section of the server config file.
+type certCmd struct {
+	optCfgCmd
+	cmdutil.LogCmd
+
+	Hosts string `long:"hosts" description:"Comma-separated host names and addresses to include in the certificate (default: the local host name)"`
+	Owner string `long:"owner" description:"user[:group] to own the installed files (default: daos_server when run as root)"`
+}
+
+func (cmd *certCmd) transportConfig() *security.TransportConfig {
+	if cmd.config == nil || cmd.config.TransportConfig == nil {
+		return security.DefaultServerTransportConfig()
+	}
+	return cmd.config.TransportConfig
+}
+
+func (cmd *certCmd) certHosts() ([]string, error) {
+	if cmd.Hosts != "" {
+		return common.TokenizeCommaSeparatedString(cmd.Hosts), nil
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to determine host name")
+	}
+	return []string{hostname}, nil
+}
+
+// fileOwner returns the uid and gid that should own the installed files, or
+// -1 for each if ownership should not be changed.
+func (cmd *certCmd) fileOwner() (int, int, error) {
+	owner := cmd.Owner
+	if owner == "" {
+		if os.Geteuid() != 0 {
+			return -1, -1, nil
+		}
+		owner = defaultCertOwner
+	}
+
+	userName, groupName := owner, ""
+	if i := strings.Index(owner, ":"); i >= 0 {
+		userName, groupName = owner[:i], owner[i+1:]
+	}
+
+	usr, err := user.Lookup(userName)
+	if err != nil {
+		return -1, -1, errors.Wrapf(err, "unable to look up owner (use --owner to set it)")
+	}
+	gidStr := usr.Gid
+	if groupName != "" {
+		grp, err := user.LookupGroup(groupName)
+		if err != nil {
+			return -1, -1, errors.Wrapf(err, "unable to look up owner group")
+		}
+		gidStr = grp.Gid
+	}
+
+	uid, err := strconv.Atoi(usr.Uid)
+	if err != nil {
+		return -1, -1, errors.Wrapf(err, "invalid uid %q", usr.Uid)
+	}
+	gid, err := strconv.Atoi(gidStr)
+	if err != nil {
+		return -1, -1, errors.Wrapf(err, "invalid gid %q", gidStr)
+	}
+
+	return uid, gid, nil
+}
+
+// installFile replaces the file at path with the data, setting its
+// permissions and ownership before it is moved into place.
+func installFile(path string, data []byte, perm os.FileMode, uid, gid int) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrapf(err, "unable to create %s", dir)
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(path))
+	if err != nil {
+		return errors.Wrapf(err, "unable to create temporary file in %s", dir)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "unable to write %s", tmp.Name())
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "unable to write %s", tmp.Name())
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return errors.Wrapf(err, "unable to set permissions of %s", path)
+	}
+	if uid >= 0 || gid >= 0 {
+		if err := os.Chown(tmp.Name(), uid, gid); err != nil {
+			return errors.Wrapf(err, "unable to set ownership of %s", path)
+		}
+	}
+
+	return errors.Wrapf(os.Rename(tmp.Name(), path), "unable to install %s", path)
+}
+
+// certRequestCmd generates a private key and certificate signing request,
+// for the request to be signed by a CA out of band. The signed certificate
+// may then be installed with "cert enroll --signed-cert".
+type certRequestCmd struct {
+	certCmd
+
+	CSRPath string `long:"csr" description:"Path to write the certificate signing request to (default: the key path with a .csr extension)"`
+	Force   bool   `short:"f" long:"force" description:"Replace an existing private key"`
+}
+
+func (cmd *certRequestCmd) Execute(_ []string) error {
+	tc := cmd.transportConfig()
+
+	if _, err := os.Stat(tc.PrivateKeyPath); err == nil && !cmd.Force {
+		return errors.Errorf("private key %s already exists (use --force to replace it)", tc.PrivateKeyPath)
+	}
+
+	hosts, err := cmd.certHosts()
+	if err != nil {
+		return err
+	}
+	uid, gid, err := cmd.fileOwner()
+	if err != nil {
+		return err
+	}
+
+	keyPEM, err := security.GeneratePrivateKey()
+	if err != nil {
+		return err
+	}
+	csrPEM, err := security.NewCertificateRequest(keyPEM, security.ComponentServer, hosts...)
+	if err != nil {
+		return err
+	}
+
+	csrPath := cmd.CSRPath
+	if csrPath == "" {
+		csrPath = strings.TrimSuffix(tc.PrivateKeyPath, filepath.Ext(tc.PrivateKeyPath)) + ".csr"
+	}
+	if err := installFile(tc.PrivateKeyPath, keyPEM, certKeyPerm, uid, gid); err != nil {
+		return err
+	}
+	if err := installFile(csrPath, csrPEM, certPerm, uid, gid); err != nil {
+		return err
+	}
+
+	cmd.Infof("Private key written to %s", tc.PrivateKeyPath)
+	cmd.Infof("Certificate signing request written to %s", csrPath)
+	cmd.Infof("Once the request has been signed, install the certificate with: daos_server cert enroll --signed-cert <file>")
+
+	return nil
+}
+
+// certEnrollCmd obtains a signed certificate for the host, either from a CA
+// endpoint or the management service, or from a file if the request was
+// signed out of band. The certificate is checked against the private key and
+// the CA certificate before it is installed.
+type certEnrollCmd struct {
+	certCmd
+
+	CAURL      string `long:"ca-url" description:"URL of a CA endpoint to submit the certificate signing request to (default: the management service)"`
+	SignedCert string `long:"signed-cert" description:"Install a certificate that was signed out of band for the request generated by \"cert request\""`
+	NewKey     bool   `long:"new-key" description:"Generate a new private key even if one exists"`
+
+	ctlInvoker control.Invoker
+}
+
+// submitCertRequest posts the PEM-encoded certificate request to the CA
+// endpoint and returns the PEM-encoded certificate in the response.
+func submitCertRequest(ctx context.Context, url string, csrPEM []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(csrPEM))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid CA URL %q", url)
+	}
+	req.Header.Set("Content-Type", "application/pkcs10")
+	req.Header.Set("Accept", "application/x-pem-file")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to submit certificate request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxCertRespSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read CA response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("CA returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return body, nil
+}
+
+func (cmd *certEnrollCmd) signWithMS(ctx context.Context, csrPEM []byte) ([]byte, error) {
+	if cmd.ctlInvoker == nil {
+		// The host does not yet have a certificate with which to
+		// authenticate, so the MS can only be reached insecurely.
+		ctlCfg := control.DefaultConfig()
+		ctlCfg.TransportConfig = &security.TransportConfig{AllowInsecure: true}
+		if cmd.config != nil {
+			ctlCfg.SystemName = cmd.config.SystemName
+			ctlCfg.ControlPort = cmd.config.ControlPort
+			ctlCfg.HostList = cmd.config.AccessPoints
+		}
+		cmd.ctlInvoker = control.NewClient(control.WithConfig(ctlCfg),
+			control.WithClientLogger(cmd.Logger))
+	}
+
+	resp, err := control.SystemSignCert(ctx, cmd.ctlInvoker, &control.SystemSignCertReq{CSR: csrPEM})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Cert, nil
+}
+
+func (cmd *certEnrollCmd) getCertificate(ctx context.Context, keyPEM []byte) ([]byte, error) {
+	if cmd.SignedCert != "" {
+		return ioutil.ReadFile(cmd.SignedCert)
+	}
+
+	hosts, err := cmd.certHosts()
+	if err != nil {
+		return nil, err
+	}
+	csrPEM, err := security.NewCertificateRequest(keyPEM, security.ComponentServer, hosts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.CAURL != "" {
+		cmd.Debugf("submitting certificate request to %s", cmd.CAURL)
+		return submitCertRequest(ctx, cmd.CAURL, csrPEM)
+	}
+	cmd.Debug("submitting certificate request to the management service")
+	return cmd.signWithMS(ctx, csrPEM)
+}
+
+func (cmd *certEnrollCmd) Execute(_ []string) error {
+	if cmd.SignedCert != "" && (cmd.CAURL != "" || cmd.NewKey) {
+		return errors.New("--signed-cert may not be combined with --ca-url or --new-key")
+	}
+
+	tc := cmd.transportConfig()
+	caPEM, err := security.LoadPEMData(tc.CARootPath, security.MaxCertPerm)
+	if err != nil {
+		return errors.Wrap(err, "unable to load CA certificate (it must be installed before enrolling)")
+	}
+	uid, gid, err := cmd.fileOwner()
+	if err != nil {
+		return err
+	}
+
+	var keyPEM []byte
+	newKey := cmd.NewKey
+	if !newKey {
+		keyPEM, err = security.LoadPEMData(tc.PrivateKeyPath, security.MaxKeyPerm)
+		switch {
+		case os.IsNotExist(err) && cmd.SignedCert == "":
+			newKey = true
+		case err != nil:
+			return errors.Wrap(err, "unable to load private key")
+		}
+	}
+	if newKey {
+		if keyPEM, err = security.GeneratePrivateKey(); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), certEnrollTimeout)
+	defer cancel()
+
+	certPEM, err := cmd.getCertificate(ctx, keyPEM)
+	if err != nil {
+		return errors.Wrap(err, "unable to obtain signed certificate")
+	}
+	if err := security.VerifyCertificate(certPEM, keyPEM, caPEM, security.ComponentServer); err != nil {
+		return err
+	}
+
+	if newKey {
+		if err := installFile(tc.PrivateKeyPath, keyPEM, certKeyPerm, uid, gid); err != nil {
+			return err
+		}
+		cmd.Infof("Private key installed at %s", tc.PrivateKeyPath)
+	}
+	if err := installFile(tc.CertificatePath, certPEM, certPerm, uid, gid); err != nil {
+		return err
+	}
+	cmd.Infof("Certificate installed at %s", tc.CertificatePath)
+
+	return nil
+}
@@ -0,0 +1,357 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/user"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func writeTestFile(t *testing.T, path string, data []byte, perm os.FileMode) {
+	t.Helper()
+
+	if err := ioutil.WriteFile(path, data, perm); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func signTestCSR(t *testing.T, caCert, caKey, key []byte) []byte {
+	t.Helper()
+
+	signer, err := security.NewCertSigner(caCert, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := security.NewCertificateRequest(key, security.ComponentServer, "host1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := signer.Sign(csr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// currentUser is used as the owner of installed files, as tests may be run as
+// root on hosts without a daos_server user.
+func currentUser(t *testing.T) string {
+	t.Helper()
+
+	usr, err := user.Current()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return usr.Username
+}
+
+func certTestConfig(dir string) *config.Server {
+	return config.DefaultServer().WithTransportConfig(&security.TransportConfig{
+		CertificateConfig: security.CertificateConfig{
+			CARootPath:      filepath.Join(dir, "daosCA.crt"),
+			CertificatePath: filepath.Join(dir, "server.crt"),
+			PrivateKeyPath:  filepath.Join(dir, "server.key"),
+		},
+	})
+}
+
+func TestDaosServer_installFile(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	path := filepath.Join(tmpDir, "certs", "server.key")
+	for _, data := range []string{"old", "new"} {
+		if err := installFile(path, []byte(data), certKeyPerm, -1, -1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "new", string(got), "unexpected file contents")
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, os.FileMode(certKeyPerm), fi.Mode().Perm(), "unexpected file permissions")
+
+	entries, err := ioutil.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(entries), "temporary file not removed")
+}
+
+func TestDaosServer_submitCertRequest(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status  int
+		body    string
+		expCert string
+		expErr  error
+	}{
+		"success": {
+			status:  http.StatusOK,
+			body:    "signed",
+			expCert: "signed",
+		},
+		"rejected": {
+			status: http.StatusForbidden,
+			body:   "host not allowed\n",
+			expErr: errors.New("403 Forbidden: host not allowed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotCSR, gotContentType string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				gotCSR = string(body)
+				gotContentType = r.Header.Get("Content-Type")
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			gotCert, gotErr := submitCertRequest(context.Background(), srv.URL, []byte("csr"))
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, "csr", gotCSR, "unexpected request body")
+			test.AssertEqual(t, "application/pkcs10", gotContentType, "unexpected content type")
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expCert, string(gotCert), "unexpected certificate")
+		})
+	}
+}
+
+func TestDaosServer_certRequestCmd(t *testing.T) {
+	for name, tc := range map[string]struct {
+		keyExists bool
+		force     bool
+		expErr    error
+	}{
+		"new key": {},
+		"existing key": {
+			keyExists: true,
+			expErr:    errors.New("already exists"),
+		},
+		"replace existing key": {
+			keyExists: true,
+			force:     true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			cfg := certTestConfig(tmpDir)
+			if tc.keyExists {
+				writeTestFile(t, cfg.TransportConfig.PrivateKeyPath, []byte("old"), 0400)
+			}
+
+			cmd := &certRequestCmd{
+				certCmd: certCmd{
+					LogCmd: cmdutil.LogCmd{Logger: log},
+					Owner:  currentUser(t),
+					Hosts:  "host1,10.0.0.1",
+				},
+				Force: tc.force,
+			}
+			cmd.config = cfg
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			keyPEM, err := security.LoadPEMData(cfg.TransportConfig.PrivateKeyPath, security.MaxKeyPerm)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrPEM, err := ioutil.ReadFile(filepath.Join(tmpDir, "server.csr"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Check that the request is for the key by signing it.
+			caCert, caKey := security.MockCA(t)
+			signer, err := security.NewCertSigner(caCert, caKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			certPEM, err := signer.Sign(csrPEM)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := security.VerifyCertificate(certPEM, keyPEM, caCert, security.ComponentServer); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestDaosServer_certEnrollCmd(t *testing.T) {
+	caCert, caKey := security.MockCA(t)
+	otherCACert, otherCAKey := security.MockCA(t)
+	key := security.MockPrivateKey(t)
+	cert := signTestCSR(t, caCert, caKey, key)
+
+	for name, tc := range map[string]struct {
+		noCACert   bool
+		noKey      bool
+		caURL      bool
+		signedCert []byte
+		newKey     bool
+		mic        *control.MockInvokerConfig
+		expNewKey  bool
+		expErr     error
+	}{
+		"no CA certificate": {
+			noCACert: true,
+			expErr:   errors.New("unable to load CA certificate"),
+		},
+		"signed cert with new key": {
+			signedCert: cert,
+			newKey:     true,
+			expErr:     errors.New("may not be combined"),
+		},
+		"signed cert without key": {
+			noKey:      true,
+			signedCert: cert,
+			expErr:     errors.New("unable to load private key"),
+		},
+		"signed cert": {
+			signedCert: cert,
+		},
+		"untrusted signed cert": {
+			signedCert: signTestCSR(t, otherCACert, otherCAKey, key),
+			expErr:     errors.New("not trusted by CA bundle"),
+		},
+		"MS failure": {
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1",
+					security.FaultCertEnrollmentDisabled, nil),
+			},
+			expErr: security.FaultCertEnrollmentDisabled,
+		},
+		"MS signed with existing key": {
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("", nil,
+					&mgmtpb.SystemSignCertResp{Cert: cert}),
+			},
+		},
+		"MS signed cert for different key": {
+			newKey: true,
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("", nil,
+					&mgmtpb.SystemSignCertResp{Cert: cert}),
+			},
+			expErr: errors.New("does not match private key"),
+		},
+		"CA endpoint": {
+			noKey:     true,
+			caURL:     true,
+			expNewKey: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			cfg := certTestConfig(tmpDir)
+			transport := cfg.TransportConfig
+			if !tc.noCACert {
+				writeTestFile(t, transport.CARootPath, caCert, 0644)
+			}
+			if !tc.noKey {
+				writeTestFile(t, transport.PrivateKeyPath, key, 0400)
+			}
+
+			cmd := &certEnrollCmd{
+				certCmd: certCmd{
+					LogCmd: cmdutil.LogCmd{Logger: log},
+					Owner:  currentUser(t),
+					Hosts:  "host1",
+				},
+				NewKey:     tc.newKey,
+				ctlInvoker: control.NewMockInvoker(log, tc.mic),
+			}
+			cmd.config = cfg
+
+			if tc.signedCert != nil {
+				cmd.SignedCert = filepath.Join(tmpDir, "signed.crt")
+				writeTestFile(t, cmd.SignedCert, tc.signedCert, 0644)
+			}
+			if tc.caURL {
+				// Act as a CA endpoint that signs any request.
+				signer, err := security.NewCertSigner(caCert, caKey)
+				if err != nil {
+					t.Fatal(err)
+				}
+				srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					csr, _ := ioutil.ReadAll(r.Body)
+					cert, err := signer.Sign(csr)
+					if err != nil {
+						http.Error(w, err.Error(), http.StatusBadRequest)
+						return
+					}
+					w.Write(cert)
+				}))
+				defer srv.Close()
+				cmd.CAURL = srv.URL
+			}
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				if _, err := os.Stat(transport.CertificatePath); !os.IsNotExist(err) {
+					t.Fatal("certificate installed after failure")
+				}
+				return
+			}
+
+			gotKey, err := security.LoadPEMData(transport.PrivateKeyPath, security.MaxKeyPerm)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotCert, err := security.LoadPEMData(transport.CertificatePath, security.MaxCertPerm)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expNewKey, string(gotKey) != string(key), "unexpected key replacement")
+			if err := security.VerifyCertificate(gotCert, gotKey, caCert, security.ComponentServer); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
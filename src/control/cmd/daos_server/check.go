@@ -0,0 +1,83 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/server"
+)
+
+// checkCmd runs the checks performed before the engines are started and
+// reports any problems with the host configuration.
+type checkCmd struct {
+	cfgCmd
+	cmdutil.LogCmd
+}
+
+func printPreflightResults(out io.Writer, results server.PreflightResults) error {
+	checkTitle := "Check"
+	statusTitle := "Status"
+	detailTitle := "Detail"
+
+	tf := txtfmt.NewTableFormatter(checkTitle, statusTitle, detailTitle)
+	var table []txtfmt.TableRow
+	for _, pr := range results {
+		table = append(table, txtfmt.TableRow{
+			checkTitle:  pr.Check,
+			statusTitle: string(pr.Status),
+			detailTitle: pr.Detail,
+		})
+	}
+
+	ew := txtfmt.NewErrWriter(out)
+	tf.InitWriter(ew)
+	tf.Format(table)
+
+	var printedHdr bool
+	for _, pr := range results {
+		if pr.Remediation == "" {
+			continue
+		}
+		if !printedHdr {
+			fmt.Fprintln(ew, "\nRemediation:")
+			printedHdr = true
+		}
+		fmt.Fprintf(ew, "  %s: %s\n", pr.Check, pr.Remediation)
+	}
+
+	return ew.Err
+}
+
+func (cmd *checkCmd) Execute(_ []string) error {
+	mi, err := common.GetMemInfo()
+	if err != nil {
+		return errors.Wrap(err, "retrieve hugepage info")
+	}
+
+	if err := cmd.config.Validate(cmd.Logger, mi.HugePageSizeKb); err != nil {
+		return errors.Wrapf(err, "%s: validation failed", cmd.config.Path)
+	}
+
+	results, err := server.RunPreflightChecks(cmd.Logger, cmd.config)
+	if err != nil {
+		return err
+	}
+
+	if err := printPreflightResults(os.Stdout, results); err != nil {
+		return err
+	}
+
+	return results.Err()
+}
@@ -0,0 +1,67 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/server"
+)
+
+func TestDaosServer_printPreflightResults(t *testing.T) {
+	for name, tc := range map[string]struct {
+		results     server.PreflightResults
+		expPrintStr string
+	}{
+		"no remediation": {
+			results: server.PreflightResults{
+				{Check: "iommu", Status: server.PreflightPass, Detail: "IOMMU enabled"},
+				{Check: "nofile", Status: server.PreflightSkip, Detail: "no engines in config"},
+			},
+			expPrintStr: `
+Check  Status Detail               
+-----  ------ ------               
+iommu  PASS   IOMMU enabled        
+nofile SKIP   no engines in config 
+`,
+		},
+		"with remediation": {
+			results: server.PreflightResults{
+				{Check: "iommu", Status: server.PreflightPass, Detail: "IOMMU enabled"},
+				{
+					Check:       "max_map_count",
+					Status:      server.PreflightWarn,
+					Detail:      "vm.max_map_count is 65530, 1000000 recommended",
+					Remediation: "run sysctl -w vm.max_map_count=1000000",
+				},
+			},
+			expPrintStr: `
+Check         Status Detail                                         
+-----         ------ ------                                         
+iommu         PASS   IOMMU enabled                                  
+max_map_count WARN   vm.max_map_count is 65530, 1000000 recommended 
+
+Remediation:
+  max_map_count: run sysctl -w vm.max_map_count=1000000
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := printPreflightResults(&bld, tc.results); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
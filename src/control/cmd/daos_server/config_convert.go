@@ -0,0 +1,102 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+const convertBackupSuffix = ".bak"
+
+// configConvertCmd rewrites a server config file that contains legacy parameters so that it
+// conforms to the current config file format.
+type configConvertCmd struct {
+	cmdutil.LogCmd `json:"-"`
+	config         *config.Server
+
+	Output string `long:"output" description:"Write the converted config to this file rather than rewriting the input file in place"`
+}
+
+func (cmd *configConvertCmd) configPath() string {
+	if cmd.config == nil {
+		return ""
+	}
+	return cmd.config.Path
+}
+
+func (cmd *configConvertCmd) loadConfig(cfgPath string) error {
+	cmd.config = config.DefaultServer()
+	if err := cmd.config.SetPath(cfgPath); err != nil {
+		return err
+	}
+
+	return cmd.config.Load()
+}
+
+// backupConfig copies the original config file so that in-place conversion can be reverted.
+func backupConfig(cfgPath string) (string, error) {
+	fi, err := os.Stat(cfgPath)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := ioutil.ReadFile(cfgPath)
+	if err != nil {
+		return "", err
+	}
+
+	backupPath := cfgPath + convertBackupSuffix
+	if err := ioutil.WriteFile(backupPath, data, fi.Mode().Perm()); err != nil {
+		return "", err
+	}
+
+	return backupPath, nil
+}
+
+// Execute is run when configConvertCmd activates.
+//
+// Translate legacy parameters in the loaded config to their current equivalents and write the
+// result. When rewriting in place, the original file is preserved with a .bak suffix.
+func (cmd *configConvertCmd) Execute(_ []string) error {
+	if cmd.config == nil {
+		return errors.New("no config loaded")
+	}
+
+	converted, err := cmd.config.ConvertLegacyParams(cmd.Logger)
+	if err != nil {
+		return err
+	}
+
+	outPath := cmd.Output
+	if outPath == "" {
+		if !converted {
+			cmd.Infof("No legacy parameters found in %s, nothing to convert",
+				cmd.config.Path)
+			return nil
+		}
+
+		backupPath, err := backupConfig(cmd.config.Path)
+		if err != nil {
+			return errors.Wrap(err, "backing up original config")
+		}
+		cmd.Infof("Original config saved to %s", backupPath)
+		outPath = cmd.config.Path
+	}
+
+	if err := cmd.config.SaveToFile(outPath); err != nil {
+		return errors.Wrapf(err, "writing converted config to %s", outPath)
+	}
+	cmd.Infof("Converted config written to %s", outPath)
+
+	return nil
+}
@@ -0,0 +1,172 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const legacyConvertCfg = `name: daos_server
+port: 10001
+provider: ofi+tcp
+access_points: ["localhost"]
+enable_vmd: false
+servers:
+- targets: 4
+  fabric_iface: eth0
+  fabric_iface_port: 31416
+  scm_class: ram
+  scm_mount: /mnt/daos
+  scm_size: 16
+  bdev_class: nvme
+  bdev_list: ["0000:81:00.0"]
+`
+
+const currentConvertCfg = `name: daos_server
+port: 10001
+provider: ofi+tcp
+access_points: ["localhost"]
+engines: []
+`
+
+func TestDaosServer_ConfigConvert(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input        string
+		output       bool
+		expErr       error
+		expConverted bool
+		expBackup    bool
+	}{
+		"nothing to convert": {
+			input: currentConvertCfg,
+		},
+		"convert in place": {
+			input:        legacyConvertCfg,
+			expConverted: true,
+			expBackup:    true,
+		},
+		"convert to output file": {
+			input:        legacyConvertCfg,
+			output:       true,
+			expConverted: true,
+		},
+		"servers and engines both set": {
+			input:  legacyConvertCfg + "engines:\n- targets: 4\n",
+			expErr: config.FaultConfigEnginesSettingDuplicate,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			cfgPath := filepath.Join(testDir, "daos_server.yml")
+			if err := ioutil.WriteFile(cfgPath, []byte(tc.input), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cmd := &configConvertCmd{}
+			cmd.SetLog(log)
+			if tc.output {
+				cmd.Output = filepath.Join(testDir, "converted.yml")
+			}
+
+			gotErr := cmd.loadConfig(cfgPath)
+			if gotErr == nil {
+				gotErr = cmd.Execute(nil)
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			outPath := cfgPath
+			if tc.output {
+				outPath = cmd.Output
+			}
+			outData, err := ioutil.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tc.expConverted {
+				test.AssertEqual(t, tc.input, string(outData), "unexpected config rewrite")
+				return
+			}
+
+			for _, legacyKey := range []string{"servers:", "enable_vmd:", "scm_class:", "bdev_class:"} {
+				if strings.Contains(string(outData), legacyKey) {
+					t.Fatalf("legacy key %q found in converted config:\n%s", legacyKey, outData)
+				}
+			}
+
+			backupData, err := ioutil.ReadFile(cfgPath + convertBackupSuffix)
+			if tc.expBackup {
+				if err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, tc.input, string(backupData), "unexpected backup contents")
+			} else if err == nil {
+				t.Fatal("unexpected backup file written")
+			}
+
+			// The converted file should load and validate without any legacy conversion.
+			cfg := config.DefaultServer()
+			if err := cfg.SetPath(outPath); err != nil {
+				t.Fatal(err)
+			}
+			if err := cfg.Load(); err != nil {
+				t.Fatal(err)
+			}
+			if len(cfg.Legacy.Deprecations) != 0 {
+				t.Fatalf("unexpected deprecations after conversion: %v", cfg.Legacy.Deprecations)
+			}
+			if len(cfg.Engines) != 1 {
+				t.Fatalf("want 1 engine, got %d", len(cfg.Engines))
+			}
+			test.AssertEqual(t, true, *cfg.DisableVMD, "unexpected disable_vmd value")
+			tiers := cfg.Engines[0].Storage.Tiers
+			test.AssertEqual(t, 2, len(tiers), "unexpected number of storage tiers")
+			test.AssertEqual(t, storage.ClassRam, tiers[0].Class, "unexpected scm class")
+			test.AssertEqual(t, storage.ClassNvme, tiers[1].Class, "unexpected bdev class")
+		})
+	}
+}
+
+func TestDaosServer_ConfigConvert_Commands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Convert in place",
+			"config convert",
+			printCommand(t, &configConvertCmd{}),
+			nil,
+		},
+		{
+			"Convert to output file",
+			"config convert --output foo.yml",
+			printCommand(t, &configConvertCmd{Output: "foo.yml"}),
+			nil,
+		},
+		{
+			"Convert with unknown option",
+			"config convert --in-place",
+			"",
+			errors.New("unknown flag `in-place'"),
+		},
+	})
+}
@@ -8,6 +8,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path"
@@ -93,14 +94,28 @@ type mainOpts struct {
 	MgmtSvc       msCmdRoot              `command:"ms" description:"Perform tasks related to management service replicas"`
 	DumpTopo      hwprov.DumpTopologyCmd `command:"dump-topology" description:"Dump system topology"`
 	Config        configCmd              `command:"config" alias:"cfg" description:"Perform tasks related to configuration of hardware on the local server"`
+	Wizard        wizardCmd              `command:"wizard" description:"Interactively set up a single-node DAOS system for evaluation"`
+	Check         checkCmd               `command:"check" description:"Check that the host is configured to start the engines in the config"`
+	Cert          certCmdRoot            `command:"cert" description:"Perform tasks related to the certificates of this host"`
 
 	// Allow a set of tests to be run before executing commands.
 	preExecTests []execTestFn
 }
 
-type versionCmd struct{}
+type versionCmd struct {
+	JSON bool `short:"j" long:"json" description:"Print version and feature information in JSON format"`
+}
 
 func (cmd *versionCmd) Execute(_ []string) error {
+	if cmd.JSON {
+		data, err := json.MarshalIndent(build.NewVersionInfo(build.ControlPlaneName, build.ComponentServer), "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Println(string(data))
+		return err
+	}
+
 	fmt.Printf("%s v%s\n", build.ControlPlaneName, build.DaosVersion)
 	return nil
 }
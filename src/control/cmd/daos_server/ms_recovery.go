@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2022-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,8 +7,10 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -26,6 +28,7 @@ type msCmdRoot struct {
 	Status  msStatusCmd   `command:"status" description:"Show status of the local management service replica"`
 	Recover msRecoveryCmd `command:"recover" description:"Recover the management service using this replica"`
 	Restore msRestoreCmd  `command:"restore" description:"Restore the management service from a snapshot"`
+	Dump    msDumpCmd     `command:"dump" description:"Dump the raft state, latest snapshot and log entries of the local management service replica as JSON"`
 }
 
 type dbCfgCmd struct {
@@ -60,6 +63,20 @@ func printSnapshotDetails(out io.Writer, sInfo *sdb.SnapshotDetails) error {
 	return ew.Err
 }
 
+func printRaftState(out io.Writer, state *sdb.RaftState) error {
+	ew := txtfmt.NewErrWriter(out)
+
+	fmt.Fprintf(ew, "Current term: %d\n", state.CurrentTerm)
+	if state.LastVoteCand != "" {
+		fmt.Fprintf(ew, "Last vote: %s (term %d)\n", state.LastVoteCand, state.LastVoteTerm)
+	}
+	if state.LastLogIndex > 0 {
+		fmt.Fprintf(ew, "Log indexes: %d-%d\n", state.FirstLogIndex, state.LastLogIndex)
+	}
+
+	return ew.Err
+}
+
 func printLogEntryDetails(out io.Writer, entry *sdb.LogEntryDetails) error {
 	ew := txtfmt.NewErrWriter(out)
 
@@ -119,6 +136,13 @@ func (cmd *msStatusCmd) Execute([]string) error {
 		fmt.Fprintf(&buf, "    %s: %s\n", srv.Address, status)
 	}
 
+	state, err := sdb.GetRaftState(dbCfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to get raft state")
+	}
+	fmt.Fprintln(&buf, "  Raft state:")
+	printRaftState(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(4)), state)
+
 	entry, err := sdb.GetLastLogEntry(cmd.Logger, dbCfg)
 	if err == nil {
 		fmt.Fprintln(&buf, "  Latest committed log entry:")
@@ -280,3 +304,44 @@ they join the quorum.
 
 	return nil
 }
+
+type msDumpCmd struct {
+	dbCfgCmd
+
+	Entries uint64 `short:"n" long:"entries" description:"Maximum number of the most recent log entries to dump (default: all)"`
+	Output  string `short:"O" long:"output" description:"Write the dump to a file instead of stdout"`
+}
+
+func (cmd *msDumpCmd) Execute([]string) error {
+	if err := common.CheckDupeProcess(); err != nil {
+		return err
+	}
+
+	dbCfg, err := cmd.getDatabaseConfig()
+	if err != nil {
+		return err
+	}
+
+	dump, err := sdb.DumpLocalReplica(cmd.Logger, dbCfg, cmd.Entries)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode dump")
+	}
+	data = append(data, '\n')
+
+	if cmd.Output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(cmd.Output, data, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write dump to %q", cmd.Output)
+	}
+	cmd.Infof("Dumped %s replica state to %s", build.ManagementServiceName, cmd.Output)
+
+	return nil
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2022-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -25,7 +25,7 @@ import (
 
 type legacyStorageCmd struct {
 	Prepare legacyPrepCmd `command:"prepare" description:"Prepare SCM and NVMe storage attached to local servers (deprecated, use scm (prepare|reset) or nvme (prepare|reset) instead)."`
-	Scan    legacyScanCmd `command:"scan" description:"Scan SCM and NVMe storage attached to local server (deprecated, use scm scan or nvme scan instead)."`
+	Scan    legacyScanCmd `command:"scan" description:"Scan SCM and NVMe storage attached to local server (deprecated, use scm scan or nvme scan instead, or --offline for an unprivileged scan)."`
 }
 
 type legacyPrepCmd struct {
@@ -153,9 +153,69 @@ type legacyScanCmd struct {
 
 	HelperLogFile string `short:"l" long:"helper-log-file" description:"Log debug from daos_server_helper binary."`
 	DisableVMD    bool   `short:"d" long:"disable-vmd" description:"Disable VMD-aware scan."`
+	Offline       bool   `long:"offline" description:"Enumerate devices from sysfs only, without privileges or changing device bindings (safe to run alongside a running server)."`
+}
+
+type offlineScanner interface {
+	ScanNvme() (storage.NvmeControllers, []string, error)
+	ScanScm() (storage.ScmNamespaces, error)
+}
+
+// scanOffline reports the candidate NVMe and SCM devices that can be found
+// without the help of SPDK or the privileged helper, for use when authoring
+// a server config file on a node that is already in production.
+func (cmd *legacyScanCmd) scanOffline(scanner offlineScanner) error {
+	cmd.Info("Scanning locally-attached storage (offline)...")
+
+	var bld strings.Builder
+	scanErrors := make([]error, 0, 2)
+
+	ctrlrs, userspace, err := scanner.ScanNvme()
+	if err != nil {
+		scanErrors = append(scanErrors, err)
+	} else {
+		fmt.Fprintf(&bld, "\n")
+		if err := pretty.PrintNvmeControllers(ctrlrs, &bld); err != nil {
+			return err
+		}
+		if len(userspace) > 0 {
+			fmt.Fprintf(&bld, "\nNVMe controllers not bound to the kernel driver (details unavailable): %s\n",
+				strings.Join(userspace, ", "))
+		}
+	}
+
+	namespaces, err := scanner.ScanScm()
+	if err != nil {
+		scanErrors = append(scanErrors, err)
+	} else {
+		fmt.Fprintf(&bld, "\n")
+		if err := pretty.PrintScmNamespaces(namespaces, &bld); err != nil {
+			return err
+		}
+	}
+
+	cmd.Info(bld.String())
+
+	return scanErrorsToError(scanErrors)
+}
+
+func scanErrorsToError(scanErrors []error) error {
+	if len(scanErrors) == 0 {
+		return nil
+	}
+
+	errStr := "scan error(s):\n"
+	for _, err := range scanErrors {
+		errStr += fmt.Sprintf("  %s\n", err.Error())
+	}
+	return errors.New(errStr)
 }
 
 func (cmd *legacyScanCmd) Execute(args []string) error {
+	if cmd.Offline {
+		return cmd.scanOffline(storage.NewSysfsScanner(cmd.Logger))
+	}
+
 	cmd.Notice("storage scan subcommand is deprecated, use nvme or scm subcommands instead")
 
 	if cmd.HelperLogFile != "" {
@@ -213,13 +273,5 @@ func (cmd *legacyScanCmd) Execute(args []string) error {
 
 	cmd.Info(bld.String())
 
-	if len(scanErrors) > 0 {
-		errStr := "scan error(s):\n"
-		for _, err := range scanErrors {
-			errStr += fmt.Sprintf("  %s\n", err.Error())
-		}
-		return errors.New(errStr)
-	}
-
-	return nil
+	return scanErrorsToError(scanErrors)
 }
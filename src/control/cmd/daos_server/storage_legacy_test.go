@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2022-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +7,7 @@
 package main
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -254,3 +255,76 @@ func TestDaosServer_StoragePrepare_Legacy(t *testing.T) {
 		})
 	}
 }
+
+type mockOfflineScanner struct {
+	ctrlrs     storage.NvmeControllers
+	userspace  []string
+	nvmeErr    error
+	namespaces storage.ScmNamespaces
+	scmErr     error
+}
+
+func (mos *mockOfflineScanner) ScanNvme() (storage.NvmeControllers, []string, error) {
+	return mos.ctrlrs, mos.userspace, mos.nvmeErr
+}
+
+func (mos *mockOfflineScanner) ScanScm() (storage.ScmNamespaces, error) {
+	return mos.namespaces, mos.scmErr
+}
+
+func TestDaosServer_StorageScan_Offline(t *testing.T) {
+	for name, tc := range map[string]struct {
+		scanner   *mockOfflineScanner
+		expOutput []string
+		expErr    error
+	}{
+		"no devices": {
+			scanner:   &mockOfflineScanner{},
+			expOutput: []string{"No NVMe devices found", "No SCM namespaces found"},
+		},
+		"devices found": {
+			scanner: &mockOfflineScanner{
+				ctrlrs: storage.NvmeControllers{
+					storage.MockNvmeController(1),
+					&storage.NvmeController{PciAddr: "0000:01:00.0"},
+				},
+				userspace:  []string{"0000:01:00.0"},
+				namespaces: storage.ScmNamespaces{storage.MockScmNamespace(0)},
+			},
+			expOutput: []string{
+				storage.MockNvmeController(1).PciAddr,
+				"not bound to the kernel driver (details unavailable): 0000:01:00.0",
+				storage.MockScmNamespace(0).BlockDevice,
+			},
+		},
+		"scan errors": {
+			scanner: &mockOfflineScanner{
+				nvmeErr: errors.New("reading PCI devices"),
+				scmErr:  errors.New("bad glob"),
+			},
+			expErr: errors.New("reading PCI devices"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cmd := &legacyScanCmd{
+				LogCmd:  cmdutil.LogCmd{Logger: log},
+				Offline: true,
+			}
+
+			gotErr := cmd.scanOffline(tc.scanner)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			for _, exp := range tc.expOutput {
+				if !strings.Contains(buf.String(), exp) {
+					t.Errorf("expected %q in output", exp)
+				}
+			}
+		})
+	}
+}
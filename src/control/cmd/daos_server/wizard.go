@@ -0,0 +1,471 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/units"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	wizardServerOutFile  = "daos_server_wizard.out"
+	wizardTimeout        = 5 * time.Minute
+	wizardPollInterval   = 2 * time.Second
+	wizardDefaultPoolPct = "50%"
+)
+
+var wizardPoolPctPattern = regexp.MustCompile(`^\s*(\d{1,3})\s*%\s*$`)
+
+// wizardAnswers contains the choices made during the first-boot wizard, either supplied in an
+// answers file or gathered interactively.
+type wizardAnswers struct {
+	ConfigPath  string `yaml:"config_path"`
+	NetClass    string `yaml:"net_class"`
+	NetProvider string `yaml:"net_provider,omitempty"`
+	UseTmpfsSCM bool   `yaml:"use_tmpfs_scm"`
+	SCMOnly     bool   `yaml:"scm_only"`
+	PoolLabel   string `yaml:"pool_label"`
+	PoolSize    string `yaml:"pool_size"`
+}
+
+// defaultWizardAnswers derives default answers from the detected hardware.
+func defaultWizardAnswers(hf *control.HostFabric, hs *control.HostStorage) *wizardAnswers {
+	wa := &wizardAnswers{
+		ConfigPath:  path.Join(build.ConfigDir, defaultConfigFile),
+		NetClass:    "ethernet",
+		UseTmpfsSCM: len(hs.ScmNamespaces) == 0,
+		SCMOnly:     len(hs.NvmeDevices) == 0,
+		PoolLabel:   "demo",
+		PoolSize:    wizardDefaultPoolPct,
+	}
+
+	for _, iface := range hf.Interfaces {
+		if iface.NetDevClass == hardware.Infiniband {
+			wa.NetClass = "infiniband"
+			break
+		}
+	}
+
+	return wa
+}
+
+// wizardPrompter asks questions on the terminal and reads the responses.
+type wizardPrompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+func (wp *wizardPrompter) ask(question, def string) (string, error) {
+	fmt.Fprintf(wp.out, "%s [%s]: ", question, def)
+
+	line, err := wp.in.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	if line = strings.TrimSpace(line); line != "" {
+		return line, nil
+	}
+	return def, nil
+}
+
+func (wp *wizardPrompter) askBool(question string, def bool) (bool, error) {
+	defStr := "n"
+	if def {
+		defStr = "y"
+	}
+
+	for {
+		resp, err := wp.ask(question+" (y/n)", defStr)
+		if err != nil {
+			return false, err
+		}
+
+		switch strings.ToLower(resp) {
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Fprintf(wp.out, "Please answer y or n.\n")
+	}
+}
+
+// gather interactively asks for each answer, offering the current value as the default.
+func (wa *wizardAnswers) gather(wp *wizardPrompter) (err error) {
+	if wa.ConfigPath, err = wp.ask("Server config file to write", wa.ConfigPath); err != nil {
+		return
+	}
+	if wa.NetClass, err = wp.ask("Network class (ethernet/infiniband)", wa.NetClass); err != nil {
+		return
+	}
+	if wa.UseTmpfsSCM, err = wp.askBool("Use RAM (tmpfs) instead of PMem for SCM", wa.UseTmpfsSCM); err != nil {
+		return
+	}
+	if wa.SCMOnly, err = wp.askBool("Create SCM-only config without NVMe SSDs", wa.SCMOnly); err != nil {
+		return
+	}
+	if wa.PoolLabel, err = wp.ask("Demo pool label", wa.PoolLabel); err != nil {
+		return
+	}
+	wa.PoolSize, err = wp.ask("Demo pool size (e.g. 10GB or 50%)", wa.PoolSize)
+	return
+}
+
+func (wa *wizardAnswers) validate() error {
+	if wa.ConfigPath == "" {
+		return errors.New("config_path must be set")
+	}
+	if wa.PoolLabel == "" {
+		return errors.New("pool_label must be set")
+	}
+	if _, err := wa.netDevClass(); err != nil {
+		return err
+	}
+	if !wizardPoolPctPattern.MatchString(wa.PoolSize) {
+		if _, err := units.ParseBytes(wa.PoolSize); err != nil {
+			return errors.Wrap(err, "invalid pool_size")
+		}
+	}
+
+	return nil
+}
+
+func (wa *wizardAnswers) netDevClass() (hardware.NetDevClass, error) {
+	switch wa.NetClass {
+	case "ethernet":
+		return hardware.Ether, nil
+	case "infiniband":
+		return hardware.Infiniband, nil
+	default:
+		return 0, errors.Errorf("unrecognized net_class value %q", wa.NetClass)
+	}
+}
+
+// startServerFn starts daos_server in the background with the given config and returns its pid.
+type startServerFn func(cfgPath, outPath string) (int, error)
+
+func startServerProcess(cfgPath, outPath string) (int, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer out.Close()
+
+	proc := exec.Command(exe, "-o", cfgPath, "start")
+	proc.Stdout = out
+	proc.Stderr = out
+	// Detach so that the server outlives the wizard.
+	proc.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := proc.Start(); err != nil {
+		return 0, err
+	}
+
+	pid := proc.Process.Pid
+	return pid, proc.Process.Release()
+}
+
+// wizardCmd walks an evaluator through bringing up a single-node DAOS system.
+type wizardCmd struct {
+	cmdutil.LogCmd `json:"-"`
+	helperLogCmd   `json:"-"`
+
+	AnswersFile string `short:"a" long:"answers" description:"YAML file with wizard answers, disables interactive prompts"`
+	Force       bool   `short:"f" long:"force" description:"Overwrite an existing server config file"`
+	ConfigOnly  bool   `long:"config-only" description:"Stop after writing the server config file"`
+
+	in          io.Reader
+	out         io.Writer
+	getFabric   getFabricFn
+	getStorage  getStorageFn
+	startServer startServerFn
+	ctlInvoker  control.Invoker
+}
+
+func (cmd *wizardCmd) step(num int, desc string) {
+	cmd.Infof("[%d/6] %s", num, desc)
+}
+
+// getAnswers loads the answers file if supplied, otherwise asks the user.
+func (cmd *wizardCmd) getAnswers(wa *wizardAnswers) error {
+	if cmd.AnswersFile != "" {
+		data, err := ioutil.ReadFile(cmd.AnswersFile)
+		if err != nil {
+			return errors.Wrap(err, "reading answers file")
+		}
+		if err := yaml.UnmarshalStrict(data, wa); err != nil {
+			return errors.Wrapf(err, "parsing answers file %s", cmd.AnswersFile)
+		}
+	} else {
+		wp := &wizardPrompter{in: bufio.NewReader(cmd.in), out: cmd.out}
+		if err := wa.gather(wp); err != nil {
+			return err
+		}
+	}
+
+	return wa.validate()
+}
+
+func (cmd *wizardCmd) genConfig(wa *wizardAnswers, hf *control.HostFabric, hs *control.HostStorage) (*config.Server, error) {
+	ndc, err := wa.netDevClass()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := control.ConfGenerate(control.ConfGenerateReq{
+		Log:          cmd.Logger,
+		NrEngines:    1,
+		SCMOnly:      wa.SCMOnly,
+		NetClass:     ndc,
+		NetProvider:  wa.NetProvider,
+		AccessPoints: []string{"localhost"},
+		UseTmpfsSCM:  wa.UseTmpfsSCM,
+	}, control.DefaultEngineCfg, hf, hs)
+	if err != nil {
+		return nil, err
+	}
+
+	// An evaluation system is not expected to have certificates provisioned.
+	resp.Server.TransportConfig.AllowInsecure = true
+
+	return &resp.Server, nil
+}
+
+func (cmd *wizardCmd) writeConfig(cfg *config.Server, cfgPath string) error {
+	if _, err := os.Stat(cfgPath); err == nil && !cmd.Force {
+		return errors.Errorf("%s already exists, use --force to overwrite it", cfgPath)
+	}
+	if err := os.MkdirAll(filepath.Dir(cfgPath), 0755); err != nil {
+		return err
+	}
+
+	return cfg.SaveToFile(cfgPath)
+}
+
+// poll calls the supplied function until it returns true or an error, or the wizard timeout expires.
+func poll(ctx context.Context, what string, check func() (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, wizardTimeout)
+	defer cancel()
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("timed out waiting for %s", what)
+		case <-time.After(wizardPollInterval):
+		}
+	}
+}
+
+func (cmd *wizardCmd) waitForServer(ctx context.Context) error {
+	return poll(ctx, "daos_server to start", func() (bool, error) {
+		resp, err := control.StorageScan(ctx, cmd.ctlInvoker, &control.StorageScanReq{})
+		if err != nil {
+			return false, err
+		}
+		if resp.Errors() != nil {
+			cmd.Debugf("waiting for daos_server: %s", resp.HostErrors)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+func (cmd *wizardCmd) formatStorage(ctx context.Context) error {
+	resp, err := control.StorageFormat(ctx, cmd.ctlInvoker, &control.StorageFormatReq{})
+	if err != nil {
+		return err
+	}
+	if err := resp.Errors(); err != nil {
+		return errors.Errorf("%s:\n%s", err, resp.HostErrors)
+	}
+	return nil
+}
+
+func (cmd *wizardCmd) waitForJoin(ctx context.Context, nrEngines int) error {
+	return poll(ctx, "engines to join the system", func() (bool, error) {
+		resp, err := control.SystemQuery(ctx, cmd.ctlInvoker, &control.SystemQueryReq{FailOnUnavailable: true})
+		if err != nil {
+			if system.IsUnavailable(err) || system.IsUninitialized(err) || control.IsConnErr(err) {
+				cmd.Debugf("waiting for MS to become available: %s", err)
+				return false, nil
+			}
+			return false, err
+		}
+
+		joined := 0
+		for _, m := range resp.Members {
+			if m.State == system.MemberStateJoined {
+				joined++
+			}
+		}
+		return joined >= nrEngines, nil
+	})
+}
+
+func (cmd *wizardCmd) createPool(ctx context.Context, wa *wizardAnswers) (*control.PoolCreateResp, error) {
+	label, err := daos.PoolProperties().GetProperty("label")
+	if err != nil {
+		return nil, err
+	}
+	if err := label.SetValue(wa.PoolLabel); err != nil {
+		return nil, err
+	}
+	req := &control.PoolCreateReq{
+		Properties: []*daos.PoolProperty{label},
+	}
+
+	if match := wizardPoolPctPattern.FindStringSubmatch(wa.PoolSize); match != nil {
+		pct, _ := strconv.ParseUint(match[1], 10, 64)
+		if pct == 0 || pct > 100 {
+			return nil, errors.Errorf("invalid pool size %q: allowed range 0 < ratio <= 100", wa.PoolSize)
+		}
+
+		scmBytes, nvmeBytes, err := control.GetMaxPoolSize(ctx, cmd.Logger, cmd.ctlInvoker, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.TierBytes = []uint64{scmBytes * pct / 100, nvmeBytes * pct / 100}
+	} else {
+		if req.TotalBytes, err = units.ParseBytes(wa.PoolSize); err != nil {
+			return nil, err
+		}
+		if wa.SCMOnly {
+			req.TierRatio = []float64{1, 0}
+		}
+	}
+
+	return control.PoolCreate(ctx, cmd.ctlInvoker, req)
+}
+
+// Execute is run when wizardCmd activates.
+//
+// Detect local hardware, write a single-engine server config, start daos_server, format storage
+// and create a demo pool so that an evaluator has a working single-node system.
+func (cmd *wizardCmd) Execute(_ []string) error {
+	ctx := context.Background()
+	if cmd.in == nil {
+		cmd.in = os.Stdin
+	}
+	if cmd.out == nil {
+		cmd.out = os.Stdout
+	}
+	if cmd.getFabric == nil {
+		cmd.getFabric = getLocalFabric
+	}
+	if cmd.getStorage == nil {
+		cmd.getStorage = getLocalStorage
+	}
+	if cmd.startServer == nil {
+		cmd.startServer = startServerProcess
+	}
+	if err := cmd.setHelperLogFile(); err != nil {
+		return err
+	}
+
+	cmd.step(1, "Detecting hardware")
+	hf, err := cmd.getFabric(ctx, cmd.Logger)
+	if err != nil {
+		return err
+	}
+	hs, err := cmd.getStorage(ctx, cmd.Logger)
+	if err != nil {
+		return err
+	}
+
+	wa := defaultWizardAnswers(hf, hs)
+	if err := cmd.getAnswers(wa); err != nil {
+		return err
+	}
+
+	cmd.step(2, "Writing server config to "+wa.ConfigPath)
+	cfg, err := cmd.genConfig(wa, hf, hs)
+	if err != nil {
+		return errors.Wrap(err, "generating server config")
+	}
+	if err := cmd.writeConfig(cfg, wa.ConfigPath); err != nil {
+		return errors.Wrap(err, "writing server config")
+	}
+	if cmd.ConfigOnly {
+		cmd.Infof("Start the server with: daos_server start -o %s", wa.ConfigPath)
+		return nil
+	}
+
+	if cmd.ctlInvoker == nil {
+		ctlCfg := control.DefaultConfig()
+		ctlCfg.TransportConfig.AllowInsecure = true
+		cmd.ctlInvoker = control.NewClient(control.WithConfig(ctlCfg),
+			control.WithClientLogger(cmd.Logger))
+	}
+
+	outPath := filepath.Join(os.TempDir(), wizardServerOutFile)
+	cmd.step(3, "Starting daos_server (output in "+outPath+")")
+	pid, err := cmd.startServer(wa.ConfigPath, outPath)
+	if err != nil {
+		return errors.Wrap(err, "starting daos_server")
+	}
+	if err := cmd.waitForServer(ctx); err != nil {
+		return err
+	}
+
+	cmd.step(4, "Formatting storage")
+	if err := cmd.formatStorage(ctx); err != nil {
+		return errors.Wrap(err, "formatting storage")
+	}
+
+	cmd.step(5, "Waiting for engine to join the system")
+	if err := cmd.waitForJoin(ctx, len(cfg.Engines)); err != nil {
+		return err
+	}
+
+	cmd.step(6, fmt.Sprintf("Creating pool %q", wa.PoolLabel))
+	pcResp, err := cmd.createPool(ctx, wa)
+	if err != nil {
+		return errors.Wrap(err, "creating pool")
+	}
+
+	cmd.Infof("\nDAOS is running (daos_server pid %d) with pool %s (%s).", pid, wa.PoolLabel, pcResp.UUID)
+	cmd.Info("Next steps:")
+	cmd.Info("  Start the agent:   daos_agent -i &")
+	cmd.Infof("  Query the pool:    dmg -i pool query %s", wa.PoolLabel)
+	cmd.Info("  Stop the system:   dmg -i system stop && kill " + strconv.Itoa(pid))
+
+	return nil
+}
@@ -0,0 +1,191 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestDaosServer_Wizard_Commands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Interactive",
+			"wizard",
+			printCommand(t, &wizardCmd{}),
+			nil,
+		},
+		{
+			"Answers file",
+			"wizard -a answers.yml --force",
+			printCommand(t, &wizardCmd{
+				AnswersFile: "answers.yml",
+				Force:       true,
+			}),
+			nil,
+		},
+		{
+			"Config only",
+			"wizard --answers answers.yml --config-only",
+			printCommand(t, &wizardCmd{
+				AnswersFile: "answers.yml",
+				ConfigOnly:  true,
+			}),
+			nil,
+		},
+	})
+}
+
+func TestDaosServer_Wizard_defaultWizardAnswers(t *testing.T) {
+	eth0 := &control.HostFabricInterface{
+		Provider: "ofi+tcp", Device: "eth0", NetDevClass: hardware.Ether,
+	}
+	ib0 := &control.HostFabricInterface{
+		Provider: "ofi+verbs", Device: "ib0", NetDevClass: hardware.Infiniband,
+	}
+
+	for name, tc := range map[string]struct {
+		hf          *control.HostFabric
+		hs          *control.HostStorage
+		expNetClass string
+		expTmpfs    bool
+		expSCMOnly  bool
+	}{
+		"no pmem or nvme": {
+			hf:          &control.HostFabric{Interfaces: []*control.HostFabricInterface{eth0}},
+			hs:          &control.HostStorage{},
+			expNetClass: "ethernet",
+			expTmpfs:    true,
+			expSCMOnly:  true,
+		},
+		"pmem and nvme with infiniband": {
+			hf: &control.HostFabric{Interfaces: []*control.HostFabricInterface{eth0, ib0}},
+			hs: &control.HostStorage{
+				ScmNamespaces: storage.ScmNamespaces{storage.MockScmNamespace()},
+				NvmeDevices:   storage.NvmeControllers{storage.MockNvmeController()},
+			},
+			expNetClass: "infiniband",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wa := defaultWizardAnswers(tc.hf, tc.hs)
+
+			test.AssertEqual(t, tc.expNetClass, wa.NetClass, "unexpected net class")
+			test.AssertEqual(t, tc.expTmpfs, wa.UseTmpfsSCM, "unexpected tmpfs setting")
+			test.AssertEqual(t, tc.expSCMOnly, wa.SCMOnly, "unexpected scm-only setting")
+			test.AssertEqual(t, "demo", wa.PoolLabel, "unexpected pool label")
+		})
+	}
+}
+
+func TestDaosServer_Wizard_getAnswers(t *testing.T) {
+	defAnswers := func() *wizardAnswers {
+		return &wizardAnswers{
+			ConfigPath:  "/etc/daos/daos_server.yml",
+			NetClass:    "ethernet",
+			UseTmpfsSCM: true,
+			SCMOnly:     true,
+			PoolLabel:   "demo",
+			PoolSize:    wizardDefaultPoolPct,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		answersFile string
+		input       string
+		expAnswers  *wizardAnswers
+		expErr      error
+	}{
+		"interactive; accept defaults": {
+			input:      strings.Repeat("\n", 6),
+			expAnswers: defAnswers(),
+		},
+		"interactive; no input": {
+			expAnswers: defAnswers(),
+		},
+		"interactive; custom answers": {
+			input: "/tmp/server.yml\ninfiniband\nmaybe\nn\nno\ntest\n10GiB\n",
+			expAnswers: &wizardAnswers{
+				ConfigPath: "/tmp/server.yml",
+				NetClass:   "infiniband",
+				PoolLabel:  "test",
+				PoolSize:   "10GiB",
+			},
+		},
+		"interactive; bad net class": {
+			input:  "\nloopback\n",
+			expErr: errors.New("unrecognized net_class"),
+		},
+		"interactive; ambiguous pool size": {
+			input:  "\n\n\n\n\n10gb\n",
+			expErr: errors.New("invalid pool_size"),
+		},
+		"answers file; overrides": {
+			answersFile: "pool_label: eval\npool_size: 25%\nscm_only: false\n",
+			expAnswers: func() *wizardAnswers {
+				wa := defAnswers()
+				wa.PoolLabel = "eval"
+				wa.PoolSize = "25%"
+				wa.SCMOnly = false
+				return wa
+			}(),
+		},
+		"answers file; unknown key": {
+			answersFile: "pool_name: eval\n",
+			expErr:      errors.New("not found"),
+		},
+		"answers file; empty label": {
+			answersFile: "pool_label: \"\"\n",
+			expErr:      errors.New("pool_label must be set"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var out bytes.Buffer
+			cmd := &wizardCmd{
+				in:  strings.NewReader(tc.input),
+				out: &out,
+			}
+			cmd.Logger = log
+
+			if tc.answersFile != "" {
+				testDir, cleanup := test.CreateTestDir(t)
+				defer cleanup()
+
+				cmd.AnswersFile = filepath.Join(testDir, "answers.yml")
+				if err := ioutil.WriteFile(cmd.AnswersFile, []byte(tc.answersFile), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			wa := defAnswers()
+			gotErr := cmd.getAnswers(wa)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expAnswers, wa); diff != "" {
+				t.Fatalf("unexpected answers (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
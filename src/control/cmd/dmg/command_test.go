@@ -9,13 +9,16 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
@@ -41,6 +44,7 @@ type cmdTest struct {
 }
 
 type testConn struct {
+	sync.Mutex
 	t      *testing.T
 	called []string
 }
@@ -52,6 +56,8 @@ func newTestConn(t *testing.T) *testConn {
 }
 
 func (tc *testConn) appendInvocation(name string) {
+	tc.Lock()
+	defer tc.Unlock()
 	tc.called = append(tc.called, name)
 }
 
@@ -75,7 +81,7 @@ func runCmd(t *testing.T, cmd string, log *logging.LeveledLogger, ctlClient cont
 // printRequest generates a stable string representation of the
 // supplied UnaryRequest. It only includes exported fields in
 // the output.
-func printRequest(t *testing.T, req control.UnaryRequest) string {
+func printRequest(t *testing.T, req interface{}) string {
 	buf, err := json.Marshal(req)
 	if err != nil {
 		t.Fatalf("unable to print %+v: %s", req, err)
@@ -113,6 +119,10 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolEvictResp{})
 	case *control.PoolSetPropReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolSetPropResp{})
+	case *control.PoolSetAdminsReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolSetAdminsResp{Admins: req.Admins})
+	case *control.PoolAnnotateReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolAnnotateResp{Annotations: req.Annotations})
 	case *control.PoolGetPropReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolGetPropResp{
 			Properties: []*mgmtpb.PoolProperty{
@@ -132,6 +142,24 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemStartResp{})
 	case *control.SystemExcludeReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemExcludeResp{})
+	case *control.SystemSetMemberAliasReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
+	case *control.SystemAnnotateReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
+	case *control.FaultDomainQueryReq:
+		for _, host := range req.HostList {
+			resp.Responses = append(resp.Responses, &control.HostResponse{
+				Addr:    host,
+				Message: &ctlpb.FaultDomainQueryResp{FaultDomain: "/rack1"},
+			})
+		}
+	case *control.DumpEngineStacksReq:
+		for _, host := range req.HostList {
+			resp.Responses = append(resp.Responses, &control.HostResponse{
+				Addr:    host,
+				Message: &ctlpb.DumpEngineStacksResp{Path: "/tmp/daos_dump_42.txt"},
+			})
+		}
 	case *control.SystemQueryReq:
 		if req.FailOnUnavailable {
 			resp = control.MockMSResponse("", system.ErrRaftUnavail, nil)
@@ -144,14 +172,29 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.LeaderQueryResp{})
 	case *control.ListPoolsReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ListPoolsResp{})
+	case *control.PoolCleanupPartialReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolCleanupPartialResp{})
+	case *control.PoolCreateStatusReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolCreateStatusResp{
+			State: mgmtpb.PoolCreateStatusResp_COMPLETED,
+			JobId: req.JobID,
+		})
 	case *control.ContSetOwnerReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ContSetOwnerResp{})
+	case *control.ContCheckReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ContCheckResp{})
 	case *control.PoolQueryReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryResp{})
+	case *control.PoolProbeReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolProbeResp{})
 	case *control.PoolQueryTargetReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryTargetResp{})
 	case *control.PoolUpgradeReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolUpgradeResp{})
+	case *control.PoolSetPolicyReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolSetPolicyResp{})
+	case *control.PoolQueryAggregationReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryAggregationResp{})
 	case *control.PoolGetACLReq, *control.PoolOverwriteACLReq,
 		*control.PoolUpdateACLReq, *control.PoolDeleteACLReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ACLResp{})
@@ -171,11 +214,59 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.SystemGetPropReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetPropResp{})
+	case *control.SystemDbVerifyReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDbVerifyResp{})
+	case *control.SystemDbBackupReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDbBackupResp{
+			Backup: []byte("{}"),
+		})
+	case *control.SystemDbRestoreReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDbRestoreResp{})
+	case *control.SystemDbStatusReq:
+		resp = &control.UnaryResponse{
+			Responses: []*control.HostResponse{
+				{
+					Addr: "host1:10001",
+					Message: &mgmtpb.SystemDbStatusResp{
+						Replica: "host1:10001",
+						State:   "Leader",
+					},
+				},
+				{
+					Addr: "host2:10001",
+					Message: &mgmtpb.SystemDbStatusResp{
+						Replica: "host2:10001",
+						State:   "Follower",
+					},
+				},
+			},
+		}
+	case *control.SystemReplicaReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemReplicaResp{
+			Replicas: []string{req.Replica},
+		})
 	}
 
 	return resp, nil
 }
 
+func (bci *bridgeConnInvoker) InvokeUnaryRPCAsync(ctx context.Context, uReq control.UnaryRequest) (control.HostResponseChan, error) {
+	bci.conn.appendInvocation(printRequest(bci.t, uReq))
+
+	// No host responses are synthesized for asynchronous requests.
+	respChan := make(control.HostResponseChan)
+	close(respChan)
+
+	return respChan, nil
+}
+
+func (bci *bridgeConnInvoker) InvokeStreamRPC(ctx context.Context, sReq control.StreamRequest, handler control.StreamHandler) error {
+	bci.conn.appendInvocation(printRequest(bci.t, sReq))
+
+	// No responses are synthesized for streaming requests.
+	return nil
+}
+
 func runCmdTests(t *testing.T, cmdTests []cmdTest) {
 	t.Helper()
 
@@ -229,3 +320,52 @@ func TestNoCommand(t *testing.T) {
 	err := parseOpts([]string{}, &opts, nil, log)
 	testExpectedError(t, fmt.Errorf("Please specify one command"), err)
 }
+
+func TestHostFileCommands(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	hostFile := test.CreateTestFile(t, tmpDir, strings.Join([]string{
+		"# test hosts",
+		"node[1-2]   @mds @storage",
+		"node3:10005 @storage",
+	}, "\n"))
+
+	withHosts := func(req control.UnaryRequest, hosts ...string) control.UnaryRequest {
+		req.SetHostList(hosts)
+		return req
+	}
+
+	runCmdTests(t, []cmdTest{
+		{
+			"Host group without hostfile",
+			"-l @mds network scan",
+			"",
+			errors.New("host groups may only be used with a hostfile"),
+		},
+		{
+			"Missing hostfile",
+			"-f " + tmpDir + "/missing network scan",
+			"",
+			errors.New("failed to read hostfile"),
+		},
+		{
+			"Unknown host group",
+			"-f " + hostFile + " -l @compute network scan",
+			"",
+			errors.New("unknown host group"),
+		},
+		{
+			"Select host group",
+			"-f " + hostFile + " -l @mds network scan",
+			printRequest(t, withHosts(&control.NetworkScanReq{}, "node1", "node2")),
+			nil,
+		},
+		{
+			"Select host group and host with port override",
+			"--host-file " + hostFile + " --host-list @mds,node3 network scan",
+			printRequest(t, withHosts(&control.NetworkScanReq{}, "node1", "node2", "node3:10005")),
+			nil,
+		},
+	})
+}
@@ -8,16 +8,19 @@ package main
 
 import (
 	"context"
+	"strings"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/lib/control"
 )
 
 // ContCmd is the struct representing the top-level container subcommand.
 type ContCmd struct {
 	SetOwner ContSetOwnerCmd `command:"set-owner" description:"Change the owner for a DAOS container"`
+	Check    ContCheckCmd    `command:"check" description:"Check a DAOS pool for orphaned container metadata"`
 }
 
 // ContSetOwnerCmd is the struct representing the command to change the owner of a DAOS container.
@@ -57,3 +60,46 @@ func (c *ContSetOwnerCmd) Execute(args []string) error {
 
 	return err
 }
+
+// ContCheckCmd is the struct representing the command to check a DAOS pool for orphaned
+// container metadata.
+type ContCheckCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	PoolID string `short:"p" long:"pool" required:"1" description:"UUID or label of the DAOS pool to check"`
+	Repair bool   `long:"repair" description:"Remove orphaned container metadata that is found"`
+}
+
+// Execute runs the container check command
+func (c *ContCheckCmd) Execute(args []string) error {
+	req := &control.ContCheckReq{
+		PoolID: c.PoolID,
+		Repair: c.Repair,
+	}
+
+	resp, err := control.ContCheck(context.Background(), c.ctlInvoker, req)
+
+	if c.jsonOutputEnabled() {
+		return c.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintContCheckResponse(&out, resp, c.PoolID); err != nil {
+		return err
+	}
+	c.Info(out.String())
+
+	for _, orphan := range resp.Orphans {
+		if !orphan.Removed {
+			c.Info("Run with --repair to remove orphaned container metadata.")
+			break
+		}
+	}
+
+	return nil
+}
@@ -72,3 +72,31 @@ func TestContSetOwnerCommand(t *testing.T) {
 		},
 	})
 }
+
+func TestContCheckCommand(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Check with no arguments",
+			"cont check",
+			"",
+			errMissingFlag,
+		},
+		{
+			"Check pool",
+			"cont check --pool=tank",
+			printRequest(t, &control.ContCheckReq{
+				PoolID: "tank",
+			}),
+			nil,
+		},
+		{
+			"Check and repair pool",
+			"cont check -p tank --repair",
+			printRequest(t, &control.ContCheckReq{
+				PoolID: "tank",
+				Repair: true,
+			}),
+			nil,
+		},
+	})
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,14 +8,17 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"strings"
 
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
-// firmwareOption defines a DMG option that enables firmware management in DAOS.
+// firmwareOption retains the original top-level firmware command for
+// compatibility. Firmware is now managed with "dmg storage firmware".
 type firmwareOption struct {
 	Firmware firmwareCmd `command:"firmware" hidden:"true" description:"Manage the storage device firmware"`
 }
@@ -24,6 +27,7 @@ type firmwareOption struct {
 type firmwareCmd struct {
 	Query  firmwareQueryCmd  `command:"query" description:"Query device firmware versions and status on DAOS storage nodes"`
 	Update firmwareUpdateCmd `command:"update" description:"Update the device firmware on DAOS storage nodes"`
+	Commit firmwareCommitCmd `command:"commit" description:"Activate staged NVMe device firmware on DAOS storage nodes"`
 }
 
 // firmwareQueryCmd is used to query the storage device firmware on a set of DAOS hosts.
@@ -121,6 +125,8 @@ type firmwareUpdateCmd struct {
 	Devices     string `short:"d" long:"devices" description:"Comma-separated list of device identifiers to update"`
 	ModelID     string `short:"m" long:"model" description:"Limit update to a model ID"`
 	FirmwareRev string `short:"f" long:"fwrev" description:"Limit update to a current firmware revision"`
+	Stage       bool   `long:"stage" description:"Download NVMe firmware without activating it (activate later with commit)"`
+	Slot        uint32 `long:"slot" description:"NVMe firmware slot to download to (default: chosen by controller)"`
 	Verbose     bool   `short:"v" long:"verbose" description:"Display verbose output"`
 }
 
@@ -132,6 +138,7 @@ func (cmd *firmwareUpdateCmd) Execute(args []string) error {
 		FirmwarePath: cmd.FilePath,
 		ModelID:      cmd.ModelID,
 		FirmwareRev:  cmd.FirmwareRev,
+		NVMeSlot:     cmd.Slot,
 	}
 
 	if cmd.isSCMUpdate() {
@@ -140,6 +147,10 @@ func (cmd *firmwareUpdateCmd) Execute(args []string) error {
 		req.Type = control.DeviceTypeNVMe
 	}
 
+	if cmd.Stage {
+		req.NVMeAction = storage.NVMeFirmwareStage
+	}
+
 	if cmd.Devices != "" {
 		req.Devices = strings.Split(cmd.Devices, ",")
 	}
@@ -163,6 +174,9 @@ func (cmd *firmwareUpdateCmd) Execute(args []string) error {
 	if err := cmd.printUpdateResult(resp, &bld); err != nil {
 		return err
 	}
+	if cmd.Stage {
+		fmt.Fprintln(&bld, "Staged firmware is not activated until committed with \"dmg storage firmware commit\".")
+	}
 
 	cmd.Info(bld.String())
 
@@ -193,3 +207,64 @@ func (cmd *firmwareUpdateCmd) printNVMeUpdateResult(resp *control.FirmwareUpdate
 	}
 	return pretty.PrintNVMeFirmwareUpdateMap(resp.HostNVMeResult, out)
 }
+
+// firmwareCommitCmd is used to activate NVMe device firmware previously staged
+// on a set of DAOS hosts.
+type firmwareCommitCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+	Slot        uint32 `long:"slot" required:"1" description:"NVMe firmware slot containing the staged firmware"`
+	Devices     string `short:"d" long:"devices" description:"Comma-separated list of NVMe device PCI addresses to commit"`
+	ModelID     string `short:"m" long:"model" description:"Limit commit to a model ID"`
+	FirmwareRev string `short:"f" long:"fwrev" description:"Limit commit to a current firmware revision"`
+	Verbose     bool   `short:"v" long:"verbose" description:"Display verbose output"`
+}
+
+// Execute runs the firmware commit command.
+func (cmd *firmwareCommitCmd) Execute(args []string) error {
+	ctx := context.Background()
+
+	req := &control.FirmwareUpdateReq{
+		Type:        control.DeviceTypeNVMe,
+		ModelID:     cmd.ModelID,
+		FirmwareRev: cmd.FirmwareRev,
+		NVMeAction:  storage.NVMeFirmwareCommit,
+		NVMeSlot:    cmd.Slot,
+	}
+
+	if cmd.Devices != "" {
+		req.Devices = strings.Split(cmd.Devices, ",")
+	}
+
+	req.SetHostList(cmd.hostlist)
+	resp, err := control.FirmwareUpdate(ctx, cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &bld); err != nil {
+		return err
+	}
+
+	if cmd.Verbose {
+		err = pretty.PrintNVMeFirmwareUpdateMapVerbose(resp.HostNVMeResult, &bld)
+	} else {
+		err = pretty.PrintNVMeFirmwareUpdateMap(resp.HostNVMeResult, &bld)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(&bld, "Committed firmware is activated on the next controller reset.")
+
+	cmd.Info(bld.String())
+
+	return resp.Errors()
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
 func TestFirmwareCommands(t *testing.T) {
@@ -199,5 +200,72 @@ func TestFirmwareCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Update with NVMe stage and slot",
+			"firmware update --type=nvme --path=/dont/care --stage --slot=2",
+			strings.Join([]string{
+				printRequest(t, &control.FirmwareUpdateReq{
+					FirmwarePath: "/dont/care",
+					Type:         control.DeviceTypeNVMe,
+					NVMeAction:   storage.NVMeFirmwareStage,
+					NVMeSlot:     2,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Commit with no slot",
+			"firmware commit",
+			"",
+			errors.New("the required flag `--slot' was not specified"),
+		},
+		{
+			"Commit with slot",
+			"firmware commit --slot=2",
+			strings.Join([]string{
+				printRequest(t, &control.FirmwareUpdateReq{
+					Type:       control.DeviceTypeNVMe,
+					NVMeAction: storage.NVMeFirmwareCommit,
+					NVMeSlot:   2,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Commit with filters",
+			"storage firmware commit --slot=3 --model=Model1 --fwrev=FW100 --devices=0000:80:00.0",
+			strings.Join([]string{
+				printRequest(t, &control.FirmwareUpdateReq{
+					Type:        control.DeviceTypeNVMe,
+					Devices:     []string{"0000:80:00.0"},
+					ModelID:     "Model1",
+					FirmwareRev: "FW100",
+					NVMeAction:  storage.NVMeFirmwareCommit,
+					NVMeSlot:    3,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Storage firmware query",
+			"storage firmware query --type=scm",
+			strings.Join([]string{
+				printRequest(t, &control.FirmwareQueryReq{
+					SCM: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Storage firmware update",
+			"storage firmware update --type=scm --path=/dont/care",
+			strings.Join([]string{
+				printRequest(t, &control.FirmwareUpdateReq{
+					FirmwarePath: "/dont/care",
+					Type:         control.DeviceTypeSCM,
+				}),
+			}, " "),
+			nil,
+		},
 	})
 }
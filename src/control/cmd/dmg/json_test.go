@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -59,14 +60,14 @@ func TestDmg_JsonOutput(t *testing.T) {
 
 	testDir, cleanup := test.CreateTestDir(t)
 	defer cleanup()
-	aclContent := "A::OWNER@:rw\nA::user1@:rw\nA:g:group1@:r\n"
+	aclContent := "A::OWNER@:rw\nA::user1@:rw\nA:G:group1@:r\n"
 	aclPath := test.CreateTestFile(t, testDir, aclContent)
 
 	for _, args := range cmdArgs {
 		t.Run(strings.Join(args, " "), func(t *testing.T) {
 			testArgs := append([]string{"-i", "--json"}, args...)
 			switch strings.Join(args, " ") {
-			case "version", "telemetry config", "telemetry run", "config generate",
+			case "telemetry config", "telemetry run", "config generate",
 				"manpage", "system set-prop":
 				return
 			case "storage nvme-rebind":
@@ -75,6 +76,9 @@ func TestDmg_JsonOutput(t *testing.T) {
 			case "storage nvme-add-device":
 				testArgs = append(testArgs, "-l", "foo.com", "-a",
 					test.MockPCIAddr(), "-e", "0")
+			case "storage spdk-rpc":
+				testArgs = append(testArgs, "-l", "foo.com", "-e", "0",
+					"bdev_get_bdevs")
 			case "storage query target-health":
 				testArgs = append(testArgs, "-r", "0", "-t", "0")
 			case "storage query device-health":
@@ -86,24 +90,36 @@ func TestDmg_JsonOutput(t *testing.T) {
 					"--new-uuid", test.MockUUID())
 			case "storage led identify", "storage led check", "storage led clear":
 				testArgs = append(testArgs, test.MockUUID())
+			case "storage led set":
+				testArgs = append(testArgs, "--state", "fault", test.MockUUID())
+			case "storage firmware update", "firmware update":
+				testArgs = append(testArgs, "--type=nvme", "--path=/dont/care")
+			case "storage firmware commit", "firmware commit":
+				testArgs = append(testArgs, "--slot=1")
 			case "pool create":
 				testArgs = append(testArgs, "-s", "1TB")
-			case "pool destroy", "pool evict", "pool query", "pool get-acl":
+			case "pool destroy", "pool evict", "pool query", "pool get-acl", "pool create-status":
 				testArgs = append(testArgs, test.MockUUID())
 			case "pool overwrite-acl", "pool update-acl":
 				testArgs = append(testArgs, test.MockUUID(), "-a", aclPath)
 			case "pool delete-acl":
-				testArgs = append(testArgs, test.MockUUID(), "-p", "foo@")
+				testArgs = append(testArgs, test.MockUUID(), "-p", "u:foo@")
 			case "pool set-prop":
 				testArgs = append(testArgs, test.MockUUID(), "label:foo")
+			case "pool policy set":
+				testArgs = append(testArgs, test.MockUUID(), "--clear")
 			case "pool get-prop":
 				testArgs = append(testArgs, test.MockUUID(), "label")
 			case "pool extend":
 				testArgs = append(testArgs, test.MockUUID(), "--ranks", "0")
 			case "pool exclude", "pool drain", "pool reintegrate":
 				testArgs = append(testArgs, test.MockUUID(), "--rank", "0")
+			case "pool debug":
+				testArgs = append(testArgs, test.MockUUID(), "-l", "foo.com", "--rank", "0", "-c", "ls")
 			case "pool query-targets":
 				testArgs = append(testArgs, test.MockUUID(), "--rank", "0", "--target-idx", "1,3,5,7")
+			case "container check":
+				testArgs = append(testArgs, "--pool", test.MockUUID())
 			case "container set-owner":
 				testArgs = append(testArgs, "--user", "foo", "--pool", test.MockUUID(),
 					"--cont", test.MockUUID())
@@ -115,10 +131,32 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, "foo:bar")
 			case "system del-attr":
 				testArgs = append(testArgs, "foo")
+			case "system db backup":
+				testArgs = append(testArgs, filepath.Join(testDir, "sysdb.backup"))
+			case "system db restore":
+				testArgs = append(testArgs, aclPath)
 			case "system exclude":
 				testArgs = append(testArgs, "--ranks", "0")
 			case "system clear-exclude":
 				testArgs = append(testArgs, "--ranks", "0")
+			case "system set-alias":
+				testArgs = append(testArgs, "--rank", "0", "rack3-node7")
+			case "system clear-alias":
+				testArgs = append(testArgs, "--rank", "0")
+			case "system annotate":
+				testArgs = append(testArgs, "--ranks", "0", "hw", "DIMM replaced")
+			case "pool annotate":
+				testArgs = append(testArgs, test.MockUUID(), "owner", "alice")
+			case "system replace-ms":
+				testArgs = append(testArgs, "--old", "host1:10001", "--new", "host2:10001")
+			case "system watch":
+				testArgs = append(testArgs, "--count", "1")
+			case "server fault-inject":
+				testArgs = append(testArgs, "join-drop")
+			case "server logs":
+				testArgs = append(testArgs, "-l", "foo.com", "--control")
+			case "server dump-stacks":
+				testArgs = append(testArgs, "-l", "foo.com", "--rank", "1")
 			}
 
 			// replace os.Stdout so that we can verify the generated output
@@ -12,18 +12,21 @@ import (
 	"io"
 	"os"
 	"path"
+	"strings"
 
 	flags "github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/lib/atm"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
-	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -56,8 +59,31 @@ type (
 		writer         io.Writer
 		shouldEmitJSON bool
 	}
+
+	// tableOutputCmd enables the representation of tabular output to be
+	// selected with a listing command.
+	tableOutputCmd struct {
+		TableFormat string `long:"format" choice:"table" choice:"wide" choice:"csv" choice:"yaml" default:"table" description:"Format of tabular output (wide, csv and yaml include all available columns)"`
+		Columns     string `long:"columns" description:"Comma-separated list of columns to display, in the order given"`
+	}
 )
 
+// tableOutputOptions returns the print options for the selected table format
+// and columns.
+func (cmd *tableOutputCmd) tableOutputOptions() ([]pretty.PrintConfigOption, error) {
+	format, err := txtfmt.ParseTableFormat(cmd.TableFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []pretty.PrintConfigOption{pretty.PrintWithTableFormat(format)}
+	if cmd.Columns != "" {
+		opts = append(opts, pretty.PrintWithColumns(strings.Split(cmd.Columns, ",")...))
+	}
+
+	return opts, nil
+}
+
 func (cmd *ctlInvokerCmd) setInvoker(c control.Invoker) {
 	cmd.ctlInvoker = c
 }
@@ -140,6 +166,11 @@ type cmdConfigSetter interface {
 	setConfig(*control.Config)
 }
 
+// rpcTraceSetter is an interface for enabling RPC tracing on an invoker
+type rpcTraceSetter interface {
+	SetRPCTrace(*control.RPCTrace)
+}
+
 // cfgCmd is a structure that can be used by commands that need the control
 // config.
 type cfgCmd struct {
@@ -152,9 +183,11 @@ func (c *cfgCmd) setConfig(cfg *control.Config) {
 
 type cliOptions struct {
 	AllowProxy     bool           `long:"allow-proxy" description:"Allow proxy configuration via environment"`
-	HostList       ui.HostSetFlag `short:"l" long:"host-list" description:"A comma separated list of addresses <ipv4addr/hostname> to connect to"`
+	HostList       string         `short:"l" long:"host-list" description:"A comma separated list of addresses <ipv4addr/hostname> or @groups from the hostfile to connect to"`
+	HostFile       string         `short:"f" long:"host-file" description:"Path of a hostfile listing addresses and host groups to connect to"`
 	Insecure       bool           `short:"i" long:"insecure" description:"Have dmg attempt to connect without certificates"`
 	Debug          bool           `short:"d" long:"debug" description:"Enable debug output"`
+	DebugRPC       bool           `long:"debug-rpc" description:"Log each RPC with its timing and print a timing summary on completion"`
 	LogFile        string         `long:"log-file" description:"Log command output to the specified file"`
 	JSON           bool           `short:"j" long:"json" description:"Enable JSON output"`
 	JSONLogs       bool           `short:"J" long:"json-logging" description:"Enable JSON-formatted log output"`
@@ -168,15 +201,22 @@ type cliOptions struct {
 	Cont           ContCmd        `command:"container" alias:"cont" description:"Perform tasks related to DAOS containers"`
 	Version        versionCmd     `command:"version" description:"Print dmg version"`
 	Telemetry      telemCmd       `command:"telemetry" alias:"telem" description:"Perform telemetry operations"`
-	firmwareOption                // build with tag "firmware" to enable
+	Perf           perfCmd        `command:"perf" hidden:"true" description:"Measure DAOS control-plane performance"`
+	Support        supportCmd     `command:"support" description:"Perform tasks related to supporting DAOS installations"`
+	firmwareOption                // deprecated, use "storage firmware"
 	ManPage        cmdutil.ManCmd `command:"manpage" hidden:"true"`
 }
 
-type versionCmd struct{}
+type versionCmd struct {
+	jsonOutputCmd
+}
 
 func (cmd *versionCmd) Execute(_ []string) error {
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(build.NewVersionInfo("dmg", build.ComponentAdmin), nil)
+	}
+
 	fmt.Printf("dmg version %s\n", build.DaosVersion)
-	os.Exit(0)
 	return nil
 }
 
@@ -189,6 +229,44 @@ func exitWithError(log logging.Logger, err error) {
 	os.Exit(1)
 }
 
+// resolveHostList returns the hosts selected by the host list and hostfile
+// options. Host groups in the host list are resolved against the hostfile,
+// and if no host list is supplied then all hosts in the hostfile are
+// selected.
+func resolveHostList(opts *cliOptions) ([]string, error) {
+	var hf *hostlist.HostFile
+	if opts.HostFile != "" {
+		var err error
+		if hf, err = hostlist.ReadHostFile(opts.HostFile); err != nil {
+			return nil, errors.Wrap(err, "failed to read hostfile")
+		}
+	}
+
+	var hs *hostlist.HostSet
+	var err error
+	switch {
+	case opts.HostList != "" && hf != nil:
+		hs, err = hf.Resolve(opts.HostList)
+	case opts.HostList != "":
+		if strings.Contains(opts.HostList, "@") {
+			return nil, errors.New("host groups may only be used with a hostfile (--host-file)")
+		}
+		hs, err = hostlist.CreateSet(opts.HostList)
+	case hf != nil:
+		hs = hf.Hosts()
+		if hs.Count() == 0 {
+			return nil, errors.Errorf("no hosts found in hostfile %s", opts.HostFile)
+		}
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid host list")
+	}
+
+	return hs.Slice(), nil
+}
+
 func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *logging.LeveledLogger) error {
 	var wroteJSON atm.Bool
 	p := flags.NewParser(opts, flags.Default)
@@ -274,19 +352,35 @@ and access control settings, along with system wide operations.`
 		}
 
 		invoker.SetConfig(ctlCfg)
+		if opts.DebugRPC {
+			if ts, ok := invoker.(rpcTraceSetter); ok {
+				trace := control.NewRPCTrace(log)
+				ts.SetRPCTrace(trace)
+				defer func() {
+					log.Noticef("%s", trace.Summary())
+				}()
+			}
+		}
 		if ctlCmd, ok := cmd.(ctlInvoker); ok {
 			ctlCmd.setInvoker(invoker)
 		}
 
-		if !opts.HostList.Empty() {
+		hl, err := resolveHostList(opts)
+		if err != nil {
+			return err
+		}
+		if opts.HostList != "" {
 			if hlCmd, ok := cmd.(hostListSetter); ok {
-				hl := opts.HostList.Slice()
 				hlCmd.setHostList(hl)
 				ctlCfg.HostList = hl
 			} else {
 				return errors.Errorf("this command does not accept a hostlist parameter (set it in %s or %s)",
 					control.UserConfigPath(), control.SystemConfigPath())
 			}
+		} else if len(hl) > 0 {
+			// A hostfile without a host selection replaces the
+			// configured hostlist for all commands.
+			ctlCfg.HostList = hl
 		}
 
 		if cfgCmd, ok := cmd.(cmdConfigSetter); ok {
@@ -28,6 +28,34 @@ type networkScanCmd struct {
 	hostListCmd
 	jsonOutputCmd
 	FabricProvider string `short:"p" long:"provider" description:"Filter device list to those that support the given OFI provider or 'all' for all available (default is the provider specified in daos_server.yml)"`
+	Recommend      bool   `short:"r" long:"recommend" description:"Rank interfaces on each NUMA socket by provider priority and link speed, and recommend options for config generate"`
+}
+
+// recommendProvider returns the provider to restrict recommendations to. The
+// scan results have already been filtered by the servers, so this is only set
+// when a specific provider was requested.
+func (cmd *networkScanCmd) recommendProvider() string {
+	if strings.EqualFold(cmd.FabricProvider, "all") {
+		return ""
+	}
+	return cmd.FabricProvider
+}
+
+type hostFabricRecommendation struct {
+	Hosts string `json:"hosts"`
+	*control.FabricRecommendation
+}
+
+func (cmd *networkScanCmd) recommendations(resp *control.NetworkScanResp) []*hostFabricRecommendation {
+	recs := []*hostFabricRecommendation{}
+	for _, key := range resp.HostFabrics.Keys() {
+		hfs := resp.HostFabrics[key]
+		recs = append(recs, &hostFabricRecommendation{
+			Hosts:                hfs.HostSet.RangedString(),
+			FabricRecommendation: hfs.HostFabric.Recommend(cmd.recommendProvider()),
+		})
+	}
+	return recs
 }
 
 func (cmd *networkScanCmd) Execute(_ []string) error {
@@ -43,6 +71,9 @@ func (cmd *networkScanCmd) Execute(_ []string) error {
 	resp, err := control.NetworkScan(ctx, cmd.ctlInvoker, req)
 
 	if cmd.jsonOutputEnabled() {
+		if cmd.Recommend && err == nil {
+			return cmd.outputJSON(cmd.recommendations(resp), resp.Errors())
+		}
 		return cmd.outputJSON(resp, err)
 	}
 
@@ -55,7 +86,11 @@ func (cmd *networkScanCmd) Execute(_ []string) error {
 		return err
 	}
 
-	if err := pretty.PrintHostFabricMap(resp.HostFabrics, &bld); err != nil {
+	if cmd.Recommend {
+		if err := pretty.PrintHostFabricRecommendations(resp.HostFabrics, cmd.recommendProvider(), &bld); err != nil {
+			return err
+		}
+	} else if err := pretty.PrintHostFabricMap(resp.HostFabrics, &bld); err != nil {
 		return err
 	}
 	cmd.Info(bld.String())
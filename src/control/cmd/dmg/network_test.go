@@ -43,5 +43,15 @@ func TestNetworkCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Perform network scan with recommendations",
+			"network scan --provider ofi+verbs --recommend",
+			strings.Join([]string{
+				printRequest(t, &control.NetworkScanReq{
+					Provider: "ofi+verbs",
+				}),
+			}, " "),
+			nil,
+		},
 	})
 }
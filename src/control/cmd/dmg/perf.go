@@ -0,0 +1,69 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// perfCmd is the struct representing the top-level perf subcommand.
+type perfCmd struct {
+	Control perfControlCmd `command:"control" description:"Measure control-plane RPC latency and fan-out throughput to hosts in the configured dmg hostlist"`
+}
+
+// perfControlCmd is the struct representing the command to measure
+// control-plane performance with a no-op RPC.
+type perfControlCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+	Rounds      int `short:"r" long:"rounds" default:"100" description:"Number of times to fan out the no-op RPC to all hosts"`
+	PayloadSize int `short:"s" long:"payload-size" default:"0" description:"Size in bytes of the padding sent with each no-op RPC"`
+}
+
+// Execute is run when perfControlCmd activates.
+func (cmd *perfControlCmd) Execute(_ []string) error {
+	if cmd.Rounds <= 0 {
+		return errors.New("--rounds must be greater than zero")
+	}
+	if cmd.PayloadSize < 0 {
+		return errors.New("--payload-size must not be negative")
+	}
+
+	req := &control.ControlPerfReq{
+		Rounds:      cmd.Rounds,
+		PayloadSize: cmd.PayloadSize,
+	}
+	req.SetHostList(cmd.hostlist)
+
+	resp, err := control.ControlPerf(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "control-plane perf measurement failed")
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &bld); err != nil {
+		return err
+	}
+	if err := pretty.PrintControlPerfResponse(resp, &bld); err != nil {
+		return err
+	}
+	cmd.Infof("%s", bld.String())
+
+	return resp.Errors()
+}
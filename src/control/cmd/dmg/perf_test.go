@@ -0,0 +1,56 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPerfCommands(t *testing.T) {
+	perfReq := func(rounds, payloadSize int) *control.ControlPerfReq {
+		return &control.ControlPerfReq{
+			Rounds:      rounds,
+			PayloadSize: payloadSize,
+		}
+	}
+
+	runCmdTests(t, []cmdTest{
+		{
+			"Control perf with defaults",
+			"perf control",
+			strings.Repeat(printRequest(t, perfReq(100, 0))+" ", 99) +
+				printRequest(t, perfReq(100, 0)),
+			nil,
+		},
+		{
+			"Control perf with options",
+			"perf control --rounds 2 --payload-size 4096",
+			strings.Join([]string{
+				printRequest(t, perfReq(2, 4096)),
+				printRequest(t, perfReq(2, 4096)),
+			}, " "),
+			nil,
+		},
+		{
+			"Control perf with zero rounds",
+			"perf control -r 0",
+			"",
+			errors.New("must be greater than zero"),
+		},
+		{
+			"Control perf with negative payload size",
+			"perf control -s -1",
+			"",
+			errors.New("must not be negative"),
+		},
+	})
+}
@@ -9,12 +9,15 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
-	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
@@ -22,29 +25,38 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/lib/units"
 	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 // PoolCmd is the struct representing the top-level pool subcommand.
 type PoolCmd struct {
-	Create       PoolCreateCmd       `command:"create" description:"Create a DAOS pool"`
-	Destroy      PoolDestroyCmd      `command:"destroy" description:"Destroy a DAOS pool"`
-	Evict        PoolEvictCmd        `command:"evict" description:"Evict all pool connections to a DAOS pool"`
-	List         PoolListCmd         `command:"list" alias:"ls" description:"List DAOS pools"`
-	Extend       PoolExtendCmd       `command:"extend" description:"Extend a DAOS pool to include new ranks."`
-	Exclude      PoolExcludeCmd      `command:"exclude" description:"Exclude targets from a rank"`
-	Drain        PoolDrainCmd        `command:"drain" description:"Drain targets from a rank"`
-	Reintegrate  PoolReintegrateCmd  `command:"reintegrate" alias:"reint" description:"Reintegrate targets for a rank"`
-	Query        PoolQueryCmd        `command:"query" description:"Query a DAOS pool"`
-	QueryTargets PoolQueryTargetsCmd `command:"query-targets" description:"Query pool target info"`
-	GetACL       PoolGetACLCmd       `command:"get-acl" description:"Get a DAOS pool's Access Control List"`
-	OverwriteACL PoolOverwriteACLCmd `command:"overwrite-acl" description:"Overwrite a DAOS pool's Access Control List"`
-	UpdateACL    PoolUpdateACLCmd    `command:"update-acl" description:"Update entries in a DAOS pool's Access Control List"`
-	DeleteACL    PoolDeleteACLCmd    `command:"delete-acl" description:"Delete an entry from a DAOS pool's Access Control List"`
-	SetProp      PoolSetPropCmd      `command:"set-prop" description:"Set pool property"`
-	GetProp      PoolGetPropCmd      `command:"get-prop" description:"Get pool properties"`
-	Upgrade      PoolUpgradeCmd      `command:"upgrade" description:"Upgrade pool to latest format"`
+	Create         PoolCreateCmd         `command:"create" description:"Create a DAOS pool"`
+	CreateStatus   PoolCreateStatusCmd   `command:"create-status" description:"Query or cancel an asynchronous DAOS pool create"`
+	Destroy        PoolDestroyCmd        `command:"destroy" description:"Destroy a DAOS pool"`
+	Evict          PoolEvictCmd          `command:"evict" description:"Evict all pool connections to a DAOS pool"`
+	CleanupPartial PoolCleanupPartialCmd `command:"cleanup-partial" description:"Destroy pools left incomplete by a failed create or destroy"`
+	List           PoolListCmd           `command:"list" alias:"ls" description:"List DAOS pools"`
+	Extend         PoolExtendCmd         `command:"extend" description:"Extend a DAOS pool to include new ranks."`
+	Exclude        PoolExcludeCmd        `command:"exclude" description:"Exclude targets from a rank"`
+	Drain          PoolDrainCmd          `command:"drain" description:"Drain targets from a rank"`
+	Reintegrate    PoolReintegrateCmd    `command:"reintegrate" alias:"reint" description:"Reintegrate targets for a rank"`
+	Query          PoolQueryCmd          `command:"query" description:"Query a DAOS pool"`
+	QueryTargets   PoolQueryTargetsCmd   `command:"query-targets" description:"Query pool target info"`
+	GetACL         PoolGetACLCmd         `command:"get-acl" description:"Get a DAOS pool's Access Control List"`
+	OverwriteACL   PoolOverwriteACLCmd   `command:"overwrite-acl" description:"Overwrite a DAOS pool's Access Control List"`
+	UpdateACL      PoolUpdateACLCmd      `command:"update-acl" description:"Update entries in a DAOS pool's Access Control List"`
+	DeleteACL      PoolDeleteACLCmd      `command:"delete-acl" description:"Delete an entry from a DAOS pool's Access Control List"`
+	SetProp        PoolSetPropCmd        `command:"set-prop" description:"Set pool property"`
+	GetProp        PoolGetPropCmd        `command:"get-prop" description:"Get pool properties"`
+	SetAdmins      PoolSetAdminsCmd      `command:"set-admins" description:"Set the principals that may administer a DAOS pool"`
+	Annotate       PoolAnnotateCmd       `command:"annotate" description:"Set or remove an annotation on a DAOS pool"`
+	Upgrade        PoolUpgradeCmd        `command:"upgrade" description:"Upgrade pool to latest format"`
+	Policy         poolPolicyCmd         `command:"policy" description:"Tune the scheduling of pool background tasks at runtime"`
+	Debug          PoolDebugCmd          `command:"debug" description:"Run the offline debugger against a pool's shards on a stopped engine"`
 }
 
 // PoolCreateCmd is the struct representing the command to create a DAOS pool.
@@ -65,6 +77,8 @@ type PoolCreateCmd struct {
 	ScmSize       string           `short:"s" long:"scm-size" description:"Per-engine SCM allocation for DAOS pool (manual)"`
 	NVMeSize      string           `short:"n" long:"nvme-size" description:"Per-engine NVMe allocation for DAOS pool (manual)"`
 	RankList      ui.RankSetFlag   `short:"r" long:"ranks" description:"Storage engine unique identifiers (ranks) for DAOS pool"`
+	ACLTemplate   string           `long:"acl-template" choice:"private" choice:"group" choice:"shared-read" description:"ACL template to apply to DAOS pool"`
+	Async         bool             `long:"async" description:"Return the ID of a job tracking the create rather than waiting for it to complete"`
 
 	Args struct {
 		PoolLabel string `positional-arg-name:"<pool label>"`
@@ -108,11 +122,15 @@ func (cmd *PoolCreateCmd) Execute(args []string) error {
 	}
 
 	if cmd.ACLFile != "" {
+		if cmd.ACLTemplate != "" {
+			return errIncompatFlags("acl-file", "acl-template")
+		}
 		req.ACL, err = control.ReadACLFile(cmd.ACLFile)
 		if err != nil {
 			return err
 		}
 	}
+	req.ACLTemplate = cmd.ACLTemplate
 
 	switch {
 	case allFlagPattern.MatchString(cmd.Size):
@@ -161,7 +179,7 @@ func (cmd *PoolCreateCmd) Execute(args []string) error {
 		cmd.Infof("Creating DAOS pool with %d%% of all storage", storageRatio)
 	case cmd.Size != "":
 		// auto-selection of storage values
-		req.TotalBytes, err = humanize.ParseBytes(cmd.Size)
+		req.TotalBytes, err = units.ParseBytes(cmd.Size)
 		if err != nil {
 			return errors.Wrap(err, "failed to parse pool size")
 		}
@@ -196,21 +214,24 @@ func (cmd *PoolCreateCmd) Execute(args []string) error {
 			return errors.New("Storage tier ratios must add up to 100")
 		}
 		cmd.Infof("Creating DAOS pool with automatic storage allocation: "+
-			"%s total, %s tier ratio", humanize.Bytes(req.TotalBytes), cmd.TierRatio)
+			"%s total, %s tier ratio", units.FormatBytes(req.TotalBytes), cmd.TierRatio)
 	default:
 		// manual selection of storage values
 		if cmd.NumRanks > 0 {
 			return errIncompatFlags("nranks", "scm-size")
 		}
+		if cmd.TierRatio != `6,94` {
+			return errIncompatFlags("tier-ratio", "scm-size")
+		}
 
-		scmBytes, err := humanize.ParseBytes(cmd.ScmSize)
+		scmBytes, err := units.ParseBytes(cmd.ScmSize)
 		if err != nil {
 			return errors.Wrap(err, "failed to parse pool SCM size")
 		}
 
 		var nvmeBytes uint64
 		if cmd.NVMeSize != "" {
-			nvmeBytes, err = humanize.ParseBytes(cmd.NVMeSize)
+			nvmeBytes, err = units.ParseBytes(cmd.NVMeSize)
 			if err != nil {
 				return errors.Wrap(err, "failed to parse pool NVMe size")
 			}
@@ -220,11 +241,12 @@ func (cmd *PoolCreateCmd) Execute(args []string) error {
 
 		cmd.Infof("Creating DAOS pool with manual per-engine storage allocation: "+
 			"%s SCM, %s NVMe (%0.2f%% ratio)",
-			humanize.Bytes(scmBytes),
-			humanize.Bytes(nvmeBytes),
+			units.FormatBytes(scmBytes),
+			units.FormatBytes(nvmeBytes),
 			scmRatio*100)
 	}
 
+	req.Async = cmd.Async
 	resp, err := control.PoolCreate(context.Background(), cmd.ctlInvoker, req)
 
 	if cmd.jsonOutputEnabled() {
@@ -235,6 +257,12 @@ func (cmd *PoolCreateCmd) Execute(args []string) error {
 		return err
 	}
 
+	if resp.JobID != "" {
+		cmd.Infof("Pool %s create started in the background, check progress with "+
+			"\"dmg pool create-status %s\"", resp.UUID, resp.JobID)
+		return nil
+	}
+
 	var bld strings.Builder
 	if err := pretty.PrintPoolCreateResponse(resp, &bld); err != nil {
 		return err
@@ -268,14 +296,86 @@ func (cmd *PoolCreateCmd) updateRequest(req *control.PoolCreateReq,
 	return scmRatio
 }
 
+// PoolCreateStatusCmd is the struct representing the command to query or
+// cancel an asynchronous pool create.
+type PoolCreateStatusCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	Cancel   bool          `long:"cancel" description:"Cancel the create and roll back any pool storage already allocated"`
+	Wait     bool          `short:"w" long:"wait" description:"Wait for the create to finish, reporting progress"`
+	Interval time.Duration `short:"i" long:"interval" default:"2s" description:"Time between progress queries when waiting"`
+
+	Args struct {
+		JobID string `positional-arg-name:"<job ID>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when PoolCreateStatusCmd subcommand is activated
+func (cmd *PoolCreateStatusCmd) Execute(args []string) error {
+	if cmd.Interval <= 0 {
+		return errors.New("--interval must be greater than zero")
+	}
+
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	req := &control.PoolCreateStatusReq{
+		JobID:  cmd.Args.JobID,
+		Cancel: cmd.Cancel,
+	}
+
+	var lastStage string
+	for {
+		resp, err := control.PoolCreateStatus(ctx, cmd.ctlInvoker, req)
+		if err != nil || !cmd.Wait || resp.Finished() {
+			if cmd.jsonOutputEnabled() {
+				return cmd.outputJSON(resp, err)
+			}
+			if err != nil {
+				return err
+			}
+			return cmd.printStatus(resp)
+		}
+		// Only request cancellation once.
+		req.Cancel = false
+
+		if !cmd.jsonOutputEnabled() && resp.Stage != lastStage {
+			cmd.Infof("Pool %s create: %s", resp.PoolUUID, resp.Stage)
+			lastStage = resp.Stage
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(cmd.Interval):
+		}
+	}
+}
+
+func (cmd *PoolCreateStatusCmd) printStatus(resp *control.PoolCreateStatusResp) error {
+	var out strings.Builder
+	if err := pretty.PrintPoolCreateStatusResponse(&out, resp); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+
+	if resp.Finished() && resp.Error != "" {
+		return errors.Errorf("pool %s create %s", resp.PoolUUID, resp.State)
+	}
+	return nil
+}
+
 // PoolListCmd represents the command to fetch a list of all DAOS pools in the system.
 type PoolListCmd struct {
 	baseCmd
 	cfgCmd
 	ctlInvokerCmd
 	jsonOutputCmd
+	tableOutputCmd
 	Verbose bool `short:"v" long:"verbose" description:"Add pool UUIDs and service replica lists to display"`
 	NoQuery bool `short:"n" long:"no-query" description:"Disable query of listed pools"`
+	Health  bool `long:"health" description:"Probe pool services and flag any that are degraded"`
 }
 
 // Execute is run when PoolListCmd activates
@@ -288,8 +388,14 @@ func (cmd *PoolListCmd) Execute(_ []string) (errOut error) {
 		return errors.New("no configuration loaded")
 	}
 
+	printOpts, err := cmd.tableOutputOptions()
+	if err != nil {
+		return err
+	}
+
 	req := &control.ListPoolsReq{
 		NoQuery: cmd.NoQuery,
+		Health:  cmd.Health,
 	}
 
 	resp, err := control.ListPools(context.Background(), cmd.ctlInvoker, req)
@@ -302,7 +408,7 @@ func (cmd *PoolListCmd) Execute(_ []string) (errOut error) {
 	}
 
 	var out, outErr strings.Builder
-	if err := pretty.PrintListPoolsResponse(&out, &outErr, resp, cmd.Verbose); err != nil {
+	if err := pretty.PrintListPoolsResponse(&out, &outErr, resp, cmd.Verbose, printOpts...); err != nil {
 		return err
 	}
 	if outErr.String() != "" {
@@ -384,6 +490,46 @@ func (cmd *PoolEvictCmd) Execute(args []string) error {
 	return err
 }
 
+// PoolCleanupPartialCmd is the struct representing the command to destroy
+// pools left incomplete by a failed create or destroy.
+type PoolCleanupPartialCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	DryRun bool `long:"dry-run" description:"List the pools that would be cleaned up without destroying them"`
+}
+
+// Execute is run when PoolCleanupPartialCmd subcommand is activated
+func (cmd *PoolCleanupPartialCmd) Execute(args []string) error {
+	req := &control.PoolCleanupPartialReq{
+		DryRun: cmd.DryRun,
+	}
+
+	resp, err := control.PoolCleanupPartial(context.Background(), cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintPoolCleanupPartialResponse(&out, resp); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+
+	for _, pool := range resp.Pools {
+		if !pool.Removed && pool.Status != 0 {
+			return errors.New("not all partial pools were cleaned up")
+		}
+	}
+
+	return nil
+}
+
 // PoolExcludeCmd is the struct representing the command to exclude a DAOS target.
 type PoolExcludeCmd struct {
 	poolCmd
@@ -499,18 +645,46 @@ type PoolQueryCmd struct {
 	poolCmd
 	ShowEnabledRanks  bool `short:"e" long:"show-enabled" description:"Show engine unique identifiers (ranks) which are enabled"`
 	ShowDisabledRanks bool `short:"b" long:"show-disabled" description:"Show engine unique identifiers (ranks) which are disabled"`
+	ShowAggregation   bool `short:"a" long:"aggregation" description:"Show the status of background aggregation on the pool's targets"`
+}
+
+func (cmd *PoolQueryCmd) queryAggregation() error {
+	if cmd.ShowEnabledRanks || cmd.ShowDisabledRanks {
+		return errors.New("--aggregation may not be mixed with --show-enabled or --show-disabled")
+	}
+
+	req := &control.PoolQueryAggregationReq{
+		ID: cmd.PoolID().String(),
+	}
+
+	resp, err := control.PoolQueryAggregation(context.Background(), cmd.ctlInvoker, req)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool aggregation query failed")
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintPoolQueryAggregationResponse(resp, &bld); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+	return nil
 }
 
 // Execute is run when PoolQueryCmd subcommand is activated
 func (cmd *PoolQueryCmd) Execute(args []string) error {
+	if cmd.ShowAggregation {
+		return cmd.queryAggregation()
+	}
+
 	req := &control.PoolQueryReq{
 		ID: cmd.PoolID().String(),
 	}
 
-	// TODO (DAOS-10250) The two options should not be incompatible (i.e. engine limitation)
-	if cmd.ShowEnabledRanks && cmd.ShowDisabledRanks {
-		return errIncompatFlags("show-enabled-ranks", "show-disabled-ranks")
-	}
 	req.IncludeEnabledRanks = cmd.ShowEnabledRanks
 	req.IncludeDisabledRanks = cmd.ShowDisabledRanks
 
@@ -575,10 +749,26 @@ func (cmd *PoolQueryTargetsCmd) Execute(args []string) error {
 // PoolUpgradeCmd is the struct representing the command to update a DAOS pool.
 type PoolUpgradeCmd struct {
 	poolCmd
+	All         bool `short:"a" long:"all" description:"Upgrade all pools in the system"`
+	Parallelism uint `short:"p" long:"parallelism" default:"4" description:"Maximum number of pools to upgrade concurrently (with --all)"`
+}
+
+// poolUpgradeResult contains the result of upgrading a single pool.
+type poolUpgradeResult struct {
+	UUID  string `json:"uuid"`
+	Label string `json:"label"`
+	Error string `json:"error,omitempty"`
 }
 
 // Execute is run when PoolUpgradeCmd subcommand is activated
 func (cmd *PoolUpgradeCmd) Execute(args []string) error {
+	if cmd.All {
+		if !cmd.PoolID().Empty() {
+			return errors.New("pool name or UUID may not be supplied with --all")
+		}
+		return cmd.upgradeAll(context.Background())
+	}
+
 	req := &control.PoolUpgradeReq{
 		ID: cmd.PoolID().String(),
 	}
@@ -592,19 +782,199 @@ func (cmd *PoolUpgradeCmd) Execute(args []string) error {
 	return nil
 }
 
+// upgradeAll upgrades every pool in the system, running no more than the
+// requested number of upgrades concurrently, and summarizes the results.
+func (cmd *PoolUpgradeCmd) upgradeAll(ctx context.Context) error {
+	if cmd.Parallelism == 0 {
+		return errors.New("parallelism must be greater than zero")
+	}
+
+	listResp, err := control.ListPools(ctx, cmd.ctlInvoker, &control.ListPoolsReq{
+		NoQuery: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to list pools")
+	}
+
+	results := make([]*poolUpgradeResult, len(listResp.Pools))
+	sem := make(chan struct{}, cmd.Parallelism)
+	var wg sync.WaitGroup
+	for i, pool := range listResp.Pools {
+		results[i] = &poolUpgradeResult{
+			UUID:  pool.UUID,
+			Label: pool.Label,
+		}
+
+		wg.Add(1)
+		go func(result *poolUpgradeResult) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			cmd.Debugf("upgrading pool %s", result.UUID)
+			req := &control.PoolUpgradeReq{ID: result.UUID}
+			if err := control.PoolUpgrade(ctx, cmd.ctlInvoker, req); err != nil {
+				result.Error = err.Error()
+			}
+		}(results[i])
+	}
+	wg.Wait()
+
+	var numFailed int
+	for _, result := range results {
+		if result.Error != "" {
+			numFailed++
+		}
+	}
+	if numFailed > 0 {
+		err = errors.Errorf("%d of %d pool upgrades failed", numFailed, len(results))
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(results, err)
+	}
+
+	if len(results) == 0 {
+		cmd.Info("No pools in system")
+		return nil
+	}
+
+	var out strings.Builder
+	printPoolUpgradeResults(&out, results)
+	cmd.Infof("%s", out.String())
+
+	return err
+}
+
+func printPoolUpgradeResults(out io.Writer, results []*poolUpgradeResult) {
+	poolTitle := "Pool"
+	uuidTitle := "UUID"
+	resultTitle := "Result"
+	table := []txtfmt.TableRow{}
+	for _, result := range results {
+		row := txtfmt.TableRow{}
+		row[poolTitle] = result.Label
+		row[uuidTitle] = result.UUID
+		row[resultTitle] = "OK"
+		if result.Error != "" {
+			row[resultTitle] = result.Error
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(poolTitle, uuidTitle, resultTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
+
 // PoolSetPropCmd represents the command to set a property on a pool.
 type PoolSetPropCmd struct {
 	poolCmd
 	Property string `short:"n" long:"name" description:"Name of property to be set (deprecated; use positional argument)"`
 	Value    string `short:"v" long:"value" description:"Value of property to be set (deprecated; use positional argument)"`
+	FromFile string `short:"f" long:"from-file" description:"Set properties on one or more pools from a YAML file"`
 
 	Args struct {
 		Props PoolSetPropsFlag `positional-arg-name:"pool properties to set (key:val[,key:val...])"`
 	} `positional-args:"yes"`
 }
 
+// checkPoolSetProps returns an error if any of the supplied properties may
+// only be set at pool creation time.
+func checkPoolSetProps(props []*daos.PoolProperty) error {
+	for _, prop := range props {
+		if prop.Name == "rd_fac" {
+			return errors.New("can't set redundancy factor on existing pool.")
+		}
+		if prop.Name == "ec_pda" {
+			return errors.New("can't set EC performance domain affinity on existing pool.")
+		}
+		if prop.Name == "rp_pda" {
+			return errors.New("can't set RP performance domain affinity on existing pool.")
+		}
+	}
+
+	return nil
+}
+
+// poolSetPropResult contains the outcome of setting properties on a single
+// pool from a file.
+type poolSetPropResult struct {
+	Pool    string                    `json:"pool"`
+	Changes []*control.PoolPropChange `json:"changes"`
+	Error   string                    `json:"error,omitempty"`
+}
+
+// setPropsFromFile applies the properties in the file to each pool in turn.
+// All properties for a pool are set in a single request so that either all
+// or none are applied, and a failure for one pool does not prevent the
+// remaining pools from being updated.
+func (cmd *PoolSetPropCmd) setPropsFromFile() error {
+	if cmd.Property != "" || cmd.Value != "" || len(cmd.Args.Props.ToSet) > 0 {
+		return errors.New("cannot mix --from-file with other property arguments")
+	}
+
+	var poolID string
+	if !cmd.PoolID().Empty() {
+		poolID = cmd.PoolID().String()
+	}
+
+	poolProps, err := poolPropsFromFile(cmd.FromFile, poolID)
+	if err != nil {
+		return err
+	}
+
+	pools := make([]string, 0, len(poolProps))
+	for id := range poolProps {
+		pools = append(pools, id)
+	}
+	sort.Strings(pools)
+
+	var results []*poolSetPropResult
+	var failed int
+	for _, id := range pools {
+		req := &control.PoolSetPropReq{
+			ID:         id,
+			Properties: poolProps[id],
+		}
+
+		res := &poolSetPropResult{Pool: id}
+		res.Changes, err = control.PoolSetPropDiff(context.Background(), cmd.ctlInvoker, req)
+		if err != nil {
+			res.Error = err.Error()
+			failed++
+		}
+		results = append(results, res)
+	}
+
+	var resErr error
+	if failed > 0 {
+		resErr = errors.Errorf("pool set-prop failed for %d of %d pools", failed, len(pools))
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(results, resErr)
+	}
+
+	var bld strings.Builder
+	for _, res := range results {
+		if res.Error != "" {
+			fmt.Fprintf(&bld, "Pool %s: set-prop failed: %s\n", res.Pool, res.Error)
+			continue
+		}
+		pretty.PrintPoolPropChanges(res.Pool, &bld, res.Changes...)
+	}
+	cmd.Infof("%s", bld.String())
+
+	return resErr
+}
+
 // Execute is run when PoolSetPropCmd subcommand is activatecmd.
 func (cmd *PoolSetPropCmd) Execute(_ []string) error {
+	if cmd.FromFile != "" {
+		return cmd.setPropsFromFile()
+	}
+
 	// TODO (DAOS-7964): Remove support for --name/--value flags.
 	if cmd.Property != "" || cmd.Value != "" {
 		if len(cmd.Args.Props.ToSet) > 0 {
@@ -625,16 +995,8 @@ func (cmd *PoolSetPropCmd) Execute(_ []string) error {
 		cmd.Args.Props.ToSet = []*daos.PoolProperty{p}
 	}
 
-	for _, prop := range cmd.Args.Props.ToSet {
-		if prop.Name == "rd_fac" {
-			return errors.New("can't set redundancy factor on existing pool.")
-		}
-		if prop.Name == "ec_pda" {
-			return errors.New("can't set EC performance domain affinity on existing pool.")
-		}
-		if prop.Name == "rp_pda" {
-			return errors.New("can't set RP performance domain affinity on existing pool.")
-		}
+	if err := checkPoolSetProps(cmd.Args.Props.ToSet); err != nil {
+		return err
 	}
 
 	req := &control.PoolSetPropReq{
@@ -655,6 +1017,52 @@ func (cmd *PoolSetPropCmd) Execute(_ []string) error {
 	return nil
 }
 
+// poolPolicyCmd is the struct representing the commands to tune the scheduling
+// of engine background tasks for a pool.
+type poolPolicyCmd struct {
+	Set PoolSetPolicyCmd `command:"set" description:"Set runtime hints for pool aggregation, rebuild and scrubbing"`
+}
+
+// PoolSetPolicyCmd represents the command to set runtime hints for the
+// scheduling of background tasks on a pool's targets.
+type PoolSetPolicyCmd struct {
+	poolCmd
+	RankList        ui.RankSetFlag `short:"r" long:"ranks" description:"Only apply hints to targets on these ranks (default: all pool ranks)"`
+	Aggregation     string         `short:"a" long:"aggregation" choice:"enabled" choice:"disabled" description:"Pause or resume aggregation"`
+	RebuildThrottle uint32         `short:"t" long:"rebuild-throttle" description:"Percentage of engine time available to rebuild (1-100)"`
+	ScrubRate       uint32         `short:"s" long:"scrub-rate" description:"Percentage of the configured scrubbing rate to use (1-100)"`
+	Clear           bool           `short:"c" long:"clear" description:"Clear previously set hints before applying any new ones"`
+}
+
+// Execute is run when PoolSetPolicyCmd subcommand is activated
+func (cmd *PoolSetPolicyCmd) Execute(_ []string) error {
+	req := &control.PoolSetPolicyReq{
+		ID:              cmd.PoolID().String(),
+		Ranks:           cmd.RankList.Ranks(),
+		RebuildThrottle: cmd.RebuildThrottle,
+		ScrubRate:       cmd.ScrubRate,
+		Clear:           cmd.Clear,
+	}
+	switch cmd.Aggregation {
+	case "enabled":
+		req.Aggregation = control.PoolAggregationEnabled
+	case "disabled":
+		req.Aggregation = control.PoolAggregationDisabled
+	}
+
+	err := control.PoolSetPolicy(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool set-policy failed")
+	}
+	cmd.Info("pool set-policy succeeded")
+
+	return nil
+}
+
 // PoolGetPropCmd represents the command to set a property on a pool.
 type PoolGetPropCmd struct {
 	poolCmd
@@ -686,6 +1094,84 @@ func (cmd *PoolGetPropCmd) Execute(_ []string) error {
 	return nil
 }
 
+// PoolSetAdminsCmd represents the command to set the principals that have been
+// delegated the administration of a DAOS pool.
+type PoolSetAdminsCmd struct {
+	poolCmd
+	Admins string `short:"a" long:"admins" description:"Comma-separated list of principals that may administer the pool (empty to remove all)"`
+}
+
+// Execute is run when the PoolSetAdminsCmd subcommand is activated.
+func (cmd *PoolSetAdminsCmd) Execute(_ []string) error {
+	req := &control.PoolSetAdminsReq{
+		ID: cmd.PoolID().String(),
+	}
+	for _, admin := range strings.Split(cmd.Admins, ",") {
+		if admin = strings.TrimSpace(admin); admin != "" {
+			req.Admins = append(req.Admins, admin)
+		}
+	}
+
+	resp, err := control.PoolSetAdmins(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool set-admins failed")
+	}
+
+	if len(resp.Admins) == 0 {
+		cmd.Infof("Pool %s has no delegated administrators", cmd.PoolID())
+		return nil
+	}
+	cmd.Infof("Pool %s administrators: %s", cmd.PoolID(), strings.Join(resp.Admins, ", "))
+
+	return nil
+}
+
+// PoolAnnotateCmd represents the command to set or remove an annotation on a
+// DAOS pool.
+type PoolAnnotateCmd struct {
+	poolCmd
+	Remove bool `long:"remove" description:"Remove the annotation instead of setting it"`
+
+	Args struct {
+		Key   string `positional-arg-name:"key" required:"1"`
+		Value string `positional-arg-name:"value"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when the PoolAnnotateCmd subcommand is activated.
+func (cmd *PoolAnnotateCmd) Execute(_ []string) error {
+	notes, err := annotationUpdate(cmd.Args.Key, cmd.Args.Value, cmd.Remove)
+	if err != nil {
+		return err
+	}
+
+	req := &control.PoolAnnotateReq{
+		ID:          cmd.PoolID().String(),
+		Annotations: notes,
+	}
+
+	resp, err := control.PoolAnnotate(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool annotate failed")
+	}
+
+	if len(resp.Annotations) == 0 {
+		cmd.Infof("Pool %s has no annotations", cmd.PoolID())
+		return nil
+	}
+	cmd.Infof("Pool %s annotations: %s", cmd.PoolID(), system.FormatAnnotations(resp.Annotations))
+
+	return nil
+}
+
 // PoolGetACLCmd represents the command to fetch an Access Control List of a
 // DAOS pool.
 type PoolGetACLCmd struct {
@@ -862,3 +1348,81 @@ func (cmd *PoolDeleteACLCmd) Execute(args []string) error {
 
 	return nil
 }
+
+// PoolDebugCmd is the struct representing the command to run the offline VOS
+// debugger against the shards of a pool held by a stopped engine.
+type PoolDebugCmd struct {
+	poolCmd
+	hostListCmd
+	Rank      uint32 `long:"rank" required:"1" description:"Rank of the stopped engine holding the pool shards"`
+	Targetidx string `long:"target-idx" description:"Comma-separated list of target idx(s) to debug (default all)"`
+	Command   string `short:"c" long:"command" required:"1" description:"ddb command to run against each pool shard"`
+	Write     bool   `short:"w" long:"write" description:"Open the pool shards for writing"`
+}
+
+// Execute is run when PoolDebugCmd subcommand is activated
+func (cmd *PoolDebugCmd) Execute(args []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "pool debug failed")
+	}()
+
+	var idxlist []uint32
+	if err := common.ParseNumberList(cmd.Targetidx, &idxlist); err != nil {
+		return errors.WithMessage(err, "parsing target list")
+	}
+
+	req := &control.PoolDebugReq{
+		ID:        cmd.PoolID().String(),
+		Rank:      ranklist.Rank(cmd.Rank),
+		Targets:   idxlist,
+		Command:   cmd.Command,
+		WriteMode: cmd.Write,
+	}
+	req.SetHostList(cmd.hostlist)
+
+	// Stopping the command also stops the debugger on the server.
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	// Output is printed as it arrives, or gathered per target to be output
+	// together if JSON output has been requested.
+	var results []*control.PoolDebugResp
+	var failed int
+	err := control.PoolDebug(ctx, cmd.ctlInvoker, req, func(resp *control.PoolDebugResp) error {
+		if len(results) == 0 || results[len(results)-1].Done {
+			results = append(results, &control.PoolDebugResp{
+				Host:   resp.Host,
+				Target: resp.Target,
+				Path:   resp.Path,
+			})
+			if !cmd.jsonOutputEnabled() {
+				cmd.Infof("rank %d target %d (%s:%s):", cmd.Rank, resp.Target, resp.Host, resp.Path)
+			}
+		}
+		result := results[len(results)-1]
+		result.Lines = append(result.Lines, resp.Lines...)
+		result.Done = resp.Done
+		result.ExitCode = resp.ExitCode
+		if resp.Done && resp.ExitCode != 0 {
+			failed++
+		}
+
+		if !cmd.jsonOutputEnabled() {
+			if len(resp.Lines) > 0 {
+				cmd.Info(strings.Join(resp.Lines, "\n"))
+			}
+			if resp.Done && resp.ExitCode != 0 {
+				cmd.Errorf("ddb exited with status %d on target %d", resp.ExitCode, resp.Target)
+			}
+		}
+		return nil
+	})
+	if err == nil && failed > 0 {
+		err = errors.Errorf("ddb failed on %d target(s)", failed)
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(results, err)
+	}
+	return err
+}
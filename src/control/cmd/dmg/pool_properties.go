@@ -7,7 +7,13 @@
 package main
 
 import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
 	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ui"
@@ -86,3 +92,85 @@ func (f *PoolGetPropsFlag) Complete(match string) []flags.Completion {
 
 	return f.GetPropertiesFlag.Complete(match)
 }
+
+// poolPropsFile describes the format of a file containing pool properties to
+// be set. Properties in the top-level section are applied to every pool and
+// may be overridden by properties in a pool's own section.
+//
+//	properties:
+//	  reclaim: lazy
+//	pools:
+//	  tank:
+//	    space_rb: 5
+//	  scratch:
+type poolPropsFile struct {
+	Properties map[string]interface{}            `yaml:"properties"`
+	Pools      map[string]map[string]interface{} `yaml:"pools"`
+}
+
+// poolPropsFromFile parses the named file and returns the validated set of
+// properties to be applied to each pool, keyed by pool label or UUID. If
+// poolID is not empty, only properties for that pool are returned.
+func poolPropsFromFile(path, poolID string) (map[string][]*daos.PoolProperty, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading pool properties file")
+	}
+
+	var ppf poolPropsFile
+	if err := yaml.UnmarshalStrict(data, &ppf); err != nil {
+		return nil, errors.Wrapf(err, "parsing pool properties file %q", path)
+	}
+
+	poolProps := make(map[string]map[string]interface{})
+	if poolID != "" {
+		poolProps[poolID] = ppf.Pools[poolID]
+	} else {
+		for id, props := range ppf.Pools {
+			poolProps[id] = props
+		}
+	}
+	if len(poolProps) == 0 {
+		return nil, errors.Errorf("no pools specified in %q or on the command line", path)
+	}
+
+	propHdlrs := daos.PoolProperties()
+	deprecated := daos.PoolDeprecatedProperties()
+	toSet := make(map[string][]*daos.PoolProperty)
+	for id, props := range poolProps {
+		merged := make(map[string]string)
+		for _, section := range []map[string]interface{}{ppf.Properties, props} {
+			for key, val := range section {
+				if newKey, found := deprecated[key]; found {
+					key = newKey
+				}
+				merged[key] = fmt.Sprint(val)
+			}
+		}
+		if len(merged) == 0 {
+			return nil, errors.Errorf("pool %s: no properties to set", id)
+		}
+
+		keys := make([]string, 0, len(merged))
+		for key := range merged {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			p, err := propHdlrs.GetProperty(key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "pool %s", id)
+			}
+			if err := p.SetValue(merged[key]); err != nil {
+				return nil, errors.Wrapf(err, "pool %s", id)
+			}
+			toSet[id] = append(toSet[id], p)
+		}
+		if err := checkPoolSetProps(toSet[id]); err != nil {
+			return nil, errors.Wrapf(err, "pool %s", id)
+		}
+	}
+
+	return toSet, nil
+}
@@ -74,6 +74,22 @@ func TestPoolCommands(t *testing.T) {
 
 	testEmptyFile := test.CreateTestFile(t, tmpDir, "")
 
+	testPropsFile := test.CreateTestFile(t, tmpDir, `properties:
+  policy: type=io_size
+pools:
+  pool1:
+    label: newlabel
+  pool2:
+`)
+	testBadPropsFile := test.CreateTestFile(t, tmpDir, `properties:
+  rd_fac: 1
+pools:
+  pool1:
+`)
+	testNoPoolsPropsFile := test.CreateTestFile(t, tmpDir, `properties:
+  reclaim: lazy
+`)
+
 	// Subdirectory with no write perms
 	testNoPermDir := filepath.Join(tmpDir, "badpermsdir")
 	if err := os.Mkdir(testNoPermDir, 0444); err != nil {
@@ -133,6 +149,24 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool asynchronously",
+			fmt.Sprintf("pool create --size %s --async foo", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateReq{
+					TotalBytes: uint64(testSize),
+					TierRatio:  []float64{0.06, 0.94},
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					Ranks:      []ranklist.Rank{},
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "foo"),
+					},
+					Async: true,
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Create pool with label flag",
 			fmt.Sprintf("pool create --size %s --label foo", testSizeStr),
@@ -241,6 +275,12 @@ func TestPoolCommands(t *testing.T) {
 			"",
 			errors.New("may not be mixed"),
 		},
+		{
+			"Create pool with incompatible arguments (manual tier-ratio)",
+			fmt.Sprintf("pool create --scm-size %s --tier-ratio 10,90", testSizeStr),
+			"",
+			errors.New("--tier-ratio may not be mixed with --scm-size"),
+		},
 		{
 			"Create pool with minimal arguments",
 			fmt.Sprintf("pool create --scm-size %s --nsvc 3", testSizeStr),
@@ -255,6 +295,32 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool with IEC unit sizes",
+			"pool create --scm-size 2GiB --nvme-size 1TiB --nsvc 3",
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateReq{
+					NumSvcReps: 3,
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					Ranks:      []ranklist.Rank{},
+					TierBytes:  []uint64{2 * humanize.GiByte, humanize.TiByte},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with ambiguous SCM size unit",
+			"pool create --scm-size 2Gb",
+			"",
+			errors.New("ambiguous unit"),
+		},
+		{
+			"Create pool with ambiguous total size unit",
+			"pool create --size 2mb",
+			"",
+			errors.New("ambiguous unit"),
+		},
 		{
 			"Create pool with manual ranks",
 			fmt.Sprintf("pool create --size %s --ranks 1,2", testSizeStr),
@@ -338,6 +404,35 @@ func TestPoolCommands(t *testing.T) {
 			"",
 			dmgTestErr(fmt.Sprintf("ACL file '%s' contains no entries", testEmptyFile)),
 		},
+		{
+			"Create pool with ACL file and ACL template",
+			fmt.Sprintf("pool create --scm-size %s --acl-file %s --acl-template private", testSizeStr, testACLFile),
+			"",
+			errors.New("may not be mixed"),
+		},
+		{
+			"Create pool with invalid ACL template",
+			fmt.Sprintf("pool create --scm-size %s --acl-template public", testSizeStr),
+			"",
+			errors.New("Invalid value"),
+		},
+		{
+			"Create pool with ACL template",
+			fmt.Sprintf("pool create --scm-size %s --acl-template private", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateReq{
+					User:        eUsr.Username + "@",
+					UserGroup:   eGrp.Name + "@",
+					Ranks:       []ranklist.Rank{},
+					TierBytes:   []uint64{uint64(testSize), 0},
+					ACLTemplate: "private",
+					ACL: &control.AccessControlList{
+						Entries: []string{"A::OWNER@:rw"},
+					},
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Create pool with scrubbing",
 			fmt.Sprintf("pool create --scm-size %s --properties=scrub:timed,scrub-freq:1", testSizeStr),
@@ -379,6 +474,49 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Debug pool shards on all targets",
+			"-l host1 pool debug 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 1 -c ls",
+			printRequest(t, func() *control.PoolDebugReq {
+				req := &control.PoolDebugReq{
+					ID:      "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Rank:    1,
+					Targets: []uint32{},
+					Command: "ls",
+				}
+				req.SetHostList([]string{"host1"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"Debug pool shards on selected targets for writing",
+			"-l host1 pool debug 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 1 --target-idx 0,2 -w -c dump_superblock",
+			printRequest(t, func() *control.PoolDebugReq {
+				req := &control.PoolDebugReq{
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Rank:      1,
+					Targets:   []uint32{0, 2},
+					Command:   "dump_superblock",
+					WriteMode: true,
+				}
+				req.SetHostList([]string{"host1"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"Debug pool shards on rank not in system",
+			"pool debug 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 1 -c ls",
+			"",
+			errors.New("rank 1 not found in system"),
+		},
+		{
+			"Debug pool shards without command",
+			"pool debug 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 1",
+			"",
+			errors.New("--command"),
+		},
 		{
 			"Exclude a target with no idx given",
 			"pool exclude 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 0",
@@ -524,6 +662,57 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Query pool create job",
+			"pool create-status " + test.MockUUID(9),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateStatusReq{
+					JobID: test.MockUUID(9),
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Cancel pool create job",
+			"pool create-status --cancel " + test.MockUUID(9),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateStatusReq{
+					JobID:  test.MockUUID(9),
+					Cancel: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query pool create job without job ID",
+			"pool create-status",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Query pool create job with bad interval",
+			"pool create-status --wait --interval 0s " + test.MockUUID(9),
+			"",
+			errors.New("--interval"),
+		},
+		{
+			"Clean up partial pools",
+			"pool cleanup-partial",
+			strings.Join([]string{
+				printRequest(t, &control.PoolCleanupPartialReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"Clean up partial pools dry run",
+			"pool cleanup-partial --dry-run",
+			strings.Join([]string{
+				printRequest(t, &control.PoolCleanupPartialReq{
+					DryRun: true,
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"List pools",
 			"pool list",
@@ -540,6 +729,30 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"List pools with health flag",
+			"pool list --health",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{
+					Health: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"List pools with csv format and selected columns",
+			"pool list --format csv --columns label,state",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"List pools with unknown format",
+			"pool list --format xml",
+			"",
+			errors.New("Invalid value `xml'"),
+		},
 		{
 			"Set pool properties",
 			"pool set-prop 031bcaf8-f0f5-42ef-b3c5-ee048676dceb label:foo,space_rb:42",
@@ -627,6 +840,84 @@ func TestPoolCommands(t *testing.T) {
 			"",
 			errors.New("can't set RP performance domain affinity on existing pool"),
 		},
+		{
+			"Set pool properties from file",
+			"pool set-prop --from-file " + testPropsFile,
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetPropReq{
+					ID: "pool1",
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", ""),
+						propWithVal("policy", ""),
+					},
+				}),
+				printRequest(t, &control.PoolSetPropReq{
+					ID: "pool1",
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "newlabel"),
+						propWithVal("policy", "type=io_size"),
+					},
+				}),
+				printRequest(t, &control.PoolGetPropReq{
+					ID: "pool2",
+					Properties: []*daos.PoolProperty{
+						propWithVal("policy", ""),
+					},
+				}),
+				printRequest(t, &control.PoolSetPropReq{
+					ID: "pool2",
+					Properties: []*daos.PoolProperty{
+						propWithVal("policy", "type=io_size"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool properties from file for a single pool",
+			"pool set-prop pool1 -f " + testPropsFile,
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetPropReq{
+					ID: "pool1",
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", ""),
+						propWithVal("policy", ""),
+					},
+				}),
+				printRequest(t, &control.PoolSetPropReq{
+					ID: "pool1",
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "newlabel"),
+						propWithVal("policy", "type=io_size"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool properties from file mixed with positional properties",
+			"pool set-prop pool1 --from-file " + testPropsFile + " label:foo",
+			"",
+			errors.New("cannot mix"),
+		},
+		{
+			"Set pool properties from file with property not settable on existing pool",
+			"pool set-prop --from-file " + testBadPropsFile,
+			"",
+			errors.New("pool pool1: can't set redundancy factor"),
+		},
+		{
+			"Set pool properties from file with no pools",
+			"pool set-prop --from-file " + testNoPoolsPropsFile,
+			"",
+			errors.New("no pools specified"),
+		},
+		{
+			"Set pool properties from missing file",
+			"pool set-prop --from-file " + filepath.Join(tmpDir, "missing.yaml"),
+			"",
+			errors.New("no such file"),
+		},
 		{
 			"Get pool property",
 			"pool get-prop 031bcaf8-f0f5-42ef-b3c5-ee048676dceb label",
@@ -640,6 +931,49 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Set pool admins",
+			"pool set-admins 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --admins alice,bob",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetAdminsReq{
+					ID:     "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Admins: []string{"alice", "bob"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Clear pool admins",
+			"pool set-admins 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetAdminsReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Annotate pool",
+			"pool annotate 031bcaf8-f0f5-42ef-b3c5-ee048676dceb owner alice@2024-06-01",
+			strings.Join([]string{
+				printRequest(t, &control.PoolAnnotateReq{
+					ID:          "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Annotations: map[string]string{"owner": "alice@2024-06-01"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Remove pool annotation",
+			"pool annotate 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --remove owner",
+			strings.Join([]string{
+				printRequest(t, &control.PoolAnnotateReq{
+					ID:          "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Annotations: map[string]string{"owner": ""},
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Get pool ACL",
 			"pool get-acl 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
@@ -850,6 +1184,34 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Query pool aggregation status",
+			"pool query --aggregation 12345678-1234-1234-1234-1234567890ab",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryAggregationReq{
+					ID: "12345678-1234-1234-1234-1234567890ab",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query pool aggregation status with enabled ranks",
+			"pool query -a -e 12345678-1234-1234-1234-1234567890ab",
+			"",
+			errors.New("may not be mixed"),
+		},
+		{
+			"Query pool with UUID and enabled and disabled ranks",
+			"pool query -e -b 12345678-1234-1234-1234-1234567890ab",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID:                   "12345678-1234-1234-1234-1234567890ab",
+					IncludeEnabledRanks:  true,
+					IncludeDisabledRanks: true,
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Query pool with Label",
 			"pool query test_label",
@@ -866,6 +1228,51 @@ func TestPoolCommands(t *testing.T) {
 			"",
 			fmt.Errorf("invalid label"),
 		},
+		{
+			"Set pool policy",
+			"pool policy set 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --aggregation disabled --rebuild-throttle 25 --scrub-rate 10",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetPolicyReq{
+					ID:              "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Ranks:           []ranklist.Rank{},
+					Aggregation:     control.PoolAggregationDisabled,
+					RebuildThrottle: 25,
+					ScrubRate:       10,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool policy on ranks",
+			"pool policy set mypool --ranks 0-1 --clear --aggregation enabled",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetPolicyReq{
+					ID:          "mypool",
+					Ranks:       []ranklist.Rank{0, 1},
+					Aggregation: control.PoolAggregationEnabled,
+					Clear:       true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool policy with invalid aggregation hint",
+			"pool policy set mypool --aggregation paused",
+			"",
+			errors.New("Invalid value"),
+		},
+		{
+			"Set pool policy with no changes",
+			"pool policy set mypool",
+			"",
+			errors.New("no policy changes"),
+		},
+		{
+			"Set pool policy with invalid rebuild throttle",
+			"pool policy set mypool --rebuild-throttle 150",
+			"",
+			errors.New("invalid rebuild throttle"),
+		},
 		{
 			"Upgrade pool with pool ID",
 			"pool upgrade 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
@@ -877,16 +1284,32 @@ func TestPoolCommands(t *testing.T) {
 			nil,
 		},
 		{
-			"Nonexistent subcommand",
-			"pool quack",
+			"Upgrade all pools",
+			"pool upgrade --all",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{
+					NoQuery: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Upgrade all pools with pool ID",
+			"pool upgrade --all 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
 			"",
-			fmt.Errorf("Unknown command"),
+			errors.New("may not be supplied with --all"),
 		},
 		{
-			"Query pool with incompatible arguments",
-			"pool query --show-disabled --show-enabled 12345678-1234-1234-1234-1234567890ab",
+			"Upgrade all pools with zero parallelism",
+			"pool upgrade --all --parallelism 0",
 			"",
-			errors.New("may not be mixed"),
+			errors.New("parallelism must be greater than zero"),
+		},
+		{
+			"Nonexistent subcommand",
+			"pool quack",
+			"",
+			fmt.Errorf("Unknown command"),
 		},
 	})
 }
@@ -939,6 +1362,8 @@ func TestDmg_PoolListCmd_Errors(t *testing.T) {
 	for name, tc := range map[string]struct {
 		ctlCfg    *control.Config
 		listResp  *mgmtpb.ListPoolsResp
+		health    bool
+		probeResp *mgmtpb.PoolProbeResp
 		queryResp *mgmtpb.PoolQueryResp
 		msErr     error
 		expErr    error
@@ -988,6 +1413,32 @@ func TestDmg_PoolListCmd_Errors(t *testing.T) {
 			},
 			expErr: errors.New("Query on pool \"00000001\" unsuccessful, status: \"DER_UNINIT"),
 		},
+		"list pools health degraded": {
+			ctlCfg: &control.Config{},
+			listResp: &mgmtpb.ListPoolsResp{
+				Pools: []*mgmtpb.ListPoolsResp_Pool{
+					{
+						Uuid:    test.MockUUID(1),
+						SvcReps: []uint32{1, 3},
+						State:   system.PoolServiceStateReady.String(),
+					},
+				},
+			},
+			health: true,
+			probeResp: &mgmtpb.PoolProbeResp{
+				Uuid:   test.MockUUID(1),
+				Health: system.PoolServiceHealthDegraded,
+				Leader: 1,
+				Replicas: []*mgmtpb.PoolProbeResp_Replica{
+					{Rank: 1, State: system.PoolReplicaStateLeader},
+					{Rank: 3, State: system.PoolReplicaStateDown},
+				},
+			},
+			queryResp: &mgmtpb.PoolQueryResp{
+				Uuid:      test.MockUUID(1),
+				TierStats: []*mgmtpb.StorageUsageStats{{}},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -996,6 +1447,10 @@ func TestDmg_PoolListCmd_Errors(t *testing.T) {
 			responses := []*control.UnaryResponse{
 				control.MockMSResponse("10.0.0.1:10001", tc.msErr, tc.listResp),
 			}
+			if tc.probeResp != nil {
+				responses = append(responses,
+					control.MockMSResponse("10.0.0.1:10001", tc.msErr, tc.probeResp))
+			}
 			if tc.queryResp != nil {
 				responses = append(responses,
 					control.MockMSResponse("10.0.0.1:10001", tc.msErr, tc.queryResp))
@@ -1009,6 +1464,7 @@ func TestDmg_PoolListCmd_Errors(t *testing.T) {
 			PoolListCmd.setInvoker(mi)
 			PoolListCmd.SetLog(log)
 			PoolListCmd.setConfig(tc.ctlCfg)
+			PoolListCmd.Health = tc.health
 
 			gotErr := PoolListCmd.Execute(nil)
 			test.CmpErr(t, tc.expErr, gotErr)
@@ -1016,6 +1472,73 @@ func TestDmg_PoolListCmd_Errors(t *testing.T) {
 	}
 }
 
+func TestDmg_PoolUpgradeCmd_All(t *testing.T) {
+	listResp := &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{Uuid: test.MockUUID(1), Label: "pool1"},
+			{Uuid: test.MockUUID(2), Label: "pool2"},
+			{Uuid: test.MockUUID(3), Label: "pool3"},
+		},
+	}
+	upgradeOK := control.MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.PoolUpgradeResp{})
+	upgradeFail := control.MockMSResponse("10.0.0.1:10001", nil,
+		&mgmtpb.PoolUpgradeResp{Status: int32(daos.Busy)})
+
+	for name, tc := range map[string]struct {
+		parallelism uint
+		responses   []*control.UnaryResponse
+		expErr      error
+	}{
+		"list pools fails": {
+			parallelism: 1,
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("10.0.0.1:10001", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"no pools": {
+			parallelism: 4,
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.ListPoolsResp{}),
+			},
+		},
+		"all succeed": {
+			parallelism: 2,
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("10.0.0.1:10001", nil, listResp),
+				upgradeOK, upgradeOK, upgradeOK,
+			},
+		},
+		"some fail": {
+			parallelism: 1,
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("10.0.0.1:10001", nil, listResp),
+				upgradeFail, upgradeOK, upgradeFail,
+			},
+			expErr: errors.New("2 of 3 pool upgrades failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+			})
+
+			cmd := &PoolUpgradeCmd{
+				All:         true,
+				Parallelism: tc.parallelism,
+			}
+			cmd.setInvoker(mi)
+			cmd.SetLog(log)
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
 type MockRequestsRecorderInvoker struct {
 	control.MockInvoker
 	Requests []control.UnaryRequest
@@ -0,0 +1,60 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// PrintContCheckResponse generates a human-readable representation of the
+// supplied ContCheckResp struct and writes it to the supplied io.Writer.
+func PrintContCheckResponse(out io.Writer, resp *control.ContCheckResp, poolID string) error {
+	if resp == nil {
+		return errors.New("nil response")
+	}
+
+	if len(resp.Orphans) == 0 {
+		fmt.Fprintf(out, "No orphaned container metadata found in pool %s\n", poolID)
+		return nil
+	}
+
+	uuidTitle := "Container UUID"
+	labelTitle := "Label"
+	reasonTitle := "Reason"
+	statusTitle := "Status"
+
+	formatter := txtfmt.NewTableFormatter(uuidTitle, labelTitle, reasonTitle, statusTitle)
+	var table []txtfmt.TableRow
+	for _, orphan := range resp.Orphans {
+		label := orphan.Label
+		if label == "" {
+			label = "-"
+		}
+		status := "orphaned"
+		if orphan.Removed {
+			status = "removed"
+		}
+
+		table = append(table, txtfmt.TableRow{
+			uuidTitle:   orphan.UUID,
+			labelTitle:  label,
+			reasonTitle: orphan.Reason,
+			statusTitle: status,
+		})
+	}
+
+	fmt.Fprintf(out, "Orphaned container metadata found in pool %s\n", poolID)
+	fmt.Fprintln(out, formatter.Format(table))
+
+	return nil
+}
@@ -0,0 +1,74 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintContCheckResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.ContCheckResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil response"),
+		},
+		"no orphans": {
+			resp: &control.ContCheckResp{},
+			expPrintStr: `
+No orphaned container metadata found in pool pool1
+`,
+		},
+		"orphans": {
+			resp: &control.ContCheckResp{
+				Orphans: []*control.ContOrphan{
+					{
+						UUID:   test.MockUUID(1),
+						Reason: "not in container table",
+					},
+					{
+						UUID:    test.MockUUID(2),
+						Label:   "old-cont",
+						Reason:  "destroy did not complete",
+						Removed: true,
+					},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Orphaned container metadata found in pool pool1
+Container UUID                       Label    Reason                   Status   
+--------------                       -----    ------                   ------   
+%s -        not in container table   orphaned 
+%s old-cont destroy did not complete removed  
+
+`, test.MockUUID(1), test.MockUUID(2)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintContCheckResponse(&bld, tc.resp, "pool1")
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -13,17 +13,18 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/dustin/go-humanize"
 	"github.com/dustin/go-humanize/english"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/lib/units"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
 const (
 	scmUpdateSuccess = "Success - The new firmware was staged. A power cycle is required to apply."
+	scmUpdateStaged  = "Success - Staged firmware version "
 	scmNotFound      = "No SCM devices detected"
 	scmDevTitle      = "Device:PhyID:Socket:Ctrl:Chan:Pos"
 	scmSectionHeader = "SCM Device Firmware"
@@ -180,7 +181,7 @@ func getSCMFirmwareInfoString(info *storage.ScmFirmwareInfo) string {
 	var b strings.Builder
 	fmt.Fprintf(&b, "Active Version: %s\n", getPrintVersion(info.ActiveVersion))
 	fmt.Fprintf(&b, "Staged Version: %s\n", getPrintVersion(info.StagedVersion))
-	fmt.Fprintf(&b, "Maximum Firmware Image Size: %s\n", humanize.IBytes(uint64(info.ImageMaxSizeBytes)))
+	fmt.Fprintf(&b, "Maximum Firmware Image Size: %s\n", units.FormatIBytes(uint64(info.ImageMaxSizeBytes)))
 	fmt.Fprintf(&b, "Last Update Status: %s", info.UpdateStatus)
 	return b.String()
 }
@@ -326,8 +327,13 @@ func PrintSCMFirmwareUpdateMap(fwMap control.HostSCMUpdateMap, out io.Writer,
 
 	return printCondensedResults(successes, out, opts,
 		func(result string, set *hostDeviceSet, _ []PrintConfigOption, w io.Writer) {
-			fmt.Fprintf(w, "Firmware staged on %s. A power cycle is required to apply the update.\n",
-				english.Plural(len(set.Devices), "device", "devices"))
+			devices := english.Plural(len(set.Devices), "device", "devices")
+			if version := strings.TrimPrefix(result, scmUpdateStaged); version != result {
+				fmt.Fprintf(w, "Firmware version %s staged on %s. A power cycle is required to apply the update.\n",
+					version, devices)
+				return
+			}
+			fmt.Fprintf(w, "Firmware staged on %s. A power cycle is required to apply the update.\n", devices)
 		})
 }
 
@@ -347,7 +353,11 @@ func condenseSCMUpdateMap(fwMap control.HostSCMUpdateMap) (hostDeviceResultMap,
 		for _, devRes := range results {
 			devID := getShortSCMString(devRes.Module)
 			if devRes.Error == nil {
-				err := successes.AddHostDevice(scmUpdateSuccess, host, devID)
+				result := scmUpdateSuccess
+				if devRes.StagedVersion != "" {
+					result = scmUpdateStaged + devRes.StagedVersion
+				}
+				err := successes.AddHostDevice(result, host, devID)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -392,6 +402,9 @@ func PrintSCMFirmwareUpdateMapVerbose(fwMap control.HostSCMUpdateMap, out io.Wri
 			}
 
 			fmt.Fprintf(iw2, "%s\n", scmUpdateSuccess)
+			if res.StagedVersion != "" {
+				fmt.Fprintf(iw2, "Staged Version: %s\n", res.StagedVersion)
+			}
 		}
 	}
 
@@ -617,6 +617,33 @@ host1
     Error: test error
   UID:Device3 PhysicalID:3 Capacity:66 KiB Location:(socket:1 memctrlr:2 chan:2 pos:1)
     Success - The new firmware was staged. A power cycle is required to apply.
+`,
+		},
+		"staged version": {
+			fwMap: control.HostSCMUpdateMap{
+				"host1": []*control.SCMUpdateResult{
+					{
+						Module: storage.ScmModule{
+							UID:             "Device1",
+							PhysicalID:      1,
+							Capacity:        12345,
+							SocketID:        1,
+							ControllerID:    2,
+							ChannelID:       3,
+							ChannelPosition: 5,
+						},
+						StagedVersion: "1.0.0.2",
+						UpdateStatus:  storage.ScmUpdateStatusStaged,
+					},
+				},
+			},
+			expPrintStr: `
+-----
+host1
+-----
+  UID:Device1 PhysicalID:1 Capacity:12 KiB Location:(socket:1 memctrlr:2 chan:3 pos:5)
+    Success - The new firmware was staged. A power cycle is required to apply.
+    Staged Version: 1.0.0.2
 `,
 		},
 		"multiple hosts": {
@@ -856,6 +883,46 @@ host[1-2]
 host[1-2]
 ---------
   Firmware staged on 3 devices. A power cycle is required to apply the update.
+`,
+		},
+		"staged version": {
+			fwMap: control.HostSCMUpdateMap{
+				"host1": []*control.SCMUpdateResult{
+					{
+						Module: storage.ScmModule{
+							UID:             "Device1",
+							PhysicalID:      1,
+							Capacity:        (1 << 31),
+							SocketID:        1,
+							ControllerID:    2,
+							ChannelID:       3,
+							ChannelPosition: 5,
+						},
+						StagedVersion: "1.0.0.2",
+						UpdateStatus:  storage.ScmUpdateStatusStaged,
+					},
+				},
+				"host2": []*control.SCMUpdateResult{
+					{
+						Module: storage.ScmModule{
+							UID:             "Device2",
+							PhysicalID:      2,
+							Capacity:        (1 << 30),
+							SocketID:        6,
+							ControllerID:    7,
+							ChannelID:       8,
+							ChannelPosition: 9,
+						},
+						StagedVersion: "1.0.0.2",
+						UpdateStatus:  storage.ScmUpdateStatusStaged,
+					},
+				},
+			},
+			expPrintStr: `
+---------
+host[1-2]
+---------
+  Firmware version 1.0.0.2 staged on 2 devices. A power cycle is required to apply the update.
 `,
 		},
 		"only errors": {
@@ -0,0 +1,153 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+var update = flag.Bool("update", false, "update .golden files")
+
+// cmpGolden compares the supplied output with the contents of the named
+// golden file in testdata, first rewriting the file if -update is set.
+func cmpGolden(t *testing.T, name, got string) {
+	t.Helper()
+
+	golden := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := ioutil.WriteFile(golden, []byte(got), 0644); err != nil {
+			t.Fatalf("failed to update golden file %s: %s", golden, err)
+		}
+	}
+
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed to read golden file %s: %s", golden, err)
+	}
+
+	if diff := cmp.Diff(string(want), got); diff != "" {
+		t.Fatalf("output differs from %s (-want, +got):\n%s\n", golden, diff)
+	}
+}
+
+func goldenHostStorageMap(t *testing.T) control.HostStorageMap {
+	hs := func(idx int32) *control.HostStorage {
+		ns := storage.MockScmNamespace(idx)
+		ns.Mount = storage.MockScmMountPoint(idx)
+		ctrlr := storage.MockNvmeController(idx)
+		ctrlr.SmdDevices[0].TotalBytes = 2e12 * uint64(idx+1)
+		ctrlr.SmdDevices[0].AvailBytes = 5e11 * uint64(idx+1)
+
+		return &control.HostStorage{
+			ScmNamespaces: storage.ScmNamespaces{ns},
+			NvmeDevices:   storage.NvmeControllers{ctrlr},
+		}
+	}
+
+	return mockHostStorageMap(t,
+		&mockHostStorage{"host1", hs(0)},
+		&mockHostStorage{"host2", hs(0)},
+		&mockHostStorage{"host3", hs(1)},
+		&mockHostStorage{"host4", hs(0)},
+	)
+}
+
+func goldenListPoolsResp() *control.ListPoolsResp {
+	usage := func(scmSize, scmFree, nvmeSize, nvmeFree uint64, imbalance uint32) []*control.PoolTierUsage {
+		return []*control.PoolTierUsage{
+			{TierName: "SCM", Size: scmSize, Free: scmFree, Imbalance: imbalance},
+			{TierName: "NVME", Size: nvmeSize, Free: nvmeFree, Imbalance: imbalance},
+		}
+	}
+
+	return &control.ListPoolsResp{
+		Pools: []*control.Pool{
+			{
+				Label:            "tank",
+				UUID:             test.MockUUID(1),
+				ServiceReplicas:  []ranklist.Rank{0, 1, 2},
+				Usage:            usage(3e9, 2e9, 1e12, 9e11, 2),
+				TargetsTotal:     64,
+				State:            system.PoolServiceStateReady.String(),
+				PoolLayoutVer:    2,
+				UpgradeLayoutVer: 2,
+			},
+			{
+				Label:            "scratch",
+				UUID:             test.MockUUID(2),
+				ServiceReplicas:  []ranklist.Rank{3},
+				Usage:            usage(6e10, 6e9, 47e9, 4e9, 12),
+				TargetsTotal:     64,
+				TargetsDisabled:  8,
+				State:            system.PoolServiceStateReady.String(),
+				PoolLayoutVer:    2,
+				UpgradeLayoutVer: 2,
+			},
+		},
+	}
+}
+
+func TestPretty_Golden(t *testing.T) {
+	for name, tc := range map[string]struct {
+		print func(t *testing.T, out *strings.Builder) error
+	}{
+		"storage_scan": {
+			print: func(t *testing.T, out *strings.Builder) error {
+				return PrintHostStorageMap(goldenHostStorageMap(t), out)
+			},
+		},
+		"storage_query_usage": {
+			print: func(t *testing.T, out *strings.Builder) error {
+				return PrintHostStorageUsageMap(goldenHostStorageMap(t), out)
+			},
+		},
+		"pool_list": {
+			print: func(t *testing.T, out *strings.Builder) error {
+				return PrintListPoolsResponse(out, out, goldenListPoolsResp(), false)
+			},
+		},
+		"pool_list_verbose": {
+			print: func(t *testing.T, out *strings.Builder) error {
+				return PrintListPoolsResponse(out, out, goldenListPoolsResp(), true)
+			},
+		},
+		"system_query_verbose": {
+			print: func(t *testing.T, out *strings.Builder) error {
+				resp := &control.SystemQueryResp{
+					Members: system.Members{
+						system.MockMember(t, 0, system.MemberStateJoined),
+						system.MockMember(t, 1, system.MemberStateErrored,
+							"engine exited with status 1 after failing to open the SCM pool file"),
+						system.MockMember(t, 2, system.MemberStateExcluded, "excluded by administrator"),
+					},
+				}
+				return PrintSystemQueryResponse(out, out, resp, PrintWithVerboseOutput(true))
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			if err := tc.print(t, &out); err != nil {
+				t.Fatal(err)
+			}
+
+			cmpGolden(t, name, out.String())
+		})
+	}
+}
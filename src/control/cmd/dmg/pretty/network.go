@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2020-2022 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 )
 
@@ -74,3 +75,91 @@ func PrintHostFabricMap(hfm control.HostFabricMap, out io.Writer, opts ...PrintC
 
 	return ew.Err
 }
+
+// configGenNetClass returns the dmg config generate --net-class value for the
+// supplied device class.
+func configGenNetClass(ndc hardware.NetDevClass) string {
+	switch ndc {
+	case hardware.Ether:
+		return "ethernet"
+	case hardware.Infiniband:
+		return "infiniband"
+	default:
+		return ndc.String()
+	}
+}
+
+// PrintHostFabricRecommendations generates a human-readable representation of
+// the ranked fabric interfaces for each set of hosts in the supplied
+// HostFabricMap, along with the config generate options that select the
+// recommended interfaces, and writes it to the supplied io.Writer.
+func PrintHostFabricRecommendations(hfm control.HostFabricMap, provider string, out io.Writer, opts ...PrintConfigOption) error {
+	if len(hfm) == 0 {
+		return nil
+	}
+
+	ew := txtfmt.NewErrWriter(out)
+
+	numaTitle := "NUMA Socket"
+	rankTitle := "Rank"
+	interfaceTitle := "Interface"
+	providerTitle := "Provider"
+	classTitle := "Class"
+	priorityTitle := "Priority"
+	speedTitle := "Link Speed"
+
+	for _, key := range hfm.Keys() {
+		hfs := hfm[key]
+		hosts := getPrintHosts(hfs.HostSet.RangedString(), opts...)
+		lineBreak := strings.Repeat("-", len(hosts))
+		fmt.Fprintf(ew, "%s\n%s\n%s\n", lineBreak, hosts, lineBreak)
+		fmt.Fprintln(ew)
+
+		iw := txtfmt.NewIndentWriter(ew, txtfmt.WithPadCount(4))
+		fr := hfs.HostFabric.Recommend(provider)
+		if len(fr.NumaInterfaces) == 0 {
+			fmt.Fprintln(iw, "No fabric interfaces found")
+			fmt.Fprintln(ew)
+			continue
+		}
+
+		formatter := txtfmt.NewTableFormatter(numaTitle, rankTitle, interfaceTitle,
+			providerTitle, classTitle, priorityTitle, speedTitle)
+		var table []txtfmt.TableRow
+		for _, nn := range fr.NumaNodes() {
+			best := fr.Interface(nn)
+			for i, fi := range fr.NumaInterfaces[nn] {
+				rank := fmt.Sprintf("%d", i+1)
+				if fi == best {
+					rank += "*"
+				}
+				speed := "N/A"
+				if fi.LinkSpeed > 0 {
+					speed = fmt.Sprintf("%g Gb/s", fi.LinkSpeed)
+				}
+				table = append(table, txtfmt.TableRow{
+					numaTitle:      fmt.Sprintf("%d", nn),
+					rankTitle:      rank,
+					interfaceTitle: fi.Device,
+					providerTitle:  fi.Provider,
+					classTitle:     fi.NetDevClass.String(),
+					priorityTitle:  fmt.Sprintf("%d", fi.Priority),
+					speedTitle:     speed,
+				})
+			}
+		}
+		fmt.Fprint(iw, formatter.Format(table))
+		fmt.Fprintln(ew)
+
+		if fr.Provider == "" {
+			fmt.Fprintln(iw, "No interfaces suitable for config generate were found")
+		} else {
+			fmt.Fprintln(iw, "* recommended interface for the NUMA socket")
+			fmt.Fprintf(iw, "Recommended config generate options: --net-provider=%s --net-class=%s\n",
+				fr.Provider, configGenNetClass(fr.NetDevClass))
+		}
+		fmt.Fprintln(ew)
+	}
+
+	return ew.Err
+}
@@ -0,0 +1,114 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+)
+
+func TestPretty_PrintHostFabricRecommendations(t *testing.T) {
+	mockFabricMap := func(t *testing.T, hosts string, ifaces ...*control.HostFabricInterface) control.HostFabricMap {
+		hfm := make(control.HostFabricMap)
+		hf := new(control.HostFabric)
+		for _, iface := range ifaces {
+			hf.AddInterface(iface)
+		}
+		for _, host := range strings.Split(hosts, ",") {
+			if err := hfm.Add(host, hf); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return hfm
+	}
+
+	for name, tc := range map[string]struct {
+		hfm         control.HostFabricMap
+		provider    string
+		expPrintStr string
+	}{
+		"empty": {},
+		"no interfaces": {
+			hfm: mockFabricMap(t, "host1"),
+			expPrintStr: `
+-----
+host1
+-----
+
+    No fabric interfaces found
+
+`,
+		},
+		"ranked interfaces": {
+			hfm: mockFabricMap(t, "host1,host2",
+				&control.HostFabricInterface{
+					Device: "eth0", Provider: "ofi+tcp", NumaNode: 0, Priority: 2,
+					NetDevClass: hardware.Ether,
+				},
+				&control.HostFabricInterface{
+					Device: "ib0", Provider: "ofi+verbs", NumaNode: 0, Priority: 0,
+					NetDevClass: hardware.Infiniband, LinkSpeed: 200,
+				},
+				&control.HostFabricInterface{
+					Device: "ib1", Provider: "ofi+verbs", NumaNode: 1, Priority: 0,
+					NetDevClass: hardware.Infiniband, LinkSpeed: 12.5,
+				},
+			),
+			expPrintStr: `
+---------
+host[1-2]
+---------
+
+    NUMA Socket Rank Interface Provider  Class      Priority Link Speed 
+    ----------- ---- --------- --------  -----      -------- ---------- 
+    0           1*   ib0       ofi+verbs INFINIBAND 0        200 Gb/s   
+    0           2    eth0      ofi+tcp   ETHER      2        N/A        
+    1           1*   ib1       ofi+verbs INFINIBAND 0        12.5 Gb/s  
+
+    * recommended interface for the NUMA socket
+    Recommended config generate options: --net-provider=ofi+verbs --net-class=infiniband
+
+`,
+		},
+		"no suitable interfaces": {
+			hfm: mockFabricMap(t, "host1",
+				&control.HostFabricInterface{
+					Device: "lo", Provider: "ofi+tcp", NumaNode: 0, Priority: 1,
+					NetDevClass: hardware.Loopback,
+				},
+			),
+			expPrintStr: `
+-----
+host1
+-----
+
+    NUMA Socket Rank Interface Provider Class    Priority Link Speed 
+    ----------- ---- --------- -------- -----    -------- ---------- 
+    0           1    lo        ofi+tcp  LOOPBACK 1        N/A        
+
+    No interfaces suitable for config generate were found
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintHostFabricRecommendations(tc.hfm, tc.provider, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
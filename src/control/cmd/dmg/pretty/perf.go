@@ -0,0 +1,81 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/lib/units"
+)
+
+// perfDuration formats latencies in fractional milliseconds, avoiding the
+// multi-byte "µs" unit that would break table column alignment.
+func perfDuration(d time.Duration) string {
+	return fmt.Sprintf("%.3fms", float64(d)/float64(time.Millisecond))
+}
+
+// PrintControlPerfResponse generates a human-readable representation of the
+// supplied ControlPerfResp and writes it to the supplied io.Writer.
+func PrintControlPerfResponse(resp *control.ControlPerfResp, out io.Writer, opts ...PrintConfigOption) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+	w := txtfmt.NewErrWriter(out)
+
+	fmt.Fprintf(w, "Control-plane no-op RPC: %d rounds, %d RPCs, %s payload\n",
+		resp.Rounds, resp.RPCs, units.FormatIBytes(uint64(resp.PayloadSize)))
+	fmt.Fprintf(w, "Elapsed: %s, throughput: %.1f RPCs/s\n",
+		resp.Elapsed.Round(time.Millisecond), resp.Throughput())
+	if resp.RoundLatency == nil || len(resp.HostLatency) == 0 {
+		fmt.Fprintln(w, "No successful RPCs")
+		return w.Err
+	}
+	fmt.Fprintln(w)
+
+	hostTitle := "Host"
+	samplesTitle := "Samples"
+	minTitle := "Min"
+	meanTitle := "Mean"
+	maxTitle := "Max"
+	p99Title := "P99"
+
+	latRow := func(name string, lat *control.PerfLatency) txtfmt.TableRow {
+		return txtfmt.TableRow{
+			hostTitle:    name,
+			samplesTitle: fmt.Sprintf("%d", lat.Samples),
+			minTitle:     perfDuration(lat.Min),
+			meanTitle:    perfDuration(lat.Mean),
+			maxTitle:     perfDuration(lat.Max),
+			p99Title:     perfDuration(lat.P99),
+		}
+	}
+
+	hosts := make([]string, 0, len(resp.HostLatency))
+	for host := range resp.HostLatency {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	table := []txtfmt.TableRow{latRow("(fan-out)", resp.RoundLatency)}
+	for _, host := range hosts {
+		table = append(table, latRow(getPrintHosts(host, opts...), resp.HostLatency[host]))
+	}
+
+	tf := txtfmt.NewTableFormatter(hostTitle, samplesTitle, minTitle, meanTitle,
+		maxTitle, p99Title)
+	tf.InitWriter(w)
+	tf.Format(table)
+
+	return w.Err
+}
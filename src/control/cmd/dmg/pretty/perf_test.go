@@ -0,0 +1,89 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintControlPerfResponse(t *testing.T) {
+	lat := func(samples int, ms float64) *control.PerfLatency {
+		d := time.Duration(ms * float64(time.Millisecond))
+		return &control.PerfLatency{
+			Samples: samples,
+			Min:     d / 2,
+			Mean:    d,
+			Max:     d * 4,
+			P99:     d * 3,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.ControlPerfResp
+		expErr      error
+		expPrintStr string
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no successful rpcs": {
+			resp: &control.ControlPerfResp{
+				Rounds:  10,
+				Elapsed: 20 * time.Millisecond,
+			},
+			expPrintStr: `
+Control-plane no-op RPC: 10 rounds, 0 RPCs, 0 B payload
+Elapsed: 20ms, throughput: 0.0 RPCs/s
+No successful RPCs
+`,
+		},
+		"two hosts": {
+			resp: &control.ControlPerfResp{
+				Rounds:       100,
+				PayloadSize:  4096,
+				RPCs:         200,
+				Elapsed:      time.Second + 123456789,
+				RoundLatency: lat(100, 11.25),
+				HostLatency: map[string]*control.PerfLatency{
+					"host2:10001": lat(100, 10),
+					"host1:10001": lat(100, 1.2345),
+				},
+			},
+			expPrintStr: `
+Control-plane no-op RPC: 100 rounds, 200 RPCs, 4.0 KiB payload
+Elapsed: 1.123s, throughput: 178.0 RPCs/s
+
+Host      Samples Min     Mean     Max      P99      
+----      ------- ---     ----     ---      ---      
+(fan-out) 100     5.625ms 11.250ms 45.000ms 33.750ms 
+host1     100     0.617ms 1.234ms  4.938ms  3.704ms  
+host2     100     5.000ms 10.000ms 40.000ms 30.000ms 
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintControlPerfResponse(tc.resp, &bld)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -9,14 +9,18 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
-	"github.com/dustin/go-humanize"
+	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/lib/units"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 func getTierNameText(tierIdx int) string {
@@ -45,6 +49,9 @@ func PrintPoolQueryResponse(pqr *control.PoolQueryResp, out io.Writer, opts ...P
 		fmt.Fprintf(w, "Pool layout out of date (%d < %d) -- see `dmg pool upgrade` for details.\n",
 			pqr.PoolLayoutVer, pqr.UpgradeLayoutVer)
 	}
+	if len(pqr.ServiceReplicas) > 0 {
+		fmt.Fprintf(w, "Pool service replicas: %s\n", ranklist.RankSetFromRanks(pqr.ServiceReplicas))
+	}
 	fmt.Fprintln(w, "Pool space info:")
 	if pqr.EnabledRanks != nil {
 		fmt.Fprintf(w, "- Enabled targets: %s\n", pqr.EnabledRanks)
@@ -56,10 +63,10 @@ func PrintPoolQueryResponse(pqr *control.PoolQueryResp, out io.Writer, opts ...P
 	if pqr.TierStats != nil {
 		for tierIdx, tierStats := range pqr.TierStats {
 			fmt.Fprintln(w, getTierNameText(tierIdx))
-			fmt.Fprintf(w, "  Total size: %s\n", humanize.Bytes(tierStats.Total))
+			fmt.Fprintf(w, "  Total size: %s\n", units.FormatBytes(tierStats.Total))
 			fmt.Fprintf(w, "  Free: %s, min:%s, max:%s, mean:%s\n",
-				humanize.Bytes(tierStats.Free), humanize.Bytes(tierStats.Min),
-				humanize.Bytes(tierStats.Max), humanize.Bytes(tierStats.Mean))
+				units.FormatBytes(tierStats.Free), units.FormatBytes(tierStats.Min),
+				units.FormatBytes(tierStats.Max), units.FormatBytes(tierStats.Mean))
 		}
 	}
 	if pqr.Rebuild != nil {
@@ -88,8 +95,8 @@ func PrintPoolQueryTargetResponse(pqtr *control.PoolQueryTargetResp, out io.Writ
 		if pqtr.Infos[infosIdx].Space != nil {
 			for tierIdx, tierUsage := range pqtr.Infos[infosIdx].Space {
 				fmt.Fprintln(w, getTierNameText(tierIdx))
-				fmt.Fprintf(w, "  Total size: %s\n", humanize.Bytes(tierUsage.Total))
-				fmt.Fprintf(w, "  Free: %s\n", humanize.Bytes(tierUsage.Free))
+				fmt.Fprintf(w, "  Total size: %s\n", units.FormatBytes(tierUsage.Total))
+				fmt.Fprintf(w, "  Free: %s\n", units.FormatBytes(tierUsage.Free))
 			}
 		}
 	}
@@ -97,6 +104,44 @@ func PrintPoolQueryTargetResponse(pqtr *control.PoolQueryTargetResp, out io.Writ
 	return w.Err
 }
 
+func aggLagString(lag uint64) string {
+	return (time.Duration(lag) * time.Second).String()
+}
+
+// PrintPoolQueryAggregationResponse generates a human-readable representation of the supplied
+// PoolQueryAggregationResp struct and writes it to the supplied io.Writer.
+func PrintPoolQueryAggregationResponse(resp *control.PoolQueryAggregationResp, out io.Writer) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+	w := txtfmt.NewErrWriter(out)
+
+	fmt.Fprintf(w, "Pool %s aggregation lag: %s\n", resp.UUID, aggLagString(resp.Lag()))
+	if len(resp.Ranks) == 0 {
+		return w.Err
+	}
+
+	rankTitle := "Rank"
+	stateTitle := "State"
+	lagTitle := "Lag"
+	lastRunTitle := "Last Run"
+
+	formatter := txtfmt.NewTableFormatter(rankTitle, stateTitle, lagTitle, lastRunTitle)
+	var table []txtfmt.TableRow
+	for _, r := range resp.Ranks {
+		table = append(table, txtfmt.TableRow{
+			rankTitle:    r.Rank.String(),
+			stateTitle:   r.State,
+			lagTitle:     aggLagString(r.Lag),
+			lastRunTitle: getTimestampString(r.LastRun),
+		})
+	}
+
+	fmt.Fprint(w, formatter.Format(table))
+
+	return w.Err
+}
+
 // PrintPoolCreateResponse generates a human-readable representation of the pool create
 // response and prints it to the supplied io.Writer.
 func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ...PrintConfigOption) error {
@@ -130,7 +175,7 @@ func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ..
 	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Service Leader": fmt.Sprintf("%d", pcr.Leader)})
 	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Service Ranks": formatRanks(pcr.SvcReps)})
 	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Storage Ranks": formatRanks(pcr.TgtRanks)})
-	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Total Size": humanize.Bytes(totalSize * numRanks)})
+	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Total Size": units.FormatBytes(totalSize * numRanks)})
 
 	title := "Pool created with "
 	tierName := "SCM"
@@ -142,7 +187,7 @@ func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ..
 
 		title += fmt.Sprintf("%0.2f%%", tierRatio*100)
 		fmtName := fmt.Sprintf("Storage tier %d (%s)", tierIdx, tierName)
-		fmtArgs = append(fmtArgs, txtfmt.TableRow{fmtName: fmt.Sprintf("%s (%s / rank)", humanize.Bytes(pcr.TierBytes[tierIdx]*numRanks), humanize.Bytes(pcr.TierBytes[tierIdx]))})
+		fmtArgs = append(fmtArgs, txtfmt.TableRow{fmtName: fmt.Sprintf("%s (%s / rank)", units.FormatBytes(pcr.TierBytes[tierIdx]*numRanks), units.FormatBytes(pcr.TierBytes[tierIdx]))})
 	}
 	title += " storage tier ratio"
 
@@ -150,7 +195,109 @@ func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ..
 	return err
 }
 
-func poolListCreateRow(pool *control.Pool, upgrade bool) txtfmt.TableRow {
+// PrintPoolCreateStatusResponse generates a human-readable representation of
+// the progress of an asynchronous pool create job.
+func PrintPoolCreateStatusResponse(out io.Writer, resp *control.PoolCreateStatusResp) error {
+	if resp == nil {
+		return errors.New("nil response")
+	}
+
+	state := resp.State
+	if resp.CancelRequested {
+		state += " (cancel requested)"
+	}
+	fmtArgs := []txtfmt.TableRow{
+		{"Pool UUID": resp.PoolUUID},
+		{"State": state},
+		{"Stage": resp.Stage},
+	}
+	if resp.Error != "" {
+		fmtArgs = append(fmtArgs, txtfmt.TableRow{"Error": resp.Error})
+	}
+	fmt.Fprintln(out, txtfmt.FormatEntity(fmt.Sprintf("Pool create job %s", resp.JobID), fmtArgs))
+
+	if len(resp.Ranks) > 0 {
+		rankStates := make(map[string][]uint32)
+		var states []string
+		for _, r := range resp.Ranks {
+			if _, found := rankStates[r.State]; !found {
+				states = append(states, r.State)
+			}
+			rankStates[r.State] = append(rankStates[r.State], r.Rank)
+		}
+
+		ranksTitle := "Ranks"
+		stateTitle := "State"
+		formatter := txtfmt.NewTableFormatter(ranksTitle, stateTitle)
+		var table []txtfmt.TableRow
+		for _, state := range states {
+			table = append(table, txtfmt.TableRow{
+				ranksTitle: formatRanks(rankStates[state]),
+				stateTitle: state,
+			})
+		}
+		fmt.Fprintln(out, formatter.Format(table))
+	}
+
+	if resp.Result != nil && len(resp.Result.TierBytes) > 0 && len(resp.Result.TgtRanks) > 0 {
+		return PrintPoolCreateResponse(resp.Result, out)
+	}
+
+	return nil
+}
+
+// poolsProbed indicates whether the service health of any of the pools
+// has been probed.
+func poolsProbed(pools []*control.Pool) bool {
+	for _, pool := range pools {
+		if pool.Health != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func poolHealth(pool *control.Pool) string {
+	if pool.Health == "" {
+		return "-"
+	}
+	return pool.Health
+}
+
+// poolHealthWarnings returns a description of each pool whose service was
+// not found to be healthy.
+func poolHealthWarnings(pools []*control.Pool) string {
+	var out strings.Builder
+
+	for _, pool := range pools {
+		if pool.IsHealthy() {
+			continue
+		}
+
+		fmt.Fprintf(&out, "Pool %q service is %s", pool.GetName(), pool.Health)
+		if pool.HealthErrorMsg != "" {
+			fmt.Fprintf(&out, ": %s\n", pool.HealthErrorMsg)
+			continue
+		}
+
+		var reasons []string
+		for _, rs := range pool.ReplicaStatus {
+			switch rs.State {
+			case system.PoolReplicaStateLeader, system.PoolReplicaStateFollower:
+				continue
+			}
+			reasons = append(reasons, fmt.Sprintf("replica %d %s", rs.Rank, rs.State))
+		}
+		if len(reasons) == 0 {
+			reasons = append(reasons, fmt.Sprintf("leader %d is not a known replica", pool.ServiceLeader))
+		}
+		fmt.Fprintf(&out, ": %s\n", strings.Join(reasons, ", "))
+	}
+
+	return out.String()
+}
+
+func poolListCreateRow(pool *control.Pool, upgrade, health bool) txtfmt.TableRow {
 	// display size of the largest non-empty tier
 	var size uint64
 	for ti := len(pool.Usage) - 1; ti >= 0; ti-- {
@@ -180,7 +327,7 @@ func poolListCreateRow(pool *control.Pool, upgrade bool) txtfmt.TableRow {
 	}
 	row := txtfmt.TableRow{
 		"Pool":      pool.GetName(),
-		"Size":      fmt.Sprintf("%s", humanize.Bytes(size)),
+		"Size":      units.FormatBytes(size),
 		"State":     pool.State,
 		"Used":      fmt.Sprintf("%d%%", used),
 		"Imbalance": fmt.Sprintf("%d%%", imbalance),
@@ -195,11 +342,14 @@ func poolListCreateRow(pool *control.Pool, upgrade bool) txtfmt.TableRow {
 		}
 		row["UpgradeNeeded?"] = upgradeString
 	}
+	if health {
+		row["Health"] = poolHealth(pool)
+	}
 
 	return row
 }
 
-func printListPoolsResp(out io.Writer, resp *control.ListPoolsResp) error {
+func printListPoolsResp(out io.Writer, resp *control.ListPoolsResp, cfg *PrintConfig) error {
 	if len(resp.Pools) == 0 {
 		fmt.Fprintln(out, "no pools in system")
 		return nil
@@ -214,28 +364,37 @@ func printListPoolsResp(out io.Writer, resp *control.ListPoolsResp) error {
 		}
 	}
 
+	health := poolsProbed(resp.Pools)
+
 	titles := []string{"Pool", "Size", "State", "Used", "Imbalance", "Disabled"}
 	if upgrade {
 		titles = append(titles, "UpgradeNeeded?")
 	}
-	formatter := txtfmt.NewTableFormatter(titles...)
+	if health {
+		titles = append(titles, "Health")
+	}
+	formatter, err := newTableFormatter(cfg, titles...)
+	if err != nil {
+		return err
+	}
+	formatter.SetColumnAlignRight("Size", "Used", "Imbalance", "Disabled")
 
 	var table []txtfmt.TableRow
 	for _, pool := range resp.Pools {
 		if pool.HasErrors() {
 			continue
 		}
-		table = append(table, poolListCreateRow(pool, upgrade))
+		table = append(table, poolListCreateRow(pool, upgrade, health))
 	}
 
-	fmt.Fprintln(out, formatter.Format(table))
+	printTable(out, formatter, table)
 
 	return nil
 }
 
 func addVerboseTierUsage(row txtfmt.TableRow, usage *control.PoolTierUsage) txtfmt.TableRow {
-	row[usage.TierName+" Size"] = humanize.Bytes(usage.Size)
-	row[usage.TierName+" Used"] = humanize.Bytes(usage.Size - usage.Free)
+	row[usage.TierName+" Size"] = units.FormatBytes(usage.Size)
+	row[usage.TierName+" Used"] = units.FormatBytes(usage.Size - usage.Free)
 	row[usage.TierName+" Imbalance"] = fmt.Sprintf("%d%%", usage.Imbalance)
 
 	return row
@@ -270,26 +429,48 @@ func poolListCreateRowVerbose(pool *control.Pool) txtfmt.TableRow {
 	for _, tu := range pool.Usage {
 		row = addVerboseTierUsage(row, tu)
 	}
+	row["Health"] = poolHealth(pool)
+	row["Notes"] = system.FormatAnnotations(pool.Annotations)
 
 	return row
 }
 
-func printListPoolsRespVerbose(out io.Writer, resp *control.ListPoolsResp) error {
-	if len(resp.Pools) == 0 {
+func printListPoolsRespVerbose(out io.Writer, resp *control.ListPoolsResp, cfg *PrintConfig) error {
+	if len(resp.Pools) == 0 && cfg.TableFormat.IsText() {
 		fmt.Fprintln(out, "no pools in system")
 		return nil
 	}
 
 	titles := []string{"Label", "UUID", "State", "SvcReps"}
-	for _, t := range resp.Pools[0].Usage {
-		titles = append(titles,
+	var numTitles []string
+	var usage []*control.PoolTierUsage
+	if len(resp.Pools) > 0 {
+		usage = resp.Pools[0].Usage
+	}
+	for _, t := range usage {
+		numTitles = append(numTitles,
 			t.TierName+" Size",
 			t.TierName+" Used",
 			t.TierName+" Imbalance")
 	}
-	titles = append(titles, "Disabled")
+	numTitles = append(numTitles, "Disabled")
+	titles = append(titles, numTitles...)
 	titles = append(titles, "UpgradeNeeded?")
-	formatter := txtfmt.NewTableFormatter(titles...)
+	if poolsProbed(resp.Pools) {
+		titles = append(titles, "Health")
+	}
+	// Only display the notes column if any of the pools have annotations.
+	for _, pool := range resp.Pools {
+		if len(pool.Annotations) > 0 {
+			titles = append(titles, "Notes")
+			break
+		}
+	}
+	formatter, err := newTableFormatter(cfg, titles...)
+	if err != nil {
+		return err
+	}
+	formatter.SetColumnAlignRight(numTitles...)
 
 	var table []txtfmt.TableRow
 	for _, pool := range resp.Pools {
@@ -299,15 +480,19 @@ func printListPoolsRespVerbose(out io.Writer, resp *control.ListPoolsResp) error
 		table = append(table, poolListCreateRowVerbose(pool))
 	}
 
-	fmt.Fprintln(out, formatter.Format(table))
+	printTable(out, formatter, table)
 
 	return nil
 }
 
 // PrintListPoolsResponse generates a human-readable representation of the
 // supplied ListPoolsResp struct and writes it to the supplied io.Writer.
-// Additional columns for pool UUID and service replicas if verbose is set.
-func PrintListPoolsResponse(out, outErr io.Writer, resp *control.ListPoolsResp, verbose bool) error {
+// Additional columns for pool UUID and service replicas if verbose is set or
+// a table format other than the default is selected.
+func PrintListPoolsResponse(out, outErr io.Writer, resp *control.ListPoolsResp, verbose bool, opts ...PrintConfigOption) error {
+	cfg := getPrintConfig(opts...)
+	cfg.Verbose = cfg.Verbose || verbose
+
 	warn, err := resp.Validate()
 	if err != nil {
 		return err
@@ -315,12 +500,15 @@ func PrintListPoolsResponse(out, outErr io.Writer, resp *control.ListPoolsResp,
 	if warn != "" {
 		fmt.Fprintln(outErr, warn)
 	}
+	if warn := poolHealthWarnings(resp.Pools); warn != "" {
+		fmt.Fprintln(outErr, warn)
+	}
 
-	if verbose {
-		return printListPoolsRespVerbose(out, resp)
+	if cfg.detailedTable() {
+		return printListPoolsRespVerbose(out, resp, cfg)
 	}
 
-	return printListPoolsResp(out, resp)
+	return printListPoolsResp(out, resp, cfg)
 }
 
 // PrintPoolProperties displays a two-column table of pool property names and values.
@@ -344,3 +532,74 @@ func PrintPoolProperties(poolID string, out io.Writer, properties ...*daos.PoolP
 	tf.InitWriter(out)
 	tf.Format(table)
 }
+
+// PrintPoolPropChanges generates a diff-style representation of the supplied
+// property changes for a pool. Modified properties are shown with their old
+// and new values, unmodified properties are shown for context.
+func PrintPoolPropChanges(poolID string, out io.Writer, changes ...*control.PoolPropChange) {
+	var nrChanged int
+	for _, change := range changes {
+		if change != nil && change.Changed() {
+			nrChanged++
+		}
+	}
+	fmt.Fprintf(out, "Pool %s: %s changed\n", poolID, english.Plural(nrChanged, "property", "properties"))
+
+	for _, change := range changes {
+		if change == nil {
+			continue
+		}
+		if !change.Changed() {
+			fmt.Fprintf(out, "  %s: %s\n", change.Name, change.NewValue)
+			continue
+		}
+		fmt.Fprintf(out, "- %s: %s\n", change.Name, change.OldValue)
+		fmt.Fprintf(out, "+ %s: %s\n", change.Name, change.NewValue)
+	}
+}
+
+// PrintPoolCleanupPartialResponse generates a human-readable representation of
+// the supplied PoolCleanupPartialResp struct and writes it to the supplied
+// io.Writer.
+func PrintPoolCleanupPartialResponse(out io.Writer, resp *control.PoolCleanupPartialResp) error {
+	if resp == nil {
+		return errors.New("nil response")
+	}
+
+	if len(resp.Pools) == 0 {
+		fmt.Fprintln(out, "No partially created or destroyed pools found")
+		return nil
+	}
+
+	uuidTitle := "Pool UUID"
+	labelTitle := "Label"
+	stateTitle := "State"
+	resultTitle := "Result"
+
+	formatter := txtfmt.NewTableFormatter(uuidTitle, labelTitle, stateTitle, resultTitle)
+	var table []txtfmt.TableRow
+	for _, pool := range resp.Pools {
+		label := pool.Label
+		if label == "" {
+			label = "-"
+		}
+		result := "not removed"
+		switch {
+		case pool.Removed:
+			result = "removed"
+		case pool.Status != 0:
+			result = fmt.Sprintf("failed: %s", pool.Msg)
+		}
+
+		table = append(table, txtfmt.TableRow{
+			uuidTitle:   pool.UUID,
+			labelTitle:  label,
+			stateTitle:  pool.State,
+			resultTitle: result,
+		})
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+
+	return nil
+}
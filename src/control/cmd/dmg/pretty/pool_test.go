@@ -17,7 +17,9 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/system"
 )
 
@@ -75,6 +77,31 @@ Pool space info:
   Total size: 2 B
   Free: 1 B, min:0 B, max:0 B, mean:0 B
 Rebuild busy, 42 objs, 21 recs
+`, test.MockUUID()),
+		},
+		"normal response; service replicas": {
+			pqr: &control.PoolQueryResp{
+				UUID: test.MockUUID(),
+				PoolInfo: control.PoolInfo{
+					TotalTargets:     2,
+					DisabledTargets:  1,
+					ActiveTargets:    1,
+					Leader:           42,
+					Version:          100,
+					PoolLayoutVer:    2,
+					UpgradeLayoutVer: 2,
+					ServiceReplicas:  []ranklist.Rank{0, 1, 2, 4},
+					Rebuild: &control.PoolRebuildStatus{
+						State: control.PoolRebuildStateIdle,
+					},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool %s, ntarget=2, disabled=1, leader=42, version=100
+Pool service replicas: 0-2,4
+Pool space info:
+- Target(VOS) count:1
+Rebuild idle, 0 objs, 0 recs
 `, test.MockUUID()),
 		},
 		"normal response; enabled ranks": {
@@ -163,6 +190,52 @@ Pool space info:
   Total size: 2 B
   Free: 1 B, min:0 B, max:0 B, mean:0 B
 Rebuild busy, 42 objs, 21 recs
+`, test.MockUUID()),
+		},
+		"normal response; enabled and disabled ranks": {
+			pqr: &control.PoolQueryResp{
+				UUID: test.MockUUID(),
+				PoolInfo: control.PoolInfo{
+					TotalTargets:     2,
+					DisabledTargets:  1,
+					ActiveTargets:    1,
+					Leader:           42,
+					Version:          100,
+					PoolLayoutVer:    1,
+					UpgradeLayoutVer: 2,
+					EnabledRanks:     ranklist.MustCreateRankSet("[2,4-5]"),
+					DisabledRanks:    ranklist.MustCreateRankSet("[0,1,3]"),
+					Rebuild: &control.PoolRebuildStatus{
+						State:   control.PoolRebuildStateBusy,
+						Objects: 42,
+						Records: 21,
+					},
+					TierStats: []*control.StorageUsageStats{
+						{
+							Total: 2,
+							Free:  1,
+						},
+						{
+							Total: 2,
+							Free:  1,
+						},
+					},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool %s, ntarget=2, disabled=1, leader=42, version=100
+Pool layout out of date (1 < 2) -- see `+backtickStr+` for details.
+Pool space info:
+- Enabled targets: 2,4-5
+- Disabled targets: 0-1,3
+- Target(VOS) count:1
+- Storage tier 0 (SCM):
+  Total size: 2 B
+  Free: 1 B, min:0 B, max:0 B, mean:0 B
+- Storage tier 1 (NVMe):
+  Total size: 2 B
+  Free: 1 B, min:0 B, max:0 B, mean:0 B
+Rebuild busy, 42 objs, 21 recs
 `, test.MockUUID()),
 		},
 		"unknown/invalid rebuild state response": {
@@ -875,6 +948,73 @@ Target: type unknown, state up_in
 	}
 }
 
+func TestPretty_PrintPoolQueryAggregationResp(t *testing.T) {
+	lastRun := getTimestampString(1685613600)
+
+	for name, tc := range map[string]struct {
+		resp        *control.PoolQueryAggregationResp
+		expErr      error
+		expPrintStr string
+	}{
+		"nil response": {
+			expErr: errors.New("nil *control.PoolQueryAggregationResp"),
+		},
+		"no ranks": {
+			resp: &control.PoolQueryAggregationResp{
+				UUID: test.MockUUID(),
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool %s aggregation lag: 0s
+`, test.MockUUID()),
+		},
+		"last run": {
+			resp: &control.PoolQueryAggregationResp{
+				UUID: test.MockUUID(),
+				Ranks: []*control.PoolRankAggregation{
+					{Rank: 0, State: "running", Lag: 45, LastRun: 1685613600},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool %s aggregation lag: 45s
+Rank State   Lag %-*s 
+---- -----   --- %-*s 
+0    running 45s %s 
+`, test.MockUUID(), len(lastRun), "Last Run", len(lastRun), "--------", lastRun),
+		},
+		"multiple ranks": {
+			resp: &control.PoolQueryAggregationResp{
+				UUID: test.MockUUID(),
+				Ranks: []*control.PoolRankAggregation{
+					{Rank: 0, State: "running", Lag: 45},
+					{Rank: 1, State: "idle", Lag: 12},
+					{Rank: 2, State: "paused", Lag: 3600},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool %s aggregation lag: 1h0m0s
+Rank State   Lag    Last Run 
+---- -----   ---    -------- 
+0    running 45s    N/A      
+1    idle    12s    N/A      
+2    paused  1h0m0s N/A      
+`, test.MockUUID()),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintPoolQueryAggregationResponse(tc.resp, &bld)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func mockRanks(ranks ...uint32) []uint32 {
 	return ranks
 }
@@ -968,12 +1108,73 @@ func TestPretty_PrintListPoolsResponse(t *testing.T) {
 		},
 	}
 
+	onePool := &control.ListPoolsResp{
+		Pools: []*control.Pool{
+			{
+				Label:            "one",
+				UUID:             test.MockUUID(1),
+				ServiceReplicas:  []ranklist.Rank{0, 1, 2},
+				Usage:            exampleUsage,
+				TargetsTotal:     16,
+				State:            system.PoolServiceStateReady.String(),
+				PoolLayoutVer:    2,
+				UpgradeLayoutVer: 2,
+			},
+		},
+	}
+
 	for name, tc := range map[string]struct {
 		resp        *control.ListPoolsResp
 		verbose     bool
+		opts        []PrintConfigOption
 		expErr      error
 		expPrintStr string
 	}{
+		"selected columns": {
+			resp: onePool,
+			opts: []PrintConfigOption{PrintWithColumns("pool", "Used")},
+			expPrintStr: `
+Pool Used 
+---- ---- 
+one   83% 
+
+`,
+		},
+		"unknown column": {
+			resp:   onePool,
+			opts:   []PrintConfigOption{PrintWithColumns("Pool", "Owner")},
+			expErr: errors.New("unknown column \"Owner\""),
+		},
+		"csv; selected columns": {
+			resp: onePool,
+			opts: []PrintConfigOption{
+				PrintWithTableFormat(txtfmt.TableFormatCSV),
+				PrintWithColumns("label", "NVME Used", "Disabled"),
+			},
+			expPrintStr: `
+Label,NVME Used,Disabled
+one,5.0 TB,0/16
+`,
+		},
+		"csv; empty response": {
+			resp: &control.ListPoolsResp{},
+			opts: []PrintConfigOption{PrintWithTableFormat(txtfmt.TableFormatCSV)},
+			expPrintStr: `
+Label,UUID,State,SvcReps,Disabled,UpgradeNeeded?
+`,
+		},
+		"yaml; selected columns": {
+			resp: onePool,
+			opts: []PrintConfigOption{
+				PrintWithTableFormat(txtfmt.TableFormatYAML),
+				PrintWithColumns("Label", "State", "SvcReps"),
+			},
+			expPrintStr: `
+- Label: one
+  State: Ready
+  SvcReps: '[0-2]'
+`,
+		},
 		"empty response": {
 			resp: &control.ListPoolsResp{},
 			expPrintStr: `
@@ -993,7 +1194,7 @@ no pools in system
 			expPrintStr: `
 Pool     Size State Used Imbalance Disabled 
 ----     ---- ----- ---- --------- -------- 
-00000001 0 B  Ready 0%   0%        0/0      
+00000001  0 B Ready   0%        0%      0/0 
 
 `,
 		},
@@ -1063,8 +1264,8 @@ Pool     Size State Used Imbalance Disabled
 			expPrintStr: `
 Pool     Size   State Used Imbalance Disabled UpgradeNeeded? 
 ----     ----   ----- ---- --------- -------- -------------- 
-00000001 6.0 TB Ready 83%  12%       0/16     1->2           
-two      6.0 TB Ready 83%  12%       8/64     1->2           
+00000001 6.0 TB Ready  83%       12%     0/16 1->2           
+two      6.0 TB Ready  83%       12%     8/64 1->2           
 
 `,
 		},
@@ -1101,8 +1302,8 @@ two      6.0 TB Ready 83%  12%       8/64     1->2
 			expPrintStr: `
 Pool Size   State Used Imbalance Disabled UpgradeNeeded? 
 ---- ----   ----- ---- --------- -------- -------------- 
-one  6.0 TB Ready 83%  12%       0/16     1->2           
-two  100 GB Ready 80%  12%       8/64     None           
+one  6.0 TB Ready  83%       12%     0/16 1->2           
+two  100 GB Ready  80%       12%     8/64 None           
 
 `,
 		},
@@ -1133,7 +1334,7 @@ Query on pool "two" unsuccessful, error: "stats unavailable"
 
 Pool Size   State Used Imbalance Disabled UpgradeNeeded? 
 ---- ----   ----- ---- --------- -------- -------------- 
-one  6.0 TB Ready 83%  12%       0/16     1->2           
+one  6.0 TB Ready  83%       12%     0/16 1->2           
 
 `,
 		},
@@ -1170,7 +1371,7 @@ Query on pool "three" unsuccessful, status: "DER_UNINIT"
 
 Pool Size   State Used Imbalance Disabled 
 ---- ----   ----- ---- --------- -------- 
-one  6.0 TB Ready 83%  12%       0/16     
+one  6.0 TB Ready  83%       12%     0/16 
 
 `,
 		},
@@ -1199,7 +1400,7 @@ no pools in system
 			expPrintStr: `
 Label UUID                                 State SvcReps SCM Size SCM Used SCM Imbalance NVME Size NVME Used NVME Imbalance Disabled UpgradeNeeded? 
 ----- ----                                 ----- ------- -------- -------- ------------- --------- --------- -------------- -------- -------------- 
--     00000001-0001-0001-0001-000000000001 Ready N/A     100 GB   80 GB    12%           6.0 TB    5.0 TB    1%             0/16     1->2           
+-     00000001-0001-0001-0001-000000000001 Ready N/A       100 GB    80 GB           12%    6.0 TB    5.0 TB             1%     0/16 1->2           
 
 `,
 		},
@@ -1234,8 +1435,101 @@ Label UUID                                 State SvcReps SCM Size SCM Used SCM I
 			expPrintStr: `
 Label UUID                                 State      SvcReps SCM Size SCM Used SCM Imbalance NVME Size NVME Used NVME Imbalance Disabled UpgradeNeeded? 
 ----- ----                                 -----      ------- -------- -------- ------------- --------- --------- -------------- -------- -------------- 
-one   00000001-0001-0001-0001-000000000001 Ready      [0-2]   100 GB   80 GB    12%           6.0 TB    5.0 TB    1%             0/16     1->2           
-two   00000002-0002-0002-0002-000000000002 Destroying [3-5]   100 GB   80 GB    12%           6.0 TB    5.0 TB    1%             8/64     None           
+one   00000001-0001-0001-0001-000000000001 Ready      [0-2]     100 GB    80 GB           12%    6.0 TB    5.0 TB             1%     0/16 1->2           
+two   00000002-0002-0002-0002-000000000002 Destroying [3-5]     100 GB    80 GB           12%    6.0 TB    5.0 TB             1%     8/64 None           
+
+`,
+		},
+		"three pools; health probed": {
+			resp: &control.ListPoolsResp{
+				Pools: []*control.Pool{
+					{
+						Label:            "one",
+						UUID:             test.MockUUID(1),
+						ServiceReplicas:  []ranklist.Rank{0, 1, 2},
+						Usage:            exampleUsage,
+						TargetsTotal:     16,
+						State:            system.PoolServiceStateReady.String(),
+						PoolLayoutVer:    2,
+						UpgradeLayoutVer: 2,
+						Health:           system.PoolServiceHealthHealthy,
+					},
+					{
+						Label:            "two",
+						UUID:             test.MockUUID(2),
+						ServiceReplicas:  []ranklist.Rank{3, 4, 5},
+						Usage:            exampleUsage,
+						TargetsTotal:     64,
+						TargetsDisabled:  8,
+						State:            system.PoolServiceStateReady.String(),
+						PoolLayoutVer:    2,
+						UpgradeLayoutVer: 2,
+						Health:           system.PoolServiceHealthDegraded,
+						ReplicaStatus: []*control.PoolReplicaStatus{
+							{Rank: 3, State: system.PoolReplicaStateLeader},
+							{Rank: 4, State: system.PoolReplicaStateExcluded},
+							{Rank: 5, State: system.PoolReplicaStateDown},
+						},
+					},
+					{
+						Label:            "three",
+						UUID:             test.MockUUID(3),
+						ServiceReplicas:  []ranklist.Rank{6},
+						Usage:            exampleUsage,
+						TargetsTotal:     16,
+						State:            system.PoolServiceStateReady.String(),
+						PoolLayoutVer:    2,
+						UpgradeLayoutVer: 2,
+						Health:           system.PoolServiceHealthUnavailable,
+						HealthErrorMsg:   "no pool service replicas available",
+					},
+				},
+			},
+			expPrintStr: `
+Pool "two" service is degraded: replica 4 excluded, replica 5 down
+Pool "three" service is unavailable: no pool service replicas available
+
+Pool  Size   State Used Imbalance Disabled Health      
+----  ----   ----- ---- --------- -------- ------      
+one   6.0 TB Ready  83%       12%     0/16 healthy     
+two   6.0 TB Ready  83%       12%     8/64 degraded    
+three 6.0 TB Ready  83%       12%     0/16 unavailable 
+
+`,
+		},
+		"verbose; two pools; one destroying; health probed": {
+			resp: &control.ListPoolsResp{
+				Pools: []*control.Pool{
+					{
+						Label:            "one",
+						UUID:             test.MockUUID(1),
+						ServiceReplicas:  []ranklist.Rank{0, 1, 2},
+						Usage:            exampleUsage,
+						TargetsTotal:     16,
+						State:            system.PoolServiceStateReady.String(),
+						PoolLayoutVer:    2,
+						UpgradeLayoutVer: 2,
+						Health:           system.PoolServiceHealthHealthy,
+					},
+					{
+						Label:            "two",
+						UUID:             test.MockUUID(2),
+						ServiceReplicas:  []ranklist.Rank{3, 4, 5},
+						Usage:            exampleUsage,
+						TargetsTotal:     64,
+						TargetsDisabled:  8,
+						State:            system.PoolServiceStateDestroying.String(),
+						PoolLayoutVer:    2,
+						UpgradeLayoutVer: 2,
+					},
+				},
+			},
+			verbose: true,
+			expPrintStr: `
+Label UUID                                 State      SvcReps SCM Size SCM Used SCM Imbalance NVME Size NVME Used NVME Imbalance Disabled UpgradeNeeded? Health  
+----- ----                                 -----      ------- -------- -------- ------------- --------- --------- -------------- -------- -------------- ------  
+one   00000001-0001-0001-0001-000000000001 Ready      [0-2]     100 GB    80 GB           12%    6.0 TB    5.0 TB             1%     0/16 None           healthy 
+two   00000002-0002-0002-0002-000000000002 Destroying [3-5]     100 GB    80 GB           12%    6.0 TB    5.0 TB             1%     8/64 None           -       
 
 `,
 		},
@@ -1245,7 +1539,7 @@ two   00000002-0002-0002-0002-000000000002 Destroying [3-5]   100 GB   80 GB
 
 			// pass the same io writer to standard and error stream
 			// parameters to mimic combined output seen on terminal
-			err := PrintListPoolsResponse(&bld, &bld, tc.resp, tc.verbose)
+			err := PrintListPoolsResponse(&bld, &bld, tc.resp, tc.verbose, tc.opts...)
 			test.CmpErr(t, tc.expErr, err)
 			if tc.expErr != nil {
 				return
@@ -1257,3 +1551,170 @@ two   00000002-0002-0002-0002-000000000002 Destroying [3-5]   100 GB   80 GB
 		})
 	}
 }
+
+func TestPretty_PrintPoolPropChanges(t *testing.T) {
+	for name, tc := range map[string]struct {
+		changes     []*control.PoolPropChange
+		expPrintStr string
+	}{
+		"no changes": {
+			expPrintStr: `
+Pool tank: 0 properties changed
+`,
+		},
+		"mixed changes": {
+			changes: []*control.PoolPropChange{
+				{Name: "label", OldValue: "tank", NewValue: "tank"},
+				nil,
+				{Name: "reclaim", OldValue: "disabled", NewValue: "lazy"},
+			},
+			expPrintStr: `
+Pool tank: 1 property changed
+  label: tank
+- reclaim: disabled
++ reclaim: lazy
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolPropChanges("tank", &bld, tc.changes...)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolCleanupPartialResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.PoolCleanupPartialResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil response"),
+		},
+		"no partial pools": {
+			resp: &control.PoolCleanupPartialResp{},
+			expPrintStr: `
+No partially created or destroyed pools found
+`,
+		},
+		"partial pools": {
+			resp: &control.PoolCleanupPartialResp{
+				Pools: []*control.PoolCleanupResult{
+					{
+						UUID:    test.MockUUID(1),
+						Label:   "pool1",
+						State:   "Destroying",
+						Removed: true,
+					},
+					{
+						UUID:   test.MockUUID(2),
+						State:  "Creating",
+						Status: int32(daos.Busy),
+						Msg:    "pool operation in progress",
+					},
+					{
+						UUID:  test.MockUUID(3),
+						Label: "pool3",
+						State: "Creating",
+					},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool UUID                            Label State      Result                             
+---------                            ----- -----      ------                             
+%s pool1 Destroying removed                            
+%s -     Creating   failed: pool operation in progress 
+%s pool3 Creating   not removed                        
+
+`, test.MockUUID(1), test.MockUUID(2), test.MockUUID(3)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintPoolCleanupPartialResponse(&bld, tc.resp)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolCreateStatusResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.PoolCreateStatusResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil response"),
+		},
+		"running": {
+			resp: &control.PoolCreateStatusResp{
+				JobID:           test.MockUUID(9),
+				PoolUUID:        test.MockUUID(1),
+				State:           "running",
+				Stage:           "creating pool shards on 4 ranks",
+				CancelRequested: true,
+				Ranks: []*control.PoolCreateRankStatus{
+					{Rank: 0, State: "creating"},
+					{Rank: 1, State: "creating"},
+					{Rank: 2, State: "creating"},
+					{Rank: 5, State: "creating"},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool create job %s
+----------------------------------------------------
+  Pool UUID : %s
+  State     : running (cancel requested)          
+  Stage     : creating pool shards on 4 ranks     
+
+Ranks   State    
+-----   -----    
+[0-2,5] creating 
+
+`, test.MockUUID(9), test.MockUUID(1)),
+		},
+		"failed": {
+			resp: &control.PoolCreateStatusResp{
+				JobID:    test.MockUUID(9),
+				PoolUUID: test.MockUUID(1),
+				State:    "failed",
+				Stage:    "done",
+				Error:    "not enough space",
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool create job %s
+----------------------------------------------------
+  Pool UUID : %s
+  State     : failed                              
+  Stage     : done                                
+  Error     : not enough space                    
+
+`, test.MockUUID(9), test.MockUUID(1)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintPoolCreateStatusResponse(&bld, tc.resp)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
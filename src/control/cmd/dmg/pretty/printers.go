@@ -40,6 +40,19 @@ type (
 		ShowHostPorts bool
 		// LEDInfoOnly indicates that the output should only include LED related info.
 		LEDInfoOnly bool
+		// DevicePools indicates that SMD devices should be annotated with
+		// the pools that have targets on them.
+		DevicePools bool
+		// PoolDevices indicates that SMD pools should be annotated with
+		// the devices that back their targets.
+		PoolDevices bool
+		// VendorAttrs indicates that NVMe health output should include
+		// vendor-specific SMART attributes.
+		VendorAttrs bool
+		// TableFormat selects the representation of tabular output.
+		TableFormat txtfmt.TableFormat
+		// Columns restricts tabular output to the named columns.
+		Columns []string
 	}
 
 	// PrintConfigOption defines a config function.
@@ -73,6 +86,74 @@ func PrintOnlyLEDInfo() PrintConfigOption {
 	}
 }
 
+// PrintWithDevicePools enables display of the pools using each SMD device
+// in place of a separate pool listing.
+func PrintWithDevicePools() PrintConfigOption {
+	return func(cfg *PrintConfig) {
+		cfg.DevicePools = true
+	}
+}
+
+// PrintWithPoolDevices enables display of the devices backing each SMD pool
+// in place of a separate device listing.
+func PrintWithPoolDevices() PrintConfigOption {
+	return func(cfg *PrintConfig) {
+		cfg.PoolDevices = true
+	}
+}
+
+// PrintWithVendorAttrs enables display of vendor-specific SMART attributes
+// in NVMe device health output.
+func PrintWithVendorAttrs() PrintConfigOption {
+	return func(cfg *PrintConfig) {
+		cfg.VendorAttrs = true
+	}
+}
+
+// PrintWithTableFormat selects the representation of tabular output. Formats
+// other than the default text table include all available columns.
+func PrintWithTableFormat(format txtfmt.TableFormat) PrintConfigOption {
+	return func(cfg *PrintConfig) {
+		cfg.TableFormat = format
+	}
+}
+
+// PrintWithColumns restricts tabular output to the named columns, in the
+// order given.
+func PrintWithColumns(columns ...string) PrintConfigOption {
+	return func(cfg *PrintConfig) {
+		cfg.Columns = columns
+	}
+}
+
+// detailedTable returns true if tabular output should include all available
+// columns, as it does when verbose.
+func (cfg *PrintConfig) detailedTable() bool {
+	return cfg.Verbose || !(cfg.TableFormat == "" || cfg.TableFormat == txtfmt.TableFormatText)
+}
+
+// newTableFormatter returns a TableFormatter for the supplied column titles
+// with the configured table format and column selection applied.
+func newTableFormatter(cfg *PrintConfig, titles ...string) (*txtfmt.TableFormatter, error) {
+	formatter := txtfmt.NewTableFormatter(titles...)
+	formatter.SetFormat(cfg.TableFormat)
+	if err := formatter.SelectColumns(cfg.Columns...); err != nil {
+		return nil, err
+	}
+
+	return formatter, nil
+}
+
+// printTable writes the formatted table rows, separating text tables from any
+// following output with a blank line.
+func printTable(out io.Writer, formatter *txtfmt.TableFormatter, table []txtfmt.TableRow) {
+	if formatter.OutputFormat().IsText() {
+		fmt.Fprintln(out, formatter.Format(table))
+		return
+	}
+	fmt.Fprint(out, formatter.Format(table))
+}
+
 // getPrintConfig is a helper that returns a format configuration
 // for a format function.
 func getPrintConfig(opts ...PrintConfigOption) *PrintConfig {
@@ -121,13 +202,13 @@ func PrintHostErrorsMap(hem control.HostErrorsMap, out io.Writer, opts ...PrintC
 	tablePrint.InitWriter(out)
 	table := []txtfmt.TableRow{}
 
-	for _, errStr := range hem.Keys() {
-		errHosts := getPrintHosts(hem[errStr].HostSet.RangedString(), opts...)
+	for _, hes := range hem.ErrorSets() {
+		errHosts := getPrintHosts(hes.HostSet.RangedString(), opts...)
 		row := txtfmt.TableRow{setTitle: errHosts}
 
 		// Unpack the root cause error. If it's a fault,
 		// just print the description.
-		hostErr := errors.Cause(hem[errStr].HostError)
+		hostErr := errors.Cause(hes.HostError)
 		row[errTitle] = hostErr.Error()
 		if f, ok := hostErr.(*fault.Fault); ok {
 			row[errTitle] = f.Description
@@ -11,10 +11,9 @@ import (
 	"io"
 	"strings"
 
-	"github.com/dustin/go-humanize"
-
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/lib/units"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
@@ -100,6 +99,8 @@ func PrintHostStorageUsageMap(hsm control.HostStorageMap, out io.Writer) error {
 
 	tablePrint := txtfmt.NewTableFormatter(hostsTitle, scmTitle, scmFreeTitle,
 		scmUsageTitle, nvmeTitle, nvmeFreeTitle, nvmeUsageTitle)
+	tablePrint.SetColumnAlignRight(scmTitle, scmFreeTitle, scmUsageTitle, nvmeTitle,
+		nvmeFreeTitle, nvmeUsageTitle)
 	tablePrint.InitWriter(out)
 	table := []txtfmt.TableRow{}
 
@@ -108,19 +109,164 @@ func PrintHostStorageUsageMap(hsm control.HostStorageMap, out io.Writer) error {
 		hosts := getPrintHosts(hss.HostSet.RangedString())
 		row := txtfmt.TableRow{hostsTitle: hosts}
 		storage := hss.HostStorage
-		row[scmTitle] = humanize.Bytes(storage.ScmNamespaces.Total())
-		row[scmFreeTitle] = humanize.Bytes(storage.ScmNamespaces.Free())
+		row[scmTitle] = units.FormatBytes(storage.ScmNamespaces.Total())
+		row[scmFreeTitle] = units.FormatBytes(storage.ScmNamespaces.Free())
 		row[scmUsageTitle] = storage.ScmNamespaces.PercentUsage()
-		row[nvmeTitle] = humanize.Bytes(storage.NvmeDevices.Total())
-		row[nvmeFreeTitle] = humanize.Bytes(storage.NvmeDevices.Free())
+		row[nvmeTitle] = units.FormatBytes(storage.NvmeDevices.Total())
+		row[nvmeFreeTitle] = units.FormatBytes(storage.NvmeDevices.Free())
 		row[nvmeUsageTitle] = storage.NvmeDevices.PercentUsage()
 		table = append(table, row)
 	}
 
+	// Hosts with differing storage details may still report identical usage.
+	table, err := txtfmt.FoldHostRows(table, hostsTitle)
+	if err != nil {
+		return err
+	}
+
+	tablePrint.Format(table)
+	return nil
+}
+
+// allocatedBytes returns the bytes allocated to pools out of the total, which
+// is whatever is neither free nor reserved.
+func allocatedBytes(total, free, reserved uint64) uint64 {
+	if free+reserved > total {
+		return 0
+	}
+	return total - free - reserved
+}
+
+// PrintHostStorageAllocationMap generates a human-readable representation of the
+// supplied HostStorageMap struct and writes the space allocated to pools, free
+// and reserved for DAOS metadata on each host to the supplied io.Writer, along
+// with the number of VOS pool shards held by the host's engines.
+func PrintHostStorageAllocationMap(hsm control.HostStorageMap, out io.Writer) error {
+	if len(hsm) == 0 {
+		return nil
+	}
+
+	hostsTitle := "Hosts"
+	scmTitle := "SCM-Total"
+	scmAllocTitle := "SCM-Allocated"
+	scmFreeTitle := "SCM-Free"
+	scmRsvdTitle := "SCM-Reserved"
+	nvmeTitle := "NVMe-Total"
+	nvmeAllocTitle := "NVMe-Allocated"
+	nvmeFreeTitle := "NVMe-Free"
+	nvmeRsvdTitle := "NVMe-Reserved"
+	shardsTitle := "Pool-Shards"
+
+	tablePrint := txtfmt.NewTableFormatter(hostsTitle, scmTitle, scmAllocTitle, scmFreeTitle,
+		scmRsvdTitle, nvmeTitle, nvmeAllocTitle, nvmeFreeTitle, nvmeRsvdTitle, shardsTitle)
+	tablePrint.SetColumnAlignRight(scmTitle, scmAllocTitle, scmFreeTitle, scmRsvdTitle,
+		nvmeTitle, nvmeAllocTitle, nvmeFreeTitle, nvmeRsvdTitle, shardsTitle)
+	tablePrint.InitWriter(out)
+	table := []txtfmt.TableRow{}
+
+	for _, key := range hsm.Keys() {
+		hss := hsm[key]
+		hosts := getPrintHosts(hss.HostSet.RangedString())
+		row := txtfmt.TableRow{hostsTitle: hosts}
+		scm := hss.HostStorage.ScmNamespaces
+		row[scmTitle] = units.FormatBytes(scm.Total())
+		row[scmAllocTitle] = units.FormatBytes(allocatedBytes(scm.Total(), scm.Free(),
+			scm.Reserved()))
+		row[scmFreeTitle] = units.FormatBytes(scm.Free())
+		row[scmRsvdTitle] = units.FormatBytes(scm.Reserved())
+		nvme := hss.HostStorage.NvmeDevices
+		row[nvmeTitle] = units.FormatBytes(nvme.Total())
+		row[nvmeAllocTitle] = units.FormatBytes(allocatedBytes(nvme.Total(), nvme.Free(),
+			nvme.Reserved()))
+		row[nvmeFreeTitle] = units.FormatBytes(nvme.Free())
+		row[nvmeRsvdTitle] = units.FormatBytes(nvme.Reserved())
+		row[shardsTitle] = fmt.Sprintf("%d", scm.PoolShards())
+		table = append(table, row)
+	}
+
+	// Hosts with differing storage details may still report identical usage.
+	table, err := txtfmt.FoldHostRows(table, hostsTitle)
+	if err != nil {
+		return err
+	}
+
 	tablePrint.Format(table)
 	return nil
 }
 
+// PrintHostBlobstoreUsage generates a human-readable representation of the
+// per-device and per-target SPDK blobstore usage in the supplied
+// BlobstoreQueryResp and writes it to the supplied io.Writer.
+func PrintHostBlobstoreUsage(resp *control.BlobstoreQueryResp, out io.Writer, opts ...PrintConfigOption) error {
+	if resp == nil {
+		return nil
+	}
+
+	rankTitle := "Rank"
+	devTitle := "Device UUID"
+	clusterTitle := "Cluster-Size"
+	totalTitle := "Total"
+	usedTitle := "Used"
+	freeTitle := "Free"
+	untrackedTitle := "Untracked"
+	tgtTitle := "Target"
+	blobsTitle := "Blobs"
+
+	for _, host := range resp.Hosts() {
+		usage := resp.HostBlobstores[host]
+		hosts := getPrintHosts(host, opts...)
+		lineBreak := strings.Repeat("-", len(hosts))
+		fmt.Fprintf(out, "%s\n%s\n%s\n", lineBreak, hosts, lineBreak)
+		if len(usage) == 0 {
+			fmt.Fprintln(out, "  No blobstores found")
+			continue
+		}
+
+		devPrint := txtfmt.NewTableFormatter(rankTitle, devTitle, clusterTitle, totalTitle,
+			usedTitle, freeTitle, untrackedTitle)
+		devPrint.InitWriter(out)
+		tgtPrint := txtfmt.NewTableFormatter(rankTitle, devTitle, tgtTitle, blobsTitle,
+			usedTitle)
+		tgtPrint.InitWriter(out)
+
+		var devTable, tgtTable []txtfmt.TableRow
+		for _, bu := range usage {
+			clusters := func(n uint64) string {
+				return units.FormatBytes(n * bu.ClusterSize)
+			}
+
+			devTable = append(devTable, txtfmt.TableRow{
+				rankTitle:      bu.Rank.String(),
+				devTitle:       bu.DevUUID,
+				clusterTitle:   units.FormatIBytes(bu.ClusterSize),
+				totalTitle:     clusters(bu.TotalClusters),
+				usedTitle:      clusters(bu.UsedClusters()),
+				freeTitle:      clusters(bu.FreeClusters),
+				untrackedTitle: clusters(bu.UntrackedClusters()),
+			})
+
+			for _, tu := range bu.Targets {
+				tgtTable = append(tgtTable, txtfmt.TableRow{
+					rankTitle:  bu.Rank.String(),
+					devTitle:   bu.DevUUID,
+					tgtTitle:   fmt.Sprintf("%d", tu.TargetID),
+					blobsTitle: fmt.Sprintf("%d", tu.Blobs),
+					usedTitle:  clusters(tu.UsedClusters),
+				})
+			}
+		}
+
+		devPrint.Format(devTable)
+		fmt.Fprintln(out)
+		if len(tgtTable) != 0 {
+			tgtPrint.Format(tgtTable)
+			fmt.Fprintln(out)
+		}
+	}
+
+	return nil
+}
+
 func printStorageFormatMapVerbose(hsm control.HostStorageMap, out io.Writer, opts ...PrintConfigOption) error {
 	for _, key := range hsm.Keys() {
 		hss := hsm[key]
@@ -218,8 +364,12 @@ func printSmdPool(pool *control.SmdPool, out io.Writer, opts ...PrintConfigOptio
 
 // PrintSmdInfoMap generates a human-readable representation of the supplied
 // HostStorageMap, with a focus on presenting the per-server metadata (SMD) information.
+//
+// If device to pool mapping is requested via print options, the mapped-to
+// entities are shown inline and not listed separately.
 func PrintSmdInfoMap(omitDevs, omitPools bool, hsm control.HostStorageMap, out io.Writer, opts ...PrintConfigOption) error {
 	w := txtfmt.NewErrWriter(out)
+	cfg := getPrintConfig(opts...)
 
 	for _, key := range hsm.Keys() {
 		hss := hsm[key]
@@ -233,7 +383,7 @@ func PrintSmdInfoMap(omitDevs, omitPools bool, hsm control.HostStorageMap, out i
 			continue
 		}
 
-		if !omitDevs {
+		if !omitDevs && !cfg.PoolDevices {
 			if len(hss.HostStorage.SmdInfo.Devices) > 0 {
 				fmt.Fprintln(iw, "Devices")
 
@@ -242,6 +392,10 @@ func PrintSmdInfoMap(omitDevs, omitPools bool, hsm control.HostStorageMap, out i
 					if err := printSmdDevice(device, iw1, opts...); err != nil {
 						return err
 					}
+					if cfg.DevicePools {
+						pools := hss.HostStorage.SmdInfo.DevicePools(device)
+						fmt.Fprintf(txtfmt.NewIndentWriter(iw1), "Pools:%+v\n", pools)
+					}
 					if device.Health != nil {
 						iw2 := txtfmt.NewIndentWriter(iw1)
 						if err := printNvmeHealth(device.Health, iw2, opts...); err != nil {
@@ -255,7 +409,7 @@ func PrintSmdInfoMap(omitDevs, omitPools bool, hsm control.HostStorageMap, out i
 			}
 		}
 
-		if !omitPools {
+		if !omitPools && !cfg.DevicePools {
 			if len(hss.HostStorage.SmdInfo.Pools) > 0 {
 				fmt.Fprintln(iw, "Pools")
 
@@ -267,6 +421,13 @@ func PrintSmdInfoMap(omitDevs, omitPools bool, hsm control.HostStorageMap, out i
 						if err := printSmdPool(pool, iw2, opts...); err != nil {
 							return err
 						}
+						if cfg.PoolDevices {
+							iw3 := txtfmt.NewIndentWriter(iw2)
+							for _, dev := range hss.HostStorage.SmdInfo.PoolDevices(pool) {
+								fmt.Fprintf(iw3, "Device:%s [TrAddr:%s] State:%s LED:%s\n",
+									dev.UUID, dev.TrAddr, dev.NvmeState, dev.LedState)
+							}
+						}
 					}
 					fmt.Fprintln(out)
 				}
@@ -13,11 +13,10 @@ import (
 	"strings"
 	"time"
 
-	"github.com/dustin/go-humanize"
-
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/lib/units"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
@@ -25,7 +24,7 @@ func printNvmeControllerSummary(nvme *storage.NvmeController, out io.Writer, opt
 	w := txtfmt.NewErrWriter(out)
 
 	if _, err := fmt.Fprintf(out, "PCI:%s Model:%s FW:%s Socket:%d Capacity:%s\n",
-		nvme.PciAddr, nvme.Model, nvme.FwRev, nvme.SocketID, humanize.Bytes(nvme.Capacity())); err != nil {
+		nvme.PciAddr, nvme.Model, nvme.FwRev, nvme.SocketID, units.FormatBytes(nvme.Capacity())); err != nil {
 		return err
 	}
 
@@ -78,6 +77,13 @@ func printNvmeHealth(stat *storage.NvmeHealth, out io.Writer, opts ...PrintConfi
 		fmt.Fprintf(iw, "Checksum Errors:%d\n", uint64(stat.ChecksumErrors))
 	}
 	fmt.Fprintf(iw, "Error Log Entries:%d\n", uint64(stat.ErrorLogEntries))
+	// A zero spare threshold indicates that the standard SMART spare and
+	// wear attributes were not retrieved, e.g. from an older engine.
+	if stat.AvailSpareThreshold > 0 {
+		fmt.Fprintf(iw, "Available Spare:%d%% (threshold:%d%%)\n",
+			stat.AvailSpare, stat.AvailSpareThreshold)
+		fmt.Fprintf(iw, "Percentage Used:%d%%\n", stat.PercentUsed)
+	}
 
 	fmt.Fprintf(out, "Critical Warnings:\n")
 	fmt.Fprintf(iw, "Temperature: ")
@@ -111,8 +117,12 @@ func printNvmeHealth(stat *storage.NvmeHealth, out io.Writer, opts ...PrintConfi
 		fmt.Fprintf(iw, "OK\n")
 	}
 
-	if stat.ProgFailCntNorm > 0 {
-		fmt.Fprintf(out, "Intel Vendor SMART Attributes:\n")
+	switch {
+	case !getPrintConfig(opts...).VendorAttrs:
+	case !stat.HasVendorAttrs():
+		fmt.Fprintf(out, "Vendor SMART Attributes: Unavailable\n")
+	default:
+		fmt.Fprintf(out, "Vendor SMART Attributes:\n")
 		fmt.Fprintf(iw, "Program Fail Count:\n")
 		fmt.Fprintf(iw, "   Normalized:%d%%\n",
 			uint8(stat.ProgFailCntNorm))
@@ -226,7 +236,7 @@ func PrintNvmeControllers(controllers storage.NvmeControllers, out io.Writer, op
 		row[modelTitle] = ctrlr.Model
 		row[fwTitle] = ctrlr.FwRev
 		row[socketTitle] = fmt.Sprint(ctrlr.SocketID)
-		row[capacityTitle] = humanize.Bytes(ctrlr.Capacity())
+		row[capacityTitle] = units.FormatBytes(ctrlr.Capacity())
 
 		table = append(table, row)
 	}
@@ -77,8 +77,19 @@ func TestPretty_PrintNVMeHealthMap(t *testing.T) {
 	controllerAwTS.HealthStats.Timestamp = tt
 	ttStr := getTimestampString(tt)
 
+	controllerNoVendor := storage.MockNvmeController(3)
+	controllerNoVendor.HealthStats.ProgFailCntNorm = 0
+	controllerNoVendor.HealthStats.EraseFailCntNorm = 0
+	controllerNoVendor.HealthStats.WearLevelingCntNorm = 0
+
+	controllerSpare := storage.MockNvmeController(1)
+	controllerSpare.HealthStats.AvailSpare = 95
+	controllerSpare.HealthStats.AvailSpareThreshold = 10
+	controllerSpare.HealthStats.PercentUsed = 3
+
 	for name, tc := range map[string]struct {
 		hsm         control.HostStorageMap
+		opts        []PrintConfigOption
 		expPrintStr string
 	}{
 		"no devices": {
@@ -91,6 +102,7 @@ host1
 `,
 		},
 		"1 host; 2 devices": {
+			opts: []PrintConfigOption{PrintWithVendorAttrs()},
 			hsm: mockHostStorageMap(t,
 				&mockHostStorage{
 					"host1",
@@ -123,7 +135,7 @@ PCI:%s Model:%s FW:%s Socket:%d Capacity:%s
     Device Reliability: WARNING
     Read Only: WARNING
     Volatile Memory Backup: WARNING
-  Intel Vendor SMART Attributes:
+  Vendor SMART Attributes:
     Program Fail Count:
        Normalized:%d%s
        Raw:%d
@@ -164,7 +176,7 @@ PCI:%s Model:%s FW:%s Socket:%d Capacity:%s
     Device Reliability: WARNING
     Read Only: WARNING
     Volatile Memory Backup: WARNING
-  Intel Vendor SMART Attributes:
+  Vendor SMART Attributes:
     Program Fail Count:
        Normalized:%d%s
        Raw:%d
@@ -231,6 +243,7 @@ PCI:%s Model:%s FW:%s Socket:%d Capacity:%s
 			),
 		},
 		"1 host; 1 device, fetched over drpc": {
+			opts: []PrintConfigOption{PrintWithVendorAttrs()},
 			hsm: mockHostStorageMap(t,
 				&mockHostStorage{
 					"host1",
@@ -267,7 +280,7 @@ PCI:%s Model:%s FW:%s Socket:%d Capacity:%s
     Device Reliability: WARNING
     Read Only: WARNING
     Volatile Memory Backup: WARNING
-  Intel Vendor SMART Attributes:
+  Vendor SMART Attributes:
     Program Fail Count:
        Normalized:%d%s
        Raw:%d
@@ -315,10 +328,146 @@ PCI:%s Model:%s FW:%s Socket:%d Capacity:%s
 				controllerAwTS.HealthStats.NandBytesWritten, controllerAwTS.HealthStats.HostBytesWritten,
 			),
 		},
+		"vendor attributes not requested": {
+			hsm: mockHostStorageMap(t,
+				&mockHostStorage{
+					"host1",
+					&control.HostStorage{
+						NvmeDevices: storage.NvmeControllers{
+							controllerA,
+						},
+					},
+				},
+			),
+			expPrintStr: fmt.Sprintf(`
+-----
+host1
+-----
+PCI:%s Model:%s FW:%s Socket:%d Capacity:%s
+  Health Stats:
+    Temperature:%dK(%.02fC)
+    Temperature Warning Duration:%dm0s
+    Temperature Critical Duration:%dm0s
+    Controller Busy Time:%dm0s
+    Power Cycles:%d
+    Power On Duration:%s
+    Unsafe Shutdowns:%d
+    Media Errors:%d
+    Error Log Entries:%d
+  Critical Warnings:
+    Temperature: WARNING
+    Available Spare: WARNING
+    Device Reliability: WARNING
+    Read Only: WARNING
+    Volatile Memory Backup: WARNING
+
+`,
+				controllerA.PciAddr, controllerA.Model, controllerA.FwRev,
+				controllerA.SocketID, humanize.Bytes(controllerA.Capacity()),
+				controllerA.HealthStats.TempK(), controllerA.HealthStats.TempC(),
+				controllerA.HealthStats.TempWarnTime, controllerA.HealthStats.TempCritTime,
+				controllerA.HealthStats.CtrlBusyTime, controllerA.HealthStats.PowerCycles,
+				time.Duration(controllerA.HealthStats.PowerOnHours)*time.Hour,
+				controllerA.HealthStats.UnsafeShutdowns, controllerA.HealthStats.MediaErrors,
+				controllerA.HealthStats.ErrorLogEntries,
+			),
+		},
+		"vendor attributes unsupported by device": {
+			opts: []PrintConfigOption{PrintWithVendorAttrs()},
+			hsm: mockHostStorageMap(t,
+				&mockHostStorage{
+					"host1",
+					&control.HostStorage{
+						NvmeDevices: storage.NvmeControllers{
+							controllerNoVendor,
+						},
+					},
+				},
+			),
+			expPrintStr: fmt.Sprintf(`
+-----
+host1
+-----
+PCI:%s Model:%s FW:%s Socket:%d Capacity:%s
+  Health Stats:
+    Temperature:%dK(%.02fC)
+    Temperature Warning Duration:%dm0s
+    Temperature Critical Duration:%dm0s
+    Controller Busy Time:%dm0s
+    Power Cycles:%d
+    Power On Duration:%s
+    Unsafe Shutdowns:%d
+    Media Errors:%d
+    Error Log Entries:%d
+  Critical Warnings:
+    Temperature: WARNING
+    Available Spare: WARNING
+    Device Reliability: WARNING
+    Read Only: WARNING
+    Volatile Memory Backup: WARNING
+  Vendor SMART Attributes: Unavailable
+
+`,
+				controllerNoVendor.PciAddr, controllerNoVendor.Model, controllerNoVendor.FwRev,
+				controllerNoVendor.SocketID, humanize.Bytes(controllerNoVendor.Capacity()),
+				controllerNoVendor.HealthStats.TempK(), controllerNoVendor.HealthStats.TempC(),
+				controllerNoVendor.HealthStats.TempWarnTime, controllerNoVendor.HealthStats.TempCritTime,
+				controllerNoVendor.HealthStats.CtrlBusyTime, controllerNoVendor.HealthStats.PowerCycles,
+				time.Duration(controllerNoVendor.HealthStats.PowerOnHours)*time.Hour,
+				controllerNoVendor.HealthStats.UnsafeShutdowns, controllerNoVendor.HealthStats.MediaErrors,
+				controllerNoVendor.HealthStats.ErrorLogEntries,
+			),
+		},
+		"spare and wear attributes": {
+			hsm: mockHostStorageMap(t,
+				&mockHostStorage{
+					"host1",
+					&control.HostStorage{
+						NvmeDevices: storage.NvmeControllers{
+							controllerSpare,
+						},
+					},
+				},
+			),
+			expPrintStr: fmt.Sprintf(`
+-----
+host1
+-----
+PCI:%s Model:%s FW:%s Socket:%d Capacity:%s
+  Health Stats:
+    Temperature:%dK(%.02fC)
+    Temperature Warning Duration:%dm0s
+    Temperature Critical Duration:%dm0s
+    Controller Busy Time:%dm0s
+    Power Cycles:%d
+    Power On Duration:%s
+    Unsafe Shutdowns:%d
+    Media Errors:%d
+    Error Log Entries:%d
+    Available Spare:95%% (threshold:10%%)
+    Percentage Used:3%%
+  Critical Warnings:
+    Temperature: WARNING
+    Available Spare: WARNING
+    Device Reliability: WARNING
+    Read Only: WARNING
+    Volatile Memory Backup: WARNING
+
+`,
+				controllerSpare.PciAddr, controllerSpare.Model, controllerSpare.FwRev,
+				controllerSpare.SocketID, humanize.Bytes(controllerSpare.Capacity()),
+				controllerSpare.HealthStats.TempK(), controllerSpare.HealthStats.TempC(),
+				controllerSpare.HealthStats.TempWarnTime, controllerSpare.HealthStats.TempCritTime,
+				controllerSpare.HealthStats.CtrlBusyTime, controllerSpare.HealthStats.PowerCycles,
+				time.Duration(controllerSpare.HealthStats.PowerOnHours)*time.Hour,
+				controllerSpare.HealthStats.UnsafeShutdowns, controllerSpare.HealthStats.MediaErrors,
+				controllerSpare.HealthStats.ErrorLogEntries,
+			),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			var bld strings.Builder
-			if err := PrintNvmeHealthMap(tc.hsm, &bld); err != nil {
+			if err := PrintNvmeHealthMap(tc.hsm, &bld, tc.opts...); err != nil {
 				t.Fatal(err)
 			}
 
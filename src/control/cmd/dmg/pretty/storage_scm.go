@@ -11,9 +11,8 @@ import (
 	"io"
 	"sort"
 
-	"github.com/dustin/go-humanize"
-
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/lib/units"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
@@ -75,7 +74,7 @@ func PrintScmModules(modules storage.ScmModules, out io.Writer, opts ...PrintCon
 		row[memCtrlrTitle] = fmt.Sprint(m.ControllerID)
 		row[channelTitle] = fmt.Sprint(m.ChannelID)
 		row[slotTitle] = fmt.Sprint(m.ChannelPosition)
-		row[capacityTitle] = humanize.IBytes(m.Capacity)
+		row[capacityTitle] = units.FormatIBytes(m.Capacity)
 
 		table = append(table, row)
 	}
@@ -108,7 +107,7 @@ func PrintScmNamespaces(namespaces storage.ScmNamespaces, out io.Writer, opts ..
 	for _, ns := range namespaces {
 		row := txtfmt.TableRow{deviceTitle: ns.BlockDevice}
 		row[socketTitle] = fmt.Sprint(ns.NumaNode)
-		row[capacityTitle] = humanize.Bytes(ns.Size)
+		row[capacityTitle] = units.FormatBytes(ns.Size)
 
 		table = append(table, row)
 	}
@@ -14,10 +14,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
@@ -923,7 +925,7 @@ Errors:
 
 Hosts     SCM-Total SCM-Free SCM-Used NVMe-Total NVMe-Free NVMe-Used 
 -----     --------- -------- -------- ---------- --------- --------- 
-host[1-2] 0 B       0 B      N/A      0 B        0 B       N/A       
+host[1-2]       0 B      0 B      N/A        0 B       0 B       N/A 
 `,
 		},
 		"no storage": {
@@ -940,7 +942,7 @@ host[1-2] 0 B       0 B      N/A      0 B        0 B       N/A
 			expPrintStr: `
 Hosts SCM-Total SCM-Free SCM-Used NVMe-Total NVMe-Free NVMe-Used 
 ----- --------- -------- -------- ---------- --------- --------- 
-host1 0 B       0 B      N/A      0 B        0 B       N/A       
+host1       0 B      0 B      N/A        0 B       0 B       N/A 
 `,
 		},
 		"single host with space usage": {
@@ -957,7 +959,7 @@ host1 0 B       0 B      N/A      0 B        0 B       N/A
 			expPrintStr: `
 Hosts SCM-Total SCM-Free SCM-Used NVMe-Total NVMe-Free NVMe-Used 
 ----- --------- -------- -------- ---------- --------- --------- 
-host1 3.0 TB    750 GB   75 %     36 TB      27 TB     25 %      
+host1    3.0 TB   750 GB     75 %      36 TB     27 TB      25 % 
 `,
 		},
 	} {
@@ -988,6 +990,162 @@ host1 3.0 TB    750 GB   75 %     36 TB      27 TB     25 %
 	}
 }
 
+func TestPretty_PrintHostStorageAllocationMap(t *testing.T) {
+	withUsage := func(pciAddr string) *control.HostStorage {
+		return &control.HostStorage{
+			ScmNamespaces: storage.ScmNamespaces{
+				{
+					BlockDevice: "pmem0",
+					Mount: &storage.ScmMountPoint{
+						Path:          "/mnt/daos0",
+						TotalBytes:    100 * humanize.GByte,
+						AvailBytes:    60 * humanize.GByte,
+						ReservedBytes: 10 * humanize.GByte,
+						PoolShards:    24,
+					},
+				},
+			},
+			NvmeDevices: storage.NvmeControllers{
+				{
+					PciAddr: pciAddr,
+					SmdDevices: []*storage.SmdDevice{
+						{
+							UUID:          test.MockUUID(),
+							TotalBytes:    1000 * humanize.GByte,
+							AvailBytes:    400 * humanize.GByte,
+							ReservedBytes: 4 * humanize.GByte,
+							PoolShards:    24,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		hsm         control.HostStorageMap
+		expPrintStr string
+	}{
+		"empty map": {
+			hsm: control.HostStorageMap{},
+		},
+		"no storage": {
+			hsm: mockHostStorageMap(t, &mockHostStorage{"host1", &control.HostStorage{}}),
+			expPrintStr: `
+Hosts SCM-Total SCM-Allocated SCM-Free SCM-Reserved NVMe-Total NVMe-Allocated NVMe-Free NVMe-Reserved Pool-Shards 
+----- --------- ------------- -------- ------------ ---------- -------------- --------- ------------- ----------- 
+host1       0 B           0 B      0 B          0 B        0 B            0 B       0 B           0 B           0 
+`,
+		},
+		"differing hosts with identical usage": {
+			hsm: mockHostStorageMap(t,
+				&mockHostStorage{"host1", withUsage("0000:01:00.0")},
+				&mockHostStorage{"host2", withUsage("0000:02:00.0")},
+			),
+			expPrintStr: `
+Hosts     SCM-Total SCM-Allocated SCM-Free SCM-Reserved NVMe-Total NVMe-Allocated NVMe-Free NVMe-Reserved Pool-Shards 
+-----     --------- ------------- -------- ------------ ---------- -------------- --------- ------------- ----------- 
+host[1-2]    100 GB         30 GB    60 GB        10 GB     1.0 TB         596 GB    400 GB        4.0 GB          24 
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintHostStorageAllocationMap(tc.hsm, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintHostBlobstoreUsage(t *testing.T) {
+	usage := func(rank uint32, idx int32) *control.BlobstoreUsage {
+		return &control.BlobstoreUsage{
+			Rank:          ranklist.Rank(rank),
+			DevUUID:       test.MockUUID(idx),
+			ClusterSize:   humanize.GiByte,
+			TotalClusters: 1024,
+			FreeClusters:  512,
+			Targets: []*control.BlobstoreTargetUsage{
+				{TargetID: 0, UsedClusters: 200, Blobs: 2},
+				{TargetID: 1, UsedClusters: 300, Blobs: 3},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.BlobstoreQueryResp
+		expPrintStr string
+	}{
+		"nil response": {},
+		"no blobstores": {
+			resp: &control.BlobstoreQueryResp{
+				HostBlobstores: map[string][]*control.BlobstoreUsage{
+					"host1:10001": nil,
+				},
+			},
+			expPrintStr: `
+-----
+host1
+-----
+  No blobstores found
+`,
+		},
+		"two hosts": {
+			resp: &control.BlobstoreQueryResp{
+				HostBlobstores: map[string][]*control.BlobstoreUsage{
+					"host2:10001": {usage(2, 2)},
+					"host1:10001": {usage(0, 0), usage(1, 1)},
+				},
+			},
+			expPrintStr: `
+-----
+host1
+-----
+Rank Device UUID                          Cluster-Size Total  Used   Free   Untracked 
+---- -----------                          ------------ -----  ----   ----   --------- 
+0    00000000-0000-0000-0000-000000000000 1.0 GiB      1.1 TB 550 GB 550 GB 13 GB     
+1    00000001-0001-0001-0001-000000000001 1.0 GiB      1.1 TB 550 GB 550 GB 13 GB     
+
+Rank Device UUID                          Target Blobs Used   
+---- -----------                          ------ ----- ----   
+0    00000000-0000-0000-0000-000000000000 0      2     215 GB 
+0    00000000-0000-0000-0000-000000000000 1      3     322 GB 
+1    00000001-0001-0001-0001-000000000001 0      2     215 GB 
+1    00000001-0001-0001-0001-000000000001 1      3     322 GB 
+
+-----
+host2
+-----
+Rank Device UUID                          Cluster-Size Total  Used   Free   Untracked 
+---- -----------                          ------------ -----  ----   ----   --------- 
+2    00000002-0002-0002-0002-000000000002 1.0 GiB      1.1 TB 550 GB 550 GB 13 GB     
+
+Rank Device UUID                          Target Blobs Used   
+---- -----------                          ------ ----- ----   
+2    00000002-0002-0002-0002-000000000002 0      2     215 GB 
+2    00000002-0002-0002-0002-000000000002 1      3     322 GB 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintHostBlobstoreUsage(tc.resp, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_PrintStorageFormatResponse(t *testing.T) {
 	for name, tc := range map[string]struct {
 		resp        *control.StorageFormatResp
@@ -1453,10 +1611,118 @@ host1
 host1
 -----
   No devices found
+`,
+		},
+		"list-devices (show pools)": {
+			opts: []PrintConfigOption{PrintWithDevicePools()},
+			hsm: mockHostStorageMap(t,
+				&mockHostStorage{
+					"host1",
+					&control.HostStorage{
+						SmdInfo: &control.SmdInfo{
+							Devices: []*storage.SmdDevice{
+								{
+									UUID:      test.MockUUID(0),
+									TrAddr:    "0000:8a:00.0",
+									TargetIDs: []int32{0, 1},
+									Rank:      0,
+									NvmeState: storage.NvmeStateNormal,
+									LedState:  storage.LedStateNormal,
+								},
+								{
+									UUID:      test.MockUUID(1),
+									TrAddr:    "0000:8b:00.0",
+									TargetIDs: []int32{2, 3},
+									Rank:      0,
+									NvmeState: storage.NvmeStateFaulty,
+									LedState:  storage.LedStateFaulty,
+								},
+							},
+							Pools: control.SmdPoolMap{
+								test.MockUUID(1): {
+									{
+										UUID:      test.MockUUID(1),
+										Rank:      0,
+										TargetIDs: []int32{0, 1, 2, 3},
+									},
+								},
+								test.MockUUID(0): {
+									{
+										UUID:      test.MockUUID(0),
+										Rank:      0,
+										TargetIDs: []int32{1},
+									},
+								},
+							},
+						},
+					},
+				},
+			),
+			expPrintStr: `
+-----
+host1
+-----
+  Devices
+    UUID:00000000-0000-0000-0000-000000000000 [TrAddr:0000:8a:00.0]
+      Targets:[0 1] Rank:0 State:NORMAL LED:OFF
+      Pools:[00000000-0000-0000-0000-000000000000 00000001-0001-0001-0001-000000000001]
+    UUID:00000001-0001-0001-0001-000000000001 [TrAddr:0000:8b:00.0]
+      Targets:[2 3] Rank:0 State:EVICTED LED:ON
+      Pools:[00000001-0001-0001-0001-000000000001]
+`,
+		},
+		"list-pools (show devices)": {
+			opts: []PrintConfigOption{PrintWithPoolDevices()},
+			hsm: mockHostStorageMap(t,
+				&mockHostStorage{
+					"host1",
+					&control.HostStorage{
+						SmdInfo: &control.SmdInfo{
+							Devices: []*storage.SmdDevice{
+								{
+									UUID:      test.MockUUID(0),
+									TrAddr:    "0000:8a:00.0",
+									TargetIDs: []int32{0, 1},
+									Rank:      0,
+									NvmeState: storage.NvmeStateNormal,
+									LedState:  storage.LedStateNormal,
+								},
+								{
+									UUID:      test.MockUUID(1),
+									TrAddr:    "0000:8b:00.0",
+									TargetIDs: []int32{0, 1},
+									Rank:      1,
+									NvmeState: storage.NvmeStateFaulty,
+									LedState:  storage.LedStateFaulty,
+								},
+							},
+							Pools: control.SmdPoolMap{
+								test.MockUUID(0): {
+									{
+										UUID:      test.MockUUID(0),
+										Rank:      1,
+										TargetIDs: []int32{0, 1},
+									},
+								},
+							},
+						},
+					},
+				},
+			),
+			expPrintStr: `
+-----
+host1
+-----
+  Pools
+    UUID:00000000-0000-0000-0000-000000000000
+      Rank:1 Targets:[0 1]
+        Device:00000001-0001-0001-0001-000000000001 [TrAddr:0000:8b:00.0] State:EVICTED LED:ON
+
 `,
 		},
 		"device-health": {
 			noPools: true,
+			opts:    []PrintConfigOption{PrintWithVendorAttrs()},
 			hsm: mockHostStorageMap(t,
 				&mockHostStorage{
 					"host1",
@@ -1499,7 +1765,7 @@ host1
         Device Reliability: WARNING
         Read Only: WARNING
         Volatile Memory Backup: WARNING
-      Intel Vendor SMART Attributes:
+      Vendor SMART Attributes:
         Program Fail Count:
            Normalized:%d%s
            Raw:%d
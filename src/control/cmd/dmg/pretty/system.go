@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
@@ -18,18 +19,22 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/lib/units"
 	"github.com/daos-stack/daos/src/control/system"
 )
 
 // tabulateRankGroups produces a representation of rank groupings in a tabular form.
-func tabulateRankGroups(out io.Writer, groups system.RankGroups, titles ...string) error {
+func tabulateRankGroups(out io.Writer, groups system.RankGroups, cfg *PrintConfig, titles ...string) error {
 	if len(titles) < 2 {
 		return errors.New("insufficient number of column titles")
 	}
 	groupTitle := titles[0]
 	columnTitles := titles[1:]
 
-	formatter := txtfmt.NewTableFormatter(titles...)
+	formatter, err := newTableFormatter(cfg, titles...)
+	if err != nil {
+		return err
+	}
 	var table []txtfmt.TableRow
 
 	for _, result := range groups.Keys() {
@@ -46,7 +51,7 @@ func tabulateRankGroups(out io.Writer, groups system.RankGroups, titles ...strin
 		table = append(table, row)
 	}
 
-	fmt.Fprintln(out, formatter.Format(table))
+	printTable(out, formatter, table)
 
 	return nil
 }
@@ -67,7 +72,7 @@ func printAbsentRanks(out io.Writer, absentRanks *ranklist.RankSet) {
 	}
 }
 
-func printSystemQuery(out io.Writer, members system.Members, absentRanks *ranklist.RankSet) error {
+func printSystemQuery(out io.Writer, members system.Members, absentRanks *ranklist.RankSet, cfg *PrintConfig) error {
 	groups := make(system.RankGroups)
 	if err := groups.FromMembers(members); err != nil {
 		return err
@@ -77,36 +82,67 @@ func printSystemQuery(out io.Writer, members system.Members, absentRanks *rankli
 		groups["Unknown Rank"] = absentRanks
 	}
 
-	if err := tabulateRankGroups(out, groups, "Rank", "State"); err != nil {
+	if err := tabulateRankGroups(out, groups, cfg, "Rank", "State"); err != nil {
 		return errors.Wrap(err, "printing state table")
 	}
 
 	return nil
 }
 
-func printSystemQueryVerbose(out io.Writer, members system.Members) {
+// maxReasonWidth is the width beyond which member state change reasons and
+// annotations are wrapped in tabular output.
+const maxReasonWidth = 50
+
+func printSystemQueryVerbose(out io.Writer, members system.Members, cfg *PrintConfig) error {
 	rankTitle := "Rank"
+	aliasTitle := "Alias"
 	uuidTitle := "UUID"
 	addrTitle := "Control Address"
 	faultDomainTitle := "Fault Domain"
 	stateTitle := "State"
 	reasonTitle := "Reason"
+	notesTitle := "Notes"
 
-	formatter := txtfmt.NewTableFormatter(rankTitle, uuidTitle, addrTitle, faultDomainTitle, stateTitle, reasonTitle)
+	// Only display the alias and notes columns if any of the members have
+	// an alias or annotations.
+	var hasAlias, hasNotes bool
+	for _, m := range members {
+		hasAlias = hasAlias || m.Alias != ""
+		hasNotes = hasNotes || len(m.Annotations) > 0
+	}
+	titles := []string{rankTitle}
+	if hasAlias {
+		titles = append(titles, aliasTitle)
+	}
+	titles = append(titles, uuidTitle, addrTitle, faultDomainTitle, stateTitle, reasonTitle)
+	if hasNotes {
+		titles = append(titles, notesTitle)
+	}
+
+	formatter, err := newTableFormatter(cfg, titles...)
+	if err != nil {
+		return err
+	}
+	formatter.SetColumnMaxWidth(reasonTitle, maxReasonWidth)
+	formatter.SetColumnMaxWidth(notesTitle, maxReasonWidth)
 	var table []txtfmt.TableRow
 
 	for _, m := range members {
 		row := txtfmt.TableRow{rankTitle: fmt.Sprintf("%d", m.Rank)}
+		row[aliasTitle] = m.Alias
 		row[uuidTitle] = m.UUID.String()
 		row[addrTitle] = m.Addr.String()
 		row[faultDomainTitle] = m.FaultDomain.String()
 		row[stateTitle] = m.State.String()
 		row[reasonTitle] = m.Info
+		row[notesTitle] = system.FormatAnnotations(m.Annotations)
 
 		table = append(table, row)
 	}
 
-	fmt.Fprintln(out, formatter.Format(table))
+	printTable(out, formatter, table)
+
+	return nil
 }
 
 // PrintSystemQueryResponse generates a human-readable representation of the supplied
@@ -116,13 +152,16 @@ func PrintSystemQueryResponse(out, outErr io.Writer, resp *control.SystemQueryRe
 		return errors.Errorf("nil %T", resp)
 	}
 
+	cfg := getPrintConfig(opts...)
 	switch {
-	case len(resp.Members) == 0:
+	case len(resp.Members) == 0 && cfg.TableFormat.IsText():
 		fmt.Fprintln(out, "Query matches no ranks in system")
-	case getPrintConfig(opts...).Verbose:
-		printSystemQueryVerbose(out, resp.Members)
+	case cfg.detailedTable():
+		if err := printSystemQueryVerbose(out, resp.Members, cfg); err != nil {
+			return err
+		}
 	default:
-		if err := printSystemQuery(out, resp.Members, &resp.AbsentRanks); err != nil {
+		if err := printSystemQuery(out, resp.Members, &resp.AbsentRanks, cfg); err != nil {
 			return err
 		}
 		printAbsentHosts(outErr, &resp.AbsentHosts)
@@ -146,7 +185,7 @@ func printSystemResultTable(out io.Writer, results system.MemberResults, absentR
 		groups[fmt.Sprintf("----%sUnknown Rank", rowFieldSep)] = absentRanks
 	}
 
-	if err := tabulateRankGroups(out, groups, "Rank", "Operation", "Result"); err != nil {
+	if err := tabulateRankGroups(out, groups, getPrintConfig(), "Rank", "Operation", "Result"); err != nil {
 		return errors.Wrap(err, "printing result table")
 	}
 
@@ -179,7 +218,16 @@ func PrintSystemStartResponse(out, outErr io.Writer, resp *control.SystemStartRe
 // PrintSystemStopResponse generates a human-readable representation of the
 // supplied SystemStopResp struct and writes it to the supplied io.Writer.
 func PrintSystemStopResponse(out, outErr io.Writer, resp *control.SystemStopResp) error {
-	return printSystemResults(out, outErr, resp.Results, &resp.AbsentHosts, &resp.AbsentRanks)
+	if err := printSystemResults(out, outErr, resp.Results, &resp.AbsentHosts, &resp.AbsentRanks); err != nil {
+		return err
+	}
+	if resp.PrepRanks.Count() > 0 {
+		fmt.Fprintf(outErr, "%s prepared for shutdown but not stopped: %s\n",
+			english.Plural(resp.PrepRanks.Count(), "rank", "ranks"),
+			resp.PrepRanks.String())
+	}
+
+	return nil
 }
 
 func printSystemCleanupRespVerbose(out io.Writer, resp *control.SystemCleanupResp) error {
@@ -226,3 +274,77 @@ func PrintSystemCleanupResponse(out, outErr io.Writer, resp *control.SystemClean
 	fmt.Fprintln(out, "System Cleanup Success")
 	return nil
 }
+
+// PrintSystemDbStatusResponse generates a human-readable representation of the
+// supplied SystemDbStatusResp struct and writes it to the supplied io.Writer.
+func PrintSystemDbStatusResponse(out, outErr io.Writer, resp *control.SystemDbStatusResp) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	if err := PrintResponseErrors(resp, outErr); err != nil {
+		return err
+	}
+
+	if len(resp.Replicas) == 0 {
+		fmt.Fprintln(out, "No system database replicas responded")
+		return nil
+	}
+
+	leader := resp.Leader
+	if leader == "" {
+		leader = "unknown"
+	}
+	fmt.Fprintf(out, "MS leader: %s\n\n", leader)
+
+	replicaTitle := "Replica"
+	stateTitle := "State"
+	termTitle := "Term"
+	commitTitle := "Commit Index"
+	appliedTitle := "Applied Index"
+	lagTitle := "Lag"
+	contactTitle := "Last Contact"
+	snapTitle := "Snapshot"
+	changesTitle := "Leader Changes"
+	latencyTitle := "Commit Latency (avg/max)"
+
+	var table []txtfmt.TableRow
+	for _, rs := range resp.Replicas {
+		contact := "-"
+		switch {
+		case rs.State == "Leader":
+		case rs.LastContactMs < 0:
+			contact = "never"
+		default:
+			contact = fmt.Sprintf("%dms", rs.LastContactMs)
+		}
+
+		latency := "-"
+		if rs.Commits > 0 {
+			latency = fmt.Sprintf("%s/%s",
+				perfDuration(time.Duration(rs.CommitLatencyAvgUs)*time.Microsecond),
+				perfDuration(time.Duration(rs.CommitLatencyMaxUs)*time.Microsecond))
+		}
+
+		table = append(table, txtfmt.TableRow{
+			replicaTitle: rs.Replica,
+			stateTitle:   rs.State,
+			termTitle:    fmt.Sprintf("%d", rs.Term),
+			commitTitle:  fmt.Sprintf("%d", rs.CommitIndex),
+			appliedTitle: fmt.Sprintf("%d", rs.AppliedIndex),
+			lagTitle:     fmt.Sprintf("%d", rs.Lag),
+			contactTitle: contact,
+			snapTitle: fmt.Sprintf("%s (index %d)", units.FormatIBytes(rs.SnapshotSize),
+				rs.LastSnapshotIndex),
+			changesTitle: fmt.Sprintf("%d", rs.LeaderChanges),
+			latencyTitle: latency,
+		})
+	}
+
+	tf := txtfmt.NewTableFormatter(replicaTitle, stateTitle, termTitle, commitTitle,
+		appliedTitle, lagTitle, contactTitle, snapTitle, changesTitle, latencyTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+
+	return nil
+}
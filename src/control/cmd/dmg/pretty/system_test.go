@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -72,7 +72,7 @@ Ranks       Action Result
 		t.Run(name, func(t *testing.T) {
 			var bld strings.Builder
 
-			gotErr := tabulateRankGroups(&bld, tc.groups, tc.cTitles...)
+			gotErr := tabulateRankGroups(&bld, tc.groups, getPrintConfig(), tc.cTitles...)
 			test.ExpectError(t, gotErr, tc.expErrMsg, name)
 
 			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
@@ -284,6 +284,46 @@ Rank UUID                                 Control Address Fault Domain State
 5    00000005-0005-0005-0005-000000000005 127.0.0.5:10001 /            Joined          
 6    00000006-0006-0006-0006-000000000006 127.0.0.6:10001 /            Joined          
 
+`,
+		},
+		"response verbose with aliases": {
+			resp: &control.SystemQueryResp{
+				Members: Members{
+					func() *Member {
+						m := MockMember(t, 0, MemberStateJoined)
+						m.Alias = "rack3-node6"
+						return m
+					}(),
+					MockMember(t, 1, MemberStateJoined),
+				},
+			},
+			verbose: true,
+			expPrintStr: `
+Rank Alias       UUID                                 Control Address Fault Domain State  Reason 
+---- -----       ----                                 --------------- ------------ -----  ------ 
+0    rack3-node6 00000000-0000-0000-0000-000000000000 127.0.0.0:10001 /            Joined        
+1                00000001-0001-0001-0001-000000000001 127.0.0.1:10001 /            Joined        
+
+`,
+		},
+		"response verbose with annotations": {
+			resp: &control.SystemQueryResp{
+				Members: Members{
+					func() *Member {
+						m := MockMember(t, 0, MemberStateJoined)
+						m.Annotations = map[string]string{"hw": "DIMM replaced"}
+						return m
+					}(),
+					MockMember(t, 1, MemberStateJoined),
+				},
+			},
+			verbose: true,
+			expPrintStr: `
+Rank UUID                                 Control Address Fault Domain State  Reason Notes            
+---- ----                                 --------------- ------------ -----  ------ -----            
+0    00000000-0000-0000-0000-000000000000 127.0.0.0:10001 /            Joined        hw=DIMM replaced 
+1    00000001-0001-0001-0001-000000000001 127.0.0.1:10001 /            Joined                         
+
 `,
 		},
 		"response verbose with missing hosts and ranks": {
@@ -448,6 +488,7 @@ func TestPretty_PrintSystemStopResp(t *testing.T) {
 		resp        *control.SystemStopResp
 		absentHosts string
 		absentRanks string
+		prepRanks   string
 		expPrintStr string
 	}{
 		"empty response": {
@@ -503,12 +544,28 @@ Rank  Operation Result
 [7-9] ----      Unknown Rank 
 
 Unknown 3 hosts: foo[7-9]
+`,
+		},
+		"response with failures after prep shutdown": {
+			resp: &control.SystemStopResp{
+				Results: failedResults,
+			},
+			prepRanks: "0,2",
+			expPrintStr: `
+Rank  Operation Result 
+----  --------- ------ 
+[1,3] stop      OK     
+2     stop      fail   
+0     stop      failed 
+
+2 ranks prepared for shutdown but not stopped: 0,2
 `,
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			tc.resp.AbsentRanks = *MustCreateRankSet(tc.absentRanks)
 			tc.resp.AbsentHosts = *hostlist.MustCreateSet(tc.absentHosts)
+			tc.resp.PrepRanks = *MustCreateRankSet(tc.prepRanks)
 
 			var bld strings.Builder
 			// pass the same io writer to standard and error stream
@@ -523,3 +580,78 @@ Unknown 3 hosts: foo[7-9]
 		})
 	}
 }
+
+func TestPretty_PrintSystemDbStatusResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemDbStatusResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no replicas": {
+			resp: &control.SystemDbStatusResp{},
+			expPrintStr: `
+No system database replicas responded
+`,
+		},
+		"leader and followers": {
+			resp: &control.SystemDbStatusResp{
+				Leader: "host1:10001",
+				Replicas: []*control.SystemDbReplicaStatus{
+					{
+						Replica:            "host1:10001",
+						State:              "Leader",
+						Term:               3,
+						CommitIndex:        120,
+						AppliedIndex:       120,
+						LastSnapshotIndex:  100,
+						SnapshotSize:       4096,
+						LeaderChanges:      2,
+						Commits:            20,
+						CommitLatencyAvgUs: 1500,
+						CommitLatencyMaxUs: 12000,
+					},
+					{
+						Replica:       "host2:10001",
+						State:         "Follower",
+						Term:          3,
+						CommitIndex:   120,
+						AppliedIndex:  110,
+						Lag:           10,
+						LeaderChanges: 2,
+						LastContactMs: 25,
+					},
+					{
+						Replica:       "host3:10001",
+						State:         "Follower",
+						LastContactMs: -1,
+					},
+				},
+			},
+			expPrintStr: `
+MS leader: host1:10001
+
+Replica     State    Term Commit Index Applied Index Lag Last Contact Snapshot            Leader Changes Commit Latency (avg/max) 
+-------     -----    ---- ------------ ------------- --- ------------ --------            -------------- ------------------------ 
+host1:10001 Leader   3    120          120           0   -            4.0 KiB (index 100) 2              1.500ms/12.000ms         
+host2:10001 Follower 3    120          110           10  25ms         0 B (index 0)       2              -                        
+host3:10001 Follower 0    0            0             0   never        0 B (index 0)       0              -                        
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintSystemDbStatusResponse(&bld, &bld, tc.resp)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
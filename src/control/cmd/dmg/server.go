@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,17 +8,25 @@ package main
 
 import (
 	"context"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
+	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 )
 
 // serverCmd is the struct representing the top-level server subcommand.
 type serverCmd struct {
 	SetLogMasks serverSetLogMasksCmd `command:"set-logmasks" alias:"slm" description:"Set log masks for a set of facilities to a given level. Setting will be applied to all running DAOS I/O Engines present in the configured dmg hostlist."`
+	Logs        serverLogsCmd        `command:"logs" description:"Show lines from the log of a DAOS I/O Engine or of the control plane on a server, optionally following new lines as they are written."`
+	DumpStacks  serverDumpStacksCmd  `command:"dump-stacks" description:"Dump the Argobots ULT stacks of a DAOS I/O Engine to a file on its server, for diagnosing stuck I/O without attaching a debugger."`
+	FaultInject serverFaultInjectCmd `command:"fault-inject" hidden:"true" description:"Set or clear an injected fault on hosts in the configured dmg hostlist (requires a server built with fault injection support)."`
 }
 
 // serverSetLogMasksCmd is the struct representing the command to set engine log
@@ -76,3 +84,209 @@ func (cmd *serverSetLogMasksCmd) Execute(_ []string) (errOut error) {
 
 	return resp.Errors()
 }
+
+// serverFaultInjectCmd is the struct representing the command to set or clear
+// an injected fault on a set of servers. Intended for test harness use only.
+type serverFaultInjectCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+
+	Clear bool `long:"clear" description:"Clear the fault at the given injection point"`
+	Args  struct {
+		Point string `positional-arg-name:"point" required:"1" description:"Fault injection point (drpc-delay, join-drop, format-fail)"`
+		Arg   string `positional-arg-name:"arg" description:"Fault argument (e.g. delay duration or format step)"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when serverFaultInjectCmd activates.
+func (cmd *serverFaultInjectCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "fault injection failed")
+	}()
+
+	req := &control.FaultInjectReq{
+		Point: cmd.Args.Point,
+		Arg:   cmd.Args.Arg,
+		Clear: cmd.Clear,
+	}
+	req.SetHostList(cmd.hostlist)
+
+	cmd.Debugf("fault inject request: %+v", req)
+
+	resp, err := control.FaultInject(context.Background(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	cmd.Debugf("fault inject response: %+v", resp)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, resp.Errors())
+	}
+
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	} else if cmd.Clear {
+		cmd.Infof("Fault %q has been cleared.", cmd.Args.Point)
+	} else {
+		cmd.Infof("Fault %q has been set.", cmd.Args.Point)
+	}
+
+	return resp.Errors()
+}
+
+// interruptContext returns a context that is canceled if the user interrupts
+// the command, so that a long-running stream can be stopped cleanly.
+func interruptContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		defer signal.Stop(sigCh)
+		select {
+		case <-sigCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// serverLogsCmd is the struct representing the command to stream lines from
+// an engine or control plane log file on a server.
+type serverLogsCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+
+	Rank       *uint32 `short:"r" long:"rank" description:"Show the log of the engine with this rank"`
+	Control    bool    `short:"c" long:"control" description:"Show the control plane log of the server given by the host list instead of an engine log"`
+	Follow     bool    `short:"f" long:"follow" description:"Keep showing new lines as they are written until interrupted"`
+	Tail       uint32  `short:"n" long:"tail" default:"100" description:"Start this many lines before the end of the log (0 to show the whole log); lines are counted before filtering"`
+	Level      string  `long:"level" description:"Only show lines at or above this level (DEBUG, INFO, NOTE, WARN, ERR, CRIT, ALRT, EMRG)"`
+	Facilities string  `long:"facilities" description:"Comma-separated list of engine log facilities to show (e.g. mgmt,pool)"`
+	Rate       uint32  `long:"rate" description:"Maximum number of lines per second to be sent by the server (default 1000)"`
+}
+
+// Execute is run when serverLogsCmd activates.
+func (cmd *serverLogsCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "log stream failed")
+	}()
+
+	req := &control.LogStreamReq{
+		Control:        cmd.Control,
+		Level:          cmd.Level,
+		Tail:           cmd.Tail,
+		Follow:         cmd.Follow,
+		MaxLinesPerSec: cmd.Rate,
+	}
+	switch {
+	case cmd.Control && cmd.Rank != nil:
+		return errors.New("--rank and --control may not be used together")
+	case cmd.Control:
+		if len(cmd.hostlist) != 1 {
+			return errors.New("--control requires a single host to be specified with --host-list")
+		}
+	case cmd.Rank == nil:
+		return errors.New("either --rank or --control must be specified")
+	default:
+		req.Rank = ranklist.Rank(*cmd.Rank)
+	}
+	for _, fac := range strings.Split(cmd.Facilities, ",") {
+		if fac = strings.TrimSpace(fac); fac != "" {
+			req.Facilities = append(req.Facilities, fac)
+		}
+	}
+	req.SetHostList(cmd.hostlist)
+
+	cmd.Debugf("log stream request: %+v", req)
+
+	// Stop streaming cleanly when interrupted.
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	// Lines are printed as they arrive, or gathered to be output together
+	// if JSON output has been requested.
+	jsonResp := new(control.LogStreamResp)
+	err := control.LogStream(ctx, cmd.ctlInvoker, req, func(resp *control.LogStreamResp) error {
+		if cmd.jsonOutputEnabled() {
+			jsonResp.Host = resp.Host
+			jsonResp.Path = resp.Path
+			jsonResp.Offset = resp.Offset
+			jsonResp.Lines = append(jsonResp.Lines, resp.Lines...)
+			return nil
+		}
+		if len(resp.Lines) > 0 {
+			cmd.Info(strings.Join(resp.Lines, "\n"))
+		}
+		return nil
+	})
+	if err != nil && ctx.Err() != nil {
+		// Interrupted by the user.
+		err = nil
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(jsonResp, err)
+	}
+	return err
+}
+
+// serverDumpStacksCmd is the struct representing the command to dump the ULT
+// stacks of an engine to a file on its server.
+type serverDumpStacksCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+
+	Rank       uint32 `short:"r" long:"rank" required:"1" description:"Dump the ULT stacks of the engine with this rank"`
+	Unattended bool   `short:"u" long:"unattended" description:"Dump from the engine main thread without waiting for ULTs to yield; use if an attended dump times out, but the output may be inconsistent"`
+	Show       bool   `short:"s" long:"show" description:"Print the output of the dump as well as its location"`
+}
+
+// Execute is run when serverDumpStacksCmd activates.
+func (cmd *serverDumpStacksCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "dump engine stacks failed")
+	}()
+
+	req := &control.DumpEngineStacksReq{
+		Rank:         ranklist.Rank(cmd.Rank),
+		Unattended:   cmd.Unattended,
+		ReturnOutput: cmd.Show,
+	}
+	req.SetHostList(cmd.hostlist)
+
+	cmd.Debugf("dump engine stacks request: %+v", req)
+
+	resp, err := control.DumpEngineStacks(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Debugf("dump engine stacks response: %+v", resp)
+
+	cmd.Infof("Rank %d ULT stacks dumped to %s on %s (%s at offset %d)", resp.Rank, resp.Path,
+		resp.Host, humanize.IBytes(uint64(resp.Size)), resp.Offset)
+	if cmd.Show {
+		cmd.Info(resp.Output)
+		if resp.Truncated {
+			cmd.Infof("Output truncated; see %s on %s for the full dump", resp.Path, resp.Host)
+		}
+	}
+
+	return nil
+}
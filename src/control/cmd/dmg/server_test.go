@@ -40,5 +40,100 @@ func TestServerCommands(t *testing.T) {
 			"",
 			errors.New("expected 0-1 positional args but got 3"),
 		},
+		{
+			"Logs from engine rank",
+			"-l host1 server logs -r 2 -f -n 10 --level warn --facilities mgmt,pool --rate 50",
+			printRequest(t, func() *control.LogStreamReq {
+				req := &control.LogStreamReq{
+					Rank:           2,
+					Level:          "warn",
+					Facilities:     []string{"mgmt", "pool"},
+					Tail:           10,
+					Follow:         true,
+					MaxLinesPerSec: 50,
+				}
+				req.SetHostList([]string{"host1"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"Logs from control plane",
+			"-l host1 server logs --control",
+			printRequest(t, func() *control.LogStreamReq {
+				req := &control.LogStreamReq{Control: true, Tail: 100}
+				req.SetHostList([]string{"host1"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"Logs from engine rank not in system",
+			"server logs -r 2",
+			"",
+			errors.New("rank 2 not found in system"),
+		},
+		{
+			"Logs from control plane without host",
+			"server logs --control",
+			"",
+			errors.New("requires a single host"),
+		},
+		{
+			"Logs with rank and control",
+			"-l host1 server logs --control --rank 1",
+			"",
+			errors.New("may not be used together"),
+		},
+		{
+			"Logs without rank or control",
+			"server logs",
+			"",
+			errors.New("either --rank or --control"),
+		},
+		{
+			"Dump stacks",
+			"-l host1 server dump-stacks -r 1 --unattended --show",
+			printRequest(t, func() *control.DumpEngineStacksReq {
+				req := &control.DumpEngineStacksReq{
+					Rank:         1,
+					Unattended:   true,
+					ReturnOutput: true,
+				}
+				req.SetHostList([]string{"host1"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"Dump stacks of rank not in system",
+			"server dump-stacks -r 2",
+			"",
+			errors.New("rank 2 not found in system"),
+		},
+		{
+			"Dump stacks without rank",
+			"server dump-stacks",
+			"",
+			errors.New("required flag"),
+		},
+		{
+			"Fault inject",
+			"server fault-inject format-fail nvme",
+			printRequest(t, &control.FaultInjectReq{Point: "format-fail", Arg: "nvme"}),
+			nil,
+		},
+		{
+			"Fault inject clear",
+			"server fault-inject --clear join-drop",
+			printRequest(t, &control.FaultInjectReq{Point: "join-drop", Clear: true}),
+			nil,
+		},
+		{
+			"Fault inject missing point",
+			"server fault-inject",
+			"",
+			errors.New("required argument"),
+		},
 	})
 }
@@ -7,7 +7,9 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -26,6 +28,8 @@ type storageCmd struct {
 	Set           setFaultyCmd      `command:"set" description:"Manually set the device state."`
 	Replace       storageReplaceCmd `command:"replace" description:"Replace a storage device that has been hot-removed with a new device."`
 	LedManage     ledManageCmd      `command:"led" description:"Manage LED status for supported drives."`
+	SpdkRpc       spdkRpcCmd        `command:"spdk-rpc" description:"Forward a JSON-RPC request to the SPDK RPC server of a DAOS engine for debugging (requires a debug certificate)."`
+	Firmware      firmwareCmd       `command:"firmware" description:"Query and update the firmware of SCM and NVMe storage devices attached to remote servers."`
 }
 
 // storageScanCmd is the struct representing the scan storage subcommand.
@@ -34,9 +38,11 @@ type storageScanCmd struct {
 	ctlInvokerCmd
 	hostListCmd
 	jsonOutputCmd
+	vendorAttrsCmd
 	Verbose    bool `short:"v" long:"verbose" description:"List SCM & NVMe device details"`
 	NvmeHealth bool `short:"n" long:"nvme-health" description:"Display NVMe device health statistics"`
 	NvmeMeta   bool `short:"m" long:"nvme-meta" description:"Display server meta data held on NVMe storage"`
+	Usage      bool `short:"u" long:"usage" description:"Display space allocated to pools, free and reserved on in-use SCM & NVMe storage"`
 }
 
 // Execute is run when storageScanCmd activates.
@@ -49,12 +55,19 @@ func (cmd *storageScanCmd) Execute(_ []string) error {
 	if cmd.Verbose && (cmd.NvmeHealth || cmd.NvmeMeta) {
 		return errors.New("cannot use --verbose with --nvme-health or --nvme-meta")
 	}
+	if cmd.Vendor && !cmd.NvmeHealth {
+		return errors.New("--vendor may only be used with --nvme-health")
+	}
+	if cmd.Usage && (cmd.Verbose || cmd.NvmeHealth || cmd.NvmeMeta) {
+		return errors.New("cannot use --usage with --verbose, --nvme-health or --nvme-meta")
+	}
 
 	req := &control.StorageScanReq{
+		Usage:      cmd.Usage,
 		NvmeHealth: cmd.NvmeHealth,
 		NvmeMeta:   cmd.NvmeMeta,
-		// don't strip nvme details if verbose or health or meta set
-		NvmeBasic: !(cmd.Verbose || cmd.NvmeHealth || cmd.NvmeMeta),
+		// don't strip nvme details if verbose or health or meta or usage set
+		NvmeBasic: !(cmd.Verbose || cmd.NvmeHealth || cmd.NvmeMeta || cmd.Usage),
 	}
 	req.SetHostList(cmd.hostlist)
 
@@ -82,13 +95,17 @@ func (cmd *storageScanCmd) Execute(_ []string) error {
 	var out strings.Builder
 	switch {
 	case cmd.NvmeHealth:
-		if err := pretty.PrintNvmeHealthMap(resp.HostStorage, &out); err != nil {
+		if err := pretty.PrintNvmeHealthMap(resp.HostStorage, &out, cmd.vendorOpts()...); err != nil {
 			return err
 		}
 	case cmd.NvmeMeta:
 		if err := pretty.PrintNvmeMetaMap(resp.HostStorage, &out); err != nil {
 			return err
 		}
+	case cmd.Usage:
+		if err := pretty.PrintHostStorageAllocationMap(resp.HostStorage, &out); err != nil {
+			return err
+		}
 	default:
 		verbose := pretty.PrintWithVerboseOutput(cmd.Verbose)
 		if err := pretty.PrintHostStorageMap(resp.HostStorage, &out, verbose); err != nil {
@@ -103,11 +120,15 @@ func (cmd *storageScanCmd) Execute(_ []string) error {
 // storageFormatCmd is the struct representing the format storage subcommand.
 type storageFormatCmd struct {
 	baseCmd
+	cfgCmd
 	ctlInvokerCmd
 	hostListCmd
 	jsonOutputCmd
-	Verbose bool `short:"v" long:"verbose" description:"Show results of each SCM & NVMe device format operation"`
-	Force   bool `long:"force" description:"Force storage format on a host, stopping any running engines (CAUTION: destructive operation)"`
+	Verbose           bool `short:"v" long:"verbose" description:"Show results of each SCM & NVMe device format operation"`
+	Force             bool `long:"force" description:"Force storage format on a host, stopping any running engines (CAUTION: destructive operation)"`
+	Reformat          bool `long:"reformat" description:"Reformat the storage of all system members; requires --system (CAUTION: destructive operation)"`
+	System            bool `long:"system" description:"Perform a coordinated system reset: stop all members, erase system metadata, format storage and wait for members to rejoin"`
+	DomainConcurrency uint `long:"domain-concurrency" description:"Format at most this many hosts at a time in each fault domain, formatting fault domains in parallel"`
 }
 
 // Execute is run when storageFormatCmd activates.
@@ -116,8 +137,28 @@ type storageFormatCmd struct {
 func (cmd *storageFormatCmd) Execute(args []string) (err error) {
 	ctx := context.Background()
 
-	req := &control.StorageFormatReq{Reformat: cmd.Force}
-	req.SetHostList(cmd.hostlist)
+	if cmd.Reformat || cmd.System {
+		if !(cmd.Reformat && cmd.System) {
+			return errors.New("--reformat and --system must be used together; " +
+				"use --force to reformat individual hosts")
+		}
+		if cmd.DomainConcurrency > 0 {
+			return errors.New("--domain-concurrency may not be used with --system")
+		}
+		return cmd.systemReformat(ctx)
+	}
+
+	req := &control.StorageFormatReq{
+		Reformat:          cmd.Force,
+		DomainConcurrency: int(cmd.DomainConcurrency),
+	}
+	hl := cmd.hostlist
+	if len(hl) == 0 && cmd.DomainConcurrency > 0 && cmd.config != nil {
+		// Scheduling by fault domain needs the hosts to be known up
+		// front, so use the configured hosts if none were given.
+		hl = cmd.config.HostList
+	}
+	req.SetHostList(hl)
 
 	resp, err := control.StorageFormat(ctx, cmd.ctlInvoker, req)
 	if err != nil {
@@ -131,6 +172,37 @@ func (cmd *storageFormatCmd) Execute(args []string) (err error) {
 	return cmd.printFormatResp(resp)
 }
 
+// systemReformat performs a coordinated reformat of all system members.
+func (cmd *storageFormatCmd) systemReformat(ctx context.Context) error {
+	req := &control.SystemReformatReq{
+		OnStep: func(step control.SystemReformatStep) {
+			if !cmd.jsonOutputEnabled() {
+				cmd.Infof("System reformat: starting %s step", step)
+			}
+		},
+	}
+	req.SetHostList(cmd.hostlist)
+
+	resp, err := control.SystemReformat(ctx, cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+	if err != nil {
+		if resp != nil && resp.Format != nil {
+			_ = cmd.printFormatResp(resp.Format)
+		}
+		return err
+	}
+
+	if err := cmd.printFormatResp(resp.Format); err != nil {
+		return err
+	}
+	cmd.Infof("System reformat complete: %d of %d members rejoined",
+		len(resp.Members), resp.PriorMembers)
+
+	return nil
+}
+
 func (cmd *storageFormatCmd) printFormatResp(resp *control.StorageFormatResp) error {
 	var outErr strings.Builder
 	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
@@ -247,3 +319,62 @@ func (cmd *nvmeAddDeviceCmd) Execute(args []string) error {
 
 	return resp.Errors()
 }
+
+// spdkRpcCmd is the struct representing the spdk-rpc storage subcommand.
+type spdkRpcCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+	EngineIndex uint32 `short:"e" long:"engine-index" required:"1" description:"Index of DAOS engine to forward the request to."`
+	Args        struct {
+		Method string `positional-arg-name:"method" required:"1" description:"SPDK JSON-RPC method name"`
+		Params string `positional-arg-name:"params" description:"JSON-encoded method parameters"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when spdkRpcCmd activates.
+//
+// Forward a JSON-RPC request to the SPDK RPC server of a single engine and print the raw result.
+func (cmd *spdkRpcCmd) Execute(_ []string) error {
+	if len(cmd.hostlist) != 1 {
+		return errors.New("command expects a single host in hostlist")
+	}
+
+	req := &control.SpdkRpcReq{
+		EngineIndex: cmd.EngineIndex,
+		Method:      cmd.Args.Method,
+	}
+	if cmd.Args.Params != "" {
+		req.Params = json.RawMessage(cmd.Args.Params)
+	}
+	req.SetHostList(cmd.hostlist)
+
+	cmd.Debugf("spdk rpc req: %+v", req)
+	resp, err := control.StorageSpdkRpc(context.Background(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, resp.Errors())
+	}
+
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+
+	for _, result := range resp.HostResults {
+		var out bytes.Buffer
+		if err := json.Indent(&out, result, "", "  "); err != nil {
+			return errors.Wrap(err, "formatting SPDK RPC result")
+		}
+		cmd.Info(out.String())
+	}
+
+	return resp.Errors()
+}
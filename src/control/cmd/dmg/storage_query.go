@@ -8,6 +8,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
 type rankCmd struct {
@@ -70,8 +72,22 @@ type storageQueryCmd struct {
 	Usage        usageQueryCmd       `command:"usage" description:"Show SCM & NVMe storage space utilization per storage server"`
 }
 
+// vendorAttrsCmd is an embeddable struct that provides the option to display
+// vendor-specific NVMe SMART attributes in device health output.
+type vendorAttrsCmd struct {
+	Vendor bool `long:"vendor" description:"Include vendor-specific SMART attributes (e.g. wear-leveling count, thermal throttle events) where supported by the device"`
+}
+
+func (cmd *vendorAttrsCmd) vendorOpts() []pretty.PrintConfigOption {
+	if !cmd.Vendor {
+		return nil
+	}
+	return []pretty.PrintConfigOption{pretty.PrintWithVendorAttrs()}
+}
+
 type devHealthQueryCmd struct {
 	smdQueryCmd
+	vendorAttrsCmd
 	UUID string `short:"u" long:"uuid" required:"1" description:"Device UUID"`
 }
 
@@ -83,11 +99,12 @@ func (cmd *devHealthQueryCmd) Execute(_ []string) error {
 		Rank:             ranklist.NilRank,
 		UUID:             cmd.UUID,
 	}
-	return cmd.makeRequest(ctx, req)
+	return cmd.makeRequest(ctx, req, cmd.vendorOpts()...)
 }
 
 type tgtHealthQueryCmd struct {
 	smdQueryCmd
+	vendorAttrsCmd
 	Rank  uint32 `short:"r" long:"rank" required:"1" description:"Server rank hosting target"`
 	TgtId uint32 `short:"t" long:"tgtid" required:"1" description:"VOS target ID to query"`
 }
@@ -100,45 +117,70 @@ func (cmd *tgtHealthQueryCmd) Execute(_ []string) error {
 		Rank:             ranklist.Rank(cmd.Rank),
 		Target:           strconv.Itoa(int(cmd.TgtId)),
 	}
-	return cmd.makeRequest(ctx, req)
+	return cmd.makeRequest(ctx, req, cmd.vendorOpts()...)
 }
 
 type listDevicesQueryCmd struct {
 	smdQueryCmd
 	rankCmd
+	vendorAttrsCmd
 	Health      bool   `short:"b" long:"health" description:"Include device health in results"`
 	UUID        string `short:"u" long:"uuid" description:"Device UUID (all devices if blank)"`
 	EvictedOnly bool   `short:"e" long:"show-evicted" description:"Show only evicted faulty devices"`
+	ShowPools   bool   `short:"p" long:"show-pools" description:"Show pools with targets on each device"`
 }
 
 func (cmd *listDevicesQueryCmd) Execute(_ []string) error {
 	ctx := context.Background()
 
+	if cmd.ShowPools && cmd.UUID != "" {
+		return errors.New("--uuid may not be used with --show-pools")
+	}
+	if cmd.Vendor && !cmd.Health {
+		return errors.New("--vendor may only be used with --health")
+	}
+
 	req := &control.SmdQueryReq{
-		OmitPools:        true,
+		OmitPools:        !cmd.ShowPools,
 		IncludeBioHealth: cmd.Health,
 		Rank:             cmd.GetRank(),
 		UUID:             cmd.UUID,
 		FaultyDevsOnly:   cmd.EvictedOnly,
 	}
-	return cmd.makeRequest(ctx, req)
+
+	opts := cmd.vendorOpts()
+	if cmd.ShowPools {
+		opts = append(opts, pretty.PrintWithDevicePools())
+	}
+	return cmd.makeRequest(ctx, req, opts...)
 }
 
 type listPoolsQueryCmd struct {
 	smdQueryCmd
 	rankCmd
-	UUID    string `short:"u" long:"uuid" description:"Pool UUID (all pools if blank)"`
-	Verbose bool   `short:"v" long:"verbose" description:"Show more detail about pools"`
+	UUID        string `short:"u" long:"uuid" description:"Pool UUID (all pools if blank)"`
+	Verbose     bool   `short:"v" long:"verbose" description:"Show more detail about pools"`
+	ShowDevices bool   `short:"d" long:"show-devices" description:"Show devices backing each pool's targets"`
 }
 
 func (cmd *listPoolsQueryCmd) Execute(_ []string) error {
 	ctx := context.Background()
+
+	if cmd.ShowDevices && cmd.UUID != "" {
+		return errors.New("--uuid may not be used with --show-devices")
+	}
+
 	req := &control.SmdQueryReq{
-		OmitDevices: true,
+		OmitDevices: !cmd.ShowDevices,
 		Rank:        cmd.GetRank(),
 		UUID:        cmd.UUID,
 	}
-	return cmd.makeRequest(ctx, req, pretty.PrintWithVerboseOutput(cmd.Verbose))
+
+	opts := []pretty.PrintConfigOption{pretty.PrintWithVerboseOutput(cmd.Verbose)}
+	if cmd.ShowDevices {
+		opts = append(opts, pretty.PrintWithPoolDevices())
+	}
+	return cmd.makeRequest(ctx, req, opts...)
 }
 
 // usageQueryCmd is the struct representing the scan storage subcommand.
@@ -147,6 +189,7 @@ type usageQueryCmd struct {
 	ctlInvokerCmd
 	hostListCmd
 	jsonOutputCmd
+	Verbose bool `short:"v" long:"verbose" description:"Show per-target SPDK blobstore usage"`
 }
 
 // Execute is run when usageQueryCmd activates.
@@ -158,7 +201,20 @@ func (cmd *usageQueryCmd) Execute(_ []string) error {
 	req.SetHostList(cmd.hostlist)
 	resp, err := control.StorageScan(ctx, cmd.ctlInvoker, req)
 
+	var bsResp *control.BlobstoreQueryResp
+	if err == nil && cmd.Verbose {
+		bsReq := new(control.BlobstoreQueryReq)
+		bsReq.SetHostList(cmd.hostlist)
+		bsResp, err = control.BlobstoreQuery(ctx, cmd.ctlInvoker, bsReq)
+	}
+
 	if cmd.jsonOutputEnabled() {
+		if cmd.Verbose {
+			return cmd.outputJSON(struct {
+				*control.StorageScanResp
+				Blobstores *control.BlobstoreQueryResp `json:"blobstore_usage"`
+			}{resp, bsResp}, err)
+		}
 		return cmd.outputJSON(resp, err)
 	}
 
@@ -173,10 +229,24 @@ func (cmd *usageQueryCmd) Execute(_ []string) error {
 	if err := pretty.PrintHostStorageUsageMap(resp.HostStorage, &bld); err != nil {
 		return err
 	}
+	if bsResp != nil {
+		fmt.Fprintln(&bld)
+		if err := pretty.PrintResponseErrors(bsResp, &bld); err != nil {
+			return err
+		}
+		if err := pretty.PrintHostBlobstoreUsage(bsResp, &bld); err != nil {
+			return err
+		}
+	}
 	// Infof prints raw string and doesn't try to expand "%"
 	// preserving column formatting in txtfmt table
 	cmd.Infof("%s", bld.String())
 
+	if bsResp != nil {
+		if err := bsResp.Errors(); err != nil {
+			return err
+		}
+	}
 	return resp.Errors()
 }
 
@@ -283,6 +353,7 @@ type ledCmd struct {
 type ledManageCmd struct {
 	Check    ledCheckCmd    `command:"check" description:"Retrieve the current LED state of specified VMD device."`
 	Identify ledIdentifyCmd `command:"identify" description:"Blink the status LED on specified VMD device (for the purpose of visual SSD identification). Default duration is 2 minutes."`
+	Set      ledSetCmd      `command:"set" description:"Set the status LED on specified VMD device to the given state."`
 }
 
 type ledIdentifyCmd struct {
@@ -329,3 +400,35 @@ func (cmd *ledCheckCmd) Execute(_ []string) error {
 	}
 	return cmd.makeRequest(context.Background(), req, pretty.PrintOnlyLEDInfo())
 }
+
+type ledSetCmd struct {
+	ledCmd
+	State   string `long:"state" required:"1" choice:"identify" choice:"fault" choice:"off" description:"LED state to set (identify: 4Hz blink, fault: solid on, off)"`
+	Timeout uint32 `long:"timeout" description:"Length of time to blink the status LED for (identify state only)"`
+}
+
+// Execute is run when ledSetCmd activates.
+//
+// Runs SPDK VMD API commands to set the LED state on VMD devices.
+func (cmd *ledSetCmd) Execute(_ []string) error {
+	if cmd.Args.IDs == "" {
+		return errors.New("neither a pci address or a uuid has been supplied")
+	}
+	req := &control.SmdManageReq{
+		Operation:       control.LedSetOp,
+		IDs:             cmd.Args.IDs,
+		IdentifyTimeout: cmd.Timeout,
+	}
+	switch cmd.State {
+	case "identify":
+		req.LedState = storage.LedStateIdentify
+	case "fault":
+		req.LedState = storage.LedStateFaulty
+	default:
+		req.LedState = storage.LedStateNormal
+	}
+	if cmd.Timeout != 0 && req.LedState != storage.LedStateIdentify {
+		return errors.New("timeout option can only be set with identify state")
+	}
+	return cmd.makeRequest(context.Background(), req, pretty.PrintOnlyLEDInfo())
+}
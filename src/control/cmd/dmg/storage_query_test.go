@@ -8,12 +8,14 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
 func TestStorageQueryCommands(t *testing.T) {
@@ -46,6 +48,17 @@ func TestStorageQueryCommands(t *testing.T) {
 			}),
 			nil,
 		},
+		{
+			"per-server metadata device health query with vendor attributes",
+			"storage query device-health --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d --vendor",
+			printRequest(t, &control.SmdQueryReq{
+				Rank:             ranklist.NilRank,
+				OmitPools:        true,
+				IncludeBioHealth: true,
+				UUID:             "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			}),
+			nil,
+		},
 		{
 			"per-server metadata device health query (missing uuid)",
 			"storage query device-health",
@@ -99,6 +112,22 @@ func TestStorageQueryCommands(t *testing.T) {
 			}),
 			nil,
 		},
+		{
+			"per-server metadata query devices (include health with vendor attributes)",
+			"storage query list-devices --health --vendor",
+			printRequest(t, &control.SmdQueryReq{
+				Rank:             ranklist.NilRank,
+				OmitPools:        true,
+				IncludeBioHealth: true,
+			}),
+			nil,
+		},
+		{
+			"per-server metadata query devices (vendor attributes without health)",
+			"storage query list-devices --vendor",
+			"",
+			errors.New("--vendor may only be used with --health"),
+		},
 		{
 			"per-server metadata query devices (show only evicted)",
 			"storage query list-devices --show-evicted",
@@ -138,12 +167,49 @@ func TestStorageQueryCommands(t *testing.T) {
 			}),
 			nil,
 		},
+		{
+			"per-server metadata query pools (show devices)",
+			"storage query list-pools --show-devices",
+			printRequest(t, &control.SmdQueryReq{
+				Rank: ranklist.NilRank,
+			}),
+			nil,
+		},
+		{
+			"per-server metadata query pools (show devices with uuid)",
+			"storage query list-pools -d --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			"",
+			errors.New("may not be used with --show-devices"),
+		},
+		{
+			"per-server metadata query devices (show pools)",
+			"storage query list-devices --show-pools --rank 42",
+			printRequest(t, &control.SmdQueryReq{
+				Rank: ranklist.Rank(42),
+			}),
+			nil,
+		},
+		{
+			"per-server metadata query devices (show pools with uuid)",
+			"storage query list-devices -p --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			"",
+			errors.New("may not be used with --show-pools"),
+		},
 		{
 			"per-server storage space utilization query",
 			"storage query usage",
 			printRequest(t, &control.StorageScanReq{Usage: true}),
 			nil,
 		},
+		{
+			"per-server storage space utilization query with blobstore usage",
+			"storage query usage --verbose",
+			strings.Join([]string{
+				printRequest(t, &control.StorageScanReq{Usage: true}),
+				printRequest(t, &control.BlobstoreQueryReq{}),
+			}, " "),
+			nil,
+		},
 		{
 			"Set FAULTY device status (force)",
 			"storage set nvme-faulty --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d -f",
@@ -266,6 +332,61 @@ func TestStorageQueryCommands(t *testing.T) {
 			}),
 			nil,
 		},
+		{
+			"Set LED state without device UUID or PCI address specified",
+			"storage led set --state fault",
+			"",
+			errors.New("neither a pci address or a uuid has been supplied"),
+		},
+		{
+			"Set LED state without state specified",
+			"storage led set d50505:01:00.0",
+			"",
+			errors.New("the required flag `--state' was not specified"),
+		},
+		{
+			"Set LED state; invalid state",
+			"storage led set --state rebuild d50505:01:00.0",
+			"",
+			errors.New("Invalid value `rebuild'"),
+		},
+		{
+			"Set LED fault state on multiple devices",
+			"storage led set --state fault 842c739b-86b5-462f-a7ba-b4a91b674f3d,d50505:01:00.0",
+			printRequest(t, &control.SmdManageReq{
+				Operation: control.LedSetOp,
+				IDs:       "842c739b-86b5-462f-a7ba-b4a91b674f3d,d50505:01:00.0",
+				LedState:  storage.LedStateFaulty,
+			}),
+			nil,
+		},
+		{
+			"Set LED off",
+			"storage led set --state off d50505:01:00.0",
+			printRequest(t, &control.SmdManageReq{
+				Operation: control.LedSetOp,
+				IDs:       "d50505:01:00.0",
+				LedState:  storage.LedStateNormal,
+			}),
+			nil,
+		},
+		{
+			"Set LED identify state with timeout",
+			"storage led set --state identify --timeout 10 d50505:01:00.0",
+			printRequest(t, &control.SmdManageReq{
+				Operation:       control.LedSetOp,
+				IDs:             "d50505:01:00.0",
+				LedState:        storage.LedStateIdentify,
+				IdentifyTimeout: 10,
+			}),
+			nil,
+		},
+		{
+			"Set LED fault state with timeout",
+			"storage led set --state fault --timeout 10 d50505:01:00.0",
+			"",
+			errors.New("timeout option can only be set with identify state"),
+		},
 		{
 			"Nonexistent subcommand",
 			"storage query quack",
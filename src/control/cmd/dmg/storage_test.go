@@ -7,6 +7,7 @@
 package main
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -29,6 +30,21 @@ func TestStorageCommands(t *testing.T) {
 		return req
 	}
 
+	hostQueryReq := func(host string) *control.SystemQueryReq {
+		req := &control.SystemQueryReq{FailOnUnavailable: true}
+		req.AddHost(host)
+		return req
+	}
+	faultDomainQueryReq := &control.FaultDomainQueryReq{}
+	faultDomainQueryReq.SetHostList([]string{"host1", "host2"})
+	batchFormatReq := func(host string) *control.StorageFormatReq {
+		req := &control.StorageFormatReq{}
+		req.SetHostList([]string{host})
+		return req
+	}
+	hostScanReq := &control.StorageScanReq{NvmeBasic: true}
+	hostScanReq.SetHostList([]string{"host1", "host2"})
+
 	runCmdTests(t, []cmdTest{
 		{
 			"Format",
@@ -43,7 +59,39 @@ func TestStorageCommands(t *testing.T) {
 			"Format with reformat",
 			"storage format --reformat",
 			"",
-			errors.New("unknown flag"),
+			errors.New("--reformat and --system must be used together"),
+		},
+		{
+			"Format with system",
+			"storage format --system",
+			"",
+			errors.New("--reformat and --system must be used together"),
+		},
+		{
+			"Format with system reformat; MS unavailable",
+			"storage format --reformat --system",
+			strings.Join([]string{
+				printRequest(t, systemQueryReq),
+			}, " "),
+			errors.New("system reformat failed at query step"),
+		},
+		{
+			"Format with domain concurrency",
+			"storage format --domain-concurrency 1 -l host1,host2",
+			strings.Join([]string{
+				printRequest(t, hostQueryReq("host1:10001")),
+				printRequest(t, hostQueryReq("host2:10001")),
+				printRequest(t, faultDomainQueryReq),
+				printRequest(t, batchFormatReq("host1")),
+				printRequest(t, batchFormatReq("host2")),
+			}, " "),
+			nil,
+		},
+		{
+			"Format with domain concurrency and system reformat",
+			"storage format --reformat --system --domain-concurrency 1",
+			"",
+			errors.New("may not be used with --system"),
 		},
 		{
 			"Format with force",
@@ -62,6 +110,14 @@ func TestStorageCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Scan summary with host list",
+			"storage scan -l host1,host2",
+			strings.Join([]string{
+				printRequest(t, hostScanReq),
+			}, " "),
+			nil,
+		},
 		{
 			"Scan verbose",
 			"storage scan --verbose",
@@ -82,6 +138,18 @@ func TestStorageCommands(t *testing.T) {
 			printRequest(t, &control.StorageScanReq{NvmeHealth: true}),
 			nil,
 		},
+		{
+			"Scan NVMe health with vendor attributes",
+			"storage scan --nvme-health --vendor",
+			printRequest(t, &control.StorageScanReq{NvmeHealth: true}),
+			nil,
+		},
+		{
+			"Scan with vendor attributes but no NVMe health",
+			"storage scan --vendor",
+			"",
+			errors.New("--vendor may only be used with --nvme-health"),
+		},
 		{
 			"Scan NVMe health with verbose",
 			"storage scan --nvme-health --verbose",
@@ -112,6 +180,30 @@ func TestStorageCommands(t *testing.T) {
 			"",
 			errors.New("cannot use --nvme-health and --nvme-meta"),
 		},
+		{
+			"Scan usage short",
+			"storage scan -u",
+			printRequest(t, &control.StorageScanReq{Usage: true}),
+			nil,
+		},
+		{
+			"Scan usage long",
+			"storage scan --usage",
+			printRequest(t, &control.StorageScanReq{Usage: true}),
+			nil,
+		},
+		{
+			"Scan usage with verbose",
+			"storage scan --usage --verbose",
+			"",
+			errors.New("cannot use --usage"),
+		},
+		{
+			"Scan usage with NVMe meta",
+			"storage scan --usage --nvme-meta",
+			"",
+			errors.New("cannot use --usage"),
+		},
 		{
 			"Rebind NVMe; no PCI address",
 			"storage nvme-rebind",
@@ -178,6 +270,44 @@ func TestStorageCommands(t *testing.T) {
 			printRequest(t, nvmeAddDeviceReq().WithStorageTierIndex(0)),
 			nil,
 		},
+		{
+			"SPDK RPC; 0 hosts in hostlist",
+			"storage spdk-rpc -e 0 bdev_get_bdevs",
+			"",
+			errors.New("expects a single host"),
+		},
+		{
+			"SPDK RPC; no engine index",
+			"storage spdk-rpc -l foo2.com bdev_get_bdevs",
+			"",
+			errors.New("engine-index"),
+		},
+		{
+			"SPDK RPC; no method",
+			"storage spdk-rpc -l foo2.com -e 0",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"SPDK RPC; invalid params",
+			"storage spdk-rpc -l foo2.com -e 0 bdev_get_bdevs {name",
+			"",
+			errors.New("not valid JSON"),
+		},
+		{
+			"SPDK RPC; with params",
+			`storage spdk-rpc -l foo2.com -e 1 bdev_get_bdevs {"name":"Nvme0n1"}`,
+			printRequest(t, func() *control.SpdkRpcReq {
+				req := &control.SpdkRpcReq{
+					EngineIndex: 1,
+					Method:      "bdev_get_bdevs",
+					Params:      json.RawMessage(`{"name":"Nvme0n1"}`),
+				}
+				req.SetHostList([]string{"foo2.com"})
+				return req
+			}()),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"storage quack",
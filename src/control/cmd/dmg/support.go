@@ -0,0 +1,282 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+)
+
+const (
+	// supportMetadataFile is the name of the file in the root of a support
+	// bundle that describes the bundle.
+	supportMetadataFile = "metadata.json"
+	// supportManifestFile is the name of the file in the root of a support
+	// bundle that lists the checksums of the other files, in the format
+	// read by sha256sum -c.
+	supportManifestFile = "manifest.sha256"
+)
+
+// supportCmd is the struct representing the top-level support subcommand.
+type supportCmd struct {
+	CollectLog collectLogCmd `command:"collectlog" description:"Collect information about the hosts in the configured dmg hostlist for support purposes (requires a support certificate)."`
+}
+
+// collectLogCmd is the struct representing the command to collect
+// information about a set of servers.
+type collectLogCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+	TargetFolder string `short:"t" long:"target-folder" default:"/tmp/daos_support_logs" description:"Local folder to write the collected information to"`
+	Ticket       string `long:"ticket" description:"Support ticket or defect ID to record in the bundle metadata"`
+	Description  string `long:"description" description:"Description of the problem to record in the bundle metadata"`
+}
+
+// supportVersionMatrix describes the versions of the DAOS software in use
+// when a support bundle was collected.
+type supportVersionMatrix struct {
+	Dmg     string            `json:"dmg"`
+	Servers map[string]string `json:"servers"`
+	Unknown string            `json:"unknown,omitempty"`
+}
+
+// supportBundleMetadata is written to the root of a support bundle so that
+// incoming bundles can be indexed without unpacking the host output.
+type supportBundleMetadata struct {
+	Ticket      string               `json:"ticket,omitempty"`
+	Description string               `json:"description,omitempty"`
+	CollectedAt time.Time            `json:"collected_at"`
+	Hosts       string               `json:"hosts"`
+	Commands    []string             `json:"commands"`
+	Versions    supportVersionMatrix `json:"versions"`
+}
+
+// newSupportVersionMatrix groups the hosts by the server version reported in
+// the output of the version support command.
+func newSupportVersionMatrix(hosts []string, versionOutput map[string]string) (supportVersionMatrix, error) {
+	vm := supportVersionMatrix{
+		Dmg:     build.DaosVersion,
+		Servers: make(map[string]string),
+	}
+
+	byVersion := make(map[string]*hostlist.HostSet)
+	unknown := hostlist.MustCreateSet("")
+	for _, host := range hosts {
+		var vi build.VersionInfo
+		if err := json.Unmarshal([]byte(versionOutput[host]), &vi); err != nil || vi.Version == "" {
+			if _, err := unknown.Insert(host); err != nil {
+				return vm, err
+			}
+			continue
+		}
+
+		if _, found := byVersion[vi.Version]; !found {
+			byVersion[vi.Version] = hostlist.MustCreateSet("")
+		}
+		if _, err := byVersion[vi.Version].Insert(host); err != nil {
+			return vm, err
+		}
+	}
+
+	for version, hs := range byVersion {
+		vm.Servers[version] = hs.String()
+	}
+	if unknown.Count() > 0 {
+		vm.Unknown = unknown.String()
+	}
+
+	return vm, nil
+}
+
+// writeSupportMetadata writes the bundle metadata to the root of the target
+// folder and returns the path of the file.
+func writeSupportMetadata(dir string, md *supportBundleMetadata) (string, error) {
+	data, err := json.MarshalIndent(md, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, supportMetadataFile)
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// writeSupportManifest writes the SHA-256 checksums of the given files, with
+// paths relative to the root of the target folder, and returns the path of the
+// manifest file.
+func writeSupportManifest(dir string, files []string) (string, error) {
+	var sb strings.Builder
+	for _, path := range files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return "", err
+		}
+
+		sum := sha256.Sum256(data)
+		fmt.Fprintf(&sb, "%s  %s\n", hex.EncodeToString(sum[:]), rel)
+	}
+
+	path := filepath.Join(dir, supportManifestFile)
+	if err := os.WriteFile(path, []byte(sb.String()), 0600); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// collectLogResp describes the files written by collectlog and any errors
+// encountered while collecting them.
+type collectLogResp struct {
+	control.HostErrorsResp
+	TargetFolder string   `json:"target_folder"`
+	Files        []string `json:"files"`
+}
+
+// supportOutputName returns the name of the file that the output of a support
+// command is written to.
+func supportOutputName(command string) string {
+	return strings.ReplaceAll(command, " ", "_") + ".txt"
+}
+
+// Execute is run when collectLogCmd activates.
+//
+// Runs each support command on the servers and writes the output to a file
+// per host and command in the target folder, along with metadata describing
+// the collection and a manifest of file checksums.
+func (cmd *collectLogCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "collect log failed")
+	}()
+
+	resp := &collectLogResp{
+		HostErrorsResp: control.HostErrorsResp{
+			HostErrors: make(control.HostErrorsMap),
+		},
+		TargetFolder: cmd.TargetFolder,
+	}
+
+	md := &supportBundleMetadata{
+		Ticket:      cmd.Ticket,
+		Description: cmd.Description,
+		CollectedAt: time.Now().UTC(),
+		Commands:    control.SupportCommands,
+	}
+	collected := hostlist.MustCreateSet("")
+	versionOutput := make(map[string]string)
+
+	for _, command := range control.SupportCommands {
+		req := &control.SupportExecReq{
+			Command: command,
+		}
+		req.SetHostList(cmd.hostlist)
+
+		cmd.Debugf("support exec request: %+v", req)
+
+		execResp, err := control.SupportExec(context.Background(), cmd.ctlInvoker, req)
+		if err != nil {
+			return err // control api returned an error, disregard response
+		}
+
+		for _, hes := range execResp.HostErrors {
+			for _, host := range hes.HostSet.Slice() {
+				hostErr := errors.Wrap(hes.HostError, command)
+				if err := resp.HostErrors.Add(host, hostErr); err != nil {
+					return err
+				}
+			}
+		}
+
+		for host, result := range execResp.Results {
+			dir := filepath.Join(cmd.TargetFolder, host)
+			if err := os.MkdirAll(dir, 0700); err != nil {
+				return err
+			}
+			path := filepath.Join(dir, supportOutputName(command))
+			if err := os.WriteFile(path, []byte(result.Output), 0600); err != nil {
+				return err
+			}
+			resp.Files = append(resp.Files, path)
+			if _, err := collected.Insert(host); err != nil {
+				return err
+			}
+			if command == control.SupportVersionCommand && result.ExitCode == 0 {
+				versionOutput[host] = result.Output
+			}
+
+			if result.ExitCode != 0 {
+				cmd.Debugf("%s: %q exited with status %d", host, command, result.ExitCode)
+			}
+			if result.Truncated {
+				cmd.Noticef("%s: output of %q was truncated", host, command)
+			}
+		}
+	}
+
+	if len(resp.Files) > 0 {
+		md.Hosts = collected.String()
+		vm, err := newSupportVersionMatrix(collected.Slice(), versionOutput)
+		if err != nil {
+			return err
+		}
+		md.Versions = vm
+
+		mdPath, err := writeSupportMetadata(cmd.TargetFolder, md)
+		if err != nil {
+			return errors.Wrap(err, "writing bundle metadata")
+		}
+		resp.Files = append(resp.Files, mdPath)
+		sort.Strings(resp.Files)
+
+		manifestPath, err := writeSupportManifest(cmd.TargetFolder, resp.Files)
+		if err != nil {
+			return errors.Wrap(err, "writing bundle manifest")
+		}
+		resp.Files = append(resp.Files, manifestPath)
+	}
+	sort.Strings(resp.Files)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, resp.Errors())
+	}
+
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+	if len(resp.Files) > 0 {
+		cmd.Infof("Support information written to %s", cmd.TargetFolder)
+	}
+
+	return resp.Errors()
+}
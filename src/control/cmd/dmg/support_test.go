@@ -0,0 +1,196 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestSupportCommands(t *testing.T) {
+	var expReqs []string
+	for _, command := range control.SupportCommands {
+		expReqs = append(expReqs, printRequest(t, &control.SupportExecReq{Command: command}))
+	}
+
+	runCmdTests(t, []cmdTest{
+		{
+			"Collect log",
+			"support collectlog",
+			strings.Join(expReqs, " "),
+			nil,
+		},
+		{
+			"Collect log with bundle metadata",
+			"support collectlog --ticket DAOS-1234 --description crash",
+			strings.Join(expReqs, " "),
+			nil,
+		},
+		{
+			"Collect log with unexpected argument",
+			"support collectlog dmesg",
+			"",
+			errors.New("unexpected"),
+		},
+	})
+}
+
+func TestDmg_collectLogCmd(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+		UnaryResponse: &control.UnaryResponse{
+			Responses: []*control.HostResponse{
+				{
+					Addr: "host1",
+					Message: &ctlpb.SupportExecResp{
+						Output: []byte("host1 output\n"),
+					},
+				},
+				{
+					Addr:  "host2",
+					Error: errors.New("permission denied"),
+				},
+			},
+		},
+	})
+
+	cmd := new(collectLogCmd)
+	cmd.setInvoker(mi)
+	cmd.SetLog(log)
+	cmd.TargetFolder = testDir
+	cmd.Ticket = "DAOS-1234"
+	cmd.Description = "engine crash"
+
+	gotErr := cmd.Execute(nil)
+	test.CmpErr(t, errors.New("1 host had errors"), gotErr)
+
+	for _, command := range control.SupportCommands {
+		path := filepath.Join(testDir, "host1", supportOutputName(command))
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEqual(t, "host1 output\n", string(data), "unexpected contents of "+path)
+	}
+	if _, err := os.Stat(filepath.Join(testDir, "host2")); !os.IsNotExist(err) {
+		t.Fatalf("expected no output for failed host, got %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(testDir, supportMetadataFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var md supportBundleMetadata
+	if err := json.Unmarshal(data, &md); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "DAOS-1234", md.Ticket, "ticket")
+	test.AssertEqual(t, "engine crash", md.Description, "description")
+	test.AssertEqual(t, "host1", md.Hosts, "hosts")
+	test.AssertFalse(t, md.CollectedAt.IsZero(), "collection time not set")
+	test.AssertEqual(t, build.DaosVersion, md.Versions.Dmg, "dmg version")
+	test.AssertEqual(t, "host1", md.Versions.Unknown, "hosts with unknown version")
+
+	data, err = os.ReadFile(filepath.Join(testDir, supportManifestFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	test.AssertEqual(t, len(control.SupportCommands)+1, len(lines), "manifest entries")
+	var sawMetadata bool
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			t.Fatalf("malformed manifest line %q", line)
+		}
+		if fields[1] == supportMetadataFile {
+			sawMetadata = true
+		}
+		contents, err := os.ReadFile(filepath.Join(testDir, fields[1]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum := sha256.Sum256(contents)
+		test.AssertEqual(t, hex.EncodeToString(sum[:]), fields[0], "checksum of "+fields[1])
+	}
+	test.AssertTrue(t, sawMetadata, "metadata missing from manifest")
+}
+
+func TestDmg_newSupportVersionMatrix(t *testing.T) {
+	versionJSON := func(version string) string {
+		data, err := json.Marshal(&build.VersionInfo{Version: version})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	for name, tc := range map[string]struct {
+		hosts  []string
+		output map[string]string
+		expVM  supportVersionMatrix
+	}{
+		"no hosts": {
+			expVM: supportVersionMatrix{
+				Dmg:     build.DaosVersion,
+				Servers: map[string]string{},
+			},
+		},
+		"mixed versions": {
+			hosts: []string{"host1", "host2", "host3", "host4"},
+			output: map[string]string{
+				"host1": versionJSON("2.4.0"),
+				"host2": versionJSON("2.4.0"),
+				"host3": versionJSON("2.4.1"),
+				"host4": "daos_server: command not found\n",
+			},
+			expVM: supportVersionMatrix{
+				Dmg: build.DaosVersion,
+				Servers: map[string]string{
+					"2.4.0": "host[1-2]",
+					"2.4.1": "host3",
+				},
+				Unknown: "host4",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotVM, err := newSupportVersionMatrix(tc.hosts, tc.output)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expVM, gotVM); diff != "" {
+				t.Fatalf("unexpected version matrix (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_supportOutputName(t *testing.T) {
+	test.AssertEqual(t, "ipmctl_show_-dimm.txt", supportOutputName("ipmctl show -dimm"),
+		"unexpected output file name")
+}
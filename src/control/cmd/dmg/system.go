@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2019-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,17 +10,24 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 // SystemCmd is the struct representing the top-level system subcommand.
@@ -31,6 +38,9 @@ type SystemCmd struct {
 	Start        systemStartCmd        `command:"start" description:"Perform start of stopped DAOS system"`
 	Exclude      systemExcludeCmd      `command:"exclude" description:"Exclude ranks from DAOS system"`
 	ClearExclude systemClearExcludeCmd `command:"clear-exclude" description:"Clear excluded state for ranks"`
+	SetAlias     systemSetAliasCmd     `command:"set-alias" description:"Set the display alias of a system member"`
+	ClearAlias   systemClearAliasCmd   `command:"clear-alias" description:"Clear the display alias of a system member"`
+	Annotate     systemAnnotateCmd     `command:"annotate" description:"Set or remove an annotation on system members"`
 	Erase        systemEraseCmd        `command:"erase" description:"Erase system metadata prior to reformat"`
 	ListPools    PoolListCmd           `command:"list-pools" description:"List all pools in the DAOS system"`
 	Cleanup      systemCleanupCmd      `command:"cleanup" description:"Clean up all resources associated with the specified machine"`
@@ -39,6 +49,9 @@ type SystemCmd struct {
 	DelAttr      systemDelAttrCmd      `command:"del-attr" description:"Delete system attributes"`
 	SetProp      systemSetPropCmd      `command:"set-prop" description:"Set system properties"`
 	GetProp      systemGetPropCmd      `command:"get-prop" description:"Get system properties"`
+	Db           systemDbCmd           `command:"db" description:"Perform tasks related to the system database"`
+	ReplaceMS    systemReplaceMSCmd    `command:"replace-ms" description:"Replace a Management Service replica without downtime"`
+	Watch        systemWatchCmd        `command:"watch" description:"Show a live summary of system status until interrupted"`
 }
 
 type leaderQueryCmd struct {
@@ -99,6 +112,7 @@ type systemQueryCmd struct {
 	cfgCmd
 	ctlInvokerCmd
 	jsonOutputCmd
+	tableOutputCmd
 	rankListCmd
 	Verbose bool `long:"verbose" short:"v" description:"Display more member details"`
 }
@@ -112,6 +126,11 @@ func (cmd *systemQueryCmd) Execute(_ []string) (errOut error) {
 	if err := cmd.validateHostsRanks(); err != nil {
 		return err
 	}
+	printOpts, err := cmd.tableOutputOptions()
+	if err != nil {
+		return err
+	}
+
 	req := new(control.SystemQueryReq)
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
@@ -126,8 +145,8 @@ func (cmd *systemQueryCmd) Execute(_ []string) (errOut error) {
 	}
 
 	var out, outErr strings.Builder
-	if err := pretty.PrintSystemQueryResponse(&out, &outErr, resp,
-		pretty.PrintWithVerboseOutput(cmd.Verbose)); err != nil {
+	printOpts = append(printOpts, pretty.PrintWithVerboseOutput(cmd.Verbose))
+	if err := pretty.PrintSystemQueryResponse(&out, &outErr, resp, printOpts...); err != nil {
 		return err
 	}
 	cmd.Info(out.String())
@@ -159,12 +178,12 @@ type systemStopCmd struct {
 	ctlInvokerCmd
 	jsonOutputCmd
 	rankListCmd
-	Force bool `long:"force" description:"Force stop DAOS system members"`
+	Force bool `long:"force" description:"Force stop DAOS system members without prep shutdown"`
 }
 
 // Execute is run when systemStopCmd activates.
 //
-// Always request both prep and kill stages when calling control API.
+// Prep shutdown is performed before stopping ranks unless forced.
 func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 	defer func() {
 		errOut = errors.Wrap(errOut, "system stop failed")
@@ -256,6 +275,125 @@ func (cmd *systemClearExcludeCmd) Execute(_ []string) error {
 	return cmd.execute(true)
 }
 
+type baseAliasCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	Rank uint32 `short:"r" long:"rank" required:"1" description:"Rank of the system member"`
+}
+
+func (cmd *baseAliasCmd) execute(alias string) error {
+	req := &control.SystemSetMemberAliasReq{
+		Rank:  ranklist.Rank(cmd.Rank),
+		Alias: alias,
+	}
+
+	err := control.SystemSetMemberAlias(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(nil, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if alias == "" {
+		cmd.Infof("cleared alias of rank %d", cmd.Rank)
+	} else {
+		cmd.Infof("set alias of rank %d to %q", cmd.Rank, alias)
+	}
+
+	return nil
+}
+
+// systemSetAliasCmd is the struct representing the command to set the
+// display alias of a system member.
+type systemSetAliasCmd struct {
+	baseAliasCmd
+	Args struct {
+		Alias string `positional-arg-name:"alias" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when systemSetAliasCmd subcommand is activated.
+func (cmd *systemSetAliasCmd) Execute(_ []string) error {
+	if err := cmd.execute(cmd.Args.Alias); err != nil {
+		return errors.Wrap(err, "system set-alias failed")
+	}
+	return nil
+}
+
+// systemClearAliasCmd is the struct representing the command to clear the
+// display alias of a system member.
+type systemClearAliasCmd struct {
+	baseAliasCmd
+}
+
+// Execute is run when systemClearAliasCmd subcommand is activated.
+func (cmd *systemClearAliasCmd) Execute(_ []string) error {
+	if err := cmd.execute(""); err != nil {
+		return errors.Wrap(err, "system clear-alias failed")
+	}
+	return nil
+}
+
+// annotationUpdate returns the annotation update for the supplied command
+// arguments. When removing an annotation no value may be supplied.
+func annotationUpdate(key, value string, remove bool) (map[string]string, error) {
+	switch {
+	case remove && value != "":
+		return nil, errors.New("a value may not be supplied when removing an annotation")
+	case !remove && value == "":
+		return nil, errors.Errorf("no value supplied for annotation %q (use --remove to remove it)", key)
+	}
+
+	return map[string]string{key: value}, nil
+}
+
+// systemAnnotateCmd is the struct representing the command to set or remove
+// an annotation on system members.
+type systemAnnotateCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	Ranks  ui.RankSetFlag `long:"ranks" short:"r" required:"1" description:"Comma separated ranges or individual system ranks to annotate"`
+	Remove bool           `long:"remove" description:"Remove the annotation instead of setting it"`
+	Args   struct {
+		Key   string `positional-arg-name:"key" required:"1"`
+		Value string `positional-arg-name:"value"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when systemAnnotateCmd subcommand is activated.
+func (cmd *systemAnnotateCmd) Execute(_ []string) error {
+	notes, err := annotationUpdate(cmd.Args.Key, cmd.Args.Value, cmd.Remove)
+	if err != nil {
+		return err
+	}
+
+	req := &control.SystemAnnotateReq{
+		Ranks:       &cmd.Ranks.RankSet,
+		Annotations: notes,
+	}
+
+	err = control.SystemAnnotate(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(nil, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "system annotate failed")
+	}
+
+	if cmd.Remove {
+		cmd.Infof("removed annotation %q from ranks %s", cmd.Args.Key, &cmd.Ranks.RankSet)
+	} else {
+		cmd.Infof("set annotation %q on ranks %s", cmd.Args.Key, &cmd.Ranks.RankSet)
+	}
+
+	return nil
+}
+
 // systemStartCmd is the struct representing the command to start system.
 type systemStartCmd struct {
 	baseCmd
@@ -637,3 +775,462 @@ func (cmd *systemGetPropCmd) Execute(_ []string) error {
 
 	return nil
 }
+
+// systemDbCmd is the struct representing the system database subcommand.
+type systemDbCmd struct {
+	Verify  systemDbVerifyCmd  `command:"verify" description:"Verify the integrity of the system database on the MS leader"`
+	Backup  systemDbBackupCmd  `command:"backup" description:"Write a portable backup of the system database to a file"`
+	Restore systemDbRestoreCmd `command:"restore" description:"Restore the system database from a backup file"`
+	Status  systemDbStatusCmd  `command:"status" description:"Show the status of the system database replicas"`
+}
+
+// systemDbVerifyCmd represents the command to verify the system database.
+type systemDbVerifyCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+}
+
+// Execute is run when systemDbVerifyCmd subcommand is activated.
+func (cmd *systemDbVerifyCmd) Execute(_ []string) error {
+	resp, err := control.SystemDbVerify(context.Background(), cmd.ctlInvoker, &control.SystemDbVerifyReq{})
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "system db verify failed")
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "Replica %s: verified %d log entries (index %d-%d)\n",
+		resp.Replica, resp.Entries, resp.FirstIndex, resp.LastIndex)
+	for _, msg := range resp.Errors {
+		fmt.Fprintf(&bld, "  %s\n", msg)
+	}
+	for _, msg := range resp.Warnings {
+		fmt.Fprintf(&bld, "  warning: %s\n", msg)
+	}
+	cmd.Infof("%s", bld.String())
+
+	if len(resp.Errors) > 0 {
+		return errors.Errorf("system database verification found %d problems", len(resp.Errors))
+	}
+	cmd.Info("system database verification succeeded")
+
+	return nil
+}
+
+// systemDbStatusCmd represents the command to display the status of the
+// system database replicas.
+type systemDbStatusCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+}
+
+// Execute is run when systemDbStatusCmd subcommand is activated.
+func (cmd *systemDbStatusCmd) Execute(_ []string) error {
+	req := new(control.SystemDbStatusReq)
+	req.SetHostList(cmd.hostlist)
+
+	resp, err := control.SystemDbStatus(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "system db status failed")
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintSystemDbStatusResponse(&out, &outErr, resp); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Errorf("%s", outErr.String())
+	}
+	cmd.Infof("%s", out.String())
+
+	return resp.Errors()
+}
+
+// systemDbBackupCmd represents the command to back up the system database.
+type systemDbBackupCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+
+	Args struct {
+		Path string `positional-arg-name:"<backup file>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when systemDbBackupCmd subcommand is activated.
+func (cmd *systemDbBackupCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system db backup failed")
+	}()
+
+	resp, err := control.SystemDbBackup(context.Background(), cmd.ctlInvoker, &control.SystemDbBackupReq{})
+	if err == nil {
+		err = writeDbBackup(cmd.Args.Path, resp.Backup)
+	}
+	if cmd.jsonOutputEnabled() {
+		if resp != nil {
+			resp.Backup = nil
+		}
+		return cmd.outputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("System database backup with %d members and %d pools written to %s (checksum %s)",
+		resp.Members, resp.Pools, cmd.Args.Path, resp.Checksum)
+
+	return nil
+}
+
+// writeDbBackup writes the backup to a new file that is only readable by
+// the current user. An existing file is never overwritten.
+func writeDbBackup(path string, backup []byte) error {
+	if len(backup) == 0 {
+		return errors.New("empty backup received from MS leader")
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(backup); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// systemDbRestoreCmd represents the command to restore the system database.
+type systemDbRestoreCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	Force bool `long:"force" description:"Overwrite the contents of a non-empty system database"`
+
+	Args struct {
+		Path string `positional-arg-name:"<backup file>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when systemDbRestoreCmd subcommand is activated.
+func (cmd *systemDbRestoreCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system db restore failed")
+	}()
+
+	backup, err := ioutil.ReadFile(cmd.Args.Path)
+	if err != nil {
+		return err
+	}
+
+	req := &control.SystemDbRestoreReq{
+		Backup: backup,
+		Force:  cmd.Force,
+	}
+	resp, err := control.SystemDbRestore(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("System database restored from %s: %d members, %d pools (map version %d)",
+		cmd.Args.Path, resp.Members, resp.Pools, resp.MapVersion)
+
+	return nil
+}
+
+// systemReplaceMSCmd represents the command to replace a MS replica.
+type systemReplaceMSCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	Old         string        `long:"old" required:"1" description:"Address of the MS replica to be replaced"`
+	New         string        `long:"new" required:"1" description:"Address of the server to become a MS replica"`
+	SyncTimeout time.Duration `long:"sync-timeout" default:"5m" description:"Time to wait for the new replica to synchronize"`
+}
+
+// Execute is run when systemReplaceMSCmd subcommand is activated.
+func (cmd *systemReplaceMSCmd) Execute(_ []string) error {
+	req := &control.SystemReplaceReplicaReq{
+		Old:         cmd.Old,
+		New:         cmd.New,
+		SyncTimeout: cmd.SyncTimeout,
+	}
+	resp, err := control.SystemReplaceReplica(context.Background(), cmd.ctlInvoker, req)
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "system replace-ms failed")
+	}
+
+	cmd.Infof("MS replica %s replaced by %s, replicas: %s", cmd.Old, cmd.New,
+		strings.Join(resp.Replicas, ","))
+	cmd.Info("Update access_points in server, agent and dmg configuration files to match")
+
+	return nil
+}
+
+// clearScreen is the terminal escape sequence used to redraw the watch view.
+const clearScreen = "\033[H\033[2J"
+
+// systemWatchPool summarizes the health of a pool in the system watch view.
+type systemWatchPool struct {
+	Label           string `json:"label"`
+	UUID            string `json:"uuid"`
+	State           string `json:"state"`
+	DisabledTargets uint32 `json:"disabled_targets"`
+	Rebuild         string `json:"rebuild"`
+	QueryError      string `json:"query_error,omitempty"`
+}
+
+// systemWatchStatus is a summary of the live status of the system.
+type systemWatchStatus struct {
+	Time            time.Time          `json:"time"`
+	Members         map[string]int     `json:"members"`
+	Pools           []*systemWatchPool `json:"pools"`
+	PoolsDegraded   int                `json:"pools_degraded"`
+	RebuildsRunning int                `json:"rebuilds_running"`
+	Events          []*events.RASEvent `json:"events"`
+	Errors          []string           `json:"errors,omitempty"`
+}
+
+// systemWatchCmd is the struct representing the command to show a live view
+// of the system status.
+type systemWatchCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	jsonOutputCmd
+	Interval time.Duration `short:"i" long:"interval" default:"5s" description:"Time between refreshes of the status summary"`
+	Events   int           `short:"e" long:"events" default:"10" description:"Number of recent RAS events to show"`
+	Count    uint          `short:"c" long:"count" description:"Exit after this many refreshes (default: run until interrupted)"`
+}
+
+// getStatus gathers a summary of member states and pool health.
+func (cmd *systemWatchCmd) getStatus(ctx context.Context) *systemWatchStatus {
+	status := &systemWatchStatus{
+		Time:    time.Now(),
+		Members: make(map[string]int),
+	}
+
+	sqResp, err := control.SystemQuery(ctx, cmd.ctlInvoker, new(control.SystemQueryReq))
+	if err != nil {
+		status.Errors = append(status.Errors, fmt.Sprintf("system query: %s", err))
+	} else {
+		for _, m := range sqResp.Members {
+			status.Members[m.State.String()]++
+		}
+	}
+
+	lpResp, err := control.ListPools(ctx, cmd.ctlInvoker, &control.ListPoolsReq{NoQuery: true})
+	if err != nil {
+		status.Errors = append(status.Errors, fmt.Sprintf("list pools: %s", err))
+		return status
+	}
+
+	for _, p := range lpResp.Pools {
+		wp := &systemWatchPool{
+			Label: p.Label,
+			UUID:  p.UUID,
+			State: p.State,
+		}
+		status.Pools = append(status.Pools, wp)
+		if p.State != system.PoolServiceStateReady.String() {
+			continue
+		}
+
+		pqResp, err := control.PoolQuery(ctx, cmd.ctlInvoker, &control.PoolQueryReq{ID: p.UUID})
+		if err != nil {
+			wp.QueryError = err.Error()
+			continue
+		}
+		wp.DisabledTargets = pqResp.DisabledTargets
+		if wp.DisabledTargets > 0 {
+			status.PoolsDegraded++
+		}
+		if pqResp.Rebuild != nil {
+			wp.Rebuild = pqResp.Rebuild.State.String()
+			if pqResp.Rebuild.State == control.PoolRebuildStateBusy {
+				status.RebuildsRunning++
+			}
+		}
+	}
+
+	return status
+}
+
+func printSystemWatchStatus(out io.Writer, status *systemWatchStatus, interval time.Duration) {
+	fmt.Fprintf(out, "DAOS system status at %s (refreshed every %s)\n\n",
+		status.Time.Format(time.Stamp), interval)
+
+	var total int
+	var states []string
+	for state, count := range status.Members {
+		total += count
+		states = append(states, fmt.Sprintf("%s: %d", state, count))
+	}
+	sort.Strings(states)
+	fmt.Fprintf(out, "Members: %d", total)
+	if len(states) > 0 {
+		fmt.Fprintf(out, " (%s)", strings.Join(states, ", "))
+	}
+	fmt.Fprintf(out, "\nPools: %d (degraded: %d, rebuilding: %d)\n\n",
+		len(status.Pools), status.PoolsDegraded, status.RebuildsRunning)
+
+	if len(status.Pools) > 0 {
+		labelTitle := "Pool"
+		stateTitle := "State"
+		disabledTitle := "Disabled Targets"
+		rebuildTitle := "Rebuild"
+		formatter := txtfmt.NewTableFormatter(labelTitle, stateTitle, disabledTitle, rebuildTitle)
+
+		var table []txtfmt.TableRow
+		for _, p := range status.Pools {
+			name := p.Label
+			if name == "" {
+				name = p.UUID
+			}
+			rebuild := p.Rebuild
+			if p.QueryError != "" {
+				rebuild = "query failed: " + p.QueryError
+			}
+			table = append(table, txtfmt.TableRow{
+				labelTitle:    name,
+				stateTitle:    p.State,
+				disabledTitle: fmt.Sprintf("%d", p.DisabledTargets),
+				rebuildTitle:  rebuild,
+			})
+		}
+		fmt.Fprintln(out, formatter.Format(table))
+	}
+
+	fmt.Fprintln(out, "Recent events:")
+	if len(status.Events) == 0 {
+		fmt.Fprintln(out, "  none")
+	}
+	for _, evt := range status.Events {
+		fmt.Fprintf(out, "  %s %s %s [%s] %s\n", evt.Timestamp, evt.Hostname, evt.ID,
+			evt.Severity, evt.Msg)
+	}
+
+	for _, msg := range status.Errors {
+		fmt.Fprintf(out, "\nWARNING: %s", msg)
+	}
+	if len(status.Errors) > 0 {
+		fmt.Fprintln(out)
+	}
+}
+
+// Execute is run when systemWatchCmd subcommand is activated.
+func (cmd *systemWatchCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system watch failed")
+	}()
+
+	if cmd.Interval <= 0 {
+		return errors.New("--interval must be greater than zero")
+	}
+	if cmd.Events < 0 {
+		return errors.New("--events must not be negative")
+	}
+
+	// Stop watching cleanly when interrupted.
+	ctx, cancel := interruptContext()
+	defer cancel()
+
+	// Keep the most recent events received from the MS leader to be shown
+	// alongside the periodic status summary.
+	var evtMutex sync.Mutex
+	var recent []*events.RASEvent
+	var streamErr error
+	streamDone := make(chan struct{})
+	defer func() {
+		cancel()
+		<-streamDone
+	}()
+	go func() {
+		defer close(streamDone)
+		err := control.SystemEventStream(ctx, cmd.ctlInvoker, new(control.SystemEventStreamReq),
+			func(evt *events.RASEvent) error {
+				evtMutex.Lock()
+				defer evtMutex.Unlock()
+				recent = append(recent, evt)
+				if len(recent) > cmd.Events {
+					recent = recent[len(recent)-cmd.Events:]
+				}
+				return nil
+			})
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("stream ended")
+		}
+		evtMutex.Lock()
+		streamErr = err
+		evtMutex.Unlock()
+	}()
+
+	// Only redraw the screen when writing to a terminal.
+	redraw := false
+	if fi, err := os.Stdout.Stat(); err == nil && fi.Mode()&os.ModeCharDevice != 0 {
+		redraw = !cmd.jsonOutputEnabled()
+	}
+
+	ticker := time.NewTicker(cmd.Interval)
+	defer ticker.Stop()
+
+	var status *systemWatchStatus
+watch:
+	for n := uint(1); cmd.Count == 0 || n <= cmd.Count; n++ {
+		if n > 1 {
+			select {
+			case <-ctx.Done():
+				break watch
+			case <-ticker.C:
+			}
+		}
+
+		status = cmd.getStatus(ctx)
+		evtMutex.Lock()
+		status.Events = append(status.Events, recent...)
+		if streamErr != nil {
+			status.Errors = append(status.Errors, fmt.Sprintf("event stream: %s", streamErr))
+		}
+		evtMutex.Unlock()
+
+		if !cmd.jsonOutputEnabled() {
+			var out strings.Builder
+			if redraw {
+				out.WriteString(clearScreen)
+			}
+			printSystemWatchStatus(&out, status, cmd.Interval)
+			cmd.Info(out.String())
+		}
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(status, nil)
+	}
+	return nil
+}
@@ -8,14 +8,19 @@ package main
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
@@ -41,6 +46,11 @@ func TestDmg_SystemCommands(t *testing.T) {
 		return req
 	}
 
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+	backupPath := filepath.Join(testDir, "sysdb.backup")
+	existingBackup := test.CreateTestFile(t, testDir, "{}")
+
 	runCmdTests(t, []cmdTest{
 		{
 			"system query with no arguments",
@@ -66,6 +76,14 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system query with yaml format and selected columns",
+			"system query --format yaml --columns rank,state",
+			strings.Join([]string{
+				printRequest(t, &control.SystemQueryReq{}),
+			}, " "),
+			nil,
+		},
 		{
 			"system query with bad ranklist",
 			"system query --ranks 0,2,four,,4-8",
@@ -256,6 +274,178 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system set-alias",
+			"system set-alias --rank 3 rack3-node7",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetMemberAliasReq{
+					Rank:  3,
+					Alias: "rack3-node7",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system set-alias without rank",
+			"system set-alias rack3-node7",
+			"",
+			errMissingFlag,
+		},
+		{
+			"system set-alias without alias",
+			"system set-alias --rank 3",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"system annotate",
+			"system annotate --ranks 3,7 hw DIMM-replaced-2024-05-01",
+			strings.Join([]string{
+				printRequest(t, &control.SystemAnnotateReq{
+					Ranks:       ranklist.MustCreateRankSet("3,7"),
+					Annotations: map[string]string{"hw": "DIMM-replaced-2024-05-01"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system annotate remove",
+			"system annotate --ranks 3 --remove hw",
+			strings.Join([]string{
+				printRequest(t, &control.SystemAnnotateReq{
+					Ranks:       ranklist.MustCreateRankSet("3"),
+					Annotations: map[string]string{"hw": ""},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system annotate without ranks",
+			"system annotate hw replaced",
+			"",
+			errMissingFlag,
+		},
+		{
+			"system annotate without value",
+			"system annotate --ranks 3 hw",
+			"",
+			errors.New("no value supplied"),
+		},
+		{
+			"system annotate remove with value",
+			"system annotate --ranks 3 --remove hw replaced",
+			"",
+			errors.New("may not be supplied"),
+		},
+		{
+			"system clear-alias",
+			"system clear-alias --rank 3",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetMemberAliasReq{
+					Rank: 3,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system db verify",
+			"system db verify",
+			printRequest(t, &control.SystemDbVerifyReq{}),
+			nil,
+		},
+		{
+			"system db status",
+			"system db status",
+			strings.Join([]string{
+				printRequest(t, &control.LeaderQueryReq{}),
+				printRequest(t, &control.SystemDbStatusReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system db status with hostlist",
+			"-l host1,host2 system db status",
+			printRequest(t, func() *control.SystemDbStatusReq {
+				req := &control.SystemDbStatusReq{}
+				req.SetHostList([]string{"host1", "host2"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"system replace-ms",
+			"system replace-ms --old host1:10001 --new host2:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemReplicaReq{Replica: "host2:10001"}),
+				printRequest(t, func() *control.SystemDbStatusReq {
+					req := &control.SystemDbStatusReq{}
+					req.SetHostList([]string{"host2:10001"})
+					return req
+				}()),
+				printRequest(t, &control.SystemReplicaReq{Replica: "host2:10001"}),
+				printRequest(t, &control.SystemReplicaReq{Replica: "host1:10001"}),
+			}, " "),
+			nil,
+		},
+		{
+			"system replace-ms without new",
+			"system replace-ms --old host1:10001",
+			"",
+			errors.New("--new"),
+		},
+		{
+			"system watch with invalid interval",
+			"system watch --interval 0s",
+			"",
+			errors.New("--interval"),
+		},
+		{
+			"system watch with invalid event count",
+			"system watch --events -1",
+			"",
+			errors.New("--events"),
+		},
+		{
+			"system db backup",
+			"system db backup " + backupPath,
+			printRequest(t, &control.SystemDbBackupReq{}),
+			nil,
+		},
+		{
+			"system db backup without path",
+			"system db backup",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"system db backup to existing file",
+			"system db backup " + existingBackup,
+			printRequest(t, &control.SystemDbBackupReq{}),
+			errors.New("file exists"),
+		},
+		{
+			"system db restore",
+			"system db restore " + existingBackup,
+			printRequest(t, &control.SystemDbRestoreReq{
+				Backup: []byte("{}"),
+			}),
+			nil,
+		},
+		{
+			"system db restore with force",
+			"system db restore --force " + existingBackup,
+			printRequest(t, &control.SystemDbRestoreReq{
+				Backup: []byte("{}"),
+				Force:  true,
+			}),
+			nil,
+		},
+		{
+			"system db restore missing file",
+			"system db restore " + filepath.Join(testDir, "missing"),
+			"",
+			errors.New("no such file"),
+		},
 		{
 			"system get-prop multi props",
 			"system get-prop daos_system,daos_version",
@@ -651,3 +841,44 @@ func TestDmg_systemStopCmd_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestDmg_printSystemWatchStatus(t *testing.T) {
+	evt := events.NewEngineDiedEvent("foo", 0, 1, common.ExitStatus("test"), 1234)
+	evt.Timestamp = "2023-06-01T12:00:00.000000+00:00"
+
+	status := &systemWatchStatus{
+		Time:    time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+		Members: map[string]int{"Joined": 2, "Excluded": 1},
+		Pools: []*systemWatchPool{
+			{Label: "pool1", State: "Ready", DisabledTargets: 2, Rebuild: "busy"},
+			{UUID: test.MockUUID(2), State: "Creating"},
+		},
+		PoolsDegraded:   1,
+		RebuildsRunning: 1,
+		Events:          []*events.RASEvent{evt},
+		Errors:          []string{"event stream: stream ended"},
+	}
+
+	expOut := `DAOS system status at Jun  1 12:00:00 (refreshed every 5s)
+
+Members: 3 (Excluded: 1, Joined: 2)
+Pools: 2 (degraded: 1, rebuilding: 1)
+
+Pool                                 State    Disabled Targets Rebuild 
+----                                 -----    ---------------- ------- 
+pool1                                Ready    2                busy    
+00000002-0002-0002-0002-000000000002 Creating 0                        
+
+Recent events:
+  2023-06-01T12:00:00.000000+00:00 foo engine_died [ERROR] DAOS engine 0 exited unexpectedly: test
+
+WARNING: event stream: stream ended
+`
+
+	var out strings.Builder
+	printSystemWatchStatus(&out, status, 5*time.Second)
+
+	if diff := cmp.Diff(expOut, out.String()); diff != "" {
+		t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+	}
+}
@@ -33,8 +33,57 @@ import (
 )
 
 type telemCmd struct {
-	Configure telemConfigCmd `command:"config" description:"Configure telemetry"`
-	Metrics   metricsCmd     `command:"metrics" description:"Interact with metrics"`
+	Configure  telemConfigCmd     `command:"config" description:"Configure telemetry"`
+	Metrics    metricsCmd         `command:"metrics" description:"Interact with metrics"`
+	SetClasses telemSetClassesCmd `command:"set-classes" description:"Set the classes of engine metrics that are not exported by the telemetry exporter on the DAOS servers in the configured dmg hostlist. Changes are applied without restarting the engines."`
+}
+
+// telemSetClassesCmd is the struct representing the command to disable
+// classes of engine metrics at runtime.
+type telemSetClassesCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	jsonOutputCmd
+
+	Disable string `long:"disable" description:"Comma-separated list of metric classes to disable (io_latency, target, network); all other classes are enabled. If unset then all classes are enabled."`
+}
+
+// Execute is run when telemSetClassesCmd activates.
+func (cmd *telemSetClassesCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "set telemetry classes failed")
+	}()
+
+	req := &control.SetTelemetryClassesReq{
+		Disabled: common.TokenizeCommaSeparatedString(cmd.Disable),
+	}
+	req.SetHostList(cmd.hostlist)
+
+	cmd.Debugf("set telemetry classes request: %+v", req)
+
+	resp, err := control.SetTelemetryClasses(context.Background(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	cmd.Debugf("set telemetry classes response: %+v", resp)
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(resp, resp.Errors())
+	}
+
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	} else {
+		cmd.Info("Telemetry classes have been updated successfully.")
+	}
+
+	return resp.Errors()
 }
 
 type telemConfigCmd struct {
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/pkg/errors"
 )
 
@@ -27,6 +28,20 @@ func TestTelemetryCommands(t *testing.T) {
 			"",
 			errors.New("exactly 1 host"),
 		},
+		{
+			"set classes with none disabled",
+			"telemetry set-classes",
+			printRequest(t, &control.SetTelemetryClassesReq{Disabled: []string{}}),
+			nil,
+		},
+		{
+			"set classes",
+			"telemetry set-classes --disable=io_latency,target",
+			printRequest(t, &control.SetTelemetryClassesReq{
+				Disabled: []string{"io_latency", "target"},
+			}),
+			nil,
+		},
 	})
 }
 
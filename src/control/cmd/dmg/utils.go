@@ -10,12 +10,12 @@ import (
 	"bytes"
 	"encoding/csv"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
-	"github.com/dustin/go-humanize"
 )
 
 // formatHostGroups adds group title header per group results.
@@ -50,7 +50,7 @@ func parseUint64Array(in string) (out []uint64, err error) {
 
 	out = make([]uint64, len(arr))
 	for idx, elemStr := range arr {
-		out[idx], err = humanize.ParseBytes(elemStr)
+		out[idx], err = strconv.ParseUint(strings.TrimSpace(elemStr), 10, 64)
 		if err != nil {
 			return
 		}
@@ -0,0 +1,101 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package common
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ChecksumFileSuffix is appended to the path of a file to obtain the
+	// path of the file holding its checksum.
+	ChecksumFileSuffix = ".sha256"
+
+	checksumTrailerPrefix = "# sha256: "
+)
+
+var (
+	// ErrNoChecksum indicates that no checksum was stored for the data.
+	ErrNoChecksum = errors.New("no checksum found")
+	// ErrChecksumMismatch indicates that the data does not match its
+	// stored checksum.
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+)
+
+// Checksum returns the hex-encoded SHA-256 digest of the data.
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// AppendChecksumTrailer returns the data with a trailing comment line holding
+// its checksum. Only suitable for formats such as YAML where lines starting
+// with "#" are ignored, so that the data can still be read by tools that are
+// unaware of the checksum.
+func AppendChecksumTrailer(data []byte) []byte {
+	out := make([]byte, 0, len(data)+len(checksumTrailerPrefix)+sha256.Size*2+2)
+	out = append(out, data...)
+	if len(out) > 0 && out[len(out)-1] != '\n' {
+		out = append(out, '\n')
+	}
+	out = append(out, checksumTrailerPrefix+Checksum(out)+"\n"...)
+	return out
+}
+
+// VerifyChecksumTrailer verifies data written with AppendChecksumTrailer and
+// returns the data without the trailer. ErrNoChecksum is returned along with
+// the unmodified data if no trailer is found.
+func VerifyChecksumTrailer(data []byte) ([]byte, error) {
+	trimmed := bytes.TrimRight(data, "\n")
+	idx := bytes.LastIndexByte(trimmed, '\n')
+	body, last := trimmed[:idx+1], string(trimmed[idx+1:])
+	if !strings.HasPrefix(last, checksumTrailerPrefix) {
+		return data, ErrNoChecksum
+	}
+
+	if Checksum(body) != strings.TrimPrefix(last, checksumTrailerPrefix) {
+		return nil, ErrChecksumMismatch
+	}
+
+	return body, nil
+}
+
+// WriteChecksumFile writes the checksum of the data written to the file at
+// the given path into a file alongside it. Used for formats that can't hold
+// the checksum themselves.
+func WriteChecksumFile(path string, data []byte, perm os.FileMode) error {
+	return WriteFileAtomic(path+ChecksumFileSuffix, []byte(Checksum(data)+"\n"), perm)
+}
+
+// VerifyChecksumFile verifies the file at the given path against the checksum
+// stored alongside it by WriteChecksumFile.
+func VerifyChecksumFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	sum, err := os.ReadFile(path + ChecksumFileSuffix)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrNoChecksum
+		}
+		return err
+	}
+
+	if Checksum(data) != strings.TrimSpace(string(sum)) {
+		return ErrChecksumMismatch
+	}
+
+	return nil
+}
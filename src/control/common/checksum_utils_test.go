@@ -0,0 +1,92 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package common
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestCommon_ChecksumTrailer(t *testing.T) {
+	data := []byte("version: 1\nuuid: abc\n")
+	withSum := AppendChecksumTrailer(data)
+
+	for name, tc := range map[string]struct {
+		data    []byte
+		expData []byte
+		expErr  error
+	}{
+		"valid": {
+			data:    withSum,
+			expData: data,
+		},
+		"no trailing newline": {
+			data:    AppendChecksumTrailer([]byte("version: 1")),
+			expData: []byte("version: 1\n"),
+		},
+		"no checksum": {
+			data:    data,
+			expData: data,
+			expErr:  ErrNoChecksum,
+		},
+		"empty": {
+			data:    []byte{},
+			expData: []byte{},
+			expErr:  ErrNoChecksum,
+		},
+		"corrupted data": {
+			data:   bytes.Replace(withSum, []byte("abc"), []byte("abd"), 1),
+			expErr: ErrChecksumMismatch,
+		},
+		"truncated": {
+			data:   withSum[len("version: 1\n"):],
+			expErr: ErrChecksumMismatch,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotData, gotErr := VerifyChecksumTrailer(tc.data)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr == ErrChecksumMismatch {
+				return
+			}
+			test.AssertEqual(t, string(tc.expData), string(gotData), "unexpected data")
+		})
+	}
+}
+
+func TestCommon_ChecksumFile(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+	path := filepath.Join(tmpDir, "nvme.conf")
+	data := []byte(`{"subsystems": []}`)
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	test.CmpErr(t, ErrNoChecksum, VerifyChecksumFile(path))
+
+	if err := WriteChecksumFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	test.CmpErr(t, nil, VerifyChecksumFile(path))
+
+	if err := os.WriteFile(path, []byte(`{"subsystems": [}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	test.CmpErr(t, ErrChecksumMismatch, VerifyChecksumFile(path))
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyChecksumFile(path); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+}
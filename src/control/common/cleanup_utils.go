@@ -0,0 +1,181 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package common
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// StaleResource describes a set of files that are created by a process and may
+// be left behind if that process exits without cleaning up after itself.
+type StaleResource struct {
+	// Description is a human-readable name for the resource.
+	Description string
+	// Dir is the directory containing the files.
+	Dir string
+	// Pattern matches the base name of the files, with the first submatch
+	// being the PID of the process that created them.
+	Pattern *regexp.Regexp
+}
+
+var (
+	// SpdkTraceShm is the shared memory created for SPDK tracepoints.
+	SpdkTraceShm = StaleResource{
+		Description: "SPDK trace shared memory",
+		Dir:         "/dev/shm",
+		Pattern:     regexp.MustCompile(`^spdk_.+\.pid([0-9]+)$`),
+	}
+	// SpdkRuntimeDir is the DPDK runtime directory of an SPDK process, which
+	// holds the memory configuration shared with secondary processes.
+	SpdkRuntimeDir = StaleResource{
+		Description: "SPDK runtime directory",
+		Dir:         "/var/run/dpdk",
+		Pattern:     regexp.MustCompile(`^spdk_pid([0-9]+)$`),
+	}
+	// SpdkHugepages are the hugepage files mapped by an SPDK process.
+	SpdkHugepages = StaleResource{
+		Description: "SPDK hugepage",
+		Dir:         "/dev/hugepages",
+		Pattern:     regexp.MustCompile(`spdk_pid([0-9]+)map`),
+	}
+)
+
+type (
+	statFn   func(string) (os.FileInfo, error)
+	removeFn func(string) error
+)
+
+func isPIDActive(pidStr string, stat statFn) (bool, error) {
+	filename := fmt.Sprintf("/proc/%s", pidStr)
+
+	if _, err := stat(filename); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// createStaleWalkFunc returns a filepath.WalkFunc that will remove any file or
+// directory in the resource's directory whose name matches the resource's
+// pattern and whose encoded pid is inactive.
+func createStaleWalkFunc(log logging.Logger, res StaleResource, stat statFn, remove removeFn, removed *[]string) filepath.WalkFunc {
+	return func(path string, info os.FileInfo, err error) error {
+		switch {
+		case err != nil:
+			if path == res.Dir && os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		case info == nil:
+			return errors.New("nil fileinfo")
+		case path == res.Dir:
+			return nil
+		}
+
+		// Matching directories are removed whole, and others are not
+		// descended into.
+		var skip error
+		if info.IsDir() {
+			skip = filepath.SkipDir
+		}
+
+		matches := res.Pattern.FindStringSubmatch(info.Name())
+		if len(matches) != 2 {
+			log.Debugf("walk func: unexpected name, skipping %s", path)
+			return skip // skip files not matching expected pattern
+		}
+		// PID string will be the first submatch at index 1 of the match results.
+
+		if isActive, err := isPIDActive(matches[1], stat); err != nil || isActive {
+			log.Debugf("walk func: active owner proc, skipping %s", path)
+			if err != nil {
+				return err
+			}
+			return skip // skip files created by an existing process
+		}
+
+		log.Debugf("walk func: removing %s %s", res.Description, path)
+		if err := remove(path); err != nil {
+			return errors.Wrapf(err, "removing %s %s", res.Description, path)
+		}
+		*removed = append(*removed, path)
+
+		return skip
+	}
+}
+
+func removeStale(log logging.Logger, res StaleResource, stat statFn, remove removeFn) (removed []string, _ error) {
+	return removed, filepath.Walk(res.Dir, createStaleWalkFunc(log, res, stat, remove, &removed))
+}
+
+// RemoveStale removes any files belonging to the supplied resources that were
+// created by processes that are no longer running. The paths of the removed
+// files are returned. Removal continues with the next resource after a
+// failure, with the first error encountered being returned.
+func RemoveStale(log logging.Logger, resources ...StaleResource) (removed []string, _ error) {
+	var firstErr error
+	for _, res := range resources {
+		paths, err := removeStale(log, res, os.Stat, os.RemoveAll)
+		removed = append(removed, paths...)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return removed, firstErr
+}
+
+// RemoveStaleSocket removes the unix domain socket file at the supplied path if
+// no process is listening on it, returning true if the file was removed. An
+// error is returned if the socket is still in use, as another process would
+// otherwise silently lose its socket.
+func RemoveStaleSocket(path string) (bool, error) {
+	fi, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return false, errors.Errorf("%s exists and is not a socket", path)
+	}
+
+	for _, network := range []string{"unixpacket", "unix"} {
+		conn, err := net.Dial(network, path)
+		if err == nil {
+			conn.Close()
+			return false, errors.Errorf("socket %s is in use by another process", path)
+		}
+		if errors.Is(err, syscall.EPROTOTYPE) {
+			// Something is listening with a different socket type.
+			continue
+		}
+		if !errors.Is(err, syscall.ECONNREFUSED) {
+			return false, errors.Wrapf(err, "checking socket %s", path)
+		}
+
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+		return true, nil
+	}
+
+	return false, errors.Errorf("socket %s is in use by another process", path)
+}
@@ -0,0 +1,337 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package common
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestCommon_removeStale(t *testing.T) {
+	procDir := makeProcTree(t, 2)
+	resDir := t.TempDir()
+
+	for _, name := range []string{
+		"spdk_tgt_trace.pid1",     // owner still running
+		"spdk_tgt_trace.pid42",    // owner exited
+		"spdk_daos_trace.pid1234", // owner exited
+		"spdk_tgt_trace.pidfoo",   // not a pid
+		"unrelated.pid42",         // doesn't match pattern
+	} {
+		if err := os.WriteFile(filepath.Join(resDir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	stat := func(path string) (os.FileInfo, error) {
+		return os.Stat(filepath.Join(procDir, strings.TrimPrefix(path, "/proc/")))
+	}
+
+	res := SpdkTraceShm
+	res.Dir = resDir
+	removed, err := removeStale(log, res, stat, os.RemoveAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(removed)
+	expRemoved := []string{
+		filepath.Join(resDir, "spdk_daos_trace.pid1234"),
+		filepath.Join(resDir, "spdk_tgt_trace.pid42"),
+	}
+	if diff := cmp.Diff(expRemoved, removed); diff != "" {
+		t.Fatalf("unexpected removed files (-want, +got):\n%s\n", diff)
+	}
+
+	var remaining []string
+	entries, err := os.ReadDir(resDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		remaining = append(remaining, entry.Name())
+	}
+	expRemaining := []string{"spdk_tgt_trace.pid1", "spdk_tgt_trace.pidfoo", "unrelated.pid42"}
+	if diff := cmp.Diff(expRemaining, remaining); diff != "" {
+		t.Fatalf("unexpected remaining files (-want, +got):\n%s\n", diff)
+	}
+
+	// Directories are removed along with their contents, and a missing
+	// resource directory is not an error.
+	runtimeDir := filepath.Join(resDir, "dpdk")
+	if err := os.MkdirAll(filepath.Join(runtimeDir, "spdk_pid42"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(runtimeDir, "spdk_pid42", "config"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	res = SpdkRuntimeDir
+	res.Dir = runtimeDir
+	removed, err = removeStale(log, res, stat, os.RemoveAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{filepath.Join(runtimeDir, "spdk_pid42")}, removed); diff != "" {
+		t.Fatalf("unexpected removed files (-want, +got):\n%s\n", diff)
+	}
+
+	res.Dir = filepath.Join(resDir, "missing")
+	if removed, err := removeStale(log, res, stat, os.RemoveAll); err != nil || removed != nil {
+		t.Fatalf("expected nothing removed from missing dir, got %v (err: %v)", removed, err)
+	}
+}
+
+type mockFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+	isDir   bool
+	stat    *syscall.Stat_t
+}
+
+func (mfi *mockFileInfo) Name() string       { return mfi.name }
+func (mfi *mockFileInfo) Size() int64        { return mfi.size }
+func (mfi *mockFileInfo) Mode() os.FileMode  { return mfi.mode }
+func (mfi *mockFileInfo) ModTime() time.Time { return mfi.modTime }
+func (mfi *mockFileInfo) IsDir() bool        { return mfi.isDir }
+func (mfi *mockFileInfo) Sys() interface{}   { return mfi.stat }
+
+func testFileInfo(t *testing.T, name string, uid uint32) os.FileInfo {
+	t.Helper()
+
+	return &mockFileInfo{
+		name: name,
+		stat: &syscall.Stat_t{
+			Uid: uid,
+		},
+	}
+}
+
+type testWalkInput struct {
+	path   string
+	info   os.FileInfo
+	err    error
+	expErr error
+}
+
+func TestCommon_staleWalkFn(t *testing.T) {
+	testDir := "/wherever"
+
+	for name, tc := range map[string]struct {
+		testInputs   []*testWalkInput
+		statExistMap map[string]bool
+		removeErr    error
+		expRemoved   []string
+		expCount     uint
+	}{
+		"ignore subdirectory": {
+			testInputs: []*testWalkInput{
+				{
+					path: filepath.Join(testDir, "prefix1_foo"),
+					info: &mockFileInfo{
+						name: "prefix1_foo",
+						stat: &syscall.Stat_t{
+							Uid: 42,
+						},
+						isDir: true,
+					},
+					expErr: errors.New("skip this directory"),
+				},
+			},
+		},
+		"input error propagated": {
+			testInputs: []*testWalkInput{
+				{
+					path:   filepath.Join(testDir, "prefix1_foo"),
+					info:   testFileInfo(t, "prefix1_foo", 42),
+					err:    errors.New("walk failed"),
+					expErr: errors.New("walk failed"),
+				},
+			},
+		},
+		"nil fileinfo": {
+			testInputs: []*testWalkInput{
+				{
+					path:   filepath.Join(testDir, "prefix1_foo"),
+					info:   nil,
+					expErr: errors.New("nil fileinfo"),
+				},
+			},
+		},
+		"no matching filenames": {
+			testInputs: []*testWalkInput{
+				{
+					path: filepath.Join(testDir, "prefix1_foo"),
+					info: &mockFileInfo{
+						name: "prefix1_foo",
+					},
+				},
+			},
+		},
+		"matching filenames; one inactive pid": {
+			testInputs: []*testWalkInput{
+				{
+					path: filepath.Join(testDir, "spdk_pid69299map_990"),
+					info: testFileInfo(t, "spdk_pid69299map_990", 42),
+				},
+				{
+					path: filepath.Join(testDir, "spdk_pid69300map_98"),
+					info: testFileInfo(t, "spdk_pid69300map_98", 42),
+				},
+			},
+			statExistMap: map[string]bool{"/proc/69299": true},
+			expRemoved:   []string{filepath.Join(testDir, "spdk_pid69300map_98")},
+			expCount:     1,
+		},
+		"remove fails": {
+			testInputs: []*testWalkInput{
+				{
+					path:   filepath.Join(testDir, "spdk_pid69299map_990"),
+					info:   testFileInfo(t, "spdk_pid69299map_990", 42),
+					expErr: errors.New("could not remove"),
+				},
+			},
+			removeErr: errors.New("could not remove"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			removedFiles := make([]string, 0)
+			remove := func(path string) error {
+				if tc.removeErr == nil {
+					removedFiles = append(removedFiles, path)
+				}
+				return tc.removeErr
+			}
+			stat := func(path string) (os.FileInfo, error) {
+				if tc.statExistMap[path] {
+					return nil, nil
+				}
+				return nil, os.ErrNotExist
+			}
+
+			res := SpdkHugepages
+			res.Dir = testDir
+			var removed []string
+			testFn := createStaleWalkFunc(log, res, stat, remove, &removed)
+			for _, ti := range tc.testInputs {
+				gotErr := testFn(ti.path, ti.info, ti.err)
+				test.CmpErr(t, ti.expErr, gotErr)
+			}
+
+			if tc.expRemoved == nil {
+				tc.expRemoved = []string{}
+			}
+			if diff := cmp.Diff(tc.expRemoved, removedFiles); diff != "" {
+				t.Fatalf("unexpected remove result (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expCount, uint(len(removed)), "unexpected remove count")
+		})
+	}
+}
+
+func TestCommon_StaleResource_Patterns(t *testing.T) {
+	for name, tc := range map[string]struct {
+		res    StaleResource
+		file   string
+		expPid string
+	}{
+		"trace shm": {
+			res:    SpdkTraceShm,
+			file:   "spdk_tgt_trace.pid1234",
+			expPid: "1234",
+		},
+		"runtime dir": {
+			res:    SpdkRuntimeDir,
+			file:   "spdk_pid1234",
+			expPid: "1234",
+		},
+		"runtime dir with suffix": {
+			res:  SpdkRuntimeDir,
+			file: "spdk_pid1234map_0",
+		},
+		"hugepage": {
+			res:    SpdkHugepages,
+			file:   "spdk_pid1234map_0",
+			expPid: "1234",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotPid string
+			if matches := tc.res.Pattern.FindStringSubmatch(tc.file); len(matches) > 1 {
+				gotPid = matches[1]
+			}
+			test.AssertEqual(t, tc.expPid, gotPid, "unexpected pid match")
+		})
+	}
+}
+
+func TestCommon_RemoveStaleSocket(t *testing.T) {
+	testDir := t.TempDir()
+
+	// Missing socket.
+	removed, err := RemoveStaleSocket(filepath.Join(testDir, "missing.sock"))
+	test.CmpErr(t, nil, err)
+	test.AssertFalse(t, removed, "missing socket should not be removed")
+
+	// Regular file.
+	notSock := filepath.Join(testDir, "file.sock")
+	if err := os.WriteFile(notSock, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = RemoveStaleSocket(notSock)
+	test.CmpErr(t, errors.New("not a socket"), err)
+
+	// Live sockets of either type.
+	for _, network := range []string{"unixpacket", "unix"} {
+		sockPath := filepath.Join(testDir, network+".sock")
+		lis, err := net.Listen(network, sockPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = RemoveStaleSocket(sockPath)
+		test.CmpErr(t, errors.New("in use by another process"), err)
+		if _, err := os.Stat(sockPath); err != nil {
+			t.Fatalf("live %s socket was removed", network)
+		}
+		lis.Close()
+	}
+
+	// Socket left behind by a process that exited.
+	sockPath := filepath.Join(testDir, "stale.sock")
+	lis, err := net.ListenUnix("unixpacket", &net.UnixAddr{Name: sockPath, Net: "unixpacket"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis.SetUnlinkOnClose(false)
+	lis.Close()
+
+	removed, err = RemoveStaleSocket(sockPath)
+	test.CmpErr(t, nil, err)
+	test.AssertTrue(t, removed, "stale socket should be removed")
+	if _, err := os.Stat(sockPath); !os.IsNotExist(err) {
+		t.Fatalf("expected stale socket to be removed, got %v", err)
+	}
+}
@@ -111,9 +111,14 @@ func PrintStructs(name string, i interface{}) {
 // WriteFileAtomic mimics ioutil.WriteFile, but it makes sure the file is
 // either successfully written persistently or untouched.
 func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
-	// Write a staging file.
-	staging := path + ".staging"
-	if err := writeFile(staging, data, perm); err != nil {
+	// Write a uniquely-named staging file so that concurrent writers
+	// can't interfere with each other's staged data.
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".staging-")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	staging := f.Name()
+	if err := writeFile(f, data, perm); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -127,27 +132,28 @@ func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
 	return SyncDir(filepath.Dir(path))
 }
 
-// writeFile mimics ioutil.WriteFile, but syncs the file before returning. The
-// error is one from the standard library.
-func writeFile(path string, data []byte, perm os.FileMode) (err error) {
-	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
-	if err != nil {
-		return
-	}
+// writeFile mimics ioutil.WriteFile on an already-created file, but syncs the
+// file before returning. The file is closed, and removed on error. The error is
+// one from the standard library.
+func writeFile(f *os.File, data []byte, perm os.FileMode) (err error) {
 	defer func() {
 		if tmperr := f.Close(); tmperr != nil && err == nil {
 			err = tmperr
 		}
 		if err != nil {
-			os.Remove(path)
+			os.Remove(f.Name())
 		}
 	}()
 
+	if err = f.Chmod(perm); err != nil {
+		return
+	}
+
 	n, err := f.Write(data)
 	if err != nil {
 		return
 	} else if n < len(data) {
-		return fmt.Errorf("write %s: only wrote %d/%d", path, n, len(data))
+		return fmt.Errorf("write %s: only wrote %d/%d", f.Name(), n, len(data))
 	}
 
 	return f.Sync()
@@ -36,125 +36,212 @@ var file_ctl_ctl_proto_rawDesc = []byte{
 	0x63, 0x74, 0x6c, 0x2f, 0x73, 0x6d, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x63,
 	0x74, 0x6c, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10,
 	0x63, 0x74, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x32, 0xf3, 0x06, 0x0a, 0x06, 0x43, 0x74, 0x6c, 0x53, 0x76, 0x63, 0x12, 0x3a, 0x0a, 0x0b, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x12, 0x12,
-	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62,
-	0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x14, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e,
-	0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x61,
-	0x6e, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53,
-	0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x0d, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72,
-	0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
-	0x12, 0x43, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
-	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
-	0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x11, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4c, 0x6f, 0x67, 0x4d, 0x61,
-	0x73, 0x6b, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67,
-	0x4d, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
-	0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
-	0x12, 0x34, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e,
+	0x1a, 0x0f, 0x63, 0x74, 0x6c, 0x2f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x11, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc0, 0x0c, 0x0a, 0x06, 0x43, 0x74, 0x6c, 0x53, 0x76, 0x63, 0x12,
+	0x3a, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x13,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x11, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x40, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65,
+	0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d,
+	0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x47, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65,
+	0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0e, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x70, 0x64, 0x6b, 0x52, 0x70, 0x63, 0x12, 0x0f, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x52, 0x70, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x53, 0x70, 0x64, 0x6b, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e,
+	0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x0d, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x15,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x43, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x53, 0x6d, 0x64, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12,
+	0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x62,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x11, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73,
+	0x6b, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d,
+	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x10, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x4c, 0x6f,
+	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4c, 0x6f,
+	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x34, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61,
+	0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70,
 	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
 	0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x61,
 	0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52,
 	0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x6e, 0x6b,
-	0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x00, 0x12, 0x33, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
-	0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f,
-	0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74,
-	0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52,
+	0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61,
+	0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0a, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61,
+	0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x78, 0x65, 0x63, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x09, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x11, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x54,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x1b, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10,
+	0x44, 0x75, 0x6d, 0x70, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x18, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x45, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x44, 0x75, 0x6d, 0x70, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_ctl_ctl_proto_goTypes = []interface{}{
-	(*StorageScanReq)(nil),     // 0: ctl.StorageScanReq
-	(*StorageFormatReq)(nil),   // 1: ctl.StorageFormatReq
-	(*NvmeRebindReq)(nil),      // 2: ctl.NvmeRebindReq
-	(*NvmeAddDeviceReq)(nil),   // 3: ctl.NvmeAddDeviceReq
-	(*NetworkScanReq)(nil),     // 4: ctl.NetworkScanReq
-	(*FirmwareQueryReq)(nil),   // 5: ctl.FirmwareQueryReq
-	(*FirmwareUpdateReq)(nil),  // 6: ctl.FirmwareUpdateReq
-	(*SmdQueryReq)(nil),        // 7: ctl.SmdQueryReq
-	(*SmdManageReq)(nil),       // 8: ctl.SmdManageReq
-	(*SetLogMasksReq)(nil),     // 9: ctl.SetLogMasksReq
-	(*RanksReq)(nil),           // 10: ctl.RanksReq
-	(*StorageScanResp)(nil),    // 11: ctl.StorageScanResp
-	(*StorageFormatResp)(nil),  // 12: ctl.StorageFormatResp
-	(*NvmeRebindResp)(nil),     // 13: ctl.NvmeRebindResp
-	(*NvmeAddDeviceResp)(nil),  // 14: ctl.NvmeAddDeviceResp
-	(*NetworkScanResp)(nil),    // 15: ctl.NetworkScanResp
-	(*FirmwareQueryResp)(nil),  // 16: ctl.FirmwareQueryResp
-	(*FirmwareUpdateResp)(nil), // 17: ctl.FirmwareUpdateResp
-	(*SmdQueryResp)(nil),       // 18: ctl.SmdQueryResp
-	(*SmdManageResp)(nil),      // 19: ctl.SmdManageResp
-	(*SetLogMasksResp)(nil),    // 20: ctl.SetLogMasksResp
-	(*RanksResp)(nil),          // 21: ctl.RanksResp
+	(*StorageScanReq)(nil),          // 0: ctl.StorageScanReq
+	(*StorageFormatReq)(nil),        // 1: ctl.StorageFormatReq
+	(*NvmeRebindReq)(nil),           // 2: ctl.NvmeRebindReq
+	(*NvmeAddDeviceReq)(nil),        // 3: ctl.NvmeAddDeviceReq
+	(*SpdkRpcReq)(nil),              // 4: ctl.SpdkRpcReq
+	(*NetworkScanReq)(nil),          // 5: ctl.NetworkScanReq
+	(*FirmwareQueryReq)(nil),        // 6: ctl.FirmwareQueryReq
+	(*FirmwareUpdateReq)(nil),       // 7: ctl.FirmwareUpdateReq
+	(*SmdQueryReq)(nil),             // 8: ctl.SmdQueryReq
+	(*SmdManageReq)(nil),            // 9: ctl.SmdManageReq
+	(*BlobstoreQueryReq)(nil),       // 10: ctl.BlobstoreQueryReq
+	(*SetLogMasksReq)(nil),          // 11: ctl.SetLogMasksReq
+	(*FaultDomainQueryReq)(nil),     // 12: ctl.FaultDomainQueryReq
+	(*LogStreamReq)(nil),            // 13: ctl.LogStreamReq
+	(*RanksReq)(nil),                // 14: ctl.RanksReq
+	(*FaultInjectReq)(nil),          // 15: ctl.FaultInjectReq
+	(*SupportExecReq)(nil),          // 16: ctl.SupportExecReq
+	(*PoolDebugReq)(nil),            // 17: ctl.PoolDebugReq
+	(*SetTelemetryClassesReq)(nil),  // 18: ctl.SetTelemetryClassesReq
+	(*DumpEngineStacksReq)(nil),     // 19: ctl.DumpEngineStacksReq
+	(*StorageScanResp)(nil),         // 20: ctl.StorageScanResp
+	(*StorageFormatResp)(nil),       // 21: ctl.StorageFormatResp
+	(*NvmeRebindResp)(nil),          // 22: ctl.NvmeRebindResp
+	(*NvmeAddDeviceResp)(nil),       // 23: ctl.NvmeAddDeviceResp
+	(*SpdkRpcResp)(nil),             // 24: ctl.SpdkRpcResp
+	(*NetworkScanResp)(nil),         // 25: ctl.NetworkScanResp
+	(*FirmwareQueryResp)(nil),       // 26: ctl.FirmwareQueryResp
+	(*FirmwareUpdateResp)(nil),      // 27: ctl.FirmwareUpdateResp
+	(*SmdQueryResp)(nil),            // 28: ctl.SmdQueryResp
+	(*SmdManageResp)(nil),           // 29: ctl.SmdManageResp
+	(*BlobstoreQueryResp)(nil),      // 30: ctl.BlobstoreQueryResp
+	(*SetLogMasksResp)(nil),         // 31: ctl.SetLogMasksResp
+	(*FaultDomainQueryResp)(nil),    // 32: ctl.FaultDomainQueryResp
+	(*LogStreamResp)(nil),           // 33: ctl.LogStreamResp
+	(*RanksResp)(nil),               // 34: ctl.RanksResp
+	(*FaultInjectResp)(nil),         // 35: ctl.FaultInjectResp
+	(*SupportExecResp)(nil),         // 36: ctl.SupportExecResp
+	(*PoolDebugResp)(nil),           // 37: ctl.PoolDebugResp
+	(*SetTelemetryClassesResp)(nil), // 38: ctl.SetTelemetryClassesResp
+	(*DumpEngineStacksResp)(nil),    // 39: ctl.DumpEngineStacksResp
 }
 var file_ctl_ctl_proto_depIdxs = []int32{
 	0,  // 0: ctl.CtlSvc.StorageScan:input_type -> ctl.StorageScanReq
-	1,  // 1: ctl.CtlSvc.StorageFormat:input_type -> ctl.StorageFormatReq
-	2,  // 2: ctl.CtlSvc.StorageNvmeRebind:input_type -> ctl.NvmeRebindReq
-	3,  // 3: ctl.CtlSvc.StorageNvmeAddDevice:input_type -> ctl.NvmeAddDeviceReq
-	4,  // 4: ctl.CtlSvc.NetworkScan:input_type -> ctl.NetworkScanReq
-	5,  // 5: ctl.CtlSvc.FirmwareQuery:input_type -> ctl.FirmwareQueryReq
-	6,  // 6: ctl.CtlSvc.FirmwareUpdate:input_type -> ctl.FirmwareUpdateReq
-	7,  // 7: ctl.CtlSvc.SmdQuery:input_type -> ctl.SmdQueryReq
-	8,  // 8: ctl.CtlSvc.SmdManage:input_type -> ctl.SmdManageReq
-	9,  // 9: ctl.CtlSvc.SetEngineLogMasks:input_type -> ctl.SetLogMasksReq
-	10, // 10: ctl.CtlSvc.PrepShutdownRanks:input_type -> ctl.RanksReq
-	10, // 11: ctl.CtlSvc.StopRanks:input_type -> ctl.RanksReq
-	10, // 12: ctl.CtlSvc.PingRanks:input_type -> ctl.RanksReq
-	10, // 13: ctl.CtlSvc.ResetFormatRanks:input_type -> ctl.RanksReq
-	10, // 14: ctl.CtlSvc.StartRanks:input_type -> ctl.RanksReq
-	11, // 15: ctl.CtlSvc.StorageScan:output_type -> ctl.StorageScanResp
-	12, // 16: ctl.CtlSvc.StorageFormat:output_type -> ctl.StorageFormatResp
-	13, // 17: ctl.CtlSvc.StorageNvmeRebind:output_type -> ctl.NvmeRebindResp
-	14, // 18: ctl.CtlSvc.StorageNvmeAddDevice:output_type -> ctl.NvmeAddDeviceResp
-	15, // 19: ctl.CtlSvc.NetworkScan:output_type -> ctl.NetworkScanResp
-	16, // 20: ctl.CtlSvc.FirmwareQuery:output_type -> ctl.FirmwareQueryResp
-	17, // 21: ctl.CtlSvc.FirmwareUpdate:output_type -> ctl.FirmwareUpdateResp
-	18, // 22: ctl.CtlSvc.SmdQuery:output_type -> ctl.SmdQueryResp
-	19, // 23: ctl.CtlSvc.SmdManage:output_type -> ctl.SmdManageResp
-	20, // 24: ctl.CtlSvc.SetEngineLogMasks:output_type -> ctl.SetLogMasksResp
-	21, // 25: ctl.CtlSvc.PrepShutdownRanks:output_type -> ctl.RanksResp
-	21, // 26: ctl.CtlSvc.StopRanks:output_type -> ctl.RanksResp
-	21, // 27: ctl.CtlSvc.PingRanks:output_type -> ctl.RanksResp
-	21, // 28: ctl.CtlSvc.ResetFormatRanks:output_type -> ctl.RanksResp
-	21, // 29: ctl.CtlSvc.StartRanks:output_type -> ctl.RanksResp
-	15, // [15:30] is the sub-list for method output_type
-	0,  // [0:15] is the sub-list for method input_type
+	0,  // 1: ctl.CtlSvc.StorageScanStream:input_type -> ctl.StorageScanReq
+	1,  // 2: ctl.CtlSvc.StorageFormat:input_type -> ctl.StorageFormatReq
+	2,  // 3: ctl.CtlSvc.StorageNvmeRebind:input_type -> ctl.NvmeRebindReq
+	3,  // 4: ctl.CtlSvc.StorageNvmeAddDevice:input_type -> ctl.NvmeAddDeviceReq
+	4,  // 5: ctl.CtlSvc.StorageSpdkRpc:input_type -> ctl.SpdkRpcReq
+	5,  // 6: ctl.CtlSvc.NetworkScan:input_type -> ctl.NetworkScanReq
+	6,  // 7: ctl.CtlSvc.FirmwareQuery:input_type -> ctl.FirmwareQueryReq
+	7,  // 8: ctl.CtlSvc.FirmwareUpdate:input_type -> ctl.FirmwareUpdateReq
+	8,  // 9: ctl.CtlSvc.SmdQuery:input_type -> ctl.SmdQueryReq
+	8,  // 10: ctl.CtlSvc.SmdQueryStream:input_type -> ctl.SmdQueryReq
+	9,  // 11: ctl.CtlSvc.SmdManage:input_type -> ctl.SmdManageReq
+	10, // 12: ctl.CtlSvc.BlobstoreQuery:input_type -> ctl.BlobstoreQueryReq
+	11, // 13: ctl.CtlSvc.SetEngineLogMasks:input_type -> ctl.SetLogMasksReq
+	12, // 14: ctl.CtlSvc.FaultDomainQuery:input_type -> ctl.FaultDomainQueryReq
+	13, // 15: ctl.CtlSvc.LogStream:input_type -> ctl.LogStreamReq
+	14, // 16: ctl.CtlSvc.PrepShutdownRanks:input_type -> ctl.RanksReq
+	14, // 17: ctl.CtlSvc.StopRanks:input_type -> ctl.RanksReq
+	14, // 18: ctl.CtlSvc.PingRanks:input_type -> ctl.RanksReq
+	14, // 19: ctl.CtlSvc.ResetFormatRanks:input_type -> ctl.RanksReq
+	14, // 20: ctl.CtlSvc.StartRanks:input_type -> ctl.RanksReq
+	15, // 21: ctl.CtlSvc.FaultInject:input_type -> ctl.FaultInjectReq
+	16, // 22: ctl.CtlSvc.SupportExec:input_type -> ctl.SupportExecReq
+	17, // 23: ctl.CtlSvc.PoolDebug:input_type -> ctl.PoolDebugReq
+	18, // 24: ctl.CtlSvc.SetTelemetryClasses:input_type -> ctl.SetTelemetryClassesReq
+	19, // 25: ctl.CtlSvc.DumpEngineStacks:input_type -> ctl.DumpEngineStacksReq
+	20, // 26: ctl.CtlSvc.StorageScan:output_type -> ctl.StorageScanResp
+	20, // 27: ctl.CtlSvc.StorageScanStream:output_type -> ctl.StorageScanResp
+	21, // 28: ctl.CtlSvc.StorageFormat:output_type -> ctl.StorageFormatResp
+	22, // 29: ctl.CtlSvc.StorageNvmeRebind:output_type -> ctl.NvmeRebindResp
+	23, // 30: ctl.CtlSvc.StorageNvmeAddDevice:output_type -> ctl.NvmeAddDeviceResp
+	24, // 31: ctl.CtlSvc.StorageSpdkRpc:output_type -> ctl.SpdkRpcResp
+	25, // 32: ctl.CtlSvc.NetworkScan:output_type -> ctl.NetworkScanResp
+	26, // 33: ctl.CtlSvc.FirmwareQuery:output_type -> ctl.FirmwareQueryResp
+	27, // 34: ctl.CtlSvc.FirmwareUpdate:output_type -> ctl.FirmwareUpdateResp
+	28, // 35: ctl.CtlSvc.SmdQuery:output_type -> ctl.SmdQueryResp
+	28, // 36: ctl.CtlSvc.SmdQueryStream:output_type -> ctl.SmdQueryResp
+	29, // 37: ctl.CtlSvc.SmdManage:output_type -> ctl.SmdManageResp
+	30, // 38: ctl.CtlSvc.BlobstoreQuery:output_type -> ctl.BlobstoreQueryResp
+	31, // 39: ctl.CtlSvc.SetEngineLogMasks:output_type -> ctl.SetLogMasksResp
+	32, // 40: ctl.CtlSvc.FaultDomainQuery:output_type -> ctl.FaultDomainQueryResp
+	33, // 41: ctl.CtlSvc.LogStream:output_type -> ctl.LogStreamResp
+	34, // 42: ctl.CtlSvc.PrepShutdownRanks:output_type -> ctl.RanksResp
+	34, // 43: ctl.CtlSvc.StopRanks:output_type -> ctl.RanksResp
+	34, // 44: ctl.CtlSvc.PingRanks:output_type -> ctl.RanksResp
+	34, // 45: ctl.CtlSvc.ResetFormatRanks:output_type -> ctl.RanksResp
+	34, // 46: ctl.CtlSvc.StartRanks:output_type -> ctl.RanksResp
+	35, // 47: ctl.CtlSvc.FaultInject:output_type -> ctl.FaultInjectResp
+	36, // 48: ctl.CtlSvc.SupportExec:output_type -> ctl.SupportExecResp
+	37, // 49: ctl.CtlSvc.PoolDebug:output_type -> ctl.PoolDebugResp
+	38, // 50: ctl.CtlSvc.SetTelemetryClasses:output_type -> ctl.SetTelemetryClassesResp
+	39, // 51: ctl.CtlSvc.DumpEngineStacks:output_type -> ctl.DumpEngineStacksResp
+	26, // [26:52] is the sub-list for method output_type
+	0,  // [0:26] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -171,6 +258,8 @@ func file_ctl_ctl_proto_init() {
 	file_ctl_smd_proto_init()
 	file_ctl_ranks_proto_init()
 	file_ctl_server_proto_init()
+	file_ctl_fault_proto_init()
+	file_ctl_support_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
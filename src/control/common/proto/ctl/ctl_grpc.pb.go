@@ -24,12 +24,16 @@ const _ = grpc.SupportPackageIsVersion7
 type CtlSvcClient interface {
 	// Retrieve details of nonvolatile storage on server, including health info
 	StorageScan(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (*StorageScanResp, error)
+	// Retrieve storage details as a series of size-limited chunks
+	StorageScanStream(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (CtlSvc_StorageScanStreamClient, error)
 	// Format nonvolatile storage devices for use with DAOS
 	StorageFormat(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (*StorageFormatResp, error)
 	// Rebind SSD from kernel and bind instead to user-space for use with DAOS
 	StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq, opts ...grpc.CallOption) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
 	StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDeviceReq, opts ...grpc.CallOption) (*NvmeAddDeviceResp, error)
+	// Forward a JSON-RPC request to a DAOS I/O Engine's SPDK RPC server (debug only)
+	StorageSpdkRpc(ctx context.Context, in *SpdkRpcReq, opts ...grpc.CallOption) (*SpdkRpcResp, error)
 	// Perform a fabric scan to determine the available provider, device, NUMA node combinations
 	NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error)
 	// Retrieve firmware details from storage devices on server
@@ -38,10 +42,18 @@ type CtlSvcClient interface {
 	FirmwareUpdate(ctx context.Context, in *FirmwareUpdateReq, opts ...grpc.CallOption) (*FirmwareUpdateResp, error)
 	// Query the per-server metadata
 	SmdQuery(ctx context.Context, in *SmdQueryReq, opts ...grpc.CallOption) (*SmdQueryResp, error)
+	// Query the per-server metadata as a series of size-limited chunks
+	SmdQueryStream(ctx context.Context, in *SmdQueryReq, opts ...grpc.CallOption) (CtlSvc_SmdQueryStreamClient, error)
 	// Manage devices (per-server) identified in SMD table
 	SmdManage(ctx context.Context, in *SmdManageReq, opts ...grpc.CallOption) (*SmdManageResp, error)
+	// Query per-target SPDK blobstore usage
+	BlobstoreQuery(ctx context.Context, in *BlobstoreQueryReq, opts ...grpc.CallOption) (*BlobstoreQueryResp, error)
 	// Set log level for DAOS I/O Engines on a host.
 	SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq, opts ...grpc.CallOption) (*SetLogMasksResp, error)
+	// Query the fault domain of a host.
+	FaultDomainQuery(ctx context.Context, in *FaultDomainQueryReq, opts ...grpc.CallOption) (*FaultDomainQueryResp, error)
+	// Stream lines from an engine or control plane log file on a host.
+	LogStream(ctx context.Context, in *LogStreamReq, opts ...grpc.CallOption) (CtlSvc_LogStreamClient, error)
 	// Prepare DAOS I/O Engines on a host for controlled shutdown. (gRPC fanout)
 	PrepShutdownRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
 	// Stop DAOS I/O Engines on a host. (gRPC fanout)
@@ -52,6 +64,16 @@ type CtlSvcClient interface {
 	ResetFormatRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
 	// Start DAOS I/O Engines on a host. (gRPC fanout)
 	StartRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
+	// Set or clear a fault on a host (fault injection builds only).
+	FaultInject(ctx context.Context, in *FaultInjectReq, opts ...grpc.CallOption) (*FaultInjectResp, error)
+	// Run a permitted command on a host to gather support information.
+	SupportExec(ctx context.Context, in *SupportExecReq, opts ...grpc.CallOption) (*SupportExecResp, error)
+	// Run the offline VOS debugger against the pool shards of a stopped engine.
+	PoolDebug(ctx context.Context, in *PoolDebugReq, opts ...grpc.CallOption) (CtlSvc_PoolDebugClient, error)
+	// Set the classes of engine metrics exported for telemetry on a host.
+	SetTelemetryClasses(ctx context.Context, in *SetTelemetryClassesReq, opts ...grpc.CallOption) (*SetTelemetryClassesResp, error)
+	// Dump the ULT stacks of an engine on a host.
+	DumpEngineStacks(ctx context.Context, in *DumpEngineStacksReq, opts ...grpc.CallOption) (*DumpEngineStacksResp, error)
 }
 
 type ctlSvcClient struct {
@@ -71,6 +93,38 @@ func (c *ctlSvcClient) StorageScan(ctx context.Context, in *StorageScanReq, opts
 	return out, nil
 }
 
+func (c *ctlSvcClient) StorageScanStream(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (CtlSvc_StorageScanStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CtlSvc_ServiceDesc.Streams[0], "/ctl.CtlSvc/StorageScanStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ctlSvcStorageScanStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CtlSvc_StorageScanStreamClient interface {
+	Recv() (*StorageScanResp, error)
+	grpc.ClientStream
+}
+
+type ctlSvcStorageScanStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *ctlSvcStorageScanStreamClient) Recv() (*StorageScanResp, error) {
+	m := new(StorageScanResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *ctlSvcClient) StorageFormat(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (*StorageFormatResp, error) {
 	out := new(StorageFormatResp)
 	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageFormat", in, out, opts...)
@@ -98,6 +152,15 @@ func (c *ctlSvcClient) StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDevi
 	return out, nil
 }
 
+func (c *ctlSvcClient) StorageSpdkRpc(ctx context.Context, in *SpdkRpcReq, opts ...grpc.CallOption) (*SpdkRpcResp, error) {
+	out := new(SpdkRpcResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageSpdkRpc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ctlSvcClient) NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error) {
 	out := new(NetworkScanResp)
 	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/NetworkScan", in, out, opts...)
@@ -134,6 +197,38 @@ func (c *ctlSvcClient) SmdQuery(ctx context.Context, in *SmdQueryReq, opts ...gr
 	return out, nil
 }
 
+func (c *ctlSvcClient) SmdQueryStream(ctx context.Context, in *SmdQueryReq, opts ...grpc.CallOption) (CtlSvc_SmdQueryStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CtlSvc_ServiceDesc.Streams[1], "/ctl.CtlSvc/SmdQueryStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ctlSvcSmdQueryStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CtlSvc_SmdQueryStreamClient interface {
+	Recv() (*SmdQueryResp, error)
+	grpc.ClientStream
+}
+
+type ctlSvcSmdQueryStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *ctlSvcSmdQueryStreamClient) Recv() (*SmdQueryResp, error) {
+	m := new(SmdQueryResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *ctlSvcClient) SmdManage(ctx context.Context, in *SmdManageReq, opts ...grpc.CallOption) (*SmdManageResp, error) {
 	out := new(SmdManageResp)
 	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SmdManage", in, out, opts...)
@@ -143,6 +238,15 @@ func (c *ctlSvcClient) SmdManage(ctx context.Context, in *SmdManageReq, opts ...
 	return out, nil
 }
 
+func (c *ctlSvcClient) BlobstoreQuery(ctx context.Context, in *BlobstoreQueryReq, opts ...grpc.CallOption) (*BlobstoreQueryResp, error) {
+	out := new(BlobstoreQueryResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/BlobstoreQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ctlSvcClient) SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq, opts ...grpc.CallOption) (*SetLogMasksResp, error) {
 	out := new(SetLogMasksResp)
 	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SetEngineLogMasks", in, out, opts...)
@@ -152,6 +256,47 @@ func (c *ctlSvcClient) SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq
 	return out, nil
 }
 
+func (c *ctlSvcClient) FaultDomainQuery(ctx context.Context, in *FaultDomainQueryReq, opts ...grpc.CallOption) (*FaultDomainQueryResp, error) {
+	out := new(FaultDomainQueryResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/FaultDomainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) LogStream(ctx context.Context, in *LogStreamReq, opts ...grpc.CallOption) (CtlSvc_LogStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CtlSvc_ServiceDesc.Streams[2], "/ctl.CtlSvc/LogStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ctlSvcLogStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CtlSvc_LogStreamClient interface {
+	Recv() (*LogStreamResp, error)
+	grpc.ClientStream
+}
+
+type ctlSvcLogStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *ctlSvcLogStreamClient) Recv() (*LogStreamResp, error) {
+	m := new(LogStreamResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *ctlSvcClient) PrepShutdownRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	out := new(RanksResp)
 	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/PrepShutdownRanks", in, out, opts...)
@@ -197,18 +342,90 @@ func (c *ctlSvcClient) StartRanks(ctx context.Context, in *RanksReq, opts ...grp
 	return out, nil
 }
 
+func (c *ctlSvcClient) FaultInject(ctx context.Context, in *FaultInjectReq, opts ...grpc.CallOption) (*FaultInjectResp, error) {
+	out := new(FaultInjectResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/FaultInject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) SupportExec(ctx context.Context, in *SupportExecReq, opts ...grpc.CallOption) (*SupportExecResp, error) {
+	out := new(SupportExecResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SupportExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) PoolDebug(ctx context.Context, in *PoolDebugReq, opts ...grpc.CallOption) (CtlSvc_PoolDebugClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CtlSvc_ServiceDesc.Streams[3], "/ctl.CtlSvc/PoolDebug", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ctlSvcPoolDebugClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CtlSvc_PoolDebugClient interface {
+	Recv() (*PoolDebugResp, error)
+	grpc.ClientStream
+}
+
+type ctlSvcPoolDebugClient struct {
+	grpc.ClientStream
+}
+
+func (x *ctlSvcPoolDebugClient) Recv() (*PoolDebugResp, error) {
+	m := new(PoolDebugResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ctlSvcClient) SetTelemetryClasses(ctx context.Context, in *SetTelemetryClassesReq, opts ...grpc.CallOption) (*SetTelemetryClassesResp, error) {
+	out := new(SetTelemetryClassesResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SetTelemetryClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) DumpEngineStacks(ctx context.Context, in *DumpEngineStacksReq, opts ...grpc.CallOption) (*DumpEngineStacksResp, error) {
+	out := new(DumpEngineStacksResp)
+	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/DumpEngineStacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CtlSvcServer is the server API for CtlSvc service.
 // All implementations must embed UnimplementedCtlSvcServer
 // for forward compatibility
 type CtlSvcServer interface {
 	// Retrieve details of nonvolatile storage on server, including health info
 	StorageScan(context.Context, *StorageScanReq) (*StorageScanResp, error)
+	// Retrieve storage details as a series of size-limited chunks
+	StorageScanStream(*StorageScanReq, CtlSvc_StorageScanStreamServer) error
 	// Format nonvolatile storage devices for use with DAOS
 	StorageFormat(context.Context, *StorageFormatReq) (*StorageFormatResp, error)
 	// Rebind SSD from kernel and bind instead to user-space for use with DAOS
 	StorageNvmeRebind(context.Context, *NvmeRebindReq) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
 	StorageNvmeAddDevice(context.Context, *NvmeAddDeviceReq) (*NvmeAddDeviceResp, error)
+	// Forward a JSON-RPC request to a DAOS I/O Engine's SPDK RPC server (debug only)
+	StorageSpdkRpc(context.Context, *SpdkRpcReq) (*SpdkRpcResp, error)
 	// Perform a fabric scan to determine the available provider, device, NUMA node combinations
 	NetworkScan(context.Context, *NetworkScanReq) (*NetworkScanResp, error)
 	// Retrieve firmware details from storage devices on server
@@ -217,10 +434,18 @@ type CtlSvcServer interface {
 	FirmwareUpdate(context.Context, *FirmwareUpdateReq) (*FirmwareUpdateResp, error)
 	// Query the per-server metadata
 	SmdQuery(context.Context, *SmdQueryReq) (*SmdQueryResp, error)
+	// Query the per-server metadata as a series of size-limited chunks
+	SmdQueryStream(*SmdQueryReq, CtlSvc_SmdQueryStreamServer) error
 	// Manage devices (per-server) identified in SMD table
 	SmdManage(context.Context, *SmdManageReq) (*SmdManageResp, error)
+	// Query per-target SPDK blobstore usage
+	BlobstoreQuery(context.Context, *BlobstoreQueryReq) (*BlobstoreQueryResp, error)
 	// Set log level for DAOS I/O Engines on a host.
 	SetEngineLogMasks(context.Context, *SetLogMasksReq) (*SetLogMasksResp, error)
+	// Query the fault domain of a host.
+	FaultDomainQuery(context.Context, *FaultDomainQueryReq) (*FaultDomainQueryResp, error)
+	// Stream lines from an engine or control plane log file on a host.
+	LogStream(*LogStreamReq, CtlSvc_LogStreamServer) error
 	// Prepare DAOS I/O Engines on a host for controlled shutdown. (gRPC fanout)
 	PrepShutdownRanks(context.Context, *RanksReq) (*RanksResp, error)
 	// Stop DAOS I/O Engines on a host. (gRPC fanout)
@@ -231,6 +456,16 @@ type CtlSvcServer interface {
 	ResetFormatRanks(context.Context, *RanksReq) (*RanksResp, error)
 	// Start DAOS I/O Engines on a host. (gRPC fanout)
 	StartRanks(context.Context, *RanksReq) (*RanksResp, error)
+	// Set or clear a fault on a host (fault injection builds only).
+	FaultInject(context.Context, *FaultInjectReq) (*FaultInjectResp, error)
+	// Run a permitted command on a host to gather support information.
+	SupportExec(context.Context, *SupportExecReq) (*SupportExecResp, error)
+	// Run the offline VOS debugger against the pool shards of a stopped engine.
+	PoolDebug(*PoolDebugReq, CtlSvc_PoolDebugServer) error
+	// Set the classes of engine metrics exported for telemetry on a host.
+	SetTelemetryClasses(context.Context, *SetTelemetryClassesReq) (*SetTelemetryClassesResp, error)
+	// Dump the ULT stacks of an engine on a host.
+	DumpEngineStacks(context.Context, *DumpEngineStacksReq) (*DumpEngineStacksResp, error)
 	mustEmbedUnimplementedCtlSvcServer()
 }
 
@@ -241,6 +476,9 @@ type UnimplementedCtlSvcServer struct {
 func (UnimplementedCtlSvcServer) StorageScan(context.Context, *StorageScanReq) (*StorageScanResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageScan not implemented")
 }
+func (UnimplementedCtlSvcServer) StorageScanStream(*StorageScanReq, CtlSvc_StorageScanStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method StorageScanStream not implemented")
+}
 func (UnimplementedCtlSvcServer) StorageFormat(context.Context, *StorageFormatReq) (*StorageFormatResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageFormat not implemented")
 }
@@ -250,6 +488,9 @@ func (UnimplementedCtlSvcServer) StorageNvmeRebind(context.Context, *NvmeRebindR
 func (UnimplementedCtlSvcServer) StorageNvmeAddDevice(context.Context, *NvmeAddDeviceReq) (*NvmeAddDeviceResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeAddDevice not implemented")
 }
+func (UnimplementedCtlSvcServer) StorageSpdkRpc(context.Context, *SpdkRpcReq) (*SpdkRpcResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageSpdkRpc not implemented")
+}
 func (UnimplementedCtlSvcServer) NetworkScan(context.Context, *NetworkScanReq) (*NetworkScanResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NetworkScan not implemented")
 }
@@ -262,12 +503,24 @@ func (UnimplementedCtlSvcServer) FirmwareUpdate(context.Context, *FirmwareUpdate
 func (UnimplementedCtlSvcServer) SmdQuery(context.Context, *SmdQueryReq) (*SmdQueryResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SmdQuery not implemented")
 }
+func (UnimplementedCtlSvcServer) SmdQueryStream(*SmdQueryReq, CtlSvc_SmdQueryStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SmdQueryStream not implemented")
+}
 func (UnimplementedCtlSvcServer) SmdManage(context.Context, *SmdManageReq) (*SmdManageResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SmdManage not implemented")
 }
+func (UnimplementedCtlSvcServer) BlobstoreQuery(context.Context, *BlobstoreQueryReq) (*BlobstoreQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobstoreQuery not implemented")
+}
 func (UnimplementedCtlSvcServer) SetEngineLogMasks(context.Context, *SetLogMasksReq) (*SetLogMasksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetEngineLogMasks not implemented")
 }
+func (UnimplementedCtlSvcServer) FaultDomainQuery(context.Context, *FaultDomainQueryReq) (*FaultDomainQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FaultDomainQuery not implemented")
+}
+func (UnimplementedCtlSvcServer) LogStream(*LogStreamReq, CtlSvc_LogStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method LogStream not implemented")
+}
 func (UnimplementedCtlSvcServer) PrepShutdownRanks(context.Context, *RanksReq) (*RanksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrepShutdownRanks not implemented")
 }
@@ -283,6 +536,21 @@ func (UnimplementedCtlSvcServer) ResetFormatRanks(context.Context, *RanksReq) (*
 func (UnimplementedCtlSvcServer) StartRanks(context.Context, *RanksReq) (*RanksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartRanks not implemented")
 }
+func (UnimplementedCtlSvcServer) FaultInject(context.Context, *FaultInjectReq) (*FaultInjectResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FaultInject not implemented")
+}
+func (UnimplementedCtlSvcServer) SupportExec(context.Context, *SupportExecReq) (*SupportExecResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupportExec not implemented")
+}
+func (UnimplementedCtlSvcServer) PoolDebug(*PoolDebugReq, CtlSvc_PoolDebugServer) error {
+	return status.Errorf(codes.Unimplemented, "method PoolDebug not implemented")
+}
+func (UnimplementedCtlSvcServer) SetTelemetryClasses(context.Context, *SetTelemetryClassesReq) (*SetTelemetryClassesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTelemetryClasses not implemented")
+}
+func (UnimplementedCtlSvcServer) DumpEngineStacks(context.Context, *DumpEngineStacksReq) (*DumpEngineStacksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpEngineStacks not implemented")
+}
 func (UnimplementedCtlSvcServer) mustEmbedUnimplementedCtlSvcServer() {}
 
 // UnsafeCtlSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -314,6 +582,27 @@ func _CtlSvc_StorageScan_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_StorageScanStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StorageScanReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CtlSvcServer).StorageScanStream(m, &ctlSvcStorageScanStreamServer{stream})
+}
+
+type CtlSvc_StorageScanStreamServer interface {
+	Send(*StorageScanResp) error
+	grpc.ServerStream
+}
+
+type ctlSvcStorageScanStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *ctlSvcStorageScanStreamServer) Send(m *StorageScanResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CtlSvc_StorageFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StorageFormatReq)
 	if err := dec(in); err != nil {
@@ -368,6 +657,24 @@ func _CtlSvc_StorageNvmeAddDevice_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_StorageSpdkRpc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpdkRpcReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).StorageSpdkRpc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/StorageSpdkRpc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).StorageSpdkRpc(ctx, req.(*SpdkRpcReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CtlSvc_NetworkScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NetworkScanReq)
 	if err := dec(in); err != nil {
@@ -440,6 +747,27 @@ func _CtlSvc_SmdQuery_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_SmdQueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SmdQueryReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CtlSvcServer).SmdQueryStream(m, &ctlSvcSmdQueryStreamServer{stream})
+}
+
+type CtlSvc_SmdQueryStreamServer interface {
+	Send(*SmdQueryResp) error
+	grpc.ServerStream
+}
+
+type ctlSvcSmdQueryStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *ctlSvcSmdQueryStreamServer) Send(m *SmdQueryResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CtlSvc_SmdManage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SmdManageReq)
 	if err := dec(in); err != nil {
@@ -458,6 +786,24 @@ func _CtlSvc_SmdManage_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_BlobstoreQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobstoreQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).BlobstoreQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/BlobstoreQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).BlobstoreQuery(ctx, req.(*BlobstoreQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CtlSvc_SetEngineLogMasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetLogMasksReq)
 	if err := dec(in); err != nil {
@@ -476,6 +822,45 @@ func _CtlSvc_SetEngineLogMasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_FaultDomainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FaultDomainQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).FaultDomainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/FaultDomainQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).FaultDomainQuery(ctx, req.(*FaultDomainQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_LogStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogStreamReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CtlSvcServer).LogStream(m, &ctlSvcLogStreamServer{stream})
+}
+
+type CtlSvc_LogStreamServer interface {
+	Send(*LogStreamResp) error
+	grpc.ServerStream
+}
+
+type ctlSvcLogStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *ctlSvcLogStreamServer) Send(m *LogStreamResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CtlSvc_PrepShutdownRanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RanksReq)
 	if err := dec(in); err != nil {
@@ -566,6 +951,99 @@ func _CtlSvc_StartRanks_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_FaultInject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FaultInjectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).FaultInject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/FaultInject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).FaultInject(ctx, req.(*FaultInjectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_SupportExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupportExecReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).SupportExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/SupportExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).SupportExec(ctx, req.(*SupportExecReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_PoolDebug_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PoolDebugReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CtlSvcServer).PoolDebug(m, &ctlSvcPoolDebugServer{stream})
+}
+
+type CtlSvc_PoolDebugServer interface {
+	Send(*PoolDebugResp) error
+	grpc.ServerStream
+}
+
+type ctlSvcPoolDebugServer struct {
+	grpc.ServerStream
+}
+
+func (x *ctlSvcPoolDebugServer) Send(m *PoolDebugResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CtlSvc_SetTelemetryClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTelemetryClassesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).SetTelemetryClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/SetTelemetryClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).SetTelemetryClasses(ctx, req.(*SetTelemetryClassesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_DumpEngineStacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpEngineStacksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).DumpEngineStacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ctl.CtlSvc/DumpEngineStacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).DumpEngineStacks(ctx, req.(*DumpEngineStacksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CtlSvc_ServiceDesc is the grpc.ServiceDesc for CtlSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -589,6 +1067,10 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StorageNvmeAddDevice",
 			Handler:    _CtlSvc_StorageNvmeAddDevice_Handler,
 		},
+		{
+			MethodName: "StorageSpdkRpc",
+			Handler:    _CtlSvc_StorageSpdkRpc_Handler,
+		},
 		{
 			MethodName: "NetworkScan",
 			Handler:    _CtlSvc_NetworkScan_Handler,
@@ -609,10 +1091,18 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SmdManage",
 			Handler:    _CtlSvc_SmdManage_Handler,
 		},
+		{
+			MethodName: "BlobstoreQuery",
+			Handler:    _CtlSvc_BlobstoreQuery_Handler,
+		},
 		{
 			MethodName: "SetEngineLogMasks",
 			Handler:    _CtlSvc_SetEngineLogMasks_Handler,
 		},
+		{
+			MethodName: "FaultDomainQuery",
+			Handler:    _CtlSvc_FaultDomainQuery_Handler,
+		},
 		{
 			MethodName: "PrepShutdownRanks",
 			Handler:    _CtlSvc_PrepShutdownRanks_Handler,
@@ -633,7 +1123,44 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartRanks",
 			Handler:    _CtlSvc_StartRanks_Handler,
 		},
+		{
+			MethodName: "FaultInject",
+			Handler:    _CtlSvc_FaultInject_Handler,
+		},
+		{
+			MethodName: "SupportExec",
+			Handler:    _CtlSvc_SupportExec_Handler,
+		},
+		{
+			MethodName: "SetTelemetryClasses",
+			Handler:    _CtlSvc_SetTelemetryClasses_Handler,
+		},
+		{
+			MethodName: "DumpEngineStacks",
+			Handler:    _CtlSvc_DumpEngineStacks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StorageScanStream",
+			Handler:       _CtlSvc_StorageScanStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SmdQueryStream",
+			Handler:       _CtlSvc_SmdQueryStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LogStream",
+			Handler:       _CtlSvc_LogStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PoolDebug",
+			Handler:       _CtlSvc_PoolDebug_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ctl/ctl.proto",
 }
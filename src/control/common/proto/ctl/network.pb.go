@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2021 Intel Corporation.
+// (C) Copyright 2019-2022 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -149,11 +149,12 @@ type FabricInterface struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Device      string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
-	Numanode    uint32 `protobuf:"varint,3,opt,name=numanode,proto3" json:"numanode,omitempty"`
-	Priority    uint32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
-	Netdevclass uint32 `protobuf:"varint,5,opt,name=netdevclass,proto3" json:"netdevclass,omitempty"`
+	Provider    string  `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Device      string  `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Numanode    uint32  `protobuf:"varint,3,opt,name=numanode,proto3" json:"numanode,omitempty"`
+	Priority    uint32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Netdevclass uint32  `protobuf:"varint,5,opt,name=netdevclass,proto3" json:"netdevclass,omitempty"`
+	Linkspeed   float64 `protobuf:"fixed64,6,opt,name=linkspeed,proto3" json:"linkspeed,omitempty"` // link speed of the network port in Gbit/s
 }
 
 func (x *FabricInterface) Reset() {
@@ -223,6 +224,13 @@ func (x *FabricInterface) GetNetdevclass() uint32 {
 	return 0
 }
 
+func (x *FabricInterface) GetLinkspeed() float64 {
+	if x != nil {
+		return x.Linkspeed
+	}
+	return 0
+}
+
 var File_ctl_network_proto protoreflect.FileDescriptor
 
 var file_ctl_network_proto_rawDesc = []byte{
@@ -242,7 +250,7 @@ var file_ctl_network_proto_rawDesc = []byte{
 	0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c,
 	0x63, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x65, 0x72, 0x6e, 0x75, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x65, 0x72, 0x6e, 0x75, 0x6d, 0x61,
-	0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x46, 0x61, 0x62, 0x72, 0x69, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x22, 0xbd, 0x01, 0x0a, 0x0f, 0x46, 0x61, 0x62, 0x72, 0x69, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72,
 	0x66, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
 	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -252,11 +260,13 @@ var file_ctl_network_proto_rawDesc = []byte{
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
 	0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
 	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x63, 0x6c, 0x61,
-	0x73, 0x73, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73,
-	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
+	0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73,
+	0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6,8 +6,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
-// 	protoc        v3.12.4
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.5.0
 // source: ctl/server.proto
 
 package ctl
@@ -130,6 +130,537 @@ func (x *SetLogMasksResp) GetStatus() int32 {
 	return 0
 }
 
+// LogStreamReq requests a stream of lines from a log file on a DAOS server.
+type LogStreamReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys            string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                                  // DAOS system name
+	Rank           uint32   `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`                                               // Rank of the engine whose log should be streamed
+	Control        bool     `protobuf:"varint,3,opt,name=control,proto3" json:"control,omitempty"`                                         // Stream the control plane log instead of an engine log
+	Level          string   `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`                                              // Minimum severity of lines to return
+	Facilities     []string `protobuf:"bytes,5,rep,name=facilities,proto3" json:"facilities,omitempty"`                                    // Only return engine lines from these facilities
+	Offset         int64    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`                                           // Resume streaming from this offset in the log file
+	Tail           uint32   `protobuf:"varint,7,opt,name=tail,proto3" json:"tail,omitempty"`                                               // Start this many lines before the end of the log file
+	Follow         bool     `protobuf:"varint,8,opt,name=follow,proto3" json:"follow,omitempty"`                                           // Keep streaming new lines as they are written
+	MaxLinesPerSec uint32   `protobuf:"varint,9,opt,name=max_lines_per_sec,json=maxLinesPerSec,proto3" json:"max_lines_per_sec,omitempty"` // Maximum rate at which lines are sent
+}
+
+func (x *LogStreamReq) Reset() {
+	*x = LogStreamReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStreamReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStreamReq) ProtoMessage() {}
+
+func (x *LogStreamReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStreamReq.ProtoReflect.Descriptor instead.
+func (*LogStreamReq) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogStreamReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *LogStreamReq) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LogStreamReq) GetControl() bool {
+	if x != nil {
+		return x.Control
+	}
+	return false
+}
+
+func (x *LogStreamReq) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogStreamReq) GetFacilities() []string {
+	if x != nil {
+		return x.Facilities
+	}
+	return nil
+}
+
+func (x *LogStreamReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LogStreamReq) GetTail() uint32 {
+	if x != nil {
+		return x.Tail
+	}
+	return 0
+}
+
+func (x *LogStreamReq) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *LogStreamReq) GetMaxLinesPerSec() uint32 {
+	if x != nil {
+		return x.MaxLinesPerSec
+	}
+	return 0
+}
+
+// LogStreamResp returns a batch of lines from a log file.
+type LogStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines  []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`    // Log lines matching the request filters
+	Offset int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // Offset in the log file following the last line read
+	Path   string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`      // Path of the log file on the server
+}
+
+func (x *LogStreamResp) Reset() {
+	*x = LogStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStreamResp) ProtoMessage() {}
+
+func (x *LogStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStreamResp.ProtoReflect.Descriptor instead.
+func (*LogStreamResp) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LogStreamResp) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *LogStreamResp) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LogStreamResp) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// FaultDomainQueryReq requests the fault domain of a DAOS server.
+type FaultDomainQueryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system name
+}
+
+func (x *FaultDomainQueryReq) Reset() {
+	*x = FaultDomainQueryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultDomainQueryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultDomainQueryReq) ProtoMessage() {}
+
+func (x *FaultDomainQueryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultDomainQueryReq.ProtoReflect.Descriptor instead.
+func (*FaultDomainQueryReq) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FaultDomainQueryReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// FaultDomainQueryResp returns the fault domain of a DAOS server.
+type FaultDomainQueryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FaultDomain string `protobuf:"bytes,1,opt,name=fault_domain,json=faultDomain,proto3" json:"fault_domain,omitempty"` // Fault domain of the server
+}
+
+func (x *FaultDomainQueryResp) Reset() {
+	*x = FaultDomainQueryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultDomainQueryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultDomainQueryResp) ProtoMessage() {}
+
+func (x *FaultDomainQueryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultDomainQueryResp.ProtoReflect.Descriptor instead.
+func (*FaultDomainQueryResp) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FaultDomainQueryResp) GetFaultDomain() string {
+	if x != nil {
+		return x.FaultDomain
+	}
+	return ""
+}
+
+// SetTelemetryClassesReq sets the classes of engine metrics that are not
+// exported for telemetry.
+type SetTelemetryClassesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`           // DAOS system name
+	Disabled []string `protobuf:"bytes,2,rep,name=disabled,proto3" json:"disabled,omitempty"` // Metric classes to disable, all others are enabled
+}
+
+func (x *SetTelemetryClassesReq) Reset() {
+	*x = SetTelemetryClassesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTelemetryClassesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTelemetryClassesReq) ProtoMessage() {}
+
+func (x *SetTelemetryClassesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTelemetryClassesReq.ProtoReflect.Descriptor instead.
+func (*SetTelemetryClassesReq) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetTelemetryClassesReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SetTelemetryClassesReq) GetDisabled() []string {
+	if x != nil {
+		return x.Disabled
+	}
+	return nil
+}
+
+// SetTelemetryClassesResp returns the classes of engine metrics that are
+// disabled after the request has been applied.
+type SetTelemetryClassesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disabled []string `protobuf:"bytes,1,rep,name=disabled,proto3" json:"disabled,omitempty"` // Disabled metric classes
+}
+
+func (x *SetTelemetryClassesResp) Reset() {
+	*x = SetTelemetryClassesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTelemetryClassesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTelemetryClassesResp) ProtoMessage() {}
+
+func (x *SetTelemetryClassesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTelemetryClassesResp.ProtoReflect.Descriptor instead.
+func (*SetTelemetryClassesResp) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetTelemetryClassesResp) GetDisabled() []string {
+	if x != nil {
+		return x.Disabled
+	}
+	return nil
+}
+
+// DumpEngineStacksReq requests that an engine dump its Argobots state and ULT
+// stacks to a file on the server.
+type DumpEngineStacksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys          string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                        // DAOS system name
+	Rank         uint32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`                                     // Rank of the engine whose ULT stacks should be dumped
+	Unattended   bool   `protobuf:"varint,3,opt,name=unattended,proto3" json:"unattended,omitempty"`                         // Dump from the engine main thread without waiting for ULTs to yield
+	ReturnOutput bool   `protobuf:"varint,4,opt,name=return_output,json=returnOutput,proto3" json:"return_output,omitempty"` // Return the output of the dump in the response
+}
+
+func (x *DumpEngineStacksReq) Reset() {
+	*x = DumpEngineStacksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpEngineStacksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpEngineStacksReq) ProtoMessage() {}
+
+func (x *DumpEngineStacksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpEngineStacksReq.ProtoReflect.Descriptor instead.
+func (*DumpEngineStacksReq) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DumpEngineStacksReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *DumpEngineStacksReq) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DumpEngineStacksReq) GetUnattended() bool {
+	if x != nil {
+		return x.Unattended
+	}
+	return false
+}
+
+func (x *DumpEngineStacksReq) GetReturnOutput() bool {
+	if x != nil {
+		return x.ReturnOutput
+	}
+	return false
+}
+
+// DumpEngineStacksResp returns the location of an engine ULT stack dump.
+type DumpEngineStacksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`            // Path of the dump file on the server
+	Offset    int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`       // Offset in the dump file at which this dump begins
+	Size      int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`           // Size of this dump in bytes
+	Output    string `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`        // Output of the dump, if requested
+	Truncated bool   `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"` // Returned output was truncated
+}
+
+func (x *DumpEngineStacksResp) Reset() {
+	*x = DumpEngineStacksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpEngineStacksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpEngineStacksResp) ProtoMessage() {}
+
+func (x *DumpEngineStacksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpEngineStacksResp.ProtoReflect.Descriptor instead.
+func (*DumpEngineStacksResp) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DumpEngineStacksResp) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DumpEngineStacksResp) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DumpEngineStacksResp) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DumpEngineStacksResp) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *DumpEngineStacksResp) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
 var File_ctl_server_proto protoreflect.FileDescriptor
 
 var file_ctl_server_proto_rawDesc = []byte{
@@ -140,11 +671,63 @@ var file_ctl_server_proto_rawDesc = []byte{
 	0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x73, 0x6b,
 	0x73, 0x22, 0x29, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x39, 0x5a, 0x37,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d,
-	0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xf3, 0x01, 0x0a,
+	0x0c, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53,
+	0x65, 0x63, 0x22, 0x51, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x27, 0x0a, 0x13, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x39,
+	0x0a, 0x14, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x46, 0x0a, 0x16, 0x53, 0x65, 0x74,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x22, 0x35, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x44, 0x75, 0x6d,
+	0x70, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x61, 0x74, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x61, 0x74,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x14,
+	0x44, 0x75, 0x6d, 0x70, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -159,10 +742,18 @@ func file_ctl_server_proto_rawDescGZIP() []byte {
 	return file_ctl_server_proto_rawDescData
 }
 
-var file_ctl_server_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ctl_server_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_ctl_server_proto_goTypes = []interface{}{
-	(*SetLogMasksReq)(nil),  // 0: ctl.SetLogMasksReq
-	(*SetLogMasksResp)(nil), // 1: ctl.SetLogMasksResp
+	(*SetLogMasksReq)(nil),          // 0: ctl.SetLogMasksReq
+	(*SetLogMasksResp)(nil),         // 1: ctl.SetLogMasksResp
+	(*LogStreamReq)(nil),            // 2: ctl.LogStreamReq
+	(*LogStreamResp)(nil),           // 3: ctl.LogStreamResp
+	(*FaultDomainQueryReq)(nil),     // 4: ctl.FaultDomainQueryReq
+	(*FaultDomainQueryResp)(nil),    // 5: ctl.FaultDomainQueryResp
+	(*SetTelemetryClassesReq)(nil),  // 6: ctl.SetTelemetryClassesReq
+	(*SetTelemetryClassesResp)(nil), // 7: ctl.SetTelemetryClassesResp
+	(*DumpEngineStacksReq)(nil),     // 8: ctl.DumpEngineStacksReq
+	(*DumpEngineStacksResp)(nil),    // 9: ctl.DumpEngineStacksResp
 }
 var file_ctl_server_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -202,6 +793,102 @@ func file_ctl_server_proto_init() {
 				return nil
 			}
 		}
+		file_ctl_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStreamReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStreamResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultDomainQueryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultDomainQueryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTelemetryClassesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTelemetryClassesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpEngineStacksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpEngineStacksResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -209,7 +896,7 @@ func file_ctl_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
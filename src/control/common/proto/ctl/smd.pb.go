@@ -292,6 +292,10 @@ type BioHealthResp struct {
 	HostBytesWritten        uint64 `protobuf:"varint,45,opt,name=host_bytes_written,json=hostBytesWritten,proto3" json:"host_bytes_written,omitempty"`
 	// Engine configs properties
 	ClusterSize uint64 `protobuf:"varint,46,opt,name=cluster_size,json=clusterSize,proto3" json:"cluster_size,omitempty"` // blobstore cluster size in bytes
+	// Standard SMART wear and spare attributes
+	AvailSpare          uint32 `protobuf:"varint,47,opt,name=avail_spare,json=availSpare,proto3" json:"avail_spare,omitempty"`                              // remaining spare capacity, percent
+	AvailSpareThreshold uint32 `protobuf:"varint,48,opt,name=avail_spare_threshold,json=availSpareThreshold,proto3" json:"avail_spare_threshold,omitempty"` // spare capacity warning threshold, percent
+	PercentageUsed      uint32 `protobuf:"varint,49,opt,name=percentage_used,json=percentageUsed,proto3" json:"percentage_used,omitempty"`                  // estimated device life used, percent
 }
 
 func (x *BioHealthResp) Reset() {
@@ -627,6 +631,27 @@ func (x *BioHealthResp) GetClusterSize() uint64 {
 	return 0
 }
 
+func (x *BioHealthResp) GetAvailSpare() uint32 {
+	if x != nil {
+		return x.AvailSpare
+	}
+	return 0
+}
+
+func (x *BioHealthResp) GetAvailSpareThreshold() uint32 {
+	if x != nil {
+		return x.AvailSpareThreshold
+	}
+	return 0
+}
+
+func (x *BioHealthResp) GetPercentageUsed() uint32 {
+	if x != nil {
+		return x.PercentageUsed
+	}
+	return 0
+}
+
 // SmdDevice represents a DAOS BIO device, identified by a UUID written into a label stored on a
 // SPDK blobstore created on a NVMe namespace. Multiple SmdDevices may exist per NVMe controller.
 type SmdDevice struct {
@@ -634,15 +659,17 @@ type SmdDevice struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid        string       `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                // UUID of blobstore
-	TgtIds      []int32      `protobuf:"varint,2,rep,packed,name=tgt_ids,json=tgtIds,proto3" json:"tgt_ids,omitempty"`                      // VOS target IDs
-	TrAddr      string       `protobuf:"bytes,3,opt,name=tr_addr,json=trAddr,proto3" json:"tr_addr,omitempty"`                              // Transport address of blobstore
-	DevState    NvmeDevState `protobuf:"varint,4,opt,name=dev_state,json=devState,proto3,enum=ctl.NvmeDevState" json:"dev_state,omitempty"` // NVMe device state
-	LedState    LedState     `protobuf:"varint,5,opt,name=led_state,json=ledState,proto3,enum=ctl.LedState" json:"led_state,omitempty"`     // LED state
-	TotalBytes  uint64       `protobuf:"varint,6,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`                 // blobstore clusters total bytes
-	AvailBytes  uint64       `protobuf:"varint,7,opt,name=avail_bytes,json=availBytes,proto3" json:"avail_bytes,omitempty"`                 // blobstore clusters available bytes
-	ClusterSize uint64       `protobuf:"varint,8,opt,name=cluster_size,json=clusterSize,proto3" json:"cluster_size,omitempty"`              // blobstore cluster size in bytes
-	Rank        uint32       `protobuf:"varint,9,opt,name=rank,proto3" json:"rank,omitempty"`                                               // DAOS I/O Engine using controller
+	Uuid          string       `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                // UUID of blobstore
+	TgtIds        []int32      `protobuf:"varint,2,rep,packed,name=tgt_ids,json=tgtIds,proto3" json:"tgt_ids,omitempty"`                      // VOS target IDs
+	TrAddr        string       `protobuf:"bytes,3,opt,name=tr_addr,json=trAddr,proto3" json:"tr_addr,omitempty"`                              // Transport address of blobstore
+	DevState      NvmeDevState `protobuf:"varint,4,opt,name=dev_state,json=devState,proto3,enum=ctl.NvmeDevState" json:"dev_state,omitempty"` // NVMe device state
+	LedState      LedState     `protobuf:"varint,5,opt,name=led_state,json=ledState,proto3,enum=ctl.LedState" json:"led_state,omitempty"`     // LED state
+	TotalBytes    uint64       `protobuf:"varint,6,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`                 // blobstore clusters total bytes
+	AvailBytes    uint64       `protobuf:"varint,7,opt,name=avail_bytes,json=availBytes,proto3" json:"avail_bytes,omitempty"`                 // blobstore clusters available bytes
+	ClusterSize   uint64       `protobuf:"varint,8,opt,name=cluster_size,json=clusterSize,proto3" json:"cluster_size,omitempty"`              // blobstore cluster size in bytes
+	Rank          uint32       `protobuf:"varint,9,opt,name=rank,proto3" json:"rank,omitempty"`                                               // DAOS I/O Engine using controller
+	ReservedBytes uint64       `protobuf:"varint,10,opt,name=reserved_bytes,json=reservedBytes,proto3" json:"reserved_bytes,omitempty"`       // available bytes not usable by pools
+	PoolShards    uint32       `protobuf:"varint,11,opt,name=pool_shards,json=poolShards,proto3" json:"pool_shards,omitempty"`                // VOS pool shards on device targets
 }
 
 func (x *SmdDevice) Reset() {
@@ -740,6 +767,20 @@ func (x *SmdDevice) GetRank() uint32 {
 	return 0
 }
 
+func (x *SmdDevice) GetReservedBytes() uint64 {
+	if x != nil {
+		return x.ReservedBytes
+	}
+	return 0
+}
+
+func (x *SmdDevice) GetPoolShards() uint32 {
+	if x != nil {
+		return x.PoolShards
+	}
+	return 0
+}
+
 type SmdDevReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -833,6 +874,265 @@ func (x *SmdDevResp) GetDevices() []*SmdDevice {
 	return nil
 }
 
+type BlobstoreUsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlobstoreUsageReq) Reset() {
+	*x = BlobstoreUsageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_smd_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobstoreUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobstoreUsageReq) ProtoMessage() {}
+
+func (x *BlobstoreUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_smd_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobstoreUsageReq.ProtoReflect.Descriptor instead.
+func (*BlobstoreUsageReq) Descriptor() ([]byte, []int) {
+	return file_ctl_smd_proto_rawDescGZIP(), []int{5}
+}
+
+// BlobstoreUsage describes the cluster allocation of a SPDK blobstore and
+// the share of it used by each VOS target.
+type BlobstoreUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DevUuid       string                   `protobuf:"bytes,1,opt,name=dev_uuid,json=devUuid,proto3" json:"dev_uuid,omitempty"`                    // UUID of blobstore
+	ClusterSize   uint64                   `protobuf:"varint,2,opt,name=cluster_size,json=clusterSize,proto3" json:"cluster_size,omitempty"`       // blobstore cluster size in bytes
+	TotalClusters uint64                   `protobuf:"varint,3,opt,name=total_clusters,json=totalClusters,proto3" json:"total_clusters,omitempty"` // total clusters in blobstore
+	FreeClusters  uint64                   `protobuf:"varint,4,opt,name=free_clusters,json=freeClusters,proto3" json:"free_clusters,omitempty"`    // unallocated clusters in blobstore
+	Targets       []*BlobstoreUsage_Target `protobuf:"bytes,5,rep,name=targets,proto3" json:"targets,omitempty"`                                   // per-target usage
+}
+
+func (x *BlobstoreUsage) Reset() {
+	*x = BlobstoreUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_smd_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobstoreUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobstoreUsage) ProtoMessage() {}
+
+func (x *BlobstoreUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_smd_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobstoreUsage.ProtoReflect.Descriptor instead.
+func (*BlobstoreUsage) Descriptor() ([]byte, []int) {
+	return file_ctl_smd_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BlobstoreUsage) GetDevUuid() string {
+	if x != nil {
+		return x.DevUuid
+	}
+	return ""
+}
+
+func (x *BlobstoreUsage) GetClusterSize() uint64 {
+	if x != nil {
+		return x.ClusterSize
+	}
+	return 0
+}
+
+func (x *BlobstoreUsage) GetTotalClusters() uint64 {
+	if x != nil {
+		return x.TotalClusters
+	}
+	return 0
+}
+
+func (x *BlobstoreUsage) GetFreeClusters() uint64 {
+	if x != nil {
+		return x.FreeClusters
+	}
+	return 0
+}
+
+func (x *BlobstoreUsage) GetTargets() []*BlobstoreUsage_Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type BlobstoreUsageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     int32             `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Blobstores []*BlobstoreUsage `protobuf:"bytes,2,rep,name=blobstores,proto3" json:"blobstores,omitempty"`
+}
+
+func (x *BlobstoreUsageResp) Reset() {
+	*x = BlobstoreUsageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_smd_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobstoreUsageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobstoreUsageResp) ProtoMessage() {}
+
+func (x *BlobstoreUsageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_smd_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobstoreUsageResp.ProtoReflect.Descriptor instead.
+func (*BlobstoreUsageResp) Descriptor() ([]byte, []int) {
+	return file_ctl_smd_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BlobstoreUsageResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *BlobstoreUsageResp) GetBlobstores() []*BlobstoreUsage {
+	if x != nil {
+		return x.Blobstores
+	}
+	return nil
+}
+
+type BlobstoreQueryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlobstoreQueryReq) Reset() {
+	*x = BlobstoreQueryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_smd_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobstoreQueryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobstoreQueryReq) ProtoMessage() {}
+
+func (x *BlobstoreQueryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_smd_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobstoreQueryReq.ProtoReflect.Descriptor instead.
+func (*BlobstoreQueryReq) Descriptor() ([]byte, []int) {
+	return file_ctl_smd_proto_rawDescGZIP(), []int{8}
+}
+
+type BlobstoreQueryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ranks []*BlobstoreQueryResp_RankResp `protobuf:"bytes,1,rep,name=ranks,proto3" json:"ranks,omitempty"` // List of per-rank responses
+}
+
+func (x *BlobstoreQueryResp) Reset() {
+	*x = BlobstoreQueryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_smd_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobstoreQueryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobstoreQueryResp) ProtoMessage() {}
+
+func (x *BlobstoreQueryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_smd_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobstoreQueryResp.ProtoReflect.Descriptor instead.
+func (*BlobstoreQueryResp) Descriptor() ([]byte, []int) {
+	return file_ctl_smd_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BlobstoreQueryResp) GetRanks() []*BlobstoreQueryResp_RankResp {
+	if x != nil {
+		return x.Ranks
+	}
+	return nil
+}
+
 type SmdPoolReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -842,7 +1142,7 @@ type SmdPoolReq struct {
 func (x *SmdPoolReq) Reset() {
 	*x = SmdPoolReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[5]
+		mi := &file_ctl_smd_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -855,7 +1155,7 @@ func (x *SmdPoolReq) String() string {
 func (*SmdPoolReq) ProtoMessage() {}
 
 func (x *SmdPoolReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[5]
+	mi := &file_ctl_smd_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,7 +1168,7 @@ func (x *SmdPoolReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdPoolReq.ProtoReflect.Descriptor instead.
 func (*SmdPoolReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{5}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{10}
 }
 
 type SmdPoolResp struct {
@@ -883,7 +1183,7 @@ type SmdPoolResp struct {
 func (x *SmdPoolResp) Reset() {
 	*x = SmdPoolResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[6]
+		mi := &file_ctl_smd_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +1196,7 @@ func (x *SmdPoolResp) String() string {
 func (*SmdPoolResp) ProtoMessage() {}
 
 func (x *SmdPoolResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[6]
+	mi := &file_ctl_smd_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1209,7 @@ func (x *SmdPoolResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdPoolResp.ProtoReflect.Descriptor instead.
 func (*SmdPoolResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{6}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SmdPoolResp) GetStatus() int32 {
@@ -931,18 +1231,21 @@ type SmdQueryReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OmitDevices      bool   `protobuf:"varint,1,opt,name=omit_devices,json=omitDevices,proto3" json:"omit_devices,omitempty"`                  // Indicate query should omit devices
-	OmitPools        bool   `protobuf:"varint,2,opt,name=omit_pools,json=omitPools,proto3" json:"omit_pools,omitempty"`                        // Indicate query should omit pools
-	IncludeBioHealth bool   `protobuf:"varint,3,opt,name=include_bio_health,json=includeBioHealth,proto3" json:"include_bio_health,omitempty"` // Indicate query should include BIO health for devices
-	Uuid             string `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                    // Constrain query to this UUID (pool or device)
-	Rank             uint32 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`                                                   // Restrict response to only include info about this rank
-	Target           string `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`                                                // Restrict response to only include info about this VOS target
+	OmitDevices      bool     `protobuf:"varint,1,opt,name=omit_devices,json=omitDevices,proto3" json:"omit_devices,omitempty"`                  // Indicate query should omit devices
+	OmitPools        bool     `protobuf:"varint,2,opt,name=omit_pools,json=omitPools,proto3" json:"omit_pools,omitempty"`                        // Indicate query should omit pools
+	IncludeBioHealth bool     `protobuf:"varint,3,opt,name=include_bio_health,json=includeBioHealth,proto3" json:"include_bio_health,omitempty"` // Indicate query should include BIO health for devices
+	Uuid             string   `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                    // Constrain query to this UUID (pool or device)
+	Rank             uint32   `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`                                                   // Restrict response to only include info about this rank
+	Target           string   `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`                                                // Restrict response to only include info about this VOS target
+	PageSize         uint32   `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                           // Maximum number of devices to return (0 for all)
+	PageToken        string   `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                         // next_page_token from a previous response
+	Fields           []string `protobuf:"bytes,9,rep,name=fields,proto3" json:"fields,omitempty"`                                                // SmdDevice fields to return (empty for all)
 }
 
 func (x *SmdQueryReq) Reset() {
 	*x = SmdQueryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[7]
+		mi := &file_ctl_smd_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -955,7 +1258,7 @@ func (x *SmdQueryReq) String() string {
 func (*SmdQueryReq) ProtoMessage() {}
 
 func (x *SmdQueryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[7]
+	mi := &file_ctl_smd_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1271,7 @@ func (x *SmdQueryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdQueryReq.ProtoReflect.Descriptor instead.
 func (*SmdQueryReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{7}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SmdQueryReq) GetOmitDevices() bool {
@@ -1013,19 +1316,41 @@ func (x *SmdQueryReq) GetTarget() string {
 	return ""
 }
 
+func (x *SmdQueryReq) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SmdQueryReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SmdQueryReq) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 type SmdQueryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
-	Ranks  []*SmdQueryResp_RankResp `protobuf:"bytes,2,rep,name=ranks,proto3" json:"ranks,omitempty"`    // List of per-rank responses
+	Status        int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                     // DAOS error code
+	Ranks         []*SmdQueryResp_RankResp `protobuf:"bytes,2,rep,name=ranks,proto3" json:"ranks,omitempty"`                                        // List of per-rank responses
+	NextPageToken string                   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // token for the next page of devices, empty if none remain
 }
 
 func (x *SmdQueryResp) Reset() {
 	*x = SmdQueryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[8]
+		mi := &file_ctl_smd_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1038,7 +1363,7 @@ func (x *SmdQueryResp) String() string {
 func (*SmdQueryResp) ProtoMessage() {}
 
 func (x *SmdQueryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[8]
+	mi := &file_ctl_smd_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1051,7 +1376,7 @@ func (x *SmdQueryResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdQueryResp.ProtoReflect.Descriptor instead.
 func (*SmdQueryResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{8}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SmdQueryResp) GetStatus() int32 {
@@ -1068,6 +1393,13 @@ func (x *SmdQueryResp) GetRanks() []*SmdQueryResp_RankResp {
 	return nil
 }
 
+func (x *SmdQueryResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type LedManageReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1082,7 +1414,7 @@ type LedManageReq struct {
 func (x *LedManageReq) Reset() {
 	*x = LedManageReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[9]
+		mi := &file_ctl_smd_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1095,7 +1427,7 @@ func (x *LedManageReq) String() string {
 func (*LedManageReq) ProtoMessage() {}
 
 func (x *LedManageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[9]
+	mi := &file_ctl_smd_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,7 +1440,7 @@ func (x *LedManageReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LedManageReq.ProtoReflect.Descriptor instead.
 func (*LedManageReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{9}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *LedManageReq) GetIds() string {
@@ -1152,7 +1484,7 @@ type DevReplaceReq struct {
 func (x *DevReplaceReq) Reset() {
 	*x = DevReplaceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[10]
+		mi := &file_ctl_smd_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1165,7 +1497,7 @@ func (x *DevReplaceReq) String() string {
 func (*DevReplaceReq) ProtoMessage() {}
 
 func (x *DevReplaceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[10]
+	mi := &file_ctl_smd_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,7 +1510,7 @@ func (x *DevReplaceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DevReplaceReq.ProtoReflect.Descriptor instead.
 func (*DevReplaceReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{10}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DevReplaceReq) GetOldDevUuid() string {
@@ -1213,7 +1545,7 @@ type SetFaultyReq struct {
 func (x *SetFaultyReq) Reset() {
 	*x = SetFaultyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[11]
+		mi := &file_ctl_smd_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1226,7 +1558,7 @@ func (x *SetFaultyReq) String() string {
 func (*SetFaultyReq) ProtoMessage() {}
 
 func (x *SetFaultyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[11]
+	mi := &file_ctl_smd_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,7 +1571,7 @@ func (x *SetFaultyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetFaultyReq.ProtoReflect.Descriptor instead.
 func (*SetFaultyReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{11}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SetFaultyReq) GetUuid() string {
@@ -1261,7 +1593,7 @@ type DevManageResp struct {
 func (x *DevManageResp) Reset() {
 	*x = DevManageResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[12]
+		mi := &file_ctl_smd_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1274,7 +1606,7 @@ func (x *DevManageResp) String() string {
 func (*DevManageResp) ProtoMessage() {}
 
 func (x *DevManageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[12]
+	mi := &file_ctl_smd_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1619,7 @@ func (x *DevManageResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DevManageResp.ProtoReflect.Descriptor instead.
 func (*DevManageResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{12}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DevManageResp) GetStatus() int32 {
@@ -1320,7 +1652,7 @@ type SmdManageReq struct {
 func (x *SmdManageReq) Reset() {
 	*x = SmdManageReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[13]
+		mi := &file_ctl_smd_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1333,7 +1665,7 @@ func (x *SmdManageReq) String() string {
 func (*SmdManageReq) ProtoMessage() {}
 
 func (x *SmdManageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[13]
+	mi := &file_ctl_smd_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,7 +1678,7 @@ func (x *SmdManageReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdManageReq.ProtoReflect.Descriptor instead.
 func (*SmdManageReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{13}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{18}
 }
 
 func (m *SmdManageReq) GetOp() isSmdManageReq_Op {
@@ -1410,7 +1742,7 @@ type SmdManageResp struct {
 func (x *SmdManageResp) Reset() {
 	*x = SmdManageResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[14]
+		mi := &file_ctl_smd_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1423,7 +1755,7 @@ func (x *SmdManageResp) String() string {
 func (*SmdManageResp) ProtoMessage() {}
 
 func (x *SmdManageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[14]
+	mi := &file_ctl_smd_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +1768,7 @@ func (x *SmdManageResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdManageResp.ProtoReflect.Descriptor instead.
 func (*SmdManageResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{14}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SmdManageResp) GetRanks() []*SmdManageResp_RankResp {
@@ -1446,6 +1778,124 @@ func (x *SmdManageResp) GetRanks() []*SmdManageResp_RankResp {
 	return nil
 }
 
+type BlobstoreUsage_Target struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TgtId        int32  `protobuf:"varint,1,opt,name=tgt_id,json=tgtId,proto3" json:"tgt_id,omitempty"`                      // VOS target ID
+	UsedClusters uint64 `protobuf:"varint,2,opt,name=used_clusters,json=usedClusters,proto3" json:"used_clusters,omitempty"` // clusters allocated to the target's blobs
+	Blobs        uint32 `protobuf:"varint,3,opt,name=blobs,proto3" json:"blobs,omitempty"`                                   // number of blobs owned by the target
+}
+
+func (x *BlobstoreUsage_Target) Reset() {
+	*x = BlobstoreUsage_Target{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_smd_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobstoreUsage_Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobstoreUsage_Target) ProtoMessage() {}
+
+func (x *BlobstoreUsage_Target) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_smd_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobstoreUsage_Target.ProtoReflect.Descriptor instead.
+func (*BlobstoreUsage_Target) Descriptor() ([]byte, []int) {
+	return file_ctl_smd_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *BlobstoreUsage_Target) GetTgtId() int32 {
+	if x != nil {
+		return x.TgtId
+	}
+	return 0
+}
+
+func (x *BlobstoreUsage_Target) GetUsedClusters() uint64 {
+	if x != nil {
+		return x.UsedClusters
+	}
+	return 0
+}
+
+func (x *BlobstoreUsage_Target) GetBlobs() uint32 {
+	if x != nil {
+		return x.Blobs
+	}
+	return 0
+}
+
+type BlobstoreQueryResp_RankResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank       uint32            `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`            // Rank to which this response corresponds
+	Blobstores []*BlobstoreUsage `protobuf:"bytes,2,rep,name=blobstores,proto3" json:"blobstores,omitempty"` // List of blobstores on the rank
+}
+
+func (x *BlobstoreQueryResp_RankResp) Reset() {
+	*x = BlobstoreQueryResp_RankResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_smd_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobstoreQueryResp_RankResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobstoreQueryResp_RankResp) ProtoMessage() {}
+
+func (x *BlobstoreQueryResp_RankResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_smd_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobstoreQueryResp_RankResp.ProtoReflect.Descriptor instead.
+func (*BlobstoreQueryResp_RankResp) Descriptor() ([]byte, []int) {
+	return file_ctl_smd_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *BlobstoreQueryResp_RankResp) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *BlobstoreQueryResp_RankResp) GetBlobstores() []*BlobstoreUsage {
+	if x != nil {
+		return x.Blobstores
+	}
+	return nil
+}
+
 type SmdPoolResp_Pool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1459,7 +1909,7 @@ type SmdPoolResp_Pool struct {
 func (x *SmdPoolResp_Pool) Reset() {
 	*x = SmdPoolResp_Pool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[15]
+		mi := &file_ctl_smd_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1472,7 +1922,7 @@ func (x *SmdPoolResp_Pool) String() string {
 func (*SmdPoolResp_Pool) ProtoMessage() {}
 
 func (x *SmdPoolResp_Pool) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[15]
+	mi := &file_ctl_smd_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1485,7 +1935,7 @@ func (x *SmdPoolResp_Pool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdPoolResp_Pool.ProtoReflect.Descriptor instead.
 func (*SmdPoolResp_Pool) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{6, 0}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *SmdPoolResp_Pool) GetUuid() string {
@@ -1521,7 +1971,7 @@ type SmdQueryResp_SmdDeviceWithHealth struct {
 func (x *SmdQueryResp_SmdDeviceWithHealth) Reset() {
 	*x = SmdQueryResp_SmdDeviceWithHealth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[16]
+		mi := &file_ctl_smd_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1534,7 +1984,7 @@ func (x *SmdQueryResp_SmdDeviceWithHealth) String() string {
 func (*SmdQueryResp_SmdDeviceWithHealth) ProtoMessage() {}
 
 func (x *SmdQueryResp_SmdDeviceWithHealth) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[16]
+	mi := &file_ctl_smd_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1547,7 +1997,7 @@ func (x *SmdQueryResp_SmdDeviceWithHealth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdQueryResp_SmdDeviceWithHealth.ProtoReflect.Descriptor instead.
 func (*SmdQueryResp_SmdDeviceWithHealth) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{8, 0}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *SmdQueryResp_SmdDeviceWithHealth) GetDetails() *SmdDevice {
@@ -1577,7 +2027,7 @@ type SmdQueryResp_Pool struct {
 func (x *SmdQueryResp_Pool) Reset() {
 	*x = SmdQueryResp_Pool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[17]
+		mi := &file_ctl_smd_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1590,7 +2040,7 @@ func (x *SmdQueryResp_Pool) String() string {
 func (*SmdQueryResp_Pool) ProtoMessage() {}
 
 func (x *SmdQueryResp_Pool) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[17]
+	mi := &file_ctl_smd_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1603,7 +2053,7 @@ func (x *SmdQueryResp_Pool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdQueryResp_Pool.ProtoReflect.Descriptor instead.
 func (*SmdQueryResp_Pool) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{8, 1}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *SmdQueryResp_Pool) GetUuid() string {
@@ -1640,7 +2090,7 @@ type SmdQueryResp_RankResp struct {
 func (x *SmdQueryResp_RankResp) Reset() {
 	*x = SmdQueryResp_RankResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[18]
+		mi := &file_ctl_smd_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1653,7 +2103,7 @@ func (x *SmdQueryResp_RankResp) String() string {
 func (*SmdQueryResp_RankResp) ProtoMessage() {}
 
 func (x *SmdQueryResp_RankResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[18]
+	mi := &file_ctl_smd_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,7 +2116,7 @@ func (x *SmdQueryResp_RankResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdQueryResp_RankResp.ProtoReflect.Descriptor instead.
 func (*SmdQueryResp_RankResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{8, 2}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{13, 2}
 }
 
 func (x *SmdQueryResp_RankResp) GetRank() uint32 {
@@ -1702,7 +2152,7 @@ type SmdManageResp_Result struct {
 func (x *SmdManageResp_Result) Reset() {
 	*x = SmdManageResp_Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[19]
+		mi := &file_ctl_smd_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1715,7 +2165,7 @@ func (x *SmdManageResp_Result) String() string {
 func (*SmdManageResp_Result) ProtoMessage() {}
 
 func (x *SmdManageResp_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[19]
+	mi := &file_ctl_smd_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,7 +2178,7 @@ func (x *SmdManageResp_Result) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdManageResp_Result.ProtoReflect.Descriptor instead.
 func (*SmdManageResp_Result) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{14, 0}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *SmdManageResp_Result) GetStatus() int32 {
@@ -1757,7 +2207,7 @@ type SmdManageResp_RankResp struct {
 func (x *SmdManageResp_RankResp) Reset() {
 	*x = SmdManageResp_RankResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[20]
+		mi := &file_ctl_smd_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1770,7 +2220,7 @@ func (x *SmdManageResp_RankResp) String() string {
 func (*SmdManageResp_RankResp) ProtoMessage() {}
 
 func (x *SmdManageResp_RankResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[20]
+	mi := &file_ctl_smd_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,7 +2233,7 @@ func (x *SmdManageResp_RankResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdManageResp_RankResp.ProtoReflect.Descriptor instead.
 func (*SmdManageResp_RankResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{14, 1}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{19, 1}
 }
 
 func (x *SmdManageResp_RankResp) GetRank() uint32 {
@@ -1808,7 +2258,7 @@ var file_ctl_smd_proto_rawDesc = []byte{
 	0x68, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x75, 0x75, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76, 0x55, 0x75, 0x69, 0x64, 0x12,
 	0x15, 0x0a, 0x06, 0x74, 0x67, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x67, 0x74, 0x49, 0x64, 0x22, 0x97, 0x0e, 0x0a, 0x0d, 0x42, 0x69, 0x6f, 0x48, 0x65,
+	0x05, 0x74, 0x67, 0x74, 0x49, 0x64, 0x22, 0x95, 0x0f, 0x0a, 0x0d, 0x42, 0x69, 0x6f, 0x48, 0x65,
 	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x61, 0x72, 0x6e, 0x5f, 0x74,
@@ -1921,145 +2371,203 @@ var file_ctl_smd_proto_rawDesc = []byte{
 	0x10, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65,
 	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a,
 	0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x53, 0x69, 0x7a, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
-	0x22, 0xa6, 0x02, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x67, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x06, 0x74, 0x67, 0x74, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76,
-	0x6d, 0x65, 0x44, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x64, 0x65, 0x76, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4c, 0x65,
-	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x0b, 0x0a, 0x09, 0x53, 0x6d, 0x64,
-	0x44, 0x65, 0x76, 0x52, 0x65, 0x71, 0x22, 0x4e, 0x0a, 0x0a, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x07,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x71, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x05,
-	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f,
-	0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x1a, 0x49, 0x0a, 0x04, 0x50, 0x6f, 0x6f,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x67, 0x74, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x74, 0x67, 0x74, 0x49, 0x64, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x62,
-	0x6c, 0x6f, 0x62, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x0b, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6d, 0x69, 0x74,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6d, 0x69, 0x74, 0x5f,
-	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6d, 0x69,
-	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x62, 0x69, 0x6f, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x69, 0x6f, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x0c, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a,
-	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
-	0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x1a,
-	0x6b, 0x0a, 0x13, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d,
-	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x12, 0x2a, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x42, 0x69, 0x6f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x1a, 0x49, 0x0a, 0x04,
-	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x67, 0x74, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x74, 0x67, 0x74, 0x49, 0x64,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x1a, 0x8d, 0x01, 0x0a, 0x08, 0x52, 0x61, 0x6e, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x3f, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x6d, 0x64,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x70, 0x6f, 0x6f,
-	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
-	0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f, 0x6f, 0x6c,
-	0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x0c, 0x4c, 0x65, 0x64, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x6c, 0x65,
-	0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
-	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
-	0x6c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x64,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x63,
-	0x74, 0x6c, 0x2e, 0x4c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0f, 0x6c, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e,
-	0x73, 0x22, 0x6e, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x44, 0x65, 0x76,
-	0x55, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x76, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x44,
-	0x65, 0x76, 0x55, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x69,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x52, 0x65, 0x69, 0x6e,
-	0x74, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x4d, 0x61, 0x6e, 0x61,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x70,
+	0x61, 0x72, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x53, 0x70, 0x61, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x73,
+	0x70, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x30,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x53, 0x70, 0x61, 0x72, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x31, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x55, 0x73,
+	0x65, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0xee,
+	0x02, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x67, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x06, 0x74, 0x67, 0x74, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65,
+	0x44, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x64, 0x65, 0x76, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4c, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x22,
+	0x0b, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x52, 0x65, 0x71, 0x22, 0x4e, 0x0a, 0x0a,
+	0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11,
+	0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x22, 0xac, 0x02, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x65,
+	0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x34,
+	0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x1a, 0x5a, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x74, 0x67, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x67, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x75, 0x73,
+	0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c,
+	0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x22, 0x61, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x61,
 	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26,
-	0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x53, 0x6d, 0x64, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x03, 0x6c, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4c, 0x65, 0x64, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x65, 0x64, 0x12, 0x2e,
-	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x44, 0x65, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x2b,
-	0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x42, 0x04, 0x0a, 0x02, 0x6f,
-	0x70, 0x22, 0xe1, 0x01, 0x0a, 0x0d, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x52,
-	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x1a, 0x48, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
-	0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x1a, 0x53, 0x0a, 0x08, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
-	0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x2a, 0x3f, 0x0a, 0x0c, 0x4e, 0x76, 0x6d, 0x65, 0x44, 0x65, 0x76,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x56,
-	0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x50, 0x4c, 0x55,
-	0x47, 0x47, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x44, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x51,
-	0x55, 0x49, 0x43, 0x4b, 0x5f, 0x42, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02,
-	0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x4c, 0x4f, 0x57, 0x5f, 0x42, 0x4c, 0x49,
-	0x4e, 0x4b, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x41, 0x10, 0x04, 0x2a, 0x28, 0x0a, 0x09,
-	0x4c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x54,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52,
-	0x45, 0x53, 0x45, 0x54, 0x10, 0x02, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f,
-	0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74,
-	0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x22, 0xa1, 0x01, 0x0a, 0x12, 0x42, 0x6c, 0x6f,
+	0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x36, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x1a, 0x53, 0x0a, 0x08, 0x52, 0x61, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x33, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x0c, 0x0a, 0x0a,
+	0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x53,
+	0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x1a,
+	0x49, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x67, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x74, 0x67,
+	0x74, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x22, 0x91, 0x02, 0x0a, 0x0b, 0x53,
+	0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6d,
+	0x69, 0x74, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6f, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x6f, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x62, 0x69, 0x6f, 0x5f, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x42, 0x69, 0x6f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xc8,
+	0x03, 0x0a, 0x0c, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x1a, 0x6b, 0x0a, 0x13, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x42, 0x69, 0x6f, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x1a, 0x49,
+	0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x67,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x74, 0x67, 0x74,
+	0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x1a, 0x8d, 0x01, 0x0a, 0x08, 0x52, 0x61,
+	0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x3f, 0x0a, 0x07, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53,
+	0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x0c, 0x4c, 0x65,
+	0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x0a,
+	0x6c, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x09, 0x6c,
+	0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6c,
+	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x65, 0x64, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x6c, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x69, 0x6e, 0x73, 0x22, 0x6e, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x44,
+	0x65, 0x76, 0x55, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65,
+	0x76, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x77, 0x44, 0x65, 0x76, 0x55, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x72,
+	0x65, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x52, 0x65,
+	0x69, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x26, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x53, 0x6d, 0x64,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x03, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4c, 0x65, 0x64,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x65, 0x64,
+	0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x44, 0x65, 0x76, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x12, 0x2b, 0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x42, 0x04, 0x0a,
+	0x02, 0x6f, 0x70, 0x22, 0xe1, 0x01, 0x0a, 0x0d, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x1a, 0x48, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x1a, 0x53, 0x0a, 0x08, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2a, 0x3f, 0x0a, 0x0c, 0x4e, 0x76, 0x6d, 0x65, 0x44,
+	0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41,
+	0x4c, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x45, 0x56, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x50,
+	0x4c, 0x55, 0x47, 0x47, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x44, 0x0a, 0x08, 0x4c, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x51, 0x55, 0x49, 0x43, 0x4b, 0x5f, 0x42, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x4c, 0x4f, 0x57, 0x5f, 0x42,
+	0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x41, 0x10, 0x04, 0x2a, 0x28,
+	0x0a, 0x09, 0x4c, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x47,
+	0x45, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x02, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2075,7 +2583,7 @@ func file_ctl_smd_proto_rawDescGZIP() []byte {
 }
 
 var file_ctl_smd_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_ctl_smd_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_ctl_smd_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_ctl_smd_proto_goTypes = []interface{}{
 	(NvmeDevState)(0),                        // 0: ctl.NvmeDevState
 	(LedState)(0),                            // 1: ctl.LedState
@@ -2085,47 +2593,58 @@ var file_ctl_smd_proto_goTypes = []interface{}{
 	(*SmdDevice)(nil),                        // 5: ctl.SmdDevice
 	(*SmdDevReq)(nil),                        // 6: ctl.SmdDevReq
 	(*SmdDevResp)(nil),                       // 7: ctl.SmdDevResp
-	(*SmdPoolReq)(nil),                       // 8: ctl.SmdPoolReq
-	(*SmdPoolResp)(nil),                      // 9: ctl.SmdPoolResp
-	(*SmdQueryReq)(nil),                      // 10: ctl.SmdQueryReq
-	(*SmdQueryResp)(nil),                     // 11: ctl.SmdQueryResp
-	(*LedManageReq)(nil),                     // 12: ctl.LedManageReq
-	(*DevReplaceReq)(nil),                    // 13: ctl.DevReplaceReq
-	(*SetFaultyReq)(nil),                     // 14: ctl.SetFaultyReq
-	(*DevManageResp)(nil),                    // 15: ctl.DevManageResp
-	(*SmdManageReq)(nil),                     // 16: ctl.SmdManageReq
-	(*SmdManageResp)(nil),                    // 17: ctl.SmdManageResp
-	(*SmdPoolResp_Pool)(nil),                 // 18: ctl.SmdPoolResp.Pool
-	(*SmdQueryResp_SmdDeviceWithHealth)(nil), // 19: ctl.SmdQueryResp.SmdDeviceWithHealth
-	(*SmdQueryResp_Pool)(nil),                // 20: ctl.SmdQueryResp.Pool
-	(*SmdQueryResp_RankResp)(nil),            // 21: ctl.SmdQueryResp.RankResp
-	(*SmdManageResp_Result)(nil),             // 22: ctl.SmdManageResp.Result
-	(*SmdManageResp_RankResp)(nil),           // 23: ctl.SmdManageResp.RankResp
+	(*BlobstoreUsageReq)(nil),                // 8: ctl.BlobstoreUsageReq
+	(*BlobstoreUsage)(nil),                   // 9: ctl.BlobstoreUsage
+	(*BlobstoreUsageResp)(nil),               // 10: ctl.BlobstoreUsageResp
+	(*BlobstoreQueryReq)(nil),                // 11: ctl.BlobstoreQueryReq
+	(*BlobstoreQueryResp)(nil),               // 12: ctl.BlobstoreQueryResp
+	(*SmdPoolReq)(nil),                       // 13: ctl.SmdPoolReq
+	(*SmdPoolResp)(nil),                      // 14: ctl.SmdPoolResp
+	(*SmdQueryReq)(nil),                      // 15: ctl.SmdQueryReq
+	(*SmdQueryResp)(nil),                     // 16: ctl.SmdQueryResp
+	(*LedManageReq)(nil),                     // 17: ctl.LedManageReq
+	(*DevReplaceReq)(nil),                    // 18: ctl.DevReplaceReq
+	(*SetFaultyReq)(nil),                     // 19: ctl.SetFaultyReq
+	(*DevManageResp)(nil),                    // 20: ctl.DevManageResp
+	(*SmdManageReq)(nil),                     // 21: ctl.SmdManageReq
+	(*SmdManageResp)(nil),                    // 22: ctl.SmdManageResp
+	(*BlobstoreUsage_Target)(nil),            // 23: ctl.BlobstoreUsage.Target
+	(*BlobstoreQueryResp_RankResp)(nil),      // 24: ctl.BlobstoreQueryResp.RankResp
+	(*SmdPoolResp_Pool)(nil),                 // 25: ctl.SmdPoolResp.Pool
+	(*SmdQueryResp_SmdDeviceWithHealth)(nil), // 26: ctl.SmdQueryResp.SmdDeviceWithHealth
+	(*SmdQueryResp_Pool)(nil),                // 27: ctl.SmdQueryResp.Pool
+	(*SmdQueryResp_RankResp)(nil),            // 28: ctl.SmdQueryResp.RankResp
+	(*SmdManageResp_Result)(nil),             // 29: ctl.SmdManageResp.Result
+	(*SmdManageResp_RankResp)(nil),           // 30: ctl.SmdManageResp.RankResp
 }
 var file_ctl_smd_proto_depIdxs = []int32{
 	0,  // 0: ctl.SmdDevice.dev_state:type_name -> ctl.NvmeDevState
 	1,  // 1: ctl.SmdDevice.led_state:type_name -> ctl.LedState
 	5,  // 2: ctl.SmdDevResp.devices:type_name -> ctl.SmdDevice
-	18, // 3: ctl.SmdPoolResp.pools:type_name -> ctl.SmdPoolResp.Pool
-	21, // 4: ctl.SmdQueryResp.ranks:type_name -> ctl.SmdQueryResp.RankResp
-	2,  // 5: ctl.LedManageReq.led_action:type_name -> ctl.LedAction
-	1,  // 6: ctl.LedManageReq.led_state:type_name -> ctl.LedState
-	5,  // 7: ctl.DevManageResp.device:type_name -> ctl.SmdDevice
-	12, // 8: ctl.SmdManageReq.led:type_name -> ctl.LedManageReq
-	13, // 9: ctl.SmdManageReq.replace:type_name -> ctl.DevReplaceReq
-	14, // 10: ctl.SmdManageReq.faulty:type_name -> ctl.SetFaultyReq
-	23, // 11: ctl.SmdManageResp.ranks:type_name -> ctl.SmdManageResp.RankResp
-	5,  // 12: ctl.SmdQueryResp.SmdDeviceWithHealth.details:type_name -> ctl.SmdDevice
-	4,  // 13: ctl.SmdQueryResp.SmdDeviceWithHealth.health:type_name -> ctl.BioHealthResp
-	19, // 14: ctl.SmdQueryResp.RankResp.devices:type_name -> ctl.SmdQueryResp.SmdDeviceWithHealth
-	20, // 15: ctl.SmdQueryResp.RankResp.pools:type_name -> ctl.SmdQueryResp.Pool
-	5,  // 16: ctl.SmdManageResp.Result.device:type_name -> ctl.SmdDevice
-	22, // 17: ctl.SmdManageResp.RankResp.results:type_name -> ctl.SmdManageResp.Result
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	23, // 3: ctl.BlobstoreUsage.targets:type_name -> ctl.BlobstoreUsage.Target
+	9,  // 4: ctl.BlobstoreUsageResp.blobstores:type_name -> ctl.BlobstoreUsage
+	24, // 5: ctl.BlobstoreQueryResp.ranks:type_name -> ctl.BlobstoreQueryResp.RankResp
+	25, // 6: ctl.SmdPoolResp.pools:type_name -> ctl.SmdPoolResp.Pool
+	28, // 7: ctl.SmdQueryResp.ranks:type_name -> ctl.SmdQueryResp.RankResp
+	2,  // 8: ctl.LedManageReq.led_action:type_name -> ctl.LedAction
+	1,  // 9: ctl.LedManageReq.led_state:type_name -> ctl.LedState
+	5,  // 10: ctl.DevManageResp.device:type_name -> ctl.SmdDevice
+	17, // 11: ctl.SmdManageReq.led:type_name -> ctl.LedManageReq
+	18, // 12: ctl.SmdManageReq.replace:type_name -> ctl.DevReplaceReq
+	19, // 13: ctl.SmdManageReq.faulty:type_name -> ctl.SetFaultyReq
+	30, // 14: ctl.SmdManageResp.ranks:type_name -> ctl.SmdManageResp.RankResp
+	9,  // 15: ctl.BlobstoreQueryResp.RankResp.blobstores:type_name -> ctl.BlobstoreUsage
+	5,  // 16: ctl.SmdQueryResp.SmdDeviceWithHealth.details:type_name -> ctl.SmdDevice
+	4,  // 17: ctl.SmdQueryResp.SmdDeviceWithHealth.health:type_name -> ctl.BioHealthResp
+	26, // 18: ctl.SmdQueryResp.RankResp.devices:type_name -> ctl.SmdQueryResp.SmdDeviceWithHealth
+	27, // 19: ctl.SmdQueryResp.RankResp.pools:type_name -> ctl.SmdQueryResp.Pool
+	5,  // 20: ctl.SmdManageResp.Result.device:type_name -> ctl.SmdDevice
+	29, // 21: ctl.SmdManageResp.RankResp.results:type_name -> ctl.SmdManageResp.Result
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_ctl_smd_proto_init() }
@@ -2195,7 +2714,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdPoolReq); i {
+			switch v := v.(*BlobstoreUsageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2207,7 +2726,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdPoolResp); i {
+			switch v := v.(*BlobstoreUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2219,7 +2738,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdQueryReq); i {
+			switch v := v.(*BlobstoreUsageResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2231,7 +2750,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdQueryResp); i {
+			switch v := v.(*BlobstoreQueryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2243,7 +2762,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LedManageReq); i {
+			switch v := v.(*BlobstoreQueryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2255,7 +2774,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevReplaceReq); i {
+			switch v := v.(*SmdPoolReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2267,7 +2786,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFaultyReq); i {
+			switch v := v.(*SmdPoolResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2279,7 +2798,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevManageResp); i {
+			switch v := v.(*SmdQueryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2291,7 +2810,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdManageReq); i {
+			switch v := v.(*SmdQueryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2303,7 +2822,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdManageResp); i {
+			switch v := v.(*LedManageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2315,7 +2834,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdPoolResp_Pool); i {
+			switch v := v.(*DevReplaceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2327,7 +2846,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdQueryResp_SmdDeviceWithHealth); i {
+			switch v := v.(*SetFaultyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2339,7 +2858,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdQueryResp_Pool); i {
+			switch v := v.(*DevManageResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2351,7 +2870,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdQueryResp_RankResp); i {
+			switch v := v.(*SmdManageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2363,7 +2882,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdManageResp_Result); i {
+			switch v := v.(*SmdManageResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2375,6 +2894,90 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobstoreUsage_Target); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_smd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobstoreQueryResp_RankResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_smd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmdPoolResp_Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_smd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmdQueryResp_SmdDeviceWithHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_smd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmdQueryResp_Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_smd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmdQueryResp_RankResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_smd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmdManageResp_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_smd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SmdManageResp_RankResp); i {
 			case 0:
 				return &v.state
@@ -2387,7 +2990,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 	}
-	file_ctl_smd_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_ctl_smd_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*SmdManageReq_Led)(nil),
 		(*SmdManageReq_Replace)(nil),
 		(*SmdManageReq_Faulty)(nil),
@@ -2398,7 +3001,7 @@ func file_ctl_smd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_smd_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   21,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
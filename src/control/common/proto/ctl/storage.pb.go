@@ -569,6 +569,116 @@ func (x *NvmeAddDeviceResp) GetState() *ResponseState {
 	return nil
 }
 
+type SpdkRpcReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EngineIndex uint32 `protobuf:"varint,1,opt,name=engine_index,json=engineIndex,proto3" json:"engine_index,omitempty"` // Index of DAOS engine to forward request to
+	Method      string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`                               // SPDK JSON-RPC method name
+	Params      string `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`                               // JSON-encoded method parameters (optional)
+}
+
+func (x *SpdkRpcReq) Reset() {
+	*x = SpdkRpcReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkRpcReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkRpcReq) ProtoMessage() {}
+
+func (x *SpdkRpcReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkRpcReq.ProtoReflect.Descriptor instead.
+func (*SpdkRpcReq) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SpdkRpcReq) GetEngineIndex() uint32 {
+	if x != nil {
+		return x.EngineIndex
+	}
+	return 0
+}
+
+func (x *SpdkRpcReq) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SpdkRpcReq) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+type SpdkRpcResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"` // Raw JSON-encoded result of SPDK JSON-RPC call
+}
+
+func (x *SpdkRpcResp) Reset() {
+	*x = SpdkRpcResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpdkRpcResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpdkRpcResp) ProtoMessage() {}
+
+func (x *SpdkRpcResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpdkRpcResp.ProtoReflect.Descriptor instead.
+func (*SpdkRpcResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SpdkRpcResp) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
 var File_ctl_storage_proto protoreflect.FileDescriptor
 
 var file_ctl_storage_proto_rawDesc = []byte{
@@ -645,11 +755,19 @@ var file_ctl_storage_proto_rawDesc = []byte{
 	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
-	0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73,
-	0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x22, 0x5f, 0x0a, 0x0a, 0x53, 0x70, 0x64, 0x6b, 0x52, 0x70, 0x63, 0x52, 0x65, 0x71, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x25, 0x0a, 0x0b, 0x53, 0x70, 0x64, 0x6b, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -664,7 +782,7 @@ func file_ctl_storage_proto_rawDescGZIP() []byte {
 	return file_ctl_storage_proto_rawDescData
 }
 
-var file_ctl_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ctl_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_ctl_storage_proto_goTypes = []interface{}{
 	(*StorageScanReq)(nil),       // 0: ctl.StorageScanReq
 	(*MemInfo)(nil),              // 1: ctl.MemInfo
@@ -675,28 +793,30 @@ var file_ctl_storage_proto_goTypes = []interface{}{
 	(*NvmeRebindResp)(nil),       // 6: ctl.NvmeRebindResp
 	(*NvmeAddDeviceReq)(nil),     // 7: ctl.NvmeAddDeviceReq
 	(*NvmeAddDeviceResp)(nil),    // 8: ctl.NvmeAddDeviceResp
-	(*ScanNvmeReq)(nil),          // 9: ctl.ScanNvmeReq
-	(*ScanScmReq)(nil),           // 10: ctl.ScanScmReq
-	(*ScanNvmeResp)(nil),         // 11: ctl.ScanNvmeResp
-	(*ScanScmResp)(nil),          // 12: ctl.ScanScmResp
-	(*FormatNvmeReq)(nil),        // 13: ctl.FormatNvmeReq
-	(*FormatScmReq)(nil),         // 14: ctl.FormatScmReq
-	(*NvmeControllerResult)(nil), // 15: ctl.NvmeControllerResult
-	(*ScmMountResult)(nil),       // 16: ctl.ScmMountResult
-	(*ResponseState)(nil),        // 17: ctl.ResponseState
+	(*SpdkRpcReq)(nil),           // 9: ctl.SpdkRpcReq
+	(*SpdkRpcResp)(nil),          // 10: ctl.SpdkRpcResp
+	(*ScanNvmeReq)(nil),          // 11: ctl.ScanNvmeReq
+	(*ScanScmReq)(nil),           // 12: ctl.ScanScmReq
+	(*ScanNvmeResp)(nil),         // 13: ctl.ScanNvmeResp
+	(*ScanScmResp)(nil),          // 14: ctl.ScanScmResp
+	(*FormatNvmeReq)(nil),        // 15: ctl.FormatNvmeReq
+	(*FormatScmReq)(nil),         // 16: ctl.FormatScmReq
+	(*NvmeControllerResult)(nil), // 17: ctl.NvmeControllerResult
+	(*ScmMountResult)(nil),       // 18: ctl.ScmMountResult
+	(*ResponseState)(nil),        // 19: ctl.ResponseState
 }
 var file_ctl_storage_proto_depIdxs = []int32{
-	9,  // 0: ctl.StorageScanReq.nvme:type_name -> ctl.ScanNvmeReq
-	10, // 1: ctl.StorageScanReq.scm:type_name -> ctl.ScanScmReq
-	11, // 2: ctl.StorageScanResp.nvme:type_name -> ctl.ScanNvmeResp
-	12, // 3: ctl.StorageScanResp.scm:type_name -> ctl.ScanScmResp
+	11, // 0: ctl.StorageScanReq.nvme:type_name -> ctl.ScanNvmeReq
+	12, // 1: ctl.StorageScanReq.scm:type_name -> ctl.ScanScmReq
+	13, // 2: ctl.StorageScanResp.nvme:type_name -> ctl.ScanNvmeResp
+	14, // 3: ctl.StorageScanResp.scm:type_name -> ctl.ScanScmResp
 	1,  // 4: ctl.StorageScanResp.mem_info:type_name -> ctl.MemInfo
-	13, // 5: ctl.StorageFormatReq.nvme:type_name -> ctl.FormatNvmeReq
-	14, // 6: ctl.StorageFormatReq.scm:type_name -> ctl.FormatScmReq
-	15, // 7: ctl.StorageFormatResp.crets:type_name -> ctl.NvmeControllerResult
-	16, // 8: ctl.StorageFormatResp.mrets:type_name -> ctl.ScmMountResult
-	17, // 9: ctl.NvmeRebindResp.state:type_name -> ctl.ResponseState
-	17, // 10: ctl.NvmeAddDeviceResp.state:type_name -> ctl.ResponseState
+	15, // 5: ctl.StorageFormatReq.nvme:type_name -> ctl.FormatNvmeReq
+	16, // 6: ctl.StorageFormatReq.scm:type_name -> ctl.FormatScmReq
+	17, // 7: ctl.StorageFormatResp.crets:type_name -> ctl.NvmeControllerResult
+	18, // 8: ctl.StorageFormatResp.mrets:type_name -> ctl.ScmMountResult
+	19, // 9: ctl.NvmeRebindResp.state:type_name -> ctl.ResponseState
+	19, // 10: ctl.NvmeAddDeviceResp.state:type_name -> ctl.ResponseState
 	11, // [11:11] is the sub-list for method output_type
 	11, // [11:11] is the sub-list for method input_type
 	11, // [11:11] is the sub-list for extension type_name
@@ -821,6 +941,30 @@ func file_ctl_storage_proto_init() {
 				return nil
 			}
 		}
+		file_ctl_storage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkRpcReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpdkRpcResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -828,7 +972,7 @@ func file_ctl_storage_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_storage_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -104,7 +104,7 @@ func Debug(msg proto.Message) string {
 	case *mgmtpb.JoinResp:
 		fmt.Fprintf(&bld, "%T rank:%d (state:%s, local:%t)", m, m.Rank, m.State, m.LocalJoin)
 	case *mgmtpb.GetAttachInfoResp:
-		msRanks := ranklist.RankSetFromRanks(ranklist.RanksFromUint32(m.MsRanks))
+		msRanks := ranklist.RankSetFromUint32(m.MsRanks)
 		uriRanks := ranklist.NewRankSet()
 		for _, ru := range m.RankUris {
 			uriRanks.Add(ranklist.Rank(ru.Rank))
@@ -127,7 +127,7 @@ func Debug(msg proto.Message) string {
 				break
 			}
 			if psi, ok := m.Event.ExtendedInfo.(*sharedpb.RASEvent_PoolSvcInfo); ok {
-				svcRanks := ranklist.RankSetFromRanks(ranklist.RanksFromUint32(psi.PoolSvcInfo.SvcReps))
+				svcRanks := ranklist.RankSetFromUint32(psi.PoolSvcInfo.SvcReps)
 				fmt.Fprintf(&bld, ": %s", svcRanks.String())
 			}
 		default:
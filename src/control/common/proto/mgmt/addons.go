@@ -69,6 +69,26 @@ func (r *PoolUpgradeReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's ID to a UUID.
+func (r *PoolSetPolicyReq) SetUUID(id uuid.UUID) {
+	r.Id = id.String()
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *PoolSetPolicyReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's ID to a UUID.
+func (r *PoolQueryAggregationReq) SetUUID(id uuid.UUID) {
+	r.Id = id.String()
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *PoolQueryAggregationReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolSetPropReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
@@ -204,6 +224,16 @@ func (r *ContSetOwnerReq) GetId() string {
 	return r.PoolUUID
 }
 
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ContCheckReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's ID to a UUID.
+func (r *ContCheckReq) SetUUID(id uuid.UUID) {
+	r.Id = id.String()
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *ListContReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
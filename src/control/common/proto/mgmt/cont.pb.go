@@ -6,8 +6,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
-// 	protoc        v3.6.1
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.5.0
 // source: mgmt/cont.proto
 
 package mgmt
@@ -162,6 +162,206 @@ func (x *ContSetOwnerResp) GetStatus() int32 {
 	return 0
 }
 
+// ContCheckReq supplies parameters for a consistency check of a pool's
+// container metadata.
+type ContCheckReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
+	Id       string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool
+	SvcRanks []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Repair   bool     `protobuf:"varint,4,opt,name=repair,proto3" json:"repair,omitempty"`                            // remove any orphaned container metadata found
+}
+
+func (x *ContCheckReq) Reset() {
+	*x = ContCheckReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_cont_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContCheckReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContCheckReq) ProtoMessage() {}
+
+func (x *ContCheckReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_cont_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContCheckReq.ProtoReflect.Descriptor instead.
+func (*ContCheckReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_cont_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ContCheckReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *ContCheckReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContCheckReq) GetSvcRanks() []uint32 {
+	if x != nil {
+		return x.SvcRanks
+	}
+	return nil
+}
+
+func (x *ContCheckReq) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+// ContCheckResp returns the orphaned container metadata found by a check.
+type ContCheckResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  int32                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`  // DAOS error code
+	Orphans []*ContCheckResp_Orphan `protobuf:"bytes,2,rep,name=orphans,proto3" json:"orphans,omitempty"` // orphaned container metadata
+}
+
+func (x *ContCheckResp) Reset() {
+	*x = ContCheckResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_cont_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContCheckResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContCheckResp) ProtoMessage() {}
+
+func (x *ContCheckResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_cont_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContCheckResp.ProtoReflect.Descriptor instead.
+func (*ContCheckResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_cont_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ContCheckResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ContCheckResp) GetOrphans() []*ContCheckResp_Orphan {
+	if x != nil {
+		return x.Orphans
+	}
+	return nil
+}
+
+type ContCheckResp_Orphan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid    string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`        // UUID of the container the metadata belongs to
+	Label   string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`      // label of the container, if known
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`    // description of the inconsistency found
+	Removed bool   `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"` // orphaned metadata has been removed
+}
+
+func (x *ContCheckResp_Orphan) Reset() {
+	*x = ContCheckResp_Orphan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_cont_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContCheckResp_Orphan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContCheckResp_Orphan) ProtoMessage() {}
+
+func (x *ContCheckResp_Orphan) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_cont_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContCheckResp_Orphan.ProtoReflect.Descriptor instead.
+func (*ContCheckResp_Orphan) Descriptor() ([]byte, []int) {
+	return file_mgmt_cont_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ContCheckResp_Orphan) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ContCheckResp_Orphan) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ContCheckResp_Orphan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ContCheckResp_Orphan) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
 var File_mgmt_cont_proto protoreflect.FileDescriptor
 
 var file_mgmt_cont_proto_rawDesc = []byte{
@@ -180,11 +380,30 @@ var file_mgmt_cont_proto_rawDesc = []byte{
 	0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73,
 	0x22, 0x2a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
 	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x3a, 0x5a, 0x38,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d,
-	0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x65, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x22, 0xc3, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a,
+	0x07, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x52, 0x07, 0x6f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x73, 0x1a, 0x64, 0x0a, 0x06, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -199,17 +418,21 @@ func file_mgmt_cont_proto_rawDescGZIP() []byte {
 	return file_mgmt_cont_proto_rawDescData
 }
 
-var file_mgmt_cont_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mgmt_cont_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_mgmt_cont_proto_goTypes = []interface{}{
-	(*ContSetOwnerReq)(nil),  // 0: mgmt.ContSetOwnerReq
-	(*ContSetOwnerResp)(nil), // 1: mgmt.ContSetOwnerResp
+	(*ContSetOwnerReq)(nil),      // 0: mgmt.ContSetOwnerReq
+	(*ContSetOwnerResp)(nil),     // 1: mgmt.ContSetOwnerResp
+	(*ContCheckReq)(nil),         // 2: mgmt.ContCheckReq
+	(*ContCheckResp)(nil),        // 3: mgmt.ContCheckResp
+	(*ContCheckResp_Orphan)(nil), // 4: mgmt.ContCheckResp.Orphan
 }
 var file_mgmt_cont_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: mgmt.ContCheckResp.orphans:type_name -> mgmt.ContCheckResp.Orphan
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_mgmt_cont_proto_init() }
@@ -242,6 +465,42 @@ func file_mgmt_cont_proto_init() {
 				return nil
 			}
 		}
+		file_mgmt_cont_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContCheckReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_cont_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContCheckResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_cont_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContCheckResp_Orphan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -249,7 +508,7 @@ func file_mgmt_cont_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mgmt_cont_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
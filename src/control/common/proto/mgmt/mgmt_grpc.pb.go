@@ -25,15 +25,26 @@ const _ = grpc.SupportPackageIsVersion7
 type MgmtSvcClient interface {
 	// Join the server described by JoinReq to the system.
 	Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinResp, error)
+	// Join the server described by JoinReq to the system, streaming the
+	// progress of the request until it completes.
+	JoinStream(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (MgmtSvc_JoinStreamClient, error)
+	// Report harness and engine liveness to the MS leader.
+	Heartbeat(ctx context.Context, in *HeartbeatReq, opts ...grpc.CallOption) (*HeartbeatResp, error)
 	// ClusterEvent notify MS of a RAS event in the cluster.
 	ClusterEvent(ctx context.Context, in *shared.ClusterEventReq, opts ...grpc.CallOption) (*shared.ClusterEventResp, error)
+	// Stream the RAS events received by the MS leader until canceled.
+	SystemEventStream(ctx context.Context, in *SystemEventStreamReq, opts ...grpc.CallOption) (MgmtSvc_SystemEventStreamClient, error)
 	// LeaderQuery provides a mechanism for clients to discover
 	// the system's current Management Service leader
 	LeaderQuery(ctx context.Context, in *LeaderQueryReq, opts ...grpc.CallOption) (*LeaderQueryResp, error)
 	// Create a DAOS pool allocated across a number of ranks
 	PoolCreate(ctx context.Context, in *PoolCreateReq, opts ...grpc.CallOption) (*PoolCreateResp, error)
+	// Query or cancel an asynchronous DAOS pool create job.
+	PoolCreateStatus(ctx context.Context, in *PoolCreateStatusReq, opts ...grpc.CallOption) (*PoolCreateStatusResp, error)
 	// Destroy a DAOS pool allocated across a number of ranks.
 	PoolDestroy(ctx context.Context, in *PoolDestroyReq, opts ...grpc.CallOption) (*PoolDestroyResp, error)
+	// Destroy pools left incomplete by a failed create or destroy.
+	PoolCleanupPartial(ctx context.Context, in *PoolCleanupPartialReq, opts ...grpc.CallOption) (*PoolCleanupPartialResp, error)
 	// Evict a DAOS pool's connections.
 	PoolEvict(ctx context.Context, in *PoolEvictReq, opts ...grpc.CallOption) (*PoolEvictResp, error)
 	// Exclude a pool target.
@@ -46,12 +57,22 @@ type MgmtSvcClient interface {
 	PoolReintegrate(ctx context.Context, in *PoolReintegrateReq, opts ...grpc.CallOption) (*PoolReintegrateResp, error)
 	// PoolQuery queries a DAOS pool.
 	PoolQuery(ctx context.Context, in *PoolQueryReq, opts ...grpc.CallOption) (*PoolQueryResp, error)
+	// PoolProbe checks the health of a DAOS pool service.
+	PoolProbe(ctx context.Context, in *PoolProbeReq, opts ...grpc.CallOption) (*PoolProbeResp, error)
 	// PoolQueryTarget queries a DAOS storage target.
 	PoolQueryTarget(ctx context.Context, in *PoolQueryTargetReq, opts ...grpc.CallOption) (*PoolQueryTargetResp, error)
+	// Set the principals that may administer a DAOS pool.
+	PoolSetAdmins(ctx context.Context, in *PoolSetAdminsReq, opts ...grpc.CallOption) (*PoolSetAdminsResp, error)
+	// Set or remove annotations on a DAOS pool.
+	PoolAnnotate(ctx context.Context, in *PoolAnnotateReq, opts ...grpc.CallOption) (*PoolAnnotateResp, error)
 	// Set a DAOS pool property.
 	PoolSetProp(ctx context.Context, in *PoolSetPropReq, opts ...grpc.CallOption) (*PoolSetPropResp, error)
 	// Get a DAOS pool property list.
 	PoolGetProp(ctx context.Context, in *PoolGetPropReq, opts ...grpc.CallOption) (*PoolGetPropResp, error)
+	// Set runtime hints for the scheduling of DAOS pool background tasks.
+	PoolSetPolicy(ctx context.Context, in *PoolSetPolicyReq, opts ...grpc.CallOption) (*PoolSetPolicyResp, error)
+	// Query the status of background aggregation on a DAOS pool's targets.
+	PoolQueryAggregation(ctx context.Context, in *PoolQueryAggregationReq, opts ...grpc.CallOption) (*PoolQueryAggregationResp, error)
 	// Fetch the Access Control List for a DAOS pool.
 	PoolGetACL(ctx context.Context, in *GetACLReq, opts ...grpc.CallOption) (*ACLResp, error)
 	// Overwrite the Access Control List for a DAOS pool with a new one.
@@ -68,6 +89,8 @@ type MgmtSvcClient interface {
 	ListContainers(ctx context.Context, in *ListContReq, opts ...grpc.CallOption) (*ListContResp, error)
 	// Change the owner of a DAOS container
 	ContSetOwner(ctx context.Context, in *ContSetOwnerReq, opts ...grpc.CallOption) (*ContSetOwnerResp, error)
+	// Check a pool's container metadata for orphans left by failed destroys.
+	ContCheck(ctx context.Context, in *ContCheckReq, opts ...grpc.CallOption) (*ContCheckResp, error)
 	// Query DAOS system status
 	SystemQuery(ctx context.Context, in *SystemQueryReq, opts ...grpc.CallOption) (*SystemQueryResp, error)
 	// Stop DAOS system (shutdown data-plane instances)
@@ -90,6 +113,26 @@ type MgmtSvcClient interface {
 	SystemSetProp(ctx context.Context, in *SystemSetPropReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(ctx context.Context, in *SystemGetPropReq, opts ...grpc.CallOption) (*SystemGetPropResp, error)
+	// Verify the integrity of the system database.
+	SystemDbVerify(ctx context.Context, in *SystemDbVerifyReq, opts ...grpc.CallOption) (*SystemDbVerifyResp, error)
+	// Create a portable backup of the system database.
+	SystemDbBackup(ctx context.Context, in *SystemDbBackupReq, opts ...grpc.CallOption) (*SystemDbBackupResp, error)
+	// Restore the system database from a backup.
+	SystemDbRestore(ctx context.Context, in *SystemDbRestoreReq, opts ...grpc.CallOption) (*SystemDbRestoreResp, error)
+	// Query the status of the local system database replica.
+	SystemDbStatus(ctx context.Context, in *SystemDbStatusReq, opts ...grpc.CallOption) (*SystemDbStatusResp, error)
+	// Set or clear the display alias of a system member.
+	SystemSetMemberAlias(ctx context.Context, in *SystemSetMemberAliasReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Set or remove annotations on system members.
+	SystemAnnotate(ctx context.Context, in *SystemAnnotateReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Add a control plane server to the set of MS replicas.
+	SystemAddReplica(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error)
+	// Remove a control plane server from the set of MS replicas.
+	SystemRemoveReplica(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error)
+	// Sign the certificate request of an enrolling host.
+	SystemSignCert(ctx context.Context, in *SystemSignCertReq, opts ...grpc.CallOption) (*SystemSignCertResp, error)
+	// Perform no work, used to measure control-plane RPC overhead.
+	Noop(ctx context.Context, in *NoopReq, opts ...grpc.CallOption) (*NoopResp, error)
 }
 
 type mgmtSvcClient struct {
@@ -109,6 +152,47 @@ func (c *mgmtSvcClient) Join(ctx context.Context, in *JoinReq, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *mgmtSvcClient) JoinStream(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (MgmtSvc_JoinStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[0], "/mgmt.MgmtSvc/JoinStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcJoinStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_JoinStreamClient interface {
+	Recv() (*JoinProgress, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcJoinStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcJoinStreamClient) Recv() (*JoinProgress, error) {
+	m := new(JoinProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) Heartbeat(ctx context.Context, in *HeartbeatReq, opts ...grpc.CallOption) (*HeartbeatResp, error) {
+	out := new(HeartbeatResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) ClusterEvent(ctx context.Context, in *shared.ClusterEventReq, opts ...grpc.CallOption) (*shared.ClusterEventResp, error) {
 	out := new(shared.ClusterEventResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/ClusterEvent", in, out, opts...)
@@ -118,6 +202,38 @@ func (c *mgmtSvcClient) ClusterEvent(ctx context.Context, in *shared.ClusterEven
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemEventStream(ctx context.Context, in *SystemEventStreamReq, opts ...grpc.CallOption) (MgmtSvc_SystemEventStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[1], "/mgmt.MgmtSvc/SystemEventStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemEventStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SystemEventStreamClient interface {
+	Recv() (*shared.RASEvent, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemEventStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemEventStreamClient) Recv() (*shared.RASEvent, error) {
+	m := new(shared.RASEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *mgmtSvcClient) LeaderQuery(ctx context.Context, in *LeaderQueryReq, opts ...grpc.CallOption) (*LeaderQueryResp, error) {
 	out := new(LeaderQueryResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/LeaderQuery", in, out, opts...)
@@ -136,6 +252,15 @@ func (c *mgmtSvcClient) PoolCreate(ctx context.Context, in *PoolCreateReq, opts
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolCreateStatus(ctx context.Context, in *PoolCreateStatusReq, opts ...grpc.CallOption) (*PoolCreateStatusResp, error) {
+	out := new(PoolCreateStatusResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolCreateStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) PoolDestroy(ctx context.Context, in *PoolDestroyReq, opts ...grpc.CallOption) (*PoolDestroyResp, error) {
 	out := new(PoolDestroyResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolDestroy", in, out, opts...)
@@ -145,6 +270,15 @@ func (c *mgmtSvcClient) PoolDestroy(ctx context.Context, in *PoolDestroyReq, opt
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolCleanupPartial(ctx context.Context, in *PoolCleanupPartialReq, opts ...grpc.CallOption) (*PoolCleanupPartialResp, error) {
+	out := new(PoolCleanupPartialResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolCleanupPartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) PoolEvict(ctx context.Context, in *PoolEvictReq, opts ...grpc.CallOption) (*PoolEvictResp, error) {
 	out := new(PoolEvictResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolEvict", in, out, opts...)
@@ -199,6 +333,15 @@ func (c *mgmtSvcClient) PoolQuery(ctx context.Context, in *PoolQueryReq, opts ..
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolProbe(ctx context.Context, in *PoolProbeReq, opts ...grpc.CallOption) (*PoolProbeResp, error) {
+	out := new(PoolProbeResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolProbe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) PoolQueryTarget(ctx context.Context, in *PoolQueryTargetReq, opts ...grpc.CallOption) (*PoolQueryTargetResp, error) {
 	out := new(PoolQueryTargetResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolQueryTarget", in, out, opts...)
@@ -208,6 +351,24 @@ func (c *mgmtSvcClient) PoolQueryTarget(ctx context.Context, in *PoolQueryTarget
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolSetAdmins(ctx context.Context, in *PoolSetAdminsReq, opts ...grpc.CallOption) (*PoolSetAdminsResp, error) {
+	out := new(PoolSetAdminsResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolSetAdmins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolAnnotate(ctx context.Context, in *PoolAnnotateReq, opts ...grpc.CallOption) (*PoolAnnotateResp, error) {
+	out := new(PoolAnnotateResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolAnnotate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) PoolSetProp(ctx context.Context, in *PoolSetPropReq, opts ...grpc.CallOption) (*PoolSetPropResp, error) {
 	out := new(PoolSetPropResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolSetProp", in, out, opts...)
@@ -226,6 +387,24 @@ func (c *mgmtSvcClient) PoolGetProp(ctx context.Context, in *PoolGetPropReq, opt
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolSetPolicy(ctx context.Context, in *PoolSetPolicyReq, opts ...grpc.CallOption) (*PoolSetPolicyResp, error) {
+	out := new(PoolSetPolicyResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolSetPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolQueryAggregation(ctx context.Context, in *PoolQueryAggregationReq, opts ...grpc.CallOption) (*PoolQueryAggregationResp, error) {
+	out := new(PoolQueryAggregationResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolQueryAggregation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) PoolGetACL(ctx context.Context, in *GetACLReq, opts ...grpc.CallOption) (*ACLResp, error) {
 	out := new(ACLResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolGetACL", in, out, opts...)
@@ -298,6 +477,15 @@ func (c *mgmtSvcClient) ContSetOwner(ctx context.Context, in *ContSetOwnerReq, o
 	return out, nil
 }
 
+func (c *mgmtSvcClient) ContCheck(ctx context.Context, in *ContCheckReq, opts ...grpc.CallOption) (*ContCheckResp, error) {
+	out := new(ContCheckResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/ContCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) SystemQuery(ctx context.Context, in *SystemQueryReq, opts ...grpc.CallOption) (*SystemQueryResp, error) {
 	out := new(SystemQueryResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemQuery", in, out, opts...)
@@ -397,21 +585,122 @@ func (c *mgmtSvcClient) SystemGetProp(ctx context.Context, in *SystemGetPropReq,
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemDbVerify(ctx context.Context, in *SystemDbVerifyReq, opts ...grpc.CallOption) (*SystemDbVerifyResp, error) {
+	out := new(SystemDbVerifyResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemDbVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemDbBackup(ctx context.Context, in *SystemDbBackupReq, opts ...grpc.CallOption) (*SystemDbBackupResp, error) {
+	out := new(SystemDbBackupResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemDbBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemDbRestore(ctx context.Context, in *SystemDbRestoreReq, opts ...grpc.CallOption) (*SystemDbRestoreResp, error) {
+	out := new(SystemDbRestoreResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemDbRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemDbStatus(ctx context.Context, in *SystemDbStatusReq, opts ...grpc.CallOption) (*SystemDbStatusResp, error) {
+	out := new(SystemDbStatusResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemDbStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemSetMemberAlias(ctx context.Context, in *SystemSetMemberAliasReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemSetMemberAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemAnnotate(ctx context.Context, in *SystemAnnotateReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemAnnotate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemAddReplica(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error) {
+	out := new(SystemReplicaResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemAddReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemRemoveReplica(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicaResp, error) {
+	out := new(SystemReplicaResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemRemoveReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemSignCert(ctx context.Context, in *SystemSignCertReq, opts ...grpc.CallOption) (*SystemSignCertResp, error) {
+	out := new(SystemSignCertResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemSignCert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) Noop(ctx context.Context, in *NoopReq, opts ...grpc.CallOption) (*NoopResp, error) {
+	out := new(NoopResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/Noop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MgmtSvcServer is the server API for MgmtSvc service.
 // All implementations must embed UnimplementedMgmtSvcServer
 // for forward compatibility
 type MgmtSvcServer interface {
 	// Join the server described by JoinReq to the system.
 	Join(context.Context, *JoinReq) (*JoinResp, error)
+	// Join the server described by JoinReq to the system, streaming the
+	// progress of the request until it completes.
+	JoinStream(*JoinReq, MgmtSvc_JoinStreamServer) error
+	// Report harness and engine liveness to the MS leader.
+	Heartbeat(context.Context, *HeartbeatReq) (*HeartbeatResp, error)
 	// ClusterEvent notify MS of a RAS event in the cluster.
 	ClusterEvent(context.Context, *shared.ClusterEventReq) (*shared.ClusterEventResp, error)
+	// Stream the RAS events received by the MS leader until canceled.
+	SystemEventStream(*SystemEventStreamReq, MgmtSvc_SystemEventStreamServer) error
 	// LeaderQuery provides a mechanism for clients to discover
 	// the system's current Management Service leader
 	LeaderQuery(context.Context, *LeaderQueryReq) (*LeaderQueryResp, error)
 	// Create a DAOS pool allocated across a number of ranks
 	PoolCreate(context.Context, *PoolCreateReq) (*PoolCreateResp, error)
+	// Query or cancel an asynchronous DAOS pool create job.
+	PoolCreateStatus(context.Context, *PoolCreateStatusReq) (*PoolCreateStatusResp, error)
 	// Destroy a DAOS pool allocated across a number of ranks.
 	PoolDestroy(context.Context, *PoolDestroyReq) (*PoolDestroyResp, error)
+	// Destroy pools left incomplete by a failed create or destroy.
+	PoolCleanupPartial(context.Context, *PoolCleanupPartialReq) (*PoolCleanupPartialResp, error)
 	// Evict a DAOS pool's connections.
 	PoolEvict(context.Context, *PoolEvictReq) (*PoolEvictResp, error)
 	// Exclude a pool target.
@@ -424,12 +713,22 @@ type MgmtSvcServer interface {
 	PoolReintegrate(context.Context, *PoolReintegrateReq) (*PoolReintegrateResp, error)
 	// PoolQuery queries a DAOS pool.
 	PoolQuery(context.Context, *PoolQueryReq) (*PoolQueryResp, error)
+	// PoolProbe checks the health of a DAOS pool service.
+	PoolProbe(context.Context, *PoolProbeReq) (*PoolProbeResp, error)
 	// PoolQueryTarget queries a DAOS storage target.
 	PoolQueryTarget(context.Context, *PoolQueryTargetReq) (*PoolQueryTargetResp, error)
+	// Set the principals that may administer a DAOS pool.
+	PoolSetAdmins(context.Context, *PoolSetAdminsReq) (*PoolSetAdminsResp, error)
+	// Set or remove annotations on a DAOS pool.
+	PoolAnnotate(context.Context, *PoolAnnotateReq) (*PoolAnnotateResp, error)
 	// Set a DAOS pool property.
 	PoolSetProp(context.Context, *PoolSetPropReq) (*PoolSetPropResp, error)
 	// Get a DAOS pool property list.
 	PoolGetProp(context.Context, *PoolGetPropReq) (*PoolGetPropResp, error)
+	// Set runtime hints for the scheduling of DAOS pool background tasks.
+	PoolSetPolicy(context.Context, *PoolSetPolicyReq) (*PoolSetPolicyResp, error)
+	// Query the status of background aggregation on a DAOS pool's targets.
+	PoolQueryAggregation(context.Context, *PoolQueryAggregationReq) (*PoolQueryAggregationResp, error)
 	// Fetch the Access Control List for a DAOS pool.
 	PoolGetACL(context.Context, *GetACLReq) (*ACLResp, error)
 	// Overwrite the Access Control List for a DAOS pool with a new one.
@@ -446,6 +745,8 @@ type MgmtSvcServer interface {
 	ListContainers(context.Context, *ListContReq) (*ListContResp, error)
 	// Change the owner of a DAOS container
 	ContSetOwner(context.Context, *ContSetOwnerReq) (*ContSetOwnerResp, error)
+	// Check a pool's container metadata for orphans left by failed destroys.
+	ContCheck(context.Context, *ContCheckReq) (*ContCheckResp, error)
 	// Query DAOS system status
 	SystemQuery(context.Context, *SystemQueryReq) (*SystemQueryResp, error)
 	// Stop DAOS system (shutdown data-plane instances)
@@ -468,6 +769,26 @@ type MgmtSvcServer interface {
 	SystemSetProp(context.Context, *SystemSetPropReq) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error)
+	// Verify the integrity of the system database.
+	SystemDbVerify(context.Context, *SystemDbVerifyReq) (*SystemDbVerifyResp, error)
+	// Create a portable backup of the system database.
+	SystemDbBackup(context.Context, *SystemDbBackupReq) (*SystemDbBackupResp, error)
+	// Restore the system database from a backup.
+	SystemDbRestore(context.Context, *SystemDbRestoreReq) (*SystemDbRestoreResp, error)
+	// Query the status of the local system database replica.
+	SystemDbStatus(context.Context, *SystemDbStatusReq) (*SystemDbStatusResp, error)
+	// Set or clear the display alias of a system member.
+	SystemSetMemberAlias(context.Context, *SystemSetMemberAliasReq) (*DaosResp, error)
+	// Set or remove annotations on system members.
+	SystemAnnotate(context.Context, *SystemAnnotateReq) (*DaosResp, error)
+	// Add a control plane server to the set of MS replicas.
+	SystemAddReplica(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error)
+	// Remove a control plane server from the set of MS replicas.
+	SystemRemoveReplica(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error)
+	// Sign the certificate request of an enrolling host.
+	SystemSignCert(context.Context, *SystemSignCertReq) (*SystemSignCertResp, error)
+	// Perform no work, used to measure control-plane RPC overhead.
+	Noop(context.Context, *NoopReq) (*NoopResp, error)
 	mustEmbedUnimplementedMgmtSvcServer()
 }
 
@@ -478,18 +799,33 @@ type UnimplementedMgmtSvcServer struct {
 func (UnimplementedMgmtSvcServer) Join(context.Context, *JoinReq) (*JoinResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
 }
+func (UnimplementedMgmtSvcServer) JoinStream(*JoinReq, MgmtSvc_JoinStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method JoinStream not implemented")
+}
+func (UnimplementedMgmtSvcServer) Heartbeat(context.Context, *HeartbeatReq) (*HeartbeatResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
 func (UnimplementedMgmtSvcServer) ClusterEvent(context.Context, *shared.ClusterEventReq) (*shared.ClusterEventResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClusterEvent not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemEventStream(*SystemEventStreamReq, MgmtSvc_SystemEventStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemEventStream not implemented")
+}
 func (UnimplementedMgmtSvcServer) LeaderQuery(context.Context, *LeaderQueryReq) (*LeaderQueryResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaderQuery not implemented")
 }
 func (UnimplementedMgmtSvcServer) PoolCreate(context.Context, *PoolCreateReq) (*PoolCreateResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolCreate not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolCreateStatus(context.Context, *PoolCreateStatusReq) (*PoolCreateStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolCreateStatus not implemented")
+}
 func (UnimplementedMgmtSvcServer) PoolDestroy(context.Context, *PoolDestroyReq) (*PoolDestroyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolDestroy not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolCleanupPartial(context.Context, *PoolCleanupPartialReq) (*PoolCleanupPartialResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolCleanupPartial not implemented")
+}
 func (UnimplementedMgmtSvcServer) PoolEvict(context.Context, *PoolEvictReq) (*PoolEvictResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolEvict not implemented")
 }
@@ -508,15 +844,30 @@ func (UnimplementedMgmtSvcServer) PoolReintegrate(context.Context, *PoolReintegr
 func (UnimplementedMgmtSvcServer) PoolQuery(context.Context, *PoolQueryReq) (*PoolQueryResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolQuery not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolProbe(context.Context, *PoolProbeReq) (*PoolProbeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolProbe not implemented")
+}
 func (UnimplementedMgmtSvcServer) PoolQueryTarget(context.Context, *PoolQueryTargetReq) (*PoolQueryTargetResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolQueryTarget not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolSetAdmins(context.Context, *PoolSetAdminsReq) (*PoolSetAdminsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolSetAdmins not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolAnnotate(context.Context, *PoolAnnotateReq) (*PoolAnnotateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAnnotate not implemented")
+}
 func (UnimplementedMgmtSvcServer) PoolSetProp(context.Context, *PoolSetPropReq) (*PoolSetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolSetProp not implemented")
 }
 func (UnimplementedMgmtSvcServer) PoolGetProp(context.Context, *PoolGetPropReq) (*PoolGetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolGetProp not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolSetPolicy(context.Context, *PoolSetPolicyReq) (*PoolSetPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolSetPolicy not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolQueryAggregation(context.Context, *PoolQueryAggregationReq) (*PoolQueryAggregationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolQueryAggregation not implemented")
+}
 func (UnimplementedMgmtSvcServer) PoolGetACL(context.Context, *GetACLReq) (*ACLResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolGetACL not implemented")
 }
@@ -541,6 +892,9 @@ func (UnimplementedMgmtSvcServer) ListContainers(context.Context, *ListContReq)
 func (UnimplementedMgmtSvcServer) ContSetOwner(context.Context, *ContSetOwnerReq) (*ContSetOwnerResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContSetOwner not implemented")
 }
+func (UnimplementedMgmtSvcServer) ContCheck(context.Context, *ContCheckReq) (*ContCheckResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContCheck not implemented")
+}
 func (UnimplementedMgmtSvcServer) SystemQuery(context.Context, *SystemQueryReq) (*SystemQueryResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemQuery not implemented")
 }
@@ -574,6 +928,36 @@ func (UnimplementedMgmtSvcServer) SystemSetProp(context.Context, *SystemSetPropR
 func (UnimplementedMgmtSvcServer) SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemGetProp not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemDbVerify(context.Context, *SystemDbVerifyReq) (*SystemDbVerifyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemDbVerify not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemDbBackup(context.Context, *SystemDbBackupReq) (*SystemDbBackupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemDbBackup not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemDbRestore(context.Context, *SystemDbRestoreReq) (*SystemDbRestoreResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemDbRestore not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemDbStatus(context.Context, *SystemDbStatusReq) (*SystemDbStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemDbStatus not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemSetMemberAlias(context.Context, *SystemSetMemberAliasReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemSetMemberAlias not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemAnnotate(context.Context, *SystemAnnotateReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemAnnotate not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemAddReplica(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemAddReplica not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemRemoveReplica(context.Context, *SystemReplicaReq) (*SystemReplicaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemRemoveReplica not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemSignCert(context.Context, *SystemSignCertReq) (*SystemSignCertResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemSignCert not implemented")
+}
+func (UnimplementedMgmtSvcServer) Noop(context.Context, *NoopReq) (*NoopResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Noop not implemented")
+}
 func (UnimplementedMgmtSvcServer) mustEmbedUnimplementedMgmtSvcServer() {}
 
 // UnsafeMgmtSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -605,6 +989,45 @@ func _MgmtSvc_Join_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_JoinStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JoinReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).JoinStream(m, &mgmtSvcJoinStreamServer{stream})
+}
+
+type MgmtSvc_JoinStreamServer interface {
+	Send(*JoinProgress) error
+	grpc.ServerStream
+}
+
+type mgmtSvcJoinStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcJoinStreamServer) Send(m *JoinProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MgmtSvc_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).Heartbeat(ctx, req.(*HeartbeatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_ClusterEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(shared.ClusterEventReq)
 	if err := dec(in); err != nil {
@@ -623,6 +1046,27 @@ func _MgmtSvc_ClusterEvent_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_SystemEventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemEventStreamReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemEventStream(m, &mgmtSvcSystemEventStreamServer{stream})
+}
+
+type MgmtSvc_SystemEventStreamServer interface {
+	Send(*shared.RASEvent) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemEventStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemEventStreamServer) Send(m *shared.RASEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MgmtSvc_LeaderQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LeaderQueryReq)
 	if err := dec(in); err != nil {
@@ -659,6 +1103,24 @@ func _MgmtSvc_PoolCreate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolCreateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolCreateStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolCreateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolCreateStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolCreateStatus(ctx, req.(*PoolCreateStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_PoolDestroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PoolDestroyReq)
 	if err := dec(in); err != nil {
@@ -677,6 +1139,24 @@ func _MgmtSvc_PoolDestroy_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolCleanupPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolCleanupPartialReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolCleanupPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolCleanupPartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolCleanupPartial(ctx, req.(*PoolCleanupPartialReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_PoolEvict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PoolEvictReq)
 	if err := dec(in); err != nil {
@@ -785,6 +1265,24 @@ func _MgmtSvc_PoolQuery_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolProbeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolProbe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolProbe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolProbe(ctx, req.(*PoolProbeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_PoolQueryTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PoolQueryTargetReq)
 	if err := dec(in); err != nil {
@@ -803,6 +1301,42 @@ func _MgmtSvc_PoolQueryTarget_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolSetAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolSetAdminsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolSetAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolSetAdmins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolSetAdmins(ctx, req.(*PoolSetAdminsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolAnnotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolAnnotateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolAnnotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolAnnotate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolAnnotate(ctx, req.(*PoolAnnotateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_PoolSetProp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PoolSetPropReq)
 	if err := dec(in); err != nil {
@@ -839,6 +1373,42 @@ func _MgmtSvc_PoolGetProp_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolSetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolSetPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolSetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolSetPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolSetPolicy(ctx, req.(*PoolSetPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolQueryAggregation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolQueryAggregationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolQueryAggregation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolQueryAggregation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolQueryAggregation(ctx, req.(*PoolQueryAggregationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_PoolGetACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetACLReq)
 	if err := dec(in); err != nil {
@@ -983,6 +1553,24 @@ func _MgmtSvc_ContSetOwner_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_ContCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContCheckReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).ContCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/ContCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).ContCheck(ctx, req.(*ContCheckReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_SystemQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SystemQueryReq)
 	if err := dec(in); err != nil {
@@ -1181,6 +1769,186 @@ func _MgmtSvc_SystemGetProp_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_SystemDbVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDbVerifyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemDbVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemDbVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemDbVerify(ctx, req.(*SystemDbVerifyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemDbBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDbBackupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemDbBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemDbBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemDbBackup(ctx, req.(*SystemDbBackupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemDbRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDbRestoreReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemDbRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemDbRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemDbRestore(ctx, req.(*SystemDbRestoreReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemDbStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDbStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemDbStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemDbStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemDbStatus(ctx, req.(*SystemDbStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemSetMemberAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSetMemberAliasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemSetMemberAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemSetMemberAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemSetMemberAlias(ctx, req.(*SystemSetMemberAliasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemAnnotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemAnnotateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemAnnotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemAnnotate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemAnnotate(ctx, req.(*SystemAnnotateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemAddReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemAddReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemAddReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemAddReplica(ctx, req.(*SystemReplicaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemRemoveReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemRemoveReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemRemoveReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemRemoveReplica(ctx, req.(*SystemReplicaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemSignCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSignCertReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemSignCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemSignCert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemSignCert(ctx, req.(*SystemSignCertReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_Noop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoopReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).Noop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/Noop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).Noop(ctx, req.(*NoopReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MgmtSvc_ServiceDesc is the grpc.ServiceDesc for MgmtSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1192,6 +1960,10 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Join",
 			Handler:    _MgmtSvc_Join_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _MgmtSvc_Heartbeat_Handler,
+		},
 		{
 			MethodName: "ClusterEvent",
 			Handler:    _MgmtSvc_ClusterEvent_Handler,
@@ -1204,10 +1976,18 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolCreate",
 			Handler:    _MgmtSvc_PoolCreate_Handler,
 		},
+		{
+			MethodName: "PoolCreateStatus",
+			Handler:    _MgmtSvc_PoolCreateStatus_Handler,
+		},
 		{
 			MethodName: "PoolDestroy",
 			Handler:    _MgmtSvc_PoolDestroy_Handler,
 		},
+		{
+			MethodName: "PoolCleanupPartial",
+			Handler:    _MgmtSvc_PoolCleanupPartial_Handler,
+		},
 		{
 			MethodName: "PoolEvict",
 			Handler:    _MgmtSvc_PoolEvict_Handler,
@@ -1232,10 +2012,22 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolQuery",
 			Handler:    _MgmtSvc_PoolQuery_Handler,
 		},
+		{
+			MethodName: "PoolProbe",
+			Handler:    _MgmtSvc_PoolProbe_Handler,
+		},
 		{
 			MethodName: "PoolQueryTarget",
 			Handler:    _MgmtSvc_PoolQueryTarget_Handler,
 		},
+		{
+			MethodName: "PoolSetAdmins",
+			Handler:    _MgmtSvc_PoolSetAdmins_Handler,
+		},
+		{
+			MethodName: "PoolAnnotate",
+			Handler:    _MgmtSvc_PoolAnnotate_Handler,
+		},
 		{
 			MethodName: "PoolSetProp",
 			Handler:    _MgmtSvc_PoolSetProp_Handler,
@@ -1244,6 +2036,14 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolGetProp",
 			Handler:    _MgmtSvc_PoolGetProp_Handler,
 		},
+		{
+			MethodName: "PoolSetPolicy",
+			Handler:    _MgmtSvc_PoolSetPolicy_Handler,
+		},
+		{
+			MethodName: "PoolQueryAggregation",
+			Handler:    _MgmtSvc_PoolQueryAggregation_Handler,
+		},
 		{
 			MethodName: "PoolGetACL",
 			Handler:    _MgmtSvc_PoolGetACL_Handler,
@@ -1276,6 +2076,10 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ContSetOwner",
 			Handler:    _MgmtSvc_ContSetOwner_Handler,
 		},
+		{
+			MethodName: "ContCheck",
+			Handler:    _MgmtSvc_ContCheck_Handler,
+		},
 		{
 			MethodName: "SystemQuery",
 			Handler:    _MgmtSvc_SystemQuery_Handler,
@@ -1320,7 +2124,58 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemGetProp",
 			Handler:    _MgmtSvc_SystemGetProp_Handler,
 		},
+		{
+			MethodName: "SystemDbVerify",
+			Handler:    _MgmtSvc_SystemDbVerify_Handler,
+		},
+		{
+			MethodName: "SystemDbBackup",
+			Handler:    _MgmtSvc_SystemDbBackup_Handler,
+		},
+		{
+			MethodName: "SystemDbRestore",
+			Handler:    _MgmtSvc_SystemDbRestore_Handler,
+		},
+		{
+			MethodName: "SystemDbStatus",
+			Handler:    _MgmtSvc_SystemDbStatus_Handler,
+		},
+		{
+			MethodName: "SystemSetMemberAlias",
+			Handler:    _MgmtSvc_SystemSetMemberAlias_Handler,
+		},
+		{
+			MethodName: "SystemAnnotate",
+			Handler:    _MgmtSvc_SystemAnnotate_Handler,
+		},
+		{
+			MethodName: "SystemAddReplica",
+			Handler:    _MgmtSvc_SystemAddReplica_Handler,
+		},
+		{
+			MethodName: "SystemRemoveReplica",
+			Handler:    _MgmtSvc_SystemRemoveReplica_Handler,
+		},
+		{
+			MethodName: "SystemSignCert",
+			Handler:    _MgmtSvc_SystemSignCert_Handler,
+		},
+		{
+			MethodName: "Noop",
+			Handler:    _MgmtSvc_Noop_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "JoinStream",
+			Handler:       _MgmtSvc_JoinStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SystemEventStream",
+			Handler:       _MgmtSvc_SystemEventStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mgmt/mgmt.proto",
 }
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2019-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -72,6 +72,58 @@ func (StorageMediaType) EnumDescriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{0}
 }
 
+type PoolCreateStatusResp_State int32
+
+const (
+	PoolCreateStatusResp_RUNNING   PoolCreateStatusResp_State = 0 // create in progress
+	PoolCreateStatusResp_COMPLETED PoolCreateStatusResp_State = 1 // create finished successfully
+	PoolCreateStatusResp_FAILED    PoolCreateStatusResp_State = 2 // create finished with an error
+	PoolCreateStatusResp_CANCELED  PoolCreateStatusResp_State = 3 // create canceled and rolled back
+)
+
+// Enum value maps for PoolCreateStatusResp_State.
+var (
+	PoolCreateStatusResp_State_name = map[int32]string{
+		0: "RUNNING",
+		1: "COMPLETED",
+		2: "FAILED",
+		3: "CANCELED",
+	}
+	PoolCreateStatusResp_State_value = map[string]int32{
+		"RUNNING":   0,
+		"COMPLETED": 1,
+		"FAILED":    2,
+		"CANCELED":  3,
+	}
+)
+
+func (x PoolCreateStatusResp_State) Enum() *PoolCreateStatusResp_State {
+	p := new(PoolCreateStatusResp_State)
+	*p = x
+	return p
+}
+
+func (x PoolCreateStatusResp_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PoolCreateStatusResp_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_pool_proto_enumTypes[1].Descriptor()
+}
+
+func (PoolCreateStatusResp_State) Type() protoreflect.EnumType {
+	return &file_mgmt_pool_proto_enumTypes[1]
+}
+
+func (x PoolCreateStatusResp_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PoolCreateStatusResp_State.Descriptor instead.
+func (PoolCreateStatusResp_State) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{3, 0}
+}
+
 type PoolRebuildStatus_State int32
 
 const (
@@ -105,11 +157,11 @@ func (x PoolRebuildStatus_State) String() string {
 }
 
 func (PoolRebuildStatus_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_mgmt_pool_proto_enumTypes[1].Descriptor()
+	return file_mgmt_pool_proto_enumTypes[2].Descriptor()
 }
 
 func (PoolRebuildStatus_State) Type() protoreflect.EnumType {
-	return &file_mgmt_pool_proto_enumTypes[1]
+	return &file_mgmt_pool_proto_enumTypes[2]
 }
 
 func (x PoolRebuildStatus_State) Number() protoreflect.EnumNumber {
@@ -118,7 +170,56 @@ func (x PoolRebuildStatus_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PoolRebuildStatus_State.Descriptor instead.
 func (PoolRebuildStatus_State) EnumDescriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{20, 0}
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type PoolSetPolicyReq_Aggregation int32
+
+const (
+	PoolSetPolicyReq_UNCHANGED PoolSetPolicyReq_Aggregation = 0
+	PoolSetPolicyReq_ENABLED   PoolSetPolicyReq_Aggregation = 1 // Run aggregation as configured by the reclaim property
+	PoolSetPolicyReq_DISABLED  PoolSetPolicyReq_Aggregation = 2 // Pause aggregation
+)
+
+// Enum value maps for PoolSetPolicyReq_Aggregation.
+var (
+	PoolSetPolicyReq_Aggregation_name = map[int32]string{
+		0: "UNCHANGED",
+		1: "ENABLED",
+		2: "DISABLED",
+	}
+	PoolSetPolicyReq_Aggregation_value = map[string]int32{
+		"UNCHANGED": 0,
+		"ENABLED":   1,
+		"DISABLED":  2,
+	}
+)
+
+func (x PoolSetPolicyReq_Aggregation) Enum() *PoolSetPolicyReq_Aggregation {
+	p := new(PoolSetPolicyReq_Aggregation)
+	*p = x
+	return p
+}
+
+func (x PoolSetPolicyReq_Aggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PoolSetPolicyReq_Aggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_pool_proto_enumTypes[3].Descriptor()
+}
+
+func (PoolSetPolicyReq_Aggregation) Type() protoreflect.EnumType {
+	return &file_mgmt_pool_proto_enumTypes[3]
+}
+
+func (x PoolSetPolicyReq_Aggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PoolSetPolicyReq_Aggregation.Descriptor instead.
+func (PoolSetPolicyReq_Aggregation) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{39, 0}
 }
 
 type PoolQueryTargetInfo_TargetType int32
@@ -160,11 +261,11 @@ func (x PoolQueryTargetInfo_TargetType) String() string {
 }
 
 func (PoolQueryTargetInfo_TargetType) Descriptor() protoreflect.EnumDescriptor {
-	return file_mgmt_pool_proto_enumTypes[2].Descriptor()
+	return file_mgmt_pool_proto_enumTypes[4].Descriptor()
 }
 
 func (PoolQueryTargetInfo_TargetType) Type() protoreflect.EnumType {
-	return &file_mgmt_pool_proto_enumTypes[2]
+	return &file_mgmt_pool_proto_enumTypes[4]
 }
 
 func (x PoolQueryTargetInfo_TargetType) Number() protoreflect.EnumNumber {
@@ -173,7 +274,7 @@ func (x PoolQueryTargetInfo_TargetType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PoolQueryTargetInfo_TargetType.Descriptor instead.
 func (PoolQueryTargetInfo_TargetType) EnumDescriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{31, 0}
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{45, 0}
 }
 
 type PoolQueryTargetInfo_TargetState int32
@@ -221,11 +322,11 @@ func (x PoolQueryTargetInfo_TargetState) String() string {
 }
 
 func (PoolQueryTargetInfo_TargetState) Descriptor() protoreflect.EnumDescriptor {
-	return file_mgmt_pool_proto_enumTypes[3].Descriptor()
+	return file_mgmt_pool_proto_enumTypes[5].Descriptor()
 }
 
 func (PoolQueryTargetInfo_TargetState) Type() protoreflect.EnumType {
-	return &file_mgmt_pool_proto_enumTypes[3]
+	return &file_mgmt_pool_proto_enumTypes[5]
 }
 
 func (x PoolQueryTargetInfo_TargetState) Number() protoreflect.EnumNumber {
@@ -234,7 +335,7 @@ func (x PoolQueryTargetInfo_TargetState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PoolQueryTargetInfo_TargetState.Descriptor instead.
 func (PoolQueryTargetInfo_TargetState) EnumDescriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{31, 1}
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{45, 1}
 }
 
 // PoolCreateReq supplies new pool parameters.
@@ -260,6 +361,7 @@ type PoolCreateReq struct {
 	Numranks     uint32    `protobuf:"varint,11,opt,name=numranks,proto3" json:"numranks,omitempty"`               // Number of target ranks to use (auto config)
 	Ranks        []uint32  `protobuf:"varint,12,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`              // target ranks (manual config)
 	Tierbytes    []uint64  `protobuf:"varint,13,rep,packed,name=tierbytes,proto3" json:"tierbytes,omitempty"`      // Size in bytes of storage tiers (manual config)
+	Async        bool      `protobuf:"varint,14,opt,name=async,proto3" json:"async,omitempty"`                     // return a job ID immediately rather than waiting for completion
 }
 
 func (x *PoolCreateReq) Reset() {
@@ -385,6 +487,13 @@ func (x *PoolCreateReq) GetTierbytes() []uint64 {
 	return nil
 }
 
+func (x *PoolCreateReq) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
 // PoolCreateResp returns created pool uuid and ranks.
 type PoolCreateResp struct {
 	state         protoimpl.MessageState
@@ -396,6 +505,7 @@ type PoolCreateResp struct {
 	SvcReps   []uint32 `protobuf:"varint,3,rep,packed,name=svc_reps,json=svcReps,proto3" json:"svc_reps,omitempty"`       // pool service replica ranks
 	TgtRanks  []uint32 `protobuf:"varint,4,rep,packed,name=tgt_ranks,json=tgtRanks,proto3" json:"tgt_ranks,omitempty"`    // pool target ranks
 	TierBytes []uint64 `protobuf:"varint,5,rep,packed,name=tier_bytes,json=tierBytes,proto3" json:"tier_bytes,omitempty"` // storage tiers allocated to pool
+	JobId     string   `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                     // ID of asynchronous create job
 }
 
 func (x *PoolCreateResp) Reset() {
@@ -465,21 +575,27 @@ func (x *PoolCreateResp) GetTierBytes() []uint64 {
 	return nil
 }
 
-// PoolDestroyReq supplies pool identifier and force flag.
-type PoolDestroyReq struct {
+func (x *PoolCreateResp) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// PoolCreateStatusReq queries the progress of an asynchronous pool create
+// job, optionally requesting that it be canceled.
+type PoolCreateStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys       string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
-	Id        string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to destroy
-	Force     bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                              // destroy regardless of active connections
-	SvcRanks  []uint32 `protobuf:"varint,4,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
-	Recursive bool     `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`                      // destroy regardless of any child containers
+	Sys    string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                  // DAOS system identifier
+	JobId  string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // ID of asynchronous create job
+	Cancel bool   `protobuf:"varint,3,opt,name=cancel,proto3" json:"cancel,omitempty"`           // cancel the job if it has not completed
 }
 
-func (x *PoolDestroyReq) Reset() {
-	*x = PoolDestroyReq{}
+func (x *PoolCreateStatusReq) Reset() {
+	*x = PoolCreateStatusReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -487,13 +603,13 @@ func (x *PoolDestroyReq) Reset() {
 	}
 }
 
-func (x *PoolDestroyReq) String() string {
+func (x *PoolCreateStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolDestroyReq) ProtoMessage() {}
+func (*PoolCreateStatusReq) ProtoMessage() {}
 
-func (x *PoolDestroyReq) ProtoReflect() protoreflect.Message {
+func (x *PoolCreateStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,57 +621,50 @@ func (x *PoolDestroyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolDestroyReq.ProtoReflect.Descriptor instead.
-func (*PoolDestroyReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolCreateStatusReq.ProtoReflect.Descriptor instead.
+func (*PoolCreateStatusReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PoolDestroyReq) GetSys() string {
+func (x *PoolCreateStatusReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolDestroyReq) GetId() string {
+func (x *PoolCreateStatusReq) GetJobId() string {
 	if x != nil {
-		return x.Id
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *PoolDestroyReq) GetForce() bool {
-	if x != nil {
-		return x.Force
-	}
-	return false
-}
-
-func (x *PoolDestroyReq) GetSvcRanks() []uint32 {
-	if x != nil {
-		return x.SvcRanks
-	}
-	return nil
-}
-
-func (x *PoolDestroyReq) GetRecursive() bool {
+func (x *PoolCreateStatusReq) GetCancel() bool {
 	if x != nil {
-		return x.Recursive
+		return x.Cancel
 	}
 	return false
 }
 
-// PoolDestroyResp returns resultant state of destroy operation.
-type PoolDestroyResp struct {
+// PoolCreateStatusResp returns the progress of an asynchronous pool create job.
+type PoolCreateStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	State           PoolCreateStatusResp_State   `protobuf:"varint,1,opt,name=state,proto3,enum=mgmt.PoolCreateStatusResp_State" json:"state,omitempty"`       // overall job state
+	JobId           string                       `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                                // ID of asynchronous create job
+	PoolUuid        string                       `protobuf:"bytes,3,opt,name=pool_uuid,json=poolUuid,proto3" json:"pool_uuid,omitempty"`                       // uuid of pool being created
+	Stage           string                       `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`                                             // current stage of the create
+	Ranks           []*PoolCreateStatusResp_Rank `protobuf:"bytes,5,rep,name=ranks,proto3" json:"ranks,omitempty"`                                             // progress of each target rank
+	Error           string                       `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                                             // reason for failure
+	Result          *PoolCreateResp              `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`                                           // create result, once completed
+	CancelRequested bool                         `protobuf:"varint,8,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"` // cancellation requested but not yet complete
 }
 
-func (x *PoolDestroyResp) Reset() {
-	*x = PoolDestroyResp{}
+func (x *PoolCreateStatusResp) Reset() {
+	*x = PoolCreateStatusResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -563,13 +672,13 @@ func (x *PoolDestroyResp) Reset() {
 	}
 }
 
-func (x *PoolDestroyResp) String() string {
+func (x *PoolCreateStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolDestroyResp) ProtoMessage() {}
+func (*PoolCreateStatusResp) ProtoMessage() {}
 
-func (x *PoolDestroyResp) ProtoReflect() protoreflect.Message {
+func (x *PoolCreateStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -581,35 +690,82 @@ func (x *PoolDestroyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolDestroyResp.ProtoReflect.Descriptor instead.
-func (*PoolDestroyResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolCreateStatusResp.ProtoReflect.Descriptor instead.
+func (*PoolCreateStatusResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PoolDestroyResp) GetStatus() int32 {
+func (x *PoolCreateStatusResp) GetState() PoolCreateStatusResp_State {
 	if x != nil {
-		return x.Status
+		return x.State
 	}
-	return 0
+	return PoolCreateStatusResp_RUNNING
 }
 
-// PoolEvictReq supplies pool identifier.
-type PoolEvictReq struct {
+func (x *PoolCreateStatusResp) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *PoolCreateStatusResp) GetPoolUuid() string {
+	if x != nil {
+		return x.PoolUuid
+	}
+	return ""
+}
+
+func (x *PoolCreateStatusResp) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *PoolCreateStatusResp) GetRanks() []*PoolCreateStatusResp_Rank {
+	if x != nil {
+		return x.Ranks
+	}
+	return nil
+}
+
+func (x *PoolCreateStatusResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PoolCreateStatusResp) GetResult() *PoolCreateResp {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *PoolCreateStatusResp) GetCancelRequested() bool {
+	if x != nil {
+		return x.CancelRequested
+	}
+	return false
+}
+
+// PoolDestroyReq supplies pool identifier and force flag.
+type PoolDestroyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys          string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                        // DAOS system identifier
-	Id           string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                          // uuid or label of pool to evict
-	SvcRanks     []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`      // List of pool service ranks
-	Handles      []string `protobuf:"bytes,4,rep,name=handles,proto3" json:"handles,omitempty"`                                // Optional list of handles to evict (Mutually exclusive with destroy/force_destroy)
-	Destroy      bool     `protobuf:"varint,5,opt,name=destroy,proto3" json:"destroy,omitempty"`                               // If true, evict is first step of a pool destroy operation
-	ForceDestroy bool     `protobuf:"varint,6,opt,name=force_destroy,json=forceDestroy,proto3" json:"force_destroy,omitempty"` // If true and destroy=true, forcibly closes open pool handles
-	Machine      string   `protobuf:"bytes,7,opt,name=machine,proto3" json:"machine,omitempty"`                                // Optional machine name to evict handles for (Mutually exclusive with handles)
+	Sys       string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
+	Id        string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to destroy
+	Force     bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                              // destroy regardless of active connections
+	SvcRanks  []uint32 `protobuf:"varint,4,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Recursive bool     `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`                      // destroy regardless of any child containers
 }
 
-func (x *PoolEvictReq) Reset() {
-	*x = PoolEvictReq{}
+func (x *PoolDestroyReq) Reset() {
+	*x = PoolDestroyReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,13 +773,13 @@ func (x *PoolEvictReq) Reset() {
 	}
 }
 
-func (x *PoolEvictReq) String() string {
+func (x *PoolDestroyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolEvictReq) ProtoMessage() {}
+func (*PoolDestroyReq) ProtoMessage() {}
 
-func (x *PoolEvictReq) ProtoReflect() protoreflect.Message {
+func (x *PoolDestroyReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -635,72 +791,57 @@ func (x *PoolEvictReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolEvictReq.ProtoReflect.Descriptor instead.
-func (*PoolEvictReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolDestroyReq.ProtoReflect.Descriptor instead.
+func (*PoolDestroyReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PoolEvictReq) GetSys() string {
+func (x *PoolDestroyReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolEvictReq) GetId() string {
+func (x *PoolDestroyReq) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PoolEvictReq) GetSvcRanks() []uint32 {
+func (x *PoolDestroyReq) GetForce() bool {
 	if x != nil {
-		return x.SvcRanks
+		return x.Force
 	}
-	return nil
+	return false
 }
 
-func (x *PoolEvictReq) GetHandles() []string {
+func (x *PoolDestroyReq) GetSvcRanks() []uint32 {
 	if x != nil {
-		return x.Handles
+		return x.SvcRanks
 	}
 	return nil
 }
 
-func (x *PoolEvictReq) GetDestroy() bool {
-	if x != nil {
-		return x.Destroy
-	}
-	return false
-}
-
-func (x *PoolEvictReq) GetForceDestroy() bool {
+func (x *PoolDestroyReq) GetRecursive() bool {
 	if x != nil {
-		return x.ForceDestroy
+		return x.Recursive
 	}
 	return false
 }
 
-func (x *PoolEvictReq) GetMachine() string {
-	if x != nil {
-		return x.Machine
-	}
-	return ""
-}
-
-// PoolEvictResp returns resultant state of evict operation.
-type PoolEvictResp struct {
+// PoolDestroyResp returns resultant state of destroy operation.
+type PoolDestroyResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
-	Count  int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`   // Count of handles evicted
 }
 
-func (x *PoolEvictResp) Reset() {
-	*x = PoolEvictResp{}
+func (x *PoolDestroyResp) Reset() {
+	*x = PoolDestroyResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -708,13 +849,13 @@ func (x *PoolEvictResp) Reset() {
 	}
 }
 
-func (x *PoolEvictResp) String() string {
+func (x *PoolDestroyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolEvictResp) ProtoMessage() {}
+func (*PoolDestroyResp) ProtoMessage() {}
 
-func (x *PoolEvictResp) ProtoReflect() protoreflect.Message {
+func (x *PoolDestroyResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -726,40 +867,31 @@ func (x *PoolEvictResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolEvictResp.ProtoReflect.Descriptor instead.
-func (*PoolEvictResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolDestroyResp.ProtoReflect.Descriptor instead.
+func (*PoolDestroyResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PoolEvictResp) GetStatus() int32 {
+func (x *PoolDestroyResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *PoolEvictResp) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-// PoolExcludeReq supplies pool identifier, rank, and target_idxs.
-type PoolExcludeReq struct {
+// PoolCleanupPartialReq requests removal of pools left incomplete by a
+// failed create or destroy.
+type PoolCleanupPartialReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys       string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
-	Id        string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to exclude some targets
-	Rank      uint32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                // target to move to the down state
-	Targetidx []uint32 `protobuf:"varint,4,rep,packed,name=targetidx,proto3" json:"targetidx,omitempty"`               // target ranks
-	SvcRanks  []uint32 `protobuf:"varint,5,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Sys    string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                      // DAOS system identifier
+	DryRun bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // only report the pools that would be cleaned up
 }
 
-func (x *PoolExcludeReq) Reset() {
-	*x = PoolExcludeReq{}
+func (x *PoolCleanupPartialReq) Reset() {
+	*x = PoolCleanupPartialReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -767,13 +899,13 @@ func (x *PoolExcludeReq) Reset() {
 	}
 }
 
-func (x *PoolExcludeReq) String() string {
+func (x *PoolCleanupPartialReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolExcludeReq) ProtoMessage() {}
+func (*PoolCleanupPartialReq) ProtoMessage() {}
 
-func (x *PoolExcludeReq) ProtoReflect() protoreflect.Message {
+func (x *PoolCleanupPartialReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,57 +917,37 @@ func (x *PoolExcludeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolExcludeReq.ProtoReflect.Descriptor instead.
-func (*PoolExcludeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolCleanupPartialReq.ProtoReflect.Descriptor instead.
+func (*PoolCleanupPartialReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PoolExcludeReq) GetSys() string {
+func (x *PoolCleanupPartialReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolExcludeReq) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *PoolExcludeReq) GetRank() uint32 {
-	if x != nil {
-		return x.Rank
-	}
-	return 0
-}
-
-func (x *PoolExcludeReq) GetTargetidx() []uint32 {
-	if x != nil {
-		return x.Targetidx
-	}
-	return nil
-}
-
-func (x *PoolExcludeReq) GetSvcRanks() []uint32 {
+func (x *PoolCleanupPartialReq) GetDryRun() bool {
 	if x != nil {
-		return x.SvcRanks
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-// PoolExcludeResp returns resultant state of Exclude operation.
-type PoolExcludeResp struct {
+// PoolCleanupPartialResp returns the results of a partial pool cleanup.
+type PoolCleanupPartialResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Status int32                          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Pools  []*PoolCleanupPartialResp_Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`    // pools not in the Ready state
 }
 
-func (x *PoolExcludeResp) Reset() {
-	*x = PoolExcludeResp{}
+func (x *PoolCleanupPartialResp) Reset() {
+	*x = PoolCleanupPartialResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -843,13 +955,13 @@ func (x *PoolExcludeResp) Reset() {
 	}
 }
 
-func (x *PoolExcludeResp) String() string {
+func (x *PoolCleanupPartialResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolExcludeResp) ProtoMessage() {}
+func (*PoolCleanupPartialResp) ProtoMessage() {}
 
-func (x *PoolExcludeResp) ProtoReflect() protoreflect.Message {
+func (x *PoolCleanupPartialResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -861,33 +973,42 @@ func (x *PoolExcludeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolExcludeResp.ProtoReflect.Descriptor instead.
-func (*PoolExcludeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolCleanupPartialResp.ProtoReflect.Descriptor instead.
+func (*PoolCleanupPartialResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PoolExcludeResp) GetStatus() int32 {
+func (x *PoolCleanupPartialResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-// PoolDrainReq supplies pool identifier, rank, and target_idxs.
-type PoolDrainReq struct {
+func (x *PoolCleanupPartialResp) GetPools() []*PoolCleanupPartialResp_Pool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+// PoolEvictReq supplies pool identifier.
+type PoolEvictReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys       string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
-	Id        string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to drain some targets
-	Rank      uint32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                // rank to move to the down state
-	Targetidx []uint32 `protobuf:"varint,4,rep,packed,name=targetidx,proto3" json:"targetidx,omitempty"`               // rank targets
-	SvcRanks  []uint32 `protobuf:"varint,5,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Sys          string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                        // DAOS system identifier
+	Id           string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                          // uuid or label of pool to evict
+	SvcRanks     []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`      // List of pool service ranks
+	Handles      []string `protobuf:"bytes,4,rep,name=handles,proto3" json:"handles,omitempty"`                                // Optional list of handles to evict (Mutually exclusive with destroy/force_destroy)
+	Destroy      bool     `protobuf:"varint,5,opt,name=destroy,proto3" json:"destroy,omitempty"`                               // If true, evict is first step of a pool destroy operation
+	ForceDestroy bool     `protobuf:"varint,6,opt,name=force_destroy,json=forceDestroy,proto3" json:"force_destroy,omitempty"` // If true and destroy=true, forcibly closes open pool handles
+	Machine      string   `protobuf:"bytes,7,opt,name=machine,proto3" json:"machine,omitempty"`                                // Optional machine name to evict handles for (Mutually exclusive with handles)
 }
 
-func (x *PoolDrainReq) Reset() {
-	*x = PoolDrainReq{}
+func (x *PoolEvictReq) Reset() {
+	*x = PoolEvictReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -895,13 +1016,13 @@ func (x *PoolDrainReq) Reset() {
 	}
 }
 
-func (x *PoolDrainReq) String() string {
+func (x *PoolEvictReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolDrainReq) ProtoMessage() {}
+func (*PoolEvictReq) ProtoMessage() {}
 
-func (x *PoolDrainReq) ProtoReflect() protoreflect.Message {
+func (x *PoolEvictReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -913,57 +1034,72 @@ func (x *PoolDrainReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolDrainReq.ProtoReflect.Descriptor instead.
-func (*PoolDrainReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolEvictReq.ProtoReflect.Descriptor instead.
+func (*PoolEvictReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PoolDrainReq) GetSys() string {
+func (x *PoolEvictReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolDrainReq) GetId() string {
+func (x *PoolEvictReq) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PoolDrainReq) GetRank() uint32 {
+func (x *PoolEvictReq) GetSvcRanks() []uint32 {
 	if x != nil {
-		return x.Rank
+		return x.SvcRanks
 	}
-	return 0
+	return nil
 }
 
-func (x *PoolDrainReq) GetTargetidx() []uint32 {
+func (x *PoolEvictReq) GetHandles() []string {
 	if x != nil {
-		return x.Targetidx
+		return x.Handles
 	}
 	return nil
 }
 
-func (x *PoolDrainReq) GetSvcRanks() []uint32 {
+func (x *PoolEvictReq) GetDestroy() bool {
 	if x != nil {
-		return x.SvcRanks
+		return x.Destroy
 	}
-	return nil
+	return false
 }
 
-// PoolDrainResp returns resultant state of Drain operation.
-type PoolDrainResp struct {
+func (x *PoolEvictReq) GetForceDestroy() bool {
+	if x != nil {
+		return x.ForceDestroy
+	}
+	return false
+}
+
+func (x *PoolEvictReq) GetMachine() string {
+	if x != nil {
+		return x.Machine
+	}
+	return ""
+}
+
+// PoolEvictResp returns resultant state of evict operation.
+type PoolEvictResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Count  int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`   // Count of handles evicted
 }
 
-func (x *PoolDrainResp) Reset() {
-	*x = PoolDrainResp{}
+func (x *PoolEvictResp) Reset() {
+	*x = PoolEvictResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -971,13 +1107,13 @@ func (x *PoolDrainResp) Reset() {
 	}
 }
 
-func (x *PoolDrainResp) String() string {
+func (x *PoolEvictResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolDrainResp) ProtoMessage() {}
+func (*PoolEvictResp) ProtoMessage() {}
 
-func (x *PoolDrainResp) ProtoReflect() protoreflect.Message {
+func (x *PoolEvictResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -989,34 +1125,40 @@ func (x *PoolDrainResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolDrainResp.ProtoReflect.Descriptor instead.
-func (*PoolDrainResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolEvictResp.ProtoReflect.Descriptor instead.
+func (*PoolEvictResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PoolDrainResp) GetStatus() int32 {
+func (x *PoolEvictResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-// PoolExtendReq supplies pool identifier and rank list.
-type PoolExtendReq struct {
+func (x *PoolEvictResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PoolExcludeReq supplies pool identifier, rank, and target_idxs.
+type PoolExcludeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys          string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
-	Id           string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to add target up to
-	Ranks        []uint32 `protobuf:"varint,3,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`                       // ranks
-	SvcRanks     []uint32 `protobuf:"varint,4,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
-	Tierbytes    []uint64 `protobuf:"varint,5,rep,packed,name=tierbytes,proto3" json:"tierbytes,omitempty"`               // Size in bytes of storage tiers
-	FaultDomains []uint32 `protobuf:"varint,6,rep,packed,name=faultDomains,proto3" json:"faultDomains,omitempty"`         // fault domain tree, minimal format
+	Sys       string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
+	Id        string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to exclude some targets
+	Rank      uint32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                // target to move to the down state
+	Targetidx []uint32 `protobuf:"varint,4,rep,packed,name=targetidx,proto3" json:"targetidx,omitempty"`               // target ranks
+	SvcRanks  []uint32 `protobuf:"varint,5,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
 }
 
-func (x *PoolExtendReq) Reset() {
-	*x = PoolExtendReq{}
+func (x *PoolExcludeReq) Reset() {
+	*x = PoolExcludeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1024,13 +1166,13 @@ func (x *PoolExtendReq) Reset() {
 	}
 }
 
-func (x *PoolExtendReq) String() string {
+func (x *PoolExcludeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolExtendReq) ProtoMessage() {}
+func (*PoolExcludeReq) ProtoMessage() {}
 
-func (x *PoolExtendReq) ProtoReflect() protoreflect.Message {
+func (x *PoolExcludeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1042,65 +1184,57 @@ func (x *PoolExtendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolExtendReq.ProtoReflect.Descriptor instead.
-func (*PoolExtendReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolExcludeReq.ProtoReflect.Descriptor instead.
+func (*PoolExcludeReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PoolExtendReq) GetSys() string {
+func (x *PoolExcludeReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolExtendReq) GetId() string {
+func (x *PoolExcludeReq) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PoolExtendReq) GetRanks() []uint32 {
-	if x != nil {
-		return x.Ranks
-	}
-	return nil
-}
-
-func (x *PoolExtendReq) GetSvcRanks() []uint32 {
+func (x *PoolExcludeReq) GetRank() uint32 {
 	if x != nil {
-		return x.SvcRanks
+		return x.Rank
 	}
-	return nil
+	return 0
 }
 
-func (x *PoolExtendReq) GetTierbytes() []uint64 {
+func (x *PoolExcludeReq) GetTargetidx() []uint32 {
 	if x != nil {
-		return x.Tierbytes
+		return x.Targetidx
 	}
 	return nil
 }
 
-func (x *PoolExtendReq) GetFaultDomains() []uint32 {
+func (x *PoolExcludeReq) GetSvcRanks() []uint32 {
 	if x != nil {
-		return x.FaultDomains
+		return x.SvcRanks
 	}
 	return nil
 }
 
-// PoolExtendResp returns resultant state of Extend operation.
-type PoolExtendResp struct {
+// PoolExcludeResp returns resultant state of Exclude operation.
+type PoolExcludeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status    int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                               // DAOS error code
-	TierBytes []uint64 `protobuf:"varint,2,rep,packed,name=tier_bytes,json=tierBytes,proto3" json:"tier_bytes,omitempty"` // storage tiers allocated to pool
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
 }
 
-func (x *PoolExtendResp) Reset() {
-	*x = PoolExtendResp{}
+func (x *PoolExcludeResp) Reset() {
+	*x = PoolExcludeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1108,13 +1242,13 @@ func (x *PoolExtendResp) Reset() {
 	}
 }
 
-func (x *PoolExtendResp) String() string {
+func (x *PoolExcludeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolExtendResp) ProtoMessage() {}
+func (*PoolExcludeResp) ProtoMessage() {}
 
-func (x *PoolExtendResp) ProtoReflect() protoreflect.Message {
+func (x *PoolExcludeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1126,41 +1260,33 @@ func (x *PoolExtendResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolExtendResp.ProtoReflect.Descriptor instead.
-func (*PoolExtendResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolExcludeResp.ProtoReflect.Descriptor instead.
+func (*PoolExcludeResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *PoolExtendResp) GetStatus() int32 {
+func (x *PoolExcludeResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *PoolExtendResp) GetTierBytes() []uint64 {
-	if x != nil {
-		return x.TierBytes
-	}
-	return nil
-}
-
-// PoolReintegrateReq supplies pool identifier, rank, and target_idxs.
-type PoolReintegrateReq struct {
+// PoolDrainReq supplies pool identifier, rank, and target_idxs.
+type PoolDrainReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Sys       string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
-	Id        string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to add target up to
-	Rank      uint32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                // target to move to the up state
-	Targetidx []uint32 `protobuf:"varint,4,rep,packed,name=targetidx,proto3" json:"targetidx,omitempty"`               // target ranks
+	Id        string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to drain some targets
+	Rank      uint32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                // rank to move to the down state
+	Targetidx []uint32 `protobuf:"varint,4,rep,packed,name=targetidx,proto3" json:"targetidx,omitempty"`               // rank targets
 	SvcRanks  []uint32 `protobuf:"varint,5,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
-	Tierbytes []uint64 `protobuf:"varint,6,rep,packed,name=tierbytes,proto3" json:"tierbytes,omitempty"`               // Size in bytes of storage tiers
 }
 
-func (x *PoolReintegrateReq) Reset() {
-	*x = PoolReintegrateReq{}
+func (x *PoolDrainReq) Reset() {
+	*x = PoolDrainReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1168,13 +1294,13 @@ func (x *PoolReintegrateReq) Reset() {
 	}
 }
 
-func (x *PoolReintegrateReq) String() string {
+func (x *PoolDrainReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolReintegrateReq) ProtoMessage() {}
+func (*PoolDrainReq) ProtoMessage() {}
 
-func (x *PoolReintegrateReq) ProtoReflect() protoreflect.Message {
+func (x *PoolDrainReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1186,55 +1312,48 @@ func (x *PoolReintegrateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolReintegrateReq.ProtoReflect.Descriptor instead.
-func (*PoolReintegrateReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolDrainReq.ProtoReflect.Descriptor instead.
+func (*PoolDrainReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PoolReintegrateReq) GetSys() string {
+func (x *PoolDrainReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolReintegrateReq) GetId() string {
+func (x *PoolDrainReq) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PoolReintegrateReq) GetRank() uint32 {
+func (x *PoolDrainReq) GetRank() uint32 {
 	if x != nil {
 		return x.Rank
 	}
 	return 0
 }
 
-func (x *PoolReintegrateReq) GetTargetidx() []uint32 {
+func (x *PoolDrainReq) GetTargetidx() []uint32 {
 	if x != nil {
 		return x.Targetidx
 	}
 	return nil
 }
 
-func (x *PoolReintegrateReq) GetSvcRanks() []uint32 {
+func (x *PoolDrainReq) GetSvcRanks() []uint32 {
 	if x != nil {
 		return x.SvcRanks
 	}
 	return nil
 }
 
-func (x *PoolReintegrateReq) GetTierbytes() []uint64 {
-	if x != nil {
-		return x.Tierbytes
-	}
-	return nil
-}
-
-// PoolReintegrateResp returns resultant state of Reintegrate operation.
-type PoolReintegrateResp struct {
+// PoolDrainResp returns resultant state of Drain operation.
+type PoolDrainResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1242,8 +1361,8 @@ type PoolReintegrateResp struct {
 	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
 }
 
-func (x *PoolReintegrateResp) Reset() {
-	*x = PoolReintegrateResp{}
+func (x *PoolDrainResp) Reset() {
+	*x = PoolDrainResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1251,13 +1370,13 @@ func (x *PoolReintegrateResp) Reset() {
 	}
 }
 
-func (x *PoolReintegrateResp) String() string {
+func (x *PoolDrainResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolReintegrateResp) ProtoMessage() {}
+func (*PoolDrainResp) ProtoMessage() {}
 
-func (x *PoolReintegrateResp) ProtoReflect() protoreflect.Message {
+func (x *PoolDrainResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1269,29 +1388,34 @@ func (x *PoolReintegrateResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolReintegrateResp.ProtoReflect.Descriptor instead.
-func (*PoolReintegrateResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolDrainResp.ProtoReflect.Descriptor instead.
+func (*PoolDrainResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PoolReintegrateResp) GetStatus() int32 {
+func (x *PoolDrainResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-// ListPoolsReq represents a request to list pools on a given DAOS system.
-type ListPoolsReq struct {
+// PoolExtendReq supplies pool identifier and rank list.
+type PoolExtendReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Sys          string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
+	Id           string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to add target up to
+	Ranks        []uint32 `protobuf:"varint,3,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`                       // ranks
+	SvcRanks     []uint32 `protobuf:"varint,4,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Tierbytes    []uint64 `protobuf:"varint,5,rep,packed,name=tierbytes,proto3" json:"tierbytes,omitempty"`               // Size in bytes of storage tiers
+	FaultDomains []uint32 `protobuf:"varint,6,rep,packed,name=faultDomains,proto3" json:"faultDomains,omitempty"`         // fault domain tree, minimal format
 }
 
-func (x *ListPoolsReq) Reset() {
-	*x = ListPoolsReq{}
+func (x *PoolExtendReq) Reset() {
+	*x = PoolExtendReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1299,13 +1423,13 @@ func (x *ListPoolsReq) Reset() {
 	}
 }
 
-func (x *ListPoolsReq) String() string {
+func (x *PoolExtendReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPoolsReq) ProtoMessage() {}
+func (*PoolExtendReq) ProtoMessage() {}
 
-func (x *ListPoolsReq) ProtoReflect() protoreflect.Message {
+func (x *PoolExtendReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,31 +1441,65 @@ func (x *ListPoolsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPoolsReq.ProtoReflect.Descriptor instead.
-func (*ListPoolsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolExtendReq.ProtoReflect.Descriptor instead.
+func (*PoolExtendReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListPoolsReq) GetSys() string {
+func (x *PoolExtendReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-// ListPoolsResp returns the list of pools in the system.
-type ListPoolsResp struct {
+func (x *PoolExtendReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PoolExtendReq) GetRanks() []uint32 {
+	if x != nil {
+		return x.Ranks
+	}
+	return nil
+}
+
+func (x *PoolExtendReq) GetSvcRanks() []uint32 {
+	if x != nil {
+		return x.SvcRanks
+	}
+	return nil
+}
+
+func (x *PoolExtendReq) GetTierbytes() []uint64 {
+	if x != nil {
+		return x.Tierbytes
+	}
+	return nil
+}
+
+func (x *PoolExtendReq) GetFaultDomains() []uint32 {
+	if x != nil {
+		return x.FaultDomains
+	}
+	return nil
+}
+
+// PoolExtendResp returns resultant state of Extend operation.
+type PoolExtendResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status      int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                              // DAOS error code
-	Pools       []*ListPoolsResp_Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`                                 // pools list
-	DataVersion uint64                `protobuf:"varint,3,opt,name=data_version,json=dataVersion,proto3" json:"data_version,omitempty"` // Version of the system database.
+	Status    int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                               // DAOS error code
+	TierBytes []uint64 `protobuf:"varint,2,rep,packed,name=tier_bytes,json=tierBytes,proto3" json:"tier_bytes,omitempty"` // storage tiers allocated to pool
 }
 
-func (x *ListPoolsResp) Reset() {
-	*x = ListPoolsResp{}
+func (x *PoolExtendResp) Reset() {
+	*x = PoolExtendResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1349,13 +1507,13 @@ func (x *ListPoolsResp) Reset() {
 	}
 }
 
-func (x *ListPoolsResp) String() string {
+func (x *PoolExtendResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPoolsResp) ProtoMessage() {}
+func (*PoolExtendResp) ProtoMessage() {}
 
-func (x *ListPoolsResp) ProtoReflect() protoreflect.Message {
+func (x *PoolExtendResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1367,47 +1525,41 @@ func (x *ListPoolsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPoolsResp.ProtoReflect.Descriptor instead.
-func (*ListPoolsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolExtendResp.ProtoReflect.Descriptor instead.
+func (*PoolExtendResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListPoolsResp) GetStatus() int32 {
+func (x *PoolExtendResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *ListPoolsResp) GetPools() []*ListPoolsResp_Pool {
+func (x *PoolExtendResp) GetTierBytes() []uint64 {
 	if x != nil {
-		return x.Pools
+		return x.TierBytes
 	}
 	return nil
 }
 
-func (x *ListPoolsResp) GetDataVersion() uint64 {
-	if x != nil {
-		return x.DataVersion
-	}
-	return 0
-}
-
-// ListContainers
-// Initial implementation differs from C API
-// (numContainers not provided in request - get whole list)
-type ListContReq struct {
+// PoolReintegrateReq supplies pool identifier, rank, and target_idxs.
+type PoolReintegrateReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
-	Id       string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool
-	SvcRanks []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Sys       string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
+	Id        string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to add target up to
+	Rank      uint32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                // target to move to the up state
+	Targetidx []uint32 `protobuf:"varint,4,rep,packed,name=targetidx,proto3" json:"targetidx,omitempty"`               // target ranks
+	SvcRanks  []uint32 `protobuf:"varint,5,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Tierbytes []uint64 `protobuf:"varint,6,rep,packed,name=tierbytes,proto3" json:"tierbytes,omitempty"`               // Size in bytes of storage tiers
 }
 
-func (x *ListContReq) Reset() {
-	*x = ListContReq{}
+func (x *PoolReintegrateReq) Reset() {
+	*x = PoolReintegrateReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1415,13 +1567,13 @@ func (x *ListContReq) Reset() {
 	}
 }
 
-func (x *ListContReq) String() string {
+func (x *PoolReintegrateReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContReq) ProtoMessage() {}
+func (*PoolReintegrateReq) ProtoMessage() {}
 
-func (x *ListContReq) ProtoReflect() protoreflect.Message {
+func (x *PoolReintegrateReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1433,43 +1585,64 @@ func (x *ListContReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContReq.ProtoReflect.Descriptor instead.
-func (*ListContReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolReintegrateReq.ProtoReflect.Descriptor instead.
+func (*PoolReintegrateReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListContReq) GetSys() string {
+func (x *PoolReintegrateReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *ListContReq) GetId() string {
+func (x *PoolReintegrateReq) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ListContReq) GetSvcRanks() []uint32 {
+func (x *PoolReintegrateReq) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PoolReintegrateReq) GetTargetidx() []uint32 {
+	if x != nil {
+		return x.Targetidx
+	}
+	return nil
+}
+
+func (x *PoolReintegrateReq) GetSvcRanks() []uint32 {
 	if x != nil {
 		return x.SvcRanks
 	}
 	return nil
 }
 
-type ListContResp struct {
+func (x *PoolReintegrateReq) GetTierbytes() []uint64 {
+	if x != nil {
+		return x.Tierbytes
+	}
+	return nil
+}
+
+// PoolReintegrateResp returns resultant state of Reintegrate operation.
+type PoolReintegrateResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status     int32                `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`        // DAOS error code
-	Containers []*ListContResp_Cont `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"` // containers
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
 }
 
-func (x *ListContResp) Reset() {
-	*x = ListContResp{}
+func (x *PoolReintegrateResp) Reset() {
+	*x = PoolReintegrateResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1477,13 +1650,13 @@ func (x *ListContResp) Reset() {
 	}
 }
 
-func (x *ListContResp) String() string {
+func (x *PoolReintegrateResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContResp) ProtoMessage() {}
+func (*PoolReintegrateResp) ProtoMessage() {}
 
-func (x *ListContResp) ProtoReflect() protoreflect.Message {
+func (x *PoolReintegrateResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1495,40 +1668,32 @@ func (x *ListContResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContResp.ProtoReflect.Descriptor instead.
-func (*ListContResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PoolReintegrateResp.ProtoReflect.Descriptor instead.
+func (*PoolReintegrateResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListContResp) GetStatus() int32 {
+func (x *PoolReintegrateResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *ListContResp) GetContainers() []*ListContResp_Cont {
-	if x != nil {
-		return x.Containers
-	}
-	return nil
-}
-
-// PoolQueryReq represents a pool query request.
-type PoolQueryReq struct {
+// ListPoolsReq represents a request to list pools on a given DAOS system.
+type ListPoolsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
-	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	SvcRanks             []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`                                // List of pool service ranks
-	IncludeEnabledRanks  bool     `protobuf:"varint,4,opt,name=include_enabled_ranks,json=includeEnabledRanks,proto3" json:"include_enabled_ranks,omitempty"`    // True if the list of enabled ranks shall be returned
-	IncludeDisabledRanks bool     `protobuf:"varint,5,opt,name=include_disabled_ranks,json=includeDisabledRanks,proto3" json:"include_disabled_ranks,omitempty"` // True if the list of disabled ranks shall be returned
+	Sys       string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                              // DAOS system identifier
+	PageSize  uint32   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // maximum number of pools to return (0 for all)
+	PageToken string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response
+	Fields    []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`                        // Pool fields to return (empty for all)
 }
 
-func (x *PoolQueryReq) Reset() {
-	*x = PoolQueryReq{}
+func (x *ListPoolsReq) Reset() {
+	*x = ListPoolsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1536,13 +1701,13 @@ func (x *PoolQueryReq) Reset() {
 	}
 }
 
-func (x *PoolQueryReq) String() string {
+func (x *ListPoolsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolQueryReq) ProtoMessage() {}
+func (*ListPoolsReq) ProtoMessage() {}
 
-func (x *PoolQueryReq) ProtoReflect() protoreflect.Message {
+func (x *ListPoolsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1554,62 +1719,53 @@ func (x *PoolQueryReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolQueryReq.ProtoReflect.Descriptor instead.
-func (*PoolQueryReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPoolsReq.ProtoReflect.Descriptor instead.
+func (*ListPoolsReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PoolQueryReq) GetSys() string {
+func (x *ListPoolsReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolQueryReq) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *PoolQueryReq) GetSvcRanks() []uint32 {
+func (x *ListPoolsReq) GetPageSize() uint32 {
 	if x != nil {
-		return x.SvcRanks
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *PoolQueryReq) GetIncludeEnabledRanks() bool {
+func (x *ListPoolsReq) GetPageToken() string {
 	if x != nil {
-		return x.IncludeEnabledRanks
+		return x.PageToken
 	}
-	return false
+	return ""
 }
 
-func (x *PoolQueryReq) GetIncludeDisabledRanks() bool {
+func (x *ListPoolsReq) GetFields() []string {
 	if x != nil {
-		return x.IncludeDisabledRanks
+		return x.Fields
 	}
-	return false
+	return nil
 }
 
-// StorageUsageStats represents usage statistics for a storage subsystem.
-type StorageUsageStats struct {
+// ListPoolsResp returns the list of pools in the system.
+type ListPoolsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total     uint64           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Free      uint64           `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
-	Min       uint64           `protobuf:"varint,3,opt,name=min,proto3" json:"min,omitempty"`
-	Max       uint64           `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
-	Mean      uint64           `protobuf:"varint,5,opt,name=mean,proto3" json:"mean,omitempty"`
-	MediaType StorageMediaType `protobuf:"varint,6,opt,name=media_type,json=mediaType,proto3,enum=mgmt.StorageMediaType" json:"media_type,omitempty"`
+	Status        int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                     // DAOS error code
+	Pools         []*ListPoolsResp_Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`                                        // pools list
+	DataVersion   uint64                `protobuf:"varint,3,opt,name=data_version,json=dataVersion,proto3" json:"data_version,omitempty"`        // Version of the system database.
+	NextPageToken string                `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // token for the next page, empty if none remain
 }
 
-func (x *StorageUsageStats) Reset() {
-	*x = StorageUsageStats{}
+func (x *ListPoolsResp) Reset() {
+	*x = ListPoolsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1617,13 +1773,13 @@ func (x *StorageUsageStats) Reset() {
 	}
 }
 
-func (x *StorageUsageStats) String() string {
+func (x *ListPoolsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageUsageStats) ProtoMessage() {}
+func (*ListPoolsResp) ProtoMessage() {}
 
-func (x *StorageUsageStats) ProtoReflect() protoreflect.Message {
+func (x *ListPoolsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1635,67 +1791,54 @@ func (x *StorageUsageStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageUsageStats.ProtoReflect.Descriptor instead.
-func (*StorageUsageStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPoolsResp.ProtoReflect.Descriptor instead.
+func (*ListPoolsResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *StorageUsageStats) GetTotal() uint64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *StorageUsageStats) GetFree() uint64 {
-	if x != nil {
-		return x.Free
-	}
-	return 0
-}
-
-func (x *StorageUsageStats) GetMin() uint64 {
+func (x *ListPoolsResp) GetStatus() int32 {
 	if x != nil {
-		return x.Min
+		return x.Status
 	}
 	return 0
 }
 
-func (x *StorageUsageStats) GetMax() uint64 {
+func (x *ListPoolsResp) GetPools() []*ListPoolsResp_Pool {
 	if x != nil {
-		return x.Max
+		return x.Pools
 	}
-	return 0
+	return nil
 }
 
-func (x *StorageUsageStats) GetMean() uint64 {
+func (x *ListPoolsResp) GetDataVersion() uint64 {
 	if x != nil {
-		return x.Mean
+		return x.DataVersion
 	}
 	return 0
 }
 
-func (x *StorageUsageStats) GetMediaType() StorageMediaType {
+func (x *ListPoolsResp) GetNextPageToken() string {
 	if x != nil {
-		return x.MediaType
+		return x.NextPageToken
 	}
-	return StorageMediaType_SCM
+	return ""
 }
 
-// PoolRebuildStatus represents a pool's rebuild status.
-type PoolRebuildStatus struct {
+// ListContainers
+// Initial implementation differs from C API
+// (numContainers not provided in request - get whole list)
+type ListContReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  int32                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
-	State   PoolRebuildStatus_State `protobuf:"varint,2,opt,name=state,proto3,enum=mgmt.PoolRebuildStatus_State" json:"state,omitempty"`
-	Objects uint64                  `protobuf:"varint,3,opt,name=objects,proto3" json:"objects,omitempty"`
-	Records uint64                  `protobuf:"varint,4,opt,name=records,proto3" json:"records,omitempty"`
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
+	Id       string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool
+	SvcRanks []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
 }
 
-func (x *PoolRebuildStatus) Reset() {
-	*x = PoolRebuildStatus{}
+func (x *ListContReq) Reset() {
+	*x = ListContReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1703,13 +1846,13 @@ func (x *PoolRebuildStatus) Reset() {
 	}
 }
 
-func (x *PoolRebuildStatus) String() string {
+func (x *ListContReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolRebuildStatus) ProtoMessage() {}
+func (*ListContReq) ProtoMessage() {}
 
-func (x *PoolRebuildStatus) ProtoReflect() protoreflect.Message {
+func (x *ListContReq) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1721,64 +1864,43 @@ func (x *PoolRebuildStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolRebuildStatus.ProtoReflect.Descriptor instead.
-func (*PoolRebuildStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListContReq.ProtoReflect.Descriptor instead.
+func (*ListContReq) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PoolRebuildStatus) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *PoolRebuildStatus) GetState() PoolRebuildStatus_State {
+func (x *ListContReq) GetSys() string {
 	if x != nil {
-		return x.State
+		return x.Sys
 	}
-	return PoolRebuildStatus_IDLE
+	return ""
 }
 
-func (x *PoolRebuildStatus) GetObjects() uint64 {
+func (x *ListContReq) GetId() string {
 	if x != nil {
-		return x.Objects
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *PoolRebuildStatus) GetRecords() uint64 {
+func (x *ListContReq) GetSvcRanks() []uint32 {
 	if x != nil {
-		return x.Records
+		return x.SvcRanks
 	}
-	return 0
+	return nil
 }
 
-// PoolQueryResp represents a pool query response.
-type PoolQueryResp struct {
+type ListContResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status           int32                `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                                // DAOS error code
-	Uuid             string               `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                     // pool uuid
-	Label            string               `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`                                                   // pool label
-	TotalTargets     uint32               `protobuf:"varint,4,opt,name=total_targets,json=totalTargets,proto3" json:"total_targets,omitempty"`                // total targets in pool
-	ActiveTargets    uint32               `protobuf:"varint,5,opt,name=active_targets,json=activeTargets,proto3" json:"active_targets,omitempty"`             // active targets in pool
-	DisabledTargets  uint32               `protobuf:"varint,6,opt,name=disabled_targets,json=disabledTargets,proto3" json:"disabled_targets,omitempty"`       // number of disabled targets in pool
-	Rebuild          *PoolRebuildStatus   `protobuf:"bytes,7,opt,name=rebuild,proto3" json:"rebuild,omitempty"`                                               // pool rebuild status
-	TierStats        []*StorageUsageStats `protobuf:"bytes,8,rep,name=tier_stats,json=tierStats,proto3" json:"tier_stats,omitempty"`                          // storage tiers usage stats
-	Version          uint32               `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`                                             // latest pool map version
-	Leader           uint32               `protobuf:"varint,11,opt,name=leader,proto3" json:"leader,omitempty"`                                               // current raft leader
-	EnabledRanks     string               `protobuf:"bytes,12,opt,name=enabled_ranks,json=enabledRanks,proto3" json:"enabled_ranks,omitempty"`                // optional set of ranks enabled
-	DisabledRanks    string               `protobuf:"bytes,13,opt,name=disabled_ranks,json=disabledRanks,proto3" json:"disabled_ranks,omitempty"`             // optional set of ranks disabled
-	TotalEngines     uint32               `protobuf:"varint,14,opt,name=total_engines,json=totalEngines,proto3" json:"total_engines,omitempty"`               // total engines in pool
-	PoolLayoutVer    uint32               `protobuf:"varint,15,opt,name=pool_layout_ver,json=poolLayoutVer,proto3" json:"pool_layout_ver,omitempty"`          // current pool global version
-	UpgradeLayoutVer uint32               `protobuf:"varint,16,opt,name=upgrade_layout_ver,json=upgradeLayoutVer,proto3" json:"upgrade_layout_ver,omitempty"` // latest pool global version to upgrade
+	Status     int32                `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`        // DAOS error code
+	Containers []*ListContResp_Cont `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"` // containers
 }
 
-func (x *PoolQueryResp) Reset() {
-	*x = PoolQueryResp{}
+func (x *ListContResp) Reset() {
+	*x = ListContResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_pool_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1786,13 +1908,13 @@ func (x *PoolQueryResp) Reset() {
 	}
 }
 
-func (x *PoolQueryResp) String() string {
+func (x *ListContResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolQueryResp) ProtoMessage() {}
+func (*ListContResp) ProtoMessage() {}
 
-func (x *PoolQueryResp) ProtoReflect() protoreflect.Message {
+func (x *ListContResp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_pool_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1804,146 +1926,1135 @@ func (x *PoolQueryResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolQueryResp.ProtoReflect.Descriptor instead.
-func (*PoolQueryResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListContResp.ProtoReflect.Descriptor instead.
+func (*ListContResp) Descriptor() ([]byte, []int) {
 	return file_mgmt_pool_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *PoolQueryResp) GetStatus() int32 {
+func (x *ListContResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *PoolQueryResp) GetUuid() string {
+func (x *ListContResp) GetContainers() []*ListContResp_Cont {
 	if x != nil {
-		return x.Uuid
+		return x.Containers
 	}
-	return ""
+	return nil
 }
 
-func (x *PoolQueryResp) GetLabel() string {
-	if x != nil {
-		return x.Label
+// PoolQueryReq represents a pool query request.
+type PoolQueryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	SvcRanks             []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"`                                // List of pool service ranks
+	IncludeEnabledRanks  bool     `protobuf:"varint,4,opt,name=include_enabled_ranks,json=includeEnabledRanks,proto3" json:"include_enabled_ranks,omitempty"`    // True if the list of enabled ranks shall be returned
+	IncludeDisabledRanks bool     `protobuf:"varint,5,opt,name=include_disabled_ranks,json=includeDisabledRanks,proto3" json:"include_disabled_ranks,omitempty"` // True if the list of disabled ranks shall be returned
+}
+
+func (x *PoolQueryReq) Reset() {
+	*x = PoolQueryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *PoolQueryResp) GetTotalTargets() uint32 {
-	if x != nil {
-		return x.TotalTargets
+func (x *PoolQueryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolQueryReq) ProtoMessage() {}
+
+func (x *PoolQueryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PoolQueryResp) GetActiveTargets() uint32 {
+// Deprecated: Use PoolQueryReq.ProtoReflect.Descriptor instead.
+func (*PoolQueryReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PoolQueryReq) GetSys() string {
 	if x != nil {
-		return x.ActiveTargets
+		return x.Sys
 	}
-	return 0
+	return ""
 }
 
-func (x *PoolQueryResp) GetDisabledTargets() uint32 {
+func (x *PoolQueryReq) GetId() string {
 	if x != nil {
-		return x.DisabledTargets
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *PoolQueryResp) GetRebuild() *PoolRebuildStatus {
+func (x *PoolQueryReq) GetSvcRanks() []uint32 {
 	if x != nil {
-		return x.Rebuild
+		return x.SvcRanks
 	}
 	return nil
 }
 
-func (x *PoolQueryResp) GetTierStats() []*StorageUsageStats {
+func (x *PoolQueryReq) GetIncludeEnabledRanks() bool {
 	if x != nil {
-		return x.TierStats
+		return x.IncludeEnabledRanks
 	}
-	return nil
+	return false
 }
 
-func (x *PoolQueryResp) GetVersion() uint32 {
+func (x *PoolQueryReq) GetIncludeDisabledRanks() bool {
 	if x != nil {
-		return x.Version
+		return x.IncludeDisabledRanks
 	}
-	return 0
+	return false
 }
 
-func (x *PoolQueryResp) GetLeader() uint32 {
-	if x != nil {
-		return x.Leader
+// StorageUsageStats represents usage statistics for a storage subsystem.
+type StorageUsageStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     uint64           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Free      uint64           `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+	Min       uint64           `protobuf:"varint,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max       uint64           `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+	Mean      uint64           `protobuf:"varint,5,opt,name=mean,proto3" json:"mean,omitempty"`
+	MediaType StorageMediaType `protobuf:"varint,6,opt,name=media_type,json=mediaType,proto3,enum=mgmt.StorageMediaType" json:"media_type,omitempty"`
+}
+
+func (x *StorageUsageStats) Reset() {
+	*x = StorageUsageStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *PoolQueryResp) GetEnabledRanks() string {
-	if x != nil {
-		return x.EnabledRanks
+func (x *StorageUsageStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageUsageStats) ProtoMessage() {}
+
+func (x *StorageUsageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageUsageStats.ProtoReflect.Descriptor instead.
+func (*StorageUsageStats) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StorageUsageStats) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *StorageUsageStats) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *StorageUsageStats) GetMin() uint64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *StorageUsageStats) GetMax() uint64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *StorageUsageStats) GetMean() uint64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *StorageUsageStats) GetMediaType() StorageMediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return StorageMediaType_SCM
+}
+
+// PoolRebuildStatus represents a pool's rebuild status.
+type PoolRebuildStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       int32                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	State        PoolRebuildStatus_State `protobuf:"varint,2,opt,name=state,proto3,enum=mgmt.PoolRebuildStatus_State" json:"state,omitempty"`
+	Objects      uint64                  `protobuf:"varint,3,opt,name=objects,proto3" json:"objects,omitempty"`                               // objects rebuilt so far
+	Records      uint64                  `protobuf:"varint,4,opt,name=records,proto3" json:"records,omitempty"`                               // records rebuilt so far
+	Version      uint32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`                               // pool map version of the current or last rebuild
+	Seconds      uint32                  `protobuf:"varint,6,opt,name=seconds,proto3" json:"seconds,omitempty"`                               // duration of the current or last rebuild
+	TotalObjects uint64                  `protobuf:"varint,7,opt,name=total_objects,json=totalObjects,proto3" json:"total_objects,omitempty"` // objects to be rebuilt, grows while busy
+	Size         uint64                  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`                                     // bytes of data rebuilt
+	FailRank     int32                   `protobuf:"varint,9,opt,name=fail_rank,json=failRank,proto3" json:"fail_rank,omitempty"`             // rank on which rebuild failed, if status is nonzero
+}
+
+func (x *PoolRebuildStatus) Reset() {
+	*x = PoolRebuildStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolRebuildStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolRebuildStatus) ProtoMessage() {}
+
+func (x *PoolRebuildStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolRebuildStatus.ProtoReflect.Descriptor instead.
+func (*PoolRebuildStatus) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PoolRebuildStatus) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PoolRebuildStatus) GetState() PoolRebuildStatus_State {
+	if x != nil {
+		return x.State
+	}
+	return PoolRebuildStatus_IDLE
+}
+
+func (x *PoolRebuildStatus) GetObjects() uint64 {
+	if x != nil {
+		return x.Objects
+	}
+	return 0
+}
+
+func (x *PoolRebuildStatus) GetRecords() uint64 {
+	if x != nil {
+		return x.Records
+	}
+	return 0
+}
+
+func (x *PoolRebuildStatus) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PoolRebuildStatus) GetSeconds() uint32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *PoolRebuildStatus) GetTotalObjects() uint64 {
+	if x != nil {
+		return x.TotalObjects
+	}
+	return 0
+}
+
+func (x *PoolRebuildStatus) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PoolRebuildStatus) GetFailRank() int32 {
+	if x != nil {
+		return x.FailRank
+	}
+	return 0
+}
+
+// PoolQueryResp represents a pool query response.
+type PoolQueryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status           int32                `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                                // DAOS error code
+	Uuid             string               `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                     // pool uuid
+	Label            string               `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`                                                   // pool label
+	TotalTargets     uint32               `protobuf:"varint,4,opt,name=total_targets,json=totalTargets,proto3" json:"total_targets,omitempty"`                // total targets in pool
+	ActiveTargets    uint32               `protobuf:"varint,5,opt,name=active_targets,json=activeTargets,proto3" json:"active_targets,omitempty"`             // active targets in pool
+	DisabledTargets  uint32               `protobuf:"varint,6,opt,name=disabled_targets,json=disabledTargets,proto3" json:"disabled_targets,omitempty"`       // number of disabled targets in pool
+	Rebuild          *PoolRebuildStatus   `protobuf:"bytes,7,opt,name=rebuild,proto3" json:"rebuild,omitempty"`                                               // pool rebuild status
+	TierStats        []*StorageUsageStats `protobuf:"bytes,8,rep,name=tier_stats,json=tierStats,proto3" json:"tier_stats,omitempty"`                          // storage tiers usage stats
+	Version          uint32               `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`                                             // latest pool map version
+	Leader           uint32               `protobuf:"varint,11,opt,name=leader,proto3" json:"leader,omitempty"`                                               // current raft leader
+	EnabledRanks     string               `protobuf:"bytes,12,opt,name=enabled_ranks,json=enabledRanks,proto3" json:"enabled_ranks,omitempty"`                // optional set of ranks enabled
+	DisabledRanks    string               `protobuf:"bytes,13,opt,name=disabled_ranks,json=disabledRanks,proto3" json:"disabled_ranks,omitempty"`             // optional set of ranks disabled
+	TotalEngines     uint32               `protobuf:"varint,14,opt,name=total_engines,json=totalEngines,proto3" json:"total_engines,omitempty"`               // total engines in pool
+	PoolLayoutVer    uint32               `protobuf:"varint,15,opt,name=pool_layout_ver,json=poolLayoutVer,proto3" json:"pool_layout_ver,omitempty"`          // current pool global version
+	UpgradeLayoutVer uint32               `protobuf:"varint,16,opt,name=upgrade_layout_ver,json=upgradeLayoutVer,proto3" json:"upgrade_layout_ver,omitempty"` // latest pool global version to upgrade
+	SvcReps          []uint32             `protobuf:"varint,17,rep,packed,name=svc_reps,json=svcReps,proto3" json:"svc_reps,omitempty"`                       // pool service replica ranks
+}
+
+func (x *PoolQueryResp) Reset() {
+	*x = PoolQueryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolQueryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolQueryResp) ProtoMessage() {}
+
+func (x *PoolQueryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolQueryResp.ProtoReflect.Descriptor instead.
+func (*PoolQueryResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PoolQueryResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PoolQueryResp) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PoolQueryResp) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PoolQueryResp) GetTotalTargets() uint32 {
+	if x != nil {
+		return x.TotalTargets
+	}
+	return 0
+}
+
+func (x *PoolQueryResp) GetActiveTargets() uint32 {
+	if x != nil {
+		return x.ActiveTargets
+	}
+	return 0
+}
+
+func (x *PoolQueryResp) GetDisabledTargets() uint32 {
+	if x != nil {
+		return x.DisabledTargets
+	}
+	return 0
+}
+
+func (x *PoolQueryResp) GetRebuild() *PoolRebuildStatus {
+	if x != nil {
+		return x.Rebuild
+	}
+	return nil
+}
+
+func (x *PoolQueryResp) GetTierStats() []*StorageUsageStats {
+	if x != nil {
+		return x.TierStats
+	}
+	return nil
+}
+
+func (x *PoolQueryResp) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PoolQueryResp) GetLeader() uint32 {
+	if x != nil {
+		return x.Leader
+	}
+	return 0
+}
+
+func (x *PoolQueryResp) GetEnabledRanks() string {
+	if x != nil {
+		return x.EnabledRanks
+	}
+	return ""
+}
+
+func (x *PoolQueryResp) GetDisabledRanks() string {
+	if x != nil {
+		return x.DisabledRanks
+	}
+	return ""
+}
+
+func (x *PoolQueryResp) GetTotalEngines() uint32 {
+	if x != nil {
+		return x.TotalEngines
+	}
+	return 0
+}
+
+func (x *PoolQueryResp) GetPoolLayoutVer() uint32 {
+	if x != nil {
+		return x.PoolLayoutVer
+	}
+	return 0
+}
+
+func (x *PoolQueryResp) GetUpgradeLayoutVer() uint32 {
+	if x != nil {
+		return x.UpgradeLayoutVer
+	}
+	return 0
+}
+
+func (x *PoolQueryResp) GetSvcReps() []uint32 {
+	if x != nil {
+		return x.SvcReps
+	}
+	return nil
+}
+
+type PoolProperty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number uint32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"` // pool property number
+	// Types that are assignable to Value:
+	//
+	//	*PoolProperty_Strval
+	//	*PoolProperty_Numval
+	Value isPoolProperty_Value `protobuf_oneof:"value"`
+}
+
+func (x *PoolProperty) Reset() {
+	*x = PoolProperty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolProperty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolProperty) ProtoMessage() {}
+
+func (x *PoolProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolProperty.ProtoReflect.Descriptor instead.
+func (*PoolProperty) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PoolProperty) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (m *PoolProperty) GetValue() isPoolProperty_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *PoolProperty) GetStrval() string {
+	if x, ok := x.GetValue().(*PoolProperty_Strval); ok {
+		return x.Strval
+	}
+	return ""
+}
+
+func (x *PoolProperty) GetNumval() uint64 {
+	if x, ok := x.GetValue().(*PoolProperty_Numval); ok {
+		return x.Numval
+	}
+	return 0
+}
+
+type isPoolProperty_Value interface {
+	isPoolProperty_Value()
+}
+
+type PoolProperty_Strval struct {
+	Strval string `protobuf:"bytes,2,opt,name=strval,proto3,oneof"` // pool property string value
+}
+
+type PoolProperty_Numval struct {
+	Numval uint64 `protobuf:"varint,3,opt,name=numval,proto3,oneof"` // pool property numeric value
+}
+
+func (*PoolProperty_Strval) isPoolProperty_Value() {}
+
+func (*PoolProperty_Numval) isPoolProperty_Value() {}
+
+// PoolSetPropReq represents a request to set pool properties.
+type PoolSetPropReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys        string          `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Id         string          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`   // uuid or label of pool to modify
+	Properties []*PoolProperty `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
+	SvcRanks   []uint32        `protobuf:"varint,4,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+}
+
+func (x *PoolSetPropReq) Reset() {
+	*x = PoolSetPropReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolSetPropReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolSetPropReq) ProtoMessage() {}
+
+func (x *PoolSetPropReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolSetPropReq.ProtoReflect.Descriptor instead.
+func (*PoolSetPropReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PoolSetPropReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *PoolSetPropReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PoolSetPropReq) GetProperties() []*PoolProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *PoolSetPropReq) GetSvcRanks() []uint32 {
+	if x != nil {
+		return x.SvcRanks
+	}
+	return nil
+}
+
+// PoolSetPropResp represents the result of setting pool properties.
+type PoolSetPropResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+}
+
+func (x *PoolSetPropResp) Reset() {
+	*x = PoolSetPropResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolSetPropResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolSetPropResp) ProtoMessage() {}
+
+func (x *PoolSetPropResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolSetPropResp.ProtoReflect.Descriptor instead.
+func (*PoolSetPropResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PoolSetPropResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// PoolGetPropReq represents a request to get pool properties.
+type PoolGetPropReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys        string          `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Id         string          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`   // uuid or label of pool to query
+	Properties []*PoolProperty `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
+	SvcRanks   []uint32        `protobuf:"varint,4,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+}
+
+func (x *PoolGetPropReq) Reset() {
+	*x = PoolGetPropReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolGetPropReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolGetPropReq) ProtoMessage() {}
+
+func (x *PoolGetPropReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolGetPropReq.ProtoReflect.Descriptor instead.
+func (*PoolGetPropReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PoolGetPropReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *PoolGetPropReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PoolGetPropReq) GetProperties() []*PoolProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *PoolGetPropReq) GetSvcRanks() []uint32 {
+	if x != nil {
+		return x.SvcRanks
+	}
+	return nil
+}
+
+// PoolGetPropResp represents the result of getting pool properties.
+type PoolGetPropResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Properties []*PoolProperty `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (x *PoolGetPropResp) Reset() {
+	*x = PoolGetPropResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolGetPropResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolGetPropResp) ProtoMessage() {}
+
+func (x *PoolGetPropResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolGetPropResp.ProtoReflect.Descriptor instead.
+func (*PoolGetPropResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PoolGetPropResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PoolGetPropResp) GetProperties() []*PoolProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// PoolUpgradeReq upgrades the disk format of an existing pool to the
+// latest version.
+type PoolUpgradeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Id       string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	SvcRanks []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+}
+
+func (x *PoolUpgradeReq) Reset() {
+	*x = PoolUpgradeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolUpgradeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolUpgradeReq) ProtoMessage() {}
+
+func (x *PoolUpgradeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolUpgradeReq.ProtoReflect.Descriptor instead.
+func (*PoolUpgradeReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PoolUpgradeReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *PoolUpgradeReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PoolUpgradeReq) GetSvcRanks() []uint32 {
+	if x != nil {
+		return x.SvcRanks
+	}
+	return nil
+}
+
+// PoolUpgradeResp returns resultant state of upgrade operation.
+type PoolUpgradeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+}
+
+func (x *PoolUpgradeResp) Reset() {
+	*x = PoolUpgradeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolUpgradeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolUpgradeResp) ProtoMessage() {}
+
+func (x *PoolUpgradeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolUpgradeResp.ProtoReflect.Descriptor instead.
+func (*PoolUpgradeResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PoolUpgradeResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// PoolSetAdminsReq replaces the set of principals that may administer a pool
+// using their own administrative certificates.
+type PoolSetAdminsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys    string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`       // DAOS system identifier
+	Id     string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`         // uuid or label of pool
+	Admins []string `protobuf:"bytes,3,rep,name=admins,proto3" json:"admins,omitempty"` // principals to be granted pool administration
+}
+
+func (x *PoolSetAdminsReq) Reset() {
+	*x = PoolSetAdminsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolSetAdminsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolSetAdminsReq) ProtoMessage() {}
+
+func (x *PoolSetAdminsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolSetAdminsReq.ProtoReflect.Descriptor instead.
+func (*PoolSetAdminsReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PoolSetAdminsReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *PoolSetAdminsReq) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *PoolQueryResp) GetDisabledRanks() string {
-	if x != nil {
-		return x.DisabledRanks
-	}
-	return ""
+func (x *PoolSetAdminsReq) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+// PoolSetAdminsResp returns the resultant set of pool administrators.
+type PoolSetAdminsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Admins []string `protobuf:"bytes,2,rep,name=admins,proto3" json:"admins,omitempty"`  // principals granted pool administration
+}
+
+func (x *PoolSetAdminsResp) Reset() {
+	*x = PoolSetAdminsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolSetAdminsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolSetAdminsResp) ProtoMessage() {}
+
+func (x *PoolSetAdminsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolSetAdminsResp.ProtoReflect.Descriptor instead.
+func (*PoolSetAdminsResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PoolSetAdminsResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PoolSetAdminsResp) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+// PoolAnnotateReq sets or removes annotations on a pool.
+type PoolAnnotateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys         string            `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                                                                                         // DAOS system identifier
+	Id          string            `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                                                                                           // uuid or label of pool
+	Annotations map[string]string `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // annotations to set, empty values remove
+}
+
+func (x *PoolAnnotateReq) Reset() {
+	*x = PoolAnnotateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolAnnotateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolAnnotateReq) ProtoMessage() {}
+
+func (x *PoolAnnotateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolAnnotateReq.ProtoReflect.Descriptor instead.
+func (*PoolAnnotateReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *PoolQueryResp) GetTotalEngines() uint32 {
+func (x *PoolAnnotateReq) GetSys() string {
 	if x != nil {
-		return x.TotalEngines
+		return x.Sys
 	}
-	return 0
+	return ""
 }
 
-func (x *PoolQueryResp) GetPoolLayoutVer() uint32 {
+func (x *PoolAnnotateReq) GetId() string {
 	if x != nil {
-		return x.PoolLayoutVer
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *PoolQueryResp) GetUpgradeLayoutVer() uint32 {
+func (x *PoolAnnotateReq) GetAnnotations() map[string]string {
 	if x != nil {
-		return x.UpgradeLayoutVer
+		return x.Annotations
 	}
-	return 0
+	return nil
 }
 
-type PoolProperty struct {
+// PoolAnnotateResp returns the resultant set of pool annotations.
+type PoolAnnotateResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number uint32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"` // pool property number
-	// Types that are assignable to Value:
-	//
-	//	*PoolProperty_Strval
-	//	*PoolProperty_Numval
-	Value isPoolProperty_Value `protobuf_oneof:"value"`
+	Status      int32             `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                                                                                  // DAOS error code
+	Annotations map[string]string `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // annotations on the pool
 }
 
-func (x *PoolProperty) Reset() {
-	*x = PoolProperty{}
+func (x *PoolAnnotateResp) Reset() {
+	*x = PoolAnnotateResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[22]
+		mi := &file_mgmt_pool_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolProperty) String() string {
+func (x *PoolAnnotateResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolProperty) ProtoMessage() {}
+func (*PoolAnnotateResp) ProtoMessage() {}
 
-func (x *PoolProperty) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[22]
+func (x *PoolAnnotateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1954,84 +3065,53 @@ func (x *PoolProperty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolProperty.ProtoReflect.Descriptor instead.
-func (*PoolProperty) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PoolAnnotateResp.ProtoReflect.Descriptor instead.
+func (*PoolAnnotateResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *PoolProperty) GetNumber() uint32 {
+func (x *PoolAnnotateResp) GetStatus() int32 {
 	if x != nil {
-		return x.Number
+		return x.Status
 	}
 	return 0
 }
 
-func (m *PoolProperty) GetValue() isPoolProperty_Value {
-	if m != nil {
-		return m.Value
+func (x *PoolAnnotateResp) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
 	}
 	return nil
 }
 
-func (x *PoolProperty) GetStrval() string {
-	if x, ok := x.GetValue().(*PoolProperty_Strval); ok {
-		return x.Strval
-	}
-	return ""
-}
-
-func (x *PoolProperty) GetNumval() uint64 {
-	if x, ok := x.GetValue().(*PoolProperty_Numval); ok {
-		return x.Numval
-	}
-	return 0
-}
-
-type isPoolProperty_Value interface {
-	isPoolProperty_Value()
-}
-
-type PoolProperty_Strval struct {
-	Strval string `protobuf:"bytes,2,opt,name=strval,proto3,oneof"` // pool property string value
-}
-
-type PoolProperty_Numval struct {
-	Numval uint64 `protobuf:"varint,3,opt,name=numval,proto3,oneof"` // pool property numeric value
-}
-
-func (*PoolProperty_Strval) isPoolProperty_Value() {}
-
-func (*PoolProperty_Numval) isPoolProperty_Value() {}
-
-// PoolSetPropReq represents a request to set pool properties.
-type PoolSetPropReq struct {
+// PoolProbeReq represents a request to check the health of a pool service.
+type PoolProbeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys        string          `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
-	Id         string          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`   // uuid or label of pool to modify
-	Properties []*PoolProperty `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
-	SvcRanks   []uint32        `protobuf:"varint,4,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
+	Id       string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool to probe
+	SvcRanks []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
 }
 
-func (x *PoolSetPropReq) Reset() {
-	*x = PoolSetPropReq{}
+func (x *PoolProbeReq) Reset() {
+	*x = PoolProbeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[23]
+		mi := &file_mgmt_pool_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolSetPropReq) String() string {
+func (x *PoolProbeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolSetPropReq) ProtoMessage() {}
+func (*PoolProbeReq) ProtoMessage() {}
 
-func (x *PoolSetPropReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[23]
+func (x *PoolProbeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2042,65 +3122,64 @@ func (x *PoolSetPropReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolSetPropReq.ProtoReflect.Descriptor instead.
-func (*PoolSetPropReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PoolProbeReq.ProtoReflect.Descriptor instead.
+func (*PoolProbeReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *PoolSetPropReq) GetSys() string {
+func (x *PoolProbeReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolSetPropReq) GetId() string {
+func (x *PoolProbeReq) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PoolSetPropReq) GetProperties() []*PoolProperty {
-	if x != nil {
-		return x.Properties
-	}
-	return nil
-}
-
-func (x *PoolSetPropReq) GetSvcRanks() []uint32 {
+func (x *PoolProbeReq) GetSvcRanks() []uint32 {
 	if x != nil {
 		return x.SvcRanks
 	}
 	return nil
 }
 
-// PoolSetPropResp represents the result of setting pool properties.
-type PoolSetPropResp struct {
+// PoolProbeResp returns the health of a pool service and its replicas.
+type PoolProbeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Status     int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                           // DAOS error code
+	Uuid       string                   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`                                // pool uuid
+	Health     string                   `protobuf:"bytes,3,opt,name=health,proto3" json:"health,omitempty"`                            // pool service health (healthy, degraded, unavailable)
+	Leader     uint32                   `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`                           // current raft leader
+	MapVersion uint32                   `protobuf:"varint,5,opt,name=map_version,json=mapVersion,proto3" json:"map_version,omitempty"` // latest pool map version
+	Replicas   []*PoolProbeResp_Replica `protobuf:"bytes,6,rep,name=replicas,proto3" json:"replicas,omitempty"`                        // per-replica status
+	Error      string                   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                              // reason the pool service could not be reached
 }
 
-func (x *PoolSetPropResp) Reset() {
-	*x = PoolSetPropResp{}
+func (x *PoolProbeResp) Reset() {
+	*x = PoolProbeResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[24]
+		mi := &file_mgmt_pool_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolSetPropResp) String() string {
+func (x *PoolProbeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolSetPropResp) ProtoMessage() {}
+func (*PoolProbeResp) ProtoMessage() {}
 
-func (x *PoolSetPropResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[24]
+func (x *PoolProbeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2111,47 +3190,95 @@ func (x *PoolSetPropResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolSetPropResp.ProtoReflect.Descriptor instead.
-func (*PoolSetPropResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PoolProbeResp.ProtoReflect.Descriptor instead.
+func (*PoolProbeResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *PoolSetPropResp) GetStatus() int32 {
+func (x *PoolProbeResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-// PoolGetPropReq represents a request to get pool properties.
-type PoolGetPropReq struct {
+func (x *PoolProbeResp) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PoolProbeResp) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *PoolProbeResp) GetLeader() uint32 {
+	if x != nil {
+		return x.Leader
+	}
+	return 0
+}
+
+func (x *PoolProbeResp) GetMapVersion() uint32 {
+	if x != nil {
+		return x.MapVersion
+	}
+	return 0
+}
+
+func (x *PoolProbeResp) GetReplicas() []*PoolProbeResp_Replica {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *PoolProbeResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// PoolSetPolicyReq supplies runtime hints for the scheduling of engine
+// background tasks on a pool's targets. The hints are not persistent and are
+// lost when an engine is restarted.
+type PoolSetPolicyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys        string          `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
-	Id         string          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`   // uuid or label of pool to query
-	Properties []*PoolProperty `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
-	SvcRanks   []uint32        `protobuf:"varint,4,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Sys             string                       `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
+	Id              string                       `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool
+	SvcRanks        []uint32                     `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Ranks           []uint32                     `protobuf:"varint,4,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`                       // Apply to targets on these ranks only (all if empty)
+	Aggregation     PoolSetPolicyReq_Aggregation `protobuf:"varint,5,opt,name=aggregation,proto3,enum=mgmt.PoolSetPolicyReq_Aggregation" json:"aggregation,omitempty"`
+	RebuildThrottle uint32                       `protobuf:"varint,6,opt,name=rebuild_throttle,json=rebuildThrottle,proto3" json:"rebuild_throttle,omitempty"` // Percent of engine time available to rebuild (1-100, 0=unchanged)
+	ScrubRate       uint32                       `protobuf:"varint,7,opt,name=scrub_rate,json=scrubRate,proto3" json:"scrub_rate,omitempty"`                   // Percent of the configured scrubbing rate (1-100, 0=unchanged)
+	Clear           bool                         `protobuf:"varint,8,opt,name=clear,proto3" json:"clear,omitempty"`                                            // Clear all hints before applying any new ones
 }
 
-func (x *PoolGetPropReq) Reset() {
-	*x = PoolGetPropReq{}
+func (x *PoolSetPolicyReq) Reset() {
+	*x = PoolSetPolicyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[25]
+		mi := &file_mgmt_pool_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolGetPropReq) String() string {
+func (x *PoolSetPolicyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolGetPropReq) ProtoMessage() {}
+func (*PoolSetPolicyReq) ProtoMessage() {}
 
-func (x *PoolGetPropReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[25]
+func (x *PoolSetPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2162,66 +3289,93 @@ func (x *PoolGetPropReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolGetPropReq.ProtoReflect.Descriptor instead.
-func (*PoolGetPropReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PoolSetPolicyReq.ProtoReflect.Descriptor instead.
+func (*PoolSetPolicyReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *PoolGetPropReq) GetSys() string {
+func (x *PoolSetPolicyReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolGetPropReq) GetId() string {
+func (x *PoolSetPolicyReq) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PoolGetPropReq) GetProperties() []*PoolProperty {
+func (x *PoolSetPolicyReq) GetSvcRanks() []uint32 {
 	if x != nil {
-		return x.Properties
+		return x.SvcRanks
 	}
 	return nil
 }
 
-func (x *PoolGetPropReq) GetSvcRanks() []uint32 {
+func (x *PoolSetPolicyReq) GetRanks() []uint32 {
 	if x != nil {
-		return x.SvcRanks
+		return x.Ranks
 	}
 	return nil
 }
 
-// PoolGetPropResp represents the result of getting pool properties.
-type PoolGetPropResp struct {
+func (x *PoolSetPolicyReq) GetAggregation() PoolSetPolicyReq_Aggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return PoolSetPolicyReq_UNCHANGED
+}
+
+func (x *PoolSetPolicyReq) GetRebuildThrottle() uint32 {
+	if x != nil {
+		return x.RebuildThrottle
+	}
+	return 0
+}
+
+func (x *PoolSetPolicyReq) GetScrubRate() uint32 {
+	if x != nil {
+		return x.ScrubRate
+	}
+	return 0
+}
+
+func (x *PoolSetPolicyReq) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+// PoolSetPolicyResp returns the status of a pool policy update.
+type PoolSetPolicyResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status     int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
-	Properties []*PoolProperty `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
 }
 
-func (x *PoolGetPropResp) Reset() {
-	*x = PoolGetPropResp{}
+func (x *PoolSetPolicyResp) Reset() {
+	*x = PoolSetPolicyResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[26]
+		mi := &file_mgmt_pool_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolGetPropResp) String() string {
+func (x *PoolSetPolicyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolGetPropResp) ProtoMessage() {}
+func (*PoolSetPolicyResp) ProtoMessage() {}
 
-func (x *PoolGetPropResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[26]
+func (x *PoolSetPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2232,54 +3386,48 @@ func (x *PoolGetPropResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolGetPropResp.ProtoReflect.Descriptor instead.
-func (*PoolGetPropResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PoolSetPolicyResp.ProtoReflect.Descriptor instead.
+func (*PoolSetPolicyResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *PoolGetPropResp) GetStatus() int32 {
+func (x *PoolSetPolicyResp) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *PoolGetPropResp) GetProperties() []*PoolProperty {
-	if x != nil {
-		return x.Properties
-	}
-	return nil
-}
-
-// PoolUpgradeReq upgrades the disk format of an existing pool to the
-// latest version.
-type PoolUpgradeReq struct {
+// PoolQueryAggregationReq requests the status of background aggregation on
+// a pool's targets.
+type PoolQueryAggregationReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
-	Id       string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Sys      string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                   // DAOS system identifier
+	Id       string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                     // uuid or label of pool
 	SvcRanks []uint32 `protobuf:"varint,3,rep,packed,name=svc_ranks,json=svcRanks,proto3" json:"svc_ranks,omitempty"` // List of pool service ranks
+	Ranks    []uint32 `protobuf:"varint,4,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`                       // engine ranks hosting the pool's targets
 }
 
-func (x *PoolUpgradeReq) Reset() {
-	*x = PoolUpgradeReq{}
+func (x *PoolQueryAggregationReq) Reset() {
+	*x = PoolQueryAggregationReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[27]
+		mi := &file_mgmt_pool_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolUpgradeReq) String() string {
+func (x *PoolQueryAggregationReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolUpgradeReq) ProtoMessage() {}
+func (*PoolQueryAggregationReq) ProtoMessage() {}
 
-func (x *PoolUpgradeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[27]
+func (x *PoolQueryAggregationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2290,58 +3438,68 @@ func (x *PoolUpgradeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolUpgradeReq.ProtoReflect.Descriptor instead.
-func (*PoolUpgradeReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use PoolQueryAggregationReq.ProtoReflect.Descriptor instead.
+func (*PoolQueryAggregationReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *PoolUpgradeReq) GetSys() string {
+func (x *PoolQueryAggregationReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolUpgradeReq) GetId() string {
+func (x *PoolQueryAggregationReq) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *PoolUpgradeReq) GetSvcRanks() []uint32 {
+func (x *PoolQueryAggregationReq) GetSvcRanks() []uint32 {
 	if x != nil {
 		return x.SvcRanks
 	}
 	return nil
 }
 
-// PoolUpgradeResp returns resultant state of upgrade operation.
-type PoolUpgradeResp struct {
+func (x *PoolQueryAggregationReq) GetRanks() []uint32 {
+	if x != nil {
+		return x.Ranks
+	}
+	return nil
+}
+
+// PoolQueryAggregationResp returns the aggregation status of the pool's
+// targets on each engine rank.
+type PoolQueryAggregationResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Status int32                            `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Uuid   string                           `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`      // pool uuid
+	Ranks  []*PoolQueryAggregationResp_Rank `protobuf:"bytes,3,rep,name=ranks,proto3" json:"ranks,omitempty"`    // per-rank aggregation status
 }
 
-func (x *PoolUpgradeResp) Reset() {
-	*x = PoolUpgradeResp{}
+func (x *PoolQueryAggregationResp) Reset() {
+	*x = PoolQueryAggregationResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[28]
+		mi := &file_mgmt_pool_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolUpgradeResp) String() string {
+func (x *PoolQueryAggregationResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolUpgradeResp) ProtoMessage() {}
+func (*PoolQueryAggregationResp) ProtoMessage() {}
 
-func (x *PoolUpgradeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[28]
+func (x *PoolQueryAggregationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2349,19 +3507,33 @@ func (x *PoolUpgradeResp) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolQueryAggregationResp.ProtoReflect.Descriptor instead.
+func (*PoolQueryAggregationResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PoolQueryAggregationResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
-// Deprecated: Use PoolUpgradeResp.ProtoReflect.Descriptor instead.
-func (*PoolUpgradeResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{28}
+func (x *PoolQueryAggregationResp) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
 }
 
-func (x *PoolUpgradeResp) GetStatus() int32 {
+func (x *PoolQueryAggregationResp) GetRanks() []*PoolQueryAggregationResp_Rank {
 	if x != nil {
-		return x.Status
+		return x.Ranks
 	}
-	return 0
+	return nil
 }
 
 // PoolQueryTargetReq represents a pool query target(s) request.
@@ -2380,7 +3552,7 @@ type PoolQueryTargetReq struct {
 func (x *PoolQueryTargetReq) Reset() {
 	*x = PoolQueryTargetReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[29]
+		mi := &file_mgmt_pool_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2393,7 +3565,7 @@ func (x *PoolQueryTargetReq) String() string {
 func (*PoolQueryTargetReq) ProtoMessage() {}
 
 func (x *PoolQueryTargetReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[29]
+	mi := &file_mgmt_pool_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,7 +3578,7 @@ func (x *PoolQueryTargetReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolQueryTargetReq.ProtoReflect.Descriptor instead.
 func (*PoolQueryTargetReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{29}
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *PoolQueryTargetReq) GetSys() string {
@@ -2458,7 +3630,7 @@ type StorageTargetUsage struct {
 func (x *StorageTargetUsage) Reset() {
 	*x = StorageTargetUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[30]
+		mi := &file_mgmt_pool_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2471,7 +3643,7 @@ func (x *StorageTargetUsage) String() string {
 func (*StorageTargetUsage) ProtoMessage() {}
 
 func (x *StorageTargetUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[30]
+	mi := &file_mgmt_pool_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2484,7 +3656,7 @@ func (x *StorageTargetUsage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorageTargetUsage.ProtoReflect.Descriptor instead.
 func (*StorageTargetUsage) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{30}
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *StorageTargetUsage) GetTotal() uint64 {
@@ -2524,7 +3696,7 @@ type PoolQueryTargetInfo struct {
 func (x *PoolQueryTargetInfo) Reset() {
 	*x = PoolQueryTargetInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[31]
+		mi := &file_mgmt_pool_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2537,7 +3709,7 @@ func (x *PoolQueryTargetInfo) String() string {
 func (*PoolQueryTargetInfo) ProtoMessage() {}
 
 func (x *PoolQueryTargetInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[31]
+	mi := &file_mgmt_pool_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2550,7 +3722,7 @@ func (x *PoolQueryTargetInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolQueryTargetInfo.ProtoReflect.Descriptor instead.
 func (*PoolQueryTargetInfo) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{31}
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *PoolQueryTargetInfo) GetType() PoolQueryTargetInfo_TargetType {
@@ -2587,7 +3759,7 @@ type PoolQueryTargetResp struct {
 func (x *PoolQueryTargetResp) Reset() {
 	*x = PoolQueryTargetResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[32]
+		mi := &file_mgmt_pool_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2600,7 +3772,7 @@ func (x *PoolQueryTargetResp) String() string {
 func (*PoolQueryTargetResp) ProtoMessage() {}
 
 func (x *PoolQueryTargetResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[32]
+	mi := &file_mgmt_pool_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2613,7 +3785,7 @@ func (x *PoolQueryTargetResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolQueryTargetResp.ProtoReflect.Descriptor instead.
 func (*PoolQueryTargetResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{32}
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *PoolQueryTargetResp) GetStatus() int32 {
@@ -2630,21 +3802,165 @@ func (x *PoolQueryTargetResp) GetInfos() []*PoolQueryTargetInfo {
 	return nil
 }
 
+type PoolCreateStatusResp_Rank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank  uint32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`  // target rank
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // state of the pool shards on the rank
+}
+
+func (x *PoolCreateStatusResp_Rank) Reset() {
+	*x = PoolCreateStatusResp_Rank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolCreateStatusResp_Rank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolCreateStatusResp_Rank) ProtoMessage() {}
+
+func (x *PoolCreateStatusResp_Rank) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolCreateStatusResp_Rank.ProtoReflect.Descriptor instead.
+func (*PoolCreateStatusResp_Rank) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *PoolCreateStatusResp_Rank) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PoolCreateStatusResp_Rank) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type PoolCleanupPartialResp_Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid    string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`        // uuid of pool
+	Label   string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`      // pool label
+	State   string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`      // pool state before cleanup
+	Removed bool   `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"` // pool shards destroyed and pool removed
+	Status  int32  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`   // DAOS error code
+	Msg     string `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg,omitempty"`          // reason the pool was not removed
+}
+
+func (x *PoolCleanupPartialResp_Pool) Reset() {
+	*x = PoolCleanupPartialResp_Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolCleanupPartialResp_Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolCleanupPartialResp_Pool) ProtoMessage() {}
+
+func (x *PoolCleanupPartialResp_Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolCleanupPartialResp_Pool.ProtoReflect.Descriptor instead.
+func (*PoolCleanupPartialResp_Pool) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *PoolCleanupPartialResp_Pool) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PoolCleanupPartialResp_Pool) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PoolCleanupPartialResp_Pool) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PoolCleanupPartialResp_Pool) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *PoolCleanupPartialResp_Pool) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PoolCleanupPartialResp_Pool) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
 type ListPoolsResp_Pool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid    string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                              // uuid of pool
-	Label   string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`                            // pool label
-	SvcReps []uint32 `protobuf:"varint,3,rep,packed,name=svc_reps,json=svcReps,proto3" json:"svc_reps,omitempty"` // pool service replica ranks
-	State   string   `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`                            // pool state
+	Uuid        string            `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                                                                       // uuid of pool
+	Label       string            `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`                                                                                                     // pool label
+	SvcReps     []uint32          `protobuf:"varint,3,rep,packed,name=svc_reps,json=svcReps,proto3" json:"svc_reps,omitempty"`                                                                          // pool service replica ranks
+	State       string            `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`                                                                                                     // pool state
+	Admins      []string          `protobuf:"bytes,5,rep,name=admins,proto3" json:"admins,omitempty"`                                                                                                   // principals delegated pool administration
+	Annotations map[string]string `protobuf:"bytes,6,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // notes recorded by administrators
 }
 
 func (x *ListPoolsResp_Pool) Reset() {
 	*x = ListPoolsResp_Pool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[33]
+		mi := &file_mgmt_pool_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2657,7 +3973,7 @@ func (x *ListPoolsResp_Pool) String() string {
 func (*ListPoolsResp_Pool) ProtoMessage() {}
 
 func (x *ListPoolsResp_Pool) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[33]
+	mi := &file_mgmt_pool_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2670,7 +3986,7 @@ func (x *ListPoolsResp_Pool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPoolsResp_Pool.ProtoReflect.Descriptor instead.
 func (*ListPoolsResp_Pool) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{15, 0}
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *ListPoolsResp_Pool) GetUuid() string {
@@ -2701,6 +4017,20 @@ func (x *ListPoolsResp_Pool) GetState() string {
 	return ""
 }
 
+func (x *ListPoolsResp_Pool) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *ListPoolsResp_Pool) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
 type ListContResp_Cont struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2712,7 +4042,7 @@ type ListContResp_Cont struct {
 func (x *ListContResp_Cont) Reset() {
 	*x = ListContResp_Cont{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_pool_proto_msgTypes[34]
+		mi := &file_mgmt_pool_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2725,7 +4055,7 @@ func (x *ListContResp_Cont) String() string {
 func (*ListContResp_Cont) ProtoMessage() {}
 
 func (x *ListContResp_Cont) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_pool_proto_msgTypes[34]
+	mi := &file_mgmt_pool_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2738,7 +4068,7 @@ func (x *ListContResp_Cont) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListContResp_Cont.ProtoReflect.Descriptor instead.
 func (*ListContResp_Cont) Descriptor() ([]byte, []int) {
-	return file_mgmt_pool_proto_rawDescGZIP(), []int{17, 0}
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *ListContResp_Cont) GetUuid() string {
@@ -2748,11 +4078,145 @@ func (x *ListContResp_Cont) GetUuid() string {
 	return ""
 }
 
+type PoolProbeResp_Replica struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank        uint32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`                                 // replica rank
+	State       string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`                                // replica state (leader, follower, excluded, down)
+	EngineState string `protobuf:"bytes,3,opt,name=engine_state,json=engineState,proto3" json:"engine_state,omitempty"` // system member state of the replica rank
+}
+
+func (x *PoolProbeResp_Replica) Reset() {
+	*x = PoolProbeResp_Replica{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolProbeResp_Replica) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolProbeResp_Replica) ProtoMessage() {}
+
+func (x *PoolProbeResp_Replica) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolProbeResp_Replica.ProtoReflect.Descriptor instead.
+func (*PoolProbeResp_Replica) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *PoolProbeResp_Replica) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PoolProbeResp_Replica) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PoolProbeResp_Replica) GetEngineState() string {
+	if x != nil {
+		return x.EngineState
+	}
+	return ""
+}
+
+type PoolQueryAggregationResp_Rank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank    uint32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`                      // engine rank
+	State   string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`                     // aggregation state (idle, running, paused)
+	Lag     uint64 `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`                        // seconds the oldest aggregated epoch trails the current time
+	LastRun uint64 `protobuf:"varint,4,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"` // completion time of the last aggregation pass in seconds since the epoch (0=never)
+}
+
+func (x *PoolQueryAggregationResp_Rank) Reset() {
+	*x = PoolQueryAggregationResp_Rank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_pool_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolQueryAggregationResp_Rank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolQueryAggregationResp_Rank) ProtoMessage() {}
+
+func (x *PoolQueryAggregationResp_Rank) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_pool_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolQueryAggregationResp_Rank.ProtoReflect.Descriptor instead.
+func (*PoolQueryAggregationResp_Rank) Descriptor() ([]byte, []int) {
+	return file_mgmt_pool_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *PoolQueryAggregationResp_Rank) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PoolQueryAggregationResp_Rank) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PoolQueryAggregationResp_Rank) GetLag() uint64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *PoolQueryAggregationResp_Rank) GetLastRun() uint64 {
+	if x != nil {
+		return x.LastRun
+	}
+	return 0
+}
+
 var File_mgmt_pool_proto protoreflect.FileDescriptor
 
 var file_mgmt_pool_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x04, 0x6d, 0x67, 0x6d, 0x74, 0x22, 0xff, 0x02, 0x0a, 0x0d, 0x50, 0x6f, 0x6f, 0x6c,
+	0x6f, 0x12, 0x04, 0x6d, 0x67, 0x6d, 0x74, 0x22, 0x95, 0x03, 0x0a, 0x0d, 0x50, 0x6f, 0x6f, 0x6c,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a,
 	0x03, 0x73, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
@@ -2776,157 +4240,239 @@ var file_mgmt_pool_proto_rawDesc = []byte{
 	0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x0c,
 	0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
 	0x69, 0x65, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09,
-	0x74, 0x69, 0x65, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x50, 0x6f,
-	0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08,
-	0x73, 0x76, 0x63, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07,
-	0x73, 0x76, 0x63, 0x52, 0x65, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x67, 0x74, 0x5f, 0x72,
-	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x67, 0x74, 0x52,
-	0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x65, 0x72, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x73, 0x74,
-	0x72, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0x29, 0x0a, 0x0f, 0x50, 0x6f, 0x6f,
-	0x6c, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x69, 0x65, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x79,
+	0x6e, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22,
+	0xae, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x76, 0x63, 0x52, 0x65, 0x70, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x67, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x08, 0x74, 0x67, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x22, 0x56, 0x0a, 0x13, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x22, 0xaf, 0x03, 0x0a, 0x14, 0x50, 0x6f, 0x6f,
+	0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52,
+	0x61, 0x6e, 0x6b, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x1a, 0x30, 0x0a, 0x04, 0x52, 0x61, 0x6e,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x3d, 0x0a, 0x05, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e,
+	0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e,
+	0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65,
+	0x22, 0x29, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x42, 0x0a, 0x15, 0x50,
+	0x6f, 0x6f, 0x6c, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0xf6, 0x01, 0x0a, 0x16, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x75, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x1a, 0x8a, 0x01, 0x0a, 0x04,
+	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xc0, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x6f,
+	0x6c, 0x45, 0x76, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08,
+	0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x3d, 0x0a, 0x0d, 0x50,
+	0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
 	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x69,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72,
-	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52,
-	0x61, 0x6e, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x3d, 0x0a, 0x0d, 0x50, 0x6f, 0x6f, 0x6c, 0x45,
-	0x76, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x45,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64, 0x78,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64,
+	0x78, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x29,
+	0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7f, 0x0a, 0x0c, 0x50, 0x6f, 0x6f,
+	0x6c, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72,
 	0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
 	0x1c, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x03,
 	0x28, 0x0d, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64, 0x78, 0x12, 0x1b, 0x0a,
 	0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d,
-	0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x50, 0x6f,
-	0x6f, 0x6c, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7f, 0x0a, 0x0c, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x72, 0x61,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x50, 0x6f,
+	0x6f, 0x6c, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63,
-	0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76,
-	0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x72,
-	0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0xa6, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0d, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63,
-	0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76,
-	0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x65, 0x72, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x65, 0x72, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x47, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x69, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
-	0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64, 0x78, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0d, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52,
-	0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x65,
-	0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
-	0x65, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
-	0x65, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x65, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x65, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x47, 0x0a, 0x0e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x20, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
-	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f,
-	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f,
-	0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x61, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x72,
-	0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x76, 0x63, 0x52, 0x65,
-	0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x4c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63,
-	0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76,
-	0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x7b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x1a, 0x1a, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61,
-	0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61,
-	0x6e, 0x6b, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0xac, 0x01,
-	0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6d, 0x61,
-	0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x35, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbb, 0x01, 0x0a,
-	0x11, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x22, 0x25, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x65, 0x72,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64, 0x78, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x64, 0x78,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x65, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x09, 0x74, 0x69, 0x65, 0x72, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x74, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0xab, 0x03, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x6f,
+	0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x86, 0x02, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f,
+	0x72, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x76, 0x63, 0x52,
+	0x65, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f, 0x6f, 0x6c,
+	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3e,
+	0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x7b, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x1a, 0x1a, 0x0a,
+	0x04, 0x43, 0x6f, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x0c, 0x50, 0x6f,
+	0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e,
+	0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x34, 0x0a,
+	0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x61,
+	0x6e, 0x6b, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66,
+	0x72, 0x65, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x35, 0x0a, 0x0a, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0xc5, 0x02, 0x0a, 0x11, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x52,
+	0x61, 0x6e, 0x6b, 0x22, 0x25, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04,
 	0x49, 0x44, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x01,
-	0x12, 0x08, 0x0a, 0x04, 0x42, 0x55, 0x53, 0x59, 0x10, 0x02, 0x22, 0xbf, 0x04, 0x0a, 0x0d, 0x50,
+	0x12, 0x08, 0x0a, 0x04, 0x42, 0x55, 0x53, 0x59, 0x10, 0x02, 0x22, 0xda, 0x04, 0x0a, 0x0d, 0x50,
 	0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
 	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
 	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
@@ -2961,98 +4507,201 @@ var file_mgmt_pool_proto_rawDesc = []byte{
 	0x6f, 0x6c, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x75,
 	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x65,
 	0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x52,
-	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x0c,
-	0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18,
-	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
-	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x76, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76, 0x63,
+	0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x76, 0x63,
+	0x52, 0x65, 0x70, 0x73, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x0c, 0x50, 0x6f, 0x6f, 0x6c, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x76, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x83, 0x01, 0x0a,
+	0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e,
+	0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e,
+	0x6b, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x83, 0x01,
+	0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61,
+	0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61,
+	0x6e, 0x6b, 0x73, 0x22, 0x5d, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x22, 0x4f, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76,
-	0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73,
-	0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x53,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61,
+	0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61,
+	0x6e, 0x6b, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4c,
+	0x0a, 0x10, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0x43, 0x0a, 0x11,
+	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x73, 0x22, 0xbd, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52,
-	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73,
-	0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08,
-	0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x5d, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50,
-	0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x48, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xb5, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49,
+	0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4d, 0x0a, 0x0c, 0x50, 0x6f, 0x6f,
+	0x6c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
 	0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08,
-	0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
-	0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0d, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76,
-	0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73,
-	0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x75, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0xda,
-	0x02, 0x0a, 0x13, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a,
-	0x05, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x3b, 0x0a,
-	0x0a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x44, 0x44, 0x10,
-	0x01, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x53, 0x44, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x50, 0x4d,
-	0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x4d, 0x10, 0x04, 0x22, 0x5f, 0x0a, 0x0b, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x44, 0x4f, 0x57, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f,
-	0x57, 0x4e, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05,
-	0x55, 0x50, 0x5f, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x05,
-	0x12, 0x09, 0x0a, 0x05, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x10, 0x06, 0x22, 0x5e, 0x0a, 0x13, 0x50,
-	0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x6e,
-	0x66, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x2a, 0x25, 0x0a, 0x10, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x07, 0x0a, 0x03, 0x53, 0x43, 0x4d, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x56, 0x4d, 0x45,
-	0x10, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73,
-	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0xb3, 0x02, 0x0a, 0x0d, 0x50, 0x6f, 0x6f,
+	0x6c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x56, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0xc6,
+	0x02, 0x0a, 0x10, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e,
+	0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e,
+	0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74,
+	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72,
+	0x75, 0x62, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
+	0x63, 0x72, 0x75, 0x62, 0x52, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x37,
+	0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x0a,
+	0x09, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53,
+	0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x2b, 0x0a, 0x11, 0x50, 0x6f, 0x6f, 0x6c, 0x53,
+	0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x6e, 0x0a, 0x17, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x72,
+	0x61, 0x6e, 0x6b, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x18, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x39, 0x0a,
+	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x1a, 0x5d, 0x0a, 0x04, 0x52, 0x61, 0x6e, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x6f, 0x6c,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x08, 0x73, 0x76, 0x63, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x75, 0x0a, 0x12, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0xda, 0x02, 0x0a, 0x13, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x22, 0x3b, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x48, 0x44, 0x44, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x53, 0x44, 0x10, 0x02, 0x12, 0x06,
+	0x0a, 0x02, 0x50, 0x4d, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x4d, 0x10, 0x04, 0x22, 0x5f,
+	0x0a, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x0a,
+	0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x4f, 0x57, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x03,
+	0x12, 0x09, 0x0a, 0x05, 0x55, 0x50, 0x5f, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x4e,
+	0x45, 0x57, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x10, 0x06, 0x22,
+	0x5e, 0x0a, 0x13, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f,
+	0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x2a,
+	0x25, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x43, 0x4d, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x56, 0x4d, 0x45, 0x10, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f,
+	0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x67,
+	0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3067,70 +4716,103 @@ func file_mgmt_pool_proto_rawDescGZIP() []byte {
 	return file_mgmt_pool_proto_rawDescData
 }
 
-var file_mgmt_pool_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_mgmt_pool_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_mgmt_pool_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_mgmt_pool_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_mgmt_pool_proto_goTypes = []interface{}{
-	(StorageMediaType)(0),                // 0: mgmt.StorageMediaType
-	(PoolRebuildStatus_State)(0),         // 1: mgmt.PoolRebuildStatus.State
-	(PoolQueryTargetInfo_TargetType)(0),  // 2: mgmt.PoolQueryTargetInfo.TargetType
-	(PoolQueryTargetInfo_TargetState)(0), // 3: mgmt.PoolQueryTargetInfo.TargetState
-	(*PoolCreateReq)(nil),                // 4: mgmt.PoolCreateReq
-	(*PoolCreateResp)(nil),               // 5: mgmt.PoolCreateResp
-	(*PoolDestroyReq)(nil),               // 6: mgmt.PoolDestroyReq
-	(*PoolDestroyResp)(nil),              // 7: mgmt.PoolDestroyResp
-	(*PoolEvictReq)(nil),                 // 8: mgmt.PoolEvictReq
-	(*PoolEvictResp)(nil),                // 9: mgmt.PoolEvictResp
-	(*PoolExcludeReq)(nil),               // 10: mgmt.PoolExcludeReq
-	(*PoolExcludeResp)(nil),              // 11: mgmt.PoolExcludeResp
-	(*PoolDrainReq)(nil),                 // 12: mgmt.PoolDrainReq
-	(*PoolDrainResp)(nil),                // 13: mgmt.PoolDrainResp
-	(*PoolExtendReq)(nil),                // 14: mgmt.PoolExtendReq
-	(*PoolExtendResp)(nil),               // 15: mgmt.PoolExtendResp
-	(*PoolReintegrateReq)(nil),           // 16: mgmt.PoolReintegrateReq
-	(*PoolReintegrateResp)(nil),          // 17: mgmt.PoolReintegrateResp
-	(*ListPoolsReq)(nil),                 // 18: mgmt.ListPoolsReq
-	(*ListPoolsResp)(nil),                // 19: mgmt.ListPoolsResp
-	(*ListContReq)(nil),                  // 20: mgmt.ListContReq
-	(*ListContResp)(nil),                 // 21: mgmt.ListContResp
-	(*PoolQueryReq)(nil),                 // 22: mgmt.PoolQueryReq
-	(*StorageUsageStats)(nil),            // 23: mgmt.StorageUsageStats
-	(*PoolRebuildStatus)(nil),            // 24: mgmt.PoolRebuildStatus
-	(*PoolQueryResp)(nil),                // 25: mgmt.PoolQueryResp
-	(*PoolProperty)(nil),                 // 26: mgmt.PoolProperty
-	(*PoolSetPropReq)(nil),               // 27: mgmt.PoolSetPropReq
-	(*PoolSetPropResp)(nil),              // 28: mgmt.PoolSetPropResp
-	(*PoolGetPropReq)(nil),               // 29: mgmt.PoolGetPropReq
-	(*PoolGetPropResp)(nil),              // 30: mgmt.PoolGetPropResp
-	(*PoolUpgradeReq)(nil),               // 31: mgmt.PoolUpgradeReq
-	(*PoolUpgradeResp)(nil),              // 32: mgmt.PoolUpgradeResp
-	(*PoolQueryTargetReq)(nil),           // 33: mgmt.PoolQueryTargetReq
-	(*StorageTargetUsage)(nil),           // 34: mgmt.StorageTargetUsage
-	(*PoolQueryTargetInfo)(nil),          // 35: mgmt.PoolQueryTargetInfo
-	(*PoolQueryTargetResp)(nil),          // 36: mgmt.PoolQueryTargetResp
-	(*ListPoolsResp_Pool)(nil),           // 37: mgmt.ListPoolsResp.Pool
-	(*ListContResp_Cont)(nil),            // 38: mgmt.ListContResp.Cont
+	(StorageMediaType)(0),                 // 0: mgmt.StorageMediaType
+	(PoolCreateStatusResp_State)(0),       // 1: mgmt.PoolCreateStatusResp.State
+	(PoolRebuildStatus_State)(0),          // 2: mgmt.PoolRebuildStatus.State
+	(PoolSetPolicyReq_Aggregation)(0),     // 3: mgmt.PoolSetPolicyReq.Aggregation
+	(PoolQueryTargetInfo_TargetType)(0),   // 4: mgmt.PoolQueryTargetInfo.TargetType
+	(PoolQueryTargetInfo_TargetState)(0),  // 5: mgmt.PoolQueryTargetInfo.TargetState
+	(*PoolCreateReq)(nil),                 // 6: mgmt.PoolCreateReq
+	(*PoolCreateResp)(nil),                // 7: mgmt.PoolCreateResp
+	(*PoolCreateStatusReq)(nil),           // 8: mgmt.PoolCreateStatusReq
+	(*PoolCreateStatusResp)(nil),          // 9: mgmt.PoolCreateStatusResp
+	(*PoolDestroyReq)(nil),                // 10: mgmt.PoolDestroyReq
+	(*PoolDestroyResp)(nil),               // 11: mgmt.PoolDestroyResp
+	(*PoolCleanupPartialReq)(nil),         // 12: mgmt.PoolCleanupPartialReq
+	(*PoolCleanupPartialResp)(nil),        // 13: mgmt.PoolCleanupPartialResp
+	(*PoolEvictReq)(nil),                  // 14: mgmt.PoolEvictReq
+	(*PoolEvictResp)(nil),                 // 15: mgmt.PoolEvictResp
+	(*PoolExcludeReq)(nil),                // 16: mgmt.PoolExcludeReq
+	(*PoolExcludeResp)(nil),               // 17: mgmt.PoolExcludeResp
+	(*PoolDrainReq)(nil),                  // 18: mgmt.PoolDrainReq
+	(*PoolDrainResp)(nil),                 // 19: mgmt.PoolDrainResp
+	(*PoolExtendReq)(nil),                 // 20: mgmt.PoolExtendReq
+	(*PoolExtendResp)(nil),                // 21: mgmt.PoolExtendResp
+	(*PoolReintegrateReq)(nil),            // 22: mgmt.PoolReintegrateReq
+	(*PoolReintegrateResp)(nil),           // 23: mgmt.PoolReintegrateResp
+	(*ListPoolsReq)(nil),                  // 24: mgmt.ListPoolsReq
+	(*ListPoolsResp)(nil),                 // 25: mgmt.ListPoolsResp
+	(*ListContReq)(nil),                   // 26: mgmt.ListContReq
+	(*ListContResp)(nil),                  // 27: mgmt.ListContResp
+	(*PoolQueryReq)(nil),                  // 28: mgmt.PoolQueryReq
+	(*StorageUsageStats)(nil),             // 29: mgmt.StorageUsageStats
+	(*PoolRebuildStatus)(nil),             // 30: mgmt.PoolRebuildStatus
+	(*PoolQueryResp)(nil),                 // 31: mgmt.PoolQueryResp
+	(*PoolProperty)(nil),                  // 32: mgmt.PoolProperty
+	(*PoolSetPropReq)(nil),                // 33: mgmt.PoolSetPropReq
+	(*PoolSetPropResp)(nil),               // 34: mgmt.PoolSetPropResp
+	(*PoolGetPropReq)(nil),                // 35: mgmt.PoolGetPropReq
+	(*PoolGetPropResp)(nil),               // 36: mgmt.PoolGetPropResp
+	(*PoolUpgradeReq)(nil),                // 37: mgmt.PoolUpgradeReq
+	(*PoolUpgradeResp)(nil),               // 38: mgmt.PoolUpgradeResp
+	(*PoolSetAdminsReq)(nil),              // 39: mgmt.PoolSetAdminsReq
+	(*PoolSetAdminsResp)(nil),             // 40: mgmt.PoolSetAdminsResp
+	(*PoolAnnotateReq)(nil),               // 41: mgmt.PoolAnnotateReq
+	(*PoolAnnotateResp)(nil),              // 42: mgmt.PoolAnnotateResp
+	(*PoolProbeReq)(nil),                  // 43: mgmt.PoolProbeReq
+	(*PoolProbeResp)(nil),                 // 44: mgmt.PoolProbeResp
+	(*PoolSetPolicyReq)(nil),              // 45: mgmt.PoolSetPolicyReq
+	(*PoolSetPolicyResp)(nil),             // 46: mgmt.PoolSetPolicyResp
+	(*PoolQueryAggregationReq)(nil),       // 47: mgmt.PoolQueryAggregationReq
+	(*PoolQueryAggregationResp)(nil),      // 48: mgmt.PoolQueryAggregationResp
+	(*PoolQueryTargetReq)(nil),            // 49: mgmt.PoolQueryTargetReq
+	(*StorageTargetUsage)(nil),            // 50: mgmt.StorageTargetUsage
+	(*PoolQueryTargetInfo)(nil),           // 51: mgmt.PoolQueryTargetInfo
+	(*PoolQueryTargetResp)(nil),           // 52: mgmt.PoolQueryTargetResp
+	(*PoolCreateStatusResp_Rank)(nil),     // 53: mgmt.PoolCreateStatusResp.Rank
+	(*PoolCleanupPartialResp_Pool)(nil),   // 54: mgmt.PoolCleanupPartialResp.Pool
+	(*ListPoolsResp_Pool)(nil),            // 55: mgmt.ListPoolsResp.Pool
+	nil,                                   // 56: mgmt.ListPoolsResp.Pool.AnnotationsEntry
+	(*ListContResp_Cont)(nil),             // 57: mgmt.ListContResp.Cont
+	nil,                                   // 58: mgmt.PoolAnnotateReq.AnnotationsEntry
+	nil,                                   // 59: mgmt.PoolAnnotateResp.AnnotationsEntry
+	(*PoolProbeResp_Replica)(nil),         // 60: mgmt.PoolProbeResp.Replica
+	(*PoolQueryAggregationResp_Rank)(nil), // 61: mgmt.PoolQueryAggregationResp.Rank
 }
 var file_mgmt_pool_proto_depIdxs = []int32{
-	26, // 0: mgmt.PoolCreateReq.properties:type_name -> mgmt.PoolProperty
-	37, // 1: mgmt.ListPoolsResp.pools:type_name -> mgmt.ListPoolsResp.Pool
-	38, // 2: mgmt.ListContResp.containers:type_name -> mgmt.ListContResp.Cont
-	0,  // 3: mgmt.StorageUsageStats.media_type:type_name -> mgmt.StorageMediaType
-	1,  // 4: mgmt.PoolRebuildStatus.state:type_name -> mgmt.PoolRebuildStatus.State
-	24, // 5: mgmt.PoolQueryResp.rebuild:type_name -> mgmt.PoolRebuildStatus
-	23, // 6: mgmt.PoolQueryResp.tier_stats:type_name -> mgmt.StorageUsageStats
-	26, // 7: mgmt.PoolSetPropReq.properties:type_name -> mgmt.PoolProperty
-	26, // 8: mgmt.PoolGetPropReq.properties:type_name -> mgmt.PoolProperty
-	26, // 9: mgmt.PoolGetPropResp.properties:type_name -> mgmt.PoolProperty
-	0,  // 10: mgmt.StorageTargetUsage.media_type:type_name -> mgmt.StorageMediaType
-	2,  // 11: mgmt.PoolQueryTargetInfo.type:type_name -> mgmt.PoolQueryTargetInfo.TargetType
-	3,  // 12: mgmt.PoolQueryTargetInfo.state:type_name -> mgmt.PoolQueryTargetInfo.TargetState
-	34, // 13: mgmt.PoolQueryTargetInfo.space:type_name -> mgmt.StorageTargetUsage
-	35, // 14: mgmt.PoolQueryTargetResp.infos:type_name -> mgmt.PoolQueryTargetInfo
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	32, // 0: mgmt.PoolCreateReq.properties:type_name -> mgmt.PoolProperty
+	1,  // 1: mgmt.PoolCreateStatusResp.state:type_name -> mgmt.PoolCreateStatusResp.State
+	53, // 2: mgmt.PoolCreateStatusResp.ranks:type_name -> mgmt.PoolCreateStatusResp.Rank
+	7,  // 3: mgmt.PoolCreateStatusResp.result:type_name -> mgmt.PoolCreateResp
+	54, // 4: mgmt.PoolCleanupPartialResp.pools:type_name -> mgmt.PoolCleanupPartialResp.Pool
+	55, // 5: mgmt.ListPoolsResp.pools:type_name -> mgmt.ListPoolsResp.Pool
+	57, // 6: mgmt.ListContResp.containers:type_name -> mgmt.ListContResp.Cont
+	0,  // 7: mgmt.StorageUsageStats.media_type:type_name -> mgmt.StorageMediaType
+	2,  // 8: mgmt.PoolRebuildStatus.state:type_name -> mgmt.PoolRebuildStatus.State
+	30, // 9: mgmt.PoolQueryResp.rebuild:type_name -> mgmt.PoolRebuildStatus
+	29, // 10: mgmt.PoolQueryResp.tier_stats:type_name -> mgmt.StorageUsageStats
+	32, // 11: mgmt.PoolSetPropReq.properties:type_name -> mgmt.PoolProperty
+	32, // 12: mgmt.PoolGetPropReq.properties:type_name -> mgmt.PoolProperty
+	32, // 13: mgmt.PoolGetPropResp.properties:type_name -> mgmt.PoolProperty
+	58, // 14: mgmt.PoolAnnotateReq.annotations:type_name -> mgmt.PoolAnnotateReq.AnnotationsEntry
+	59, // 15: mgmt.PoolAnnotateResp.annotations:type_name -> mgmt.PoolAnnotateResp.AnnotationsEntry
+	60, // 16: mgmt.PoolProbeResp.replicas:type_name -> mgmt.PoolProbeResp.Replica
+	3,  // 17: mgmt.PoolSetPolicyReq.aggregation:type_name -> mgmt.PoolSetPolicyReq.Aggregation
+	61, // 18: mgmt.PoolQueryAggregationResp.ranks:type_name -> mgmt.PoolQueryAggregationResp.Rank
+	0,  // 19: mgmt.StorageTargetUsage.media_type:type_name -> mgmt.StorageMediaType
+	4,  // 20: mgmt.PoolQueryTargetInfo.type:type_name -> mgmt.PoolQueryTargetInfo.TargetType
+	5,  // 21: mgmt.PoolQueryTargetInfo.state:type_name -> mgmt.PoolQueryTargetInfo.TargetState
+	50, // 22: mgmt.PoolQueryTargetInfo.space:type_name -> mgmt.StorageTargetUsage
+	51, // 23: mgmt.PoolQueryTargetResp.infos:type_name -> mgmt.PoolQueryTargetInfo
+	56, // 24: mgmt.ListPoolsResp.Pool.annotations:type_name -> mgmt.ListPoolsResp.Pool.AnnotationsEntry
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_mgmt_pool_proto_init() }
@@ -3164,7 +4846,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolDestroyReq); i {
+			switch v := v.(*PoolCreateStatusReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3176,7 +4858,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolDestroyResp); i {
+			switch v := v.(*PoolCreateStatusResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3188,7 +4870,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolEvictReq); i {
+			switch v := v.(*PoolDestroyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3200,7 +4882,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolEvictResp); i {
+			switch v := v.(*PoolDestroyResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3212,7 +4894,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolExcludeReq); i {
+			switch v := v.(*PoolCleanupPartialReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3224,7 +4906,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolExcludeResp); i {
+			switch v := v.(*PoolCleanupPartialResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3236,7 +4918,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolDrainReq); i {
+			switch v := v.(*PoolEvictReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3248,7 +4930,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolDrainResp); i {
+			switch v := v.(*PoolEvictResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3260,7 +4942,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolExtendReq); i {
+			switch v := v.(*PoolExcludeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3272,7 +4954,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolExtendResp); i {
+			switch v := v.(*PoolExcludeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3284,7 +4966,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolReintegrateReq); i {
+			switch v := v.(*PoolDrainReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3296,7 +4978,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolReintegrateResp); i {
+			switch v := v.(*PoolDrainResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3308,7 +4990,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPoolsReq); i {
+			switch v := v.(*PoolExtendReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3320,7 +5002,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPoolsResp); i {
+			switch v := v.(*PoolExtendResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3332,7 +5014,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListContReq); i {
+			switch v := v.(*PoolReintegrateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3344,7 +5026,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListContResp); i {
+			switch v := v.(*PoolReintegrateResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3356,7 +5038,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolQueryReq); i {
+			switch v := v.(*ListPoolsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3368,7 +5050,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageUsageStats); i {
+			switch v := v.(*ListPoolsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3380,7 +5062,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolRebuildStatus); i {
+			switch v := v.(*ListContReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3392,7 +5074,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolQueryResp); i {
+			switch v := v.(*ListContResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3404,7 +5086,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolProperty); i {
+			switch v := v.(*PoolQueryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3416,7 +5098,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolSetPropReq); i {
+			switch v := v.(*StorageUsageStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3428,7 +5110,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolSetPropResp); i {
+			switch v := v.(*PoolRebuildStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3440,7 +5122,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolGetPropReq); i {
+			switch v := v.(*PoolQueryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3452,7 +5134,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolGetPropResp); i {
+			switch v := v.(*PoolProperty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3464,7 +5146,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolUpgradeReq); i {
+			switch v := v.(*PoolSetPropReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3476,7 +5158,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolUpgradeResp); i {
+			switch v := v.(*PoolSetPropResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3488,7 +5170,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolQueryTargetReq); i {
+			switch v := v.(*PoolGetPropReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3500,7 +5182,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageTargetUsage); i {
+			switch v := v.(*PoolGetPropResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3512,7 +5194,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolQueryTargetInfo); i {
+			switch v := v.(*PoolUpgradeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3524,7 +5206,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolQueryTargetResp); i {
+			switch v := v.(*PoolUpgradeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3536,7 +5218,7 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPoolsResp_Pool); i {
+			switch v := v.(*PoolSetAdminsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3548,6 +5230,198 @@ func file_mgmt_pool_proto_init() {
 			}
 		}
 		file_mgmt_pool_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolSetAdminsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolAnnotateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolAnnotateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolProbeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolProbeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolSetPolicyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolSetPolicyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolQueryAggregationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolQueryAggregationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolQueryTargetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageTargetUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolQueryTargetInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolQueryTargetResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolCreateStatusResp_Rank); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolCleanupPartialResp_Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPoolsResp_Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListContResp_Cont); i {
 			case 0:
 				return &v.state
@@ -3559,8 +5433,32 @@ func file_mgmt_pool_proto_init() {
 				return nil
 			}
 		}
+		file_mgmt_pool_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolProbeResp_Replica); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_pool_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolQueryAggregationResp_Rank); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_mgmt_pool_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_mgmt_pool_proto_msgTypes[26].OneofWrappers = []interface{}{
 		(*PoolProperty_Strval)(nil),
 		(*PoolProperty_Numval)(nil),
 	}
@@ -3569,8 +5467,8 @@ func file_mgmt_pool_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mgmt_pool_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   35,
+			NumEnums:      6,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
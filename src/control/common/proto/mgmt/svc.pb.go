@@ -72,6 +72,61 @@ func (JoinResp_State) EnumDescriptor() ([]byte, []int) {
 	return file_mgmt_svc_proto_rawDescGZIP(), []int{4, 0}
 }
 
+type JoinProgress_Stage int32
+
+const (
+	JoinProgress_QUEUED               JoinProgress_Stage = 0 // Request queued for the next join batch.
+	JoinProgress_VALIDATED            JoinProgress_Stage = 1 // Superblock identity and fault domain validated.
+	JoinProgress_RANK_ASSIGNED        JoinProgress_Stage = 2 // Rank assigned in the system membership.
+	JoinProgress_MEMBERSHIP_CONFIRMED JoinProgress_Stage = 3 // Group map (and SWIM membership) updated on engines.
+	JoinProgress_DONE                 JoinProgress_Stage = 4 // Join complete, final response included.
+)
+
+// Enum value maps for JoinProgress_Stage.
+var (
+	JoinProgress_Stage_name = map[int32]string{
+		0: "QUEUED",
+		1: "VALIDATED",
+		2: "RANK_ASSIGNED",
+		3: "MEMBERSHIP_CONFIRMED",
+		4: "DONE",
+	}
+	JoinProgress_Stage_value = map[string]int32{
+		"QUEUED":               0,
+		"VALIDATED":            1,
+		"RANK_ASSIGNED":        2,
+		"MEMBERSHIP_CONFIRMED": 3,
+		"DONE":                 4,
+	}
+)
+
+func (x JoinProgress_Stage) Enum() *JoinProgress_Stage {
+	p := new(JoinProgress_Stage)
+	*p = x
+	return p
+}
+
+func (x JoinProgress_Stage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinProgress_Stage) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_svc_proto_enumTypes[1].Descriptor()
+}
+
+func (JoinProgress_Stage) Type() protoreflect.EnumType {
+	return &file_mgmt_svc_proto_enumTypes[1]
+}
+
+func (x JoinProgress_Stage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinProgress_Stage.Descriptor instead.
+func (JoinProgress_Stage) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{6, 0}
+}
+
 // Generic response just containing DER from I/O Engine.
 type DaosResp struct {
 	state         protoimpl.MessageState
@@ -227,15 +282,16 @@ type JoinReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys            string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                       // DAOS system name.
-	Uuid           string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`                     // Server UUID.
-	Rank           uint32 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                    // Server rank desired, if not MAX_UINT32.
-	Uri            string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`                       // Server CaRT base URI (i.e., for context 0).
-	Nctxs          uint32 `protobuf:"varint,5,opt,name=nctxs,proto3" json:"nctxs,omitempty"`                  // Server CaRT context count.
-	Addr           string `protobuf:"bytes,6,opt,name=addr,proto3" json:"addr,omitempty"`                     // Server management address.
-	SrvFaultDomain string `protobuf:"bytes,7,opt,name=srvFaultDomain,proto3" json:"srvFaultDomain,omitempty"` // Fault domain for this instance's server
-	Idx            uint32 `protobuf:"varint,8,opt,name=idx,proto3" json:"idx,omitempty"`                      // Instance index on server node.
-	Incarnation    uint64 `protobuf:"varint,9,opt,name=incarnation,proto3" json:"incarnation,omitempty"`      // rank incarnation
+	Sys            string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                           // DAOS system name.
+	Uuid           string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`                                         // Server UUID.
+	Rank           uint32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                        // Server rank desired, if not MAX_UINT32.
+	Uri            string   `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`                                           // Server CaRT base URI (i.e., for context 0).
+	Nctxs          uint32   `protobuf:"varint,5,opt,name=nctxs,proto3" json:"nctxs,omitempty"`                                      // Server CaRT context count.
+	Addr           string   `protobuf:"bytes,6,opt,name=addr,proto3" json:"addr,omitempty"`                                         // Server management address.
+	SrvFaultDomain string   `protobuf:"bytes,7,opt,name=srvFaultDomain,proto3" json:"srvFaultDomain,omitempty"`                     // Fault domain for this instance's server
+	Idx            uint32   `protobuf:"varint,8,opt,name=idx,proto3" json:"idx,omitempty"`                                          // Instance index on server node.
+	Incarnation    uint64   `protobuf:"varint,9,opt,name=incarnation,proto3" json:"incarnation,omitempty"`                          // rank incarnation
+	SecondaryUris  []string `protobuf:"bytes,10,rep,name=secondary_uris,json=secondaryUris,proto3" json:"secondary_uris,omitempty"` // Additional CaRT URIs, e.g. for a fallback provider
 }
 
 func (x *JoinReq) Reset() {
@@ -333,6 +389,13 @@ func (x *JoinReq) GetIncarnation() uint64 {
 	return 0
 }
 
+func (x *JoinReq) GetSecondaryUris() []string {
+	if x != nil {
+		return x.SecondaryUris
+	}
+	return nil
+}
+
 type JoinResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -343,6 +406,7 @@ type JoinResp struct {
 	State       JoinResp_State `protobuf:"varint,3,opt,name=state,proto3,enum=mgmt.JoinResp_State" json:"state,omitempty"` // Server state in the system map.
 	FaultDomain string         `protobuf:"bytes,4,opt,name=faultDomain,proto3" json:"faultDomain,omitempty"`               // Fault domain for the instance
 	LocalJoin   bool           `protobuf:"varint,5,opt,name=localJoin,proto3" json:"localJoin,omitempty"`                  // Join processed locally.
+	Prime       *JoinPrimeData `protobuf:"bytes,6,opt,name=prime,proto3" json:"prime,omitempty"`                           // State to prime caches on the joined rank.
 }
 
 func (x *JoinResp) Reset() {
@@ -412,6 +476,142 @@ func (x *JoinResp) GetLocalJoin() bool {
 	return false
 }
 
+func (x *JoinResp) GetPrime() *JoinPrimeData {
+	if x != nil {
+		return x.Prime
+	}
+	return nil
+}
+
+// JoinPrimeData contains system state pushed by the MS to a newly joined rank
+// in order to avoid lazy lookups when the rank first services requests.
+type JoinPrimeData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MapVersion uint32                `protobuf:"varint,1,opt,name=map_version,json=mapVersion,proto3" json:"map_version,omitempty"` // System map version at the time of the join.
+	Pools      []*JoinPrimeData_Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`                              // Ready pool services.
+}
+
+func (x *JoinPrimeData) Reset() {
+	*x = JoinPrimeData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_svc_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinPrimeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinPrimeData) ProtoMessage() {}
+
+func (x *JoinPrimeData) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_svc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinPrimeData.ProtoReflect.Descriptor instead.
+func (*JoinPrimeData) Descriptor() ([]byte, []int) {
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *JoinPrimeData) GetMapVersion() uint32 {
+	if x != nil {
+		return x.MapVersion
+	}
+	return 0
+}
+
+func (x *JoinPrimeData) GetPools() []*JoinPrimeData_Pool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+// JoinProgress reports the progress of a join request through the MS leader.
+type JoinProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage JoinProgress_Stage `protobuf:"varint,1,opt,name=stage,proto3,enum=mgmt.JoinProgress_Stage" json:"stage,omitempty"`
+	Rank  uint32             `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"` // Rank assigned, once known.
+	Info  string             `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`  // Description of the stage.
+	Resp  *JoinResp          `protobuf:"bytes,4,opt,name=resp,proto3" json:"resp,omitempty"`  // Final response, set when stage is DONE.
+}
+
+func (x *JoinProgress) Reset() {
+	*x = JoinProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_svc_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinProgress) ProtoMessage() {}
+
+func (x *JoinProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_svc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinProgress.ProtoReflect.Descriptor instead.
+func (*JoinProgress) Descriptor() ([]byte, []int) {
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *JoinProgress) GetStage() JoinProgress_Stage {
+	if x != nil {
+		return x.Stage
+	}
+	return JoinProgress_QUEUED
+}
+
+func (x *JoinProgress) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *JoinProgress) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *JoinProgress) GetResp() *JoinResp {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
 type LeaderQueryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -423,7 +623,7 @@ type LeaderQueryReq struct {
 func (x *LeaderQueryReq) Reset() {
 	*x = LeaderQueryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[5]
+		mi := &file_mgmt_svc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -436,7 +636,7 @@ func (x *LeaderQueryReq) String() string {
 func (*LeaderQueryReq) ProtoMessage() {}
 
 func (x *LeaderQueryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[5]
+	mi := &file_mgmt_svc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +649,7 @@ func (x *LeaderQueryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaderQueryReq.ProtoReflect.Descriptor instead.
 func (*LeaderQueryReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{5}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LeaderQueryReq) GetSys() string {
@@ -471,7 +671,7 @@ type LeaderQueryResp struct {
 func (x *LeaderQueryResp) Reset() {
 	*x = LeaderQueryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[6]
+		mi := &file_mgmt_svc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -484,7 +684,7 @@ func (x *LeaderQueryResp) String() string {
 func (*LeaderQueryResp) ProtoMessage() {}
 
 func (x *LeaderQueryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[6]
+	mi := &file_mgmt_svc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +697,7 @@ func (x *LeaderQueryResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaderQueryResp.ProtoReflect.Descriptor instead.
 func (*LeaderQueryResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{6}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *LeaderQueryResp) GetCurrentLeader() string {
@@ -526,7 +726,7 @@ type GetAttachInfoReq struct {
 func (x *GetAttachInfoReq) Reset() {
 	*x = GetAttachInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[7]
+		mi := &file_mgmt_svc_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +739,7 @@ func (x *GetAttachInfoReq) String() string {
 func (*GetAttachInfoReq) ProtoMessage() {}
 
 func (x *GetAttachInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[7]
+	mi := &file_mgmt_svc_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +752,7 @@ func (x *GetAttachInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAttachInfoReq.ProtoReflect.Descriptor instead.
 func (*GetAttachInfoReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{7}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetAttachInfoReq) GetSys() string {
@@ -588,7 +788,7 @@ type ClientNetHint struct {
 func (x *ClientNetHint) Reset() {
 	*x = ClientNetHint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[8]
+		mi := &file_mgmt_svc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -601,7 +801,7 @@ func (x *ClientNetHint) String() string {
 func (*ClientNetHint) ProtoMessage() {}
 
 func (x *ClientNetHint) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[8]
+	mi := &file_mgmt_svc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +814,7 @@ func (x *ClientNetHint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientNetHint.ProtoReflect.Descriptor instead.
 func (*ClientNetHint) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{8}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ClientNetHint) GetProvider() string {
@@ -690,7 +890,7 @@ type GetAttachInfoResp struct {
 func (x *GetAttachInfoResp) Reset() {
 	*x = GetAttachInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[9]
+		mi := &file_mgmt_svc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -703,7 +903,7 @@ func (x *GetAttachInfoResp) String() string {
 func (*GetAttachInfoResp) ProtoMessage() {}
 
 func (x *GetAttachInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[9]
+	mi := &file_mgmt_svc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +916,7 @@ func (x *GetAttachInfoResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAttachInfoResp.ProtoReflect.Descriptor instead.
 func (*GetAttachInfoResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{9}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetAttachInfoResp) GetStatus() int32 {
@@ -765,7 +965,7 @@ type PrepShutdownReq struct {
 func (x *PrepShutdownReq) Reset() {
 	*x = PrepShutdownReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[10]
+		mi := &file_mgmt_svc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -778,7 +978,7 @@ func (x *PrepShutdownReq) String() string {
 func (*PrepShutdownReq) ProtoMessage() {}
 
 func (x *PrepShutdownReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[10]
+	mi := &file_mgmt_svc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +991,7 @@ func (x *PrepShutdownReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepShutdownReq.ProtoReflect.Descriptor instead.
 func (*PrepShutdownReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{10}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PrepShutdownReq) GetRank() uint32 {
@@ -812,7 +1012,7 @@ type PingRankReq struct {
 func (x *PingRankReq) Reset() {
 	*x = PingRankReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[11]
+		mi := &file_mgmt_svc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -825,7 +1025,7 @@ func (x *PingRankReq) String() string {
 func (*PingRankReq) ProtoMessage() {}
 
 func (x *PingRankReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[11]
+	mi := &file_mgmt_svc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,7 +1038,7 @@ func (x *PingRankReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingRankReq.ProtoReflect.Descriptor instead.
 func (*PingRankReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{11}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PingRankReq) GetRank() uint32 {
@@ -859,7 +1059,7 @@ type SetRankReq struct {
 func (x *SetRankReq) Reset() {
 	*x = SetRankReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[12]
+		mi := &file_mgmt_svc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -872,7 +1072,7 @@ func (x *SetRankReq) String() string {
 func (*SetRankReq) ProtoMessage() {}
 
 func (x *SetRankReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[12]
+	mi := &file_mgmt_svc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,7 +1085,7 @@ func (x *SetRankReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetRankReq.ProtoReflect.Descriptor instead.
 func (*SetRankReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{12}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SetRankReq) GetRank() uint32 {
@@ -909,7 +1109,7 @@ type PoolMonitorReq struct {
 func (x *PoolMonitorReq) Reset() {
 	*x = PoolMonitorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[13]
+		mi := &file_mgmt_svc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -922,7 +1122,7 @@ func (x *PoolMonitorReq) String() string {
 func (*PoolMonitorReq) ProtoMessage() {}
 
 func (x *PoolMonitorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[13]
+	mi := &file_mgmt_svc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,7 +1135,7 @@ func (x *PoolMonitorReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolMonitorReq.ProtoReflect.Descriptor instead.
 func (*PoolMonitorReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{13}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PoolMonitorReq) GetSys() string {
@@ -966,6 +1166,135 @@ func (x *PoolMonitorReq) GetJobid() string {
 	return ""
 }
 
+// JobNotifyReq is sent to the agent by a job scheduler prolog or epilog to
+// report the start or end of a job on the local node.
+type JobNotifyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys   string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`     // DAOS system identifier
+	Jobid string `protobuf:"bytes,2,opt,name=jobid,proto3" json:"jobid,omitempty"` // Job ID reported by clients via DAOS_JOBID
+	Uid   uint32 `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`    // User ID that the job runs as
+	Nodes string `protobuf:"bytes,4,opt,name=nodes,proto3" json:"nodes,omitempty"` // Hostlist of nodes allocated to the job
+}
+
+func (x *JobNotifyReq) Reset() {
+	*x = JobNotifyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_svc_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobNotifyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobNotifyReq) ProtoMessage() {}
+
+func (x *JobNotifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_svc_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobNotifyReq.ProtoReflect.Descriptor instead.
+func (*JobNotifyReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *JobNotifyReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *JobNotifyReq) GetJobid() string {
+	if x != nil {
+		return x.Jobid
+	}
+	return ""
+}
+
+func (x *JobNotifyReq) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *JobNotifyReq) GetNodes() string {
+	if x != nil {
+		return x.Nodes
+	}
+	return ""
+}
+
+// JobNotifyResp returns the result of a job start or end notification.
+type JobNotifyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                       // DAOS error code
+	EvictedHandles uint32 `protobuf:"varint,2,opt,name=evicted_handles,json=evictedHandles,proto3" json:"evicted_handles,omitempty"` // Pool handles left open by the job and evicted
+}
+
+func (x *JobNotifyResp) Reset() {
+	*x = JobNotifyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_svc_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobNotifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobNotifyResp) ProtoMessage() {}
+
+func (x *JobNotifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_svc_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobNotifyResp.ProtoReflect.Descriptor instead.
+func (*JobNotifyResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *JobNotifyResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *JobNotifyResp) GetEvictedHandles() uint32 {
+	if x != nil {
+		return x.EvictedHandles
+	}
+	return 0
+}
+
 type GroupUpdateReq_Engine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -979,7 +1308,7 @@ type GroupUpdateReq_Engine struct {
 func (x *GroupUpdateReq_Engine) Reset() {
 	*x = GroupUpdateReq_Engine{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[14]
+		mi := &file_mgmt_svc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -992,7 +1321,7 @@ func (x *GroupUpdateReq_Engine) String() string {
 func (*GroupUpdateReq_Engine) ProtoMessage() {}
 
 func (x *GroupUpdateReq_Engine) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[14]
+	mi := &file_mgmt_svc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,6 +1358,69 @@ func (x *GroupUpdateReq_Engine) GetIncarnation() uint64 {
 	return 0
 }
 
+type JoinPrimeData_Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid    string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                              // Pool UUID.
+	Label   string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`                            // Pool label.
+	SvcReps []uint32 `protobuf:"varint,3,rep,packed,name=svc_reps,json=svcReps,proto3" json:"svc_reps,omitempty"` // Pool service replica ranks.
+}
+
+func (x *JoinPrimeData_Pool) Reset() {
+	*x = JoinPrimeData_Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_svc_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinPrimeData_Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinPrimeData_Pool) ProtoMessage() {}
+
+func (x *JoinPrimeData_Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_svc_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinPrimeData_Pool.ProtoReflect.Descriptor instead.
+func (*JoinPrimeData_Pool) Descriptor() ([]byte, []int) {
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *JoinPrimeData_Pool) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *JoinPrimeData_Pool) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *JoinPrimeData_Pool) GetSvcReps() []uint32 {
+	if x != nil {
+		return x.SvcReps
+	}
+	return nil
+}
+
 type GetAttachInfoResp_RankUri struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1041,7 +1433,7 @@ type GetAttachInfoResp_RankUri struct {
 func (x *GetAttachInfoResp_RankUri) Reset() {
 	*x = GetAttachInfoResp_RankUri{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_svc_proto_msgTypes[15]
+		mi := &file_mgmt_svc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1054,7 +1446,7 @@ func (x *GetAttachInfoResp_RankUri) String() string {
 func (*GetAttachInfoResp_RankUri) ProtoMessage() {}
 
 func (x *GetAttachInfoResp_RankUri) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_svc_proto_msgTypes[15]
+	mi := &file_mgmt_svc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,7 +1459,7 @@ func (x *GetAttachInfoResp_RankUri) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAttachInfoResp_RankUri.ProtoReflect.Descriptor instead.
 func (*GetAttachInfoResp_RankUri) Descriptor() ([]byte, []int) {
-	return file_mgmt_svc_proto_rawDescGZIP(), []int{9, 0}
+	return file_mgmt_svc_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *GetAttachInfoResp_RankUri) GetRank() uint32 {
@@ -1105,7 +1497,7 @@ var file_mgmt_svc_proto_rawDesc = []byte{
 	0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70,
 	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
 	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x07, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10,
+	0x75, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x07, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10,
 	0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73,
 	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01,
@@ -1119,84 +1511,125 @@ var file_mgmt_svc_proto_rawDesc = []byte{
 	0x69, 0x64, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x78, 0x12, 0x20,
 	0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xbc, 0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x22, 0x18, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x06,
-	0x0a, 0x02, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x22,
-	0x22, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x73, 0x79, 0x73, 0x22, 0x53, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
-	0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x0d,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12,
-	0x2b, 0x0a, 0x12, 0x63, 0x72, 0x74, 0x5f, 0x63, 0x74, 0x78, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x72, 0x74,
-	0x43, 0x74, 0x78, 0x53, 0x68, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
-	0x63, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x63, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x0a,
-	0x0d, 0x6e, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x43, 0x6c, 0x61, 0x73,
-	0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x73, 0x72, 0x76, 0x5f, 0x73, 0x72, 0x78, 0x5f, 0x73, 0x65, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x72, 0x76, 0x53, 0x72, 0x78, 0x53, 0x65,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x73, 0x22, 0x95, 0x02, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x61,
-	0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x72, 0x69, 0x52, 0x08,
-	0x72, 0x61, 0x6e, 0x6b, 0x55, 0x72, 0x69, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x5f, 0x72,
-	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x73, 0x52, 0x61,
-	0x6e, 0x6b, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x65,
-	0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x48, 0x69, 0x6e,
-	0x74, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x1a, 0x2f, 0x0a, 0x07, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x72, 0x69, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61,
-	0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x22, 0x25, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x75, 0x74,
-	0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x21, 0x0a, 0x0b, 0x50,
-	0x69, 0x6e, 0x67, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
-	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x20,
-	0x0a, 0x0a, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
-	0x22, 0x7c, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x73, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x55, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x55, 0x49, 0x44,
-	0x12, 0x26, 0x0a, 0x0e, 0x70, 0x6f, 0x6f, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x55,
-	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6f, 0x6f, 0x6c, 0x48, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x69, 0x64, 0x42, 0x3a,
-	0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f,
-	0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63,
-	0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x75, 0x72,
+	0x69, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x55, 0x72, 0x69, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x06, 0x0a, 0x02, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x55, 0x54, 0x10,
+	0x01, 0x22, 0xad, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x70, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x1a, 0x4b, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76, 0x63, 0x5f, 0x72, 0x65,
+	0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x76, 0x63, 0x52, 0x65, 0x70,
+	0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x04, 0x72, 0x65,
+	0x73, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x22, 0x59,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x45, 0x55, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47,
+	0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53,
+	0x48, 0x49, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x04, 0x22, 0x22, 0x0a, 0x0e, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x53, 0x0a,
+	0x0f, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x22, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x5f,
+	0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x6c, 0x6c,
+	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4e, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x12, 0x63, 0x72, 0x74,
+	0x5f, 0x63, 0x74, 0x78, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x72, 0x74, 0x43, 0x74, 0x78, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x74, 0x5f, 0x64,
+	0x65, 0x76, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x73,
+	0x72, 0x76, 0x5f, 0x73, 0x72, 0x78, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x73, 0x72, 0x76, 0x53, 0x72, 0x78, 0x53, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x76, 0x56, 0x61, 0x72, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x69,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x72, 0x69, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x55, 0x72,
+	0x69, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x3b, 0x0a,
+	0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2f, 0x0a,
+	0x07, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x25,
+	0x0a, 0x0f, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x21, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x20, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x7c, 0x0a, 0x0e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x55, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x6f, 0x6f, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x55,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x69, 0x64, 0x22, 0x5e, 0x0a, 0x0c, 0x4a, 0x6f, 0x62, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f,
+	0x62, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x76, 0x69, 0x63,
+	0x74, 0x65, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1211,37 +1644,47 @@ func file_mgmt_svc_proto_rawDescGZIP() []byte {
 	return file_mgmt_svc_proto_rawDescData
 }
 
-var file_mgmt_svc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mgmt_svc_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_mgmt_svc_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mgmt_svc_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_mgmt_svc_proto_goTypes = []interface{}{
 	(JoinResp_State)(0),               // 0: mgmt.JoinResp.State
-	(*DaosResp)(nil),                  // 1: mgmt.DaosResp
-	(*GroupUpdateReq)(nil),            // 2: mgmt.GroupUpdateReq
-	(*GroupUpdateResp)(nil),           // 3: mgmt.GroupUpdateResp
-	(*JoinReq)(nil),                   // 4: mgmt.JoinReq
-	(*JoinResp)(nil),                  // 5: mgmt.JoinResp
-	(*LeaderQueryReq)(nil),            // 6: mgmt.LeaderQueryReq
-	(*LeaderQueryResp)(nil),           // 7: mgmt.LeaderQueryResp
-	(*GetAttachInfoReq)(nil),          // 8: mgmt.GetAttachInfoReq
-	(*ClientNetHint)(nil),             // 9: mgmt.ClientNetHint
-	(*GetAttachInfoResp)(nil),         // 10: mgmt.GetAttachInfoResp
-	(*PrepShutdownReq)(nil),           // 11: mgmt.PrepShutdownReq
-	(*PingRankReq)(nil),               // 12: mgmt.PingRankReq
-	(*SetRankReq)(nil),                // 13: mgmt.SetRankReq
-	(*PoolMonitorReq)(nil),            // 14: mgmt.PoolMonitorReq
-	(*GroupUpdateReq_Engine)(nil),     // 15: mgmt.GroupUpdateReq.Engine
-	(*GetAttachInfoResp_RankUri)(nil), // 16: mgmt.GetAttachInfoResp.RankUri
+	(JoinProgress_Stage)(0),           // 1: mgmt.JoinProgress.Stage
+	(*DaosResp)(nil),                  // 2: mgmt.DaosResp
+	(*GroupUpdateReq)(nil),            // 3: mgmt.GroupUpdateReq
+	(*GroupUpdateResp)(nil),           // 4: mgmt.GroupUpdateResp
+	(*JoinReq)(nil),                   // 5: mgmt.JoinReq
+	(*JoinResp)(nil),                  // 6: mgmt.JoinResp
+	(*JoinPrimeData)(nil),             // 7: mgmt.JoinPrimeData
+	(*JoinProgress)(nil),              // 8: mgmt.JoinProgress
+	(*LeaderQueryReq)(nil),            // 9: mgmt.LeaderQueryReq
+	(*LeaderQueryResp)(nil),           // 10: mgmt.LeaderQueryResp
+	(*GetAttachInfoReq)(nil),          // 11: mgmt.GetAttachInfoReq
+	(*ClientNetHint)(nil),             // 12: mgmt.ClientNetHint
+	(*GetAttachInfoResp)(nil),         // 13: mgmt.GetAttachInfoResp
+	(*PrepShutdownReq)(nil),           // 14: mgmt.PrepShutdownReq
+	(*PingRankReq)(nil),               // 15: mgmt.PingRankReq
+	(*SetRankReq)(nil),                // 16: mgmt.SetRankReq
+	(*PoolMonitorReq)(nil),            // 17: mgmt.PoolMonitorReq
+	(*JobNotifyReq)(nil),              // 18: mgmt.JobNotifyReq
+	(*JobNotifyResp)(nil),             // 19: mgmt.JobNotifyResp
+	(*GroupUpdateReq_Engine)(nil),     // 20: mgmt.GroupUpdateReq.Engine
+	(*JoinPrimeData_Pool)(nil),        // 21: mgmt.JoinPrimeData.Pool
+	(*GetAttachInfoResp_RankUri)(nil), // 22: mgmt.GetAttachInfoResp.RankUri
 }
 var file_mgmt_svc_proto_depIdxs = []int32{
-	15, // 0: mgmt.GroupUpdateReq.engines:type_name -> mgmt.GroupUpdateReq.Engine
+	20, // 0: mgmt.GroupUpdateReq.engines:type_name -> mgmt.GroupUpdateReq.Engine
 	0,  // 1: mgmt.JoinResp.state:type_name -> mgmt.JoinResp.State
-	16, // 2: mgmt.GetAttachInfoResp.rank_uris:type_name -> mgmt.GetAttachInfoResp.RankUri
-	9,  // 3: mgmt.GetAttachInfoResp.client_net_hint:type_name -> mgmt.ClientNetHint
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	7,  // 2: mgmt.JoinResp.prime:type_name -> mgmt.JoinPrimeData
+	21, // 3: mgmt.JoinPrimeData.pools:type_name -> mgmt.JoinPrimeData.Pool
+	1,  // 4: mgmt.JoinProgress.stage:type_name -> mgmt.JoinProgress.Stage
+	6,  // 5: mgmt.JoinProgress.resp:type_name -> mgmt.JoinResp
+	22, // 6: mgmt.GetAttachInfoResp.rank_uris:type_name -> mgmt.GetAttachInfoResp.RankUri
+	12, // 7: mgmt.GetAttachInfoResp.client_net_hint:type_name -> mgmt.ClientNetHint
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_mgmt_svc_proto_init() }
@@ -1311,7 +1754,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaderQueryReq); i {
+			switch v := v.(*JoinPrimeData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1323,7 +1766,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaderQueryResp); i {
+			switch v := v.(*JoinProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1335,7 +1778,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAttachInfoReq); i {
+			switch v := v.(*LeaderQueryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1347,7 +1790,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientNetHint); i {
+			switch v := v.(*LeaderQueryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1359,7 +1802,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAttachInfoResp); i {
+			switch v := v.(*GetAttachInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1371,7 +1814,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrepShutdownReq); i {
+			switch v := v.(*ClientNetHint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1383,7 +1826,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRankReq); i {
+			switch v := v.(*GetAttachInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1395,7 +1838,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetRankReq); i {
+			switch v := v.(*PrepShutdownReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1407,7 +1850,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolMonitorReq); i {
+			switch v := v.(*PingRankReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1419,7 +1862,7 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupUpdateReq_Engine); i {
+			switch v := v.(*SetRankReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1431,6 +1874,66 @@ func file_mgmt_svc_proto_init() {
 			}
 		}
 		file_mgmt_svc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolMonitorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_svc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobNotifyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_svc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobNotifyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_svc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupUpdateReq_Engine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_svc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinPrimeData_Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_svc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAttachInfoResp_RankUri); i {
 			case 0:
 				return &v.state
@@ -1448,8 +1951,8 @@ func file_mgmt_svc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mgmt_svc_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      2,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
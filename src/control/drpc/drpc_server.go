@@ -12,6 +12,7 @@ import (
 	"os"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -36,6 +37,7 @@ type DomainSocketServer struct {
 	service       *ModuleService
 	sessions      map[net.Conn]*Session
 	sessionsMutex sync.Mutex
+	lastActive    time.Time
 }
 
 // closeSession cleans up the session and removes it from the list of active
@@ -44,9 +46,19 @@ func (d *DomainSocketServer) closeSession(s *Session) {
 	d.sessionsMutex.Lock()
 	s.Close()
 	delete(d.sessions, s.Conn)
+	d.lastActive = time.Now()
 	d.sessionsMutex.Unlock()
 }
 
+// IdleSince returns the time at which the last session was closed and true
+// if the server has no open sessions, or false if any session is open.
+func (d *DomainSocketServer) IdleSince() (time.Time, bool) {
+	d.sessionsMutex.Lock()
+	defer d.sessionsMutex.Unlock()
+
+	return d.lastActive, len(d.sessions) == 0
+}
+
 // listenSession runs the listening loop for a Session. It listens for incoming
 // dRPC calls and processes them.
 func (d *DomainSocketServer) listenSession(ctx context.Context, s *Session) {
@@ -85,8 +97,24 @@ func (d *DomainSocketServer) Listen(ctx context.Context) {
 	}
 }
 
+// SetListener supplies an already-listening socket (e.g. one passed in by
+// systemd socket activation) to be used instead of creating the socket file
+// on Start.
+func (d *DomainSocketServer) SetListener(lis net.Listener) {
+	d.listener = lis
+}
+
 // Start sets up the dRPC server socket and kicks off the listener goroutine.
 func (d *DomainSocketServer) Start(ctx context.Context) error {
+	d.sessionsMutex.Lock()
+	d.lastActive = time.Now()
+	d.sessionsMutex.Unlock()
+
+	if d.listener != nil {
+		go d.Listen(ctx)
+		return nil
+	}
+
 	// Just in case an old socket file is still lying around
 	if err := syscall.Unlink(d.sockFile); err != nil && !os.IsNotExist(err) {
 		return errors.Wrapf(err, "Unable to unlink %s", d.sockFile)
@@ -263,6 +263,41 @@ func TestServer_ListenSession_Error(t *testing.T) {
 	}
 }
 
+func TestServer_IdleSince(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx, shutdown := context.WithCancel(context.Background())
+	dss, _ := NewDomainSocketServer(log, "dontcare.sock", testFileMode)
+	lis := newCtxMockListener(ctx)
+	dss.SetListener(lis)
+	if err := dss.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		shutdown()
+		<-lis.closed
+	}()
+	started, idle := dss.IdleSince()
+	test.AssertTrue(t, idle, "server without sessions should be idle")
+
+	conn := newMockConn()
+	conn.ReadOutputError = errors.New("mock read error")
+	session := NewSession(conn, dss.service)
+	dss.sessionsMutex.Lock()
+	dss.sessions[conn] = session
+	dss.sessionsMutex.Unlock()
+
+	_, idle = dss.IdleSince()
+	test.AssertFalse(t, idle, "server with open session should not be idle")
+
+	dss.listenSession(context.Background(), session) // will return when error is sent
+
+	closed, idle := dss.IdleSince()
+	test.AssertTrue(t, idle, "server should be idle after session closed")
+	test.AssertFalse(t, closed.Before(started), "idle time should be updated on session close")
+}
+
 func TestServer_Shutdown(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	defer test.ShowBufferOnFailure(t, buf)
@@ -157,6 +157,12 @@ func (m MgmtMethod) String() string {
 		MethodPoolGetProp:          "PoolGetProp",
 		MethodPoolUpgrade:          "PoolUpgrade",
 		MethodLedManage:            "LedManage",
+		MethodNotifyJobStart:       "NotifyJobStart",
+		MethodNotifyJobEnd:         "NotifyJobEnd",
+		MethodPoolQueryAggregation: "PoolQueryAggregation",
+		MethodPoolSetPolicy:        "PoolSetPolicy",
+		MethodContCheck:            "ContCheck",
+		MethodBlobstoreUsage:       "BlobstoreUsage",
 	}[m]; ok {
 		return s
 	}
@@ -244,6 +250,18 @@ const (
 	MethodPoolUpgrade MgmtMethod = C.DRPC_METHOD_MGMT_POOL_UPGRADE
 	// MethodLedManage defines a method to manage a VMD device LED state
 	MethodLedManage MgmtMethod = C.DRPC_METHOD_MGMT_LED_MANAGE
+	// MethodNotifyJobStart defines a method for signaling the start of a scheduler job
+	MethodNotifyJobStart MgmtMethod = C.DRPC_METHOD_MGMT_NOTIFY_JOB_START
+	// MethodNotifyJobEnd defines a method for signaling the end of a scheduler job
+	MethodNotifyJobEnd MgmtMethod = C.DRPC_METHOD_MGMT_NOTIFY_JOB_END
+	// MethodPoolQueryAggregation defines a method for querying the aggregation status of a pool
+	MethodPoolQueryAggregation MgmtMethod = C.DRPC_METHOD_MGMT_POOL_QUERY_AGGREGATION
+	// MethodPoolSetPolicy defines a method for setting pool background task hints
+	MethodPoolSetPolicy MgmtMethod = C.DRPC_METHOD_MGMT_POOL_SET_POLICY
+	// MethodContCheck defines a method for checking a pool's container metadata
+	MethodContCheck MgmtMethod = C.DRPC_METHOD_MGMT_CONT_CHECK
+	// MethodBlobstoreUsage defines a method to query per-target SPDK blobstore usage
+	MethodBlobstoreUsage MgmtMethod = C.DRPC_METHOD_MGMT_BLOBSTORE_USAGE
 )
 
 type srvMethod int32
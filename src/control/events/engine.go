@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +8,7 @@ package events
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/daos-stack/daos/src/control/common"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
@@ -87,3 +88,41 @@ func NewEngineFormatRequiredEvent(hostname string, instanceIdx uint32, formatTyp
 		},
 	})
 }
+
+// NewEngineUnresponsiveEvent creates an EngineUnresponsive event from given inputs.
+func NewEngineUnresponsiveEvent(hostname string, instanceIdx uint32, rank uint32, incarnation uint64, missed time.Duration) *RASEvent {
+	return fill(&RASEvent{
+		Msg:         fmt.Sprintf("DAOS engine %d (rank %d) missed heartbeats for %s", instanceIdx, rank, missed),
+		ID:          RASEngineUnresponsive,
+		Hostname:    hostname,
+		Rank:        rank,
+		Incarnation: incarnation,
+		Type:        RASTypeStateChange,
+		Severity:    RASSeverityWarning,
+		ExtendedInfo: &EngineStateInfo{
+			InstanceIdx: instanceIdx,
+		},
+	})
+}
+
+// NewEngineMetadataCorruptedEvent creates an EngineMetadataCorrupted event from
+// given inputs. The event is an error unless the corrupted file could be
+// regenerated.
+func NewEngineMetadataCorruptedEvent(hostname string, instanceIdx uint32, rank uint32, path string, regenerated bool) *RASEvent {
+	sev, outcome := RASSeverityError, "manual recovery is required"
+	if regenerated {
+		sev, outcome = RASSeverityWarning, "the file has been regenerated"
+	}
+
+	return fill(&RASEvent{
+		Msg:      fmt.Sprintf("DAOS engine %d metadata file %s failed integrity check, %s", instanceIdx, path, outcome),
+		ID:       RASEngineMetadataCorrupted,
+		Hostname: hostname,
+		Rank:     rank,
+		Type:     RASTypeInfoOnly,
+		Severity: sev,
+		ExtendedInfo: &EngineStateInfo{
+			InstanceIdx: instanceIdx,
+		},
+	})
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +8,7 @@ package events
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -36,6 +37,16 @@ func mockEvtFmtReq(t *testing.T) *RASEvent {
 	return NewEngineFormatRequiredEvent(tHost, tInstanceIdx, tFmtType)
 }
 
+func mockEvtUnresponsive(t *testing.T) *RASEvent {
+	t.Helper()
+	return NewEngineUnresponsiveEvent(tHost, tInstanceIdx, tRank, 0x10, 15*time.Second)
+}
+
+func mockEvtMetadataCorrupted(t *testing.T) *RASEvent {
+	t.Helper()
+	return NewEngineMetadataCorruptedEvent(tHost, tInstanceIdx, tRank, "/mnt/daos/superblock", false)
+}
+
 func TestEvents_ConvertEngineDied(t *testing.T) {
 	event := mockEvtDied(t)
 
@@ -79,3 +90,39 @@ func TestEvents_ConvertEngineFormatRequired(t *testing.T) {
 		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
 	}
 }
+
+func TestEvents_ConvertEngineUnresponsive(t *testing.T) {
+	event := mockEvtUnresponsive(t)
+
+	pbEvent, err := event.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	returnedEvent := new(RASEvent)
+	if err := returnedEvent.FromProto(pbEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestEvents_ConvertEngineMetadataCorrupted(t *testing.T) {
+	event := mockEvtMetadataCorrupted(t)
+
+	pbEvent, err := event.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	returnedEvent := new(RASEvent)
+	if err := returnedEvent.FromProto(pbEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+	}
+}
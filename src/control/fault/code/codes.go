@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2018-2022 Intel Corporation.
+// (C) Copyright 2018-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -60,6 +60,7 @@ const (
 	StorageDeviceAlreadyMounted
 	StorageTargetAlreadyMounted
 	StoragePathAccessDenied
+	StorageDirLocked
 )
 
 // SCM fault codes
@@ -78,6 +79,7 @@ const (
 	ScmNoModules
 	ScmBadRegion
 	ScmInvalidPMem
+	ScmMountInvalid
 )
 
 // Bdev fault codes
@@ -101,6 +103,7 @@ const (
 	SystemUnknown Code = iota + 400
 	SystemBadFaultDomainDepth
 	SystemPoolLocked
+	SystemPoolOpInProgress
 )
 
 // client fault codes
@@ -139,6 +142,12 @@ const (
 	ServerPoolNoLabel
 	ServerIncompatibleComponents
 	ServerPoolHasContainers
+	ServerScmMountShared
+	ServerSuperblockMismatch
+	ServerPoolInvalidTierRatio
+	ServerSuperblockCorrupted
+	ServerPreflightFailed
+	ServerDrpcSelfTestFailed
 )
 
 // server config fault codes
@@ -173,6 +182,7 @@ const (
 	ServerConfigHugepagesDisabled
 	ServerConfigVMDSettingDuplicate
 	ServerConfigEngineNUMAImbalance
+	ServerConfigEnginesSettingDuplicate
 )
 
 // SPDK library bindings codes
@@ -188,4 +198,5 @@ const (
 	SecurityUnknown Code = iota + 900
 	SecurityMissingCertFile
 	SecurityUnreadableCertFile
+	SecurityCertEnrollmentDisabled
 )
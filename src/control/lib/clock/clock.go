@@ -0,0 +1,227 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package clock provides an abstraction of the passage of time so that
+// time-based behavior, e.g. expiry, retry backoff and periodic checks, can be
+// driven deterministically in tests.
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+type (
+	// Clock provides the current time and the means to wait for it to pass.
+	Clock interface {
+		// Now returns the current time.
+		Now() time.Time
+		// Since returns the time elapsed since t.
+		Since(t time.Time) time.Duration
+		// After waits for the duration to elapse and then sends the
+		// current time on the returned channel.
+		After(d time.Duration) <-chan time.Time
+		// NewTicker returns a Ticker that sends the current time on
+		// its channel after each period.
+		NewTicker(d time.Duration) Ticker
+	}
+
+	// Ticker delivers ticks at intervals.
+	Ticker interface {
+		// C returns the channel on which ticks are delivered.
+		C() <-chan time.Time
+		// Stop turns off the ticker.
+		Stop()
+	}
+)
+
+type (
+	realClock  struct{}
+	realTicker struct{ t *time.Ticker }
+)
+
+// New returns a Clock backed by the system clock.
+func New() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{t: time.NewTicker(d)}
+}
+
+func (rt *realTicker) C() <-chan time.Time {
+	return rt.t.C
+}
+
+func (rt *realTicker) Stop() {
+	rt.t.Stop()
+}
+
+type (
+	// MockClock is a Clock whose time only moves when it is advanced.
+	// Timers and tickers created from it fire as the time passes their
+	// deadlines.
+	MockClock struct {
+		sync.Mutex
+		cond    *sync.Cond
+		now     time.Time
+		waiters []*mockWaiter
+	}
+
+	mockWaiter struct {
+		deadline time.Time
+		period   time.Duration
+		c        chan time.Time
+	}
+
+	mockTicker struct {
+		clock  *MockClock
+		waiter *mockWaiter
+	}
+)
+
+// NewMock returns a MockClock set to the supplied time.
+func NewMock(start time.Time) *MockClock {
+	mc := &MockClock{now: start}
+	mc.cond = sync.NewCond(&mc.Mutex)
+	return mc
+}
+
+// Now returns the current mock time.
+func (mc *MockClock) Now() time.Time {
+	mc.Lock()
+	defer mc.Unlock()
+
+	return mc.now
+}
+
+// Since returns the mock time elapsed since t.
+func (mc *MockClock) Since(t time.Time) time.Duration {
+	return mc.Now().Sub(t)
+}
+
+// After returns a channel that receives the mock time once the clock has
+// been advanced by at least d.
+func (mc *MockClock) After(d time.Duration) <-chan time.Time {
+	mc.Lock()
+	defer mc.Unlock()
+
+	w := &mockWaiter{deadline: mc.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		w.c <- mc.now
+		return w.c
+	}
+	mc.addWaiter(w)
+
+	return w.c
+}
+
+// NewTicker returns a Ticker that ticks each time the clock is advanced past
+// another period.
+func (mc *MockClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	mc.Lock()
+	defer mc.Unlock()
+
+	w := &mockWaiter{deadline: mc.now.Add(d), period: d, c: make(chan time.Time, 1)}
+	mc.addWaiter(w)
+
+	return &mockTicker{clock: mc, waiter: w}
+}
+
+func (mc *MockClock) addWaiter(w *mockWaiter) {
+	mc.waiters = append(mc.waiters, w)
+	mc.cond.Broadcast()
+}
+
+func (mc *MockClock) removeWaiter(w *mockWaiter) {
+	for i, cur := range mc.waiters {
+		if cur == w {
+			mc.waiters = append(mc.waiters[:i], mc.waiters[i+1:]...)
+			mc.cond.Broadcast()
+			return
+		}
+	}
+}
+
+// Advance moves the mock time forward by d, firing any timers and tickers
+// whose deadlines are reached along the way. As with a real ticker, ticks
+// are dropped if the receiver has not kept up.
+func (mc *MockClock) Advance(d time.Duration) {
+	mc.Lock()
+	defer mc.Unlock()
+
+	end := mc.now.Add(d)
+	for {
+		sort.SliceStable(mc.waiters, func(i, j int) bool {
+			return mc.waiters[i].deadline.Before(mc.waiters[j].deadline)
+		})
+		if len(mc.waiters) == 0 || mc.waiters[0].deadline.After(end) {
+			break
+		}
+
+		w := mc.waiters[0]
+		mc.now = w.deadline
+		select {
+		case w.c <- mc.now:
+		default:
+		}
+
+		if w.period > 0 {
+			w.deadline = w.deadline.Add(w.period)
+			continue
+		}
+		mc.removeWaiter(w)
+	}
+	mc.now = end
+}
+
+// Set moves the mock time forward to t. Moving the time backward is not
+// supported and has no effect.
+func (mc *MockClock) Set(t time.Time) {
+	if d := t.Sub(mc.Now()); d > 0 {
+		mc.Advance(d)
+	}
+}
+
+// BlockUntil waits until at least n timers or tickers are waiting on the
+// clock. Tests use this to ensure that the code under test has started
+// waiting before the clock is advanced.
+func (mc *MockClock) BlockUntil(n int) {
+	mc.Lock()
+	defer mc.Unlock()
+
+	for len(mc.waiters) < n {
+		mc.cond.Wait()
+	}
+}
+
+func (mt *mockTicker) C() <-chan time.Time {
+	return mt.waiter.c
+}
+
+func (mt *mockTicker) Stop() {
+	mt.clock.Lock()
+	defer mt.clock.Unlock()
+
+	mt.clock.removeWaiter(mt.waiter)
+}
@@ -0,0 +1,121 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/clock"
+)
+
+var epoch = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func expFired(t *testing.T, c <-chan time.Time, exp time.Time) {
+	t.Helper()
+
+	select {
+	case got := <-c:
+		if !got.Equal(exp) {
+			t.Fatalf("expected tick at %s, got %s", exp, got)
+		}
+	default:
+		t.Fatalf("expected tick at %s, got none", exp)
+	}
+}
+
+func expNotFired(t *testing.T, c <-chan time.Time) {
+	t.Helper()
+
+	select {
+	case got := <-c:
+		t.Fatalf("unexpected tick at %s", got)
+	default:
+	}
+}
+
+func TestClock_MockClock_Now(t *testing.T) {
+	mc := clock.NewMock(epoch)
+
+	if !mc.Now().Equal(epoch) {
+		t.Fatalf("expected %s, got %s", epoch, mc.Now())
+	}
+
+	mc.Advance(time.Minute)
+	if mc.Since(epoch) != time.Minute {
+		t.Fatalf("expected %s since start, got %s", time.Minute, mc.Since(epoch))
+	}
+
+	mc.Set(epoch) // backward; no effect
+	if mc.Since(epoch) != time.Minute {
+		t.Fatalf("expected %s since start, got %s", time.Minute, mc.Since(epoch))
+	}
+
+	mc.Set(epoch.Add(time.Hour))
+	if !mc.Now().Equal(epoch.Add(time.Hour)) {
+		t.Fatalf("expected %s, got %s", epoch.Add(time.Hour), mc.Now())
+	}
+}
+
+func TestClock_MockClock_After(t *testing.T) {
+	mc := clock.NewMock(epoch)
+
+	expFired(t, mc.After(0), epoch)
+	c := mc.After(10 * time.Second)
+
+	mc.Advance(9 * time.Second)
+	expNotFired(t, c)
+
+	mc.Advance(5 * time.Second)
+	expFired(t, c, epoch.Add(10*time.Second))
+	if !mc.Now().Equal(epoch.Add(14 * time.Second)) {
+		t.Fatalf("unexpected time after advance: %s", mc.Now())
+	}
+
+	mc.Advance(time.Minute)
+	expNotFired(t, c)
+}
+
+func TestClock_MockClock_Ticker(t *testing.T) {
+	mc := clock.NewMock(epoch)
+
+	ticker := mc.NewTicker(time.Second)
+	expNotFired(t, ticker.C())
+
+	mc.Advance(time.Second)
+	expFired(t, ticker.C(), epoch.Add(time.Second))
+
+	// Ticks are dropped if the receiver doesn't keep up.
+	mc.Advance(3 * time.Second)
+	expFired(t, ticker.C(), epoch.Add(2*time.Second))
+	expNotFired(t, ticker.C())
+
+	ticker.Stop()
+	mc.Advance(time.Minute)
+	expNotFired(t, ticker.C())
+}
+
+func TestClock_MockClock_BlockUntil(t *testing.T) {
+	mc := clock.NewMock(epoch)
+	done := make(chan time.Time)
+
+	go func() {
+		done <- <-mc.After(time.Second)
+	}()
+
+	mc.BlockUntil(1)
+	mc.Advance(time.Second)
+
+	select {
+	case got := <-done:
+		if !got.Equal(epoch.Add(time.Second)) {
+			t.Fatalf("unexpected time %s", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timer not fired")
+	}
+}
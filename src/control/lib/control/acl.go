@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -68,6 +69,147 @@ func (acl *AccessControlList) String() string {
 	return fmt.Sprintf("%+v", *acl)
 }
 
+// aclTemplates maps the names of ACL templates for common pool access patterns
+// to their entries.
+var aclTemplates = map[string][]string{
+	"private":     {"A::OWNER@:rw"},
+	"group":       {"A::OWNER@:rw", "A:G:GROUP@:rw"},
+	"shared-read": {"A::OWNER@:rw", "A:G:GROUP@:rw", "A::EVERYONE@:r"},
+}
+
+// ACLTemplateNames returns the sorted names of the available ACL templates.
+func ACLTemplateNames() []string {
+	names := make([]string, 0, len(aclTemplates))
+	for name := range aclTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetACLTemplate returns a copy of the pool ACL defined by the named template.
+func GetACLTemplate(name string) (*AccessControlList, error) {
+	entries, found := aclTemplates[name]
+	if !found {
+		return nil, errors.Errorf("unknown ACL template %q (valid: %s)",
+			name, strings.Join(ACLTemplateNames(), ", "))
+	}
+
+	return &AccessControlList{Entries: append([]string{}, entries...)}, nil
+}
+
+const (
+	// maxACLPrincipalLen is the maximum length of a principal name.
+	maxACLPrincipalLen = 255
+
+	aclUserPrefix  = "u:"
+	aclGroupPrefix = "g:"
+
+	aceAccessTypes = "AUL"
+	aceFlags       = "GSFP"
+	acePerms       = "rwcdtTaAo"
+)
+
+var aclSpecialPrincipals = []string{"OWNER@", "GROUP@", "EVERYONE@"}
+
+func isSpecialACLPrincipal(principal string) bool {
+	for _, sp := range aclSpecialPrincipals {
+		if principal == sp {
+			return true
+		}
+	}
+	return false
+}
+
+// validateACLPrincipalName checks that the name is of the form name@[domain].
+func validateACLPrincipalName(name string) error {
+	if len(name) > maxACLPrincipalLen {
+		return errors.Errorf("principal name %q is longer than %d characters",
+			name, maxACLPrincipalLen)
+	}
+
+	if strings.Index(name, "@") < 1 || strings.Count(name, "@") != 1 {
+		return errors.Errorf("invalid principal name %q (expected name@[domain])", name)
+	}
+
+	return nil
+}
+
+// ValidateACLPrincipal returns an error if the supplied string is not a valid
+// ACL principal, i.e. one of the special principals (OWNER@, GROUP@ or
+// EVERYONE@) or a user or group name with the "u:" or "g:" prefix.
+func ValidateACLPrincipal(principal string) error {
+	if isSpecialACLPrincipal(principal) {
+		return nil
+	}
+
+	for _, prefix := range []string{aclUserPrefix, aclGroupPrefix} {
+		if strings.HasPrefix(principal, prefix) {
+			return validateACLPrincipalName(strings.TrimPrefix(principal, prefix))
+		}
+	}
+
+	return errors.Errorf("invalid principal %q (expected u:name@, g:name@ or one of %s)",
+		principal, strings.Join(aclSpecialPrincipals, ", "))
+}
+
+func validateACEField(ace, desc, field, valid string, required bool) error {
+	if required && field == "" {
+		return errors.Errorf("invalid ACE %q: no %s", ace, desc)
+	}
+	for _, c := range field {
+		if !strings.ContainsRune(valid, c) {
+			return errors.Errorf("invalid ACE %q: unknown %s %q (valid: %s)",
+				ace, desc, c, valid)
+		}
+	}
+	return nil
+}
+
+// ValidateACE returns an error if the supplied string is not a valid Access
+// Control Entry in the short format TYPES:FLAGS:IDENTITY:PERMISSIONS.
+func ValidateACE(ace string) error {
+	fields := strings.Split(ace, ":")
+	if len(fields) != 4 {
+		return errors.Errorf("invalid ACE %q (expected TYPES:FLAGS:IDENTITY:PERMISSIONS)", ace)
+	}
+	types, flags, identity, perms := fields[0], fields[1], fields[2], fields[3]
+
+	if err := validateACEField(ace, "access type", types, aceAccessTypes, true); err != nil {
+		return err
+	}
+	if err := validateACEField(ace, "flag", flags, aceFlags, false); err != nil {
+		return err
+	}
+	if err := validateACEField(ace, "permission", perms, acePerms, false); err != nil {
+		return err
+	}
+
+	if isSpecialACLPrincipal(identity) {
+		return nil
+	}
+	if err := validateACLPrincipalName(identity); err != nil {
+		return errors.Wrapf(err, "invalid ACE %q", ace)
+	}
+
+	return nil
+}
+
+// Validate returns an error if any of the entries in the ACL are invalid.
+func (acl *AccessControlList) Validate() error {
+	if acl == nil {
+		return nil
+	}
+
+	for _, ace := range acl.Entries {
+		if err := ValidateACE(ace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ReadACLFile reads in a file representing an ACL, and translates it into an
 // AccessControlList structure
 func ReadACLFile(aclFile string) (*AccessControlList, error) {
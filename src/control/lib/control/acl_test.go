@@ -433,3 +433,187 @@ func TestControl_GetVerboseACE(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_ValidateACE(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ace    string
+		expErr error
+	}{
+		"special principal": {
+			ace: "A::OWNER@:rw",
+		},
+		"named user": {
+			ace: "A::user@:rwcdtTaAo",
+		},
+		"named group with domain": {
+			ace: "A:G:group@example.com:r",
+		},
+		"multiple types and flags": {
+			ace: "AUL:GSF:user@:rw",
+		},
+		"no permissions": {
+			ace: "A::EVERYONE@:",
+		},
+		"empty": {
+			expErr: errors.New("expected TYPES:FLAGS:IDENTITY:PERMISSIONS"),
+		},
+		"too many fields": {
+			ace:    "A::OWNER@:rw:x",
+			expErr: errors.New("expected TYPES:FLAGS:IDENTITY:PERMISSIONS"),
+		},
+		"no access type": {
+			ace:    ":G:GROUP@:rw",
+			expErr: errors.New("no access type"),
+		},
+		"bad access type": {
+			ace:    "X::OWNER@:rw",
+			expErr: errors.New("unknown access type 'X'"),
+		},
+		"bad flag": {
+			ace:    "A:X:OWNER@:rw",
+			expErr: errors.New("unknown flag 'X'"),
+		},
+		"bad permission": {
+			ace:    "A::OWNER@:rx",
+			expErr: errors.New("unknown permission 'x'"),
+		},
+		"identity without @": {
+			ace:    "A::user:rw",
+			expErr: errors.New("invalid principal name"),
+		},
+		"identity without name": {
+			ace:    "A::@domain:rw",
+			expErr: errors.New("invalid principal name"),
+		},
+		"identity with two @": {
+			ace:    "A::user@domain@x:rw",
+			expErr: errors.New("invalid principal name"),
+		},
+		"identity too long": {
+			ace:    "A::" + strings.Repeat("a", maxACLPrincipalLen) + "@:rw",
+			expErr: errors.New("longer than"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, ValidateACE(tc.ace))
+		})
+	}
+}
+
+func TestControl_ValidateACLPrincipal(t *testing.T) {
+	for name, tc := range map[string]struct {
+		principal string
+		expErr    error
+	}{
+		"owner": {
+			principal: "OWNER@",
+		},
+		"owner group": {
+			principal: "GROUP@",
+		},
+		"everyone": {
+			principal: "EVERYONE@",
+		},
+		"user": {
+			principal: "u:user@",
+		},
+		"group with domain": {
+			principal: "g:group@example.com",
+		},
+		"empty": {
+			expErr: errors.New("invalid principal"),
+		},
+		"no prefix": {
+			principal: "user@",
+			expErr:    errors.New("invalid principal"),
+		},
+		"bad special principal": {
+			principal: "owner@",
+			expErr:    errors.New("invalid principal"),
+		},
+		"user without name": {
+			principal: "u:@",
+			expErr:    errors.New("invalid principal name"),
+		},
+		"group without @": {
+			principal: "g:group",
+			expErr:    errors.New("invalid principal name"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, ValidateACLPrincipal(tc.principal))
+		})
+	}
+}
+
+func TestControl_AccessControlList_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		acl    *AccessControlList
+		expErr error
+	}{
+		"nil": {},
+		"empty": {
+			acl: &AccessControlList{},
+		},
+		"valid": {
+			acl: &AccessControlList{
+				Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r", "A::user@:rw"},
+			},
+		},
+		"one invalid": {
+			acl: &AccessControlList{
+				Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:z"},
+			},
+			expErr: errors.New("invalid ACE \"A:G:GROUP@:z\""),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.acl.Validate())
+		})
+	}
+}
+
+func TestControl_GetACLTemplate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		name   string
+		expACL *AccessControlList
+		expErr error
+	}{
+		"unknown": {
+			name:   "public",
+			expErr: errors.New("valid: group, private, shared-read"),
+		},
+		"private": {
+			name:   "private",
+			expACL: &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+		},
+		"shared-read": {
+			name: "shared-read",
+			expACL: &AccessControlList{
+				Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:rw", "A::EVERYONE@:r"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotACL, gotErr := GetACLTemplate(tc.name)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expACL, gotACL); diff != "" {
+				t.Fatalf("unexpected ACL (-want, +got):\n%s\n", diff)
+			}
+
+			// Modifying the returned ACL must not affect the template.
+			gotACL.Entries[0] = "A::EVERYONE@:rw"
+			again, err := GetACLTemplate(tc.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expACL, again); diff != "" {
+				t.Fatalf("template modified (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/clock"
+)
+
+// tokenRefreshMargin is the period before a cached token expires at which
+// a new token is obtained from the auth_token_command.
+const tokenRefreshMargin = 30 * time.Second
+
+// bearerTokenCredentials implements the gRPC PerRPCCredentials interface in
+// order to attach an OIDC bearer token to each request sent by the client.
+type bearerTokenCredentials struct {
+	sync.Mutex
+	file    string
+	command string
+	token   string
+	expiry  time.Time
+	clock   clock.Clock
+	runCmd  func(ctx context.Context, command string) ([]byte, error)
+}
+
+func runTokenCommand(ctx context.Context, command string) ([]byte, error) {
+	return exec.CommandContext(ctx, "/bin/sh", "-c", command).Output()
+}
+
+// newBearerTokenCredentials returns credentials for the token source set in
+// the configuration, or nil if no token source is set.
+func newBearerTokenCredentials(cfg *Config, clk clock.Clock) *bearerTokenCredentials {
+	if cfg == nil || (cfg.AuthTokenFile == "" && cfg.AuthTokenCommand == "") {
+		return nil
+	}
+
+	return &bearerTokenCredentials{
+		file:    cfg.AuthTokenFile,
+		command: cfg.AuthTokenCommand,
+		clock:   clk,
+		runCmd:  runTokenCommand,
+	}
+}
+
+// tokenExpiry returns the expiry time set in the token's claims, if any. The
+// token is not verified here; that is the job of the server.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(claims.Exp, 0)
+}
+
+func (c *bearerTokenCredentials) getToken(ctx context.Context) (string, error) {
+	if c.file != "" {
+		data, err := os.ReadFile(c.file)
+		if err != nil {
+			return "", errors.Wrap(err, "reading auth_token_file")
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", errors.Errorf("auth_token_file %s is empty", c.file)
+		}
+		return token, nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.token != "" && c.clock.Now().Before(c.expiry.Add(-tokenRefreshMargin)) {
+		return c.token, nil
+	}
+
+	out, err := c.runCmd(ctx, c.command)
+	if err != nil {
+		if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+			err = errors.Errorf("%s: %s", err, strings.TrimSpace(string(ee.Stderr)))
+		}
+		return "", errors.Wrap(err, "running auth_token_command")
+	}
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return "", errors.New("auth_token_command returned an empty token")
+	}
+
+	// Tokens without an expiry are not cached, as there is no way to know
+	// when they need to be replaced.
+	c.token = token
+	c.expiry = tokenExpiry(token)
+
+	return token, nil
+}
+
+// GetRequestMetadata returns the authorization header to be sent with a
+// request.
+func (c *bearerTokenCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity indicates that the token must only be sent over
+// a secure connection.
+func (c *bearerTokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
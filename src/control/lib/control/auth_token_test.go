@@ -0,0 +1,128 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/clock"
+)
+
+func mockUnsignedToken(exp time.Time) string {
+	enc := base64.RawURLEncoding
+	payload := fmt.Sprintf(`{"sub":"jdoe","exp":%d}`, exp.Unix())
+	return enc.EncodeToString([]byte(`{"alg":"RS256"}`)) + "." +
+		enc.EncodeToString([]byte(payload)) + ".sig"
+}
+
+func TestControl_bearerTokenCredentials_file(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+	tokenPath := filepath.Join(testDir, "token")
+
+	creds := newBearerTokenCredentials(&Config{AuthTokenFile: tokenPath}, clock.New())
+
+	if _, err := creds.GetRequestMetadata(context.Background()); err == nil {
+		t.Fatal("expected error for missing token file")
+	}
+
+	// The file is re-read on each request so that an externally refreshed
+	// token is picked up.
+	for _, token := range []string{"token1", "token2"} {
+		if err := os.WriteFile(tokenPath, []byte(token+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		md, err := creds.GetRequestMetadata(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEqual(t, "Bearer "+token, md["authorization"], "unexpected header")
+	}
+}
+
+func TestControl_bearerTokenCredentials_command(t *testing.T) {
+	start := time.Now()
+
+	for name, tc := range map[string]struct {
+		tokens   []string
+		cmdErr   error
+		elapsed  time.Duration
+		expCalls int
+		expErr   error
+	}{
+		"command fails": {
+			cmdErr:   errors.New("no credentials"),
+			expCalls: 1,
+			expErr:   errors.New("running auth_token_command: no credentials"),
+		},
+		"empty token": {
+			tokens:   []string{""},
+			expCalls: 1,
+			expErr:   errors.New("empty token"),
+		},
+		"token cached until near expiry": {
+			tokens:   []string{mockUnsignedToken(start.Add(time.Hour))},
+			elapsed:  time.Hour - 2*tokenRefreshMargin,
+			expCalls: 1,
+		},
+		"expiring token refreshed": {
+			tokens: []string{
+				mockUnsignedToken(start.Add(time.Hour)),
+				mockUnsignedToken(start.Add(2 * time.Hour)),
+			},
+			elapsed:  time.Hour - tokenRefreshMargin/2,
+			expCalls: 2,
+		},
+		"token without expiry not cached": {
+			tokens:   []string{"opaque1", "opaque2"},
+			expCalls: 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mc := clock.NewMock(start)
+			creds := newBearerTokenCredentials(&Config{AuthTokenCommand: "get-token"}, mc)
+
+			var calls int
+			creds.runCmd = func(_ context.Context, command string) ([]byte, error) {
+				test.AssertEqual(t, "get-token", command, "unexpected command")
+				calls++
+				if tc.cmdErr != nil {
+					return nil, tc.cmdErr
+				}
+				return []byte(tc.tokens[calls-1] + "\n"), nil
+			}
+
+			var gotHeaders []string
+			for i := 0; i < 2; i++ {
+				md, err := creds.GetRequestMetadata(context.Background())
+				test.CmpErr(t, tc.expErr, err)
+				if tc.expErr != nil {
+					test.AssertEqual(t, tc.expCalls, calls, "unexpected command calls")
+					return
+				}
+				gotHeaders = append(gotHeaders, md["authorization"])
+				mc.Set(start.Add(tc.elapsed))
+			}
+
+			test.AssertEqual(t, tc.expCalls, calls, "unexpected command calls")
+			expHeaders := []string{"Bearer " + tc.tokens[0], "Bearer " + tc.tokens[tc.expCalls-1]}
+			if diff := cmp.Diff(expHeaders, gotHeaders); diff != "" {
+				t.Fatalf("unexpected headers (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
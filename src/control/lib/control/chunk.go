@@ -0,0 +1,102 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+// invokeChunked invokes a server-streaming RPC that returns a single logical
+// response split into chunks, passing each chunk to the merge function. If the
+// server predates the streaming RPC, the unary fallback RPC is invoked instead
+// and its response is returned. Otherwise a nil response is returned and the
+// merged chunks are the response.
+func invokeChunked(ctx context.Context, conn *grpc.ClientConn, open streamRPC, fallback unaryRPC, merge func(proto.Message) error) (proto.Message, error) {
+	recv, err := open(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	for received := 0; ; received++ {
+		msg, err := recv()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			if received == 0 && status.Code(err) == codes.Unimplemented {
+				return fallback(ctx, conn)
+			}
+			return nil, err
+		}
+		if err := merge(msg); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// mergeStorageScanChunk adds the contents of a chunk of a streamed storage
+// scan response to the response being reassembled.
+func mergeStorageScanChunk(resp *ctlpb.StorageScanResp, msg proto.Message) error {
+	chunk, ok := msg.(*ctlpb.StorageScanResp)
+	if !ok {
+		return errors.Errorf("unexpected storage scan chunk type %T", msg)
+	}
+
+	if resp.MemInfo == nil {
+		resp.MemInfo = chunk.MemInfo
+	}
+	if chunk.Nvme != nil {
+		if resp.Nvme == nil {
+			resp.Nvme = new(ctlpb.ScanNvmeResp)
+		}
+		if resp.Nvme.State == nil {
+			resp.Nvme.State = chunk.Nvme.State
+		}
+		resp.Nvme.Ctrlrs = append(resp.Nvme.Ctrlrs, chunk.Nvme.Ctrlrs...)
+	}
+	if chunk.Scm != nil {
+		if resp.Scm == nil {
+			resp.Scm = new(ctlpb.ScanScmResp)
+		}
+		if resp.Scm.State == nil {
+			resp.Scm.State = chunk.Scm.State
+		}
+		resp.Scm.Modules = append(resp.Scm.Modules, chunk.Scm.Modules...)
+		resp.Scm.Namespaces = append(resp.Scm.Namespaces, chunk.Scm.Namespaces...)
+	}
+
+	return nil
+}
+
+// mergeSmdQueryChunk adds the contents of a chunk of a streamed SMD query
+// response to the response being reassembled. Per-rank entries are appended
+// as-is, as a rank's devices and pools may already be split across entries.
+func mergeSmdQueryChunk(resp *ctlpb.SmdQueryResp, msg proto.Message) error {
+	chunk, ok := msg.(*ctlpb.SmdQueryResp)
+	if !ok {
+		return errors.Errorf("unexpected SMD query chunk type %T", msg)
+	}
+
+	if resp.Status == 0 {
+		resp.Status = chunk.Status
+	}
+	if resp.NextPageToken == "" {
+		resp.NextPageToken = chunk.NextPageToken
+	}
+	resp.Ranks = append(resp.Ranks, chunk.Ranks...)
+
+	return nil
+}
@@ -0,0 +1,173 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestControl_invokeChunked(t *testing.T) {
+	chunks := []proto.Message{
+		&ctlpb.SmdQueryResp{
+			NextPageToken: "next",
+			Ranks:         []*ctlpb.SmdQueryResp_RankResp{{Rank: 0}},
+		},
+		&ctlpb.SmdQueryResp{
+			Ranks: []*ctlpb.SmdQueryResp_RankResp{{Rank: 1}},
+		},
+	}
+	fallbackResp := &ctlpb.SmdQueryResp{
+		Ranks: []*ctlpb.SmdQueryResp_RankResp{{Rank: 2}},
+	}
+
+	for name, tc := range map[string]struct {
+		openErr     error
+		recvErrs    []error
+		expFallback bool
+		expResp     *ctlpb.SmdQueryResp
+		expErr      error
+	}{
+		"open fails": {
+			openErr: errors.New("open"),
+			expErr:  errors.New("open"),
+		},
+		"chunks merged": {
+			expResp: &ctlpb.SmdQueryResp{
+				NextPageToken: "next",
+				Ranks:         []*ctlpb.SmdQueryResp_RankResp{{Rank: 0}, {Rank: 1}},
+			},
+		},
+		"streaming unimplemented; fallback used": {
+			recvErrs:    []error{status.Error(codes.Unimplemented, "unknown method")},
+			expFallback: true,
+			expResp:     fallbackResp,
+		},
+		"stream fails": {
+			recvErrs: []error{status.Error(codes.Unavailable, "down")},
+			expErr:   errors.New("down"),
+		},
+		"stream fails after first chunk": {
+			recvErrs: []error{nil, status.Error(codes.Unimplemented, "gone")},
+			expErr:   errors.New("gone"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var recvCalls int
+			open := func(context.Context, *grpc.ClientConn) (streamRecvFn, error) {
+				if tc.openErr != nil {
+					return nil, tc.openErr
+				}
+				return func() (proto.Message, error) {
+					defer func() { recvCalls++ }()
+					if recvCalls < len(tc.recvErrs) && tc.recvErrs[recvCalls] != nil {
+						return nil, tc.recvErrs[recvCalls]
+					}
+					if recvCalls >= len(chunks) {
+						return nil, io.EOF
+					}
+					return chunks[recvCalls], nil
+				}, nil
+			}
+			var fallbackCalled bool
+			fallback := func(context.Context, *grpc.ClientConn) (proto.Message, error) {
+				fallbackCalled = true
+				return fallbackResp, nil
+			}
+
+			merged := new(ctlpb.SmdQueryResp)
+			gotFallback, gotErr := invokeChunked(context.TODO(), nil, open, fallback,
+				func(msg proto.Message) error {
+					return mergeSmdQueryChunk(merged, msg)
+				})
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expFallback, fallbackCalled, "unexpected fallback")
+			if tc.expErr != nil {
+				return
+			}
+
+			gotResp := merged
+			if tc.expFallback {
+				gotResp = gotFallback.(*ctlpb.SmdQueryResp)
+			} else if gotFallback != nil {
+				t.Fatalf("unexpected fallback response %+v", gotFallback)
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_mergeStorageScanChunk(t *testing.T) {
+	state := &ctlpb.ResponseState{Info: "ok"}
+	chunks := []*ctlpb.StorageScanResp{
+		{
+			MemInfo: &ctlpb.MemInfo{MemTotal: 1},
+			Nvme: &ctlpb.ScanNvmeResp{
+				State:  state,
+				Ctrlrs: []*ctlpb.NvmeController{{PciAddr: "0000:01:00.0"}},
+			},
+			Scm: &ctlpb.ScanScmResp{State: state},
+		},
+		{
+			Nvme: &ctlpb.ScanNvmeResp{
+				Ctrlrs: []*ctlpb.NvmeController{{PciAddr: "0000:02:00.0"}},
+			},
+			Scm: &ctlpb.ScanScmResp{
+				Modules:    []*ctlpb.ScmModule{{Uid: "m1"}},
+				Namespaces: []*ctlpb.ScmNamespace{{Uuid: "ns1"}},
+			},
+		},
+		{
+			Scm: &ctlpb.ScanScmResp{
+				Namespaces: []*ctlpb.ScmNamespace{{Uuid: "ns2"}},
+			},
+		},
+	}
+
+	got := new(ctlpb.StorageScanResp)
+	for _, chunk := range chunks {
+		if err := mergeStorageScanChunk(got, chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	exp := &ctlpb.StorageScanResp{
+		MemInfo: &ctlpb.MemInfo{MemTotal: 1},
+		Nvme: &ctlpb.ScanNvmeResp{
+			State: state,
+			Ctrlrs: []*ctlpb.NvmeController{
+				{PciAddr: "0000:01:00.0"},
+				{PciAddr: "0000:02:00.0"},
+			},
+		},
+		Scm: &ctlpb.ScanScmResp{
+			State:      state,
+			Modules:    []*ctlpb.ScmModule{{Uid: "m1"}},
+			Namespaces: []*ctlpb.ScmNamespace{{Uuid: "ns1"}, {Uuid: "ns2"}},
+		},
+	}
+	if diff := cmp.Diff(exp, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+	}
+
+	test.CmpErr(t, errors.New("unexpected storage scan chunk type"),
+		mergeStorageScanChunk(got, new(ctlpb.SmdQueryResp)))
+}
@@ -0,0 +1,192 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// compressionTracker records the servers that don't accept compressed
+// requests, so that requests to them are sent uncompressed. Servers running
+// a release without support for compressed requests reject them with an
+// Unimplemented status. As servers also return that status for methods they
+// don't implement, a server is only recorded once an uncompressed retry of a
+// rejected request is not rejected in the same way.
+type compressionTracker struct {
+	sync.RWMutex
+	log          debugLogger
+	uncompressed map[string]struct{}
+}
+
+func newCompressionTracker(log debugLogger) *compressionTracker {
+	return &compressionTracker{
+		log:          log,
+		uncompressed: make(map[string]struct{}),
+	}
+}
+
+// isCompressionRejected indicates whether the error may have been returned by
+// a server that doesn't accept compressed requests.
+func isCompressionRejected(err error) bool {
+	return status.Code(err) == codes.Unimplemented
+}
+
+// withCompressor returns a copy of the call options with the compressor set.
+func withCompressor(opts []grpc.CallOption, compressor string) []grpc.CallOption {
+	compOpts := make([]grpc.CallOption, 0, len(opts)+1)
+	compOpts = append(compOpts, opts...)
+	return append(compOpts, grpc.UseCompressor(compressor))
+}
+
+func (ct *compressionTracker) isUncompressed(target string) bool {
+	ct.RLock()
+	defer ct.RUnlock()
+
+	_, found := ct.uncompressed[target]
+	return found
+}
+
+// recordRetry records the target as not accepting compressed requests, unless
+// the uncompressed retry was rejected in the same way as the compressed one.
+func (ct *compressionTracker) recordRetry(target string, retryErr error) {
+	if isCompressionRejected(retryErr) {
+		return
+	}
+
+	ct.Lock()
+	defer ct.Unlock()
+
+	if _, found := ct.uncompressed[target]; !found {
+		ct.log.Debugf("%s does not accept compressed requests", target)
+		ct.uncompressed[target] = struct{}{}
+	}
+}
+
+// unaryInterceptor returns an interceptor that sends unary requests using the
+// compressor, retrying them uncompressed if they are rejected.
+func (ct *compressionTracker) unaryInterceptor(compressor string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		target := cc.Target()
+		if ct.isUncompressed(target) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, withCompressor(opts, compressor)...)
+		if !isCompressionRejected(err) {
+			return err
+		}
+
+		ct.log.Debugf("%s rejected %s-compressed %s request; retrying uncompressed",
+			target, compressor, method)
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		ct.recordRetry(target, err)
+
+		return err
+	}
+}
+
+// fallbackStream wraps a client stream opened with a compressor so that it can
+// be reopened uncompressed, and the messages sent on it replayed, if the
+// server rejects the compressed request before any response is received.
+type fallbackStream struct {
+	grpc.ClientStream
+	reopen   func() (grpc.ClientStream, error)
+	onReopen func(err error)
+	sent     []interface{}
+	closed   bool
+}
+
+func (s *fallbackStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if s.reopen == nil {
+		return err
+	}
+
+	s.sent = append(s.sent, m)
+	// A stream terminated by the server returns io.EOF here, with the
+	// status returned by RecvMsg, which may then reopen the stream.
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+func (s *fallbackStream) CloseSend() error {
+	s.closed = true
+	return s.ClientStream.CloseSend()
+}
+
+func (s *fallbackStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if s.reopen == nil || !isCompressionRejected(err) {
+		s.reopen = nil
+		s.sent = nil
+		return err
+	}
+
+	reopen := s.reopen
+	s.reopen = nil
+	cs, err := reopen()
+	if err != nil {
+		return err
+	}
+	s.ClientStream = cs
+
+	for _, sent := range s.sent {
+		if err := cs.SendMsg(sent); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+	}
+	s.sent = nil
+	if s.closed {
+		if err := cs.CloseSend(); err != nil {
+			return err
+		}
+	}
+
+	err = cs.RecvMsg(m)
+	s.onReopen(err)
+
+	return err
+}
+
+// streamInterceptor returns an interceptor that opens streams using the
+// compressor, reopening them uncompressed if the request is rejected.
+func (ct *compressionTracker) streamInterceptor(compressor string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		target := cc.Target()
+		if ct.isUncompressed(target) {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		cs, err := streamer(ctx, desc, cc, method, withCompressor(opts, compressor)...)
+		if err != nil {
+			return nil, err
+		}
+
+		return &fallbackStream{
+			ClientStream: cs,
+			reopen: func() (grpc.ClientStream, error) {
+				ct.log.Debugf("%s rejected %s-compressed %s request; retrying uncompressed",
+					target, compressor, method)
+				return streamer(ctx, desc, cc, method, opts...)
+			},
+			onReopen: func(err error) {
+				ct.recordRetry(target, err)
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,211 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+var errNoCompressor = status.Error(codes.Unimplemented, "grpc: Decompressor is not installed for grpc-encoding \"gzip\"")
+
+// compressedCall returns true if the call options set a compressor.
+func compressedCall(opts []grpc.CallOption) bool {
+	for _, opt := range opts {
+		if _, ok := opt.(grpc.CompressorCallOption); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func TestControl_compressionTracker_unaryInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		compressedErr   error
+		uncompressedErr error
+		expCalls        []bool
+		expErr          error
+		expUncompressed bool
+	}{
+		"compressed request accepted": {
+			expCalls: []bool{true, true},
+		},
+		"compressed request fails": {
+			compressedErr: errors.New("whoops"),
+			expCalls:      []bool{true, true},
+			expErr:        errors.New("whoops"),
+		},
+		"compressed request rejected": {
+			compressedErr:   errNoCompressor,
+			expCalls:        []bool{true, false, false},
+			expUncompressed: true,
+		},
+		"uncompressed retry fails": {
+			compressedErr:   errNoCompressor,
+			uncompressedErr: errors.New("whoops"),
+			expCalls:        []bool{true, false, false},
+			expErr:          errors.New("whoops"),
+			expUncompressed: true,
+		},
+		"method not implemented": {
+			compressedErr:   status.Error(codes.Unimplemented, "unknown method"),
+			uncompressedErr: status.Error(codes.Unimplemented, "unknown method"),
+			expCalls:        []bool{true, false, true, false},
+			expErr:          errors.New("unknown method"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			conn, err := grpc.Dial("host1:10001", grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			var gotCalls []bool
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				compressed := compressedCall(opts)
+				gotCalls = append(gotCalls, compressed)
+				if compressed {
+					return tc.compressedErr
+				}
+				return tc.uncompressedErr
+			}
+
+			ct := newCompressionTracker(log)
+			intercept := ct.unaryInterceptor(gzip.Name)
+
+			// The second request shows whether compression is
+			// still attempted after the first.
+			var gotErr error
+			for i := 0; i < 2; i++ {
+				gotErr = intercept(context.Background(), "/ctl.CtlSvc/StorageScan", nil, nil, conn, invoker)
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expCalls, gotCalls, "unexpected calls (compressed)")
+			test.AssertEqual(t, tc.expUncompressed, ct.isUncompressed("host1:10001"),
+				"unexpected uncompressed state")
+		})
+	}
+}
+
+type mockClientStream struct {
+	grpc.ClientStream
+	compressed bool
+	recvErr    error
+	sent       int
+	closed     bool
+}
+
+func (s *mockClientStream) SendMsg(m interface{}) error {
+	s.sent++
+	if s.recvErr != nil {
+		return io.EOF
+	}
+	return nil
+}
+
+func (s *mockClientStream) CloseSend() error {
+	s.closed = true
+	return nil
+}
+
+func (s *mockClientStream) RecvMsg(m interface{}) error {
+	return s.recvErr
+}
+
+func TestControl_compressionTracker_streamInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		compressedErr   error
+		uncompressedErr error
+		expStreams      []bool
+		expErr          error
+		expUncompressed bool
+	}{
+		"compressed request accepted": {
+			expStreams: []bool{true},
+		},
+		"compressed request fails": {
+			compressedErr: errors.New("whoops"),
+			expStreams:    []bool{true},
+			expErr:        errors.New("whoops"),
+		},
+		"compressed request rejected": {
+			compressedErr:   errNoCompressor,
+			expStreams:      []bool{true, false},
+			expUncompressed: true,
+		},
+		"method not implemented": {
+			compressedErr:   status.Error(codes.Unimplemented, "unknown method"),
+			uncompressedErr: status.Error(codes.Unimplemented, "unknown method"),
+			expStreams:      []bool{true, false},
+			expErr:          errors.New("unknown method"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			conn, err := grpc.Dial("host1:10001", grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			var streams []*mockClientStream
+			streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				s := &mockClientStream{compressed: compressedCall(opts)}
+				s.recvErr = tc.uncompressedErr
+				if s.compressed {
+					s.recvErr = tc.compressedErr
+				}
+				streams = append(streams, s)
+				return s, nil
+			}
+
+			ct := newCompressionTracker(log)
+			intercept := ct.streamInterceptor(gzip.Name)
+
+			cs, err := intercept(context.Background(), &grpc.StreamDesc{ServerStreams: true},
+				conn, "/ctl.CtlSvc/StorageScanStream", streamer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := cs.SendMsg(nil); err != nil {
+				t.Fatal(err)
+			}
+			if err := cs.CloseSend(); err != nil {
+				t.Fatal(err)
+			}
+
+			test.CmpErr(t, tc.expErr, cs.RecvMsg(nil))
+
+			gotStreams := make([]bool, 0, len(streams))
+			for _, s := range streams {
+				gotStreams = append(gotStreams, s.compressed)
+				test.AssertEqual(t, 1, s.sent, "unexpected messages sent on stream")
+				test.AssertTrue(t, s.closed, "stream not closed for sending")
+			}
+			test.AssertEqual(t, tc.expStreams, gotStreams, "unexpected streams (compressed)")
+			test.AssertEqual(t, tc.expUncompressed, ct.isUncompressed("host1:10001"),
+				"unexpected uncompressed state")
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path"
 
+	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/build"
@@ -20,15 +21,50 @@ import (
 
 const (
 	defaultConfigFile = "daos_control.yml"
+
+	// CompressionNone disables compression of control plane RPC messages.
+	CompressionNone = "none"
+	// CompressionGzip enables gzip compression of control plane RPC messages.
+	CompressionGzip = "gzip"
 )
 
 // Config defines the parameters used to connect to a control API server.
 type Config struct {
-	SystemName      string                    `yaml:"name"`
-	ControlPort     int                       `yaml:"port"`
-	HostList        []string                  `yaml:"hostlist"`
-	TransportConfig *security.TransportConfig `yaml:"transport_config"`
-	Path            string                    `yaml:"-"`
+	SystemName       string                    `yaml:"name"`
+	ControlPort      int                       `yaml:"port"`
+	HostList         []string                  `yaml:"hostlist"`
+	TransportConfig  *security.TransportConfig `yaml:"transport_config"`
+	Compression      string                    `yaml:"compression,omitempty"`
+	AuthTokenFile    string                    `yaml:"auth_token_file,omitempty"`
+	AuthTokenCommand string                    `yaml:"auth_token_command,omitempty"`
+	Path             string                    `yaml:"-"`
+}
+
+// Validate checks that the configuration is usable.
+func (cfg *Config) Validate() error {
+	switch cfg.Compression {
+	case "", CompressionNone, CompressionGzip:
+	default:
+		return fmt.Errorf("unsupported compression %q (supported: %s, %s)",
+			cfg.Compression, CompressionNone, CompressionGzip)
+	}
+
+	if cfg.TransportConfig != nil {
+		if err := cfg.TransportConfig.Grpc.Validate(); err != nil {
+			return errors.Wrap(err, "invalid grpc config")
+		}
+	}
+
+	if cfg.AuthTokenFile != "" || cfg.AuthTokenCommand != "" {
+		if cfg.AuthTokenFile != "" && cfg.AuthTokenCommand != "" {
+			return errors.New("auth_token_file and auth_token_command are mutually exclusive")
+		}
+		if cfg.TransportConfig == nil || cfg.TransportConfig.AllowInsecure {
+			return errors.New("bearer token authentication requires transport security")
+		}
+	}
+
+	return nil
 }
 
 // DefaultConfig returns a Config populated with default values. Only
@@ -91,6 +127,9 @@ func LoadConfig(cfgPath string) (*Config, error) {
 	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
 		return nil, err
 	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
 	cfg.Path = cfgPath
 
 	return cfg, nil
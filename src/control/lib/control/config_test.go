@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -146,6 +146,18 @@ func TestControl_LoadConfig_BadInputs(t *testing.T) {
 			input:  `hostlist: ['nvm0612-ib0:10001','nvm0611-ib0:10001,'nvm0610-ib0:10001']`,
 			expErr: errors.New("did not find expected"),
 		},
+		"unsupported compression": {
+			input:  `compression: zstd`,
+			expErr: errors.New("unsupported compression \"zstd\""),
+		},
+		"both token sources": {
+			input:  "auth_token_file: /tmp/token\nauth_token_command: get-token",
+			expErr: errors.New("mutually exclusive"),
+		},
+		"token without transport security": {
+			input:  "auth_token_file: /tmp/token\ntransport_config:\n  allow_insecure: true",
+			expErr: errors.New("requires transport security"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			tmpDir, cleanup := test.CreateTestDir(t)
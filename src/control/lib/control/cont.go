@@ -14,6 +14,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
 // ContSetOwnerReq contains the parameters for the set owner request
@@ -62,3 +63,64 @@ func ContSetOwner(ctx context.Context, rpcClient UnaryInvoker, req *ContSetOwner
 
 	return errors.Wrap(ur.getMSError(), "container set-owner failed")
 }
+
+type (
+	// ContCheckReq contains the parameters for the container check request.
+	ContCheckReq struct {
+		msRequest
+		unaryRequest
+		PoolID string // UUID or label of the pool to check
+		Repair bool   // Remove any orphaned container metadata found
+	}
+
+	// ContOrphan describes container metadata left behind by a failed
+	// container destroy.
+	ContOrphan struct {
+		UUID    string `json:"uuid"`
+		Label   string `json:"label"`
+		Reason  string `json:"reason"`
+		Removed bool   `json:"removed"`
+	}
+
+	// ContCheckResp contains the results of the container check request.
+	ContCheckResp struct {
+		Status  int32         `json:"status"`
+		Orphans []*ContOrphan `json:"orphans"`
+	}
+)
+
+// ContCheck checks the container metadata of a DAOS pool for orphans left
+// behind by failed container destroys and optionally removes them.
+func ContCheck(ctx context.Context, rpcClient UnaryInvoker, req *ContCheckReq) (*ContCheckResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if req.PoolID == "" {
+		return nil, errors.New("no pool ID specified")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ContCheck(ctx, &mgmtpb.ContCheckReq{
+			Sys:    req.getSystem(rpcClient),
+			Id:     req.PoolID,
+			Repair: req.Repair,
+		})
+	})
+
+	rpcClient.Debugf("Check DAOS containers request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ContCheckResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "container check failed")
+	}
+	if resp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(resp.Status), "container check failed")
+	}
+
+	return resp, nil
+}
@@ -10,11 +10,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -135,3 +137,103 @@ func TestControl_ContSetOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_ContCheck(t *testing.T) {
+	testContUUID := uuid.New().String()
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ContCheckReq
+		expResp *ContCheckResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no pool ID": {
+			req:    &ContCheckReq{},
+			expErr: errors.New("no pool ID"),
+		},
+		"local failure": {
+			req: &ContCheckReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &ContCheckReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"engine failure": {
+			req: &ContCheckReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContCheckResp{Status: int32(daos.Busy)},
+				),
+			},
+			expErr: daos.Busy,
+		},
+		"no orphans": {
+			req: &ContCheckReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContCheckResp{},
+				),
+			},
+			expResp: &ContCheckResp{},
+		},
+		"orphans removed": {
+			req: &ContCheckReq{PoolID: "pool1", Repair: true},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContCheckResp{
+						Orphans: []*mgmtpb.ContCheckResp_Orphan{
+							{
+								Uuid:    testContUUID,
+								Label:   "cont1",
+								Reason:  "destroy did not complete",
+								Removed: true,
+							},
+						},
+					},
+				),
+			},
+			expResp: &ContCheckResp{
+				Orphans: []*ContOrphan{
+					{
+						UUID:    testContUUID,
+						Label:   "cont1",
+						Reason:  "destroy did not complete",
+						Removed: true,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ContCheck(context.TODO(), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
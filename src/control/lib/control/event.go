@@ -10,6 +10,7 @@ import (
 	"context"
 	"log"
 	"log/syslog"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -67,6 +68,91 @@ func eventNotify(ctx context.Context, rpcClient UnaryInvoker, seq uint64, evt *e
 	return convertMSResponse(ur, new(EventNotifyResp))
 }
 
+const (
+	// eventStreamMaxRetries is the number of consecutive attempts that
+	// will be made to re-establish an interrupted event stream.
+	eventStreamMaxRetries = 10
+	// eventStreamRetryInterval is the period to wait before attempting to
+	// re-establish an interrupted event stream.
+	eventStreamRetryInterval = 2 * time.Second
+)
+
+// SystemEventStreamReq contains the inputs for a request to stream the RAS
+// events received by the MS leader.
+type SystemEventStreamReq struct {
+	streamRequest
+}
+
+// SystemEventHandler defines the function signature for a callback which is
+// invoked for each event received from a system event stream.
+type SystemEventHandler func(*events.RASEvent) error
+
+// SystemEventStream streams the RAS events received by the MS leader to the
+// supplied handler until the context is canceled or the handler returns an
+// error. If the stream is interrupted, e.g. by a change of MS leader, it is
+// re-established with the current leader. Events raised while the stream is
+// being re-established are not received.
+func SystemEventStream(ctx context.Context, rpcClient Invoker, req *SystemEventStreamReq, handler SystemEventHandler) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if handler == nil {
+		return errors.New("nil handler")
+	}
+
+	hosts := req.getHostList()
+	pbReq := &mgmtpb.SystemEventStreamReq{Sys: req.getSystem(rpcClient)}
+	req.rpc = func(ctx context.Context, conn *grpc.ClientConn) (streamRecvFn, error) {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemEventStream(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+		return func() (proto.Message, error) {
+			return stream.Recv()
+		}, nil
+	}
+
+	var retries int
+	for {
+		leader, err := msLeaderAddr(ctx, rpcClient, hosts, req.Sys)
+		var handlerErr error
+		if err == nil {
+			req.SetHostList([]string{leader})
+			rpcClient.Debugf("DAOS system event stream request to %s", leader)
+
+			err = rpcClient.InvokeStreamRPC(ctx, req, func(msg proto.Message) error {
+				pbEvt, ok := msg.(*sharedpb.RASEvent)
+				if !ok {
+					return errors.Errorf("unexpected event stream response type %T", msg)
+				}
+				retries = 0
+
+				evt, err := events.NewFromProto(pbEvt)
+				if err != nil {
+					return err
+				}
+				handlerErr = handler(evt)
+				return handlerErr
+			})
+		}
+
+		switch {
+		case handlerErr != nil:
+			return handlerErr
+		case err == nil, ctx.Err() != nil, retries >= eventStreamMaxRetries:
+			return err
+		}
+		retries++
+
+		rpcClient.Debugf("system event stream interrupted (%s); reconnecting", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(eventStreamRetryInterval):
+		}
+	}
+}
+
 // EventForwarder implements the events.Handler interface, increments sequence
 // number for each event forwarded and distributes requests to MS access points.
 type EventForwarder struct {
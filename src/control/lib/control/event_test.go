@@ -14,11 +14,14 @@ import (
 	"math"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/events"
@@ -85,6 +88,85 @@ func TestControl_eventNotify(t *testing.T) {
 	}
 }
 
+func TestControl_SystemEventStream(t *testing.T) {
+	pbEvt, err := mockEvtEngineDied(t).ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lqResp := MockMSResponse("host1", nil, &mgmtpb.LeaderQueryResp{CurrentLeader: "host1"})
+
+	for name, tc := range map[string]struct {
+		req        *SystemEventStreamReq
+		noHandler  bool
+		handlerErr error
+		mic        *MockInvokerConfig
+		expEvents  int
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"nil handler": {
+			req:       &SystemEventStreamReq{},
+			noHandler: true,
+			expErr:    errors.New("nil handler"),
+		},
+		"leader query fails; retried until canceled": {
+			req: &SystemEventStreamReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("no leader"),
+			},
+			expErr: context.Canceled,
+		},
+		"stream ends": {
+			req: &SystemEventStreamReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse:   lqResp,
+				StreamResponses: []proto.Message{pbEvt, pbEvt},
+			},
+			expEvents: 2,
+		},
+		"handler error ends stream": {
+			req:        &SystemEventStreamReq{},
+			handlerErr: errors.New("stop"),
+			mic: &MockInvokerConfig{
+				UnaryResponse:   lqResp,
+				StreamResponses: []proto.Message{pbEvt, pbEvt},
+			},
+			expEvents: 1,
+			expErr:    errors.New("stop"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.mic != nil && tc.mic.UnaryError != nil {
+				// Cancel while waiting to retry.
+				time.AfterFunc(100*time.Millisecond, cancel)
+			}
+
+			var gotEvents int
+			var handler SystemEventHandler
+			if !tc.noHandler {
+				handler = func(evt *events.RASEvent) error {
+					gotEvents++
+					if evt.ID != events.RASEngineDied {
+						t.Errorf("unexpected event %s", evt.ID)
+					}
+					return tc.handlerErr
+				}
+			}
+
+			gotErr := SystemEventStream(ctx, NewMockInvoker(log, tc.mic), tc.req, handler)
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expEvents, gotEvents, "unexpected number of events")
+		})
+	}
+}
+
 func TestControl_EventForwarder_OnEvent(t *testing.T) {
 	rasEventEngineDied := mockEvtEngineDied(t).WithForwardable(false)
 	rasEventEngineDiedFwdable := mockEvtEngineDied(t).WithForwardable(true)
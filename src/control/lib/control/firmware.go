@@ -205,10 +205,11 @@ type (
 		unaryRequest
 		FirmwarePath string
 		Type         DeviceType
-		Devices      []string // Specific devices to update
-		ModelID      string   // Update only devices of specific model
-		FirmwareRev  string   // Update only devices with a specific current firmware
-
+		Devices      []string                   // Specific devices to update
+		ModelID      string                     // Update only devices of specific model
+		FirmwareRev  string                     // Update only devices with a specific current firmware
+		NVMeAction   storage.NVMeFirmwareAction // Update, stage or commit NVMe firmware
+		NVMeSlot     uint32                     // NVMe firmware slot, 0 for controller choice
 	}
 
 	// HostSCMUpdateMap maps a host name to a slice of SCM update results.
@@ -217,8 +218,10 @@ type (
 	// SCMUpdateResult represents the results of a firmware update
 	// for a single SCM device.
 	SCMUpdateResult struct {
-		Module storage.ScmModule
-		Error  error
+		Module        storage.ScmModule
+		StagedVersion string                          // version awaiting activation
+		UpdateStatus  storage.ScmFirmwareUpdateStatus // status after staging
+		Error         error
 	}
 
 	// HostNVMeUpdateMap maps a host name to a slice of NVMe update results.
@@ -239,6 +242,11 @@ type (
 	}
 )
 
+const (
+	// maxNVMeFirmwareSlot is the highest firmware slot defined by the NVMe spec.
+	maxNVMeFirmwareSlot = 7
+)
+
 const (
 	// DeviceTypeUnknown represents an unspecified device type.
 	DeviceTypeUnknown DeviceType = iota
@@ -283,7 +291,10 @@ func (ur *FirmwareUpdateResp) addHostSCMResults(hostAddr string, pbResp *ctlpb.F
 		scmResults := make([]*SCMUpdateResult, 0, len(pbResp.ScmResults))
 
 		for _, pbRes := range pbResp.ScmResults {
-			devResult := &SCMUpdateResult{}
+			devResult := &SCMUpdateResult{
+				StagedVersion: pbRes.StagedVersion,
+				UpdateStatus:  storage.ScmFirmwareUpdateStatus(pbRes.UpdateStatus),
+			}
 			if err := convert.Types(pbRes.Module, &devResult.Module); err != nil {
 				return errors.Wrapf(err, "unable to convert module")
 			}
@@ -349,13 +360,22 @@ func (m HostNVMeUpdateMap) Keys() []string {
 // (successful or otherwise) are received, and returns a single response
 // structure containing results for all host firmware update operations.
 func FirmwareUpdate(ctx context.Context, rpcClient UnaryInvoker, req *FirmwareUpdateReq) (*FirmwareUpdateResp, error) {
-	if req.FirmwarePath == "" {
+	if req.FirmwarePath == "" && req.NVMeAction != storage.NVMeFirmwareCommit {
 		return nil, errors.New("firmware file path missing")
 	}
 	pbType, err := req.Type.toCtlPBType()
 	if err != nil {
 		return nil, err
 	}
+	if req.Type != DeviceTypeNVMe && (req.NVMeAction != storage.NVMeFirmwareUpdate || req.NVMeSlot != 0) {
+		return nil, errors.New("firmware stage, commit and slot selection are only supported for NVMe devices")
+	}
+	if req.NVMeAction == storage.NVMeFirmwareCommit && req.NVMeSlot == 0 {
+		return nil, errors.New("firmware slot required to commit NVMe firmware")
+	}
+	if req.NVMeSlot > maxNVMeFirmwareSlot {
+		return nil, errors.Errorf("invalid NVMe firmware slot %d (max %d)", req.NVMeSlot, maxNVMeFirmwareSlot)
+	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return ctlpb.NewCtlSvcClient(conn).FirmwareUpdate(ctx, &ctlpb.FirmwareUpdateReq{
 			FirmwarePath: req.FirmwarePath,
@@ -363,6 +383,8 @@ func FirmwareUpdate(ctx context.Context, rpcClient UnaryInvoker, req *FirmwareUp
 			DeviceIDs:    req.Devices,
 			ModelID:      req.ModelID,
 			FirmwareRev:  req.FirmwareRev,
+			NvmeAction:   ctlpb.FirmwareUpdateReq_NvmeAction(req.NVMeAction),
+			NvmeSlot:     req.NVMeSlot,
 		})
 	})
 
@@ -337,6 +337,29 @@ func TestControl_FirmwareUpdate(t *testing.T) {
 			},
 			expErr: errors.New("firmware file path missing"),
 		},
+		"stage on SCM": {
+			req: &FirmwareUpdateReq{
+				Type:         DeviceTypeSCM,
+				FirmwarePath: "/my/path",
+				NVMeAction:   storage.NVMeFirmwareStage,
+			},
+			expErr: errors.New("only supported for NVMe"),
+		},
+		"NVMe commit without slot": {
+			req: &FirmwareUpdateReq{
+				Type:       DeviceTypeNVMe,
+				NVMeAction: storage.NVMeFirmwareCommit,
+			},
+			expErr: errors.New("firmware slot required"),
+		},
+		"NVMe slot out of range": {
+			req: &FirmwareUpdateReq{
+				Type:         DeviceTypeNVMe,
+				FirmwarePath: "/my/path",
+				NVMeSlot:     8,
+			},
+			expErr: errors.New("invalid NVMe firmware slot 8"),
+		},
 		"local failure": {
 			req: &FirmwareUpdateReq{
 				Type:         DeviceTypeSCM,
@@ -398,6 +421,23 @@ func TestControl_FirmwareUpdate(t *testing.T) {
 				},
 			},
 		},
+		"NVMe commit success": {
+			req: &FirmwareUpdateReq{
+				Type:       DeviceTypeNVMe,
+				NVMeAction: storage.NVMeFirmwareCommit,
+				NVMeSlot:   2,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+					NvmeResults: pbNVMeResults,
+				}),
+			},
+			expResp: &FirmwareUpdateResp{
+				HostNVMeResult: map[string][]*NVMeUpdateResult{
+					"host1": expNVMeResults,
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -436,7 +476,8 @@ func getTestSCMUpdateResults(t *testing.T) ([]*ctlpb.ScmFirmwareUpdateResp, []*S
 				Channelid:       4,
 				Channelposition: 5,
 			},
-			Error: "",
+			StagedVersion: "1.0.0.2",
+			UpdateStatus:  uint32(storage.ScmUpdateStatusStaged),
 		},
 		{
 			Module: &ctlpb.ScmModule{
@@ -454,7 +495,10 @@ func getTestSCMUpdateResults(t *testing.T) ([]*ctlpb.ScmFirmwareUpdateResp, []*S
 
 	expSCMResults := make([]*SCMUpdateResult, 0, len(pbSCMResults))
 	for _, pbRes := range pbSCMResults {
-		res := &SCMUpdateResult{}
+		res := &SCMUpdateResult{
+			StagedVersion: pbRes.StagedVersion,
+			UpdateStatus:  storage.ScmFirmwareUpdateStatus(pbRes.UpdateStatus),
+		}
 		if err := convert.Types(pbRes.Module, &res.Module); err != nil {
 			t.Fatalf("couldn't set up expected results: %v", err)
 		}
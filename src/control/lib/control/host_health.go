@@ -0,0 +1,118 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/clock"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// unreachableThreshold is the number of consecutive connection
+	// failures after which a host is considered to be unreachable.
+	unreachableThreshold = 3
+	// unreachableRetryInterval is the period for which an unreachable host
+	// is skipped when choosing MS candidates before it is tried again.
+	unreachableRetryInterval = 5 * time.Minute
+)
+
+type (
+	hostHealth struct {
+		failures    uint
+		lastFailure time.Time
+	}
+
+	// hostHealthTracker records hosts that persistently fail to respond
+	// so that they can be demoted when choosing the hosts to send MS
+	// requests to. Without this, a stale entry in the host list adds a
+	// connection timeout to every request that happens to select it.
+	hostHealthTracker struct {
+		sync.Mutex
+		log   debugLogger
+		warn  logging.NoticeLogger
+		clock clock.Clock
+		hosts map[string]*hostHealth
+	}
+)
+
+func newHostHealthTracker(log debugLogger) *hostHealthTracker {
+	return &hostHealthTracker{
+		log:   log,
+		clock: clock.New(),
+		hosts: make(map[string]*hostHealth),
+	}
+}
+
+// isUnreachableErr indicates whether the error returned for a host suggests
+// that the host could not be reached at all.
+func isUnreachableErr(err error) bool {
+	if errors.Cause(err) == context.Canceled {
+		return false
+	}
+	return IsConnErr(err) || isTimeout(err)
+}
+
+func (t *hostHealthTracker) warnf(format string, args ...interface{}) {
+	if t.warn != nil {
+		t.warn.Noticef(format, args...)
+		return
+	}
+	t.log.Debugf(format, args...)
+}
+
+// record updates the health of the host based on the result of a request
+// sent to it.
+func (t *hostHealthTracker) record(host string, err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	hh, found := t.hosts[host]
+	if !isUnreachableErr(err) {
+		if found {
+			if hh.failures >= unreachableThreshold {
+				t.warnf("host %s is reachable again", host)
+			}
+			delete(t.hosts, host)
+		}
+		return
+	}
+
+	if !found {
+		hh = new(hostHealth)
+		t.hosts[host] = hh
+	}
+	hh.failures++
+	hh.lastFailure = t.clock.Now()
+
+	switch {
+	case hh.failures == unreachableThreshold:
+		t.warnf("host %s failed to respond to %d consecutive requests and will be "+
+			"deprioritized; check that it is a valid access point: %s", host, hh.failures, err)
+	case hh.failures > unreachableThreshold:
+		t.log.Debugf("host %s is still unreachable after %d attempts: %s", host, hh.failures, err)
+	}
+}
+
+// isDemoted indicates whether the host has persistently failed to respond
+// and has not yet been skipped for long enough to be worth trying again.
+func (t *hostHealthTracker) isDemoted(host string) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	hh, found := t.hosts[host]
+	if !found || hh.failures < unreachableThreshold {
+		return false
+	}
+
+	return t.clock.Since(hh.lastFailure) < unreachableRetryInterval
+}
@@ -0,0 +1,129 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/clock"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_hostHealthTracker(t *testing.T) {
+	const host = "host1:10001"
+	refused := FaultConnectionRefused(host)
+
+	for name, tc := range map[string]struct {
+		results    []error
+		elapsed    time.Duration
+		expDemoted bool
+		expWarning string
+	}{
+		"no results": {},
+		"success": {
+			results: []error{nil},
+		},
+		"failures below threshold": {
+			results: []error{refused, refused},
+		},
+		"persistently unreachable": {
+			results:    []error{refused, refused, refused},
+			expDemoted: true,
+			expWarning: "host1:10001 failed to respond to 3 consecutive requests",
+		},
+		"timeouts count as failures": {
+			results:    []error{context.DeadlineExceeded, refused, context.DeadlineExceeded},
+			expDemoted: true,
+		},
+		"cancellation ignored": {
+			results: []error{refused, refused, context.Canceled},
+		},
+		"server errors reset failures": {
+			results: []error{refused, refused, errors.New("not leader"), refused},
+		},
+		"recovered": {
+			results:    []error{refused, refused, refused, nil},
+			expWarning: "host1:10001 is reachable again",
+		},
+		"retry after interval": {
+			results: []error{refused, refused, refused},
+			elapsed: unreachableRetryInterval,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			start := time.Now()
+			tracker := newHostHealthTracker(log)
+			tracker.warn = log
+			mc := clock.NewMock(start)
+			tracker.clock = mc
+
+			for _, err := range tc.results {
+				tracker.record(host, err)
+			}
+
+			mc.Advance(tc.elapsed)
+			test.AssertEqual(t, tc.expDemoted, tracker.isDemoted(host), "unexpected demoted state")
+			test.AssertEqual(t, false, tracker.isDemoted("host2:10001"), "unknown host demoted")
+
+			if tc.expWarning != "" && !strings.Contains(buf.String(), tc.expWarning) {
+				t.Fatalf("expected %q to be logged", tc.expWarning)
+			}
+		})
+	}
+}
+
+func TestControl_Client_filterMSCandidates(t *testing.T) {
+	for name, tc := range map[string]struct {
+		hosts       []string
+		unreachable []string
+		expHosts    []string
+	}{
+		"all reachable": {
+			hosts:    []string{"host1", "host2:10001", "host3"},
+			expHosts: []string{"host1", "host2:10001", "host3"},
+		},
+		"some unreachable": {
+			hosts:       []string{"host1", "host2:10001", "host3"},
+			unreachable: []string{"host1:10001", "host2:10001"},
+			expHosts:    []string{"host3"},
+		},
+		"all unreachable": {
+			hosts:       []string{"host1", "host2"},
+			unreachable: []string{"host1:10001", "host2:10001"},
+			expHosts:    []string{"host1", "host2"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := DefaultConfig()
+			cfg.ControlPort = 10001
+			client := NewClient(WithClientLogger(log), WithConfig(cfg))
+
+			for _, host := range tc.unreachable {
+				for i := 0; i < unreachableThreshold; i++ {
+					client.health.record(host, FaultConnectionRefused(host))
+				}
+			}
+
+			if diff := cmp.Diff(tc.expHosts, client.filterMSCandidates(tc.hosts)); diff != "" {
+				t.Fatalf("unexpected candidates (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
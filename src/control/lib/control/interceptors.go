@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +8,7 @@ package control
 
 import (
 	"context"
+	"io"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -36,19 +37,40 @@ func connErrToFault(st *status.Status, target string) error {
 	}
 }
 
+// unwrapStatusErr returns the error wrapped in a gRPC status, if any, or
+// otherwise attempts to resolve the status to a more informative Fault.
+func unwrapStatusErr(err error, target string) error {
+	st := status.Convert(err)
+	err = proto.UnwrapError(st)
+	if err.Error() != st.Err().Error() {
+		return err
+	}
+	return connErrToFault(st, target)
+}
+
+// errorUnwrappingStream wraps a client stream in order to unwrap errors
+// returned by the server after the stream has been established.
+type errorUnwrappingStream struct {
+	grpc.ClientStream
+	target string
+}
+
+func (s *errorUnwrappingStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return unwrapStatusErr(err, s.target)
+}
+
 // streamErrorInterceptor calls the specified streaming RPC and returns any unwrapped errors.
 func streamErrorInterceptor() grpc.DialOption {
 	return grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
 		cs, err := streamer(ctx, desc, cc, method, opts...)
 		if err != nil {
-			st := status.Convert(err)
-			err = proto.UnwrapError(st)
-			if err.Error() != st.Err().Error() {
-				return cs, err
-			}
-			return cs, connErrToFault(st, cc.Target())
+			return cs, unwrapStatusErr(err, cc.Target())
 		}
-		return cs, nil
+		return &errorUnwrappingStream{ClientStream: cs, target: cc.Target()}, nil
 	})
 }
 
@@ -57,12 +79,7 @@ func unaryErrorInterceptor() grpc.DialOption {
 	return grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		err := invoker(ctx, method, req, reply, cc, opts...)
 		if err != nil {
-			st := status.Convert(err)
-			err = proto.UnwrapError(st)
-			if err.Error() != st.Err().Error() {
-				return err
-			}
-			return connErrToFault(st, cc.Target())
+			return unwrapStatusErr(err, cc.Target())
 		}
 		return nil
 	})
@@ -25,6 +25,7 @@ import (
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/clock"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -55,6 +56,11 @@ type (
 		HostResponses       HostResponseChan
 		ReqTimeout          time.Duration
 		RetryTimeout        time.Duration
+		StreamResponses     []proto.Message
+		StreamError         error
+		// Clock is used for retry backoff and response delays. If
+		// unset, the system clock is used.
+		Clock clock.Clock
 	}
 
 	// MockInvoker implements the Invoker interface in order
@@ -100,6 +106,13 @@ func (mi *MockInvoker) GetSystem() string {
 	return mi.cfg.Sys
 }
 
+func (mi *MockInvoker) clock() clock.Clock {
+	if mi.cfg.Clock == nil {
+		return clock.New()
+	}
+	return mi.cfg.Clock
+}
+
 func (mi *MockInvoker) InvokeUnaryRPC(ctx context.Context, uReq UnaryRequest) (*UnaryResponse, error) {
 	// Allow the test to override the timeouts set by the caller.
 	if mi.cfg.ReqTimeout > 0 {
@@ -110,7 +123,7 @@ func (mi *MockInvoker) InvokeUnaryRPC(ctx context.Context, uReq UnaryRequest) (*
 			rReq.setRetryTimeout(mi.cfg.RetryTimeout)
 		}
 	}
-	return invokeUnaryRPC(ctx, mi.log, mi, uReq, nil)
+	return invokeUnaryRPC(ctx, mi.log, mi.clock(), mi, uReq, nil)
 }
 
 func (mi *MockInvoker) InvokeUnaryRPCAsync(ctx context.Context, uReq UnaryRequest) (HostResponseChan, error) {
@@ -154,7 +167,7 @@ func (mi *MockInvoker) InvokeUnaryRPCAsync(ctx context.Context, uReq UnaryReques
 				delay = mi.cfg.UnaryResponseDelays[delayIdx][idx]
 			}
 			if delay > 0 {
-				time.Sleep(delay)
+				<-mi.clock().After(delay)
 			}
 
 			select {
@@ -169,6 +182,23 @@ func (mi *MockInvoker) InvokeUnaryRPCAsync(ctx context.Context, uReq UnaryReques
 	return responses, nil
 }
 
+func (mi *MockInvoker) InvokeStreamRPC(ctx context.Context, sReq StreamRequest, handler StreamHandler) error {
+	mi.invokeCountMutex.Lock()
+	mi.invokeCount++
+	mi.invokeCountMutex.Unlock()
+
+	for _, msg := range mi.cfg.StreamResponses {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := handler(msg); err != nil {
+			return err
+		}
+	}
+
+	return mi.cfg.StreamError
+}
+
 func (mi *MockInvoker) SetConfig(_ *Config) {}
 
 // DefaultMockInvokerConfig returns the default MockInvoker
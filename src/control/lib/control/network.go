@@ -32,6 +32,7 @@ type HostFabricInterface struct {
 	NumaNode    uint32
 	Priority    uint32
 	NetDevClass hardware.NetDevClass
+	LinkSpeed   float64 // link speed of the network port in Gbit/s
 }
 
 func (hfi *HostFabricInterface) String() string {
@@ -60,6 +61,141 @@ func (hf *HostFabric) AddInterface(hfi *HostFabricInterface) {
 	sort.Strings(hf.Providers)
 }
 
+// RankFabricInterfaces sorts the supplied interfaces in order of preference:
+// by provider priority, then by link speed (fastest first). Interfaces of equal
+// preference retain their relative order.
+func RankFabricInterfaces(ifaces []*HostFabricInterface) {
+	sort.SliceStable(ifaces, func(i, j int) bool {
+		if ifaces[i].Priority != ifaces[j].Priority {
+			return ifaces[i].Priority < ifaces[j].Priority
+		}
+		return ifaces[i].LinkSpeed > ifaces[j].LinkSpeed
+	})
+}
+
+// FabricRecommendation describes the preferred fabric configuration for a
+// host, as would be selected when generating a server config.
+type FabricRecommendation struct {
+	// Provider is the recommended provider, supported on the most NUMA nodes.
+	Provider string `json:"provider"`
+	// NetDevClass is the device class of the recommended interfaces.
+	NetDevClass hardware.NetDevClass `json:"net_dev_class"`
+	// NumaInterfaces contains the ranked interfaces on each NUMA node.
+	NumaInterfaces map[uint32][]*HostFabricInterface `json:"numa_interfaces"`
+}
+
+// Interface returns the recommended interface on the given NUMA node, if any.
+func (fr *FabricRecommendation) Interface(numaNode uint32) *HostFabricInterface {
+	if fr == nil {
+		return nil
+	}
+	for _, iface := range fr.NumaInterfaces[numaNode] {
+		if iface.Provider == fr.Provider && iface.NetDevClass == fr.NetDevClass {
+			return iface
+		}
+	}
+	return nil
+}
+
+// NumaNodes returns the sorted NUMA node IDs with ranked interfaces.
+func (fr *FabricRecommendation) NumaNodes() []uint32 {
+	nodes := make([]uint32, 0, len(fr.NumaInterfaces))
+	for nn := range fr.NumaInterfaces {
+		nodes = append(nodes, nn)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
+
+// Recommend ranks the host's fabric interfaces on each NUMA node and selects
+// the provider and device class to recommend for use in a server config. If
+// provider is set, only interfaces supporting it are considered.
+//
+// Only device classes supported by config generation are recommended. The
+// recommended provider is the one with interfaces on the most NUMA nodes, with
+// ties broken by the lowest total priority and then the highest total link
+// speed of the best interface on each node.
+func (hf *HostFabric) Recommend(provider string) *FabricRecommendation {
+	fr := &FabricRecommendation{
+		NumaInterfaces: make(map[uint32][]*HostFabricInterface),
+	}
+	if hf == nil {
+		return fr
+	}
+
+	for _, iface := range hf.Interfaces {
+		if provider != "" && iface.Provider != provider {
+			continue
+		}
+		fr.NumaInterfaces[iface.NumaNode] = append(fr.NumaInterfaces[iface.NumaNode], iface)
+	}
+
+	type candidate struct {
+		provider string
+		ndc      hardware.NetDevClass
+	}
+	type score struct {
+		nodes    int
+		priority uint32
+		speed    float64
+	}
+	scores := make(map[candidate]*score)
+	for _, ifaces := range fr.NumaInterfaces {
+		RankFabricInterfaces(ifaces)
+
+		seen := make(map[candidate]bool)
+		for _, iface := range ifaces {
+			switch iface.NetDevClass {
+			case hardware.Ether, hardware.Infiniband:
+			default:
+				continue
+			}
+			c := candidate{provider: iface.Provider, ndc: iface.NetDevClass}
+			if seen[c] {
+				continue // only the best interface on each node counts
+			}
+			seen[c] = true
+
+			if scores[c] == nil {
+				scores[c] = new(score)
+			}
+			scores[c].nodes++
+			scores[c].priority += iface.Priority
+			scores[c].speed += iface.LinkSpeed
+		}
+	}
+
+	var best *score
+	for c, s := range scores {
+		switch {
+		case best == nil:
+		case s.nodes != best.nodes:
+			if s.nodes < best.nodes {
+				continue
+			}
+		case s.priority != best.priority:
+			if s.priority > best.priority {
+				continue
+			}
+		case s.speed != best.speed:
+			if s.speed < best.speed {
+				continue
+			}
+		case c.provider != fr.Provider:
+			if c.provider > fr.Provider {
+				continue
+			}
+		case c.ndc > fr.NetDevClass:
+			continue
+		}
+		best = s
+		fr.Provider = c.provider
+		fr.NetDevClass = c.ndc
+	}
+
+	return fr
+}
+
 // HostFabricSet contains a HostFabric configuration and the
 // set of hosts matching this configuration.
 type HostFabricSet struct {
@@ -16,6 +16,7 @@ import (
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -380,3 +381,131 @@ func TestControl_GetAttachInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_RankFabricInterfaces(t *testing.T) {
+	ifaces := []*HostFabricInterface{
+		{Device: "slow", Priority: 1, LinkSpeed: 10},
+		{Device: "low-prio", Priority: 2, LinkSpeed: 100},
+		{Device: "unknown-speed", Priority: 1},
+		{Device: "fast", Priority: 1, LinkSpeed: 25},
+		{Device: "best-prio", Priority: 0},
+		{Device: "fast-dupe", Priority: 1, LinkSpeed: 25},
+	}
+
+	RankFabricInterfaces(ifaces)
+
+	var gotDevs []string
+	for _, iface := range ifaces {
+		gotDevs = append(gotDevs, iface.Device)
+	}
+	expDevs := []string{"best-prio", "fast", "fast-dupe", "slow", "unknown-speed", "low-prio"}
+	if diff := cmp.Diff(expDevs, gotDevs); diff != "" {
+		t.Fatalf("unexpected ranking (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestControl_HostFabric_Recommend(t *testing.T) {
+	mockIface := func(dev, prov string, numa, prio uint32, ndc hardware.NetDevClass, speed float64) *HostFabricInterface {
+		return &HostFabricInterface{
+			Device:      dev,
+			Provider:    prov,
+			NumaNode:    numa,
+			Priority:    prio,
+			NetDevClass: ndc,
+			LinkSpeed:   speed,
+		}
+	}
+	ib0Verbs := mockIface("ib0", "ofi+verbs", 0, 0, hardware.Infiniband, 25)
+	ib0Tcp := mockIface("ib0", "ofi+tcp", 0, 2, hardware.Infiniband, 25)
+	eth0Tcp := mockIface("eth0", "ofi+tcp", 0, 2, hardware.Ether, 1)
+	ib1Verbs := mockIface("ib1", "ofi+verbs", 1, 0, hardware.Infiniband, 12.5)
+	ib1Tcp := mockIface("ib1", "ofi+tcp", 1, 2, hardware.Infiniband, 12.5)
+	ib2Verbs := mockIface("ib2", "ofi+verbs", 1, 0, hardware.Infiniband, 25)
+	eth1Tcp := mockIface("eth1", "ofi+tcp", 1, 2, hardware.Ether, 1)
+	lo := mockIface("lo", "ofi+tcp", 0, 1, hardware.Loopback, 0)
+
+	for name, tc := range map[string]struct {
+		hf          *HostFabric
+		provider    string
+		expProvider string
+		expNdc      hardware.NetDevClass
+		expNuma     map[uint32][]*HostFabricInterface
+		expBest     map[uint32]*HostFabricInterface
+	}{
+		"nil": {
+			expNuma: map[uint32][]*HostFabricInterface{},
+		},
+		"best priority and speed on each numa": {
+			hf: &HostFabric{
+				Interfaces: []*HostFabricInterface{
+					eth0Tcp, ib0Tcp, ib0Verbs, ib1Tcp, ib1Verbs, ib2Verbs, eth1Tcp,
+				},
+			},
+			expProvider: "ofi+verbs",
+			expNdc:      hardware.Infiniband,
+			expNuma: map[uint32][]*HostFabricInterface{
+				0: {ib0Verbs, ib0Tcp, eth0Tcp},
+				1: {ib2Verbs, ib1Verbs, ib1Tcp, eth1Tcp},
+			},
+			expBest: map[uint32]*HostFabricInterface{
+				0: ib0Verbs,
+				1: ib2Verbs,
+			},
+		},
+		"provider supported on more numa nodes preferred": {
+			hf: &HostFabric{
+				Interfaces: []*HostFabricInterface{ib0Verbs, eth0Tcp, eth1Tcp},
+			},
+			expProvider: "ofi+tcp",
+			expNdc:      hardware.Ether,
+			expNuma: map[uint32][]*HostFabricInterface{
+				0: {ib0Verbs, eth0Tcp},
+				1: {eth1Tcp},
+			},
+			expBest: map[uint32]*HostFabricInterface{
+				0: eth0Tcp,
+				1: eth1Tcp,
+			},
+		},
+		"filtered by provider": {
+			hf: &HostFabric{
+				Interfaces: []*HostFabricInterface{ib0Verbs, ib0Tcp, eth0Tcp, lo},
+			},
+			provider:    "ofi+tcp",
+			expProvider: "ofi+tcp",
+			expNdc:      hardware.Infiniband,
+			expNuma: map[uint32][]*HostFabricInterface{
+				0: {lo, ib0Tcp, eth0Tcp},
+			},
+			expBest: map[uint32]*HostFabricInterface{
+				0: ib0Tcp,
+			},
+		},
+		"unsupported class only": {
+			hf: &HostFabric{
+				Interfaces: []*HostFabricInterface{lo},
+			},
+			expNuma: map[uint32][]*HostFabricInterface{
+				0: {lo},
+			},
+			expBest: map[uint32]*HostFabricInterface{
+				0: nil,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			fr := tc.hf.Recommend(tc.provider)
+
+			test.AssertEqual(t, tc.expProvider, fr.Provider, "unexpected provider")
+			test.AssertEqual(t, tc.expNdc, fr.NetDevClass, "unexpected net dev class")
+			if diff := cmp.Diff(tc.expNuma, fr.NumaInterfaces); diff != "" {
+				t.Fatalf("unexpected ranked interfaces (-want, +got):\n%s\n", diff)
+			}
+			for nn, expIface := range tc.expBest {
+				if diff := cmp.Diff(expIface, fr.Interface(nn)); diff != "" {
+					t.Fatalf("unexpected interface for numa %d (-want, +got):\n%s\n", nn, diff)
+				}
+			}
+		})
+	}
+}
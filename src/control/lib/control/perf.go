@@ -0,0 +1,176 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+const defaultPerfRounds = 100
+
+type (
+	// ControlPerfReq contains the parameters for a control-plane
+	// performance measurement.
+	ControlPerfReq struct {
+		unaryRequest
+		// Rounds is the number of times the no-op RPC is fanned out to
+		// all hosts in the request's host list.
+		Rounds int
+		// PayloadSize is the number of bytes of padding to send with
+		// each no-op RPC.
+		PayloadSize int
+	}
+
+	// PerfLatency contains summary statistics for a set of latency samples.
+	PerfLatency struct {
+		Samples int           `json:"samples"`
+		Min     time.Duration `json:"min_ns"`
+		Mean    time.Duration `json:"mean_ns"`
+		Max     time.Duration `json:"max_ns"`
+		P99     time.Duration `json:"p99_ns"`
+	}
+
+	// ControlPerfResp contains the results of a control-plane
+	// performance measurement.
+	ControlPerfResp struct {
+		HostErrorsResp
+		Rounds      int           `json:"rounds"`
+		PayloadSize int           `json:"payload_size"`
+		RPCs        int           `json:"rpcs"`
+		Elapsed     time.Duration `json:"elapsed_ns"`
+		// RoundLatency is the time taken for all hosts to respond to
+		// each fan-out.
+		RoundLatency *PerfLatency `json:"round_latency"`
+		// HostLatency is the round-trip time of the no-op RPC to each
+		// host, as observed during the fan-outs.
+		HostLatency map[string]*PerfLatency `json:"host_latency"`
+	}
+)
+
+func newPerfLatency(samples []time.Duration) *PerfLatency {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, s := range sorted {
+		total += s
+	}
+
+	// Nearest-rank percentile.
+	p99Idx := (len(sorted)*99+99)/100 - 1
+
+	return &PerfLatency{
+		Samples: len(sorted),
+		Min:     sorted[0],
+		Mean:    total / time.Duration(len(sorted)),
+		Max:     sorted[len(sorted)-1],
+		P99:     sorted[p99Idx],
+	}
+}
+
+// Throughput returns the number of successful RPCs completed per second.
+func (resp *ControlPerfResp) Throughput() float64 {
+	if resp == nil || resp.Elapsed <= 0 {
+		return 0
+	}
+	return float64(resp.RPCs) / resp.Elapsed.Seconds()
+}
+
+// ControlPerf measures control-plane performance by repeatedly fanning out a
+// no-op RPC to the hosts in the request's host list. The RPC is handled by
+// the management service on each host without involving the engines, so the
+// results reflect the control-plane round-trip overhead only.
+func ControlPerf(ctx context.Context, rpcClient UnaryInvoker, req *ControlPerfReq) (*ControlPerfResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.PayloadSize < 0 {
+		return nil, errors.New("payload size must not be negative")
+	}
+
+	rounds := req.Rounds
+	if rounds <= 0 {
+		rounds = defaultPerfRounds
+	}
+
+	pbReq := &mgmtpb.NoopReq{
+		Sys:     req.getSystem(rpcClient),
+		Payload: make([]byte, req.PayloadSize),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).Noop(ctx, pbReq)
+	})
+
+	resp := &ControlPerfResp{
+		Rounds:      rounds,
+		PayloadSize: req.PayloadSize,
+	}
+	hostSamples := make(map[string][]time.Duration)
+	roundSamples := make([]time.Duration, 0, rounds)
+
+	start := time.Now()
+	for i := 0; i < rounds; i++ {
+		roundStart := time.Now()
+		respChan, err := rpcClient.InvokeUnaryRPCAsync(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+	gather:
+		for {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case hr, ok := <-respChan:
+				if !ok {
+					break gather
+				}
+				elapsed := time.Since(roundStart)
+
+				if hr.Error != nil {
+					if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				if _, ok := hr.Message.(*mgmtpb.NoopResp); !ok {
+					err := errors.Errorf("unable to unpack message: %+v", hr.Message)
+					if err := resp.addHostError(hr.Addr, err); err != nil {
+						return nil, err
+					}
+					continue
+				}
+
+				resp.RPCs++
+				hostSamples[hr.Addr] = append(hostSamples[hr.Addr], elapsed)
+			}
+		}
+		roundSamples = append(roundSamples, time.Since(roundStart))
+	}
+	resp.Elapsed = time.Since(start)
+
+	resp.RoundLatency = newPerfLatency(roundSamples)
+	resp.HostLatency = make(map[string]*PerfLatency)
+	for addr, samples := range hostSamples {
+		resp.HostLatency[addr] = newPerfLatency(samples)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,188 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_newPerfLatency(t *testing.T) {
+	ms := func(n int) time.Duration { return time.Duration(n) * time.Millisecond }
+
+	for name, tc := range map[string]struct {
+		samples []time.Duration
+		expLat  *PerfLatency
+	}{
+		"no samples": {},
+		"one sample": {
+			samples: []time.Duration{ms(3)},
+			expLat: &PerfLatency{
+				Samples: 1,
+				Min:     ms(3),
+				Mean:    ms(3),
+				Max:     ms(3),
+				P99:     ms(3),
+			},
+		},
+		"unsorted samples": {
+			samples: []time.Duration{ms(4), ms(1), ms(3), ms(2)},
+			expLat: &PerfLatency{
+				Samples: 4,
+				Min:     ms(1),
+				Mean:    ms(2) + ms(1)/2,
+				Max:     ms(4),
+				P99:     ms(4),
+			},
+		},
+		"p99 excludes outlier": {
+			samples: func() []time.Duration {
+				s := make([]time.Duration, 200)
+				for i := range s {
+					s[i] = ms(1)
+				}
+				s[100] = ms(500)
+				return s
+			}(),
+			expLat: &PerfLatency{
+				Samples: 200,
+				Min:     ms(1),
+				Mean:    ms(1) + ms(499)/200,
+				Max:     ms(500),
+				P99:     ms(1),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotLat := newPerfLatency(tc.samples)
+			if diff := cmp.Diff(tc.expLat, gotLat); diff != "" {
+				t.Fatalf("unexpected latency (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ControlPerf(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ControlPerfReq
+		expResp *ControlPerfResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"negative payload size": {
+			req:    &ControlPerfReq{PayloadSize: -1},
+			expErr: errors.New("must not be negative"),
+		},
+		"invoke fails": {
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("whoops"),
+			},
+			req:    &ControlPerfReq{Rounds: 2},
+			expErr: errors.New("whoops"),
+		},
+		"default rounds": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1", Message: &mgmtpb.NoopResp{}},
+					},
+				},
+			},
+			req: &ControlPerfReq{},
+			expResp: &ControlPerfResp{
+				Rounds:       defaultPerfRounds,
+				RPCs:         defaultPerfRounds,
+				RoundLatency: &PerfLatency{Samples: defaultPerfRounds},
+				HostLatency: map[string]*PerfLatency{
+					"host1": {Samples: defaultPerfRounds},
+				},
+			},
+		},
+		"host errors": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1", Message: &mgmtpb.NoopResp{}},
+						{Addr: "host2", Error: errors.New("remote failed")},
+						{Addr: "host3", Message: &mgmtpb.SystemQueryResp{}},
+					},
+				},
+			},
+			req: &ControlPerfReq{Rounds: 3, PayloadSize: 1024},
+			expResp: &ControlPerfResp{
+				HostErrorsResp: MockHostErrorsResp(t,
+					&MockHostError{"host2", "remote failed"},
+					&MockHostError{"host3", "unable to unpack message: "},
+				),
+				Rounds:       3,
+				PayloadSize:  1024,
+				RPCs:         3,
+				RoundLatency: &PerfLatency{Samples: 3},
+				HostLatency: map[string]*PerfLatency{
+					"host1": {Samples: 3},
+				},
+			},
+		},
+		"multiple hosts": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: MockHostResponses(t, 2, "host%d", &mgmtpb.NoopResp{}),
+				},
+			},
+			req: &ControlPerfReq{Rounds: 5},
+			expResp: &ControlPerfResp{
+				Rounds:       5,
+				RPCs:         10,
+				RoundLatency: &PerfLatency{Samples: 5},
+				HostLatency: map[string]*PerfLatency{
+					"host0": {Samples: 5},
+					"host1": {Samples: 5},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ControlPerf(context.TODO(), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreFields(ControlPerfResp{}, "Elapsed"),
+				cmpopts.IgnoreFields(PerfLatency{}, "Min", "Mean", "Max", "P99"),
+				cmp.Comparer(func(x, y *HostErrorSet) bool {
+					return x.HostSet.String() == y.HostSet.String()
+				}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
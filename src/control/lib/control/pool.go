@@ -163,6 +163,15 @@ func genPoolCreateRequest(in *PoolCreateReq) (out *mgmtpb.PoolCreateReq, err err
 		return nil, errors.New("can't create pool with size of 0")
 	}
 
+	if in.ACLTemplate != "" {
+		if !in.ACL.Empty() {
+			return nil, errors.New("can't mix ACL and ACL template")
+		}
+		if in.ACL, err = GetACLTemplate(in.ACLTemplate); err != nil {
+			return nil, err
+		}
+	}
+
 	out = new(mgmtpb.PoolCreateReq)
 	if err = convert.Types(in, out); err != nil {
 		return
@@ -230,6 +239,9 @@ type (
 		ACL        *AccessControlList
 		NumSvcReps uint32
 		Properties []*daos.PoolProperty `json:"-"`
+		// ACLTemplate names an ACL template to apply to the pool in
+		// place of an explicit ACL.
+		ACLTemplate string `json:"-"`
 		// auto-config params
 		TotalBytes uint64
 		TierRatio  []float64
@@ -237,6 +249,9 @@ type (
 		// manual params
 		Ranks     []ranklist.Rank
 		TierBytes []uint64
+		// Async requests that the create run in the background, with
+		// progress reported by PoolCreateStatus.
+		Async bool
 	}
 
 	// PoolCreateResp contains the response from a pool create request.
@@ -246,6 +261,7 @@ type (
 		SvcReps   []uint32 `json:"svc_reps"`
 		TgtRanks  []uint32 `json:"tgt_ranks"`
 		TierBytes []uint64 `json:"tier_bytes"`
+		JobID     string   `json:"job_id,omitempty"`
 	}
 )
 
@@ -282,6 +298,105 @@ func PoolCreate(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq)
 	return pcr, nil
 }
 
+type (
+	// PoolCreateStatusReq contains the parameters for a request to query
+	// or cancel an asynchronous pool create job.
+	PoolCreateStatusReq struct {
+		msRequest
+		unaryRequest
+		JobID  string
+		Cancel bool
+	}
+
+	// PoolCreateRankStatus describes the state of the pool shards on a
+	// rank selected to host a pool being created.
+	PoolCreateRankStatus struct {
+		Rank  uint32 `json:"rank"`
+		State string `json:"state"`
+	}
+
+	// PoolCreateStatusResp contains the progress of an asynchronous pool
+	// create job.
+	PoolCreateStatusResp struct {
+		JobID           string                  `json:"job_id"`
+		PoolUUID        string                  `json:"pool_uuid"`
+		State           string                  `json:"state"`
+		Stage           string                  `json:"stage"`
+		Ranks           []*PoolCreateRankStatus `json:"ranks"`
+		Error           string                  `json:"error,omitempty"`
+		CancelRequested bool                    `json:"cancel_requested"`
+		Result          *PoolCreateResp         `json:"result,omitempty"`
+	}
+)
+
+// Finished returns true if the pool create job is no longer running.
+func (r *PoolCreateStatusResp) Finished() bool {
+	return r.State != poolCreateJobStateStr(mgmtpb.PoolCreateStatusResp_RUNNING)
+}
+
+func poolCreateJobStateStr(state mgmtpb.PoolCreateStatusResp_State) string {
+	return strings.ToLower(state.String())
+}
+
+// PoolCreateStatus queries the progress of an asynchronous pool create job
+// started on the MS leader, optionally requesting that it be canceled.
+func PoolCreateStatus(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateStatusReq) (*PoolCreateStatusResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if req.JobID == "" {
+		return nil, errors.New("no job ID in request")
+	}
+
+	pbReq := &mgmtpb.PoolCreateStatusReq{
+		Sys:    req.getSystem(rpcClient),
+		JobId:  req.JobID,
+		Cancel: req.Cancel,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolCreateStatus(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS pool create status request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "pool create status failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.PoolCreateStatusResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	resp := &PoolCreateStatusResp{
+		JobID:           pbResp.GetJobId(),
+		PoolUUID:        pbResp.GetPoolUuid(),
+		State:           poolCreateJobStateStr(pbResp.GetState()),
+		Stage:           pbResp.GetStage(),
+		Error:           pbResp.GetError(),
+		CancelRequested: pbResp.GetCancelRequested(),
+	}
+	for _, r := range pbResp.GetRanks() {
+		resp.Ranks = append(resp.Ranks, &PoolCreateRankStatus{
+			Rank:  r.GetRank(),
+			State: r.GetState(),
+		})
+	}
+	if pbResp.GetResult() != nil {
+		resp.Result = new(PoolCreateResp)
+		if err := convert.Types(pbResp.GetResult(), resp.Result); err != nil {
+			return nil, err
+		}
+		resp.Result.UUID = resp.PoolUUID
+	}
+
+	return resp, nil
+}
+
 // PoolDestroyReq contains the parameters for a pool destroy request.
 type PoolDestroyReq struct {
 	poolRequest
@@ -343,7 +458,123 @@ func PoolUpgrade(ctx context.Context, rpcClient UnaryInvoker, req *PoolUpgradeRe
 		return err
 	}
 
-	return errors.Wrap(ur.getMSError(), "pool upgrade failed")
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return errors.Wrap(err, "pool upgrade failed")
+	}
+
+	pbResp, ok := msResp.(*mgmtpb.PoolUpgradeResp)
+	if !ok {
+		return errors.New("unable to extract PoolUpgradeResp from MS response")
+	}
+	if pbResp.Status != 0 {
+		return errors.Wrap(daos.Status(pbResp.Status), "pool upgrade failed")
+	}
+
+	return nil
+}
+
+// PoolSetAdminsReq contains the parameters for a request to set the principals
+// delegated the administration of a pool.
+type PoolSetAdminsReq struct {
+	poolRequest
+	ID     string
+	Admins []string
+}
+
+// PoolSetAdminsResp contains the resultant set of pool administrators.
+type PoolSetAdminsResp struct {
+	Status int32    `json:"status"`
+	Admins []string `json:"admins"`
+}
+
+// PoolSetAdmins replaces the set of principals that may administer a pool
+// using their own administrative certificates. An empty set removes all
+// delegated administrators.
+func PoolSetAdmins(ctx context.Context, rpcClient UnaryInvoker, req *PoolSetAdminsReq) (*PoolSetAdminsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+
+	pbReq := &mgmtpb.PoolSetAdminsReq{
+		Sys:    req.getSystem(rpcClient),
+		Id:     req.ID,
+		Admins: req.Admins,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolSetAdmins(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Set DAOS pool admins request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolSetAdminsResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "pool set-admins failed")
+	}
+	if resp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(resp.Status), "pool set-admins failed")
+	}
+
+	return resp, nil
+}
+
+// PoolAnnotateReq contains the parameters for a request to set or remove
+// annotations on a pool.
+type PoolAnnotateReq struct {
+	poolRequest
+	ID          string
+	Annotations map[string]string // empty values remove annotations
+}
+
+// PoolAnnotateResp contains the resultant set of pool annotations.
+type PoolAnnotateResp struct {
+	Status      int32             `json:"status"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// PoolAnnotate sets or removes annotations on a pool. Annotations are notes
+// recorded by administrators that are reported when listing pools.
+func PoolAnnotate(ctx context.Context, rpcClient UnaryInvoker, req *PoolAnnotateReq) (*PoolAnnotateResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if len(req.Annotations) == 0 {
+		return nil, errors.New("no annotations specified")
+	}
+	for k, v := range req.Annotations {
+		if err := system.ValidateAnnotation(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	pbReq := &mgmtpb.PoolAnnotateReq{
+		Sys:         req.getSystem(rpcClient),
+		Id:          req.ID,
+		Annotations: req.Annotations,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolAnnotate(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Annotate DAOS pool request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolAnnotateResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "pool annotate failed")
+	}
+	if resp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(resp.Status), "pool annotate failed")
+	}
+
+	return resp, nil
 }
 
 // PoolEvictReq contains the parameters for a pool evict request.
@@ -373,6 +604,65 @@ func PoolEvict(ctx context.Context, rpcClient UnaryInvoker, req *PoolEvictReq) e
 	return errors.Wrap(ur.getMSError(), "pool evict failed")
 }
 
+type (
+	// PoolCleanupPartialReq contains the parameters for a partial pool
+	// cleanup request.
+	PoolCleanupPartialReq struct {
+		msRequest
+		unaryRequest
+		DryRun bool // Only report the pools that would be cleaned up
+	}
+
+	// PoolCleanupResult describes a pool left incomplete by a failed create
+	// or destroy and the result of cleaning it up.
+	PoolCleanupResult struct {
+		UUID    string `json:"uuid"`
+		Label   string `json:"label"`
+		State   string `json:"state"`
+		Removed bool   `json:"removed"`
+		Status  int32  `json:"status"`
+		Msg     string `json:"msg"`
+	}
+
+	// PoolCleanupPartialResp contains the results of a partial pool cleanup.
+	PoolCleanupPartialResp struct {
+		Status int32                `json:"status"`
+		Pools  []*PoolCleanupResult `json:"pools"`
+	}
+)
+
+// PoolCleanupPartial destroys any pools left in an incomplete state by a
+// failed create or destroy, reclaiming the storage used by their shards.
+func PoolCleanupPartial(ctx context.Context, rpcClient UnaryInvoker, req *PoolCleanupPartialReq) (*PoolCleanupPartialResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &mgmtpb.PoolCleanupPartialReq{
+		Sys:    req.getSystem(rpcClient),
+		DryRun: req.DryRun,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolCleanupPartial(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Clean up partial DAOS pools request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolCleanupPartialResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "partial pool cleanup failed")
+	}
+	if resp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(resp.Status), "partial pool cleanup failed")
+	}
+
+	return resp, nil
+}
+
 type (
 	// PoolQueryReq contains the parameters for a pool query request.
 	PoolQueryReq struct {
@@ -397,10 +687,16 @@ type (
 
 	// PoolRebuildStatus contains detailed information about the pool rebuild process.
 	PoolRebuildStatus struct {
-		Status  int32            `json:"status"`
-		State   PoolRebuildState `json:"state"`
-		Objects uint64           `json:"objects"`
-		Records uint64           `json:"records"`
+		Status       int32            `json:"status"`
+		State        PoolRebuildState `json:"state"`
+		Objects      uint64           `json:"objects"`
+		Records      uint64           `json:"records"`
+		TotalObjects uint64           `json:"total_objects"`
+		Size         uint64           `json:"size"`
+		Version      uint32           `json:"version"`
+		Seconds      uint32           `json:"seconds"`
+		FailRank     int32            `json:"fail_rank"`
+		Error        string           `json:"error,omitempty"`
 	}
 
 	// PoolInfo contains information about the pool.
@@ -411,6 +707,7 @@ type (
 		DisabledTargets  uint32               `json:"disabled_targets"`
 		Version          uint32               `json:"version"`
 		Leader           uint32               `json:"leader"`
+		ServiceReplicas  []ranklist.Rank      `json:"svc_reps"`
 		Rebuild          *PoolRebuildStatus   `json:"rebuild"`
 		TierStats        []*StorageUsageStats `json:"tier_stats"`
 		EnabledRanks     *ranklist.RankSet    `json:"-"`
@@ -600,7 +897,62 @@ func PoolQuery(ctx context.Context, rpcClient UnaryInvoker, req *PoolQueryReq) (
 	}
 
 	pqr := new(PoolQueryResp)
-	return pqr, convertMSResponse(ur, pqr)
+	if err := convertMSResponse(ur, pqr); err != nil {
+		return nil, err
+	}
+
+	if pqr.Rebuild != nil && pqr.Rebuild.Status != 0 {
+		pqr.Rebuild.Error = daos.Status(pqr.Rebuild.Status).Error()
+	}
+
+	return pqr, nil
+}
+
+type (
+	// PoolProbeReq contains the parameters for a pool service health probe.
+	PoolProbeReq struct {
+		poolRequest
+		ID string
+	}
+
+	// PoolReplicaStatus describes the state of a single pool service replica.
+	PoolReplicaStatus struct {
+		Rank        ranklist.Rank `json:"rank"`
+		State       string        `json:"state"`
+		EngineState string        `json:"engine_state"`
+	}
+
+	// PoolProbeResp contains the health of a pool service and its replicas.
+	PoolProbeResp struct {
+		Status     int32                `json:"status"`
+		UUID       string               `json:"uuid"`
+		Health     string               `json:"health"`
+		Leader     uint32               `json:"leader"`
+		MapVersion uint32               `json:"map_version"`
+		Replicas   []*PoolReplicaStatus `json:"replicas"`
+		Error      string               `json:"error,omitempty"`
+	}
+)
+
+// PoolProbe checks that the service for the specified pool ID is reachable
+// and reports the status of each of its replicas.
+func PoolProbe(ctx context.Context, rpcClient UnaryInvoker, req *PoolProbeReq) (*PoolProbeResp, error) {
+	pbReq := &mgmtpb.PoolProbeReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.ID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolProbe(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Probe DAOS pool request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ppr := new(PoolProbeResp)
+	return ppr, convertMSResponse(ur, ppr)
 }
 
 // PoolQueryTargets performs a pool query targets operation on a DAOS Management Server instance,
@@ -730,6 +1082,185 @@ func convertPoolTargetInfo(pbInfo *mgmtpb.PoolQueryTargetInfo) (*PoolQueryTarget
 	return pqti, nil
 }
 
+// PoolAggregationHint indicates whether aggregation should run on a pool's
+// targets.
+type PoolAggregationHint int32
+
+const (
+	// PoolAggregationUnchanged leaves the aggregation hint unchanged.
+	PoolAggregationUnchanged PoolAggregationHint = iota
+	// PoolAggregationEnabled runs aggregation as configured by the
+	// pool's reclaim property.
+	PoolAggregationEnabled
+	// PoolAggregationDisabled pauses aggregation.
+	PoolAggregationDisabled
+)
+
+func (pah PoolAggregationHint) String() string {
+	pahs, ok := mgmtpb.PoolSetPolicyReq_Aggregation_name[int32(pah)]
+	if !ok {
+		return "unknown"
+	}
+	return strings.ToLower(pahs)
+}
+
+// PoolSetPolicyReq contains the parameters for a pool set-policy request.
+type PoolSetPolicyReq struct {
+	poolRequest
+	// ID identifies the pool for which the policy should be set.
+	ID string
+	// Ranks limits the policy to targets on these ranks (all if empty).
+	Ranks []ranklist.Rank
+	// Aggregation pauses or resumes aggregation.
+	Aggregation PoolAggregationHint
+	// RebuildThrottle is the percentage of engine time available to
+	// rebuild (1-100, 0 leaves it unchanged).
+	RebuildThrottle uint32
+	// ScrubRate is the percentage of the configured scrubbing rate to use
+	// (1-100, 0 leaves it unchanged).
+	ScrubRate uint32
+	// Clear reverts any hints previously set before applying new ones.
+	Clear bool
+}
+
+func (req *PoolSetPolicyReq) validate() error {
+	if req.RebuildThrottle > 100 {
+		return errors.Errorf("invalid rebuild throttle %d%%: must be 1-100", req.RebuildThrottle)
+	}
+	if req.ScrubRate > 100 {
+		return errors.Errorf("invalid scrub rate %d%%: must be 1-100", req.ScrubRate)
+	}
+	if _, ok := mgmtpb.PoolSetPolicyReq_Aggregation_name[int32(req.Aggregation)]; !ok {
+		return errors.Errorf("invalid aggregation hint %d", req.Aggregation)
+	}
+	if !req.Clear && req.Aggregation == PoolAggregationUnchanged &&
+		req.RebuildThrottle == 0 && req.ScrubRate == 0 {
+		return errors.New("no policy changes requested")
+	}
+
+	return nil
+}
+
+// PoolSetPolicy sets runtime hints for the scheduling of background tasks
+// (aggregation, rebuild and scrubbing) on the targets of a pool. The hints are
+// not persisted and are lost when an engine restarts.
+func PoolSetPolicy(ctx context.Context, rpcClient UnaryInvoker, req *PoolSetPolicyReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T in PoolSetPolicy()", req)
+	}
+	if err := req.validate(); err != nil {
+		return err
+	}
+
+	pbReq := &mgmtpb.PoolSetPolicyReq{
+		Sys:             req.getSystem(rpcClient),
+		Id:              req.ID,
+		Ranks:           ranklist.RanksToUint32(req.Ranks),
+		Aggregation:     mgmtpb.PoolSetPolicyReq_Aggregation(req.Aggregation),
+		RebuildThrottle: req.RebuildThrottle,
+		ScrubRate:       req.ScrubRate,
+		Clear:           req.Clear,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolSetPolicy(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS pool set-policy request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return errors.Wrap(err, "pool set-policy failed")
+	}
+
+	pbResp, ok := msResp.(*mgmtpb.PoolSetPolicyResp)
+	if !ok {
+		return errors.New("unable to extract PoolSetPolicyResp from MS response")
+	}
+	if pbResp.Status != 0 {
+		return errors.Wrap(daos.Status(pbResp.Status), "pool set-policy failed")
+	}
+
+	return nil
+}
+
+type (
+	// PoolQueryAggregationReq contains the parameters for a pool aggregation
+	// status query.
+	PoolQueryAggregationReq struct {
+		poolRequest
+		ID string
+	}
+
+	// PoolRankAggregation describes the state of aggregation on a pool's
+	// targets on a single engine rank.
+	PoolRankAggregation struct {
+		Rank  ranklist.Rank `json:"rank"`
+		State string        `json:"state"`
+		// Lag is the number of seconds by which the oldest aggregated
+		// epoch of the pool's containers trails the current time.
+		Lag uint64 `json:"lag"`
+		// LastRun is the completion time of the last aggregation pass in
+		// seconds since the epoch, or zero if aggregation has not run.
+		LastRun uint64 `json:"last_run"`
+	}
+
+	// PoolQueryAggregationResp contains the aggregation status of a pool's
+	// targets on each engine rank.
+	PoolQueryAggregationResp struct {
+		Status int32                  `json:"status"`
+		UUID   string                 `json:"uuid"`
+		Ranks  []*PoolRankAggregation `json:"ranks"`
+	}
+)
+
+// Lag returns the largest aggregation lag in seconds across all of the pool's
+// ranks.
+func (resp *PoolQueryAggregationResp) Lag() (max uint64) {
+	for _, r := range resp.Ranks {
+		if r.Lag > max {
+			max = r.Lag
+		}
+	}
+	return
+}
+
+// PoolQueryAggregation reports the status of background aggregation on the
+// targets of a pool, which can be used to determine whether space is not being
+// reclaimed because aggregation is falling behind.
+func PoolQueryAggregation(ctx context.Context, rpcClient UnaryInvoker, req *PoolQueryAggregationReq) (*PoolQueryAggregationResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T in PoolQueryAggregation()", req)
+	}
+
+	pbReq := &mgmtpb.PoolQueryAggregationReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.ID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolQueryAggregation(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Query DAOS pool aggregation request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolQueryAggregationResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(resp.Status), "pool aggregation query failed")
+	}
+
+	return resp, nil
+}
+
 // PoolSetPropReq contains pool set-prop parameters.
 type PoolSetPropReq struct {
 	poolRequest
@@ -854,6 +1385,63 @@ func PoolGetProp(ctx context.Context, rpcClient UnaryInvoker, req *PoolGetPropRe
 	return resp, nil
 }
 
+// PoolPropChange describes the effect of a set-prop operation on a single
+// pool property.
+type PoolPropChange struct {
+	Name     string `json:"name"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// Changed returns true if the property value was modified.
+func (ppc *PoolPropChange) Changed() bool {
+	return ppc.OldValue != ppc.NewValue
+}
+
+// PoolSetPropDiff retrieves the current values of the properties in the
+// request, sets the requested values and returns the before and after value
+// of each property. All properties are set in a single request so either all
+// or none of them are applied to the pool.
+func PoolSetPropDiff(ctx context.Context, rpcClient UnaryInvoker, req *PoolSetPropReq) ([]*PoolPropChange, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T in PoolSetPropDiff()", req)
+	}
+	if len(req.Properties) == 0 {
+		return nil, errors.New("empty properties list in PoolSetPropDiff()")
+	}
+
+	propHdlrs := daos.PoolProperties()
+	getReq := &PoolGetPropReq{ID: req.ID}
+	getReq.SetSystem(req.Sys)
+	for _, prop := range req.Properties {
+		cur, err := propHdlrs.GetProperty(prop.Name)
+		if err != nil {
+			return nil, err
+		}
+		getReq.Properties = append(getReq.Properties, cur)
+	}
+
+	curProps, err := PoolGetProp(ctx, rpcClient, getReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching current property values")
+	}
+
+	if err := PoolSetProp(ctx, rpcClient, req); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*PoolPropChange, 0, len(req.Properties))
+	for i, prop := range req.Properties {
+		changes = append(changes, &PoolPropChange{
+			Name:     prop.Name,
+			OldValue: curProps[i].StringValue(),
+			NewValue: prop.StringValue(),
+		})
+	}
+
+	return changes, nil
+}
+
 // PoolExcludeReq struct contains request
 type PoolExcludeReq struct {
 	poolRequest
@@ -1021,6 +1609,11 @@ type (
 		ServiceReplicas []ranklist.Rank `json:"svc_reps"`
 		// State is the current state of the pool.
 		State string `json:"state"`
+		// Admins is the list of principals delegated administration
+		// of the pool.
+		Admins []string `json:"admins,omitempty"`
+		// Annotations holds notes recorded on the pool by administrators.
+		Annotations map[string]string `json:"annotations,omitempty"`
 
 		// TargetsTotal is the total number of targets in pool.
 		TargetsTotal uint32 `json:"targets_total"`
@@ -1040,6 +1633,16 @@ type (
 
 		// Usage contains pool usage statistics for each storage tier.
 		Usage []*PoolTierUsage `json:"usage"`
+
+		// Health is the health of the pool service as reported by a
+		// pool service probe.
+		Health string `json:"health,omitempty"`
+		// HealthErrorMsg reports why the pool service could not be
+		// reached by a probe.
+		HealthErrorMsg string `json:"health_error_msg,omitempty"`
+		// ReplicaStatus contains the status of each pool service
+		// replica as reported by a pool service probe.
+		ReplicaStatus []*PoolReplicaStatus `json:"replica_status,omitempty"`
 	}
 )
 
@@ -1064,6 +1667,19 @@ func (p *Pool) setUsage(pqr *PoolQueryResp) {
 	}
 }
 
+func (p *Pool) setHealth(ppr *PoolProbeResp) {
+	p.Health = ppr.Health
+	p.HealthErrorMsg = ppr.Error
+	p.ReplicaStatus = ppr.Replicas
+	p.ServiceLeader = ppr.Leader
+}
+
+// IsHealthy indicates whether a probe found the pool service to be healthy.
+// Pools that have not been probed are considered healthy.
+func (p *Pool) IsHealthy() bool {
+	return p.Health == "" || p.Health == system.PoolServiceHealthHealthy
+}
+
 // HasErrors indicates whether a pool query operation failed on this pool.
 func (p *Pool) HasErrors() bool {
 	return p.QueryErrorMsg != "" || p.QueryStatusMsg != ""
@@ -1083,14 +1699,19 @@ func (p *Pool) GetName() string {
 type ListPoolsReq struct {
 	unaryRequest
 	msRequest
-	NoQuery bool
+	NoQuery   bool
+	Health    bool
+	PageSize  uint32   // Maximum number of pools to return (0 for all).
+	PageToken string   // NextPageToken from a previous response.
+	Fields    []string // Pool fields to return (empty for all).
 }
 
 // ListPoolsResp contains the status of the request and, if successful, the list
 // of pools in the system.
 type ListPoolsResp struct {
-	Status int32   `json:"status"`
-	Pools  []*Pool `json:"pools"`
+	Status        int32   `json:"status"`
+	Pools         []*Pool `json:"pools"`
+	NextPageToken string  `json:"next_page_token,omitempty"`
 }
 
 // Validate returns error if response contents are unexpected, string of
@@ -1141,7 +1762,18 @@ func (lpr *ListPoolsResp) Errors() error {
 // ListPools fetches the list of all pools and their service replicas from the
 // system.
 func ListPools(ctx context.Context, rpcClient UnaryInvoker, req *ListPoolsReq) (*ListPoolsResp, error) {
-	pbReq := &mgmtpb.ListPoolsReq{Sys: req.getSystem(rpcClient)}
+	fields := req.Fields
+	if len(fields) > 0 && (req.Health || !req.NoQuery) {
+		// Pools are only probed or queried when in the Ready state.
+		fields = append(fields[:len(fields):len(fields)], "state")
+	}
+
+	pbReq := &mgmtpb.ListPoolsReq{
+		Sys:       req.getSystem(rpcClient),
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+		Fields:    fields,
+	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).ListPools(ctx, pbReq)
 	})
@@ -1157,6 +1789,24 @@ func ListPools(ctx context.Context, rpcClient UnaryInvoker, req *ListPoolsReq) (
 		return nil, err
 	}
 
+	if req.Health {
+		// issue probe request and populate service health for each pool
+		for _, p := range resp.Pools {
+			if p.State != system.PoolServiceStateReady.String() {
+				rpcClient.Debugf("Skipping probe of pool in state: %s", p.State)
+				continue
+			}
+
+			ppr, err := PoolProbe(ctx, rpcClient, &PoolProbeReq{ID: p.UUID})
+			if err != nil {
+				p.Health = system.PoolServiceHealthUnavailable
+				p.HealthErrorMsg = err.Error()
+				continue
+			}
+			p.setHealth(ppr)
+		}
+	}
+
 	if req.NoQuery {
 		return resp, nil
 	}
@@ -71,6 +71,9 @@ func PoolOverwriteACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolOver
 	if req.ACL.Empty() {
 		return nil, errors.New("empty ACL on modify")
 	}
+	if err := req.ACL.Validate(); err != nil {
+		return nil, err
+	}
 
 	pbReq := &mgmtpb.ModifyACLReq{
 		Sys: req.getSystem(rpcClient),
@@ -114,6 +117,9 @@ func PoolUpdateACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolUpdateA
 	if req.ACL.Empty() {
 		return nil, errors.New("empty ACL on modify")
 	}
+	if err := req.ACL.Validate(); err != nil {
+		return nil, err
+	}
 
 	pbReq := &mgmtpb.ModifyACLReq{
 		Sys: req.getSystem(rpcClient),
@@ -156,6 +162,9 @@ func PoolDeleteACL(ctx context.Context, rpcClient UnaryInvoker, req *PoolDeleteA
 	if req.Principal == "" {
 		return nil, errors.New("no principal provided")
 	}
+	if err := ValidateACLPrincipal(req.Principal); err != nil {
+		return nil, err
+	}
 
 	pbReq := &mgmtpb.DeleteACLReq{
 		Sys:       req.getSystem(rpcClient),
@@ -126,6 +126,15 @@ func TestControl_PoolOverwriteACL(t *testing.T) {
 			},
 			expErr: errors.New("empty ACL"),
 		},
+		"invalid ACE": {
+			req: &PoolOverwriteACLReq{
+				ID: test.MockUUID(),
+				ACL: &AccessControlList{
+					Entries: []string{"A::OWNER@:rw", "A::bad:rw"},
+				},
+			},
+			expErr: errors.New("invalid ACE \"A::bad:rw\""),
+		},
 		"success": {
 			mic: &MockInvokerConfig{
 				UnaryResponse: MockMSResponse("", nil, &mgmtpb.ACLResp{
@@ -199,6 +208,15 @@ func TestControl_PoolUpdateACL(t *testing.T) {
 			},
 			expErr: errors.New("empty ACL"),
 		},
+		"invalid ACE": {
+			req: &PoolUpdateACLReq{
+				ID: test.MockUUID(),
+				ACL: &AccessControlList{
+					Entries: []string{"A::OWNER@:rw", "A::bad:rw"},
+				},
+			},
+			expErr: errors.New("invalid ACE \"A::bad:rw\""),
+		},
 		"success": {
 			mic: &MockInvokerConfig{
 				UnaryResponse: MockMSResponse("", nil, &mgmtpb.ACLResp{
@@ -240,7 +258,7 @@ func TestControl_PoolUpdateACL(t *testing.T) {
 }
 
 func TestControl_PoolDeleteACL(t *testing.T) {
-	testPrincipal := "Skinner@"
+	testPrincipal := "u:Skinner@"
 
 	for name, tc := range map[string]struct {
 		mic     *MockInvokerConfig
@@ -274,6 +292,13 @@ func TestControl_PoolDeleteACL(t *testing.T) {
 			},
 			expErr: errors.New("no principal provided"),
 		},
+		"invalid principal": {
+			req: &PoolDeleteACLReq{
+				ID:        test.MockUUID(),
+				Principal: "Skinner@",
+			},
+			expErr: errors.New("invalid principal"),
+		},
 		"success": {
 			mic: &MockInvokerConfig{
 				UnaryResponse: MockMSResponse("", nil, &mgmtpb.ACLResp{
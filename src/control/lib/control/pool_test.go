@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -121,6 +122,29 @@ func TestControl_PoolDestroy(t *testing.T) {
 				},
 			},
 		},
+		"PoolLocked error is retried": {
+			req: &PoolDestroyReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", &fault.Fault{Code: code.SystemPoolLocked}, nil),
+					MockMSResponse("host1", nil, &mgmtpb.PoolDestroyResp{}),
+				},
+			},
+		},
+		"operation in progress error is not retried": {
+			req: &PoolDestroyReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", system.FaultPoolOpInProgress(test.MockPoolUUID(), test.MockPoolUUID(2), "PoolExtend", time.Time{}), nil),
+					MockMSResponse("host1", nil, &mgmtpb.PoolDestroyResp{}),
+				},
+			},
+			expErr: errors.New("PoolExtend operation in progress"),
+		},
 		"success": {
 			req: &PoolDestroyReq{
 				ID: test.MockUUID(),
@@ -177,6 +201,17 @@ func TestControl_PoolUpgrade(t *testing.T) {
 			},
 			expErr: errors.New("remote failed"),
 		},
+		"upgrade failure": {
+			req: &PoolUpgradeReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolUpgradeResp{Status: int32(daos.Busy)},
+				),
+			},
+			expErr: daos.Busy,
+		},
 		"-DER_GRPVER is retried": {
 			req: &PoolUpgradeReq{
 				ID: test.MockUUID(),
@@ -231,6 +266,200 @@ func TestControl_PoolUpgrade(t *testing.T) {
 	}
 }
 
+func TestControl_PoolSetPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		req    *PoolSetPolicyReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"no changes": {
+			req: &PoolSetPolicyReq{
+				ID: test.MockUUID(),
+			},
+			expErr: errors.New("no policy changes"),
+		},
+		"invalid rebuild throttle": {
+			req: &PoolSetPolicyReq{
+				ID:              test.MockUUID(),
+				RebuildThrottle: 101,
+			},
+			expErr: errors.New("invalid rebuild throttle"),
+		},
+		"invalid scrub rate": {
+			req: &PoolSetPolicyReq{
+				ID:        test.MockUUID(),
+				ScrubRate: 101,
+			},
+			expErr: errors.New("invalid scrub rate"),
+		},
+		"invalid aggregation hint": {
+			req: &PoolSetPolicyReq{
+				ID:          test.MockUUID(),
+				Aggregation: 42,
+			},
+			expErr: errors.New("invalid aggregation hint"),
+		},
+		"local failure": {
+			req: &PoolSetPolicyReq{
+				ID:    test.MockUUID(),
+				Clear: true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolSetPolicyReq{
+				ID:    test.MockUUID(),
+				Clear: true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"set-policy failure": {
+			req: &PoolSetPolicyReq{
+				ID:          test.MockUUID(),
+				Aggregation: PoolAggregationDisabled,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolSetPolicyResp{Status: int32(daos.Nonexistent)},
+				),
+			},
+			expErr: daos.Nonexistent,
+		},
+		"success": {
+			req: &PoolSetPolicyReq{
+				ID:              test.MockUUID(),
+				Ranks:           []ranklist.Rank{0, 1},
+				Aggregation:     PoolAggregationDisabled,
+				RebuildThrottle: 25,
+				ScrubRate:       10,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolSetPolicyResp{},
+				),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := context.TODO()
+			mi := NewMockInvoker(log, mic)
+
+			gotErr := PoolSetPolicy(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_PoolQueryAggregation(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolQueryAggregationReq
+		expResp *PoolQueryAggregationResp
+		expLag  uint64
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"local failure": {
+			req: &PoolQueryAggregationReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolQueryAggregationReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"query failure": {
+			req: &PoolQueryAggregationReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolQueryAggregationResp{Status: int32(daos.Nonexistent)},
+				),
+			},
+			expErr: daos.Nonexistent,
+		},
+		"success": {
+			req: &PoolQueryAggregationReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolQueryAggregationResp{
+						Uuid: test.MockUUID(),
+						Ranks: []*mgmtpb.PoolQueryAggregationResp_Rank{
+							{Rank: 0, State: "running", Lag: 45, LastRun: 1685613600},
+							{Rank: 1, State: "idle", Lag: 12},
+							{Rank: 2, State: "paused", Lag: 3600},
+						},
+					},
+				),
+			},
+			expResp: &PoolQueryAggregationResp{
+				UUID: test.MockUUID(),
+				Ranks: []*PoolRankAggregation{
+					{Rank: 0, State: "running", Lag: 45, LastRun: 1685613600},
+					{Rank: 1, State: "idle", Lag: 12},
+					{Rank: 2, State: "paused", Lag: 3600},
+				},
+			},
+			expLag: 3600,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := context.TODO()
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolQueryAggregation(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expLag, gotResp.Lag(), "unexpected lag")
+		})
+	}
+}
+
 func TestControl_PoolDrain(t *testing.T) {
 	for name, tc := range map[string]struct {
 		mic    *MockInvokerConfig
@@ -281,51 +510,431 @@ func TestControl_PoolDrain(t *testing.T) {
 				mic = DefaultMockInvokerConfig()
 			}
 
-			ctx := context.TODO()
+			ctx := context.TODO()
+			mi := NewMockInvoker(log, mic)
+
+			gotErr := PoolDrain(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+		})
+	}
+}
+
+func TestControl_PoolSetAdmins(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolSetAdminsReq
+		expResp *PoolSetAdminsResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"local failure": {
+			req: &PoolSetAdminsReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolSetAdminsReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"set failure": {
+			req: &PoolSetAdminsReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolSetAdminsResp{Status: int32(daos.Busy)},
+				),
+			},
+			expErr: daos.Busy,
+		},
+		"success": {
+			req: &PoolSetAdminsReq{
+				ID:     test.MockUUID(),
+				Admins: []string{"alice", "bob"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolSetAdminsResp{Admins: []string{"alice", "bob"}},
+				),
+			},
+			expResp: &PoolSetAdminsResp{
+				Admins: []string{"alice", "bob"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := context.TODO()
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolSetAdmins(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolAnnotate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolAnnotateReq
+		expResp *PoolAnnotateResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"no annotations": {
+			req: &PoolAnnotateReq{
+				ID: test.MockUUID(),
+			},
+			expErr: errors.New("no annotations"),
+		},
+		"invalid annotation": {
+			req: &PoolAnnotateReq{
+				ID:          test.MockUUID(),
+				Annotations: map[string]string{"bad key": "x"},
+			},
+			expErr: errors.New("invalid character"),
+		},
+		"local failure": {
+			req: &PoolAnnotateReq{
+				ID:          test.MockUUID(),
+				Annotations: map[string]string{"owner": "alice"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"annotate failure": {
+			req: &PoolAnnotateReq{
+				ID:          test.MockUUID(),
+				Annotations: map[string]string{"owner": "alice"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolAnnotateResp{Status: int32(daos.Busy)},
+				),
+			},
+			expErr: daos.Busy,
+		},
+		"success": {
+			req: &PoolAnnotateReq{
+				ID:          test.MockUUID(),
+				Annotations: map[string]string{"owner": "alice", "ticket": ""},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolAnnotateResp{Annotations: map[string]string{"owner": "alice"}},
+				),
+			},
+			expResp: &PoolAnnotateResp{
+				Annotations: map[string]string{"owner": "alice"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := context.TODO()
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolAnnotate(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolEvict(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		req    *PoolEvictReq
+		expErr error
+	}{
+		"local failure": {
+			req: &PoolEvictReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolEvictReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &PoolEvictReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolEvictResp{},
+				),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := context.TODO()
+			mi := NewMockInvoker(log, mic)
+
+			gotErr := PoolEvict(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+		})
+	}
+}
+
+func TestControl_PoolCleanupPartial(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolCleanupPartialReq
+		expResp *PoolCleanupPartialResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"local failure": {
+			req: &PoolCleanupPartialReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolCleanupPartialReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"failed status": {
+			req: &PoolCleanupPartialReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolCleanupPartialResp{Status: int32(daos.Busy)},
+				),
+			},
+			expErr: daos.Busy,
+		},
+		"no partial pools": {
+			req: &PoolCleanupPartialReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolCleanupPartialResp{},
+				),
+			},
+			expResp: &PoolCleanupPartialResp{},
+		},
+		"success": {
+			req: &PoolCleanupPartialReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolCleanupPartialResp{
+						Pools: []*mgmtpb.PoolCleanupPartialResp_Pool{
+							{
+								Uuid:    test.MockUUID(1),
+								Label:   "pool1",
+								State:   "Destroying",
+								Removed: true,
+							},
+							{
+								Uuid:   test.MockUUID(2),
+								Label:  "pool2",
+								State:  "Creating",
+								Status: int32(daos.Busy),
+								Msg:    "pool operation in progress",
+							},
+						},
+					},
+				),
+			},
+			expResp: &PoolCleanupPartialResp{
+				Pools: []*PoolCleanupResult{
+					{
+						UUID:    test.MockUUID(1),
+						Label:   "pool1",
+						State:   "Destroying",
+						Removed: true,
+					},
+					{
+						UUID:   test.MockUUID(2),
+						Label:  "pool2",
+						State:  "Creating",
+						Status: int32(daos.Busy),
+						Msg:    "pool operation in progress",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
 			mi := NewMockInvoker(log, mic)
 
-			gotErr := PoolDrain(ctx, mi, tc.req)
+			gotResp, gotErr := PoolCleanupPartial(context.TODO(), mi, tc.req)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
 			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
 		})
 	}
 }
 
-func TestControl_PoolEvict(t *testing.T) {
+func TestControl_PoolCreateStatus(t *testing.T) {
 	for name, tc := range map[string]struct {
-		mic    *MockInvokerConfig
-		req    *PoolEvictReq
-		expErr error
+		mic     *MockInvokerConfig
+		req     *PoolCreateStatusReq
+		expResp *PoolCreateStatusResp
+		expErr  error
 	}{
-		"local failure": {
-			req: &PoolEvictReq{
-				ID: test.MockUUID(),
-			},
-			mic: &MockInvokerConfig{
-				UnaryError: errors.New("local failed"),
-			},
-			expErr: errors.New("local failed"),
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"missing job ID": {
+			req:    &PoolCreateStatusReq{},
+			expErr: errors.New("no job ID"),
 		},
 		"remote failure": {
-			req: &PoolEvictReq{
-				ID: test.MockUUID(),
-			},
+			req: &PoolCreateStatusReq{JobID: test.MockUUID(9)},
 			mic: &MockInvokerConfig{
 				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
 			},
 			expErr: errors.New("remote failed"),
 		},
-		"success": {
-			req: &PoolEvictReq{
-				ID: test.MockUUID(),
+		"running": {
+			req: &PoolCreateStatusReq{JobID: test.MockUUID(9)},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolCreateStatusResp{
+						State:    mgmtpb.PoolCreateStatusResp_RUNNING,
+						JobId:    test.MockUUID(9),
+						PoolUuid: test.MockUUID(1),
+						Stage:    "creating pool shards on 2 ranks",
+						Ranks: []*mgmtpb.PoolCreateStatusResp_Rank{
+							{Rank: 0, State: "creating"},
+							{Rank: 1, State: "creating"},
+						},
+						CancelRequested: true,
+					},
+				),
+			},
+			expResp: &PoolCreateStatusResp{
+				JobID:    test.MockUUID(9),
+				PoolUUID: test.MockUUID(1),
+				State:    "running",
+				Stage:    "creating pool shards on 2 ranks",
+				Ranks: []*PoolCreateRankStatus{
+					{Rank: 0, State: "creating"},
+					{Rank: 1, State: "creating"},
+				},
+				CancelRequested: true,
 			},
+		},
+		"completed": {
+			req: &PoolCreateStatusReq{JobID: test.MockUUID(9)},
 			mic: &MockInvokerConfig{
 				UnaryResponse: MockMSResponse("host1", nil,
-					&mgmtpb.PoolEvictResp{},
+					&mgmtpb.PoolCreateStatusResp{
+						State:    mgmtpb.PoolCreateStatusResp_COMPLETED,
+						JobId:    test.MockUUID(9),
+						PoolUuid: test.MockUUID(1),
+						Stage:    "done",
+						Ranks: []*mgmtpb.PoolCreateStatusResp_Rank{
+							{Rank: 0, State: "created"},
+						},
+						Result: &mgmtpb.PoolCreateResp{
+							SvcReps:   []uint32{0},
+							TgtRanks:  []uint32{0},
+							TierBytes: []uint64{1, 2},
+						},
+					},
 				),
 			},
+			expResp: &PoolCreateStatusResp{
+				JobID:    test.MockUUID(9),
+				PoolUUID: test.MockUUID(1),
+				State:    "completed",
+				Stage:    "done",
+				Ranks: []*PoolCreateRankStatus{
+					{Rank: 0, State: "created"},
+				},
+				Result: &PoolCreateResp{
+					UUID:      test.MockUUID(1),
+					SvcReps:   []uint32{0},
+					TgtRanks:  []uint32{0},
+					TierBytes: []uint64{1, 2},
+				},
+			},
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -337,14 +946,61 @@ func TestControl_PoolEvict(t *testing.T) {
 				mic = DefaultMockInvokerConfig()
 			}
 
-			ctx := context.TODO()
 			mi := NewMockInvoker(log, mic)
 
-			gotErr := PoolEvict(ctx, mi, tc.req)
+			gotResp, gotErr := PoolCreateStatus(context.TODO(), mi, tc.req)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
 			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expResp.State != "running", gotResp.Finished(), "unexpected finished state")
+		})
+	}
+}
+
+func TestControl_genPoolCreateRequest_ACLTemplate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *PoolCreateReq
+		expACL []string
+	}{
+		"no template": {
+			req: &PoolCreateReq{
+				TotalBytes: 10,
+				ACL:        &AccessControlList{Entries: []string{"A::OWNER@:r"}},
+			},
+			expACL: []string{"A::OWNER@:r"},
+		},
+		"private template": {
+			req: &PoolCreateReq{
+				TotalBytes:  10,
+				ACLTemplate: "private",
+			},
+			expACL: []string{"A::OWNER@:rw"},
+		},
+		"group template": {
+			req: &PoolCreateReq{
+				TotalBytes:  10,
+				ACLTemplate: "group",
+			},
+			expACL: []string{"A::OWNER@:rw", "A:G:GROUP@:rw"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.req.User = "bob@"
+			tc.req.UserGroup = "builders@"
+
+			gotReq, err := genPoolCreateRequest(tc.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expACL, gotReq.Acl); diff != "" {
+				t.Fatalf("unexpected pool ACL (-want, +got):\n%s\n", diff)
+			}
 		})
 	}
 }
@@ -466,6 +1122,21 @@ func TestControl_PoolCreate(t *testing.T) {
 				TgtRanks: []uint32{0, 1, 2},
 			},
 		},
+		"unknown ACL template": {
+			req: &PoolCreateReq{
+				TotalBytes:  10,
+				ACLTemplate: "public",
+			},
+			expErr: errors.New("unknown ACL template"),
+		},
+		"ACL and ACL template": {
+			req: &PoolCreateReq{
+				TotalBytes:  10,
+				ACL:         &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+				ACLTemplate: "private",
+			},
+			expErr: errors.New("can't mix"),
+		},
 		"success no props": {
 			req: &PoolCreateReq{TotalBytes: 10},
 			mic: &MockInvokerConfig{
@@ -531,7 +1202,7 @@ func TestControl_PoolQueryResp_MarshallJSON(t *testing.T) {
 					UpgradeLayoutVer: 8,
 				},
 			},
-			exp: `{"enabled_ranks":null,"disabled_ranks":null,"status":0,"uuid":"foo","total_targets":1,"active_targets":2,"total_engines":3,"disabled_targets":4,"version":5,"leader":6,"rebuild":null,"tier_stats":null,"pool_layout_ver":7,"upgrade_layout_ver":8}`,
+			exp: `{"enabled_ranks":null,"disabled_ranks":null,"status":0,"uuid":"foo","total_targets":1,"active_targets":2,"total_engines":3,"disabled_targets":4,"version":5,"leader":6,"svc_reps":null,"rebuild":null,"tier_stats":null,"pool_layout_ver":7,"upgrade_layout_ver":8}`,
 		},
 		"valid rankset": {
 			pqr: &PoolQueryResp{
@@ -550,7 +1221,41 @@ func TestControl_PoolQueryResp_MarshallJSON(t *testing.T) {
 					UpgradeLayoutVer: 8,
 				},
 			},
-			exp: `{"enabled_ranks":[0,1,2,3,5],"disabled_ranks":[],"status":0,"uuid":"foo","total_targets":1,"active_targets":2,"total_engines":3,"disabled_targets":4,"version":5,"leader":6,"rebuild":null,"tier_stats":null,"pool_layout_ver":7,"upgrade_layout_ver":8}`,
+			exp: `{"enabled_ranks":[0,1,2,3,5],"disabled_ranks":[],"status":0,"uuid":"foo","total_targets":1,"active_targets":2,"total_engines":3,"disabled_targets":4,"version":5,"leader":6,"svc_reps":null,"rebuild":null,"tier_stats":null,"pool_layout_ver":7,"upgrade_layout_ver":8}`,
+		},
+		"rebuild busy": {
+			pqr: &PoolQueryResp{
+				UUID: "foo",
+				PoolInfo: PoolInfo{
+					Version: 5,
+					Rebuild: &PoolRebuildStatus{
+						State:        PoolRebuildStateBusy,
+						Objects:      10,
+						Records:      20,
+						TotalObjects: 30,
+						Size:         40,
+						Version:      5,
+						Seconds:      60,
+					},
+				},
+			},
+			exp: `{"enabled_ranks":null,"disabled_ranks":null,"status":0,"uuid":"foo","total_targets":0,"active_targets":0,"total_engines":0,"disabled_targets":0,"version":5,"leader":0,"svc_reps":null,"rebuild":{"status":0,"state":"busy","objects":10,"records":20,"total_objects":30,"size":40,"version":5,"seconds":60,"fail_rank":0},"tier_stats":null,"pool_layout_ver":0,"upgrade_layout_ver":0}`,
+		},
+		"rebuild failed": {
+			pqr: &PoolQueryResp{
+				UUID: "foo",
+				PoolInfo: PoolInfo{
+					Version: 5,
+					Rebuild: &PoolRebuildStatus{
+						Status:   -1011,
+						Version:  5,
+						Seconds:  60,
+						FailRank: 3,
+						Error:    "timed out",
+					},
+				},
+			},
+			exp: `{"enabled_ranks":null,"disabled_ranks":null,"status":0,"uuid":"foo","total_targets":0,"active_targets":0,"total_engines":0,"disabled_targets":0,"version":5,"leader":0,"svc_reps":null,"rebuild":{"status":-1011,"state":"idle","objects":0,"records":0,"total_objects":0,"size":0,"version":5,"seconds":60,"fail_rank":3,"error":"timed out"},"tier_stats":null,"pool_layout_ver":0,"upgrade_layout_ver":0}`,
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -661,6 +1366,7 @@ func TestControl_PoolQuery(t *testing.T) {
 						DisabledTargets:  17,
 						PoolLayoutVer:    1,
 						UpgradeLayoutVer: 2,
+						SvcReps:          []uint32{0, 2, 4},
 						Rebuild: &mgmtpb.PoolRebuildStatus{
 							State:   mgmtpb.PoolRebuildStatus_BUSY,
 							Objects: 1,
@@ -695,6 +1401,7 @@ func TestControl_PoolQuery(t *testing.T) {
 					DisabledTargets:  17,
 					PoolLayoutVer:    1,
 					UpgradeLayoutVer: 2,
+					ServiceReplicas:  []ranklist.Rank{0, 2, 4},
 					Rebuild: &PoolRebuildStatus{
 						State:   PoolRebuildStateBusy,
 						Objects: 1,
@@ -865,6 +1572,37 @@ func TestControl_PoolQuery(t *testing.T) {
 				},
 			},
 		},
+		"query succeeds rebuild failed": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolQueryResp{
+						Uuid:         test.MockUUID(),
+						TotalTargets: 42,
+						Version:      3,
+						Rebuild: &mgmtpb.PoolRebuildStatus{
+							Status:   int32(daos.TimedOut),
+							Version:  3,
+							Seconds:  120,
+							FailRank: 2,
+						},
+					},
+				),
+			},
+			expResp: &PoolQueryResp{
+				UUID: test.MockUUID(),
+				PoolInfo: PoolInfo{
+					TotalTargets: 42,
+					Version:      3,
+					Rebuild: &PoolRebuildStatus{
+						Status:   int32(daos.TimedOut),
+						Version:  3,
+						Seconds:  120,
+						FailRank: 2,
+						Error:    daos.TimedOut.Error(),
+					},
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -994,6 +1732,101 @@ func TestPoolSetProp(t *testing.T) {
 	}
 }
 
+func TestPoolSetPropDiff(t *testing.T) {
+	getResp := MockMSResponse("host1", nil, &mgmtpb.PoolGetPropResp{
+		Properties: []*mgmtpb.PoolProperty{
+			{
+				Number: propWithVal("label", "").Number,
+				Value:  &mgmtpb.PoolProperty_Strval{Strval: "foo"},
+			},
+			{
+				Number: propWithVal("reclaim", "").Number,
+				Value:  &mgmtpb.PoolProperty_Numval{Numval: daos.PoolSpaceReclaimDisabled},
+			},
+		},
+	})
+
+	for name, tc := range map[string]struct {
+		mic        *MockInvokerConfig
+		req        *PoolSetPropReq
+		expChanges []*PoolPropChange
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"empty request properties": {
+			req:    &PoolSetPropReq{ID: test.MockUUID()},
+			expErr: errors.New("empty properties list"),
+		},
+		"get-prop fails": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			req: &PoolSetPropReq{
+				ID:         test.MockUUID(),
+				Properties: []*daos.PoolProperty{propWithVal("label", "bar")},
+			},
+			expErr: errors.New("fetching current property values: remote failed"),
+		},
+		"set-prop fails": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					getResp,
+					MockMSResponse("host1", errors.New("set failed"), nil),
+				},
+			},
+			req: &PoolSetPropReq{
+				ID:         test.MockUUID(),
+				Properties: []*daos.PoolProperty{propWithVal("label", "bar")},
+			},
+			expErr: errors.New("set failed"),
+		},
+		"success": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					getResp,
+					MockMSResponse("host1", nil, &mgmtpb.PoolSetPropResp{}),
+				},
+			},
+			req: &PoolSetPropReq{
+				ID: test.MockUUID(),
+				Properties: []*daos.PoolProperty{
+					propWithVal("label", "foo"),
+					propWithVal("reclaim", "lazy"),
+				},
+			},
+			expChanges: []*PoolPropChange{
+				{Name: "label", OldValue: "foo", NewValue: "foo"},
+				{Name: "reclaim", OldValue: "disabled", NewValue: "lazy"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotChanges, gotErr := PoolSetPropDiff(context.TODO(), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expChanges, gotChanges); diff != "" {
+				t.Fatalf("unexpected changes (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, false, gotChanges[0].Changed(), "label should be unchanged")
+			test.AssertEqual(t, true, gotChanges[1].Changed(), "reclaim should be changed")
+		})
+	}
+}
+
 func TestPoolGetProp(t *testing.T) {
 	defaultReq := &PoolGetPropReq{
 		ID: test.MockUUID(),
@@ -1601,6 +2434,69 @@ func TestControl_ListPools(t *testing.T) {
 				},
 			},
 		},
+		"two pools; health probe": {
+			req: &ListPoolsReq{NoQuery: true, Health: true},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+						Pools: []*mgmtpb.ListPoolsResp_Pool{
+							{
+								Uuid:    test.MockUUID(1),
+								SvcReps: []uint32{1, 2},
+								State:   system.PoolServiceStateReady.String(),
+							},
+							{
+								Uuid:    test.MockUUID(2),
+								SvcReps: []uint32{1, 2},
+								State:   system.PoolServiceStateReady.String(),
+							},
+							{
+								Uuid:    test.MockUUID(3),
+								SvcReps: []uint32{1, 2},
+								State:   system.PoolServiceStateDestroying.String(),
+							},
+						},
+					}),
+					MockMSResponse("host1", nil, &mgmtpb.PoolProbeResp{
+						Uuid:   test.MockUUID(1),
+						Health: system.PoolServiceHealthDegraded,
+						Leader: 1,
+						Replicas: []*mgmtpb.PoolProbeResp_Replica{
+							{Rank: 1, State: system.PoolReplicaStateLeader},
+							{Rank: 2, State: system.PoolReplicaStateDown},
+						},
+					}),
+					MockMSResponse("host1", errors.New("remote failed"), nil),
+				},
+			},
+			expResp: &ListPoolsResp{
+				Pools: []*Pool{
+					{
+						UUID:            test.MockUUID(1),
+						ServiceLeader:   1,
+						ServiceReplicas: []ranklist.Rank{1, 2},
+						State:           system.PoolServiceStateReady.String(),
+						Health:          system.PoolServiceHealthDegraded,
+						ReplicaStatus: []*PoolReplicaStatus{
+							{Rank: 1, State: system.PoolReplicaStateLeader},
+							{Rank: 2, State: system.PoolReplicaStateDown},
+						},
+					},
+					{
+						UUID:            test.MockUUID(2),
+						ServiceReplicas: []ranklist.Rank{1, 2},
+						State:           system.PoolServiceStateReady.String(),
+						Health:          system.PoolServiceHealthUnavailable,
+						HealthErrorMsg:  "remote failed",
+					},
+					{
+						UUID:            test.MockUUID(3),
+						ServiceReplicas: []ranklist.Rank{1, 2},
+						State:           system.PoolServiceStateDestroying.String(),
+					},
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1631,6 +2527,88 @@ func TestControl_ListPools(t *testing.T) {
 	}
 }
 
+func TestControl_PoolProbe(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolProbeReq
+		expResp *PoolProbeResp
+		expErr  error
+	}{
+		"local failure": {
+			req: &PoolProbeReq{ID: test.MockUUID()},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolProbeReq{ID: test.MockUUID()},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &PoolProbeReq{ID: test.MockUUID()},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.PoolProbeResp{
+					Uuid:       test.MockUUID(),
+					Health:     system.PoolServiceHealthDegraded,
+					Leader:     2,
+					MapVersion: 7,
+					Replicas: []*mgmtpb.PoolProbeResp_Replica{
+						{
+							Rank:        1,
+							State:       system.PoolReplicaStateExcluded,
+							EngineState: system.MemberStateJoined.String(),
+						},
+						{
+							Rank:        2,
+							State:       system.PoolReplicaStateLeader,
+							EngineState: system.MemberStateJoined.String(),
+						},
+					},
+				}),
+			},
+			expResp: &PoolProbeResp{
+				UUID:       test.MockUUID(),
+				Health:     system.PoolServiceHealthDegraded,
+				Leader:     2,
+				MapVersion: 7,
+				Replicas: []*PoolReplicaStatus{
+					{
+						Rank:        1,
+						State:       system.PoolReplicaStateExcluded,
+						EngineState: system.MemberStateJoined.String(),
+					},
+					{
+						Rank:        2,
+						State:       system.PoolReplicaStateLeader,
+						EngineState: system.MemberStateJoined.String(),
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResp, gotErr := PoolProbe(context.TODO(), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_GetMaxPoolSize(t *testing.T) {
 	devStateFaulty := storage.NvmeStateFaulty
 	type ExpectedOutput struct {
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -74,6 +74,13 @@ type (
 		retryer
 		unaryRPCGetter
 	}
+
+	// StreamRequest defines an interface to be implemented by
+	// server-streaming request types (N responses to 1 request).
+	StreamRequest interface {
+		targetChooser
+		streamRPCGetter
+	}
 )
 
 var (
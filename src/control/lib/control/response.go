@@ -8,6 +8,7 @@ package control
 
 import (
 	"encoding/json"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -25,6 +26,12 @@ var (
 	errNoMsResponse = errors.New("response did not contain a management service response")
 )
 
+// IsNoMSResponse returns true if the error indicates that a response from a
+// management service replica did not contain a response message.
+func IsNoMSResponse(err error) bool {
+	return errors.Cause(err) == errNoMsResponse
+}
+
 type (
 	// HostResponse contains a single host's response to an unary RPC, or
 	// an error if the host was unable to respond successfully.
@@ -63,18 +70,8 @@ func (her *HostErrorsResp) GetHostErrors() HostErrorsMap {
 // Errors returns an error containing brief description of errors in map.
 func (her *HostErrorsResp) Errors() error {
 	if len(her.HostErrors) > 0 {
-		erroredHosts := make(map[string]bool)
-		for _, hes := range her.HostErrors {
-			hostsInSet := strings.Split(hes.HostSet.DerangedString(), ",")
-			for _, host := range hostsInSet {
-				if _, exists := erroredHosts[host]; !exists {
-					erroredHosts[host] = true
-				}
-			}
-		}
-
 		return errors.Errorf("%s had errors",
-			english.Plural(len(erroredHosts), "host", "hosts"))
+			english.Plural(her.HostErrors.ErroredHosts().Count(), "host", "hosts"))
 	}
 	return nil
 }
@@ -86,6 +83,15 @@ type HostErrorSet struct {
 	HostError error
 }
 
+// String returns the error followed by the ranged set of hosts that
+// experienced it, e.g. "connection refused: node[2-9]".
+func (hes *HostErrorSet) String() string {
+	if hes == nil || hes.HostSet == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s", hes.HostError, hes.HostSet.RangedString())
+}
+
 // HostErrorsMap provides a mapping from error strings to a set of
 // hosts to which the error applies.
 type HostErrorsMap map[string]*HostErrorSet
@@ -154,6 +160,53 @@ func (hem HostErrorsMap) Keys() []string {
 	return keys
 }
 
+// ErrorSets returns the error sets in the map in the stable order of Keys.
+func (hem HostErrorsMap) ErrorSets() []*HostErrorSet {
+	sets := make([]*HostErrorSet, 0, len(hem))
+	for _, key := range hem.Keys() {
+		sets = append(sets, hem[key])
+	}
+	return sets
+}
+
+// ErroredHosts returns the set of hosts that experienced at least one of the
+// errors in the map.
+func (hem HostErrorsMap) ErroredHosts() *hostlist.HostSet {
+	hosts := new(hostlist.HostSet)
+	for _, hes := range hem {
+		if hes == nil || hes.HostSet == nil {
+			continue
+		}
+		_ = hosts.Merge(hes.HostSet) // only fails on nil set
+	}
+	return hosts
+}
+
+// ErrorsForHost returns the errors experienced by the given host in the
+// stable order of Keys.
+func (hem HostErrorsMap) ErrorsForHost(hostAddr string) []error {
+	var errs []error
+	for _, hes := range hem.ErrorSets() {
+		if hes == nil || hes.HostSet == nil {
+			continue
+		}
+		if in, err := hes.HostSet.Within(hostAddr); err == nil && in {
+			errs = append(errs, hes.HostError)
+		}
+	}
+	return errs
+}
+
+// String returns one line per distinct error with the ranged set of hosts
+// that experienced it.
+func (hem HostErrorsMap) String() string {
+	lines := make([]string, 0, len(hem))
+	for _, hes := range hem.ErrorSets() {
+		lines = append(lines, hes.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
 // UnaryResponse contains a slice of *HostResponse items returned
 // from synchronous unary RPC invokers.
 type UnaryResponse struct {
@@ -7,6 +7,7 @@
 package control
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -106,6 +107,49 @@ func TestControl_HostErrorsMap(t *testing.T) {
 	}
 }
 
+func TestControl_HostErrorsMap_accessors(t *testing.T) {
+	hem := mockHostErrorsMap(t,
+		&MockHostError{"node2:10001", "connection refused"},
+		&MockHostError{"node3:10001", "connection refused"},
+		&MockHostError{"node9:10001", "connection refused"},
+		&MockHostError{"node3:10001", "timed out"},
+		&MockHostError{"node1:10001", "bad config"},
+	)
+
+	test.AssertEqual(t, "node[1-3,9]:10001", hem.ErroredHosts().RangedString(),
+		"unexpected errored hosts")
+	test.AssertEqual(t, "1 host had errors",
+		(&HostErrorsResp{HostErrors: mockHostErrorsMap(t,
+			&MockHostError{"node1", "whoops"},
+			&MockHostError{"node1", "oops"},
+		)}).Errors().Error(), "unexpected errors summary")
+
+	var gotSets []string
+	for _, hes := range hem.ErrorSets() {
+		gotSets = append(gotSets, hes.String())
+	}
+	expSets := []string{
+		"bad config: node1:10001",
+		"timed out: node3:10001",
+		"connection refused: node[2-3,9]:10001",
+	}
+	if diff := cmp.Diff(expSets, gotSets); diff != "" {
+		t.Fatalf("unexpected error sets (-want, +got):\n%s\n", diff)
+	}
+	test.AssertEqual(t, strings.Join(expSets, "\n"), hem.String(), "unexpected string")
+
+	var gotErrs []string
+	for _, err := range hem.ErrorsForHost("node3:10001") {
+		gotErrs = append(gotErrs, err.Error())
+	}
+	if diff := cmp.Diff([]string{"timed out", "connection refused"}, gotErrs); diff != "" {
+		t.Fatalf("unexpected host errors (-want, +got):\n%s\n", diff)
+	}
+	if errs := hem.ErrorsForHost("node4:10001"); len(errs) != 0 {
+		t.Fatalf("unexpected errors for host without errors: %v", errs)
+	}
+}
+
 func TestControl_getMSResponse(t *testing.T) {
 	for name, tc := range map[string]struct {
 		resp    *UnaryResponse
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +8,7 @@ package control
 
 import (
 	"context"
+	"io"
 	"math/rand"
 	"os"
 	"sync"
@@ -16,11 +17,14 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/clock"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -90,11 +94,44 @@ type (
 		InvokeUnaryRPCAsync(ctx context.Context, req UnaryRequest) (HostResponseChan, error)
 	}
 
+	// msCandidateFilter defines an interface to be implemented by clients
+	// that can exclude hosts from the set of MS candidates.
+	msCandidateFilter interface {
+		filterMSCandidates(hosts []string) []string
+	}
+
+	// streamRecvFn defines the function signature for a closure that
+	// receives the next protobuf response from a server-streaming gRPC
+	// method. It returns io.EOF when the stream has ended.
+	streamRecvFn func() (proto.Message, error)
+
+	// streamRPC defines the function signature for a closure that invokes
+	// a server-streaming gRPC method and returns a closure for receiving
+	// its responses.
+	streamRPC func(context.Context, *grpc.ClientConn) (streamRecvFn, error)
+
+	// streamRPCGetter defines the interface to be implemented by requests
+	// that can invoke a server-streaming gRPC method.
+	streamRPCGetter interface {
+		getStreamRPC() streamRPC
+	}
+
+	// StreamHandler defines the function signature for a callback which
+	// is invoked for each response received from a stream.
+	StreamHandler func(proto.Message) error
+
+	// StreamInvoker defines an interface to be implemented by clients
+	// capable of invoking a server-streaming RPC (N responses for 1
+	// request) on a single host.
+	StreamInvoker interface {
+		InvokeStreamRPC(ctx context.Context, req StreamRequest, handler StreamHandler) error
+	}
+
 	// Invoker defines an interface to be implemented by clients
 	// capable of invoking unary or stream RPCs.
 	Invoker interface {
 		UnaryInvoker
-		//TODO: StreamInvoker
+		StreamInvoker
 		SetConfig(*Config)
 	}
 )
@@ -111,6 +148,18 @@ func (r *unaryRequest) getRPC() unaryRPC {
 	return r.rpc
 }
 
+// streamRequest is an embeddable struct to be used by requests which
+// implement the StreamRequest interface.
+type streamRequest struct {
+	request
+	rpc streamRPC
+}
+
+// getStreamRPC returns the request's RPC closure.
+func (r *streamRequest) getStreamRPC() streamRPC {
+	return r.rpc
+}
+
 // setRPC sets the requests's RPC closure.
 func (r *unaryRequest) setRPC(rpc unaryRPC) {
 	r.rpc = rpc
@@ -122,6 +171,13 @@ type (
 	Client struct {
 		config *Config
 		log    debugLogger
+		trace  *RPCTrace
+		health *hostHealthTracker
+		comp   *compressionTracker
+		clock  clock.Clock
+
+		authLock sync.Mutex
+		auth     *bearerTokenCredentials
 	}
 
 	// ClientOption defines the signature for functional Client options.
@@ -135,6 +191,29 @@ func WithClientLogger(log debugLogger) ClientOption {
 	}
 }
 
+// WithRPCTrace sets an RPCTrace to record the RPCs made by the client.
+func WithRPCTrace(trace *RPCTrace) ClientOption {
+	return func(c *Client) {
+		c.trace = trace
+	}
+}
+
+// WithUnreachableHostLogger sets a logger to be used to warn when a host
+// persistently fails to respond to requests and is deprioritized.
+func WithUnreachableHostLogger(log logging.NoticeLogger) ClientOption {
+	return func(c *Client) {
+		c.health.warn = log
+	}
+}
+
+// WithClock sets the clock used by the client for request retry backoff and
+// for the expiry of cached state.
+func WithClock(clk clock.Clock) ClientOption {
+	return func(c *Client) {
+		c.clock = clk
+	}
+}
+
 // WithConfig sets the client's configuration.
 func WithConfig(cfg *Config) ClientOption {
 	return func(c *Client) {
@@ -147,6 +226,9 @@ func WithConfig(cfg *Config) ClientOption {
 func NewClient(opts ...ClientOption) *Client {
 	c := &Client{
 		config: DefaultConfig(),
+		health: newHostHealthTracker(defaultLogger),
+		comp:   newCompressionTracker(defaultLogger),
+		clock:  clock.New(),
 	}
 
 	for _, opt := range opts {
@@ -156,6 +238,9 @@ func NewClient(opts ...ClientOption) *Client {
 	if c.log == nil {
 		WithClientLogger(defaultLogger)(c)
 	}
+	c.health.log = c.log
+	c.health.clock = c.clock
+	c.comp.log = c.log
 
 	return c
 }
@@ -173,6 +258,16 @@ func DefaultClient() *Client {
 // existing Client.
 func (c *Client) SetConfig(cfg *Config) {
 	c.config = cfg
+
+	c.authLock.Lock()
+	c.auth = nil
+	c.authLock.Unlock()
+}
+
+// SetRPCTrace sets an RPCTrace to record the RPCs made by an existing
+// Client.
+func (c *Client) SetRPCTrace(trace *RPCTrace) {
+	c.trace = trace
 }
 
 // GetConfig retrieves the system name from the client configuration and
@@ -189,6 +284,19 @@ func (c *Client) Debugf(fmtStr string, args ...interface{}) {
 	c.log.Debugf(fmtStr, args...)
 }
 
+// authCredentials returns the bearer token credentials to be used by the
+// client, if configured. The credentials are shared between connections so
+// that a token obtained from a command can be reused until it expires.
+func (c *Client) authCredentials() *bearerTokenCredentials {
+	c.authLock.Lock()
+	defer c.authLock.Unlock()
+
+	if c.auth == nil {
+		c.auth = newBearerTokenCredentials(c.config, c.clock)
+	}
+	return c.auth
+}
+
 // dialOptions is a helper method to return a set of gRPC
 // client dialer options.
 func (c *Client) dialOptions() ([]grpc.DialOption, error) {
@@ -204,6 +312,38 @@ func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 	}
 	opts = append(opts, creds)
 
+	if c.config.TransportConfig != nil {
+		tuningOpts, err := c.config.TransportConfig.Grpc.DialOptions()
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid grpc config")
+		}
+		opts = append(opts, tuningOpts...)
+	}
+
+	if c.trace != nil {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(c.trace.unaryInterceptor()))
+	}
+
+	if auth := c.authCredentials(); auth != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(auth))
+	}
+
+	// Compressing the request causes the server to compress its response
+	// with the same compressor, which is where the savings come from for
+	// large responses (e.g. storage scans) on slow management links.
+	// Requests to servers that don't accept compressed requests are
+	// retried uncompressed.
+	switch c.config.Compression {
+	case "", CompressionNone:
+	case CompressionGzip:
+		opts = append(opts,
+			grpc.WithChainUnaryInterceptor(c.comp.unaryInterceptor(gzip.Name)),
+			grpc.WithChainStreamInterceptor(c.comp.streamInterceptor(gzip.Name)),
+		)
+	default:
+		return nil, errors.Errorf("unsupported compression %q", c.config.Compression)
+	}
+
 	return opts, nil
 }
 
@@ -279,6 +419,8 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 					}
 				}
 
+				c.health.record(hostAddr, err)
+
 				select {
 				case <-parent.Done():
 					c.Debug("parent context canceled -- tearing down client invoker")
@@ -297,7 +439,7 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 // invokeUnaryRPC is the actual implementation which is called by the
 // real Client as well as the MockInvoker. This allows us to ensure that
 // the retry logic here gets adequate test coverage.
-func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker, req UnaryRequest, defaultHosts []string) (*UnaryResponse, error) {
+func invokeUnaryRPC(parentCtx context.Context, log debugLogger, clk clock.Clock, c UnaryInvoker, req UnaryRequest, defaultHosts []string) (*UnaryResponse, error) {
 	gatherResponses := func(ctx context.Context, respChan chan *HostResponse, ur *UnaryResponse) error {
 		for {
 			select {
@@ -337,16 +479,21 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 		// will be up and running enough to return ErrNotReplica in order to
 		// learn the actual list of MS replicas. We may also get lucky and
 		// send the request to a server that can handle the request directly.
+		candidates := defaultHosts
+		if cf, ok := c.(msCandidateFilter); ok {
+			candidates = cf.filterMSCandidates(defaultHosts)
+		}
+
 		rnd := rand.New(msCandidateRandSource)
 		msCandidates := hostlist.MustCreateSet("")
 
 		numCandidates := maxMSCandidates
-		if len(defaultHosts) < numCandidates {
-			numCandidates = len(defaultHosts)
+		if len(candidates) < numCandidates {
+			numCandidates = len(candidates)
 		}
 
 		for msCandidates.Count() < numCandidates {
-			if _, err := msCandidates.Insert(defaultHosts[rnd.Intn(len(defaultHosts))]); err != nil {
+			if _, err := msCandidates.Insert(candidates[rnd.Intn(len(candidates))]); err != nil {
 				return nil, errors.Wrap(err, "failed to build MS candidates set")
 			}
 		}
@@ -407,6 +554,7 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 			tryCtx, tryCancel = context.WithTimeout(reqCtx, tryTimeout)
 			defer tryCancel()
 		}
+		tryCtx = withRPCAttempt(tryCtx, try)
 		respChan, err := c.InvokeUnaryRPCAsync(tryCtx, req)
 		if isHardFailure(err, reqCtx) {
 			return nil, wrapReqTimeout(req, err)
@@ -496,16 +644,87 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 				return nil, FaultRpcTimeout(req)
 			}
 			return nil, reqCtx.Err()
-		case <-time.After(backoff):
+		case <-clk.After(backoff):
 		}
 		try++
 	}
 }
 
+// filterMSCandidates removes hosts that have persistently failed to respond
+// from the list of possible MS candidates, unless doing so would leave no
+// candidates at all. Demoted hosts become candidates again once they have
+// been skipped for long enough, so that recovered hosts are rediscovered.
+func (c *Client) filterMSCandidates(hosts []string) []string {
+	var reachable []string
+	for _, host := range hosts {
+		addr := host
+		if parsed, err := common.ParseHostList([]string{host}, c.config.ControlPort); err == nil && len(parsed) == 1 {
+			addr = parsed[0]
+		}
+		if c.health.isDemoted(addr) {
+			c.Debugf("skipping unreachable MS candidate %s", host)
+			continue
+		}
+		reachable = append(reachable, host)
+	}
+
+	if len(reachable) == 0 {
+		return hosts
+	}
+	return reachable
+}
+
 // InvokeUnaryRPC performs a synchronous (blocking) invocation of the request's
 // RPC across all hosts in the request. The response contains a slice of HostResponse
 // items which represent the success or failure of the RPC invocation for each host
 // in the request.
 func (c *Client) InvokeUnaryRPC(ctx context.Context, req UnaryRequest) (*UnaryResponse, error) {
-	return invokeUnaryRPC(ctx, c.log, c, req, c.config.HostList)
+	return invokeUnaryRPC(ctx, c.log, c.clock, c, req, c.config.HostList)
+}
+
+// InvokeStreamRPC invokes the request's server-streaming RPC on the single
+// host in the request's hostlist and calls the handler for each response
+// received. It returns when the stream ends, the handler returns an error, or
+// the context is canceled. No deadline is imposed on the request, as streams
+// may be long-lived.
+func (c *Client) InvokeStreamRPC(ctx context.Context, req StreamRequest, handler StreamHandler) error {
+	hosts, err := getRequestHosts(c.config, req)
+	if err != nil {
+		return err
+	}
+	if len(hosts) != 1 {
+		return errors.Errorf("streaming request must be sent to a single host (got %d)", len(hosts))
+	}
+	hostAddr := hosts[0]
+
+	opts, err := c.dialOptions()
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.DialContext(ctx, hostAddr, opts...)
+	if err != nil {
+		c.health.record(hostAddr, err)
+		return err
+	}
+	defer conn.Close()
+
+	recv, err := req.getStreamRPC()(ctx, conn)
+	c.health.record(hostAddr, err)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := handler(msg); err != nil {
+			return err
+		}
+	}
 }
@@ -12,6 +12,7 @@ import (
 	"os"
 	"runtime"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/clock"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -536,3 +538,71 @@ func TestControl_InvokeUnaryRPC(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_InvokeUnaryRPC_retryBackoff(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	clientCfg := DefaultConfig()
+	clientCfg.TransportConfig.AllowInsecure = true
+
+	mc := clock.NewMock(time.Now())
+	client := NewClient(
+		WithConfig(clientCfg),
+		WithClientLogger(log),
+		WithClock(mc),
+	)
+
+	host := "host1:10001"
+	var callsMu sync.Mutex
+	var calls int
+	getCalls := func() int {
+		callsMu.Lock()
+		defer callsMu.Unlock()
+		return calls
+	}
+
+	req := &testRequest{
+		HostList: []string{host},
+		toMS:     true,
+		rpcFn: func(_ context.Context, _ *grpc.ClientConn) (proto.Message, error) {
+			callsMu.Lock()
+			defer callsMu.Unlock()
+			calls++
+			if calls < 3 {
+				return nil, &system.ErrNotLeader{Replicas: []string{host}}
+			}
+			return defaultMessage, nil
+		},
+	}
+	req.SetTimeout(10 * time.Second)
+
+	type result struct {
+		resp *UnaryResponse
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := client.InvokeUnaryRPC(context.Background(), req)
+		done <- result{resp, err}
+	}()
+
+	// The first retry is immediate; subsequent retries wait for the
+	// backoff period to elapse.
+	mc.BlockUntil(1)
+	test.AssertEqual(t, 2, getCalls(), "unexpected number of calls before backoff")
+	select {
+	case <-done:
+		t.Fatal("request completed before backoff elapsed")
+	default:
+	}
+
+	mc.Advance(time.Minute)
+
+	res := <-done
+	if res.err != nil {
+		t.Fatal(res.err)
+	}
+	test.AssertEqual(t, 3, getCalls(), "unexpected number of calls")
+	test.AssertEqual(t, 1, len(res.resp.Responses), "unexpected number of responses")
+}
@@ -0,0 +1,183 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+)
+
+type (
+	// noticeLogger defines a logging interface for messages that are
+	// displayed regardless of whether debug output is enabled.
+	noticeLogger interface {
+		Noticef(string, ...interface{})
+	}
+
+	// RPCTraceEntry describes a single gRPC call made by a Client.
+	RPCTraceEntry struct {
+		Host     string
+		Method   string
+		Attempt  uint
+		ReqSize  int
+		RespSize int
+		Duration time.Duration
+		Err      error
+	}
+
+	// RPCTrace logs each gRPC call made by a Client as it completes and
+	// keeps a record of them in order to produce a timing summary.
+	RPCTrace struct {
+		sync.Mutex
+		log     noticeLogger
+		start   time.Time
+		entries []*RPCTraceEntry
+	}
+
+	rpcAttemptKey struct{}
+)
+
+// NewRPCTrace returns an RPCTrace that writes to the supplied logger.
+func NewRPCTrace(log noticeLogger) *RPCTrace {
+	return &RPCTrace{
+		log:   log,
+		start: time.Now(),
+	}
+}
+
+// withRPCAttempt returns a context indicating which attempt of a request
+// the RPCs made with it belong to.
+func withRPCAttempt(ctx context.Context, attempt uint) context.Context {
+	return context.WithValue(ctx, rpcAttemptKey{}, attempt)
+}
+
+func rpcAttempt(ctx context.Context) uint {
+	attempt, _ := ctx.Value(rpcAttemptKey{}).(uint)
+	return attempt
+}
+
+func msgSize(msg interface{}) int {
+	if pm, ok := msg.(proto.Message); ok {
+		return proto.Size(pm)
+	}
+	return 0
+}
+
+// Entries returns a copy of the RPCs recorded so far.
+func (t *RPCTrace) Entries() []*RPCTraceEntry {
+	t.Lock()
+	defer t.Unlock()
+
+	entries := make([]*RPCTraceEntry, len(t.entries))
+	copy(entries, t.entries)
+	return entries
+}
+
+func (t *RPCTrace) add(entry *RPCTraceEntry) {
+	t.Lock()
+	t.entries = append(t.entries, entry)
+	t.Unlock()
+
+	result := "ok"
+	if entry.Err != nil {
+		result = entry.Err.Error()
+	}
+	t.log.Noticef("rpc %s -> %s attempt:%d req:%dB resp:%dB %s: %s",
+		entry.Method, entry.Host, entry.Attempt, entry.ReqSize, entry.RespSize,
+		entry.Duration.Round(time.Microsecond), result)
+}
+
+// unaryInterceptor returns a gRPC client interceptor that records each
+// RPC made by the client.
+func (t *RPCTrace) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if pm, ok := req.(proto.Message); ok && pbUtil.ShouldDebug(pm) {
+			t.log.Noticef("rpc %s -> %s payload: %s", path.Base(method), cc.Target(), pbUtil.Debug(pm))
+		}
+
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		entry := &RPCTraceEntry{
+			Host:     cc.Target(),
+			Method:   path.Base(method),
+			Attempt:  rpcAttempt(ctx),
+			ReqSize:  msgSize(req),
+			Duration: time.Since(start),
+			Err:      err,
+		}
+		if err == nil {
+			entry.RespSize = msgSize(reply)
+		}
+		t.add(entry)
+
+		return err
+	}
+}
+
+// Summary returns a description of the time taken since the trace was
+// created and of the RPCs made during that time, grouped by method.
+func (t *RPCTrace) Summary() string {
+	entries := t.Entries()
+
+	type methodStats struct {
+		calls   int
+		failed  int
+		retries int
+		bytes   int
+		total   time.Duration
+		max     time.Duration
+	}
+	stats := make(map[string]*methodStats)
+	var methods []string
+	var failed, retries int
+	for _, e := range entries {
+		ms, found := stats[e.Method]
+		if !found {
+			ms = new(methodStats)
+			stats[e.Method] = ms
+			methods = append(methods, e.Method)
+		}
+		ms.calls++
+		ms.bytes += e.RespSize
+		ms.total += e.Duration
+		if e.Duration > ms.max {
+			ms.max = e.Duration
+		}
+		if e.Err != nil {
+			ms.failed++
+			failed++
+		}
+		if e.Attempt > 0 {
+			ms.retries++
+			retries++
+		}
+	}
+	sort.Strings(methods)
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "command completed in %s: %d RPCs, %d failed, %d retried",
+		time.Since(t.start).Round(time.Millisecond), len(entries), failed, retries)
+	for _, m := range methods {
+		ms := stats[m]
+		fmt.Fprintf(&bld, "\n  %s: calls:%d failed:%d retried:%d resp:%dB avg:%s max:%s",
+			m, ms.calls, ms.failed, ms.retries, ms.bytes,
+			(ms.total / time.Duration(ms.calls)).Round(time.Microsecond),
+			ms.max.Round(time.Microsecond))
+	}
+
+	return bld.String()
+}
@@ -0,0 +1,78 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_RPCTrace(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	conn, err := grpc.Dial("host1:10001", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	trace := NewRPCTrace(log)
+	intercept := trace.unaryInterceptor()
+
+	req := &mgmtpb.SystemQueryReq{Sys: "daos_server"}
+	resp := &mgmtpb.SystemQueryResp{
+		Members: []*mgmtpb.SystemMember{{Rank: 1, State: "joined"}},
+	}
+	for attempt, invokeErr := range []error{errors.New("not leader"), nil} {
+		invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			if invokeErr == nil {
+				proto.Merge(reply.(proto.Message), resp)
+			}
+			return invokeErr
+		}
+
+		ctx := withRPCAttempt(context.Background(), uint(attempt))
+		gotErr := intercept(ctx, "/mgmt.MgmtSvc/SystemQuery", req, new(mgmtpb.SystemQueryResp), conn, invoker)
+		test.CmpErr(t, invokeErr, gotErr)
+	}
+
+	entries := trace.Entries()
+	test.AssertEqual(t, 2, len(entries), "unexpected number of entries")
+	for i, e := range entries {
+		test.AssertEqual(t, "host1:10001", e.Host, "unexpected host")
+		test.AssertEqual(t, "SystemQuery", e.Method, "unexpected method")
+		test.AssertEqual(t, uint(i), e.Attempt, "unexpected attempt")
+		test.AssertEqual(t, proto.Size(req), e.ReqSize, "unexpected request size")
+	}
+	test.AssertEqual(t, 0, entries[0].RespSize, "unexpected failed response size")
+	test.AssertEqual(t, proto.Size(resp), entries[1].RespSize, "unexpected response size")
+
+	if !strings.Contains(buf.String(), "rpc SystemQuery -> host1:10001 attempt:0") {
+		t.Fatal("RPC not logged")
+	}
+
+	summary := trace.Summary()
+	for _, exp := range []string{
+		"2 RPCs, 1 failed, 1 retried",
+		"SystemQuery: calls:2 failed:1 retried:1",
+	} {
+		if !strings.Contains(summary, exp) {
+			t.Fatalf("expected %q in summary:\n%s", exp, summary)
+		}
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,13 +8,18 @@ package control
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/server/engine"
 )
 
@@ -69,3 +74,606 @@ func SetEngineLogMasks(ctx context.Context, rpcClient UnaryInvoker, req *SetEngi
 	rpcClient.Debugf("DAOS set engine log masks response: %+v", resp)
 	return resp, nil
 }
+
+// SetTelemetryClassesReq contains the inputs for the set telemetry classes
+// request.
+type SetTelemetryClassesReq struct {
+	unaryRequest
+	Disabled []string `json:"disabled"`
+}
+
+// SetTelemetryClassesResp contains the results of a set telemetry classes
+// request.
+type SetTelemetryClassesResp struct {
+	HostErrorsResp
+}
+
+// SetTelemetryClasses will send RPC to hostlist to request changes to the
+// metric classes that are disabled in the telemetry exporter on each host.
+// Classes that are not listed will be enabled.
+func SetTelemetryClasses(ctx context.Context, rpcClient UnaryInvoker, req *SetTelemetryClassesReq) (*SetTelemetryClassesResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &ctlpb.SetTelemetryClassesReq{
+		Sys:      req.getSystem(rpcClient),
+		Disabled: req.Disabled,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).SetTelemetryClasses(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS set telemetry classes request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke set telemetry classes RPC: %s", err)
+		return nil, err
+	}
+
+	resp := new(SetTelemetryClassesResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	rpcClient.Debugf("DAOS set telemetry classes response: %+v", resp)
+	return resp, nil
+}
+
+// FaultInjectReq contains the inputs for the fault injection request.
+type FaultInjectReq struct {
+	unaryRequest
+	Point string `json:"point"`
+	Arg   string `json:"arg"`
+	Clear bool   `json:"clear"`
+}
+
+// FaultInjectResp contains the results of a fault injection request.
+type FaultInjectResp struct {
+	HostErrorsResp
+}
+
+// FaultInject will send RPC to hostlist to set or clear a fault on each host
+// in the list. Only servers built with fault injection support will honor the
+// request.
+func FaultInject(ctx context.Context, rpcClient UnaryInvoker, req *FaultInjectReq) (*FaultInjectResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if req.Point == "" {
+		return nil, errors.New("no fault injection point specified")
+	}
+
+	pbReq := &ctlpb.FaultInjectReq{
+		Sys: req.getSystem(rpcClient),
+		Fault: &ctlpb.InjectedFault{
+			Point: req.Point,
+			Arg:   req.Arg,
+		},
+		Clear: req.Clear,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).FaultInject(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS fault inject request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke fault inject RPC: %s", err)
+		return nil, err
+	}
+
+	resp := new(FaultInjectResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	rpcClient.Debugf("DAOS fault inject response: %+v", resp)
+	return resp, nil
+}
+
+// SupportVersionCommand is the support command that reports the version of
+// the DAOS server software installed on a host in JSON format.
+const SupportVersionCommand = "daos_server version --json"
+
+// SupportCommands are the command lines that may be run on a server by
+// SupportExec. They report on the state of the host without modifying it, and
+// are run with exactly the arguments given here.
+var SupportCommands = []string{
+	"dmesg",
+	"lspci -vvv",
+	"lscpu",
+	"lsblk",
+	"numactl --hardware",
+	"ipmctl show -dimm",
+	"ipmctl show -region",
+	"ipmctl show -topology",
+	"ndctl list -RN",
+	"ip address",
+	"df -h",
+	"free -m",
+	"uname -a",
+	SupportVersionCommand,
+}
+
+// SupportExecReq contains the inputs for a request to run a support command
+// on a set of hosts.
+type SupportExecReq struct {
+	unaryRequest
+	Command string `json:"command"`
+}
+
+// SupportExecResult contains the results of running a support command on a
+// single host.
+type SupportExecResult struct {
+	Command   string `json:"command"`
+	ExitCode  int32  `json:"exit_code"`
+	Output    string `json:"output"`
+	Truncated bool   `json:"truncated"`
+}
+
+// SupportExecResp contains the per-host results of a support command request.
+type SupportExecResp struct {
+	HostErrorsResp
+	Results map[string]*SupportExecResult `json:"results"`
+}
+
+// SupportExec will send RPC to hostlist to run a support command on each host
+// in the list. Only the fixed set of commands permitted by the server may be
+// run, and only by a client with a certificate for the support role.
+func SupportExec(ctx context.Context, rpcClient UnaryInvoker, req *SupportExecReq) (*SupportExecResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if req.Command == "" {
+		return nil, errors.New("no support command specified")
+	}
+
+	pbReq := &ctlpb.SupportExecReq{
+		Sys:     req.getSystem(rpcClient),
+		Command: req.Command,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).SupportExec(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS support exec request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke support exec RPC: %s", err)
+		return nil, err
+	}
+
+	resp := &SupportExecResp{
+		Results: make(map[string]*SupportExecResult),
+	}
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*ctlpb.SupportExecResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+		resp.Results[hostResp.Addr] = &SupportExecResult{
+			Command:   pbResp.Command,
+			ExitCode:  pbResp.ExitCode,
+			Output:    string(pbResp.Output),
+			Truncated: pbResp.Truncated,
+		}
+	}
+
+	return resp, nil
+}
+
+// FaultDomainQueryReq contains the inputs for a request to query the fault
+// domains of a set of hosts.
+type FaultDomainQueryReq struct {
+	unaryRequest
+}
+
+// FaultDomainQueryResp maps each responding host to its fault domain.
+type FaultDomainQueryResp struct {
+	HostErrorsResp
+	Domains map[string]string `json:"domains"`
+}
+
+// FaultDomainQuery will send RPC to hostlist to retrieve the fault domain of
+// each host in the list. The fault domain is available before the host's
+// engines have been formatted or joined the system.
+func FaultDomainQuery(ctx context.Context, rpcClient UnaryInvoker, req *FaultDomainQueryReq) (*FaultDomainQueryResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &ctlpb.FaultDomainQueryReq{Sys: req.getSystem(rpcClient)}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).FaultDomainQuery(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &FaultDomainQueryResp{
+		Domains: make(map[string]string),
+	}
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*ctlpb.FaultDomainQueryResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+		resp.Domains[hostResp.Addr] = pbResp.FaultDomain
+	}
+
+	return resp, nil
+}
+
+// DumpEngineStacksReq contains the inputs for a request to dump the ULT stacks
+// of an engine.
+type DumpEngineStacksReq struct {
+	unaryRequest
+	Rank         ranklist.Rank `json:"rank"`
+	Unattended   bool          `json:"unattended"`
+	ReturnOutput bool          `json:"return_output"`
+}
+
+// DumpEngineStacksResp contains the location of an engine ULT stack dump and
+// its output if requested.
+type DumpEngineStacksResp struct {
+	Host      string        `json:"host"`
+	Rank      ranklist.Rank `json:"rank"`
+	Path      string        `json:"path"`
+	Offset    int64         `json:"offset"`
+	Size      int64         `json:"size"`
+	Output    string        `json:"output,omitempty"`
+	Truncated bool          `json:"truncated,omitempty"`
+}
+
+// DumpEngineStacks requests that the engine with the given rank dumps its
+// Argobots state and ULT stacks to a file on its host. If the request does not
+// specify a host, the host running the requested rank is used.
+func DumpEngineStacks(ctx context.Context, rpcClient UnaryInvoker, req *DumpEngineStacksReq) (*DumpEngineStacksResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	switch len(req.getHostList()) {
+	case 0:
+		host, err := rankHost(ctx, rpcClient, req.Rank)
+		if err != nil {
+			return nil, err
+		}
+		req.SetHostList([]string{host})
+	case 1:
+	default:
+		return nil, errors.New("stack dump request must be sent to a single host")
+	}
+
+	pbReq := &ctlpb.DumpEngineStacksReq{
+		Sys:          req.getSystem(rpcClient),
+		Rank:         req.Rank.Uint32(),
+		Unattended:   req.Unattended,
+		ReturnOutput: req.ReturnOutput,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).DumpEngineStacks(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS dump engine stacks request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(ur.Responses) != 1 {
+		return nil, errors.Errorf("unexpected number of responses (%d)", len(ur.Responses))
+	}
+
+	hostResp := ur.Responses[0]
+	if hostResp.Error != nil {
+		return nil, errors.Wrapf(hostResp.Error, "rank %d on %s", req.Rank, hostResp.Addr)
+	}
+	pbResp, ok := hostResp.Message.(*ctlpb.DumpEngineStacksResp)
+	if !ok {
+		return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+	}
+
+	return &DumpEngineStacksResp{
+		Host:      hostResp.Addr,
+		Rank:      req.Rank,
+		Path:      pbResp.Path,
+		Offset:    pbResp.Offset,
+		Size:      pbResp.Size,
+		Output:    pbResp.Output,
+		Truncated: pbResp.Truncated,
+	}, nil
+}
+
+const (
+	// logStreamMaxRetries is the number of consecutive attempts that will
+	// be made to resume an interrupted log stream before giving up.
+	logStreamMaxRetries = 10
+	// logStreamRetryInterval is the period to wait before attempting to
+	// resume an interrupted log stream.
+	logStreamRetryInterval = 2 * time.Second
+)
+
+// LogStreamReq contains the inputs for a request to stream lines from an
+// engine or control plane log file on a host.
+type LogStreamReq struct {
+	streamRequest
+	Rank           ranklist.Rank `json:"rank"`
+	Control        bool          `json:"control"`
+	Level          string        `json:"level"`
+	Facilities     []string      `json:"facilities"`
+	Offset         int64         `json:"offset"`
+	Tail           uint32        `json:"tail"`
+	Follow         bool          `json:"follow"`
+	MaxLinesPerSec uint32        `json:"max_lines_per_sec"`
+}
+
+// LogStreamResp contains a batch of lines received from a log stream.
+type LogStreamResp struct {
+	Host   string   `json:"host"`
+	Path   string   `json:"path"`
+	Lines  []string `json:"lines"`
+	Offset int64    `json:"offset"`
+}
+
+// LogStreamHandler defines the function signature for a callback which is
+// invoked for each batch of lines received from a log stream.
+type LogStreamHandler func(*LogStreamResp) error
+
+// rankHost returns the address of the host running the requested rank.
+func rankHost(ctx context.Context, rpcClient UnaryInvoker, rank ranklist.Rank) (string, error) {
+	sqReq := new(SystemQueryReq)
+	sqReq.Ranks.Add(rank)
+	sqResp, err := SystemQuery(ctx, rpcClient, sqReq)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to look up host for rank")
+	}
+
+	for _, m := range sqResp.Members {
+		if m.Rank.Equals(rank) && m.Addr != nil {
+			return m.Addr.String(), nil
+		}
+	}
+
+	return "", errors.Errorf("rank %d not found in system", rank)
+}
+
+// isResumableStreamErr indicates whether a stream that failed with the given
+// error may be resumed.
+func isResumableStreamErr(err error) bool {
+	return IsConnErr(err) || status.Code(errors.Cause(err)) == codes.Unavailable
+}
+
+// LogStream streams lines from a log file on a single host to the supplied
+// handler. If the request does not specify a host, the host running the
+// requested rank is used.
+//
+// Each response carries the offset from which the stream may be resumed. If
+// the stream is interrupted, it is automatically resumed from the last offset
+// received, so that lines are neither lost nor repeated. The stream ends when
+// the end of the log file is reached, or when the context is canceled if the
+// request is following the log file.
+func LogStream(ctx context.Context, rpcClient Invoker, req *LogStreamReq, handler LogStreamHandler) error {
+	if req == nil {
+		return errors.New("nil request")
+	}
+	if handler == nil {
+		return errors.New("nil handler")
+	}
+
+	switch len(req.getHostList()) {
+	case 0:
+		if req.Control {
+			return errors.New("a host must be specified to stream the control plane log")
+		}
+		host, err := rankHost(ctx, rpcClient, req.Rank)
+		if err != nil {
+			return err
+		}
+		req.SetHostList([]string{host})
+	case 1:
+	default:
+		return errors.New("log stream request must be sent to a single host")
+	}
+	host := req.getHostList()[0]
+
+	sys := req.getSystem(rpcClient)
+	req.rpc = func(ctx context.Context, conn *grpc.ClientConn) (streamRecvFn, error) {
+		pbReq := &ctlpb.LogStreamReq{
+			Sys:            sys,
+			Rank:           req.Rank.Uint32(),
+			Control:        req.Control,
+			Level:          req.Level,
+			Facilities:     req.Facilities,
+			Offset:         req.Offset,
+			Tail:           req.Tail,
+			Follow:         req.Follow,
+			MaxLinesPerSec: req.MaxLinesPerSec,
+		}
+		rpcClient.Debugf("DAOS log stream request: %+v", pbReq)
+
+		stream, err := ctlpb.NewCtlSvcClient(conn).LogStream(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+		return func() (proto.Message, error) {
+			return stream.Recv()
+		}, nil
+	}
+
+	var retries int
+	for {
+		err := rpcClient.InvokeStreamRPC(ctx, req, func(msg proto.Message) error {
+			pbResp, ok := msg.(*ctlpb.LogStreamResp)
+			if !ok {
+				return errors.Errorf("unexpected log stream response type %T", msg)
+			}
+			retries = 0
+
+			// Resume from the last line received if interrupted.
+			req.Offset = pbResp.Offset
+			req.Tail = 0
+
+			return handler(&LogStreamResp{
+				Host:   host,
+				Path:   pbResp.Path,
+				Lines:  pbResp.Lines,
+				Offset: pbResp.Offset,
+			})
+		})
+		if err == nil || !isResumableStreamErr(err) || retries >= logStreamMaxRetries {
+			return err
+		}
+		retries++
+
+		rpcClient.Debugf("log stream from %s interrupted (%s); resuming from offset %d",
+			host, err, req.Offset)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(logStreamRetryInterval):
+		}
+	}
+}
+
+// PoolDebugReq contains the inputs for a request to run the offline VOS
+// debugger against the shards of a pool held by a stopped engine.
+type PoolDebugReq struct {
+	streamRequest
+	ID        string        `json:"id"`
+	Rank      ranklist.Rank `json:"rank"`
+	Targets   []uint32      `json:"targets"`
+	Command   string        `json:"command"`
+	WriteMode bool          `json:"write_mode"`
+}
+
+// PoolDebugResp contains a batch of debugger output for a pool shard.
+type PoolDebugResp struct {
+	Host     string   `json:"host"`
+	Target   uint32   `json:"target"`
+	Path     string   `json:"path"`
+	Lines    []string `json:"lines"`
+	Done     bool     `json:"done"`
+	ExitCode int32    `json:"exit_code"`
+}
+
+// PoolDebugHandler defines the function signature for a callback which is
+// invoked for each batch of debugger output received.
+type PoolDebugHandler func(*PoolDebugResp) error
+
+// poolDebugUUID returns the UUID of the pool identified by label or UUID. The
+// pool service may be unavailable, so labels are resolved from the MS.
+func poolDebugUUID(ctx context.Context, rpcClient UnaryInvoker, id string) (string, error) {
+	if _, err := uuid.Parse(id); err == nil {
+		return id, nil
+	}
+
+	lpResp, err := ListPools(ctx, rpcClient, &ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to resolve pool label")
+	}
+	for _, p := range lpResp.Pools {
+		if p.Label == id {
+			return p.UUID, nil
+		}
+	}
+
+	return "", errors.Errorf("pool %q not found", id)
+}
+
+// PoolDebug runs the offline VOS debugger against the shards of a pool held by
+// the engine running the requested rank, which must be stopped, and streams the
+// debugger output to the supplied handler. The server prevents the engine
+// from being started until the debugger has finished.
+//
+// As the debugger may modify the pool shards, an interrupted stream is not
+// resumed.
+func PoolDebug(ctx context.Context, rpcClient Invoker, req *PoolDebugReq, handler PoolDebugHandler) error {
+	if req == nil {
+		return errors.New("nil request")
+	}
+	if handler == nil {
+		return errors.New("nil handler")
+	}
+	if req.Command == "" {
+		return errors.New("no debugger command specified")
+	}
+
+	poolUUID, err := poolDebugUUID(ctx, rpcClient, req.ID)
+	if err != nil {
+		return err
+	}
+
+	switch len(req.getHostList()) {
+	case 0:
+		host, err := rankHost(ctx, rpcClient, req.Rank)
+		if err != nil {
+			return err
+		}
+		req.SetHostList([]string{host})
+	case 1:
+	default:
+		return errors.New("pool debug request must be sent to a single host")
+	}
+	host := req.getHostList()[0]
+
+	pbReq := &ctlpb.PoolDebugReq{
+		Sys:       req.getSystem(rpcClient),
+		PoolUuid:  poolUUID,
+		Rank:      req.Rank.Uint32(),
+		Targets:   req.Targets,
+		Command:   req.Command,
+		WriteMode: req.WriteMode,
+	}
+	req.rpc = func(ctx context.Context, conn *grpc.ClientConn) (streamRecvFn, error) {
+		rpcClient.Debugf("DAOS pool debug request: %+v", pbReq)
+
+		stream, err := ctlpb.NewCtlSvcClient(conn).PoolDebug(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+		return func() (proto.Message, error) {
+			return stream.Recv()
+		}, nil
+	}
+
+	return rpcClient.InvokeStreamRPC(ctx, req, func(msg proto.Message) error {
+		pbResp, ok := msg.(*ctlpb.PoolDebugResp)
+		if !ok {
+			return errors.Errorf("unexpected pool debug response type %T", msg)
+		}
+
+		return handler(&PoolDebugResp{
+			Host:     host,
+			Target:   pbResp.Target,
+			Path:     pbResp.Path,
+			Lines:    pbResp.Lines,
+			Done:     pbResp.Done,
+			ExitCode: pbResp.ExitCode,
+		})
+	})
+}
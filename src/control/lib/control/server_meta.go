@@ -9,6 +9,7 @@ package control
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -33,6 +34,7 @@ const (
 	LedCheckOp
 	LedBlinkOp
 	LedResetOp
+	LedSetOp
 )
 
 type (
@@ -49,8 +51,9 @@ type (
 
 	// SmdInfo encapsulates SMD-specific information.
 	SmdInfo struct {
-		Devices []*storage.SmdDevice `hash:"set" json:"devices"`
-		Pools   SmdPoolMap           `json:"pools"`
+		Devices       []*storage.SmdDevice `hash:"set" json:"devices"`
+		Pools         SmdPoolMap           `json:"pools"`
+		NextPageToken string               `json:"next_page_token,omitempty"`
 	}
 
 	// SmdQueryReq contains the request parameters for a SMD query operation.
@@ -62,7 +65,10 @@ type (
 		UUID             string        `json:"uuid"`
 		Rank             ranklist.Rank `json:"rank"`
 		Target           string        `json:"target"`
-		FaultyDevsOnly   bool          `json:"-"` // only show faulty devices
+		PageSize         uint32        `json:"page_size"`  // max devices per host (0 for all)
+		PageToken        string        `json:"page_token"` // token from a previous response
+		Fields           []string      `json:"fields"`     // device fields to return
+		FaultyDevsOnly   bool          `json:"-"`          // only show faulty devices
 	}
 
 	// SmdManageReq contains the request parameters for a SMD query operation.
@@ -70,9 +76,10 @@ type (
 		unaryRequest
 		IDs             string // comma separated list of IDs
 		Rank            ranklist.Rank
-		ReplaceUUID     string // For device replacement, UUID of new device
-		ReplaceNoReint  bool   // For device replacement, indicate no reintegration
-		IdentifyTimeout uint32 // For LED identify, blink duration in minutes
+		ReplaceUUID     string           // For device replacement, UUID of new device
+		ReplaceNoReint  bool             // For device replacement, indicate no reintegration
+		IdentifyTimeout uint32           // For LED identify, blink duration in minutes
+		LedState        storage.LedState // For LED set, state to apply
 		Operation       SmdManageOpcode
 	}
 
@@ -94,6 +101,55 @@ func (si *SmdInfo) addRankPools(rank ranklist.Rank, pools []*SmdPool) {
 	}
 }
 
+// DevicePools returns the sorted UUIDs of pools that have targets on the given
+// device, i.e. the pools that would be impacted by the loss of that device.
+func (si *SmdInfo) DevicePools(dev *storage.SmdDevice) []string {
+	if si == nil || dev == nil {
+		return nil
+	}
+
+	uuids := make([]string, 0, len(si.Pools))
+	for uuid, rankPools := range si.Pools {
+		for _, pool := range rankPools {
+			if pool.Rank == dev.Rank && targetsOverlap(pool.TargetIDs, dev.TargetIDs) {
+				uuids = append(uuids, uuid)
+				break
+			}
+		}
+	}
+	sort.Strings(uuids)
+
+	return uuids
+}
+
+// PoolDevices returns the devices that back the targets of the given per-rank
+// pool component.
+func (si *SmdInfo) PoolDevices(pool *SmdPool) []*storage.SmdDevice {
+	if si == nil || pool == nil {
+		return nil
+	}
+
+	var devs []*storage.SmdDevice
+	for _, dev := range si.Devices {
+		if dev.Rank == pool.Rank && targetsOverlap(pool.TargetIDs, dev.TargetIDs) {
+			devs = append(devs, dev)
+		}
+	}
+
+	return devs
+}
+
+func targetsOverlap(a, b []int32) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (si *SmdInfo) String() string {
 	return fmt.Sprintf("[Devices: %v, Pools: %v]", si.Devices, si.Pools)
 }
@@ -106,7 +162,8 @@ func (sr *SmdResp) addHostQueryResponse(hr *HostResponse, faultyOnly bool) error
 
 	hs := &HostStorage{
 		SmdInfo: &SmdInfo{
-			Pools: make(SmdPoolMap),
+			Pools:         make(SmdPoolMap),
+			NextPageToken: pbResp.GetNextPageToken(),
 		},
 	}
 	for _, rResp := range pbResp.GetRanks() {
@@ -172,7 +229,29 @@ func SmdQuery(ctx context.Context, rpcClient UnaryInvoker, req *SmdQueryReq) (*S
 		return nil, errors.Wrap(err, "unable to convert request to protobuf")
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return ctlpb.NewCtlSvcClient(conn).SmdQuery(ctx, pbReq)
+		// The response is streamed in chunks so that hosts with many
+		// devices or pools don't exceed the gRPC message size limit.
+		resp := new(ctlpb.SmdQueryResp)
+		fallback, err := invokeChunked(ctx, conn,
+			func(ctx context.Context, conn *grpc.ClientConn) (streamRecvFn, error) {
+				stream, err := ctlpb.NewCtlSvcClient(conn).SmdQueryStream(ctx, pbReq)
+				if err != nil {
+					return nil, err
+				}
+				return func() (proto.Message, error) {
+					return stream.Recv()
+				}, nil
+			},
+			func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return ctlpb.NewCtlSvcClient(conn).SmdQuery(ctx, pbReq)
+			},
+			func(msg proto.Message) error {
+				return mergeSmdQueryChunk(resp, msg)
+			})
+		if err != nil || fallback != nil {
+			return fallback, err
+		}
+		return resp, nil
 	})
 
 	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
@@ -314,6 +393,23 @@ func packPBSmdManageReq(req *SmdManageReq, pbReq *ctlpb.SmdManageReq) error {
 				LedAction: ctlpb.LedAction_RESET,
 			},
 		}
+	case LedSetOp:
+		switch req.LedState {
+		case storage.LedStateNormal, storage.LedStateIdentify, storage.LedStateFaulty:
+		default:
+			return errors.Errorf("LED state %s can not be set", req.LedState)
+		}
+		if req.IdentifyTimeout != 0 && req.LedState != storage.LedStateIdentify {
+			return errors.New("LED timeout only applies to identify state")
+		}
+		pbReq.Op = &ctlpb.SmdManageReq_Led{
+			Led: &ctlpb.LedManageReq{
+				Ids:             req.IDs,
+				LedState:        ctlpb.LedState(req.LedState),
+				LedAction:       ctlpb.LedAction_SET,
+				LedDurationMins: req.IdentifyTimeout,
+			},
+		}
 	default:
 		return errors.New("smd manage called but unrecognized operation requested")
 	}
@@ -378,3 +474,130 @@ func SmdManage(ctx context.Context, rpcClient UnaryInvoker, req *SmdManageReq) (
 
 	return sr, nil
 }
+
+type (
+	// BlobstoreTargetUsage describes the blobstore clusters allocated to a
+	// single VOS target.
+	BlobstoreTargetUsage struct {
+		TargetID     int32  `json:"tgt_id"`
+		UsedClusters uint64 `json:"used_clusters"`
+		Blobs        uint32 `json:"blobs"`
+	}
+
+	// BlobstoreUsage describes the cluster allocation of a SPDK blobstore
+	// on a rank.
+	BlobstoreUsage struct {
+		Rank          ranklist.Rank           `json:"rank"`
+		DevUUID       string                  `json:"dev_uuid"`
+		ClusterSize   uint64                  `json:"cluster_size"`
+		TotalClusters uint64                  `json:"total_clusters"`
+		FreeClusters  uint64                  `json:"free_clusters"`
+		Targets       []*BlobstoreTargetUsage `json:"targets"`
+	}
+
+	// BlobstoreQueryReq contains the request parameters for a blobstore
+	// usage query.
+	BlobstoreQueryReq struct {
+		unaryRequest
+	}
+
+	// BlobstoreQueryResp contains the per-host results of a blobstore
+	// usage query.
+	BlobstoreQueryResp struct {
+		HostErrorsResp
+		HostBlobstores map[string][]*BlobstoreUsage `json:"host_blobstores"`
+	}
+)
+
+// UsedClusters returns the number of allocated clusters in the blobstore.
+func (bu *BlobstoreUsage) UsedClusters() uint64 {
+	if bu.FreeClusters > bu.TotalClusters {
+		return 0
+	}
+	return bu.TotalClusters - bu.FreeClusters
+}
+
+// UntrackedClusters returns the number of allocated clusters in the blobstore
+// that are not accounted for by any VOS target, e.g. blobstore metadata.
+func (bu *BlobstoreUsage) UntrackedClusters() uint64 {
+	var tgtUsed uint64
+	for _, tu := range bu.Targets {
+		tgtUsed += tu.UsedClusters
+	}
+	if tgtUsed > bu.UsedClusters() {
+		return 0
+	}
+	return bu.UsedClusters() - tgtUsed
+}
+
+// Hosts returns the sorted addresses of the hosts in the response.
+func (bqr *BlobstoreQueryResp) Hosts() []string {
+	hosts := make([]string, 0, len(bqr.HostBlobstores))
+	for host := range bqr.HostBlobstores {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+func (bqr *BlobstoreQueryResp) addHostResponse(hr *HostResponse) error {
+	pbResp, ok := hr.Message.(*ctlpb.BlobstoreQueryResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	var usage []*BlobstoreUsage
+	for _, rResp := range pbResp.GetRanks() {
+		var rUsage []*BlobstoreUsage
+		if err := convert.Types(rResp.GetBlobstores(), &rUsage); err != nil {
+			return errors.Wrapf(err, "converting %T to %T", rResp.Blobstores, &rUsage)
+		}
+		for _, bu := range rUsage {
+			bu.Rank = ranklist.Rank(rResp.Rank)
+		}
+		usage = append(usage, rUsage...)
+	}
+
+	if bqr.HostBlobstores == nil {
+		bqr.HostBlobstores = make(map[string][]*BlobstoreUsage)
+	}
+	bqr.HostBlobstores[hr.Addr] = usage
+
+	return nil
+}
+
+// BlobstoreQuery concurrently retrieves per-target SPDK blobstore usage
+// from all hosts supplied in the request's hostlist, or all configured hosts
+// if not explicitly specified. The function blocks until all results
+// (successful or otherwise) are received, and returns a single response
+// structure containing results for all hosts.
+func BlobstoreQuery(ctx context.Context, rpcClient UnaryInvoker, req *BlobstoreQueryReq) (*BlobstoreQueryResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).BlobstoreQuery(ctx, new(ctlpb.BlobstoreQueryReq))
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	bqr := new(BlobstoreQueryResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := bqr.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := bqr.addHostResponse(hostResp); err != nil {
+			return nil, err
+		}
+	}
+
+	return bqr, nil
+}
@@ -401,6 +401,64 @@ func TestControl_SmdQuery(t *testing.T) {
 	}
 }
 
+func TestControl_SmdInfo_DevicePools(t *testing.T) {
+	devs := []*storage.SmdDevice{
+		{UUID: test.MockUUID(0), Rank: 0, TargetIDs: []int32{0, 1}},
+		{UUID: test.MockUUID(1), Rank: 0, TargetIDs: []int32{2, 3}},
+		{UUID: test.MockUUID(2), Rank: 1, TargetIDs: []int32{0, 1}},
+	}
+	si := &SmdInfo{
+		Devices: devs,
+		Pools: SmdPoolMap{
+			test.MockUUID(5): {
+				{UUID: test.MockUUID(5), Rank: 0, TargetIDs: []int32{0, 1, 2, 3}},
+				{UUID: test.MockUUID(5), Rank: 1, TargetIDs: []int32{0, 1}},
+			},
+			test.MockUUID(4): {
+				{UUID: test.MockUUID(4), Rank: 0, TargetIDs: []int32{3}},
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		dev      *storage.SmdDevice
+		expPools []string
+	}{
+		"nil device": {},
+		"all pools": {
+			dev:      devs[1],
+			expPools: []string{test.MockUUID(4), test.MockUUID(5)},
+		},
+		"one pool": {
+			dev:      devs[0],
+			expPools: []string{test.MockUUID(5)},
+		},
+		"other rank": {
+			dev:      devs[2],
+			expPools: []string{test.MockUUID(5)},
+		},
+		"no pools": {
+			dev:      &storage.SmdDevice{Rank: 2, TargetIDs: []int32{0}},
+			expPools: []string{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotPools := si.DevicePools(tc.dev)
+			if diff := cmp.Diff(tc.expPools, gotPools); diff != "" {
+				t.Fatalf("unexpected pools (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+
+	gotDevs := si.PoolDevices(si.Pools[test.MockUUID(5)][0])
+	if diff := cmp.Diff(devs[:2], gotDevs); diff != "" {
+		t.Fatalf("unexpected devices (-want, +got):\n%s\n", diff)
+	}
+	if gotDevs := si.PoolDevices(si.Pools[test.MockUUID(4)][0]); len(gotDevs) != 1 || gotDevs[0] != devs[1] {
+		t.Fatalf("unexpected devices %v", gotDevs)
+	}
+}
+
 func TestControl_packPBSmdManageReq(t *testing.T) {
 	for name, tc := range map[string]struct {
 		req      *SmdManageReq
@@ -530,6 +588,57 @@ func TestControl_packPBSmdManageReq(t *testing.T) {
 				},
 			},
 		},
+		"led-manage; set fault": {
+			req: &SmdManageReq{
+				Operation: LedSetOp,
+				IDs:       test.MockPCIAddr(1),
+				LedState:  storage.LedStateFaulty,
+			},
+			expPBReq: &ctlpb.SmdManageReq{
+				Op: &ctlpb.SmdManageReq_Led{
+					Led: &ctlpb.LedManageReq{
+						Ids:       test.MockPCIAddr(1),
+						LedState:  ctlpb.LedState_ON,
+						LedAction: ctlpb.LedAction_SET,
+					},
+				},
+			},
+		},
+		"led-manage; set identify with timeout": {
+			req: &SmdManageReq{
+				Operation:       LedSetOp,
+				IDs:             test.MockPCIAddr(1),
+				LedState:        storage.LedStateIdentify,
+				IdentifyTimeout: 5,
+			},
+			expPBReq: &ctlpb.SmdManageReq{
+				Op: &ctlpb.SmdManageReq_Led{
+					Led: &ctlpb.LedManageReq{
+						Ids:             test.MockPCIAddr(1),
+						LedState:        ctlpb.LedState_QUICK_BLINK,
+						LedAction:       ctlpb.LedAction_SET,
+						LedDurationMins: 5,
+					},
+				},
+			},
+		},
+		"led-manage; set off with timeout": {
+			req: &SmdManageReq{
+				Operation:       LedSetOp,
+				IDs:             test.MockPCIAddr(1),
+				LedState:        storage.LedStateNormal,
+				IdentifyTimeout: 5,
+			},
+			expErr: errors.New("timeout only applies"),
+		},
+		"led-manage; set unsupported state": {
+			req: &SmdManageReq{
+				Operation: LedSetOp,
+				IDs:       test.MockPCIAddr(1),
+				LedState:  storage.LedStateUnknown,
+			},
+			expErr: errors.New("can not be set"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			pbReq := new(ctlpb.SmdManageReq)
@@ -726,3 +835,185 @@ func TestControl_SmdManage(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_BlobstoreQuery(t *testing.T) {
+	pbUsage := func(idx int32) *ctlpb.BlobstoreUsage {
+		return &ctlpb.BlobstoreUsage{
+			DevUuid:       test.MockUUID(idx),
+			ClusterSize:   1 << 30,
+			TotalClusters: 1024,
+			FreeClusters:  512,
+			Targets: []*ctlpb.BlobstoreUsage_Target{
+				{TgtId: 0, UsedClusters: 200, Blobs: 2},
+				{TgtId: 1, UsedClusters: 300, Blobs: 3},
+			},
+		}
+	}
+	expUsage := func(rank uint32, idx int32) *BlobstoreUsage {
+		return &BlobstoreUsage{
+			Rank:          ranklist.Rank(rank),
+			DevUUID:       test.MockUUID(idx),
+			ClusterSize:   1 << 30,
+			TotalClusters: 1024,
+			FreeClusters:  512,
+			Targets: []*BlobstoreTargetUsage{
+				{TargetID: 0, UsedClusters: 200, Blobs: 2},
+				{TargetID: 1, UsedClusters: 300, Blobs: 3},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *BlobstoreQueryReq
+		expResp *BlobstoreQueryResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"local failure": {
+			req: &BlobstoreQueryReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &BlobstoreQueryReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:  "host1",
+							Error: errors.New("remote failed"),
+						},
+					},
+				},
+			},
+			expResp: &BlobstoreQueryResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "remote failed"}),
+			},
+		},
+		"two hosts": {
+			req: &BlobstoreQueryReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.BlobstoreQueryResp{
+								Ranks: []*ctlpb.BlobstoreQueryResp_RankResp{
+									{
+										Rank:       0,
+										Blobstores: []*ctlpb.BlobstoreUsage{pbUsage(0)},
+									},
+									{
+										Rank:       1,
+										Blobstores: []*ctlpb.BlobstoreUsage{pbUsage(1)},
+									},
+								},
+							},
+						},
+						{
+							Addr: "host2",
+							Message: &ctlpb.BlobstoreQueryResp{
+								Ranks: []*ctlpb.BlobstoreQueryResp_RankResp{
+									{
+										Rank:       2,
+										Blobstores: []*ctlpb.BlobstoreUsage{pbUsage(2)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expResp: &BlobstoreQueryResp{
+				HostBlobstores: map[string][]*BlobstoreUsage{
+					"host1": {expUsage(0, 0), expUsage(1, 1)},
+					"host2": {expUsage(2, 2)},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			gotResp, gotErr := BlobstoreQuery(context.TODO(), NewMockInvoker(log, mic), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected resp (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_BlobstoreUsage(t *testing.T) {
+	for name, tc := range map[string]struct {
+		usage        *BlobstoreUsage
+		expUsed      uint64
+		expUntracked uint64
+	}{
+		"empty": {
+			usage: &BlobstoreUsage{},
+		},
+		"no free clusters": {
+			usage: &BlobstoreUsage{
+				TotalClusters: 100,
+				Targets: []*BlobstoreTargetUsage{
+					{UsedClusters: 60},
+					{UsedClusters: 30},
+				},
+			},
+			expUsed:      100,
+			expUntracked: 10,
+		},
+		"free clusters": {
+			usage: &BlobstoreUsage{
+				TotalClusters: 100,
+				FreeClusters:  40,
+				Targets: []*BlobstoreTargetUsage{
+					{UsedClusters: 60},
+				},
+			},
+			expUsed: 60,
+		},
+		"untracked clusters": {
+			usage: &BlobstoreUsage{
+				TotalClusters: 100,
+				FreeClusters:  40,
+				Targets: []*BlobstoreTargetUsage{
+					{UsedClusters: 50},
+				},
+			},
+			expUsed:      60,
+			expUntracked: 10,
+		},
+		"target usage exceeds blobstore usage": {
+			usage: &BlobstoreUsage{
+				TotalClusters: 100,
+				FreeClusters:  50,
+				Targets: []*BlobstoreTargetUsage{
+					{UsedClusters: 60},
+				},
+			},
+			expUsed: 50,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expUsed, tc.usage.UsedClusters(), "unexpected used clusters")
+			test.AssertEqual(t, tc.expUntracked, tc.usage.UntrackedClusters(), "unexpected untracked clusters")
+		})
+	}
+}
@@ -0,0 +1,465 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// mockRankHostResp returns the MS response to the system query used to look
+// up the host of a rank, with the given rank running on the given address.
+func mockRankHostResp(rank ranklist.Rank, addr string, state system.MemberState) *UnaryResponse {
+	return MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+		Members: []*mgmtpb.SystemMember{
+			{
+				Rank:  rank.Uint32(),
+				Uuid:  test.MockUUID(int32(rank)),
+				State: state.String(),
+				Addr:  addr,
+			},
+		},
+	})
+}
+
+// mockAbsentRankResp returns the MS response to the system query used to
+// look up the host of a rank, with the given rank absent from the system.
+func mockAbsentRankResp(rank ranklist.Rank) *UnaryResponse {
+	return MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+		Absentranks: rank.String(),
+	})
+}
+
+type (
+	logStreamResult struct {
+		resps []*ctlpb.LogStreamResp
+		err   error
+	}
+
+	logStreamCall struct {
+		host   string
+		offset int64
+		tail   uint32
+	}
+
+	// mockLogStreamInvoker returns a different set of responses for each
+	// stream invocation, and records the request at the time of each.
+	mockLogStreamInvoker struct {
+		*MockInvoker
+		results []logStreamResult
+		calls   []logStreamCall
+	}
+)
+
+func (mi *mockLogStreamInvoker) InvokeStreamRPC(ctx context.Context, sReq StreamRequest, handler StreamHandler) error {
+	req := sReq.(*LogStreamReq)
+	mi.calls = append(mi.calls, logStreamCall{
+		host:   req.getHostList()[0],
+		offset: req.Offset,
+		tail:   req.Tail,
+	})
+
+	if len(mi.calls) > len(mi.results) {
+		return errors.New("unexpected stream invocation")
+	}
+	result := mi.results[len(mi.calls)-1]
+	for _, resp := range result.resps {
+		if err := handler(resp); err != nil {
+			return err
+		}
+	}
+	return result.err
+}
+
+func TestControl_LogStream(t *testing.T) {
+	sqResp := mockRankHostResp(1, "10.0.0.1:10001", system.MemberStateJoined)
+	lineResp := func(offset int64, lines ...string) *ctlpb.LogStreamResp {
+		return &ctlpb.LogStreamResp{Path: "/tmp/engine.log", Lines: lines, Offset: offset}
+	}
+
+	for name, tc := range map[string]struct {
+		req      *LogStreamReq
+		uResp    *UnaryResponse
+		results  []logStreamResult
+		expCalls []logStreamCall
+		expLines []string
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"control log without host": {
+			req:    &LogStreamReq{Control: true},
+			expErr: errors.New("host must be specified"),
+		},
+		"multiple hosts": {
+			req: func() *LogStreamReq {
+				req := &LogStreamReq{}
+				req.SetHostList([]string{"host1", "host2"})
+				return req
+			}(),
+			expErr: errors.New("single host"),
+		},
+		"rank not found": {
+			req:    &LogStreamReq{Rank: 2},
+			uResp:  mockAbsentRankResp(2),
+			expErr: errors.New("rank 2 not found"),
+		},
+		"rank lookup fails": {
+			req:    &LogStreamReq{Rank: 1},
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"stream from rank host": {
+			req:   &LogStreamReq{Rank: 1, Tail: 10},
+			uResp: sqResp,
+			results: []logStreamResult{
+				{resps: []*ctlpb.LogStreamResp{lineResp(10, "a", "b"), lineResp(20, "c")}},
+			},
+			expCalls: []logStreamCall{{host: "10.0.0.1:10001", tail: 10}},
+			expLines: []string{"a", "b", "c"},
+		},
+		"resumed after connection lost": {
+			req: func() *LogStreamReq {
+				req := &LogStreamReq{Control: true, Tail: 10, Follow: true}
+				req.SetHostList([]string{"host1:10001"})
+				return req
+			}(),
+			results: []logStreamResult{
+				{
+					resps: []*ctlpb.LogStreamResp{lineResp(10, "a")},
+					err:   FaultConnectionClosed("host1:10001"),
+				},
+				{resps: []*ctlpb.LogStreamResp{lineResp(20, "b")}},
+			},
+			expCalls: []logStreamCall{
+				{host: "host1:10001", tail: 10},
+				{host: "host1:10001", offset: 10},
+			},
+			expLines: []string{"a", "b"},
+		},
+		"server error not retried": {
+			req: func() *LogStreamReq {
+				req := &LogStreamReq{Rank: 1, Follow: true}
+				req.SetHostList([]string{"host1:10001"})
+				return req
+			}(),
+			results: []logStreamResult{
+				{err: errors.New("rank 1 not found on this host")},
+			},
+			expCalls: []logStreamCall{{host: "host1:10001"}},
+			expErr:   errors.New("not found on this host"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := &mockLogStreamInvoker{
+				MockInvoker: NewMockInvoker(log, &MockInvokerConfig{
+					UnaryResponse: tc.uResp,
+				}),
+				results: tc.results,
+			}
+
+			var gotLines []string
+			gotErr := LogStream(context.Background(), mi, tc.req, func(resp *LogStreamResp) error {
+				gotLines = append(gotLines, resp.Lines...)
+				return nil
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil && len(tc.expCalls) == 0 {
+				return
+			}
+
+			cmpOpts := []cmp.Option{cmp.AllowUnexported(logStreamCall{})}
+			if diff := cmp.Diff(tc.expCalls, mi.calls, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected stream calls (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expLines, gotLines); diff != "" {
+				t.Fatalf("unexpected lines (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SupportExec(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SupportExecReq
+		mic     *MockInvokerConfig
+		expResp *SupportExecResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no command": {
+			req:    &SupportExecReq{},
+			expErr: errors.New("no support command"),
+		},
+		"local failure": {
+			req: &SupportExecReq{Command: "dmesg"},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"mixed results": {
+			req: &SupportExecReq{Command: "dmesg"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:  "host1",
+							Error: errors.New("remote failed"),
+						},
+						{
+							Addr: "host2",
+							Message: &ctlpb.SupportExecResp{
+								Command:   "dmesg",
+								Output:    []byte("[    0.000000] Linux version\n"),
+								Truncated: true,
+							},
+						},
+						{
+							Addr: "host3",
+							Message: &ctlpb.SupportExecResp{
+								Command:  "dmesg",
+								ExitCode: 1,
+								Output:   []byte("dmesg: read kernel buffer failed\n"),
+							},
+						},
+					},
+				},
+			},
+			expResp: &SupportExecResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "remote failed"}),
+				Results: map[string]*SupportExecResult{
+					"host2": {
+						Command:   "dmesg",
+						Output:    "[    0.000000] Linux version\n",
+						Truncated: true,
+					},
+					"host3": {
+						Command:  "dmesg",
+						ExitCode: 1,
+						Output:   "dmesg: read kernel buffer failed\n",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			gotResp, gotErr := SupportExec(context.TODO(), NewMockInvoker(log, mic), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolDebug(t *testing.T) {
+	lpResp := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{Uuid: test.MockUUID(1), Label: "pool1"},
+		},
+	})
+	sqResp := mockRankHostResp(1, "10.0.0.1:10001", system.MemberStateStopped)
+	dbgResps := []proto.Message{
+		&ctlpb.PoolDebugResp{Target: 0, Path: "/mnt/daos/vos-0", Lines: []string{"a"}},
+		&ctlpb.PoolDebugResp{Target: 0, Path: "/mnt/daos/vos-0", Lines: []string{"b"}, Done: true},
+		&ctlpb.PoolDebugResp{Target: 1, Path: "/mnt/daos/vos-1", Done: true, ExitCode: 2},
+	}
+
+	for name, tc := range map[string]struct {
+		req      *PoolDebugReq
+		mic      *MockInvokerConfig
+		expResps []*PoolDebugResp
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no command": {
+			req:    &PoolDebugReq{ID: test.MockUUID(1)},
+			expErr: errors.New("no debugger command"),
+		},
+		"unknown label": {
+			req: &PoolDebugReq{ID: "pool2", Command: "ls"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{lpResp},
+			},
+			expErr: errors.New("not found"),
+		},
+		"multiple hosts": {
+			req: func() *PoolDebugReq {
+				req := &PoolDebugReq{ID: test.MockUUID(1), Command: "ls"}
+				req.SetHostList([]string{"host1", "host2"})
+				return req
+			}(),
+			expErr: errors.New("single host"),
+		},
+		"rank not found": {
+			req: &PoolDebugReq{ID: test.MockUUID(1), Rank: 2, Command: "ls"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{mockAbsentRankResp(2)},
+			},
+			expErr: errors.New("rank 2 not found"),
+		},
+		"label resolved on rank host": {
+			req: &PoolDebugReq{ID: "pool1", Rank: 1, Command: "ls"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{lpResp, sqResp},
+				StreamResponses:  dbgResps,
+			},
+			expResps: []*PoolDebugResp{
+				{Host: "10.0.0.1:10001", Path: "/mnt/daos/vos-0", Lines: []string{"a"}},
+				{Host: "10.0.0.1:10001", Path: "/mnt/daos/vos-0", Lines: []string{"b"}, Done: true},
+				{Host: "10.0.0.1:10001", Target: 1, Path: "/mnt/daos/vos-1", Done: true, ExitCode: 2},
+			},
+		},
+		"stream error": {
+			req: func() *PoolDebugReq {
+				req := &PoolDebugReq{ID: test.MockUUID(1), Rank: 1, Command: "ls"}
+				req.SetHostList([]string{"host1:10001"})
+				return req
+			}(),
+			mic: &MockInvokerConfig{
+				StreamError: errors.New("engine is running"),
+			},
+			expErr: errors.New("engine is running"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var gotResps []*PoolDebugResp
+			gotErr := PoolDebug(context.Background(), NewMockInvoker(log, tc.mic), tc.req,
+				func(resp *PoolDebugResp) error {
+					gotResps = append(gotResps, resp)
+					return nil
+				})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResps, gotResps); diff != "" {
+				t.Fatalf("unexpected responses (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_DumpEngineStacks(t *testing.T) {
+	sqResp := mockRankHostResp(1, "10.0.0.1:10001", system.MemberStateJoined)
+	dumpResp := func(hostErr error, msg *ctlpb.DumpEngineStacksResp) *UnaryResponse {
+		return &UnaryResponse{
+			Responses: []*HostResponse{
+				{Addr: "10.0.0.1:10001", Error: hostErr, Message: msg},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req     *DumpEngineStacksReq
+		uResps  []*UnaryResponse
+		expResp *DumpEngineStacksResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"multiple hosts": {
+			req: func() *DumpEngineStacksReq {
+				req := &DumpEngineStacksReq{}
+				req.SetHostList([]string{"host1", "host2"})
+				return req
+			}(),
+			expErr: errors.New("single host"),
+		},
+		"rank not found": {
+			req:    &DumpEngineStacksReq{Rank: 2},
+			uResps: []*UnaryResponse{mockAbsentRankResp(2)},
+			expErr: errors.New("rank 2 not found"),
+		},
+		"dump fails": {
+			req: &DumpEngineStacksReq{Rank: 1},
+			uResps: []*UnaryResponse{
+				sqResp,
+				dumpResp(errors.New("engine not started"), nil),
+			},
+			expErr: errors.New("rank 1 on 10.0.0.1:10001: engine not started"),
+		},
+		"success": {
+			req: &DumpEngineStacksReq{Rank: 1, ReturnOutput: true},
+			uResps: []*UnaryResponse{
+				sqResp,
+				dumpResp(nil, &ctlpb.DumpEngineStacksResp{
+					Path:      "/tmp/daos_dump_42.txt",
+					Offset:    10,
+					Size:      20,
+					Output:    "ULT stacks",
+					Truncated: true,
+				}),
+			},
+			expResp: &DumpEngineStacksResp{
+				Host:      "10.0.0.1:10001",
+				Rank:      1,
+				Path:      "/tmp/daos_dump_42.txt",
+				Offset:    10,
+				Size:      20,
+				Output:    "ULT stacks",
+				Truncated: true,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+
+			gotResp, gotErr := DumpEngineStacks(context.TODO(), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,9 +8,11 @@ package control
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/dustin/go-humanize"
 	"github.com/mitchellh/hashstructure/v2"
@@ -253,18 +255,41 @@ func (ssp *StorageScanResp) addHostResponse(hr *HostResponse) error {
 // NumaMeta option requests DAOS server meta data stored on SSDs.
 // NumaBasic option strips SSD details down to only the most basic.
 func StorageScan(ctx context.Context, rpcClient UnaryInvoker, req *StorageScanReq) (*StorageScanResp, error) {
+	pbReq := &ctlpb.StorageScanReq{
+		Scm: &ctlpb.ScanScmReq{
+			Usage: req.Usage,
+		},
+		Nvme: &ctlpb.ScanNvmeReq{
+			Health: req.NvmeHealth,
+			// NVMe meta option will populate usage statistics
+			Meta:  req.NvmeMeta || req.Usage,
+			Basic: req.NvmeBasic,
+		},
+	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return ctlpb.NewCtlSvcClient(conn).StorageScan(ctx, &ctlpb.StorageScanReq{
-			Scm: &ctlpb.ScanScmReq{
-				Usage: req.Usage,
+		// The response is streamed in chunks so that hosts with many
+		// devices don't exceed the gRPC message size limit.
+		resp := new(ctlpb.StorageScanResp)
+		fallback, err := invokeChunked(ctx, conn,
+			func(ctx context.Context, conn *grpc.ClientConn) (streamRecvFn, error) {
+				stream, err := ctlpb.NewCtlSvcClient(conn).StorageScanStream(ctx, pbReq)
+				if err != nil {
+					return nil, err
+				}
+				return func() (proto.Message, error) {
+					return stream.Recv()
+				}, nil
 			},
-			Nvme: &ctlpb.ScanNvmeReq{
-				Health: req.NvmeHealth,
-				// NVMe meta option will populate usage statistics
-				Meta:  req.NvmeMeta || req.Usage,
-				Basic: req.NvmeBasic,
+			func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return ctlpb.NewCtlSvcClient(conn).StorageScan(ctx, pbReq)
 			},
-		})
+			func(msg proto.Message) error {
+				return mergeStorageScanChunk(resp, msg)
+			})
+		if err != nil || fallback != nil {
+			return fallback, err
+		}
+		return resp, nil
 	})
 
 	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
@@ -294,6 +319,9 @@ type (
 	StorageFormatReq struct {
 		unaryRequest
 		Reformat bool
+		// DomainConcurrency, if nonzero, limits the number of hosts
+		// formatted at the same time within each fault domain.
+		DomainConcurrency int `json:"-"`
 	}
 
 	// StorageFormatResp contains the response from a storage format request.
@@ -419,41 +447,178 @@ func checkFormatReq(ctx context.Context, rpcClient UnaryInvoker, req *StorageFor
 	return nil
 }
 
+// addResponses adds the results of a format fan-out to the StorageFormatResp.
+func (sfr *StorageFormatResp) addResponses(ur *UnaryResponse) error {
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := sfr.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := sfr.addHostResponse(hostResp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func invokeFormat(ctx context.Context, rpcClient UnaryInvoker, req *StorageFormatReq) (*UnaryResponse, error) {
+	pbReq := new(ctlpb.StorageFormatReq)
+	if err := convert.Types(req, pbReq); err != nil {
+		return nil, err
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageFormat(ctx, pbReq)
+	})
+
+	return rpcClient.InvokeUnaryRPC(ctx, req)
+}
+
+// formatDomain holds the batches of hosts to be formatted, one batch after
+// another, within a single fault domain.
+type formatDomain struct {
+	domain  string
+	batches [][]string
+}
+
+// formatSchedule groups hosts by fault domain and splits the hosts in each
+// domain into batches of at most batchSize hosts. Domains and the hosts within
+// them are sorted so that the schedule is deterministic.
+func formatSchedule(domains map[string]string, batchSize int) []*formatDomain {
+	byDomain := make(map[string][]string)
+	for host, domain := range domains {
+		byDomain[domain] = append(byDomain[domain], host)
+	}
+
+	names := make([]string, 0, len(byDomain))
+	for name := range byDomain {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sched := make([]*formatDomain, 0, len(names))
+	for _, name := range names {
+		hosts := byDomain[name]
+		sort.Strings(hosts)
+
+		fd := &formatDomain{domain: name}
+		for len(hosts) > batchSize {
+			fd.batches = append(fd.batches, hosts[:batchSize])
+			hosts = hosts[batchSize:]
+		}
+		fd.batches = append(fd.batches, hosts)
+		sched = append(sched, fd)
+	}
+
+	return sched
+}
+
+// scheduledStorageFormat formats the hosts in the request's hostlist in
+// batches of bounded size within each fault domain. Fault domains are
+// formatted concurrently but the batches within a domain are formatted one
+// after another, limiting the load placed on infrastructure shared by the
+// hosts in a domain. Hosts whose fault domain cannot be determined are
+// reported as host errors and are not formatted.
+func scheduledStorageFormat(ctx context.Context, rpcClient UnaryInvoker, req *StorageFormatReq) (*StorageFormatResp, error) {
+	if len(req.getHostList()) == 0 {
+		return nil, errors.New("scheduling format by fault domain requires a host list")
+	}
+
+	fdReq := &FaultDomainQueryReq{}
+	fdReq.SetSystem(req.Sys)
+	fdReq.SetHostList(req.getHostList())
+	fdResp, err := FaultDomainQuery(ctx, rpcClient, fdReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying fault domains")
+	}
+
+	sfr := &StorageFormatResp{
+		HostErrorsResp: fdResp.HostErrorsResp,
+	}
+	sched := formatSchedule(fdResp.Domains, req.DomainConcurrency)
+
+	var mu sync.Mutex
+	var firstErr error
+	setErr := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, fd := range sched {
+		wg.Add(1)
+		go func(fd *formatDomain) {
+			defer wg.Done()
+
+			for i, batch := range fd.batches {
+				if ctx.Err() != nil {
+					setErr(ctx.Err())
+					return
+				}
+				rpcClient.Debugf("formatting batch %d/%d of fault domain %s: %s", i+1,
+					len(fd.batches), fd.domain, strings.Join(batch, ","))
+
+				batchReq := &StorageFormatReq{Reformat: req.Reformat}
+				batchReq.SetSystem(req.Sys)
+				batchReq.SetHostList(batch)
+				if req.getTimeout() > 0 {
+					batchReq.SetTimeout(req.getTimeout())
+				}
+				ur, err := invokeFormat(ctx, rpcClient, batchReq)
+				if err != nil {
+					setErr(err)
+					return
+				}
+
+				mu.Lock()
+				err = sfr.addResponses(ur)
+				mu.Unlock()
+				if err != nil {
+					setErr(err)
+					return
+				}
+			}
+		}(fd)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return sfr, nil
+}
+
 // StorageFormat concurrently performs storage preparation steps across
 // all hosts supplied in the request's hostlist, or all configured hosts
 // if not explicitly specified. The function blocks until all results
 // (successful or otherwise) are received, and returns a single response
 // structure containing results for all host storage prepare operations.
+//
+// If DomainConcurrency is set in the request, hosts are formatted in batches
+// of at most that size within each fault domain.
 func StorageFormat(ctx context.Context, rpcClient UnaryInvoker, req *StorageFormatReq) (*StorageFormatResp, error) {
 	if err := checkFormatReq(ctx, rpcClient, req); err != nil {
 		return nil, err
 	}
 
-	pbReq := new(ctlpb.StorageFormatReq)
-	if err := convert.Types(req, pbReq); err != nil {
-		return nil, err
+	if req.DomainConcurrency > 0 {
+		return scheduledStorageFormat(ctx, rpcClient, req)
 	}
-	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return ctlpb.NewCtlSvcClient(conn).StorageFormat(ctx, pbReq)
-	})
 
-	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	ur, err := invokeFormat(ctx, rpcClient, req)
 	if err != nil {
 		return nil, err
 	}
 
 	sfr := new(StorageFormatResp)
-	for _, hostResp := range ur.Responses {
-		if hostResp.Error != nil {
-			if err := sfr.addHostError(hostResp.Addr, hostResp.Error); err != nil {
-				return nil, err
-			}
-			continue
-		}
-
-		if err := sfr.addHostResponse(hostResp); err != nil {
-			return nil, err
-		}
+	if err := sfr.addResponses(ur); err != nil {
+		return nil, err
 	}
 
 	return sfr, nil
@@ -561,3 +726,77 @@ func StorageNvmeAddDevice(ctx context.Context, rpcClient UnaryInvoker, req *Nvme
 
 	return resp, nil
 }
+
+type (
+	// SpdkRpcReq contains the parameters for a storage spdk-rpc request.
+	SpdkRpcReq struct {
+		unaryRequest
+		EngineIndex uint32          `json:"engine_index"`
+		Method      string          `json:"method"`
+		Params      json.RawMessage `json:"params,omitempty"`
+	}
+
+	// SpdkRpcResp contains the raw results of a storage spdk-rpc request
+	// keyed by host address.
+	SpdkRpcResp struct {
+		HostErrorsResp
+		HostResults map[string]json.RawMessage `json:"host_results"`
+	}
+)
+
+// StorageSpdkRpc forwards a JSON-RPC request to the SPDK RPC server of an engine
+// on each host in the request hostlist and returns the raw results. Only
+// clients with a debug certificate are permitted to make this request.
+func StorageSpdkRpc(ctx context.Context, rpcClient UnaryInvoker, req *SpdkRpcReq) (*SpdkRpcResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if req.Method == "" {
+		return nil, errors.New("no SPDK RPC method specified")
+	}
+
+	pbReq := &ctlpb.SpdkRpcReq{
+		EngineIndex: req.EngineIndex,
+		Method:      req.Method,
+	}
+	if len(req.Params) > 0 {
+		if !json.Valid(req.Params) {
+			return nil, errors.New("SPDK RPC params are not valid JSON")
+		}
+		pbReq.Params = string(req.Params)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageSpdkRpc(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS SPDK RPC request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SpdkRpcResp{
+		HostResults: make(map[string]json.RawMessage),
+	}
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*ctlpb.SpdkRpcResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+		result := json.RawMessage(pbResp.GetResult())
+		if len(result) == 0 {
+			result = json.RawMessage("null")
+		}
+		resp.HostResults[hostResp.Addr] = result
+	}
+
+	rpcClient.Debugf("DAOS SPDK RPC response: %+v", resp)
+	return resp, nil
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,11 +8,16 @@ package control
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
@@ -733,6 +738,201 @@ func TestControl_StorageFormat(t *testing.T) {
 	}
 }
 
+func TestControl_formatSchedule(t *testing.T) {
+	for name, tc := range map[string]struct {
+		domains   map[string]string
+		batchSize int
+		expSched  []*formatDomain
+	}{
+		"no hosts": {
+			batchSize: 1,
+			expSched:  []*formatDomain{},
+		},
+		"batches within domains": {
+			domains: map[string]string{
+				"host1": "/rack1",
+				"host2": "/rack2",
+				"host3": "/rack1",
+				"host4": "/rack1",
+				"host5": "/rack2",
+			},
+			batchSize: 2,
+			expSched: []*formatDomain{
+				{
+					domain:  "/rack1",
+					batches: [][]string{{"host1", "host3"}, {"host4"}},
+				},
+				{
+					domain:  "/rack2",
+					batches: [][]string{{"host2", "host5"}},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotSched := formatSchedule(tc.domains, tc.batchSize)
+			if diff := cmp.Diff(tc.expSched, gotSched, cmp.AllowUnexported(formatDomain{})); diff != "" {
+				t.Fatalf("unexpected schedule (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+// formatScheduleInvoker answers the requests made by a fault domain scheduled
+// format, recording the largest number of hosts formatted at the same time in
+// each fault domain.
+type formatScheduleInvoker struct {
+	*MockInvoker
+	domains map[string]string
+
+	sync.Mutex
+	active    map[string]int
+	maxActive map[string]int
+	batches   [][]string
+}
+
+func (i *formatScheduleInvoker) InvokeUnaryRPC(ctx context.Context, req UnaryRequest) (*UnaryResponse, error) {
+	ur := new(UnaryResponse)
+
+	switch req.(type) {
+	case *SystemQueryReq:
+		return MockMSResponse("", errMSConnectionFailure, nil), nil
+	case *FaultDomainQueryReq:
+		for _, host := range req.getHostList() {
+			hr := &HostResponse{Addr: host}
+			if domain, found := i.domains[host]; found {
+				hr.Message = &ctlpb.FaultDomainQueryResp{FaultDomain: domain}
+			} else {
+				hr.Error = errors.New("unreachable")
+			}
+			ur.Responses = append(ur.Responses, hr)
+		}
+	case *StorageFormatReq:
+		hosts := req.getHostList()
+		domain := i.domains[hosts[0]]
+
+		i.Lock()
+		i.batches = append(i.batches, hosts)
+		i.active[domain] += len(hosts)
+		if i.active[domain] > i.maxActive[domain] {
+			i.maxActive[domain] = i.active[domain]
+		}
+		i.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		i.Lock()
+		i.active[domain] -= len(hosts)
+		i.Unlock()
+
+		for _, host := range hosts {
+			ur.Responses = append(ur.Responses, &HostResponse{
+				Addr: host,
+				Message: &ctlpb.StorageFormatResp{
+					Mrets: []*ctlpb.ScmMountResult{
+						{Mntpoint: "/mnt/1", State: &ctlpb.ResponseState{}},
+					},
+				},
+			})
+		}
+	default:
+		return nil, errors.Errorf("unexpected request %T", req)
+	}
+
+	return ur, nil
+}
+
+func TestControl_StorageFormat_DomainConcurrency(t *testing.T) {
+	domains := map[string]string{
+		"host1": "/rack1",
+		"host2": "/rack1",
+		"host3": "/rack1",
+		"host4": "/rack2",
+		"host5": "/rack2",
+	}
+
+	for name, tc := range map[string]struct {
+		hostList       []string
+		concurrency    int
+		expFormatted   []string
+		expErrHosts    []string
+		expMaxInDomain int
+		expBatches     int
+		expErr         error
+	}{
+		"no host list": {
+			concurrency: 1,
+			expErr:      errors.New("requires a host list"),
+		},
+		"one host at a time": {
+			hostList:       []string{"host1", "host2", "host3", "host4", "host5"},
+			concurrency:    1,
+			expFormatted:   []string{"host1", "host2", "host3", "host4", "host5"},
+			expMaxInDomain: 1,
+			expBatches:     5,
+		},
+		"two hosts at a time": {
+			hostList:       []string{"host1", "host2", "host3", "host4", "host5"},
+			concurrency:    2,
+			expFormatted:   []string{"host1", "host2", "host3", "host4", "host5"},
+			expMaxInDomain: 2,
+			expBatches:     3,
+		},
+		"unreachable host skipped": {
+			hostList:       []string{"host1", "host4", "host6"},
+			concurrency:    2,
+			expFormatted:   []string{"host1", "host4"},
+			expErrHosts:    []string{"host6"},
+			expMaxInDomain: 1,
+			expBatches:     2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := &formatScheduleInvoker{
+				MockInvoker: NewMockInvoker(log, &MockInvokerConfig{}),
+				domains:     domains,
+				active:      make(map[string]int),
+				maxActive:   make(map[string]int),
+			}
+
+			req := &StorageFormatReq{DomainConcurrency: tc.concurrency}
+			req.SetHostList(tc.hostList)
+			gotResp, gotErr := StorageFormat(context.TODO(), mi, req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotFormatted []string
+			for _, hss := range gotResp.HostStorage {
+				gotFormatted = append(gotFormatted, hss.HostSet.Slice()...)
+			}
+			sort.Strings(gotFormatted)
+			if diff := cmp.Diff(tc.expFormatted, gotFormatted); diff != "" {
+				t.Fatalf("unexpected formatted hosts (-want, +got):\n%s\n", diff)
+			}
+			var gotErrHosts []string
+			if errHosts := gotResp.HostErrors.ErroredHosts(); errHosts.Count() > 0 {
+				gotErrHosts = errHosts.Slice()
+			}
+			if diff := cmp.Diff(tc.expErrHosts, gotErrHosts, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("unexpected errored hosts (-want, +got):\n%s\n", diff)
+			}
+
+			test.AssertEqual(t, tc.expBatches, len(mi.batches), "unexpected number of batches")
+			for domain, gotMax := range mi.maxActive {
+				if gotMax > tc.expMaxInDomain {
+					t.Fatalf("%d hosts formatted at once in %s, want at most %d",
+						gotMax, domain, tc.expMaxInDomain)
+				}
+			}
+		})
+	}
+}
+
 func TestControl_checkFormatReq(t *testing.T) {
 	reqHosts := func(h ...string) []string {
 		return h
@@ -1003,3 +1203,100 @@ func TestControl_StorageNvmeAddDevice(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_StorageSpdkRpc(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic         *MockInvokerConfig
+		req         *SpdkRpcReq
+		expResponse *SpdkRpcResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"missing method": {
+			req:    &SpdkRpcReq{},
+			expErr: errors.New("no SPDK RPC method"),
+		},
+		"invalid params": {
+			req: &SpdkRpcReq{
+				Method: "bdev_get_bdevs",
+				Params: json.RawMessage(`{"name"`),
+			},
+			expErr: errors.New("not valid JSON"),
+		},
+		"invoke fails": {
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("failed"),
+			},
+			req:    &SpdkRpcReq{Method: "bdev_get_bdevs"},
+			expErr: errors.New("failed"),
+		},
+		"server error": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{
+						Responses: []*HostResponse{
+							{
+								Addr:  "host1",
+								Error: errors.New("failed"),
+							},
+						},
+					},
+				},
+			},
+			req: &SpdkRpcReq{Method: "bdev_get_bdevs"},
+			expResponse: &SpdkRpcResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "failed"}),
+				HostResults:    map[string]json.RawMessage{},
+			},
+		},
+		"success": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{
+						Responses: []*HostResponse{
+							{
+								Addr: "host1",
+								Message: &ctlpb.SpdkRpcResp{
+									Result: `[{"name":"Nvme0n1"}]`,
+								},
+							},
+							{
+								Addr:    "host2",
+								Message: &ctlpb.SpdkRpcResp{},
+							},
+						},
+					},
+				},
+			},
+			req: &SpdkRpcReq{
+				Method: "bdev_get_bdevs",
+				Params: json.RawMessage(`{"name":"Nvme0n1"}`),
+			},
+			expResponse: &SpdkRpcResp{
+				HostResults: map[string]json.RawMessage{
+					"host1": json.RawMessage(`[{"name":"Nvme0n1"}]`),
+					"host2": json.RawMessage("null"),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := StorageSpdkRpc(context.TODO(), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
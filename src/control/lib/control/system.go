@@ -11,12 +11,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/daos-stack/daos/src/control/build"
@@ -101,14 +104,16 @@ type SystemJoinReq struct {
 	unaryRequest
 	msRequest
 	retryableRequest
-	ControlAddr *net.TCPAddr
-	UUID        string
-	Rank        ranklist.Rank
-	URI         string
-	NumContexts uint32              `json:"Nctxs"`
-	FaultDomain *system.FaultDomain `json:"SrvFaultDomain"`
-	InstanceIdx uint32              `json:"Idx"`
-	Incarnation uint64              `json:"Incarnation"`
+	ControlAddr   *net.TCPAddr
+	UUID          string
+	Rank          ranklist.Rank
+	URI           string
+	NumContexts   uint32              `json:"Nctxs"`
+	FaultDomain   *system.FaultDomain `json:"SrvFaultDomain"`
+	InstanceIdx   uint32              `json:"Idx"`
+	Incarnation   uint64              `json:"Incarnation"`
+	SecondaryURIs []string            `json:"secondary_uris"`
+	NoRetry       bool                `json:"-"` // Return retryable errors to the caller instead of retrying.
 }
 
 // MarshalJSON packs SystemJoinResp struct into a JSON message.
@@ -132,6 +137,21 @@ type SystemJoinResp struct {
 	Rank      ranklist.Rank
 	State     system.MemberState
 	LocalJoin bool
+	Prime     *JoinPrimeData
+}
+
+// JoinPrimePool describes a pool service pushed to a newly joined rank.
+type JoinPrimePool struct {
+	UUID    string   `json:"uuid"`
+	Label   string   `json:"label"`
+	SvcReps []uint32 `json:"svc_reps"`
+}
+
+// JoinPrimeData contains the system state pushed by the MS to a newly joined
+// rank so that it can be cached locally rather than looked up lazily.
+type JoinPrimeData struct {
+	MapVersion uint32           `json:"map_version"`
+	Pools      []*JoinPrimePool `json:"pools"`
 }
 
 // SystemJoin will attempt to join a new member to the DAOS system.
@@ -146,12 +166,23 @@ func SystemJoin(ctx context.Context, rpcClient UnaryInvoker, req *SystemJoinReq)
 	})
 	req.SetTimeout(SystemJoinTimeout)
 	req.retryTimeout = SystemJoinRetryTimeout
+	var lastErr error
 	req.retryTestFn = func(err error, _ uint) bool {
 		switch {
-		case IsRetryableConnErr(err), system.IsNotReady(err):
+		case IsRetryableConnErr(err), system.IsNotReady(err), IsNoMSResponse(err):
+			lastErr = err
 			return true
 		}
-		return err == errNoMsResponse
+		return false
+	}
+	req.retryFn = func(_ context.Context, _ uint) error {
+		// In the case where the caller wants to implement its own
+		// retry behavior, hand the retryable error back instead of
+		// trying again.
+		if req.NoRetry {
+			return lastErr
+		}
+		return errNoRetryHandler
 	}
 	rpcClient.Debugf("DAOS system join request: %+v", pbReq)
 
@@ -170,19 +201,178 @@ func SystemJoin(ctx context.Context, rpcClient UnaryInvoker, req *SystemJoinReq)
 	return resp, nil
 }
 
+// JoinProgress describes a progress update received during a streaming system
+// join.
+type JoinProgress struct {
+	Stage string
+	Rank  ranklist.Rank
+	Info  string
+}
+
+// JoinProgressHandler defines the function signature for a callback which is
+// invoked for each progress update received during a streaming system join.
+type JoinProgressHandler func(*JoinProgress)
+
+type systemJoinStreamReq struct {
+	streamRequest
+}
+
+// SystemJoinStream will attempt to join a new member to the DAOS system using
+// the streaming join RPC on the MS leader, invoking the handler for each
+// progress update received. If the leader doesn't support streaming joins,
+// the request falls back to SystemJoin and its retry behavior. Any other
+// stream failure is returned to the caller.
+func SystemJoinStream(ctx context.Context, rpcClient Invoker, req *SystemJoinReq, handler JoinProgressHandler) (*SystemJoinResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	resp, err := systemJoinStream(ctx, rpcClient, req, handler)
+	if status.Code(err) != codes.Unimplemented {
+		return resp, err
+	}
+	rpcClient.Debugf("streaming system join not supported (%s); falling back to unary join", err)
+
+	return SystemJoin(ctx, rpcClient, req)
+}
+
+func systemJoinStream(ctx context.Context, rpcClient Invoker, req *SystemJoinReq, handler JoinProgressHandler) (*SystemJoinResp, error) {
+	leader, err := msLeaderAddr(ctx, rpcClient, req.getHostList(), req.Sys)
+	if err != nil {
+		return nil, err
+	}
+
+	pbReq := new(mgmtpb.JoinReq)
+	if err := convert.Types(req, pbReq); err != nil {
+		return nil, err
+	}
+	pbReq.Sys = req.getSystem(rpcClient)
+
+	sReq := new(systemJoinStreamReq)
+	sReq.SetHostList([]string{leader})
+	sReq.rpc = func(ctx context.Context, conn *grpc.ClientConn) (streamRecvFn, error) {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).JoinStream(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+		return func() (proto.Message, error) {
+			return stream.Recv()
+		}, nil
+	}
+	rpcClient.Debugf("DAOS system join stream request to %s: %+v", leader, pbReq)
+
+	ctx, cancel := context.WithTimeout(ctx, SystemJoinRetryTimeout)
+	defer cancel()
+
+	var pbResp *mgmtpb.JoinResp
+	if err := rpcClient.InvokeStreamRPC(ctx, sReq, func(msg proto.Message) error {
+		progress, ok := msg.(*mgmtpb.JoinProgress)
+		if !ok {
+			return errors.Errorf("unexpected join progress type %T", msg)
+		}
+		if progress.Stage == mgmtpb.JoinProgress_DONE {
+			pbResp = progress.Resp
+		}
+		if handler != nil {
+			handler(&JoinProgress{
+				Stage: progress.Stage.String(),
+				Rank:  ranklist.Rank(progress.Rank),
+				Info:  progress.Info,
+			})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if pbResp == nil {
+		return nil, errors.New("join stream ended without a response")
+	}
+
+	resp := new(SystemJoinResp)
+	if err := convert.Types(pbResp, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// EngineHeartbeat describes the liveness of a ranked engine as seen by its harness.
+type EngineHeartbeat struct {
+	Rank        ranklist.Rank
+	InstanceIdx uint32
+	Alive       bool
+	State       system.MemberState
+}
+
+// SystemHeartbeatReq contains the inputs for the system heartbeat request.
+type SystemHeartbeatReq struct {
+	unaryRequest
+	msRequest
+	retryableRequest
+	ControlAddr *net.TCPAddr
+	Engines     []*EngineHeartbeat
+}
+
+// SystemHeartbeat reports the liveness of the local harness and its engines to
+// the MS leader. Failed heartbeats are not retried, as the next periodic
+// heartbeat will supersede them.
+func SystemHeartbeat(ctx context.Context, rpcClient UnaryInvoker, req *SystemHeartbeatReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if req.ControlAddr == nil {
+		return errors.New("heartbeat request missing control address")
+	}
+
+	pbReq := &mgmtpb.HeartbeatReq{
+		Sys:  req.getSystem(rpcClient),
+		Addr: req.ControlAddr.String(),
+	}
+	for _, eh := range req.Engines {
+		pbReq.Engines = append(pbReq.Engines, &mgmtpb.EngineHeartbeat{
+			Rank:  eh.Rank.Uint32(),
+			Idx:   eh.InstanceIdx,
+			Alive: eh.Alive,
+			State: eh.State.String(),
+		})
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).Heartbeat(ctx, pbReq)
+	})
+	req.retryTestFn = func(err error, _ uint) bool {
+		// Intercept the MS startup case which is otherwise always
+		// retried, so that the heartbeat fails immediately.
+		return system.IsUnavailable(err)
+	}
+	req.retryFn = func(_ context.Context, _ uint) error {
+		return system.ErrRaftUnavail
+	}
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
 // SystemQueryReq contains the inputs for the system query request.
 type SystemQueryReq struct {
 	unaryRequest
 	msRequest
 	sysRequest
 	retryableRequest
-	FailOnUnavailable bool // Fail without retrying if the MS is unavailable.
+	FailOnUnavailable bool     // Fail without retrying if the MS is unavailable.
+	PageSize          uint32   // Maximum number of members to return (0 for all).
+	PageToken         string   // NextPageToken from a previous response.
+	Fields            []string // Member fields to return (empty for all).
 }
 
 // SystemQueryResp contains the request response.
 type SystemQueryResp struct {
 	sysResponse
-	Members system.Members `json:"members"`
+	Members       system.Members `json:"members"`
+	NextPageToken string         `json:"next_page_token,omitempty"`
 }
 
 // UnmarshalJSON unpacks JSON message into SystemQueryResp struct.
@@ -226,6 +416,9 @@ func SystemQuery(ctx context.Context, rpcClient UnaryInvoker, req *SystemQueryRe
 	pbReq.Hosts = req.Hosts.String()
 	pbReq.Ranks = req.Ranks.String()
 	pbReq.Sys = req.getSystem(rpcClient)
+	pbReq.PageSize = req.PageSize
+	pbReq.PageToken = req.PageToken
+	pbReq.Fields = req.Fields
 
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemQuery(ctx, pbReq)
@@ -349,10 +542,12 @@ type SystemStopReq struct {
 	Force bool
 }
 
-// SystemStopResp contains the request response.
+// SystemStopResp contains the request response. PrepRanks holds ranks that
+// completed prep shutdown but were not stopped.
 type SystemStopResp struct {
 	sysResponse
-	Results system.MemberResults
+	Results   system.MemberResults
+	PrepRanks ranklist.RankSet `json:"-"`
 }
 
 // UnmarshalJSON unpacks JSON message into SystemStopResp struct.
@@ -361,6 +556,7 @@ func (resp *SystemStopResp) UnmarshalJSON(data []byte) error {
 	aux := &struct {
 		AbsentHosts string
 		AbsentRanks string
+		PrepRanks   string
 		*Alias
 	}{
 		Alias: (*Alias)(resp),
@@ -371,6 +567,11 @@ func (resp *SystemStopResp) UnmarshalJSON(data []byte) error {
 	if err := resp.getAbsentHostsRanks(aux.AbsentHosts, aux.AbsentRanks); err != nil {
 		return err
 	}
+	prs, err := ranklist.CreateRankSet(aux.PrepRanks)
+	if err != nil {
+		return err
+	}
+	resp.PrepRanks.Replace(prs)
 
 	return nil
 }
@@ -487,6 +688,91 @@ func SystemExclude(ctx context.Context, rpcClient UnaryInvoker, req *SystemExclu
 	return resp, convertMSResponse(ur, resp)
 }
 
+// SystemSetMemberAliasReq contains the inputs for the request to set or clear
+// the display alias of a system member.
+type SystemSetMemberAliasReq struct {
+	unaryRequest
+	msRequest
+
+	Rank  ranklist.Rank
+	Alias string // empty to clear the alias
+}
+
+// SystemSetMemberAlias sets or clears the display alias of a system member.
+func SystemSetMemberAlias(ctx context.Context, rpcClient UnaryInvoker, req *SystemSetMemberAliasReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if req.Alias != "" {
+		if err := system.ValidateMemberAlias(req.Alias); err != nil {
+			return err
+		}
+	}
+
+	pbReq := &mgmtpb.SystemSetMemberAliasReq{
+		Sys:   req.getSystem(rpcClient),
+		Rank:  req.Rank.Uint32(),
+		Alias: req.Alias,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemSetMemberAlias(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemSetMemberAlias request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
+// SystemAnnotateReq contains the inputs for the request to set or remove
+// annotations on system members.
+type SystemAnnotateReq struct {
+	unaryRequest
+	msRequest
+
+	Ranks       *ranklist.RankSet
+	Annotations map[string]string // empty values remove annotations
+}
+
+// SystemAnnotate sets or removes annotations on system members. Annotations
+// are notes recorded by administrators that are reported by system query.
+func SystemAnnotate(ctx context.Context, rpcClient UnaryInvoker, req *SystemAnnotateReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if req.Ranks == nil || req.Ranks.Count() == 0 {
+		return errors.New("no ranks specified")
+	}
+	if len(req.Annotations) == 0 {
+		return errors.New("no annotations specified")
+	}
+	for k, v := range req.Annotations {
+		if err := system.ValidateAnnotation(k, v); err != nil {
+			return err
+		}
+	}
+
+	pbReq := &mgmtpb.SystemAnnotateReq{
+		Sys:         req.getSystem(rpcClient),
+		Ranks:       req.Ranks.String(),
+		Annotations: req.Annotations,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemAnnotate(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemAnnotate request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
 // SystemEraseReq contains the inputs for a system erase request.
 type SystemEraseReq struct {
 	msRequest
@@ -633,6 +919,24 @@ func LeaderQuery(ctx context.Context, rpcClient UnaryInvoker, req *LeaderQueryRe
 	return resp, convertMSResponse(ur, resp)
 }
 
+// msLeaderAddr returns the address of the current MS leader, as reported by
+// the MS replicas reachable through the given host list.
+func msLeaderAddr(ctx context.Context, rpcClient UnaryInvoker, hosts []string, sys string) (string, error) {
+	req := new(LeaderQueryReq)
+	req.SetHostList(hosts)
+	req.SetSystem(sys)
+
+	resp, err := LeaderQuery(ctx, rpcClient, req)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to find MS leader")
+	}
+	if resp.Leader == "" {
+		return "", errors.New("no MS leader")
+	}
+
+	return resp.Leader, nil
+}
+
 // RanksReq contains the parameters for a system ranks request.
 type RanksReq struct {
 	unaryRequest
@@ -1062,3 +1366,509 @@ func SystemGetProp(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetPr
 
 	return resp, nil
 }
+
+type (
+	// SystemDbVerifyReq contains the inputs for the system database verify request.
+	SystemDbVerifyReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemDbVerifyResp contains the results of a system database verification.
+	SystemDbVerifyResp struct {
+		Replica    string   `json:"replica"`
+		FirstIndex uint64   `json:"first_index"`
+		LastIndex  uint64   `json:"last_index"`
+		Entries    uint64   `json:"entries"`
+		Errors     []string `json:"errors"`
+		Warnings   []string `json:"warnings"`
+	}
+)
+
+// SystemDbVerify requests that the MS leader verify the integrity of its
+// system database journal and the consistency of the database state.
+func SystemDbVerify(ctx context.Context, rpcClient UnaryInvoker, req *SystemDbVerifyReq) (*SystemDbVerifyResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemDbVerifyReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemDbVerify(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemDbVerify request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemDbVerifyResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+type (
+	// SystemDbBackupReq contains the inputs for the system database backup request.
+	SystemDbBackupReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemDbBackupResp contains a portable backup of the system database.
+	SystemDbBackupResp struct {
+		Backup   []byte `json:"backup,omitempty"`
+		Members  uint32 `json:"members"`
+		Pools    uint32 `json:"pools"`
+		Checksum string `json:"checksum"`
+	}
+)
+
+// SystemDbBackup requests a portable, checksummed backup of the system
+// database from the MS leader. The returned backup may be stored and later
+// supplied to SystemDbRestore.
+func SystemDbBackup(ctx context.Context, rpcClient UnaryInvoker, req *SystemDbBackupReq) (*SystemDbBackupResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemDbBackupReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemDbBackup(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemDbBackup request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemDbBackupResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+type (
+	// SystemDbRestoreReq contains the inputs for the system database restore request.
+	SystemDbRestoreReq struct {
+		unaryRequest
+		msRequest
+		Backup []byte
+		Force  bool
+	}
+
+	// SystemDbRestoreResp contains the results of a system database restore.
+	SystemDbRestoreResp struct {
+		Members    uint32 `json:"members"`
+		Pools      uint32 `json:"pools"`
+		MapVersion uint32 `json:"map_version"`
+	}
+)
+
+// SystemDbRestore requests that the MS leader replace the contents of the
+// system database with the contents of the supplied backup.
+func SystemDbRestore(ctx context.Context, rpcClient UnaryInvoker, req *SystemDbRestoreReq) (*SystemDbRestoreResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if len(req.Backup) == 0 {
+		return nil, errors.New("empty system database backup")
+	}
+
+	pbReq := &mgmtpb.SystemDbRestoreReq{
+		Sys:    req.getSystem(rpcClient),
+		Backup: req.Backup,
+		Force:  req.Force,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemDbRestore(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemDbRestore request: %d byte backup (force: %t)", len(pbReq.Backup), pbReq.Force)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemDbRestoreResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+type (
+	// SystemDbStatusReq contains the inputs for the system database status
+	// request. If no hosts are specified, all MS replicas are queried.
+	SystemDbStatusReq struct {
+		unaryRequest
+	}
+
+	// SystemDbReplicaStatus contains the status of a system database
+	// replica.
+	SystemDbReplicaStatus struct {
+		Replica            string `json:"replica"`
+		State              string `json:"state"`
+		Leader             string `json:"leader"`
+		Term               uint64 `json:"term"`
+		LastLogIndex       uint64 `json:"last_log_index"`
+		CommitIndex        uint64 `json:"commit_index"`
+		AppliedIndex       uint64 `json:"applied_index"`
+		LastSnapshotIndex  uint64 `json:"last_snapshot_index"`
+		SnapshotSize       uint64 `json:"snapshot_size"`
+		LeaderChanges      uint64 `json:"leader_changes"`
+		Commits            uint64 `json:"commits"`
+		CommitLatencyAvgUs uint64 `json:"commit_latency_avg_us"`
+		CommitLatencyMaxUs uint64 `json:"commit_latency_max_us"`
+		LastContactMs      int64  `json:"last_contact_ms"`
+		// Lag is the number of log entries committed by the leader
+		// that have not yet been applied by the replica.
+		Lag uint64 `json:"lag"`
+	}
+
+	// SystemDbStatusResp contains the status of the system database
+	// replicas.
+	SystemDbStatusResp struct {
+		HostErrorsResp
+		Leader   string                   `json:"leader"`
+		Replicas []*SystemDbReplicaStatus `json:"replicas"`
+	}
+)
+
+// setLag calculates the replication lag of each replica relative to the
+// leader's commit index.
+func (resp *SystemDbStatusResp) setLag() {
+	var leader *SystemDbReplicaStatus
+	for _, rs := range resp.Replicas {
+		if rs.State == "Leader" {
+			leader = rs
+			break
+		}
+	}
+
+	if leader == nil {
+		for _, rs := range resp.Replicas {
+			if rs.Leader != "" {
+				resp.Leader = rs.Leader
+				break
+			}
+		}
+		return
+	}
+
+	resp.Leader = leader.Replica
+	for _, rs := range resp.Replicas {
+		if leader.CommitIndex > rs.AppliedIndex {
+			rs.Lag = leader.CommitIndex - rs.AppliedIndex
+		}
+	}
+}
+
+// SystemDbStatus requests the status of the system database from each of the
+// MS replicas, and calculates the replication lag of each replica.
+func SystemDbStatus(ctx context.Context, rpcClient UnaryInvoker, req *SystemDbStatusReq) (*SystemDbStatusResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	if len(req.getHostList()) == 0 {
+		lqResp, err := LeaderQuery(ctx, rpcClient, &LeaderQueryReq{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query MS replicas")
+		}
+		req.SetHostList(lqResp.Replicas)
+	}
+
+	pbReq := &mgmtpb.SystemDbStatusReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemDbStatus(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemDbStatus request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemDbStatusResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*mgmtpb.SystemDbStatusResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+
+		rs := new(SystemDbReplicaStatus)
+		if err := convert.Types(pbResp, rs); err != nil {
+			return nil, err
+		}
+		resp.Replicas = append(resp.Replicas, rs)
+	}
+
+	sort.Slice(resp.Replicas, func(i, j int) bool {
+		return resp.Replicas[i].Replica < resp.Replicas[j].Replica
+	})
+	resp.setLag()
+
+	return resp, nil
+}
+
+type (
+	// SystemReplicaReq contains the inputs for a request to add or remove
+	// a MS replica.
+	SystemReplicaReq struct {
+		unaryRequest
+		msRequest
+		Replica string
+	}
+
+	// SystemReplicaResp contains the set of MS replicas after a change.
+	SystemReplicaResp struct {
+		Replicas []string `json:"replicas"`
+	}
+)
+
+type replicaChange int
+
+const (
+	replicaAdd replicaChange = iota
+	replicaPromote
+	replicaRemove
+)
+
+func (rc replicaChange) String() string {
+	switch rc {
+	case replicaAdd:
+		return "add"
+	case replicaPromote:
+		return "promote"
+	case replicaRemove:
+		return "remove"
+	default:
+		return "unknown"
+	}
+}
+
+func systemReplicaChange(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq, change replicaChange) (*SystemReplicaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Replica == "" {
+		return nil, errors.New("no replica specified")
+	}
+
+	pbReq := &mgmtpb.SystemReplicaReq{
+		Sys:     req.getSystem(rpcClient),
+		Replica: req.Replica,
+		Promote: change == replicaPromote,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		if change == replicaRemove {
+			return mgmtpb.NewMgmtSvcClient(conn).SystemRemoveReplica(ctx, pbReq)
+		}
+		return mgmtpb.NewMgmtSvcClient(conn).SystemAddReplica(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system replica %s request: %s", change, pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemReplicaResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SystemAddReplica adds a control plane server to the set of MS replicas. The
+// server must be configured as a MS replica and must have joined the system.
+// The new replica does not vote until it is promoted with SystemPromoteReplica.
+func SystemAddReplica(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq) (*SystemReplicaResp, error) {
+	return systemReplicaChange(ctx, rpcClient, req, replicaAdd)
+}
+
+// SystemPromoteReplica promotes a MS replica added with SystemAddReplica to a
+// voter.
+func SystemPromoteReplica(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq) (*SystemReplicaResp, error) {
+	return systemReplicaChange(ctx, rpcClient, req, replicaPromote)
+}
+
+// SystemRemoveReplica removes a control plane server from the set of MS
+// replicas.
+func SystemRemoveReplica(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq) (*SystemReplicaResp, error) {
+	return systemReplicaChange(ctx, rpcClient, req, replicaRemove)
+}
+
+type (
+	// SystemSignCertReq contains the inputs for a request to the MS to sign
+	// the certificate request of an enrolling host.
+	SystemSignCertReq struct {
+		unaryRequest
+		msRequest
+		CSR []byte // PEM-encoded certificate signing request
+	}
+
+	// SystemSignCertResp contains the certificate issued by the MS.
+	SystemSignCertResp struct {
+		Cert []byte `json:"cert"` // PEM-encoded certificate
+	}
+)
+
+// SystemSignCert requests that the MS signs a certificate request for a host
+// that is enrolling in the system. The MS must have been configured with a CA
+// key, which is only supported when transport security is disabled.
+func SystemSignCert(ctx context.Context, rpcClient UnaryInvoker, req *SystemSignCertReq) (*SystemSignCertResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if len(req.CSR) == 0 {
+		return nil, errors.New("no certificate request provided")
+	}
+
+	pbReq := &mgmtpb.SystemSignCertReq{
+		Sys: req.getSystem(rpcClient),
+		Csr: req.CSR,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemSignCert(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system sign certificate request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemSignCertResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+var (
+	// replicaSyncPollInterval is the interval between checks of the
+	// replication state of a newly-added MS replica.
+	replicaSyncPollInterval = time.Second
+	// defaultReplicaSyncTimeout bounds the wait for a newly-added MS
+	// replica to synchronize when no timeout is specified.
+	defaultReplicaSyncTimeout = 5 * time.Minute
+)
+
+type (
+	// SystemReplaceReplicaReq contains the inputs for a request to replace
+	// a MS replica with another control plane server.
+	SystemReplaceReplicaReq struct {
+		unaryRequest
+		Old         string
+		New         string
+		SyncTimeout time.Duration
+	}
+
+	// SystemReplaceReplicaResp contains the set of MS replicas after the
+	// replacement.
+	SystemReplaceReplicaResp struct {
+		Replicas []string `json:"replicas"`
+	}
+)
+
+// waitForReplicaSync waits until the given MS replica has applied all of the
+// updates committed by the leader.
+func waitForReplicaSync(ctx context.Context, rpcClient UnaryInvoker, replica string, replicas []string) error {
+	for {
+		statusReq := new(SystemDbStatusReq)
+		statusReq.SetHostList(replicas)
+		statusResp, err := SystemDbStatus(ctx, rpcClient, statusReq)
+		if err != nil {
+			return err
+		}
+
+		// Replication lag is only meaningful if the leader responded.
+		var leaderSeen, synced bool
+		for _, rs := range statusResp.Replicas {
+			switch {
+			case rs.State == "Leader":
+				leaderSeen = true
+			case rs.Replica == replica && rs.State == "Follower" && rs.Lag == 0:
+				synced = true
+			}
+		}
+		if leaderSeen && synced {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(replicaSyncPollInterval):
+		}
+	}
+}
+
+// SystemReplaceReplica replaces a MS replica without interrupting the
+// management service. The new replica is added to the set of replicas as a
+// nonvoter and, once it has caught up with the leader, is promoted to a voter
+// and the old replica is removed. If the new replica does not catch up in time,
+// it is removed again and the set of replicas is left unchanged.
+func SystemReplaceReplica(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplaceReplicaReq) (*SystemReplaceReplicaResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Old == "" || req.New == "" {
+		return nil, errors.New("old and new replicas must be specified")
+	}
+	if req.Old == req.New {
+		return nil, errors.New("old and new replicas must differ")
+	}
+
+	addReq := &SystemReplicaReq{Replica: req.New}
+	addReq.SetSystem(req.Sys)
+	addResp, err := SystemAddReplica(ctx, rpcClient, addReq)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to add %s as a MS replica", req.New)
+	}
+	if len(addResp.Replicas) == 0 {
+		return nil, errors.New("no MS replicas in add replica response")
+	}
+	// The new replica is appended to the set of replicas, in the
+	// canonical form used by the replicas to identify themselves.
+	newReplica := addResp.Replicas[len(addResp.Replicas)-1]
+
+	removeNew := func() {
+		rmReq := &SystemReplicaReq{Replica: newReplica}
+		rmReq.SetSystem(req.Sys)
+		if _, rmErr := SystemRemoveReplica(ctx, rpcClient, rmReq); rmErr != nil {
+			rpcClient.Debugf("failed to remove new MS replica %s: %s", newReplica, rmErr)
+		}
+	}
+
+	syncTimeout := req.SyncTimeout
+	if syncTimeout <= 0 {
+		syncTimeout = defaultReplicaSyncTimeout
+	}
+	syncCtx, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+	if err := waitForReplicaSync(syncCtx, rpcClient, newReplica, addResp.Replicas); err != nil {
+		removeNew()
+		return nil, errors.Wrapf(err, "MS replica %s did not synchronize", req.New)
+	}
+
+	promoteReq := &SystemReplicaReq{Replica: newReplica}
+	promoteReq.SetSystem(req.Sys)
+	if _, err := SystemPromoteReplica(ctx, rpcClient, promoteReq); err != nil {
+		removeNew()
+		return nil, errors.Wrapf(err, "failed to promote %s to a voting MS replica", req.New)
+	}
+
+	rmReq := &SystemReplicaReq{Replica: req.Old}
+	rmReq.SetSystem(req.Sys)
+	rmResp, err := SystemRemoveReplica(ctx, rpcClient, rmReq)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to remove %s as a MS replica", req.Old)
+	}
+
+	return &SystemReplaceReplicaResp{Replicas: rmResp.Replicas}, nil
+}
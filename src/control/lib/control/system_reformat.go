@@ -0,0 +1,188 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// SystemReformatStep identifies a step in a coordinated system reformat.
+type SystemReformatStep string
+
+// Steps performed, in order, during a coordinated system reformat.
+const (
+	SystemReformatStepQuery  SystemReformatStep = "query"
+	SystemReformatStepStop   SystemReformatStep = "stop"
+	SystemReformatStepErase  SystemReformatStep = "erase"
+	SystemReformatStepFormat SystemReformatStep = "format"
+	SystemReformatStepRejoin SystemReformatStep = "rejoin"
+)
+
+const (
+	defaultReformatRejoinTimeout  = 5 * time.Minute
+	defaultReformatRejoinInterval = 2 * time.Second
+)
+
+type (
+	// SystemReformatReq contains the inputs for a coordinated system reformat.
+	SystemReformatReq struct {
+		unaryRequest
+		// RejoinTimeout is the time to wait for the reformatted members
+		// to rejoin the system.
+		RejoinTimeout time.Duration
+		// RejoinInterval is the interval between membership checks while
+		// waiting for members to rejoin.
+		RejoinInterval time.Duration
+		// OnStep, if set, is called as each step of the reformat begins.
+		OnStep func(SystemReformatStep) `json:"-"`
+	}
+
+	// SystemReformatResp contains the results of a coordinated system reformat.
+	SystemReformatResp struct {
+		// FailedStep is set to the step at which the reformat failed.
+		FailedStep   SystemReformatStep   `json:"failed_step,omitempty"`
+		PriorMembers int                  `json:"prior_members"`
+		StopResults  system.MemberResults `json:"stop_results"`
+		EraseResults system.MemberResults `json:"erase_results"`
+		Format       *StorageFormatResp   `json:"format"`
+		Members      system.Members       `json:"members"`
+	}
+)
+
+func (req *SystemReformatReq) onStep(step SystemReformatStep) {
+	if req.OnStep != nil {
+		req.OnStep(step)
+	}
+}
+
+// SystemReformat performs a coordinated reformat of the whole system: all
+// members are stopped, the system metadata and engine superblocks are erased,
+// storage is reformatted on all hosts and the reformatted members are waited
+// on to rejoin the system.
+//
+// If a step fails, the response records the step and the results gathered so
+// far, and an error is returned.
+func SystemReformat(ctx context.Context, rpcClient UnaryInvoker, req *SystemReformatReq) (*SystemReformatResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	resp := new(SystemReformatResp)
+	fail := func(step SystemReformatStep, err error) (*SystemReformatResp, error) {
+		resp.FailedStep = step
+		return resp, errors.Wrapf(err, "system reformat failed at %s step", step)
+	}
+	hostList := req.getHostList()
+
+	req.onStep(SystemReformatStepQuery)
+	queryReq := &SystemQueryReq{FailOnUnavailable: true}
+	queryReq.SetHostList(hostList)
+	queryResp, err := SystemQuery(ctx, rpcClient, queryReq)
+	if err != nil {
+		return fail(SystemReformatStepQuery, err)
+	}
+	resp.PriorMembers = len(queryResp.Members)
+	if resp.PriorMembers == 0 {
+		return fail(SystemReformatStepQuery, errors.New("no system members to reformat"))
+	}
+
+	req.onStep(SystemReformatStepStop)
+	stopReq := &SystemStopReq{Force: true}
+	stopReq.SetHostList(hostList)
+	stopResp, err := SystemStop(ctx, rpcClient, stopReq)
+	if err != nil {
+		return fail(SystemReformatStepStop, err)
+	}
+	resp.StopResults = stopResp.Results
+	if err := stopResp.Errors(); err != nil {
+		return fail(SystemReformatStepStop, err)
+	}
+
+	req.onStep(SystemReformatStepErase)
+	eraseReq := new(SystemEraseReq)
+	eraseReq.SetHostList(hostList)
+	eraseResp, err := SystemErase(ctx, rpcClient, eraseReq)
+	if err != nil {
+		return fail(SystemReformatStepErase, err)
+	}
+	resp.EraseResults = eraseResp.Results
+	if err := eraseResp.Errors(); err != nil {
+		return fail(SystemReformatStepErase, err)
+	}
+
+	req.onStep(SystemReformatStepFormat)
+	formatReq := &StorageFormatReq{Reformat: true}
+	formatReq.SetHostList(hostList)
+	resp.Format, err = StorageFormat(ctx, rpcClient, formatReq)
+	if err != nil {
+		return fail(SystemReformatStepFormat, err)
+	}
+	if err := resp.Format.Errors(); err != nil {
+		return fail(SystemReformatStepFormat, err)
+	}
+
+	req.onStep(SystemReformatStepRejoin)
+	resp.Members, err = waitForRejoin(ctx, rpcClient, req, resp.PriorMembers)
+	if err != nil {
+		return fail(SystemReformatStepRejoin, err)
+	}
+
+	return resp, nil
+}
+
+// waitForRejoin polls the system membership until the expected number of
+// members have joined or the rejoin timeout expires.
+func waitForRejoin(ctx context.Context, rpcClient UnaryInvoker, req *SystemReformatReq, expMembers int) (system.Members, error) {
+	timeout := req.RejoinTimeout
+	if timeout == 0 {
+		timeout = defaultReformatRejoinTimeout
+	}
+	interval := req.RejoinInterval
+	if interval == 0 {
+		interval = defaultReformatRejoinInterval
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var members system.Members
+	for {
+		queryReq := &SystemQueryReq{FailOnUnavailable: true}
+		queryReq.SetHostList(req.getHostList())
+		queryResp, err := SystemQuery(ctx, rpcClient, queryReq)
+		switch {
+		case err == nil:
+			members = queryResp.Members
+			joined := 0
+			for _, m := range members {
+				if m.State == system.MemberStateJoined {
+					joined++
+				}
+			}
+			rpcClient.Debugf("%d/%d members rejoined", joined, expMembers)
+			if joined >= expMembers {
+				return members, nil
+			}
+		case system.IsUnavailable(err) || system.IsUninitialized(err) ||
+			errors.Cause(err) == errMSConnectionFailure:
+			rpcClient.Debugf("waiting for MS to become available: %s", err)
+		default:
+			return members, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return members, errors.Errorf("timed out waiting for %d members to rejoin", expMembers)
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,186 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_SystemReformat(t *testing.T) {
+	mockQuery := func(states ...system.MemberState) *UnaryResponse {
+		resp := new(mgmtpb.SystemQueryResp)
+		for i, state := range states {
+			resp.Members = append(resp.Members, &mgmtpb.SystemMember{
+				Rank:  uint32(i),
+				Uuid:  test.MockUUID(int32(i)),
+				State: state.String(),
+				Addr:  "10.0.0.1:10001",
+			})
+		}
+		return MockMSResponse("10.0.0.1:10001", nil, resp)
+	}
+	mockResults := func(action string, state system.MemberState, errored bool) []*sharedpb.RankResult {
+		results := make([]*sharedpb.RankResult, 2)
+		for i := range results {
+			results[i] = &sharedpb.RankResult{
+				Rank:   uint32(i),
+				Action: action,
+				State:  state.String(),
+				Addr:   "10.0.0.1:10001",
+			}
+		}
+		if errored {
+			results[1].Errored = true
+			results[1].Msg = action + " failed"
+		}
+		return results
+	}
+	mockStop := func(errored bool) *UnaryResponse {
+		return MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemStopResp{
+			Results: mockResults("stop", system.MemberStateStopped, errored),
+		})
+	}
+	mockErase := MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemEraseResp{
+		Results: mockResults("reset format", system.MemberStateAwaitFormat, false),
+	})
+	msUnavail := MockMSResponse("", system.ErrRaftUnavail, nil)
+	msConnFailed := MockMSResponse("", errors.Wrap(errMSConnectionFailure, "query"), nil)
+	mockFormat := func(err error) *UnaryResponse {
+		hr := &HostResponse{Addr: "host1", Message: &ctlpb.StorageFormatResp{}}
+		if err != nil {
+			hr = &HostResponse{Addr: "host1", Error: err}
+		}
+		return &UnaryResponse{Responses: []*HostResponse{hr}}
+	}
+	joined := system.MemberStateJoined
+
+	for name, tc := range map[string]struct {
+		req           *SystemReformatReq
+		uResps        []*UnaryResponse
+		expSteps      []SystemReformatStep
+		expFailedStep SystemReformatStep
+		expMembers    int
+		expErr        error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"query fails": {
+			req:           &SystemReformatReq{},
+			uResps:        []*UnaryResponse{msUnavail},
+			expSteps:      []SystemReformatStep{SystemReformatStepQuery},
+			expFailedStep: SystemReformatStepQuery,
+			expErr:        system.ErrRaftUnavail,
+		},
+		"no members": {
+			req:           &SystemReformatReq{},
+			uResps:        []*UnaryResponse{mockQuery()},
+			expSteps:      []SystemReformatStep{SystemReformatStepQuery},
+			expFailedStep: SystemReformatStepQuery,
+			expErr:        errors.New("no system members"),
+		},
+		"stop fails on a rank": {
+			req:    &SystemReformatReq{},
+			uResps: []*UnaryResponse{mockQuery(joined, joined), mockStop(true)},
+			expSteps: []SystemReformatStep{
+				SystemReformatStepQuery, SystemReformatStepStop,
+			},
+			expFailedStep: SystemReformatStepStop,
+			expErr:        errors.New("failed rank 1"),
+		},
+		"format fails on a host": {
+			req: &SystemReformatReq{},
+			uResps: []*UnaryResponse{
+				mockQuery(joined, joined), mockStop(false),
+				mockQuery(system.MemberStateStopped, system.MemberStateStopped), mockErase,
+				msUnavail, mockFormat(errors.New("format failed")),
+			},
+			expSteps: []SystemReformatStep{
+				SystemReformatStepQuery, SystemReformatStepStop,
+				SystemReformatStepErase, SystemReformatStepFormat,
+			},
+			expFailedStep: SystemReformatStepFormat,
+			expErr:        errors.New("format failed"),
+		},
+		"rejoin times out": {
+			req: &SystemReformatReq{
+				RejoinTimeout:  10 * time.Millisecond,
+				RejoinInterval: time.Second,
+			},
+			uResps: []*UnaryResponse{
+				mockQuery(joined, joined), mockStop(false),
+				mockQuery(system.MemberStateStopped, system.MemberStateStopped), mockErase,
+				msUnavail, mockFormat(nil),
+				msUnavail,
+			},
+			expSteps: []SystemReformatStep{
+				SystemReformatStepQuery, SystemReformatStepStop,
+				SystemReformatStepErase, SystemReformatStepFormat,
+				SystemReformatStepRejoin,
+			},
+			expFailedStep: SystemReformatStepRejoin,
+			expErr:        errors.New("timed out waiting for 2 members to rejoin"),
+		},
+		"success": {
+			req: &SystemReformatReq{
+				RejoinInterval: time.Millisecond,
+			},
+			uResps: []*UnaryResponse{
+				mockQuery(joined, joined), mockStop(false),
+				mockQuery(system.MemberStateStopped, system.MemberStateStopped), mockErase,
+				msUnavail, mockFormat(nil),
+				msUnavail, msConnFailed, mockQuery(joined), mockQuery(joined, joined),
+			},
+			expSteps: []SystemReformatStep{
+				SystemReformatStepQuery, SystemReformatStepStop,
+				SystemReformatStepErase, SystemReformatStepFormat,
+				SystemReformatStepRejoin,
+			},
+			expMembers: 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var gotSteps []SystemReformatStep
+			if tc.req != nil {
+				tc.req.OnStep = func(step SystemReformatStep) {
+					gotSteps = append(gotSteps, step)
+				}
+			}
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.uResps,
+			})
+
+			gotResp, gotErr := SystemReformat(context.TODO(), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if diff := cmp.Diff(tc.expSteps, gotSteps); diff != "" {
+				t.Fatalf("unexpected steps (-want, +got):\n%s\n", diff)
+			}
+			if tc.req == nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expFailedStep, gotResp.FailedStep, "unexpected failed step")
+			test.AssertEqual(t, tc.expMembers, len(gotResp.Members), "unexpected rejoined members")
+		})
+	}
+}
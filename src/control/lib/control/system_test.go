@@ -8,12 +8,16 @@ package control
 
 import (
 	"context"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
@@ -547,12 +551,15 @@ func TestControl_SystemQuery(t *testing.T) {
 		fds[i] = system.MustCreateFaultDomainFromString(fdStrs[i])
 	}
 
+	lastUpdate := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+
 	for name, tc := range map[string]struct {
-		req     *SystemQueryReq
-		uErr    error
-		uResp   *UnaryResponse
-		expResp *SystemQueryResp
-		expErr  error
+		req           *SystemQueryReq
+		uErr          error
+		uResp         *UnaryResponse
+		expResp       *SystemQueryResp
+		cmpLastUpdate bool
+		expErr        error
 	}{
 		"nil req": {
 			req:    nil,
@@ -637,6 +644,35 @@ func TestControl_SystemQuery(t *testing.T) {
 				},
 			},
 		},
+		"member last update": {
+			req: new(SystemQueryReq),
+			uResp: MockMSResponse("10.0.0.1:10001", nil,
+				&mgmtpb.SystemQueryResp{
+					Members: []*mgmtpb.SystemMember{
+						{
+							Rank:        1,
+							Uuid:        test.MockUUID(1),
+							State:       system.MemberStateExcluded.String(),
+							Addr:        "10.0.0.1:10001",
+							FaultDomain: fdStrs[1],
+							LastUpdate:  lastUpdate.Format(time.RFC3339),
+						},
+					},
+				},
+			),
+			expResp: &SystemQueryResp{
+				Members: system.Members{
+					func() *system.Member {
+						m := system.MockMemberFullSpec(t, 1, test.MockUUID(1), "",
+							test.MockHostAddr(1), system.MemberStateExcluded).
+							WithFaultDomain(fds[1])
+						m.LastUpdate = lastUpdate
+						return m
+					}(),
+				},
+			},
+			cmpLastUpdate: true,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -655,7 +691,9 @@ func TestControl_SystemQuery(t *testing.T) {
 
 			cmpOpts := []cmp.Option{
 				cmpopts.IgnoreUnexported(SystemQueryResp{}, system.Member{}),
-				cmpopts.IgnoreFields(system.Member{}, "LastUpdate"),
+			}
+			if !tc.cmpLastUpdate {
+				cmpOpts = append(cmpOpts, cmpopts.IgnoreFields(system.Member{}, "LastUpdate"))
 			}
 			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
@@ -886,6 +924,8 @@ func TestControl_SystemStop(t *testing.T) {
 	testReqRS.Ranks.Replace(testRS)
 	testRespRS := new(SystemStopResp)
 	testRespRS.AbsentRanks.Replace(testRS)
+	testRespPrepRS := new(SystemStopResp)
+	testRespPrepRS.PrepRanks.Replace(testRS)
 
 	for name, tc := range map[string]struct {
 		req     *SystemStopReq
@@ -924,6 +964,14 @@ func TestControl_SystemStop(t *testing.T) {
 				}),
 			expResp: testRespRS,
 		},
+		"prep-only rank set": {
+			req: new(SystemStopReq),
+			uResp: MockMSResponse("0.0.0.0", nil,
+				&mgmtpb.SystemStopResp{
+					Prepranks: "1-23",
+				}),
+			expResp: testRespPrepRS,
+		},
 		"dual host dual rank": {
 			req: new(SystemStopReq),
 			uResp: MockMSResponse("10.0.0.1:10001", nil,
@@ -973,7 +1021,9 @@ func TestControl_SystemStop(t *testing.T) {
 				return
 			}
 
-			cmpOpts := []cmp.Option{cmpopts.IgnoreUnexported(SystemStopResp{}, system.MemberResult{})}
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(SystemStopResp{}, system.MemberResult{}, ranklist.RankSet{}),
+			}
 			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
 			}
@@ -983,6 +1033,9 @@ func TestControl_SystemStop(t *testing.T) {
 			if diff := cmp.Diff(tc.expResp.AbsentRanks.String(), gotResp.AbsentRanks.String()); diff != "" {
 				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
 			}
+			if diff := cmp.Diff(tc.expResp.PrepRanks.String(), gotResp.PrepRanks.String()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
 		})
 	}
 }
@@ -1124,6 +1177,130 @@ func TestControl_SystemExclude(t *testing.T) {
 	}
 }
 
+func TestControl_SystemAnnotate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *SystemAnnotateReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"no ranks": {
+			req: &SystemAnnotateReq{
+				Annotations: map[string]string{"hw": "DIMM replaced"},
+			},
+			expErr: errors.New("no ranks"),
+		},
+		"no annotations": {
+			req: &SystemAnnotateReq{
+				Ranks: ranklist.MustCreateRankSet("7"),
+			},
+			expErr: errors.New("no annotations"),
+		},
+		"invalid annotation": {
+			req: &SystemAnnotateReq{
+				Ranks:       ranklist.MustCreateRankSet("7"),
+				Annotations: map[string]string{"hw": "DIMM\treplaced"},
+			},
+			expErr: errors.New("invalid character"),
+		},
+		"req fails": {
+			req: &SystemAnnotateReq{
+				Ranks:       ranklist.MustCreateRankSet("7"),
+				Annotations: map[string]string{"hw": "DIMM replaced"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemAnnotateReq{
+				Ranks:       ranklist.MustCreateRankSet("6-7"),
+				Annotations: map[string]string{"hw": "DIMM replaced", "owner": ""},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SystemAnnotate(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_SystemSetMemberAlias(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *SystemSetMemberAliasReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"invalid alias": {
+			req: &SystemSetMemberAliasReq{
+				Rank:  1,
+				Alias: "rack3/node7",
+			},
+			expErr: errors.New("invalid character"),
+		},
+		"req fails": {
+			req: &SystemSetMemberAliasReq{
+				Rank:  1,
+				Alias: "rack3-node7",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemSetMemberAliasReq{
+				Rank:  1,
+				Alias: "rack3-node7",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+		"clear alias": {
+			req: &SystemSetMemberAliasReq{
+				Rank: 1,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SystemSetMemberAlias(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
 func TestDmg_System_checkSystemErase(t *testing.T) {
 	for name, tc := range map[string]struct {
 		uErr, expErr error
@@ -1414,6 +1591,176 @@ func TestControl_SystemJoin_RetryableErrors(t *testing.T) {
 	}
 }
 
+func TestControl_SystemJoin_Prime(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	client := NewMockInvoker(log, &MockInvokerConfig{
+		UnaryResponse: MockMSResponse("", nil, &mgmtpb.JoinResp{
+			Rank: 42,
+			Prime: &mgmtpb.JoinPrimeData{
+				MapVersion: 7,
+				Pools: []*mgmtpb.JoinPrimeData_Pool{
+					{Uuid: test.MockUUID(1), Label: "pool1", SvcReps: []uint32{0, 1, 2}},
+				},
+			},
+		}),
+	})
+
+	gotResp, gotErr := SystemJoin(context.TODO(), client, &SystemJoinReq{})
+	if gotErr != nil {
+		t.Fatalf("unexpected error: %v", gotErr)
+	}
+
+	expResp := &SystemJoinResp{
+		Rank: 42,
+		Prime: &JoinPrimeData{
+			MapVersion: 7,
+			Pools: []*JoinPrimePool{
+				{UUID: test.MockUUID(1), Label: "pool1", SvcReps: []uint32{0, 1, 2}},
+			},
+		},
+	}
+	if diff := cmp.Diff(expResp, gotResp, defResCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestControl_SystemJoin_NoRetry(t *testing.T) {
+	for name, tc := range map[string]struct {
+		testErr error
+		expErr  error
+	}{
+		"system not formatted": {
+			testErr: system.ErrUninitialized,
+			expErr:  system.ErrUninitialized,
+		},
+		"system unavailable": {
+			testErr: system.ErrRaftUnavail,
+			expErr:  system.ErrRaftUnavail,
+		},
+		"connection refused": {
+			testErr: FaultConnectionRefused(""),
+			expErr:  FaultConnectionRefused(""),
+		},
+		"join rejected": {
+			testErr: errors.New("rejected"),
+			expErr:  errors.New("rejected"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", tc.testErr, nil),
+					MockMSResponse("", nil, &mgmtpb.JoinResp{Rank: 42}),
+				},
+			})
+
+			_, gotErr := SystemJoin(context.TODO(), client, &SystemJoinReq{NoRetry: true})
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_SystemJoinStream(t *testing.T) {
+	lqResp := MockMSResponse("host1", nil, &mgmtpb.LeaderQueryResp{CurrentLeader: "host1"})
+
+	for name, tc := range map[string]struct {
+		req       *SystemJoinReq
+		mic       *MockInvokerConfig
+		expStages []string
+		expResp   *SystemJoinResp
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"stream completes": {
+			req: &SystemJoinReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{lqResp},
+				StreamResponses: []proto.Message{
+					&mgmtpb.JoinProgress{Stage: mgmtpb.JoinProgress_QUEUED},
+					&mgmtpb.JoinProgress{Stage: mgmtpb.JoinProgress_VALIDATED},
+					&mgmtpb.JoinProgress{Stage: mgmtpb.JoinProgress_RANK_ASSIGNED, Rank: 42},
+					&mgmtpb.JoinProgress{Stage: mgmtpb.JoinProgress_MEMBERSHIP_CONFIRMED, Rank: 42},
+					&mgmtpb.JoinProgress{
+						Stage: mgmtpb.JoinProgress_DONE,
+						Rank:  42,
+						Resp:  &mgmtpb.JoinResp{Rank: 42},
+					},
+				},
+			},
+			expStages: []string{"QUEUED", "VALIDATED", "RANK_ASSIGNED", "MEMBERSHIP_CONFIRMED", "DONE"},
+			expResp:   &SystemJoinResp{Rank: 42},
+		},
+		"stream unimplemented; falls back to unary join": {
+			req: &SystemJoinReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					lqResp,
+					nil, // stream
+					MockMSResponse("host1", nil, &mgmtpb.JoinResp{Rank: 42}),
+				},
+				StreamError: status.Error(codes.Unimplemented, "unknown method JoinStream"),
+			},
+			expResp: &SystemJoinResp{Rank: 42},
+		},
+		"stream fails": {
+			req: &SystemJoinReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					lqResp,
+					nil, // stream
+					MockMSResponse("host1", nil, &mgmtpb.JoinResp{Rank: 42}),
+				},
+				StreamError: errors.New("connection refused"),
+			},
+			expErr: errors.New("connection refused"),
+		},
+		"stream ends without response": {
+			req: &SystemJoinReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					lqResp,
+					nil, // stream
+					MockMSResponse("host1", nil, &mgmtpb.JoinResp{Rank: 42}),
+				},
+				StreamResponses: []proto.Message{
+					&mgmtpb.JoinProgress{Stage: mgmtpb.JoinProgress_QUEUED},
+				},
+			},
+			expErr: errors.New("without a response"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+
+			var gotStages []string
+			gotResp, gotErr := SystemJoinStream(context.TODO(), client, tc.req, func(jp *JoinProgress) {
+				gotStages = append(gotStages, jp.Stage)
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expStages, gotStages); diff != "" {
+				t.Fatalf("unexpected progress stages (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_SystemJoin_Timeouts(t *testing.T) {
 	for name, tc := range map[string]struct {
 		mic     *MockInvokerConfig
@@ -1507,6 +1854,56 @@ func TestControl_SystemJoin_Timeouts(t *testing.T) {
 	}
 }
 
+func TestControl_SystemHeartbeat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *SystemHeartbeatReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"missing address": {
+			req:    &SystemHeartbeatReq{},
+			expErr: errors.New("missing control address"),
+		},
+		"req fails": {
+			req: &SystemHeartbeatReq{
+				ControlAddr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 10001},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", system.ErrRaftUnavail, nil),
+				},
+			},
+			expErr: system.ErrRaftUnavail,
+		},
+		"success": {
+			req: &SystemHeartbeatReq{
+				ControlAddr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 10001},
+				Engines: []*EngineHeartbeat{
+					{Rank: 1, InstanceIdx: 0, Alive: true, State: system.MemberStateReady},
+					{Rank: 2, InstanceIdx: 1, State: system.MemberStateStopped},
+				},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.HeartbeatResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SystemHeartbeat(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
 func TestControl_SystemSetAttr(t *testing.T) {
 	for name, tc := range map[string]struct {
 		req    *SystemSetAttrReq
@@ -1623,3 +2020,596 @@ func TestControl_SystemGetAttr(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_SystemDbVerify(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemDbVerifyReq
+		mic     *MockInvokerConfig
+		expResp *SystemDbVerifyResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemDbVerifyReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemDbVerifyReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemDbVerifyResp{
+						Replica:    "10.0.0.1:10001",
+						FirstIndex: 1,
+						LastIndex:  42,
+						Entries:    42,
+						Errors:     []string{"log entry 42: bad"},
+					}),
+				},
+			},
+			expResp: &SystemDbVerifyResp{
+				Replica:    "10.0.0.1:10001",
+				FirstIndex: 1,
+				LastIndex:  42,
+				Entries:    42,
+				Errors:     []string{"log entry 42: bad"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemDbVerify(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemDbBackup(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemDbBackupReq
+		mic     *MockInvokerConfig
+		expResp *SystemDbBackupResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemDbBackupReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemDbBackupReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemDbBackupResp{
+						Backup:   []byte(`{"format_version":1}`),
+						Members:  4,
+						Pools:    2,
+						Checksum: "abcd",
+					}),
+				},
+			},
+			expResp: &SystemDbBackupResp{
+				Backup:   []byte(`{"format_version":1}`),
+				Members:  4,
+				Pools:    2,
+				Checksum: "abcd",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemDbBackup(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemDbRestore(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemDbRestoreReq
+		mic     *MockInvokerConfig
+		expResp *SystemDbRestoreResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"empty backup": {
+			req:    &SystemDbRestoreReq{},
+			expErr: errors.New("empty system database backup"),
+		},
+		"req fails": {
+			req: &SystemDbRestoreReq{Backup: []byte("{}")},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("checksum mismatch"), nil),
+				},
+			},
+			expErr: errors.New("checksum mismatch"),
+		},
+		"success": {
+			req: &SystemDbRestoreReq{Backup: []byte("{}"), Force: true},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemDbRestoreResp{
+						Members:    4,
+						Pools:      2,
+						MapVersion: 12,
+					}),
+				},
+			},
+			expResp: &SystemDbRestoreResp{
+				Members:    4,
+				Pools:      2,
+				MapVersion: 12,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemDbRestore(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemDbStatus(t *testing.T) {
+	lqResp := MockMSResponse("host1", nil, &mgmtpb.LeaderQueryResp{
+		CurrentLeader: "10.0.0.1:10001",
+		Replicas:      []string{"10.0.0.1:10001", "10.0.0.2:10001", "10.0.0.3:10001"},
+	})
+	leaderResp := &HostResponse{
+		Addr: "10.0.0.1:10001",
+		Message: &mgmtpb.SystemDbStatusResp{
+			Replica:      "10.0.0.1:10001",
+			State:        "Leader",
+			Leader:       "10.0.0.1:10001",
+			Term:         2,
+			CommitIndex:  100,
+			AppliedIndex: 100,
+			Commits:      10,
+		},
+	}
+	followerResp := &HostResponse{
+		Addr: "10.0.0.2:10001",
+		Message: &mgmtpb.SystemDbStatusResp{
+			Replica:       "10.0.0.2:10001",
+			State:         "Follower",
+			Leader:        "10.0.0.1:10001",
+			Term:          2,
+			CommitIndex:   95,
+			AppliedIndex:  90,
+			LastContactMs: 12,
+		},
+	}
+	errResp := &HostResponse{
+		Addr:  "10.0.0.3:10001",
+		Error: errors.New("connection refused"),
+	}
+
+	for name, tc := range map[string]struct {
+		req     *SystemDbStatusReq
+		mic     *MockInvokerConfig
+		expResp *SystemDbStatusResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"leader query fails": {
+			req: &SystemDbStatusReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("no leader"), nil),
+				},
+			},
+			expErr: errors.New("no leader"),
+		},
+		"all replicas": {
+			req: &SystemDbStatusReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					lqResp,
+					{Responses: []*HostResponse{errResp, followerResp, leaderResp}},
+				},
+			},
+			expResp: &SystemDbStatusResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"10.0.0.3:10001", "connection refused"}),
+				Leader:         "10.0.0.1:10001",
+				Replicas: []*SystemDbReplicaStatus{
+					{
+						Replica:      "10.0.0.1:10001",
+						State:        "Leader",
+						Leader:       "10.0.0.1:10001",
+						Term:         2,
+						CommitIndex:  100,
+						AppliedIndex: 100,
+						Commits:      10,
+					},
+					{
+						Replica:       "10.0.0.2:10001",
+						State:         "Follower",
+						Leader:        "10.0.0.1:10001",
+						Term:          2,
+						CommitIndex:   95,
+						AppliedIndex:  90,
+						LastContactMs: 12,
+						Lag:           10,
+					},
+				},
+			},
+		},
+		"leader not reached": {
+			req: func() *SystemDbStatusReq {
+				req := &SystemDbStatusReq{}
+				req.SetHostList([]string{"10.0.0.2:10001"})
+				return req
+			}(),
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{Responses: []*HostResponse{followerResp}},
+				},
+			},
+			expResp: &SystemDbStatusResp{
+				Leader: "10.0.0.1:10001",
+				Replicas: []*SystemDbReplicaStatus{
+					{
+						Replica:       "10.0.0.2:10001",
+						State:         "Follower",
+						Leader:        "10.0.0.1:10001",
+						Term:          2,
+						CommitIndex:   95,
+						AppliedIndex:  90,
+						LastContactMs: 12,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemDbStatus(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemReplicaChanges(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemReplicaReq
+		change  replicaChange
+		mic     *MockInvokerConfig
+		expResp *SystemReplicaResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"no replica": {
+			req:    &SystemReplicaReq{},
+			expErr: errors.New("no replica"),
+		},
+		"add fails": {
+			req: &SystemReplicaReq{Replica: "10.0.0.2"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", errors.New("not a system member"), nil),
+			},
+			expErr: errors.New("not a system member"),
+		},
+		"add": {
+			req: &SystemReplicaReq{Replica: "10.0.0.2"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.SystemReplicaResp{
+					Replicas: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+				}),
+			},
+			expResp: &SystemReplicaResp{
+				Replicas: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+			},
+		},
+		"promote": {
+			req:    &SystemReplicaReq{Replica: "10.0.0.2"},
+			change: replicaPromote,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.SystemReplicaResp{
+					Replicas: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+				}),
+			},
+			expResp: &SystemReplicaResp{
+				Replicas: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+			},
+		},
+		"remove": {
+			req:    &SystemReplicaReq{Replica: "10.0.0.1"},
+			change: replicaRemove,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.SystemReplicaResp{
+					Replicas: []string{"10.0.0.2:10001"},
+				}),
+			},
+			expResp: &SystemReplicaResp{
+				Replicas: []string{"10.0.0.2:10001"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			call := SystemAddReplica
+			switch tc.change {
+			case replicaPromote:
+				call = SystemPromoteReplica
+			case replicaRemove:
+				call = SystemRemoveReplica
+			}
+			gotResp, gotErr := call(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemSignCert(t *testing.T) {
+	csr := []byte("-----BEGIN CERTIFICATE REQUEST-----")
+	cert := []byte("-----BEGIN CERTIFICATE-----")
+
+	for name, tc := range map[string]struct {
+		req     *SystemSignCertReq
+		mic     *MockInvokerConfig
+		expResp *SystemSignCertResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.SystemSignCertReq request"),
+		},
+		"no CSR": {
+			req:    &SystemSignCertReq{},
+			expErr: errors.New("no certificate request"),
+		},
+		"local failure": {
+			req: &SystemSignCertReq{CSR: csr},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &SystemSignCertReq{CSR: csr},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &SystemSignCertReq{CSR: csr},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.SystemSignCertResp{
+					Cert: cert,
+				}),
+			},
+			expResp: &SystemSignCertResp{Cert: cert},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemSignCert(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemReplaceReplica(t *testing.T) {
+	addResp := MockMSResponse("", nil, &mgmtpb.SystemReplicaResp{
+		Replicas: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+	})
+	rmResp := MockMSResponse("", nil, &mgmtpb.SystemReplicaResp{
+		Replicas: []string{"10.0.0.2:10001"},
+	})
+	statusResp := func(followerApplied uint64) *UnaryResponse {
+		return &UnaryResponse{
+			Responses: []*HostResponse{
+				{
+					Addr: "10.0.0.1:10001",
+					Message: &mgmtpb.SystemDbStatusResp{
+						Replica:      "10.0.0.1:10001",
+						State:        "Leader",
+						Leader:       "10.0.0.1:10001",
+						CommitIndex:  100,
+						AppliedIndex: 100,
+					},
+				},
+				{
+					Addr: "10.0.0.2:10001",
+					Message: &mgmtpb.SystemDbStatusResp{
+						Replica:      "10.0.0.2:10001",
+						State:        "Follower",
+						Leader:       "10.0.0.1:10001",
+						CommitIndex:  followerApplied,
+						AppliedIndex: followerApplied,
+					},
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req     *SystemReplaceReplicaReq
+		mic     *MockInvokerConfig
+		expResp *SystemReplaceReplicaResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"missing new": {
+			req:    &SystemReplaceReplicaReq{Old: "10.0.0.1"},
+			expErr: errors.New("must be specified"),
+		},
+		"same replica": {
+			req:    &SystemReplaceReplicaReq{Old: "10.0.0.1", New: "10.0.0.1"},
+			expErr: errors.New("must differ"),
+		},
+		"add fails": {
+			req: &SystemReplaceReplicaReq{Old: "10.0.0.1", New: "10.0.0.2"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("not a system member"), nil),
+				},
+			},
+			expErr: errors.New("failed to add 10.0.0.2"),
+		},
+		"sync timeout": {
+			req: &SystemReplaceReplicaReq{
+				Old:         "10.0.0.1",
+				New:         "10.0.0.2",
+				SyncTimeout: 50 * time.Millisecond,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{addResp},
+				UnaryResponse:    statusResp(50),
+			},
+			expErr: errors.New("did not synchronize"),
+		},
+		"default sync timeout": {
+			req: &SystemReplaceReplicaReq{Old: "10.0.0.1", New: "10.0.0.2"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{addResp},
+				UnaryResponse:    statusResp(50),
+			},
+			expErr: errors.New("did not synchronize"),
+		},
+		"promote fails": {
+			req: &SystemReplaceReplicaReq{Old: "10.0.0.1", New: "10.0.0.2"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					addResp,
+					statusResp(100),
+					MockMSResponse("", errors.New("promote failed"), nil),
+				},
+			},
+			expErr: errors.New("failed to promote 10.0.0.2"),
+		},
+		"remove fails": {
+			req: &SystemReplaceReplicaReq{Old: "10.0.0.1", New: "10.0.0.2"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					addResp,
+					statusResp(100),
+					addResp,
+					MockMSResponse("", errors.New("remove failed"), nil),
+				},
+			},
+			expErr: errors.New("failed to remove 10.0.0.1"),
+		},
+		"success": {
+			req: &SystemReplaceReplicaReq{Old: "10.0.0.1", New: "10.0.0.2"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					addResp,
+					statusResp(50),
+					statusResp(100),
+					addResp,
+					rmResp,
+				},
+			},
+			expResp: &SystemReplaceReplicaResp{
+				Replicas: []string{"10.0.0.2:10001"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			oldInterval := replicaSyncPollInterval
+			replicaSyncPollInterval = 10 * time.Millisecond
+			defer func() { replicaSyncPollInterval = oldInterval }()
+			oldTimeout := defaultReplicaSyncTimeout
+			defaultReplicaSyncTimeout = 50 * time.Millisecond
+			defer func() { defaultReplicaSyncTimeout = oldTimeout }()
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemReplaceReplica(context.TODO(), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
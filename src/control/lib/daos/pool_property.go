@@ -13,10 +13,10 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/units"
 )
 
 func numericMarshaler(v *PoolPropertyValue) ([]byte, error) {
@@ -108,7 +108,7 @@ func PoolProperties() PoolPropertyMap {
 				Number:      PoolPropertyECCellSize,
 				Description: "EC cell size",
 				valueHandler: func(s string) (*PoolPropertyValue, error) {
-					b, err := humanize.ParseBytes(s)
+					b, err := units.ParseBytes(s)
 					if err != nil || !EcCellSizeIsValid(b) {
 						return nil, errors.Errorf("invalid EC Cell size %q", s)
 					}
@@ -120,7 +120,7 @@ func PoolProperties() PoolPropertyMap {
 					if err != nil {
 						return "not set"
 					}
-					return humanize.IBytes(n)
+					return units.FormatIBytes(n)
 				},
 				valueMarshaler: numericMarshaler,
 			},
@@ -244,6 +244,7 @@ const (
 	objTypePCIDevice = C.HWLOC_OBJ_PCI_DEVICE
 	objTypeNUMANode  = C.HWLOC_OBJ_NUMANODE
 	objTypeCore      = C.HWLOC_OBJ_CORE
+	objTypePU        = C.HWLOC_OBJ_PU
 
 	osDevTypeBlock       = C.HWLOC_OBJ_OSDEV_BLOCK
 	osDevTypeNetwork     = C.HWLOC_OBJ_OSDEV_NETWORK
@@ -217,7 +217,8 @@ func (p *Provider) getCoresPerNodeSet(topo *topology, nodes hardware.NodeMap) er
 				continue
 			}
 			node.AddCore(hardware.CPUCore{
-				ID: coreObj.logicalIndex(),
+				ID:      coreObj.logicalIndex(),
+				Threads: getCoreThreads(coreObj),
 			})
 		}
 
@@ -227,6 +228,22 @@ func (p *Provider) getCoresPerNodeSet(topo *topology, nodes hardware.NodeMap) er
 	return nil
 }
 
+// getCoreThreads returns the OS indexes of the hardware threads (PUs) of a core.
+func getCoreThreads(coreObj *object) []uint {
+	threads := []uint{}
+	for i := uint(0); i < coreObj.getNumChildren(); i++ {
+		child, err := coreObj.getChild(i)
+		if err != nil {
+			break
+		}
+		if child.objType() == objTypePU {
+			threads = append(threads, child.osIndex())
+		}
+	}
+
+	return threads
+}
+
 func (p *Provider) getPCIBridgesPerNUMANode(topo *topology, nodes hardware.NodeMap) error {
 	if topo == nil || nodes == nil {
 		return errors.New("nil topology or nodes")
@@ -804,6 +804,7 @@ func TestHwlocProvider_GetTopology_Samples(t *testing.T) {
 			cmpOpts := []cmp.Option{
 				cmpopts.IgnoreFields(hardware.BlockDevice{}, "BackingDevice"),
 				cmpopts.IgnoreFields(hardware.PCIDevice{}, "BlockDevice"),
+				cmpopts.IgnoreFields(hardware.CPUCore{}, "Threads"),
 			}
 
 			if hwlocMajor < 2 {
@@ -819,6 +820,60 @@ func TestHwlocProvider_GetTopology_Samples(t *testing.T) {
 	}
 }
 
+func TestHwlocProvider_GetTopology_CoreThreads(t *testing.T) {
+	_, filename, _, _ := runtime.Caller(0)
+	testdataDir := filepath.Join(filepath.Dir(filename), "testdata")
+
+	for name, tc := range map[string]struct {
+		hwlocXMLFile string
+		expCores     int
+		expThreadsFn func(core hardware.CPUCore) []uint
+	}{
+		"no hyperthreading": {
+			hwlocXMLFile: filepath.Join(testdataDir, "no-numa-no-devices.xml"),
+			expCores:     4,
+			expThreadsFn: func(core hardware.CPUCore) []uint {
+				return []uint{core.ID}
+			},
+		},
+		"two threads per core": {
+			hwlocXMLFile: filepath.Join(testdataDir, "gcp_topology.xml"),
+			expCores:     16,
+			expThreadsFn: func(core hardware.CPUCore) []uint {
+				return []uint{core.ID, core.ID + 16}
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			os.Setenv("HWLOC_XMLFILE", tc.hwlocXMLFile)
+			defer os.Unsetenv("HWLOC_XMLFILE")
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			hwlocMajor, _, _ := hwlocVersion()
+			result, err := NewProvider(log).GetTopology(ctx)
+			if err != nil {
+				t.Skipf("failed to load %s with hwloc %d.x: %s", tc.hwlocXMLFile, hwlocMajor, err)
+			}
+
+			var numCores int
+			for _, node := range result.NUMANodes {
+				for _, core := range node.Cores {
+					numCores++
+					if diff := cmp.Diff(tc.expThreadsFn(core), core.Threads); diff != "" {
+						t.Fatalf("core %d: unexpected threads (-want, +got)\n%s\n", core.ID, diff)
+					}
+				}
+			}
+			test.AssertEqual(t, tc.expCores, numCores, "unexpected number of cores")
+		})
+	}
+}
+
 func TestHwloc_Provider_GetNUMANodeForPID_Parallel(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	defer test.ShowBufferOnFailure(t, buf)
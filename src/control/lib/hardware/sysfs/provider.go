@@ -75,6 +75,30 @@ func (s *Provider) GetNetDevClass(dev string) (hardware.NetDevClass, error) {
 	return hardware.NetDevClass(res), err
 }
 
+// GetNetDevSpeed fetches the link speed of a network interface in Mbit/s. An
+// error is returned if the speed is unknown, e.g. because the link is down.
+func (s *Provider) GetNetDevSpeed(iface string) (uint64, error) {
+	if iface == "" {
+		return 0, errors.New("device name required")
+	}
+
+	speedBytes, err := ioutil.ReadFile(s.sysPath("class", "net", iface, "speed"))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get %q link speed", iface)
+	}
+
+	// The kernel reports -1 for links of unknown speed.
+	speed, err := strconv.ParseInt(strings.TrimSpace(string(speedBytes)), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse %q link speed", iface)
+	}
+	if speed <= 0 {
+		return 0, errors.Errorf("%q link speed is unknown", iface)
+	}
+
+	return uint64(speed), nil
+}
+
 // GetTopology builds a topology from the contents of sysfs.
 func (s *Provider) GetTopology(ctx context.Context) (*hardware.Topology, error) {
 	if s == nil {
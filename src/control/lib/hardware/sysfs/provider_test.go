@@ -76,6 +76,64 @@ func setupTestNetDevOperStates(t *testing.T, root string, devStates map[string]s
 	}
 }
 
+func TestSysfs_Provider_GetNetDevSpeed(t *testing.T) {
+	testDir, cleanupTestDir := test.CreateTestDir(t)
+	defer cleanupTestDir()
+
+	for dev, speed := range map[string]string{
+		"eth0": "25000",
+		"eth1": "-1",
+		"eth2": "fast",
+	} {
+		path := filepath.Join(testDir, "class", "net", dev)
+		if err := os.MkdirAll(path, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(path, "speed"), []byte(speed+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		in        string
+		expResult uint64
+		expErr    error
+	}{
+		"empty": {
+			expErr: errors.New("device name required"),
+		},
+		"no such device": {
+			in:     "fakedevice",
+			expErr: errors.New("no such file"),
+		},
+		"unknown speed": {
+			in:     "eth1",
+			expErr: errors.New("link speed is unknown"),
+		},
+		"bad speed": {
+			in:     "eth2",
+			expErr: errors.New("failed to parse"),
+		},
+		"success": {
+			in:        "eth0",
+			expResult: 25000,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			p := NewProvider(log)
+			p.root = testDir
+
+			result, err := p.GetNetDevSpeed(tc.in)
+
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expResult, result, "")
+		})
+	}
+}
+
 func TestSysfs_Provider_GetNetDevClass(t *testing.T) {
 	testDir, cleanupTestDir := test.CreateTestDir(t)
 	defer cleanupTestDir()
@@ -429,6 +429,7 @@ type (
 	// CPUCore represents a CPU core within a NUMA node.
 	CPUCore struct {
 		ID       uint      `json:"id"`
+		Threads  []uint    `json:"threads,omitempty"` // OS indexes of hardware threads
 		NUMANode *NUMANode `json:"-"`
 	}
 
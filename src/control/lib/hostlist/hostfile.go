@@ -0,0 +1,251 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package hostlist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// This file contains support for reading hosts from a hostfile.
+//
+// A hostfile lists one or more host entries per line, each of which may
+// optionally specify a port that overrides the default for that host.
+// Entries may be followed by one or more group tags (e.g. @storage) that
+// add all hosts on the line to the named group. Blank lines are ignored
+// and '#' starts a comment that runs to the end of the line, e.g.:
+//
+//   # metadata servers
+//   node[1-2]        @mds @storage
+//   node3:10005      @storage   # non-default port
+//   node[4-8]        @storage
+
+const (
+	hostFileComment = "#"
+	hostGroupPrefix = "@"
+)
+
+// HostFile contains the hosts and host groups read from a hostfile.
+type HostFile struct {
+	hosts  *HostSet
+	groups map[string]*HostSet
+	ports  map[string]string
+}
+
+func splitHostPort(host string) (string, string) {
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		return host[:i], host[i+1:]
+	}
+	return host, ""
+}
+
+// withPort returns the host with its port override applied, if
+// one exists and the host does not already specify a port.
+func (hf *HostFile) withPort(host string) string {
+	if _, port := splitHostPort(host); port != "" {
+		return host
+	}
+	if port, found := hf.ports[host]; found {
+		return host + ":" + port
+	}
+	return host
+}
+
+// applyPorts returns a new HostSet with port overrides applied to the
+// supplied hosts.
+func (hf *HostFile) applyPorts(in *HostSet) (*HostSet, error) {
+	hosts := in.Slice()
+	for i, host := range hosts {
+		hosts[i] = hf.withPort(host)
+	}
+	return CreateSet(strings.Join(hosts, ","))
+}
+
+// ReadHostFile reads the hostfile at the supplied path.
+func ReadHostFile(path string) (*HostFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	hf, err := ParseHostFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return hf, nil
+}
+
+// ParseHostFile parses hostfile content from the supplied reader.
+func ParseHostFile(r io.Reader) (*HostFile, error) {
+	hf := &HostFile{
+		groups: make(map[string]*HostSet),
+		ports:  make(map[string]string),
+	}
+	lineHosts := make(map[string][]*HostSet)
+	var all []*HostSet
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if i := strings.Index(line, hostFileComment); i >= 0 {
+			line = line[:i]
+		}
+
+		var hosts []string
+		var groups []string
+		for rest := strings.TrimSpace(line); rest != ""; rest = strings.TrimSpace(rest) {
+			var token string
+			rest, token = nextToken(rest, outerRangeSeparators)
+			if token == "" {
+				continue
+			}
+			if strings.HasPrefix(token, hostGroupPrefix) {
+				group := strings.TrimPrefix(token, hostGroupPrefix)
+				if group == "" {
+					return nil, fmt.Errorf("line %d: empty host group name", lineNum)
+				}
+				groups = append(groups, group)
+				continue
+			}
+			if len(groups) > 0 {
+				return nil, fmt.Errorf("line %d: host %q follows host group tags", lineNum, token)
+			}
+			hosts = append(hosts, token)
+		}
+		if len(hosts) == 0 {
+			if len(groups) > 0 {
+				return nil, fmt.Errorf("line %d: host group tags without hosts", lineNum)
+			}
+			continue
+		}
+
+		hs, err := CreateSet(strings.Join(hosts, ","))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		for _, host := range hs.Slice() {
+			name, port := splitHostPort(host)
+			if port == "" {
+				continue
+			}
+			if prev, found := hf.ports[name]; found && prev != port {
+				return nil, fmt.Errorf("line %d: conflicting ports %s and %s for host %q",
+					lineNum, prev, port, name)
+			}
+			hf.ports[name] = port
+		}
+
+		all = append(all, hs)
+		for _, group := range groups {
+			lineHosts[group] = append(lineHosts[group], hs)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Port overrides are applied once the whole file has been read so
+	// that a host's port is consistent regardless of where it is listed.
+	merge := func(sets []*HostSet) (*HostSet, error) {
+		out := new(HostSet)
+		for _, hs := range sets {
+			withPorts, err := hf.applyPorts(hs)
+			if err != nil {
+				return nil, err
+			}
+			if err := out.Merge(withPorts); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+
+	var err error
+	if hf.hosts, err = merge(all); err != nil {
+		return nil, err
+	}
+	for group, sets := range lineHosts {
+		if hf.groups[group], err = merge(sets); err != nil {
+			return nil, err
+		}
+	}
+
+	return hf, nil
+}
+
+// Hosts returns the set of all hosts in the hostfile.
+func (hf *HostFile) Hosts() *HostSet {
+	out := new(HostSet)
+	out.Replace(hf.hosts)
+	return out
+}
+
+// Groups returns the sorted names of the host groups in the hostfile.
+func (hf *HostFile) Groups() []string {
+	groups := make([]string, 0, len(hf.groups))
+	for group := range hf.groups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// Resolve converts a host selection expression into a HostSet. The
+// expression is a list of hosts and/or host group names prefixed with
+// '@' (e.g. "@mds,node9"). Port overrides from the hostfile are applied
+// to any selected hosts that do not specify a port.
+func (hf *HostFile) Resolve(expr string) (*HostSet, error) {
+	out := new(HostSet)
+
+	var hosts []string
+	for rest := strings.TrimSpace(expr); rest != ""; rest = strings.TrimSpace(rest) {
+		var token string
+		rest, token = nextToken(rest, outerRangeSeparators)
+		if token == "" {
+			continue
+		}
+		if !strings.HasPrefix(token, hostGroupPrefix) {
+			hosts = append(hosts, token)
+			continue
+		}
+
+		group := strings.TrimPrefix(token, hostGroupPrefix)
+		hs, found := hf.groups[group]
+		if !found {
+			return nil, fmt.Errorf("unknown host group %q", group)
+		}
+		if err := out.Merge(hs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(hosts) > 0 {
+		hs, err := CreateSet(strings.Join(hosts, ","))
+		if err != nil {
+			return nil, err
+		}
+		if hs, err = hf.applyPorts(hs); err != nil {
+			return nil, err
+		}
+		if err := out.Merge(hs); err != nil {
+			return nil, err
+		}
+	}
+
+	if out.Count() == 0 {
+		return nil, ErrEmpty
+	}
+
+	return out, nil
+}
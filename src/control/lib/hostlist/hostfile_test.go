@@ -0,0 +1,137 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package hostlist_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+)
+
+const testHostFile = `
+# metadata servers
+node[1-2]        @mds @storage
+node3:10005      @storage   # non-default port
+
+node[4-5] node7  @storage
+node6
+node3
+`
+
+func TestHostList_ParseHostFile(t *testing.T) {
+	for name, tc := range map[string]struct {
+		content   string
+		expHosts  string
+		expGroups []string
+		expErr    error
+	}{
+		"empty": {
+			expGroups: []string{},
+		},
+		"comments only": {
+			content:   "# nothing here\n   # or here\n",
+			expGroups: []string{},
+		},
+		"hosts and groups": {
+			content:   testHostFile,
+			expHosts:  "node[1-2,4-7],node3:10005",
+			expGroups: []string{"mds", "storage"},
+		},
+		"bad host": {
+			content: "node1\nnode[3-1] @mds\n",
+			expErr:  errors.New("line 2: invalid range"),
+		},
+		"empty group name": {
+			content: "node1 @\n",
+			expErr:  errors.New("line 1: empty host group name"),
+		},
+		"group without hosts": {
+			content: "@mds\n",
+			expErr:  errors.New("line 1: host group tags without hosts"),
+		},
+		"host after group": {
+			content: "node1 @mds node2\n",
+			expErr:  errors.New("line 1: host \"node2\" follows host group tags"),
+		},
+		"conflicting ports": {
+			content: "node1:10001\nnode1:10002\n",
+			expErr:  errors.New("line 2: conflicting ports"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			hf, gotErr := hostlist.ParseHostFile(strings.NewReader(tc.content))
+			cmpErr(t, tc.expErr, gotErr)
+			if gotErr != nil {
+				return
+			}
+
+			cmpOut(t, tc.expHosts, hf.Hosts().String())
+			if diff := cmp.Diff(tc.expGroups, hf.Groups()); diff != "" {
+				t.Fatalf("unexpected groups (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestHostList_HostFileResolve(t *testing.T) {
+	hf, err := hostlist.ParseHostFile(strings.NewReader(testHostFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		expr   string
+		expOut string
+		expErr error
+	}{
+		"empty": {
+			expErr: hostlist.ErrEmpty,
+		},
+		"single group": {
+			expr:   "@mds",
+			expOut: "node[1-2]",
+		},
+		"group with port override": {
+			expr:   "@storage",
+			expOut: "node[1-2,4-5,7],node3:10005",
+		},
+		"groups and hosts": {
+			expr:   "@mds,node[6-7] node9",
+			expOut: "node[1-2,6-7,9]",
+		},
+		"host port override applied": {
+			expr:   "node3",
+			expOut: "node3:10005",
+		},
+		"explicit port not overridden": {
+			expr:   "node3:10001",
+			expOut: "node3:10001",
+		},
+		"unknown group": {
+			expr:   "@mds,@compute",
+			expErr: errors.New("unknown host group \"compute\""),
+		},
+		"bad host": {
+			expr:   "@mds,node[",
+			expErr: errors.New("invalid"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			hs, gotErr := hf.Resolve(tc.expr)
+			cmpErr(t, tc.expErr, gotErr)
+			if gotErr != nil {
+				return
+			}
+
+			cmpOut(t, tc.expOut, hs.String())
+		})
+	}
+}
@@ -59,6 +59,21 @@ func (nl *NumericList) Delete(i uint) {
 	}
 }
 
+// Contains returns true if the supplied numeric entry is present in the
+// NumericList.
+func (nl *NumericList) Contains(i uint) bool {
+	nl.hl.RLock()
+	defer nl.hl.RUnlock()
+
+	for _, hr := range nl.hl.ranges {
+		if _, found := hr.containsHost(&hostName{number: i, hasNumber: true}); found {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Slice returns a slice of the numeric entries in the NumericList.
 func (nl *NumericList) Slice() (out []uint) {
 	nl.hl.RLock()
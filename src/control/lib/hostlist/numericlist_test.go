@@ -186,6 +186,17 @@ func TestHostList_NumericSet(t *testing.T) {
 			if gotCount != tc.expCount {
 				t.Fatalf("expected count to be %d; got %d", tc.expCount, gotCount)
 			}
+
+			for _, i := range tc.delList {
+				if nl.Contains(i) {
+					t.Fatalf("deleted entry %d still in set", i)
+				}
+			}
+			for _, i := range nl.Slice() {
+				if !nl.Contains(i) {
+					t.Fatalf("entry %d not found in set", i)
+				}
+			}
 		})
 	}
 }
@@ -125,11 +125,56 @@ func (rs *RankSet) Delete(rank Rank) {
 	rs.ns.Delete(uint(rank))
 }
 
+// Contains returns true if the rank is a member of the RankSet.
+func (rs *RankSet) Contains(rank Rank) bool {
+	if rs == nil || rs.ns == nil {
+		return false
+	}
+	return rs.ns.Contains(uint(rank))
+}
+
+// Equals returns true if both RankSets contain the same ranks.
+func (rs *RankSet) Equals(other *RankSet) bool {
+	return rs.String() == other.String()
+}
+
+// Union returns a new RankSet containing the ranks that are members of either
+// the receiver or the supplied RankSet.
+func (rs *RankSet) Union(other *RankSet) *RankSet {
+	out := RankSetFromRanks(rs.Ranks())
+	out.Merge(RankSetFromRanks(other.Ranks()))
+	return out
+}
+
+// Intersect returns a new RankSet containing the ranks that are members of
+// both the receiver and the supplied RankSet.
+func (rs *RankSet) Intersect(other *RankSet) *RankSet {
+	out := NewRankSet()
+	for _, r := range rs.Ranks() {
+		if other.Contains(r) {
+			out.Add(r)
+		}
+	}
+	return out
+}
+
+// Difference returns a new RankSet containing the ranks that are members of
+// the receiver but not of the supplied RankSet.
+func (rs *RankSet) Difference(other *RankSet) *RankSet {
+	out := NewRankSet()
+	for _, r := range rs.Ranks() {
+		if !other.Contains(r) {
+			out.Add(r)
+		}
+	}
+	return out
+}
+
 // Ranks returns a slice of Rank from a RankSet.
 func (rs *RankSet) Ranks() (out []Rank) {
 	out = make([]Rank, 0, rs.Count())
 
-	if rs.ns == nil {
+	if rs == nil || rs.ns == nil {
 		return
 	}
 
@@ -181,6 +226,12 @@ func RankSetFromRanks(ranks RankList) *RankSet {
 	return rs
 }
 
+// RankSetFromUint32 returns a RankSet created from the supplied uint32 slice,
+// e.g. the ranks in a protobuf message.
+func RankSetFromUint32(ranks []uint32) *RankSet {
+	return RankSetFromRanks(RanksFromUint32(ranks))
+}
+
 // ParseRanks takes a string representation of a list of ranks e.g. 1-4,6 and
 // returns a slice of Rank type or error.
 func ParseRanks(stringRanks string) ([]Rank, error) {
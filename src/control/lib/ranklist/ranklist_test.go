@@ -96,3 +96,68 @@ func TestRankList_RankSet(t *testing.T) {
 		})
 	}
 }
+
+func TestRankList_RankSet_Algebra(t *testing.T) {
+	for name, tc := range map[string]struct {
+		a            *RankSet
+		b            *RankSet
+		expUnion     string
+		expIntersect string
+		expDiff      string
+		expEqual     bool
+	}{
+		"both nil": {
+			expEqual: true,
+		},
+		"nil and empty": {
+			b:        NewRankSet(),
+			expEqual: true,
+		},
+		"nil and populated": {
+			b:        MustCreateRankSet("0-3"),
+			expUnion: "0-3",
+		},
+		"populated and nil": {
+			a:        MustCreateRankSet("0-3"),
+			expUnion: "0-3",
+			expDiff:  "0-3",
+		},
+		"disjoint": {
+			a:        MustCreateRankSet("0-3"),
+			b:        MustCreateRankSet("8-9"),
+			expUnion: "0-3,8-9",
+			expDiff:  "0-3",
+		},
+		"overlapping": {
+			a:            MustCreateRankSet("0-15,32"),
+			b:            MustCreateRankSet("8-31"),
+			expUnion:     "0-32",
+			expIntersect: "8-15",
+			expDiff:      "0-7,32",
+		},
+		"equal": {
+			a:            MustCreateRankSet("1,2,3,5"),
+			b:            MustCreateRankSet("5,1-3"),
+			expUnion:     "1-3,5",
+			expIntersect: "1-3,5",
+			expEqual:     true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expUnion, tc.a.Union(tc.b).String(), "unexpected union")
+			test.AssertEqual(t, tc.expIntersect, tc.a.Intersect(tc.b).String(), "unexpected intersection")
+			test.AssertEqual(t, tc.expDiff, tc.a.Difference(tc.b).String(), "unexpected difference")
+			test.AssertEqual(t, tc.expEqual, tc.a.Equals(tc.b), "unexpected equality")
+
+			for _, r := range tc.a.Ranks() {
+				test.AssertTrue(t, tc.a.Contains(r), "rank missing from set")
+			}
+			test.AssertFalse(t, tc.a.Contains(Rank(100)), "unexpected rank in set")
+		})
+	}
+}
+
+func TestRankList_RankSetFromUint32(t *testing.T) {
+	rs := RankSetFromUint32([]uint32{4, 0, 1, 2, 4})
+	test.AssertEqual(t, "0-2,4", rs.String(), "unexpected rank set")
+}
@@ -7,6 +7,8 @@
 #ifndef NVMECONTROL_H
 #define NVMECONTROL_H
 
+#include <stdbool.h>
+
 /**
  * Discover NVMe controllers and namespaces, as well as return device health
  * information.
@@ -44,11 +46,26 @@ nvme_format(char *ctrlr_pci_addr);
  * \param ctrlr_pci_addr PCI address of NVMe controller.
  * \param path Local filepath where firmware image is stored.
  * \param slot Identifier of software slot/register to upload to.
+ * \param activate Activate the image on the next controller reset, otherwise
+ *		   only stage it in the slot.
+ *
+ * \return a pointer to a return struct (ret_t).
+ */
+struct ret_t *
+nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot,
+	      bool activate);
+
+/**
+ * Activate NVMe controller firmware previously staged in a slot on the next
+ * controller reset.
+ *
+ * \param ctrlr_pci_addr PCI address of NVMe controller.
+ * \param slot Identifier of software slot/register holding the image.
  *
  * \return a pointer to a return struct (ret_t).
  */
 struct ret_t *
-nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot);
+nvme_fwcommit(char *ctrlr_pci_addr, unsigned int slot);
 
 /**
  * Initialize SPDK environment.
@@ -9,6 +9,7 @@
 
 #include <stdbool.h>
 #include <spdk/nvme_intel.h>
+#include <spdk/pci_ids.h>
 
 #define BUFLEN 1024
 
@@ -107,6 +108,20 @@ struct health_entry {
 
 extern struct ctrlr_entry	*g_controllers;
 
+/**
+ * Check whether SSDs from the given PCI vendor may provide the extended SMART
+ * attributes log page (0xCA). Support still needs to be confirmed per device.
+ *
+ * \param vid	PCI vendor ID of the controller.
+ *
+ * \return true if the vendor SMART log page should be requested.
+ */
+static inline bool
+vendor_smart_log_supported(uint16_t vid)
+{
+	return vid == SPDK_PCI_VID_INTEL || vid == SPDK_PCI_VID_SAMSUNG;
+}
+
 bool
 probe_cb(void *cb_ctx, const struct spdk_nvme_transport_id *trid,
 	 struct spdk_nvme_ctrlr_opts *opts);
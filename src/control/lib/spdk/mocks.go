@@ -55,6 +55,7 @@ type MockNvmeCfg struct {
 	FormatRes      []*FormatResult
 	FormatErr      error
 	UpdateErr      error
+	CommitErr      error
 }
 
 // MockNvmeImpl is an implementation of the Nvme interface.
@@ -98,3 +99,24 @@ func (n *MockNvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path stri
 
 	return nil
 }
+
+// Stage calls C.nvme_fwupdate to stage controller firmware image.
+func (n *MockNvmeImpl) Stage(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error {
+	if n.Cfg.UpdateErr != nil {
+		return n.Cfg.UpdateErr
+	}
+	log.Debugf("mock stage fw on nvme ssd: %q, image path %q, slot %d",
+		ctrlrPciAddr, path, slot)
+
+	return nil
+}
+
+// Commit calls C.nvme_fwcommit to activate a staged controller firmware image.
+func (n *MockNvmeImpl) Commit(log logging.Logger, ctrlrPciAddr string, slot int32) error {
+	if n.Cfg.CommitErr != nil {
+		return n.Cfg.CommitErr
+	}
+	log.Debugf("mock commit fw on nvme ssd: %q, slot %d", ctrlrPciAddr, slot)
+
+	return nil
+}
@@ -32,6 +32,10 @@ type Nvme interface {
 	Format(logging.Logger) ([]*FormatResult, error)
 	// Update updates the firmware on a specific PCI address and slot
 	Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error
+	// Stage downloads the firmware to a slot without activating it
+	Stage(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error
+	// Commit activates the firmware staged in a slot on next reset
+	Commit(log logging.Logger, ctrlrPciAddr string, slot int32) error
 }
 
 // NvmeImpl is an implementation of the Nvme interface.
@@ -95,12 +95,51 @@ func (n *NvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string,
 	csPci := C.CString(ctrlrPciAddr)
 	defer C.free(unsafe.Pointer(csPci))
 
-	_, err := collectCtrlrs(C.nvme_fwupdate(csPci, csPath, C.uint(slot)),
+	_, err := collectCtrlrs(C.nvme_fwupdate(csPci, csPath, C.uint(slot), true),
 		"NVMe Update(): C.nvme_fwupdate")
 
 	return wrapCleanError(err, cleanLockfiles(log, realRemove, ctrlrPciAddr))
 }
 
+// Stage downloads the firmware image via SPDK to a given slot on the device
+// without activating it.
+//
+// Afterwards remove lockfile for the updated device.
+func (n *NvmeImpl) Stage(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error {
+	if n == nil {
+		return errors.New("nil NvmeImpl")
+	}
+
+	csPath := C.CString(path)
+	defer C.free(unsafe.Pointer(csPath))
+
+	csPci := C.CString(ctrlrPciAddr)
+	defer C.free(unsafe.Pointer(csPci))
+
+	_, err := collectCtrlrs(C.nvme_fwupdate(csPci, csPath, C.uint(slot), false),
+		"NVMe Stage(): C.nvme_fwupdate")
+
+	return wrapCleanError(err, cleanLockfiles(log, realRemove, ctrlrPciAddr))
+}
+
+// Commit activates the firmware image previously staged in a given slot on
+// the device. The image becomes active on the next controller reset.
+//
+// Afterwards remove lockfile for the updated device.
+func (n *NvmeImpl) Commit(log logging.Logger, ctrlrPciAddr string, slot int32) error {
+	if n == nil {
+		return errors.New("nil NvmeImpl")
+	}
+
+	csPci := C.CString(ctrlrPciAddr)
+	defer C.free(unsafe.Pointer(csPci))
+
+	_, err := collectCtrlrs(C.nvme_fwcommit(csPci, C.uint(slot)),
+		"NVMe Commit(): C.nvme_fwcommit")
+
+	return wrapCleanError(err, cleanLockfiles(log, realRemove, ctrlrPciAddr))
+}
+
 // c2GoController is a private translation function.
 func c2GoController(ctrlr *C.struct_ctrlr_t) *storage.NvmeController {
 	return &storage.NvmeController{
@@ -124,6 +163,9 @@ func c2GoDeviceHealth(hs *C.struct_nvme_stats) *storage.NvmeHealth {
 		MediaErrors:             uint64(hs.media_errs),
 		ErrorLogEntries:         uint64(hs.err_log_entries),
 		Temperature:             uint32(hs.temperature),
+		AvailSpare:              uint8(hs.avail_spare),
+		AvailSpareThreshold:     uint8(hs.avail_spare_thres),
+		PercentUsed:             uint8(hs.percent_used),
 		TempWarn:                bool(hs.temp_warn),
 		AvailSpareWarn:          bool(hs.avail_spare_warn),
 		ReliabilityWarn:         bool(hs.dev_reliability_warn),
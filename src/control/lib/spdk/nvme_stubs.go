@@ -27,3 +27,13 @@ func (n *NvmeImpl) Format(log logging.Logger) ([]*FormatResult, error) {
 func (n *NvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error {
 	return nil
 }
+
+// Stage downloads the firmware image via SPDK to a given slot on the device.
+func (n *NvmeImpl) Stage(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error {
+	return nil
+}
+
+// Commit activates the firmware image staged in a given slot on the device.
+func (n *NvmeImpl) Commit(log logging.Logger, ctrlrPciAddr string, slot int32) error {
+	return nil
+}
@@ -68,8 +68,8 @@ get_health_logs(struct spdk_nvme_ctrlr *ctrlr, struct health_entry *health)
 
 	health->page = hp;
 
-	/* Non-Intel SSDs do not support this */
-	if (cdata->vid != SPDK_PCI_VID_INTEL)
+	/* Only some vendors provide extended SMART attributes */
+	if (!vendor_smart_log_supported(cdata->vid))
 		return 0;
 	if (!spdk_nvme_ctrlr_is_log_page_supported(ctrlr,
 					SPDK_NVME_INTEL_LOG_SMART))
@@ -353,7 +353,8 @@ nvme_format(char *ctrlr_pci_addr)
 }
 
 struct ret_t *
-nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot)
+nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot,
+	      bool activate)
 {
 	int					rc = 1;
 	int					fd = -1;
@@ -411,7 +412,10 @@ nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot)
 	}
 	close(fd);
 
-	commit_action = SPDK_NVME_FW_COMMIT_REPLACE_AND_ENABLE_IMG;
+	if (activate)
+		commit_action = SPDK_NVME_FW_COMMIT_REPLACE_AND_ENABLE_IMG;
+	else
+		commit_action = SPDK_NVME_FW_COMMIT_REPLACE_IMG;
 	rc = spdk_nvme_ctrlr_update_firmware(ctrlr_entry->ctrlr, fw_image, size,
 					     slot, commit_action, &status);
 	if (rc == -ENXIO && status.sct == SPDK_NVME_SCT_COMMAND_SPECIFIC &&
@@ -429,6 +433,69 @@ nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot)
 	return ret;
 }
 
+/** data structure passed to firmware commit cmd completion */
+struct fw_commit_data {
+	bool			done;
+	struct spdk_nvme_status	status;
+};
+
+static void
+fw_commit_completion(void *cb_arg, const struct spdk_nvme_cpl *cpl)
+{
+	struct fw_commit_data *data = cb_arg;
+
+	data->status = cpl->status;
+	data->done = true;
+}
+
+struct ret_t *
+nvme_fwcommit(char *ctrlr_pci_addr, unsigned int slot)
+{
+	struct spdk_nvme_fw_commit	fw_commit = {};
+	struct spdk_nvme_cmd		cmd = {};
+	struct fw_commit_data		data = {};
+	struct ctrlr_entry		*ctrlr_entry;
+	struct ret_t			*ret;
+
+	ret = init_ret();
+
+	ret->rc = get_controller(&ctrlr_entry, ctrlr_pci_addr);
+	if (ret->rc != 0)
+		return ret;
+
+	fw_commit.fs = slot;
+	fw_commit.ca = SPDK_NVME_FW_COMMIT_ENABLE_IMG;
+
+	cmd.opc = SPDK_NVME_OPC_FIRMWARE_COMMIT;
+	memcpy(&cmd.cdw10, &fw_commit, sizeof(uint32_t));
+
+	ret->rc = spdk_nvme_ctrlr_cmd_admin_raw(ctrlr_entry->ctrlr, &cmd, NULL,
+						0, fw_commit_completion, &data);
+	if (ret->rc != 0) {
+		sprintf(ret->info, "firmware commit submission failed");
+		return ret;
+	}
+
+	while (!data.done)
+		spdk_nvme_ctrlr_process_admin_completions(ctrlr_entry->ctrlr);
+
+	if (data.status.sct == SPDK_NVME_SCT_COMMAND_SPECIFIC &&
+	    data.status.sc == SPDK_NVME_SC_FIRMWARE_REQ_CONVENTIONAL_RESET) {
+		sprintf(ret->info,
+			"conventional reset is needed to enable firmware !");
+		ret->rc = -ENXIO;
+	} else if (data.status.sct != SPDK_NVME_SCT_GENERIC ||
+		   data.status.sc != SPDK_NVME_SC_SUCCESS) {
+		sprintf(ret->info, "firmware commit failed (sct %d, sc %d)",
+			data.status.sct, data.status.sc);
+		ret->rc = -EIO;
+	} else {
+		sprintf(ret->info, "firmware commit success");
+	}
+
+	return ret;
+}
+
 static int
 is_addr_in_allowlist(char *pci_addr, const struct spdk_pci_addr *allowlist,
 		     int num_allowlist_devices)
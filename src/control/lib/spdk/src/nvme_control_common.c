@@ -308,6 +308,9 @@ populate_dev_health(struct nvme_stats *stats,
 	stats->media_errs = hp->media_errors[0];
 	stats->err_log_entries = hp->num_error_info_log_entries[0];
 	stats->temperature = hp->temperature;
+	stats->avail_spare = hp->available_spare;
+	stats->avail_spare_thres = hp->available_spare_threshold;
+	stats->percent_used = hp->percentage_used;
 	stats->temp_warn = cw.bits.temperature ? true : false;
 	stats->avail_spare_warn = cw.bits.available_spare ? true : false;
 	stats->dev_reliability_warn = cw.bits.device_reliability ? true : false;
@@ -315,8 +318,8 @@ populate_dev_health(struct nvme_stats *stats,
 	stats->volatile_mem_warn = cw.bits.volatile_memory_backup ?
 				   true : false;
 
-	/* Intel Smart Information Attributes */
-	if (cdata->vid != SPDK_PCI_VID_INTEL)
+	/* Vendor Smart Information Attributes */
+	if (!vendor_smart_log_supported(cdata->vid))
 		return;
 	for (i = 0; i < SPDK_COUNTOF(isp->attributes); i++) {
 		if (isp->attributes[i].code ==
@@ -0,0 +1,85 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package systemd
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// listenFdsStart is the first file descriptor passed by systemd socket activation.
+const listenFdsStart = 3
+
+// ListenFiles returns the files passed to the process by systemd socket
+// activation, or nil if the process was not socket-activated. The activation
+// environment variables are unset so that they are not inherited by children.
+func ListenFiles() ([]*os.File, error) {
+	defer func() {
+		os.Unsetenv("LISTEN_PID")
+		os.Unsetenv("LISTEN_FDS")
+		os.Unsetenv("LISTEN_FDNAMES")
+	}()
+
+	pidStr := os.Getenv("LISTEN_PID")
+	if pidStr == "" {
+		return nil, nil
+	}
+	pid, err := strconv.Atoi(pidStr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid LISTEN_PID %q", pidStr)
+	}
+	if pid != os.Getpid() {
+		// The descriptors were intended for another process.
+		return nil, nil
+	}
+
+	nfdsStr := os.Getenv("LISTEN_FDS")
+	nfds, err := strconv.Atoi(nfdsStr)
+	if err != nil || nfds < 0 {
+		return nil, errors.Errorf("invalid LISTEN_FDS %q", nfdsStr)
+	}
+
+	names := strings.Split(os.Getenv("LISTEN_FDNAMES"), ":")
+	files := make([]*os.File, 0, nfds)
+	for fd := listenFdsStart; fd < listenFdsStart+nfds; fd++ {
+		syscall.CloseOnExec(fd)
+
+		name := "LISTEN_FD_" + strconv.Itoa(fd)
+		if idx := fd - listenFdsStart; idx < len(names) && names[idx] != "" {
+			name = names[idx]
+		}
+		files = append(files, os.NewFile(uintptr(fd), name))
+	}
+
+	return files, nil
+}
+
+// Listener returns a listener for the first socket passed to the process by
+// systemd socket activation, or nil if the process was not socket-activated.
+func Listener() (net.Listener, error) {
+	files, err := ListenFiles()
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	for _, f := range files[1:] {
+		f.Close()
+	}
+
+	lis, err := net.FileListener(files[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create listener from %s", files[0].Name())
+	}
+	// The listener holds a duplicate of the descriptor.
+	files[0].Close()
+
+	return lis, nil
+}
@@ -0,0 +1,77 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package systemd_test
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/systemd"
+)
+
+func Test_Systemd_ListenFiles(t *testing.T) {
+	ourPid := strconv.Itoa(os.Getpid())
+
+	for name, tc := range map[string]struct {
+		env      map[string]string
+		expFiles int
+		expErr   error
+	}{
+		"not socket-activated": {},
+		"other pid": {
+			env: map[string]string{
+				"LISTEN_PID": strconv.Itoa(os.Getpid() + 1),
+				"LISTEN_FDS": "1",
+			},
+		},
+		"bad pid": {
+			env: map[string]string{
+				"LISTEN_PID": "pid",
+				"LISTEN_FDS": "1",
+			},
+			expErr: errors.New("invalid LISTEN_PID"),
+		},
+		"missing fds": {
+			env: map[string]string{
+				"LISTEN_PID": ourPid,
+			},
+			expErr: errors.New("invalid LISTEN_FDS"),
+		},
+		"negative fds": {
+			env: map[string]string{
+				"LISTEN_PID": ourPid,
+				"LISTEN_FDS": "-1",
+			},
+			expErr: errors.New("invalid LISTEN_FDS"),
+		},
+		"zero fds": {
+			env: map[string]string{
+				"LISTEN_PID": ourPid,
+				"LISTEN_FDS": "0",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			for key, val := range tc.env {
+				os.Setenv(key, val)
+			}
+
+			files, err := systemd.ListenFiles()
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expFiles, len(files), "unexpected number of files")
+
+			for _, key := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+				if _, set := os.LookupEnv(key); set {
+					t.Fatalf("%s was not unset", key)
+				}
+			}
+		})
+	}
+}
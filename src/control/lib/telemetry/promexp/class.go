@@ -0,0 +1,145 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
+
+//
+
+package promexp
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// MetricClass identifies a group of engine metrics that may be disabled in
+// order to reduce the number of metrics exported.
+type MetricClass string
+
+const (
+	// MetricClassIOLatency is the class of I/O latency histograms, which
+	// are exported per operation, I/O size and target.
+	MetricClassIOLatency MetricClass = "io_latency"
+	// MetricClassTarget is the class of metrics exported per target.
+	MetricClassTarget MetricClass = "target"
+	// MetricClassNetwork is the class of network (CaRT) metrics.
+	MetricClassNetwork MetricClass = "network"
+)
+
+var metricClasses = []MetricClass{
+	MetricClassIOLatency,
+	MetricClassTarget,
+	MetricClassNetwork,
+}
+
+// MetricClasses returns the names of all metric classes.
+func MetricClasses() []string {
+	names := make([]string, 0, len(metricClasses))
+	for _, mc := range metricClasses {
+		names = append(names, string(mc))
+	}
+	return names
+}
+
+func (mc MetricClass) matches(baseName string, labels labelMap) bool {
+	switch mc {
+	case MetricClassIOLatency:
+		return strings.HasPrefix(baseName, "engine_io_latency_")
+	case MetricClassTarget:
+		_, found := labels["target"]
+		return found
+	case MetricClassNetwork:
+		return strings.HasPrefix(baseName, "engine_net_")
+	}
+	return false
+}
+
+func parseMetricClass(name string) (MetricClass, error) {
+	for _, mc := range metricClasses {
+		if strings.EqualFold(strings.TrimSpace(name), string(mc)) {
+			return mc, nil
+		}
+	}
+	return "", errors.Errorf("unknown metric class %q (valid classes: %s)",
+		name, strings.Join(MetricClasses(), ", "))
+}
+
+// ClassFilter records the metric classes that are disabled. It may be
+// updated while metrics are being collected.
+type ClassFilter struct {
+	mu       sync.RWMutex
+	disabled map[MetricClass]struct{}
+}
+
+// NewClassFilter returns a ClassFilter with the given classes disabled.
+func NewClassFilter(disabled ...string) (*ClassFilter, error) {
+	cf := &ClassFilter{}
+	if err := cf.SetDisabled(disabled...); err != nil {
+		return nil, err
+	}
+	return cf, nil
+}
+
+// SetDisabled replaces the set of disabled classes. No change is made if any
+// of the class names are invalid.
+func (cf *ClassFilter) SetDisabled(disabled ...string) error {
+	if cf == nil {
+		return errors.New("nil ClassFilter")
+	}
+
+	set := make(map[MetricClass]struct{})
+	for _, name := range disabled {
+		mc, err := parseMetricClass(name)
+		if err != nil {
+			return err
+		}
+		set[mc] = struct{}{}
+	}
+
+	cf.mu.Lock()
+	defer cf.mu.Unlock()
+	cf.disabled = set
+
+	return nil
+}
+
+// Disabled returns the sorted names of the disabled classes.
+func (cf *ClassFilter) Disabled() []string {
+	names := []string{}
+	if cf == nil {
+		return names
+	}
+
+	cf.mu.RLock()
+	defer cf.mu.RUnlock()
+
+	for mc := range cf.disabled {
+		names = append(names, string(mc))
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (cf *ClassFilter) isFiltered(baseName string, labels labelMap) bool {
+	if cf == nil {
+		return false
+	}
+
+	cf.mu.RLock()
+	defer cf.mu.RUnlock()
+
+	for mc := range cf.disabled {
+		if mc.matches(baseName, labels) {
+			return true
+		}
+	}
+	return false
+}
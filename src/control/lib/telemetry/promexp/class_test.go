@@ -0,0 +1,98 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
+
+//
+
+package promexp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestPromExp_ClassFilter(t *testing.T) {
+	for name, tc := range map[string]struct {
+		disabled    []string
+		baseName    string
+		labels      labelMap
+		expErr      error
+		expDisabled []string
+		expFiltered bool
+	}{
+		"none disabled": {
+			baseName:    "engine_io_latency_update",
+			expDisabled: []string{},
+		},
+		"unknown class": {
+			disabled: []string{"io_latency", "bogus"},
+			expErr:   errors.New("unknown metric class"),
+		},
+		"io latency disabled": {
+			disabled:    []string{"io_latency"},
+			baseName:    "engine_io_latency_update",
+			labels:      labelMap{"size": "4KB"},
+			expDisabled: []string{"io_latency"},
+			expFiltered: true,
+		},
+		"network disabled; io latency metric": {
+			disabled:    []string{"network"},
+			baseName:    "engine_io_latency_update",
+			expDisabled: []string{"network"},
+		},
+		"network disabled": {
+			disabled:    []string{"network"},
+			baseName:    "engine_net_ofi_tcp_req_timeout",
+			expDisabled: []string{"network"},
+			expFiltered: true,
+		},
+		"target disabled": {
+			disabled:    []string{"Target", "network"},
+			baseName:    "engine_io_ops_update_active",
+			labels:      labelMap{"target": "1"},
+			expDisabled: []string{"network", "target"},
+			expFiltered: true,
+		},
+		"target disabled; engine-level metric": {
+			disabled:    []string{"target"},
+			baseName:    "engine_started_at",
+			labels:      labelMap{"rank": "1"},
+			expDisabled: []string{"target"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cf, err := NewClassFilter(tc.disabled...)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expDisabled, cf.Disabled()); diff != "" {
+				t.Fatalf("unexpected disabled classes (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expFiltered, cf.isFiltered(tc.baseName, tc.labels), "filtered")
+
+			// An invalid update must not change the current settings.
+			if err := cf.SetDisabled("bogus"); err == nil {
+				t.Fatal("expected error for invalid class")
+			}
+			if diff := cmp.Diff(tc.expDisabled, cf.Disabled()); diff != "" {
+				t.Fatalf("disabled classes changed (-want, +got):\n%s\n", diff)
+			}
+
+			if err := cf.SetDisabled(); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertFalse(t, cf.isFiltered(tc.baseName, tc.labels), "filtered after enabling all")
+		})
+	}
+}
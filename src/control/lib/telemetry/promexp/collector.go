@@ -32,6 +32,7 @@ type (
 		log            logging.Logger
 		summary        *prometheus.SummaryVec
 		ignoredMetrics []*regexp.Regexp
+		filter         *ClassFilter
 		sources        []*EngineSource
 		cleanupSource  map[uint32]func()
 		sourceMutex    sync.RWMutex // To protect sources
@@ -39,6 +40,7 @@ type (
 
 	CollectorOpts struct {
 		Ignores []string
+		Filter  *ClassFilter
 	}
 
 	EngineSource struct {
@@ -122,6 +124,7 @@ func NewCollector(log logging.Logger, opts *CollectorOpts, sources ...*EngineSou
 
 	c := &Collector{
 		log:           log,
+		filter:        opts.Filter,
 		sources:       sources,
 		cleanupSource: make(map[uint32]func()),
 		summary: prometheus.NewSummaryVec(
@@ -570,7 +573,7 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	}(c.getSources())
 
 	for rm := range rankMetrics {
-		if c.isIgnored(rm.baseName) {
+		if c.isIgnored(rm.baseName) || c.filter.isFiltered(rm.baseName, rm.labels) {
 			continue
 		}
 
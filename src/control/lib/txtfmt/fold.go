@@ -0,0 +1,76 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package txtfmt
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+)
+
+// rowKey returns a string that uniquely identifies the values of a row,
+// excluding the named column.
+func rowKey(row TableRow, exclude string) string {
+	keys := make([]string, 0, len(row))
+	for title := range row {
+		if title != exclude {
+			keys = append(keys, title)
+		}
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, title := range keys {
+		b.WriteString(title)
+		b.WriteByte(0)
+		b.WriteString(row[title])
+		b.WriteByte(0)
+	}
+
+	return b.String()
+}
+
+// FoldHostRows merges table rows that have identical values in every column
+// other than the named hosts column. The hosts value of each merged row is
+// replaced with the ranged string of the combined host set. Merged rows are
+// returned in order of first occurrence.
+func FoldHostRows(table []TableRow, hostsTitle string) ([]TableRow, error) {
+	var folded []TableRow
+	sets := make(map[string]*hostlist.HostSet)
+	idx := make(map[string]int)
+
+	for _, row := range table {
+		key := rowKey(row, hostsTitle)
+
+		if _, exists := sets[key]; !exists {
+			hs, err := hostlist.CreateSet(row[hostsTitle])
+			if err != nil {
+				return nil, err
+			}
+			sets[key] = hs
+
+			newRow := make(TableRow, len(row))
+			for title, value := range row {
+				newRow[title] = value
+			}
+			idx[key] = len(folded)
+			folded = append(folded, newRow)
+			continue
+		}
+
+		if _, err := sets[key].Insert(row[hostsTitle]); err != nil {
+			return nil, err
+		}
+	}
+
+	for key, hs := range sets {
+		folded[idx[key]][hostsTitle] = hs.RangedString()
+	}
+
+	return folded, nil
+}
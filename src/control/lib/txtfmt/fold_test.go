@@ -0,0 +1,85 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package txtfmt
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestTxtfmt_FoldHostRows(t *testing.T) {
+	for name, tc := range map[string]struct {
+		table    []TableRow
+		expTable []TableRow
+		expErr   error
+	}{
+		"empty": {},
+		"nothing to fold": {
+			table: []TableRow{
+				{"Hosts": "host1", "Size": "1 GB"},
+				{"Hosts": "host2", "Size": "2 GB"},
+			},
+			expTable: []TableRow{
+				{"Hosts": "host1", "Size": "1 GB"},
+				{"Hosts": "host2", "Size": "2 GB"},
+			},
+		},
+		"folded in order of first occurrence": {
+			table: []TableRow{
+				{"Hosts": "host3", "Size": "2 GB"},
+				{"Hosts": "host1", "Size": "1 GB"},
+				{"Hosts": "host4", "Size": "2 GB"},
+				{"Hosts": "host2", "Size": "1 GB"},
+			},
+			expTable: []TableRow{
+				{"Hosts": "host[3-4]", "Size": "2 GB"},
+				{"Hosts": "host[1-2]", "Size": "1 GB"},
+			},
+		},
+		"ranged host values": {
+			table: []TableRow{
+				{"Hosts": "host[1-2]", "Size": "1 GB"},
+				{"Hosts": "host[3-5]", "Size": "1 GB"},
+			},
+			expTable: []TableRow{
+				{"Hosts": "host[1-5]", "Size": "1 GB"},
+			},
+		},
+		"missing column distinguishes rows": {
+			table: []TableRow{
+				{"Hosts": "host1", "Size": "1 GB"},
+				{"Hosts": "host2"},
+			},
+			expTable: []TableRow{
+				{"Hosts": "host1", "Size": "1 GB"},
+				{"Hosts": "host2"},
+			},
+		},
+		"invalid host": {
+			table: []TableRow{
+				{"Hosts": "host[1-", "Size": "1 GB"},
+			},
+			expErr: errors.New("invalid"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotTable, gotErr := FoldHostRows(tc.table, "Hosts")
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expTable, gotTable); diff != "" {
+				t.Fatalf("unexpected table (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
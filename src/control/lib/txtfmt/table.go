@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2021 Intel Corporation.
+// (C) Copyright 2019-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,20 +8,78 @@ package txtfmt
 
 import (
 	"bytes"
+	"encoding/csv"
 	"fmt"
 	"io"
+	"strings"
 	"text/tabwriter"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 )
 
 // TableRow is a map of string values to be printed, keyed by column title.
 type TableRow map[string]string
 
+// TableFormat selects the representation generated for a table.
+type TableFormat string
+
+// TableFormat constant definitions.
+const (
+	// TableFormatText renders an aligned text table, wrapping values in
+	// columns that have a maximum width.
+	TableFormatText TableFormat = "table"
+	// TableFormatWide renders an aligned text table without wrapping.
+	TableFormatWide TableFormat = "wide"
+	// TableFormatCSV renders comma-separated values with a header record.
+	TableFormatCSV TableFormat = "csv"
+	// TableFormatYAML renders a YAML sequence with one mapping per row.
+	TableFormatYAML TableFormat = "yaml"
+)
+
+// TableFormats lists the supported table formats.
+var TableFormats = []TableFormat{TableFormatText, TableFormatWide, TableFormatCSV, TableFormatYAML}
+
+// ParseTableFormat returns the TableFormat matching the supplied name. An
+// empty name selects the default text format.
+func ParseTableFormat(name string) (TableFormat, error) {
+	if name == "" {
+		return TableFormatText, nil
+	}
+	for _, tf := range TableFormats {
+		if strings.EqualFold(name, string(tf)) {
+			return tf, nil
+		}
+	}
+
+	return "", errors.Errorf("unknown table format %q (valid: %s)", name,
+		strings.Join(tableFormatNames(), ", "))
+}
+
+func tableFormatNames() []string {
+	names := make([]string, 0, len(TableFormats))
+	for _, tf := range TableFormats {
+		names = append(names, string(tf))
+	}
+	return names
+}
+
+// IsText returns true if the format renders an aligned text table.
+func (tf TableFormat) IsText() bool {
+	return tf == TableFormatText || tf == TableFormatWide || tf == ""
+}
+
 // TableFormatter is a structure that formats string output for a table with
 // labeled columns.
 type TableFormatter struct {
-	titles []string
-	writer *tabwriter.Writer
-	out    bytes.Buffer
+	titles     []string
+	rightAlign map[string]bool
+	maxWidth   map[string]int
+	format     TableFormat
+	dest       io.Writer
+	writer     *tabwriter.Writer
+	out        bytes.Buffer
 }
 
 // Init instantiates internal variables.
@@ -33,9 +91,56 @@ func (t *TableFormatter) Init() {
 // use the supplied io.Writer instead of the internal
 // buffer.
 func (t *TableFormatter) InitWriter(w io.Writer) {
+	t.dest = w
 	t.writer = tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
 }
 
+// SetFormat sets the representation generated by Format.
+func (t *TableFormatter) SetFormat(format TableFormat) {
+	t.format = format
+}
+
+// OutputFormat returns the representation generated by Format.
+func (t *TableFormatter) OutputFormat() TableFormat {
+	if t.format == "" {
+		return TableFormatText
+	}
+	return t.format
+}
+
+// Titles returns the ordered column titles for the table.
+func (t *TableFormatter) Titles() []string {
+	return t.titles
+}
+
+// SelectColumns restricts the table to the named columns, in the order
+// given. Names are matched against the column titles without regard to case.
+// An empty selection leaves the columns unchanged.
+func (t *TableFormatter) SelectColumns(names ...string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	selected := make([]string, 0, len(names))
+	for _, name := range names {
+		var found bool
+		for _, title := range t.titles {
+			if strings.EqualFold(strings.TrimSpace(name), title) {
+				selected = append(selected, title)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("unknown column %q (valid: %s)", name,
+				strings.Join(t.titles, ", "))
+		}
+	}
+	t.titles = selected
+
+	return nil
+}
+
 // SetColumnTitles sets the ordered column titles for the table.
 func (t *TableFormatter) SetColumnTitles(c ...string) {
 	if c == nil {
@@ -45,6 +150,116 @@ func (t *TableFormatter) SetColumnTitles(c ...string) {
 	t.titles = c
 }
 
+// SetColumnAlignRight sets the named columns to be right-aligned, which is
+// generally preferable for numeric values.
+func (t *TableFormatter) SetColumnAlignRight(titles ...string) {
+	if t.rightAlign == nil {
+		t.rightAlign = make(map[string]bool)
+	}
+	for _, title := range titles {
+		t.rightAlign[title] = true
+	}
+}
+
+// SetColumnMaxWidth limits the width of the named column. Values longer than
+// the limit are wrapped onto continuation lines at word boundaries where
+// possible. A width of zero or less removes the limit.
+func (t *TableFormatter) SetColumnMaxWidth(title string, width int) {
+	if t.maxWidth == nil {
+		t.maxWidth = make(map[string]int)
+	}
+	if width <= 0 {
+		delete(t.maxWidth, title)
+		return
+	}
+	t.maxWidth[title] = width
+}
+
+// wrapValue splits a value into lines no longer than width, breaking on
+// whitespace where possible and splitting words that exceed the width.
+func wrapValue(value string, width int) []string {
+	if width <= 0 || utf8.RuneCountInString(value) <= width {
+		return []string{value}
+	}
+
+	var lines []string
+	var line []rune
+	for _, word := range strings.Fields(value) {
+		w := []rune(word)
+		if len(line) > 0 && len(line)+1+len(w) > width {
+			lines = append(lines, string(line))
+			line = nil
+		}
+		if len(line) > 0 {
+			line = append(line, ' ')
+		}
+		for len(line)+len(w) > width {
+			n := width - len(line)
+			lines = append(lines, string(append(line, w[:n]...)))
+			line, w = nil, w[n:]
+		}
+		line = append(line, w...)
+	}
+	if len(line) > 0 {
+		lines = append(lines, string(line))
+	}
+
+	return lines
+}
+
+// layoutRows returns the cell values for each output line of the table,
+// applying column wrapping and alignment settings.
+func (t *TableFormatter) layoutRows(table []TableRow) [][]string {
+	var lines [][]string
+	for _, row := range table {
+		cells := make([][]string, len(t.titles))
+		nrLines := 1
+		for i, title := range t.titles {
+			value, ok := row[title]
+			if !ok {
+				value = "None"
+			}
+			maxWidth := t.maxWidth[title]
+			if t.format == TableFormatWide {
+				maxWidth = 0
+			}
+			cells[i] = wrapValue(value, maxWidth)
+			if len(cells[i]) > nrLines {
+				nrLines = len(cells[i])
+			}
+		}
+		for l := 0; l < nrLines; l++ {
+			line := make([]string, len(t.titles))
+			for i := range t.titles {
+				if l < len(cells[i]) {
+					line[i] = cells[i][l]
+				}
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	for i, title := range t.titles {
+		if !t.rightAlign[title] {
+			continue
+		}
+		width := utf8.RuneCountInString(title)
+		for _, line := range lines {
+			if w := utf8.RuneCountInString(line[i]); w > width {
+				width = w
+			}
+		}
+		for _, line := range lines {
+			if line[i] == "" {
+				continue
+			}
+			line[i] = strings.Repeat(" ", width-utf8.RuneCountInString(line[i])) + line[i]
+		}
+	}
+
+	return lines
+}
+
 // formatHeader formats a table header based on the column titles.
 func (t *TableFormatter) formatHeader() {
 	for _, title := range t.titles {
@@ -60,22 +275,64 @@ func (t *TableFormatter) formatHeader() {
 	fmt.Fprint(t.writer, "\n")
 }
 
+// formatCSV writes a header record of column titles followed by a record
+// for each row. Values are written unaltered.
+func (t *TableFormatter) formatCSV(table []TableRow) {
+	w := csv.NewWriter(t.dest)
+	w.Write(t.titles)
+	for _, row := range table {
+		record := make([]string, len(t.titles))
+		for i, title := range t.titles {
+			record[i] = row[title]
+		}
+		w.Write(record)
+	}
+	w.Flush()
+}
+
+// formatYAML writes a sequence containing a mapping for each row, with keys
+// in column order.
+func (t *TableFormatter) formatYAML(table []TableRow) {
+	rows := make([]yaml.MapSlice, 0, len(table))
+	for _, row := range table {
+		ms := make(yaml.MapSlice, 0, len(t.titles))
+		for _, title := range t.titles {
+			ms = append(ms, yaml.MapItem{Key: title, Value: row[title]})
+		}
+		rows = append(rows, ms)
+	}
+
+	data, err := yaml.Marshal(rows)
+	if err != nil {
+		fmt.Fprintf(t.dest, "unable to marshal yaml: %s\n", err)
+		return
+	}
+	t.dest.Write(data)
+}
+
 // Format generates an output string for the set of table rows provided. It
 // includes a header with column titles, and fills only the requested columns
-// in order.
+// in order. Values in columns with a maximum width are wrapped onto
+// continuation lines, unless the wide format is set. The csv and yaml formats
+// emit column values unaltered.
 func (t *TableFormatter) Format(table []TableRow) string {
 	if len(t.titles) == 0 {
 		return "" // nothing to format
 	}
 
+	switch t.format {
+	case TableFormatCSV:
+		t.formatCSV(table)
+		return t.out.String()
+	case TableFormatYAML:
+		t.formatYAML(table)
+		return t.out.String()
+	}
+
 	t.formatHeader()
 
-	for _, row := range table {
-		for _, title := range t.titles {
-			value, ok := row[title]
-			if !ok {
-				value = "None"
-			}
+	for _, line := range t.layoutRows(table) {
+		for _, value := range line {
 			fmt.Fprintf(t.writer, "%s\t", value)
 		}
 		fmt.Fprint(t.writer, "\n")
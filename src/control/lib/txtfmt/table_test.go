@@ -83,6 +83,9 @@ func TestTableFormatter_SetColumnTitles(t *testing.T) {
 func TestTableFormatter_Format(t *testing.T) {
 	for name, tt := range map[string]struct {
 		titles         []string
+		rightAlign     []string
+		maxWidth       map[string]int
+		format         TableFormat
 		table          []TableRow
 		expectedResult string
 	}{
@@ -177,11 +180,116 @@ too darn long None
 Hosts    SCM Total             NVMe Total             
 -----    ---------             ----------             
 wolf-118 5.79TB (2 namespaces) 1.46TB (2 controllers) 
+`,
+		},
+		"right-aligned column": {
+			titles:     []string{"Pool", "Size", "Used"},
+			rightAlign: []string{"Size", "Used"},
+			table: []TableRow{
+				{"Pool": "tank", "Size": "1.0 TB", "Used": "5%"},
+				{"Pool": "scratch", "Size": "47 GB", "Used": "100%"},
+			},
+			expectedResult: `
+Pool    Size   Used 
+----    ----   ---- 
+tank    1.0 TB   5% 
+scratch  47 GB 100% 
+`,
+		},
+		"wrapped column": {
+			titles:   []string{"Rank", "Reason"},
+			maxWidth: map[string]int{"Reason": 10},
+			table: []TableRow{
+				{"Rank": "0", "Reason": "engine exited unexpectedly"},
+				{"Rank": "1", "Reason": "ok"},
+			},
+			expectedResult: `
+Rank Reason     
+---- ------     
+0    engine     
+     exited     
+     unexpected 
+     ly         
+1    ok         
+`,
+		},
+		"wrapped and right-aligned column": {
+			titles:     []string{"One", "Two"},
+			rightAlign: []string{"Two"},
+			maxWidth:   map[string]int{"One": 3},
+			table: []TableRow{
+				{"One": "a b c d", "Two": "1"},
+			},
+			expectedResult: `
+One Two 
+--- --- 
+a b   1 
+c d     
+`,
+		},
+		"wide format ignores max width": {
+			titles:   []string{"Rank", "Reason"},
+			maxWidth: map[string]int{"Reason": 10},
+			format:   TableFormatWide,
+			table: []TableRow{
+				{"Rank": "0", "Reason": "engine exited unexpectedly"},
+			},
+			expectedResult: `
+Rank Reason                     
+---- ------                     
+0    engine exited unexpectedly 
+`,
+		},
+		"csv format": {
+			titles:     []string{"Pool", "Size", "Svc Reps"},
+			rightAlign: []string{"Size"},
+			format:     TableFormatCSV,
+			table: []TableRow{
+				{"Pool": "tank", "Size": "1.0 TB", "Svc Reps": "[0-2]"},
+				{"Pool": "scratch, temp", "Size": "47 GB"},
+			},
+			expectedResult: `
+Pool,Size,Svc Reps
+tank,1.0 TB,[0-2]
+"scratch, temp",47 GB,
+`,
+		},
+		"csv format; empty table": {
+			titles: []string{"One", "Two"},
+			format: TableFormatCSV,
+			expectedResult: `
+One,Two
+`,
+		},
+		"yaml format": {
+			titles: []string{"Pool", "Used"},
+			format: TableFormatYAML,
+			table: []TableRow{
+				{"Pool": "tank", "Used": "5%"},
+				{"Pool": "scratch"},
+			},
+			expectedResult: `
+- Pool: tank
+  Used: 5%
+- Pool: scratch
+  Used: ""
+`,
+		},
+		"yaml format; empty table": {
+			titles: []string{"One"},
+			format: TableFormatYAML,
+			expectedResult: `
+[]
 `,
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			f := NewTableFormatter(tt.titles...)
+			f.SetColumnAlignRight(tt.rightAlign...)
+			for title, width := range tt.maxWidth {
+				f.SetColumnMaxWidth(title, width)
+			}
+			f.SetFormat(tt.format)
 
 			result := f.Format(tt.table)
 
@@ -191,3 +299,117 @@ wolf-118 5.79TB (2 namespaces) 1.46TB (2 controllers)
 		})
 	}
 }
+
+func TestTableFormatter_SelectColumns(t *testing.T) {
+	for name, tt := range map[string]struct {
+		titles    []string
+		columns   []string
+		expTitles []string
+		expErr    string
+	}{
+		"no selection": {
+			titles:    []string{"One", "Two"},
+			expTitles: []string{"One", "Two"},
+		},
+		"subset reordered": {
+			titles:    []string{"One", "Two", "Three"},
+			columns:   []string{"three", " ONE "},
+			expTitles: []string{"Three", "One"},
+		},
+		"unknown column": {
+			titles:  []string{"One", "Two"},
+			columns: []string{"one", "four"},
+			expErr:  `unknown column "four" (valid: One, Two)`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := NewTableFormatter(tt.titles...)
+
+			err := f.SelectColumns(tt.columns...)
+			if tt.expErr != "" {
+				if err == nil || err.Error() != tt.expErr {
+					t.Fatalf("expected error %q, got %v", tt.expErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tt.expTitles, f.Titles()); diff != "" {
+				t.Fatalf("unexpected titles (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestTxtfmt_ParseTableFormat(t *testing.T) {
+	for name, tt := range map[string]struct {
+		in        string
+		expFormat TableFormat
+		expErr    bool
+	}{
+		"empty":   {expFormat: TableFormatText},
+		"table":   {in: "table", expFormat: TableFormatText},
+		"wide":    {in: "wide", expFormat: TableFormatWide},
+		"csv":     {in: "CSV", expFormat: TableFormatCSV},
+		"yaml":    {in: "yaml", expFormat: TableFormatYAML},
+		"unknown": {in: "xml", expErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseTableFormat(tt.in)
+			if tt.expErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.expFormat {
+				t.Fatalf("expected %q, got %q", tt.expFormat, got)
+			}
+		})
+	}
+}
+
+func TestTxtfmt_wrapValue(t *testing.T) {
+	for name, tc := range map[string]struct {
+		value    string
+		width    int
+		expLines []string
+	}{
+		"no limit": {
+			value:    "some long value",
+			expLines: []string{"some long value"},
+		},
+		"fits": {
+			value:    "short",
+			width:    5,
+			expLines: []string{"short"},
+		},
+		"word boundaries": {
+			value:    "one two three",
+			width:    7,
+			expLines: []string{"one two", "three"},
+		},
+		"long word split": {
+			value:    "abcdefghij",
+			width:    4,
+			expLines: []string{"abcd", "efgh", "ij"},
+		},
+		"long word after short word": {
+			value:    "a bcdefg",
+			width:    4,
+			expLines: []string{"a", "bcde", "fg"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotLines := wrapValue(tc.value, tc.width)
+			if diff := cmp.Diff(tc.expLines, gotLines); diff != "" {
+				t.Fatalf("unexpected lines (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package units provides parsing and formatting of byte sizes expressed in
+// SI (decimal, e.g. GB) or IEC (binary, e.g. GiB) units.
+package units
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+)
+
+var (
+	siPrefixes = map[rune]uint64{
+		'k': humanize.KByte,
+		'm': humanize.MByte,
+		'g': humanize.GByte,
+		't': humanize.TByte,
+		'p': humanize.PByte,
+		'e': humanize.EByte,
+	}
+	iecPrefixes = map[rune]uint64{
+		'k': humanize.KiByte,
+		'm': humanize.MiByte,
+		'g': humanize.GiByte,
+		't': humanize.TiByte,
+		'p': humanize.PiByte,
+		'e': humanize.EiByte,
+	}
+)
+
+// parseUnit returns the multiplier for the supplied unit string. Units that
+// could be misread are rejected: a lowercase "b" could denote bits rather
+// than bytes and a lowercase "m" could denote milli rather than mega.
+func parseUnit(unit string) (uint64, error) {
+	if unit == "" {
+		return 1, nil
+	}
+
+	runes := []rune(unit)
+	last := runes[len(runes)-1]
+	if last == 'b' {
+		return 0, errors.Errorf("ambiguous unit %q: use %q for bytes", unit,
+			string(runes[:len(runes)-1])+"B")
+	}
+	if last == 'B' {
+		runes = runes[:len(runes)-1]
+	}
+
+	switch len(runes) {
+	case 0:
+		return 1, nil
+	case 1:
+		// SI prefix, e.g. "G" or "GB"
+	case 2:
+		// IEC prefix, e.g. "Gi" or "GiB"
+		if runes[1] != 'i' && runes[1] != 'I' {
+			return 0, errors.Errorf("unknown unit %q", unit)
+		}
+	default:
+		return 0, errors.Errorf("unknown unit %q", unit)
+	}
+
+	if runes[0] == 'm' {
+		return 0, errors.Errorf("ambiguous unit %q: use %q for megabytes", unit,
+			"M"+string(runes[1:])+"B")
+	}
+
+	prefixes := siPrefixes
+	if len(runes) == 2 {
+		prefixes = iecPrefixes
+	}
+	mult, found := prefixes[unicode.ToLower(runes[0])]
+	if !found {
+		return 0, errors.Errorf("unknown unit %q", unit)
+	}
+
+	return mult, nil
+}
+
+// ParseBytes parses a byte size such as "2TiB", "1.5 GB" or "4096" and returns
+// the number of bytes. SI units (kB, MB, GB, TB, PB, EB) are powers of 1000 and
+// IEC units (KiB, MiB, GiB, TiB, PiB, EiB) are powers of 1024. For
+// compatibility, a prefix without a trailing "B" (e.g. "2G") is treated as an
+// SI unit. Fractional byte counts are truncated.
+func ParseBytes(in string) (uint64, error) {
+	str := strings.TrimSpace(strings.ReplaceAll(in, ",", ""))
+	if str == "" {
+		return 0, errors.New("empty byte size")
+	}
+
+	numEnd := strings.IndexFunc(str, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.'
+	})
+	if numEnd < 0 {
+		numEnd = len(str)
+	}
+	numStr := str[:numEnd]
+	unitStr := strings.TrimSpace(str[numEnd:])
+
+	if numStr == "" {
+		return 0, errors.Errorf("invalid byte size %q: no numeric value", in)
+	}
+	num, _, err := big.ParseFloat(numStr, 10, 128, big.ToZero)
+	if err != nil {
+		return 0, errors.Errorf("invalid byte size %q: bad numeric value", in)
+	}
+
+	mult, err := parseUnit(unitStr)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid byte size %q", in)
+	}
+
+	total := new(big.Float).Mul(num, new(big.Float).SetUint64(mult))
+	if total.Cmp(new(big.Float).SetUint64(math.MaxUint64)) > 0 {
+		return 0, errors.Errorf("invalid byte size %q: value too large", in)
+	}
+	bytes, _ := total.Uint64()
+
+	return bytes, nil
+}
+
+// FormatBytes returns a human-readable representation of the supplied byte
+// count in SI units, e.g. "2.0 TB".
+func FormatBytes(size uint64) string {
+	return humanize.Bytes(size)
+}
+
+// FormatIBytes returns a human-readable representation of the supplied byte
+// count in IEC units, e.g. "1.8 TiB".
+func FormatIBytes(size uint64) string {
+	return humanize.IBytes(size)
+}
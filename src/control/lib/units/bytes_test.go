@@ -0,0 +1,94 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package units
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestUnits_ParseBytes(t *testing.T) {
+	for input, tc := range map[string]struct {
+		expBytes uint64
+		expErr   error
+	}{
+		"":                     {expErr: errors.New("empty")},
+		"   ":                  {expErr: errors.New("empty")},
+		"4096":                 {expBytes: 4096},
+		"4096B":                {expBytes: 4096},
+		"1,024 B":              {expBytes: 1024},
+		"1kB":                  {expBytes: 1000},
+		"1KB":                  {expBytes: 1000},
+		"1K":                   {expBytes: 1000},
+		"1KiB":                 {expBytes: 1024},
+		"1Ki":                  {expBytes: 1024},
+		"2G":                   {expBytes: 2000000000},
+		"2g":                   {expBytes: 2000000000},
+		"2 GB":                 {expBytes: 2000000000},
+		"2GiB":                 {expBytes: 2 << 30},
+		"2GIB":                 {expBytes: 2 << 30},
+		"2TiB":                 {expBytes: 2 << 40},
+		"1.5MiB":               {expBytes: 3 << 19},
+		"1.5 MB":               {expBytes: 1500000},
+		"0.1KiB":               {expBytes: 102},
+		"16EiB":                {expErr: errors.New("too large")},
+		"2Gb":                  {expErr: errors.New("ambiguous unit")},
+		"2gib":                 {expErr: errors.New("ambiguous unit")},
+		"2b":                   {expErr: errors.New("ambiguous unit")},
+		"2mB":                  {expErr: errors.New("ambiguous unit")},
+		"2m":                   {expErr: errors.New("ambiguous unit")},
+		"2XB":                  {expErr: errors.New("unknown unit")},
+		"2GiBs":                {expErr: errors.New("unknown unit")},
+		"2Gx":                  {expErr: errors.New("unknown unit")},
+		"GiB":                  {expErr: errors.New("no numeric value")},
+		"-2GiB":                {expErr: errors.New("no numeric value")},
+		"1.2.3GB":              {expErr: errors.New("bad numeric value")},
+		"18446744073709551615": {expBytes: 18446744073709551615},
+		"18446744073709551616": {expErr: errors.New("too large")},
+	} {
+		t.Run(input, func(t *testing.T) {
+			gotBytes, gotErr := ParseBytes(input)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expBytes, gotBytes, "unexpected byte count")
+		})
+	}
+}
+
+func TestUnits_FormatBytes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		size   uint64
+		expSI  string
+		expIEC string
+	}{
+		"zero": {
+			expSI:  "0 B",
+			expIEC: "0 B",
+		},
+		"bytes": {
+			size:   512,
+			expSI:  "512 B",
+			expIEC: "512 B",
+		},
+		"terabytes": {
+			size:   2 << 40,
+			expSI:  "2.2 TB",
+			expIEC: "2.0 TiB",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expSI, FormatBytes(tc.size), "unexpected SI string")
+			test.AssertEqual(t, tc.expIEC, FormatIBytes(tc.size), "unexpected IEC string")
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"os"
 	"sync"
 
+	"github.com/pkg/errors"
+
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -41,6 +43,8 @@ type (
 		GetfsUsageResps []GetfsUsageRetval
 		StatErrors      map[string]error
 		RealStat        bool
+		MountInfo       map[string]*MountInfo
+		GetMountInfoErr error
 	}
 
 	// MockSysProvider gives a mock SystemProvider implementation.
@@ -130,6 +134,18 @@ func (msp *MockSysProvider) GetfsUsage(_ string) (uint64, uint64, error) {
 	return resp.Total, resp.Avail, resp.Err
 }
 
+func (msp *MockSysProvider) GetMountInfo(target string) (*MountInfo, error) {
+	if msp.cfg.GetMountInfoErr != nil {
+		return nil, msp.cfg.GetMountInfoErr
+	}
+
+	mi, found := msp.cfg.MountInfo[target]
+	if !found {
+		return nil, errors.Wrap(ErrNotMounted, target)
+	}
+	return mi, nil
+}
+
 func (msp *MockSysProvider) Stat(path string) (os.FileInfo, error) {
 	msp.RLock()
 	defer msp.RUnlock()
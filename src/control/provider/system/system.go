@@ -9,8 +9,13 @@ package system
 import (
 	"fmt"
 	"os/exec"
+
+	"github.com/pkg/errors"
 )
 
+// ErrNotMounted indicates that a path is not a mountpoint.
+var ErrNotMounted = errors.New("not a mountpoint")
+
 type (
 	// IsMountedProvider is the interface that wraps the IsMounted method,
 	// which can be provided by a system-specific implementation or a mock.
@@ -27,6 +32,21 @@ type (
 	UnmountProvider interface {
 		Unmount(target string, flags int) error
 	}
+	// MountInfoProvider is the interface that wraps the GetMountInfo
+	// method, which can be provided by a system-specific implementation
+	// or a mock.
+	MountInfoProvider interface {
+		GetMountInfo(target string) (*MountInfo, error)
+	}
+
+	// MountInfo describes the filesystem mounted at a mountpoint.
+	MountInfo struct {
+		MajorMinor string   // ID of the device backing the mount
+		MountPoint string   // Path of the mountpoint
+		FsType     string   // Filesystem type
+		Source     string   // Mount source (e.g. device path)
+		Options    []string // Per-mount and per-superblock mount options
+	}
 
 	// RunCmdError documents the output of a command that has been run.
 	RunCmdError struct {
@@ -43,3 +63,16 @@ func (rce *RunCmdError) Error() string {
 
 	return fmt.Sprintf("%s: stdout: %s", rce.Wrapped.Error(), rce.Stdout)
 }
+
+// HasOption returns true if the mount has the supplied option set.
+func (mi *MountInfo) HasOption(opt string) bool {
+	if mi == nil {
+		return false
+	}
+	for _, o := range mi.Options {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
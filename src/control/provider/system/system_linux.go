@@ -145,6 +145,64 @@ func (s LinuxProvider) IsMounted(target string) (bool, error) {
 	return scanMountInfo(mi, target, scanField)
 }
 
+// parseMountInfo returns details of the filesystem mounted at target from the
+// supplied mountinfo content. If the target has been mounted over more than
+// once, the most recent (visible) mount is returned.
+func parseMountInfo(input io.Reader, target string) (*MountInfo, error) {
+	var found *MountInfo
+
+	scn := bufio.NewScanner(input)
+	for scn.Scan() {
+		fields := strings.Fields(scn.Text())
+		if len(fields) < miNumFields || fields[miMountPoint] != target {
+			continue
+		}
+
+		// Optional fields are terminated by a single hyphen, followed by
+		// the filesystem type, mount source and superblock options.
+		sep := -1
+		for i := miNumFields + 1; i < len(fields); i++ {
+			if fields[i] == "-" {
+				sep = i
+				break
+			}
+		}
+		if sep < 0 || len(fields) < sep+4 {
+			return nil, errors.Errorf("malformed mountinfo entry for %q", target)
+		}
+
+		opts := strings.Split(fields[miNumFields], ",")
+		opts = append(opts, strings.Split(fields[sep+3], ",")...)
+		found = &MountInfo{
+			MajorMinor: fields[miMajorMinor],
+			MountPoint: fields[miMountPoint],
+			FsType:     fields[sep+1],
+			Source:     fields[sep+2],
+			Options:    opts,
+		}
+	}
+	if err := scn.Err(); err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, errors.Wrap(ErrNotMounted, target)
+	}
+	return found, nil
+}
+
+// GetMountInfo returns details of the filesystem mounted at the target
+// directory.
+func (s LinuxProvider) GetMountInfo(target string) (*MountInfo, error) {
+	mi, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return nil, err
+	}
+	defer mi.Close()
+
+	return parseMountInfo(mi, filepath.Clean(target))
+}
+
 // Mount provides an implementation of Mounter which calls the system implementation.
 func (s LinuxProvider) Mount(source, target, fstype string, flags uintptr, data string) error {
 	return unix.Mount(source, target, fstype, flags, data)
@@ -68,6 +68,93 @@ func TestScanMountInfo(t *testing.T) {
 	}
 }
 
+func TestParseMountInfo(t *testing.T) {
+	pmemMount := "98 39 259:0 / /mnt/daos0 rw,relatime shared:50 - ext4 /dev/pmem0 rw,dax,nodelalloc"
+
+	for name, tc := range map[string]struct {
+		input   string
+		target  string
+		expInfo *MountInfo
+		expErr  error
+	}{
+		"pmem mount": {
+			input:  pmemMount,
+			target: "/mnt/daos0",
+			expInfo: &MountInfo{
+				MajorMinor: "259:0",
+				MountPoint: "/mnt/daos0",
+				FsType:     "ext4",
+				Source:     "/dev/pmem0",
+				Options:    []string{"rw", "relatime", "rw", "dax", "nodelalloc"},
+			},
+		},
+		"no optional fields": {
+			input:  "99 39 0:50 / /mnt/daos1 rw,relatime - tmpfs tmpfs rw,size=16777216k",
+			target: "/mnt/daos1",
+			expInfo: &MountInfo{
+				MajorMinor: "0:50",
+				MountPoint: "/mnt/daos1",
+				FsType:     "tmpfs",
+				Source:     "tmpfs",
+				Options:    []string{"rw", "relatime", "rw", "size=16777216k"},
+			},
+		},
+		"stacked mounts": {
+			input: strings.Join([]string{
+				pmemMount,
+				"100 98 0:51 / /mnt/daos0 rw,relatime shared:51 - tmpfs tmpfs rw",
+			}, "\n"),
+			target: "/mnt/daos0",
+			expInfo: &MountInfo{
+				MajorMinor: "0:51",
+				MountPoint: "/mnt/daos0",
+				FsType:     "tmpfs",
+				Source:     "tmpfs",
+				Options:    []string{"rw", "relatime", "rw"},
+			},
+		},
+		"not mounted": {
+			input:  pmemMount,
+			target: "/mnt/daos1",
+			expErr: ErrNotMounted,
+		},
+		"malformed entry": {
+			input:  "98 39 259:0 / /mnt/daos0 rw,relatime shared:50 ext4",
+			target: "/mnt/daos0",
+			expErr: errors.New("malformed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotInfo, gotErr := parseMountInfo(strings.NewReader(tc.input), tc.target)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expInfo, gotInfo); diff != "" {
+				t.Fatalf("unexpected mount info (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestGetMountInfo(t *testing.T) {
+	provider := LinuxProvider{}
+
+	mi, err := provider.GetMountInfo("/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mi.MountPoint != "/" || mi.FsType == "" {
+		t.Fatalf("unexpected mount info for /: %+v", mi)
+	}
+
+	_, err = provider.GetMountInfo("/fooooooooooooooooo")
+	if !errors.Is(err, ErrNotMounted) {
+		t.Fatalf("expected %s, got %v", ErrNotMounted, err)
+	}
+}
+
 func TestIsMounted(t *testing.T) {
 	provider := LinuxProvider{}
 
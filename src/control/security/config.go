@@ -32,7 +32,12 @@ const (
 // TransportConfig contains all the information on whether or not to use
 // certificates and their location if their use is specified.
 type TransportConfig struct {
-	AllowInsecure     bool `yaml:"allow_insecure"`
+	AllowInsecure bool        `yaml:"allow_insecure"`
+	Grpc          *GrpcConfig `yaml:"grpc,omitempty"`
+	// CAKeyPath is only used by the management service, which signs the
+	// certificate requests of enrolling hosts with it when transport
+	// security is disabled.
+	CAKeyPath         string `yaml:"ca_key,omitempty"`
 	CertificateConfig `yaml:",inline"`
 }
 
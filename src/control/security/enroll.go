@@ -0,0 +1,288 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	certOrganization = "DAOS"
+	// certValidity is the lifetime of certificates signed by a CertSigner,
+	// matching that of those created by utils/certs/gen_certificates.sh.
+	certValidity = 1095 * 24 * time.Hour
+	// certClockSkew allows for small differences between the clocks of the
+	// signing and enrolling hosts.
+	certClockSkew = 5 * time.Minute
+
+	pemTypeCert = "CERTIFICATE"
+	pemTypeCSR  = "CERTIFICATE REQUEST"
+	pemTypeKey  = "PRIVATE KEY"
+)
+
+// certKeyBits is the size of generated RSA keys. Only RSA keys are supported
+// by LoadPrivateKey.
+var certKeyBits = 3072
+
+// enrollComponents are the components that may obtain a certificate by
+// enrolling with a CertSigner. Admin certificates are never issued this way.
+var enrollComponents = []Component{ComponentServer, ComponentAgent}
+
+func checkEnrollComponent(commonName string) (Component, error) {
+	comp := CommonNameToComponent(commonName)
+	for _, ec := range enrollComponents {
+		if comp == ec {
+			return comp, nil
+		}
+	}
+	return ComponentUndefined, errors.Errorf("certificates may not be issued for %q by enrollment", commonName)
+}
+
+// GeneratePrivateKey generates a new RSA private key and returns it in PEM
+// (PKCS #8) format.
+func GeneratePrivateKey() ([]byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, certKeyBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate private key")
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal private key")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeKey, Bytes: der}), nil
+}
+
+func parsePrivateKey(keyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("private key does not contain PEM data")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid private key")
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("unsupported private key type")
+	}
+
+	return rsaKey, nil
+}
+
+// NewCertificateRequest returns a PEM-encoded certificate signing request for
+// the given component, signed with the private key. The hosts, which may be
+// host names or IP addresses, are added as subject alternative names.
+func NewCertificateRequest(keyPEM []byte, comp Component, hosts ...string) ([]byte, error) {
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			Organization: []string{certOrganization},
+			CommonName:   comp.String(),
+		},
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+			continue
+		}
+		tmpl.DNSNames = append(tmpl.DNSNames, host)
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, tmpl, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create certificate request")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeCSR, Bytes: der}), nil
+}
+
+func decodePEMBlock(data []byte, pemType string) ([]byte, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.Errorf("no %s PEM block found", pemType)
+		}
+		if block.Type == pemType {
+			return block.Bytes, nil
+		}
+	}
+}
+
+// CertSigner signs certificate requests for enrolling components using a CA
+// certificate and key.
+type CertSigner struct {
+	caCert *x509.Certificate
+	caKey  crypto.Signer
+}
+
+// NewCertSigner returns a CertSigner using the PEM-encoded CA certificate and
+// key.
+func NewCertSigner(caCertPEM, caKeyPEM []byte) (*CertSigner, error) {
+	der, err := decodePEMBlock(caCertPEM, pemTypeCert)
+	if err != nil {
+		return nil, errors.Wrap(err, "CA certificate")
+	}
+	caCert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid CA certificate")
+	}
+	if !caCert.IsCA {
+		return nil, errors.New("CA certificate is not a certificate authority")
+	}
+	if time.Now().After(caCert.NotAfter) {
+		return nil, errors.Errorf("CA certificate expired at %s", caCert.NotAfter)
+	}
+
+	caKey, err := parsePrivateKey(caKeyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "CA key")
+	}
+	if _, err := tls.X509KeyPair(caCertPEM, caKeyPEM); err != nil {
+		return nil, errors.Wrap(err, "CA certificate and key do not match")
+	}
+
+	return &CertSigner{caCert: caCert, caKey: caKey}, nil
+}
+
+// LoadCertSigner returns a CertSigner using the CA certificate and key at the
+// given paths, checking the file permissions in the same way as when loading
+// certificates for transport security.
+func LoadCertSigner(caCertPath, caKeyPath string) (*CertSigner, error) {
+	caCertPEM, err := LoadPEMData(caCertPath, MaxCertPerm)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load CA certificate")
+	}
+	caKeyPEM, err := LoadPEMData(caKeyPath, MaxKeyPerm)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load CA key")
+	}
+
+	return NewCertSigner(caCertPEM, caKeyPEM)
+}
+
+// Sign validates the PEM-encoded certificate request and returns the
+// PEM-encoded certificate issued for it. Only server and agent certificates
+// may be issued.
+func (cs *CertSigner) Sign(csrPEM []byte) ([]byte, error) {
+	if cs == nil {
+		return nil, errors.New("nil CertSigner")
+	}
+
+	der, err := decodePEMBlock(csrPEM, pemTypeCSR)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid certificate request")
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, errors.Wrap(err, "invalid certificate request signature")
+	}
+
+	comp, err := checkEnrollComponent(csr.Subject.CommonName)
+	if err != nil {
+		return nil, err
+	}
+	extKeyUsage := []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	if comp == ComponentServer {
+		extKeyUsage = append(extKeyUsage, x509.ExtKeyUsageServerAuth)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serial number")
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{certOrganization},
+			CommonName:   comp.String(),
+		},
+		NotBefore:             now.Add(-certClockSkew),
+		NotAfter:              now.Add(certValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           extKeyUsage,
+		BasicConstraintsValid: true,
+		DNSNames:              csr.DNSNames,
+		IPAddresses:           csr.IPAddresses,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, cs.caCert, csr.PublicKey, cs.caKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign certificate")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeCert, Bytes: certDER}), nil
+}
+
+// VerifyCertificate checks that the PEM-encoded certificate was issued for the
+// given component, matches the private key and is trusted by the CA bundle.
+// Only the first certificate in certPEM is checked; any others are treated as
+// intermediates.
+func VerifyCertificate(certPEM, keyPEM, caPEM []byte, comp Component) error {
+	keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return errors.Wrap(err, "certificate does not match private key")
+	}
+
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return errors.Wrap(err, "invalid certificate")
+	}
+	if cert.Subject.CommonName != comp.String() {
+		return errors.Errorf("certificate was issued for %q, expected %q",
+			cert.Subject.CommonName, comp.String())
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caPEM) {
+		return errors.New("CA bundle contains no certificates")
+	}
+	intermediates := x509.NewCertPool()
+	for _, der := range keyPair.Certificate[1:] {
+		ic, err := x509.ParseCertificate(der)
+		if err != nil {
+			return errors.Wrap(err, "invalid intermediate certificate")
+		}
+		intermediates.AddCert(ic)
+	}
+
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return errors.Wrap(err, "certificate is not trusted by CA bundle")
+	}
+
+	return nil
+}
@@ -0,0 +1,348 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func testSigner(t *testing.T, caCert, caKey []byte) *CertSigner {
+	t.Helper()
+
+	signer, err := NewCertSigner(caCert, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+func testCSR(t *testing.T, key []byte, comp Component, hosts ...string) []byte {
+	t.Helper()
+
+	csr, err := NewCertificateRequest(key, comp, hosts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return csr
+}
+
+func readTestFile(t *testing.T, path string) []byte {
+	t.Helper()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestSecurity_NewCertificateRequest(t *testing.T) {
+	key := MockPrivateKey(t)
+
+	for name, tc := range map[string]struct {
+		key    []byte
+		comp   Component
+		hosts  []string
+		expDNS []string
+		expIPs []string
+		expErr error
+	}{
+		"bad key": {
+			key:    []byte("garbage"),
+			comp:   ComponentServer,
+			expErr: errors.New("does not contain PEM data"),
+		},
+		"no hosts": {
+			key:  key,
+			comp: ComponentServer,
+		},
+		"hosts and addresses": {
+			key:    key,
+			comp:   ComponentAgent,
+			hosts:  []string{"host1", "10.0.0.1", "host1.example.com"},
+			expDNS: []string{"host1", "host1.example.com"},
+			expIPs: []string{"10.0.0.1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			csrPEM, gotErr := NewCertificateRequest(tc.key, tc.comp, tc.hosts...)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			block, _ := pem.Decode(csrPEM)
+			if block == nil || block.Type != pemTypeCSR {
+				t.Fatalf("unexpected PEM data: %s", csrPEM)
+			}
+			csr, err := x509.ParseCertificateRequest(block.Bytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, tc.comp.String(), csr.Subject.CommonName, "bad common name")
+			if diff := cmp.Diff([]string{certOrganization}, csr.Subject.Organization); diff != "" {
+				t.Fatalf("unexpected organization (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expDNS, csr.DNSNames); diff != "" {
+				t.Fatalf("unexpected DNS names (-want, +got):\n%s\n", diff)
+			}
+			var gotIPs []string
+			for _, ip := range csr.IPAddresses {
+				gotIPs = append(gotIPs, ip.String())
+			}
+			if diff := cmp.Diff(tc.expIPs, gotIPs); diff != "" {
+				t.Fatalf("unexpected IP addresses (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSecurity_NewCertSigner(t *testing.T) {
+	caCert, caKey := MockCA(t)
+	otherKey := MockPrivateKey(t)
+
+	for name, tc := range map[string]struct {
+		caCert []byte
+		caKey  []byte
+		expErr error
+	}{
+		"bad CA cert": {
+			caCert: []byte("garbage"),
+			caKey:  caKey,
+			expErr: errors.New("no CERTIFICATE PEM block"),
+		},
+		"not a CA": {
+			caCert: readTestFile(t, "testdata/certs/server.crt"),
+			caKey:  readTestFile(t, "testdata/certs/server.key"),
+			expErr: errors.New("not a certificate authority"),
+		},
+		"expired CA": {
+			caCert: readTestFile(t, "testdata/certs/daosCA.crt"),
+			caKey:  readTestFile(t, "testdata/certs/daosCA.key"),
+			expErr: errors.New("CA certificate expired"),
+		},
+		"bad CA key": {
+			caCert: caCert,
+			caKey:  []byte("garbage"),
+			expErr: errors.New("CA key"),
+		},
+		"mismatched key": {
+			caCert: caCert,
+			caKey:  otherKey,
+			expErr: errors.New("do not match"),
+		},
+		"success": {
+			caCert: caCert,
+			caKey:  caKey,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := NewCertSigner(tc.caCert, tc.caKey)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestSecurity_LoadCertSigner(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	caCert, caKey := MockCA(t)
+	caCertPath := filepath.Join(tmpDir, "daosCA.crt")
+	if err := ioutil.WriteFile(caCertPath, caCert, 0644); err != nil {
+		t.Fatal(err)
+	}
+	caKeyPath := filepath.Join(tmpDir, "daosCA.key")
+	if err := ioutil.WriteFile(caKeyPath, caKey, 0400); err != nil {
+		t.Fatal(err)
+	}
+	badPermsKeyPath := filepath.Join(tmpDir, "badperms.key")
+	if err := ioutil.WriteFile(badPermsKeyPath, caKey, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		certPath string
+		keyPath  string
+		expErr   error
+	}{
+		"missing cert": {
+			certPath: filepath.Join(tmpDir, "missing.crt"),
+			keyPath:  caKeyPath,
+			expErr:   errors.New("could not load CA certificate"),
+		},
+		"insecure key permissions": {
+			certPath: caCertPath,
+			keyPath:  badPermsKeyPath,
+			expErr:   errors.New("insecure permissions"),
+		},
+		"success": {
+			certPath: caCertPath,
+			keyPath:  caKeyPath,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := LoadCertSigner(tc.certPath, tc.keyPath)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestSecurity_CertSigner_Sign(t *testing.T) {
+	caCert, caKey := MockCA(t)
+	signer := testSigner(t, caCert, caKey)
+	key := MockPrivateKey(t)
+
+	for name, tc := range map[string]struct {
+		signer         *CertSigner
+		csr            []byte
+		expExtKeyUsage []x509.ExtKeyUsage
+		expErr         error
+	}{
+		"nil signer": {
+			csr:    testCSR(t, key, ComponentServer),
+			expErr: errors.New("nil CertSigner"),
+		},
+		"no CSR": {
+			signer: signer,
+			csr:    []byte("garbage"),
+			expErr: errors.New("no CERTIFICATE REQUEST PEM block"),
+		},
+		"admin CSR": {
+			signer: signer,
+			csr:    testCSR(t, key, ComponentAdmin),
+			expErr: errors.New("may not be issued for \"admin\""),
+		},
+		"server CSR": {
+			signer:         signer,
+			csr:            testCSR(t, key, ComponentServer, "host1", "10.0.0.1"),
+			expExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		},
+		"agent CSR": {
+			signer:         signer,
+			csr:            testCSR(t, key, ComponentAgent),
+			expExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			certPEM, gotErr := tc.signer.Sign(tc.csr)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			block, _ := pem.Decode(certPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expExtKeyUsage, cert.ExtKeyUsage); diff != "" {
+				t.Fatalf("unexpected ext key usage (-want, +got):\n%s\n", diff)
+			}
+			if cert.IsCA {
+				t.Fatal("issued certificate is a CA")
+			}
+			if err := cert.CheckSignatureFrom(signer.caCert); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestSecurity_CertSigner_Sign_SANs(t *testing.T) {
+	caCert, caKey := MockCA(t)
+	signer := testSigner(t, caCert, caKey)
+
+	certPEM, err := signer.Sign(testCSR(t, MockPrivateKey(t), ComponentServer, "host1", "10.0.0.1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"host1"}, cert.DNSNames); diff != "" {
+		t.Fatalf("unexpected DNS names (-want, +got):\n%s\n", diff)
+	}
+	if len(cert.IPAddresses) != 1 || !cert.IPAddresses[0].Equal(net.ParseIP("10.0.0.1")) {
+		t.Fatalf("unexpected IP addresses: %v", cert.IPAddresses)
+	}
+}
+
+func TestSecurity_VerifyCertificate(t *testing.T) {
+	caCert, caKey := MockCA(t)
+	signer := testSigner(t, caCert, caKey)
+	otherCACert, _ := MockCA(t)
+	key := MockPrivateKey(t)
+	otherKey := MockPrivateKey(t)
+
+	serverCert, err := signer.Sign(testCSR(t, key, ComponentServer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		cert   []byte
+		key    []byte
+		ca     []byte
+		comp   Component
+		expErr error
+	}{
+		"mismatched key": {
+			cert:   serverCert,
+			key:    otherKey,
+			ca:     caCert,
+			comp:   ComponentServer,
+			expErr: errors.New("does not match private key"),
+		},
+		"wrong component": {
+			cert:   serverCert,
+			key:    key,
+			ca:     caCert,
+			comp:   ComponentAgent,
+			expErr: errors.New("issued for \"server\", expected \"agent\""),
+		},
+		"empty CA bundle": {
+			cert:   serverCert,
+			key:    key,
+			ca:     []byte{},
+			comp:   ComponentServer,
+			expErr: errors.New("contains no certificates"),
+		},
+		"untrusted": {
+			cert:   serverCert,
+			key:    key,
+			ca:     otherCACert,
+			comp:   ComponentServer,
+			expErr: errors.New("not trusted by CA bundle"),
+		},
+		"success": {
+			cert: serverCert,
+			key:  key,
+			ca:   caCert,
+			comp: ComponentServer,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := VerifyCertificate(tc.cert, tc.key, tc.ca, tc.comp)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
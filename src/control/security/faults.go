@@ -19,6 +19,11 @@ var (
 		"unknown security error",
 		"",
 	)
+	FaultCertEnrollmentDisabled = securityFault(
+		code.SecurityCertEnrollmentDisabled,
+		"certificate enrollment through the management service is not enabled",
+		"set transport_config.ca_key in the server config file of the management service replicas (only supported with allow_insecure), or enroll with an external CA",
+	)
 )
 
 func FaultMissingCertFile(filePath string) *fault.Fault {
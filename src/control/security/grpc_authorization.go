@@ -6,6 +6,8 @@
 
 package security
 
+import "strings"
+
 // Component represents the DAOS component being granted authorization.
 type Component int
 
@@ -14,43 +16,72 @@ const (
 	ComponentAdmin
 	ComponentAgent
 	ComponentServer
+	ComponentDebug
+	ComponentSupport
+	ComponentPoolAdmin
 )
 
+// poolAdminPrefix is the CommonName prefix of certificates issued to
+// principals that have been delegated the administration of pools, e.g.
+// "pool-admin:alice".
+const poolAdminPrefix = "pool-admin:"
+
 func (c Component) String() string {
-	return [...]string{"undefined", "admin", "agent", "server"}[c]
+	return [...]string{"undefined", "admin", "agent", "server", "debug", "support", "pool-admin"}[c]
 }
 
 // methodAuthorizations is the map for checking which components are authorized to make the specific method call.
 var methodAuthorizations = map[string][]Component{
 	"/ctl.CtlSvc/StorageScan":              {ComponentAdmin},
+	"/ctl.CtlSvc/StorageScanStream":        {ComponentAdmin},
 	"/ctl.CtlSvc/StorageFormat":            {ComponentAdmin},
 	"/ctl.CtlSvc/StorageNvmeRebind":        {ComponentAdmin},
 	"/ctl.CtlSvc/StorageNvmeAddDevice":     {ComponentAdmin},
+	"/ctl.CtlSvc/StorageSpdkRpc":           {ComponentDebug},
 	"/ctl.CtlSvc/NetworkScan":              {ComponentAdmin},
 	"/ctl.CtlSvc/FirmwareQuery":            {ComponentAdmin},
 	"/ctl.CtlSvc/FirmwareUpdate":           {ComponentAdmin},
+	"/ctl.CtlSvc/BlobstoreQuery":           {ComponentAdmin},
 	"/ctl.CtlSvc/SmdQuery":                 {ComponentAdmin},
+	"/ctl.CtlSvc/SmdQueryStream":           {ComponentAdmin},
 	"/ctl.CtlSvc/SmdManage":                {ComponentAdmin},
 	"/ctl.CtlSvc/SetEngineLogMasks":        {ComponentAdmin},
+	"/ctl.CtlSvc/FaultDomainQuery":         {ComponentAdmin},
+	"/ctl.CtlSvc/LogStream":                {ComponentAdmin},
 	"/ctl.CtlSvc/PrepShutdownRanks":        {ComponentServer},
 	"/ctl.CtlSvc/StopRanks":                {ComponentServer},
 	"/ctl.CtlSvc/PingRanks":                {ComponentServer},
 	"/ctl.CtlSvc/ResetFormatRanks":         {ComponentServer},
 	"/ctl.CtlSvc/StartRanks":               {ComponentServer},
+	"/ctl.CtlSvc/FaultInject":              {ComponentAdmin},
+	"/ctl.CtlSvc/SupportExec":              {ComponentSupport},
+	"/ctl.CtlSvc/PoolDebug":                {ComponentAdmin},
+	"/ctl.CtlSvc/SetTelemetryClasses":      {ComponentAdmin},
+	"/ctl.CtlSvc/DumpEngineStacks":         {ComponentAdmin},
 	"/mgmt.MgmtSvc/Join":                   {ComponentServer},
+	"/mgmt.MgmtSvc/JoinStream":             {ComponentServer},
+	"/mgmt.MgmtSvc/Heartbeat":              {ComponentServer},
 	"/mgmt.MgmtSvc/ClusterEvent":           {ComponentServer},
-	"/mgmt.MgmtSvc/LeaderQuery":            {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemEventStream":      {ComponentAdmin, ComponentAgent},
+	"/mgmt.MgmtSvc/LeaderQuery":            {ComponentAdmin, ComponentAgent},
 	"/mgmt.MgmtSvc/SystemQuery":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemErase":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemStart":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemStop":             {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemExclude":          {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolCreate":             {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolCreateStatus":       {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolDestroy":            {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolQuery":              {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolQueryTarget":        {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolSetProp":            {ComponentAdmin},
-	"/mgmt.MgmtSvc/PoolGetProp":            {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolCleanupPartial":     {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolProbe":              {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolQuery":              {ComponentAdmin, ComponentPoolAdmin},
+	"/mgmt.MgmtSvc/PoolQueryTarget":        {ComponentAdmin, ComponentPoolAdmin},
+	"/mgmt.MgmtSvc/PoolAnnotate":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolSetAdmins":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolSetProp":            {ComponentAdmin, ComponentPoolAdmin},
+	"/mgmt.MgmtSvc/PoolGetProp":            {ComponentAdmin, ComponentPoolAdmin},
+	"/mgmt.MgmtSvc/PoolSetPolicy":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolQueryAggregation":   {ComponentAdmin, ComponentPoolAdmin},
 	"/mgmt.MgmtSvc/PoolGetACL":             {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolOverwriteACL":       {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolUpdateACL":          {ComponentAdmin},
@@ -59,17 +90,28 @@ var methodAuthorizations = map[string][]Component{
 	"/mgmt.MgmtSvc/PoolDrain":              {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolReintegrate":        {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolEvict":              {ComponentAdmin, ComponentAgent},
-	"/mgmt.MgmtSvc/PoolExtend":             {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolExtend":             {ComponentAdmin, ComponentPoolAdmin},
 	"/mgmt.MgmtSvc/GetAttachInfo":          {ComponentAgent},
 	"/mgmt.MgmtSvc/ListPools":              {ComponentAdmin},
 	"/mgmt.MgmtSvc/ListContainers":         {ComponentAdmin},
 	"/mgmt.MgmtSvc/ContSetOwner":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/ContCheck":              {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemCleanup":          {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolUpgrade":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemSetAttr":          {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemGetAttr":          {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemSetProp":          {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemGetProp":          {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemDbVerify":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemDbStatus":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemDbBackup":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemDbRestore":        {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemAnnotate":         {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemSetMemberAlias":   {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemAddReplica":       {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemRemoveReplica":    {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemSignCert":         {ComponentServer},
+	"/mgmt.MgmtSvc/Noop":                   {ComponentAdmin},
 	"/RaftTransport/AppendEntries":         {ComponentServer},
 	"/RaftTransport/AppendEntriesPipeline": {ComponentServer},
 	"/RaftTransport/RequestVote":           {ComponentServer},
@@ -104,7 +146,23 @@ func CommonNameToComponent(commonname string) Component {
 		return ComponentAgent
 	case commonname == ComponentServer.String():
 		return ComponentServer
+	case commonname == ComponentDebug.String():
+		return ComponentDebug
+	case commonname == ComponentSupport.String():
+		return ComponentSupport
+	case PoolAdminPrincipal(commonname) != "":
+		return ComponentPoolAdmin
 	default:
 		return ComponentUndefined
 	}
 }
+
+// PoolAdminPrincipal returns the principal named in the CommonName of a
+// delegated pool administrator certificate, or an empty string if the
+// CommonName is not that of a pool administrator.
+func PoolAdminPrincipal(commonname string) string {
+	if !strings.HasPrefix(commonname, poolAdminPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(commonname, poolAdminPrefix)
+}
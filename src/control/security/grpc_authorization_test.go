@@ -27,6 +27,11 @@ func TestSecurity_CommonNameToComponent(t *testing.T) {
 		{"AdminPrefix", "administrator", ComponentUndefined},
 		{"AgentCN", "agent", ComponentAgent},
 		{"ServerCN", "server", ComponentServer},
+		{"DebugCN", "debug", ComponentDebug},
+		{"SupportCN", "support", ComponentSupport},
+		{"PoolAdminCN", "pool-admin:alice", ComponentPoolAdmin},
+		{"PoolAdminNoPrincipal", "pool-admin:", ComponentUndefined},
+		{"PoolAdminNoSeparator", "pool-admin", ComponentUndefined},
 		{"UnknownCN", "knownbadvalue", ComponentUndefined},
 	}
 
@@ -48,37 +53,58 @@ func inList(c Component, compList []Component) bool {
 	return false
 }
 func TestSecurity_ComponentHasAccess(t *testing.T) {
-	allComponents := []Component{ComponentUndefined, ComponentAdmin, ComponentAgent, ComponentServer}
+	allComponents := []Component{ComponentUndefined, ComponentAdmin, ComponentAgent, ComponentServer, ComponentDebug, ComponentSupport, ComponentPoolAdmin}
 	testCases := map[string][]Component{
 		"/ctl.CtlSvc/StorageScan":              {ComponentAdmin},
+		"/ctl.CtlSvc/StorageScanStream":        {ComponentAdmin},
 		"/ctl.CtlSvc/StorageFormat":            {ComponentAdmin},
 		"/ctl.CtlSvc/StorageNvmeRebind":        {ComponentAdmin},
 		"/ctl.CtlSvc/StorageNvmeAddDevice":     {ComponentAdmin},
+		"/ctl.CtlSvc/StorageSpdkRpc":           {ComponentDebug},
 		"/ctl.CtlSvc/NetworkScan":              {ComponentAdmin},
 		"/ctl.CtlSvc/FirmwareQuery":            {ComponentAdmin},
 		"/ctl.CtlSvc/FirmwareUpdate":           {ComponentAdmin},
+		"/ctl.CtlSvc/BlobstoreQuery":           {ComponentAdmin},
 		"/ctl.CtlSvc/SmdQuery":                 {ComponentAdmin},
+		"/ctl.CtlSvc/SmdQueryStream":           {ComponentAdmin},
 		"/ctl.CtlSvc/SmdManage":                {ComponentAdmin},
 		"/ctl.CtlSvc/SetEngineLogMasks":        {ComponentAdmin},
+		"/ctl.CtlSvc/FaultDomainQuery":         {ComponentAdmin},
+		"/ctl.CtlSvc/LogStream":                {ComponentAdmin},
 		"/ctl.CtlSvc/PrepShutdownRanks":        {ComponentServer},
 		"/ctl.CtlSvc/StopRanks":                {ComponentServer},
 		"/ctl.CtlSvc/PingRanks":                {ComponentServer},
 		"/ctl.CtlSvc/ResetFormatRanks":         {ComponentServer},
 		"/ctl.CtlSvc/StartRanks":               {ComponentServer},
+		"/ctl.CtlSvc/FaultInject":              {ComponentAdmin},
+		"/ctl.CtlSvc/SupportExec":              {ComponentSupport},
+		"/ctl.CtlSvc/PoolDebug":                {ComponentAdmin},
+		"/ctl.CtlSvc/SetTelemetryClasses":      {ComponentAdmin},
+		"/ctl.CtlSvc/DumpEngineStacks":         {ComponentAdmin},
 		"/mgmt.MgmtSvc/Join":                   {ComponentServer},
+		"/mgmt.MgmtSvc/JoinStream":             {ComponentServer},
+		"/mgmt.MgmtSvc/Heartbeat":              {ComponentServer},
 		"/mgmt.MgmtSvc/ClusterEvent":           {ComponentServer},
-		"/mgmt.MgmtSvc/LeaderQuery":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemEventStream":      {ComponentAdmin, ComponentAgent},
+		"/mgmt.MgmtSvc/LeaderQuery":            {ComponentAdmin, ComponentAgent},
 		"/mgmt.MgmtSvc/SystemQuery":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemStop":             {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemErase":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemStart":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemExclude":          {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolCreate":             {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolCreateStatus":       {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolDestroy":            {ComponentAdmin},
-		"/mgmt.MgmtSvc/PoolQuery":              {ComponentAdmin},
-		"/mgmt.MgmtSvc/PoolQueryTarget":        {ComponentAdmin},
-		"/mgmt.MgmtSvc/PoolSetProp":            {ComponentAdmin},
-		"/mgmt.MgmtSvc/PoolGetProp":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolCleanupPartial":     {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolProbe":              {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolQuery":              {ComponentAdmin, ComponentPoolAdmin},
+		"/mgmt.MgmtSvc/PoolQueryTarget":        {ComponentAdmin, ComponentPoolAdmin},
+		"/mgmt.MgmtSvc/PoolAnnotate":           {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolSetAdmins":          {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolSetProp":            {ComponentAdmin, ComponentPoolAdmin},
+		"/mgmt.MgmtSvc/PoolGetProp":            {ComponentAdmin, ComponentPoolAdmin},
+		"/mgmt.MgmtSvc/PoolSetPolicy":          {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolQueryAggregation":   {ComponentAdmin, ComponentPoolAdmin},
 		"/mgmt.MgmtSvc/PoolGetACL":             {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolOverwriteACL":       {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolUpdateACL":          {ComponentAdmin},
@@ -87,17 +113,28 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/mgmt.MgmtSvc/PoolDrain":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolReintegrate":        {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolEvict":              {ComponentAdmin, ComponentAgent},
-		"/mgmt.MgmtSvc/PoolExtend":             {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolExtend":             {ComponentAdmin, ComponentPoolAdmin},
 		"/mgmt.MgmtSvc/GetAttachInfo":          {ComponentAgent},
 		"/mgmt.MgmtSvc/ListPools":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/ListContainers":         {ComponentAdmin},
 		"/mgmt.MgmtSvc/ContSetOwner":           {ComponentAdmin},
+		"/mgmt.MgmtSvc/ContCheck":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemCleanup":          {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolUpgrade":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemSetAttr":          {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemGetAttr":          {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemSetProp":          {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemGetProp":          {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemDbVerify":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemDbStatus":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemDbBackup":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemDbRestore":        {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemAnnotate":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemSetMemberAlias":   {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemAddReplica":       {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemRemoveReplica":    {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemSignCert":         {ComponentServer},
+		"/mgmt.MgmtSvc/Noop":                   {ComponentAdmin},
 		"/RaftTransport/AppendEntries":         {ComponentServer},
 		"/RaftTransport/AppendEntriesPipeline": {ComponentServer},
 		"/RaftTransport/RequestVote":           {ComponentServer},
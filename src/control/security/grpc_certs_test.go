@@ -0,0 +1,172 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+// mockMgmtSvc is a minimal management service used to exercise a mutually
+// authenticated round trip.
+type mockMgmtSvc struct {
+	mgmtpb.UnimplementedMgmtSvcServer
+}
+
+func (*mockMgmtSvc) LeaderQuery(context.Context, *mgmtpb.LeaderQueryReq) (*mgmtpb.LeaderQueryResp, error) {
+	return &mgmtpb.LeaderQueryResp{}, nil
+}
+
+// mockTransportConfig writes the certificate and key to the directory and
+// returns a TransportConfig that uses them.
+func mockTransportConfig(t *testing.T, dir, name string, caCert, cert, key []byte) *TransportConfig {
+	t.Helper()
+
+	tc := &TransportConfig{
+		CertificateConfig: CertificateConfig{
+			ServerName:      defaultServer,
+			CARootPath:      filepath.Join(dir, name+"CA.crt"),
+			CertificatePath: filepath.Join(dir, name+".crt"),
+			PrivateKeyPath:  filepath.Join(dir, name+".key"),
+		},
+	}
+	for path, data := range map[string][]byte{
+		tc.CARootPath:      caCert,
+		tc.CertificatePath: cert,
+	} {
+		if err := ioutil.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(tc.PrivateKeyPath, key, 0400); err != nil {
+		t.Fatal(err)
+	}
+
+	return tc
+}
+
+func TestSecurity_MutualTLS(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	caCert, caKey := MockCA(t)
+	otherCACert, otherCAKey := MockCA(t)
+
+	mockCert := func(name string, caCert, caKey []byte, cn string) *TransportConfig {
+		cert, key := MockCertificate(t, caCert, caKey, cn)
+		return mockTransportConfig(t, tmpDir, name, caCert, cert, key)
+	}
+	serverCfg := mockCert("server", caCert, caKey, ComponentServer.String())
+	// A trusted certificate that is not a server certificate.
+	notServerCfg := mockCert("notserver", caCert, caKey, ComponentAgent.String())
+	untrustedCert, untrustedKey := MockCertificate(t, otherCACert, otherCAKey, ComponentAdmin.String())
+
+	for name, tc := range map[string]struct {
+		serverCfg *TransportConfig
+		clientCfg *TransportConfig
+		noCert    bool
+		expComp   Component
+		expErr    error
+	}{
+		"admin": {
+			serverCfg: serverCfg,
+			clientCfg: mockCert("admin", caCert, caKey, ComponentAdmin.String()),
+			expComp:   ComponentAdmin,
+		},
+		"agent": {
+			serverCfg: serverCfg,
+			clientCfg: mockCert("agent", caCert, caKey, ComponentAgent.String()),
+			expComp:   ComponentAgent,
+		},
+		"unknown component": {
+			serverCfg: serverCfg,
+			clientCfg: mockCert("unknown", caCert, caKey, "intruder"),
+			expComp:   ComponentUndefined,
+		},
+		"no client certificate": {
+			serverCfg: serverCfg,
+			clientCfg: mockCert("nocert", caCert, caKey, ComponentAdmin.String()),
+			noCert:    true,
+			expErr:    errors.New("Unavailable"),
+		},
+		"untrusted client certificate": {
+			serverCfg: serverCfg,
+			clientCfg: mockTransportConfig(t, tmpDir, "untrusted", caCert, untrustedCert, untrustedKey),
+			expErr:    errors.New("Unavailable"),
+		},
+		"server does not identify as server": {
+			serverCfg: notServerCfg,
+			clientCfg: mockCert("admin2", caCert, caKey, ComponentAdmin.String()),
+			expErr:    errors.New("does not identify as Server"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotComp Component
+			interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				p, ok := peer.FromContext(ctx)
+				if !ok {
+					return nil, errors.New("no peer")
+				}
+				chains := p.AuthInfo.(credentials.TLSInfo).State.VerifiedChains
+				gotComp = CommonNameToComponent(chains[0][0].Subject.CommonName)
+				return handler(ctx, req)
+			}
+
+			srvOpt, err := ServerOptionForTransportConfig(tc.serverCfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			srv := grpc.NewServer(srvOpt, grpc.UnaryInterceptor(interceptor))
+			mgmtpb.RegisterMgmtSvcServer(srv, &mockMgmtSvc{})
+
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			go srv.Serve(lis)
+			defer srv.Stop()
+
+			dialOpt, err := DialOptionForTransportConfig(tc.clientCfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.noCert {
+				tlsCfg := clientTLSConfig(tc.clientCfg)
+				tlsCfg.Certificates = nil
+				dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg))
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			conn, err := grpc.DialContext(ctx, lis.Addr().String(), dialOpt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			_, gotErr := mgmtpb.NewMgmtSvcClient(conn).LeaderQuery(ctx, &mgmtpb.LeaderQueryReq{})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expComp, gotComp, "unexpected component")
+		})
+	}
+}
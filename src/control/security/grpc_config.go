@@ -0,0 +1,119 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// minKeepaliveTime is the shortest keepalive interval honored by gRPC clients.
+const minKeepaliveTime = 10 * time.Second
+
+// GrpcConfig contains optional tuning parameters for the gRPC connections made
+// or accepted by a control plane component. Unset parameters retain the gRPC
+// defaults. Message sizes are in MiB.
+type GrpcConfig struct {
+	KeepaliveTime        time.Duration `yaml:"keepalive_time,omitempty"`
+	KeepaliveTimeout     time.Duration `yaml:"keepalive_timeout,omitempty"`
+	MaxRecvMsgSize       int           `yaml:"max_recv_msg_size,omitempty"`
+	MaxSendMsgSize       int           `yaml:"max_send_msg_size,omitempty"`
+	MaxConcurrentStreams uint32        `yaml:"max_concurrent_streams,omitempty"`
+}
+
+// Validate ensures that the gRPC tuning parameters are usable.
+func (gc *GrpcConfig) Validate() error {
+	if gc == nil {
+		return nil
+	}
+
+	switch {
+	case gc.KeepaliveTime < 0 || gc.KeepaliveTimeout < 0:
+		return errors.New("keepalive_time and keepalive_timeout must not be negative")
+	case gc.KeepaliveTime > 0 && gc.KeepaliveTime < minKeepaliveTime:
+		return errors.Errorf("keepalive_time must be at least %s", minKeepaliveTime)
+	case gc.KeepaliveTimeout > 0 && gc.KeepaliveTime == 0:
+		return errors.New("keepalive_timeout requires keepalive_time to be set")
+	case gc.MaxRecvMsgSize < 0 || gc.MaxSendMsgSize < 0:
+		return errors.New("max_recv_msg_size and max_send_msg_size must not be negative")
+	}
+
+	return nil
+}
+
+// ServerOptions returns the gRPC server options for the tuning parameters.
+// A server permits its clients to send keepalive pings as often as its own
+// keepalive interval.
+func (gc *GrpcConfig) ServerOptions() ([]grpc.ServerOption, error) {
+	if gc == nil {
+		return nil, nil
+	}
+	if err := gc.Validate(); err != nil {
+		return nil, err
+	}
+
+	var opts []grpc.ServerOption
+	if gc.KeepaliveTime > 0 {
+		opts = append(opts,
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				Time:    gc.KeepaliveTime,
+				Timeout: gc.KeepaliveTimeout,
+			}),
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+				MinTime:             gc.KeepaliveTime,
+				PermitWithoutStream: true,
+			}))
+	}
+	if gc.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(gc.MaxRecvMsgSize*humanize.MiByte))
+	}
+	if gc.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(gc.MaxSendMsgSize*humanize.MiByte))
+	}
+	if gc.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(gc.MaxConcurrentStreams))
+	}
+
+	return opts, nil
+}
+
+// DialOptions returns the gRPC client dial options for the tuning parameters.
+// The maximum number of concurrent streams only applies to servers.
+func (gc *GrpcConfig) DialOptions() ([]grpc.DialOption, error) {
+	if gc == nil {
+		return nil, nil
+	}
+	if err := gc.Validate(); err != nil {
+		return nil, err
+	}
+
+	var opts []grpc.DialOption
+	if gc.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                gc.KeepaliveTime,
+			Timeout:             gc.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	var callOpts []grpc.CallOption
+	if gc.MaxRecvMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(gc.MaxRecvMsgSize*humanize.MiByte))
+	}
+	if gc.MaxSendMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(gc.MaxSendMsgSize*humanize.MiByte))
+	}
+	if len(callOpts) > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,137 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSecurity_GrpcConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *GrpcConfig
+		expErr error
+	}{
+		"nil config": {},
+		"empty config": {
+			cfg: &GrpcConfig{},
+		},
+		"valid config": {
+			cfg: &GrpcConfig{
+				KeepaliveTime:        time.Minute,
+				KeepaliveTimeout:     20 * time.Second,
+				MaxRecvMsgSize:       16,
+				MaxSendMsgSize:       16,
+				MaxConcurrentStreams: 100,
+			},
+		},
+		"negative keepalive time": {
+			cfg:    &GrpcConfig{KeepaliveTime: -time.Minute},
+			expErr: errors.New("must not be negative"),
+		},
+		"keepalive time too short": {
+			cfg:    &GrpcConfig{KeepaliveTime: time.Second},
+			expErr: errors.New("at least 10s"),
+		},
+		"keepalive timeout without time": {
+			cfg:    &GrpcConfig{KeepaliveTimeout: time.Second},
+			expErr: errors.New("requires keepalive_time"),
+		},
+		"negative message size": {
+			cfg:    &GrpcConfig{MaxRecvMsgSize: -1},
+			expErr: errors.New("must not be negative"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+func TestSecurity_GrpcConfig_Options(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg         *GrpcConfig
+		expSrvOpts  int
+		expDialOpts int
+		expErr      error
+	}{
+		"nil config": {},
+		"empty config": {
+			cfg: &GrpcConfig{},
+		},
+		"keepalive only": {
+			cfg:         &GrpcConfig{KeepaliveTime: time.Minute},
+			expSrvOpts:  2,
+			expDialOpts: 1,
+		},
+		"message sizes only": {
+			cfg:         &GrpcConfig{MaxRecvMsgSize: 8, MaxSendMsgSize: 8},
+			expSrvOpts:  2,
+			expDialOpts: 1,
+		},
+		"streams only": {
+			cfg:        &GrpcConfig{MaxConcurrentStreams: 10},
+			expSrvOpts: 1,
+		},
+		"all set": {
+			cfg: &GrpcConfig{
+				KeepaliveTime:        time.Minute,
+				KeepaliveTimeout:     time.Minute,
+				MaxRecvMsgSize:       8,
+				MaxSendMsgSize:       8,
+				MaxConcurrentStreams: 10,
+			},
+			expSrvOpts:  5,
+			expDialOpts: 2,
+		},
+		"invalid config": {
+			cfg:    &GrpcConfig{KeepaliveTime: time.Second},
+			expErr: errors.New("at least"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			srvOpts, err := tc.cfg.ServerOptions()
+			test.CmpErr(t, tc.expErr, err)
+			dialOpts, err := tc.cfg.DialOptions()
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expSrvOpts, len(srvOpts), "unexpected number of server options")
+			test.AssertEqual(t, tc.expDialOpts, len(dialOpts), "unexpected number of dial options")
+		})
+	}
+}
+
+func TestSecurity_GrpcConfig_Unmarshal(t *testing.T) {
+	in := `
+allow_insecure: true
+grpc:
+  keepalive_time: 30s
+  keepalive_timeout: 10s
+  max_recv_msg_size: 32
+  max_concurrent_streams: 64
+`
+	tc := new(TransportConfig)
+	if err := yaml.UnmarshalStrict([]byte(in), tc); err != nil {
+		t.Fatal(err)
+	}
+
+	expGrpc := &GrpcConfig{
+		KeepaliveTime:        30 * time.Second,
+		KeepaliveTimeout:     10 * time.Second,
+		MaxRecvMsgSize:       32,
+		MaxConcurrentStreams: 64,
+	}
+	test.AssertEqual(t, *expGrpc, *tc.Grpc, "unexpected grpc config")
+}
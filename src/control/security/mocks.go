@@ -0,0 +1,103 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// MockPrivateKey generates a PEM-encoded private key for use in tests. Keys
+// are smaller than those generated by GeneratePrivateKey, as they are much
+// quicker to generate.
+func MockPrivateKey(t *testing.T) []byte {
+	t.Helper()
+
+	defer func(bits int) { certKeyBits = bits }(certKeyBits)
+	certKeyBits = 1024
+
+	key, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// MockCA generates a PEM-encoded CA certificate and key for use in tests.
+func MockCA(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	keyPEM = MockPrivateKey(t)
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Mock CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeCert, Bytes: der}), keyPEM
+}
+
+// MockCertificate generates a PEM-encoded certificate with the given common
+// name and its private key, signed by the CA, for use in tests.
+func MockCertificate(t *testing.T, caCertPEM, caKeyPEM []byte, commonName string) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	caDER, err := decodePEMBlock(caCertPEM, pemTypeCert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caKey, err := parsePrivateKey(caKeyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyPEM = MockPrivateKey(t)
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject: pkix.Name{
+			Organization: []string{certOrganization},
+			CommonName:   commonName,
+		},
+		NotBefore:   time.Now().Add(-time.Hour),
+		NotAfter:    time.Now().Add(time.Hour),
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, key.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeCert, Bytes: der}), keyPEM
+}
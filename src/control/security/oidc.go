@@ -0,0 +1,486 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultRolesClaim is the token claim that is mapped to roles if the
+	// configuration does not specify one.
+	defaultRolesClaim = "groups"
+	// tokenLeeway is the allowed clock skew when checking token validity.
+	tokenLeeway = time.Minute
+	// jwksRefreshInterval is the minimum time between fetches of the
+	// issuer's signing keys.
+	jwksRefreshInterval = time.Minute
+	jwksFetchTimeout    = 10 * time.Second
+)
+
+// OIDCConfig configures the validation of OpenID Connect (OIDC) bearer tokens
+// presented by administrative clients in addition to their certificates.
+type OIDCConfig struct {
+	// Issuer is the expected issuer ("iss") of tokens, and is used to
+	// discover the issuer's signing keys unless JWKSFile is set.
+	Issuer string `yaml:"issuer"`
+	// Audience, if set, must be present in the audience ("aud") of tokens.
+	Audience string `yaml:"audience,omitempty"`
+	// JWKSFile is an optional path to a local copy of the issuer's JSON
+	// Web Key Set.
+	JWKSFile string `yaml:"jwks_file,omitempty"`
+	// RolesClaim is the claim containing the roles or groups of the token
+	// subject. Nested claims may be specified with "." separators.
+	RolesClaim string `yaml:"roles_claim,omitempty"`
+	// RoleMap maps role claim values to DAOS components.
+	RoleMap map[string]string `yaml:"role_map"`
+	// Required indicates that administrative requests must present a
+	// valid token.
+	Required bool `yaml:"required,omitempty"`
+}
+
+// Validate returns an error if the OIDC configuration is invalid.
+func (cfg *OIDCConfig) Validate() error {
+	if cfg == nil {
+		return errors.New("nil OIDCConfig")
+	}
+	if cfg.Issuer == "" {
+		return errors.New("oidc issuer must be set")
+	}
+	if len(cfg.RoleMap) == 0 {
+		return errors.New("oidc role_map must not be empty")
+	}
+	for role, name := range cfg.RoleMap {
+		switch CommonNameToComponent(name) {
+		case ComponentAdmin, ComponentDebug:
+		default:
+			return errors.Errorf("oidc role %q maps to invalid component %q (must be %q or %q)",
+				role, name, ComponentAdmin, ComponentDebug)
+		}
+	}
+
+	return nil
+}
+
+// TokenIdentity describes the identity established by a validated token.
+type TokenIdentity struct {
+	Subject    string
+	Roles      []string
+	Components []Component
+}
+
+// HasAccess indicates whether any of the components granted to the token
+// identity have access to the given method.
+func (ti *TokenIdentity) HasAccess(FullMethod string) bool {
+	for _, comp := range ti.Components {
+		if comp.HasAccess(FullMethod) {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenValidator validates OIDC bearer tokens against a configured issuer
+// and maps their claims to DAOS components.
+type TokenValidator struct {
+	sync.Mutex
+	cfg        *OIDCConfig
+	now        func() time.Time
+	httpClient *http.Client
+	keys       map[string]crypto.PublicKey
+	lastFetch  time.Time
+}
+
+// NewTokenValidator returns a TokenValidator for the given configuration.
+func NewTokenValidator(cfg *OIDCConfig) (*TokenValidator, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	tv := &TokenValidator{
+		cfg:        cfg,
+		now:        time.Now,
+		httpClient: &http.Client{Timeout: jwksFetchTimeout},
+	}
+
+	if cfg.JWKSFile != "" {
+		data, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading oidc jwks file")
+		}
+		if tv.keys, err = parseJWKS(data); err != nil {
+			return nil, errors.Wrapf(err, "parsing %s", cfg.JWKSFile)
+		}
+	}
+
+	return tv, nil
+}
+
+// Required indicates whether administrative requests must present a token.
+func (tv *TokenValidator) Required() bool {
+	return tv.cfg.Required
+}
+
+type (
+	jwtHeader struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+
+	jwk struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+)
+
+func decodeSegment(seg string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(seg, "="))
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := decodeSegment(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid RSA modulus")
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil || !e.IsInt64() {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported EC curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid EC x coordinate")
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid EC y coordinate")
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("EC point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// parseJWKS parses the signing keys from a JSON Web Key Set.
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []*jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %q", k.Kid)
+		}
+		keys[k.Kid] = pub
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys found")
+	}
+
+	return keys, nil
+}
+
+func (tv *TokenValidator) httpGet(url string) ([]byte, error) {
+	resp, err := tv.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// fetchKeys retrieves the issuer's signing keys using OIDC discovery.
+func (tv *TokenValidator) fetchKeys() (map[string]crypto.PublicKey, error) {
+	data, err := tv.httpGet(strings.TrimSuffix(tv.cfg.Issuer, "/") + "/.well-known/openid-configuration")
+	if err != nil {
+		return nil, errors.Wrap(err, "oidc discovery")
+	}
+
+	var discovery struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.Unmarshal(data, &discovery); err != nil {
+		return nil, errors.Wrap(err, "parsing oidc discovery document")
+	}
+	if discovery.JWKSURI == "" {
+		return nil, errors.New("oidc discovery document has no jwks_uri")
+	}
+
+	if data, err = tv.httpGet(discovery.JWKSURI); err != nil {
+		return nil, errors.Wrap(err, "fetching oidc jwks")
+	}
+	return parseJWKS(data)
+}
+
+// getKey returns the signing key with the given ID, fetching the issuer's
+// keys if they have not been loaded or the key is unknown.
+func (tv *TokenValidator) getKey(kid string) (crypto.PublicKey, error) {
+	tv.Lock()
+	defer tv.Unlock()
+
+	lookup := func() crypto.PublicKey {
+		if kid == "" && len(tv.keys) == 1 {
+			for _, key := range tv.keys {
+				return key
+			}
+		}
+		return tv.keys[kid]
+	}
+
+	if key := lookup(); key != nil {
+		return key, nil
+	}
+
+	if tv.cfg.JWKSFile == "" && tv.now().Sub(tv.lastFetch) >= jwksRefreshInterval {
+		tv.lastFetch = tv.now()
+		keys, err := tv.fetchKeys()
+		if err != nil {
+			return nil, err
+		}
+		tv.keys = keys
+
+		if key := lookup(); key != nil {
+			return key, nil
+		}
+	}
+
+	return nil, errors.Errorf("unknown token signing key %q", kid)
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			break
+		}
+		return rsa.VerifyPKCS1v15(pub, hash, digest, sig)
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			break
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid ECDSA signature length")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("ECDSA verification failed")
+		}
+		return nil
+	}
+
+	return errors.Errorf("signing key type %T cannot be used with %s", key, alg)
+}
+
+func claimTime(claims map[string]interface{}, name string) (time.Time, bool) {
+	v, ok := claims[name].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(v), 0), true
+}
+
+func claimStrings(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case []interface{}:
+		var strs []string
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				strs = append(strs, s)
+			}
+		}
+		return strs
+	default:
+		return nil
+	}
+}
+
+// lookupClaim returns the value of a possibly nested claim.
+func lookupClaim(claims map[string]interface{}, path string) interface{} {
+	var cur interface{} = claims
+	for _, key := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		cur = m[key]
+	}
+	return cur
+}
+
+func (tv *TokenValidator) checkClaims(claims map[string]interface{}) error {
+	if iss, _ := claims["iss"].(string); iss != tv.cfg.Issuer {
+		return errors.Errorf("unexpected token issuer %q", iss)
+	}
+
+	if tv.cfg.Audience != "" {
+		found := false
+		for _, aud := range claimStrings(claims["aud"]) {
+			if aud == tv.cfg.Audience {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("token audience does not include %q", tv.cfg.Audience)
+		}
+	}
+
+	now := tv.now()
+	exp, ok := claimTime(claims, "exp")
+	if !ok {
+		return errors.New("token has no expiry")
+	}
+	if now.After(exp.Add(tokenLeeway)) {
+		return errors.Errorf("token expired at %s", exp)
+	}
+	if nbf, ok := claimTime(claims, "nbf"); ok && now.Add(tokenLeeway).Before(nbf) {
+		return errors.Errorf("token not valid before %s", nbf)
+	}
+
+	return nil
+}
+
+// Validate verifies the signature and claims of the given token and returns
+// the identity it establishes.
+func (tv *TokenValidator) Validate(token string) (*TokenIdentity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	hdrData, err := decodeSegment(parts[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding token header")
+	}
+	var hdr jwtHeader
+	if err := json.Unmarshal(hdrData, &hdr); err != nil {
+		return nil, errors.Wrap(err, "parsing token header")
+	}
+	switch hdr.Alg {
+	case "RS256", "RS384", "RS512", "ES256", "ES384", "ES512":
+	default:
+		return nil, errors.Errorf("unsupported token signing algorithm %q", hdr.Alg)
+	}
+
+	sig, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding token signature")
+	}
+	key, err := tv.getKey(hdr.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(hdr.Alg, key, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return nil, errors.Wrap(err, "invalid token signature")
+	}
+
+	payload, err := decodeSegment(parts[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding token payload")
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.Wrap(err, "parsing token payload")
+	}
+	if err := tv.checkClaims(claims); err != nil {
+		return nil, err
+	}
+
+	rolesClaim := tv.cfg.RolesClaim
+	if rolesClaim == "" {
+		rolesClaim = defaultRolesClaim
+	}
+
+	id := &TokenIdentity{
+		Roles: claimStrings(lookupClaim(claims, rolesClaim)),
+	}
+	id.Subject, _ = claims["preferred_username"].(string)
+	if id.Subject == "" {
+		id.Subject, _ = claims["sub"].(string)
+	}
+	for _, role := range id.Roles {
+		if name, found := tv.cfg.RoleMap[role]; found {
+			id.Components = append(id.Components, CommonNameToComponent(name))
+		}
+	}
+
+	return id, nil
+}
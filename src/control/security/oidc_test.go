@@ -0,0 +1,368 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+const testIssuer = "https://idp.example.com/realms/hpc"
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func mockJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa1",
+				"use": "sig",
+				"n":   b64(rsaKey.N.Bytes()),
+				"e":   b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec1",
+				"crv": "P-256",
+				"x":   b64(ecKey.X.FillBytes(make([]byte, 32))),
+				"y":   b64(ecKey.Y.FillBytes(make([]byte, 32))),
+			},
+			{
+				"kty": "RSA",
+				"kid": "enc1",
+				"use": "enc",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func mockToken(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	t.Helper()
+
+	hdr, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := b64(hdr) + "." + b64(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k, digest[:])
+		if err == nil {
+			sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+		}
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed + "." + b64(sig)
+}
+
+func TestSecurity_OIDCConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *OIDCConfig
+		expErr error
+	}{
+		"nil": {
+			expErr: errors.New("nil"),
+		},
+		"no issuer": {
+			cfg: &OIDCConfig{
+				RoleMap: map[string]string{"daos-admins": "admin"},
+			},
+			expErr: errors.New("issuer must be set"),
+		},
+		"no role map": {
+			cfg: &OIDCConfig{
+				Issuer: testIssuer,
+			},
+			expErr: errors.New("role_map must not be empty"),
+		},
+		"server role": {
+			cfg: &OIDCConfig{
+				Issuer:  testIssuer,
+				RoleMap: map[string]string{"daos-servers": "server"},
+			},
+			expErr: errors.New("invalid component"),
+		},
+		"valid": {
+			cfg: &OIDCConfig{
+				Issuer: testIssuer,
+				RoleMap: map[string]string{
+					"daos-admins": "admin",
+					"daos-devs":   "debug",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+func TestSecurity_TokenValidator_Validate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+	jwksPath := filepath.Join(testDir, "jwks.json")
+	if err := os.WriteFile(jwksPath, mockJWKS(t, rsaKey, ecKey), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	claims := func(mods ...func(map[string]interface{})) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss":                testIssuer,
+			"sub":                "f81d4fae",
+			"preferred_username": "jdoe",
+			"aud":                []string{"daos", "other"},
+			"exp":                now.Add(time.Hour).Unix(),
+			"groups":             []string{"users", "daos-admins"},
+		}
+		for _, mod := range mods {
+			mod(c)
+		}
+		return c
+	}
+
+	for name, tc := range map[string]struct {
+		cfg    *OIDCConfig
+		token  string
+		expID  *TokenIdentity
+		expErr error
+	}{
+		"malformed": {
+			token:  "abc.def",
+			expErr: errors.New("malformed token"),
+		},
+		"unsupported algorithm": {
+			token:  b64([]byte(`{"alg":"none"}`)) + "." + b64([]byte(`{}`)) + ".",
+			expErr: errors.New("unsupported token signing algorithm"),
+		},
+		"unknown key": {
+			token:  mockToken(t, "RS256", "rsa2", rsaKey, claims()),
+			expErr: errors.New("unknown token signing key"),
+		},
+		"bad signature": {
+			token:  mockToken(t, "RS256", "rsa1", otherKey, claims()),
+			expErr: errors.New("invalid token signature"),
+		},
+		"algorithm does not match key": {
+			token:  mockToken(t, "ES256", "rsa1", ecKey, claims()),
+			expErr: errors.New("cannot be used with ES256"),
+		},
+		"wrong issuer": {
+			token: mockToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+				c["iss"] = "https://evil.example.com"
+			})),
+			expErr: errors.New("unexpected token issuer"),
+		},
+		"wrong audience": {
+			token: mockToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+				c["aud"] = "other"
+			})),
+			expErr: errors.New("audience does not include \"daos\""),
+		},
+		"no expiry": {
+			token: mockToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+				delete(c, "exp")
+			})),
+			expErr: errors.New("no expiry"),
+		},
+		"expired": {
+			token: mockToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+				c["exp"] = now.Add(-2 * tokenLeeway).Unix()
+			})),
+			expErr: errors.New("token expired"),
+		},
+		"not yet valid": {
+			token: mockToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+				c["nbf"] = now.Add(2 * tokenLeeway).Unix()
+			})),
+			expErr: errors.New("not valid before"),
+		},
+		"rsa admin": {
+			token: mockToken(t, "RS256", "rsa1", rsaKey, claims()),
+			expID: &TokenIdentity{
+				Subject:    "jdoe",
+				Roles:      []string{"users", "daos-admins"},
+				Components: []Component{ComponentAdmin},
+			},
+		},
+		"ec admin": {
+			token: mockToken(t, "ES256", "ec1", ecKey, claims()),
+			expID: &TokenIdentity{
+				Subject:    "jdoe",
+				Roles:      []string{"users", "daos-admins"},
+				Components: []Component{ComponentAdmin},
+			},
+		},
+		"no mapped roles": {
+			token: mockToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+				c["groups"] = "users"
+				delete(c, "preferred_username")
+			})),
+			expID: &TokenIdentity{
+				Subject: "f81d4fae",
+				Roles:   []string{"users"},
+			},
+		},
+		"nested roles claim": {
+			cfg: &OIDCConfig{
+				Issuer:     testIssuer,
+				JWKSFile:   jwksPath,
+				RolesClaim: "realm_access.roles",
+				RoleMap:    map[string]string{"daos-dev": "debug"},
+			},
+			token: mockToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+				c["realm_access"] = map[string]interface{}{
+					"roles": []string{"daos-dev"},
+				}
+			})),
+			expID: &TokenIdentity{
+				Subject:    "jdoe",
+				Roles:      []string{"daos-dev"},
+				Components: []Component{ComponentDebug},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := tc.cfg
+			if cfg == nil {
+				cfg = &OIDCConfig{
+					Issuer:   testIssuer,
+					Audience: "daos",
+					JWKSFile: jwksPath,
+					RoleMap:  map[string]string{"daos-admins": "admin"},
+				}
+			}
+			tv, err := NewTokenValidator(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotID, gotErr := tv.Validate(tc.token)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expID, gotID); diff != "" {
+				t.Fatalf("unexpected identity (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSecurity_TokenValidator_Discovery(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fetches int
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, `{"issuer":%q,"jwks_uri":%q}`, srv.URL, srv.URL+"/certs")
+	})
+	mux.HandleFunc("/certs", func(w http.ResponseWriter, _ *http.Request) {
+		fetches++
+		w.Write(mockJWKS(t, rsaKey, ecKey))
+	})
+
+	tv, err := NewTokenValidator(&OIDCConfig{
+		Issuer:  srv.URL,
+		RoleMap: map[string]string{"daos-admins": "admin"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token := mockToken(t, "RS256", "rsa1", rsaKey, map[string]interface{}{
+		"iss":    srv.URL,
+		"sub":    "jdoe",
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"groups": []string{"daos-admins"},
+	})
+	for i := 0; i < 2; i++ {
+		id, err := tv.Validate(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !id.HasAccess("/mgmt.MgmtSvc/PoolCreate") {
+			t.Fatal("expected token identity to have admin access")
+		}
+	}
+	test.AssertEqual(t, 1, fetches, "keys should be fetched once")
+
+	// Unknown keys do not trigger a refetch within the refresh interval.
+	badToken := mockToken(t, "RS256", "rsa2", rsaKey, map[string]interface{}{
+		"iss": srv.URL,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	if _, err := tv.Validate(badToken); err == nil {
+		t.Fatal("expected error for unknown key")
+	}
+	test.AssertEqual(t, 1, fetches, "keys should not be refetched")
+}
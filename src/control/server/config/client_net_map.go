@@ -0,0 +1,133 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ClientNetMapEntry selects the fabric URIs given to clients on a subnet when
+// ranks advertise more than one, e.g. an RDMA URI and a TCP fallback, or URIs
+// on the networks of different datacenters. A rank URI is selected if it
+// matches every criterion that is set.
+type ClientNetMapEntry struct {
+	// ClientSubnet is the subnet, in CIDR notation, containing the address
+	// from which a client's daos_agent connects to the control plane.
+	ClientSubnet string `yaml:"client_subnet"`
+	// Provider selects URIs for the given fabric provider. It is also
+	// given to clients as the provider to use.
+	Provider string `yaml:"provider,omitempty"`
+	// URISubnet selects URIs with an address in the given subnet.
+	URISubnet string `yaml:"uri_subnet,omitempty"`
+
+	clientNet *net.IPNet
+	uriNet    *net.IPNet
+}
+
+// Validate returns an error if the entry is invalid.
+func (e *ClientNetMapEntry) Validate() (err error) {
+	if e == nil {
+		return errors.New("nil ClientNetMapEntry")
+	}
+
+	if e.ClientSubnet == "" {
+		return errors.New("client_net_map entry requires a client_subnet")
+	}
+	if _, e.clientNet, err = net.ParseCIDR(e.ClientSubnet); err != nil {
+		return errors.Wrapf(err, "invalid client_net_map client_subnet %q", e.ClientSubnet)
+	}
+
+	if e.Provider == "" && e.URISubnet == "" {
+		return errors.Errorf("client_net_map entry for %s requires a provider or uri_subnet",
+			e.ClientSubnet)
+	}
+	if e.URISubnet != "" {
+		if _, e.uriNet, err = net.ParseCIDR(e.URISubnet); err != nil {
+			return errors.Wrapf(err, "invalid client_net_map uri_subnet %q", e.URISubnet)
+		}
+	}
+
+	return nil
+}
+
+// splitFabricURI returns the provider and host of a fabric URI in the form
+// "provider://host:port".
+func splitFabricURI(uri string) (provider, host string) {
+	i := strings.Index(uri, "://")
+	if i < 0 {
+		return "", uri
+	}
+	provider, host = uri[:i], uri[i+3:]
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	return provider, host
+}
+
+// MatchesURI returns true if the fabric URI may be given to clients on the
+// entry's subnet.
+func (e *ClientNetMapEntry) MatchesURI(uri string) bool {
+	provider, host := splitFabricURI(uri)
+	if e.Provider != "" && provider != e.Provider {
+		return false
+	}
+	if e.uriNet != nil {
+		ip := net.ParseIP(host)
+		if ip == nil || !e.uriNet.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SelectURI returns the first of the fabric URIs that matches the entry, or
+// an empty string if none match.
+func (e *ClientNetMapEntry) SelectURI(uris ...string) string {
+	for _, uri := range uris {
+		if e.MatchesURI(uri) {
+			return uri
+		}
+	}
+
+	return ""
+}
+
+// ClientNetMap is an ordered list of entries that select the fabric URIs given
+// to clients based on their subnet.
+type ClientNetMap []*ClientNetMapEntry
+
+// Validate returns an error if any of the entries are invalid.
+func (cnm ClientNetMap) Validate() error {
+	for _, entry := range cnm {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Lookup returns the first entry whose client subnet contains the address, or
+// nil if there is none.
+func (cnm ClientNetMap) Lookup(addr net.IP) *ClientNetMapEntry {
+	if addr == nil {
+		return nil
+	}
+
+	for _, entry := range cnm {
+		if entry.clientNet != nil && entry.clientNet.Contains(addr) {
+			return entry
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestConfig_ClientNetMapEntry_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		entry  *ClientNetMapEntry
+		expErr error
+	}{
+		"nil entry": {
+			expErr: errors.New("nil"),
+		},
+		"no client subnet": {
+			entry:  &ClientNetMapEntry{Provider: "ofi+tcp"},
+			expErr: errors.New("requires a client_subnet"),
+		},
+		"bad client subnet": {
+			entry:  &ClientNetMapEntry{ClientSubnet: "10.0.0.1", Provider: "ofi+tcp"},
+			expErr: errors.New("invalid client_net_map client_subnet"),
+		},
+		"no selection criteria": {
+			entry:  &ClientNetMapEntry{ClientSubnet: "10.0.0.0/8"},
+			expErr: errors.New("requires a provider or uri_subnet"),
+		},
+		"bad uri subnet": {
+			entry:  &ClientNetMapEntry{ClientSubnet: "10.0.0.0/8", URISubnet: "fabric"},
+			expErr: errors.New("invalid client_net_map uri_subnet"),
+		},
+		"provider": {
+			entry: &ClientNetMapEntry{ClientSubnet: "10.0.0.0/8", Provider: "ofi+tcp"},
+		},
+		"uri subnet": {
+			entry: &ClientNetMapEntry{ClientSubnet: "fd00::/8", URISubnet: "192.168.0.0/16"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.entry.Validate())
+		})
+	}
+}
+
+func TestConfig_ClientNetMapEntry_SelectURI(t *testing.T) {
+	uris := []string{
+		"ofi+verbs;ofi_rxm://10.0.0.1:31416",
+		"ofi+tcp://192.168.1.1:31416",
+		"ofi+tcp://172.16.1.1:31416",
+	}
+
+	for name, tc := range map[string]struct {
+		entry  *ClientNetMapEntry
+		expURI string
+	}{
+		"provider": {
+			entry: &ClientNetMapEntry{
+				ClientSubnet: "10.0.0.0/8",
+				Provider:     "ofi+tcp",
+			},
+			expURI: "ofi+tcp://192.168.1.1:31416",
+		},
+		"uri subnet": {
+			entry: &ClientNetMapEntry{
+				ClientSubnet: "10.0.0.0/8",
+				URISubnet:    "172.16.0.0/12",
+			},
+			expURI: "ofi+tcp://172.16.1.1:31416",
+		},
+		"provider and uri subnet": {
+			entry: &ClientNetMapEntry{
+				ClientSubnet: "10.0.0.0/8",
+				Provider:     "ofi+verbs;ofi_rxm",
+				URISubnet:    "10.0.0.0/24",
+			},
+			expURI: "ofi+verbs;ofi_rxm://10.0.0.1:31416",
+		},
+		"no match": {
+			entry: &ClientNetMapEntry{
+				ClientSubnet: "10.0.0.0/8",
+				Provider:     "ofi+verbs;ofi_rxm",
+				URISubnet:    "192.168.0.0/16",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := tc.entry.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, tc.expURI, tc.entry.SelectURI(uris...), "unexpected URI")
+		})
+	}
+}
+
+func TestConfig_ClientNetMap_Lookup(t *testing.T) {
+	cnm := ClientNetMap{
+		{ClientSubnet: "10.1.0.0/16", Provider: "ofi+tcp"},
+		{ClientSubnet: "10.0.0.0/8", URISubnet: "10.0.0.0/8"},
+	}
+	if err := cnm.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		addr     net.IP
+		expEntry *ClientNetMapEntry
+	}{
+		"nil address": {},
+		"no match": {
+			addr: net.ParseIP("192.168.1.1"),
+		},
+		"first match wins": {
+			addr:     net.ParseIP("10.1.2.3"),
+			expEntry: cnm[0],
+		},
+		"second entry": {
+			addr:     net.ParseIP("10.2.2.3"),
+			expEntry: cnm[1],
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expEntry, cnm.Lookup(tc.addr), "unexpected entry")
+		})
+	}
+}
@@ -100,6 +100,11 @@ var (
 		"enable_vmd and disable_vmd parameters both specified in config",
 		"remove legacy enable_vmd parameter from config",
 	)
+	FaultConfigEnginesSettingDuplicate = serverConfigFault(
+		code.ServerConfigEnginesSettingDuplicate,
+		"servers and engines parameters both specified in config",
+		"remove legacy servers parameter from config and move any engine sections under engines",
+	)
 )
 
 func FaultConfigDuplicateFabric(curIdx, seenIdx int) *fault.Fault {
@@ -0,0 +1,83 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// InventoryExportJSON exports snapshots as a single JSON document.
+	InventoryExportJSON = "json"
+	// InventoryExportCSV exports snapshots as a set of CSV tables.
+	InventoryExportCSV = "csv"
+
+	defaultInventoryExportInterval = time.Hour
+	minInventoryExportInterval     = time.Minute
+)
+
+// InventoryExportConfig configures the periodic export of system inventory
+// snapshots (membership, pools and pool capacity) by the MS leader, allowing
+// reporting systems to ingest them without access to the control plane.
+type InventoryExportConfig struct {
+	// Path is the directory in which snapshot files are written.
+	Path string `yaml:"path,omitempty"`
+	// Format is the format of snapshot files, either "json" or "csv".
+	Format string `yaml:"format,omitempty"`
+	// URL is an HTTP(S) endpoint to which JSON snapshots are posted.
+	URL string `yaml:"url,omitempty"`
+	// Interval is the period between snapshots.
+	Interval time.Duration `yaml:"interval,omitempty"`
+}
+
+// Validate returns an error if the inventory export configuration is invalid,
+// and sets defaults for unset parameters.
+func (cfg *InventoryExportConfig) Validate() error {
+	if cfg == nil {
+		return errors.New("nil InventoryExportConfig")
+	}
+	if cfg.Path == "" && cfg.URL == "" {
+		return errors.New("inventory_export requires a path or url")
+	}
+
+	if cfg.Path != "" && !filepath.IsAbs(cfg.Path) {
+		return errors.Errorf("inventory_export path %q is not absolute", cfg.Path)
+	}
+
+	switch cfg.Format {
+	case "":
+		cfg.Format = InventoryExportJSON
+	case InventoryExportJSON, InventoryExportCSV:
+	default:
+		return errors.Errorf("inventory_export format %q is not one of %q or %q",
+			cfg.Format, InventoryExportJSON, InventoryExportCSV)
+	}
+
+	if cfg.URL != "" {
+		u, err := url.Parse(cfg.URL)
+		if err != nil {
+			return errors.Wrap(err, "invalid inventory_export url")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.Errorf("inventory_export url %q must use http or https", cfg.URL)
+		}
+	}
+
+	switch {
+	case cfg.Interval == 0:
+		cfg.Interval = defaultInventoryExportInterval
+	case cfg.Interval < minInventoryExportInterval:
+		return errors.Errorf("inventory_export interval must be at least %s",
+			minInventoryExportInterval)
+	}
+
+	return nil
+}
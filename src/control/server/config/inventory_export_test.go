@@ -0,0 +1,83 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestConfig_InventoryExportConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *InventoryExportConfig
+		expCfg *InventoryExportConfig
+		expErr error
+	}{
+		"nil config": {
+			expErr: errors.New("nil"),
+		},
+		"no destination": {
+			cfg:    &InventoryExportConfig{Format: InventoryExportJSON},
+			expErr: errors.New("requires a path or url"),
+		},
+		"relative path": {
+			cfg:    &InventoryExportConfig{Path: "inventory"},
+			expErr: errors.New("not absolute"),
+		},
+		"bad format": {
+			cfg:    &InventoryExportConfig{Path: "/tmp/inventory", Format: "xml"},
+			expErr: errors.New("format \"xml\""),
+		},
+		"bad url scheme": {
+			cfg:    &InventoryExportConfig{URL: "ftp://reports.example.com/"},
+			expErr: errors.New("must use http or https"),
+		},
+		"interval too short": {
+			cfg:    &InventoryExportConfig{Path: "/tmp/inventory", Interval: time.Second},
+			expErr: errors.New("at least"),
+		},
+		"defaults": {
+			cfg: &InventoryExportConfig{Path: "/tmp/inventory"},
+			expCfg: &InventoryExportConfig{
+				Path:     "/tmp/inventory",
+				Format:   InventoryExportJSON,
+				Interval: time.Hour,
+			},
+		},
+		"csv and url": {
+			cfg: &InventoryExportConfig{
+				Path:     "/tmp/inventory",
+				Format:   InventoryExportCSV,
+				URL:      "https://reports.example.com/daos",
+				Interval: 5 * time.Minute,
+			},
+			expCfg: &InventoryExportConfig{
+				Path:     "/tmp/inventory",
+				Format:   InventoryExportCSV,
+				URL:      "https://reports.example.com/daos",
+				Interval: 5 * time.Minute,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.cfg.Validate()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expCfg, tc.cfg); diff != "" {
+				t.Fatalf("unexpected config (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
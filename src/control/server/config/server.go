@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -14,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	yaml "gopkg.in/yaml.v2"
@@ -39,11 +40,13 @@ type Server struct {
 	// control-specific
 	ControlPort         int                       `yaml:"port"`
 	TransportConfig     *security.TransportConfig `yaml:"transport_config"`
+	OIDC                *security.OIDCConfig      `yaml:"oidc,omitempty"`
 	Engines             []*engine.Config          `yaml:"engines"`
 	BdevExclude         []string                  `yaml:"bdev_exclude,omitempty"`
 	DisableVFIO         bool                      `yaml:"disable_vfio"`
 	DisableVMD          *bool                     `yaml:"disable_vmd"`
 	EnableHotplug       bool                      `yaml:"enable_hotplug"`
+	HotplugPeriod       time.Duration             `yaml:"hotplug_period,omitempty"`
 	NrHugepages         int                       `yaml:"nr_hugepages"` // total for all engines
 	DisableHugepages    bool                      `yaml:"disable_hugepages"`
 	ControlLogMask      common.ControlLogLevel    `yaml:"control_log_mask"`
@@ -52,10 +55,16 @@ type Server struct {
 	HelperLogFile       string                    `yaml:"helper_log_file,omitempty"`
 	FWHelperLogFile     string                    `yaml:"firmware_helper_log_file,omitempty"`
 	RecreateSuperblocks bool                      `yaml:"recreate_superblocks,omitempty"`
+	WarmRestart         bool                      `yaml:"warm_restart,omitempty"`
+	JoinMaxWait         time.Duration             `yaml:"join_max_wait,omitempty"`
+	ResetNVMeOnShutdown bool                      `yaml:"reset_nvme_on_shutdown,omitempty"`
 	FaultPath           string                    `yaml:"fault_path,omitempty"`
 	TelemetryPort       int                       `yaml:"telemetry_port,omitempty"`
+	TelemetryDisabled   []string                  `yaml:"telemetry_disabled_classes,omitempty"`
 	CoreDumpFilter      uint8                     `yaml:"core_dump_filter,omitempty"`
 	ClientEnvVars       []string                  `yaml:"client_env_vars,omitempty"`
+	ClientNetMap        ClientNetMap              `yaml:"client_net_map,omitempty"`
+	InventoryExport     *InventoryExportConfig    `yaml:"inventory_export,omitempty"`
 
 	// duplicated in engine.Config
 	SystemName string              `yaml:"name"`
@@ -88,6 +97,40 @@ func (cfg *Server) WithRecreateSuperblocks() *Server {
 	return cfg
 }
 
+// WithWarmRestart indicates that engines should be left running when the
+// server exits and be reattached to when it is next started.
+func (cfg *Server) WithWarmRestart(enabled bool) *Server {
+	cfg.WarmRestart = enabled
+	return cfg
+}
+
+// WithJoinMaxWait sets the maximum amount of time that engines will keep
+// retrying a system join while the management service is unavailable.
+func (cfg *Server) WithJoinMaxWait(d time.Duration) *Server {
+	cfg.JoinMaxWait = d
+	return cfg
+}
+
+// WithResetNVMeOnShutdown indicates that NVMe SSDs should be returned to the
+// kernel driver and hugepages released when the server exits.
+func (cfg *Server) WithResetNVMeOnShutdown(enabled bool) *Server {
+	cfg.ResetNVMeOnShutdown = enabled
+	return cfg
+}
+
+// WithInventoryExport sets the configuration used to export system inventory
+// snapshots.
+func (cfg *Server) WithInventoryExport(ie *InventoryExportConfig) *Server {
+	cfg.InventoryExport = ie
+	return cfg
+}
+
+// WithOIDC sets the configuration used to validate OIDC bearer tokens.
+func (cfg *Server) WithOIDC(oidc *security.OIDCConfig) *Server {
+	cfg.OIDC = oidc
+	return cfg
+}
+
 // WithSystemName sets the system name.
 func (cfg *Server) WithSystemName(name string) *Server {
 	cfg.SystemName = name
@@ -140,6 +183,12 @@ func (cfg *Server) WithClientEnvVars(envVars []string) *Server {
 	return cfg
 }
 
+// WithClientNetMap sets the map used to select the fabric URIs given to clients.
+func (cfg *Server) WithClientNetMap(entries ...*ClientNetMapEntry) *Server {
+	cfg.ClientNetMap = entries
+	return cfg
+}
+
 // WithCrtCtxShareAddr sets the top-level CrtCtxShareAddr.
 func (cfg *Server) WithCrtCtxShareAddr(addr uint32) *Server {
 	cfg.Fabric.CrtCtxShareAddr = addr
@@ -178,6 +227,7 @@ func (cfg *Server) updateServerConfig(cfgPtr **engine.Config) {
 	engineCfg.SocketDir = cfg.SocketDir
 	engineCfg.Modules = cfg.Modules
 	engineCfg.Storage.EnableHotplug = cfg.EnableHotplug
+	engineCfg.Storage.HotplugPeriod = cfg.HotplugPeriod
 }
 
 // WithEngines sets the list of engine configurations.
@@ -246,6 +296,13 @@ func (cfg *Server) WithEnableHotplug(enabled bool) *Server {
 	return cfg
 }
 
+// WithHotplugPeriod sets the interval at which the engine polls for NVMe SSD
+// hotplug events when hotplug is enabled.
+func (cfg *Server) WithHotplugPeriod(period time.Duration) *Server {
+	cfg.HotplugPeriod = period
+	return cfg
+}
+
 // WithHyperthreads enables or disables hyperthread support.
 func (cfg *Server) WithHyperthreads(enabled bool) *Server {
 	cfg.Hyperthreads = enabled
@@ -300,6 +357,13 @@ func (cfg *Server) WithTelemetryPort(port int) *Server {
 	return cfg
 }
 
+// WithTelemetryDisabledClasses sets the metric classes that will not be
+// exported by the telemetry exporter.
+func (cfg *Server) WithTelemetryDisabledClasses(classes ...string) *Server {
+	cfg.TelemetryDisabled = classes
+	return cfg
+}
+
 // DefaultServer creates a new instance of configuration struct
 // populated with defaults.
 func DefaultServer() *Server {
@@ -441,10 +505,9 @@ func (cfg *Server) Validate(log logging.Logger, hugePageSize int) (err error) {
 		}
 	}()
 
-	// The config file format no longer supports "servers"
-	if len(cfg.Legacy.Servers) > 0 {
-		return errors.New("\"servers\" server config file parameter is deprecated, use " +
-			"\"engines\" instead")
+	// Translate any legacy parameters that remain and warn about deprecated usage.
+	if _, err := cfg.ConvertLegacyParams(log); err != nil {
+		return err
 	}
 
 	// Set DisableVMD reference if unset in config file.
@@ -470,6 +533,42 @@ func (cfg *Server) Validate(log logging.Logger, hugePageSize int) (err error) {
 	}
 	cfg.AccessPoints = newAPs
 
+	if cfg.TransportConfig != nil {
+		if err := cfg.TransportConfig.Grpc.Validate(); err != nil {
+			return errors.Wrap(err, "invalid grpc config")
+		}
+	}
+
+	if cfg.OIDC != nil {
+		if cfg.TransportConfig == nil || cfg.TransportConfig.AllowInsecure {
+			return errors.New("oidc token authentication requires transport security")
+		}
+		if err := cfg.OIDC.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.HotplugPeriod < 0 {
+		return errors.New("hotplug_period must not be negative")
+	}
+	if cfg.HotplugPeriod != 0 && !cfg.EnableHotplug {
+		log.Noticef("hotplug_period ignored as enable_hotplug is false")
+	}
+
+	if cfg.JoinMaxWait < 0 {
+		return errors.New("join_max_wait must not be negative")
+	}
+
+	if cfg.InventoryExport != nil {
+		if err := cfg.InventoryExport.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := cfg.ClientNetMap.Validate(); err != nil {
+		return err
+	}
+
 	// A config without engines is valid when initially discovering hardware prior to adding
 	// per-engine sections with device allocations.
 	if len(cfg.Engines) == 0 {
@@ -503,8 +602,6 @@ func (cfg *Server) Validate(log logging.Logger, hugePageSize int) (err error) {
 	for idx, ec := range cfg.Engines {
 		cfgTargetCount += ec.TargetCount
 
-		ec.ConvertLegacyStorage(log, idx)
-
 		if ec.Storage.Tiers.HaveBdevs() {
 			cfgHasBdevs = true
 			if ec.TargetCount == 0 {
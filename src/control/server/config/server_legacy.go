@@ -7,6 +7,9 @@
 package config
 
 import (
+	"fmt"
+
+	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/engine"
 )
 
@@ -18,6 +21,15 @@ type ServerLegacy struct {
 	EnableVMD *bool `yaml:"enable_vmd,omitempty"`
 	// Detect outdated "servers" config, to direct users to change their config file.
 	Servers []*engine.Config `yaml:"servers,omitempty"`
+	// Deprecations records legacy parameters that have been translated to their current
+	// equivalents so that users can be warned once a logger is available.
+	Deprecations []string `yaml:"-" json:"-"`
+}
+
+func (sl *ServerLegacy) deprecated(oldParam, newParam string) {
+	sl.Deprecations = append(sl.Deprecations, fmt.Sprintf("%q server config file "+
+		"parameter is deprecated, use %q instead (run \"daos_server config convert\" to "+
+		"update the config file)", oldParam, newParam))
 }
 
 // WithEnableVMD can be used to set the state of VMD functionality,
@@ -36,6 +48,22 @@ func updateVMDSetting(legacyCfg ServerLegacy, srvCfg *Server) error {
 	default:
 		disable := !(*legacyCfg.EnableVMD)
 		srvCfg.DisableVMD = &disable
+		srvCfg.Legacy.EnableVMD = nil
+		srvCfg.Legacy.deprecated("enable_vmd", "disable_vmd")
+		return nil
+	}
+}
+
+func updateEnginesSetting(legacyCfg ServerLegacy, srvCfg *Server) error {
+	switch {
+	case len(legacyCfg.Servers) == 0:
+		return nil // Legacy servers setting not used.
+	case len(srvCfg.Engines) != 0:
+		return FaultConfigEnginesSettingDuplicate // Both legacy and current settings used.
+	default:
+		srvCfg.Engines = legacyCfg.Servers
+		srvCfg.Legacy.Servers = nil
+		srvCfg.Legacy.deprecated("servers", "engines")
 		return nil
 	}
 }
@@ -45,5 +73,34 @@ func updateFromLegacyParams(srvCfg *Server) error {
 		return err
 	}
 
+	if err := updateEnginesSetting(srvCfg.Legacy, srvCfg); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// ConvertLegacyParams translates any legacy parameters in the config to their current
+// equivalents, including per-engine storage specified in the legacy format, and returns true if
+// any conversions were performed. Deprecation warnings are logged for each conversion.
+func (cfg *Server) ConvertLegacyParams(log logging.Logger) (bool, error) {
+	if err := updateFromLegacyParams(cfg); err != nil {
+		return false, err
+	}
+
+	converted := len(cfg.Legacy.Deprecations) != 0
+	for _, msg := range cfg.Legacy.Deprecations {
+		log.Notice(msg)
+	}
+	cfg.Legacy.Deprecations = nil
+
+	for idx, ec := range cfg.Engines {
+		if ec == nil || !ec.LegacyStorage.WasDefined() {
+			continue
+		}
+		ec.ConvertLegacyStorage(log, idx)
+		converted = true
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,120 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestServerConfig_ConvertLegacyParams(t *testing.T) {
+	legacyStorageEngine := func() *engine.Config {
+		return engine.MockConfig().WithLegacyStorage(engine.LegacyStorage{
+			ScmClass: storage.ClassRam,
+			ScmConfig: storage.ScmConfig{
+				MountPoint:  "/mnt/daos",
+				RamdiskSize: 16,
+			},
+		})
+	}
+
+	for name, tc := range map[string]struct {
+		cfg             *Server
+		expErr          error
+		expConverted    bool
+		expDisableVMD   *bool
+		expEngines      int
+		expNotices      []string
+		expStorageTiers int
+	}{
+		"no legacy params": {
+			cfg:        DefaultServer().WithEngines(engine.MockConfig()),
+			expEngines: 1,
+		},
+		"legacy enable_vmd": {
+			cfg: func() *Server {
+				c := DefaultServer()
+				c.Legacy.WithEnableVMD(false)
+				return c
+			}(),
+			expConverted:  true,
+			expDisableVMD: func() *bool { b := true; return &b }(),
+			expNotices:    []string{`"enable_vmd" server config file parameter is deprecated`},
+		},
+		"legacy enable_vmd; disable_vmd also set": {
+			cfg: func() *Server {
+				c := DefaultServer().WithDisableVMD(false)
+				c.Legacy.WithEnableVMD(true)
+				return c
+			}(),
+			expErr: FaultConfigVMDSettingDuplicate,
+		},
+		"legacy servers": {
+			cfg: func() *Server {
+				c := DefaultServer()
+				c.Legacy.Servers = []*engine.Config{engine.MockConfig(), engine.MockConfig()}
+				return c
+			}(),
+			expConverted: true,
+			expEngines:   2,
+			expNotices:   []string{`"servers" server config file parameter is deprecated`},
+		},
+		"legacy servers; engines also set": {
+			cfg: func() *Server {
+				c := DefaultServer().WithEngines(engine.MockConfig())
+				c.Legacy.Servers = []*engine.Config{engine.MockConfig()}
+				return c
+			}(),
+			expErr: FaultConfigEnginesSettingDuplicate,
+		},
+		"legacy engine storage": {
+			cfg:             DefaultServer().WithEngines(legacyStorageEngine()),
+			expConverted:    true,
+			expEngines:      1,
+			expNotices:      []string{"engine 0: Legacy storage configuration detected"},
+			expStorageTiers: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			converted, err := tc.cfg.ConvertLegacyParams(log)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expConverted, converted, "unexpected conversion result")
+			test.AssertEqual(t, tc.expEngines, len(tc.cfg.Engines), "unexpected engine count")
+			if diff := cmp.Diff(tc.expDisableVMD, tc.cfg.DisableVMD); diff != "" {
+				t.Fatalf("unexpected disable_vmd (-want, +got):\n%s\n", diff)
+			}
+			if tc.cfg.Legacy.EnableVMD != nil || len(tc.cfg.Legacy.Servers) != 0 {
+				t.Fatalf("legacy params not cleared: %+v", tc.cfg.Legacy)
+			}
+			if len(tc.cfg.Legacy.Deprecations) != 0 {
+				t.Fatalf("deprecations not cleared: %v", tc.cfg.Legacy.Deprecations)
+			}
+			for _, notice := range tc.expNotices {
+				test.AssertTrue(t, strings.Contains(buf.String(), notice),
+					"expected notice not logged: "+notice)
+			}
+			if tc.expStorageTiers != 0 {
+				test.AssertEqual(t, tc.expStorageTiers,
+					len(tc.cfg.Engines[0].Storage.Tiers), "unexpected storage tiers")
+			}
+		})
+	}
+}
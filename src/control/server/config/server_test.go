@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -43,6 +44,7 @@ var (
 		cmpopts.SortSlices(func(x, y string) bool { return x < y }),
 		cmpopts.IgnoreUnexported(
 			security.CertificateConfig{},
+			ClientNetMapEntry{},
 		),
 		cmpopts.IgnoreFields(Server{}, "Path"),
 		cmp.Comparer(func(x, y *storage.BdevDeviceList) bool {
@@ -215,17 +217,46 @@ func TestServerConfig_Constructed(t *testing.T) {
 
 	// Next, construct a config to compare against the first one. It should be
 	// possible to construct an identical configuration with the helpers.
+	transport := security.DefaultServerTransportConfig()
+	transport.CAKeyPath = "/etc/daos/certs/private/daosCA.key"
+	transport.Grpc = &security.GrpcConfig{
+		KeepaliveTime:        time.Minute,
+		KeepaliveTimeout:     20 * time.Second,
+		MaxRecvMsgSize:       16,
+		MaxSendMsgSize:       16,
+		MaxConcurrentStreams: 100,
+	}
 	constructed := DefaultServer().
 		WithControlPort(10001).
+		WithTransportConfig(transport).
 		WithBdevExclude("0000:81:00.1").
 		WithDisableVFIO(true).   // vfio enabled by default
 		WithDisableVMD(true).    // vmd enabled by default
 		WithEnableHotplug(true). // hotplug disabled by default
+		WithHotplugPeriod(10*time.Second).
 		WithControlLogMask(common.ControlLogLevelError).
 		WithControlLogFile("/tmp/daos_server.log").
 		WithHelperLogFile("/tmp/daos_server_helper.log").
 		WithFirmwareHelperLogFile("/tmp/daos_firmware_helper.log").
 		WithTelemetryPort(9191).
+		WithTelemetryDisabledClasses("io_latency", "target").
+		WithInventoryExport(&InventoryExportConfig{
+			Path:     "/var/lib/daos/inventory",
+			Format:   InventoryExportCSV,
+			URL:      "https://reports.example.com/daos/inventory",
+			Interval: 15 * time.Minute,
+		}).
+		WithWarmRestart(true).
+		WithResetNVMeOnShutdown(true).
+		WithJoinMaxWait(30 * time.Minute).
+		WithOIDC(&security.OIDCConfig{
+			Issuer:     "https://idp.example.com/realms/hpc",
+			Audience:   "daos",
+			JWKSFile:   "/etc/daos/oidc_jwks.json",
+			RolesClaim: "groups",
+			RoleMap:    map[string]string{"daos-admins": "admin"},
+			Required:   true,
+		}).
 		WithSystemName("daos_server").
 		WithSocketDir("./.daos/daos_server").
 		WithFabricProvider("ofi+verbs;ofi_rxm").
@@ -235,6 +266,9 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithFaultCb("./.daos/fd_callback").
 		WithFaultPath("/vcdu0/rack1/hostname").
 		WithClientEnvVars([]string{"foo=bar"}).
+		WithClientNetMap(
+			&ClientNetMapEntry{ClientSubnet: "10.2.0.0/16", URISubnet: "172.16.0.0/12"},
+		).
 		WithFabricAuthKey("foo:bar").
 		WithHyperthreads(true) // hyper-threads disabled by default
 
@@ -245,6 +279,7 @@ func TestServerConfig_Constructed(t *testing.T) {
 			WithSocketDir("./.daos/daos_server").
 			WithTargetCount(16).
 			WithHelperStreamCount(4).
+			WithHelperPlacement(engine.HelperPlacementHyperthread).
 			WithServiceThreadCore(0).
 			WithStorage(
 				storage.NewTierConfig().
@@ -268,7 +303,14 @@ func TestServerConfig_Constructed(t *testing.T) {
 			WithEnvVars("CRT_TIMEOUT=30").
 			WithLogFile("/tmp/daos_engine.0.log").
 			WithLogMask("INFO").
+			WithOutput(engine.OutputConfig{
+				Destination: engine.OutputFile,
+				File:        "/tmp/daos_engine.0.out",
+				MaxSize:     100,
+				MaxBackups:  5,
+			}).
 			WithStorageEnableHotplug(true).
+			WithStorageHotplugPeriod(10*time.Second).
 			WithStorageAccelProps(storage.AccelEngineSPDK,
 				storage.AccelOptCRCFlag|storage.AccelOptMoveFlag),
 		engine.MockConfig().
@@ -276,6 +318,7 @@ func TestServerConfig_Constructed(t *testing.T) {
 			WithSocketDir("./.daos/daos_server").
 			WithTargetCount(16).
 			WithHelperStreamCount(4).
+			WithHelperPlacement(engine.HelperPlacementHyperthread).
 			WithServiceThreadCore(22).
 			WithStorage(
 				storage.NewTierConfig().
@@ -298,6 +341,7 @@ func TestServerConfig_Constructed(t *testing.T) {
 			WithLogFile("/tmp/daos_engine.1.log").
 			WithLogMask("INFO").
 			WithStorageEnableHotplug(true).
+			WithStorageHotplugPeriod(10*time.Second).
 			WithStorageAccelProps(storage.AccelEngineDML, storage.AccelOptCRCFlag),
 	}
 	constructed.Path = testFile // just to avoid failing the cmp
@@ -360,6 +404,18 @@ func TestServerConfig_Validation(t *testing.T) {
 			},
 			expErr: FaultConfigNoProvider,
 		},
+		"negative hotplug period": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithHotplugPeriod(-time.Second)
+			},
+			expErr: errors.New("hotplug_period"),
+		},
+		"negative join max wait": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithJoinMaxWait(-time.Second)
+			},
+			expErr: errors.New("join_max_wait"),
+		},
 		"no access point": {
 			extraConfig: func(c *Server) *Server {
 				return c.WithAccessPoints()
@@ -854,18 +910,24 @@ func TestServerConfig_Parsing(t *testing.T) {
 			expParseErr: errors.New("field engine not found"),
 		},
 		"use legacy servers conf directive rather than engines": {
-			inTxt:          "engines:",
-			outTxt:         "servers:",
-			expValidateErr: errors.New("use \"engines\" instead"),
-		},
-		"specify legacy servers conf directive in addition to engines": {
 			inTxt:  "engines:",
 			outTxt: "servers:",
+			expCheck: func(c *Server) error {
+				if len(c.Legacy.Servers) != 0 {
+					return errors.New("expected legacy servers to be cleared")
+				}
+				if len(c.Engines) != 2 {
+					return errors.Errorf("want %d engines, got %d", 2, len(c.Engines))
+				}
+				return nil
+			},
+		},
+		"specify legacy servers conf directive in addition to engines": {
 			extraConfig: func(c *Server) *Server {
-				var nilEngineConfig *engine.Config
-				return c.WithEngines(nilEngineConfig)
+				c.Legacy.Servers = []*engine.Config{engine.MockConfig()}
+				return c
 			},
-			expValidateErr: errors.New("use \"engines\" instead"),
+			expValidateErr: FaultConfigEnginesSettingDuplicate,
 		},
 		"duplicates in bdev_list from config": {
 			extraConfig: func(c *Server) *Server {
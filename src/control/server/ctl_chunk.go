@@ -0,0 +1,179 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+const (
+	// respChunkMaxBytes is the target maximum encoded size of each chunk
+	// of a streamed response. It is kept well below the default 4MiB gRPC
+	// message size limit so that chunks never approach it.
+	respChunkMaxBytes = 1 << 20
+	// respChunkItemOverhead approximates the encoding overhead (field tags
+	// and length prefixes of enclosing messages) added to each item.
+	respChunkItemOverhead = 16
+)
+
+// chunkBudget tracks the space used in the current chunk of a response.
+type chunkBudget struct {
+	max   int
+	used  int
+	items int
+}
+
+// add accounts for an item of the given encoded size and indicates whether a
+// new chunk must be started for it. An item is always added to an empty chunk,
+// even if it exceeds the budget on its own.
+func (cb *chunkBudget) add(size int) (newChunk bool) {
+	size += respChunkItemOverhead
+	if cb.items > 0 && cb.used+size > cb.max {
+		newChunk = true
+		cb.used, cb.items = 0, 0
+	}
+	cb.used += size
+	cb.items++
+
+	return
+}
+
+// chunkStorageScanResp splits a storage scan response into chunks of no more
+// than maxBytes each, other than where a single item exceeds the limit. The
+// first chunk carries the memory info and response states.
+func chunkStorageScanResp(resp *ctlpb.StorageScanResp, maxBytes int) []*ctlpb.StorageScanResp {
+	cur := &ctlpb.StorageScanResp{MemInfo: resp.MemInfo}
+	if resp.Nvme != nil {
+		cur.Nvme = &ctlpb.ScanNvmeResp{State: resp.Nvme.State}
+	}
+	if resp.Scm != nil {
+		cur.Scm = &ctlpb.ScanScmResp{State: resp.Scm.State}
+	}
+	chunks := []*ctlpb.StorageScanResp{cur}
+	budget := &chunkBudget{max: maxBytes, used: proto.Size(cur)}
+
+	addItem := func(item proto.Message) {
+		if budget.add(proto.Size(item)) {
+			cur = new(ctlpb.StorageScanResp)
+			chunks = append(chunks, cur)
+		}
+	}
+	nvme := func() *ctlpb.ScanNvmeResp {
+		if cur.Nvme == nil {
+			cur.Nvme = new(ctlpb.ScanNvmeResp)
+		}
+		return cur.Nvme
+	}
+	scm := func() *ctlpb.ScanScmResp {
+		if cur.Scm == nil {
+			cur.Scm = new(ctlpb.ScanScmResp)
+		}
+		return cur.Scm
+	}
+
+	for _, ctrlr := range resp.Nvme.GetCtrlrs() {
+		addItem(ctrlr)
+		nvme().Ctrlrs = append(nvme().Ctrlrs, ctrlr)
+	}
+	for _, module := range resp.Scm.GetModules() {
+		addItem(module)
+		scm().Modules = append(scm().Modules, module)
+	}
+	for _, ns := range resp.Scm.GetNamespaces() {
+		addItem(ns)
+		scm().Namespaces = append(scm().Namespaces, ns)
+	}
+
+	return chunks
+}
+
+// chunkSmdQueryResp splits a SMD query response into chunks of no more than
+// maxBytes each, other than where a single item exceeds the limit. The devices
+// and pools of a rank may be split across chunks, in which case each chunk has
+// its own entry for the rank. The first chunk carries the status and next page
+// token.
+func chunkSmdQueryResp(resp *ctlpb.SmdQueryResp, maxBytes int) []*ctlpb.SmdQueryResp {
+	cur := &ctlpb.SmdQueryResp{
+		Status:        resp.Status,
+		NextPageToken: resp.NextPageToken,
+	}
+	chunks := []*ctlpb.SmdQueryResp{cur}
+	budget := &chunkBudget{max: maxBytes, used: proto.Size(cur)}
+
+	var curRank *ctlpb.SmdQueryResp_RankResp
+	addItem := func(rank uint32, item proto.Message) {
+		if budget.add(proto.Size(item)) {
+			cur = new(ctlpb.SmdQueryResp)
+			chunks = append(chunks, cur)
+			curRank = nil
+		}
+		if curRank == nil {
+			curRank = &ctlpb.SmdQueryResp_RankResp{Rank: rank}
+			cur.Ranks = append(cur.Ranks, curRank)
+		}
+	}
+
+	for _, rResp := range resp.Ranks {
+		// Start each rank with an empty entry so that ranks without
+		// any devices or pools are still reported.
+		addItem(rResp.Rank, &ctlpb.SmdQueryResp_RankResp{Rank: rResp.Rank})
+		for _, dev := range rResp.Devices {
+			addItem(rResp.Rank, dev)
+			curRank.Devices = append(curRank.Devices, dev)
+		}
+		for _, pool := range rResp.Pools {
+			addItem(rResp.Rank, pool)
+			curRank.Pools = append(curRank.Pools, pool)
+		}
+		curRank = nil
+	}
+
+	return chunks
+}
+
+// StorageScanStream performs a storage scan and sends the response as a series
+// of chunks, in order to avoid exceeding the gRPC message size limit on hosts
+// with many devices.
+func (c *ControlService) StorageScanStream(req *ctlpb.StorageScanReq, stream ctlpb.CtlSvc_StorageScanStreamServer) error {
+	resp, err := c.StorageScan(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	chunks := chunkStorageScanResp(resp, respChunkMaxBytes)
+	c.log.Debugf("sending storage scan response in %d chunks", len(chunks))
+	for _, chunk := range chunks {
+		if err := stream.Send(chunk); err != nil {
+			return errors.Wrap(err, "sending storage scan chunk")
+		}
+	}
+
+	return nil
+}
+
+// SmdQueryStream performs a SMD query and sends the response as a series of
+// chunks, in order to avoid exceeding the gRPC message size limit on hosts
+// with many devices or pools.
+func (svc *ControlService) SmdQueryStream(req *ctlpb.SmdQueryReq, stream ctlpb.CtlSvc_SmdQueryStreamServer) error {
+	resp, err := svc.SmdQuery(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	chunks := chunkSmdQueryResp(resp, respChunkMaxBytes)
+	svc.log.Debugf("sending SMD query response in %d chunks", len(chunks))
+	for _, chunk := range chunks {
+		if err := stream.Send(chunk); err != nil {
+			return errors.Wrap(err, "sending SMD query chunk")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+func TestServer_chunkStorageScanResp(t *testing.T) {
+	resp := &ctlpb.StorageScanResp{
+		MemInfo: &ctlpb.MemInfo{MemTotal: 1 << 30},
+		Nvme:    &ctlpb.ScanNvmeResp{State: new(ctlpb.ResponseState)},
+		Scm:     &ctlpb.ScanScmResp{State: new(ctlpb.ResponseState)},
+	}
+	for i := 0; i < 200; i++ {
+		resp.Nvme.Ctrlrs = append(resp.Nvme.Ctrlrs, &ctlpb.NvmeController{
+			PciAddr: fmt.Sprintf("0000:%02x:00.0", i),
+		})
+		resp.Scm.Namespaces = append(resp.Scm.Namespaces, &ctlpb.ScmNamespace{
+			Uuid:     fmt.Sprintf("ns-%03d", i),
+			Blockdev: fmt.Sprintf("pmem%d", i),
+		})
+	}
+
+	for name, tc := range map[string]struct {
+		maxBytes  int
+		expChunks int
+	}{
+		"fits in one chunk": {
+			maxBytes:  respChunkMaxBytes,
+			expChunks: 1,
+		},
+		"one item per chunk": {
+			maxBytes:  1,
+			expChunks: 400,
+		},
+		"split": {
+			maxBytes: 1024,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			chunks := chunkStorageScanResp(resp, tc.maxBytes)
+			if tc.expChunks != 0 && len(chunks) != tc.expChunks {
+				t.Fatalf("expected %d chunks, got %d", tc.expChunks, len(chunks))
+			}
+
+			got := &ctlpb.StorageScanResp{
+				MemInfo: chunks[0].MemInfo,
+				Nvme:    &ctlpb.ScanNvmeResp{State: chunks[0].Nvme.State},
+				Scm:     &ctlpb.ScanScmResp{State: chunks[0].Scm.State},
+			}
+			for _, chunk := range chunks {
+				if len(chunks) > 1 && tc.maxBytes > 1 && proto.Size(chunk) > tc.maxBytes {
+					t.Fatalf("chunk size %d exceeds %d", proto.Size(chunk), tc.maxBytes)
+				}
+				got.Nvme.Ctrlrs = append(got.Nvme.Ctrlrs, chunk.Nvme.GetCtrlrs()...)
+				got.Scm.Namespaces = append(got.Scm.Namespaces, chunk.Scm.GetNamespaces()...)
+			}
+
+			if diff := cmp.Diff(resp, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected reassembled response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_chunkSmdQueryResp(t *testing.T) {
+	resp := &ctlpb.SmdQueryResp{NextPageToken: "next"}
+	for rank := uint32(0); rank < 3; rank++ {
+		rResp := &ctlpb.SmdQueryResp_RankResp{Rank: rank}
+		// Leave rank 1 empty.
+		for i := 0; rank != 1 && i < 20; i++ {
+			rResp.Devices = append(rResp.Devices, &ctlpb.SmdQueryResp_SmdDeviceWithHealth{
+				Details: &ctlpb.SmdDevice{Uuid: fmt.Sprintf("dev-%d-%02d", rank, i)},
+			})
+			rResp.Pools = append(rResp.Pools, &ctlpb.SmdQueryResp_Pool{
+				Uuid:   fmt.Sprintf("pool-%d-%02d", rank, i),
+				TgtIds: []int32{0, 1, 2, 3},
+			})
+		}
+		resp.Ranks = append(resp.Ranks, rResp)
+	}
+
+	for name, tc := range map[string]struct {
+		maxBytes  int
+		expChunks int
+	}{
+		"fits in one chunk": {
+			maxBytes:  respChunkMaxBytes,
+			expChunks: 1,
+		},
+		"one item per chunk": {
+			maxBytes:  1,
+			expChunks: 83,
+		},
+		"split": {
+			maxBytes: 256,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			chunks := chunkSmdQueryResp(resp, tc.maxBytes)
+			if tc.expChunks != 0 && len(chunks) != tc.expChunks {
+				t.Fatalf("expected %d chunks, got %d", tc.expChunks, len(chunks))
+			}
+			if chunks[0].NextPageToken != resp.NextPageToken {
+				t.Fatal("next page token not in first chunk")
+			}
+
+			// Reassemble the per-rank entries, which may be split
+			// across chunks.
+			got := &ctlpb.SmdQueryResp{NextPageToken: chunks[0].NextPageToken}
+			byRank := make(map[uint32]*ctlpb.SmdQueryResp_RankResp)
+			for _, chunk := range chunks {
+				if len(chunks) > 1 && tc.maxBytes > 1 && proto.Size(chunk) > tc.maxBytes {
+					t.Fatalf("chunk size %d exceeds %d", proto.Size(chunk), tc.maxBytes)
+				}
+				for _, rResp := range chunk.Ranks {
+					if _, found := byRank[rResp.Rank]; !found {
+						byRank[rResp.Rank] = &ctlpb.SmdQueryResp_RankResp{Rank: rResp.Rank}
+						got.Ranks = append(got.Ranks, byRank[rResp.Rank])
+					}
+					byRank[rResp.Rank].Devices = append(byRank[rResp.Rank].Devices, rResp.Devices...)
+					byRank[rResp.Rank].Pools = append(byRank[rResp.Rank].Pools, rResp.Pools...)
+				}
+			}
+
+			if diff := cmp.Diff(resp, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected reassembled response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+// stackDumpMaxOutputBytes is the maximum amount of stack dump output returned
+// in a response. The full dump is always available in the dump file.
+const stackDumpMaxOutputBytes = 2 << 20
+
+// readStackDump returns up to maxBytes of the output of the supplied dump,
+// and whether the output was truncated.
+func readStackDump(dump *stackDump, maxBytes int64) (string, bool, error) {
+	f, err := os.Open(dump.Path)
+	if err != nil {
+		return "", false, err
+	}
+	defer f.Close()
+
+	size := dump.Size
+	truncated := size > maxBytes
+	if truncated {
+		size = maxBytes
+	}
+
+	buf := make([]byte, size)
+	n, err := f.ReadAt(buf, dump.Offset)
+	if err != nil && err != io.EOF {
+		return "", false, errors.Wrapf(err, "read stack dump from %s", dump.Path)
+	}
+
+	return string(buf[:n]), truncated, nil
+}
+
+// DumpEngineStacks implements the method defined for the control service.
+//
+// Request that the engine with the given rank on this host dumps its Argobots
+// state and ULT stacks to a file, and return the location of the dump along
+// with its output if requested.
+func (svc *ControlService) DumpEngineStacks(ctx context.Context, req *ctlpb.DumpEngineStacksReq) (*ctlpb.DumpEngineStacksResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	instances, err := svc.harness.FilterInstancesByRankSet(fmt.Sprintf("%d", req.Rank))
+	if err != nil {
+		return nil, err
+	}
+	if len(instances) == 0 {
+		return nil, errors.Errorf("rank %d not found on this host", req.Rank)
+	}
+
+	dump, err := instances[0].DumpStacks(ctx, req.Unattended)
+	if err != nil {
+		return nil, err
+	}
+	svc.log.Noticef("rank %d ULT stacks dumped to %s (%d bytes at offset %d)",
+		req.Rank, dump.Path, dump.Size, dump.Offset)
+
+	resp := &ctlpb.DumpEngineStacksResp{
+		Path:   dump.Path,
+		Offset: dump.Offset,
+		Size:   dump.Size,
+	}
+	if req.ReturnOutput {
+		resp.Output, resp.Truncated, err = readStackDump(dump, stackDumpMaxOutputBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,158 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+func TestServer_readStackDump(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	dumpPath := filepath.Join(testDir, "daos_dump_1.txt")
+	if err := os.WriteFile(dumpPath, []byte("old dump\nnew dump\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		dump         *stackDump
+		maxBytes     int64
+		expOutput    string
+		expTruncated bool
+		expErr       error
+	}{
+		"missing file": {
+			dump:     &stackDump{Path: filepath.Join(testDir, "missing"), Size: 1},
+			maxBytes: 10,
+			expErr:   errors.New("no such file"),
+		},
+		"whole dump": {
+			dump:      &stackDump{Path: dumpPath, Offset: 9, Size: 9},
+			maxBytes:  100,
+			expOutput: "new dump\n",
+		},
+		"truncated dump": {
+			dump:         &stackDump{Path: dumpPath, Offset: 9, Size: 9},
+			maxBytes:     3,
+			expOutput:    "new",
+			expTruncated: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotOutput, gotTruncated, gotErr := readStackDump(tc.dump, tc.maxBytes)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expOutput, gotOutput, "unexpected output")
+			test.AssertEqual(t, tc.expTruncated, gotTruncated, "unexpected truncated")
+		})
+	}
+}
+
+func TestServer_CtlSvc_DumpEngineStacks(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	dumpPath := filepath.Join(testDir, "daos_dump_1.txt")
+	if err := os.WriteFile(dumpPath, []byte("old dump\nnew dump\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	testDump := &stackDump{Path: dumpPath, Offset: 9, Size: 9}
+
+	for name, tc := range map[string]struct {
+		req     *ctlpb.DumpEngineStacksReq
+		dump    *stackDump
+		dumpErr error
+		expResp *ctlpb.DumpEngineStacksResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"unknown rank": {
+			req:    &ctlpb.DumpEngineStacksReq{Rank: 5},
+			expErr: errors.New("rank 5 not found on this host"),
+		},
+		"dump fails": {
+			req:     &ctlpb.DumpEngineStacksReq{Rank: 1},
+			dumpErr: errors.New("waiting for stack dump"),
+			expErr:  errors.New("waiting for stack dump"),
+		},
+		"success": {
+			req:  &ctlpb.DumpEngineStacksReq{Rank: 1},
+			dump: testDump,
+			expResp: &ctlpb.DumpEngineStacksResp{
+				Path:   dumpPath,
+				Offset: 9,
+				Size:   9,
+			},
+		},
+		"success with output": {
+			req:  &ctlpb.DumpEngineStacksReq{Rank: 1, ReturnOutput: true},
+			dump: testDump,
+			expResp: &ctlpb.DumpEngineStacksResp{
+				Path:   dumpPath,
+				Offset: 9,
+				Size:   9,
+				Output: "new dump\n",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithEngines(
+				engine.MockConfig().WithTargetCount(1),
+				engine.MockConfig().WithTargetCount(1),
+			)
+			svc := mockControlService(t, log, cfg, nil, nil, nil)
+			svc.harness.instances = nil
+			for idx := range cfg.Engines {
+				mic := &MockInstanceConfig{
+					GetRankResp: ranklist.Rank(idx),
+					Index:       uint32(idx),
+				}
+				if idx == 1 {
+					mic.DumpStacksResp = tc.dump
+					mic.DumpStacksErr = tc.dumpErr
+				} else {
+					mic.DumpStacksErr = errors.New("wrong engine")
+				}
+				mic.Started.SetTrue()
+				svc.harness.instances = append(svc.harness.instances, NewMockInstance(mic))
+			}
+
+			gotResp, gotErr := svc.DumpEngineStacks(context.Background(), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build fault_inject
+// +build fault_inject
+
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+var injectedFaults faultRegistry
+
+func injectedFault(point string) (string, bool) {
+	return injectedFaults.get(point)
+}
+
+// FaultInject implements the method defined for the control service if fault
+// injection is enabled for this build.
+//
+// It sets or clears a fault at the requested injection point and returns the
+// faults that remain active.
+func (svc *ControlService) FaultInject(ctx context.Context, req *ctlpb.FaultInjectReq) (*ctlpb.FaultInjectResp, error) {
+	if req == nil || req.Fault == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if req.Clear {
+		svc.log.Noticef("clearing injected fault %q", req.Fault.Point)
+		injectedFaults.clear(req.Fault.Point)
+	} else {
+		svc.log.Noticef("injecting fault %q (%q)", req.Fault.Point, req.Fault.Arg)
+		if err := injectedFaults.set(req.Fault); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ctlpb.FaultInjectResp{Active: injectedFaults.active()}, nil
+}
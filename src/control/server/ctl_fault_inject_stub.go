@@ -0,0 +1,15 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !fault_inject
+// +build !fault_inject
+
+package server
+
+// injectedFault never reports a fault, as fault injection is disabled for
+// this build. The FaultInject RPC is left unimplemented.
+func injectedFault(_ string) (string, bool) {
+	return "", false
+}
@@ -0,0 +1,87 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build fault_inject
+// +build fault_inject
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestServer_CtlSvc_FaultInject(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *ctlpb.FaultInjectReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"nil fault": {
+			req:    &ctlpb.FaultInjectReq{},
+			expErr: errors.New("nil request"),
+		},
+		"invalid fault": {
+			req: &ctlpb.FaultInjectReq{
+				Fault: &ctlpb.InjectedFault{Point: faultFormatFail, Arg: "pmem"},
+			},
+			expErr: errors.New("invalid format-fail step"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cs := mockControlService(t, log, config.DefaultServer(), nil, nil, nil)
+
+			_, err := cs.FaultInject(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestServer_CtlSvc_FaultInject_SetAndClear(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	cs := mockControlService(t, log, config.DefaultServer(), nil, nil, nil)
+	defer injectedFaults.clear(faultFormatFail)
+
+	fault := &ctlpb.InjectedFault{Point: faultFormatFail, Arg: formatStepNVMe}
+
+	resp, err := cs.FaultInject(context.TODO(), &ctlpb.FaultInjectReq{Fault: fault})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expActive := []*ctlpb.InjectedFault{fault}
+	if diff := cmp.Diff(expActive, resp.Active, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected active faults (-want, +got):\n%s\n", diff)
+	}
+	test.CmpErr(t, errInjectedFault, injectFormatFailure(formatStepNVMe))
+	test.CmpErr(t, nil, injectFormatFailure(formatStepSCM))
+
+	resp, err = cs.FaultInject(context.TODO(), &ctlpb.FaultInjectReq{
+		Fault: &ctlpb.InjectedFault{Point: faultFormatFail},
+		Clear: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Active) != 0 {
+		t.Fatalf("expected no active faults after clear, got %v", resp.Active)
+	}
+	test.CmpErr(t, nil, injectFormatFailure(formatStepNVMe))
+}
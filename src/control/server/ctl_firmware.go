@@ -148,6 +148,10 @@ func (svc *ControlService) updateSCM(pbReq *ctlpb.FirmwareUpdateReq, pbResp *ctl
 			return err
 		}
 		pbRes.Error = res.Error
+		if res.Info != nil {
+			pbRes.StagedVersion = res.Info.StagedVersion
+			pbRes.UpdateStatus = uint32(res.Info.UpdateStatus)
+		}
 		pbResp.ScmResults = append(pbResp.ScmResults, pbRes)
 	}
 	return nil
@@ -159,6 +163,8 @@ func (svc *ControlService) updateNVMe(pbReq *ctlpb.FirmwareUpdateReq, pbResp *ct
 		FirmwareRev:  pbReq.FirmwareRev,
 		ModelID:      pbReq.ModelID,
 		DeviceAddrs:  pbReq.DeviceIDs,
+		Action:       storage.NVMeFirmwareAction(pbReq.NvmeAction),
+		Slot:         int32(pbReq.NvmeSlot),
 	})
 	if err != nil {
 		return err
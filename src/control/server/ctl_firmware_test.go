@@ -593,6 +593,30 @@ func TestCtlSvc_FirmwareUpdate(t *testing.T) {
 				},
 			},
 		},
+		"SCM - success with staged firmware": {
+			req: ctlpb.FirmwareUpdateReq{
+				Type:         ctlpb.FirmwareUpdateReq_SCM,
+				FirmwarePath: "/some/path",
+				DeviceIDs:    []string{"Device1"},
+			},
+			smbc: &scm.MockBackendConfig{
+				GetModulesRes: mockSCM,
+				GetFirmwareStatusRes: &storage.ScmFirmwareInfo{
+					ActiveVersion: "1.0.0.1",
+					StagedVersion: "1.0.0.2",
+					UpdateStatus:  storage.ScmUpdateStatusStaged,
+				},
+			},
+			expResp: &ctlpb.FirmwareUpdateResp{
+				ScmResults: []*ctlpb.ScmFirmwareUpdateResp{
+					{
+						Module:        mockPbSCM[1],
+						StagedVersion: "1.0.0.2",
+						UpdateStatus:  uint32(storage.ScmUpdateStatusStaged),
+					},
+				},
+			},
+		},
 		"SCM - failed with devices": {
 			req: ctlpb.FirmwareUpdateReq{
 				Type:         ctlpb.FirmwareUpdateReq_SCM,
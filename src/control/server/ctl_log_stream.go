@@ -0,0 +1,435 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+const (
+	// logStreamDefaultRate is the rate at which lines are sent if the
+	// request does not specify one.
+	logStreamDefaultRate = 1000
+	// logStreamMaxRate is the maximum rate at which lines will be sent
+	// regardless of the rate requested.
+	logStreamMaxRate = 10000
+	// logStreamMaxBatchLines is the maximum number of lines sent in a
+	// single response.
+	logStreamMaxBatchLines = 256
+	// logStreamMaxBatchBytes is the approximate maximum size of the lines
+	// sent in a single response.
+	logStreamMaxBatchBytes = 64 << 10
+	// logStreamPollInterval is the period between checks for new lines
+	// when following a log file.
+	logStreamPollInterval = 500 * time.Millisecond
+)
+
+// logLevels maps the severity names used by the engine and control plane
+// log formats to a common ordering.
+var logLevels = map[string]int{
+	"DBUG":   0,
+	"DEBUG":  0,
+	"INFO":   1,
+	"NOTE":   2,
+	"NOTICE": 2,
+	"WARN":   3,
+	"ERR":    4,
+	"ERROR":  4,
+	"CRIT":   5,
+	"ALRT":   6,
+	"EMRG":   7,
+	"FATAL":  7,
+	"EMIT":   8,
+}
+
+// logFilter selects the lines of a log file to be returned to the caller.
+type logFilter struct {
+	control    bool
+	minLevel   int
+	facilities map[string]struct{}
+	lastMatch  bool
+}
+
+func newLogFilter(req *ctlpb.LogStreamReq) (*logFilter, error) {
+	lf := &logFilter{
+		control:   req.Control,
+		lastMatch: true,
+	}
+
+	if req.Level != "" {
+		level, found := logLevels[strings.ToUpper(req.Level)]
+		if !found {
+			return nil, errors.Errorf("unknown log level %q", req.Level)
+		}
+		lf.minLevel = level
+	}
+
+	if len(req.Facilities) > 0 {
+		if req.Control {
+			return nil, errors.New("facility filter cannot be used with the control plane log")
+		}
+		lf.facilities = make(map[string]struct{})
+		for _, fac := range req.Facilities {
+			lf.facilities[strings.ToLower(fac)] = struct{}{}
+		}
+	}
+
+	return lf, nil
+}
+
+// parse returns the severity and facility of a log line, or false if the line
+// does not start with a log header (e.g. it is the continuation of a
+// multi-line message).
+//
+// Engine lines are of the form "<date> <host> <tag> <facility> <level> ...",
+// and control plane lines begin with "[<host>] <level> ...".
+func (lf *logFilter) parse(line string) (int, string, bool) {
+	fields := strings.Fields(line)
+
+	if lf.control {
+		for i := 0; i < 2 && i < len(fields); i++ {
+			if level, found := logLevels[strings.TrimSuffix(fields[i], ":")]; found {
+				return level, "", true
+			}
+		}
+		return 0, "", false
+	}
+
+	if len(fields) < 5 {
+		return 0, "", false
+	}
+	level, found := logLevels[fields[4]]
+	if !found {
+		return 0, "", false
+	}
+	return level, strings.ToLower(fields[3]), true
+}
+
+// match indicates whether the line should be returned. Lines without a header
+// are matched if the preceding line was.
+func (lf *logFilter) match(line string) bool {
+	level, fac, ok := lf.parse(line)
+	if !ok {
+		return lf.lastMatch
+	}
+
+	lf.lastMatch = level >= lf.minLevel
+	if lf.lastMatch && lf.facilities != nil {
+		_, lf.lastMatch = lf.facilities[fac]
+	}
+	return lf.lastMatch
+}
+
+// logTailer reads complete lines from a log file, tracking the offset
+// following the last line read so that reading can be resumed later.
+type logTailer struct {
+	path    string
+	file    *os.File
+	rdr     *bufio.Reader
+	offset  int64
+	partial []byte
+}
+
+func newLogTailer(path string, offset int64, tail uint32) (*logTailer, error) {
+	lt := &logTailer{path: path}
+	if err := lt.open(); err != nil {
+		return nil, err
+	}
+
+	fi, err := lt.file.Stat()
+	if err != nil {
+		lt.Close()
+		return nil, errors.Wrapf(err, "stat %q", path)
+	}
+
+	switch {
+	case offset > fi.Size():
+		// The file has been truncated or replaced since the offset
+		// was returned, so start again from the beginning.
+	case offset > 0:
+		lt.offset = offset
+	case tail > 0:
+		if lt.offset, err = tailOffset(lt.file, fi.Size(), tail); err != nil {
+			lt.Close()
+			return nil, errors.Wrapf(err, "read %q", path)
+		}
+	}
+
+	if _, err := lt.file.Seek(lt.offset, io.SeekStart); err != nil {
+		lt.Close()
+		return nil, errors.Wrapf(err, "seek %q", path)
+	}
+
+	return lt, nil
+}
+
+func (lt *logTailer) open() error {
+	f, err := os.Open(lt.path)
+	if err != nil {
+		return errors.Wrap(err, "open log file")
+	}
+
+	lt.file = f
+	lt.rdr = bufio.NewReader(f)
+	lt.offset = 0
+	lt.partial = nil
+	return nil
+}
+
+// Close closes the underlying log file.
+func (lt *logTailer) Close() error {
+	return lt.file.Close()
+}
+
+// readLine returns the next complete line, or false if the end of the file
+// has been reached. An incomplete final line is held back until the rest of
+// it has been written.
+func (lt *logTailer) readLine() (string, bool, error) {
+	chunk, err := lt.rdr.ReadBytes('\n')
+	lt.partial = append(lt.partial, chunk...)
+	if err == io.EOF {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, errors.Wrapf(err, "read %q", lt.path)
+	}
+
+	line := strings.TrimRight(string(lt.partial), "\r\n")
+	lt.offset += int64(len(lt.partial))
+	lt.partial = lt.partial[:0]
+	return line, true, nil
+}
+
+// reopenIfReplaced starts reading from the beginning of the log file if it
+// has been truncated or replaced (e.g. by log rotation).
+func (lt *logTailer) reopenIfReplaced() (bool, error) {
+	cur, err := lt.file.Stat()
+	if err != nil {
+		return false, errors.Wrapf(err, "stat %q", lt.path)
+	}
+	latest, err := os.Stat(lt.path)
+	if err != nil {
+		// The file may be briefly missing while being rotated.
+		return false, nil
+	}
+
+	if os.SameFile(cur, latest) && latest.Size() >= lt.offset+int64(len(lt.partial)) {
+		return false, nil
+	}
+
+	lt.Close()
+	return true, lt.open()
+}
+
+// tailOffset returns the offset of the start of the last n lines of a file.
+func tailOffset(f io.ReaderAt, size int64, n uint32) (int64, error) {
+	buf := make([]byte, 4096)
+	var count uint32
+
+	for pos := size; pos > 0; {
+		chunk := int64(len(buf))
+		if pos < chunk {
+			chunk = pos
+		}
+		pos -= chunk
+
+		if _, err := f.ReadAt(buf[:chunk], pos); err != nil && err != io.EOF {
+			return 0, err
+		}
+		for i := chunk - 1; i >= 0; i-- {
+			// Ignore the newline terminating the final line.
+			if buf[i] != '\n' || pos+i == size-1 {
+				continue
+			}
+			count++
+			if count == n {
+				return pos + i + 1, nil
+			}
+		}
+	}
+
+	return 0, nil
+}
+
+// lineRateLimiter paces the sending of lines so that the average rate does
+// not exceed the configured number of lines per second.
+type lineRateLimiter struct {
+	rate uint32
+	next time.Time
+}
+
+func newLineRateLimiter(rate uint32) *lineRateLimiter {
+	if rate == 0 {
+		rate = logStreamDefaultRate
+	}
+	if rate > logStreamMaxRate {
+		rate = logStreamMaxRate
+	}
+	return &lineRateLimiter{rate: rate}
+}
+
+// batchSize returns the maximum number of lines that should be sent at once.
+func (rl *lineRateLimiter) batchSize() int {
+	if rl.rate < logStreamMaxBatchLines {
+		return int(rl.rate)
+	}
+	return logStreamMaxBatchLines
+}
+
+// wait blocks until n more lines may be sent without exceeding the rate.
+func (rl *lineRateLimiter) wait(ctx context.Context, n int) error {
+	now := time.Now()
+	if rl.next.Before(now) {
+		rl.next = now
+	}
+	delay := rl.next.Sub(now)
+	rl.next = rl.next.Add(time.Duration(n) * time.Second / time.Duration(rl.rate))
+
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// logStreamPath returns the path of the log file requested.
+func (svc *ControlService) logStreamPath(req *ctlpb.LogStreamReq) (string, error) {
+	if req.Control {
+		if svc.srvCfg.ControlLogFile == "" {
+			return "", errors.New("no control_log_file set in server config")
+		}
+		return svc.srvCfg.ControlLogFile, nil
+	}
+
+	rank := ranklist.Rank(req.Rank)
+	for _, ei := range svc.harness.Instances() {
+		eiRank, err := ei.GetRank()
+		if err != nil || !eiRank.Equals(rank) {
+			continue
+		}
+
+		idx := int(ei.Index())
+		if idx >= len(svc.srvCfg.Engines) || svc.srvCfg.Engines[idx].LogFile == "" {
+			return "", errors.Errorf("engine-%d: no log_file set in engine config", idx)
+		}
+		return svc.srvCfg.Engines[idx].LogFile, nil
+	}
+
+	return "", errors.Errorf("rank %d not found on this host", rank)
+}
+
+// LogStream implements the method defined for the control service.
+//
+// Stream lines from an engine or control plane log file on this host,
+// filtered by level and facility. If the follow flag is set, new lines are
+// streamed as they are written until the client cancels the request. Lines
+// are sent at a bounded rate in order to avoid flooding the network, and
+// each response includes the file offset from which the stream may be
+// resumed if it is interrupted.
+func (svc *ControlService) LogStream(req *ctlpb.LogStreamReq, stream ctlpb.CtlSvc_LogStreamServer) error {
+	if req == nil {
+		return errors.New("nil request")
+	}
+
+	filter, err := newLogFilter(req)
+	if err != nil {
+		return err
+	}
+
+	path, err := svc.logStreamPath(req)
+	if err != nil {
+		return err
+	}
+
+	lt, err := newLogTailer(path, req.Offset, req.Tail)
+	if err != nil {
+		return err
+	}
+	defer lt.Close()
+
+	ctx := stream.Context()
+	limiter := newLineRateLimiter(req.MaxLinesPerSec)
+	maxLines := limiter.batchSize()
+	sentOffset := req.Offset
+
+	resp := &ctlpb.LogStreamResp{Path: path}
+	var respBytes int
+	send := func() error {
+		if err := limiter.wait(ctx, len(resp.Lines)); err != nil {
+			return err
+		}
+		resp.Offset = lt.offset
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		sentOffset = lt.offset
+		resp = &ctlpb.LogStreamResp{Path: path}
+		respBytes = 0
+		return nil
+	}
+
+	for {
+		line, ok, err := lt.readLine()
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			if filter.match(line) {
+				resp.Lines = append(resp.Lines, line)
+				respBytes += len(line)
+			}
+			if len(resp.Lines) >= maxLines || respBytes >= logStreamMaxBatchBytes {
+				if err := send(); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		// End of file reached; flush any pending lines, and report the
+		// new offset even if all of the lines read were filtered out.
+		if len(resp.Lines) > 0 || lt.offset != sentOffset {
+			if err := send(); err != nil {
+				return err
+			}
+		}
+
+		if !req.Follow {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(logStreamPollInterval):
+		}
+
+		reopened, err := lt.reopenIfReplaced()
+		if err != nil {
+			return err
+		}
+		if reopened {
+			svc.log.Debugf("log stream: %s was replaced; reading from start", path)
+			sentOffset = -1
+		}
+	}
+}
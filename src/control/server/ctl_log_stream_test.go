@@ -0,0 +1,291 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+type mockLogStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	resps []*ctlpb.LogStreamResp
+	onRcv func(*ctlpb.LogStreamResp)
+}
+
+func (ms *mockLogStream) Context() context.Context {
+	return ms.ctx
+}
+
+func (ms *mockLogStream) Send(resp *ctlpb.LogStreamResp) error {
+	ms.resps = append(ms.resps, resp)
+	if ms.onRcv != nil {
+		ms.onRcv(resp)
+	}
+	return nil
+}
+
+func (ms *mockLogStream) lines() []string {
+	var lines []string
+	for _, resp := range ms.resps {
+		lines = append(lines, resp.Lines...)
+	}
+	return lines
+}
+
+var (
+	testEngineLog = []string{
+		"10/17-08:12:41.07 host1 DAOS[4021/0/0] server INFO src/engine/init.c:1 server_init() starting",
+		"10/17-08:12:41.08 host1 DAOS[4021/0/0] mgmt DBUG src/mgmt/srv.c:2 ds_mgmt_init() init",
+		"10/17-08:12:41.09 host1 DAOS[4021/0/0] pool WARN src/pool/srv.c:3 pool_init() slow",
+		"10/17-08:12:41.10 host1 DAOS[4021/0/0] mgmt ERR  src/mgmt/srv.c:4 ds_mgmt_drpc() failed:",
+		"  continued",
+		"10/17-08:12:41.11 host1 DAOS[4021/0/0] rdb INFO src/rdb/rdb.c:5 rdb_start() started",
+	}
+	testControlLog = []string{
+		"DEBUG 2023/10/17 08:12:41.072511 main.go:1: parsing config",
+		"host1 INFO 2023/10/17 08:12:41 server.go:2: starting",
+		"host1 NOTICE 2023/10/17 08:12:42 server.go:3: listening",
+		"host1 ERROR 2023/10/17 08:12:43 server.go:4: failed",
+	}
+)
+
+func TestServer_CtlSvc_LogStream(t *testing.T) {
+	engineLogData := strings.Join(testEngineLog, "\n") + "\n"
+
+	for name, tc := range map[string]struct {
+		req       *ctlpb.LogStreamReq
+		noCtlLog  bool
+		expLines  []string
+		expOffset int64
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"unknown rank": {
+			req:    &ctlpb.LogStreamReq{Rank: 5},
+			expErr: errors.New("rank 5 not found"),
+		},
+		"bad level": {
+			req:    &ctlpb.LogStreamReq{Level: "LOUD"},
+			expErr: errors.New("unknown log level"),
+		},
+		"no control log": {
+			req:      &ctlpb.LogStreamReq{Control: true},
+			noCtlLog: true,
+			expErr:   errors.New("no control_log_file"),
+		},
+		"control log with facilities": {
+			req:    &ctlpb.LogStreamReq{Control: true, Facilities: []string{"mgmt"}},
+			expErr: errors.New("facility filter cannot be used"),
+		},
+		"whole engine log": {
+			req:       &ctlpb.LogStreamReq{Rank: 1},
+			expLines:  testEngineLog,
+			expOffset: int64(len(engineLogData)),
+		},
+		"level filter": {
+			req:       &ctlpb.LogStreamReq{Rank: 1, Level: "warn"},
+			expLines:  testEngineLog[2:5],
+			expOffset: int64(len(engineLogData)),
+		},
+		"facility filter": {
+			req:       &ctlpb.LogStreamReq{Rank: 1, Facilities: []string{"MGMT", "rdb"}},
+			expLines:  []string{testEngineLog[1], testEngineLog[3], testEngineLog[4], testEngineLog[5]},
+			expOffset: int64(len(engineLogData)),
+		},
+		"tail": {
+			req:       &ctlpb.LogStreamReq{Rank: 1, Tail: 2},
+			expLines:  testEngineLog[4:],
+			expOffset: int64(len(engineLogData)),
+		},
+		"tail more than file": {
+			req:       &ctlpb.LogStreamReq{Rank: 1, Tail: 100},
+			expLines:  testEngineLog,
+			expOffset: int64(len(engineLogData)),
+		},
+		"resume from offset": {
+			req: &ctlpb.LogStreamReq{
+				Rank:   1,
+				Offset: int64(len(strings.Join(testEngineLog[:3], "\n")) + 1),
+				Tail:   1,
+			},
+			expLines:  testEngineLog[3:],
+			expOffset: int64(len(engineLogData)),
+		},
+		"offset beyond truncated file": {
+			req:       &ctlpb.LogStreamReq{Rank: 1, Offset: 1 << 20},
+			expLines:  testEngineLog,
+			expOffset: int64(len(engineLogData)),
+		},
+		"control log level filter": {
+			req:       &ctlpb.LogStreamReq{Control: true, Level: "NOTICE"},
+			expLines:  testControlLog[2:],
+			expOffset: int64(len(strings.Join(testControlLog, "\n")) + 1),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			engineLogs := make([]string, 2)
+			for i := range engineLogs {
+				engineLogs[i] = filepath.Join(testDir, "engine.log."+string(rune('0'+i)))
+				if err := os.WriteFile(engineLogs[i], []byte(engineLogData), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := os.WriteFile(engineLogs[0], []byte("wrong engine\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg := config.DefaultServer().WithEngines(
+				engine.MockConfig().WithTargetCount(1).WithLogFile(engineLogs[0]),
+				engine.MockConfig().WithTargetCount(1).WithLogFile(engineLogs[1]),
+			)
+			if !tc.noCtlLog {
+				ctlLog := filepath.Join(testDir, "daos_server.log")
+				data := strings.Join(testControlLog, "\n") + "\n"
+				if err := os.WriteFile(ctlLog, []byte(data), 0644); err != nil {
+					t.Fatal(err)
+				}
+				cfg = cfg.WithControlLogFile(ctlLog)
+			}
+			svc := mockControlService(t, log, cfg, nil, nil, nil)
+
+			stream := &mockLogStream{ctx: context.Background()}
+			gotErr := svc.LogStream(tc.req, stream)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expLines, stream.lines()); diff != "" {
+				t.Fatalf("unexpected lines (-want, +got):\n%s\n", diff)
+			}
+			last := stream.resps[len(stream.resps)-1]
+			test.AssertEqual(t, tc.expOffset, last.Offset, "unexpected final offset")
+		})
+	}
+}
+
+func TestServer_CtlSvc_LogStream_Follow(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	logPath := filepath.Join(testDir, "engine.log")
+	if err := os.WriteFile(logPath, []byte(testEngineLog[0]+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	cfg := config.DefaultServer().WithEngines(
+		engine.MockConfig().WithTargetCount(1).WithLogFile(logPath),
+	)
+	svc := mockControlService(t, log, cfg, nil, nil, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Append a line each time a response is received, finishing with an
+	// incomplete line which must be held back until it has been completed.
+	pending := testEngineLog[1:]
+	stream := &mockLogStream{ctx: ctx}
+	stream.onRcv = func(resp *ctlpb.LogStreamResp) {
+		for _, line := range resp.Lines {
+			if strings.HasPrefix(line, "partial") && line != "partial line" {
+				t.Errorf("incomplete line sent: %q", line)
+			}
+		}
+
+		switch {
+		case len(pending) > 0:
+			if _, err := f.WriteString(pending[0] + "\n"); err != nil {
+				t.Error(err)
+			}
+			pending = pending[1:]
+		case len(stream.lines()) == len(testEngineLog):
+			if _, err := f.WriteString("partial "); err != nil {
+				t.Error(err)
+			}
+			go func() {
+				time.Sleep(2 * logStreamPollInterval)
+				if _, err := f.WriteString("line\n"); err != nil {
+					t.Error(err)
+				}
+			}()
+		default:
+			cancel()
+		}
+	}
+
+	gotErr := svc.LogStream(&ctlpb.LogStreamReq{
+		Rank:           0,
+		Follow:         true,
+		MaxLinesPerSec: 100,
+	}, stream)
+	test.CmpErr(t, context.Canceled, gotErr)
+
+	expLines := append(append([]string{}, testEngineLog...), "partial line")
+	if diff := cmp.Diff(expLines, stream.lines()); diff != "" {
+		t.Fatalf("unexpected lines (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestServer_lineRateLimiter(t *testing.T) {
+	rl := newLineRateLimiter(0)
+	test.AssertEqual(t, uint32(logStreamDefaultRate), rl.rate, "unexpected default rate")
+	test.AssertEqual(t, logStreamMaxBatchLines, rl.batchSize(), "unexpected batch size")
+
+	rl = newLineRateLimiter(logStreamMaxRate * 2)
+	test.AssertEqual(t, uint32(logStreamMaxRate), rl.rate, "rate not capped")
+
+	rl = newLineRateLimiter(20)
+	test.AssertEqual(t, 20, rl.batchSize(), "unexpected batch size")
+
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := rl.wait(ctx, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 2 batches of 2 lines must be paid for before the third is sent.
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Fatalf("expected rate to be limited, took %s", elapsed)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	test.CmpErr(t, context.Canceled, rl.wait(canceled, 1))
+}
@@ -14,6 +14,7 @@ import (
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hardware/hwprov"
+	"github.com/daos-stack/daos/src/control/lib/hardware/sysfs"
 )
 
 // NetworkScan retrieves details of network interfaces on remote hosts.
@@ -41,6 +42,7 @@ func (c *ControlService) NetworkScan(ctx context.Context, req *ctlpb.NetworkScan
 	}
 
 	resp := c.fabricInterfaceSetToNetworkScanResp(result, provider)
+	setInterfaceLinkSpeeds(sysfs.NewProvider(c.log), resp.Interfaces)
 
 	resp.Numacount = int32(topo.NumNUMANodes())
 	resp.Corespernuma = int32(topo.NumCoresPerNUMA())
@@ -79,3 +81,21 @@ func (c *ControlService) fabricInterfaceSetToNetworkScanResp(fis *hardware.Fabri
 
 	return resp
 }
+
+// netDevSpeedProvider is an interface for a type that can be used to get the
+// link speed of a network interface in Mbit/s.
+type netDevSpeedProvider interface {
+	GetNetDevSpeed(string) (uint64, error)
+}
+
+// setInterfaceLinkSpeeds populates the link speed of each interface's network
+// port in Gbit/s, where known.
+func setInterfaceLinkSpeeds(sp netDevSpeedProvider, ifaces []*ctlpb.FabricInterface) {
+	for _, fi := range ifaces {
+		speed, err := sp.GetNetDevSpeed(fi.Device)
+		if err != nil {
+			continue
+		}
+		fi.Linkspeed = float64(speed) / 1000
+	}
+}
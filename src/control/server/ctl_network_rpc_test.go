@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
@@ -164,3 +165,38 @@ func TestServer_ControlService_fabricInterfaceSetToNetworkScanResp(t *testing.T)
 		})
 	}
 }
+
+type mockNetDevSpeedProvider map[string]uint64
+
+func (m mockNetDevSpeedProvider) GetNetDevSpeed(iface string) (uint64, error) {
+	speed, found := m[iface]
+	if !found {
+		return 0, errors.Errorf("%q link speed is unknown", iface)
+	}
+	return speed, nil
+}
+
+func TestServer_setInterfaceLinkSpeeds(t *testing.T) {
+	sp := mockNetDevSpeedProvider{
+		"ib0":   200000,
+		"bond0": 2500,
+	}
+	ifaces := []*ctlpb.FabricInterface{
+		{Device: "ib0", Provider: "ofi+verbs"},
+		{Device: "ib0", Provider: "ofi+tcp"},
+		{Device: "eth0", Provider: "ofi+tcp"},
+		{Device: "bond0", Provider: "ofi+tcp"},
+	}
+
+	setInterfaceLinkSpeeds(sp, ifaces)
+
+	expIfaces := []*ctlpb.FabricInterface{
+		{Device: "ib0", Provider: "ofi+verbs", Linkspeed: 200},
+		{Device: "ib0", Provider: "ofi+tcp", Linkspeed: 200},
+		{Device: "eth0", Provider: "ofi+tcp"},
+		{Device: "bond0", Provider: "ofi+tcp", Linkspeed: 2.5},
+	}
+	if diff := cmp.Diff(expIfaces, ifaces, test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
@@ -0,0 +1,267 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+const (
+	// poolDebugBin is the name of the offline VOS debugger binary.
+	poolDebugBin = "ddb"
+	// poolDebugMaxBatchLines is the maximum number of output lines sent in
+	// a single response.
+	poolDebugMaxBatchLines = 256
+	// poolDebugMaxLineBytes is the maximum length of a line of debugger
+	// output.
+	poolDebugMaxLineBytes = 1 << 20
+)
+
+// findPoolDebugBin returns the path of the offline VOS debugger binary.
+var findPoolDebugBin = func() (string, error) {
+	return common.FindBinary(poolDebugBin)
+}
+
+// rankGuard records the ranks whose storage is in use by the control plane,
+// so that their engines are not started in the meantime.
+type rankGuard struct {
+	sync.Mutex
+	ranks map[ranklist.Rank]struct{}
+}
+
+// acquire marks the rank as in use, or returns false if it already is.
+func (rg *rankGuard) acquire(rank ranklist.Rank) bool {
+	rg.Lock()
+	defer rg.Unlock()
+
+	if _, held := rg.ranks[rank]; held {
+		return false
+	}
+	if rg.ranks == nil {
+		rg.ranks = make(map[ranklist.Rank]struct{})
+	}
+	rg.ranks[rank] = struct{}{}
+	return true
+}
+
+func (rg *rankGuard) release(rank ranklist.Rank) {
+	rg.Lock()
+	defer rg.Unlock()
+
+	delete(rg.ranks, rank)
+}
+
+func (rg *rankGuard) held(rank ranklist.Rank) bool {
+	rg.Lock()
+	defer rg.Unlock()
+
+	_, held := rg.ranks[rank]
+	return held
+}
+
+// poolShard identifies the VOS file holding a pool shard on a target.
+type poolShard struct {
+	target uint32
+	path   string
+}
+
+// poolShards returns the VOS files of the pool's shards on an engine. The
+// files are named by target index within the pool's directory on the
+// engine's SCM mount. If no targets are requested, the shards of all targets
+// that have one are returned.
+func poolShards(scmMount, poolUUID string, tgtCount int, targets []uint32) ([]poolShard, error) {
+	poolDir := filepath.Join(scmMount, poolUUID)
+	if _, err := os.Stat(poolDir); err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Errorf("no shards of pool %s found in %s", poolUUID, scmMount)
+		}
+		return nil, err
+	}
+
+	allTargets := len(targets) == 0
+	if allTargets {
+		for i := 0; i < tgtCount; i++ {
+			targets = append(targets, uint32(i))
+		}
+	}
+
+	var shards []poolShard
+	for _, tgt := range targets {
+		if int(tgt) >= tgtCount {
+			return nil, errors.Errorf("target %d out of range (engine has %d targets)", tgt, tgtCount)
+		}
+
+		path := filepath.Join(poolDir, fmt.Sprintf("vos-%d", tgt))
+		if _, err := os.Stat(path); err != nil {
+			if os.IsNotExist(err) {
+				if allTargets {
+					continue
+				}
+				return nil, errors.Errorf("no shard of pool %s found for target %d", poolUUID, tgt)
+			}
+			return nil, err
+		}
+		shards = append(shards, poolShard{target: tgt, path: path})
+	}
+
+	if len(shards) == 0 {
+		return nil, errors.Errorf("no shards of pool %s found in %s", poolUUID, poolDir)
+	}
+
+	return shards, nil
+}
+
+// poolDebugShards checks that the engine running the requested rank is stopped
+// and returns the VOS files to be debugged.
+func (svc *ControlService) poolDebugShards(req *ctlpb.PoolDebugReq) ([]poolShard, error) {
+	rank := ranklist.Rank(req.Rank)
+	for _, ei := range svc.harness.Instances() {
+		eiRank, err := ei.GetRank()
+		if err != nil || !eiRank.Equals(rank) {
+			continue
+		}
+
+		if ei.IsStarted() {
+			return nil, errors.Errorf("rank %d engine is running; stop it with "+
+				"\"dmg system stop --ranks=%d\" before debugging its pool shards", rank, rank)
+		}
+
+		idx := int(ei.Index())
+		if idx >= len(svc.srvCfg.Engines) {
+			return nil, errors.Errorf("engine-%d: no config found", idx)
+		}
+		scmCfgs := svc.srvCfg.Engines[idx].Storage.Tiers.ScmConfigs()
+		if len(scmCfgs) != 1 {
+			return nil, errors.Errorf("engine-%d: expected a single SCM tier", idx)
+		}
+
+		return poolShards(scmCfgs[0].Scm.MountPoint, req.PoolUuid, ei.GetTargetCount(), req.Targets)
+	}
+
+	return nil, errors.Errorf("rank %d not found on this host", rank)
+}
+
+// runPoolDebugger runs the debugger against a single shard and streams its
+// combined output back to the client.
+func runPoolDebugger(ctx context.Context, bin string, req *ctlpb.PoolDebugReq, shard poolShard, stream ctlpb.CtlSvc_PoolDebugServer) error {
+	args := []string{"-R", req.Command}
+	if req.WriteMode {
+		args = append(args, "-w")
+	}
+	args = append(args, shard.path)
+
+	pr, pw := io.Pipe()
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Stdout = pw
+	cmd.Stderr = pw
+
+	if err := cmd.Start(); err != nil {
+		return errors.Wrapf(err, "starting %s", poolDebugBin)
+	}
+	waitErr := make(chan error, 1)
+	go func() {
+		err := cmd.Wait()
+		pw.Close()
+		waitErr <- err
+	}()
+
+	resp := &ctlpb.PoolDebugResp{Target: shard.target, Path: shard.path}
+	scanner := bufio.NewScanner(pr)
+	scanner.Buffer(nil, poolDebugMaxLineBytes)
+	for scanner.Scan() {
+		resp.Lines = append(resp.Lines, scanner.Text())
+		if len(resp.Lines) < poolDebugMaxBatchLines {
+			continue
+		}
+		if err := stream.Send(resp); err != nil {
+			pr.CloseWithError(err)
+			<-waitErr
+			return err
+		}
+		resp = &ctlpb.PoolDebugResp{Target: shard.target, Path: shard.path}
+	}
+	if err := scanner.Err(); err != nil {
+		pr.CloseWithError(err)
+		<-waitErr
+		return errors.Wrapf(err, "reading %s output", poolDebugBin)
+	}
+
+	err := <-waitErr
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+	case errors.As(err, &exitErr):
+		resp.ExitCode = int32(exitErr.ExitCode())
+	default:
+		return errors.Wrapf(err, "running %s", poolDebugBin)
+	}
+
+	resp.Done = true
+	return stream.Send(resp)
+}
+
+// PoolDebug implements the method defined for the control service.
+//
+// Run the offline VOS debugger against the shards of a pool held by a stopped
+// engine on this host, streaming the debugger output back to the client. The
+// engine is prevented from being started until the debugger has finished with
+// all of the requested shards.
+func (svc *ControlService) PoolDebug(req *ctlpb.PoolDebugReq, stream ctlpb.CtlSvc_PoolDebugServer) error {
+	if req == nil {
+		return errors.New("nil request")
+	}
+	if _, err := uuid.Parse(req.PoolUuid); err != nil {
+		return errors.Wrapf(err, "invalid pool UUID %q", req.PoolUuid)
+	}
+	if req.Command == "" {
+		return errors.New("no debugger command specified")
+	}
+
+	rank := ranklist.Rank(req.Rank)
+	if !svc.debugRanks.acquire(rank) {
+		return errors.Errorf("rank %d is already being debugged", rank)
+	}
+	defer svc.debugRanks.release(rank)
+
+	shards, err := svc.poolDebugShards(req)
+	if err != nil {
+		return err
+	}
+
+	bin, err := findPoolDebugBin()
+	if err != nil {
+		return errors.Wrapf(err, "unable to locate %s", poolDebugBin)
+	}
+
+	ctx := stream.Context()
+	for _, shard := range shards {
+		svc.log.Noticef("running %s %q (write mode: %t) on %s", poolDebugBin, req.Command,
+			req.WriteMode, shard.path)
+		if err := runPoolDebugger(ctx, bin, req, shard, stream); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+type mockPoolDebugStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	resps []*ctlpb.PoolDebugResp
+}
+
+func (ms *mockPoolDebugStream) Context() context.Context {
+	return ms.ctx
+}
+
+func (ms *mockPoolDebugStream) Send(resp *ctlpb.PoolDebugResp) error {
+	ms.resps = append(ms.resps, resp)
+	return nil
+}
+
+func TestServer_poolShards(t *testing.T) {
+	poolUUID := test.MockUUID()
+
+	for name, tc := range map[string]struct {
+		noPoolDir bool
+		targets   []uint32
+		expTgts   []uint32
+		expErr    error
+	}{
+		"no pool directory": {
+			noPoolDir: true,
+			expErr:    errors.New("no shards of pool"),
+		},
+		"all targets": {
+			expTgts: []uint32{0, 1},
+		},
+		"selected target": {
+			targets: []uint32{1},
+			expTgts: []uint32{1},
+		},
+		"target out of range": {
+			targets: []uint32{4},
+			expErr:  errors.New("out of range"),
+		},
+		"missing shard": {
+			targets: []uint32{2},
+			expErr:  errors.New("no shard of pool"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			if !tc.noPoolDir {
+				poolDir := filepath.Join(testDir, poolUUID)
+				if err := os.Mkdir(poolDir, 0755); err != nil {
+					t.Fatal(err)
+				}
+				for _, name := range []string{"vos-0", "vos-1", "rdb-pool"} {
+					if err := os.WriteFile(filepath.Join(poolDir, name), nil, 0644); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			shards, err := poolShards(testDir, poolUUID, 3, tc.targets)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotTgts []uint32
+			for _, shard := range shards {
+				gotTgts = append(gotTgts, shard.target)
+				if filepath.Dir(shard.path) != filepath.Join(testDir, poolUUID) {
+					t.Fatalf("unexpected shard path %q", shard.path)
+				}
+			}
+			if diff := cmp.Diff(tc.expTgts, gotTgts); diff != "" {
+				t.Fatalf("unexpected targets (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_PoolDebug(t *testing.T) {
+	poolUUID := test.MockUUID()
+
+	for name, tc := range map[string]struct {
+		req       *ctlpb.PoolDebugReq
+		running   bool
+		debugging bool
+		exitCode  int
+		expResps  []*ctlpb.PoolDebugResp
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"bad pool UUID": {
+			req:    &ctlpb.PoolDebugReq{PoolUuid: "bad", Command: "ls"},
+			expErr: errors.New("invalid pool UUID"),
+		},
+		"no command": {
+			req:    &ctlpb.PoolDebugReq{PoolUuid: poolUUID},
+			expErr: errors.New("no debugger command"),
+		},
+		"unknown rank": {
+			req:    &ctlpb.PoolDebugReq{PoolUuid: poolUUID, Rank: 5, Command: "ls"},
+			expErr: errors.New("rank 5 not found"),
+		},
+		"engine running": {
+			req:     &ctlpb.PoolDebugReq{PoolUuid: poolUUID, Rank: 1, Command: "ls"},
+			running: true,
+			expErr:  errors.New("engine is running"),
+		},
+		"already debugging": {
+			req:       &ctlpb.PoolDebugReq{PoolUuid: poolUUID, Rank: 1, Command: "ls"},
+			debugging: true,
+			expErr:    errors.New("already being debugged"),
+		},
+		"success": {
+			req: &ctlpb.PoolDebugReq{
+				PoolUuid: poolUUID,
+				Rank:     1,
+				Targets:  []uint32{1},
+				Command:  "ls",
+			},
+			expResps: []*ctlpb.PoolDebugResp{
+				{
+					Target: 1,
+					Lines:  []string{"-R ls vos-1", "done"},
+					Done:   true,
+				},
+			},
+		},
+		"write mode with failure": {
+			req: &ctlpb.PoolDebugReq{
+				PoolUuid:  poolUUID,
+				Rank:      1,
+				Command:   "rm /[0]",
+				WriteMode: true,
+			},
+			exitCode: 3,
+			expResps: []*ctlpb.PoolDebugResp{
+				{
+					Target:   0,
+					Lines:    []string{"-R rm /[0] -w vos-0", "done"},
+					Done:     true,
+					ExitCode: 3,
+				},
+				{
+					Target:   1,
+					Lines:    []string{"-R rm /[0] -w vos-1", "done"},
+					Done:     true,
+					ExitCode: 3,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			// The mock debugger prints its arguments, with the shard
+			// path reduced to the file name.
+			bin := filepath.Join(testDir, "ddb")
+			script := "#!/bin/sh\n" +
+				"for last; do :; done\n" +
+				"args=\"$*\"\n" +
+				"echo \"${args%$last}$(basename $last)\"\n" +
+				"echo done\n" +
+				"exit " + string(rune('0'+tc.exitCode)) + "\n"
+			if err := os.WriteFile(bin, []byte(script), 0755); err != nil {
+				t.Fatal(err)
+			}
+			oldFind := findPoolDebugBin
+			findPoolDebugBin = func() (string, error) { return bin, nil }
+			defer func() { findPoolDebugBin = oldFind }()
+
+			scmMount := filepath.Join(testDir, "scm")
+			poolDir := filepath.Join(scmMount, poolUUID)
+			if err := os.MkdirAll(poolDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			for _, name := range []string{"vos-0", "vos-1"} {
+				if err := os.WriteFile(filepath.Join(poolDir, name), nil, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cfg := config.DefaultServer().WithEngines(
+				engine.MockConfig().WithTargetCount(2),
+				engine.MockConfig().WithTargetCount(2).WithStorage(
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassRam.String()).
+						WithScmMountPoint(scmMount),
+				),
+			)
+			svc := mockControlService(t, log, cfg, nil, nil, nil)
+			svc.harness.instances = nil
+			for idx := range cfg.Engines {
+				mic := &MockInstanceConfig{
+					GetRankResp: ranklist.Rank(idx),
+					Index:       uint32(idx),
+					TargetCount: 2,
+				}
+				mic.Started.SetTrue()
+				if idx == 1 && !tc.running {
+					mic.Started.SetFalse()
+				}
+				svc.harness.instances = append(svc.harness.instances, NewMockInstance(mic))
+			}
+			if tc.debugging {
+				svc.debugRanks.acquire(1)
+			}
+
+			stream := &mockPoolDebugStream{ctx: context.Background()}
+			gotErr := svc.PoolDebug(tc.req, stream)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			for _, resp := range stream.resps {
+				if filepath.Dir(resp.Path) != poolDir {
+					t.Fatalf("unexpected shard path %q", resp.Path)
+				}
+				resp.Path = ""
+			}
+			if diff := cmp.Diff(tc.expResps, stream.resps, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected responses (-want, +got):\n%s\n", diff)
+			}
+			if svc.debugRanks.held(1) {
+				t.Fatal("rank still held after debugging")
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_StartRanks_Debugging(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := mockControlService(t, log, nil, nil, nil, nil)
+	svc.debugRanks.acquire(0)
+
+	_, err := svc.StartRanks(context.Background(), &ctlpb.RanksReq{Ranks: "0"})
+	test.CmpErr(t, errors.New("being debugged"), err)
+}
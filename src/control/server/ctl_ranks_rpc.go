@@ -16,13 +16,13 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
-	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/system"
+	sysconvert "github.com/daos-stack/daos/src/control/system/convert"
 )
 
 const (
@@ -118,7 +118,7 @@ func (svc *ControlService) PrepShutdownRanks(ctx context.Context, req *ctlpb.Ran
 	}
 
 	resp := &ctlpb.RanksResp{}
-	if err := convert.Types(results, &resp.Results); err != nil {
+	if err := (*sysconvert.RankResults)(&resp.Results).FromNative(results); err != nil {
 		return nil, err
 	}
 
@@ -201,7 +201,7 @@ func (svc *ControlService) StopRanks(ctx context.Context, req *ctlpb.RanksReq) (
 		return nil, err
 	}
 	resp := &ctlpb.RanksResp{}
-	if err := convert.Types(results, &resp.Results); err != nil {
+	if err := (*sysconvert.RankResults)(&resp.Results).FromNative(results); err != nil {
 		return nil, err
 	}
 
@@ -256,7 +256,7 @@ func (svc *ControlService) PingRanks(ctx context.Context, req *ctlpb.RanksReq) (
 	}
 
 	resp := &ctlpb.RanksResp{}
-	if err := convert.Types(results, &resp.Results); err != nil {
+	if err := (*sysconvert.RankResults)(&resp.Results).FromNative(results); err != nil {
 		return nil, err
 	}
 
@@ -320,7 +320,7 @@ func (svc *ControlService) ResetFormatRanks(ctx context.Context, req *ctlpb.Rank
 	}
 
 	resp := &ctlpb.RanksResp{}
-	if err := convert.Types(results, &resp.Results); err != nil {
+	if err := (*sysconvert.RankResults)(&resp.Results).FromNative(results); err != nil {
 		return nil, err
 	}
 
@@ -345,6 +345,12 @@ func (svc *ControlService) StartRanks(ctx context.Context, req *ctlpb.RanksReq)
 	if err != nil {
 		return nil, err
 	}
+	for _, srv := range instances {
+		rank, err := srv.GetRank()
+		if err == nil && svc.debugRanks.held(rank) {
+			return nil, errors.Errorf("rank %d cannot be started while its pool shards are being debugged", rank)
+		}
+	}
 	for _, srv := range instances {
 		if srv.IsStarted() {
 			continue
@@ -365,7 +371,7 @@ func (svc *ControlService) StartRanks(ctx context.Context, req *ctlpb.RanksReq)
 		return nil, err
 	}
 	resp := &ctlpb.RanksResp{}
-	if err := convert.Types(results, &resp.Results); err != nil {
+	if err := (*sysconvert.RankResults)(&resp.Results).FromNative(results); err != nil {
 		return nil, err
 	}
 
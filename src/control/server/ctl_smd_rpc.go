@@ -34,7 +34,53 @@ func queryRank(reqRank uint32, engineRank ranklist.Rank) bool {
 	return rr.Equals(engineRank)
 }
 
+func smdDevicePageKey(rank uint32, devUUID string) string {
+	return rankPageKey(rank) + "/" + devUUID
+}
+
+// paginateSmdDevices restricts the devices in the per-rank responses to the
+// requested page. Devices are paged in order of rank and then UUID.
+func paginateSmdDevices(req *ctlpb.SmdQueryReq, resp *ctlpb.SmdQueryResp) error {
+	var keys []string
+	for _, rResp := range resp.Ranks {
+		for _, dev := range rResp.Devices {
+			keys = append(keys, smdDevicePageKey(rResp.Rank, dev.Details.Uuid))
+		}
+	}
+	sort.Strings(keys)
+
+	start, end, next, err := paginate(len(keys), func(i int) string {
+		return keys[i]
+	}, req.PageSize, req.PageToken)
+	if err != nil {
+		return err
+	}
+	resp.NextPageToken = next
+
+	inPage := make(map[string]struct{})
+	for _, key := range keys[start:end] {
+		inPage[key] = struct{}{}
+	}
+	for _, rResp := range resp.Ranks {
+		var devices []*ctlpb.SmdQueryResp_SmdDeviceWithHealth
+		for _, dev := range rResp.Devices {
+			if _, found := inPage[smdDevicePageKey(rResp.Rank, dev.Details.Uuid)]; found {
+				devices = append(devices, dev)
+			}
+		}
+		rResp.Devices = devices
+	}
+
+	return nil
+}
+
 func (svc *ControlService) querySmdDevices(ctx context.Context, req *ctlpb.SmdQueryReq, resp *ctlpb.SmdQueryResp) error {
+	mask, err := newFieldMask(new(ctlpb.SmdDevice), req.Fields, "uuid")
+	if err != nil {
+		return err
+	}
+
+	var rankEngines []Engine
 	for _, ei := range svc.harness.Instances() {
 		if !ei.IsReady() {
 			svc.log.Debugf("skipping not-ready instance %d", ei.Index())
@@ -60,6 +106,7 @@ func (svc *ControlService) querySmdDevices(ctx context.Context, req *ctlpb.SmdQu
 		if len(listDevsResp.Devices) == 0 {
 			rResp.Devices = nil
 			resp.Ranks = append(resp.Ranks, rResp)
+			rankEngines = append(rankEngines, ei)
 			continue
 		}
 
@@ -106,6 +153,16 @@ func (svc *ControlService) querySmdDevices(ctx context.Context, req *ctlpb.SmdQu
 			}
 		}
 
+		rankEngines = append(rankEngines, ei)
+	}
+
+	if err := paginateSmdDevices(req, resp); err != nil {
+		return err
+	}
+
+	for i, rResp := range resp.Ranks {
+		ei := rankEngines[i]
+
 		for _, dev := range rResp.Devices {
 			state := dev.Details.DevState
 
@@ -126,6 +183,10 @@ func (svc *ControlService) querySmdDevices(ctx context.Context, req *ctlpb.SmdQu
 					dev, state)
 			}
 		}
+
+		for _, dev := range rResp.Devices {
+			mask.apply(dev.Details)
+		}
 	}
 
 	return nil
@@ -220,6 +281,53 @@ func (svc *ControlService) SmdQuery(ctx context.Context, req *ctlpb.SmdQueryReq)
 	return resp, nil
 }
 
+// BlobstoreQuery implements the method defined for the Control Service.
+//
+// Query per-target SPDK blobstore usage from each ready I/O Engine.
+func (svc *ControlService) BlobstoreQuery(ctx context.Context, req *ctlpb.BlobstoreQueryReq) (*ctlpb.BlobstoreQueryResp, error) {
+	if !svc.harness.isStarted() {
+		return nil, FaultHarnessNotStarted
+	}
+	if len(svc.harness.readyRanks()) == 0 {
+		return nil, FaultDataPlaneNotStarted
+	}
+
+	resp := new(ctlpb.BlobstoreQueryResp)
+	for _, ei := range svc.harness.Instances() {
+		if !ei.IsReady() {
+			svc.log.Debugf("skipping not-ready instance %d", ei.Index())
+			continue
+		}
+
+		engineRank, err := ei.GetRank()
+		if err != nil {
+			return nil, err
+		}
+
+		dresp, err := ei.CallDrpc(ctx, drpc.MethodBlobstoreUsage, new(ctlpb.BlobstoreUsageReq))
+		if err != nil {
+			return nil, errors.Wrapf(err, "rank %d", engineRank)
+		}
+
+		rankResp := new(ctlpb.BlobstoreUsageResp)
+		if err = proto.Unmarshal(dresp.Body, rankResp); err != nil {
+			return nil, errors.Wrap(err, "unmarshal BlobstoreUsage response")
+		}
+
+		if rankResp.Status != 0 {
+			return nil, errors.Wrapf(daos.Status(rankResp.Status),
+				"rank %d BlobstoreUsage failed", engineRank)
+		}
+
+		resp.Ranks = append(resp.Ranks, &ctlpb.BlobstoreQueryResp_RankResp{
+			Rank:       engineRank.Uint32(),
+			Blobstores: rankResp.Blobstores,
+		})
+	}
+
+	return resp, nil
+}
+
 type idMap map[string]bool
 
 func (im idMap) Keys() (keys []string) {
@@ -296,6 +296,101 @@ func TestServer_CtlSvc_SmdQuery(t *testing.T) {
 				},
 			},
 		},
+		"list-devices; paged with field mask": {
+			req: &ctlpb.SmdQueryReq{
+				OmitPools: true,
+				Rank:      uint32(ranklist.NilRank),
+				PageSize:  3,
+				Fields:    []string{"tgt_ids"},
+			},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{
+						Message: &ctlpb.SmdDevResp{
+							Devices: []*ctlpb.SmdDevice{
+								{
+									Uuid:     test.MockUUID(0),
+									TrAddr:   "0000:8a:00.0",
+									TgtIds:   []int32{0, 1, 2},
+									DevState: devStateNormal,
+									LedState: ledStateNormal,
+								},
+								{
+									Uuid:     test.MockUUID(1),
+									TrAddr:   "0000:80:00.0",
+									TgtIds:   []int32{3, 4, 5},
+									DevState: devStateFaulty,
+									LedState: ledStateFault,
+								},
+							},
+						},
+					},
+				},
+				1: {
+					{
+						Message: &ctlpb.SmdDevResp{
+							Devices: []*ctlpb.SmdDevice{
+								{
+									Uuid:     test.MockUUID(3),
+									TrAddr:   "0000:db:00.0",
+									TgtIds:   []int32{3, 4, 5},
+									DevState: devStateNormal,
+									LedState: ledStateIdentify,
+								},
+								{
+									Uuid:     test.MockUUID(2),
+									TrAddr:   "0000:da:00.0",
+									TgtIds:   []int32{0, 1, 2},
+									DevState: devStateFaulty,
+									LedState: ledStateUnknown,
+								},
+							},
+						},
+					},
+				},
+			},
+			expResp: &ctlpb.SmdQueryResp{
+				Ranks: []*ctlpb.SmdQueryResp_RankResp{
+					{
+						Devices: []*ctlpb.SmdQueryResp_SmdDeviceWithHealth{
+							{
+								Details: &ctlpb.SmdDevice{
+									Uuid:   test.MockUUID(0),
+									TgtIds: []int32{0, 1, 2},
+								},
+							},
+							{
+								Details: &ctlpb.SmdDevice{
+									Uuid:   test.MockUUID(1),
+									TgtIds: []int32{3, 4, 5},
+								},
+							},
+						},
+						Rank: uint32(0),
+					},
+					{
+						Devices: []*ctlpb.SmdQueryResp_SmdDeviceWithHealth{
+							{
+								Details: &ctlpb.SmdDevice{
+									Uuid:   test.MockUUID(2),
+									TgtIds: []int32{0, 1, 2},
+								},
+							},
+						},
+						Rank: uint32(1),
+					},
+				},
+				NextPageToken: encodePageToken(smdDevicePageKey(1, test.MockUUID(2))),
+			},
+		},
+		"list-devices; unknown field in mask": {
+			req: &ctlpb.SmdQueryReq{
+				OmitPools: true,
+				Rank:      uint32(ranklist.NilRank),
+				Fields:    []string{"bogus"},
+			},
+			expErr: errors.New("unknown field"),
+		},
 		"list-devices; missing state": {
 			req: &ctlpb.SmdQueryReq{
 				OmitPools: true,
@@ -779,6 +874,148 @@ func TestServer_CtlSvc_SmdQuery(t *testing.T) {
 	}
 }
 
+func TestServer_CtlSvc_BlobstoreQuery(t *testing.T) {
+	mockUsage := func(idx int32) *ctlpb.BlobstoreUsage {
+		return &ctlpb.BlobstoreUsage{
+			DevUuid:       test.MockUUID(idx),
+			ClusterSize:   1 << 30,
+			TotalClusters: 1024,
+			FreeClusters:  512,
+			Targets: []*ctlpb.BlobstoreUsage_Target{
+				{TgtId: 0, UsedClusters: 256, Blobs: 2},
+				{TgtId: 1, UsedClusters: 256, Blobs: 2},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		junkResp       bool
+		drpcResps      map[int][]*mockDrpcResponse
+		harnessStopped bool
+		ioStopped      bool
+		expResp        *ctlpb.BlobstoreQueryResp
+		expErr         error
+	}{
+		"harness not started": {
+			harnessStopped: true,
+			expErr:         FaultHarnessNotStarted,
+		},
+		"i/o engine not started": {
+			ioStopped: true,
+			expErr:    FaultDataPlaneNotStarted,
+		},
+		"dRPC send fails": {
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					&mockDrpcResponse{
+						Message: &ctlpb.BlobstoreUsageResp{},
+						Error:   errors.New("send failure"),
+					},
+				},
+			},
+			expErr: errors.New("send failure"),
+		},
+		"dRPC resp fails": {
+			junkResp: true,
+			expErr:   errors.New("unmarshal"),
+		},
+		"dRPC resp bad status": {
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					&mockDrpcResponse{
+						Message: &ctlpb.BlobstoreUsageResp{
+							Status: int32(daos.Nonexistent),
+						},
+					},
+				},
+			},
+			expErr: daos.Nonexistent,
+		},
+		"two ranks": {
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					&mockDrpcResponse{
+						Message: &ctlpb.BlobstoreUsageResp{
+							Blobstores: []*ctlpb.BlobstoreUsage{mockUsage(0)},
+						},
+					},
+				},
+				1: {
+					&mockDrpcResponse{
+						Message: &ctlpb.BlobstoreUsageResp{
+							Blobstores: []*ctlpb.BlobstoreUsage{
+								mockUsage(1), mockUsage(2),
+							},
+						},
+					},
+				},
+			},
+			expResp: &ctlpb.BlobstoreQueryResp{
+				Ranks: []*ctlpb.BlobstoreQueryResp_RankResp{
+					{
+						Blobstores: []*ctlpb.BlobstoreUsage{mockUsage(0)},
+					},
+					{
+						Rank: 1,
+						Blobstores: []*ctlpb.BlobstoreUsage{
+							mockUsage(1), mockUsage(2),
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			engineCount := len(tc.drpcResps)
+			if engineCount == 0 {
+				engineCount = 1
+			}
+
+			cfg := config.DefaultServer()
+			for i := 0; i < engineCount; i++ {
+				cfg.Engines = append(cfg.Engines, engine.MockConfig().WithTargetCount(1))
+			}
+			svc := mockControlService(t, log, cfg, nil, nil, nil)
+			svc.harness.started.SetTrue()
+
+			for i, e := range svc.harness.instances {
+				srv := e.(*EngineInstance)
+				cfg := new(mockDrpcClientConfig)
+				if tc.junkResp {
+					cfg.setSendMsgResponse(drpc.Status_SUCCESS, makeBadBytes(42), nil)
+				} else if len(tc.drpcResps) > i {
+					for _, mock := range tc.drpcResps[i] {
+						cfg.setSendMsgResponseList(t, mock)
+					}
+				}
+				srv.setDrpcClient(newMockDrpcClient(cfg))
+				srv.ready.SetTrue()
+			}
+			if tc.harnessStopped {
+				svc.harness.started.SetFalse()
+			}
+			if tc.ioStopped {
+				for _, srv := range svc.harness.instances {
+					srv.(*EngineInstance).ready.SetFalse()
+				}
+			}
+
+			gotResp, gotErr := svc.BlobstoreQuery(context.TODO(), new(ctlpb.BlobstoreQueryReq))
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_CtlSvc_SmdManage(t *testing.T) {
 	pbNormDev := &ctlpb.SmdDevice{
 		TrAddr:   test.MockPCIAddr(1),
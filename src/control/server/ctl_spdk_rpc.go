@@ -0,0 +1,140 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+const (
+	// defaultSpdkRpcSockAddr is the socket used by the engine's SPDK JSON-RPC
+	// server when none is configured (SPDK_DEFAULT_RPC_ADDR).
+	defaultSpdkRpcSockAddr = "/var/tmp/spdk.sock"
+	// spdkRpcTimeout bounds a forwarded call if the request has no deadline.
+	spdkRpcTimeout = 30 * time.Second
+	spdkRpcID      = 1
+)
+
+type (
+	spdkRpcRequest struct {
+		Version string          `json:"jsonrpc"`
+		ID      int             `json:"id"`
+		Method  string          `json:"method"`
+		Params  json.RawMessage `json:"params,omitempty"`
+	}
+
+	spdkRpcError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	spdkRpcResponse struct {
+		ID     int             `json:"id"`
+		Result json.RawMessage `json:"result"`
+		Error  *spdkRpcError   `json:"error"`
+	}
+)
+
+func (e *spdkRpcError) Error() string {
+	return fmt.Sprintf("SPDK JSON-RPC error %d: %s", e.Code, e.Message)
+}
+
+// callSpdkRpc sends a single JSON-RPC request to the SPDK RPC server listening
+// on the given unix socket and returns the raw result.
+func callSpdkRpc(ctx context.Context, sockAddr, method string, params json.RawMessage) (json.RawMessage, error) {
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, spdkRpcTimeout)
+		defer cancel()
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", sockAddr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "connecting to SPDK RPC server at %s", sockAddr)
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	req := &spdkRpcRequest{
+		Version: "2.0",
+		ID:      spdkRpcID,
+		Method:  method,
+		Params:  params,
+	}
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return nil, errors.Wrap(err, "sending SPDK RPC request")
+	}
+
+	resp := new(spdkRpcResponse)
+	if err := json.NewDecoder(conn).Decode(resp); err != nil {
+		return nil, errors.Wrap(err, "reading SPDK RPC response")
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	return resp.Result, nil
+}
+
+// StorageSpdkRpc forwards a JSON-RPC request to the SPDK RPC server of the
+// selected engine and returns the raw result.
+//
+// Intended for debugging only; the SPDK RPC server has to be enabled in the
+// engine storage config and access is restricted to the debug component.
+func (c *ControlService) StorageSpdkRpc(ctx context.Context, req *ctlpb.SpdkRpcReq) (*ctlpb.SpdkRpcResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if req.GetMethod() == "" {
+		return nil, errors.New("no SPDK RPC method specified")
+	}
+
+	var params json.RawMessage
+	if req.GetParams() != "" {
+		if !json.Valid([]byte(req.GetParams())) {
+			return nil, errors.New("SPDK RPC params are not valid JSON")
+		}
+		params = json.RawMessage(req.GetParams())
+	}
+
+	engines := c.harness.Instances()
+	engineIndex := req.GetEngineIndex()
+	if len(engines) <= int(engineIndex) {
+		return nil, errors.Errorf("engine with index %d not found", engineIndex)
+	}
+
+	srvCfg := engines[engineIndex].GetStorage().GetSpdkRpcServer()
+	if !srvCfg.Enable {
+		return nil, errors.Errorf("SPDK RPC server not enabled for engine %d", engineIndex)
+	}
+	sockAddr := srvCfg.SockAddr
+	if sockAddr == "" {
+		sockAddr = defaultSpdkRpcSockAddr
+	}
+
+	c.log.Noticef("forwarding SPDK RPC %q to engine %d", req.GetMethod(), engineIndex)
+
+	result, err := callSpdkRpc(ctx, sockAddr, req.GetMethod(), params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "engine %d", engineIndex)
+	}
+
+	return &ctlpb.SpdkRpcResp{Result: string(result)}, nil
+}
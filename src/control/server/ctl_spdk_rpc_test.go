@@ -0,0 +1,129 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+// mockSpdkRpcServer answers a single JSON-RPC request on the given socket.
+func mockSpdkRpcServer(t *testing.T, sockAddr string, handle func(*spdkRpcRequest) *spdkRpcResponse) {
+	t.Helper()
+
+	lis, err := net.Listen("unix", sockAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		req := new(spdkRpcRequest)
+		if err := json.NewDecoder(conn).Decode(req); err != nil {
+			return
+		}
+		json.NewEncoder(conn).Encode(handle(req))
+	}()
+}
+
+func TestServer_CtlSvc_StorageSpdkRpc(t *testing.T) {
+	echoParams := func(req *spdkRpcRequest) *spdkRpcResponse {
+		if req.Method != "bdev_get_bdevs" {
+			return &spdkRpcResponse{
+				ID:    req.ID,
+				Error: &spdkRpcError{Code: -32601, Message: "Method not found"},
+			}
+		}
+		return &spdkRpcResponse{ID: req.ID, Result: req.Params}
+	}
+
+	for name, tc := range map[string]struct {
+		req       *ctlpb.SpdkRpcReq
+		disabled  bool
+		noServer  bool
+		expResult string
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"missing method": {
+			req:    &ctlpb.SpdkRpcReq{},
+			expErr: errors.New("no SPDK RPC method"),
+		},
+		"invalid params": {
+			req:    &ctlpb.SpdkRpcReq{Method: "bdev_get_bdevs", Params: "{name:"},
+			expErr: errors.New("not valid JSON"),
+		},
+		"missing engine": {
+			req:    &ctlpb.SpdkRpcReq{Method: "bdev_get_bdevs", EngineIndex: 1},
+			expErr: errors.New("engine with index 1 not found"),
+		},
+		"server not enabled": {
+			req:      &ctlpb.SpdkRpcReq{Method: "bdev_get_bdevs"},
+			disabled: true,
+			expErr:   errors.New("not enabled for engine 0"),
+		},
+		"server not listening": {
+			req:      &ctlpb.SpdkRpcReq{Method: "bdev_get_bdevs"},
+			noServer: true,
+			expErr:   errors.New("connecting to SPDK RPC server"),
+		},
+		"rpc error": {
+			req:    &ctlpb.SpdkRpcReq{Method: "framework_bogus"},
+			expErr: errors.New("engine 0: SPDK JSON-RPC error -32601: Method not found"),
+		},
+		"success": {
+			req: &ctlpb.SpdkRpcReq{
+				Method: "bdev_get_bdevs",
+				Params: `{"name":"Nvme0n1"}`,
+			},
+			expResult: `{"name":"Nvme0n1"}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+			sockAddr := filepath.Join(testDir, "spdk.sock")
+
+			ec := engine.MockConfig().WithStorageSpdkRpcSrvProps(!tc.disabled, sockAddr)
+			cs := mockControlService(t, log, config.DefaultServer().WithEngines(ec), nil, nil, nil)
+
+			if !tc.noServer {
+				mockSpdkRpcServer(t, sockAddr, echoParams)
+			}
+
+			resp, err := cs.StorageSpdkRpc(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expResult, resp.Result, "unexpected result")
+		})
+	}
+}
@@ -214,6 +214,7 @@ func (c *ControlService) adjustNvmeSize(resp *ctlpb.ScanNvmeResp) {
 					dev.GetUuid(), rank, dev.GetTgtIds(),
 					humanize.Bytes(dev.GetAvailBytes()), dev.GetAvailBytes(),
 					humanize.Bytes(availBytes), availBytes)
+				dev.ReservedBytes = dev.GetAvailBytes() - availBytes
 				dev.AvailBytes = availBytes
 			}
 		}
@@ -278,6 +279,7 @@ func (c *ControlService) adjustScmSize(resp *ctlpb.ScanScmResp) {
 			c.log.Debugf("Adjusting available size of SCM device %q: "+
 				"excluding %s (%d Bytes) of storage reserved for DAOS metadata",
 				scmNamespace.Mount.GetPath(), humanize.Bytes(mdBytes), mdBytes)
+			scmNamespace.Mount.ReservedBytes = mdBytes
 			scmNamespace.Mount.AvailBytes -= mdBytes
 		} else {
 			c.log.Noticef("Adjusting available size to 0 Bytes of SCM device %q: "+
@@ -285,11 +287,62 @@ func (c *ControlService) adjustScmSize(resp *ctlpb.ScanScmResp) {
 				scmNamespace.Mount.GetPath(),
 				humanize.Bytes(availBytes), availBytes,
 				humanize.Bytes(mdBytes), mdBytes)
+			scmNamespace.Mount.ReservedBytes = availBytes
 			scmNamespace.Mount.AvailBytes = 0
 		}
 	}
 }
 
+// countPoolShards sets the number of VOS pool shards held on the SCM mount and
+// NVMe SMD devices of each running engine. Counts are left unset for engines
+// whose pools can't be listed.
+func (c *ControlService) countPoolShards(ctx context.Context, respNvme *ctlpb.ScanNvmeResp, respScm *ctlpb.ScanScmResp) {
+	for _, ei := range c.harness.Instances() {
+		if !ei.IsReady() {
+			continue
+		}
+
+		rank, err := ei.GetRank()
+		if err != nil {
+			c.log.Errorf("instance %d: skipping pool shard count: %s", ei.Index(), err)
+			continue
+		}
+
+		pools, err := ei.ListSmdPools(ctx, new(ctlpb.SmdPoolReq))
+		if err != nil {
+			c.log.Errorf("rank %d: skipping pool shard count: %s", rank, err)
+			continue
+		}
+
+		var total uint32
+		tgtShards := make(map[int32]uint32)
+		for _, pool := range pools.GetPools() {
+			for _, tgtID := range pool.GetTgtIds() {
+				tgtShards[tgtID]++
+				total++
+			}
+		}
+
+		for _, ns := range respScm.GetNamespaces() {
+			if ns.GetMount() != nil && ns.GetMount().GetRank() == rank.Uint32() {
+				ns.Mount.PoolShards = total
+			}
+		}
+
+		for _, ctrlr := range respNvme.GetCtrlrs() {
+			for _, dev := range ctrlr.GetSmdDevices() {
+				if dev.GetRank() != rank.Uint32() {
+					continue
+				}
+				dev.PoolShards = 0
+				for _, tgtID := range dev.GetTgtIds() {
+					dev.PoolShards += tgtShards[tgtID]
+				}
+			}
+		}
+	}
+}
+
 // StorageScan discovers non-volatile storage hardware on node.
 func (c *ControlService) StorageScan(ctx context.Context, req *ctlpb.StorageScanReq) (*ctlpb.StorageScanResp, error) {
 	if req == nil {
@@ -312,6 +365,7 @@ func (c *ControlService) StorageScan(ctx context.Context, req *ctlpb.StorageScan
 	}
 	if req.Scm.GetUsage() {
 		c.adjustScmSize(respScm)
+		c.countPoolShards(ctx, respNvme, respScm)
 	}
 	resp.Scm = respScm
 
@@ -384,7 +438,11 @@ func (c *ControlService) StorageFormat(ctx context.Context, req *ctlpb.StorageFo
 			continue
 		}
 
-		if err := ei.GetStorage().WriteNvmeConfig(ctx, c.log); err != nil {
+		err := injectFormatFailure(formatStepConfig)
+		if err == nil {
+			err = ei.GetStorage().WriteNvmeConfig(ctx, c.log)
+		}
+		if err != nil {
 			instanceErrored[ei.Index()] = err.Error()
 			cResults = append(cResults, ei.newCret("", err))
 		}
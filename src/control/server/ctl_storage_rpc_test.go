@@ -62,19 +62,25 @@ func adjustNvmeSize(smdDevices []*ctl.SmdDevice) {
 	}
 
 	for _, dev := range smdDevices {
-		dev.AvailBytes = clusterCount * targetNb * clusterSize
+		availBytes := clusterCount * targetNb * clusterSize
+		dev.ReservedBytes = dev.AvailBytes - availBytes
+		dev.AvailBytes = availBytes
 	}
 }
 
 func adjustScmSize(availBytes uint64) uint64 {
+	return availBytes - reservedScmSize(availBytes)
+}
+
+func reservedScmSize(availBytes uint64) uint64 {
 	const mdCapSize uint64 = uint64(128) * humanize.MiByte
 	const mdBytes uint64 = mdCapSize + mdDaosScmBytes
 
 	if availBytes < mdBytes {
-		return 0
+		return availBytes
 	}
 
-	return availBytes - mdBytes
+	return mdBytes
 }
 
 func TestServer_CtlSvc_StorageScan_PreEngineStart(t *testing.T) {
@@ -478,6 +484,11 @@ func TestServer_CtlSvc_StorageScan_PostEngineStart(t *testing.T) {
 		adjustNvmeSize(c.GetSmdDevices())
 		return c
 	}
+	newCtrlrPBwPoolShards := func(idx int32, poolShards uint32) *ctlpb.NvmeController {
+		c := newCtrlrPBwMeta(idx)
+		c.SmdDevices[0].PoolShards = poolShards
+		return c
+	}
 	newSmdDevResp := func(idx int32, smdIndexes ...int32) *ctlpb.SmdDevResp {
 		_, s := newCtrlrMeta(idx, smdIndexes...)
 		return s
@@ -491,12 +502,14 @@ func TestServer_CtlSvc_StorageScan_PostEngineStart(t *testing.T) {
 	ctrlrPBwMetaNew.SmdDevices[0].TotalBytes = 0
 	ctrlrPBwMetaNew.SmdDevices[0].DevState = devStateNew
 	ctrlrPBwMetaNew.SmdDevices[0].ClusterSize = 0
+	ctrlrPBwMetaNew.SmdDevices[0].ReservedBytes = 0
 
 	ctrlrPBwMetaNormal := newCtrlrPBwMeta(1)
 	ctrlrPBwMetaNormal.SmdDevices[0].AvailBytes = 0
 	ctrlrPBwMetaNormal.SmdDevices[0].TotalBytes = 0
 	ctrlrPBwMetaNormal.SmdDevices[0].DevState = devStateNormal
 	ctrlrPBwMetaNormal.SmdDevices[0].ClusterSize = 0
+	ctrlrPBwMetaNormal.SmdDevices[0].ReservedBytes = 0
 
 	mockPbScmMount0 := proto.MockScmMountPoint(0)
 	mockPbScmMount0.Rank += 1
@@ -814,12 +827,13 @@ func TestServer_CtlSvc_StorageScan_PostEngineStart(t *testing.T) {
 							Size:     mockPbScmNamespace0.Size,
 							Uuid:     mockPbScmNamespace0.Uuid,
 							Mount: &ctlpb.ScmNamespace_Mount{
-								Class:      mockPbScmMount0.Class,
-								DeviceList: mockPbScmMount0.DeviceList,
-								Path:       mockPbScmMount0.Path,
-								Rank:       mockPbScmMount0.Rank,
-								TotalBytes: mockPbScmMount0.TotalBytes,
-								AvailBytes: adjustScmSize(mockPbScmMount0.AvailBytes),
+								Class:         mockPbScmMount0.Class,
+								DeviceList:    mockPbScmMount0.DeviceList,
+								Path:          mockPbScmMount0.Path,
+								Rank:          mockPbScmMount0.Rank,
+								TotalBytes:    mockPbScmMount0.TotalBytes,
+								AvailBytes:    adjustScmSize(mockPbScmMount0.AvailBytes),
+								ReservedBytes: reservedScmSize(mockPbScmMount0.AvailBytes),
 							},
 						},
 					},
@@ -905,11 +919,12 @@ func TestServer_CtlSvc_StorageScan_PostEngineStart(t *testing.T) {
 							Blockdev: "ramdisk",
 							Size:     uint64(humanize.GiByte * 16),
 							Mount: &ctlpb.ScmNamespace_Mount{
-								Class:      "ram",
-								Path:       mockPbScmMount0.Path,
-								TotalBytes: mockPbScmMount0.TotalBytes,
-								AvailBytes: adjustScmSize(mockPbScmMount0.AvailBytes),
-								Rank:       mockPbScmMount0.Rank,
+								Class:         "ram",
+								Path:          mockPbScmMount0.Path,
+								TotalBytes:    mockPbScmMount0.TotalBytes,
+								AvailBytes:    adjustScmSize(mockPbScmMount0.AvailBytes),
+								ReservedBytes: reservedScmSize(mockPbScmMount0.AvailBytes),
+								Rank:          mockPbScmMount0.Rank,
 							},
 						},
 					},
@@ -991,12 +1006,130 @@ func TestServer_CtlSvc_StorageScan_PostEngineStart(t *testing.T) {
 							Size:     mockPbScmNamespace0.Size,
 							Uuid:     mockPbScmNamespace0.Uuid,
 							Mount: &ctlpb.ScmNamespace_Mount{
-								Class:      mockPbScmMount0.Class,
-								DeviceList: mockPbScmMount0.DeviceList,
-								Path:       mockPbScmMount0.Path,
-								TotalBytes: mockPbScmMount0.TotalBytes,
-								AvailBytes: adjustScmSize(mockPbScmMount0.AvailBytes),
-								Rank:       mockPbScmMount0.Rank,
+								Class:         mockPbScmMount0.Class,
+								DeviceList:    mockPbScmMount0.DeviceList,
+								Path:          mockPbScmMount0.Path,
+								TotalBytes:    mockPbScmMount0.TotalBytes,
+								AvailBytes:    adjustScmSize(mockPbScmMount0.AvailBytes),
+								ReservedBytes: reservedScmSize(mockPbScmMount0.AvailBytes),
+								Rank:          mockPbScmMount0.Rank,
+							},
+						},
+						&ctlpb.ScmNamespace{
+							Blockdev: mockPbScmNamespace1.Blockdev,
+							Dev:      mockPbScmNamespace1.Dev,
+							Size:     mockPbScmNamespace1.Size,
+							Uuid:     mockPbScmNamespace1.Uuid,
+							NumaNode: mockPbScmNamespace1.NumaNode,
+							Mount: &ctlpb.ScmNamespace_Mount{
+								Class:         mockPbScmMount1.Class,
+								DeviceList:    mockPbScmMount1.DeviceList,
+								Path:          mockPbScmMount1.Path,
+								TotalBytes:    mockPbScmMount1.TotalBytes,
+								AvailBytes:    adjustScmSize(mockPbScmMount1.AvailBytes),
+								ReservedBytes: reservedScmSize(mockPbScmMount1.AvailBytes),
+								Rank:          mockPbScmMount1.Rank,
+							},
+						},
+					},
+					State: new(ctlpb.ResponseState),
+				},
+			},
+		},
+		"multi-engine; multi-tier; with usage; pool shards": {
+			req: &ctlpb.StorageScanReq{
+				Scm:  &ctlpb.ScanScmReq{Usage: true},
+				Nvme: &ctlpb.ScanNvmeReq{Meta: true},
+			},
+			csCtrlrs: &storage.NvmeControllers{newCtrlr(1), newCtrlr(2)},
+			eCtrlrs:  []*storage.NvmeControllers{{newCtrlr(1)}, {newCtrlr(2)}},
+			smbc: &scm.MockBackendConfig{
+				GetModulesRes: storage.ScmModules{
+					storage.MockScmModule(0),
+				},
+				GetNamespacesRes: storage.ScmNamespaces{
+					storage.MockScmNamespace(0),
+					storage.MockScmNamespace(1),
+				},
+			},
+			smsc: &system.MockSysConfig{
+				GetfsUsageResps: []system.GetfsUsageRetval{
+					{
+						Total: mockPbScmMount0.TotalBytes,
+						Avail: mockPbScmMount0.AvailBytes,
+					},
+					{
+						Total: mockPbScmMount1.TotalBytes,
+						Avail: mockPbScmMount1.AvailBytes,
+					},
+				},
+			},
+			storageCfgs: []storage.TierConfigs{
+				{
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassDcpm.String()).
+						WithScmMountPoint(mockPbScmMount0.Path).
+						WithScmDeviceList(mockPbScmNamespace0.Blockdev),
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassNvme.String()).
+						WithBdevDeviceList(newCtrlr(1).PciAddr),
+				},
+				{
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassDcpm.String()).
+						WithScmMountPoint(mockPbScmMount1.Path).
+						WithScmDeviceList(mockPbScmNamespace1.Blockdev),
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassNvme.String()).
+						WithBdevDeviceList(newCtrlr(2).PciAddr),
+				},
+			},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{Message: newSmdDevResp(1)},
+					{Message: newBioHealthResp(1)},
+					{Message: &ctlpb.SmdPoolResp{
+						Pools: []*ctlpb.SmdPoolResp_Pool{
+							{Uuid: test.MockUUID(1), TgtIds: []int32{9, 10, 11, 12}},
+							{Uuid: test.MockUUID(2), TgtIds: []int32{9, 10}},
+							{Uuid: test.MockUUID(3), TgtIds: []int32{0}},
+						},
+					}},
+				},
+				1: {
+					{Message: newSmdDevResp(2)},
+					{Message: newBioHealthResp(2)},
+					{Message: &ctlpb.SmdPoolResp{
+						Pools: []*ctlpb.SmdPoolResp_Pool{
+							{Uuid: test.MockUUID(1), TgtIds: []int32{13, 14, 15, 16}},
+						},
+					}},
+				},
+			},
+			expResp: &ctlpb.StorageScanResp{
+				Nvme: &ctlpb.ScanNvmeResp{
+					Ctrlrs: proto.NvmeControllers{
+						newCtrlrPBwPoolShards(1, 6),
+						newCtrlrPBwPoolShards(2, 4),
+					},
+					State: new(ctlpb.ResponseState),
+				},
+				Scm: &ctlpb.ScanScmResp{
+					Namespaces: proto.ScmNamespaces{
+						&ctlpb.ScmNamespace{
+							Blockdev: mockPbScmNamespace0.Blockdev,
+							Dev:      mockPbScmNamespace0.Dev,
+							Size:     mockPbScmNamespace0.Size,
+							Uuid:     mockPbScmNamespace0.Uuid,
+							Mount: &ctlpb.ScmNamespace_Mount{
+								Class:         mockPbScmMount0.Class,
+								DeviceList:    mockPbScmMount0.DeviceList,
+								Path:          mockPbScmMount0.Path,
+								TotalBytes:    mockPbScmMount0.TotalBytes,
+								AvailBytes:    adjustScmSize(mockPbScmMount0.AvailBytes),
+								ReservedBytes: reservedScmSize(mockPbScmMount0.AvailBytes),
+								PoolShards:    7,
+								Rank:          mockPbScmMount0.Rank,
 							},
 						},
 						&ctlpb.ScmNamespace{
@@ -1006,12 +1139,14 @@ func TestServer_CtlSvc_StorageScan_PostEngineStart(t *testing.T) {
 							Uuid:     mockPbScmNamespace1.Uuid,
 							NumaNode: mockPbScmNamespace1.NumaNode,
 							Mount: &ctlpb.ScmNamespace_Mount{
-								Class:      mockPbScmMount1.Class,
-								DeviceList: mockPbScmMount1.DeviceList,
-								Path:       mockPbScmMount1.Path,
-								TotalBytes: mockPbScmMount1.TotalBytes,
-								AvailBytes: adjustScmSize(mockPbScmMount1.AvailBytes),
-								Rank:       mockPbScmMount1.Rank,
+								Class:         mockPbScmMount1.Class,
+								DeviceList:    mockPbScmMount1.DeviceList,
+								Path:          mockPbScmMount1.Path,
+								TotalBytes:    mockPbScmMount1.TotalBytes,
+								AvailBytes:    adjustScmSize(mockPbScmMount1.AvailBytes),
+								ReservedBytes: reservedScmSize(mockPbScmMount1.AvailBytes),
+								PoolShards:    4,
+								Rank:          mockPbScmMount1.Rank,
 							},
 						},
 					},
@@ -0,0 +1,104 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+const (
+	// supportExecTimeout bounds the time that a support command may run.
+	supportExecTimeout = time.Minute
+	// supportExecMaxOutput is the maximum amount of output returned for a
+	// support command, which must fit within a single gRPC message.
+	supportExecMaxOutput = 1 << 20
+)
+
+// supportCommands are the only command lines that may be run by SupportExec.
+var supportCommands = control.SupportCommands
+
+// tailBuffer is an io.Writer that keeps only the last max bytes written to it.
+type tailBuffer struct {
+	max       int
+	data      []byte
+	truncated bool
+}
+
+func (tb *tailBuffer) Write(p []byte) (int, error) {
+	tb.data = append(tb.data, p...)
+	if over := len(tb.data) - tb.max; over > 0 {
+		tb.data = append(tb.data[:0], tb.data[over:]...)
+		tb.truncated = true
+	}
+
+	return len(p), nil
+}
+
+func supportCommandArgs(cmdLine string) ([]string, error) {
+	for _, permitted := range supportCommands {
+		if cmdLine == permitted {
+			return strings.Fields(permitted), nil
+		}
+	}
+
+	return nil, errors.Errorf("command %q is not permitted (permitted commands: %s)",
+		cmdLine, strings.Join(supportCommands, ", "))
+}
+
+// SupportExec implements the method defined for the control service.
+//
+// It runs one of a fixed set of commands on the host and returns its output,
+// allowing information about the host to be gathered for support purposes
+// without shell access.
+func (svc *ControlService) SupportExec(parent context.Context, req *ctlpb.SupportExecReq) (*ctlpb.SupportExecResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	args, err := supportCommandArgs(req.Command)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.log.Noticef("running support command %q", req.Command)
+
+	ctx, cancel := context.WithTimeout(parent, supportExecTimeout)
+	defer cancel()
+
+	out := &tailBuffer{max: supportExecMaxOutput}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	resp := &ctlpb.SupportExecResp{Command: req.Command}
+
+	err = cmd.Run()
+	if ctx.Err() != nil {
+		return nil, errors.Wrapf(ctx.Err(), "running %q", req.Command)
+	}
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+	case errors.As(err, &exitErr):
+		resp.ExitCode = int32(exitErr.ExitCode())
+	default:
+		return nil, errors.Wrapf(err, "running %q", req.Command)
+	}
+
+	resp.Output = out.data
+	resp.Truncated = out.truncated
+
+	return resp, nil
+}
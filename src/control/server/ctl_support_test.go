@@ -0,0 +1,98 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestServer_tailBuffer(t *testing.T) {
+	tb := &tailBuffer{max: 8}
+
+	for _, s := range []string{"abc", "defg"} {
+		if _, err := tb.Write([]byte(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	test.AssertEqual(t, "abcdefg", string(tb.data), "unexpected contents")
+	test.AssertFalse(t, tb.truncated, "buffer should not be truncated")
+
+	if _, err := tb.Write([]byte("hijkl")); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "efghijkl", string(tb.data), "unexpected contents")
+	test.AssertTrue(t, tb.truncated, "buffer should be truncated")
+}
+
+func TestServer_CtlSvc_SupportExec(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *ctlpb.SupportExecReq
+		expResp *ctlpb.SupportExecResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"command not permitted": {
+			req:    &ctlpb.SupportExecReq{Command: "rm -rf /"},
+			expErr: errors.New("not permitted"),
+		},
+		"arguments must match": {
+			req:    &ctlpb.SupportExecReq{Command: "echo goodbye"},
+			expErr: errors.New("not permitted"),
+		},
+		"missing binary": {
+			req:    &ctlpb.SupportExecReq{Command: "daos_no_such_command"},
+			expErr: errors.New("executable file not found"),
+		},
+		"command fails": {
+			req: &ctlpb.SupportExecReq{Command: "false"},
+			expResp: &ctlpb.SupportExecResp{
+				Command:  "false",
+				ExitCode: 1,
+			},
+		},
+		"success": {
+			req: &ctlpb.SupportExecReq{Command: "echo hello"},
+			expResp: &ctlpb.SupportExecResp{
+				Command: "echo hello",
+				Output:  []byte("hello\n"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			origCommands := supportCommands
+			supportCommands = []string{"echo hello", "false", "daos_no_such_command"}
+			defer func() { supportCommands = origCommands }()
+
+			cs := mockControlService(t, log, config.DefaultServer(), nil, nil, nil)
+
+			resp, err := cs.SupportExec(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
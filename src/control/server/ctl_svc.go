@@ -10,6 +10,7 @@ import (
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/config"
 )
@@ -23,6 +24,9 @@ type ControlService struct {
 	srvCfg  *config.Server
 	events  *events.PubSub
 	fabric  *hardware.FabricScanner
+
+	debugRanks      rankGuard
+	telemetryFilter *promexp.ClassFilter
 }
 
 // NewControlService returns ControlService to be used as gRPC control service
@@ -0,0 +1,40 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+// SetTelemetryClasses implements the method defined for the control service.
+//
+// Replace the set of metric classes that are not exported by the local
+// telemetry exporter. The change applies from the next scrape onwards and
+// does not require engines to be restarted.
+func (svc *ControlService) SetTelemetryClasses(ctx context.Context, req *ctlpb.SetTelemetryClassesReq) (*ctlpb.SetTelemetryClassesResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if svc.srvCfg.TelemetryPort == 0 {
+		return nil, errors.New("telemetry exporter is not enabled (telemetry_port not set)")
+	}
+
+	if err := svc.telemetryFilter.SetDisabled(req.Disabled...); err != nil {
+		return nil, err
+	}
+
+	disabled := svc.telemetryFilter.Disabled()
+	svc.log.Noticef("telemetry metric classes disabled: [%s]", strings.Join(disabled, ", "))
+
+	return &ctlpb.SetTelemetryClassesResp{Disabled: disabled}, nil
+}
@@ -0,0 +1,88 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestServer_CtlSvc_SetTelemetryClasses(t *testing.T) {
+	for name, tc := range map[string]struct {
+		telemPort int
+		initial   []string
+		req       *ctlpb.SetTelemetryClassesReq
+		expResp   *ctlpb.SetTelemetryClassesResp
+		expErr    error
+	}{
+		"nil request": {
+			telemPort: 9191,
+			expErr:    errors.New("nil request"),
+		},
+		"exporter disabled": {
+			req:    &ctlpb.SetTelemetryClassesReq{Disabled: []string{"target"}},
+			expErr: errors.New("not enabled"),
+		},
+		"unknown class": {
+			telemPort: 9191,
+			req:       &ctlpb.SetTelemetryClassesReq{Disabled: []string{"bogus"}},
+			expErr:    errors.New("unknown metric class"),
+		},
+		"disable classes": {
+			telemPort: 9191,
+			initial:   []string{"network"},
+			req: &ctlpb.SetTelemetryClassesReq{
+				Disabled: []string{"target", "io_latency"},
+			},
+			expResp: &ctlpb.SetTelemetryClassesResp{
+				Disabled: []string{"io_latency", "target"},
+			},
+		},
+		"enable all classes": {
+			telemPort: 9191,
+			initial:   []string{"network", "target"},
+			req:       &ctlpb.SetTelemetryClassesReq{},
+			expResp: &ctlpb.SetTelemetryClassesResp{
+				Disabled: []string{},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithTelemetryPort(tc.telemPort)
+			cs := mockControlService(t, log, cfg, nil, nil, nil)
+
+			filter, err := promexp.NewClassFilter(tc.initial...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cs.telemetryFilter = filter
+
+			resp, err := cs.SetTelemetryClasses(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
@@ -21,7 +22,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
-	"github.com/daos-stack/daos/src/control/system/raft"
+	"github.com/daos-stack/daos/src/control/server/engine"
 )
 
 const (
@@ -102,7 +103,7 @@ type drpcServerSetupReq struct {
 	sockDir string
 	engines []Engine
 	tc      *security.TransportConfig
-	sysdb   *raft.Database
+	pools   poolResolver
 	events  *events.PubSub
 }
 
@@ -125,7 +126,7 @@ func drpcServerSetup(ctx context.Context, req *drpcServerSetupReq) error {
 	// Create and add our modules
 	drpcServer.RegisterRPCModule(NewSecurityModule(req.log, req.tc))
 	drpcServer.RegisterRPCModule(newMgmtModule())
-	drpcServer.RegisterRPCModule(newSrvModule(req.log, req.sysdb, req.engines, req.events))
+	drpcServer.RegisterRPCModule(newSrvModule(req.log, req.pools, req.engines, req.events))
 
 	if err := drpcServer.Start(ctx); err != nil {
 		return errors.Wrapf(err, "unable to start socket server on %s", sockPath)
@@ -150,6 +151,13 @@ func drpcCleanup(sockDir string) error {
 	}
 
 	for _, s := range engineSocks {
+		// Leave sockets of engines that are still running (e.g. those
+		// left running for a warm restart) in place.
+		var pid int
+		if _, err := fmt.Sscanf(filepath.Base(s), "daos_engine_%d.sock", &pid); err == nil &&
+			engine.IsEngineProcess(pid) {
+			continue
+		}
 		os.Remove(s)
 	}
 
@@ -9,15 +9,32 @@ package engine
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
-const maxHelperStreamCount = 2
+const (
+	maxHelperStreamCount = 2
+
+	// HelperPlacementCores runs helper XS on dedicated cores following the
+	// target cores. This is the engine's default placement.
+	HelperPlacementCores = "cores"
+	// HelperPlacementHyperthread runs helper XS on the sibling hardware
+	// threads of the cores used by the target XS.
+	HelperPlacementHyperthread = "hyperthread"
+
+	// sysXSCoreCount is the number of cores used by the engine's system XS
+	// ahead of the first target XS.
+	sysXSCoreCount = 2
+)
 
 // FabricConfig encapsulates networking fabric configuration.
 type FabricConfig struct {
@@ -124,6 +141,9 @@ type Config struct {
 	Index             uint32         `yaml:"-" cmdLongFlag:"--instance_idx" cmdShortFlag:"-I"`
 	MemSize           int            `yaml:"-" cmdLongFlag:"--mem_size" cmdShortFlag:"-r"`
 	HugePageSz        int            `yaml:"-" cmdLongFlag:"--hugepage_size" cmdShortFlag:"-H"`
+	HelperPlacement   string         `yaml:"helper_placement,omitempty"`
+	HelperCPUs        string         `yaml:"-" cmdLongFlag:"--helper_cpus" cmdShortFlag:"-X"`
+	Output            OutputConfig   `yaml:"output,omitempty"`
 }
 
 // NewConfig returns an I/O Engine config.
@@ -151,6 +171,22 @@ func (c *Config) Validate() error {
 		return errors.Wrap(err, "validate engine log masks")
 	}
 
+	if err := c.Output.Validate(); err != nil {
+		return errors.Wrap(err, "output config validation failed")
+	}
+
+	switch c.HelperPlacement {
+	case "", HelperPlacementCores:
+	case HelperPlacementHyperthread:
+		if c.HelperStreamCount == 0 {
+			return errors.Errorf("helper_placement %q requires nr_xs_helpers to be nonzero",
+				c.HelperPlacement)
+		}
+	default:
+		return errors.Errorf("unknown helper_placement %q (valid: %s, %s)",
+			c.HelperPlacement, HelperPlacementCores, HelperPlacementHyperthread)
+	}
+
 	return nil
 }
 
@@ -199,6 +235,91 @@ func (c *Config) SetNUMAAffinity(node uint) error {
 	return hasMismatch
 }
 
+// engineCores returns the cores available to the engine in the order that
+// the engine allocates them to XS.
+func (c *Config) engineCores(topo *hardware.Topology) ([]hardware.CPUCore, error) {
+	if topo == nil {
+		return nil, errors.New("nil topology")
+	}
+
+	var cores []hardware.CPUCore
+	if c.PinnedNumaNode != nil {
+		node, found := topo.NUMANodes[*c.PinnedNumaNode]
+		if !found {
+			return nil, errors.Errorf("NUMA node %d not found in topology", *c.PinnedNumaNode)
+		}
+		cores = append(cores, node.Cores...)
+	} else {
+		for _, node := range topo.NUMANodes {
+			cores = append(cores, node.Cores...)
+		}
+	}
+	sort.Slice(cores, func(i, j int) bool { return cores[i].ID < cores[j].ID })
+
+	if c.ServiceThreadCore >= len(cores) {
+		return nil, errors.Errorf("first_core %d is out of range (%d cores available)",
+			c.ServiceThreadCore, len(cores))
+	}
+
+	return cores[c.ServiceThreadCore:], nil
+}
+
+// SetHelperCPUs computes the hardware threads to be used by the helper XS
+// from the configured helper placement and the supplied topology.
+//
+// With hyperthread placement, each helper XS is assigned a sibling thread of
+// a target core, in the order that the engine starts the helper XS. An error
+// is returned if the target cores do not have enough hardware threads.
+func (c *Config) SetHelperCPUs(topo *hardware.Topology) error {
+	c.HelperCPUs = ""
+	if c.HelperPlacement != HelperPlacementHyperthread || c.HelperStreamCount == 0 {
+		return nil
+	}
+	if c.TargetCount <= 0 {
+		return errors.Errorf("helper_placement %q requires targets to be set", c.HelperPlacement)
+	}
+
+	cores, err := c.engineCores(topo)
+	if err != nil {
+		return err
+	}
+	if len(cores) < sysXSCoreCount+c.TargetCount {
+		return errors.Errorf("%d targets require %d cores, only %d available",
+			c.TargetCount, sysXSCoreCount+c.TargetCount, len(cores))
+	}
+	tgtCores := cores[sysXSCoreCount : sysXSCoreCount+c.TargetCount]
+
+	// The engine runs at most two helpers per target.
+	helpers := c.HelperStreamCount
+	if helpers > 2*c.TargetCount {
+		helpers = 2 * c.TargetCount
+	}
+	// If the helpers can be evenly divided between targets, each target's
+	// helpers are started after it; otherwise they are started as a pool.
+	helpersPerTgt := 0
+	if helpers%c.TargetCount == 0 {
+		helpersPerTgt = helpers / c.TargetCount
+	}
+
+	cpus := make([]string, 0, helpers)
+	for i := 0; i < helpers; i++ {
+		tgt, thread := i%c.TargetCount, 1+i/c.TargetCount
+		if helpersPerTgt > 0 {
+			tgt, thread = i/helpersPerTgt, 1+i%helpersPerTgt
+		}
+
+		core := tgtCores[tgt]
+		if len(core.Threads) <= thread {
+			return errors.Errorf("helper_placement %q requires %d hardware threads per core, core %d has %d",
+				c.HelperPlacement, thread+1, core.ID, len(core.Threads))
+		}
+		cpus = append(cpus, strconv.FormatUint(uint64(core.Threads[thread]), 10))
+	}
+	c.HelperCPUs = strings.Join(cpus, ",")
+
+	return nil
+}
+
 // CmdLineArgs returns a slice of command line arguments to be
 // supplied when starting an I/O Engine instance.
 func (c *Config) CmdLineArgs() ([]string, error) {
@@ -327,6 +448,12 @@ func (c *Config) WithStorageEnableHotplug(enable bool) *Config {
 	return c
 }
 
+// WithStorageHotplugPeriod sets HotplugPeriod in engine storage.
+func (c *Config) WithStorageHotplugPeriod(period time.Duration) *Config {
+	c.Storage.HotplugPeriod = period
+	return c
+}
+
 // WithStorageNumaNodeIndex sets the NUMA node index to be used by this instance.
 func (c *Config) WithStorageNumaNodeIndex(nodeIndex uint) *Config {
 	c.Storage.NumaNodeIndex = nodeIndex
@@ -411,6 +538,12 @@ func (c *Config) WithHelperStreamCount(count int) *Config {
 	return c
 }
 
+// WithHelperPlacement sets the placement policy for XS Helper streams on this instance.
+func (c *Config) WithHelperPlacement(placement string) *Config {
+	c.HelperPlacement = placement
+	return c
+}
+
 // WithServiceThreadCore sets the core index to be used for running DAOS service threads.
 func (c *Config) WithServiceThreadCore(idx int) *Config {
 	c.ServiceThreadCore = idx
@@ -423,6 +556,12 @@ func (c *Config) WithLogFile(logPath string) *Config {
 	return c
 }
 
+// WithOutput sets the destination of the engine process's stdout and stderr.
+func (c *Config) WithOutput(oc OutputConfig) *Config {
+	c.Output = oc
+	return c
+}
+
 // WithLogMask sets the DAOS logging mask to be used by this instance.
 func (c *Config) WithLogMask(logMask string) *Config {
 	c.LogMask = logMask
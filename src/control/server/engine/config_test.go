@@ -20,6 +20,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
@@ -481,6 +482,18 @@ func TestConfig_Validation(t *testing.T) {
 		"minimally-valid config should pass": {
 			cfg: validConfig(),
 		},
+		"unknown helper placement": {
+			cfg:    validConfig().WithHelperPlacement("spread"),
+			expErr: errors.New("unknown helper_placement"),
+		},
+		"hyperthread helper placement without helpers": {
+			cfg: validConfig().WithHelperPlacement(HelperPlacementHyperthread).
+				WithHelperStreamCount(0),
+			expErr: errors.New("requires nr_xs_helpers"),
+		},
+		"hyperthread helper placement": {
+			cfg: validConfig().WithHelperPlacement(HelperPlacementHyperthread),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
@@ -552,6 +565,7 @@ func TestConfig_ToCmdVals(t *testing.T) {
 		crtTimeout      = uint32(30)
 		memSize         = 8192
 		hugepageSz      = 2
+		helperCPUs      = "26,27,28,29"
 	)
 	cfg := MockConfig().
 		WithStorage(
@@ -579,6 +593,7 @@ func TestConfig_ToCmdVals(t *testing.T) {
 		WithSrxDisabled(true)
 
 	cfg.Index = uint32(index)
+	cfg.HelperCPUs = helperCPUs
 
 	wantArgs := []string{
 		"-x", strconv.Itoa(helperCount),
@@ -595,6 +610,7 @@ func TestConfig_ToCmdVals(t *testing.T) {
 		"-b",
 		"-r", strconv.Itoa(memSize),
 		"-H", strconv.Itoa(hugepageSz),
+		"-X", helperCPUs,
 	}
 	wantEnv := []string{
 		"OFI_INTERFACE=" + interfaceName,
@@ -624,6 +640,110 @@ func TestConfig_ToCmdVals(t *testing.T) {
 	}
 }
 
+func TestConfig_SetHelperCPUs(t *testing.T) {
+	// Two NUMA nodes with 8 cores each. Core N has hardware threads
+	// N, N+16, N+32, etc.
+	mockTopo := func(threadsPerCore int) *hardware.Topology {
+		topo := &hardware.Topology{
+			NUMANodes: hardware.NodeMap{
+				0: hardware.MockNUMANode(0, 8),
+				1: hardware.MockNUMANode(1, 8, 8),
+			},
+		}
+		for _, node := range topo.NUMANodes {
+			for i := range node.Cores {
+				for j := 0; j < threadsPerCore; j++ {
+					node.Cores[i].Threads = append(node.Cores[i].Threads,
+						node.Cores[i].ID+uint(j*16))
+				}
+			}
+		}
+		return topo
+	}
+	hyperthreadCfg := func(targets, helpers int) *Config {
+		return MockConfig().
+			WithHelperPlacement(HelperPlacementHyperthread).
+			WithTargetCount(targets).
+			WithHelperStreamCount(helpers)
+	}
+
+	for name, tc := range map[string]struct {
+		cfg           *Config
+		topo          *hardware.Topology
+		expHelperCPUs string
+		expErr        error
+	}{
+		"default placement": {
+			cfg:  MockConfig().WithTargetCount(4).WithPinnedNumaNode(1),
+			topo: mockTopo(2),
+		},
+		"core placement": {
+			cfg: MockConfig().WithHelperPlacement(HelperPlacementCores).
+				WithTargetCount(4).WithPinnedNumaNode(1),
+			topo: mockTopo(2),
+		},
+		"nil topology": {
+			cfg:    hyperthreadCfg(4, 4).WithPinnedNumaNode(0),
+			expErr: errors.New("nil topology"),
+		},
+		"unknown NUMA node": {
+			cfg:    hyperthreadCfg(4, 4).WithPinnedNumaNode(3),
+			topo:   mockTopo(2),
+			expErr: errors.New("NUMA node 3 not found"),
+		},
+		"no targets": {
+			cfg:    hyperthreadCfg(0, 4).WithPinnedNumaNode(0),
+			topo:   mockTopo(2),
+			expErr: errors.New("requires targets"),
+		},
+		"not enough cores": {
+			cfg:    hyperthreadCfg(7, 7).WithPinnedNumaNode(0),
+			topo:   mockTopo(2),
+			expErr: errors.New("7 targets require 9 cores, only 8 available"),
+		},
+		"no hyperthreads": {
+			cfg:    hyperthreadCfg(4, 4).WithPinnedNumaNode(0),
+			topo:   mockTopo(1),
+			expErr: errors.New("requires 2 hardware threads per core, core 2 has 1"),
+		},
+		"one helper per target": {
+			cfg:           hyperthreadCfg(4, 4).WithPinnedNumaNode(1),
+			topo:          mockTopo(2),
+			expHelperCPUs: "26,27,28,29",
+		},
+		"helper pool": {
+			cfg:           hyperthreadCfg(4, 2).WithPinnedNumaNode(0),
+			topo:          mockTopo(2),
+			expHelperCPUs: "18,19",
+		},
+		"two helpers per target": {
+			cfg:           hyperthreadCfg(4, 8).WithPinnedNumaNode(0),
+			topo:          mockTopo(3),
+			expHelperCPUs: "18,34,19,35,20,36,21,37",
+		},
+		"two helpers per target; not enough hyperthreads": {
+			cfg:    hyperthreadCfg(2, 6).WithPinnedNumaNode(0),
+			topo:   mockTopo(2),
+			expErr: errors.New("requires 3 hardware threads per core, core 2 has 2"),
+		},
+		"legacy allocation with first core": {
+			cfg:           hyperthreadCfg(4, 4).WithServiceThreadCore(8),
+			topo:          mockTopo(2),
+			expHelperCPUs: "26,27,28,29",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cfg.SetHelperCPUs(tc.topo)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expHelperCPUs, tc.cfg.HelperCPUs, "unexpected helper CPUs")
+		})
+	}
+}
+
 func TestFabricConfig_Update(t *testing.T) {
 	for name, tc := range map[string]struct {
 		fc        *FabricConfig
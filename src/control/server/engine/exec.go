@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2019-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,6 +12,7 @@ import (
 	"os"
 	"os/exec"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -24,10 +25,11 @@ const engineBin = "daos_engine"
 
 // Runner starts and manages an instance of a DAOS I/O Engine
 type Runner struct {
-	Config  *Config
-	log     logging.Logger
-	running atm.Bool
-	sigCh   chan os.Signal
+	Config   *Config
+	log      logging.Logger
+	running  atm.Bool
+	sigCh    chan os.Signal
+	detached bool
 }
 
 type (
@@ -39,6 +41,10 @@ type (
 	RunnerExitChan chan *RunnerExitInfo
 )
 
+// attachPollInterval is the interval at which an attached engine process is
+// checked for liveness.
+var attachPollInterval = time.Second
+
 // NewRunner returns a configured engine.Runner
 func NewRunner(log logging.Logger, config *Config) *Runner {
 	return &Runner{
@@ -48,6 +54,45 @@ func NewRunner(log logging.Logger, config *Config) *Runner {
 	}
 }
 
+// WithDetached sets whether the engine process should be started detached from
+// the control plane, in which case it is left running when the control plane
+// exits and can be reattached to by a subsequent control plane instance.
+func (r *Runner) WithDetached(detached bool) *Runner {
+	r.detached = detached
+	return r
+}
+
+// logPrefix returns the prefix used to identify the engine in log messages.
+func (r *Runner) logPrefix() string {
+	return fmt.Sprintf("%s:%d", engineBin, r.Config.Index)
+}
+
+// forwardSignals relays signals sent to the Runner to the engine process until
+// the process exits. If the parent context is cancelled, the process is
+// killed unless it was started detached.
+func (r *Runner) forwardSignals(parent, ctx context.Context, proc *os.Process) {
+	for {
+		select {
+		case <-parent.Done():
+			if r.detached {
+				r.log.Noticef("leaving %s (pid %d) running", r.logPrefix(), proc.Pid)
+				return
+			}
+			r.log.Debugf("parent context cancelled, killing %s", r.logPrefix())
+			if err := proc.Signal(syscall.SIGKILL); err != nil && err != os.ErrProcessDone {
+				r.log.Errorf("%s failed to kill pid %d: %s", r.logPrefix(), proc.Pid, err)
+			}
+		case <-ctx.Done():
+			return
+		case sig := <-r.sigCh:
+			r.log.Debugf("signalling %s with %s", r.logPrefix(), sig)
+			if err := proc.Signal(sig); err != nil {
+				r.log.Errorf("%s failed to send signal %s to pid %d: %s", r.logPrefix(), sig, proc.Pid, err)
+			}
+		}
+	}
+}
+
 func (r *Runner) run(parent context.Context, args, env []string, exitCh RunnerExitChan) error {
 	binPath, err := common.FindBinary(engineBin)
 	if err != nil {
@@ -55,14 +100,6 @@ func (r *Runner) run(parent context.Context, args, env []string, exitCh RunnerEx
 	}
 
 	cmd := exec.Command(binPath, args...)
-	cmd.Stdout = &cmdLogger{
-		logFn:  r.log.Info,
-		prefix: fmt.Sprintf("%s:%d", engineBin, r.Config.Index),
-	}
-	cmd.Stderr = &cmdLogger{
-		logFn:  r.log.Error,
-		prefix: fmt.Sprintf("%s:%d", engineBin, r.Config.Index),
-	}
 	cmd.Env = env
 
 	cmd.SysProcAttr = &syscall.SysProcAttr{
@@ -76,16 +113,46 @@ func (r *Runner) run(parent context.Context, args, env []string, exitCh RunnerEx
 		},
 	}
 
-	r.log.Debugf("%s:%d args: %s", engineBin, r.Config.Index, args)
-	r.log.Debugf("%s:%d env: %s", engineBin, r.Config.Index, cmd.Env)
+	closeOutput := func() {}
+	if r.detached {
+		// A detached engine must outlive this process, so it runs in
+		// its own session and its output goes to a file rather than
+		// to a pipe that would be closed when this process exits.
+		cmd.SysProcAttr.Pdeathsig = 0
+		cmd.SysProcAttr.Setsid = true
+
+		out, err := r.detachedOutputFile()
+		if err != nil {
+			return errors.Wrapf(err, "can't open %s output file", r.logPrefix())
+		}
+		defer out.Close()
+		cmd.Stdout = out
+		cmd.Stderr = out
+	} else {
+		cmd.Stdout, cmd.Stderr, closeOutput, err = r.outputWriters()
+		if err != nil {
+			return err
+		}
+	}
+
+	r.log.Debugf("%s args: %s", r.logPrefix(), args)
+	r.log.Debugf("%s env: %s", r.logPrefix(), cmd.Env)
 	r.log.Infof("Starting I/O Engine instance %d: %s", r.Config.Index, binPath)
 
 	if err := cmd.Start(); err != nil {
+		closeOutput()
 		return errors.Wrapf(common.GetExitStatus(err),
 			"%s (instance %d) failed to start", binPath, r.Config.Index)
 	}
 	r.running.SetTrue()
 
+	pidFile := PidFilePath(r.Config.SocketDir, r.Config.Index)
+	if r.detached {
+		if err := writePidFile(pidFile, cmd.Process.Pid); err != nil {
+			r.log.Errorf("%s: %s", r.logPrefix(), err)
+		}
+	}
+
 	ctx, cancel := context.WithCancel(parent)
 	go func() {
 		// Block on cmd.Wait() and then cancel the inner context
@@ -94,6 +161,10 @@ func (r *Runner) run(parent context.Context, args, env []string, exitCh RunnerEx
 			Error: errors.Wrapf(common.GetExitStatus(cmd.Wait()), "%s exited", binPath),
 			PID:   cmd.Process.Pid,
 		}
+		closeOutput()
+		if r.detached {
+			os.Remove(pidFile)
+		}
 		cancel()
 		r.running.SetFalse()
 
@@ -102,26 +173,59 @@ func (r *Runner) run(parent context.Context, args, env []string, exitCh RunnerEx
 		close(exitCh)
 	}()
 
+	go r.forwardSignals(parent, ctx, cmd.Process)
+
+	return nil
+}
+
+// Attach starts managing an engine process that was started detached by a
+// previous control plane instance. As the process is not a child of this
+// one, its exit status is unknown and it is polled for liveness instead.
+func (r *Runner) Attach(parent context.Context, pid int) (RunnerExitChan, error) {
+	if !IsEngineProcess(pid) {
+		return nil, errors.Errorf("pid %d is not a running %s", pid, engineBin)
+	}
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't attach to %s", r.logPrefix())
+	}
+
+	r.log.Infof("Attaching to running I/O Engine instance %d (pid %d)", r.Config.Index, pid)
+	// The process must be left running on exit, as it was when it was
+	// first started.
+	r.detached = true
+	r.running.SetTrue()
+
+	exitCh := make(RunnerExitChan)
+	ctx, cancel := context.WithCancel(parent)
 	go func() {
-		for {
+		ticker := time.NewTicker(attachPollInterval)
+		defer ticker.Stop()
+
+		for proc.Signal(syscall.Signal(0)) == nil {
 			select {
 			case <-parent.Done():
-				r.log.Debugf("parent context cancelled, killing %s:%d", engineBin, r.Config.Index)
-				if err := cmd.Process.Signal(syscall.SIGKILL); err != nil && err != os.ErrProcessDone {
-					r.log.Errorf("%s:%d failed to kill pid %d: %s", engineBin, r.Config.Index, cmd.Process.Pid, err)
-				}
-			case <-ctx.Done():
+				cancel()
 				return
-			case sig := <-r.sigCh:
-				r.log.Debugf("signalling %s:%d with %s", engineBin, r.Config.Index, sig)
-				if err := cmd.Process.Signal(sig); err != nil {
-					r.log.Errorf("%s:%d failed to send signal %s to pid %d: %s", engineBin, r.Config.Index, sig, cmd.Process.Pid, err)
-				}
+			case <-ticker.C:
 			}
 		}
+
+		exitInfo := &RunnerExitInfo{
+			Error: errors.Errorf("%s (pid %d) exited", engineBin, pid),
+			PID:   pid,
+		}
+		os.Remove(PidFilePath(r.Config.SocketDir, r.Config.Index))
+		cancel()
+		r.running.SetFalse()
+
+		exitCh <- exitInfo
+		close(exitCh)
 	}()
 
-	return nil
+	go r.forwardSignals(parent, ctx, proc)
+
+	return exitCh, nil
 }
 
 // Start asynchronously starts the Engine instance.
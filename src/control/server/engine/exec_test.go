@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
 
@@ -132,6 +133,65 @@ func TestRunnerContextExit(t *testing.T) {
 	}
 }
 
+func TestRunnerDetachedAttach(t *testing.T) {
+	createFakeBinary(t)
+
+	// set this to control the behavior in TestMain()
+	os.Setenv(testModeVar, "RunnerContextExit")
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	origInterval := attachPollInterval
+	attachPollInterval = 10 * time.Millisecond
+	defer func() { attachPollInterval = origInterval }()
+
+	cfg := MockConfig().
+		WithEnvPassThrough(testModeVar).
+		WithSocketDir(testDir)
+	cfg.Index = 3
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := NewRunner(log, cfg).WithDetached(true).Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	// The detached process should survive the context being cancelled.
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+
+	pid, err := FindDetached(log, testDir, cfg.Index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid == 0 {
+		t.Fatal("expected detached engine to still be running")
+	}
+
+	runner := NewRunner(log, cfg)
+	eiChan, err := runner.Attach(context.Background(), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !runner.IsRunning() {
+		t.Fatal("expected attached runner to be running")
+	}
+	runner.Signal(syscall.SIGKILL)
+
+	select {
+	case ei := <-eiChan:
+		test.AssertEqual(t, pid, ei.PID, "unexpected pid in exit info")
+	case <-time.After(10 * time.Second):
+		t.Fatal("attached engine exit not detected")
+	}
+	if _, err := os.Stat(PidFilePath(testDir, cfg.Index)); !os.IsNotExist(err) {
+		t.Fatal("expected pid file to be removed on exit")
+	}
+}
+
 func TestRunnerNormalExit(t *testing.T) {
 	var bypass bool = false
 	createFakeBinary(t)
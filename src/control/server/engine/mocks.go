@@ -73,6 +73,11 @@ func (tr *TestRunner) Start(ctx context.Context) (RunnerExitChan, error) {
 	return runnerExitInfoCh, tr.runnerCfg.StartErr
 }
 
+func (tr *TestRunner) Attach(ctx context.Context, pid int) (RunnerExitChan, error) {
+	tr.runnerCfg.LastPid = uint64(pid)
+	return tr.Start(ctx)
+}
+
 func (tr *TestRunner) Signal(sig os.Signal) {
 	if tr.runnerCfg.SignalCb != nil {
 		tr.runnerCfg.SignalCb(tr.serverCfg.Index, sig)
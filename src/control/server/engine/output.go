@@ -0,0 +1,411 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/syslog"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// OutputControl forwards engine output to the control plane log.
+	OutputControl = "control"
+	// OutputFile writes engine output to a file, optionally rotated by size.
+	OutputFile = "file"
+	// OutputSyslog sends engine output to the local syslog daemon.
+	OutputSyslog = "syslog"
+	// OutputJournald sends engine output to the systemd journal.
+	OutputJournald = "journald"
+)
+
+// journaldSocket is the path of the systemd journal's native protocol socket,
+// overridden in tests.
+var journaldSocket = "/run/systemd/journal/socket"
+
+// OutputConfig determines where the stdout and stderr of the engine process
+// are written.
+type OutputConfig struct {
+	Destination string `yaml:"destination,omitempty"`
+	File        string `yaml:"file,omitempty"`
+	MaxSize     uint64 `yaml:"max_size,omitempty"`
+	MaxBackups  uint   `yaml:"max_backups,omitempty"`
+}
+
+// Validate ensures that the output configuration is consistent.
+func (oc *OutputConfig) Validate() error {
+	switch oc.Destination {
+	case "", OutputControl, OutputSyslog, OutputJournald:
+		if oc.File != "" || oc.MaxSize != 0 || oc.MaxBackups != 0 {
+			return errors.Errorf("file, max_size and max_backups only apply to destination %q",
+				OutputFile)
+		}
+	case OutputFile:
+		if oc.File == "" {
+			return errors.Errorf("destination %q requires file to be set", OutputFile)
+		}
+		if oc.MaxBackups != 0 && oc.MaxSize == 0 {
+			return errors.New("max_backups requires max_size to be set")
+		}
+	default:
+		return errors.Errorf("unknown output destination %q (valid: %s)", oc.Destination,
+			strings.Join([]string{OutputControl, OutputFile, OutputSyslog, OutputJournald}, ", "))
+	}
+
+	return nil
+}
+
+// droppedOutput counts engine output that could not be written to its
+// destination. Write errors are logged rather than returned, as an error
+// returned to the copier of the process output would stop the copy and leave
+// the engine blocked writing to its stdout or stderr. The caller must
+// serialize access.
+type droppedOutput struct {
+	log   logging.Logger
+	desc  string
+	count int
+}
+
+// countLines returns the number of lines in the data, counting any unterminated
+// final line.
+func countLines(data []byte) int {
+	lines := bytes.Count(data, []byte("\n"))
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		lines++
+	}
+	return lines
+}
+
+func (do *droppedOutput) drop(lines int, err error) {
+	if do.count == 0 {
+		do.log.Errorf("dropping %s: %s", do.desc, err)
+	}
+	do.count += lines
+}
+
+func (do *droppedOutput) resume() {
+	if do.count == 0 {
+		return
+	}
+	do.log.Noticef("%s resumed after %d lines were dropped", do.desc, do.count)
+	do.count = 0
+}
+
+func (do *droppedOutput) close() {
+	if do.count == 0 {
+		return
+	}
+	do.log.Errorf("%d lines of %s were dropped", do.count, do.desc)
+	do.count = 0
+}
+
+// rotatingFile is an io.Writer that writes to a file, renaming it to
+// <path>.1 (and any older backups to <path>.2 etc.) once it reaches the
+// maximum size. A maximum size of zero disables rotation. If the file can't be
+// written, it is reopened on the next write and the output is dropped until
+// that succeeds.
+type rotatingFile struct {
+	sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups uint
+	file       *os.File
+	size       int64
+	dropped    droppedOutput
+}
+
+func openRotatingFile(log logging.Logger, path string, maxSize int64, maxBackups uint) (*rotatingFile, error) {
+	rf := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+		dropped: droppedOutput{
+			log:  log,
+			desc: "engine output to " + path,
+		},
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+func (rf *rotatingFile) open() error {
+	f, err := os.OpenFile(rf.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	rf.file = f
+	rf.size = fi.Size()
+	return nil
+}
+
+func (rf *rotatingFile) closeFile() error {
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
+
+func (rf *rotatingFile) backupPath(n uint) string {
+	return rf.path + "." + strconv.FormatUint(uint64(n), 10)
+}
+
+func (rf *rotatingFile) rotate() error {
+	if err := rf.closeFile(); err != nil {
+		return err
+	}
+
+	if rf.maxBackups == 0 {
+		if err := os.Remove(rf.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return rf.open()
+	}
+
+	for n := rf.maxBackups; n > 1; n-- {
+		if err := os.Rename(rf.backupPath(n-1), rf.backupPath(n)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(rf.path, rf.backupPath(1)); err != nil {
+		return err
+	}
+
+	return rf.open()
+}
+
+func (rf *rotatingFile) write(data []byte) error {
+	if rf.file == nil {
+		if err := rf.open(); err != nil {
+			return errors.Wrapf(err, "reopening %s", rf.path)
+		}
+	}
+
+	if rf.maxSize > 0 && rf.size > 0 && rf.size+int64(len(data)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			rf.closeFile()
+			return errors.Wrapf(err, "rotating %s", rf.path)
+		}
+	}
+
+	n, err := rf.file.Write(data)
+	rf.size += int64(n)
+	if err != nil {
+		rf.closeFile()
+	}
+	return err
+}
+
+func (rf *rotatingFile) Write(data []byte) (int, error) {
+	rf.Lock()
+	defer rf.Unlock()
+
+	if err := rf.write(data); err != nil {
+		rf.dropped.drop(countLines(data), err)
+	} else {
+		rf.dropped.resume()
+	}
+
+	return len(data), nil
+}
+
+func (rf *rotatingFile) Close() error {
+	rf.Lock()
+	defer rf.Unlock()
+
+	rf.dropped.close()
+	return rf.closeFile()
+}
+
+// journalConn is a connection to the systemd journal shared by the writers for
+// an engine's stdout and stderr. If a write fails, the connection is reopened
+// and the write retried once before the entry is dropped.
+type journalConn struct {
+	sync.Mutex
+	conn    *net.UnixConn
+	dropped droppedOutput
+}
+
+func newJournalConn(log logging.Logger, logPrefix string) (*journalConn, error) {
+	conn, err := dialJournal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &journalConn{
+		conn: conn,
+		dropped: droppedOutput{
+			log:  log,
+			desc: logPrefix + " output to journald",
+		},
+	}, nil
+}
+
+func (jc *journalConn) send(entry []byte) error {
+	if jc.conn != nil {
+		_, err := jc.conn.Write(entry)
+		if err == nil {
+			return nil
+		}
+		jc.conn.Close()
+		jc.conn = nil
+	}
+
+	conn, err := dialJournal()
+	if err != nil {
+		return err
+	}
+	jc.conn = conn
+
+	_, err = jc.conn.Write(entry)
+	return err
+}
+
+func (jc *journalConn) Close() error {
+	jc.Lock()
+	defer jc.Unlock()
+
+	jc.dropped.close()
+	if jc.conn == nil {
+		return nil
+	}
+	err := jc.conn.Close()
+	jc.conn = nil
+	return err
+}
+
+// journalWriter is an io.Writer that sends each line written to it to the
+// systemd journal as a separate entry, using the journal's native protocol.
+type journalWriter struct {
+	jc     *journalConn
+	fields string
+}
+
+func newJournalWriter(jc *journalConn, priority syslog.Priority, idx uint32) *journalWriter {
+	return &journalWriter{
+		jc: jc,
+		fields: fmt.Sprintf("PRIORITY=%d\nSYSLOG_IDENTIFIER=%s\nDAOS_ENGINE_IDX=%d\n",
+			priority, engineBin, idx),
+	}
+}
+
+func (jw *journalWriter) Write(data []byte) (int, error) {
+	jw.jc.Lock()
+	defer jw.jc.Unlock()
+
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		if err := jw.jc.send([]byte(jw.fields + "MESSAGE=" + line + "\n")); err != nil {
+			jw.jc.dropped.drop(1, err)
+			continue
+		}
+		jw.jc.dropped.resume()
+	}
+
+	return len(data), nil
+}
+
+func dialJournal() (*net.UnixConn, error) {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: journaldSocket, Net: "unixgram"})
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to systemd journal")
+	}
+	return conn, nil
+}
+
+// outputWriters returns the writers that the stdout and stderr of the engine
+// process should be connected to, along with a function to close them once
+// the process has exited.
+func (r *Runner) outputWriters() (stdout, stderr io.Writer, closer func(), err error) {
+	oc := r.Config.Output
+
+	switch oc.Destination {
+	case OutputFile:
+		rf, err := openRotatingFile(r.log, oc.File, int64(oc.MaxSize*humanize.MiByte), oc.MaxBackups)
+		if err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "can't open %s output file", r.logPrefix())
+		}
+		return rf, rf, func() { rf.Close() }, nil
+	case OutputSyslog:
+		tag := fmt.Sprintf("%s.%d", engineBin, r.Config.Index)
+		out, err := syslog.New(syslog.LOG_DAEMON|syslog.LOG_INFO, tag)
+		if err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "can't connect %s output to syslog", r.logPrefix())
+		}
+		errOut, err := syslog.New(syslog.LOG_DAEMON|syslog.LOG_ERR, tag)
+		if err != nil {
+			out.Close()
+			return nil, nil, nil, errors.Wrapf(err, "can't connect %s output to syslog", r.logPrefix())
+		}
+		return out, errOut, func() { out.Close(); errOut.Close() }, nil
+	case OutputJournald:
+		jc, err := newJournalConn(r.log, r.logPrefix())
+		if err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "can't connect %s output to journald", r.logPrefix())
+		}
+		stdout = newJournalWriter(jc, syslog.LOG_INFO, r.Config.Index)
+		stderr = newJournalWriter(jc, syslog.LOG_ERR, r.Config.Index)
+		return stdout, stderr, func() { jc.Close() }, nil
+	}
+
+	stdout = &cmdLogger{
+		logFn:  r.log.Info,
+		prefix: r.logPrefix(),
+	}
+	stderr = &cmdLogger{
+		logFn:  r.log.Error,
+		prefix: r.logPrefix(),
+	}
+	return stdout, stderr, func() {}, nil
+}
+
+// detachedOutputFile opens the file that the output of a detached engine is
+// written to. A detached engine must outlive this process, so its output
+// can't be passed through a pipe for rotation or forwarding and goes directly
+// to the configured log file, or to the default output file if another
+// destination is configured.
+func (r *Runner) detachedOutputFile() (*os.File, error) {
+	oc := r.Config.Output
+
+	path := OutputFilePath(r.Config.SocketDir, r.Config.Index)
+	switch oc.Destination {
+	case "", OutputControl:
+	case OutputFile:
+		path = oc.File
+		if oc.MaxSize != 0 {
+			r.log.Noticef("%s output file %s will not be rotated while running detached",
+				r.logPrefix(), path)
+		}
+	default:
+		r.log.Noticef("%s output can't be sent to %s while running detached, using %s",
+			r.logPrefix(), oc.Destination, path)
+	}
+
+	return os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+}
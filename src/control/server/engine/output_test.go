@@ -0,0 +1,290 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestEngine_OutputConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    OutputConfig
+		expErr error
+	}{
+		"default": {},
+		"control": {
+			cfg: OutputConfig{Destination: OutputControl},
+		},
+		"unknown destination": {
+			cfg:    OutputConfig{Destination: "stdout"},
+			expErr: errors.New("unknown output destination"),
+		},
+		"file without path": {
+			cfg:    OutputConfig{Destination: OutputFile},
+			expErr: errors.New("requires file"),
+		},
+		"file with rotation": {
+			cfg: OutputConfig{
+				Destination: OutputFile,
+				File:        "/tmp/engine.out",
+				MaxSize:     100,
+				MaxBackups:  3,
+			},
+		},
+		"backups without size": {
+			cfg: OutputConfig{
+				Destination: OutputFile,
+				File:        "/tmp/engine.out",
+				MaxBackups:  3,
+			},
+			expErr: errors.New("requires max_size"),
+		},
+		"syslog with file settings": {
+			cfg: OutputConfig{
+				Destination: OutputSyslog,
+				File:        "/tmp/engine.out",
+			},
+			expErr: errors.New("only apply to destination"),
+		},
+		"journald": {
+			cfg: OutputConfig{Destination: OutputJournald},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+func readTestFile(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestEngine_rotatingFile(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	path := filepath.Join(testDir, "engine.out")
+	if err := os.WriteFile(path, []byte("old\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	rf, err := openRotatingFile(log, path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rf.Close()
+
+	for _, line := range []string{"one\n", "two\n", "three\n", "four\n", "five\n", "six\n"} {
+		if _, err := rf.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Each file is rotated before it would exceed 10 bytes, and only the
+	// 2 most recent backups are kept.
+	for file, exp := range map[string]string{
+		path:        "six\n",
+		path + ".1": "four\nfive\n",
+		path + ".2": "two\nthree\n",
+	} {
+		test.AssertEqual(t, exp, readTestFile(t, file), "unexpected contents of "+file)
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Fatalf("expected no third backup, got %v", err)
+	}
+
+	// Without backups, the file is truncated when full.
+	noBackups := filepath.Join(testDir, "nobackups.out")
+	rf2, err := openRotatingFile(log, noBackups, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rf2.Close()
+	for _, line := range []string{"one\n", "two\n", "three\n"} {
+		if _, err := rf2.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	test.AssertEqual(t, "three\n", readTestFile(t, noBackups), "unexpected contents")
+
+	// Output that can't be written is dropped without returning an error,
+	// and the file is reopened once it can be written again.
+	subDir := filepath.Join(testDir, "sub")
+	if err := os.Mkdir(subDir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	dropPath := filepath.Join(subDir, "engine.out")
+	rf3, err := openRotatingFile(log, dropPath, 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rf3.Close()
+	if _, err := rf3.Write([]byte("one\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(subDir); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"rotated\n", "lost\n"} {
+		if _, err := rf3.Write([]byte(line)); err != nil {
+			t.Fatalf("expected dropped output, got %s", err)
+		}
+	}
+	if err := os.Mkdir(subDir, 0750); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rf3.Write([]byte("found\n")); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "found\n", readTestFile(t, dropPath), "unexpected contents after reopen")
+	if !strings.Contains(buf.String(), "resumed after 2 lines were dropped") {
+		t.Fatal("expected dropped lines to be logged")
+	}
+}
+
+func TestEngine_journalWriter(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	sockPath := filepath.Join(testDir, "journal.sock")
+	listener, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: sockPath, Net: "unixgram"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	origSocket := journaldSocket
+	journaldSocket = sockPath
+	defer func() { journaldSocket = origSocket }()
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	r := NewRunner(log, MockConfig().WithIndex(1).WithOutput(OutputConfig{
+		Destination: OutputJournald,
+	}))
+	stdout, stderr, closer, err := r.outputWriters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer()
+
+	if _, err := stdout.Write([]byte("first\nsecond\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stderr.Write([]byte("failed\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	msg := make([]byte, 1024)
+	for i := 0; i < 3; i++ {
+		n, err := listener.Read(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, string(msg[:n]))
+	}
+
+	entry := func(prio int, line string) string {
+		return fmt.Sprintf("PRIORITY=%d\nSYSLOG_IDENTIFIER=daos_engine\nDAOS_ENGINE_IDX=1\nMESSAGE=%s\n",
+			prio, line)
+	}
+	exp := []string{entry(6, "first"), entry(6, "second"), entry(3, "failed")}
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("unexpected journal entries (-want, +got):\n%s\n", diff)
+	}
+
+	// Entries are dropped while the journal is unavailable, and the
+	// connection is reopened once it is available again.
+	listener.Close()
+	if err := os.Remove(sockPath); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stdout.Write([]byte("lost\n")); err != nil {
+		t.Fatalf("expected dropped output, got %s", err)
+	}
+
+	listener, err = net.ListenUnixgram("unixgram", &net.UnixAddr{Name: sockPath, Net: "unixgram"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	if _, err := stdout.Write([]byte("found\n")); err != nil {
+		t.Fatal(err)
+	}
+	n, err := listener.Read(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, entry(6, "found"), string(msg[:n]), "unexpected entry after reconnect")
+	if !strings.Contains(buf.String(), "resumed after 1 lines were dropped") {
+		t.Fatal("expected dropped lines to be logged")
+	}
+}
+
+func TestEngine_Runner_outputWriters(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	// By default, output goes to the control plane log.
+	r := NewRunner(log, MockConfig().WithIndex(0))
+	stdout, _, closer, err := r.outputWriters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stdout.Write([]byte("engine says hello")); err != nil {
+		t.Fatal(err)
+	}
+	closer()
+	if !strings.Contains(buf.String(), "daos_engine:0 engine says hello") {
+		t.Fatal("expected engine output in control log")
+	}
+
+	path := filepath.Join(testDir, "engine.out")
+	r = NewRunner(log, MockConfig().WithIndex(0).WithOutput(OutputConfig{
+		Destination: OutputFile,
+		File:        path,
+	}))
+	stdout, stderr, closer, err := r.outputWriters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stdout.Write([]byte("out\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stderr.Write([]byte("err\n")); err != nil {
+		t.Fatal(err)
+	}
+	closer()
+	test.AssertEqual(t, "out\nerr\n", readTestFile(t, path), "unexpected output file contents")
+}
@@ -0,0 +1,95 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// procRoot is the mountpoint of procfs, overridden in tests.
+var procRoot = "/proc"
+
+// PidFilePath returns the path of the file recording the pid of a detached
+// engine instance.
+func PidFilePath(sockDir string, idx uint32) string {
+	return filepath.Join(sockDir, fmt.Sprintf("%s.%d.pid", engineBin, idx))
+}
+
+// OutputFilePath returns the path of the file that the stdout and stderr of a
+// detached engine instance are written to.
+func OutputFilePath(sockDir string, idx uint32) string {
+	return filepath.Join(sockDir, fmt.Sprintf("%s.%d.out", engineBin, idx))
+}
+
+func writePidFile(path string, pid int) error {
+	if err := os.WriteFile(path, []byte(strconv.Itoa(pid)+"\n"), 0640); err != nil {
+		return errors.Wrap(err, "writing engine pid file")
+	}
+	return nil
+}
+
+// ReadPidFile returns the pid recorded in the given pid file.
+func ReadPidFile(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pid <= 0 {
+		return 0, errors.Errorf("invalid pid in %s: %q", path, strings.TrimSpace(string(data)))
+	}
+
+	return pid, nil
+}
+
+// IsEngineProcess indicates whether the given pid belongs to a running
+// engine process.
+func IsEngineProcess(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	comm, err := os.ReadFile(filepath.Join(procRoot, strconv.Itoa(pid), "comm"))
+	if err != nil {
+		return false
+	}
+
+	return strings.TrimSpace(string(comm)) == engineBin
+}
+
+// FindDetached returns the pid of a running engine that was started detached
+// with the given socket directory and instance index, or zero if there is
+// none. Stale pid files are removed.
+func FindDetached(log logging.Logger, sockDir string, idx uint32) (int, error) {
+	path := PidFilePath(sockDir, idx)
+	pid, err := ReadPidFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+
+	if !IsEngineProcess(pid) {
+		log.Debugf("removing stale %s pid file %s (pid %d)", engineBin, path, pid)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return 0, errors.Wrap(err, "removing stale engine pid file")
+		}
+		return 0, nil
+	}
+
+	return pid, nil
+}
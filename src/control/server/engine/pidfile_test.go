@@ -0,0 +1,88 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestEngine_FindDetached(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pidFile    string
+		comm       string
+		expPid     int
+		expRemoved bool
+		expErr     error
+	}{
+		"no pid file": {},
+		"invalid pid file": {
+			pidFile: "bob\n",
+			expErr:  errors.New("invalid pid"),
+		},
+		"stale pid file": {
+			pidFile:    "1234\n",
+			comm:       "bash\n",
+			expRemoved: true,
+		},
+		"process gone": {
+			pidFile:    "1234\n",
+			expRemoved: true,
+		},
+		"engine running": {
+			pidFile: "1234\n",
+			comm:    engineBin + "\n",
+			expPid:  1234,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			procDir := filepath.Join(testDir, "proc")
+			if tc.comm != "" {
+				if err := os.MkdirAll(filepath.Join(procDir, "1234"), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(procDir, "1234", "comm"), []byte(tc.comm), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			origRoot := procRoot
+			procRoot = procDir
+			defer func() { procRoot = origRoot }()
+
+			pidPath := PidFilePath(testDir, 1)
+			if tc.pidFile != "" {
+				if err := os.WriteFile(pidPath, []byte(tc.pidFile), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotPid, gotErr := FindDetached(log, testDir, 1)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expPid, gotPid, "unexpected pid")
+			if tc.pidFile != "" {
+				_, err := os.Stat(pidPath)
+				test.AssertEqual(t, tc.expRemoved, os.IsNotExist(err), "unexpected pid file state")
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+// Fault injection points understood by servers built with the fault_inject
+// build tag. Faults are set and cleared at runtime via the FaultInject RPC.
+const (
+	// faultDrpcDelay delays all dRPC responses from engines by the
+	// duration given as the argument.
+	faultDrpcDelay = "drpc-delay"
+	// faultJoinDrop causes the MS to drop system join requests.
+	faultJoinDrop = "join-drop"
+	// faultFormatFail causes storage format to fail at the step given as
+	// the argument.
+	faultFormatFail = "format-fail"
+)
+
+// Storage format steps at which a failure can be injected.
+const (
+	formatStepSCM    = "scm"
+	formatStepNVMe   = "nvme"
+	formatStepConfig = "config"
+)
+
+var errInjectedFault = errors.New("injected fault")
+
+// validateFault checks that the fault refers to a known injection point and
+// that its argument is valid for that point.
+func validateFault(fault *ctlpb.InjectedFault) error {
+	if fault == nil {
+		return errors.New("nil fault")
+	}
+
+	switch fault.Point {
+	case faultDrpcDelay:
+		if _, err := time.ParseDuration(fault.Arg); err != nil {
+			return errors.Wrapf(err, "invalid %s argument", faultDrpcDelay)
+		}
+	case faultJoinDrop:
+		if fault.Arg != "" {
+			return errors.Errorf("%s takes no argument", faultJoinDrop)
+		}
+	case faultFormatFail:
+		switch fault.Arg {
+		case formatStepSCM, formatStepNVMe, formatStepConfig:
+		default:
+			return errors.Errorf("invalid %s step %q (must be one of %s, %s or %s)",
+				faultFormatFail, fault.Arg, formatStepSCM, formatStepNVMe, formatStepConfig)
+		}
+	default:
+		return errors.Errorf("unknown fault injection point %q", fault.Point)
+	}
+
+	return nil
+}
+
+// faultRegistry tracks the faults currently injected, keyed by point.
+type faultRegistry struct {
+	sync.RWMutex
+	faults map[string]string
+}
+
+func (fr *faultRegistry) set(fault *ctlpb.InjectedFault) error {
+	if err := validateFault(fault); err != nil {
+		return err
+	}
+
+	fr.Lock()
+	defer fr.Unlock()
+
+	if fr.faults == nil {
+		fr.faults = make(map[string]string)
+	}
+	fr.faults[fault.Point] = fault.Arg
+
+	return nil
+}
+
+func (fr *faultRegistry) clear(point string) {
+	fr.Lock()
+	defer fr.Unlock()
+
+	delete(fr.faults, point)
+}
+
+func (fr *faultRegistry) get(point string) (string, bool) {
+	fr.RLock()
+	defer fr.RUnlock()
+
+	arg, found := fr.faults[point]
+	return arg, found
+}
+
+func (fr *faultRegistry) active() []*ctlpb.InjectedFault {
+	fr.RLock()
+	defer fr.RUnlock()
+
+	active := make([]*ctlpb.InjectedFault, 0, len(fr.faults))
+	for point, arg := range fr.faults {
+		active = append(active, &ctlpb.InjectedFault{Point: point, Arg: arg})
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].Point < active[j].Point
+	})
+
+	return active
+}
+
+// injectDrpcDelay blocks for the injected dRPC delay, if any.
+func injectDrpcDelay(ctx context.Context) error {
+	arg, found := injectedFault(faultDrpcDelay)
+	if !found {
+		return nil
+	}
+
+	delay, err := time.ParseDuration(arg)
+	if err != nil {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// injectJoinDrop blocks until the context is done if join requests are to be
+// dropped, returning true in that case.
+func injectJoinDrop(ctx context.Context) bool {
+	if _, found := injectedFault(faultJoinDrop); !found {
+		return false
+	}
+
+	<-ctx.Done()
+	return true
+}
+
+// injectFormatFailure returns an error if format is set to fail at the given
+// step.
+func injectFormatFailure(step string) error {
+	if arg, found := injectedFault(faultFormatFail); found && arg == step {
+		return errors.Wrapf(errInjectedFault, "format %s", step)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestServer_validateFault(t *testing.T) {
+	for name, tc := range map[string]struct {
+		fault  *ctlpb.InjectedFault
+		expErr error
+	}{
+		"nil fault": {
+			expErr: errors.New("nil fault"),
+		},
+		"unknown point": {
+			fault:  &ctlpb.InjectedFault{Point: "foo"},
+			expErr: errors.New("unknown fault injection point"),
+		},
+		"drpc delay": {
+			fault: &ctlpb.InjectedFault{Point: faultDrpcDelay, Arg: "5s"},
+		},
+		"drpc delay; bad duration": {
+			fault:  &ctlpb.InjectedFault{Point: faultDrpcDelay, Arg: "soon"},
+			expErr: errors.New("invalid drpc-delay argument"),
+		},
+		"join drop": {
+			fault: &ctlpb.InjectedFault{Point: faultJoinDrop},
+		},
+		"join drop; unexpected arg": {
+			fault:  &ctlpb.InjectedFault{Point: faultJoinDrop, Arg: "1"},
+			expErr: errors.New("takes no argument"),
+		},
+		"format fail": {
+			fault: &ctlpb.InjectedFault{Point: faultFormatFail, Arg: formatStepNVMe},
+		},
+		"format fail; unknown step": {
+			fault:  &ctlpb.InjectedFault{Point: faultFormatFail, Arg: "pmem"},
+			expErr: errors.New("invalid format-fail step"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, validateFault(tc.fault))
+		})
+	}
+}
+
+func TestServer_faultRegistry(t *testing.T) {
+	var fr faultRegistry
+
+	if _, found := fr.get(faultJoinDrop); found {
+		t.Fatal("unexpected fault in empty registry")
+	}
+
+	test.CmpErr(t, errors.New("unknown fault injection point"),
+		fr.set(&ctlpb.InjectedFault{Point: "foo"}))
+
+	for _, fault := range []*ctlpb.InjectedFault{
+		{Point: faultJoinDrop},
+		{Point: faultFormatFail, Arg: formatStepSCM},
+		{Point: faultFormatFail, Arg: formatStepConfig},
+	} {
+		if err := fr.set(fault); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	arg, found := fr.get(faultFormatFail)
+	if !found {
+		t.Fatal("expected format-fail fault to be set")
+	}
+	test.AssertEqual(t, formatStepConfig, arg, "unexpected format-fail arg")
+
+	expActive := []*ctlpb.InjectedFault{
+		{Point: faultFormatFail, Arg: formatStepConfig},
+		{Point: faultJoinDrop},
+	}
+	if diff := cmp.Diff(expActive, fr.active(), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected active faults (-want, +got):\n%s\n", diff)
+	}
+
+	fr.clear(faultJoinDrop)
+	fr.clear(faultDrpcDelay) // clearing an unset fault is a no-op
+
+	expActive = expActive[:1]
+	if diff := cmp.Diff(expActive, fr.active(), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected active faults after clear (-want, +got):\n%s\n", diff)
+	}
+}
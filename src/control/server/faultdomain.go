@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +7,7 @@
 package server
 
 import (
+	"context"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -15,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -126,3 +128,18 @@ func checkFaultDomainCallback(path, requiredDir string) error {
 
 	return nil
 }
+
+// FaultDomainQuery implements the method defined for the control service.
+//
+// Return the fault domain of this server, which is available before the
+// server's engines have joined the system.
+func (svc *ControlService) FaultDomainQuery(_ context.Context, req *ctlpb.FaultDomainQueryReq) (*ctlpb.FaultDomainQueryResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if svc.harness.faultDomain == nil {
+		return nil, errors.New("fault domain not set")
+	}
+
+	return &ctlpb.FaultDomainQueryResp{FaultDomain: svc.harness.faultDomain.String()}, nil
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +7,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,7 +16,9 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -342,3 +345,48 @@ func TestServer_getFaultDomainFromCallback(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_CtlSvc_FaultDomainQuery(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req         *ctlpb.FaultDomainQueryReq
+		faultDomain string
+		expResp     *ctlpb.FaultDomainQueryResp
+		expErr      error
+	}{
+		"nil request": {
+			faultDomain: "/rack1",
+			expErr:      errors.New("nil request"),
+		},
+		"no fault domain": {
+			req:    &ctlpb.FaultDomainQueryReq{},
+			expErr: errors.New("fault domain not set"),
+		},
+		"success": {
+			req:         &ctlpb.FaultDomainQueryReq{},
+			faultDomain: "/rack1",
+			expResp:     &ctlpb.FaultDomainQueryResp{FaultDomain: "/rack1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mockControlService(t, log, nil, nil, nil, nil)
+			if tc.faultDomain != "" {
+				fd, err := system.NewFaultDomainFromString(tc.faultDomain)
+				if err != nil {
+					t.Fatal(err)
+				}
+				svc.harness.WithFaultDomain(fd)
+			}
+
+			gotResp, gotErr := svc.FaultDomainQuery(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expResp.FaultDomain, gotResp.FaultDomain, "unexpected fault domain")
+		})
+	}
+}
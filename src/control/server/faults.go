@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -118,6 +118,19 @@ func FaultPoolInvalidNumRanks(req, avail int) *fault.Fault {
 	)
 }
 
+func FaultPoolInvalidTierRatio(ratios []float64) *fault.Fault {
+	rs := make([]string, len(ratios))
+	for i, r := range ratios {
+		rs[i] = fmt.Sprintf("%.2f%%", r*100)
+	}
+
+	return serverFault(
+		code.ServerPoolInvalidTierRatio,
+		fmt.Sprintf("pool request contains invalid storage tier ratio (%s)", strings.Join(rs, ",")),
+		"retry the request with tier ratios between 0 and 100 that add up to 100 and a non-zero SCM ratio",
+	)
+}
+
 func FaultPoolDuplicateLabel(dupe string) *fault.Fault {
 	return serverFault(
 		code.ServerPoolDuplicateLabel,
@@ -153,6 +166,47 @@ func FaultScmUnmanaged(mntPoint string) *fault.Fault {
 	)
 }
 
+// FaultScmMountShared creates a fault for the case where the same filesystem
+// is mounted at the SCM mountpoints of more than one engine.
+func FaultScmMountShared(mntPoint, otherMntPoint string) *fault.Fault {
+	return serverFault(
+		code.ServerScmMountShared,
+		fmt.Sprintf("the SCM mountpoint at %s is backed by the same device as %s, which is used by another engine", mntPoint, otherMntPoint),
+		"check for bind or duplicate mounts and ensure that each engine's scm_mount has its own PMem namespace or ramdisk mounted, then restart daos_server",
+	)
+}
+
+// FaultSuperblockMismatch creates a fault for the case where the superblock
+// found on an engine's SCM storage is not the one expected for the engine.
+func FaultSuperblockMismatch(sbPath, reason string) *fault.Fault {
+	return serverFault(
+		code.ServerSuperblockMismatch,
+		fmt.Sprintf("unexpected superblock at %s: %s", sbPath, reason),
+		"check that the correct SCM storage is mounted for this engine (storage may have been swapped between engines or hosts), or reformat the storage with dmg storage format if it should be reused",
+	)
+}
+
+// FaultSuperblockCorrupted creates a fault for the case where the superblock
+// found on an engine's SCM storage does not match its checksum.
+func FaultSuperblockCorrupted(sbPath string) *fault.Fault {
+	return serverFault(
+		code.ServerSuperblockCorrupted,
+		fmt.Sprintf("superblock at %s failed integrity check", sbPath),
+		"restore the superblock from a backup if one is available, otherwise restart daos_server with --recreate-superblocks (the engine will join the system with a new rank) or reformat the storage with dmg storage format",
+	)
+}
+
+// FaultDrpcSelfTestFailed creates a fault for the case where the dRPC round
+// trip made to an engine when it first becomes ready does not succeed.
+func FaultDrpcSelfTestFailed(engineIdx uint32, sockPath, reason string) *fault.Fault {
+	return serverFault(
+		code.ServerDrpcSelfTestFailed,
+		fmt.Sprintf("dRPC self-test of %s instance %d on %s failed: %s",
+			build.DataPlaneName, engineIdx, sockPath, reason),
+		"check that daos_server and daos_engine are from the same DAOS release and that the engine is not hung, then restart daos_server",
+	)
+}
+
 func FaultWrongSystem(reqName, sysName string) *fault.Fault {
 	return serverFault(
 		code.ServerWrongSystem,
@@ -169,6 +223,26 @@ func FaultIncompatibleComponents(self, other *build.VersionedComponent) *fault.F
 	)
 }
 
+// FaultPreflightFailed creates a fault for the case where the host fails one
+// or more of the checks run before the engines are started.
+func FaultPreflightFailed(failed PreflightResults) *fault.Fault {
+	details := make([]string, 0, len(failed))
+	remediations := make([]string, 0, len(failed))
+	for _, pr := range failed {
+		details = append(details, pr.String())
+		if pr.Remediation != "" {
+			remediations = append(remediations, fmt.Sprintf("%s: %s", pr.Check, pr.Remediation))
+		}
+	}
+
+	return serverFault(
+		code.ServerPreflightFailed,
+		fmt.Sprintf("%d preflight check(s) failed: %s", len(failed), strings.Join(details, "; ")),
+		fmt.Sprintf("fix the host configuration and rerun daos_server check: %s",
+			strings.Join(remediations, "; ")),
+	)
+}
+
 func serverFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "server",
@@ -19,6 +19,7 @@ import (
 	srvpb "github.com/daos-stack/daos/src/control/common/proto/srv"
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/clock"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -49,6 +50,7 @@ type Engine interface {
 	GetBioHealth(context.Context, *ctlpb.BioHealthReq) (*ctlpb.BioHealthResp, error)
 	ScanBdevTiers() ([]storage.BdevTierScanResult, error)
 	ListSmdDevices(context.Context, *ctlpb.SmdDevReq) (*ctlpb.SmdDevResp, error)
+	ListSmdPools(context.Context, *ctlpb.SmdPoolReq) (*ctlpb.SmdPoolResp, error)
 	StorageFormatSCM(context.Context, bool) *ctlpb.ScmMountResult
 	StorageFormatNVMe() commonpb.NvmeControllerResults
 
@@ -64,6 +66,7 @@ type Engine interface {
 	Run(context.Context, bool)
 	SetupRank(context.Context, ranklist.Rank) error
 	Stop(os.Signal) error
+	DumpStacks(context.Context, bool) (*stackDump, error)
 	OnInstanceExit(...onInstanceExitFn)
 	OnReady(...onReadyFn)
 	GetStorage() *storage.Provider
@@ -77,6 +80,7 @@ type EngineHarness struct {
 	started       atm.Bool
 	faultDomain   *system.FaultDomain
 	onDrpcFailure []func(context.Context, error)
+	clock         clock.Clock
 }
 
 // NewEngineHarness returns an initialized *EngineHarness.
@@ -84,9 +88,16 @@ func NewEngineHarness(log logging.Logger) *EngineHarness {
 	return &EngineHarness{
 		log:       log,
 		instances: make([]Engine, 0),
+		clock:     clock.New(),
 	}
 }
 
+// WithClock sets the clock used by the EngineHarness for periodic tasks.
+func (h *EngineHarness) WithClock(c clock.Clock) *EngineHarness {
+	h.clock = c
+	return h
+}
+
 // WithFaultDomain adds a fault domain to the EngineHarness.
 func (h *EngineHarness) WithFaultDomain(fd *system.FaultDomain) *EngineHarness {
 	h.faultDomain = fd
@@ -78,10 +78,12 @@ func TestServer_Harness_Start(t *testing.T) {
 			expStartCount: maxEngines,
 			expDrpcCalls: map[uint32][]drpc.Method{
 				0: {
+					drpc.MethodPingRank,
 					drpc.MethodSetRank,
 					drpc.MethodSetUp,
 				},
 				1: {
+					drpc.MethodPingRank,
 					drpc.MethodSetRank,
 					drpc.MethodSetUp,
 				},
@@ -108,10 +110,12 @@ func TestServer_Harness_Start(t *testing.T) {
 			expStartCount:    maxEngines,
 			expDrpcCalls: map[uint32][]drpc.Method{
 				0: {
+					drpc.MethodPingRank,
 					drpc.MethodSetRank,
 					drpc.MethodSetUp,
 				},
 				1: {
+					drpc.MethodPingRank,
 					drpc.MethodSetRank,
 					drpc.MethodSetUp,
 				},
@@ -171,10 +175,12 @@ func TestServer_Harness_Start(t *testing.T) {
 			expStartCount: maxEngines,
 			expDrpcCalls: map[uint32][]drpc.Method{
 				0: {
+					drpc.MethodPingRank,
 					drpc.MethodSetRank,
 					drpc.MethodSetUp,
 				},
 				1: {
+					drpc.MethodPingRank,
 					drpc.MethodSetRank,
 					drpc.MethodSetUp,
 				},
@@ -235,7 +241,17 @@ func TestServer_Harness_Start(t *testing.T) {
 				}
 				runner := engine.NewTestRunner(tc.trc, engineCfg)
 
-				msc := &sysprov.MockSysConfig{IsMountedBool: true}
+				scmMount := engineCfg.Storage.Tiers[0].Scm.MountPoint
+				msc := &sysprov.MockSysConfig{
+					IsMountedBool: true,
+					MountInfo: map[string]*sysprov.MountInfo{
+						scmMount: {
+							MajorMinor: fmt.Sprintf("0:%d", i),
+							MountPoint: scmMount,
+							FsType:     sysprov.FsTypeTmpfs,
+						},
+					},
+				}
 				sysp := sysprov.NewMockSysProvider(log, msc)
 				provider := storage.MockProvider(
 					log, 0, &engineCfg.Storage,
@@ -272,9 +288,14 @@ func TestServer_Harness_Start(t *testing.T) {
 				} else if isAP { // bootstrap will assume rank 0
 					rank = new(ranklist.Rank)
 				}
-				ei.setSuperblock(&Superblock{
+				sb := &Superblock{
 					UUID: uuid, Rank: rank, ValidRank: isValid,
-				})
+					System: ei.systemName(),
+				}
+				ei.setSuperblock(sb)
+				if err := WriteSuperblock(ei.superblockPath(), sb); err != nil {
+					t.Fatal(err)
+				}
 
 				if err := harness.AddInstance(ei); err != nil {
 					t.Fatal(err)
@@ -0,0 +1,258 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/peer"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	// heartbeatInterval is the period between harness heartbeats to the MS.
+	heartbeatInterval = 5 * time.Second
+	// heartbeatExpiry is how long the MS waits for a heartbeat reporting an
+	// engine alive before marking the engine's rank unresponsive.
+	heartbeatExpiry = 3 * heartbeatInterval
+)
+
+type heartbeatFn func(context.Context, *control.SystemHeartbeatReq) error
+
+// engineHeartbeats returns the liveness of harness engines that have been
+// assigned a rank.
+func (h *EngineHarness) engineHeartbeats() []*control.EngineHeartbeat {
+	var hbs []*control.EngineHeartbeat
+	for _, ei := range h.Instances() {
+		rank, err := ei.GetRank()
+		if err != nil {
+			continue // not yet joined
+		}
+
+		hbs = append(hbs, &control.EngineHeartbeat{
+			Rank:        rank,
+			InstanceIdx: ei.Index(),
+			Alive:       ei.IsStarted(),
+			State:       ei.LocalState(),
+		})
+	}
+
+	return hbs
+}
+
+// runHeartbeatLoop periodically reports the liveness of ranked engines to the
+// MS leader until the context is canceled.
+func (h *EngineHarness) runHeartbeatLoop(ctx context.Context, ctlAddr *net.TCPAddr, interval time.Duration, send heartbeatFn) {
+	ticker := h.clock.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.log.Debug("stopped heartbeat loop")
+			return
+		case <-ticker.C():
+			engines := h.engineHeartbeats()
+			if len(engines) == 0 {
+				continue
+			}
+
+			req := &control.SystemHeartbeatReq{
+				ControlAddr: ctlAddr,
+				Engines:     engines,
+			}
+			req.SetTimeout(interval)
+			if err := send(ctx, req); err != nil {
+				h.log.Debugf("heartbeat to MS failed: %s", err)
+			}
+		}
+	}
+}
+
+type (
+	// engineLiveness records when a rank was last reported alive.
+	engineLiveness struct {
+		rank      ranklist.Rank
+		idx       uint32
+		host      string
+		lastAlive time.Time
+		expired   bool
+	}
+
+	// heartbeatTracker holds the liveness of ranks reported by harness
+	// heartbeats received by the MS leader.
+	heartbeatTracker struct {
+		sync.Mutex
+		engines map[ranklist.Rank]*engineLiveness
+	}
+)
+
+func newHeartbeatTracker() *heartbeatTracker {
+	return &heartbeatTracker{
+		engines: make(map[ranklist.Rank]*engineLiveness),
+	}
+}
+
+// reset forgets all tracked ranks.
+func (ht *heartbeatTracker) reset() {
+	ht.Lock()
+	defer ht.Unlock()
+
+	ht.engines = make(map[ranklist.Rank]*engineLiveness)
+}
+
+// update records a heartbeat for a rank. The expiry clock for a rank starts
+// when it is first reported, whether or not it is alive.
+func (ht *heartbeatTracker) update(rank ranklist.Rank, idx uint32, host string, alive bool, now time.Time) {
+	ht.Lock()
+	defer ht.Unlock()
+
+	el, found := ht.engines[rank]
+	if !found {
+		el = &engineLiveness{rank: rank, lastAlive: now}
+		ht.engines[rank] = el
+	}
+	el.idx = idx
+	el.host = host
+	if alive {
+		el.lastAlive = now
+		el.expired = false
+	}
+}
+
+// expire returns copies of the ranks that have not been reported alive within
+// the expiry period. Each rank is returned once per expiry.
+func (ht *heartbeatTracker) expire(now time.Time, expiry time.Duration) []engineLiveness {
+	ht.Lock()
+	defer ht.Unlock()
+
+	var expired []engineLiveness
+	for _, el := range ht.engines {
+		if el.expired || now.Sub(el.lastAlive) < expiry {
+			continue
+		}
+		el.expired = true
+		expired = append(expired, *el)
+	}
+
+	return expired
+}
+
+// heartbeatSenderAddr returns the control address of the harness that sent a
+// heartbeat, using the IP address of the connected peer rather than the one
+// reported in the request.
+func heartbeatSenderAddr(ctx context.Context, reqAddr string) (*net.TCPAddr, error) {
+	_, portStr, err := net.SplitHostPort(reqAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "get control port")
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("peer details not found in context")
+	}
+	tcpAddr, ok := p.Addr.(*net.TCPAddr)
+	if !ok {
+		return nil, errors.Errorf("peer address (%s) not tcp", p.Addr)
+	}
+
+	return net.ResolveTCPAddr("tcp", net.JoinHostPort(tcpAddr.IP.String(), portStr))
+}
+
+// Heartbeat records the liveness of engines reported by a harness. Ranks that
+// stop being reported alive are marked unresponsive when their heartbeats
+// expire, and unresponsive ranks reported ready again are marked joined. Only
+// ranks whose member control address matches the sender are updated.
+func (svc *mgmtSvc) Heartbeat(ctx context.Context, req *mgmtpb.HeartbeatReq) (*mgmtpb.HeartbeatResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	sender, err := heartbeatSenderAddr(ctx, req.GetAddr())
+	if err != nil {
+		return nil, errors.Wrapf(err, "heartbeat from %q", req.GetAddr())
+	}
+	host := sender.IP.String()
+
+	now := svc.clock.Now()
+	for _, eh := range req.GetEngines() {
+		rank := ranklist.Rank(eh.GetRank())
+
+		member, err := svc.membership.Get(rank)
+		if err != nil {
+			svc.log.Debugf("heartbeat from %s for rank %d: %s", sender, rank, err)
+			continue
+		}
+		if !common.CmpTCPAddr(member.Addr, sender) {
+			svc.log.Errorf("heartbeat from %s for rank %d ignored: rank control address is %s",
+				sender, rank, member.Addr)
+			continue
+		}
+
+		svc.heartbeats.update(rank, eh.GetIdx(), host, eh.GetAlive(), now)
+
+		if !eh.GetAlive() || eh.GetState() != system.MemberStateReady.String() {
+			continue
+		}
+		if _, err := svc.membership.MarkRankResponsive(rank); err != nil {
+			svc.log.Debugf("heartbeat from %s for rank %d: %s", sender, rank, err)
+		}
+	}
+
+	return new(mgmtpb.HeartbeatResp), nil
+}
+
+// checkHeartbeats raises an engine_unresponsive event for each joined rank
+// whose heartbeats have expired.
+func (svc *mgmtSvc) checkHeartbeats(now time.Time, expiry time.Duration) {
+	for _, el := range svc.heartbeats.expire(now, expiry) {
+		member, err := svc.membership.Get(el.rank)
+		if err != nil {
+			svc.log.Debugf("heartbeat expired for rank %d: %s", el.rank, err)
+			continue
+		}
+		if member.State != system.MemberStateJoined {
+			continue
+		}
+
+		svc.events.Publish(events.NewEngineUnresponsiveEvent(el.host, el.idx,
+			el.rank.Uint32(), member.Incarnation, now.Sub(el.lastAlive)))
+	}
+}
+
+// startHeartbeatMonitor starts checking for expired heartbeats on the MS
+// leader. Ranks are only tracked once heartbeats for them have been received
+// by the current leader.
+func (svc *mgmtSvc) startHeartbeatMonitor(ctx context.Context) {
+	svc.log.Debug("starting heartbeat monitor")
+	svc.heartbeats.reset()
+
+	go func() {
+		ticker := svc.clock.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				svc.log.Debug("stopped heartbeat monitor")
+				return
+			case now := <-ticker.C():
+				svc.checkHeartbeats(now, heartbeatExpiry)
+			}
+		}
+	}()
+}
@@ -0,0 +1,391 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/peer"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/clock"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_Harness_engineHeartbeats(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	h := NewEngineHarness(log)
+
+	ready := &MockInstanceConfig{
+		GetRankResp: 2,
+		Index:       0,
+		LocalState:  system.MemberStateReady,
+	}
+	ready.Started.SetTrue()
+	stopped := &MockInstanceConfig{
+		GetRankResp: 5,
+		Index:       1,
+		LocalState:  system.MemberStateStopped,
+	}
+	unranked := &MockInstanceConfig{
+		GetRankErr: errors.New("nil superblock"),
+		Index:      2,
+		LocalState: system.MemberStateAwaitFormat,
+	}
+	for _, cfg := range []*MockInstanceConfig{ready, stopped, unranked} {
+		if err := h.AddInstance(NewMockInstance(cfg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expHeartbeats := []*control.EngineHeartbeat{
+		{Rank: 2, InstanceIdx: 0, Alive: true, State: system.MemberStateReady},
+		{Rank: 5, InstanceIdx: 1, State: system.MemberStateStopped},
+	}
+	if diff := cmp.Diff(expHeartbeats, h.engineHeartbeats()); diff != "" {
+		t.Fatalf("unexpected heartbeats (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestServer_Harness_runHeartbeatLoop(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mc := clock.NewMock(time.Now())
+	h := NewEngineHarness(log).WithClock(mc)
+	if err := h.AddInstance(NewMockInstance(&MockInstanceConfig{GetRankResp: 1})); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ctlAddr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: build.DefaultControlPort}
+	sent := make(chan *control.SystemHeartbeatReq, 1)
+	go h.runHeartbeatLoop(ctx, ctlAddr, heartbeatInterval,
+		func(_ context.Context, req *control.SystemHeartbeatReq) error {
+			select {
+			case sent <- req:
+			default:
+			}
+			return errors.New("not leader")
+		})
+
+	// Nothing is sent until the first interval has elapsed.
+	mc.BlockUntil(1)
+	select {
+	case <-sent:
+		t.Fatal("heartbeat sent before interval elapsed")
+	default:
+	}
+	mc.Advance(heartbeatInterval)
+
+	select {
+	case req := <-sent:
+		test.AssertEqual(t, ctlAddr, req.ControlAddr, "unexpected control address")
+		test.AssertEqual(t, 1, len(req.Engines), "unexpected number of engines")
+	case <-ctx.Done():
+		t.Fatal("no heartbeat sent")
+	}
+}
+
+func TestServer_heartbeatTracker(t *testing.T) {
+	start := time.Now()
+	expiry := 15 * time.Second
+	ht := newHeartbeatTracker()
+
+	ht.update(1, 0, "host1", true, start)
+	ht.update(2, 1, "host1", false, start)
+
+	if expired := ht.expire(start.Add(expiry-time.Second), expiry); len(expired) != 0 {
+		t.Fatalf("unexpected expired ranks: %+v", expired)
+	}
+
+	// Rank 1 stays alive, rank 2 has never been reported alive.
+	ht.update(1, 0, "host1", true, start.Add(expiry-time.Second))
+	expired := ht.expire(start.Add(expiry), expiry)
+	if len(expired) != 1 || expired[0].rank != 2 {
+		t.Fatalf("expected only rank 2 to expire, got %+v", expired)
+	}
+
+	// Expiry is only reported once until the rank is alive again.
+	if expired := ht.expire(start.Add(2*expiry), expiry); len(expired) != 1 || expired[0].rank != 1 {
+		t.Fatalf("expected only rank 1 to expire, got %+v", expired)
+	}
+	ht.update(2, 1, "host1", true, start.Add(2*expiry))
+	if expired := ht.expire(start.Add(3*expiry), expiry); len(expired) != 1 || expired[0].rank != 2 {
+		t.Fatalf("expected rank 2 to expire again, got %+v", expired)
+	}
+
+	ht.reset()
+	if expired := ht.expire(start.Add(10*expiry), expiry); len(expired) != 0 {
+		t.Fatalf("unexpected expired ranks after reset: %+v", expired)
+	}
+}
+
+func TestServer_MgmtSvc_Heartbeat(t *testing.T) {
+	readyReq := &mgmtpb.HeartbeatReq{
+		Sys:  build.DefaultSystemName,
+		Addr: "127.0.0.1:10001",
+		Engines: []*mgmtpb.EngineHeartbeat{
+			{Rank: 1, Alive: true, State: system.MemberStateReady.String()},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		nonLeader bool
+		noPeer    bool
+		peerAddr  net.Addr
+		req       *mgmtpb.HeartbeatReq
+		expState  system.MemberState
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"not leader": {
+			nonLeader: true,
+			req:       &mgmtpb.HeartbeatReq{Sys: build.DefaultSystemName},
+			expErr:    errors.New("replica"),
+		},
+		"unresponsive rank reported ready": {
+			req: &mgmtpb.HeartbeatReq{
+				Sys:  build.DefaultSystemName,
+				Addr: "127.0.0.1:10001",
+				Engines: []*mgmtpb.EngineHeartbeat{
+					{Rank: 1, Alive: true, State: system.MemberStateReady.String()},
+					{Rank: 42, Alive: true, State: system.MemberStateReady.String()},
+				},
+			},
+			expState: system.MemberStateJoined,
+		},
+		"unresponsive rank reported starting": {
+			req: &mgmtpb.HeartbeatReq{
+				Sys:  build.DefaultSystemName,
+				Addr: "127.0.0.1:10001",
+				Engines: []*mgmtpb.EngineHeartbeat{
+					{Rank: 1, Alive: true, State: system.MemberStateStarting.String()},
+				},
+			},
+			expState: system.MemberStateUnresponsive,
+		},
+		"missing peer details": {
+			noPeer: true,
+			req:    readyReq,
+			expErr: errors.New("peer details not found"),
+		},
+		"invalid request address": {
+			req: &mgmtpb.HeartbeatReq{
+				Sys:     build.DefaultSystemName,
+				Addr:    "127.0.0.1",
+				Engines: readyReq.Engines,
+			},
+			expErr: errors.New("control port"),
+		},
+		"sender is not the rank's host": {
+			peerAddr: system.MockControlAddr(t, 2),
+			req:      readyReq,
+			expState: system.MemberStateUnresponsive,
+		},
+		"sender on a different control port": {
+			req: &mgmtpb.HeartbeatReq{
+				Sys:     build.DefaultSystemName,
+				Addr:    "127.0.0.1:10002",
+				Engines: readyReq.Engines,
+			},
+			expState: system.MemberStateUnresponsive,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.nonLeader {
+				svc = newTestMgmtSvcNonReplica(t, log)
+			}
+			if _, err := svc.membership.Add(system.MockMember(t, 1, system.MemberStateUnresponsive)); err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := context.TODO()
+			if !tc.noPeer {
+				if tc.peerAddr == nil {
+					tc.peerAddr = system.MockControlAddr(t, 1)
+				}
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: tc.peerAddr})
+			}
+
+			_, err := svc.Heartbeat(ctx, tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			m, err := svc.membership.Get(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expState, m.State, "unexpected member state")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_checkHeartbeats(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	svc := newTestMgmtSvc(t, log)
+	svc.events = events.NewPubSub(ctx, log)
+	defer svc.events.Close()
+
+	members := system.Members{
+		system.MockMember(t, 1, system.MemberStateJoined),
+		system.MockMember(t, 2, system.MemberStateStopped),
+	}
+	for _, m := range members {
+		if _, err := svc.membership.Add(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	published := make(chan *events.RASEvent, 2)
+	svc.events.Subscribe(events.RASTypeStateChange, svc.membership)
+	svc.events.Subscribe(events.RASTypeStateChange,
+		events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+			published <- evt
+		}))
+
+	req := &mgmtpb.HeartbeatReq{
+		Sys:  build.DefaultSystemName,
+		Addr: "127.0.0.1:10001",
+		Engines: []*mgmtpb.EngineHeartbeat{
+			{Rank: 1, Idx: 0, State: system.MemberStateStopped.String()},
+			{Rank: 2, Idx: 1, State: system.MemberStateStopped.String()},
+		},
+	}
+	peerCtx := peer.NewContext(ctx, &peer.Peer{Addr: system.MockControlAddr(t, 1)})
+	if _, err := svc.Heartbeat(peerCtx, req); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the joined member should be reported as unresponsive.
+	svc.checkHeartbeats(time.Now().Add(heartbeatExpiry), heartbeatExpiry)
+
+	select {
+	case evt := <-published:
+		test.AssertEqual(t, events.RASEngineUnresponsive, evt.ID, "unexpected event ID")
+		test.AssertEqual(t, uint32(1), evt.Rank, "unexpected event rank")
+		test.AssertEqual(t, "127.0.0.1", evt.Hostname, "unexpected event hostname")
+	case <-ctx.Done():
+		t.Fatal("no event published")
+	}
+
+	for {
+		m, err := svc.membership.Get(ranklist.Rank(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.State == system.MemberStateUnresponsive {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("rank 1 not marked unresponsive (state %s)", m.State)
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	select {
+	case evt := <-published:
+		t.Fatalf("unexpected event published: %+v", evt)
+	default:
+	}
+}
+
+func TestServer_MgmtSvc_startHeartbeatMonitor(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	mc := clock.NewMock(start)
+	svc := newTestMgmtSvc(t, log)
+	svc.clock = mc
+	svc.events = events.NewPubSub(ctx, log)
+	defer svc.events.Close()
+
+	if _, err := svc.membership.Add(system.MockMember(t, 1, system.MemberStateJoined)); err != nil {
+		t.Fatal(err)
+	}
+
+	published := make(chan *events.RASEvent, 1)
+	svc.events.Subscribe(events.RASTypeStateChange,
+		events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+			select {
+			case published <- evt:
+			default:
+			}
+		}))
+
+	monCtx, monCancel := context.WithCancel(ctx)
+	defer monCancel()
+	svc.startHeartbeatMonitor(monCtx)
+	mc.BlockUntil(1)
+
+	req := &mgmtpb.HeartbeatReq{
+		Sys:     build.DefaultSystemName,
+		Addr:    "127.0.0.1:10001",
+		Engines: []*mgmtpb.EngineHeartbeat{{Rank: 1, Idx: 0}},
+	}
+	peerCtx := peer.NewContext(ctx, &peer.Peer{Addr: system.MockControlAddr(t, 1)})
+	if _, err := svc.Heartbeat(peerCtx, req); err != nil {
+		t.Fatal(err)
+	}
+
+	// The rank must not expire before the expiry period has elapsed.
+	mc.Advance(heartbeatExpiry - heartbeatInterval)
+	select {
+	case evt := <-published:
+		t.Fatalf("unexpected event before expiry: %+v", evt)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	for {
+		mc.Advance(heartbeatInterval)
+		select {
+		case evt := <-published:
+			test.AssertEqual(t, events.RASEngineUnresponsive, evt.ID, "unexpected event ID")
+			test.AssertEqual(t, uint32(1), evt.Rank, "unexpected event rank")
+			if mc.Since(start) < heartbeatExpiry {
+				t.Fatalf("rank expired after %s", mc.Since(start))
+			}
+			return
+		case <-ctx.Done():
+			t.Fatal("no event published")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
@@ -79,6 +79,15 @@ if [[ $1 == reset ]]; then
 	PATH=/sbin:${PATH}			\
 	${scriptpath} reset
 	set +x
+
+	if [[ ${_CLEAR_HUGE} == yes ]]; then
+		echo "RUN: release hugepages"
+		for nr in /sys/devices/system/node/node*/hugepages/hugepages-*/nr_hugepages; do
+			if [ -f "$nr" ]; then
+				echo 0 > "$nr"
+			fi
+		done
+	fi
 else
 	set -x
 	PCI_ALLOWED=${_PCI_ALLOWED}		\
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2019-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -19,6 +20,7 @@ import (
 	srvpb "github.com/daos-stack/daos/src/control/common/proto/srv"
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/clock"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -27,11 +29,13 @@ import (
 )
 
 type (
-	systemJoinFn     func(context.Context, *control.SystemJoinReq) (*control.SystemJoinResp, error)
-	onAwaitFormatFn  func(context.Context, uint32, string) error
-	onStorageReadyFn func(context.Context) error
-	onReadyFn        func(context.Context) error
-	onInstanceExitFn func(context.Context, uint32, ranklist.Rank, error, int) error
+	systemJoinFn          func(context.Context, *control.SystemJoinReq) (*control.SystemJoinResp, error)
+	onAwaitFormatFn       func(context.Context, uint32, string) error
+	onStorageReadyFn      func(context.Context) error
+	onReadyFn             func(context.Context) error
+	onJoinedFn            func(*control.SystemJoinResp)
+	onInstanceExitFn      func(context.Context, uint32, ranklist.Rank, error, int) error
+	onMetadataCorruptedFn func(uint32, ranklist.Rank, string, bool)
 )
 
 // EngineInstance encapsulates control-plane specific configuration
@@ -42,22 +46,31 @@ type (
 // be used with EngineHarness to manage and monitor multiple instances
 // per node.
 type EngineInstance struct {
-	log             logging.Logger
-	runner          EngineRunner
-	storage         *storage.Provider
-	waitFormat      atm.Bool
-	storageReady    chan bool
-	waitDrpc        atm.Bool
-	drpcReady       chan *srvpb.NotifyReadyReq
-	ready           atm.Bool
-	startRequested  chan bool
-	fsRoot          string
-	hostFaultDomain *system.FaultDomain
-	joinSystem      systemJoinFn
-	onAwaitFormat   []onAwaitFormatFn
-	onStorageReady  []onStorageReadyFn
-	onReady         []onReadyFn
-	onInstanceExit  []onInstanceExitFn
+	log                 logging.Logger
+	runner              EngineRunner
+	storage             *storage.Provider
+	waitFormat          atm.Bool
+	storageReady        chan bool
+	waitDrpc            atm.Bool
+	drpcReady           chan *srvpb.NotifyReadyReq
+	ready               atm.Bool
+	startRequested      chan bool
+	fsRoot              string
+	hostFaultDomain     *system.FaultDomain
+	peerScmMounts       []string
+	warmRestart         bool
+	reattached          atm.Bool
+	joinSystem          systemJoinFn
+	joinMaxWait         time.Duration
+	clock               clock.Clock
+	onAwaitFormat       []onAwaitFormatFn
+	onStorageReady      []onStorageReadyFn
+	onReady             []onReadyFn
+	onJoined            []onJoinedFn
+	onInstanceExit      []onInstanceExitFn
+	onMetadataCorrupted []onMetadataCorruptedFn
+	superblockMu        sync.Mutex       // serializes superblock storage access
+	storageLock         *storage.DirLock // held on instance storage from first use until shutdown
 
 	sync.RWMutex
 	// these must be protected by a mutex in order to
@@ -79,6 +92,7 @@ func NewEngineInstance(l logging.Logger, p *storage.Provider, jf systemJoinFn, r
 		drpcReady:      make(chan *srvpb.NotifyReadyReq),
 		storageReady:   make(chan bool),
 		startRequested: make(chan bool),
+		clock:          clock.New(),
 	}
 }
 
@@ -89,6 +103,28 @@ func (ei *EngineInstance) WithHostFaultDomain(fd *system.FaultDomain) *EngineIns
 	return ei
 }
 
+// WithPeerScmMounts adds the SCM mountpoints of the other engines managed on
+// this host, used to check that storage is not shared between engines.
+func (ei *EngineInstance) WithPeerScmMounts(mounts ...string) *EngineInstance {
+	ei.peerScmMounts = mounts
+	return ei
+}
+
+// WithWarmRestart enables reattaching to an engine process left running by a
+// previous control plane instance rather than starting a new one.
+func (ei *EngineInstance) WithWarmRestart(enabled bool) *EngineInstance {
+	ei.warmRestart = enabled
+	return ei
+}
+
+// WithJoinMaxWait sets the maximum amount of time to keep retrying a system
+// join while the management service is unavailable. A zero value selects the
+// default.
+func (ei *EngineInstance) WithJoinMaxWait(d time.Duration) *EngineInstance {
+	ei.joinMaxWait = d
+	return ei
+}
+
 // isAwaitingFormat indicates whether EngineInstance is waiting
 // for an administrator action to trigger a format.
 func (ei *EngineInstance) isAwaitingFormat() bool {
@@ -126,12 +162,37 @@ func (ei *EngineInstance) OnReady(fns ...onReadyFn) {
 	ei.onReady = append(ei.onReady, fns...)
 }
 
+// OnJoined adds a list of callbacks to invoke when the instance has
+// successfully joined the system.
+func (ei *EngineInstance) OnJoined(fns ...onJoinedFn) {
+	ei.onJoined = append(ei.onJoined, fns...)
+}
+
 // OnInstanceExit adds a list of callbacks to invoke when the instance
 // runner (process) terminates.
 func (ei *EngineInstance) OnInstanceExit(fns ...onInstanceExitFn) {
 	ei.onInstanceExit = append(ei.onInstanceExit, fns...)
 }
 
+// OnMetadataCorrupted adds a list of callbacks to invoke when a file persisted
+// for the instance fails an integrity check.
+func (ei *EngineInstance) OnMetadataCorrupted(fns ...onMetadataCorruptedFn) {
+	ei.onMetadataCorrupted = append(ei.onMetadataCorrupted, fns...)
+}
+
+// reportMetadataCorrupted invokes the callbacks registered to be notified of
+// files that fail an integrity check.
+func (ei *EngineInstance) reportMetadataCorrupted(path string, regenerated bool) {
+	rank, err := ei.GetRank()
+	if err != nil {
+		ei.log.Debugf("instance %d: no rank (%s)", ei.Index(), err)
+	}
+
+	for _, fn := range ei.onMetadataCorrupted {
+		fn(ei.Index(), rank, path, regenerated)
+	}
+}
+
 // LocalState returns local perspective of the current instance state
 // (doesn't consider state info held by the global system membership).
 func (ei *EngineInstance) LocalState() system.MemberState {
@@ -189,14 +250,15 @@ func (ei *EngineInstance) determineRank(ctx context.Context, ready *srvpb.Notify
 		r = *superblock.Rank
 	}
 
-	resp, err := ei.joinSystem(ctx, &control.SystemJoinReq{
-		UUID:        superblock.UUID,
-		Rank:        r,
-		URI:         ready.GetUri(),
-		NumContexts: ready.GetNctxs(),
-		FaultDomain: ei.hostFaultDomain,
-		InstanceIdx: ei.Index(),
-		Incarnation: ready.GetIncarnation(),
+	resp, err := ei.joinSystemWithRetry(ctx, &control.SystemJoinReq{
+		UUID:          superblock.UUID,
+		Rank:          r,
+		URI:           ready.GetUri(),
+		NumContexts:   ready.GetNctxs(),
+		FaultDomain:   ei.hostFaultDomain,
+		InstanceIdx:   ei.Index(),
+		Incarnation:   ready.GetIncarnation(),
+		SecondaryURIs: ready.GetSecondaryUris(),
 	})
 	if err != nil {
 		ei.log.Errorf("join failed: %s", err)
@@ -208,6 +270,10 @@ func (ei *EngineInstance) determineRank(ctx context.Context, ready *srvpb.Notify
 	}
 	r = ranklist.Rank(resp.Rank)
 
+	for _, fn := range ei.onJoined {
+		fn(resp)
+	}
+
 	// TODO: Check to see if ready.Uri != superblock.URI, which might
 	// need to trigger some kind of update?
 
@@ -274,6 +340,12 @@ func (ei *EngineInstance) handleReady(ctx context.Context, ready *srvpb.NotifyRe
 }
 
 func (ei *EngineInstance) SetupRank(ctx context.Context, rank ranklist.Rank) error {
+	// A reattached engine already has its rank set and its modules set up.
+	if ei.reattached.IsTrue() {
+		ei.ready.SetTrue()
+		return nil
+	}
+
 	if err := ei.callSetRank(ctx, rank); err != nil {
 		return errors.Wrap(err, "SetRank failed")
 	}
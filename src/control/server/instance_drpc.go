@@ -55,6 +55,12 @@ func (ei *EngineInstance) NotifyDrpcReady(msg *srvpb.NotifyReadyReq) {
 	// activate the dRPC client connection to this engine
 	ei.setDrpcClient(drpc.NewClientConnection(msg.DrpcListenerSock))
 
+	if ei.warmRestart {
+		if err := ei.saveReadyState(msg); err != nil {
+			ei.log.Errorf("instance %d: %s", ei.Index(), err)
+		}
+	}
+
 	go func() {
 		ei.drpcReady <- msg
 	}()
@@ -67,6 +73,107 @@ func (ei *EngineInstance) awaitDrpcReady() chan *srvpb.NotifyReadyReq {
 	return ei.drpcReady
 }
 
+const (
+	// drpcSelfTestTimeout is the time allowed for the dRPC round trip made
+	// to an engine when it first reports that it is ready.
+	drpcSelfTestTimeout = 10 * time.Second
+	// drpcSelfTestSlowThreshold is the round trip latency above which a
+	// warning is logged for the dRPC self-test.
+	drpcSelfTestSlowThreshold = time.Second
+)
+
+// drpcSelfTest makes a no-op dRPC round trip to a newly ready engine in order
+// to verify that the channel works and that the engine can decode requests
+// from, and produce responses for, this control plane. It is run before any
+// setup calls are made so that a broken channel is reported with its cause
+// rather than surfacing later as a timeout.
+func (ei *EngineInstance) drpcSelfTest(ctx context.Context) error {
+	dc, err := ei.getDrpcClient()
+	if err != nil {
+		return err
+	}
+	idx := ei.Index()
+	sockPath := dc.GetSocketPath()
+	method := drpc.MethodPingRank
+
+	type selfTestResult struct {
+		resp *drpc.Response
+		err  error
+	}
+	resChan := make(chan selfTestResult, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, drpcSelfTestTimeout)
+	defer cancel()
+
+	startedAt := time.Now()
+	go func() {
+		dc.Lock()
+		defer dc.Unlock()
+
+		call, err := newDrpcCall(method, &mgmtpb.PingRankReq{Rank: uint32(ranklist.NilRank)})
+		if err != nil {
+			resChan <- selfTestResult{err: errors.Wrapf(err, "build %s request", method)}
+			return
+		}
+		if err := dc.Connect(); err != nil {
+			resChan <- selfTestResult{err: errors.Wrap(err, "connect")}
+			return
+		}
+		defer dc.Close()
+
+		resp, err := dc.SendMsg(call)
+		resChan <- selfTestResult{resp: resp, err: errors.Wrapf(err, "send %s request", method)}
+	}()
+
+	var res selfTestResult
+	select {
+	case <-ctx.Done():
+		if ctx.Err() != context.DeadlineExceeded {
+			return ctx.Err()
+		}
+		return FaultDrpcSelfTestFailed(idx, sockPath,
+			fmt.Sprintf("no %s response after %s", method, time.Since(startedAt).Round(time.Millisecond)))
+	case res = <-resChan:
+	}
+	elapsed := time.Since(startedAt)
+
+	if res.err != nil {
+		return FaultDrpcSelfTestFailed(idx, sockPath, res.err.Error())
+	}
+	if res.resp == nil {
+		return FaultDrpcSelfTestFailed(idx, sockPath, fmt.Sprintf("empty %s response", method))
+	}
+
+	switch res.resp.Status {
+	case drpc.Status_SUCCESS:
+	case drpc.Status_UNKNOWN_MODULE, drpc.Status_UNKNOWN_METHOD,
+		drpc.Status_FAILED_UNMARSHAL_CALL, drpc.Status_FAILED_UNMARSHAL_PAYLOAD:
+		return FaultDrpcSelfTestFailed(idx, sockPath,
+			fmt.Sprintf("engine could not decode %s request (%s)", method, res.resp.Status))
+	default:
+		return FaultDrpcSelfTestFailed(idx, sockPath,
+			fmt.Sprintf("bad %s response status %s", method, res.resp.Status))
+	}
+
+	resp := new(mgmtpb.DaosResp)
+	if err := proto.Unmarshal(res.resp.Body, resp); err != nil {
+		return FaultDrpcSelfTestFailed(idx, sockPath,
+			fmt.Sprintf("unable to decode %s response: %s", method, err))
+	}
+	if resp.Status != 0 {
+		return FaultDrpcSelfTestFailed(idx, sockPath,
+			fmt.Sprintf("%s returned %s", method, daos.Status(resp.Status)))
+	}
+
+	if elapsed > drpcSelfTestSlowThreshold {
+		ei.log.Noticef("instance %d: slow dRPC self-test round trip on %s: %s", idx, sockPath, elapsed)
+		return nil
+	}
+	ei.log.Debugf("instance %d: dRPC self-test round trip on %s: %s", idx, sockPath, elapsed)
+
+	return nil
+}
+
 // CallDrpc makes the supplied dRPC call via this instance's dRPC client.
 func (ei *EngineInstance) CallDrpc(ctx context.Context, method drpc.Method, body proto.Message) (*drpc.Response, error) {
 	dc, err := ei.getDrpcClient()
@@ -84,6 +191,10 @@ func (ei *EngineInstance) CallDrpc(ctx context.Context, method drpc.Method, body
 		ei.log.Debugf("dRPC to index %d%s: %s/%dB/%s", ei.Index(), rankMsg, method, proto.Size(body), time.Since(startedAt))
 	}()
 
+	if err := injectDrpcDelay(ctx); err != nil {
+		return nil, err
+	}
+
 	return makeDrpcCall(ctx, ei.log, dc, method, body)
 }
 
@@ -201,6 +312,24 @@ func (ei *EngineInstance) ListSmdDevices(ctx context.Context, req *ctlpb.SmdDevR
 	return resp, nil
 }
 
+func (ei *EngineInstance) ListSmdPools(ctx context.Context, req *ctlpb.SmdPoolReq) (*ctlpb.SmdPoolResp, error) {
+	dresp, err := ei.CallDrpc(ctx, drpc.MethodSmdPools, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ctlpb.SmdPoolResp)
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal SmdListPools response")
+	}
+
+	if resp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(resp.Status), "ListSmdPools failed")
+	}
+
+	return resp, nil
+}
+
 func (ei *EngineInstance) getSmdDetails(smd *ctlpb.SmdDevice) (*storage.SmdDevice, error) {
 	smdDev := new(storage.SmdDevice)
 	if err := convert.Types(smd, smdDev); err != nil {
@@ -92,6 +92,111 @@ func TestEngineInstance_CallDrpc(t *testing.T) {
 	}
 }
 
+func TestEngineInstance_drpcSelfTest(t *testing.T) {
+	mockResp := func(t *testing.T, status drpc.Status, msg proto.Message) *drpc.Response {
+		body, err := proto.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &drpc.Response{Status: status, Body: body}
+	}
+
+	for name, tc := range map[string]struct {
+		notReady bool
+		timeout  time.Duration
+		cfg      *mockDrpcClientConfig
+		expErr   error
+	}{
+		"not ready": {
+			notReady: true,
+			expErr:   errors.New("no dRPC client set"),
+		},
+		"connect fails": {
+			cfg: &mockDrpcClientConfig{
+				ConnectError: errors.New("no such file"),
+			},
+			expErr: errors.New("instance 0 on /tmp/engine.sock failed: connect: no such file"),
+		},
+		"send fails": {
+			cfg: &mockDrpcClientConfig{
+				SendMsgError: errors.New("broken pipe"),
+			},
+			expErr: errors.New("send PingRank request: broken pipe"),
+		},
+		"no response": {
+			cfg:    &mockDrpcClientConfig{},
+			expErr: errors.New("empty PingRank response"),
+		},
+		"engine can't decode request": {
+			cfg: &mockDrpcClientConfig{
+				SendMsgResponse: &drpc.Response{Status: drpc.Status_FAILED_UNMARSHAL_PAYLOAD},
+			},
+			expErr: errors.New("engine could not decode PingRank request (FAILED_UNMARSHAL_PAYLOAD)"),
+		},
+		"engine failure": {
+			cfg: &mockDrpcClientConfig{
+				SendMsgResponse: &drpc.Response{Status: drpc.Status_FAILURE},
+			},
+			expErr: errors.New("bad PingRank response status FAILURE"),
+		},
+		"undecodable response": {
+			cfg: &mockDrpcClientConfig{
+				SendMsgResponse: &drpc.Response{Body: []byte{0xff, 0xff}},
+			},
+			expErr: errors.New("unable to decode PingRank response"),
+		},
+		"engine returns error status": {
+			cfg: &mockDrpcClientConfig{
+				SendMsgResponse: mockResp(t, drpc.Status_SUCCESS,
+					&mgmtpb.DaosResp{Status: int32(daos.MiscError)}),
+			},
+			expErr: errors.Errorf("PingRank returned %s", daos.MiscError),
+		},
+		"timeout": {
+			timeout: 10 * time.Millisecond,
+			cfg: &mockDrpcClientConfig{
+				SendMsgResponse: mockResp(t, drpc.Status_SUCCESS, &mgmtpb.DaosResp{}),
+				ResponseDelay:   200 * time.Millisecond,
+			},
+			expErr: errors.New("no PingRank response after"),
+		},
+		"success": {
+			cfg: &mockDrpcClientConfig{
+				SendMsgResponse: mockResp(t, drpc.Status_SUCCESS, &mgmtpb.DaosResp{}),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			instance := getTestEngineInstance(log)
+			var dc *mockDrpcClient
+			if !tc.notReady {
+				tc.cfg.SocketPath = "/tmp/engine.sock"
+				dc = newMockDrpcClient(tc.cfg)
+				instance.setDrpcClient(dc)
+			}
+
+			ctx := context.Background()
+			if tc.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+
+			gotErr := instance.drpcSelfTest(ctx)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, []drpc.Method{drpc.MethodPingRank}, dc.CalledMethods(),
+				"unexpected dRPC calls")
+		})
+	}
+}
+
 func TestEngineInstance_DrespToRankResult(t *testing.T) {
 	dRank := Rank(1)
 
@@ -0,0 +1,145 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// engineDumpDir is the directory in which engines write dumps of their
+// Argobots state and ULT stacks.
+var engineDumpDir = "/tmp"
+
+var (
+	// stackDumpTimeout is the time allowed for an engine to write a
+	// requested stack dump. An attended dump waits for up to 10s for the
+	// engine ULTs to yield before their stacks are written.
+	stackDumpTimeout = 15 * time.Second
+	// stackDumpPollInterval is the period at which the dump file is
+	// checked for new output.
+	stackDumpPollInterval = 250 * time.Millisecond
+)
+
+// stackDump describes the output of a single engine stack dump. Engines
+// append each dump to the same file for the lifetime of the process.
+type stackDump struct {
+	Path   string
+	Offset int64
+	Size   int64
+}
+
+// enginePid returns the PID of the running engine process, which is part of
+// the name of the dRPC socket created by the engine.
+func (ei *EngineInstance) enginePid() (int, error) {
+	dc, err := ei.getDrpcClient()
+	if err != nil {
+		return 0, err
+	}
+
+	var pid int
+	sockPath := dc.GetSocketPath()
+	if _, err := fmt.Sscanf(filepath.Base(sockPath), "daos_engine_%d.sock", &pid); err != nil {
+		return 0, errors.Errorf("unable to determine engine pid from dRPC socket %q", sockPath)
+	}
+
+	return pid, nil
+}
+
+// findStackDump returns the path and size of the most recently modified stack
+// dump file written by the engine process with the given PID. An empty path is
+// returned if the engine has not written a dump.
+func findStackDump(pid int) (string, int64, error) {
+	matches, err := filepath.Glob(filepath.Join(engineDumpDir, fmt.Sprintf("daos_dump_%d[._]*", pid)))
+	if err != nil {
+		return "", 0, err
+	}
+
+	var path string
+	var size int64
+	var modTime time.Time
+	for _, match := range matches {
+		fi, err := os.Stat(match)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", 0, err
+		}
+		if path == "" || fi.ModTime().After(modTime) {
+			path, size, modTime = match, fi.Size(), fi.ModTime()
+		}
+	}
+
+	return path, size, nil
+}
+
+// DumpStacks signals the engine to dump its Argobots state and ULT stacks and
+// waits for the dump to be written. An attended dump is made by the engine
+// ULTs as they yield, whereas an unattended dump is made from the engine main
+// thread without synchronization, which may succeed when ULTs are stuck but
+// can produce inconsistent output.
+func (ei *EngineInstance) DumpStacks(ctx context.Context, unattended bool) (*stackDump, error) {
+	if !ei.IsStarted() {
+		return nil, errors.Errorf("instance %d: engine not started", ei.Index())
+	}
+
+	pid, err := ei.enginePid()
+	if err != nil {
+		return nil, err
+	}
+
+	path, offset, err := findStackDump(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := syscall.SIGUSR2
+	if unattended {
+		sig = syscall.SIGUSR1
+	}
+	ei.log.Noticef("instance %d: requesting ULT stack dump from engine (pid %d)", ei.Index(), pid)
+	ei.runner.Signal(sig)
+
+	ctx, cancel := context.WithTimeout(ctx, stackDumpTimeout)
+	defer cancel()
+
+	lastSize := int64(-1)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "instance %d: waiting for stack dump from engine (pid %d) in %s",
+				ei.Index(), pid, engineDumpDir)
+		case <-time.After(stackDumpPollInterval):
+		}
+
+		curPath, size, err := findStackDump(pid)
+		if err != nil {
+			return nil, err
+		}
+		if curPath == "" {
+			continue
+		}
+		if curPath != path {
+			// The engine created its dump file for this dump.
+			path, offset, lastSize = curPath, 0, -1
+		}
+
+		// The dump is complete once output has been written and the
+		// file has stopped growing.
+		if size > offset && size == lastSize {
+			return &stackDump{Path: path, Offset: offset, Size: size - offset}, nil
+		}
+		lastSize = size
+	}
+}
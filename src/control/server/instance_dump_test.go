@@ -0,0 +1,156 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+func TestServer_Instance_DumpStacks(t *testing.T) {
+	const (
+		prevDump  = "=== previous dump\n"
+		testDump  = "=== Dump of ABT infos and ULTs stacks\nULT stack\n"
+		dumpFile  = "daos_dump_4242_20230601_10_00.txt"
+		otherFile = "daos_dump_42420_20230601_10_00.txt"
+	)
+
+	for name, tc := range map[string]struct {
+		notStarted bool
+		noDrpc     bool
+		sockName   string
+		prevDump   bool
+		noDump     bool
+		unattended bool
+		expSignal  os.Signal
+		expDump    *stackDump
+		expErr     error
+	}{
+		"not started": {
+			notStarted: true,
+			expErr:     errors.New("engine not started"),
+		},
+		"no dRPC client": {
+			noDrpc: true,
+			expErr: errors.New("no dRPC client set"),
+		},
+		"unexpected socket name": {
+			sockName: "engine.sock",
+			expErr:   errors.New("unable to determine engine pid"),
+		},
+		"first dump": {
+			expSignal: syscall.SIGUSR2,
+			expDump: &stackDump{
+				Offset: 0,
+				Size:   int64(len(testDump)),
+			},
+		},
+		"dump appended to previous dump": {
+			prevDump:  true,
+			expSignal: syscall.SIGUSR2,
+			expDump: &stackDump{
+				Offset: int64(len(prevDump)),
+				Size:   int64(len(testDump)),
+			},
+		},
+		"unattended dump": {
+			unattended: true,
+			expSignal:  syscall.SIGUSR1,
+			expDump: &stackDump{
+				Offset: 0,
+				Size:   int64(len(testDump)),
+			},
+		},
+		"no dump written": {
+			noDump:    true,
+			expSignal: syscall.SIGUSR2,
+			expErr:    errors.New("waiting for stack dump"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			origDir, origTimeout, origInterval := engineDumpDir, stackDumpTimeout, stackDumpPollInterval
+			engineDumpDir = testDir
+			stackDumpTimeout = 500 * time.Millisecond
+			stackDumpPollInterval = 10 * time.Millisecond
+			defer func() {
+				engineDumpDir, stackDumpTimeout, stackDumpPollInterval = origDir, origTimeout, origInterval
+			}()
+
+			dumpPath := filepath.Join(testDir, dumpFile)
+			if tc.prevDump {
+				if err := os.WriteFile(dumpPath, []byte(prevDump), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// A dump from an engine whose pid has the same prefix must be ignored.
+			if err := os.WriteFile(filepath.Join(testDir, otherFile), []byte(prevDump), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			var gotSignal os.Signal
+			trc := &engine.TestRunnerConfig{
+				SignalCb: func(_ uint32, sig os.Signal) {
+					gotSignal = sig
+					if tc.noDump {
+						return
+					}
+					f, err := os.OpenFile(dumpPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					defer f.Close()
+					if _, err := f.WriteString(testDump); err != nil {
+						t.Error(err)
+					}
+				},
+			}
+			if !tc.notStarted {
+				trc.Running.SetTrue()
+			}
+			ei := NewEngineInstance(log, nil, nil, engine.NewTestRunner(trc, engine.MockConfig()))
+
+			if !tc.noDrpc {
+				if tc.sockName == "" {
+					tc.sockName = "daos_engine_4242.sock"
+				}
+				ei.setDrpcClient(newMockDrpcClient(&mockDrpcClientConfig{
+					SocketPath: filepath.Join(testDir, tc.sockName),
+				}))
+			}
+
+			gotDump, gotErr := ei.DumpStacks(context.Background(), tc.unattended)
+			test.AssertEqual(t, tc.expSignal, gotSignal, "unexpected signal")
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			tc.expDump.Path = dumpPath
+			if diff := cmp.Diff(tc.expDump, gotDump); diff != "" {
+				t.Fatalf("unexpected dump (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -17,6 +17,7 @@ import (
 	"github.com/daos-stack/daos/src/control/common"
 	srvpb "github.com/daos-stack/daos/src/control/common/proto/srv"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/server/engine"
 )
@@ -25,6 +26,7 @@ import (
 // daos_engine.
 type EngineRunner interface {
 	Start(context.Context) (engine.RunnerExitChan, error)
+	Attach(context.Context, int) (engine.RunnerExitChan, error)
 	IsRunning() bool
 	Signal(os.Signal)
 	GetConfig() *engine.Config
@@ -60,6 +62,17 @@ func (ei *EngineInstance) format(ctx context.Context, recreateSBs bool) error {
 // performing any required NVMe preparation steps and launching a managed
 // daos_engine instance.
 func (ei *EngineInstance) start(ctx context.Context) (chan *engine.RunnerExitInfo, error) {
+	if err := ei.checkScmStorage(); err != nil {
+		if fault.HasResolution(err) {
+			ei.log.Errorf("instance %d: %s", ei.Index(), fault.ShowResolutionFor(err))
+		}
+		return nil, errors.Wrapf(err, "instance %d: refusing to start", ei.Index())
+	}
+
+	if err := ei.checkNvmeConfig(ctx); err != nil {
+		return nil, errors.Wrapf(err, "instance %d: refusing to start", ei.Index())
+	}
+
 	if err := ei.logScmStorage(); err != nil {
 		ei.log.Errorf("instance %d: unable to log SCM storage stats: %s", ei.Index(), err)
 	}
@@ -82,11 +95,14 @@ func (ei *EngineInstance) waitReady(ctx context.Context) error {
 	}
 }
 
-// finishStartup sets up instance once dRPC comms are ready, this includes setting the instance
-// rank, starting management service and loading I/O Engine modules.
+// finishStartup sets up instance once dRPC comms are ready, this includes verifying the dRPC
+// channel, setting the instance rank, starting management service and loading I/O Engine modules.
 //
 // Instance ready state is set to indicate that all setup is complete.
 func (ei *EngineInstance) finishStartup(ctx context.Context, ready *srvpb.NotifyReadyReq) error {
+	if err := ei.drpcSelfTest(ctx); err != nil {
+		return err
+	}
 	if err := ei.handleReady(ctx, ready); err != nil {
 		return err
 	}
@@ -155,9 +171,25 @@ func (ei *EngineInstance) handleExit(ctx context.Context, exitPid int, exitErr e
 		}
 	}
 
+	// A detached engine may still be running if startup failed after it
+	// was launched, in which case it can be reattached to later.
+	if ei.warmRestart && ei.runner.IsRunning() {
+		ei.log.Noticef("%s still running, leaving dRPC socket in place", strDetails)
+		ei.ready.SetFalse()
+		ei.reattached.SetFalse()
+		return
+	}
+
 	if err := ei.removeSocket(); err != nil && !os.IsNotExist(errors.Cause(err)) {
 		ei.log.Errorf("removing socket file: %s", err)
 	}
+
+	ei.reattached.SetFalse()
+	if ei.warmRestart {
+		if err := os.Remove(ei.readyStatePath()); err != nil && !os.IsNotExist(err) {
+			ei.log.Errorf("removing ready state file: %s", err)
+		}
+	}
 }
 
 // startRunner performs setup of and starts process runner for I/O Engine instance and
@@ -174,6 +206,18 @@ func (ei *EngineInstance) startRunner(parent context.Context, recreateSBs bool)
 		}
 	}()
 
+	if ei.warmRestart {
+		var pid int
+		cfg := ei.runner.GetConfig()
+		pid, err = engine.FindDetached(ei.log, cfg.SocketDir, cfg.Index)
+		if err != nil {
+			return
+		}
+		if pid != 0 {
+			return ei.reattach(ctx, pid)
+		}
+	}
+
 	if err = ei.format(ctx, recreateSBs); err != nil {
 		return
 	}
@@ -0,0 +1,147 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	// joinBackoffBase is the delay before the first join retry.
+	joinBackoffBase = 500 * time.Millisecond
+	// joinBackoffJitter is the unit of random jitter added to each retry
+	// delay, in order to avoid all engines in the system retrying in
+	// lockstep when the MS comes up.
+	joinBackoffJitter = 250 * time.Millisecond
+	// joinBackoffLimit caps the exponential growth of the retry delay.
+	joinBackoffLimit = 6
+)
+
+// joinErrKind classifies a failed join attempt.
+type joinErrKind int
+
+const (
+	// joinErrRejected indicates that the MS received and refused the join
+	// request. Retrying will not help.
+	joinErrRejected joinErrKind = iota
+	// joinErrMSNotReady indicates that the MS exists but is not yet in a
+	// state to service the request, e.g. it is still starting or electing
+	// a leader.
+	joinErrMSNotReady
+	// joinErrNetwork indicates that the request couldn't be delivered to
+	// or answered by a MS replica.
+	joinErrNetwork
+)
+
+func (k joinErrKind) String() string {
+	switch k {
+	case joinErrMSNotReady:
+		return "management service not ready"
+	case joinErrNetwork:
+		return "network error"
+	default:
+		return "join rejected"
+	}
+}
+
+// classifyJoinErr determines whether a join error is worth retrying and why.
+func classifyJoinErr(err error) joinErrKind {
+	switch {
+	case system.IsNotReady(err), system.IsNotLeader(err), system.IsNotReplica(err),
+		control.IsNoMSResponse(err):
+		return joinErrMSNotReady
+	case control.IsConnErr(err), fault.IsFaultCode(err, code.ClientRpcTimeout):
+		return joinErrNetwork
+	}
+
+	cause := errors.Cause(err)
+	if cause == context.DeadlineExceeded {
+		return joinErrNetwork
+	}
+	switch status.Code(cause) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return joinErrNetwork
+	}
+
+	return joinErrRejected
+}
+
+// joinBackoff returns the jittered delay to wait before the given join retry.
+func joinBackoff(try uint) time.Duration {
+	return common.ExpBackoffWithJitter(joinBackoffBase, joinBackoffJitter, uint64(try)+1, joinBackoffLimit)
+}
+
+// joinSystemWithRetry attempts to join the system, retrying with a capped
+// exponential backoff while the MS is unavailable or unreachable. Requests
+// rejected by the MS are not retried. The loop gives up once the configured
+// maximum wait time has elapsed.
+func (ei *EngineInstance) joinSystemWithRetry(ctx context.Context, req *control.SystemJoinReq) (*control.SystemJoinResp, error) {
+	maxWait := ei.joinMaxWait
+	if maxWait == 0 {
+		maxWait = control.SystemJoinTimeout
+	}
+
+	// Retries are handled here rather than in the control API, so that
+	// the different failure modes can be distinguished.
+	req.NoRetry = true
+
+	start := ei.clock.Now()
+	for try := uint(0); ; try++ {
+		tryCtx, cancel := context.WithTimeout(ctx, control.SystemJoinRetryTimeout)
+		resp, err := ei.joinSystem(tryCtx, req)
+		cancel()
+		if err == nil {
+			if try > 0 {
+				ei.log.Noticef("instance %d joined system after %d retries", ei.Index(), try)
+			}
+			return resp, nil
+		}
+		if ctx.Err() != nil {
+			return nil, errors.Wrap(ctx.Err(), "join canceled")
+		}
+
+		kind := classifyJoinErr(err)
+		if kind == joinErrRejected {
+			return nil, errors.Wrap(err, "join rejected")
+		}
+
+		waited := ei.clock.Since(start)
+		if waited >= maxWait {
+			return nil, errors.Wrapf(err, "unable to join system after %s (%d attempts)",
+				waited.Round(time.Second), try+1)
+		}
+
+		delay := joinBackoff(try)
+		if remaining := maxWait - waited; delay > remaining {
+			delay = remaining
+		}
+		msg := "instance %d join attempt %d failed (%s: %s); retrying in %s"
+		args := []interface{}{ei.Index(), try + 1, kind, err, delay.Round(time.Millisecond)}
+		if kind == joinErrNetwork {
+			ei.log.Noticef(msg, args...)
+		} else {
+			ei.log.Infof(msg, args...)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "join canceled")
+		case <-ei.clock.After(delay):
+		}
+	}
+}
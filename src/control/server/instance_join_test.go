@@ -0,0 +1,187 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/clock"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_classifyJoinErr(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err     error
+		expKind joinErrKind
+	}{
+		"system not formatted": {
+			err:     system.ErrUninitialized,
+			expKind: joinErrMSNotReady,
+		},
+		"raft unavailable": {
+			err:     system.ErrRaftUnavail,
+			expKind: joinErrMSNotReady,
+		},
+		"not leader": {
+			err:     &system.ErrNotLeader{LeaderHint: "host1"},
+			expKind: joinErrMSNotReady,
+		},
+		"not replica": {
+			err:     &system.ErrNotReplica{Replicas: []string{"host1"}},
+			expKind: joinErrMSNotReady,
+		},
+		"connection refused": {
+			err:     control.FaultConnectionRefused("host1"),
+			expKind: joinErrNetwork,
+		},
+		"no route": {
+			err:     control.FaultConnectionNoRoute("host1"),
+			expKind: joinErrNetwork,
+		},
+		"request timeout": {
+			err:     control.FaultRpcTimeout(&control.SystemJoinReq{}),
+			expKind: joinErrNetwork,
+		},
+		"context deadline": {
+			err:     errors.Wrap(context.DeadlineExceeded, "join"),
+			expKind: joinErrNetwork,
+		},
+		"grpc unavailable": {
+			err:     status.Error(codes.Unavailable, "down"),
+			expKind: joinErrNetwork,
+		},
+		"rejected": {
+			err:     errors.New("rank 1 already exists with a different UUID"),
+			expKind: joinErrRejected,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expKind, classifyJoinErr(tc.err), "unexpected kind")
+		})
+	}
+}
+
+func TestServer_joinBackoff(t *testing.T) {
+	maxBackoff := joinBackoffBase*(1<<(joinBackoffLimit-2)) + joinBackoffJitter*joinBackoffLimit
+
+	for try := uint(0); try < 20; try++ {
+		backoff := joinBackoff(try)
+		if backoff < joinBackoffBase {
+			t.Fatalf("try %d: backoff %s < %s", try, backoff, joinBackoffBase)
+		}
+		if backoff > maxBackoff {
+			t.Fatalf("try %d: backoff %s > %s", try, backoff, maxBackoff)
+		}
+	}
+}
+
+func TestServer_Instance_joinSystemWithRetry(t *testing.T) {
+	// Large enough to fire any single backoff timer.
+	const step = 10 * time.Second
+
+	for name, tc := range map[string]struct {
+		maxWait  time.Duration
+		joinErrs []error
+		expWaits int
+		expCalls int
+		expErr   error
+	}{
+		"success on first attempt": {
+			expCalls: 1,
+		},
+		"rejected; no retry": {
+			joinErrs: []error{errors.New("bad UUID")},
+			expCalls: 1,
+			expErr:   errors.New("join rejected: bad UUID"),
+		},
+		"ms not ready, then network error, then success": {
+			joinErrs: []error{
+				system.ErrRaftUnavail,
+				control.FaultConnectionRefused("host1"),
+			},
+			expWaits: 2,
+			expCalls: 3,
+		},
+		"retry until rejected": {
+			joinErrs: []error{
+				system.ErrUninitialized,
+				errors.New("bad UUID"),
+			},
+			expWaits: 1,
+			expCalls: 2,
+			expErr:   errors.New("join rejected"),
+		},
+		"max wait exceeded": {
+			maxWait: 25 * time.Second,
+			joinErrs: []error{
+				system.ErrRaftUnavail,
+				system.ErrRaftUnavail,
+				system.ErrRaftUnavail,
+				system.ErrRaftUnavail,
+			},
+			expWaits: 3,
+			expCalls: 4,
+			expErr:   errors.New("unable to join system after 30s (4 attempts)"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var calls int
+			var gotNoRetry bool
+			joinFn := func(_ context.Context, req *control.SystemJoinReq) (*control.SystemJoinResp, error) {
+				calls++
+				gotNoRetry = req.NoRetry
+				if calls <= len(tc.joinErrs) {
+					return nil, tc.joinErrs[calls-1]
+				}
+				return &control.SystemJoinResp{Rank: 1}, nil
+			}
+
+			mc := clock.NewMock(time.Now())
+			ei := NewEngineInstance(log, nil, joinFn,
+				engine.NewTestRunner(nil, engine.MockConfig())).
+				WithJoinMaxWait(tc.maxWait)
+			ei.clock = mc
+
+			type result struct {
+				resp *control.SystemJoinResp
+				err  error
+			}
+			resChan := make(chan result, 1)
+			go func() {
+				resp, err := ei.joinSystemWithRetry(context.TODO(), &control.SystemJoinReq{})
+				resChan <- result{resp, err}
+			}()
+
+			for i := 0; i < tc.expWaits; i++ {
+				mc.BlockUntil(1)
+				mc.Advance(step)
+			}
+			res := <-resChan
+
+			test.AssertEqual(t, tc.expCalls, calls, "unexpected number of join calls")
+			test.AssertTrue(t, gotNoRetry, "expected join request to disable internal retries")
+			test.CmpErr(t, tc.expErr, res.err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, uint32(1), uint32(res.resp.Rank), "unexpected rank")
+		})
+	}
+}
@@ -0,0 +1,102 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	srvpb "github.com/daos-stack/daos/src/control/common/proto/srv"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+// When warm restart is enabled, engines are started detached from the control
+// plane so that it can be restarted (e.g. for an upgrade) without interrupting
+// I/O. The engine only sends its ready notification once, so the contents are
+// saved alongside the engine pid file and used to recover the instance state
+// when a new control plane instance reattaches to the running engine.
+
+// readyStatePath returns the path of the file holding the last ready
+// notification received from the engine.
+func (ei *EngineInstance) readyStatePath() string {
+	cfg := ei.runner.GetConfig()
+	return filepath.Join(cfg.SocketDir, fmt.Sprintf("daos_engine.%d.ready", cfg.Index))
+}
+
+func (ei *EngineInstance) saveReadyState(msg *srvpb.NotifyReadyReq) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "marshal engine ready state")
+	}
+
+	return errors.Wrap(os.WriteFile(ei.readyStatePath(), data, 0600),
+		"write engine ready state")
+}
+
+// loadReadyState returns the saved ready notification of the engine running
+// with the given pid.
+func (ei *EngineInstance) loadReadyState(pid int) (*srvpb.NotifyReadyReq, error) {
+	data, err := os.ReadFile(ei.readyStatePath())
+	if err != nil {
+		return nil, errors.Wrap(err, "read engine ready state")
+	}
+
+	msg := new(srvpb.NotifyReadyReq)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, errors.Wrap(err, "unmarshal engine ready state")
+	}
+
+	// The engine dRPC socket name includes the engine pid, use it to
+	// verify that the state belongs to the running engine.
+	expSock := filepath.Join(ei.runner.GetConfig().SocketDir, fmt.Sprintf("daos_engine_%d.sock", pid))
+	if msg.GetDrpcListenerSock() != expSock {
+		return nil, errors.Errorf("engine ready state is for %q, not pid %d",
+			msg.GetDrpcListenerSock(), pid)
+	}
+	if err := checkDrpcClientSocketPath(expSock); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// reattach recovers the state of an engine left running by a previous control
+// plane instance and resumes management of it. The engine has already been
+// set up, so only the system join is repeated in order to refresh the
+// membership.
+func (ei *EngineInstance) reattach(ctx context.Context, pid int) (engine.RunnerExitChan, error) {
+	idx := ei.Index()
+
+	ready, err := ei.loadReadyState(pid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "instance %d: unable to reattach to running engine (pid %d); "+
+			"stop it before restarting", idx, pid)
+	}
+
+	// Recover the superblock and fire the storage ready callbacks, the
+	// storage is already in use by the running engine.
+	if err := ei.format(ctx, false); err != nil {
+		return nil, err
+	}
+
+	runnerExitChan, err := ei.runner.Attach(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	ei.reattached.SetTrue()
+	ei.setDrpcClient(drpc.NewClientConnection(ready.GetDrpcListenerSock()))
+
+	ei.log.Noticef("instance %d: reattached to running engine (pid %d)", idx, pid)
+
+	return runnerExitChan, ei.finishStartup(ctx, ready)
+}
@@ -0,0 +1,115 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	srvpb "github.com/daos-stack/daos/src/control/common/proto/srv"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+func TestServer_Instance_loadReadyState(t *testing.T) {
+	const testPid = 4321
+
+	for name, tc := range map[string]struct {
+		saved     bool
+		savedPid  int
+		noSocket  bool
+		expErr    error
+		expSocket string
+	}{
+		"no saved state": {
+			expErr: errors.New("read engine ready state"),
+		},
+		"state for another engine": {
+			saved:    true,
+			savedPid: testPid + 1,
+			expErr:   errors.New("not pid 4321"),
+		},
+		"socket missing": {
+			saved:    true,
+			savedPid: testPid,
+			noSocket: true,
+			expErr:   errors.New("could not be accessed"),
+		},
+		"success": {
+			saved:    true,
+			savedPid: testPid,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			runner := engine.NewTestRunner(nil, engine.MockConfig().WithSocketDir(testDir))
+			ei := NewEngineInstance(log, nil, nil, runner).WithWarmRestart(true)
+
+			sock := filepath.Join(testDir, fmt.Sprintf("daos_engine_%d.sock", tc.savedPid))
+			saved := &srvpb.NotifyReadyReq{
+				Uri:              "tcp://1.2.3.4:5678",
+				Nctxs:            7,
+				DrpcListenerSock: sock,
+				Ntgts:            4,
+				Incarnation:      42,
+			}
+			if tc.saved {
+				if err := ei.saveReadyState(saved); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !tc.noSocket {
+				lis, err := net.Listen("unix", sock)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer lis.Close()
+			}
+
+			got, err := ei.loadReadyState(testPid)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(saved, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected ready state (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_Instance_SetupRank_Reattached(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	trc := &engine.TestRunnerConfig{}
+	trc.Running.SetTrue()
+	ei := NewEngineInstance(log, nil, nil, engine.NewTestRunner(trc, engine.MockConfig()))
+	ei.reattached.SetTrue()
+
+	// No dRPC client is set, so the engine must not be called.
+	if err := ei.SetupRank(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if !ei.IsReady() {
+		t.Fatal("expected reattached instance to be ready")
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -15,7 +15,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
@@ -60,6 +63,16 @@ func createPublishFormatRequiredFunc(publish func(*events.RASEvent), hostname st
 	}
 }
 
+// createPublishMetadataCorruptedFunc returns onMetadataCorruptedFn which will
+// publish an event using the provided publish function to indicate that a file
+// persisted for an engine failed an integrity check.
+func createPublishMetadataCorruptedFunc(publish func(*events.RASEvent), hostname string) onMetadataCorruptedFn {
+	return func(engineIdx uint32, rank ranklist.Rank, path string, regenerated bool) {
+		publish(events.NewEngineMetadataCorruptedEvent(hostname, engineIdx, rank.Uint32(),
+			path, regenerated))
+	}
+}
+
 // awaitStorageReady blocks until instance has storage available and ready to be used.
 func (ei *EngineInstance) awaitStorageReady(ctx context.Context, skipMissingSuperblock bool) error {
 	idx := ei.Index()
@@ -82,6 +95,10 @@ func (ei *EngineInstance) awaitStorageReady(ctx context.Context, skipMissingSupe
 		}
 		ei.log.Debugf("instance %d: no SCM format required; checking for superblock", idx)
 		needsSuperblock, err := ei.NeedsSuperblock()
+		if fault.IsFaultCode(err, code.StorageDirLocked) {
+			// Another process is managing this instance's storage.
+			return err
+		}
 		if err != nil {
 			ei.log.Errorf("instance %d: failed to check instance superblock: %s", idx, err)
 		}
@@ -127,6 +144,66 @@ func (ei *EngineInstance) awaitStorageReady(ctx context.Context, skipMissingSupe
 	return ctx.Err()
 }
 
+// checkNvmeConfig verifies the instance's generated NVMe config file against
+// its checksum before it is consumed by the engine. The file is derived from
+// the server configuration, so it is safe to regenerate it if it is corrupted
+// or was written without a checksum by an older version.
+func (ei *EngineInstance) checkNvmeConfig(ctx context.Context) error {
+	cfgPath := ei.runner.GetConfig().Storage.ConfigOutputPath
+	if cfgPath == "" {
+		return nil
+	}
+
+	verifyErr := common.VerifyChecksumFile(cfgPath)
+	switch {
+	case verifyErr == nil, os.IsNotExist(verifyErr):
+		return nil
+	case verifyErr == common.ErrNoChecksum:
+		ei.log.Noticef("instance %d: regenerating NVMe config %s to add checksum", ei.Index(), cfgPath)
+	case verifyErr == common.ErrChecksumMismatch:
+		ei.log.Errorf("instance %d: NVMe config %s failed integrity check, regenerating", ei.Index(), cfgPath)
+	default:
+		return errors.Wrapf(verifyErr, "verifying NVMe config %s", cfgPath)
+	}
+
+	corrupted := verifyErr == common.ErrChecksumMismatch
+	if err := ei.storage.WriteNvmeConfig(ctx, ei.log); err != nil {
+		if corrupted {
+			ei.reportMetadataCorrupted(cfgPath, false)
+		}
+		return errors.Wrapf(err, "regenerating NVMe config %s", cfgPath)
+	}
+	if corrupted {
+		ei.reportMetadataCorrupted(cfgPath, true)
+	}
+
+	return nil
+}
+
+// checkScmStorage verifies that the instance's SCM mountpoint has the
+// configured storage mounted on it, that the storage is not shared with
+// another engine and that it holds the expected superblock.
+func (ei *EngineInstance) checkScmStorage() error {
+	mi, err := ei.storage.CheckScmMount()
+	if err != nil {
+		return err
+	}
+
+	for _, peer := range ei.peerScmMounts {
+		pmi, err := ei.storage.Sys.GetMountInfo(peer)
+		if err != nil {
+			// The other engine's storage may not be mounted yet, in
+			// which case the check is made when that engine starts.
+			continue
+		}
+		if pmi.MajorMinor == mi.MajorMinor {
+			return FaultScmMountShared(mi.MountPoint, peer)
+		}
+	}
+
+	return ei.checkSuperblock()
+}
+
 func (ei *EngineInstance) logScmStorage() error {
 	scmMount := path.Dir(ei.superblockPath())
 
@@ -65,6 +65,10 @@ func (ei *EngineInstance) scmFormat(force bool) (*ctlpb.ScmMountResult, error) {
 		"Format of SCM storage for %s instance %d (reformat: %t)", build.DataPlaneName,
 		ei.Index(), force)))
 
+	// The open storage directory would prevent SCM from being unmounted
+	// for reformat, so drop the lock until the superblock is next accessed.
+	ei.releaseStorageLock()
+
 	err = ei.storage.FormatScm(force)
 	if err != nil {
 		return nil, err
@@ -118,6 +122,10 @@ func (ei *EngineInstance) StorageFormatSCM(ctx context.Context, force bool) (mRe
 		}
 	}()
 
+	if scmErr = injectFormatFailure(formatStepSCM); scmErr != nil {
+		return
+	}
+
 	if ei.IsStarted() {
 		if !force {
 			scmErr = errors.Errorf("instance %d: can't format storage of running instance",
@@ -150,6 +158,12 @@ func (ei *EngineInstance) StorageFormatNVMe() (cResults proto.NvmeControllerResu
 		}
 	}
 
+	if err := injectFormatFailure(formatStepNVMe); err != nil {
+		return proto.NvmeControllerResults{
+			ei.newCret("", err),
+		}
+	}
+
 	if needsSuperblock {
 		cResults = ei.bdevFormat()
 	}
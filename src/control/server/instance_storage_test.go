@@ -382,3 +382,105 @@ func TestIOEngineInstance_awaitStorageReady(t *testing.T) {
 		})
 	}
 }
+
+func TestIOEngineInstance_checkScmStorage(t *testing.T) {
+	const (
+		sysName  = "test-sys"
+		peerPath = "/mnt/daos1"
+	)
+	tmpfsMount := func(path, majMin string) *system.MountInfo {
+		return &system.MountInfo{
+			MajorMinor: majMin,
+			MountPoint: path,
+			FsType:     system.FsTypeTmpfs,
+			Source:     "tmpfs",
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		notMounted bool
+		peerMount  *system.MountInfo
+		diskSb     *Superblock
+		loadedSb   *Superblock
+		expErr     error
+	}{
+		"not mounted": {
+			notMounted: true,
+			expErr:     errors.New("is not mounted"),
+		},
+		"shared with another engine": {
+			peerMount: tmpfsMount(peerPath, "0:50"),
+			diskSb:    &Superblock{UUID: "a", System: sysName},
+			expErr:    errors.New("is backed by the same device as " + peerPath),
+		},
+		"other engine not mounted": {
+			diskSb: &Superblock{UUID: "a", System: sysName},
+		},
+		"other engine mounted separately": {
+			peerMount: tmpfsMount(peerPath, "0:51"),
+			diskSb:    &Superblock{UUID: "a", System: sysName},
+		},
+		"no superblock": {
+			expErr: errors.New("no superblock found"),
+		},
+		"superblock for different system": {
+			diskSb: &Superblock{UUID: "a", System: "other-sys"},
+			expErr: errors.New(`belongs to system \"other-sys\"`),
+		},
+		"superblock does not match loaded superblock": {
+			diskSb:   &Superblock{UUID: "a", System: sysName},
+			loadedSb: &Superblock{UUID: "b", System: sysName},
+			expErr:   errors.New("instance UUID a does not match b"),
+		},
+		"loaded superblock matches": {
+			diskSb:   &Superblock{UUID: "a", System: sysName},
+			loadedSb: &Superblock{UUID: "a", System: sysName},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			mntPath := testDir + "/mnt/daos0"
+			if err := os.MkdirAll(mntPath, 0777); err != nil {
+				t.Fatal(err)
+			}
+
+			msc := &system.MockSysConfig{
+				MountInfo: make(map[string]*system.MountInfo),
+			}
+			if !tc.notMounted {
+				msc.MountInfo[mntPath] = tmpfsMount(mntPath, "0:50")
+			}
+			if tc.peerMount != nil {
+				msc.MountInfo[peerPath] = tc.peerMount
+			}
+
+			ec := engine.MockConfig().
+				WithSystemName(sysName).
+				WithStorage(
+					storage.NewTierConfig().
+						WithStorageClass("ram").
+						WithScmRamdiskSize(1).
+						WithScmMountPoint(mntPath),
+				)
+			sys := system.NewMockSysProvider(log, msc)
+			provider := storage.MockProvider(log, 0, &ec.Storage, sys, nil, nil)
+			instance := NewEngineInstance(log, provider, nil, engine.NewRunner(log, ec)).
+				WithPeerScmMounts(peerPath)
+
+			if tc.diskSb != nil {
+				if err := WriteSuperblock(instance.superblockPath(), tc.diskSb); err != nil {
+					t.Fatal(err)
+				}
+			}
+			instance.setSuperblock(tc.loadedSb)
+
+			gotErr := instance.checkScmStorage()
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2019-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +7,7 @@
 package server
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -16,7 +17,10 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
 const (
@@ -66,6 +70,38 @@ func (ei *EngineInstance) superblockPath() string {
 	return filepath.Join(ei.fsRoot, storagePath, "superblock")
 }
 
+// lockSuperblock serializes access to the instance's stored superblock within
+// this process. The first access also takes the lock on the instance's storage
+// directory, which is then held until releaseStorageLock() is called so that
+// no other process can manage the same storage in the meantime. The returned
+// function releases the superblock lock.
+func (ei *EngineInstance) lockSuperblock() (func(), error) {
+	ei.superblockMu.Lock()
+
+	if ei.storageLock == nil {
+		dl, err := storage.LockDir(filepath.Dir(ei.superblockPath()))
+		if err != nil {
+			ei.superblockMu.Unlock()
+			return nil, err
+		}
+		ei.storageLock = dl
+	}
+
+	return ei.superblockMu.Unlock, nil
+}
+
+// releaseStorageLock releases the lock on the instance's storage directory if
+// it is held. The lock will be taken again on the next superblock access.
+func (ei *EngineInstance) releaseStorageLock() {
+	ei.superblockMu.Lock()
+	defer ei.superblockMu.Unlock()
+
+	if err := ei.storageLock.Unlock(); err != nil {
+		ei.log.Errorf("instance %d: %s", ei.Index(), err)
+	}
+	ei.storageLock = nil
+}
+
 func (ei *EngineInstance) setSuperblock(sb *Superblock) {
 	ei.Lock()
 	defer ei.Unlock()
@@ -133,21 +169,28 @@ func (ei *EngineInstance) createSuperblock(recreate bool) error {
 		return err
 	}
 
-	u, err := uuid.NewRandom()
+	unlock, err := ei.lockSuperblock()
 	if err != nil {
-		return errors.Wrap(err, "Failed to generate instance UUID")
+		return errors.Wrap(err, "failed to lock instance storage")
+	}
+	defer unlock()
+
+	// Check again now that the lock is held, in case the superblock was
+	// created by another process since it was last read.
+	if sb, err := ReadSuperblock(ei.superblockPath()); err == nil {
+		ei.setSuperblock(sb)
+		return nil
 	}
 
-	cfg := ei.runner.GetConfig()
-	systemName := cfg.SystemName
-	if systemName == "" {
-		systemName = defaultGroupName
+	u, err := uuid.NewRandom()
+	if err != nil {
+		return errors.Wrap(err, "Failed to generate instance UUID")
 	}
 
 	superblock := &Superblock{
 		Version: superblockVersion,
 		UUID:    u.String(),
-		System:  systemName,
+		System:  ei.systemName(),
 	}
 
 	if ei.hostFaultDomain != nil {
@@ -158,12 +201,57 @@ func (ei *EngineInstance) createSuperblock(recreate bool) error {
 	ei.log.Debugf("index %d: creating %s: (rank: %s, uuid: %s)",
 		ei.Index(), ei.superblockPath(), superblock.Rank, superblock.UUID)
 
-	return ei.WriteSuperblock()
+	return WriteSuperblock(ei.superblockPath(), superblock)
+}
+
+// systemName returns the name of the system the instance is configured for.
+func (ei *EngineInstance) systemName() string {
+	if name := ei.runner.GetConfig().SystemName; name != "" {
+		return name
+	}
+	return defaultGroupName
+}
+
+// checkSuperblock verifies that the superblock in the instance's storage
+// belongs to the configured system and, if a superblock has already been
+// loaded by the instance, that it is the same one.
+func (ei *EngineInstance) checkSuperblock() error {
+	unlock, err := ei.lockSuperblock()
+	if err != nil {
+		return errors.Wrap(err, "failed to lock instance storage")
+	}
+	defer unlock()
+
+	sbPath := ei.superblockPath()
+	sb, err := ReadSuperblock(sbPath)
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return FaultSuperblockMismatch(sbPath, "no superblock found")
+		}
+		return errors.Wrap(err, "failed to read instance superblock")
+	}
+
+	if sb.System != ei.systemName() {
+		return FaultSuperblockMismatch(sbPath,
+			fmt.Sprintf("belongs to system %q, not %q", sb.System, ei.systemName()))
+	}
+	if cur := ei.getSuperblock(); cur != nil && cur.UUID != sb.UUID {
+		return FaultSuperblockMismatch(sbPath,
+			fmt.Sprintf("instance UUID %s does not match %s", sb.UUID, cur.UUID))
+	}
+
+	return nil
 }
 
 // WriteSuperblock writes the instance's superblock
 // to storage.
 func (ei *EngineInstance) WriteSuperblock() error {
+	unlock, err := ei.lockSuperblock()
+	if err != nil {
+		return errors.Wrap(err, "failed to lock instance storage")
+	}
+	defer unlock()
+
 	return WriteSuperblock(ei.superblockPath(), ei.getSuperblock())
 }
 
@@ -174,12 +262,31 @@ func (ei *EngineInstance) ReadSuperblock() error {
 		return errors.Wrap(err, "failed to mount SCM device")
 	}
 
-	sb, err := ReadSuperblock(ei.superblockPath())
+	unlock, err := ei.lockSuperblock()
+	if err != nil {
+		return errors.Wrap(err, "failed to lock instance storage")
+	}
+	defer unlock()
+
+	sbPath := ei.superblockPath()
+	sb, hasChecksum, err := readSuperblock(sbPath)
 	if err != nil {
+		if fault.IsFaultCode(err, code.ServerSuperblockCorrupted) {
+			ei.reportMetadataCorrupted(sbPath, false)
+		}
 		return errors.Wrap(err, "failed to read instance superblock")
 	}
 	ei.setSuperblock(sb)
 
+	// The superblock was read successfully so it is safe to rewrite it
+	// with a checksum.
+	if !hasChecksum {
+		ei.log.Noticef("instance %d: adding checksum to superblock %s", ei.Index(), sbPath)
+		if err := WriteSuperblock(sbPath, sb); err != nil {
+			ei.log.Errorf("instance %d: %s", ei.Index(), err)
+		}
+	}
+
 	return nil
 }
 
@@ -187,31 +294,58 @@ func (ei *EngineInstance) ReadSuperblock() error {
 func (ei *EngineInstance) RemoveSuperblock() error {
 	ei.setSuperblock(nil)
 
+	unlock, err := ei.lockSuperblock()
+	if err != nil {
+		return errors.Wrap(err, "failed to lock instance storage")
+	}
+	defer unlock()
+
 	return os.Remove(ei.superblockPath())
 }
 
-// WriteSuperblock writes a Superblock to storage.
+// WriteSuperblock writes a Superblock to storage. The superblock is written to
+// a staging file which is renamed over the original, so that readers never see
+// a partially-written superblock. A checksum is appended so that corruption of
+// the stored superblock can be detected when it is read.
 func WriteSuperblock(sbPath string, sb *Superblock) error {
 	data, err := sb.Marshal()
 	if err != nil {
 		return err
 	}
 
-	return errors.Wrapf(common.WriteFileAtomic(sbPath, data, 0600),
+	return errors.Wrapf(common.WriteFileAtomic(sbPath, common.AppendChecksumTrailer(data), 0600),
 		"Failed to write Superblock to %s", sbPath)
 }
 
-// ReadSuperblock reads a Superblock from storage.
-func ReadSuperblock(sbPath string) (*Superblock, error) {
+// readSuperblock reads a Superblock from storage and indicates whether it was
+// stored with a checksum.
+func readSuperblock(sbPath string) (*Superblock, bool, error) {
 	data, err := ioutil.ReadFile(sbPath)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Failed to read Superblock from %s", sbPath)
+		return nil, false, errors.Wrapf(err, "Failed to read Superblock from %s", sbPath)
+	}
+
+	// Superblocks written by older versions have no checksum.
+	data, err = common.VerifyChecksumTrailer(data)
+	hasChecksum := err == nil
+	switch err {
+	case nil, common.ErrNoChecksum:
+	case common.ErrChecksumMismatch:
+		return nil, false, FaultSuperblockCorrupted(sbPath)
+	default:
+		return nil, false, err
 	}
 
 	sb := &Superblock{}
 	if err := sb.Unmarshal(data); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	return sb, nil
+	return sb, hasChecksum, nil
+}
+
+// ReadSuperblock reads a Superblock from storage.
+func ReadSuperblock(sbPath string) (*Superblock, error) {
+	sb, _, err := readSuperblock(sbPath)
+	return sb, err
 }
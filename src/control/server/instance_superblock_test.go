@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,12 +7,19 @@
 package server
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 	sysprov "github.com/daos-stack/daos/src/control/provider/system"
 	"github.com/daos-stack/daos/src/control/server/engine"
@@ -83,3 +90,242 @@ func TestServer_Instance_createSuperblock(t *testing.T) {
 		}
 	}
 }
+
+func TestServer_Instance_NeedsSuperblock(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	if err := os.MkdirAll(filepath.Join(testDir, "mnt"), 0777); err != nil {
+		t.Fatal(err)
+	}
+
+	newInstance := func() *EngineInstance {
+		cfg := engine.MockConfig().
+			WithSystemName(t.Name()).
+			WithStorage(
+				storage.NewTierConfig().
+					WithStorageClass("ram").
+					WithScmRamdiskSize(1).
+					WithScmMountPoint("mnt"),
+			)
+		r := engine.NewRunner(log, cfg)
+		msc := &sysprov.MockSysConfig{
+			IsMountedBool: true,
+		}
+		mbc := &scm.MockBackendConfig{}
+		mp := storage.NewProvider(log, 0, &cfg.Storage, sysprov.NewMockSysProvider(log, msc), scm.NewMockProvider(log, mbc, msc), nil)
+		ei := NewEngineInstance(log, mp, nil, r)
+		ei.fsRoot = testDir
+		return ei
+	}
+
+	needsSuperblock := func(t *testing.T, ei *EngineInstance, exp bool) {
+		t.Helper()
+
+		needs, err := ei.NeedsSuperblock()
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEqual(t, exp, needs, "unexpected NeedsSuperblock() result")
+	}
+
+	ei := newInstance()
+	needsSuperblock(t, ei, true)
+
+	if err := ei.createSuperblock(false); err != nil {
+		t.Fatal(err)
+	}
+	needsSuperblock(t, ei, false)
+	created := ei.getSuperblock()
+
+	// A new instance using the same storage should load the superblock
+	// rather than need a new one, once the first has released the storage.
+	ei.releaseStorageLock()
+	other := newInstance()
+	needsSuperblock(t, other, false)
+	test.AssertEqual(t, created.UUID, other.getSuperblock().UUID, "unexpected superblock UUID")
+	test.AssertEqual(t, t.Name(), other.getSuperblock().System, "unexpected superblock system")
+
+	if err := other.RemoveSuperblock(); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertFalse(t, other.hasSuperblock(), "expected superblock to be cleared")
+	if _, err := os.Stat(other.superblockPath()); !os.IsNotExist(err) {
+		t.Fatalf("expected superblock file to be removed, got %v", err)
+	}
+	needsSuperblock(t, other, true)
+}
+
+func TestServer_Instance_superblockLocked(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	mntDir := filepath.Join(testDir, "mnt")
+	if err := os.MkdirAll(mntDir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	cfg := engine.MockConfig().
+		WithSystemName(t.Name()).
+		WithStorage(
+			storage.NewTierConfig().
+				WithStorageClass("ram").
+				WithScmRamdiskSize(1).
+				WithScmMountPoint("mnt"),
+		)
+	msc := &sysprov.MockSysConfig{
+		IsMountedBool: true,
+	}
+	mp := storage.NewProvider(log, 0, &cfg.Storage, sysprov.NewMockSysProvider(log, msc),
+		scm.NewMockProvider(log, &scm.MockBackendConfig{}, msc), nil)
+	ei := NewEngineInstance(log, mp, nil, engine.NewRunner(log, cfg))
+	ei.fsRoot = testDir
+
+	// Simulate another process holding the instance storage lock.
+	dl, err := storage.LockDir(mntDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test.CmpErr(t, storage.FaultDirLocked(mntDir), ei.createSuperblock(false))
+	if _, err := os.Stat(ei.superblockPath()); !os.IsNotExist(err) {
+		t.Fatalf("expected no superblock to be written while locked, got %v", err)
+	}
+
+	if err := dl.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ei.createSuperblock(false); err != nil {
+		t.Fatal(err)
+	}
+	sb, err := ReadSuperblock(ei.superblockPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, ei.getSuperblock().UUID, sb.UUID, "unexpected stored superblock")
+
+	// The instance holds the storage lock from first use until released.
+	if _, err := storage.LockDir(mntDir); !fault.IsFaultCode(err, code.StorageDirLocked) {
+		t.Fatalf("expected instance to hold storage lock, got %v", err)
+	}
+	if err := ei.WriteSuperblock(); err != nil {
+		t.Fatal(err)
+	}
+	ei.releaseStorageLock()
+	dl, err = storage.LockDir(mntDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dl.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+
+	// No staging files should be left behind by the atomic write.
+	entries, err := os.ReadDir(mntDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(entries), "unexpected files in instance directory")
+}
+
+func TestServer_Instance_ReadSuperblock_integrity(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mangle       func([]byte) []byte
+		expErr       error
+		expCorrupted bool
+	}{
+		"valid": {
+			mangle: func(data []byte) []byte { return data },
+		},
+		"no checksum": {
+			mangle: func(data []byte) []byte {
+				body, err := common.VerifyChecksumTrailer(data)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return body
+			},
+		},
+		"corrupted": {
+			mangle: func(data []byte) []byte {
+				return bytes.Replace(data, []byte("version: 1"), []byte("version: 3"), 1)
+			},
+			expErr:       errors.New("failed integrity check"),
+			expCorrupted: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			if err := os.MkdirAll(filepath.Join(testDir, "mnt"), 0777); err != nil {
+				t.Fatal(err)
+			}
+			cfg := engine.MockConfig().
+				WithStorage(
+					storage.NewTierConfig().
+						WithStorageClass("ram").
+						WithScmRamdiskSize(1).
+						WithScmMountPoint("mnt"),
+				)
+			msc := &sysprov.MockSysConfig{
+				IsMountedBool: true,
+			}
+			mp := storage.NewProvider(log, 0, &cfg.Storage, sysprov.NewMockSysProvider(log, msc),
+				scm.NewMockProvider(log, &scm.MockBackendConfig{}, msc), nil)
+			ei := NewEngineInstance(log, mp, nil, engine.NewRunner(log, cfg))
+			ei.fsRoot = testDir
+
+			var reported []string
+			ei.OnMetadataCorrupted(func(_ uint32, _ ranklist.Rank, path string, regenerated bool) {
+				test.AssertFalse(t, regenerated, "superblock should not be regenerated")
+				reported = append(reported, path)
+			})
+
+			sbPath := ei.superblockPath()
+			if err := WriteSuperblock(sbPath, &Superblock{
+				Version: superblockVersion,
+				UUID:    test.MockUUID(),
+				System:  "daos_server",
+			}); err != nil {
+				t.Fatal(err)
+			}
+			data, err := os.ReadFile(sbPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(sbPath, tc.mangle(data), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			gotErr := ei.ReadSuperblock()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expCorrupted {
+				test.AssertTrue(t, fault.IsFaultCode(gotErr, code.ServerSuperblockCorrupted),
+					"expected superblock corrupted fault")
+				test.AssertEqual(t, []string{sbPath}, reported, "unexpected corruption reports")
+				return
+			}
+			test.AssertEqual(t, 0, len(reported), "unexpected corruption reports")
+
+			// Superblocks without a checksum are rewritten with one.
+			data, err = os.ReadFile(sbPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := common.VerifyChecksumTrailer(data); err != nil {
+				t.Fatalf("stored superblock has no valid checksum: %s", err)
+			}
+			test.AssertEqual(t, test.MockUUID(), ei.getSuperblock().UUID, "unexpected superblock")
+		})
+	}
+}
@@ -193,6 +193,8 @@ type (
 		RemoveSuperblockErr error
 		SetupRankErr        error
 		StopErr             error
+		DumpStacksResp      *stackDump
+		DumpStacksErr       error
 		ScmTierConfig       *storage.TierConfig
 		ScanBdevTiersResult []storage.BdevTierScanResult
 	}
@@ -258,6 +260,10 @@ func (mi *MockInstance) Stop(os.Signal) error {
 	return mi.cfg.StopErr
 }
 
+func (mi *MockInstance) DumpStacks(_ context.Context, _ bool) (*stackDump, error) {
+	return mi.cfg.DumpStacksResp, mi.cfg.DumpStacksErr
+}
+
 func (mi *MockInstance) ScanBdevTiers() ([]storage.BdevTierScanResult, error) {
 	return nil, nil
 }
@@ -298,6 +304,10 @@ func (mi *MockInstance) ListSmdDevices(context.Context, *ctlpb.SmdDevReq) (*ctlp
 	return nil, nil
 }
 
+func (mi *MockInstance) ListSmdPools(context.Context, *ctlpb.SmdPoolReq) (*ctlpb.SmdPoolResp, error) {
+	return nil, nil
+}
+
 func (mi *MockInstance) StorageFormatNVMe() commonpb.NvmeControllerResults {
 	return nil
 }
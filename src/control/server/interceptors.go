@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2019-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -28,35 +29,112 @@ import (
 	"github.com/daos-stack/daos/src/control/system"
 )
 
-func componentFromContext(ctx context.Context) (comp *security.Component, err error) {
+func commonNameFromContext(ctx context.Context) (string, error) {
 	clientPeer, ok := peer.FromContext(ctx)
 	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "no peer information found")
+		return "", status.Error(codes.Unauthenticated, "no peer information found")
 	}
 
 	authInfo, ok := clientPeer.AuthInfo.(credentials.TLSInfo)
 	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "unable to obtain TLS info where it should be available")
+		return "", status.Error(codes.Unauthenticated, "unable to obtain TLS info where it should be available")
 	}
 
 	certs := authInfo.State.VerifiedChains
 	if len(certs) == 0 || len(certs[0]) == 0 {
 		// This should never happen since we require it on the TLS handshake and don't allow skipping.
-		return nil, status.Error(codes.Unauthenticated, "unable to verify client certificates")
+		return "", status.Error(codes.Unauthenticated, "unable to verify client certificates")
 	}
 
-	peerCert := certs[0][0]
-	component := security.CommonNameToComponent(peerCert.Subject.CommonName)
+	return certs[0][0].Subject.CommonName, nil
+}
 
+func componentFromContext(ctx context.Context) (comp *security.Component, err error) {
+	cn, err := commonNameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	component := security.CommonNameToComponent(cn)
 	return &component, nil
 }
 
-func checkAccess(ctx context.Context, FullMethod string) error {
+// poolAdminFromContext returns the principal of a delegated pool administrator
+// making the request, or an empty string if the request was made by any other
+// client or without certificates.
+func poolAdminFromContext(ctx context.Context) string {
+	cn, err := commonNameFromContext(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return security.PoolAdminPrincipal(cn)
+}
+
+// tokenFromContext returns the bearer token supplied with the request, if any.
+func tokenFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return "", nil
+	}
+
+	const bearerPrefix = "Bearer "
+	if !strings.HasPrefix(vals[0], bearerPrefix) {
+		return "", status.Error(codes.Unauthenticated, "unsupported authorization scheme")
+	}
+
+	return strings.TrimPrefix(vals[0], bearerPrefix), nil
+}
+
+// checkTokenAccess checks the access of an administrative client that may
+// have supplied a bearer token. If a token is supplied, the roles it grants
+// are used in place of those granted by the client certificate.
+func checkTokenAccess(ctx context.Context, FullMethod string, tv *security.TokenValidator) error {
+	token, err := tokenFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if token == "" {
+		if tv.Required() {
+			return status.Error(codes.Unauthenticated, "a bearer token is required for administrative requests")
+		}
+		if !security.ComponentAdmin.HasAccess(FullMethod) {
+			errMsg := fmt.Sprintf("%s does not have permission to call %s", security.ComponentAdmin, FullMethod)
+			return status.Error(codes.PermissionDenied, errMsg)
+		}
+		return nil
+	}
+
+	id, err := tv.Validate(token)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid bearer token: %s", err)
+	}
+
+	if !id.HasAccess(FullMethod) {
+		errMsg := fmt.Sprintf("token subject %q with roles %v does not have permission to call %s",
+			id.Subject, id.Roles, FullMethod)
+		return status.Error(codes.PermissionDenied, errMsg)
+	}
+
+	return nil
+}
+
+func checkAccess(ctx context.Context, FullMethod string, tv *security.TokenValidator) error {
 	component, err := componentFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
+	if tv != nil && *component == security.ComponentAdmin {
+		return checkTokenAccess(ctx, FullMethod, tv)
+	}
+
 	if !component.HasAccess(FullMethod) {
 		errMsg := fmt.Sprintf("%s does not have permission to call %s", component, FullMethod)
 		return status.Error(codes.PermissionDenied, errMsg)
@@ -65,23 +143,27 @@ func checkAccess(ctx context.Context, FullMethod string) error {
 	return nil
 }
 
-func unaryAccessInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	if err := checkAccess(ctx, info.FullMethod); err != nil {
-		return nil, errors.Wrapf(err, "access denied for %T", req)
-	}
+func unaryAccessInterceptor(tv *security.TokenValidator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkAccess(ctx, info.FullMethod, tv); err != nil {
+			return nil, errors.Wrapf(err, "access denied for %T", req)
+		}
 
-	return handler(ctx, req)
+		return handler(ctx, req)
+	}
 }
 
-func streamAccessInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	if err := checkAccess(ss.Context(), info.FullMethod); err != nil {
-		return err
-	}
+func streamAccessInterceptor(tv *security.TokenValidator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkAccess(ss.Context(), info.FullMethod, tv); err != nil {
+			return err
+		}
 
-	return handler(srv, ss)
+		return handler(srv, ss)
+	}
 }
 
-func unaryInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.UnaryServerInterceptor, error) {
+func unaryInterceptorForTransportConfig(cfg *security.TransportConfig, tv *security.TokenValidator) (grpc.UnaryServerInterceptor, error) {
 	if cfg == nil {
 		return nil, errors.New("nil TransportConfig")
 	}
@@ -90,10 +172,10 @@ func unaryInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.Una
 		return nil, nil
 	}
 
-	return unaryAccessInterceptor, nil
+	return unaryAccessInterceptor(tv), nil
 }
 
-func streamInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.StreamServerInterceptor, error) {
+func streamInterceptorForTransportConfig(cfg *security.TransportConfig, tv *security.TokenValidator) (grpc.StreamServerInterceptor, error) {
 	if cfg == nil {
 		return nil, errors.New("nil TransportConfig")
 	}
@@ -102,7 +184,7 @@ func streamInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.St
 		return nil, nil
 	}
 
-	return streamAccessInterceptor, nil
+	return streamAccessInterceptor(tv), nil
 }
 
 var selfServerComponent = func() *build.VersionedComponent {
@@ -131,6 +213,10 @@ func checkVersion(ctx context.Context, self *build.VersionedComponent, req inter
 	secComponent, err := componentFromContext(ctx)
 	if err == nil {
 		buildComponent = build.Component(secComponent.String())
+		if *secComponent == security.ComponentPoolAdmin {
+			// Delegated pool administrators use dmg.
+			buildComponent = build.ComponentAdmin
+		}
 	}
 
 	otherVersion := "0.0.0"
@@ -15,13 +15,17 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 type testStatus struct {
@@ -95,6 +99,131 @@ func newTestAuthCtx(parent context.Context, commonName string) context.Context {
 	return peer.NewContext(parent, ctxPeer)
 }
 
+func TestServer_unaryAccessInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ctx     context.Context
+		method  string
+		expCode codes.Code
+	}{
+		"no peer": {
+			ctx:     context.TODO(),
+			method:  "/mgmt.MgmtSvc/PoolDestroy",
+			expCode: codes.Unauthenticated,
+		},
+		"peer without TLS info": {
+			ctx:     peer.NewContext(context.TODO(), &peer.Peer{Addr: common.LocalhostCtrlAddr()}),
+			method:  "/mgmt.MgmtSvc/PoolDestroy",
+			expCode: codes.Unauthenticated,
+		},
+		"admin calls pool destroy": {
+			ctx:     newTestAuthCtx(context.TODO(), "admin"),
+			method:  "/mgmt.MgmtSvc/PoolDestroy",
+			expCode: codes.OK,
+		},
+		"admin calls server-only method": {
+			ctx:     newTestAuthCtx(context.TODO(), "admin"),
+			method:  "/mgmt.MgmtSvc/Join",
+			expCode: codes.PermissionDenied,
+		},
+		"agent calls get attach info": {
+			ctx:     newTestAuthCtx(context.TODO(), "agent"),
+			method:  "/mgmt.MgmtSvc/GetAttachInfo",
+			expCode: codes.OK,
+		},
+		"agent calls pool destroy": {
+			ctx:     newTestAuthCtx(context.TODO(), "agent"),
+			method:  "/mgmt.MgmtSvc/PoolDestroy",
+			expCode: codes.PermissionDenied,
+		},
+		"agent calls stop ranks": {
+			ctx:     newTestAuthCtx(context.TODO(), "agent"),
+			method:  "/ctl.CtlSvc/StopRanks",
+			expCode: codes.PermissionDenied,
+		},
+		"server calls join": {
+			ctx:     newTestAuthCtx(context.TODO(), "server"),
+			method:  "/mgmt.MgmtSvc/Join",
+			expCode: codes.OK,
+		},
+		"pool admin calls pool query": {
+			ctx:     newTestAuthCtx(context.TODO(), "pool-admin:alice"),
+			method:  "/mgmt.MgmtSvc/PoolQuery",
+			expCode: codes.OK,
+		},
+		"pool admin calls pool destroy": {
+			ctx:     newTestAuthCtx(context.TODO(), "pool-admin:alice"),
+			method:  "/mgmt.MgmtSvc/PoolDestroy",
+			expCode: codes.PermissionDenied,
+		},
+		"pool admin calls set pool admins": {
+			ctx:     newTestAuthCtx(context.TODO(), "pool-admin:alice"),
+			method:  "/mgmt.MgmtSvc/PoolSetAdmins",
+			expCode: codes.PermissionDenied,
+		},
+		"pool admin calls system stop": {
+			ctx:     newTestAuthCtx(context.TODO(), "pool-admin:alice"),
+			method:  "/mgmt.MgmtSvc/SystemStop",
+			expCode: codes.PermissionDenied,
+		},
+		"unknown component": {
+			ctx:     newTestAuthCtx(context.TODO(), "3v1l"),
+			method:  "/mgmt.MgmtSvc/GetAttachInfo",
+			expCode: codes.PermissionDenied,
+		},
+		"unknown method": {
+			ctx:     newTestAuthCtx(context.TODO(), "admin"),
+			method:  "/mgmt.MgmtSvc/NotARealMethod",
+			expCode: codes.PermissionDenied,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var called bool
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+
+			interceptor := unaryAccessInterceptor(nil)
+			_, gotErr := interceptor(tc.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+
+			test.AssertEqual(t, tc.expCode, status.Code(errors.Cause(gotErr)), "unexpected status code")
+			test.AssertEqual(t, tc.expCode == codes.OK, called, "unexpected handler invocation")
+		})
+	}
+}
+
+func TestServer_interceptorsForTransportConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg       *security.TransportConfig
+		expAccess bool
+		expErr    error
+	}{
+		"nil config": {
+			expErr: errors.New("nil TransportConfig"),
+		},
+		"insecure": {
+			cfg: &security.TransportConfig{AllowInsecure: true},
+		},
+		"secure": {
+			cfg:       &security.TransportConfig{},
+			expAccess: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			unary, gotErr := unaryInterceptorForTransportConfig(tc.cfg, nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+			stream, gotErr := streamInterceptorForTransportConfig(tc.cfg, nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expAccess, unary != nil, "unexpected unary access interceptor")
+			test.AssertEqual(t, tc.expAccess, stream != nil, "unexpected stream access interceptor")
+		})
+	}
+}
+
 type checkVerReq struct {
 	Sys string
 }
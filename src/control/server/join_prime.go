@@ -0,0 +1,144 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daos-stack/daos/src/control/lib/clock"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// joinPrimeTTL limits how long pool service information pushed by the MS in
+// a join response is trusted. Pool services may be moved or destroyed after
+// the join, so the cache only serves to speed up the first lookups after a
+// rank joins.
+const joinPrimeTTL = 5 * time.Minute
+
+// joinPrimeCache holds the system state pushed by the MS to local ranks in
+// join responses.
+type joinPrimeCache struct {
+	sync.RWMutex
+	log        logging.Logger
+	clock      clock.Clock
+	ttl        time.Duration
+	mapVersion uint32
+	updated    time.Time
+	byUUID     map[uuid.UUID]*system.PoolService
+	byLabel    map[string]*system.PoolService
+}
+
+func newJoinPrimeCache(log logging.Logger) *joinPrimeCache {
+	return &joinPrimeCache{
+		log:   log,
+		clock: clock.New(),
+		ttl:   joinPrimeTTL,
+	}
+}
+
+// update replaces the cache contents with the prime data from a join response.
+// Data older than what is already cached is ignored.
+func (c *joinPrimeCache) update(resp *control.SystemJoinResp) {
+	if resp == nil || resp.Prime == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.byUUID != nil && resp.Prime.MapVersion < c.mapVersion {
+		c.log.Debugf("ignoring stale join prime data (map version %d < %d)",
+			resp.Prime.MapVersion, c.mapVersion)
+		return
+	}
+
+	c.byUUID = make(map[uuid.UUID]*system.PoolService)
+	c.byLabel = make(map[string]*system.PoolService)
+	for _, p := range resp.Prime.Pools {
+		poolUUID, err := uuid.Parse(p.UUID)
+		if err != nil {
+			c.log.Errorf("invalid pool UUID %q in join prime data", p.UUID)
+			continue
+		}
+
+		ps := &system.PoolService{
+			PoolUUID:  poolUUID,
+			PoolLabel: p.Label,
+			State:     system.PoolServiceStateReady,
+			Replicas:  ranklist.RanksFromUint32(p.SvcReps),
+		}
+		c.byUUID[poolUUID] = ps
+		if p.Label != "" {
+			c.byLabel[p.Label] = ps
+		}
+	}
+	c.mapVersion = resp.Prime.MapVersion
+	c.updated = c.clock.Now()
+
+	c.log.Debugf("primed %d pool services at map version %d", len(c.byUUID), c.mapVersion)
+}
+
+// expired indicates whether the cached data is missing or too old to be used.
+// Must be called with the lock held.
+func (c *joinPrimeCache) expired() bool {
+	return c.byUUID == nil || c.clock.Since(c.updated) > c.ttl
+}
+
+func (c *joinPrimeCache) findByUUID(id uuid.UUID) (*system.PoolService, bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.expired() {
+		return nil, false
+	}
+	ps, found := c.byUUID[id]
+	return ps, found
+}
+
+func (c *joinPrimeCache) findByLabel(label string) (*system.PoolService, bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.expired() {
+		return nil, false
+	}
+	ps, found := c.byLabel[label]
+	return ps, found
+}
+
+// primedPoolResolver resolves pools using the system database, falling back
+// to the join prime cache when the local database is not a MS replica.
+type primedPoolResolver struct {
+	sysdb poolResolver
+	cache *joinPrimeCache
+}
+
+func (r *primedPoolResolver) FindPoolServiceByUUID(id uuid.UUID) (*system.PoolService, error) {
+	ps, err := r.sysdb.FindPoolServiceByUUID(id)
+	if err != nil && system.IsNotReplica(err) {
+		if cached, found := r.cache.findByUUID(id); found {
+			return cached, nil
+		}
+	}
+	return ps, err
+}
+
+func (r *primedPoolResolver) FindPoolServiceByLabel(label string) (*system.PoolService, error) {
+	ps, err := r.sysdb.FindPoolServiceByLabel(label)
+	if err != nil && system.IsNotReplica(err) {
+		if cached, found := r.cache.findByLabel(label); found {
+			return cached, nil
+		}
+	}
+	return ps, err
+}
@@ -0,0 +1,164 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/clock"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type mockPoolResolver struct {
+	ps  *system.PoolService
+	err error
+}
+
+func (mpr *mockPoolResolver) FindPoolServiceByLabel(string) (*system.PoolService, error) {
+	return mpr.ps, mpr.err
+}
+
+func (mpr *mockPoolResolver) FindPoolServiceByUUID(uuid.UUID) (*system.PoolService, error) {
+	return mpr.ps, mpr.err
+}
+
+func TestServer_primedPoolResolver(t *testing.T) {
+	primeResp := func(mapVer uint32, reps ...uint32) *control.SystemJoinResp {
+		return &control.SystemJoinResp{
+			Prime: &control.JoinPrimeData{
+				MapVersion: mapVer,
+				Pools: []*control.JoinPrimePool{
+					{
+						UUID:    test.MockUUID(1),
+						Label:   "pool1",
+						SvcReps: reps,
+					},
+				},
+			},
+		}
+	}
+	dbPool := &system.PoolService{
+		PoolUUID:  test.MockPoolUUID(1),
+		PoolLabel: "pool1",
+		State:     system.PoolServiceStateReady,
+		Replicas:  []ranklist.Rank{3},
+	}
+	notReplica := &system.ErrNotReplica{Replicas: []string{"host1"}}
+
+	for name, tc := range map[string]struct {
+		sysdb   *mockPoolResolver
+		updates []*control.SystemJoinResp
+		elapsed time.Duration
+		expPool *system.PoolService
+		expErr  error
+	}{
+		"replica; cache not used": {
+			sysdb:   &mockPoolResolver{ps: dbPool},
+			updates: []*control.SystemJoinResp{primeResp(1, 0, 1)},
+			expPool: dbPool,
+		},
+		"replica; pool not found": {
+			sysdb:   &mockPoolResolver{err: system.ErrPoolUUIDNotFound(test.MockPoolUUID(1))},
+			updates: []*control.SystemJoinResp{primeResp(1, 0, 1)},
+			expErr:  errors.New("unable to find pool"),
+		},
+		"not replica; nothing cached": {
+			sysdb:  &mockPoolResolver{err: notReplica},
+			expErr: notReplica,
+		},
+		"not replica; join without prime data": {
+			sysdb:   &mockPoolResolver{err: notReplica},
+			updates: []*control.SystemJoinResp{{Rank: 1}},
+			expErr:  notReplica,
+		},
+		"not replica; cached": {
+			sysdb:   &mockPoolResolver{err: notReplica},
+			updates: []*control.SystemJoinResp{primeResp(1, 0, 1)},
+			expPool: &system.PoolService{
+				PoolUUID:  test.MockPoolUUID(1),
+				PoolLabel: "pool1",
+				State:     system.PoolServiceStateReady,
+				Replicas:  []ranklist.Rank{0, 1},
+			},
+		},
+		"not replica; stale update ignored": {
+			sysdb: &mockPoolResolver{err: notReplica},
+			updates: []*control.SystemJoinResp{
+				primeResp(3, 0, 1),
+				primeResp(2, 2),
+			},
+			expPool: &system.PoolService{
+				PoolUUID:  test.MockPoolUUID(1),
+				PoolLabel: "pool1",
+				State:     system.PoolServiceStateReady,
+				Replicas:  []ranklist.Rank{0, 1},
+			},
+		},
+		"not replica; newer update applied": {
+			sysdb: &mockPoolResolver{err: notReplica},
+			updates: []*control.SystemJoinResp{
+				primeResp(2, 0, 1),
+				primeResp(3, 2),
+			},
+			expPool: &system.PoolService{
+				PoolUUID:  test.MockPoolUUID(1),
+				PoolLabel: "pool1",
+				State:     system.PoolServiceStateReady,
+				Replicas:  []ranklist.Rank{2},
+			},
+		},
+		"not replica; cache expired": {
+			sysdb:   &mockPoolResolver{err: notReplica},
+			updates: []*control.SystemJoinResp{primeResp(1, 0, 1)},
+			elapsed: joinPrimeTTL + time.Second,
+			expErr:  notReplica,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mc := clock.NewMock(time.Now())
+			cache := newJoinPrimeCache(log)
+			cache.clock = mc
+			for _, resp := range tc.updates {
+				cache.update(resp)
+			}
+			mc.Advance(tc.elapsed)
+
+			resolver := &primedPoolResolver{sysdb: tc.sysdb, cache: cache}
+
+			for _, lookup := range map[string]func() (*system.PoolService, error){
+				"uuid": func() (*system.PoolService, error) {
+					return resolver.FindPoolServiceByUUID(test.MockPoolUUID(1))
+				},
+				"label": func() (*system.PoolService, error) {
+					return resolver.FindPoolServiceByLabel("pool1")
+				},
+			} {
+				gotPool, gotErr := lookup()
+				test.CmpErr(t, tc.expErr, gotErr)
+				if tc.expErr != nil {
+					continue
+				}
+
+				if diff := cmp.Diff(tc.expPool, gotPool); diff != "" {
+					t.Fatalf("unexpected pool service (-want, +got):\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
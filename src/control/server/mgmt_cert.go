@@ -0,0 +1,59 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/peer"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+// newCertSigner returns a CertSigner for the management service if it has
+// been configured to act as a CA for enrolling hosts. This is only supported
+// when transport security is disabled, as otherwise hosts without certificates
+// are unable to connect.
+func newCertSigner(log logging.Logger, tc *security.TransportConfig) (*security.CertSigner, error) {
+	if tc == nil || tc.CAKeyPath == "" {
+		return nil, nil
+	}
+
+	if !tc.AllowInsecure {
+		log.Noticef("ignoring transport_config.ca_key as transport security is enabled")
+		return nil, nil
+	}
+
+	return security.LoadCertSigner(tc.CARootPath, tc.CAKeyPath)
+}
+
+// SystemSignCert signs the certificate request of a host that is enrolling in
+// the system, returning the issued certificate.
+func (svc *mgmtSvc) SystemSignCert(ctx context.Context, req *mgmtpb.SystemSignCertReq) (*mgmtpb.SystemSignCertResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if svc.certSigner == nil {
+		return nil, security.FaultCertEnrollmentDisabled
+	}
+
+	cert, err := svc.certSigner.Sign(req.GetCsr())
+	if err != nil {
+		return nil, err
+	}
+
+	from := "unknown peer"
+	if p, ok := peer.FromContext(ctx); ok {
+		from = p.Addr.String()
+	}
+	svc.log.Noticef("issued certificate for enrolling host %s", from)
+
+	return &mgmtpb.SystemSignCertResp{Cert: cert}, nil
+}
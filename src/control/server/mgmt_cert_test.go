@@ -0,0 +1,153 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestServer_newCertSigner(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	caCert, caKey := security.MockCA(t)
+	caCertPath := filepath.Join(tmpDir, "daosCA.crt")
+	if err := ioutil.WriteFile(caCertPath, caCert, 0644); err != nil {
+		t.Fatal(err)
+	}
+	caKeyPath := filepath.Join(tmpDir, "daosCA.key")
+	if err := ioutil.WriteFile(caKeyPath, caKey, 0400); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		tc        *security.TransportConfig
+		expSigner bool
+		expErr    error
+	}{
+		"nil config": {},
+		"no CA key": {
+			tc: &security.TransportConfig{AllowInsecure: true},
+		},
+		"secure transport": {
+			tc: &security.TransportConfig{
+				CAKeyPath: caKeyPath,
+				CertificateConfig: security.CertificateConfig{
+					CARootPath: caCertPath,
+				},
+			},
+		},
+		"missing CA key": {
+			tc: &security.TransportConfig{
+				AllowInsecure: true,
+				CAKeyPath:     filepath.Join(tmpDir, "missing.key"),
+				CertificateConfig: security.CertificateConfig{
+					CARootPath: caCertPath,
+				},
+			},
+			expErr: errors.New("could not load CA key"),
+		},
+		"success": {
+			tc: &security.TransportConfig{
+				AllowInsecure: true,
+				CAKeyPath:     caKeyPath,
+				CertificateConfig: security.CertificateConfig{
+					CARootPath: caCertPath,
+				},
+			},
+			expSigner: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			signer, gotErr := newCertSigner(log, tc.tc)
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expSigner, signer != nil, "unexpected signer")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemSignCert(t *testing.T) {
+	caCert, caKey := security.MockCA(t)
+	signer, err := security.NewCertSigner(caCert, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := security.MockPrivateKey(t)
+	mockCSR := func(comp security.Component) []byte {
+		csr, err := security.NewCertificateRequest(key, comp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return csr
+	}
+
+	for name, tc := range map[string]struct {
+		signer    *security.CertSigner
+		req       *mgmtpb.SystemSignCertReq
+		expAPIErr error
+	}{
+		"nil req": {
+			signer:    signer,
+			req:       (*mgmtpb.SystemSignCertReq)(nil),
+			expAPIErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			signer:    signer,
+			req:       &mgmtpb.SystemSignCertReq{Sys: "quack"},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"enrollment disabled": {
+			req:       &mgmtpb.SystemSignCertReq{Csr: mockCSR(security.ComponentServer)},
+			expAPIErr: security.FaultCertEnrollmentDisabled,
+		},
+		"admin certificate": {
+			signer:    signer,
+			req:       &mgmtpb.SystemSignCertReq{Csr: mockCSR(security.ComponentAdmin)},
+			expAPIErr: errors.New("may not be issued"),
+		},
+		"success": {
+			signer: signer,
+			req:    &mgmtpb.SystemSignCertReq{Csr: mockCSR(security.ComponentServer)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, nil, nil)
+			svc.certSigner = tc.signer
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			resp, gotAPIErr := svc.SystemSignCert(context.Background(), tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			if err := security.VerifyCertificate(resp.Cert, key, caCert, security.ComponentServer); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
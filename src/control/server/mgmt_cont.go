@@ -53,3 +53,30 @@ func (svc *mgmtSvc) ContSetOwner(ctx context.Context, req *mgmtpb.ContSetOwnerRe
 
 	return resp, nil
 }
+
+// ContCheck forwards a gRPC request to the DAOS I/O Engine to check a pool's container metadata
+// for orphans left behind by failed container destroys, optionally removing them.
+func (svc *mgmtSvc) ContCheck(ctx context.Context, req *mgmtpb.ContCheckReq) (*mgmtpb.ContCheckResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodContCheck, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.ContCheckResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal ContCheck response")
+	}
+
+	for _, orphan := range resp.GetOrphans() {
+		if orphan.GetRemoved() {
+			svc.log.Noticef("removed orphaned metadata for container %s in pool %s: %s",
+				orphan.GetUuid(), req.GetId(), orphan.GetReason())
+		}
+	}
+
+	return resp, nil
+}
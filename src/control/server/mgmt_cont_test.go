@@ -237,3 +237,143 @@ func TestMgmt_ContSetOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestMgmt_ContCheck(t *testing.T) {
+	validContCheckReq := func() *mgmtpb.ContCheckReq {
+		return &mgmtpb.ContCheckReq{
+			Sys: build.DefaultSystemName,
+			Id:  mockUUID,
+		}
+	}
+
+	orphans := []*mgmtpb.ContCheckResp_Orphan{
+		{
+			Uuid:   "56781234-5678-5678-5678-123456789abc",
+			Reason: "container not in pool service container table",
+		},
+		{
+			Uuid:   "67812345-6781-6781-6781-123456789abc",
+			Label:  "old-cont",
+			Reason: "destroy did not complete",
+		},
+	}
+	removed := func() []*mgmtpb.ContCheckResp_Orphan {
+		var out []*mgmtpb.ContCheckResp_Orphan
+		for _, o := range orphans {
+			out = append(out, &mgmtpb.ContCheckResp_Orphan{
+				Uuid:    o.Uuid,
+				Label:   o.Label,
+				Reason:  o.Reason,
+				Removed: true,
+			})
+		}
+		return out
+	}
+
+	for name, tc := range map[string]struct {
+		createMS  func(*testing.T, logging.Logger) *mgmtSvc
+		setupDrpc func(*testing.T, *mgmtSvc)
+		req       *mgmtpb.ContCheckReq
+		expResp   *mgmtpb.ContCheckResp
+		expErr    error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"pool svc not found": {
+			req: &mgmtpb.ContCheckReq{
+				Sys: build.DefaultSystemName,
+				Id:  "fake",
+			},
+			expErr: errors.New("unable to find pool"),
+		},
+		"harness not started": {
+			createMS: func(t *testing.T, log logging.Logger) *mgmtSvc {
+				db := raft.MockDatabase(t, log)
+				ms := system.MockMembership(t, log, db, mockTCPResolver)
+				return newMgmtSvc(NewEngineHarness(log), ms, db, nil,
+					events.NewPubSub(context.Background(), log))
+			},
+			req:    validContCheckReq(),
+			expErr: FaultHarnessNotStarted,
+		},
+		"drpc error": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupMockDrpcClient(svc, nil, errors.New("mock drpc"))
+			},
+			req:    validContCheckReq(),
+			expErr: errors.New("mock drpc"),
+		},
+		"bad drpc resp": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				badBytes := makeBadBytes(16)
+				setupMockDrpcClientBytes(svc, badBytes, nil)
+			},
+			req:    validContCheckReq(),
+			expErr: errors.New("unmarshal"),
+		},
+		"success; no orphans": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupMockDrpcClient(svc, &mgmtpb.ContCheckResp{}, nil)
+			},
+			req:     validContCheckReq(),
+			expResp: &mgmtpb.ContCheckResp{},
+		},
+		"success; orphans found by pool label": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupMockDrpcClient(svc, &mgmtpb.ContCheckResp{
+					Orphans: orphans,
+				}, nil)
+			},
+			req: &mgmtpb.ContCheckReq{
+				Sys: build.DefaultSystemName,
+				Id:  "test-pool",
+			},
+			expResp: &mgmtpb.ContCheckResp{
+				Orphans: orphans,
+			},
+		},
+		"success; orphans removed": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupMockDrpcClient(svc, &mgmtpb.ContCheckResp{
+					Orphans: removed(),
+				}, nil)
+			},
+			req: &mgmtpb.ContCheckReq{
+				Sys:    build.DefaultSystemName,
+				Id:     mockUUID,
+				Repair: true,
+			},
+			expResp: &mgmtpb.ContCheckResp{
+				Orphans: removed(),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.createMS == nil {
+				tc.createMS = newTestMgmtSvc
+			}
+			svc := tc.createMS(t, log)
+			addTestPoolService(t, svc.sysdb, testPoolService())
+
+			if tc.setupDrpc != nil {
+				tc.setupDrpc(t, svc)
+			}
+
+			resp, err := svc.ContCheck(context.TODO(), tc.req)
+
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("(-want, +got): \n%s\n", diff)
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, mockUUID, tc.req.GetId(), "pool ID not resolved to UUID")
+		})
+	}
+}
@@ -434,7 +434,7 @@ func TestSrvModule_handleGetPoolServiceRanks(t *testing.T) {
 				sysdb: db,
 			}
 			if tc.testPool != nil {
-				lock, err := db.TakePoolLock(ctx, tc.testPool.PoolUUID)
+				lock, err := db.TakePoolLock(ctx, tc.testPool.PoolUUID, "test")
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -519,7 +519,7 @@ func TestSrvModule_handlePoolFindByLabel(t *testing.T) {
 				sysdb: db,
 			}
 			if tc.testPool != nil {
-				lock, err := db.TakePoolLock(ctx, tc.testPool.PoolUUID)
+				lock, err := db.TakePoolLock(ctx, tc.testPool.PoolUUID, "test")
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -0,0 +1,120 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/events"
+)
+
+// eventWatcherBufSize is the number of events that may be queued for a
+// watcher before further events are dropped.
+const eventWatcherBufSize = 128
+
+// eventWatchers fans out the RAS events received by the MS leader to each of
+// the clients streaming them.
+type eventWatchers struct {
+	sync.Mutex
+	nextID   int
+	watchers map[int]chan *events.RASEvent
+}
+
+func newEventWatchers() *eventWatchers {
+	return &eventWatchers{
+		watchers: make(map[int]chan *events.RASEvent),
+	}
+}
+
+// add registers a new watcher and returns its ID along with the channel on
+// which it will receive events.
+func (ew *eventWatchers) add() (int, chan *events.RASEvent) {
+	ew.Lock()
+	defer ew.Unlock()
+
+	id := ew.nextID
+	ew.nextID++
+	ch := make(chan *events.RASEvent, eventWatcherBufSize)
+	ew.watchers[id] = ch
+
+	return id, ch
+}
+
+// remove unregisters the watcher with the given ID.
+func (ew *eventWatchers) remove(id int) {
+	ew.Lock()
+	defer ew.Unlock()
+
+	delete(ew.watchers, id)
+}
+
+// closeAll unregisters all watchers and closes their channels in order to end
+// their streams, e.g. when MS leadership is lost.
+func (ew *eventWatchers) closeAll() {
+	ew.Lock()
+	defer ew.Unlock()
+
+	for id, ch := range ew.watchers {
+		close(ch)
+		delete(ew.watchers, id)
+	}
+}
+
+// OnEvent implements the events.Handler interface. Events are dropped for any
+// watcher that is not keeping up rather than allowed to block publishing.
+func (ew *eventWatchers) OnEvent(_ context.Context, evt *events.RASEvent) {
+	ew.Lock()
+	defer ew.Unlock()
+
+	for _, ch := range ew.watchers {
+		select {
+		case ch <- evt:
+		default:
+		}
+	}
+}
+
+// SystemEventStream management service gRPC handler streams the RAS events
+// received by the MS leader to the caller until the stream is canceled or
+// leadership is lost.
+func (svc *mgmtSvc) SystemEventStream(req *mgmtpb.SystemEventStreamReq, stream mgmtpb.MgmtSvc_SystemEventStreamServer) error {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return err
+	}
+
+	id, evtCh := svc.eventWatchers.add()
+	defer svc.eventWatchers.remove(id)
+	svc.log.Debugf("started event stream %d", id)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			svc.log.Debugf("event stream %d canceled", id)
+			return nil
+		case evt, ok := <-evtCh:
+			if !ok {
+				if err := svc.sysdb.CheckLeader(); err != nil {
+					return err
+				}
+				return errors.New("event stream closed")
+			}
+
+			pbEvt, err := evt.ToProto()
+			if err != nil {
+				svc.log.Errorf("event stream %d: unable to convert event: %s", id, err)
+				continue
+			}
+			if err := stream.Send(pbEvt); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockEventStream struct {
+	grpc.ServerStream
+	sync.Mutex
+	ctx  context.Context
+	evts []*sharedpb.RASEvent
+}
+
+func (ms *mockEventStream) Context() context.Context {
+	return ms.ctx
+}
+
+func (ms *mockEventStream) Send(evt *sharedpb.RASEvent) error {
+	ms.Lock()
+	defer ms.Unlock()
+	ms.evts = append(ms.evts, evt)
+	return nil
+}
+
+func (ms *mockEventStream) numEvents() int {
+	ms.Lock()
+	defer ms.Unlock()
+	return len(ms.evts)
+}
+
+func TestServer_MgmtSvc_SystemEventStream(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req       *mgmtpb.SystemEventStreamReq
+		closeAll  bool
+		expEvents int
+		expErr    error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.SystemEventStreamReq{Sys: "bad"},
+			expErr: errors.New("does not match"),
+		},
+		"events streamed until canceled": {
+			req:       &mgmtpb.SystemEventStreamReq{Sys: build.DefaultSystemName},
+			expEvents: 2,
+		},
+		"watchers closed": {
+			req:       &mgmtpb.SystemEventStreamReq{Sys: build.DefaultSystemName},
+			closeAll:  true,
+			expEvents: 2,
+			expErr:    errors.New("closed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			stream := &mockEventStream{ctx: ctx}
+
+			errCh := make(chan error)
+			go func() {
+				errCh <- svc.SystemEventStream(tc.req, stream)
+			}()
+
+			if tc.expErr == nil || tc.closeAll {
+				// Wait for the stream to start watching.
+				for {
+					svc.eventWatchers.Lock()
+					started := len(svc.eventWatchers.watchers) > 0
+					svc.eventWatchers.Unlock()
+					if started {
+						break
+					}
+					time.Sleep(time.Millisecond)
+				}
+
+				for _, evt := range []*events.RASEvent{
+					events.NewEngineDiedEvent("foo", 0, 1, common.ExitStatus("test"), 1234),
+					events.NewEngineDiedEvent("bar", 0, 2, common.ExitStatus("test"), 5678),
+				} {
+					svc.eventWatchers.OnEvent(ctx, evt)
+				}
+				for stream.numEvents() < tc.expEvents {
+					time.Sleep(time.Millisecond)
+				}
+
+				if tc.closeAll {
+					svc.eventWatchers.closeAll()
+				} else {
+					cancel()
+				}
+			}
+
+			test.CmpErr(t, tc.expErr, <-errCh)
+			test.AssertEqual(t, tc.expEvents, stream.numEvents(), "unexpected number of events")
+		})
+	}
+}
@@ -0,0 +1,304 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	inventoryJSONFile    = "inventory.json"
+	inventoryMembersFile = "members.csv"
+	inventoryPoolsFile   = "pools.csv"
+	inventoryCapFile     = "capacity.csv"
+	inventoryPostTimeout = 30 * time.Second
+)
+
+type (
+	// inventoryMember describes a system member in an inventory snapshot.
+	inventoryMember struct {
+		Rank        uint32    `json:"rank"`
+		UUID        string    `json:"uuid"`
+		Addr        string    `json:"addr"`
+		FaultDomain string    `json:"fault_domain"`
+		State       string    `json:"state"`
+		LastUpdate  time.Time `json:"last_update"`
+	}
+
+	// inventoryPool describes a pool and the storage allocated to it in an
+	// inventory snapshot.
+	inventoryPool struct {
+		UUID      string   `json:"uuid"`
+		Label     string   `json:"label"`
+		State     string   `json:"state"`
+		SvcReps   []uint32 `json:"svc_reps"`
+		Ranks     string   `json:"ranks"`
+		ScmBytes  uint64   `json:"scm_bytes"`
+		NvmeBytes uint64   `json:"nvme_bytes"`
+	}
+
+	// inventoryCapacity summarizes the members and pool storage allocation
+	// of the system in an inventory snapshot.
+	inventoryCapacity struct {
+		Members       int    `json:"members"`
+		JoinedMembers int    `json:"joined_members"`
+		Pools         int    `json:"pools"`
+		ScmBytes      uint64 `json:"scm_bytes"`
+		NvmeBytes     uint64 `json:"nvme_bytes"`
+	}
+
+	// inventorySnapshot is the system inventory exported by the MS leader.
+	inventorySnapshot struct {
+		System    string             `json:"system"`
+		Leader    string             `json:"leader"`
+		Timestamp time.Time          `json:"timestamp"`
+		Members   []*inventoryMember `json:"members"`
+		Pools     []*inventoryPool   `json:"pools"`
+		Capacity  *inventoryCapacity `json:"capacity"`
+	}
+)
+
+// inventorySnapshot takes a snapshot of the system inventory from the system
+// database.
+func (svc *mgmtSvc) inventorySnapshot(now time.Time) (*inventorySnapshot, error) {
+	leader, _, err := svc.sysdb.LeaderQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := svc.sysdb.AllMembers()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Rank < members[j].Rank })
+
+	pools, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].PoolUUID.String() < pools[j].PoolUUID.String()
+	})
+
+	snap := &inventorySnapshot{
+		System:    svc.sysdb.SystemName(),
+		Leader:    leader,
+		Timestamp: now.UTC(),
+		Members:   []*inventoryMember{},
+		Pools:     []*inventoryPool{},
+		Capacity:  &inventoryCapacity{},
+	}
+
+	for _, m := range members {
+		im := &inventoryMember{
+			Rank:       m.Rank.Uint32(),
+			UUID:       m.UUID.String(),
+			State:      m.State.String(),
+			LastUpdate: m.LastUpdate.UTC(),
+		}
+		if m.Addr != nil {
+			im.Addr = m.Addr.String()
+		}
+		if m.FaultDomain != nil {
+			im.FaultDomain = m.FaultDomain.String()
+		}
+		snap.Members = append(snap.Members, im)
+
+		snap.Capacity.Members++
+		if m.State == system.MemberStateJoined {
+			snap.Capacity.JoinedMembers++
+		}
+	}
+
+	for _, ps := range pools {
+		ip := &inventoryPool{
+			UUID:    ps.PoolUUID.String(),
+			Label:   ps.PoolLabel,
+			State:   ps.State.String(),
+			SvcReps: ranklist.RanksToUint32(ps.Replicas),
+		}
+		if ps.Storage != nil {
+			ip.Ranks = ps.Storage.CurrentRankStr
+			ip.ScmBytes = ps.Storage.TotalSCM()
+			ip.NvmeBytes = ps.Storage.TotalNVMe()
+		}
+		snap.Pools = append(snap.Pools, ip)
+
+		snap.Capacity.Pools++
+		snap.Capacity.ScmBytes += ip.ScmBytes
+		snap.Capacity.NvmeBytes += ip.NvmeBytes
+	}
+
+	return snap, nil
+}
+
+func writeInventoryJSON(dir string, snap *inventorySnapshot) error {
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return common.WriteFileAtomic(filepath.Join(dir, inventoryJSONFile), data, 0644)
+}
+
+func writeCSVFile(path string, records [][]string) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(records); err != nil {
+		return err
+	}
+
+	return common.WriteFileAtomic(path, buf.Bytes(), 0644)
+}
+
+// writeInventoryCSV writes the snapshot as a set of CSV tables, one each for
+// members, pools and the capacity summary. Each row carries the timestamp of
+// the snapshot so that tables may be appended to one another on ingestion.
+func writeInventoryCSV(dir string, snap *inventorySnapshot) error {
+	ts := snap.Timestamp.Format(time.RFC3339)
+	u64 := func(v uint64) string { return strconv.FormatUint(v, 10) }
+
+	members := [][]string{{"timestamp", "rank", "uuid", "addr", "fault_domain", "state", "last_update"}}
+	for _, m := range snap.Members {
+		members = append(members, []string{
+			ts, u64(uint64(m.Rank)), m.UUID, m.Addr, m.FaultDomain, m.State,
+			m.LastUpdate.Format(time.RFC3339),
+		})
+	}
+
+	pools := [][]string{{"timestamp", "uuid", "label", "state", "svc_reps", "ranks", "scm_bytes", "nvme_bytes"}}
+	for _, p := range snap.Pools {
+		svcReps := ranklist.RankSetFromRanks(ranklist.RanksFromUint32(p.SvcReps))
+		pools = append(pools, []string{
+			ts, p.UUID, p.Label, p.State, svcReps.RangedString(), p.Ranks,
+			u64(p.ScmBytes), u64(p.NvmeBytes),
+		})
+	}
+
+	c := snap.Capacity
+	capacity := [][]string{
+		{"timestamp", "system", "leader", "members", "joined_members", "pools", "scm_bytes", "nvme_bytes"},
+		{
+			ts, snap.System, snap.Leader, strconv.Itoa(c.Members), strconv.Itoa(c.JoinedMembers),
+			strconv.Itoa(c.Pools), u64(c.ScmBytes), u64(c.NvmeBytes),
+		},
+	}
+
+	for name, records := range map[string][][]string{
+		inventoryMembersFile: members,
+		inventoryPoolsFile:   pools,
+		inventoryCapFile:     capacity,
+	} {
+		if err := writeCSVFile(filepath.Join(dir, name), records); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// postInventory posts the snapshot as JSON to the given URL.
+func postInventory(ctx context.Context, client *http.Client, url string, snap *inventorySnapshot) error {
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, inventoryPostTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("POST %s: %s", url, resp.Status)
+	}
+
+	return nil
+}
+
+// exportInventory takes an inventory snapshot and exports it to each of the
+// configured destinations.
+func (svc *mgmtSvc) exportInventory(ctx context.Context, cfg *config.InventoryExportConfig, client *http.Client, now time.Time) error {
+	snap, err := svc.inventorySnapshot(now)
+	if err != nil {
+		return errors.Wrap(err, "taking inventory snapshot")
+	}
+
+	if cfg.Path != "" {
+		if err := os.MkdirAll(cfg.Path, 0755); err != nil {
+			return err
+		}
+
+		write := writeInventoryJSON
+		if cfg.Format == config.InventoryExportCSV {
+			write = writeInventoryCSV
+		}
+		if err := write(cfg.Path, snap); err != nil {
+			return errors.Wrapf(err, "writing inventory to %s", cfg.Path)
+		}
+	}
+
+	if cfg.URL != "" {
+		if err := postInventory(ctx, client, cfg.URL, snap); err != nil {
+			return errors.Wrap(err, "posting inventory")
+		}
+	}
+
+	svc.log.Debugf("exported inventory of %d members and %d pools", len(snap.Members), len(snap.Pools))
+	return nil
+}
+
+// startInventoryExport periodically exports snapshots of the system inventory
+// while this instance is the MS leader. Failures are logged but do not stop
+// subsequent exports.
+func (svc *mgmtSvc) startInventoryExport(ctx context.Context, cfg *config.InventoryExportConfig) {
+	svc.log.Debugf("starting inventory export every %s", cfg.Interval)
+	client := &http.Client{}
+
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				svc.log.Debug("stopped inventory export")
+				return
+			case now := <-ticker.C:
+				if err := svc.exportInventory(ctx, cfg, client, now); err != nil {
+					svc.log.Errorf("inventory export failed: %s", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,189 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_exportInventory(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	poolUUID := uuid.MustParse(test.MockUUID(1))
+
+	expCapacity := &inventoryCapacity{
+		Members:       2,
+		JoinedMembers: 1,
+		Pools:         1,
+		ScmBytes:      200,
+		NvmeBytes:     2000,
+	}
+	expPools := []*inventoryPool{
+		{
+			UUID:      poolUUID.String(),
+			Label:     "pool1",
+			State:     system.PoolServiceStateReady.String(),
+			SvcReps:   []uint32{0},
+			Ranks:     "[0-1]",
+			ScmBytes:  200,
+			NvmeBytes: 2000,
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		format     string
+		toPath     bool
+		toURL      bool
+		postStatus int
+		expFiles   []string
+		expErr     error
+	}{
+		"json file": {
+			format:   config.InventoryExportJSON,
+			toPath:   true,
+			expFiles: []string{inventoryJSONFile},
+		},
+		"csv files": {
+			format:   config.InventoryExportCSV,
+			toPath:   true,
+			expFiles: []string{inventoryCapFile, inventoryMembersFile, inventoryPoolsFile},
+		},
+		"http post": {
+			toURL:      true,
+			postStatus: http.StatusOK,
+		},
+		"http post rejected": {
+			toURL:      true,
+			postStatus: http.StatusForbidden,
+			expErr:     errors.New("403"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			svc := newTestMgmtSvc(t, log)
+			for _, m := range []*system.Member{
+				system.MockMember(t, 1, system.MemberStateStopped),
+				system.MockMember(t, 0, system.MemberStateJoined),
+			} {
+				if err := svc.sysdb.AddMember(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+			ps := system.NewPoolService(poolUUID, []uint64{100, 1000},
+				[]ranklist.Rank{0, 1})
+			ps.PoolLabel = "pool1"
+			ps.State = system.PoolServiceStateReady
+			ps.Replicas = []ranklist.Rank{0}
+			addTestPoolService(t, svc.sysdb, ps)
+
+			var posted *inventorySnapshot
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+				}
+				posted = new(inventorySnapshot)
+				if err := json.Unmarshal(body, posted); err != nil {
+					t.Error(err)
+				}
+				w.WriteHeader(tc.postStatus)
+			}))
+			defer srv.Close()
+
+			cfg := &config.InventoryExportConfig{Format: tc.format}
+			exportDir := filepath.Join(testDir, "inventory")
+			if tc.toPath {
+				cfg.Path = exportDir
+			}
+			if tc.toURL {
+				cfg.URL = srv.URL
+			}
+
+			gotErr := svc.exportInventory(context.TODO(), cfg, srv.Client(), now)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotFiles []string
+			if entries, err := os.ReadDir(exportDir); err == nil {
+				for _, entry := range entries {
+					gotFiles = append(gotFiles, entry.Name())
+				}
+			}
+			if diff := cmp.Diff(tc.expFiles, gotFiles, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("unexpected files (-want, +got):\n%s\n", diff)
+			}
+
+			var snap *inventorySnapshot
+			switch {
+			case tc.toURL:
+				snap = posted
+			case tc.format == config.InventoryExportJSON:
+				data, err := os.ReadFile(filepath.Join(exportDir, inventoryJSONFile))
+				if err != nil {
+					t.Fatal(err)
+				}
+				snap = new(inventorySnapshot)
+				if err := json.Unmarshal(data, snap); err != nil {
+					t.Fatal(err)
+				}
+			default:
+				f, err := os.Open(filepath.Join(exportDir, inventoryMembersFile))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer f.Close()
+				records, err := csv.NewReader(f).ReadAll()
+				if err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, 3, len(records), "unexpected member rows")
+				test.AssertEqual(t, "rank", records[0][1], "unexpected header")
+				test.AssertEqual(t, "0", records[1][1], "members not sorted by rank")
+				test.AssertEqual(t, "Stopped", records[2][5], "unexpected member state")
+				return
+			}
+
+			if snap == nil {
+				t.Fatal("no snapshot exported")
+			}
+			test.AssertEqual(t, now, snap.Timestamp, "unexpected timestamp")
+			test.AssertEqual(t, 2, len(snap.Members), "unexpected members")
+			if diff := cmp.Diff(expPools, snap.Pools); diff != "" {
+				t.Fatalf("unexpected pools (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(expCapacity, snap.Capacity); diff != "" {
+				t.Fatalf("unexpected capacity (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -7,19 +7,22 @@
 package server
 
 import (
+	"fmt"
+	"math"
 	"math/rand"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/drpc"
-	"github.com/daos-stack/daos/src/control/fault"
-	"github.com/daos-stack/daos/src/control/fault/code"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/server/engine"
@@ -36,6 +39,9 @@ const (
 	// MaxPoolServiceReps defines the maximum number of pool service
 	// replicas that may be configured when creating a pool.
 	MaxPoolServiceReps = 2*daos.PoolSvcRedunFacMax + 1
+	// poolTierRatioTolerance defines the allowed deviation from 1 of the
+	// sum of the tier ratios supplied in a pool create request.
+	poolTierRatioTolerance = 0.001
 )
 
 type poolServiceReq interface {
@@ -51,7 +57,7 @@ func (svc *mgmtSvc) makeLockedPoolServiceCall(ctx context.Context, method drpc.M
 	if err != nil {
 		return nil, err
 	}
-	lock, err := svc.sysdb.TakePoolLock(ctx, ps.PoolUUID)
+	lock, err := svc.sysdb.TakePoolLock(ctx, ps.PoolUUID, method.String())
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +127,33 @@ func (svc *mgmtSvc) getPoolService(id string) (*system.PoolService, error) {
 	return ps, nil
 }
 
+// checkPoolAdminRequest checks that a request made by a delegated pool
+// administrator is for a pool that the principal has been granted
+// administration of. Requests made by other clients are not restricted here.
+func (svc *mgmtSvc) checkPoolAdminRequest(ctx context.Context, id string) error {
+	principal := poolAdminFromContext(ctx)
+	if principal == "" {
+		return nil
+	}
+
+	poolUUID, err := svc.resolvePoolID(id)
+	if err != nil {
+		return err
+	}
+
+	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+	if err != nil {
+		return err
+	}
+
+	if !ps.IsAdmin(principal) {
+		return status.Errorf(codes.PermissionDenied, "%q is not an administrator of pool %s",
+			principal, id)
+	}
+
+	return nil
+}
+
 // getPoolServiceRanks returns a slice of ranks designated as the
 // pool service hosts.
 func (svc *mgmtSvc) getPoolServiceRanks(ps *system.PoolService) ([]uint32, error) {
@@ -159,6 +192,37 @@ func minPoolNvme(tgtCount, rankCount uint64) uint64 {
 	return minRankNvme(tgtCount) * rankCount
 }
 
+// minPoolAutoTotal returns the minimum total pool size that satisfies the
+// per-target minimums of both tiers when split according to the given ratios.
+func minPoolAutoTotal(tgtCount, rankCount uint64, ratios []float64) uint64 {
+	minTotal := math.Ceil(float64(minPoolScm(tgtCount, rankCount)) / ratios[0])
+	if ratios[1] > 0 {
+		minTotal = math.Max(minTotal,
+			math.Ceil(float64(minPoolNvme(tgtCount, rankCount))/ratios[1]))
+	}
+
+	return uint64(minTotal)
+}
+
+// checkTierRatio verifies that the storage tier ratios supplied in a pool
+// create request are each in the range 0-1, add up to 1 and allocate some
+// storage to the SCM tier.
+func checkTierRatio(ratios []float64) error {
+	var total float64
+	for _, ratio := range ratios {
+		if ratio < 0 || ratio > 1 {
+			return FaultPoolInvalidTierRatio(ratios)
+		}
+		total += ratio
+	}
+
+	if ratios[0] == 0 || math.Abs(total-1) > poolTierRatioTolerance {
+		return FaultPoolInvalidTierRatio(ratios)
+	}
+
+	return nil
+}
+
 // calculateCreateStorage determines the amount of SCM/NVMe storage to
 // allocate per engine in order to fulfill the create request, if those
 // values are not already supplied as part of the request.
@@ -182,7 +246,14 @@ func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq) error {
 	if len(req.GetTierratio()) == 0 {
 		req.Tierratio = []float64{DefaultPoolScmRatio, DefaultPoolNvmeRatio}
 	} else if len(req.GetTierratio()) == 1 {
-		req.Tierratio = append(req.Tierratio, 0)
+		// A single ratio assigns the remainder to the second tier.
+		req.Tierratio = append(req.Tierratio, math.Max(0, 1-req.Tierratio[0]))
+	}
+
+	if req.GetTotalbytes() > 0 {
+		if err := checkTierRatio(req.Tierratio); err != nil {
+			return err
+		}
 	}
 
 	storagePerRank := func(total uint64) uint64 {
@@ -220,6 +291,11 @@ func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq) error {
 	minPoolTotal := minPoolScm(tgts, ranks)
 	if req.Tierbytes[1] > 0 {
 		minPoolTotal += minPoolNvme(tgts, ranks)
+		if req.GetTotalbytes() > 0 {
+			// The total is split by ratio, so the minimum is determined
+			// by whichever tier reaches its per-rank minimum last.
+			minPoolTotal = minPoolAutoTotal(tgts, ranks, req.Tierratio)
+		}
 	}
 
 	if req.Tierbytes[0] < minRankScm(tgts) {
@@ -243,11 +319,29 @@ func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq) error {
 // Validate minimum SCM/NVMe pool size per VOS target, pool size request params
 // are per-engine so need to be larger than (minimum_target_allocation *
 // target_count).
-func (svc *mgmtSvc) PoolCreate(parent context.Context, req *mgmtpb.PoolCreateReq) (resp *mgmtpb.PoolCreateResp, err error) {
+//
+// If the request is asynchronous, the create runs in the background and the
+// response contains the ID of a job whose progress may be queried with
+// PoolCreateStatus.
+func (svc *mgmtSvc) PoolCreate(parent context.Context, req *mgmtpb.PoolCreateReq) (*mgmtpb.PoolCreateResp, error) {
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
 
+	if req.GetAsync() {
+		if _, err := uuid.Parse(req.GetUuid()); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse pool UUID %q", req.GetUuid())
+		}
+		return svc.startPoolCreateJob(req), nil
+	}
+
+	return svc.poolCreate(parent, req, nil)
+}
+
+// poolCreate creates the pool, reporting progress to the supplied job if the
+// create is asynchronous.
+func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq, job *poolCreateJob) (resp *mgmtpb.PoolCreateResp, err error) {
+	job.setStage("validating request")
 	if err := svc.poolCreateAddSystemProps(req); err != nil {
 		return nil, err
 	}
@@ -257,7 +351,7 @@ func (svc *mgmtSvc) PoolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		return nil, errors.Wrapf(err, "failed to parse pool UUID %q", req.GetUuid())
 	}
 
-	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID, "PoolCreate")
 	if err != nil {
 		return nil, err
 	}
@@ -306,6 +400,10 @@ func (svc *mgmtSvc) PoolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		return nil, err
 	}
 
+	if len(req.GetRanks()) > 0 && req.GetNumranks() > 0 {
+		return nil, errors.New("pool request may not specify both a rank list and a number of ranks")
+	}
+
 	if len(req.GetRanks()) > 0 {
 		// If the request supplies a specific rank list, use it. Note that
 		// the rank list may include downed ranks, in which case the create
@@ -352,6 +450,7 @@ func (svc *mgmtSvc) PoolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 	if len(req.GetRanks()) == 0 {
 		return nil, errors.New("pool request contains zero target ranks")
 	}
+	job.setRanks(req.GetRanks(), poolCreateRankPending)
 
 	// Clamp the maximum allowed svc replicas to the smaller of requested
 	// storage ranks or MaxPoolServiceReps.
@@ -377,6 +476,10 @@ func (svc *mgmtSvc) PoolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		return nil, err
 	}
 
+	if err := job.checkCanceled(); err != nil {
+		return nil, err
+	}
+
 	ps = system.NewPoolService(poolUUID, req.Tierbytes, ranklist.RanksFromUint32(req.GetRanks()))
 	ps.PoolLabel = poolLabel
 	if err := svc.sysdb.AddPoolService(ctx, ps); err != nil {
@@ -384,58 +487,54 @@ func (svc *mgmtSvc) PoolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 	}
 
 	defer func() {
-		var cuErr error
-		switch {
-		// No pool service created; nothing to clean up
-		case ps == nil:
-			return
-		// No error and pool create went OK, nothing to do
-		case err == nil && resp.GetStatus() == 0:
-			return
-		// Error after pool was created
-		case err != nil && resp.GetStatus() == 0:
-			svc.log.Errorf("cleaning up pool %s due to create failure: %q", req.Uuid, err)
-
-			var pdResp *mgmtpb.PoolDestroyResp
-			pdResp, cuErr = svc.PoolDestroy(ctx,
-				&mgmtpb.PoolDestroyReq{
-					Id:       req.Uuid,
-					Sys:      req.Sys,
-					Force:    true,
-					SvcRanks: req.Ranks,
-				})
-			if cuErr != nil {
-				svc.log.Errorf("error while destroying pool %s: %s", req.Uuid, cuErr)
-				break
-			}
-			if pdResp.GetStatus() != 0 {
-				cuErr = errors.Errorf("failed to destroy pool %s: %s",
-					req.Uuid, daos.Status(pdResp.GetStatus()))
-			}
-		}
-
-		if cuErr == nil {
-			svc.log.Debugf("removed pool service entry for %s in cleanup", req.Uuid)
+		// No pool service created or pool create went OK, nothing to do
+		if ps == nil || (err == nil && resp.GetStatus() == 0) {
 			return
 		}
+		svc.rollbackPoolCreate(ctx, ps)
 	}()
 
-	dresp, err := svc.harness.CallDrpc(ctx, drpc.MethodPoolCreate, req)
+	// Cancellation of an asynchronous create interrupts the call to the
+	// engine, after which the deferred rollback destroys any pool shards
+	// already created.
+	drpcCtx := ctx
+	if job != nil {
+		var cancel context.CancelFunc
+		drpcCtx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			select {
+			case <-job.ctx.Done():
+				cancel()
+			case <-drpcCtx.Done():
+			}
+		}()
+	}
+
+	job.setStage(fmt.Sprintf("creating pool shards on %d ranks", len(req.GetRanks())))
+	job.setRanks(nil, poolCreateRankCreating)
+	dresp, err := svc.harness.CallDrpc(drpcCtx, drpc.MethodPoolCreate, req)
 	if err != nil {
 		svc.log.Errorf("pool create dRPC call failed: %s", err)
-		if err := svc.sysdb.RemovePoolService(ctx, ps.PoolUUID); err != nil {
-			return nil, err
-		}
 
 		switch errors.Cause(err) {
-		case errInstanceNotReady:
+		case errInstanceNotReady, FaultHarnessNotStarted, FaultDataPlaneNotStarted:
+			// The request never reached an engine, so no pool shards
+			// can have been created and the service entry can simply
+			// be removed.
+			if err := svc.sysdb.RemovePoolService(ctx, ps.PoolUUID); err != nil {
+				return nil, err
+			}
+			ps = nil
+		}
+
+		if errors.Cause(err) == errInstanceNotReady {
 			// If the pool create failed because there was no available instance
 			// to service the request, signal to the client that it should try again.
 			resp.Status = int32(daos.TryAgain)
 			return resp, nil
-		default:
-			return nil, err
 		}
+		return nil, err
 	}
 
 	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
@@ -443,10 +542,8 @@ func (svc *mgmtSvc) PoolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 	}
 
 	if resp.GetStatus() != 0 {
-		if err := svc.sysdb.RemovePoolService(ctx, ps.PoolUUID); err != nil {
-			return nil, err
-		}
-
+		// Some ranks may have created their shards before the create
+		// failed, the deferred rollback will destroy them.
 		return resp, nil
 	}
 
@@ -534,9 +631,9 @@ func (svc *mgmtSvc) checkPools(parent context.Context, ignCreating bool, psList
 			continue
 		}
 
-		lock, err := svc.sysdb.TakePoolLock(parent, ps.PoolUUID)
+		lock, err := svc.sysdb.TakeBackgroundPoolLock(parent, ps.PoolUUID, "PoolCleanup")
 		if err != nil {
-			if fault.IsFaultCode(err, code.SystemPoolLocked) {
+			if system.IsPoolLocked(err) {
 				svc.log.Noticef("pool %s not cleaned up due to err: %s", ps.PoolUUID, err)
 				continue
 			}
@@ -547,26 +644,7 @@ func (svc *mgmtSvc) checkPools(parent context.Context, ignCreating bool, psList
 
 		svc.log.Errorf("pool %s is in unexpected state %s", ps.PoolUUID, ps.State)
 
-		// Change the pool state to Destroying in order to trigger
-		// the cleanup mode of PoolDestroy(), which will cause the
-		// destroy RPC to be sent to all ranks and then the service
-		// will be removed from the system.
-		if ps.State != system.PoolServiceStateDestroying {
-			ps.State = system.PoolServiceStateDestroying
-			if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
-				return errors.Wrapf(err, "pool %s not updated", ps.PoolUUID)
-			}
-		}
-
-		// Attempt to destroy the pool.
-		dr := &mgmtpb.PoolDestroyReq{
-			Sys:       svc.sysdb.SystemName(),
-			Force:     true,
-			Recursive: true,
-			Id:        ps.PoolUUID.String(),
-		}
-
-		if _, err := svc.PoolDestroy(ctx, dr); err != nil {
+		if err := svc.cleanupPool(ctx, ps); err != nil {
 			// Best effort cleanup. If the pool destroy fails here,
 			// another leadership step-up should get it eventually.
 			svc.log.Errorf("pool %s not destroyed: %s", ps.PoolUUID, err)
@@ -576,6 +654,111 @@ func (svc *mgmtSvc) checkPools(parent context.Context, ignCreating bool, psList
 	return nil
 }
 
+// cleanupPool destroys any pool shards on all ranks and removes the pool
+// service from the system. The caller must hold the pool lock.
+func (svc *mgmtSvc) cleanupPool(ctx context.Context, ps *system.PoolService) error {
+	// Change the pool state to Destroying in order to trigger
+	// the cleanup mode of PoolDestroy(), which will cause the
+	// destroy RPC to be sent to all ranks and then the service
+	// will be removed from the system.
+	if ps.State != system.PoolServiceStateDestroying {
+		ps.State = system.PoolServiceStateDestroying
+		if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+			return errors.Wrapf(err, "pool %s not updated", ps.PoolUUID)
+		}
+	}
+
+	resp, err := svc.PoolDestroy(ctx, &mgmtpb.PoolDestroyReq{
+		Sys:       svc.sysdb.SystemName(),
+		Force:     true,
+		Recursive: true,
+		Id:        ps.PoolUUID.String(),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != 0 {
+		return daos.Status(resp.GetStatus())
+	}
+
+	return nil
+}
+
+// rollbackPoolCreate removes any pool shards created by a failed pool create.
+// If the rollback fails, the pool service is left in the Destroying state so
+// that the partially-created pool remains visible and can be cleaned up later.
+func (svc *mgmtSvc) rollbackPoolCreate(ctx context.Context, ps *system.PoolService) {
+	svc.log.Errorf("rolling back failed create of pool %s", ps.PoolUUID)
+
+	if err := svc.cleanupPool(ctx, ps); err != nil {
+		svc.log.Errorf("pool %s: rollback of failed create incomplete, run "+
+			"\"dmg pool cleanup-partial\" to retry: %s", ps.PoolUUID, err)
+		return
+	}
+
+	svc.log.Debugf("removed pool %s in cleanup", ps.PoolUUID)
+}
+
+// PoolCleanupPartial implements the method defined for the Management Service.
+//
+// Destroy any pools left in an incomplete state by a failed create or destroy
+// in order to reclaim their storage.
+func (svc *mgmtSvc) PoolCleanupPartial(parent context.Context, req *mgmtpb.PoolCleanupPartialReq) (*mgmtpb.PoolCleanupPartialResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	psList, err := svc.sysdb.PoolServiceList(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pool service list")
+	}
+
+	resp := new(mgmtpb.PoolCleanupPartialResp)
+	for _, ps := range psList {
+		if ps.State == system.PoolServiceStateReady {
+			continue
+		}
+
+		result := &mgmtpb.PoolCleanupPartialResp_Pool{
+			Uuid:  ps.PoolUUID.String(),
+			Label: ps.PoolLabel,
+			State: ps.State.String(),
+		}
+		resp.Pools = append(resp.Pools, result)
+
+		if req.GetDryRun() {
+			continue
+		}
+
+		// A pool locked by an in-progress create or destroy is not
+		// stranded, leave it alone.
+		lock, err := svc.sysdb.TakePoolLock(parent, ps.PoolUUID, "PoolCleanupPartial")
+		if err != nil {
+			if system.IsPoolLocked(err) {
+				result.Status = int32(daos.Busy)
+				result.Msg = "pool operation in progress"
+				continue
+			}
+			return nil, err
+		}
+
+		err = svc.cleanupPool(lock.InContext(parent), ps)
+		lock.Release()
+		if err != nil {
+			svc.log.Errorf("pool %s not cleaned up: %s", ps.PoolUUID, err)
+			result.Status = int32(daos.MiscError)
+			if ds, ok := errors.Cause(err).(daos.Status); ok {
+				result.Status = int32(ds)
+			}
+			result.Msg = err.Error()
+			continue
+		}
+		result.Removed = true
+	}
+
+	return resp, nil
+}
+
 func (svc *mgmtSvc) poolHasContainers(ctx context.Context, req *mgmtpb.PoolDestroyReq) (bool, error) {
 	lcReq := &mgmtpb.ListContReq{}
 	lcReq.Sys = req.Sys
@@ -630,7 +813,7 @@ func (svc *mgmtSvc) PoolDestroy(parent context.Context, req *mgmtpb.PoolDestroyR
 		return nil, err
 	}
 
-	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID, "PoolDestroy")
 	if err != nil {
 		return nil, err
 	}
@@ -795,6 +978,9 @@ func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
+	if err := svc.checkPoolAdminRequest(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
 
 	// the IO engine needs the domain tree for placement purposes
 	fdTree, err := svc.membership.CompressedFaultDomainTree(req.Ranks...)
@@ -813,7 +999,14 @@ func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (
 
 	svc.log.Debugf("MgmtSvc.PoolExtend forwarding modified req:%+v\n", req)
 
-	dresp, err := svc.makeLockedPoolServiceCall(ctx, drpc.MethodPoolExtend, req)
+	lock, err := svc.sysdb.TakePoolLock(ctx, ps.PoolUUID, drpc.MethodPoolExtend.String())
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx = lock.InContext(ctx)
+
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolExtend, req)
 	if err != nil {
 		return nil, err
 	}
@@ -823,6 +1016,17 @@ func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (
 		return nil, errors.Wrap(err, "unmarshal PoolExtend response")
 	}
 
+	if resp.GetStatus() != 0 {
+		return resp, nil
+	}
+
+	// Record the new ranks in the MS pool record so that the pool's
+	// size and rank list reflect the extension.
+	ps.Storage = ps.Storage.WithAddedRanks(ranklist.RanksFromUint32(req.GetRanks())...)
+	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+		return nil, errors.Wrapf(err, "failed to update pool %s ranks", ps.PoolUUID)
+	}
+
 	return resp, nil
 }
 
@@ -858,6 +1062,9 @@ func (svc *mgmtSvc) PoolQuery(ctx context.Context, req *mgmtpb.PoolQueryReq) (*m
 	if err := svc.checkReplicaRequest(req); err != nil {
 		return nil, err
 	}
+	if err := svc.checkPoolAdminRequest(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
 
 	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolQuery, req)
 	if err != nil {
@@ -869,6 +1076,14 @@ func (svc *mgmtSvc) PoolQuery(ctx context.Context, req *mgmtpb.PoolQueryReq) (*m
 		return nil, errors.Wrap(err, "unmarshal PoolQuery response")
 	}
 
+	// The engine does not report the pool service membership, so use the
+	// replica ranks recorded in the system database.
+	ps, err := svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	resp.SvcReps = ranklist.RanksToUint32(ps.Replicas)
+
 	return resp, nil
 }
 
@@ -877,6 +1092,9 @@ func (svc *mgmtSvc) PoolQueryTarget(ctx context.Context, req *mgmtpb.PoolQueryTa
 	if err := svc.checkReplicaRequest(req); err != nil {
 		return nil, err
 	}
+	if err := svc.checkPoolAdminRequest(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
 
 	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolQueryTarget, req)
 	if err != nil {
@@ -891,6 +1109,114 @@ func (svc *mgmtSvc) PoolQueryTarget(ctx context.Context, req *mgmtpb.PoolQueryTa
 	return resp, nil
 }
 
+// PoolProbe checks that a pool's service is reachable and reports the status
+// of each of its replicas. Failure to reach the pool service is reported in
+// the response rather than as an error so that callers can report on the
+// health of many pools at once.
+func (svc *mgmtSvc) PoolProbe(ctx context.Context, req *mgmtpb.PoolProbeReq) (*mgmtpb.PoolProbeResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	ps, err := svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.PoolProbeResp{
+		Uuid:   ps.PoolUUID.String(),
+		Health: system.PoolServiceHealthUnavailable,
+	}
+
+	var availRanks []uint32
+	for _, r := range ps.Replicas {
+		rep := &mgmtpb.PoolProbeResp_Replica{Rank: r.Uint32()}
+		resp.Replicas = append(resp.Replicas, rep)
+
+		m, err := svc.sysdb.FindMemberByRank(r)
+		switch {
+		case err == nil:
+			rep.EngineState = m.State.String()
+		case !system.IsMemberNotFound(err):
+			return nil, err
+		}
+		if m == nil || m.State&system.AvailableMemberFilter == 0 {
+			rep.State = system.PoolReplicaStateDown
+			continue
+		}
+		availRanks = append(availRanks, r.Uint32())
+	}
+
+	if len(availRanks) == 0 {
+		resp.Error = "no pool service replicas available"
+		return resp, nil
+	}
+
+	qReq := &mgmtpb.PoolQueryReq{
+		Sys:                  req.GetSys(),
+		Id:                   ps.PoolUUID.String(),
+		SvcRanks:             req.GetSvcRanks(),
+		IncludeDisabledRanks: true,
+	}
+	if len(qReq.SvcRanks) == 0 {
+		qReq.SvcRanks = availRanks
+	}
+
+	dresp, err := svc.harness.CallDrpc(ctx, drpc.MethodPoolQuery, qReq)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	qResp := new(mgmtpb.PoolQueryResp)
+	if err := proto.Unmarshal(dresp.Body, qResp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal PoolQuery response")
+	}
+	if qResp.Status != 0 {
+		resp.Status = qResp.Status
+		resp.Error = daos.Status(qResp.Status).Error()
+		return resp, nil
+	}
+	resp.Leader = qResp.Leader
+	resp.MapVersion = qResp.Version
+
+	disabled, err := ranklist.ParseRanks(qResp.DisabledRanks)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing disabled ranks")
+	}
+	excluded := make(map[uint32]bool)
+	for _, r := range disabled {
+		excluded[r.Uint32()] = true
+	}
+
+	// The service is only healthy if every replica is available, none
+	// have been excluded from the pool map and the current leader is one
+	// of the replicas recorded in the system database.
+	resp.Health = system.PoolServiceHealthHealthy
+	leaderFound := false
+	for _, rep := range resp.Replicas {
+		switch {
+		case rep.State == system.PoolReplicaStateDown:
+			resp.Health = system.PoolServiceHealthDegraded
+		case excluded[rep.Rank]:
+			rep.State = system.PoolReplicaStateExcluded
+			resp.Health = system.PoolServiceHealthDegraded
+		case rep.Rank == qResp.Leader:
+			rep.State = system.PoolReplicaStateLeader
+			leaderFound = true
+		default:
+			// The engine doesn't report replica log positions, so a
+			// follower is only known to be reachable, not in sync.
+			rep.State = system.PoolReplicaStateFollower
+		}
+	}
+	if !leaderFound {
+		resp.Health = system.PoolServiceHealthDegraded
+	}
+
+	return resp, nil
+}
+
 // PoolUpgrade forwards a pool upgrade request to the I/O Engine.
 func (svc *mgmtSvc) PoolUpgrade(ctx context.Context, req *mgmtpb.PoolUpgradeReq) (*mgmtpb.PoolUpgradeResp, error) {
 	if err := svc.checkLeaderRequest(req); err != nil {
@@ -910,6 +1236,173 @@ func (svc *mgmtSvc) PoolUpgrade(ctx context.Context, req *mgmtpb.PoolUpgradeReq)
 	return resp, nil
 }
 
+// PoolSetAdmins replaces the set of principals that have been delegated the
+// administration of a pool. The set is only held in the system database.
+func (svc *mgmtSvc) PoolSetAdmins(parent context.Context, req *mgmtpb.PoolSetAdminsReq) (*mgmtpb.PoolSetAdminsResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	admins := make([]string, 0, len(req.GetAdmins()))
+	seen := make(map[string]struct{})
+	for _, admin := range req.GetAdmins() {
+		if admin == "" || strings.ContainsAny(admin, " \t\n") {
+			return nil, errors.Errorf("invalid pool admin principal %q", admin)
+		}
+		if _, dupe := seen[admin]; dupe {
+			continue
+		}
+		seen[admin] = struct{}{}
+		admins = append(admins, admin)
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID, "PoolSetAdmins")
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx := lock.InContext(parent)
+
+	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	ps.Admins = admins
+	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("pool %s admins set to %v", poolUUID, admins)
+
+	return &mgmtpb.PoolSetAdminsResp{Admins: admins}, nil
+}
+
+// PoolAnnotate sets or removes annotations on a pool. The annotations are only
+// held in the system database.
+func (svc *mgmtSvc) PoolAnnotate(parent context.Context, req *mgmtpb.PoolAnnotateReq) (*mgmtpb.PoolAnnotateResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if len(req.GetAnnotations()) == 0 {
+		return nil, errors.New("no annotations specified")
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID, "PoolAnnotate")
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx := lock.InContext(parent)
+
+	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The current annotations may be shared with the database, so a new
+	// map is always created.
+	notes, err := system.UpdateAnnotations(ps.Annotations, req.GetAnnotations())
+	if err != nil {
+		return nil, err
+	}
+
+	ps.Annotations = notes
+	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("pool %s annotations set to %v", poolUUID, notes)
+
+	return &mgmtpb.PoolAnnotateResp{Annotations: notes}, nil
+}
+
+// PoolSetPolicy forwards a gRPC request to the DAOS I/O Engine to set runtime hints for the
+// scheduling of background tasks (aggregation, rebuild and scrubbing) on a pool's targets.
+func (svc *mgmtSvc) PoolSetPolicy(ctx context.Context, req *mgmtpb.PoolSetPolicyReq) (*mgmtpb.PoolSetPolicyResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.GetRebuildThrottle() > 100 {
+		return nil, errors.Errorf("invalid rebuild throttle %d%%: must be 1-100", req.GetRebuildThrottle())
+	}
+	if req.GetScrubRate() > 100 {
+		return nil, errors.Errorf("invalid scrub rate %d%%: must be 1-100", req.GetScrubRate())
+	}
+	if !req.GetClear() && req.GetAggregation() == mgmtpb.PoolSetPolicyReq_UNCHANGED &&
+		req.GetRebuildThrottle() == 0 && req.GetScrubRate() == 0 {
+		return nil, errors.New("PoolSetPolicy() request with no policy changes")
+	}
+
+	ps, err := svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	// The engine applies the hints on each of the requested ranks directly,
+	// so default to the pool's current ranks as recorded in the MS.
+	poolRanks := ranklist.RankSetFromRanks(ps.Storage.CurrentRanks())
+	if len(req.GetRanks()) == 0 {
+		req.Ranks = ranklist.RanksToUint32(poolRanks.Ranks())
+	}
+	badRanks := ranklist.RankSetFromUint32(req.GetRanks()).Difference(poolRanks)
+	if badRanks.Count() > 0 {
+		return nil, errors.Errorf("pool %s has no targets on ranks %s", ps.PoolUUID, badRanks)
+	}
+
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolSetPolicy, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.PoolSetPolicyResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal PoolSetPolicy response")
+	}
+
+	return resp, nil
+}
+
+// PoolQueryAggregation forwards a gRPC request to the DAOS I/O Engine to report the status of
+// background aggregation (state, lag and last run) on a pool's targets on each engine rank.
+func (svc *mgmtSvc) PoolQueryAggregation(ctx context.Context, req *mgmtpb.PoolQueryAggregationReq) (*mgmtpb.PoolQueryAggregationResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	if err := svc.checkPoolAdminRequest(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	ps, err := svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	// The engine queries each rank hosting the pool's targets directly,
+	// so supply the pool's current ranks as recorded in the MS.
+	req.Ranks = ranklist.RanksToUint32(ps.Storage.CurrentRanks())
+
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolQueryAggregation, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.PoolQueryAggregationResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal PoolQueryAggregation response")
+	}
+
+	return resp, nil
+}
+
 func (svc *mgmtSvc) updatePoolLabel(ctx context.Context, sys string, uuid uuid.UUID, prop *mgmtpb.PoolProperty) error {
 	if prop.GetNumber() != daos.PoolPropertyLabel {
 		return errors.New("updatePoolLabel() called with non-label prop")
@@ -971,13 +1464,16 @@ func (svc *mgmtSvc) PoolSetProp(parent context.Context, req *mgmtpb.PoolSetPropR
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
+	if err := svc.checkPoolAdminRequest(parent, req.GetId()); err != nil {
+		return nil, err
+	}
 
 	poolUUID, err := svc.resolvePoolID(req.GetId())
 	if err != nil {
 		return nil, err
 	}
 
-	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID, "PoolSetProp")
 	if err != nil {
 		return nil, err
 	}
@@ -1028,6 +1524,9 @@ func (svc *mgmtSvc) PoolGetProp(ctx context.Context, req *mgmtpb.PoolGetPropReq)
 	if err := svc.checkReplicaRequest(req); err != nil {
 		return nil, err
 	}
+	if err := svc.checkPoolAdminRequest(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
 
 	// The request must contain a list of expected properties. We don't want
 	// to just let the engine return all properties because not all properties
@@ -1137,19 +1636,38 @@ func (svc *mgmtSvc) ListPools(ctx context.Context, req *mgmtpb.ListPoolsReq) (*m
 		return nil, err
 	}
 
+	mask, err := newFieldMask(new(mgmtpb.ListPoolsResp_Pool), req.Fields, "uuid")
+	if err != nil {
+		return nil, err
+	}
+
 	psList, err := svc.sysdb.PoolServiceList(true)
 	if err != nil {
 		return nil, err
 	}
+	sort.Slice(psList, func(i, j int) bool {
+		return psList[i].PoolUUID.String() < psList[j].PoolUUID.String()
+	})
 
-	resp := new(mgmtpb.ListPoolsResp)
-	for _, ps := range psList {
-		resp.Pools = append(resp.Pools, &mgmtpb.ListPoolsResp_Pool{
-			Uuid:    ps.PoolUUID.String(),
-			Label:   ps.PoolLabel,
-			SvcReps: ranklist.RanksToUint32(ps.Replicas),
-			State:   ps.State.String(),
-		})
+	start, end, next, err := paginate(len(psList), func(i int) string {
+		return psList[i].PoolUUID.String()
+	}, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.ListPoolsResp{NextPageToken: next}
+	for _, ps := range psList[start:end] {
+		pool := &mgmtpb.ListPoolsResp_Pool{
+			Uuid:        ps.PoolUUID.String(),
+			Label:       ps.PoolLabel,
+			SvcReps:     ranklist.RanksToUint32(ps.Replicas),
+			State:       ps.State.String(),
+			Admins:      ps.Admins,
+			Annotations: ps.Annotations,
+		}
+		mask.apply(pool)
+		resp.Pools = append(resp.Pools, pool)
 	}
 
 	v, err := svc.sysdb.DataVersion()
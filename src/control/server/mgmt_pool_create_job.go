@@ -0,0 +1,251 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// poolCreateJobRetention is the length of time that the status of a finished
+// asynchronous pool create job remains available for query.
+const poolCreateJobRetention = time.Hour
+
+const (
+	poolCreateRankPending  = "pending"
+	poolCreateRankCreating = "creating"
+	poolCreateRankCreated  = "created"
+	poolCreateRankFailed   = "failed"
+)
+
+// poolCreateJob tracks the progress of an asynchronous pool create.
+type poolCreateJob struct {
+	sync.RWMutex
+	ctx       context.Context
+	cancel    context.CancelFunc
+	id        uuid.UUID
+	poolUUID  string
+	state     mgmtpb.PoolCreateStatusResp_State
+	stage     string
+	ranks     []uint32
+	rankState string
+	result    *mgmtpb.PoolCreateResp
+	err       error
+	finished  time.Time
+}
+
+func newPoolCreateJob(poolUUID string) *poolCreateJob {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &poolCreateJob{
+		ctx:      ctx,
+		cancel:   cancel,
+		id:       uuid.New(),
+		poolUUID: poolUUID,
+		state:    mgmtpb.PoolCreateStatusResp_RUNNING,
+		stage:    "queued",
+	}
+}
+
+// setStage records the current stage of the create. It is a no-op for a
+// synchronous create, which has no job.
+func (job *poolCreateJob) setStage(stage string) {
+	if job == nil {
+		return
+	}
+	job.Lock()
+	defer job.Unlock()
+
+	job.stage = stage
+}
+
+// setRanks records the ranks selected to host the pool and the state of
+// the pool shards on them. The shards are created by the engines in a
+// single operation, so all ranks share the same state.
+func (job *poolCreateJob) setRanks(ranks []uint32, state string) {
+	if job == nil {
+		return
+	}
+	job.Lock()
+	defer job.Unlock()
+
+	if ranks != nil {
+		job.ranks = ranks
+	}
+	job.rankState = state
+}
+
+// checkCanceled returns an error if cancellation of the job was requested.
+func (job *poolCreateJob) checkCanceled() error {
+	if job == nil {
+		return nil
+	}
+	if job.ctx.Err() != nil {
+		return errors.Errorf("pool create job %s canceled", job.id)
+	}
+	return nil
+}
+
+// finish records the result of the create.
+func (job *poolCreateJob) finish(resp *mgmtpb.PoolCreateResp, err error) {
+	job.Lock()
+	defer job.Unlock()
+
+	job.result = resp
+	job.err = err
+	if err == nil && resp.GetStatus() != 0 {
+		job.err = daos.Status(resp.GetStatus())
+	}
+	job.finished = time.Now()
+	job.stage = "done"
+
+	switch {
+	case job.ctx.Err() != nil && job.err != nil:
+		job.state = mgmtpb.PoolCreateStatusResp_CANCELED
+	case job.err != nil:
+		job.state = mgmtpb.PoolCreateStatusResp_FAILED
+	default:
+		job.state = mgmtpb.PoolCreateStatusResp_COMPLETED
+	}
+	if job.rankState != "" {
+		if job.state == mgmtpb.PoolCreateStatusResp_COMPLETED {
+			job.rankState = poolCreateRankCreated
+		} else if job.rankState == poolCreateRankCreating {
+			job.rankState = poolCreateRankFailed
+		}
+	}
+	job.cancel()
+}
+
+func (job *poolCreateJob) finishedAt() time.Time {
+	job.RLock()
+	defer job.RUnlock()
+
+	return job.finished
+}
+
+func (job *poolCreateJob) isFinished() bool {
+	return !job.finishedAt().IsZero()
+}
+
+func (job *poolCreateJob) toProto() *mgmtpb.PoolCreateStatusResp {
+	job.RLock()
+	defer job.RUnlock()
+
+	resp := &mgmtpb.PoolCreateStatusResp{
+		State:           job.state,
+		JobId:           job.id.String(),
+		PoolUuid:        job.poolUUID,
+		Stage:           job.stage,
+		CancelRequested: job.finished.IsZero() && job.ctx.Err() != nil,
+	}
+	for _, rank := range job.ranks {
+		resp.Ranks = append(resp.Ranks, &mgmtpb.PoolCreateStatusResp_Rank{
+			Rank:  rank,
+			State: job.rankState,
+		})
+	}
+	if job.err != nil {
+		resp.Error = job.err.Error()
+	}
+	if job.result != nil {
+		resp.Result = proto.Clone(job.result).(*mgmtpb.PoolCreateResp)
+	}
+
+	return resp
+}
+
+// poolCreateJobs tracks the asynchronous pool create jobs started on the MS
+// leader. NB: Jobs are not backed by raft and their status is not available
+// after a change of leadership.
+type poolCreateJobs struct {
+	sync.Mutex
+	jobs map[uuid.UUID]*poolCreateJob
+}
+
+func newPoolCreateJobs() *poolCreateJobs {
+	return &poolCreateJobs{
+		jobs: make(map[uuid.UUID]*poolCreateJob),
+	}
+}
+
+// add registers a new job, pruning any finished jobs whose status is no
+// longer retained.
+func (pcj *poolCreateJobs) add(job *poolCreateJob) {
+	pcj.Lock()
+	defer pcj.Unlock()
+
+	for id, j := range pcj.jobs {
+		if j.isFinished() && time.Since(j.finishedAt()) > poolCreateJobRetention {
+			delete(pcj.jobs, id)
+		}
+	}
+	pcj.jobs[job.id] = job
+}
+
+func (pcj *poolCreateJobs) get(id uuid.UUID) (*poolCreateJob, bool) {
+	pcj.Lock()
+	defer pcj.Unlock()
+
+	job, found := pcj.jobs[id]
+	return job, found
+}
+
+// startPoolCreateJob runs the pool create in the background and returns a
+// response containing the ID of the job tracking its progress.
+func (svc *mgmtSvc) startPoolCreateJob(req *mgmtpb.PoolCreateReq) *mgmtpb.PoolCreateResp {
+	req = proto.Clone(req).(*mgmtpb.PoolCreateReq)
+	req.Async = false
+
+	job := newPoolCreateJob(req.GetUuid())
+	svc.poolCreateJobs.add(job)
+	svc.log.Debugf("pool %s: started create job %s", req.GetUuid(), job.id)
+
+	go func() {
+		resp, err := svc.poolCreate(context.Background(), req, job)
+		if err != nil {
+			svc.log.Errorf("pool %s: create job %s failed: %s", req.GetUuid(), job.id, err)
+		}
+		job.finish(resp, err)
+	}()
+
+	return &mgmtpb.PoolCreateResp{JobId: job.id.String()}
+}
+
+// PoolCreateStatus implements the method defined for the Management Service.
+//
+// Report the progress of an asynchronous pool create job, canceling the job
+// if requested. A job canceled before it completes is rolled back.
+func (svc *mgmtSvc) PoolCreateStatus(ctx context.Context, req *mgmtpb.PoolCreateStatusReq) (*mgmtpb.PoolCreateStatusResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	jobID, err := uuid.Parse(req.GetJobId())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse job ID %q", req.GetJobId())
+	}
+
+	job, found := svc.poolCreateJobs.get(jobID)
+	if !found {
+		return nil, errors.Errorf("pool create job %s not found", jobID)
+	}
+
+	if req.GetCancel() && !job.isFinished() {
+		svc.log.Noticef("pool %s: canceling create job %s", job.poolUUID, job.id)
+		job.cancel()
+	}
+
+	return job.toProto(), nil
+}
@@ -0,0 +1,219 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_poolCreateJob_finish(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rankState    string
+		cancel       bool
+		resp         *mgmtpb.PoolCreateResp
+		err          error
+		expState     mgmtpb.PoolCreateStatusResp_State
+		expRankState string
+		expErr       string
+	}{
+		"completed": {
+			rankState:    poolCreateRankCreating,
+			resp:         &mgmtpb.PoolCreateResp{SvcReps: []uint32{0}},
+			expState:     mgmtpb.PoolCreateStatusResp_COMPLETED,
+			expRankState: poolCreateRankCreated,
+		},
+		"failed with error": {
+			rankState:    poolCreateRankCreating,
+			err:          errors.New("whoops"),
+			expState:     mgmtpb.PoolCreateStatusResp_FAILED,
+			expRankState: poolCreateRankFailed,
+			expErr:       "whoops",
+		},
+		"failed with status": {
+			rankState:    poolCreateRankCreating,
+			resp:         &mgmtpb.PoolCreateResp{Status: int32(daos.NoSpace)},
+			expState:     mgmtpb.PoolCreateStatusResp_FAILED,
+			expRankState: poolCreateRankFailed,
+			expErr:       daos.NoSpace.Error(),
+		},
+		"failed before ranks selected": {
+			err:      errors.New("whoops"),
+			expState: mgmtpb.PoolCreateStatusResp_FAILED,
+			expErr:   "whoops",
+		},
+		"canceled before dispatch": {
+			rankState:    poolCreateRankPending,
+			cancel:       true,
+			err:          errors.New("canceled"),
+			expState:     mgmtpb.PoolCreateStatusResp_CANCELED,
+			expRankState: poolCreateRankPending,
+			expErr:       "canceled",
+		},
+		"canceled too late": {
+			rankState:    poolCreateRankCreating,
+			cancel:       true,
+			resp:         &mgmtpb.PoolCreateResp{SvcReps: []uint32{0}},
+			expState:     mgmtpb.PoolCreateStatusResp_COMPLETED,
+			expRankState: poolCreateRankCreated,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			job := newPoolCreateJob(test.MockUUID())
+			if tc.rankState != "" {
+				job.setRanks([]uint32{0, 1}, tc.rankState)
+			}
+			if tc.cancel {
+				job.cancel()
+				if !job.toProto().CancelRequested {
+					t.Fatal("expected cancel to be requested")
+				}
+			}
+
+			job.finish(tc.resp, tc.err)
+
+			got := job.toProto()
+			test.AssertEqual(t, tc.expState, got.State, "unexpected job state")
+			test.AssertEqual(t, tc.expErr, got.Error, "unexpected job error")
+			test.AssertFalse(t, got.CancelRequested, "cancel should not be pending after finish")
+			for _, r := range got.Ranks {
+				test.AssertEqual(t, tc.expRankState, r.State, "unexpected rank state")
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolCreateStatus(t *testing.T) {
+	for name, tc := range map[string]struct {
+		jobID  string
+		expErr error
+	}{
+		"bad job ID": {
+			jobID:  "foo",
+			expErr: errors.New("failed to parse job ID"),
+		},
+		"unknown job": {
+			jobID:  test.MockUUID(9),
+			expErr: errors.New("not found"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			_, gotErr := svc.PoolCreateStatus(context.TODO(), &mgmtpb.PoolCreateStatusReq{
+				Sys:   build.DefaultSystemName,
+				JobId: tc.jobID,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolCreate_Async(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	ec := engine.MockConfig().
+		WithTargetCount(1).
+		WithStorage(
+			storage.NewTierConfig().
+				WithStorageClass("ram").
+				WithScmMountPoint("/foo/bar"),
+			storage.NewTierConfig().
+				WithStorageClass("nvme").
+				WithBdevDeviceList("foo", "bar"),
+		)
+	sp := storage.NewProvider(log, 0, &ec.Storage, nil, nil, nil)
+	svc.harness.instances[0] = newTestEngine(log, false, sp, ec)
+
+	respBytes, err := proto.Marshal(&mgmtpb.PoolCreateResp{SvcReps: []uint32{0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dc := newMockDrpcClient(&mockDrpcClientConfig{IsConnectedBool: true})
+	dc.cfg.setSendMsgResponse(drpc.Status_SUCCESS, respBytes, nil)
+	svc.harness.instances[0].(*EngineInstance)._drpcClient = dc
+
+	for _, m := range []*system.Member{
+		system.MockMember(t, 0, system.MemberStateJoined),
+		system.MockMember(t, 1, system.MemberStateJoined),
+	} {
+		if err := svc.sysdb.AddMember(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resp, err := svc.PoolCreate(context.TODO(), &mgmtpb.PoolCreateReq{
+		Sys:        build.DefaultSystemName,
+		Uuid:       test.MockUUID(),
+		Totalbytes: 100 * humanize.GiByte,
+		Properties: testPoolLabelProp(),
+		Async:      true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JobId == "" {
+		t.Fatal("expected job ID in response")
+	}
+
+	statusReq := &mgmtpb.PoolCreateStatusReq{
+		Sys:   build.DefaultSystemName,
+		JobId: resp.JobId,
+	}
+	var status *mgmtpb.PoolCreateStatusResp
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(time.Millisecond) {
+		status, err = svc.PoolCreateStatus(context.TODO(), statusReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.State != mgmtpb.PoolCreateStatusResp_RUNNING {
+			break
+		}
+	}
+
+	expStatus := &mgmtpb.PoolCreateStatusResp{
+		State:    mgmtpb.PoolCreateStatusResp_COMPLETED,
+		JobId:    resp.JobId,
+		PoolUuid: test.MockUUID(),
+		Stage:    "done",
+		Ranks: []*mgmtpb.PoolCreateStatusResp_Rank{
+			{Rank: 0, State: poolCreateRankCreated},
+			{Rank: 1, State: poolCreateRankCreated},
+		},
+		Result: &mgmtpb.PoolCreateResp{SvcReps: []uint32{0}},
+	}
+	if diff := cmp.Diff(expStatus, status, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected job status (-want, +got)\n%s\n", diff)
+	}
+
+	ps, err := svc.sysdb.FindPoolServiceByUUID(test.MockPoolUUID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, system.PoolServiceStateReady, ps.State, "unexpected pool state")
+}
@@ -41,7 +41,7 @@ func getPoolLockCtx(t *testing.T, parent context.Context, sysdb *raft.Database,
 		parent = context.Background()
 	}
 
-	lock, err := sysdb.TakePoolLock(parent, poolUUID)
+	lock, err := sysdb.TakePoolLock(parent, poolUUID, "test")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -210,6 +210,42 @@ func TestServer_MgmtSvc_calculateCreateStorage(t *testing.T) {
 			},
 			expErr: FaultPoolNvmeTooSmall(uint64(float64(nvmeTooSmallReq)*DefaultPoolNvmeRatio), minPoolNvme),
 		},
+		"auto sizing (single tier ratio)": {
+			in: &mgmtpb.PoolCreateReq{
+				Totalbytes: defaultTotal,
+				Tierratio:  []float64{DefaultPoolScmRatio},
+				Ranks:      []uint32{0, 1},
+			},
+			expOut: &mgmtpb.PoolCreateReq{
+				Tierbytes: []uint64{defaultScmBytes / 2, defaultNvmeBytes / 2},
+				Tierratio: []float64{0, 0},
+				Ranks:     []uint32{0, 1},
+			},
+		},
+		"auto sizing (tier ratios don't add up)": {
+			in: &mgmtpb.PoolCreateReq{
+				Totalbytes: defaultTotal,
+				Tierratio:  []float64{0.1, 0.8},
+				Ranks:      []uint32{0},
+			},
+			expErr: FaultPoolInvalidTierRatio([]float64{0.1, 0.8}),
+		},
+		"auto sizing (tier ratio out of range)": {
+			in: &mgmtpb.PoolCreateReq{
+				Totalbytes: defaultTotal,
+				Tierratio:  []float64{1.5, -0.5},
+				Ranks:      []uint32{0},
+			},
+			expErr: FaultPoolInvalidTierRatio([]float64{1.5, -0.5}),
+		},
+		"auto sizing (zero SCM ratio)": {
+			in: &mgmtpb.PoolCreateReq{
+				Totalbytes: defaultTotal,
+				Tierratio:  []float64{0, 1},
+				Ranks:      []uint32{0},
+			},
+			expErr: FaultPoolInvalidTierRatio([]float64{0, 1}),
+		},
 		"auto sizing (no NVMe in config)": {
 			disableNVMe: true,
 			in: &mgmtpb.PoolCreateReq{
@@ -414,6 +450,17 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 			},
 			expErr: FaultPoolInvalidNumRanks(3, 2),
 		},
+		"failed creation with both ranks and number of ranks": {
+			targetCount: 1,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:       test.MockUUID(1),
+				Totalbytes: 100 * humanize.GiByte,
+				Ranks:      []uint32{0},
+				Numranks:   1,
+				Properties: testPoolLabelProp(),
+			},
+			expErr: errors.New("both a rank list and a number of ranks"),
+		},
 		"svc replicas > max": {
 			targetCount: 1,
 			memberCount: MaxPoolServiceReps + 2,
@@ -613,6 +660,235 @@ func TestServer_MgmtSvc_PoolCreateDownRanks(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_PoolCreateRollback(t *testing.T) {
+	poolUUID := test.MockUUID(1)
+
+	for name, tc := range map[string]struct {
+		destroyResp  *mockDrpcResponse
+		expStatus    daos.Status
+		expDrpcCalls int
+		expPoolState system.PoolServiceState
+		expRemoved   bool
+	}{
+		"partial create rolled back": {
+			destroyResp:  &mockDrpcResponse{Message: &mgmtpb.PoolDestroyResp{}},
+			expStatus:    daos.NoSpace,
+			expDrpcCalls: 2,
+			expRemoved:   true,
+		},
+		"rollback fails": {
+			destroyResp:  &mockDrpcResponse{Error: errors.New("destroy failed")},
+			expStatus:    daos.NoSpace,
+			expDrpcCalls: 2,
+			expPoolState: system.PoolServiceStateDestroying,
+		},
+		"rollback returns error status": {
+			destroyResp:  &mockDrpcResponse{Message: &mgmtpb.PoolDestroyResp{Status: int32(daos.TimedOut)}},
+			expStatus:    daos.NoSpace,
+			expDrpcCalls: 2,
+			expPoolState: system.PoolServiceStateDestroying,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			svc := newTestMgmtSvc(t, log)
+			ec := engine.MockConfig().
+				WithTargetCount(1).
+				WithStorage(
+					storage.NewTierConfig().
+						WithStorageClass("ram").
+						WithScmMountPoint("/foo/bar"),
+				)
+			sp := storage.NewProvider(log, 0, &ec.Storage, nil, nil, nil)
+			svc.harness.instances[0] = newTestEngine(log, false, sp, ec)
+
+			dc := newMockDrpcClient(&mockDrpcClientConfig{IsConnectedBool: true})
+			dc.cfg.setSendMsgResponseList(t,
+				&mockDrpcResponse{Message: &mgmtpb.PoolCreateResp{Status: int32(daos.NoSpace)}},
+				tc.destroyResp,
+			)
+			svc.harness.instances[0].(*EngineInstance)._drpcClient = dc
+
+			if err := svc.sysdb.AddMember(system.MockMember(t, 0, system.MemberStateJoined)); err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := svc.PoolCreate(ctx, &mgmtpb.PoolCreateReq{
+				Sys:        build.DefaultSystemName,
+				Uuid:       poolUUID,
+				Tierbytes:  []uint64{100 * humanize.GiByte},
+				Properties: testPoolLabelProp(),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expStatus, daos.Status(resp.GetStatus()), "unexpected status")
+
+			test.AssertEqual(t, tc.expDrpcCalls, len(dc.calls), "unexpected number of dRPC calls")
+			test.AssertEqual(t, drpc.MethodPoolDestroy, dc.calls[len(dc.calls)-1].Method, "unexpected dRPC method")
+
+			ps, err := svc.sysdb.FindPoolServiceByUUID(uuid.MustParse(poolUUID))
+			if tc.expRemoved {
+				if err == nil {
+					t.Fatal("pool service not removed after rollback")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expPoolState, ps.State, "unexpected pool state")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolCleanupPartial(t *testing.T) {
+	readyUUID := uuid.MustParse(test.MockUUID(1))
+	creatingUUID := uuid.MustParse(test.MockUUID(2))
+	destroyingUUID := uuid.MustParse(test.MockUUID(3))
+
+	for name, tc := range map[string]struct {
+		req        *mgmtpb.PoolCleanupPartialReq
+		lockPool   bool
+		drpcResp   *mgmtpb.PoolDestroyResp
+		expResp    *mgmtpb.PoolCleanupPartialResp
+		expRemoved []uuid.UUID
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"dry run": {
+			req: &mgmtpb.PoolCleanupPartialReq{DryRun: true},
+			expResp: &mgmtpb.PoolCleanupPartialResp{
+				Pools: []*mgmtpb.PoolCleanupPartialResp_Pool{
+					{Uuid: creatingUUID.String(), Label: "creating", State: "Creating"},
+					{Uuid: destroyingUUID.String(), Label: "destroying", State: "Destroying"},
+				},
+			},
+		},
+		"pools removed": {
+			req:      &mgmtpb.PoolCleanupPartialReq{},
+			drpcResp: &mgmtpb.PoolDestroyResp{},
+			expResp: &mgmtpb.PoolCleanupPartialResp{
+				Pools: []*mgmtpb.PoolCleanupPartialResp_Pool{
+					{Uuid: creatingUUID.String(), Label: "creating", State: "Creating", Removed: true},
+					{Uuid: destroyingUUID.String(), Label: "destroying", State: "Destroying", Removed: true},
+				},
+			},
+			expRemoved: []uuid.UUID{creatingUUID, destroyingUUID},
+		},
+		"destroy fails": {
+			req:      &mgmtpb.PoolCleanupPartialReq{},
+			drpcResp: &mgmtpb.PoolDestroyResp{Status: int32(daos.TimedOut)},
+			expResp: &mgmtpb.PoolCleanupPartialResp{
+				Pools: []*mgmtpb.PoolCleanupPartialResp_Pool{
+					{
+						Uuid:   creatingUUID.String(),
+						Label:  "creating",
+						State:  "Creating",
+						Status: int32(daos.TimedOut),
+						Msg:    daos.TimedOut.Error(),
+					},
+					{
+						Uuid:   destroyingUUID.String(),
+						Label:  "destroying",
+						State:  "Destroying",
+						Status: int32(daos.TimedOut),
+						Msg:    daos.TimedOut.Error(),
+					},
+				},
+			},
+		},
+		"pool operation in progress": {
+			req:      &mgmtpb.PoolCleanupPartialReq{},
+			lockPool: true,
+			drpcResp: &mgmtpb.PoolDestroyResp{},
+			expResp: &mgmtpb.PoolCleanupPartialResp{
+				Pools: []*mgmtpb.PoolCleanupPartialResp_Pool{
+					{
+						Uuid:   creatingUUID.String(),
+						Label:  "creating",
+						State:  "Creating",
+						Status: int32(daos.Busy),
+						Msg:    "pool operation in progress",
+					},
+					{Uuid: destroyingUUID.String(), Label: "destroying", State: "Destroying", Removed: true},
+				},
+			},
+			expRemoved: []uuid.UUID{destroyingUUID},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			svc := newTestMgmtSvc(t, log)
+			if _, err := svc.membership.Add(system.MockMember(t, 0, system.MemberStateJoined)); err != nil {
+				t.Fatal(err)
+			}
+			for _, ps := range []*system.PoolService{
+				{PoolUUID: readyUUID, PoolLabel: "ready", State: system.PoolServiceStateReady},
+				{PoolUUID: creatingUUID, PoolLabel: "creating", State: system.PoolServiceStateCreating},
+				{PoolUUID: destroyingUUID, PoolLabel: "destroying", State: system.PoolServiceStateDestroying},
+			} {
+				ps.Replicas = []ranklist.Rank{0}
+				ps.Storage = &system.PoolServiceStorage{}
+				lock, lockCtx := getPoolLockCtx(t, nil, svc.sysdb, ps.PoolUUID)
+				if err := svc.sysdb.AddPoolService(lockCtx, ps); err != nil {
+					t.Fatal(err)
+				}
+				lock.Release()
+			}
+			if tc.drpcResp != nil {
+				setupMockDrpcClient(svc, tc.drpcResp, nil)
+			}
+
+			if tc.lockPool {
+				lock, err := svc.sysdb.TakePoolLock(ctx, creatingUUID, "test")
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer lock.Release()
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolCleanupPartial(ctx, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			sortPools := protocmp.SortRepeated(func(a, b *mgmtpb.PoolCleanupPartialResp_Pool) bool {
+				return a.Uuid < b.Uuid
+			})
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform(), sortPools); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			if _, err := svc.sysdb.FindPoolServiceByUUID(readyUUID); err != nil {
+				t.Fatalf("ready pool removed: %s", err)
+			}
+			for _, id := range tc.expRemoved {
+				if _, err := svc.sysdb.FindPoolServiceByUUID(id); err == nil {
+					t.Fatalf("pool %s not removed", id)
+				}
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_PoolDestroy(t *testing.T) {
 	testLog, _ := logging.NewTestLogger(t.Name())
 	missingSB := newTestMgmtSvc(t, testLog)
@@ -1019,6 +1295,7 @@ func TestServer_MgmtSvc_PoolExtend(t *testing.T) {
 		setupMockDrpc func(_ *mgmtSvc, _ error)
 		req           *mgmtpb.PoolExtendReq
 		expResp       *mgmtpb.PoolExtendResp
+		expRanks      string
 		expErr        error
 	}{
 		"nil request": {
@@ -1061,6 +1338,14 @@ func TestServer_MgmtSvc_PoolExtend(t *testing.T) {
 			expResp: &mgmtpb.PoolExtendResp{
 				TierBytes: []uint64{scmAllocation, nvmeAllocation},
 			},
+			expRanks: "[0-1]",
+		},
+		"extend fails; ranks not recorded": {
+			req: &mgmtpb.PoolExtendReq{Id: mockUUID, Ranks: []uint32{1}},
+			expResp: &mgmtpb.PoolExtendResp{
+				Status: int32(daos.MiscError),
+			},
+			expRanks: "0",
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -1098,6 +1383,12 @@ func TestServer_MgmtSvc_PoolExtend(t *testing.T) {
 			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
 			}
+
+			ps, err := tc.mgmtSvc.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expRanks, ps.Storage.CurrentRankStr, "unexpected pool ranks")
 		})
 	}
 }
@@ -1369,6 +1660,73 @@ func TestListPools_Success(t *testing.T) {
 	}
 }
 
+func TestListPools_Paginated(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	// Add pools out of order to verify that they are listed by UUID.
+	for _, idx := range []int32{3, 1, 2} {
+		ps := &system.PoolService{
+			PoolUUID:  test.MockPoolUUID(idx),
+			PoolLabel: fmt.Sprintf("pool%d", idx),
+			State:     system.PoolServiceStateReady,
+			Replicas:  []ranklist.Rank{0},
+		}
+		lock, ctx := getPoolLockCtx(t, nil, svc.sysdb, ps.PoolUUID)
+		if err := svc.sysdb.AddPoolService(ctx, ps); err != nil {
+			t.Fatal(err)
+		}
+		lock.Release()
+	}
+
+	listPage := func(token string, fields ...string) (*mgmtpb.ListPoolsResp, error) {
+		req := newTestListPoolsReq()
+		req.PageSize = 2
+		req.PageToken = token
+		req.Fields = fields
+		return svc.ListPools(context.TODO(), req)
+	}
+	cmpPools := func(t *testing.T, exp, got []*mgmtpb.ListPoolsResp_Pool) {
+		t.Helper()
+		if diff := cmp.Diff(exp, got, test.DefaultCmpOpts()...); diff != "" {
+			t.Fatalf("bad pools (-want, +got): \n%s\n", diff)
+		}
+	}
+
+	resp, err := listPage("", "label")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmpPools(t, []*mgmtpb.ListPoolsResp_Pool{
+		{Uuid: test.MockPoolUUID(1).String(), Label: "pool1"},
+		{Uuid: test.MockPoolUUID(2).String(), Label: "pool2"},
+	}, resp.Pools)
+	if resp.NextPageToken == "" {
+		t.Fatal("expected a next page token")
+	}
+
+	resp, err = listPage(resp.NextPageToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmpPools(t, []*mgmtpb.ListPoolsResp_Pool{
+		{
+			Uuid:    test.MockPoolUUID(3).String(),
+			Label:   "pool3",
+			SvcReps: []uint32{0},
+			State:   system.PoolServiceStateReady.String(),
+		},
+	}, resp.Pools)
+	test.AssertEqual(t, "", resp.NextPageToken, "expected last page")
+
+	_, err = listPage("", "bogus")
+	test.CmpErr(t, errors.New("unknown field"), err)
+
+	_, err = listPage("!not-a-token!")
+	test.CmpErr(t, errors.New("invalid page token"), err)
+}
+
 func newTestGetACLReq() *mgmtpb.GetACLReq {
 	return &mgmtpb.GetACLReq{
 		Sys: build.DefaultSystemName,
@@ -1773,8 +2131,16 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 			req: &mgmtpb.PoolQueryReq{
 				Id: mockUUID,
 			},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				setupMockDrpcClient(svc, &mgmtpb.PoolQueryResp{
+					Uuid:         mockUUID,
+					TotalTargets: 8,
+				}, err)
+			},
 			expResp: &mgmtpb.PoolQueryResp{
-				Uuid: mockUUID,
+				Uuid:         mockUUID,
+				TotalTargets: 8,
+				SvcReps:      []uint32{0},
 			},
 		},
 	} {
@@ -1813,6 +2179,241 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_PoolProbe(t *testing.T) {
+	replica := func(rank uint32, state, engineState string) *mgmtpb.PoolProbeResp_Replica {
+		return &mgmtpb.PoolProbeResp_Replica{
+			Rank:        rank,
+			State:       state,
+			EngineState: engineState,
+		}
+	}
+	joined := system.MemberStateJoined.String()
+	stopped := system.MemberStateStopped.String()
+
+	for name, tc := range map[string]struct {
+		req           *mgmtpb.PoolProbeReq
+		stoppedRanks  []ranklist.Rank
+		setupMockDrpc func(_ *mgmtSvc)
+		expDrpcReq    *mgmtpb.PoolQueryReq
+		expResp       *mgmtpb.PoolProbeResp
+		expErr        error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolProbeReq{Id: mockUUID, Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.PoolProbeReq{Id: test.MockUUID(9)},
+			expErr: errors.New("unable to find pool"),
+		},
+		"all replicas down": {
+			req:          &mgmtpb.PoolProbeReq{Id: mockUUID},
+			stoppedRanks: []ranklist.Rank{0, 1, 2},
+			expResp: &mgmtpb.PoolProbeResp{
+				Uuid:   mockUUID,
+				Health: system.PoolServiceHealthUnavailable,
+				Replicas: []*mgmtpb.PoolProbeResp_Replica{
+					replica(0, system.PoolReplicaStateDown, stopped),
+					replica(1, system.PoolReplicaStateDown, stopped),
+					replica(2, system.PoolReplicaStateDown, stopped),
+				},
+				Error: "no pool service replicas available",
+			},
+		},
+		"dRPC send fails": {
+			req: &mgmtpb.PoolProbeReq{Id: mockUUID},
+			setupMockDrpc: func(svc *mgmtSvc) {
+				setupMockDrpcClient(svc, nil, errors.New("send failure"))
+			},
+			expResp: &mgmtpb.PoolProbeResp{
+				Uuid:   mockUUID,
+				Health: system.PoolServiceHealthUnavailable,
+				Replicas: []*mgmtpb.PoolProbeResp_Replica{
+					replica(0, "", joined),
+					replica(1, "", joined),
+					replica(2, "", joined),
+				},
+				Error: "failed to send 58B message: send failure",
+			},
+		},
+		"garbage resp": {
+			req: &mgmtpb.PoolProbeReq{Id: mockUUID},
+			setupMockDrpc: func(svc *mgmtSvc) {
+				setupMockDrpcClientBytes(svc, makeBadBytes(42), nil)
+			},
+			expErr: errors.New("unmarshal"),
+		},
+		"query fails": {
+			req: &mgmtpb.PoolProbeReq{Id: mockUUID},
+			setupMockDrpc: func(svc *mgmtSvc) {
+				setupMockDrpcClient(svc, &mgmtpb.PoolQueryResp{
+					Status: int32(daos.TimedOut),
+				}, nil)
+			},
+			expResp: &mgmtpb.PoolProbeResp{
+				Status: int32(daos.TimedOut),
+				Uuid:   mockUUID,
+				Health: system.PoolServiceHealthUnavailable,
+				Replicas: []*mgmtpb.PoolProbeResp_Replica{
+					replica(0, "", joined),
+					replica(1, "", joined),
+					replica(2, "", joined),
+				},
+				Error: daos.TimedOut.Error(),
+			},
+		},
+		"healthy": {
+			req: &mgmtpb.PoolProbeReq{Id: mockUUID},
+			setupMockDrpc: func(svc *mgmtSvc) {
+				setupMockDrpcClient(svc, &mgmtpb.PoolQueryResp{
+					Leader:  1,
+					Version: 5,
+				}, nil)
+			},
+			expDrpcReq: &mgmtpb.PoolQueryReq{
+				Sys:                  build.DefaultSystemName,
+				Id:                   mockUUID,
+				SvcRanks:             []uint32{0, 1, 2},
+				IncludeDisabledRanks: true,
+			},
+			expResp: &mgmtpb.PoolProbeResp{
+				Uuid:       mockUUID,
+				Health:     system.PoolServiceHealthHealthy,
+				Leader:     1,
+				MapVersion: 5,
+				Replicas: []*mgmtpb.PoolProbeResp_Replica{
+					replica(0, system.PoolReplicaStateFollower, joined),
+					replica(1, system.PoolReplicaStateLeader, joined),
+					replica(2, system.PoolReplicaStateFollower, joined),
+				},
+			},
+		},
+		"replica down": {
+			req:          &mgmtpb.PoolProbeReq{Id: mockUUID},
+			stoppedRanks: []ranklist.Rank{2},
+			setupMockDrpc: func(svc *mgmtSvc) {
+				setupMockDrpcClient(svc, &mgmtpb.PoolQueryResp{
+					Leader:  0,
+					Version: 5,
+				}, nil)
+			},
+			expDrpcReq: &mgmtpb.PoolQueryReq{
+				Sys:                  build.DefaultSystemName,
+				Id:                   mockUUID,
+				SvcRanks:             []uint32{0, 1},
+				IncludeDisabledRanks: true,
+			},
+			expResp: &mgmtpb.PoolProbeResp{
+				Uuid:       mockUUID,
+				Health:     system.PoolServiceHealthDegraded,
+				MapVersion: 5,
+				Replicas: []*mgmtpb.PoolProbeResp_Replica{
+					replica(0, system.PoolReplicaStateLeader, joined),
+					replica(1, system.PoolReplicaStateFollower, joined),
+					replica(2, system.PoolReplicaStateDown, stopped),
+				},
+			},
+		},
+		"replica excluded": {
+			req: &mgmtpb.PoolProbeReq{Id: mockUUID},
+			setupMockDrpc: func(svc *mgmtSvc) {
+				setupMockDrpcClient(svc, &mgmtpb.PoolQueryResp{
+					Leader:        0,
+					Version:       6,
+					DisabledRanks: "1",
+				}, nil)
+			},
+			expResp: &mgmtpb.PoolProbeResp{
+				Uuid:       mockUUID,
+				Health:     system.PoolServiceHealthDegraded,
+				MapVersion: 6,
+				Replicas: []*mgmtpb.PoolProbeResp_Replica{
+					replica(0, system.PoolReplicaStateLeader, joined),
+					replica(1, system.PoolReplicaStateExcluded, joined),
+					replica(2, system.PoolReplicaStateFollower, joined),
+				},
+			},
+		},
+		"leader not a replica": {
+			req: &mgmtpb.PoolProbeReq{Id: mockUUID},
+			setupMockDrpc: func(svc *mgmtSvc) {
+				setupMockDrpcClient(svc, &mgmtpb.PoolQueryResp{
+					Leader:  3,
+					Version: 5,
+				}, nil)
+			},
+			expResp: &mgmtpb.PoolProbeResp{
+				Uuid:       mockUUID,
+				Health:     system.PoolServiceHealthDegraded,
+				Leader:     3,
+				MapVersion: 5,
+				Replicas: []*mgmtpb.PoolProbeResp_Replica{
+					replica(0, system.PoolReplicaStateFollower, joined),
+					replica(1, system.PoolReplicaStateFollower, joined),
+					replica(2, system.PoolReplicaStateFollower, joined),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPoolService(t, svc.sysdb, &system.PoolService{
+				PoolUUID:  uuid.MustParse(mockUUID),
+				PoolLabel: "test-pool",
+				State:     system.PoolServiceStateReady,
+				Replicas:  []ranklist.Rank{0, 1, 2},
+			})
+
+			var results system.MemberResults
+			for _, r := range tc.stoppedRanks {
+				results = append(results, &system.MemberResult{
+					Rank:  r,
+					State: system.MemberStateStopped,
+				})
+			}
+			if err := svc.membership.UpdateMemberStates(results, true); err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.setupMockDrpc != nil {
+				tc.setupMockDrpc(svc)
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolProbe(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := test.DefaultCmpOpts()
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			if tc.expDrpcReq == nil {
+				return
+			}
+			gotReq := new(mgmtpb.PoolQueryReq)
+			if err := proto.Unmarshal(getLastMockCall(svc).Body, gotReq); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expDrpcReq, gotReq, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected dRPC call (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func getLastMockCall(svc *mgmtSvc) *drpc.Call {
 	mi := svc.harness.instances[0].(*EngineInstance)
 	if mi == nil || mi._drpcClient == nil {
@@ -2035,6 +2636,214 @@ func TestServer_MgmtSvc_PoolGetProp(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_PoolSetPolicy(t *testing.T) {
+	testPoolService := &system.PoolService{
+		PoolUUID:  uuid.MustParse(mockUUID),
+		PoolLabel: "test-pool",
+		State:     system.PoolServiceStateReady,
+		Replicas:  []ranklist.Rank{0},
+		Storage: &system.PoolServiceStorage{
+			CreationRankStr: "0-2",
+			CurrentRankStr:  "0-2",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		setupMockDrpc func(_ *mgmtSvc, _ error)
+		req           *mgmtpb.PoolSetPolicyReq
+		expResp       *mgmtpb.PoolSetPolicyResp
+		expRanks      []uint32
+		expErr        error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolSetPolicyReq{Id: mockUUID, Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"no changes": {
+			req:    &mgmtpb.PoolSetPolicyReq{Id: mockUUID},
+			expErr: errors.New("no policy changes"),
+		},
+		"invalid rebuild throttle": {
+			req:    &mgmtpb.PoolSetPolicyReq{Id: mockUUID, RebuildThrottle: 101},
+			expErr: errors.New("invalid rebuild throttle"),
+		},
+		"invalid scrub rate": {
+			req:    &mgmtpb.PoolSetPolicyReq{Id: mockUUID, ScrubRate: 200},
+			expErr: errors.New("invalid scrub rate"),
+		},
+		"rank not in pool": {
+			req: &mgmtpb.PoolSetPolicyReq{
+				Id:          mockUUID,
+				Ranks:       []uint32{1, 3},
+				Aggregation: mgmtpb.PoolSetPolicyReq_DISABLED,
+			},
+			expErr: errors.New("has no targets on ranks 3"),
+		},
+		"unknown pool": {
+			req: &mgmtpb.PoolSetPolicyReq{
+				Id:          "other-pool",
+				Ranks:       []uint32{1},
+				Aggregation: mgmtpb.PoolSetPolicyReq_DISABLED,
+			},
+			expErr: errors.New("unable to find pool"),
+		},
+		"dRPC send fails": {
+			req:    &mgmtpb.PoolSetPolicyReq{Id: mockUUID, Clear: true},
+			expErr: errors.New("send failure"),
+		},
+		"garbage resp": {
+			req: &mgmtpb.PoolSetPolicyReq{Id: mockUUID, Clear: true},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				// dRPC call returns junk in the message body
+				badBytes := makeBadBytes(42)
+
+				setupMockDrpcClientBytes(svc, badBytes, err)
+			},
+			expErr: errors.New("unmarshal"),
+		},
+		"clear on all pool ranks": {
+			req:      &mgmtpb.PoolSetPolicyReq{Id: mockUUID, Clear: true},
+			expResp:  &mgmtpb.PoolSetPolicyResp{},
+			expRanks: []uint32{0, 1, 2},
+		},
+		"set on ranks by label": {
+			req: &mgmtpb.PoolSetPolicyReq{
+				Id:              "test-pool",
+				Ranks:           []uint32{0, 2},
+				Aggregation:     mgmtpb.PoolSetPolicyReq_DISABLED,
+				RebuildThrottle: 25,
+				ScrubRate:       10,
+			},
+			expResp:  &mgmtpb.PoolSetPolicyResp{},
+			expRanks: []uint32{0, 2},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPoolService(t, svc.sysdb, testPoolService)
+
+			if tc.setupMockDrpc == nil {
+				tc.setupMockDrpc = func(svc *mgmtSvc, err error) {
+					setupMockDrpcClient(svc, tc.expResp, tc.expErr)
+				}
+			}
+			tc.setupMockDrpc(svc, tc.expErr)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolSetPolicy(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := test.DefaultCmpOpts()
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+			test.AssertEqual(t, mockUUID, tc.req.GetId(), "pool ID not resolved to UUID")
+			test.AssertEqual(t, []uint32{0}, tc.req.GetSvcRanks(), "unexpected service ranks")
+			test.AssertEqual(t, tc.expRanks, tc.req.GetRanks(), "unexpected ranks")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolQueryAggregation(t *testing.T) {
+	testPoolService := &system.PoolService{
+		PoolUUID:  uuid.MustParse(mockUUID),
+		PoolLabel: "test-pool",
+		State:     system.PoolServiceStateReady,
+		Replicas:  []ranklist.Rank{0},
+		Storage: &system.PoolServiceStorage{
+			CurrentRankStr: "[0-2]",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		setupMockDrpc func(_ *mgmtSvc, _ error)
+		req           *mgmtpb.PoolQueryAggregationReq
+		expResp       *mgmtpb.PoolQueryAggregationResp
+		expErr        error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolQueryAggregationReq{Id: mockUUID, Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.PoolQueryAggregationReq{Id: "other-pool"},
+			expErr: errors.New("unable to find pool"),
+		},
+		"dRPC send fails": {
+			req:    &mgmtpb.PoolQueryAggregationReq{Id: mockUUID},
+			expErr: errors.New("send failure"),
+		},
+		"garbage resp": {
+			req: &mgmtpb.PoolQueryAggregationReq{Id: mockUUID},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				// dRPC call returns junk in the message body
+				badBytes := makeBadBytes(42)
+
+				setupMockDrpcClientBytes(svc, badBytes, err)
+			},
+			expErr: errors.New("unmarshal"),
+		},
+		"successful query by label": {
+			req: &mgmtpb.PoolQueryAggregationReq{Id: "test-pool"},
+			expResp: &mgmtpb.PoolQueryAggregationResp{
+				Uuid: mockUUID,
+				Ranks: []*mgmtpb.PoolQueryAggregationResp_Rank{
+					{Rank: 0, State: "running", Lag: 45, LastRun: 1685613600},
+					{Rank: 1, State: "paused", Lag: 3600},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPoolService(t, svc.sysdb, testPoolService)
+
+			if tc.setupMockDrpc == nil {
+				tc.setupMockDrpc = func(svc *mgmtSvc, err error) {
+					setupMockDrpcClient(svc, tc.expResp, tc.expErr)
+				}
+			}
+			tc.setupMockDrpc(svc, tc.expErr)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolQueryAggregation(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := test.DefaultCmpOpts()
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+			test.AssertEqual(t, mockUUID, tc.req.GetId(), "pool ID not resolved to UUID")
+			test.AssertEqual(t, []uint32{0}, tc.req.GetSvcRanks(), "unexpected service ranks")
+			test.AssertEqual(t, []uint32{0, 1, 2}, tc.req.GetRanks(), "unexpected pool ranks")
+		})
+	}
+}
+
 func TestServer_MgmtSvc_PoolUpgrade(t *testing.T) {
 	testLog, _ := logging.NewTestLogger(t.Name())
 	missingSB := newTestMgmtSvc(t, testLog)
@@ -2127,3 +2936,211 @@ func TestServer_MgmtSvc_PoolUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_MgmtSvc_PoolSetAdmins(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req       *mgmtpb.PoolSetAdminsReq
+		expResp   *mgmtpb.PoolSetAdminsResp
+		expAdmins []string
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolSetAdminsReq{Id: mockUUID, Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.PoolSetAdminsReq{Id: "missing", Admins: []string{"alice"}},
+			expErr: system.ErrPoolLabelNotFound("missing"),
+		},
+		"invalid principal": {
+			req:    &mgmtpb.PoolSetAdminsReq{Id: mockUUID, Admins: []string{"alice", "bad user"}},
+			expErr: errors.New("invalid pool admin principal"),
+		},
+		"empty principal": {
+			req:    &mgmtpb.PoolSetAdminsReq{Id: mockUUID, Admins: []string{""}},
+			expErr: errors.New("invalid pool admin principal"),
+		},
+		"set by label; duplicates removed": {
+			req: &mgmtpb.PoolSetAdminsReq{
+				Id:     "test-pool",
+				Admins: []string{"alice", "bob", "alice"},
+			},
+			expResp: &mgmtpb.PoolSetAdminsResp{
+				Admins: []string{"alice", "bob"},
+			},
+			expAdmins: []string{"alice", "bob"},
+		},
+		"clear": {
+			req:       &mgmtpb.PoolSetAdminsReq{Id: mockUUID},
+			expResp:   &mgmtpb.PoolSetAdminsResp{Admins: []string{}},
+			expAdmins: []string{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			ps := testPoolService()
+			ps.Admins = []string{"carol"}
+			addTestPoolService(t, svc.sysdb, ps)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolSetAdmins(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			gotPS, err := svc.sysdb.FindPoolServiceByUUID(ps.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expAdmins, gotPS.Admins, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("unexpected pool admins (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolAnnotate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req      *mgmtpb.PoolAnnotateReq
+		expResp  *mgmtpb.PoolAnnotateResp
+		expNotes map[string]string
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolAnnotateReq{Id: mockUUID, Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"no annotations": {
+			req:    &mgmtpb.PoolAnnotateReq{Id: mockUUID},
+			expErr: errors.New("no annotations"),
+		},
+		"unknown pool": {
+			req: &mgmtpb.PoolAnnotateReq{
+				Id:          "missing",
+				Annotations: map[string]string{"owner": "alice"},
+			},
+			expErr: system.ErrPoolLabelNotFound("missing"),
+		},
+		"invalid annotation": {
+			req: &mgmtpb.PoolAnnotateReq{
+				Id:          mockUUID,
+				Annotations: map[string]string{"bad key": "x"},
+			},
+			expErr: errors.New("invalid character"),
+		},
+		"set and remove by label": {
+			req: &mgmtpb.PoolAnnotateReq{
+				Id:          "test-pool",
+				Annotations: map[string]string{"owner": "alice", "ticket": ""},
+			},
+			expResp: &mgmtpb.PoolAnnotateResp{
+				Annotations: map[string]string{"owner": "alice", "purpose": "scratch"},
+			},
+			expNotes: map[string]string{"owner": "alice", "purpose": "scratch"},
+		},
+		"remove all": {
+			req: &mgmtpb.PoolAnnotateReq{
+				Id:          mockUUID,
+				Annotations: map[string]string{"purpose": "", "ticket": ""},
+			},
+			expResp: &mgmtpb.PoolAnnotateResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			ps := testPoolService()
+			ps.Annotations = map[string]string{"purpose": "scratch", "ticket": "42"}
+			addTestPoolService(t, svc.sysdb, ps)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolAnnotate(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			gotPS, err := svc.sysdb.FindPoolServiceByUUID(ps.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expNotes, gotPS.Annotations); diff != "" {
+				t.Fatalf("unexpected pool annotations (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_checkPoolAdminRequest(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ctx    context.Context
+		id     string
+		expErr error
+	}{
+		"no peer": {
+			ctx: context.TODO(),
+			id:  mockUUID,
+		},
+		"system admin": {
+			ctx: newTestAuthCtx(context.TODO(), "admin"),
+			id:  mockUUID,
+		},
+		"delegated admin; by uuid": {
+			ctx: newTestAuthCtx(context.TODO(), "pool-admin:alice"),
+			id:  mockUUID,
+		},
+		"delegated admin; by label": {
+			ctx: newTestAuthCtx(context.TODO(), "pool-admin:alice"),
+			id:  "test-pool",
+		},
+		"not a delegated admin of pool": {
+			ctx:    newTestAuthCtx(context.TODO(), "pool-admin:bob"),
+			id:     mockUUID,
+			expErr: errors.New("not an administrator of pool"),
+		},
+		"delegated admin; unknown pool": {
+			ctx:    newTestAuthCtx(context.TODO(), "pool-admin:alice"),
+			id:     "missing",
+			expErr: system.ErrPoolLabelNotFound("missing"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			ps := testPoolService()
+			ps.Admins = []string{"alice"}
+			addTestPoolService(t, svc.sysdb, ps)
+
+			gotErr := svc.checkPoolAdminRequest(tc.ctx, tc.id)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
@@ -7,6 +7,7 @@
 package server
 
 import (
+	"context"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -16,9 +17,12 @@ import (
 	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/clock"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/system"
 	"github.com/daos-stack/daos/src/control/system/raft"
 )
@@ -35,9 +39,15 @@ type mgmtSvc struct {
 	events            *events.PubSub
 	systemProps       daos.SystemPropertyMap
 	clientNetworkHint *mgmtpb.ClientNetHint
+	clientNetMap      config.ClientNetMap
 	joinReqs          joinReqChan
 	groupUpdateReqs   chan bool
 	lastMapVer        uint32
+	heartbeats        *heartbeatTracker
+	eventWatchers     *eventWatchers
+	poolCreateJobs    *poolCreateJobs
+	certSigner        *security.CertSigner
+	clock             clock.Clock
 }
 
 func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c control.UnaryInvoker, p *events.PubSub) *mgmtSvc {
@@ -52,6 +62,10 @@ func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c cont
 		clientNetworkHint: new(mgmtpb.ClientNetHint),
 		joinReqs:          make(joinReqChan),
 		groupUpdateReqs:   make(chan bool),
+		heartbeats:        newHeartbeatTracker(),
+		eventWatchers:     newEventWatchers(),
+		poolCreateJobs:    newPoolCreateJobs(),
+		clock:             clock.New(),
 	}
 }
 
@@ -96,3 +110,13 @@ func (svc *mgmtSvc) checkReplicaRequest(req proto.Message) error {
 	}
 	return svc.checkSystemRequest(req)
 }
+
+// Noop performs no work beyond validating the request. It may be called on
+// any server and is used to measure control-plane RPC overhead.
+func (svc *mgmtSvc) Noop(ctx context.Context, req *mgmtpb.NoopReq) (*mgmtpb.NoopResp, error) {
+	if err := svc.checkSystemRequest(req); err != nil {
+		return nil, err
+	}
+
+	return new(mgmtpb.NoopResp), nil
+}
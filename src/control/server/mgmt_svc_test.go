@@ -7,6 +7,7 @@
 package server
 
 import (
+	"context"
 	"net"
 	"testing"
 
@@ -92,3 +93,43 @@ func TestServer_MgmtSvc_checkSystemRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_MgmtSvc_Noop(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *mgmtpb.NoopReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req: &mgmtpb.NoopReq{
+				Sys: "wrong",
+			},
+			expErr: errors.New("does not match"),
+		},
+		"success": {
+			req: &mgmtpb.NoopReq{
+				Sys:     build.DefaultSystemName,
+				Payload: make([]byte, 1024),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+
+			resp, err := svc.Noop(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if resp == nil {
+				t.Fatal("expected non-nil response")
+			}
+		})
+	}
+}
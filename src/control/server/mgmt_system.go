@@ -8,6 +8,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"os"
@@ -35,7 +36,9 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
 )
 
 // GetAttachInfo handles a request to retrieve a map of ranks to fabric URIs, in addition
@@ -57,12 +60,25 @@ func (svc *mgmtSvc) GetAttachInfo(ctx context.Context, req *mgmtpb.GetAttachInfo
 		return nil, err
 	}
 
+	netMap := svc.clientNetMapEntry(ctx)
+	rankURI := func(rank ranklist.Rank) string {
+		entry := groupMap.RankEntries[rank]
+		if netMap != nil {
+			if uri := netMap.SelectURI(append([]string{entry.URI}, entry.SecondaryURIs...)...); uri != "" {
+				return uri
+			}
+			svc.log.Debugf("rank %d has no URI matching client_net_map entry for %s",
+				rank, netMap.ClientSubnet)
+		}
+		return entry.URI
+	}
+
 	resp := new(mgmtpb.GetAttachInfoResp)
 	if req.GetAllRanks() {
-		for rank, entry := range groupMap.RankEntries {
+		for rank := range groupMap.RankEntries {
 			resp.RankUris = append(resp.RankUris, &mgmtpb.GetAttachInfoResp_RankUri{
 				Rank: rank.Uint32(),
-				Uri:  entry.URI,
+				Uri:  rankURI(rank),
 			})
 		}
 	} else {
@@ -72,11 +88,16 @@ func (svc *mgmtSvc) GetAttachInfo(ctx context.Context, req *mgmtpb.GetAttachInfo
 		for _, rank := range groupMap.MSRanks {
 			resp.RankUris = append(resp.RankUris, &mgmtpb.GetAttachInfoResp_RankUri{
 				Rank: rank.Uint32(),
-				Uri:  groupMap.RankEntries[rank].URI,
+				Uri:  rankURI(rank),
 			})
 		}
 	}
 	resp.ClientNetHint = svc.clientNetworkHint
+	if netMap != nil && netMap.Provider != "" {
+		hint := proto.Clone(svc.clientNetworkHint).(*mgmtpb.ClientNetHint)
+		hint.Provider = netMap.Provider
+		resp.ClientNetHint = hint
+	}
 	resp.MsRanks = ranklist.RanksToUint32(groupMap.MSRanks)
 
 	v, err := svc.sysdb.DataVersion()
@@ -88,6 +109,25 @@ func (svc *mgmtSvc) GetAttachInfo(ctx context.Context, req *mgmtpb.GetAttachInfo
 	return resp, nil
 }
 
+// clientNetMapEntry returns the client_net_map entry for the subnet of the
+// client that sent the request, or nil if there is none.
+func (svc *mgmtSvc) clientNetMapEntry(ctx context.Context) *config.ClientNetMapEntry {
+	if len(svc.clientNetMap) == 0 {
+		return nil
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tcpAddr, ok := p.Addr.(*net.TCPAddr)
+	if !ok {
+		return nil
+	}
+
+	return svc.clientNetMap.Lookup(tcpAddr.IP)
+}
+
 // LeaderQuery returns the system leader and access point replica details.
 func (svc *mgmtSvc) LeaderQuery(ctx context.Context, req *mgmtpb.LeaderQueryReq) (*mgmtpb.LeaderQueryResp, error) {
 	if err := svc.checkSystemRequest(req); err != nil {
@@ -143,9 +183,10 @@ const (
 type (
 	batchJoinRequest struct {
 		mgmtpb.JoinReq
-		peerAddr *net.TCPAddr
-		joinCtx  context.Context
-		respCh   chan *batchJoinResponse
+		peerAddr   *net.TCPAddr
+		joinCtx    context.Context
+		respCh     chan *batchJoinResponse
+		progressCh chan *mgmtpb.JoinProgress
 	}
 
 	batchJoinResponse struct {
@@ -156,6 +197,23 @@ type (
 	joinReqChan chan *batchJoinRequest
 )
 
+// joinProgressBufSize allows for one update per stage so that reporting
+// progress never blocks the join loop.
+var joinProgressBufSize = len(mgmtpb.JoinProgress_Stage_name)
+
+// reportProgress sends a progress update to the requester if a streaming join
+// was requested. Updates are dropped rather than allowed to block.
+func (r *batchJoinRequest) reportProgress(stage mgmtpb.JoinProgress_Stage, rank uint32, info string) {
+	if r.progressCh == nil {
+		return
+	}
+
+	select {
+	case r.progressCh <- &mgmtpb.JoinProgress{Stage: stage, Rank: rank, Info: info}:
+	default:
+	}
+}
+
 func (svc *mgmtSvc) startJoinLoop(ctx context.Context) {
 	svc.log.Debug("starting joinLoop")
 	go svc.joinLoop(ctx)
@@ -226,6 +284,13 @@ func (svc *mgmtSvc) joinLoop(parent context.Context) {
 						}
 					}
 				}
+			} else {
+				for i, req := range joinReqs {
+					if joinResps[i].joinErr == nil {
+						req.reportProgress(mgmtpb.JoinProgress_MEMBERSHIP_CONFIRMED,
+							joinResps[i].Rank, "group map updated on engines")
+					}
+				}
 			}
 
 			svc.log.Debugf("sending %d join responses", len(joinReqs))
@@ -244,6 +309,28 @@ func (svc *mgmtSvc) joinLoop(parent context.Context) {
 	}
 }
 
+// newEngineJoinedEvent creates an event to notify subscribers, e.g. agents
+// caching attach info, that a rank has joined or rejoined the system.
+func newEngineJoinedEvent(member *system.Member) *events.RASEvent {
+	evt := events.NewGenericEvent(events.RASEngineJoined, events.RASSeverityNotice,
+		fmt.Sprintf("rank %d joined the system", member.Rank), "")
+	evt.Rank = member.Rank.Uint32()
+	evt.Incarnation = member.Incarnation
+
+	return evt
+}
+
+// newEngineDuplicateEvent creates an event to notify administrators that a
+// join was rejected because it conflicts with a running member on another
+// host, identifying both hosts.
+func newEngineDuplicateEvent(dupErr *system.ErrDuplicateMember) *events.RASEvent {
+	evt := events.NewGenericEvent(events.RASEngineDuplicate, events.RASSeverityError,
+		dupErr.Error(), "")
+	evt.Rank = dupErr.Rank.Uint32()
+
+	return evt
+}
+
 func (svc *mgmtSvc) join(ctx context.Context, req *batchJoinRequest) *batchJoinResponse {
 	uuid, err := uuid.Parse(req.GetUuid())
 	if err != nil {
@@ -258,17 +345,23 @@ func (svc *mgmtSvc) join(ctx context.Context, req *batchJoinRequest) *batchJoinR
 			joinErr: errors.Wrapf(err, "invalid server fault domain %q", req.GetSrvFaultDomain()),
 		}
 	}
+	req.reportProgress(mgmtpb.JoinProgress_VALIDATED, 0, "superblock identity and fault domain validated")
 
 	joinResponse, err := svc.membership.Join(&system.JoinRequest{
-		Rank:           ranklist.Rank(req.Rank),
-		UUID:           uuid,
-		ControlAddr:    req.peerAddr,
-		FabricURI:      req.GetUri(),
-		FabricContexts: req.GetNctxs(),
-		FaultDomain:    fd,
-		Incarnation:    req.GetIncarnation(),
+		Rank:                ranklist.Rank(req.Rank),
+		UUID:                uuid,
+		ControlAddr:         req.peerAddr,
+		FabricURI:           req.GetUri(),
+		FabricContexts:      req.GetNctxs(),
+		SecondaryFabricURIs: req.GetSecondaryUris(),
+		FaultDomain:         fd,
+		Incarnation:         req.GetIncarnation(),
 	})
 	if err != nil {
+		if dupErr, ok := errors.Cause(err).(*system.ErrDuplicateMember); ok {
+			svc.log.Errorf("rejected join: %s", dupErr)
+			svc.events.Publish(newEngineDuplicateEvent(dupErr))
+		}
 		return &batchJoinResponse{joinErr: err}
 	}
 
@@ -280,6 +373,9 @@ func (svc *mgmtSvc) join(ctx context.Context, req *batchJoinRequest) *batchJoinR
 		svc.log.Debugf("updated system member: rank %d, uri %s, %s->%s",
 			member.Rank, member.FabricURI, joinResponse.PrevState, member.State)
 	}
+	req.reportProgress(mgmtpb.JoinProgress_RANK_ASSIGNED, member.Rank.Uint32(),
+		fmt.Sprintf("rank %d assigned", member.Rank))
+	svc.events.Publish(newEngineJoinedEvent(member))
 
 	resp := &batchJoinResponse{
 		JoinResp: mgmtpb.JoinResp{
@@ -288,6 +384,14 @@ func (svc *mgmtSvc) join(ctx context.Context, req *batchJoinRequest) *batchJoinR
 		},
 	}
 
+	prime, err := svc.joinPrimeData()
+	if err != nil {
+		// Priming is an optimization; the rank can still look up
+		// anything it needs once joined.
+		svc.log.Noticef("rank %d: unable to collect join prime data: %s", member.Rank, err)
+	}
+	resp.Prime = prime
+
 	// If the rank is local to the MS leader, then we need to wire up at least
 	// one in order to perform a CaRT group update.
 	if common.IsLocalAddr(req.peerAddr) && req.Idx == 0 {
@@ -311,6 +415,32 @@ func (svc *mgmtSvc) join(ctx context.Context, req *batchJoinRequest) *batchJoinR
 	return resp
 }
 
+// joinPrimeData collects the system state to be pushed to a newly joined rank
+// in the join response, in order to save it from having to look each item up
+// lazily when it first services client I/O.
+func (svc *mgmtSvc) joinPrimeData() (*mgmtpb.JoinPrimeData, error) {
+	mapVersion, err := svc.sysdb.CurMapVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get map version")
+	}
+
+	poolSvcs, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pool services")
+	}
+
+	prime := &mgmtpb.JoinPrimeData{MapVersion: mapVersion}
+	for _, ps := range poolSvcs {
+		prime.Pools = append(prime.Pools, &mgmtpb.JoinPrimeData_Pool{
+			Uuid:    ps.PoolUUID.String(),
+			Label:   ps.PoolLabel,
+			SvcReps: ranklist.RanksToUint32(ps.Replicas),
+		})
+	}
+
+	return prime, nil
+}
+
 // reqGroupUpdate requests a group update.
 func (svc *mgmtSvc) reqGroupUpdate(ctx context.Context, sync bool) {
 	select {
@@ -398,23 +528,78 @@ func (svc *mgmtSvc) doGroupUpdate(ctx context.Context, forced bool) error {
 // The reply address is generated by combining peer (sender) IP (from context)
 // with listening port from joining instance's host addr contained in the
 // provided request.
-func (svc *mgmtSvc) Join(ctx context.Context, req *mgmtpb.JoinReq) (resp *mgmtpb.JoinResp, err error) {
+func (svc *mgmtSvc) Join(ctx context.Context, req *mgmtpb.JoinReq) (*mgmtpb.JoinResp, error) {
+	return svc.submitJoin(ctx, req, nil)
+}
+
+// JoinStream management service gRPC handler processes a Join request in the
+// same way as the unary handler, but streams progress updates to the caller as
+// the request passes through the join loop. The final update carries the join
+// response.
+func (svc *mgmtSvc) JoinStream(req *mgmtpb.JoinReq, stream mgmtpb.MgmtSvc_JoinStreamServer) error {
+	type joinResult struct {
+		resp *mgmtpb.JoinResp
+		err  error
+	}
+
+	progressCh := make(chan *mgmtpb.JoinProgress, joinProgressBufSize)
+	doneCh := make(chan joinResult, 1)
+	go func() {
+		resp, err := svc.submitJoin(stream.Context(), req, progressCh)
+		doneCh <- joinResult{resp: resp, err: err}
+	}()
+
+	for {
+		select {
+		case progress := <-progressCh:
+			if err := stream.Send(progress); err != nil {
+				return err
+			}
+		case result := <-doneCh:
+			for len(progressCh) > 0 {
+				if err := stream.Send(<-progressCh); err != nil {
+					return err
+				}
+			}
+			if result.err != nil {
+				return result.err
+			}
+
+			return stream.Send(&mgmtpb.JoinProgress{
+				Stage: mgmtpb.JoinProgress_DONE,
+				Rank:  result.resp.Rank,
+				Info:  "join complete",
+				Resp:  result.resp,
+			})
+		}
+	}
+}
+
+// submitJoin queues the request for the join loop and waits for the response.
+// If progressCh is non-nil, progress updates are sent to it along the way.
+func (svc *mgmtSvc) submitJoin(ctx context.Context, req *mgmtpb.JoinReq, progressCh chan *mgmtpb.JoinProgress) (resp *mgmtpb.JoinResp, err error) {
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
 
+	if injectJoinDrop(ctx) {
+		return nil, ctx.Err()
+	}
+
 	replyAddr, err := getPeerListenAddr(ctx, req.GetAddr())
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to parse %q into a peer control address", req.GetAddr())
 	}
 
 	bjr := &batchJoinRequest{
-		JoinReq:  *req,
-		peerAddr: replyAddr,
-		joinCtx:  ctx,
-		respCh:   make(chan *batchJoinResponse),
+		JoinReq:    *req,
+		peerAddr:   replyAddr,
+		joinCtx:    ctx,
+		respCh:     make(chan *batchJoinResponse),
+		progressCh: progressCh,
 	}
 
+	bjr.reportProgress(mgmtpb.JoinProgress_QUEUED, 0, "join request queued")
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -440,10 +625,9 @@ type (
 	systemRanksFunc func(context.Context, control.UnaryInvoker, *control.RanksReq) (*control.RanksResp, error)
 
 	fanoutRequest struct {
-		Method     systemRanksFunc
-		Ranks      *ranklist.RankSet
-		Force      bool
-		FullSystem bool
+		Method systemRanksFunc
+		Ranks  *ranklist.RankSet
+		Force  bool
 	}
 
 	fanoutResponse struct {
@@ -495,7 +679,7 @@ func (svc *mgmtSvc) resolveRanks(hosts, ranks string) (hitRS, missRS *ranklist.R
 
 // synthesise "Stopped" rank results for any harness host errors
 func addUnresponsiveResults(log logging.Logger, hostRanks map[string][]ranklist.Rank, rr *control.RanksResp, resp *fanoutResponse) {
-	for _, hes := range rr.HostErrors {
+	for _, hes := range rr.HostErrors.ErrorSets() {
 		for _, addr := range strings.Split(hes.HostSet.DerangedString(), ",") {
 			for _, rank := range hostRanks[addr] {
 				resp.Results = append(resp.Results,
@@ -504,9 +688,8 @@ func addUnresponsiveResults(log logging.Logger, hostRanks map[string][]ranklist.
 						State: system.MemberStateUnresponsive,
 					})
 			}
-			log.Debugf("harness %s (ranks %v) host error: %s", addr, hostRanks[addr],
-				hes.HostError)
 		}
+		log.Debugf("harness host error: %s", hes)
 	}
 }
 
@@ -628,6 +811,11 @@ func (svc *mgmtSvc) SystemQuery(ctx context.Context, req *mgmtpb.SystemQueryReq)
 		return nil, err
 	}
 
+	mask, err := newFieldMask(new(mgmtpb.SystemMember), req.Fields, "rank")
+	if err != nil {
+		return nil, err
+	}
+
 	hitRanks, missRanks, missHosts, err := svc.resolveRanks(req.Hosts, req.Ranks)
 	if err != nil {
 		return nil, err
@@ -647,10 +835,22 @@ func (svc *mgmtSvc) SystemQuery(ctx context.Context, req *mgmtpb.SystemQueryReq)
 		return resp, nil
 	}
 
+	// Members are returned in rank order.
 	members := svc.membership.Members(hitRanks)
-	if err := convert.Types(members, &resp.Members); err != nil {
+	start, end, next, err := paginate(len(members), func(i int) string {
+		return rankPageKey(members[i].Rank.Uint32())
+	}, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp.NextPageToken = next
+
+	if err := convert.Types(members[start:end], &resp.Members); err != nil {
 		return nil, err
 	}
+	for _, m := range resp.Members {
+		mask.apply(m)
+	}
 
 	v, err := svc.sysdb.DataVersion()
 	if err != nil {
@@ -707,31 +907,85 @@ func (svc *mgmtSvc) getFanout(req systemReq) (*fanoutRequest, *fanoutResponse, e
 	if err != nil {
 		return nil, nil, err
 	}
-	allRanks, err := svc.membership.RankList()
-	if err != nil {
-		return nil, nil, err
-	}
-
 	force := false
 	if forceReq, ok := req.(interface{ GetForce() bool }); ok {
 		force = forceReq.GetForce()
 	}
 	return &fanoutRequest{
-			Ranks:      hitRanks,
-			Force:      force,
-			FullSystem: len(ranklist.CheckRankMembership(hitRanks.Ranks(), allRanks)) == 0,
+			Ranks: hitRanks,
+			Force: force,
 		}, &fanoutResponse{
 			AbsentRanks: missRanks,
 			AbsentHosts: missHosts,
 		}, nil
 }
 
+// poolSvcReplicaRanks returns the set of ranks hosting pool service replicas.
+func (svc *mgmtSvc) poolSvcReplicaRanks() (*ranklist.RankSet, error) {
+	psList, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return nil, err
+	}
+
+	replicaRanks := ranklist.MustCreateRankSet("")
+	for _, ps := range psList {
+		for _, r := range ps.Replicas {
+			replicaRanks.Add(r)
+		}
+	}
+
+	return replicaRanks, nil
+}
+
+// stopRanks fans out the stop request to the selected ranks. Unless the
+// request is forced, ranks hosting pool service replicas are stopped after all
+// other ranks so that the pool services remain available while the rest of
+// the selected ranks drain.
+func (svc *mgmtSvc) stopRanks(ctx context.Context, fReq *fanoutRequest, fResp *fanoutResponse) (*fanoutResponse, error) {
+	waves := []*ranklist.RankSet{fReq.Ranks}
+	if !fReq.Force {
+		replicaRanks, err := svc.poolSvcReplicaRanks()
+		if err != nil {
+			return nil, err
+		}
+
+		psRanks := fReq.Ranks.Intersect(replicaRanks)
+		otherRanks := fReq.Ranks.Difference(replicaRanks)
+		if psRanks.Count() > 0 && otherRanks.Count() > 0 {
+			svc.log.Debugf("stopping ranks %s before pool service replica ranks %s",
+				otherRanks, psRanks)
+			waves = []*ranklist.RankSet{otherRanks, psRanks}
+		}
+	}
+
+	var results system.MemberResults
+	for _, ranks := range waves {
+		waveReq := *fReq
+		waveReq.Ranks = ranks
+
+		waveResp, _, err := svc.rpcFanout(ctx, &waveReq, &fanoutResponse{
+			AbsentHosts: fResp.AbsentHosts,
+			AbsentRanks: fResp.AbsentRanks,
+		}, true)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, waveResp.Results...)
+	}
+	fResp.Results = results
+
+	return fResp, nil
+}
+
 // SystemStop implements the method defined for the Management Service.
 //
 // Initiate two-phase controlled shutdown of DAOS system, return results for
 // each selected rank. First phase results in "PrepShutdown" dRPC requests being
 // issued to each rank and the second phase stops the running executable
-// processes associated with each rank.
+// processes associated with each rank. The first phase is skipped if the
+// request is forced. In an unforced stop, ranks hosting pool service replicas
+// are stopped last. Ranks that complete the first phase but are not stopped
+// are reported in the response.
 //
 // This control service method is triggered from the control API method of the
 // same name in lib/control/system.go and returns results from all selected ranks.
@@ -747,23 +1001,35 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 	}
 
 	// First phase: Prepare the ranks for shutdown, but only if the request
-	// is for an unforced full system stop.
-	if fReq.FullSystem && !fReq.Force {
+	// is unforced.
+	prepped := ranklist.MustCreateRankSet("")
+	if !fReq.Force {
 		fReq.Method = control.PrepShutdownRanks
 		fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
 		if err != nil {
 			return nil, err
 		}
+		for _, r := range fResp.Results {
+			if !r.Errored {
+				prepped.Add(r.Rank)
+			}
+		}
 		if fResp.Results.Errors() != nil {
 			// return early if not forced and prep shutdown fails
-			return processStopResp("prep shutdown", fResp, svc.events)
+			resp, err := processStopResp("prep shutdown", fResp, svc.events)
+			if err != nil {
+				return nil, err
+			}
+			resp.Prepranks = prepped.String()
+
+			return resp, nil
 		}
 	}
 
 	// Second phase: Stop the ranks. If the request is forced, we will
 	// kill the ranks immediately without a graceful shutdown.
 	fReq.Method = control.StopRanks
-	fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
+	fResp, err = svc.stopRanks(ctx, fReq, fResp)
 	if err != nil {
 		return nil, err
 	}
@@ -773,6 +1039,13 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 		return nil, err
 	}
 
+	for _, r := range fResp.Results {
+		if !r.Errored {
+			prepped.Delete(r.Rank)
+		}
+	}
+	resp.Prepranks = prepped.String()
+
 	return resp, nil
 }
 
@@ -884,6 +1157,135 @@ func (svc *mgmtSvc) SystemExclude(ctx context.Context, req *mgmtpb.SystemExclude
 	return resp, nil
 }
 
+// SystemSetMemberAlias sets or clears the display alias of a system member.
+func (svc *mgmtSvc) SystemSetMemberAlias(ctx context.Context, req *mgmtpb.SystemSetMemberAliasReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	m, err := svc.membership.SetAlias(ranklist.Rank(req.GetRank()), req.GetAlias())
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Alias == "" {
+		svc.log.Debugf("cleared alias of rank %d", m.Rank)
+	} else {
+		svc.log.Debugf("set alias of rank %d to %q", m.Rank, m.Alias)
+	}
+
+	return &mgmtpb.DaosResp{}, nil
+}
+
+// resolveReplicaAddr resolves the control address of a MS replica, using the
+// default control port if none is specified.
+func resolveReplicaAddr(replica string) (*net.TCPAddr, error) {
+	addrs, err := common.ParseHostList([]string{replica}, build.DefaultControlPort)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) != 1 {
+		return nil, errors.Errorf("expected a single replica address, got %q", replica)
+	}
+
+	return net.ResolveTCPAddr("tcp", addrs[0])
+}
+
+func newSystemReplicasUpdatedEvent(replicas []string) *events.RASEvent {
+	return events.NewGenericEvent(events.RASSystemReplicasUpdated, events.RASSeverityNotice,
+		fmt.Sprintf("MS replicas updated: %s", strings.Join(replicas, ",")), "")
+}
+
+// SystemAddReplica adds a control plane server to the set of MS replicas. The
+// server must be configured to run a MS replica and must have joined the
+// system. A new replica does not vote until a further request promotes it.
+func (svc *mgmtSvc) SystemAddReplica(ctx context.Context, req *mgmtpb.SystemReplicaReq) (*mgmtpb.SystemReplicaResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	addr, err := resolveReplicaAddr(req.GetReplica())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPromote() {
+		replicas, err := svc.sysdb.PromoteReplica(addr)
+		if err != nil {
+			return nil, err
+		}
+		return &mgmtpb.SystemReplicaResp{Replicas: replicas}, nil
+	}
+
+	replicas, err := svc.sysdb.AddReplica(addr)
+	if err != nil {
+		return nil, err
+	}
+	svc.events.Publish(newSystemReplicasUpdatedEvent(replicas))
+
+	return &mgmtpb.SystemReplicaResp{Replicas: replicas}, nil
+}
+
+// SystemRemoveReplica removes a control plane server from the set of MS
+// replicas.
+func (svc *mgmtSvc) SystemRemoveReplica(ctx context.Context, req *mgmtpb.SystemReplicaReq) (*mgmtpb.SystemReplicaResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	addr, err := resolveReplicaAddr(req.GetReplica())
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := svc.sysdb.RemoveReplica(addr)
+	if err != nil {
+		return nil, err
+	}
+	svc.events.Publish(newSystemReplicasUpdatedEvent(replicas))
+
+	return &mgmtpb.SystemReplicaResp{Replicas: replicas}, nil
+}
+
+// SystemAnnotate sets or removes annotations on a set of system members. All
+// of the members must exist for any of them to be annotated.
+func (svc *mgmtSvc) SystemAnnotate(ctx context.Context, req *mgmtpb.SystemAnnotateReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if len(req.GetAnnotations()) == 0 {
+		return nil, errors.New("no annotations specified")
+	}
+	for k, v := range req.GetAnnotations() {
+		if err := system.ValidateAnnotation(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	rs, err := ranklist.CreateRankSet(req.GetRanks())
+	if err != nil {
+		return nil, err
+	}
+	if rs.Count() == 0 {
+		return nil, errors.New("no ranks specified")
+	}
+	for _, rank := range rs.Ranks() {
+		if _, err := svc.membership.Get(rank); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rank := range rs.Ranks() {
+		if _, err := svc.membership.SetAnnotations(rank, req.GetAnnotations()); err != nil {
+			return nil, errors.Wrapf(err, "annotating rank %d", rank)
+		}
+	}
+	svc.log.Debugf("updated annotations %v on ranks %s", req.GetAnnotations(), rs)
+
+	return &mgmtpb.DaosResp{}, nil
+}
+
 // ClusterEvent management service gRPC handler receives ClusterEvent requests
 // from control-plane instances attempting to notify the MS of a cluster event
 // in the DAOS system (this handler should only get called on the MS leader).
@@ -1192,3 +1594,115 @@ func (svc *mgmtSvc) SystemGetProp(ctx context.Context, req *mgmtpb.SystemGetProp
 	resp = &mgmtpb.SystemGetPropResp{Properties: props}
 	return
 }
+
+// SystemDbVerify verifies the integrity of the system database journal and
+// the consistency of the database state on the current MS leader.
+func (svc *mgmtSvc) SystemDbVerify(ctx context.Context, req *mgmtpb.SystemDbVerifyReq) (*mgmtpb.SystemDbVerifyResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	report, err := svc.sysdb.Verify()
+	if err != nil {
+		return nil, err
+	}
+	if len(report.Errors) > 0 {
+		svc.log.Errorf("system database verification found %d errors", len(report.Errors))
+	}
+
+	resp := new(mgmtpb.SystemDbVerifyResp)
+	if err := convert.Types(report, resp); err != nil {
+		return nil, errors.Wrap(err, "failed to convert verification report")
+	}
+
+	return resp, nil
+}
+
+// SystemDbStatus returns the status of the system database replica on this
+// server, including raft state and update statistics.
+func (svc *mgmtSvc) SystemDbStatus(ctx context.Context, req *mgmtpb.SystemDbStatusReq) (*mgmtpb.SystemDbStatusResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	stats, err := svc.sysdb.Stats()
+	if err != nil {
+		return nil, err
+	}
+
+	lastContact := int64(-1)
+	if stats.LastContact >= 0 {
+		lastContact = stats.LastContact.Milliseconds()
+	}
+
+	return &mgmtpb.SystemDbStatusResp{
+		Replica:            stats.Replica,
+		State:              stats.State,
+		Leader:             stats.Leader,
+		Term:               stats.Term,
+		LastLogIndex:       stats.LastLogIndex,
+		CommitIndex:        stats.CommitIndex,
+		AppliedIndex:       stats.AppliedIndex,
+		LastSnapshotIndex:  stats.LastSnapshotIndex,
+		SnapshotSize:       stats.SnapshotSize,
+		LeaderChanges:      stats.LeaderChanges,
+		Commits:            stats.Commits,
+		CommitLatencyAvgUs: uint64(stats.CommitLatencyAvg.Microseconds()),
+		CommitLatencyMaxUs: uint64(stats.CommitLatencyMax.Microseconds()),
+		LastContactMs:      lastContact,
+	}, nil
+}
+
+// SystemDbBackup creates a portable backup of the system database on the
+// current MS leader.
+func (svc *mgmtSvc) SystemDbBackup(ctx context.Context, req *mgmtpb.SystemDbBackupReq) (*mgmtpb.SystemDbBackupResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	backup, err := svc.sysdb.Backup()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(backup)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode system database backup")
+	}
+
+	svc.log.Noticef("system database backup created (checksum %s)", backup.Checksum)
+	return &mgmtpb.SystemDbBackupResp{
+		Backup:   data,
+		Members:  uint32(backup.Members),
+		Pools:    uint32(backup.Pools),
+		Checksum: backup.Checksum,
+	}, nil
+}
+
+// SystemDbRestore replaces the contents of the system database with the
+// contents of a backup and distributes the resulting group map.
+func (svc *mgmtSvc) SystemDbRestore(ctx context.Context, req *mgmtpb.SystemDbRestoreReq) (*mgmtpb.SystemDbRestoreResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	backup := new(raft.DatabaseBackup)
+	if err := json.Unmarshal(req.GetBackup(), backup); err != nil {
+		return nil, errors.Wrap(err, "failed to decode system database backup")
+	}
+
+	report, err := svc.sysdb.Restore(backup, req.GetForce())
+	if err != nil {
+		return nil, err
+	}
+	svc.log.Noticef("system database restored from backup created %s (checksum %s)",
+		common.FormatTime(backup.Created), backup.Checksum)
+
+	svc.reqGroupUpdate(ctx, false)
+
+	resp := new(mgmtpb.SystemDbRestoreResp)
+	if err := convert.Types(report, resp); err != nil {
+		return nil, errors.Wrap(err, "failed to convert restore report")
+	}
+
+	return resp, nil
+}
@@ -18,7 +18,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -33,6 +35,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/server/storage"
 	"github.com/daos-stack/daos/src/control/system"
 	"github.com/daos-stack/daos/src/control/system/raft"
@@ -84,11 +87,21 @@ var defEvtCmpOpts = append(test.DefaultCmpOpts(),
 
 func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 	msReplica := system.MockMember(t, 0, system.MemberStateJoined)
+	msReplica.SecondaryFabricURIs = []string{"ofi+tcp://172.16.0.1:31416"}
 	nonReplica := system.MockMember(t, 1, system.MemberStateJoined)
 
+	clientNetMap := config.ClientNetMap{
+		{ClientSubnet: "10.1.0.0/16", Provider: "ofi+tcp"},
+	}
+	if err := clientNetMap.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
 	for name, tc := range map[string]struct {
 		svc               *mgmtSvc
 		clientNetworkHint *mgmtpb.ClientNetHint
+		clientNetMap      config.ClientNetMap
+		clientAddr        *net.TCPAddr
 		req               *mgmtpb.GetAttachInfoReq
 		expResp           *mgmtpb.GetAttachInfoResp
 	}{
@@ -184,6 +197,63 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 				DataVersion: 2,
 			},
 		},
+		"client outside client net map": {
+			clientNetworkHint: &mgmtpb.ClientNetHint{
+				Provider: "ofi+verbs",
+			},
+			clientNetMap: clientNetMap,
+			clientAddr:   &net.TCPAddr{IP: net.ParseIP("10.2.0.1"), Port: 40000},
+			req: &mgmtpb.GetAttachInfoReq{
+				Sys:      build.DefaultSystemName,
+				AllRanks: true,
+			},
+			expResp: &mgmtpb.GetAttachInfoResp{
+				ClientNetHint: &mgmtpb.ClientNetHint{
+					Provider: "ofi+verbs",
+				},
+				RankUris: []*mgmtpb.GetAttachInfoResp_RankUri{
+					{
+						Rank: msReplica.Rank.Uint32(),
+						Uri:  msReplica.FabricURI,
+					},
+					{
+						Rank: nonReplica.Rank.Uint32(),
+						Uri:  nonReplica.FabricURI,
+					},
+				},
+				MsRanks:     []uint32{0},
+				DataVersion: 2,
+			},
+		},
+		"client in client net map": {
+			clientNetworkHint: &mgmtpb.ClientNetHint{
+				Provider: "ofi+verbs",
+			},
+			clientNetMap: clientNetMap,
+			clientAddr:   &net.TCPAddr{IP: net.ParseIP("10.1.0.1"), Port: 40000},
+			req: &mgmtpb.GetAttachInfoReq{
+				Sys:      build.DefaultSystemName,
+				AllRanks: true,
+			},
+			expResp: &mgmtpb.GetAttachInfoResp{
+				ClientNetHint: &mgmtpb.ClientNetHint{
+					Provider: "ofi+tcp",
+				},
+				RankUris: []*mgmtpb.GetAttachInfoResp_RankUri{
+					{
+						Rank: msReplica.Rank.Uint32(),
+						Uri:  "ofi+tcp://172.16.0.1:31416",
+					},
+					{
+						// No matching URI, so the primary URI is used.
+						Rank: nonReplica.Rank.Uint32(),
+						Uri:  nonReplica.FabricURI,
+					},
+				},
+				MsRanks:     []uint32{0},
+				DataVersion: 2,
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -208,7 +278,12 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 				t.Fatal(err)
 			}
 			tc.svc.clientNetworkHint = tc.clientNetworkHint
-			gotResp, gotErr := tc.svc.GetAttachInfo(context.TODO(), tc.req)
+			tc.svc.clientNetMap = tc.clientNetMap
+			ctx := context.TODO()
+			if tc.clientAddr != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: tc.clientAddr})
+			}
+			gotResp, gotErr := tc.svc.GetAttachInfo(ctx, tc.req)
 			if gotErr != nil {
 				t.Fatalf("unexpected error: %+v\n", gotErr)
 			}
@@ -292,6 +367,63 @@ func TestServer_MgmtSvc_LeaderQuery(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_SystemDbStatus(t *testing.T) {
+	localhost := common.LocalhostCtrlAddr()
+
+	for name, tc := range map[string]struct {
+		req    *mgmtpb.SystemDbStatusReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.SystemDbStatusReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"successful query": {
+			req: &mgmtpb.SystemDbStatusReq{Sys: build.DefaultSystemName},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			db, cleanup := raft.TestDatabase(t, log)
+			defer cleanup()
+			svc.sysdb = db
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if err := db.Start(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			// wait for the bootstrap to finish
+			for {
+				if leader, _, _ := db.LeaderQuery(); leader != "" {
+					break
+				}
+				time.Sleep(250 * time.Millisecond)
+			}
+
+			gotResp, gotErr := svc.SystemDbStatus(context.TODO(), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, localhost.String(), gotResp.Replica, "unexpected replica")
+			test.AssertEqual(t, localhost.String(), gotResp.Leader, "unexpected leader")
+			test.AssertEqual(t, "Leader", gotResp.State, "unexpected state")
+			test.AssertEqual(t, int64(0), gotResp.LastContactMs, "unexpected last contact")
+			test.AssertTrue(t, gotResp.Term > 0, "expected non-zero term")
+			test.AssertTrue(t, gotResp.CommitIndex > 0, "expected non-zero commit index")
+		})
+	}
+}
+
 type eventsDispatched struct {
 	rx     []*events.RASEvent
 	cancel context.CancelFunc
@@ -589,8 +721,7 @@ func TestServer_MgmtSvc_rpcFanout(t *testing.T) {
 			sysReq:     &mgmtpb.SystemStartReq{},
 			expMembers: system.Members{},
 			expFanReq: &fanoutRequest{
-				Method:     control.StartRanks,
-				FullSystem: true,
+				Method: control.StartRanks,
 			},
 		},
 		"bad hosts in request": {
@@ -651,9 +782,8 @@ func TestServer_MgmtSvc_rpcFanout(t *testing.T) {
 				},
 			},
 			expFanReq: &fanoutRequest{
-				Method:     control.StartRanks,
-				Ranks:      ranklist.MustCreateRankSet("0-7"),
-				FullSystem: true,
+				Method: control.StartRanks,
+				Ranks:  ranklist.MustCreateRankSet("0-7"),
 			},
 			// results from ranks on failing hosts generated
 			// results from host responses amalgamated
@@ -1048,16 +1178,51 @@ func TestServer_MgmtSvc_SystemQuery(t *testing.T) {
 		emptyDb        bool
 		ranks          string
 		hosts          string
+		pageSize       uint32
+		pageToken      string
+		fields         []string
 		expMembers     []*mgmtpb.SystemMember
 		expRanks       string
 		expAbsentHosts string
 		expAbsentRanks string
+		expNextToken   string
 		expErrMsg      string
 	}{
 		"nil req": {
 			nilReq:    true,
 			expErrMsg: "nil request",
 		},
+		"first page with field mask": {
+			pageSize: 2,
+			fields:   []string{"state"},
+			expMembers: []*mgmtpb.SystemMember{
+				{Rank: 0, State: stateString(system.MemberStateErrored)},
+				{Rank: 1, State: stateString(system.MemberStateStopping)},
+			},
+			expNextToken: encodePageToken(rankPageKey(1)),
+		},
+		"last page": {
+			pageSize:  4,
+			pageToken: encodePageToken(rankPageKey(3)),
+			expMembers: []*mgmtpb.SystemMember{
+				{
+					Rank: 4, Addr: test.MockHostAddr(3).String(),
+					Uuid:        test.MockUUID(4),
+					State:       stateString(system.MemberStateStarting),
+					FaultDomain: "/",
+				},
+				{
+					Rank: 5, Addr: test.MockHostAddr(3).String(),
+					Uuid:        test.MockUUID(5),
+					State:       stateString(system.MemberStateStopped),
+					FaultDomain: "/",
+				},
+			},
+		},
+		"unknown field in mask": {
+			fields:    []string{"bogus"},
+			expErrMsg: `unknown field "bogus" in SystemMember field mask`,
+		},
 		"unfiltered rank results": {
 			expMembers: []*mgmtpb.SystemMember{
 				{
@@ -1204,6 +1369,8 @@ func TestServer_MgmtSvc_SystemQuery(t *testing.T) {
 			req := &mgmtpb.SystemQueryReq{
 				Sys:   build.DefaultSystemName,
 				Ranks: tc.ranks, Hosts: tc.hosts,
+				PageSize: tc.pageSize, PageToken: tc.pageToken,
+				Fields: tc.fields,
 			}
 			if tc.nilReq {
 				req = nil
@@ -1221,8 +1388,10 @@ func TestServer_MgmtSvc_SystemQuery(t *testing.T) {
 			if diff := cmp.Diff(tc.expMembers, gotResp.Members, cmpOpts...); diff != "" {
 				t.Logf("unexpected results (-want, +got)\n%s\n", diff) // prints on err
 			}
+			test.AssertEqual(t, len(tc.expMembers), len(gotResp.Members), "number of members")
 			test.AssertEqual(t, tc.expAbsentHosts, gotResp.Absenthosts, "absent hosts")
 			test.AssertEqual(t, tc.expAbsentRanks, gotResp.Absentranks, "absent ranks")
+			test.AssertEqual(t, tc.expNextToken, gotResp.NextPageToken, "next page token")
 		})
 	}
 }
@@ -1461,11 +1630,13 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 	for name, tc := range map[string]struct {
 		req            *mgmtpb.SystemStopReq
 		members        system.Members
+		poolReplicas   []ranklist.Rank
 		mResps         [][]*control.HostResponse
 		expMembers     system.Members
 		expResults     []*sharedpb.RankResult
 		expAbsentRanks string
 		expAbsentHosts string
+		expPrepRanks   string
 		expAPIErr      error
 		expDispatched  []*events.RASEvent
 		expInvokeCount int
@@ -1486,6 +1657,7 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 			mResps:         hostRespFail,
 			expResults:     rankResPrepFail,
 			expMembers:     expMembersPrepFail,
+			expPrepRanks:   "1",
 			expDispatched:  expEventsPrepFail,
 			expInvokeCount: 1,
 		},
@@ -1495,11 +1667,27 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 			mResps:         hostRespStopFail,
 			expResults:     rankResStopFail,
 			expMembers:     expMembersStopFail,
+			expPrepRanks:   "0,3",
 			expDispatched:  expEventsStopFail,
 			expInvokeCount: 2,
 		},
 		"stop some ranks": {
-			req:        &mgmtpb.SystemStopReq{Ranks: "0,1"},
+			req:     &mgmtpb.SystemStopReq{Ranks: "0,1"},
+			members: defaultMembers,
+			mResps: [][]*control.HostResponse{
+				{hr(1, mockRankSuccess("prep shutdown", 0), mockRankSuccess("prep shutdown", 1))},
+				{hr(1, mockRankSuccess("stop", 0), mockRankSuccess("stop", 1))},
+			},
+			expResults: []*sharedpb.RankResult{mockRankSuccess("stop", 0, 1), mockRankSuccess("stop", 1, 1)},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "stopped"),
+				mockMember(t, 1, 1, "stopped"),
+				mockMember(t, 3, 2, "joined"),
+			},
+			expInvokeCount: 2, // prep should be called
+		},
+		"stop some ranks (forced)": {
+			req:        &mgmtpb.SystemStopReq{Ranks: "0,1", Force: true},
 			members:    defaultMembers,
 			mResps:     [][]*control.HostResponse{{hr(1, mockRankSuccess("stop", 0), mockRankSuccess("stop", 1))}},
 			expResults: []*sharedpb.RankResult{mockRankSuccess("stop", 0, 1), mockRankSuccess("stop", 1, 1)},
@@ -1534,6 +1722,15 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 			},
 			expInvokeCount: 2, // prep should be called
 		},
+		"full system stop (forced) stop fail": {
+			req:            &mgmtpb.SystemStopReq{Force: true},
+			members:        defaultMembers,
+			mResps:         [][]*control.HostResponse{hrsf},
+			expResults:     rankResStopFail,
+			expMembers:     expMembersStopFail,
+			expDispatched:  expEventsStopFail,
+			expInvokeCount: 1, // prep should not be called
+		},
 		"full system stop (forced)": {
 			req:        &mgmtpb.SystemStopReq{Force: true},
 			members:    defaultMembers,
@@ -1546,6 +1743,52 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 			},
 			expInvokeCount: 1, // prep should not be called
 		},
+		"full system stop; pool service replica ranks stopped last": {
+			req:          &mgmtpb.SystemStopReq{},
+			members:      defaultMembers,
+			poolReplicas: []ranklist.Rank{3},
+			mResps: [][]*control.HostResponse{
+				hrps,
+				{hr(1, mockRankSuccess("stop", 0), mockRankSuccess("stop", 1))},
+				{hr(2, mockRankSuccess("stop", 3))},
+			},
+			expResults: rankResStopSuccess,
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "stopped"),
+				mockMember(t, 1, 1, "stopped"),
+				mockMember(t, 3, 2, "stopped"),
+			},
+			expInvokeCount: 3, // prep, stop non-replicas, stop replicas
+		},
+		"stop pool service replica ranks only": {
+			req:          &mgmtpb.SystemStopReq{Ranks: "3"},
+			members:      defaultMembers,
+			poolReplicas: []ranklist.Rank{3},
+			mResps: [][]*control.HostResponse{
+				{hr(2, mockRankSuccess("prep shutdown", 3))},
+				{hr(2, mockRankSuccess("stop", 3))},
+			},
+			expResults: []*sharedpb.RankResult{mockRankSuccess("stop", 3, 2)},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 3, 2, "stopped"),
+			},
+			expInvokeCount: 2, // single stop invocation
+		},
+		"full system stop (forced); pool service replica ranks not ordered": {
+			req:          &mgmtpb.SystemStopReq{Force: true},
+			members:      defaultMembers,
+			poolReplicas: []ranklist.Rank{3},
+			mResps:       hostRespStopSuccess,
+			expResults:   rankResStopSuccess,
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "stopped"),
+				mockMember(t, 1, 1, "stopped"),
+				mockMember(t, 3, 2, "stopped"),
+			},
+			expInvokeCount: 1, // prep should not be called
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1555,6 +1798,14 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 				tc.mResps = [][]*control.HostResponse{{}}
 			}
 			svc := mgmtSystemTestSetup(t, log, tc.members, tc.mResps...)
+			if len(tc.poolReplicas) > 0 {
+				addTestPoolService(t, svc.sysdb, &system.PoolService{
+					PoolUUID:  uuid.MustParse(test.MockUUID(1)),
+					PoolLabel: "pool1",
+					State:     system.PoolServiceStateReady,
+					Replicas:  tc.poolReplicas,
+				})
+			}
 
 			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
 			defer cancel()
@@ -1575,9 +1826,16 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 			}
 
 			checkRankResults(t, tc.expResults, gotResp.Results)
+			// The expected members are created along with the test
+			// table, which can be more than a second before the
+			// later cases run, so bring their update times forward.
+			for _, m := range tc.expMembers {
+				m.LastUpdate = time.Now()
+			}
 			checkMembers(t, tc.expMembers, svc.membership)
 			test.AssertEqual(t, tc.expAbsentHosts, gotResp.Absenthosts, "absent hosts")
 			test.AssertEqual(t, tc.expAbsentRanks, gotResp.Absentranks, "absent ranks")
+			test.AssertEqual(t, tc.expPrepRanks, gotResp.Prepranks, "prep-only ranks")
 
 			<-ctx.Done()
 
@@ -1736,6 +1994,243 @@ func TestServer_MgmtSvc_SystemExclude(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_SystemSetMemberAlias(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req       *mgmtpb.SystemSetMemberAliasReq
+		members   system.Members
+		expAlias  string
+		expAPIErr error
+	}{
+		"nil req": {
+			req:       (*mgmtpb.SystemSetMemberAliasReq)(nil),
+			expAPIErr: errors.New("nil request"),
+		},
+		"not system leader": {
+			req: &mgmtpb.SystemSetMemberAliasReq{
+				Sys: "quack",
+			},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"unknown rank": {
+			req: &mgmtpb.SystemSetMemberAliasReq{Rank: 42, Alias: "rack3-node7"},
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+			},
+			expAPIErr: system.ErrMemberRankNotFound(42),
+		},
+		"invalid alias": {
+			req: &mgmtpb.SystemSetMemberAliasReq{Rank: 0, Alias: "rack3 node7"},
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+			},
+			expAPIErr: errors.New("invalid character"),
+		},
+		"set alias": {
+			req: &mgmtpb.SystemSetMemberAliasReq{Rank: 1, Alias: "rack3-node7"},
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+			},
+			expAlias: "rack3-node7",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, tc.members, nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			_, gotAPIErr := svc.SystemSetMemberAlias(ctx, tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			m, err := svc.membership.Get(ranklist.Rank(tc.req.Rank))
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expAlias, m.Alias, "unexpected alias")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemAnnotate(t *testing.T) {
+	defaultMembers := system.Members{
+		mockMember(t, 0, 1, "joined"),
+		mockMember(t, 1, 1, "joined"),
+		mockMember(t, 2, 2, "stopped"),
+	}
+
+	for name, tc := range map[string]struct {
+		req       *mgmtpb.SystemAnnotateReq
+		expNotes  map[ranklist.Rank]map[string]string
+		expAPIErr error
+	}{
+		"nil req": {
+			req:       (*mgmtpb.SystemAnnotateReq)(nil),
+			expAPIErr: errors.New("nil request"),
+		},
+		"not system leader": {
+			req: &mgmtpb.SystemAnnotateReq{
+				Sys: "quack",
+			},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"no annotations": {
+			req:       &mgmtpb.SystemAnnotateReq{Ranks: "0"},
+			expAPIErr: errors.New("no annotations"),
+		},
+		"invalid annotation": {
+			req: &mgmtpb.SystemAnnotateReq{
+				Ranks:       "0",
+				Annotations: map[string]string{"hw note": "DIMM replaced"},
+			},
+			expAPIErr: errors.New("invalid character"),
+		},
+		"no ranks": {
+			req: &mgmtpb.SystemAnnotateReq{
+				Annotations: map[string]string{"hw": "DIMM replaced"},
+			},
+			expAPIErr: errors.New("no ranks"),
+		},
+		"unknown rank; no members annotated": {
+			req: &mgmtpb.SystemAnnotateReq{
+				Ranks:       "1,42",
+				Annotations: map[string]string{"hw": "DIMM replaced"},
+			},
+			expAPIErr: system.ErrMemberRankNotFound(42),
+			expNotes:  map[ranklist.Rank]map[string]string{},
+		},
+		"annotate ranks": {
+			req: &mgmtpb.SystemAnnotateReq{
+				Ranks:       "1-2",
+				Annotations: map[string]string{"hw": "DIMM replaced 2024-05-01"},
+			},
+			expNotes: map[ranklist.Rank]map[string]string{
+				1: {"hw": "DIMM replaced 2024-05-01"},
+				2: {"hw": "DIMM replaced 2024-05-01"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, defaultMembers, nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			_, gotAPIErr := svc.SystemAnnotate(ctx, tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expNotes == nil {
+				return
+			}
+
+			for _, m := range defaultMembers {
+				got, err := svc.membership.Get(m.Rank)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(tc.expNotes[m.Rank], got.Annotations); diff != "" {
+					t.Fatalf("unexpected rank %d annotations (-want, +got):\n%s\n", m.Rank, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemReplicaChanges(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req         *mgmtpb.SystemReplicaReq
+		remove      bool
+		members     system.Members
+		expReplicas []string
+		expAPIErr   error
+	}{
+		"nil req": {
+			req:       (*mgmtpb.SystemReplicaReq)(nil),
+			expAPIErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:       &mgmtpb.SystemReplicaReq{Sys: "quack"},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"add non-member": {
+			req: &mgmtpb.SystemReplicaReq{Replica: "10.0.0.3"},
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+			},
+			expAPIErr: errors.New("not a system member"),
+		},
+		"add replica": {
+			req: &mgmtpb.SystemReplicaReq{Replica: "10.0.0.2"},
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 2, "joined"),
+			},
+			expReplicas: []string{"127.0.0.1:10001", "10.0.0.2:10001"},
+		},
+		"promote non-replica": {
+			req:       &mgmtpb.SystemReplicaReq{Replica: "10.0.0.2", Promote: true},
+			expAPIErr: errors.New("not a MS replica"),
+		},
+		"promote replica": {
+			req:         &mgmtpb.SystemReplicaReq{Replica: "127.0.0.1", Promote: true},
+			expReplicas: []string{"127.0.0.1:10001"},
+		},
+		"remove non-replica": {
+			req:       &mgmtpb.SystemReplicaReq{Replica: "10.0.0.2:10001"},
+			remove:    true,
+			expAPIErr: errors.New("not a MS replica"),
+		},
+		"remove only replica": {
+			req:       &mgmtpb.SystemReplicaReq{Replica: "127.0.0.1"},
+			remove:    true,
+			expAPIErr: errors.New("only MS replica"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, tc.members, nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			var gotResp *mgmtpb.SystemReplicaResp
+			var gotAPIErr error
+			if tc.remove {
+				gotResp, gotAPIErr = svc.SystemRemoveReplica(ctx, tc.req)
+			} else {
+				gotResp, gotAPIErr = svc.SystemAddReplica(ctx, tc.req)
+			}
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReplicas, gotResp.Replicas); diff != "" {
+				t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_SystemErase(t *testing.T) {
 	hr := func(a int32, rrs ...*sharedpb.RankResult) *control.HostResponse {
 		return &control.HostResponse{
@@ -1857,11 +2352,14 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 	newMember := mockMember(t, 1, 1, "joined")
 
 	for name, tc := range map[string]struct {
+		curState system.MemberState
 		req      *mgmtpb.JoinReq
+		pools    []*system.PoolService
 		guResp   *mgmtpb.GroupUpdateResp
 		expGuReq *mgmtpb.GroupUpdateReq
 		expResp  *mgmtpb.JoinResp
 		expErr   error
+		expEvent events.RASID
 	}{
 		"bad sys": {
 			req: &mgmtpb.JoinReq{
@@ -1895,6 +2393,15 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 			},
 			expErr: errors.New("already exists"),
 		},
+		"cloned host same uuid as running member": {
+			curState: system.MemberStateJoined,
+			req: &mgmtpb.JoinReq{
+				Rank: curMember.Rank.Uint32(),
+				Uuid: curMember.UUID.String(),
+			},
+			expErr:   errors.New("cloned storage"),
+			expEvent: events.RASEngineDuplicate,
+		},
 		"rejoining host": {
 			req: &mgmtpb.JoinReq{
 				Rank:        curMember.Rank.Uint32(),
@@ -1915,6 +2422,7 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 				Status: 0,
 				Rank:   curMember.Rank.Uint32(),
 				State:  mgmtpb.JoinResp_IN,
+				Prime:  &mgmtpb.JoinPrimeData{MapVersion: 2},
 			},
 		},
 		"rejoining host; NilRank": {
@@ -1937,6 +2445,7 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 				Status: 0,
 				Rank:   curMember.Rank.Uint32(),
 				State:  mgmtpb.JoinResp_IN,
+				Prime:  &mgmtpb.JoinPrimeData{MapVersion: 2},
 			},
 		},
 		"new host (non local)": {
@@ -1960,6 +2469,7 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 				Rank:      newMember.Rank.Uint32(),
 				State:     mgmtpb.JoinResp_IN,
 				LocalJoin: false,
+				Prime:     &mgmtpb.JoinPrimeData{MapVersion: 2},
 			},
 		},
 		"new host (local)": {
@@ -1985,6 +2495,51 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 				Rank:      newMember.Rank.Uint32(),
 				State:     mgmtpb.JoinResp_IN,
 				LocalJoin: true,
+				Prime:     &mgmtpb.JoinPrimeData{MapVersion: 2},
+			},
+		},
+		"new host; ready pools primed": {
+			req: &mgmtpb.JoinReq{
+				Rank:        uint32(ranklist.NilRank),
+				Incarnation: newMember.Incarnation,
+			},
+			pools: []*system.PoolService{
+				{
+					PoolUUID:  test.MockPoolUUID(1),
+					PoolLabel: "pool1",
+					State:     system.PoolServiceStateReady,
+					Replicas:  []ranklist.Rank{0, 1},
+				},
+				{
+					PoolUUID:  test.MockPoolUUID(2),
+					PoolLabel: "pool2",
+					State:     system.PoolServiceStateCreating,
+					Replicas:  []ranklist.Rank{1},
+				},
+			},
+			expGuReq: &mgmtpb.GroupUpdateReq{
+				MapVersion: 2,
+				Engines: []*mgmtpb.GroupUpdateReq_Engine{
+					{
+						Rank:        newMember.Rank.Uint32(),
+						Uri:         newMember.FabricURI,
+						Incarnation: newMember.Incarnation,
+					},
+				},
+			},
+			expResp: &mgmtpb.JoinResp{
+				Rank:  newMember.Rank.Uint32(),
+				State: mgmtpb.JoinResp_IN,
+				Prime: &mgmtpb.JoinPrimeData{
+					MapVersion: 2,
+					Pools: []*mgmtpb.JoinPrimeData_Pool{
+						{
+							Uuid:    test.MockPoolUUID(1).String(),
+							Label:   "pool1",
+							SvcReps: []uint32{0, 1},
+						},
+					},
+				},
 			},
 		},
 	} {
@@ -1996,13 +2551,31 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 			curCopy := &system.Member{}
 			*curCopy = *curMember
 			curCopy.Rank = ranklist.NilRank // ensure that db.data.NextRank is incremented
+			if tc.curState != system.MemberStateUnknown {
+				curCopy.State = tc.curState
+			}
 
 			svc := mgmtSystemTestSetup(t, log, system.Members{curCopy}, nil)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
+			for _, ps := range tc.pools {
+				lock, lockCtx := getPoolLockCtx(t, ctx, svc.sysdb, ps.PoolUUID)
+				if err := svc.sysdb.AddPoolService(lockCtx, ps); err != nil {
+					t.Fatal(err)
+				}
+				lock.Release()
+			}
 			svc.startJoinLoop(ctx)
 
+			// Joins are processed in batches, so allow for a full
+			// batch interval before the joined event is published.
+			evtCtx, evtCancel := context.WithTimeout(ctx, batchJoinInterval+time.Second)
+			defer evtCancel()
+			svc.events = events.NewPubSub(evtCtx, log)
+			dispatched := &eventsDispatched{cancel: evtCancel}
+			svc.events.Subscribe(events.RASTypeInfoOnly, dispatched)
+
 			if tc.req.Sys == "" {
 				tc.req.Sys = build.DefaultSystemName
 			}
@@ -2037,6 +2610,13 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 			gotResp, gotErr := svc.Join(peerCtx, tc.req)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
+				if tc.expEvent != events.RASUnknownEvent {
+					<-evtCtx.Done()
+					if len(dispatched.rx) != 1 {
+						t.Fatalf("expected 1 event to be published, got %d", len(dispatched.rx))
+					}
+					test.AssertEqual(t, tc.expEvent, dispatched.rx[0].ID, "event ID")
+				}
 				return
 			}
 
@@ -2055,6 +2635,111 @@ func TestServer_MgmtSvc_Join(t *testing.T) {
 			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
 				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
 			}
+
+			<-evtCtx.Done()
+			if len(dispatched.rx) != 1 {
+				t.Fatalf("expected 1 event to be published, got %d", len(dispatched.rx))
+			}
+			test.AssertEqual(t, events.RASEngineJoined, dispatched.rx[0].ID, "event ID")
+			test.AssertEqual(t, tc.expResp.Rank, dispatched.rx[0].Rank, "event rank")
+		})
+	}
+}
+
+type mockJoinStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	resps []*mgmtpb.JoinProgress
+}
+
+func (ms *mockJoinStream) Context() context.Context {
+	return ms.ctx
+}
+
+func (ms *mockJoinStream) Send(resp *mgmtpb.JoinProgress) error {
+	ms.resps = append(ms.resps, resp)
+	return nil
+}
+
+func TestServer_MgmtSvc_JoinStream(t *testing.T) {
+	curMember := mockMember(t, 0, 0, "excluded")
+	newMember := mockMember(t, 1, 1, "joined")
+
+	for name, tc := range map[string]struct {
+		uuid      string
+		expStages []mgmtpb.JoinProgress_Stage
+		expErr    error
+	}{
+		"bad uuid": {
+			uuid:      "bad uuid",
+			expStages: []mgmtpb.JoinProgress_Stage{mgmtpb.JoinProgress_QUEUED},
+			expErr:    errors.New("bad uuid"),
+		},
+		"new host": {
+			expStages: []mgmtpb.JoinProgress_Stage{
+				mgmtpb.JoinProgress_QUEUED,
+				mgmtpb.JoinProgress_VALIDATED,
+				mgmtpb.JoinProgress_RANK_ASSIGNED,
+				mgmtpb.JoinProgress_MEMBERSHIP_CONFIRMED,
+				mgmtpb.JoinProgress_DONE,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			curCopy := &system.Member{}
+			*curCopy = *curMember
+			curCopy.Rank = ranklist.NilRank // ensure that db.data.NextRank is incremented
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{curCopy}, nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			svc.startJoinLoop(ctx)
+			setupMockDrpcClient(svc, nil, nil)
+
+			if tc.uuid == "" {
+				tc.uuid = newMember.UUID.String()
+			}
+			req := &mgmtpb.JoinReq{
+				Sys:            build.DefaultSystemName,
+				Uuid:           tc.uuid,
+				Rank:           uint32(ranklist.NilRank),
+				Addr:           newMember.Addr.String(),
+				Uri:            newMember.FabricURI,
+				SrvFaultDomain: newMember.FaultDomain.String(),
+				Nctxs:          newMember.FabricContexts,
+				Incarnation:    newMember.Incarnation,
+			}
+			stream := &mockJoinStream{
+				ctx: peer.NewContext(ctx, &peer.Peer{Addr: newMember.Addr}),
+			}
+
+			gotErr := svc.JoinStream(req, stream)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			var gotStages []mgmtpb.JoinProgress_Stage
+			for _, resp := range stream.resps {
+				gotStages = append(gotStages, resp.Stage)
+			}
+			if diff := cmp.Diff(tc.expStages, gotStages); diff != "" {
+				t.Fatalf("unexpected progress stages (-want, +got)\n%s\n", diff)
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			final := stream.resps[len(stream.resps)-1]
+			expResp := &mgmtpb.JoinResp{
+				Rank:  newMember.Rank.Uint32(),
+				State: mgmtpb.JoinResp_IN,
+				Prime: &mgmtpb.JoinPrimeData{MapVersion: 2},
+			}
+			if diff := cmp.Diff(expResp, final.Resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
 		})
 	}
 }
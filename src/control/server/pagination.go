@@ -0,0 +1,99 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// encodePageToken returns an opaque page token that resumes a listing after
+// the item with the given key.
+func encodePageToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodePageToken(token string) (string, error) {
+	key, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", errors.Errorf("invalid page token %q", token)
+	}
+	return string(key), nil
+}
+
+// rankPageKey returns a key for the given rank that sorts in rank order.
+func rankPageKey(rank uint32) string {
+	return fmt.Sprintf("%010d", rank)
+}
+
+// paginate returns the bounds of the page of n items that follows the item
+// identified by the page token, along with the token for the page after it.
+// Items must be sorted by the key returned by keyFn. Resuming from a key
+// rather than an offset means that items added or removed between requests
+// do not cause others to be skipped or returned twice. A page size of zero
+// returns all remaining items.
+func paginate(n int, keyFn func(int) string, pageSize uint32, token string) (start, end int, next string, err error) {
+	if token != "" {
+		after, err := decodePageToken(token)
+		if err != nil {
+			return 0, 0, "", err
+		}
+		start = sort.Search(n, func(i int) bool { return keyFn(i) > after })
+	}
+
+	end = n
+	if pageSize > 0 && start+int(pageSize) < n {
+		end = start + int(pageSize)
+		next = encodePageToken(keyFn(end - 1))
+	}
+
+	return start, end, next, nil
+}
+
+// fieldMask is the set of fields to be retained in a response message.
+type fieldMask map[protoreflect.Name]struct{}
+
+// newFieldMask validates the field names in the mask against the message type
+// and returns a fieldMask that retains them, along with any fields that are
+// required to identify the message. Field names are those used in the
+// protobuf definition. A nil fieldMask is returned for an empty mask.
+func newFieldMask(msgType proto.Message, mask []string, required ...string) (fieldMask, error) {
+	if len(mask) == 0 {
+		return nil, nil
+	}
+
+	desc := msgType.ProtoReflect().Descriptor()
+	fm := make(fieldMask)
+	for _, name := range append(append([]string{}, mask...), required...) {
+		if desc.Fields().ByName(protoreflect.Name(name)) == nil {
+			return nil, errors.Errorf("unknown field %q in %s field mask", name, desc.Name())
+		}
+		fm[protoreflect.Name(name)] = struct{}{}
+	}
+
+	return fm, nil
+}
+
+// apply clears all fields of the message that are not in the mask.
+func (fm fieldMask) apply(msg proto.Message) {
+	if fm == nil {
+		return
+	}
+
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if _, found := fm[fields.Get(i).Name()]; !found {
+			m.Clear(fields.Get(i))
+		}
+	}
+}
@@ -0,0 +1,134 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestServer_paginate(t *testing.T) {
+	keys := []string{"a", "c", "e", "g", "i"}
+
+	for name, tc := range map[string]struct {
+		pageSize uint32
+		token    string
+		expKeys  []string
+		expNext  string
+		expErr   error
+	}{
+		"all items": {
+			expKeys: keys,
+		},
+		"first page": {
+			pageSize: 2,
+			expKeys:  []string{"a", "c"},
+			expNext:  encodePageToken("c"),
+		},
+		"middle page": {
+			pageSize: 2,
+			token:    encodePageToken("c"),
+			expKeys:  []string{"e", "g"},
+			expNext:  encodePageToken("g"),
+		},
+		"last page exactly filled": {
+			pageSize: 2,
+			token:    encodePageToken("e"),
+			expKeys:  []string{"g", "i"},
+		},
+		"resume after removed item": {
+			pageSize: 2,
+			token:    encodePageToken("d"),
+			expKeys:  []string{"e", "g"},
+			expNext:  encodePageToken("g"),
+		},
+		"no items remain": {
+			pageSize: 2,
+			token:    encodePageToken("i"),
+			expKeys:  []string{},
+		},
+		"invalid token": {
+			token:  "!",
+			expErr: errors.New("invalid page token"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			start, end, next, err := paginate(len(keys), func(i int) string {
+				return keys[i]
+			}, tc.pageSize, tc.token)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expKeys, keys[start:end]); diff != "" {
+				t.Fatalf("unexpected page (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expNext, next, "next page token")
+		})
+	}
+}
+
+func TestServer_fieldMask(t *testing.T) {
+	newPool := func() *mgmtpb.ListPoolsResp_Pool {
+		return &mgmtpb.ListPoolsResp_Pool{
+			Uuid:    test.MockUUID(),
+			Label:   "pool",
+			SvcReps: []uint32{0, 1, 2},
+			State:   "Ready",
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		mask    []string
+		expPool *mgmtpb.ListPoolsResp_Pool
+		expErr  error
+	}{
+		"empty mask": {
+			expPool: newPool(),
+		},
+		"unknown field": {
+			mask:   []string{"label", "size"},
+			expErr: errors.New(`unknown field "size"`),
+		},
+		"required field retained": {
+			mask: []string{"state"},
+			expPool: &mgmtpb.ListPoolsResp_Pool{
+				Uuid:  test.MockUUID(),
+				State: "Ready",
+			},
+		},
+		"repeated field": {
+			mask: []string{"svc_reps", "label"},
+			expPool: &mgmtpb.ListPoolsResp_Pool{
+				Uuid:    test.MockUUID(),
+				Label:   "pool",
+				SvcReps: []uint32{0, 1, 2},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			fm, err := newFieldMask(new(mgmtpb.ListPoolsResp_Pool), tc.mask, "uuid")
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			pool := newPool()
+			fm.apply(pool)
+			if diff := cmp.Diff(tc.expPool, pool, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,425 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/hardware/hwprov"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+// PreflightStatus indicates the outcome of a preflight check.
+type PreflightStatus string
+
+// Preflight check outcomes.
+const (
+	PreflightPass PreflightStatus = "PASS"
+	PreflightWarn PreflightStatus = "WARN"
+	PreflightFail PreflightStatus = "FAIL"
+	PreflightSkip PreflightStatus = "SKIP"
+)
+
+const (
+	vfioDevPath     = "/dev/vfio/vfio"
+	maxMapCountPath = "/proc/sys/vm/max_map_count"
+	sysctlConfPath  = "/etc/sysctl.d/10-daos_server.conf"
+	limitsConfPath  = "/etc/security/limits.d/daos_server.conf"
+
+	// minMaxMapCount is the vm.max_map_count value below which the engine
+	// will not use mmap()'d ULT stacks.
+	minMaxMapCount = 65530
+	// recMaxMapCount is the vm.max_map_count value set by the sysctl config
+	// installed with daos_server.
+	recMaxMapCount = 1000000
+	// minMemlockBytes is the locked memory limit below which SPDK is unable
+	// to map hugepages for DMA when running as a non-root user.
+	minMemlockBytes = 64 * humanize.MiByte
+	// minNofile is the open file limit below which engines with many targets
+	// and pools may exhaust their file descriptors.
+	minNofile = 65536
+)
+
+// PreflightResult describes the outcome of a single preflight check.
+type PreflightResult struct {
+	Check       string          `json:"check"`
+	Status      PreflightStatus `json:"status"`
+	Detail      string          `json:"detail"`
+	Remediation string          `json:"remediation,omitempty"`
+}
+
+func (pr *PreflightResult) String() string {
+	return fmt.Sprintf("%s: %s", pr.Check, pr.Detail)
+}
+
+// PreflightResults is a list of preflight check results.
+type PreflightResults []*PreflightResult
+
+// Failed returns the results of the checks that failed.
+func (prs PreflightResults) Failed() PreflightResults {
+	var failed PreflightResults
+	for _, pr := range prs {
+		if pr.Status == PreflightFail {
+			failed = append(failed, pr)
+		}
+	}
+	return failed
+}
+
+// Err returns a fault describing the failed checks, or nil if no checks
+// failed.
+func (prs PreflightResults) Err() error {
+	failed := prs.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return FaultPreflightFailed(failed)
+}
+
+// preflightEnv provides access to the host state examined by the preflight
+// checks.
+type preflightEnv struct {
+	username       string
+	isIOMMUEnabled func() (bool, error)
+	getMemInfo     func() (*common.MemInfo, error)
+	readFile       func(string) ([]byte, error)
+	stat           func(string) (os.FileInfo, error)
+	access         func(string, uint32) error
+	getRlimit      func(int, *unix.Rlimit) error
+}
+
+func defaultPreflightEnv(log logging.Logger) (*preflightEnv, error) {
+	runningUser, err := user.Current()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to lookup current user")
+	}
+
+	return &preflightEnv{
+		username:       runningUser.Username,
+		isIOMMUEnabled: hwprov.DefaultIOMMUDetector(log).IsIOMMUEnabled,
+		getMemInfo:     common.GetMemInfo,
+		readFile:       os.ReadFile,
+		stat:           os.Stat,
+		access:         unix.Access,
+		getRlimit:      unix.Getrlimit,
+	}, nil
+}
+
+type preflightCheckFn func(*preflightEnv, *config.Server) *PreflightResult
+
+// RunPreflightChecks validates that the kernel parameters, device permissions
+// and resource limits of the host are sufficient to start the engines
+// described by the supplied (validated) server config.
+func RunPreflightChecks(log logging.Logger, cfg *config.Server) (PreflightResults, error) {
+	env, err := defaultPreflightEnv(log)
+	if err != nil {
+		return nil, err
+	}
+
+	return runPreflightChecks(env, cfg), nil
+}
+
+// checkPreflight runs the preflight checks at start-up, logging any warnings
+// and returning an error if any check failed.
+func checkPreflight(log logging.Logger, cfg *config.Server) error {
+	results, err := RunPreflightChecks(log, cfg)
+	if err != nil {
+		return errors.Wrap(err, "preflight checks")
+	}
+
+	for _, pr := range results {
+		switch pr.Status {
+		case PreflightWarn:
+			log.Noticef("preflight %s (%s)", pr, pr.Remediation)
+		case PreflightFail:
+			log.Errorf("preflight %s", pr)
+		default:
+			log.Debugf("preflight %s: %s", pr.Status, pr)
+		}
+	}
+
+	return results.Err()
+}
+
+func runPreflightChecks(env *preflightEnv, cfg *config.Server) PreflightResults {
+	var results PreflightResults
+	for _, check := range []preflightCheckFn{
+		checkIOMMU,
+		checkVFIO,
+		checkHugepages,
+		checkMaxMapCount,
+		checkMemlock,
+		checkNofile,
+	} {
+		results = append(results, check(env, cfg))
+	}
+
+	return results
+}
+
+// needsVFIO returns a reason to skip checks related to the userspace VFIO
+// driver, or an empty string if the driver is required by the config.
+func needsVFIO(env *preflightEnv, cfg *config.Server) string {
+	switch {
+	case !getBdevCfgsFromSrvCfg(cfg).HaveRealNVMe():
+		return "no NVMe SSDs in config"
+	case cfg.DisableHugepages:
+		return "hugepages disabled in config"
+	case env.username == "root":
+		return "running as root"
+	}
+	return ""
+}
+
+func checkIOMMU(env *preflightEnv, cfg *config.Server) *PreflightResult {
+	res := &PreflightResult{Check: "iommu"}
+
+	if reason := needsVFIO(env, cfg); reason != "" {
+		res.Status = PreflightSkip
+		res.Detail = reason
+		return res
+	}
+
+	if cfg.DisableVFIO {
+		res.Status = PreflightFail
+		res.Detail = FaultVfioDisabled.Description
+		res.Remediation = "set disable_vfio: false in the server config or run daos_server as root"
+		return res
+	}
+
+	enabled, err := env.isIOMMUEnabled()
+	switch {
+	case err != nil:
+		res.Status = PreflightFail
+		res.Detail = fmt.Sprintf("unable to determine IOMMU state: %s", err)
+	case !enabled:
+		res.Status = PreflightFail
+		res.Detail = "no IOMMU detected (/sys/class/iommu is empty) while running as non-root user with NVMe devices"
+		res.Remediation = "enable VT-d (Intel) or AMD-Vi (AMD) in the BIOS, add " +
+			"\"intel_iommu=on iommu=pt\" (Intel) or \"amd_iommu=on iommu=pt\" (AMD) to " +
+			"GRUB_CMDLINE_LINUX in /etc/default/grub, run grub2-mkconfig -o " +
+			"/boot/grub2/grub.cfg and reboot, or run daos_server as root"
+	default:
+		res.Status = PreflightPass
+		res.Detail = "IOMMU enabled"
+	}
+
+	return res
+}
+
+func checkVFIO(env *preflightEnv, cfg *config.Server) *PreflightResult {
+	res := &PreflightResult{Check: "vfio"}
+
+	reason := needsVFIO(env, cfg)
+	if reason == "" && cfg.DisableVFIO {
+		reason = "VFIO disabled in config"
+	}
+	if reason != "" {
+		res.Status = PreflightSkip
+		res.Detail = reason
+		return res
+	}
+
+	if _, err := env.stat(vfioDevPath); err != nil {
+		res.Status = PreflightFail
+		if os.IsNotExist(err) {
+			res.Detail = fmt.Sprintf("%s not found", vfioDevPath)
+			res.Remediation = "load the vfio-pci kernel module with modprobe vfio-pci and " +
+				"persist it with echo vfio-pci > /etc/modules-load.d/vfio-pci.conf"
+		} else {
+			res.Detail = fmt.Sprintf("unable to stat %s: %s", vfioDevPath, err)
+		}
+		return res
+	}
+
+	if err := env.access(vfioDevPath, unix.R_OK|unix.W_OK); err != nil {
+		res.Status = PreflightFail
+		res.Detail = fmt.Sprintf("user %s cannot open %s: %s", env.username, vfioDevPath, err)
+		res.Remediation = fmt.Sprintf("restore the default permissions with chmod 0666 %s",
+			vfioDevPath)
+		return res
+	}
+
+	res.Status = PreflightPass
+	res.Detail = fmt.Sprintf("%s accessible by user %s", vfioDevPath, env.username)
+	return res
+}
+
+func checkHugepages(env *preflightEnv, cfg *config.Server) *PreflightResult {
+	res := &PreflightResult{Check: "hugepages"}
+
+	if cfg.DisableHugepages {
+		res.Status = PreflightSkip
+		res.Detail = "hugepages disabled in config"
+		return res
+	}
+
+	required := cfg.NrHugepages
+	if required == 0 {
+		required = scanMinHugePageCount
+	}
+
+	mi, err := env.getMemInfo()
+	if err != nil {
+		res.Status = PreflightFail
+		res.Detail = fmt.Sprintf("unable to read hugepage info: %s", err)
+		return res
+	}
+	pageSize := uint64(mi.HugePageSizeKb) * humanize.KiByte
+	reqBytes := uint64(required) * pageSize
+
+	if reqBytes > uint64(mi.MemTotal)*humanize.KiByte {
+		res.Status = PreflightFail
+		res.Detail = fmt.Sprintf("nr_hugepages %d (%s) exceeds total memory (%s)", required,
+			humanize.IBytes(reqBytes), humanize.IBytes(uint64(mi.MemTotal)*humanize.KiByte))
+		res.Remediation = "reduce nr_hugepages in the server config"
+		return res
+	}
+
+	if mi.HugePagesTotal >= required {
+		res.Status = PreflightPass
+		res.Detail = fmt.Sprintf("%d of %d required hugepages allocated", mi.HugePagesTotal,
+			required)
+		return res
+	}
+
+	missing := required - mi.HugePagesTotal
+	missingBytes := uint64(missing) * pageSize
+	availBytes := uint64(mi.MemAvailable) * humanize.KiByte
+	if missingBytes > availBytes {
+		res.Status = PreflightFail
+		res.Detail = fmt.Sprintf("%d more hugepages (%s) required but only %s of memory available",
+			missing, humanize.IBytes(missingBytes), humanize.IBytes(availBytes))
+		res.Remediation = fmt.Sprintf("reserve hugepages at boot by adding \"hugepagesz=%dk "+
+			"hugepages=%d\" to GRUB_CMDLINE_LINUX in /etc/default/grub, run grub2-mkconfig -o "+
+			"/boot/grub2/grub.cfg and reboot, or reduce nr_hugepages in the server config",
+			mi.HugePageSizeKb, required)
+		return res
+	}
+
+	res.Status = PreflightPass
+	res.Detail = fmt.Sprintf("%d of %d required hugepages allocated, %d will be allocated at start",
+		mi.HugePagesTotal, required, missing)
+	return res
+}
+
+func checkMaxMapCount(env *preflightEnv, cfg *config.Server) *PreflightResult {
+	res := &PreflightResult{Check: "max_map_count"}
+
+	buf, err := env.readFile(maxMapCountPath)
+	if err != nil {
+		res.Status = PreflightWarn
+		res.Detail = fmt.Sprintf("unable to read %s: %s", maxMapCountPath, err)
+		return res
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(string(buf)))
+	if err != nil {
+		res.Status = PreflightWarn
+		res.Detail = fmt.Sprintf("unable to parse %s: %s", maxMapCountPath, err)
+		return res
+	}
+
+	if count >= recMaxMapCount {
+		res.Status = PreflightPass
+		res.Detail = fmt.Sprintf("vm.max_map_count is %d", count)
+		return res
+	}
+
+	res.Status = PreflightWarn
+	res.Detail = fmt.Sprintf("vm.max_map_count is %d, %d recommended", count, recMaxMapCount)
+	if count < minMaxMapCount {
+		res.Detail += fmt.Sprintf(" (engines will not use mmap()'d ULT stacks below %d)",
+			minMaxMapCount)
+	}
+	res.Remediation = fmt.Sprintf("run sysctl -w vm.max_map_count=%d and persist it with "+
+		"vm.max_map_count=%d in %s", recMaxMapCount, recMaxMapCount, sysctlConfPath)
+	return res
+}
+
+func limitsRemediation(username, item, value string) string {
+	unitKey := map[string]string{
+		"memlock": "LimitMEMLOCK",
+		"nofile":  "LimitNOFILE",
+	}[item]
+
+	return fmt.Sprintf("set %s=infinity in the daos_server systemd unit or add \"%s soft %s %s\" "+
+		"and \"%s hard %s %s\" to %s", unitKey, username, item, value, username, item, value,
+		limitsConfPath)
+}
+
+func checkMemlock(env *preflightEnv, cfg *config.Server) *PreflightResult {
+	res := &PreflightResult{Check: "memlock"}
+
+	if reason := needsVFIO(env, cfg); reason != "" {
+		res.Status = PreflightSkip
+		res.Detail = reason
+		return res
+	}
+
+	var rlim unix.Rlimit
+	if err := env.getRlimit(unix.RLIMIT_MEMLOCK, &rlim); err != nil {
+		res.Status = PreflightFail
+		res.Detail = fmt.Sprintf("unable to get RLIMIT_MEMLOCK: %s", err)
+		return res
+	}
+
+	switch {
+	case rlim.Cur == unix.RLIM_INFINITY:
+		res.Status = PreflightPass
+		res.Detail = "locked memory limit is unlimited"
+	case rlim.Cur < minMemlockBytes:
+		res.Status = PreflightFail
+		res.Detail = fmt.Sprintf("locked memory limit %s is less than the %s required for "+
+			"NVMe access as a non-root user", humanize.IBytes(rlim.Cur),
+			humanize.IBytes(minMemlockBytes))
+		res.Remediation = limitsRemediation(env.username, "memlock", "unlimited")
+	default:
+		res.Status = PreflightPass
+		res.Detail = fmt.Sprintf("locked memory limit is %s", humanize.IBytes(rlim.Cur))
+	}
+
+	return res
+}
+
+func checkNofile(env *preflightEnv, cfg *config.Server) *PreflightResult {
+	res := &PreflightResult{Check: "nofile"}
+
+	if len(cfg.Engines) == 0 {
+		res.Status = PreflightSkip
+		res.Detail = "no engines in config"
+		return res
+	}
+
+	var rlim unix.Rlimit
+	if err := env.getRlimit(unix.RLIMIT_NOFILE, &rlim); err != nil {
+		res.Status = PreflightWarn
+		res.Detail = fmt.Sprintf("unable to get RLIMIT_NOFILE: %s", err)
+		return res
+	}
+
+	if rlim.Cur != unix.RLIM_INFINITY && rlim.Cur < minNofile {
+		res.Status = PreflightWarn
+		res.Detail = fmt.Sprintf("open file limit is %d, %d recommended", rlim.Cur, minNofile)
+		res.Remediation = limitsRemediation(env.username, "nofile",
+			strconv.Itoa(minNofile))
+		return res
+	}
+
+	res.Status = PreflightPass
+	res.Detail = fmt.Sprintf("open file limit is %d", rlim.Cur)
+	return res
+}
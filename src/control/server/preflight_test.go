@@ -0,0 +1,268 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func mockPreflightEnv() *preflightEnv {
+	return &preflightEnv{
+		username:       "daos_server",
+		isIOMMUEnabled: func() (bool, error) { return true, nil },
+		getMemInfo: func() (*common.MemInfo, error) {
+			return &common.MemInfo{
+				HugePageSizeKb: 2048,
+				HugePagesTotal: 4096,
+				MemTotal:       64 * humanize.MiByte,
+				MemAvailable:   32 * humanize.MiByte,
+			}, nil
+		},
+		readFile: func(string) ([]byte, error) { return []byte("1000000\n"), nil },
+		stat:     func(string) (os.FileInfo, error) { return nil, nil },
+		access:   func(string, uint32) error { return nil },
+		getRlimit: func(_ int, rlim *unix.Rlimit) error {
+			rlim.Cur = unix.RLIM_INFINITY
+			rlim.Max = unix.RLIM_INFINITY
+			return nil
+		},
+	}
+}
+
+func mockPreflightConfig() *config.Server {
+	cfg := config.DefaultServer().WithEngines(
+		engine.MockConfig().
+			WithStorage(
+				storage.NewTierConfig().
+					WithStorageClass("ram").
+					WithScmMountPoint("/mnt/daos"),
+				storage.NewTierConfig().
+					WithStorageClass("nvme").
+					WithBdevDeviceList("0000:81:00.0"),
+			),
+	)
+	cfg.NrHugepages = 4096
+	return cfg
+}
+
+func TestServer_runPreflightChecks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		setup     func(*preflightEnv, *config.Server)
+		expStatus map[string]PreflightStatus
+		expErr    error
+		expRes    string
+	}{
+		"all pass": {
+			expStatus: map[string]PreflightStatus{
+				"iommu":         PreflightPass,
+				"vfio":          PreflightPass,
+				"hugepages":     PreflightPass,
+				"max_map_count": PreflightPass,
+				"memlock":       PreflightPass,
+				"nofile":        PreflightPass,
+			},
+		},
+		"root user": {
+			setup: func(env *preflightEnv, _ *config.Server) {
+				env.username = "root"
+				env.isIOMMUEnabled = func() (bool, error) { return false, nil }
+			},
+			expStatus: map[string]PreflightStatus{
+				"iommu":   PreflightSkip,
+				"vfio":    PreflightSkip,
+				"memlock": PreflightSkip,
+			},
+		},
+		"no nvme in config": {
+			setup: func(env *preflightEnv, cfg *config.Server) {
+				cfg.Engines[0].Storage.Tiers = cfg.Engines[0].Storage.Tiers.ScmConfigs()
+				env.isIOMMUEnabled = func() (bool, error) { return false, nil }
+			},
+			expStatus: map[string]PreflightStatus{
+				"iommu":   PreflightSkip,
+				"vfio":    PreflightSkip,
+				"memlock": PreflightSkip,
+			},
+		},
+		"iommu disabled": {
+			setup: func(env *preflightEnv, _ *config.Server) {
+				env.isIOMMUEnabled = func() (bool, error) { return false, nil }
+			},
+			expStatus: map[string]PreflightStatus{
+				"iommu": PreflightFail,
+			},
+			expErr: errors.New("no IOMMU detected"),
+			expRes: "intel_iommu=on iommu=pt",
+		},
+		"vfio disabled in config": {
+			setup: func(_ *preflightEnv, cfg *config.Server) {
+				cfg.DisableVFIO = true
+			},
+			expStatus: map[string]PreflightStatus{
+				"iommu": PreflightFail,
+				"vfio":  PreflightSkip,
+			},
+			expErr: errors.New("disable_vfio: true in config"),
+			expRes: "set disable_vfio: false",
+		},
+		"vfio device missing": {
+			setup: func(env *preflightEnv, _ *config.Server) {
+				env.stat = func(string) (os.FileInfo, error) { return nil, os.ErrNotExist }
+			},
+			expStatus: map[string]PreflightStatus{
+				"vfio": PreflightFail,
+			},
+			expErr: errors.New("/dev/vfio/vfio not found"),
+			expRes: "modprobe vfio-pci",
+		},
+		"vfio device inaccessible": {
+			setup: func(env *preflightEnv, _ *config.Server) {
+				env.access = func(string, uint32) error { return unix.EACCES }
+			},
+			expStatus: map[string]PreflightStatus{
+				"vfio": PreflightFail,
+			},
+			expErr: errors.New("cannot open /dev/vfio/vfio"),
+			expRes: "chmod 0666 /dev/vfio/vfio",
+		},
+		"hugepages exceed total memory": {
+			setup: func(_ *preflightEnv, cfg *config.Server) {
+				cfg.NrHugepages = 65536
+			},
+			expStatus: map[string]PreflightStatus{
+				"hugepages": PreflightFail,
+			},
+			expErr: errors.New("exceeds total memory"),
+			expRes: "reduce nr_hugepages",
+		},
+		"hugepages allocated at start": {
+			setup: func(env *preflightEnv, _ *config.Server) {
+				env.getMemInfo = func() (*common.MemInfo, error) {
+					return &common.MemInfo{
+						HugePageSizeKb: 2048,
+						MemTotal:       64 * humanize.MiByte,
+						MemAvailable:   32 * humanize.MiByte,
+					}, nil
+				}
+			},
+			expStatus: map[string]PreflightStatus{
+				"hugepages": PreflightPass,
+			},
+		},
+		"insufficient memory to allocate hugepages": {
+			setup: func(env *preflightEnv, _ *config.Server) {
+				env.getMemInfo = func() (*common.MemInfo, error) {
+					return &common.MemInfo{
+						HugePageSizeKb: 2048,
+						HugePagesTotal: 1024,
+						MemTotal:       64 * humanize.MiByte,
+						MemAvailable:   2 * humanize.MiByte,
+					}, nil
+				}
+			},
+			expStatus: map[string]PreflightStatus{
+				"hugepages": PreflightFail,
+			},
+			expErr: errors.New("3072 more hugepages"),
+			expRes: "hugepagesz=2048k hugepages=4096",
+		},
+		"hugepages disabled": {
+			setup: func(env *preflightEnv, cfg *config.Server) {
+				cfg.DisableHugepages = true
+				env.getMemInfo = func() (*common.MemInfo, error) {
+					return nil, errors.New("should not be called")
+				}
+			},
+			expStatus: map[string]PreflightStatus{
+				"iommu":     PreflightSkip,
+				"hugepages": PreflightSkip,
+			},
+		},
+		"low max_map_count": {
+			setup: func(env *preflightEnv, _ *config.Server) {
+				env.readFile = func(string) ([]byte, error) { return []byte("65530\n"), nil }
+			},
+			expStatus: map[string]PreflightStatus{
+				"max_map_count": PreflightWarn,
+			},
+		},
+		"low memlock": {
+			setup: func(env *preflightEnv, _ *config.Server) {
+				env.getRlimit = func(res int, rlim *unix.Rlimit) error {
+					rlim.Cur = unix.RLIM_INFINITY
+					if res == unix.RLIMIT_MEMLOCK {
+						rlim.Cur = 8 * humanize.MiByte
+					}
+					return nil
+				}
+			},
+			expStatus: map[string]PreflightStatus{
+				"memlock": PreflightFail,
+			},
+			expErr: errors.New("locked memory limit 8.0 MiB is less than"),
+			expRes: "LimitMEMLOCK=infinity",
+		},
+		"low nofile": {
+			setup: func(env *preflightEnv, _ *config.Server) {
+				env.getRlimit = func(res int, rlim *unix.Rlimit) error {
+					rlim.Cur = unix.RLIM_INFINITY
+					if res == unix.RLIMIT_NOFILE {
+						rlim.Cur = 1024
+					}
+					return nil
+				}
+			},
+			expStatus: map[string]PreflightStatus{
+				"nofile": PreflightWarn,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			env := mockPreflightEnv()
+			cfg := mockPreflightConfig()
+			if tc.setup != nil {
+				tc.setup(env, cfg)
+			}
+
+			results := runPreflightChecks(env, cfg)
+
+			gotStatus := make(map[string]PreflightStatus)
+			for _, pr := range results {
+				gotStatus[pr.Check] = pr.Status
+			}
+			for check, expStatus := range tc.expStatus {
+				if diff := cmp.Diff(expStatus, gotStatus[check]); diff != "" {
+					t.Fatalf("unexpected %s status (-want, +got):\n%s\n", check, diff)
+				}
+			}
+
+			gotErr := results.Err()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if !strings.Contains(fault.ShowResolutionFor(gotErr), tc.expRes) {
+				t.Fatalf("expected %q in resolution %q", tc.expRes,
+					fault.ShowResolutionFor(gotErr))
+			}
+			if tc.expErr == nil && len(results.Failed()) != 0 {
+				t.Fatalf("unexpected failed checks: %v", results.Failed())
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hardware/hwprov"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -125,6 +126,7 @@ type server struct {
 	membership   *system.Membership
 	sysdb        *raft.Database
 	pubSub       *events.PubSub
+	joinPrime    *joinPrimeCache
 	evtForwarder *control.EventForwarder
 	evtLogger    *control.EventLogger
 	ctlSvc       *ControlService
@@ -205,6 +207,7 @@ func (srv *server) createServices(ctx context.Context) (err error) {
 		return
 	}
 	srv.membership = system.NewMembership(srv.log, srv.sysdb)
+	srv.joinPrime = newJoinPrimeCache(srv.log)
 
 	// Create rpcClient for inter-server communication.
 	cliCfg := control.DefaultConfig()
@@ -221,7 +224,15 @@ func (srv *server) createServices(ctx context.Context) (err error) {
 
 	srv.ctlSvc = NewControlService(srv.log, srv.harness, srv.cfg, srv.pubSub,
 		hwprov.DefaultFabricScanner(srv.log))
+	srv.ctlSvc.telemetryFilter, err = promexp.NewClassFilter(srv.cfg.TelemetryDisabled...)
+	if err != nil {
+		return errors.Wrap(err, "invalid telemetry_disabled_classes")
+	}
 	srv.mgmtSvc = newMgmtSvc(srv.harness, srv.membership, srv.sysdb, rpcClient, srv.pubSub)
+	srv.mgmtSvc.certSigner, err = newCertSigner(srv.log, srv.cfg.TransportConfig)
+	if err != nil {
+		return errors.Wrap(err, "invalid transport_config.ca_key")
+	}
 
 	if err := srv.mgmtSvc.systemProps.UpdateCompPropVal(daos.SystemPropertyDaosSystem, func() string {
 		return srv.cfg.SystemName
@@ -301,11 +312,34 @@ func (srv *server) createEngine(ctx context.Context, idx int, cfg *engine.Config
 		req.SetSystem(srv.cfg.SystemName)
 		req.ControlAddr = srv.ctlAddr
 
-		return control.SystemJoin(ctxIn, srv.mgmtSvc.rpcClient, req)
+		rpcClient, ok := srv.mgmtSvc.rpcClient.(control.Invoker)
+		if !ok {
+			return control.SystemJoin(ctxIn, srv.mgmtSvc.rpcClient, req)
+		}
+
+		// Stream the join so that progress is visible on large systems
+		// where joins may take some time to be processed.
+		return control.SystemJoinStream(ctxIn, rpcClient, req, func(jp *control.JoinProgress) {
+			srv.log.Infof("instance %d join: %s", req.InstanceIdx, jp.Info)
+		})
+	}
+
+	var peerScmMounts []string
+	for peerIdx, peerCfg := range srv.cfg.Engines {
+		if peerIdx == idx {
+			continue
+		}
+		if scmCfgs := peerCfg.Storage.Tiers.ScmConfigs(); len(scmCfgs) == 1 {
+			peerScmMounts = append(peerScmMounts, scmCfgs[0].Scm.MountPoint)
+		}
 	}
 
 	engine := NewEngineInstance(srv.log, storage.DefaultProvider(srv.log, idx, &cfg.Storage), joinFn,
-		engine.NewRunner(srv.log, cfg)).WithHostFaultDomain(srv.harness.faultDomain)
+		engine.NewRunner(srv.log, cfg).WithDetached(srv.cfg.WarmRestart)).
+		WithHostFaultDomain(srv.harness.faultDomain).
+		WithPeerScmMounts(peerScmMounts...).
+		WithWarmRestart(srv.cfg.WarmRestart).
+		WithJoinMaxWait(srv.cfg.JoinMaxWait)
 	if idx == 0 {
 		configureFirstEngine(ctx, engine, srv.sysdb, joinFn)
 	}
@@ -324,16 +358,27 @@ func (srv *server) addEngines(ctx context.Context) error {
 		return err
 	}
 
-	// Allocate hugepages and rebind NVMe devices to userspace drivers.
-	if err := prepBdevStorage(srv, iommuEnabled); err != nil {
-		return err
-	}
+	var nvmeScanResp *storage.BdevScanResponse
+	if detachedEnginesRunning(srv.log, srv.cfg) {
+		// The NVMe devices are in use by the engines that are going to be
+		// reattached to, so they must not be prepared or scanned.
+		srv.log.Notice("engines left running for warm restart, skipping NVMe prepare and scan")
+		nvmeScanResp = &storage.BdevScanResponse{}
+	} else {
+		// Allocate hugepages and rebind NVMe devices to userspace drivers.
+		if err := prepBdevStorage(srv, iommuEnabled); err != nil {
+			return err
+		}
+		srv.OnShutdown(func() {
+			teardownBdevStorage(srv, engineStopTimeout)
+		})
 
-	// Retrieve NVMe device details (before engines are started) so static details can be
-	// recovered by the engine storage provider(s) during scan even if devices are in use.
-	nvmeScanResp, err := scanBdevStorage(srv)
-	if err != nil {
-		return err
+		// Retrieve NVMe device details (before engines are started) so static details can be
+		// recovered by the engine storage provider(s) during scan even if devices are in use.
+		nvmeScanResp, err = scanBdevStorage(srv)
+		if err != nil {
+			return err
+		}
 	}
 
 	if len(srv.cfg.Engines) == 0 {
@@ -357,6 +402,7 @@ func (srv *server) addEngines(ctx context.Context) error {
 		if err := srv.harness.AddInstance(engine); err != nil {
 			return err
 		}
+		srv.OnShutdown(engine.releaseStorageLock)
 		// increment count of engines waiting to start
 		allStarted.Add(1)
 	}
@@ -381,7 +427,17 @@ func (srv *server) addEngines(ctx context.Context) error {
 
 // setupGrpc creates a new grpc server and registers services.
 func (srv *server) setupGrpc() error {
-	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig)
+	var tv *security.TokenValidator
+	if srv.cfg.OIDC != nil {
+		var err error
+		tv, err = security.NewTokenValidator(srv.cfg.OIDC)
+		if err != nil {
+			return errors.Wrap(err, "setting up oidc token validation")
+		}
+		srv.log.Infof("OIDC bearer tokens from issuer %s accepted for administrative requests", srv.cfg.OIDC.Issuer)
+	}
+
+	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, tv)
 	if err != nil {
 		return err
 	}
@@ -401,6 +457,7 @@ func (srv *server) setupGrpc() error {
 		SrvSrxSet:       srxSetting,
 		EnvVars:         srv.cfg.ClientEnvVars,
 	}
+	srv.mgmtSvc.clientNetMap = srv.cfg.ClientNetMap
 	mgmtpb.RegisterMgmtSvcServer(srv.grpcServer, srv.mgmtSvc)
 
 	tSec, err := security.DialOptionForTransportConfig(srv.cfg.TransportConfig)
@@ -421,6 +478,10 @@ func (srv *server) registerEvents() {
 		func(ctx context.Context) error {
 			srv.log.Infof("MS leader running on %s", srv.hostname)
 			srv.mgmtSvc.startJoinLoop(ctx)
+			srv.mgmtSvc.startHeartbeatMonitor(ctx)
+			if srv.cfg.InventoryExport != nil {
+				srv.mgmtSvc.startInventoryExport(ctx, srv.cfg.InventoryExport)
+			}
 			registerLeaderSubscriptions(srv)
 			srv.log.Debugf("requesting sync GroupUpdate after leader change")
 			go func() {
@@ -451,6 +512,7 @@ func (srv *server) registerEvents() {
 	srv.sysdb.OnLeadershipLost(func() error {
 		srv.log.Infof("MS leader no longer running on %s", srv.hostname)
 		registerFollowerSubscriptions(srv)
+		srv.mgmtSvc.eventWatchers.closeAll()
 		return nil
 	})
 }
@@ -483,7 +545,7 @@ func (srv *server) start(ctx context.Context, shutdown context.CancelFunc) error
 		sockDir: srv.cfg.SocketDir,
 		engines: srv.harness.Instances(),
 		tc:      srv.cfg.TransportConfig,
-		sysdb:   srv.sysdb,
+		pools:   &primedPoolResolver{sysdb: srv.sysdb, cache: srv.joinPrime},
 		events:  srv.pubSub,
 	}
 	// Single daos_server dRPC server to handle all engine requests
@@ -496,6 +558,14 @@ func (srv *server) start(ctx context.Context, shutdown context.CancelFunc) error
 		}
 	}()
 
+	go srv.harness.runHeartbeatLoop(ctx, srv.ctlAddr, heartbeatInterval,
+		func(ctxIn context.Context, req *control.SystemHeartbeatReq) error {
+			req.SetHostList(srv.cfg.AccessPoints)
+			req.SetSystem(srv.cfg.SystemName)
+
+			return control.SystemHeartbeat(ctxIn, srv.mgmtSvc.rpcClient, req)
+		})
+
 	return errors.Wrapf(srv.harness.Start(ctx, srv.sysdb, srv.cfg),
 		"%s harness exited", build.ControlPlaneName)
 }
@@ -528,6 +598,10 @@ func Start(log logging.Logger, cfg *config.Server) error {
 		return err
 	}
 
+	// Remove anything left behind by a previous instance that crashed, so
+	// that it doesn't accumulate across engine restarts.
+	removeStaleEngineResources(log)
+
 	// Create the root context here. All contexts should inherit from this one so
 	// that they can be shut down from one place.
 	ctx, shutdown := context.WithCancel(context.Background())
@@ -555,6 +629,14 @@ func Start(log logging.Logger, cfg *config.Server) error {
 		return err
 	}
 
+	if err := setEngineHelperCPUs(ctx, log, cfg, hwprov.DefaultTopologyProvider(log)); err != nil {
+		return err
+	}
+
+	if err := checkPreflight(log, cfg); err != nil {
+		return err
+	}
+
 	faultDomain, err := getFaultDomain(cfg)
 	if err != nil {
 		return err
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -17,10 +17,12 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/encoding/gzip" // accept gzip-compressed requests from clients
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/events"
@@ -462,6 +464,24 @@ func updateMemValues(srv *server, engine *EngineInstance, getMemInfo common.GetM
 	return nil
 }
 
+// removeStaleEngineResources removes SPDK shared memory and runtime files left
+// behind by engines that exited without cleaning up after themselves, e.g.
+// after a crash. Files belonging to running processes are left in place.
+// Failures are logged but not fatal. Stale hugepage files are removed
+// separately by cleanEngineHugePages().
+func removeStaleEngineResources(log logging.Logger) {
+	removed, err := common.RemoveStale(log, common.SpdkTraceShm, common.SpdkRuntimeDir)
+	for _, path := range removed {
+		log.Debugf("removed stale engine resource %s", path)
+	}
+	if len(removed) > 0 {
+		log.Noticef("removed %d files left behind by previous engine instances", len(removed))
+	}
+	if err != nil {
+		log.Errorf("failed to remove stale engine resources: %s", err)
+	}
+}
+
 func cleanEngineHugePages(srv *server) error {
 	req := storage.BdevPrepareRequest{
 		CleanHugePagesOnly: true,
@@ -479,6 +499,82 @@ func cleanEngineHugePages(srv *server) error {
 	return nil
 }
 
+// engineStopTimeout is the length of time to wait on shutdown for engine
+// processes to exit before NVMe storage is torn down.
+const engineStopTimeout = 30 * time.Second
+
+// waitEnginesStopped waits for the processes of all engines managed by the
+// harness to exit, so that the NVMe controllers they were using have been
+// detached.
+func waitEnginesStopped(ctx context.Context, harness *EngineHarness, pollInterval time.Duration) error {
+	for {
+		var running []string
+		for _, ei := range harness.Instances() {
+			if ei.IsStarted() {
+				running = append(running, strconv.Itoa(int(ei.Index())))
+			}
+		}
+		if len(running) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("engine instance(s) %s still running",
+				strings.Join(running, ","))
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// teardownBdevStorage returns NVMe storage to a clean state on shutdown. Once
+// all engines have stopped, hugepage files left behind by the engines are
+// removed and, if enabled in the config, the NVMe SSDs are rebound to the
+// kernel driver and hugepage allocations are released.
+func teardownBdevStorage(srv *server, stopTimeout time.Duration) {
+	if srv.cfg.DisableHugepages {
+		return
+	}
+	if srv.cfg.WarmRestart {
+		srv.log.Debug("engines left running for warm restart, skipping NVMe teardown")
+		return
+	}
+	defer srv.logDuration(track("time to tear down bdev storage"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+	if err := waitEnginesStopped(ctx, srv.harness, 100*time.Millisecond); err != nil {
+		srv.log.Errorf("skipping NVMe teardown: %s", err)
+		return
+	}
+
+	if err := cleanEngineHugePages(srv); err != nil {
+		srv.log.Errorf(err.Error())
+	}
+
+	if !srv.cfg.ResetNVMeOnShutdown {
+		return
+	}
+
+	bdevCfgs := getBdevCfgsFromSrvCfg(srv.cfg)
+	req := storage.BdevPrepareRequest{
+		TargetUser:       srv.runningUser.Username,
+		PCIAllowList:     strings.Join(bdevCfgs.NVMeBdevs().Devices(), storage.BdevPciAddrSep),
+		PCIBlockList:     strings.Join(srv.cfg.BdevExclude, storage.BdevPciAddrSep),
+		DisableVFIO:      srv.cfg.DisableVFIO,
+		Reset_:           true,
+		ReleaseHugePages: true,
+	}
+	if srv.cfg.DisableVMD == nil || !*srv.cfg.DisableVMD {
+		req.EnableVMD = true
+	}
+
+	srv.log.Info("resetting NVMe devices and releasing hugepages")
+	if _, err := srv.ctlSvc.NvmePrepare(req); err != nil {
+		srv.log.Errorf("NVMe reset on shutdown failed: %s", err)
+	}
+}
+
 func registerEngineEventCallbacks(srv *server, engine *EngineInstance, allStarted *sync.WaitGroup) {
 	// Register callback to publish engine process exit events.
 	engine.OnInstanceExit(createPublishInstanceExitFunc(srv.pubSub.Publish, srv.hostname))
@@ -486,6 +582,12 @@ func registerEngineEventCallbacks(srv *server, engine *EngineInstance, allStarte
 	// Register callback to publish engine format requested events.
 	engine.OnAwaitFormat(createPublishFormatRequiredFunc(srv.pubSub.Publish, srv.hostname))
 
+	// Register callback to publish engine metadata integrity check failures.
+	engine.OnMetadataCorrupted(createPublishMetadataCorruptedFunc(srv.pubSub.Publish, srv.hostname))
+
+	// Register callback to cache the system state pushed by the MS on join.
+	engine.OnJoined(srv.joinPrime.update)
+
 	var onceReady sync.Once
 	engine.OnReady(func(_ context.Context) error {
 		// Indicate that engine has been started, only do this the first time that the
@@ -560,7 +662,8 @@ func registerTelemetryCallbacks(ctx context.Context, srv *server) {
 
 	srv.OnEnginesStarted(func(ctxIn context.Context) error {
 		srv.log.Debug("starting Prometheus exporter")
-		cleanup, err := startPrometheusExporter(ctxIn, srv.log, telemPort, srv.harness.Instances())
+		cleanup, err := startPrometheusExporter(ctxIn, srv.log, telemPort, srv.harness.Instances(), srv.sysdb,
+			srv.ctlSvc.telemetryFilter)
 		if err != nil {
 			return err
 		}
@@ -586,6 +689,7 @@ func registerLeaderSubscriptions(srv *server) {
 	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.membership)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.sysdb)
+	srv.pubSub.Subscribe(events.RASTypeAny, srv.mgmtSvc.eventWatchers)
 	srv.pubSub.Subscribe(events.RASTypeStateChange,
 		events.HandlerFunc(func(ctx context.Context, evt *events.RASEvent) {
 			switch evt.ID {
@@ -620,7 +724,7 @@ func registerLeaderSubscriptions(srv *server) {
 }
 
 // getGrpcOpts generates a set of gRPC options for the server based on the supplied configuration.
-func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig) ([]grpc.ServerOption, error) {
+func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, tv *security.TokenValidator) ([]grpc.ServerOption, error) {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		unaryLoggingInterceptor(log), // must be first in order to properly log errors
 		unaryErrorInterceptor,
@@ -636,14 +740,20 @@ func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig) ([]
 	}
 	srvOpts := []grpc.ServerOption{tcOpt}
 
-	uintOpt, err := unaryInterceptorForTransportConfig(cfgTransport)
+	tuningOpts, err := cfgTransport.Grpc.ServerOptions()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid grpc config")
+	}
+	srvOpts = append(srvOpts, tuningOpts...)
+
+	uintOpt, err := unaryInterceptorForTransportConfig(cfgTransport, tv)
 	if err != nil {
 		return nil, err
 	}
 	if uintOpt != nil {
 		unaryInterceptors = append(unaryInterceptors, uintOpt)
 	}
-	sintOpt, err := streamInterceptorForTransportConfig(cfgTransport)
+	sintOpt, err := streamInterceptorForTransportConfig(cfgTransport, tv)
 	if err != nil {
 		return nil, err
 	}
@@ -742,3 +852,49 @@ func checkFabricInterface(name string, lookup func(string) (netInterface, error)
 
 	return nil
 }
+
+// setEngineHelperCPUs computes the CPUs to be used by helper XS for engines
+// that have been configured with an explicit helper placement.
+func setEngineHelperCPUs(ctx context.Context, log logging.Logger, cfg *config.Server, tp hardware.TopologyProvider) error {
+	var topo *hardware.Topology
+	for idx, ec := range cfg.Engines {
+		if ec.HelperPlacement != engine.HelperPlacementHyperthread {
+			continue
+		}
+
+		if topo == nil {
+			var err error
+			if topo, err = tp.GetTopology(ctx); err != nil {
+				return errors.Wrap(err, "get hardware topology")
+			}
+		}
+
+		if err := ec.SetHelperCPUs(topo); err != nil {
+			return errors.Wrapf(err, "engine %d: unable to place helper XS", idx)
+		}
+		log.Debugf("engine %d: helper XS placed on CPUs %s", idx, ec.HelperCPUs)
+	}
+
+	return nil
+}
+
+// detachedEnginesRunning indicates whether any of the configured engines were
+// left running by a previous server instance for a warm restart.
+func detachedEnginesRunning(log logging.Logger, cfg *config.Server) bool {
+	if !cfg.WarmRestart {
+		return false
+	}
+
+	for idx, ec := range cfg.Engines {
+		pid, err := engine.FindDetached(log, ec.SocketDir, uint32(idx))
+		if err != nil {
+			log.Errorf("engine %d: %s", idx, err)
+			continue
+		}
+		if pid != 0 {
+			return true
+		}
+	}
+
+	return false
+}
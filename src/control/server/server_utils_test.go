@@ -7,17 +7,20 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os/user"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/atm"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/logging"
 	sysprov "github.com/daos-stack/daos/src/control/provider/system"
@@ -705,6 +708,107 @@ func TestServer_prepBdevStorage(t *testing.T) {
 	}
 }
 
+func TestServer_teardownBdevStorage(t *testing.T) {
+	nvmeEngine := engine.MockConfig().WithStorage(
+		storage.NewTierConfig().WithStorageClass(storage.ClassDcpm.String()).
+			WithScmMountPoint("/mnt/daos0").
+			WithScmDeviceList("/dev/pmem0"),
+		storage.NewTierConfig().WithStorageClass(storage.ClassNvme.String()).
+			WithBdevDeviceList(test.MockPCIAddr(1)),
+	)
+
+	for name, tc := range map[string]struct {
+		srvCfgExtra   func(*config.Server) *config.Server
+		engineRunning bool
+		expPrepCalls  []storage.BdevPrepareRequest
+		expResetCalls []storage.BdevPrepareRequest
+	}{
+		"hugepages disabled": {
+			srvCfgExtra: func(sc *config.Server) *config.Server {
+				return sc.WithDisableHugePages(true)
+			},
+		},
+		"warm restart": {
+			srvCfgExtra: func(sc *config.Server) *config.Server {
+				return sc.WithWarmRestart(true).WithResetNVMeOnShutdown(true)
+			},
+		},
+		"engine still running": {
+			srvCfgExtra: func(sc *config.Server) *config.Server {
+				return sc.WithResetNVMeOnShutdown(true)
+			},
+			engineRunning: true,
+		},
+		"clean hugepages only": {
+			expPrepCalls: []storage.BdevPrepareRequest{
+				{CleanHugePagesOnly: true},
+			},
+		},
+		"reset nvme on shutdown": {
+			srvCfgExtra: func(sc *config.Server) *config.Server {
+				return sc.WithResetNVMeOnShutdown(true).
+					WithBdevExclude(test.MockPCIAddr(2))
+			},
+			expPrepCalls: []storage.BdevPrepareRequest{
+				{CleanHugePagesOnly: true},
+			},
+			expResetCalls: []storage.BdevPrepareRequest{
+				{
+					TargetUser:       "daos_server",
+					PCIAllowList:     test.MockPCIAddr(1),
+					PCIBlockList:     test.MockPCIAddr(2),
+					EnableVMD:        true,
+					Reset_:           true,
+					ReleaseHugePages: true,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithEngines(nvmeEngine)
+			if tc.srvCfgExtra != nil {
+				cfg = tc.srvCfgExtra(cfg)
+			}
+
+			srv, err := newServer(log, cfg, &system.FaultDomain{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			srv.runningUser = &user.User{Username: "daos_server"}
+
+			mbb := bdev.NewMockBackend(nil)
+			sp := sysprov.NewMockSysProvider(log, nil)
+			srv.ctlSvc = &ControlService{
+				StorageControlService: *NewMockStorageControlService(log, cfg.Engines,
+					sp, scm.NewProvider(log, scm.NewMockBackend(nil), sp, nil),
+					bdev.NewProvider(log, mbb)),
+				srvCfg: cfg,
+			}
+
+			ei := NewMockInstance(&MockInstanceConfig{
+				Started: atm.NewBool(tc.engineRunning),
+			})
+			if err := srv.harness.AddInstance(ei); err != nil {
+				t.Fatal(err)
+			}
+
+			teardownBdevStorage(srv, 10*time.Millisecond)
+
+			mbb.RLock()
+			defer mbb.RUnlock()
+			if diff := cmp.Diff(tc.expPrepCalls, mbb.PrepareCalls); diff != "" {
+				t.Fatalf("unexpected prepare calls (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expResetCalls, mbb.ResetCalls); diff != "" {
+				t.Fatalf("unexpected reset calls (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 // TestServer_scanBdevStorage validates that an error is returned in the case that a SSD is not
 // found and doesn't return an error if SPDK fails to init.
 func TestServer_scanBdevStorage(t *testing.T) {
@@ -1169,3 +1273,73 @@ func TestServerUtils_getControlAddr(t *testing.T) {
 		})
 	}
 }
+
+func TestServerUtils_setEngineHelperCPUs(t *testing.T) {
+	mockTopo := &hardware.Topology{
+		NUMANodes: hardware.NodeMap{
+			0: hardware.MockNUMANode(0, 6),
+		},
+	}
+	for i := range mockTopo.NUMANodes[0].Cores {
+		core := &mockTopo.NUMANodes[0].Cores[i]
+		core.Threads = []uint{core.ID, core.ID + 8}
+	}
+
+	for name, tc := range map[string]struct {
+		engineCfgs    []*engine.Config
+		topoErr       error
+		expHelperCPUs []string
+		expErr        error
+	}{
+		"default placement; topology not needed": {
+			engineCfgs:    []*engine.Config{engine.MockConfig().WithTargetCount(2)},
+			topoErr:       errors.New("topology not needed"),
+			expHelperCPUs: []string{""},
+		},
+		"topology fails": {
+			engineCfgs: []*engine.Config{
+				engine.MockConfig().WithTargetCount(2).
+					WithHelperPlacement(engine.HelperPlacementHyperthread),
+			},
+			topoErr: errors.New("topology failed"),
+			expErr:  errors.New("topology failed"),
+		},
+		"placement fails": {
+			engineCfgs: []*engine.Config{
+				engine.MockConfig().WithTargetCount(8).WithPinnedNumaNode(0).
+					WithHelperPlacement(engine.HelperPlacementHyperthread),
+			},
+			expErr: errors.New("engine 0: unable to place helper XS"),
+		},
+		"mixed placements": {
+			engineCfgs: []*engine.Config{
+				engine.MockConfig().WithTargetCount(2).WithPinnedNumaNode(0),
+				engine.MockConfig().WithTargetCount(2).WithPinnedNumaNode(0).
+					WithHelperPlacement(engine.HelperPlacementHyperthread),
+			},
+			expHelperCPUs: []string{"", "10,11"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithEngines(tc.engineCfgs...)
+			tp := &hardware.MockTopologyProvider{
+				GetTopoReturn: mockTopo,
+				GetTopoErr:    tc.topoErr,
+			}
+
+			err := setEngineHelperCPUs(context.Background(), log, cfg, tp)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			for idx, ec := range cfg.Engines {
+				test.AssertEqual(t, tc.expHelperCPUs[idx], ec.HelperCPUs,
+					fmt.Sprintf("engine %d: unexpected helper CPUs", idx))
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -108,6 +109,32 @@ func (nds *NvmeDevState) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// NVMeFirmwareAction represents the action to perform in a firmware update.
+type NVMeFirmwareAction int32
+
+// NVMeFirmwareAction values representing firmware update actions.
+const (
+	// NVMeFirmwareUpdate downloads an image and activates it on next reset.
+	NVMeFirmwareUpdate NVMeFirmwareAction = iota
+	// NVMeFirmwareStage downloads an image to a slot without activating it.
+	NVMeFirmwareStage
+	// NVMeFirmwareCommit activates the image in a slot on next reset.
+	NVMeFirmwareCommit
+)
+
+func (nfa NVMeFirmwareAction) String() string {
+	switch nfa {
+	case NVMeFirmwareUpdate:
+		return "update"
+	case NVMeFirmwareStage:
+		return "stage"
+	case NVMeFirmwareCommit:
+		return "commit"
+	default:
+		return "unknown"
+	}
+}
+
 // LedState represents the LED state of device.
 type LedState int32
 
@@ -175,6 +202,9 @@ type NvmeHealth struct {
 	UnmapErrors             uint32 `json:"bio_unmap_errs"`
 	ChecksumErrors          uint32 `json:"checksum_errs"`
 	Temperature             uint32 `json:"temperature"`
+	AvailSpare              uint8  `json:"avail_spare"`
+	AvailSpareThreshold     uint8  `json:"avail_spare_threshold"`
+	PercentUsed             uint8  `json:"percentage_used"`
 	TempWarn                bool   `json:"temp_warn"`
 	AvailSpareWarn          bool   `json:"avail_spare_warn"`
 	ReliabilityWarn         bool   `json:"dev_reliability_warn"`
@@ -202,6 +232,12 @@ type NvmeHealth struct {
 	ClusterSize             uint64 `json:"cluster_size"`
 }
 
+// HasVendorAttrs returns true if vendor-specific SMART attributes have been
+// retrieved from the device's extended SMART log page.
+func (nch *NvmeHealth) HasVendorAttrs() bool {
+	return nch.ProgFailCntNorm > 0 || nch.EraseFailCntNorm > 0 || nch.WearLevelingCntNorm > 0
+}
+
 // TempK returns controller temperature in degrees Kelvin.
 func (nch *NvmeHealth) TempK() uint32 {
 	return uint32(nch.Temperature)
@@ -227,16 +263,18 @@ type NvmeNamespace struct {
 // SmdDevice contains DAOS storage device information, including
 // health details if requested.
 type SmdDevice struct {
-	UUID        string        `json:"uuid"`
-	TargetIDs   []int32       `hash:"set" json:"tgt_ids"`
-	NvmeState   NvmeDevState  `json:"dev_state"`
-	LedState    LedState      `json:"led_state"`
-	Rank        ranklist.Rank `json:"rank"`
-	TotalBytes  uint64        `json:"total_bytes"`
-	AvailBytes  uint64        `json:"avail_bytes"`
-	ClusterSize uint64        `json:"cluster_size"`
-	Health      *NvmeHealth   `json:"health"`
-	TrAddr      string        `json:"tr_addr"`
+	UUID          string        `json:"uuid"`
+	TargetIDs     []int32       `hash:"set" json:"tgt_ids"`
+	NvmeState     NvmeDevState  `json:"dev_state"`
+	LedState      LedState      `json:"led_state"`
+	Rank          ranklist.Rank `json:"rank"`
+	TotalBytes    uint64        `json:"total_bytes"`
+	AvailBytes    uint64        `json:"avail_bytes"`
+	ClusterSize   uint64        `json:"cluster_size"`
+	ReservedBytes uint64        `json:"reserved_bytes"`
+	PoolShards    uint32        `json:"pool_shards"`
+	Health        *NvmeHealth   `json:"health"`
+	TrAddr        string        `json:"tr_addr"`
 }
 
 func (sd *SmdDevice) String() string {
@@ -297,6 +335,23 @@ func (nc NvmeController) Free() (tb uint64) {
 	return
 }
 
+// Reserved returns the cumulative available bytes of blobstore clusters that
+// can't be allocated to pools.
+func (nc NvmeController) Reserved() (tb uint64) {
+	for _, d := range nc.SmdDevices {
+		tb += d.ReservedBytes
+	}
+	return
+}
+
+// PoolShards returns the cumulative number of VOS pool shards on all blobstores.
+func (nc NvmeController) PoolShards() (n uint32) {
+	for _, d := range nc.SmdDevices {
+		n += d.PoolShards
+	}
+	return
+}
+
 // NvmeControllers is a type alias for []*NvmeController.
 type NvmeControllers []*NvmeController
 
@@ -332,6 +387,24 @@ func (ncs NvmeControllers) Free() (tb uint64) {
 	return
 }
 
+// Reserved returns the cumulative available bytes of all blobstore clusters
+// that can't be allocated to pools.
+func (ncs NvmeControllers) Reserved() (tb uint64) {
+	for _, c := range ncs {
+		tb += (*NvmeController)(c).Reserved()
+	}
+	return
+}
+
+// PoolShards returns the cumulative number of VOS pool shards on all
+// controller blobstores.
+func (ncs NvmeControllers) PoolShards() (n uint32) {
+	for _, c := range ncs {
+		n += (*NvmeController)(c).PoolShards()
+	}
+	return
+}
+
 // PercentUsage returns the percentage of used storage space.
 func (ncs NvmeControllers) PercentUsage() string {
 	return common.PercentageString(ncs.Total()-ncs.Free(), ncs.Total())
@@ -390,6 +463,7 @@ type (
 		PCIBlockList       string
 		TargetUser         string
 		Reset_             bool
+		ReleaseHugePages   bool
 		DisableVFIO        bool
 		EnableVMD          bool
 	}
@@ -442,6 +516,7 @@ type (
 		TierProps         []BdevTierProperties
 		VMDEnabled        bool
 		HotplugEnabled    bool
+		HotplugPeriod     time.Duration
 		HotplugBusidBegin uint8
 		HotplugBusidEnd   uint8
 		Hostname          string
@@ -499,6 +574,8 @@ type (
 		FirmwarePath string   // location of the firmware binary
 		ModelID      string   // filter devices by model ID
 		FirmwareRev  string   // filter devices by current FW revision
+		Action       NVMeFirmwareAction
+		Slot         int32 // firmware slot, 0 lets the controller choose
 	}
 
 	// NVMeDeviceFirmwareUpdateResult represents the result of a firmware update for
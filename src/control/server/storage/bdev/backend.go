@@ -7,16 +7,14 @@
 package bdev
 
 import (
-	"fmt"
 	"os"
 	"os/exec"
-	"path/filepath"
-	"regexp"
 	"sort"
 	"syscall"
 
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/spdk"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -25,8 +23,7 @@ import (
 )
 
 const (
-	hugePageDir         = "/dev/hugepages"
-	spdkHugepagePattern = `spdk_pid([0-9]+)map`
+	hugePageDir = "/dev/hugepages"
 )
 
 type (
@@ -41,8 +38,6 @@ type (
 		script  *spdkSetupScript
 	}
 
-	statFn      func(string) (os.FileInfo, error)
-	removeFn    func(string) error
 	vmdDetectFn func() (*hardware.PCIAddressSet, error)
 	hpCleanFn   func(logging.Logger, string) (uint, error)
 	writeConfFn func(logging.Logger, *storage.BdevWriteConfigRequest) error
@@ -113,65 +108,14 @@ func defaultBackend(log logging.Logger) *spdkBackend {
 	return newBackend(log, defaultScriptRunner(log))
 }
 
-func isPIDActive(pidStr string, stat statFn) (bool, error) {
-	filename := fmt.Sprintf("/proc/%s", pidStr)
+// cleanHugePages removes hugepage files in hugePageDir that were created by SPDK
+// processes that are no longer running.
+func cleanHugePages(log logging.Logger, hugePageDir string) (uint, error) {
+	res := common.SpdkHugepages
+	res.Dir = hugePageDir
 
-	if _, err := stat(filename); err != nil {
-		if os.IsNotExist(err) {
-			return false, nil
-		}
-		return false, err
-	}
-
-	return true, nil
-}
-
-// createHugePageWalkFunc returns a filepath.WalkFunc that will remove any file whose
-// name begins with prefix and encoded pid is inactive.
-func createHugePageWalkFunc(log logging.Logger, hugePageDir string, stat statFn, remove removeFn, count *uint) filepath.WalkFunc {
-	re := regexp.MustCompile(spdkHugepagePattern)
-
-	return func(path string, info os.FileInfo, err error) error {
-		switch {
-		case err != nil:
-			return err
-		case info == nil:
-			return errors.New("nil fileinfo")
-		case info.IsDir():
-			log.Debugf("walk func: dir %s", path)
-			if path == hugePageDir {
-				return nil
-			}
-			return filepath.SkipDir // skip subdirectories
-		}
-
-		matches := re.FindStringSubmatch(info.Name())
-		if len(matches) != 2 {
-			log.Debugf("walk func: unexpected name, skipping %s", path)
-			return nil // skip files not matching expected pattern
-		}
-		// PID string will be the first submatch at index 1 of the match results.
-
-		if isActive, err := isPIDActive(matches[1], stat); err != nil || isActive {
-			log.Debugf("walk func: active owner proc, skipping %s", path)
-			return err // skip files created by an existing process (isActive == true)
-		}
-
-		log.Debugf("walk func: removing %s", path)
-		if err := remove(path); err != nil {
-			return err
-		}
-		*count++
-
-		return nil
-	}
-}
-
-// cleanHugePages removes hugepage files with pathPrefix that are owned by the user with username
-// tgtUsr by processing directory tree with filepath.WalkFunc returned from hugePageWalkFunc.
-func cleanHugePages(log logging.Logger, hugePageDir string) (count uint, _ error) {
-	return count, filepath.Walk(hugePageDir,
-		createHugePageWalkFunc(log, hugePageDir, os.Stat, os.Remove, &count))
+	removed, err := common.RemoveStale(log, res)
+	return uint(len(removed)), err
 }
 
 func logNUMAStats(log logging.Logger) {
@@ -550,17 +494,23 @@ func (sb *spdkBackend) WriteConfig(req storage.BdevWriteConfigRequest) (*storage
 	return &storage.BdevWriteConfigResponse{}, sb.writeNvmeConfig(req, writeJsonConfig)
 }
 
-// UpdateFirmware uses the SPDK bindings to update an NVMe controller's firmware.
-func (sb *spdkBackend) UpdateFirmware(pciAddr string, path string, slot int32) error {
-	sb.log.Debug("spdk backend update firmware")
+// UpdateFirmware uses the SPDK bindings to update, stage or commit an NVMe
+// controller's firmware.
+func (sb *spdkBackend) UpdateFirmware(pciAddr string, path string, slot int32, action storage.NVMeFirmwareAction) error {
+	sb.log.Debugf("spdk backend %s firmware", action)
 
 	if pciAddr == "" {
 		return FaultBadPCIAddr("")
 	}
 
-	if err := sb.binding.Update(sb.log, pciAddr, path, slot); err != nil {
-		return err
+	switch action {
+	case storage.NVMeFirmwareUpdate:
+		return sb.binding.Update(sb.log, pciAddr, path, slot)
+	case storage.NVMeFirmwareStage:
+		return sb.binding.Stage(sb.log, pciAddr, path, slot)
+	case storage.NVMeFirmwareCommit:
+		return sb.binding.Commit(sb.log, pciAddr, slot)
+	default:
+		return errors.Errorf("unknown firmware action %d", action)
 	}
-
-	return nil
 }
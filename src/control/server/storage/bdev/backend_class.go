@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -78,10 +78,17 @@ func writeConfigFile(log logging.Logger, buf *bytes.Buffer, req *storage.BdevWri
 		}
 	}()
 
-	if _, err := buf.WriteTo(f); err != nil {
+	data := buf.Bytes()
+	if _, err := f.Write(data); err != nil {
 		return errors.Wrap(err, "write")
 	}
 
+	// Store a checksum so that corruption of the file can be detected
+	// before it is consumed by the engine.
+	if err := common.WriteChecksumFile(req.ConfigOutputPath, data, 0644); err != nil {
+		return errors.Wrap(err, "write checksum")
+	}
+
 	return errors.Wrapf(os.Chown(req.ConfigOutputPath, req.OwnerUID, req.OwnerGID),
 		"failed to set ownership of %q to %d.%d", req.ConfigOutputPath,
 		req.OwnerUID, req.OwnerGID)
@@ -18,6 +18,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/engine"
@@ -635,6 +636,125 @@ func TestBackend_writeJSONFile(t *testing.T) {
     }
   ]
 }
+`,
+		},
+		"file; multiple files": {
+			confIn: storage.TierConfig{
+				Tier:  tierID,
+				Class: storage.ClassFile,
+				Bdev: storage.BdevConfig{
+					DeviceList: storage.MustNewBdevDeviceList("/tmp/daos-bdev-0", "/tmp/daos-bdev-1"),
+					FileSize:   1,
+				},
+			},
+			expOut: `
+{
+  "daos_data": {
+    "config": []
+  },
+  "subsystems": [
+    {
+      "subsystem": "bdev",
+      "config": [
+        {
+          "params": {
+            "bdev_io_pool_size": 65536,
+            "bdev_io_cache_size": 256
+          },
+          "method": "bdev_set_options"
+        },
+        {
+          "params": {
+            "retry_count": 4,
+            "timeout_us": 0,
+            "nvme_adminq_poll_period_us": 100000,
+            "action_on_timeout": "none",
+            "nvme_ioq_poll_period_us": 0
+          },
+          "method": "bdev_nvme_set_options"
+        },
+        {
+          "params": {
+            "enable": false,
+            "period_us": 0
+          },
+          "method": "bdev_nvme_set_hotplug"
+        },
+        {
+          "params": {
+            "block_size": 4096,
+            "name": "AIO_hostfoo_0_84",
+            "filename": "/tmp/daos-bdev-0"
+          },
+          "method": "bdev_aio_create"
+        },
+        {
+          "params": {
+            "block_size": 4096,
+            "name": "AIO_hostfoo_1_84",
+            "filename": "/tmp/daos-bdev-1"
+          },
+          "method": "bdev_aio_create"
+        }
+      ]
+    }
+  ]
+}
+`,
+		},
+		"kdev; single device": {
+			confIn: storage.TierConfig{
+				Tier:  tierID,
+				Class: storage.ClassKdev,
+				Bdev: storage.BdevConfig{
+					DeviceList: storage.MustNewBdevDeviceList("/dev/sdb"),
+				},
+			},
+			expOut: `
+{
+  "daos_data": {
+    "config": []
+  },
+  "subsystems": [
+    {
+      "subsystem": "bdev",
+      "config": [
+        {
+          "params": {
+            "bdev_io_pool_size": 65536,
+            "bdev_io_cache_size": 256
+          },
+          "method": "bdev_set_options"
+        },
+        {
+          "params": {
+            "retry_count": 4,
+            "timeout_us": 0,
+            "nvme_adminq_poll_period_us": 100000,
+            "action_on_timeout": "none",
+            "nvme_ioq_poll_period_us": 0
+          },
+          "method": "bdev_nvme_set_options"
+        },
+        {
+          "params": {
+            "enable": false,
+            "period_us": 0
+          },
+          "method": "bdev_nvme_set_hotplug"
+        },
+        {
+          "params": {
+            "block_size": 0,
+            "name": "AIO_hostfoo_0_84",
+            "filename": "/dev/sdb"
+          },
+          "method": "bdev_aio_create"
+        }
+      ]
+    }
+  ]
+}
 `,
 		},
 	}
@@ -688,6 +808,10 @@ func TestBackend_writeJSONFile(t *testing.T) {
 			if diff := cmp.Diff(tc.expOut, string(gotOut)); diff != "" {
 				t.Fatalf("(-want, +got):\n%s", diff)
 			}
+
+			if err := common.VerifyChecksumFile(cfgOutputPath); err != nil {
+				t.Fatalf("nvme config checksum: %s", err)
+			}
 		})
 	}
 }
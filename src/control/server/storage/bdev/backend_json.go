@@ -19,7 +19,7 @@ import (
 // JSON tags should match decoding logic in src/bio/bio_config.c.
 
 const (
-	hotplugPeriod = 5 * time.Second
+	defaultHotplugPeriod = 5 * time.Second
 )
 
 // SpdkSubsystemConfigParams is an interface that defines an object that
@@ -312,6 +312,11 @@ func newSpdkConfig(log logging.Logger, req *storage.BdevWriteConfigRequest) (*Sp
 	}
 
 	if req.HotplugEnabled {
+		period := req.HotplugPeriod
+		if period == 0 {
+			period = defaultHotplugPeriod
+		}
+
 		var found bool
 		for _, ss := range sc.Subsystems {
 			if ss.Name != "bdev" {
@@ -321,7 +326,7 @@ func newSpdkConfig(log logging.Logger, req *storage.BdevWriteConfigRequest) (*Sp
 				if bsc.Method == storage.ConfBdevNvmeSetHotplug {
 					bsc.Params = NvmeSetHotplugParams{
 						Enable:     true,
-						PeriodUsec: uint64(hotplugPeriod.Microseconds()),
+						PeriodUsec: uint64(period.Microseconds()),
 					}
 					found = true
 					break
@@ -56,6 +56,10 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 	hotplugConfs[2].Params = NvmeSetHotplugParams{
 		Enable: true, PeriodUsec: uint64((5 * time.Second).Microseconds()),
 	}
+	hotplugPeriodConfs := multiCtrlrConfs()
+	hotplugPeriodConfs[2].Params = NvmeSetHotplugParams{
+		Enable: true, PeriodUsec: uint64((500 * time.Millisecond).Microseconds()),
+	}
 
 	tests := map[string]struct {
 		class              storage.Class
@@ -64,6 +68,7 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 		enableVmd          bool
 		vosEnv             string
 		enableHotplug      bool
+		hotplugPeriod      time.Duration
 		busidRange         string
 		accelEngine        string
 		accelOptMask       storage.AccelOptionBits
@@ -117,6 +122,28 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 				},
 			},
 		},
+		"multiple controllers; hotplug enabled; custom period": {
+			class:         storage.ClassNvme,
+			devList:       []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			enableHotplug: true,
+			hotplugPeriod: 500 * time.Millisecond,
+			busidRange:    "0x8a-0x8f",
+			expBdevCfgs:   hotplugPeriodConfs,
+			expDaosCfgs: []*DaosConfig{
+				{
+					Method: storage.ConfSetHotplugBusidRange,
+					Params: HotplugBusidRangeParams{
+						Begin: 138, End: 143,
+					},
+				},
+			},
+		},
+		"multiple controllers; hotplug disabled; period ignored": {
+			class:         storage.ClassNvme,
+			devList:       []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			hotplugPeriod: 500 * time.Millisecond,
+			expBdevCfgs:   multiCtrlrConfs(),
+		},
 		"AIO file class; multiple files; zero file size": {
 			class:          storage.ClassFile,
 			devList:        []string{"/path/to/myfile", "/path/to/myotherfile"},
@@ -229,6 +256,7 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 					cfg,
 				).
 				WithStorageEnableHotplug(tc.enableHotplug).
+				WithStorageHotplugPeriod(tc.hotplugPeriod).
 				WithPinnedNumaNode(0).
 				WithStorageAccelProps(tc.accelEngine, tc.accelOptMask).
 				WithStorageSpdkRpcSrvProps(tc.rpcSrvEnable, tc.rpcSrvSockAddr)
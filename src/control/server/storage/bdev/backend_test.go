@@ -11,9 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
-	"syscall"
 	"testing"
-	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -704,6 +702,7 @@ func TestBackend_Update(t *testing.T) {
 
 	for name, tc := range map[string]struct {
 		pciAddr string
+		action  storage.NVMeFirmwareAction
 		mec     spdk.MockEnvCfg
 		mnc     spdk.MockNvmeCfg
 		expErr  error
@@ -722,165 +721,44 @@ func TestBackend_Update(t *testing.T) {
 			pciAddr: controllers[0].PciAddr,
 			expErr:  nil,
 		},
-	} {
-		t.Run(name, func(t *testing.T) {
-			log, buf := logging.NewTestLogger(name)
-			defer test.ShowBufferOnFailure(t, buf)
-
-			b := backendWithMockBinding(log, tc.mec, tc.mnc)
-
-			gotErr := b.UpdateFirmware(tc.pciAddr, "/some/path", 0)
-			test.CmpErr(t, tc.expErr, gotErr)
-		})
-	}
-}
-
-type mockFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-	isDir   bool
-	stat    *syscall.Stat_t
-}
-
-func (mfi *mockFileInfo) Name() string       { return mfi.name }
-func (mfi *mockFileInfo) Size() int64        { return mfi.size }
-func (mfi *mockFileInfo) Mode() os.FileMode  { return mfi.mode }
-func (mfi *mockFileInfo) ModTime() time.Time { return mfi.modTime }
-func (mfi *mockFileInfo) IsDir() bool        { return mfi.isDir }
-func (mfi *mockFileInfo) Sys() interface{}   { return mfi.stat }
-
-func testFileInfo(t *testing.T, name string, uid uint32) os.FileInfo {
-	t.Helper()
-
-	return &mockFileInfo{
-		name: name,
-		stat: &syscall.Stat_t{
-			Uid: uid,
-		},
-	}
-}
-
-type testWalkInput struct {
-	path   string
-	info   os.FileInfo
-	err    error
-	expErr error
-}
-
-func TestBackend_hugePageWalkFn(t *testing.T) {
-	testDir := "/wherever"
-
-	for name, tc := range map[string]struct {
-		testInputs   []*testWalkInput
-		statExistMap map[string]bool
-		removeErr    error
-		expRemoved   []string
-		expCount     uint
-	}{
-		"ignore subdirectory": {
-			testInputs: []*testWalkInput{
-				{
-					path: filepath.Join(testDir, "prefix1_foo"),
-					info: &mockFileInfo{
-						name: "prefix1_foo",
-						stat: &syscall.Stat_t{
-							Uid: 42,
-						},
-						isDir: true,
-					},
-					expErr: errors.New("skip this directory"),
-				},
-			},
-		},
-		"input error propagated": {
-			testInputs: []*testWalkInput{
-				{
-					path:   filepath.Join(testDir, "prefix1_foo"),
-					info:   testFileInfo(t, "prefix1_foo", 42),
-					err:    errors.New("walk failed"),
-					expErr: errors.New("walk failed"),
-				},
+		"binding stage fail": {
+			pciAddr: controllers[0].PciAddr,
+			action:  storage.NVMeFirmwareStage,
+			mnc: spdk.MockNvmeCfg{
+				UpdateErr: errors.New("spdk says no"),
 			},
+			expErr: errors.New("spdk says no"),
 		},
-		"nil fileinfo": {
-			testInputs: []*testWalkInput{
-				{
-					path:   filepath.Join(testDir, "prefix1_foo"),
-					info:   nil,
-					expErr: errors.New("nil fileinfo"),
-				},
-			},
+		"binding stage success": {
+			pciAddr: controllers[0].PciAddr,
+			action:  storage.NVMeFirmwareStage,
 		},
-		"no matching filenames": {
-			testInputs: []*testWalkInput{
-				{
-					path: filepath.Join(testDir, "prefix1_foo"),
-					info: &mockFileInfo{
-						name: "prefix1_foo",
-					},
-				},
+		"binding commit fail": {
+			pciAddr: controllers[0].PciAddr,
+			action:  storage.NVMeFirmwareCommit,
+			mnc: spdk.MockNvmeCfg{
+				CommitErr: errors.New("commit says no"),
 			},
+			expErr: errors.New("commit says no"),
 		},
-		"matching filenames; one inactive pid": {
-			testInputs: []*testWalkInput{
-				{
-					path: filepath.Join(testDir, "spdk_pid69299map_990"),
-					info: testFileInfo(t, "spdk_pid69299map_990", 42),
-				},
-				{
-					path: filepath.Join(testDir, "spdk_pid69300map_98"),
-					info: testFileInfo(t, "spdk_pid69300map_98", 42),
-				},
-			},
-			statExistMap: map[string]bool{"/proc/69299": true},
-			expRemoved:   []string{filepath.Join(testDir, "spdk_pid69300map_98")},
-			expCount:     1,
+		"binding commit success": {
+			pciAddr: controllers[0].PciAddr,
+			action:  storage.NVMeFirmwareCommit,
 		},
-		"remove fails": {
-			testInputs: []*testWalkInput{
-				{
-					path:   filepath.Join(testDir, "spdk_pid69299map_990"),
-					info:   testFileInfo(t, "spdk_pid69299map_990", 42),
-					expErr: errors.New("could not remove"),
-				},
-			},
-			removeErr: errors.New("could not remove"),
+		"unknown action": {
+			pciAddr: controllers[0].PciAddr,
+			action:  42,
+			expErr:  errors.New("unknown firmware action"),
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(name)
 			defer test.ShowBufferOnFailure(t, buf)
 
-			removedFiles := make([]string, 0)
-			remove := func(path string) error {
-				if tc.removeErr == nil {
-					removedFiles = append(removedFiles, path)
-				}
-				return tc.removeErr
-			}
-			stat := func(path string) (os.FileInfo, error) {
-				if tc.statExistMap[path] {
-					return nil, nil
-				}
-				return nil, os.ErrNotExist
-			}
-
-			var count uint = 0
-			testFn := createHugePageWalkFunc(log, testDir, stat, remove, &count)
-			for _, ti := range tc.testInputs {
-				gotErr := testFn(ti.path, ti.info, ti.err)
-				test.CmpErr(t, ti.expErr, gotErr)
-			}
+			b := backendWithMockBinding(log, tc.mec, tc.mnc)
 
-			if tc.expRemoved == nil {
-				tc.expRemoved = []string{}
-			}
-			if diff := cmp.Diff(tc.expRemoved, removedFiles); diff != "" {
-				t.Fatalf("unexpected remove result (-want, +got):\n%s\n", diff)
-			}
-			test.AssertEqual(t, tc.expCount, count, "unexpected remove count")
+			gotErr := b.UpdateFirmware(tc.pciAddr, "/some/path", 0, tc.action)
+			test.CmpErr(t, tc.expErr, gotErr)
 		})
 	}
 }
@@ -941,6 +819,24 @@ func TestBackend_Prepare(t *testing.T) {
 				},
 			},
 		},
+		"prepare reset; release hugepages": {
+			reset: true,
+			req: storage.BdevPrepareRequest{
+				TargetUser:       username,
+				PCIAllowList:     mockAddrListStr(1, 2, 3),
+				ReleaseHugePages: true,
+			},
+			expScriptCalls: []scriptCall{
+				{
+					Env: []string{
+						fmt.Sprintf("PATH=%s", os.Getenv("PATH")),
+						fmt.Sprintf("%s=%s", pciAllowListEnv, mockAddrList(1, 2, 3)),
+						fmt.Sprintf("%s=yes", clearHugeEnv),
+					},
+					Args: []string{"reset"},
+				},
+			},
+		},
 		"prepare reset; vmd enabled": {
 			reset: true,
 			req: storage.BdevPrepareRequest{
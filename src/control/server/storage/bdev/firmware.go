@@ -17,6 +17,8 @@ const (
 	// defaultFirmwareSlot is the slot automatically chosen for the firmware
 	// update
 	defaultFirmwareSlot = 0
+	// maxFirmwareSlot is the highest firmware slot defined by the NVMe spec
+	maxFirmwareSlot = 7
 )
 
 // QueryFirmware requests the firmware information for the NVMe device controller.
@@ -107,8 +109,20 @@ func filterControllersByModelFirmware(controllers storage.NvmeControllers, model
 
 // UpdateFirmware updates the NVMe device controller firmware.
 func (p *Provider) UpdateFirmware(req storage.NVMeFirmwareUpdateRequest) (*storage.NVMeFirmwareUpdateResponse, error) {
-	if len(req.FirmwarePath) == 0 {
-		return nil, errors.New("missing path to firmware file")
+	switch req.Action {
+	case storage.NVMeFirmwareUpdate, storage.NVMeFirmwareStage:
+		if len(req.FirmwarePath) == 0 {
+			return nil, errors.New("missing path to firmware file")
+		}
+	case storage.NVMeFirmwareCommit:
+		if req.Slot == defaultFirmwareSlot {
+			return nil, errors.New("firmware slot required to commit firmware")
+		}
+	default:
+		return nil, errors.Errorf("unknown firmware action %d", req.Action)
+	}
+	if req.Slot < defaultFirmwareSlot || req.Slot > maxFirmwareSlot {
+		return nil, errors.Errorf("invalid firmware slot %d (max %d)", req.Slot, maxFirmwareSlot)
 	}
 
 	controllers, err := p.getRequestedControllers(req.DeviceAddrs, req.ModelID, req.FirmwareRev, false)
@@ -124,7 +138,7 @@ func (p *Provider) UpdateFirmware(req storage.NVMeFirmwareUpdateRequest) (*stora
 		Results: make([]storage.NVMeDeviceFirmwareUpdateResult, len(controllers)),
 	}
 	for i, con := range controllers {
-		err = p.backend.UpdateFirmware(con.PciAddr, req.FirmwarePath, defaultFirmwareSlot)
+		err = p.backend.UpdateFirmware(con.PciAddr, req.FirmwarePath, req.Slot, req.Action)
 		resp.Results[i].Device = *con
 		if err != nil {
 			resp.Results[i].Error = err.Error()
@@ -215,6 +215,41 @@ func TestProvider_UpdateFirmware(t *testing.T) {
 		"empty path": {
 			expErr: errors.New("missing path to firmware file"),
 		},
+		"stage with empty path": {
+			input:  storage.NVMeFirmwareUpdateRequest{Action: storage.NVMeFirmwareStage},
+			expErr: errors.New("missing path to firmware file"),
+		},
+		"commit without slot": {
+			input:  storage.NVMeFirmwareUpdateRequest{Action: storage.NVMeFirmwareCommit},
+			expErr: errors.New("firmware slot required"),
+		},
+		"slot out of range": {
+			input: storage.NVMeFirmwareUpdateRequest{
+				FirmwarePath: testPath,
+				Slot:         8,
+			},
+			expErr: errors.New("invalid firmware slot 8"),
+		},
+		"unknown action": {
+			input:  storage.NVMeFirmwareUpdateRequest{Action: 42},
+			expErr: errors.New("unknown firmware action"),
+		},
+		"commit success": {
+			input: storage.NVMeFirmwareUpdateRequest{
+				Action: storage.NVMeFirmwareCommit,
+				Slot:   2,
+			},
+			backendCfg: &MockBackendConfig{
+				ScanRes: &storage.BdevScanResponse{Controllers: defaultDevs[:1]},
+			},
+			expRes: &storage.NVMeFirmwareUpdateResponse{
+				Results: []storage.NVMeDeviceFirmwareUpdateResult{
+					{
+						Device: *defaultDevs[0],
+					},
+				},
+			},
+		},
 		"NVMe device scan failed": {
 			input:      storage.NVMeFirmwareUpdateRequest{FirmwarePath: testPath},
 			backendCfg: &MockBackendConfig{ScanErr: errors.New("mock scan")},
@@ -105,7 +105,7 @@ func (mb *MockBackend) Reset(req storage.BdevPrepareRequest) error {
 	return nil
 }
 
-func (mb *MockBackend) UpdateFirmware(_ string, _ string, _ int32) error {
+func (mb *MockBackend) UpdateFirmware(_ string, _ string, _ int32, _ storage.NVMeFirmwareAction) error {
 	return mb.cfg.UpdateErr
 }
 
@@ -22,7 +22,7 @@ type (
 		Reset(storage.BdevPrepareRequest) error
 		Scan(storage.BdevScanRequest) (*storage.BdevScanResponse, error)
 		Format(storage.BdevFormatRequest) (*storage.BdevFormatResponse, error)
-		UpdateFirmware(pciAddr string, path string, slot int32) error
+		UpdateFirmware(pciAddr string, path string, slot int32, action storage.NVMeFirmwareAction) error
 		WriteConfig(storage.BdevWriteConfigRequest) (*storage.BdevWriteConfigResponse, error)
 	}
 
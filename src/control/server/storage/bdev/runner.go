@@ -28,6 +28,7 @@ const (
 	pciAllowListEnv    = "_PCI_ALLOWED"
 	pciBlockListEnv    = "_PCI_BLOCKED"
 	driverOverrideEnv  = "_DRIVER_OVERRIDE"
+	clearHugeEnv       = "_CLEAR_HUGE"
 	vfioDisabledDriver = "uio_pci_generic"
 	noDriver           = "none"
 )
@@ -142,6 +143,7 @@ func (s *spdkSetupScript) Unbind(req *storage.BdevPrepareRequest) error {
 // active mountpoints) from SPDK compatible driver e.g. VFIO and bind back to the kernel bdev driver
 // to be used by the OS. Either all PCI devices will be unbound by default if allow list parameter
 // is not set, otherwise PCI devices can be specified by passing in a allow list of PCI addresses.
+// If requested, hugepage allocations on all NUMA nodes are also released.
 //
 // NOTE: will make the controller reappear in /dev.
 func (s *spdkSetupScript) Reset(req *storage.BdevPrepareRequest) error {
@@ -151,5 +153,9 @@ func (s *spdkSetupScript) Reset(req *storage.BdevPrepareRequest) error {
 		pciBlockListEnv: req.PCIBlockList,
 	}
 
+	if req.ReleaseHugePages {
+		s.env[clearHugeEnv] = "yes"
+	}
+
 	return errors.Wrap(s.run("reset"), "reset")
 }
@@ -12,12 +12,15 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/units"
 )
 
 const (
@@ -87,6 +90,42 @@ func NewTierConfig() *TierConfig {
 	return new(TierConfig)
 }
 
+// tierSizeKeys are the tier config parameters that specify a size in GiB.
+var tierSizeKeys = []string{"scm_size", "bdev_size"}
+
+// UnmarshalYAML allows sizes to be specified with units (e.g. "16GiB") in
+// addition to plain integer values, which are interpreted as GiB.
+func (tc *TierConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var raw map[string]interface{}
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+
+	for _, key := range tierSizeKeys {
+		str, isStr := raw[key].(string)
+		if !isStr {
+			continue
+		}
+
+		size, err := units.ParseBytes(str)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s", key)
+		}
+		if size%humanize.GiByte != 0 {
+			return errors.Errorf("invalid %s %q: must be a whole number of GiB", key, str)
+		}
+		raw[key] = size / humanize.GiByte
+	}
+
+	data, err := yaml.Marshal(raw)
+	if err != nil {
+		return err
+	}
+
+	type fromYAML TierConfig
+	return yaml.UnmarshalStrict(data, (*fromYAML)(tc))
+}
+
 func (tc *TierConfig) IsSCM() bool {
 	switch tc.Class {
 	case ClassDcpm, ClassRam:
@@ -761,6 +800,7 @@ type Config struct {
 	ConfigOutputPath string        `yaml:"-" cmdLongFlag:"--nvme" cmdShortFlag:"-n"`
 	VosEnv           string        `yaml:"-" cmdEnv:"VOS_BDEV_CLASS"`
 	EnableHotplug    bool          `yaml:"-"`
+	HotplugPeriod    time.Duration `yaml:"-"`
 	NumaNodeIndex    uint          `yaml:"-"`
 	AccelProps       AccelProps    `yaml:"acceleration,omitempty"`
 	SpdkRpcSrvProps  SpdkRpcServer `yaml:"spdk_rpc_server,omitempty"`
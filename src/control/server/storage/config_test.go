@@ -256,6 +256,85 @@ func TestStorage_BdevDeviceList_FromJSON(t *testing.T) {
 	}
 }
 
+func TestStorage_TierConfig_FromYAML(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input  string
+		expCfg *TierConfig
+		expErr error
+	}{
+		"plain integer sizes": {
+			input: `
+class: ram
+scm_mount: /mnt/daos
+scm_size: 16
+`,
+			expCfg: NewTierConfig().
+				WithStorageClass("ram").
+				WithScmMountPoint("/mnt/daos").
+				WithScmRamdiskSize(16),
+		},
+		"scm size with units": {
+			input: `
+class: ram
+scm_mount: /mnt/daos
+scm_size: 16GiB
+`,
+			expCfg: NewTierConfig().
+				WithStorageClass("ram").
+				WithScmMountPoint("/mnt/daos").
+				WithScmRamdiskSize(16),
+		},
+		"bdev size with units": {
+			input: `
+class: file
+bdev_list: [/tmp/daos-bdev]
+bdev_size: 1TiB
+`,
+			expCfg: NewTierConfig().
+				WithStorageClass("file").
+				WithBdevDeviceList("/tmp/daos-bdev").
+				WithBdevFileSize(1024),
+		},
+		"size not a whole number of GiB": {
+			input: `
+class: ram
+scm_mount: /mnt/daos
+scm_size: 16GB
+`,
+			expErr: errors.New("whole number of GiB"),
+		},
+		"ambiguous size unit": {
+			input: `
+class: ram
+scm_mount: /mnt/daos
+scm_size: 16Gb
+`,
+			expErr: errors.New("ambiguous unit"),
+		},
+		"unknown key": {
+			input: `
+class: ram
+scm_mount: /mnt/daos
+scm_sz: 16
+`,
+			expErr: errors.New("not found"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := NewTierConfig()
+			err := yaml.Unmarshal([]byte(tc.input), cfg)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expCfg, cfg, defConfigCmpOpts()...); diff != "" {
+				t.Fatalf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestStorage_parsePCIBusRange(t *testing.T) {
 	for name, tc := range map[string]struct {
 		rangeStr string
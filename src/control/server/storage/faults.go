@@ -59,6 +59,42 @@ func FaultScmInvalidPMem(msg string) *fault.Fault {
 		recreateRegionsStr)
 }
 
+// FaultScmNotMounted creates a fault for the case where nothing is mounted at
+// an engine's SCM mountpoint.
+func FaultScmNotMounted(mntPoint string) *fault.Fault {
+	return storageFault(
+		code.ScmMountInvalid,
+		fmt.Sprintf("SCM mountpoint %s is not mounted", mntPoint),
+		fmt.Sprintf("check that %s is not a plain directory and has not been unmounted, then restart daos_server so that it can mount the SCM storage (format with dmg storage format if the storage is new)", mntPoint))
+}
+
+// FaultScmMountNotDax creates a fault for the case where the filesystem at a
+// PMem engine's SCM mountpoint is not mounted with DAX enabled.
+func FaultScmMountNotDax(mntPoint, fsType string) *fault.Fault {
+	return storageFault(
+		code.ScmMountInvalid,
+		fmt.Sprintf("SCM mountpoint %s has a non-DAX %s filesystem mounted but the engine is configured for PMem (class: dcpm)", mntPoint, fsType),
+		fmt.Sprintf("unmount %s and restart daos_server so that the PMem namespace is mounted with the dax option, or set the engine's scm_class to ram to use a ramdisk", mntPoint))
+}
+
+// FaultScmMountNotTmpfs creates a fault for the case where the filesystem at a
+// ramdisk engine's SCM mountpoint is not tmpfs.
+func FaultScmMountNotTmpfs(mntPoint, fsType string) *fault.Fault {
+	return storageFault(
+		code.ScmMountInvalid,
+		fmt.Sprintf("SCM mountpoint %s has a %s filesystem mounted but the engine is configured for a tmpfs ramdisk (class: ram)", mntPoint, fsType),
+		fmt.Sprintf("unmount %s and restart daos_server so that the ramdisk is mounted, or update the engine's scm_class in the server config file to match the mounted storage", mntPoint))
+}
+
+// FaultScmMountWrongDevice creates a fault for the case where the device
+// mounted at a PMem engine's SCM mountpoint is not the configured device.
+func FaultScmMountWrongDevice(mntPoint, mounted, configured string) *fault.Fault {
+	return storageFault(
+		code.ScmMountInvalid,
+		fmt.Sprintf("SCM mountpoint %s has %s mounted but the engine is configured to use %s", mntPoint, mounted, configured),
+		fmt.Sprintf("unmount %s and restart daos_server so that %s is mounted, or update the engine's scm_list in the server config file", mntPoint, configured))
+}
+
 // FaultScmNoModules represents an error where no PMem modules exist.
 var FaultScmNoModules = storageFault(code.ScmNoModules,
 	"No PMem modules exist on storage server",
@@ -127,6 +163,16 @@ func FaultPathAccessDenied(path string) *fault.Fault {
 	)
 }
 
+// FaultDirLocked represents an error where a storage directory is locked by
+// another process.
+func FaultDirLocked(path string) *fault.Fault {
+	return storageFault(
+		code.StorageDirLocked,
+		fmt.Sprintf("storage directory %q is locked by another process", path),
+		"verify that only one daos_server process is managing this storage and try again",
+	)
+}
+
 func storageFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "storage",
@@ -0,0 +1,58 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// DirLock is an advisory lock held on a storage directory.
+type DirLock struct {
+	path string
+	dir  *os.File
+}
+
+// LockDir acquires an exclusive flock(2)-based lock on the directory at the
+// supplied path. The lock does not wait for other holders; if another process
+// holds the lock then a FaultDirLocked error is returned.
+//
+// NB: Locks are associated with the open directory rather than the process,
+// so callers must also serialize access within a process.
+func LockDir(path string) (*DirLock, error) {
+	dir, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open %s for locking", path)
+	}
+
+	if err := unix.Flock(int(dir.Fd()), unix.LOCK_EX|unix.LOCK_NB); err != nil {
+		dir.Close()
+		if err == unix.EWOULDBLOCK {
+			return nil, FaultDirLocked(path)
+		}
+		return nil, errors.Wrapf(err, "failed to lock %s", path)
+	}
+
+	return &DirLock{path: path, dir: dir}, nil
+}
+
+// Unlock releases the lock.
+func (dl *DirLock) Unlock() error {
+	if dl == nil || dl.dir == nil {
+		return nil
+	}
+
+	err := unix.Flock(int(dl.dir.Fd()), unix.LOCK_UN)
+	if cerr := dl.dir.Close(); err == nil {
+		err = cerr
+	}
+	dl.dir = nil
+
+	return errors.Wrapf(err, "failed to unlock %s", dl.path)
+}
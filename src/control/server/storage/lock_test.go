@@ -0,0 +1,51 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestStorage_LockDir(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	if _, err := LockDir(filepath.Join(testDir, "missing")); err == nil {
+		t.Fatal("expected error locking missing directory")
+	}
+
+	dl, err := LockDir(testDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A second lock on the same directory should fail without waiting,
+	// regardless of whether it is held by this process or another.
+	_, err = LockDir(testDir)
+	test.CmpErr(t, FaultDirLocked(testDir), err)
+
+	if err := dl.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+	// Unlocking twice is harmless.
+	if err := dl.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+
+	dl, err = LockDir(testDir)
+	if err != nil {
+		t.Fatal(errors.Wrap(err, "relock after unlock"))
+	}
+	if err := dl.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+}
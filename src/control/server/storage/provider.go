@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/dustin/go-humanize"
@@ -24,6 +25,7 @@ import (
 // SystemProvider provides operating system capabilities.
 type SystemProvider interface {
 	system.IsMountedProvider
+	system.MountInfoProvider
 	GetfsUsage(string) (uint64, uint64, error)
 }
 
@@ -105,6 +107,58 @@ func (p *Provider) ScmIsMounted() (bool, error) {
 	return p.Sys.IsMounted(cfg.Scm.MountPoint)
 }
 
+// sameDevicePath returns true if the supplied paths refer to the same device,
+// resolving any symbolic links (e.g. /dev/disk/by-id/...) if necessary.
+func sameDevicePath(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ra, errA := filepath.EvalSymlinks(a)
+	rb, errB := filepath.EvalSymlinks(b)
+	return errA == nil && errB == nil && ra == rb
+}
+
+// CheckScmMount verifies that the filesystem mounted at the SCM mountpoint is
+// suitable for the configured SCM class, i.e. a DAX-enabled ext4 filesystem on
+// the configured PMem device or a tmpfs ramdisk, and returns details of the
+// mount.
+func (p *Provider) CheckScmMount() (*system.MountInfo, error) {
+	cfg, err := p.GetScmConfig()
+	if err != nil {
+		return nil, err
+	}
+	mntPoint := cfg.Scm.MountPoint
+
+	mi, err := p.Sys.GetMountInfo(mntPoint)
+	if err != nil {
+		if errors.Is(err, system.ErrNotMounted) {
+			return nil, FaultScmNotMounted(mntPoint)
+		}
+		return nil, errors.Wrapf(err, "get %s mount info", mntPoint)
+	}
+
+	switch cfg.Class {
+	case ClassDcpm:
+		if mi.FsType != system.FsTypeExt4 || !(mi.HasOption("dax") || mi.HasOption("dax=always")) {
+			return nil, FaultScmMountNotDax(mntPoint, mi.FsType)
+		}
+		if len(cfg.Scm.DeviceList) != 1 {
+			return nil, ErrInvalidDcpmCount
+		}
+		if !sameDevicePath(mi.Source, cfg.Scm.DeviceList[0]) {
+			return nil, FaultScmMountWrongDevice(mntPoint, mi.Source, cfg.Scm.DeviceList[0])
+		}
+	case ClassRam:
+		if mi.FsType != system.FsTypeTmpfs {
+			return nil, FaultScmMountNotTmpfs(mntPoint, mi.FsType)
+		}
+	default:
+		return nil, errors.New(ScmMsgClassNotSupported)
+	}
+
+	return mi, nil
+}
+
 // MountScm mounts SCM based on provider config.
 func (p *Provider) MountScm() error {
 	cfg, err := p.GetScmConfig()
@@ -230,6 +284,11 @@ func (p *Provider) GetBdevConfigs() []*TierConfig {
 	return p.engineStorage.Tiers.BdevConfigs()
 }
 
+// GetSpdkRpcServer returns the SPDK JSON-RPC server settings for the engine.
+func (p *Provider) GetSpdkRpcServer() SpdkRpcServer {
+	return p.engineStorage.SpdkRpcSrvProps
+}
+
 // QueryScmFirmware queries PMem SSD firmware.
 func (p *Provider) QueryScmFirmware(req ScmFirmwareQueryRequest) (*ScmFirmwareQueryResponse, error) {
 	return p.scm.QueryFirmware(req)
@@ -391,6 +450,7 @@ func BdevWriteConfigRequestFromConfig(ctx context.Context, log logging.Logger, c
 		Hostname:         hn,
 		ConfigOutputPath: cfg.ConfigOutputPath,
 		HotplugEnabled:   cfg.EnableHotplug,
+		HotplugPeriod:    cfg.HotplugPeriod,
 		VMDEnabled:       vmdEnabled,
 		TierProps:        []BdevTierProperties{},
 		AccelProps:       cfg.AccelProps,
@@ -17,6 +17,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/provider/system"
 )
 
 // defBdevCmpOpts returns a default set of cmp option suitable for this package
@@ -334,3 +335,125 @@ func Test_BdevWriteRequestFromConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_CheckScmMount(t *testing.T) {
+	dcpmTier := NewTierConfig().WithStorageClass(ClassDcpm.String()).
+		WithScmMountPoint("/mnt/daos0").WithScmDeviceList("/dev/pmem0")
+	ramTier := NewTierConfig().WithStorageClass(ClassRam.String()).
+		WithScmMountPoint("/mnt/daos0").WithScmRamdiskSize(16)
+	daxMount := &system.MountInfo{
+		MajorMinor: "259:0",
+		MountPoint: "/mnt/daos0",
+		FsType:     system.FsTypeExt4,
+		Source:     "/dev/pmem0",
+		Options:    []string{"rw", "relatime", "rw", "dax", "nodelalloc"},
+	}
+	tmpfsMount := &system.MountInfo{
+		MajorMinor: "0:50",
+		MountPoint: "/mnt/daos0",
+		FsType:     system.FsTypeTmpfs,
+		Source:     "tmpfs",
+		Options:    []string{"rw", "relatime", "rw", "size=16777216k"},
+	}
+	mountInfo := func(mi *system.MountInfo, modify func(*system.MountInfo)) *system.MountInfo {
+		out := *mi
+		out.Options = append([]string{}, mi.Options...)
+		if modify != nil {
+			modify(&out)
+		}
+		return &out
+	}
+
+	for name, tc := range map[string]struct {
+		tier      *TierConfig
+		mountInfo *system.MountInfo
+		mountErr  error
+		expErr    error
+	}{
+		"no scm tier": {
+			expErr: ErrNoScmTiers,
+		},
+		"not mounted": {
+			tier:   dcpmTier,
+			expErr: FaultScmNotMounted("/mnt/daos0"),
+		},
+		"mount info fails": {
+			tier:     dcpmTier,
+			mountErr: errors.New("whoops"),
+			expErr:   errors.New("whoops"),
+		},
+		"dcpm with dax": {
+			tier:      dcpmTier,
+			mountInfo: daxMount,
+		},
+		"dcpm with dax=always": {
+			tier: dcpmTier,
+			mountInfo: mountInfo(daxMount, func(mi *system.MountInfo) {
+				mi.Options = []string{"rw", "dax=always"}
+			}),
+		},
+		"dcpm without dax": {
+			tier: dcpmTier,
+			mountInfo: mountInfo(daxMount, func(mi *system.MountInfo) {
+				mi.Options = []string{"rw", "relatime"}
+			}),
+			expErr: FaultScmMountNotDax("/mnt/daos0", system.FsTypeExt4),
+		},
+		"dcpm with dax=never": {
+			tier: dcpmTier,
+			mountInfo: mountInfo(daxMount, func(mi *system.MountInfo) {
+				mi.Options = []string{"rw", "dax=never"}
+			}),
+			expErr: FaultScmMountNotDax("/mnt/daos0", system.FsTypeExt4),
+		},
+		"dcpm with tmpfs mounted": {
+			tier:      dcpmTier,
+			mountInfo: tmpfsMount,
+			expErr:    FaultScmMountNotDax("/mnt/daos0", system.FsTypeTmpfs),
+		},
+		"dcpm with wrong device": {
+			tier: dcpmTier,
+			mountInfo: mountInfo(daxMount, func(mi *system.MountInfo) {
+				mi.Source = "/dev/pmem1"
+			}),
+			expErr: FaultScmMountWrongDevice("/mnt/daos0", "/dev/pmem1", "/dev/pmem0"),
+		},
+		"ram with tmpfs": {
+			tier:      ramTier,
+			mountInfo: tmpfsMount,
+		},
+		"ram with pmem mounted": {
+			tier:      ramTier,
+			mountInfo: daxMount,
+			expErr:    FaultScmMountNotTmpfs("/mnt/daos0", system.FsTypeExt4),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := new(Config)
+			if tc.tier != nil {
+				cfg.Tiers = TierConfigs{tc.tier}
+			}
+			msc := &system.MockSysConfig{
+				MountInfo:       make(map[string]*system.MountInfo),
+				GetMountInfoErr: tc.mountErr,
+			}
+			if tc.mountInfo != nil {
+				msc.MountInfo[tc.mountInfo.MountPoint] = tc.mountInfo
+			}
+			p := MockProvider(log, 0, cfg, system.NewMockSysProvider(log, msc), nil, nil)
+
+			gotInfo, gotErr := p.CheckScmMount()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.mountInfo, gotInfo); diff != "" {
+				t.Fatalf("unexpected mount info (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
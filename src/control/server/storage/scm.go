@@ -90,13 +90,15 @@ type (
 
 	// ScmMountPoint represents location PMem filesystem is mounted.
 	ScmMountPoint struct {
-		Class      Class         `json:"class"`
-		DeviceList []string      `json:"device_list"`
-		Info       string        `json:"info"`
-		Path       string        `json:"path"`
-		Rank       ranklist.Rank `json:"rank"`
-		TotalBytes uint64        `json:"total_bytes"`
-		AvailBytes uint64        `json:"avail_bytes"`
+		Class         Class         `json:"class"`
+		DeviceList    []string      `json:"device_list"`
+		Info          string        `json:"info"`
+		Path          string        `json:"path"`
+		Rank          ranklist.Rank `json:"rank"`
+		TotalBytes    uint64        `json:"total_bytes"`
+		AvailBytes    uint64        `json:"avail_bytes"`
+		ReservedBytes uint64        `json:"reserved_bytes"`
+		PoolShards    uint32        `json:"pool_shards"`
 	}
 
 	// ScmMountPoints is a type alias for []ScmMountPoint that implements fmt.Stringer.
@@ -211,6 +213,23 @@ func (sn ScmNamespace) Free() uint64 {
 	return sn.Mount.AvailBytes
 }
 
+// Reserved returns the available bytes on mounted PMem namespace that are
+// reserved for DAOS metadata.
+func (sn ScmNamespace) Reserved() uint64 {
+	if sn.Mount == nil {
+		return 0
+	}
+	return sn.Mount.ReservedBytes
+}
+
+// PoolShards returns the number of VOS pool shards on mounted PMem namespace.
+func (sn ScmNamespace) PoolShards() uint32 {
+	if sn.Mount == nil {
+		return 0
+	}
+	return sn.Mount.PoolShards
+}
+
 // Capacity reports total storage capacity (bytes) across all namespaces.
 func (sns ScmNamespaces) Capacity() (tb uint64) {
 	for _, sn := range sns {
@@ -235,6 +254,24 @@ func (sns ScmNamespaces) Free() (tb uint64) {
 	return
 }
 
+// Reserved returns the cumulative available bytes on all mounted PMem
+// namespaces that are reserved for DAOS metadata.
+func (sns ScmNamespaces) Reserved() (tb uint64) {
+	for _, sn := range sns {
+		tb += (*ScmNamespace)(sn).Reserved()
+	}
+	return
+}
+
+// PoolShards returns the cumulative number of VOS pool shards on all mounted
+// PMem namespaces.
+func (sns ScmNamespaces) PoolShards() (n uint32) {
+	for _, sn := range sns {
+		n += (*ScmNamespace)(sn).PoolShards()
+	}
+	return
+}
+
 // PercentUsage returns the percentage of used storage space.
 func (sns ScmNamespaces) PercentUsage() string {
 	return common.PercentageString(sns.Total()-sns.Free(), sns.Total())
@@ -365,6 +402,7 @@ type (
 	// a specific PMem module.
 	ScmFirmwareUpdateResult struct {
 		Module ScmModule
+		Info   *ScmFirmwareInfo // firmware status after the update
 		Error  string
 	}
 
@@ -120,7 +120,18 @@ func (p *Provider) UpdateFirmware(req storage.ScmFirmwareUpdateRequest) (*storag
 		resp.Results[i].Module = *mod
 		if err != nil {
 			resp.Results[i].Error = err.Error()
+			continue
 		}
+
+		// The new image is only staged by the update and is not activated
+		// until the next power cycle, report the staged version so that
+		// pending activations are visible.
+		info, err := p.backend.GetFirmwareStatus(mod.UID)
+		if err != nil {
+			p.log.Errorf("failed to get firmware status of %s after update: %s", mod.UID, err)
+			continue
+		}
+		resp.Results[i].Info = info
 	}
 
 	return resp, nil
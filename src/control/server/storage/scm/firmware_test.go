@@ -295,6 +295,49 @@ func TestProvider_UpdateFirmware(t *testing.T) {
 				},
 			},
 		},
+		"success with staged firmware": {
+			input: storage.ScmFirmwareUpdateRequest{
+				FirmwarePath: testPath,
+				DeviceUIDs:   []string{"Device1"},
+			},
+			backendCfg: &MockBackendConfig{
+				GetModulesRes: defaultModules,
+				GetFirmwareStatusRes: &storage.ScmFirmwareInfo{
+					ActiveVersion: "1.0.0.1",
+					StagedVersion: "1.0.0.2",
+					UpdateStatus:  storage.ScmUpdateStatusStaged,
+				},
+			},
+			expRes: &storage.ScmFirmwareUpdateResponse{
+				Results: []storage.ScmFirmwareUpdateResult{
+					{
+						Module: *defaultModules[0],
+						Info: &storage.ScmFirmwareInfo{
+							ActiveVersion: "1.0.0.1",
+							StagedVersion: "1.0.0.2",
+							UpdateStatus:  storage.ScmUpdateStatusStaged,
+						},
+					},
+				},
+			},
+		},
+		"status query failed after update": {
+			input: storage.ScmFirmwareUpdateRequest{
+				FirmwarePath: testPath,
+				DeviceUIDs:   []string{"Device1"},
+			},
+			backendCfg: &MockBackendConfig{
+				GetModulesRes:        defaultModules,
+				GetFirmwareStatusErr: testErr,
+			},
+			expRes: &storage.ScmFirmwareUpdateResponse{
+				Results: []storage.ScmFirmwareUpdateResult{
+					{
+						Module: *defaultModules[0],
+					},
+				},
+			},
+		},
 		"update failed": {
 			input: storage.ScmFirmwareUpdateRequest{
 				FirmwarePath: testPath,
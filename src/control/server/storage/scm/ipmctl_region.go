@@ -14,10 +14,10 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/ipmctl"
+	"github.com/daos-stack/daos/src/control/lib/units"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
@@ -103,7 +103,7 @@ func (rc *regionCapacity) UnmarshalXML(d *xml.Decoder, start xml.StartElement) e
 		return err
 	}
 
-	fc, err := humanize.ParseBytes(s)
+	fc, err := units.ParseBytes(s)
 	if err != nil {
 		return errors.Wrapf(err, "capacity %q could not be parsed", s)
 	}
@@ -399,7 +399,7 @@ func getPMemState(log logging.Logger, regions Regions) (*storage.ScmSocketState,
 			return resp, nil
 		case storage.ScmFreeCap:
 			log.Debugf("socket %d app-direct region has %s free", r.SocketID,
-				humanize.Bytes(uint64(r.FreeCapacity)))
+				units.FormatBytes(uint64(r.FreeCapacity)))
 			hasFreeCap = true
 		case storage.ScmNoFreeCap:
 			// Fall-through
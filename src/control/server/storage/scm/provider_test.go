@@ -870,3 +870,113 @@ func TestProvider_Format(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_Mount(t *testing.T) {
+	const target = "/mnt/daos"
+
+	for name, tc := range map[string]struct {
+		request      storage.ScmMountRequest
+		mountErr     error
+		expMountOpts string
+		expErr       error
+	}{
+		"unsupported class": {
+			request: storage.ScmMountRequest{
+				Class:  storage.ClassNvme,
+				Target: target,
+			},
+			expErr: errors.New(storage.ScmMsgClassNotSupported),
+		},
+		"dcpm": {
+			request: storage.ScmMountRequest{
+				Class:  storage.ClassDcpm,
+				Device: "/dev/pmem0",
+				Target: target,
+			},
+			expMountOpts: dcpmMountOpts,
+		},
+		"ramdisk: missing params": {
+			request: storage.ScmMountRequest{
+				Class:  storage.ClassRam,
+				Target: target,
+			},
+			expErr: FaultFormatMissingParam,
+		},
+		"ramdisk": {
+			request: storage.ScmMountRequest{
+				Class:  storage.ClassRam,
+				Target: target,
+				Ramdisk: &storage.RamdiskParams{
+					Size:     16,
+					NUMANode: 1,
+				},
+			},
+			expMountOpts: "mpol=prefer:1,size=16g,huge=always",
+		},
+		"ramdisk: no size; hugepages disabled": {
+			request: storage.ScmMountRequest{
+				Class:  storage.ClassRam,
+				Target: target,
+				Ramdisk: &storage.RamdiskParams{
+					DisableHugepages: true,
+				},
+			},
+			expMountOpts: "mpol=prefer:0",
+		},
+		"mount fails": {
+			request: storage.ScmMountRequest{
+				Class:  storage.ClassDcpm,
+				Device: "/dev/pmem0",
+				Target: target,
+			},
+			mountErr: errors.New("mount failed"),
+			expErr:   errors.New("mount failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var mmc *storage.MockMountProviderConfig
+			if tc.mountErr != nil {
+				mmc = &storage.MockMountProviderConfig{
+					MountErr: tc.mountErr,
+				}
+			}
+
+			p := DefaultMockProvider(log)
+			mmp := storage.NewMockMountProvider(mmc)
+			p.mounter = mmp
+
+			res, err := p.Mount(tc.request)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, target, res.Target, "unexpected mount target")
+			test.AssertTrue(t, res.Mounted, "expected target to be mounted")
+
+			gotOpts, _ := mmp.GetMountOpts(target)
+			if diff := cmp.Diff(tc.expMountOpts, gotOpts); diff != "" {
+				t.Fatalf("unexpected mount options (-want, +got):\n%s\n", diff)
+			}
+
+			mounted, err := p.IsMounted(target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertTrue(t, mounted, "expected IsMounted() to report target mounted")
+
+			if _, err := p.Unmount(storage.ScmMountRequest{Target: target}); err != nil {
+				t.Fatal(err)
+			}
+
+			mounted, err = p.IsMounted(target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertFalse(t, mounted, "expected IsMounted() to report target unmounted")
+		})
+	}
+}
@@ -0,0 +1,224 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// nvmeClassCode is the PCI class code of NVM Express mass storage
+	// controllers.
+	nvmeClassCode = "0x010802"
+	// sysfsSectorSize is the unit of block device sizes reported in sysfs.
+	sysfsSectorSize = 512
+)
+
+var sysfsNvmeNsRegex = regexp.MustCompile(`^nvme[0-9]+n([0-9]+)$`)
+
+// SysfsScanner enumerates storage devices using only the information
+// exported via sysfs. No privileges are required and devices are neither
+// bound to nor unbound from any driver, so a scan can be run on a node where
+// DAOS engines are already using the devices.
+type SysfsScanner struct {
+	log  logging.Logger
+	root string
+}
+
+// NewSysfsScanner returns an initialized SysfsScanner.
+func NewSysfsScanner(log logging.Logger) *SysfsScanner {
+	return &SysfsScanner{
+		log:  log,
+		root: "/sys",
+	}
+}
+
+func (s *SysfsScanner) sysPath(pathElem ...string) string {
+	return filepath.Join(append([]string{s.root}, pathElem...)...)
+}
+
+func readSysfsString(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func readSysfsUint(path string) (uint64, error) {
+	str, err := readSysfsString(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(str, 10, 64)
+}
+
+// readSysfsNumaNode returns the NUMA node of a device, or 0 if the platform
+// doesn't report one.
+func readSysfsNumaNode(devPath string) uint32 {
+	str, err := readSysfsString(filepath.Join(devPath, "numa_node"))
+	if err != nil {
+		return 0
+	}
+	node, err := strconv.Atoi(str)
+	if err != nil || node < 0 {
+		return 0
+	}
+	return uint32(node)
+}
+
+// sysfsDriver returns the name of the driver bound to a device, or an empty
+// string if no driver is bound.
+func sysfsDriver(devPath string) string {
+	drvPath, err := filepath.EvalSymlinks(filepath.Join(devPath, "driver"))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(drvPath)
+}
+
+// ScanNvme returns the NVMe controllers found on the PCI bus. Model, serial,
+// firmware and namespace details are only available for controllers bound
+// to the kernel nvme driver; the PCI addresses of controllers bound to other
+// drivers (e.g. vfio-pci when in use by SPDK) are returned in the second
+// value.
+func (s *SysfsScanner) ScanNvme() (NvmeControllers, []string, error) {
+	pciRoot := s.sysPath("bus", "pci", "devices")
+	entries, err := ioutil.ReadDir(pciRoot)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "reading PCI devices")
+	}
+
+	var ctrlrs NvmeControllers
+	var userspace []string
+	for _, entry := range entries {
+		devPath := filepath.Join(pciRoot, entry.Name())
+		class, err := readSysfsString(filepath.Join(devPath, "class"))
+		if err != nil || class != nvmeClassCode {
+			continue
+		}
+
+		ctrlr := &NvmeController{
+			PciAddr:  entry.Name(),
+			SocketID: int32(readSysfsNumaNode(devPath)),
+		}
+
+		drv := sysfsDriver(devPath)
+		if drv != "nvme" {
+			s.log.Debugf("NVMe controller %s bound to driver %q", ctrlr.PciAddr, drv)
+			userspace = append(userspace, ctrlr.PciAddr)
+		} else if err := s.addNvmeDetails(devPath, ctrlr); err != nil {
+			s.log.Debugf("unable to read details of NVMe controller %s: %s",
+				ctrlr.PciAddr, err)
+		}
+
+		ctrlrs = append(ctrlrs, ctrlr)
+	}
+
+	return ctrlrs, userspace, nil
+}
+
+func (s *SysfsScanner) addNvmeDetails(devPath string, ctrlr *NvmeController) error {
+	names, err := filepath.Glob(filepath.Join(devPath, "nvme", "nvme*"))
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return errors.New("no nvme character device")
+	}
+	ctrlrPath := names[0]
+
+	if ctrlr.Model, err = readSysfsString(filepath.Join(ctrlrPath, "model")); err != nil {
+		return err
+	}
+	if ctrlr.Serial, err = readSysfsString(filepath.Join(ctrlrPath, "serial")); err != nil {
+		return err
+	}
+	if ctrlr.FwRev, err = readSysfsString(filepath.Join(ctrlrPath, "firmware_rev")); err != nil {
+		return err
+	}
+
+	entries, err := ioutil.ReadDir(ctrlrPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		matches := sysfsNvmeNsRegex.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		id, err := strconv.ParseUint(matches[1], 10, 32)
+		if err != nil {
+			return err
+		}
+		sectors, err := readSysfsUint(filepath.Join(ctrlrPath, entry.Name(), "size"))
+		if err != nil {
+			return err
+		}
+		ctrlr.Namespaces = append(ctrlr.Namespaces, &NvmeNamespace{
+			ID:   uint32(id),
+			Size: sectors * sysfsSectorSize,
+		})
+	}
+	sort.Slice(ctrlr.Namespaces, func(i, j int) bool {
+		return ctrlr.Namespaces[i].ID < ctrlr.Namespaces[j].ID
+	})
+
+	return nil
+}
+
+// ScanScm returns the PMem namespaces in fsdax mode that have been created
+// on the libnvdimm bus.
+func (s *SysfsScanner) ScanScm() (ScmNamespaces, error) {
+	ndRoot := s.sysPath("bus", "nd", "devices")
+	names, err := filepath.Glob(filepath.Join(ndRoot, "namespace*.*"))
+	if err != nil {
+		return nil, err
+	}
+
+	var namespaces ScmNamespaces
+	for _, nsPath := range names {
+		mode, err := readSysfsString(filepath.Join(nsPath, "mode"))
+		if err != nil || mode != "fsdax" {
+			continue
+		}
+		size, err := readSysfsUint(filepath.Join(nsPath, "size"))
+		if err != nil || size == 0 {
+			continue
+		}
+
+		ns := &ScmNamespace{
+			Name:     filepath.Base(nsPath),
+			NumaNode: readSysfsNumaNode(nsPath),
+			Size:     size,
+		}
+		if uuid, err := readSysfsString(filepath.Join(nsPath, "uuid")); err == nil {
+			ns.UUID = uuid
+		}
+		blkDevs, err := ioutil.ReadDir(filepath.Join(nsPath, "block"))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if len(blkDevs) > 0 {
+			ns.BlockDevice = blkDevs[0].Name()
+		}
+
+		namespaces = append(namespaces, ns)
+	}
+
+	return namespaces, nil
+}
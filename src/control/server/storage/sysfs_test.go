@@ -0,0 +1,166 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// writeSysfsFiles creates the supplied files, relative to the root directory.
+func writeSysfsFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// bindSysfsDriver links a PCI device to the named driver.
+func bindSysfsDriver(t *testing.T, root, pciAddr, driver string) {
+	t.Helper()
+
+	drvPath := filepath.Join(root, "bus", "pci", "drivers", driver)
+	if err := os.MkdirAll(drvPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(root, "bus", "pci", "devices", pciAddr, "driver")
+	if err := os.Symlink(drvPath, link); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStorage_SysfsScanner_ScanNvme(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	root, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pci := "bus/pci/devices/"
+	writeSysfsFiles(t, root, map[string]string{
+		// kernel-bound controller with two namespaces
+		pci + "0000:81:00.0/class":                        nvmeClassCode,
+		pci + "0000:81:00.0/numa_node":                    "1",
+		pci + "0000:81:00.0/nvme/nvme1/model":             "INTEL SSDPF2KX038TZ   ",
+		pci + "0000:81:00.0/nvme/nvme1/serial":            "PHAC1234",
+		pci + "0000:81:00.0/nvme/nvme1/firmware_rev":      "JCV10100",
+		pci + "0000:81:00.0/nvme/nvme1/nvme1n2/size":      "2000",
+		pci + "0000:81:00.0/nvme/nvme1/nvme1n1/size":      "1000",
+		pci + "0000:81:00.0/nvme/nvme1/nvme1c1n1/size":    "1000",
+		pci + "0000:81:00.0/nvme/nvme1/power/autosuspend": "0",
+		// controller in use by SPDK
+		pci + "0000:01:00.0/class":     nvmeClassCode,
+		pci + "0000:01:00.0/numa_node": "-1",
+		// not an NVMe controller
+		pci + "0000:02:00.0/class": "0x020000",
+	})
+	bindSysfsDriver(t, root, "0000:81:00.0", "nvme")
+	bindSysfsDriver(t, root, "0000:01:00.0", "vfio-pci")
+
+	ss := NewSysfsScanner(log)
+	ss.root = root
+
+	ctrlrs, userspace, err := ss.ScanNvme()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expCtrlrs := NvmeControllers{
+		{
+			PciAddr: "0000:01:00.0",
+		},
+		{
+			PciAddr:  "0000:81:00.0",
+			Model:    "INTEL SSDPF2KX038TZ",
+			Serial:   "PHAC1234",
+			FwRev:    "JCV10100",
+			SocketID: 1,
+			Namespaces: []*NvmeNamespace{
+				{ID: 1, Size: 1000 * sysfsSectorSize},
+				{ID: 2, Size: 2000 * sysfsSectorSize},
+			},
+		},
+	}
+	if diff := cmp.Diff(expCtrlrs, ctrlrs); diff != "" {
+		t.Fatalf("unexpected controllers (-want, +got):\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{"0000:01:00.0"}, userspace); diff != "" {
+		t.Fatalf("unexpected userspace controllers (-want, +got):\n%s\n", diff)
+	}
+
+	ss.root = filepath.Join(root, "missing")
+	if _, _, err := ss.ScanNvme(); err == nil {
+		t.Fatal("expected error scanning missing sysfs")
+	}
+}
+
+func TestStorage_SysfsScanner_ScanScm(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	root, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	nd := "bus/nd/devices/"
+	writeSysfsFiles(t, root, map[string]string{
+		nd + "namespace0.0/mode":            "fsdax",
+		nd + "namespace0.0/size":            "3183575302144",
+		nd + "namespace0.0/uuid":            "842fc847-28e0-4bb6-8dfc-d24afdba1528",
+		nd + "namespace0.0/numa_node":       "0",
+		nd + "namespace0.0/block/pmem0/dev": "259:0",
+		nd + "namespace1.0/mode":            "fsdax",
+		nd + "namespace1.0/size":            "3183575302144",
+		nd + "namespace1.0/uuid":            "2a6fa6a1-fd6d-4a4b-9c3a-4c6c56b2bf2f",
+		nd + "namespace1.0/numa_node":       "1",
+		nd + "namespace1.0/block/pmem1/dev": "259:1",
+		nd + "namespace1.1/mode":            "raw",
+		nd + "namespace1.1/size":            "0",
+		nd + "namespace2.0/mode":            "devdax",
+		nd + "namespace2.0/size":            "1073741824",
+		nd + "region0/size":                 "3183575302144",
+	})
+
+	ss := NewSysfsScanner(log)
+	ss.root = root
+
+	namespaces, err := ss.ScanScm()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expNamespaces := ScmNamespaces{
+		{
+			UUID:        "842fc847-28e0-4bb6-8dfc-d24afdba1528",
+			BlockDevice: "pmem0",
+			Name:        "namespace0.0",
+			Size:        3183575302144,
+		},
+		{
+			UUID:        "2a6fa6a1-fd6d-4a4b-9c3a-4c6c56b2bf2f",
+			BlockDevice: "pmem1",
+			Name:        "namespace1.0",
+			NumaNode:    1,
+			Size:        3183575302144,
+		},
+	}
+	if diff := cmp.Diff(expNamespaces, namespaces); diff != "" {
+		t.Fatalf("unexpected namespaces (-want, +got):\n%s\n", diff)
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2018-2022 Intel Corporation.
+// (C) Copyright 2018-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -19,15 +19,116 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system/raft"
 )
 
-func regPromEngineSources(ctx context.Context, log logging.Logger, engines []Engine) error {
+type (
+	sysdbStatsFn func() (*raft.DatabaseStats, error)
+
+	// sysdbCollector exports statistics about the local replica of the
+	// system database.
+	sysdbCollector struct {
+		log   logging.Logger
+		stats sysdbStatsFn
+
+		isLeader          *prometheus.Desc
+		term              *prometheus.Desc
+		commitIndex       *prometheus.Desc
+		appliedIndex      *prometheus.Desc
+		appliedLag        *prometheus.Desc
+		lastSnapshotIndex *prometheus.Desc
+		snapshotSize      *prometheus.Desc
+		leaderChanges     *prometheus.Desc
+		commits           *prometheus.Desc
+		commitLatencyAvg  *prometheus.Desc
+		commitLatencyMax  *prometheus.Desc
+		lastContact       *prometheus.Desc
+	}
+)
+
+func newSysdbCollector(log logging.Logger, stats sysdbStatsFn) *sysdbCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("server", "sysdb", name), help, nil, nil)
+	}
+
+	return &sysdbCollector{
+		log:               log,
+		stats:             stats,
+		isLeader:          desc("is_leader", "1 if the local replica is the MS leader"),
+		term:              desc("term", "Current raft term"),
+		commitIndex:       desc("commit_index", "Index of the latest committed log entry"),
+		appliedIndex:      desc("applied_index", "Index of the latest log entry applied to the local database"),
+		appliedLag:        desc("applied_lag", "Number of committed log entries not yet applied to the local database"),
+		lastSnapshotIndex: desc("last_snapshot_index", "Index of the latest log entry included in a snapshot"),
+		snapshotSize:      desc("snapshot_size_bytes", "Size of the latest snapshot"),
+		leaderChanges:     desc("leader_changes_total", "Number of MS leadership changes observed"),
+		commits:           desc("commits_total", "Number of updates committed via the local replica"),
+		commitLatencyAvg:  desc("commit_latency_avg_seconds", "Mean time taken to commit an update"),
+		commitLatencyMax:  desc("commit_latency_max_seconds", "Maximum time taken to commit an update"),
+		lastContact:       desc("last_contact_seconds", "Time since the local replica was last contacted by the leader"),
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *sysdbCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		c.isLeader, c.term, c.commitIndex, c.appliedIndex, c.appliedLag,
+		c.lastSnapshotIndex, c.snapshotSize, c.leaderChanges, c.commits,
+		c.commitLatencyAvg, c.commitLatencyMax, c.lastContact,
+	} {
+		ch <- d
+	}
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *sysdbCollector) Collect(ch chan<- prometheus.Metric) {
+	stats, err := c.stats()
+	if err != nil {
+		c.log.Debugf("failed to collect system database stats: %s", err)
+		return
+	}
+
+	gauge := func(d *prometheus.Desc, val float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.GaugeValue, val)
+	}
+	counter := func(d *prometheus.Desc, val float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.CounterValue, val)
+	}
+
+	var isLeader float64
+	if stats.State == "Leader" {
+		isLeader = 1
+	}
+	var lag uint64
+	if stats.CommitIndex > stats.AppliedIndex {
+		lag = stats.CommitIndex - stats.AppliedIndex
+	}
+
+	gauge(c.isLeader, isLeader)
+	gauge(c.term, float64(stats.Term))
+	gauge(c.commitIndex, float64(stats.CommitIndex))
+	gauge(c.appliedIndex, float64(stats.AppliedIndex))
+	gauge(c.appliedLag, float64(lag))
+	gauge(c.lastSnapshotIndex, float64(stats.LastSnapshotIndex))
+	gauge(c.snapshotSize, float64(stats.SnapshotSize))
+	counter(c.leaderChanges, float64(stats.LeaderChanges))
+	counter(c.commits, float64(stats.Commits))
+	gauge(c.commitLatencyAvg, stats.CommitLatencyAvg.Seconds())
+	gauge(c.commitLatencyMax, stats.CommitLatencyMax.Seconds())
+	if stats.LastContact >= 0 {
+		gauge(c.lastContact, stats.LastContact.Seconds())
+	}
+}
+
+func regPromEngineSources(ctx context.Context, log logging.Logger, engines []Engine, filter *promexp.ClassFilter) error {
 	numEngines := len(engines)
 	if numEngines == 0 {
 		return nil
 	}
 
-	c, err := promexp.NewCollector(log, &promexp.CollectorOpts{})
+	c, err := promexp.NewCollector(log, &promexp.CollectorOpts{
+		Filter: filter,
+	})
 	if err != nil {
 		return err
 	}
@@ -72,11 +173,15 @@ func regPromEngineSources(ctx context.Context, log logging.Logger, engines []Eng
 	return nil
 }
 
-func startPrometheusExporter(ctx context.Context, log logging.Logger, port int, engines []Engine) (func(), error) {
-	if err := regPromEngineSources(ctx, log, engines); err != nil {
+func startPrometheusExporter(ctx context.Context, log logging.Logger, port int, engines []Engine, sysdb *raft.Database, filter *promexp.ClassFilter) (func(), error) {
+	if err := regPromEngineSources(ctx, log, engines, filter); err != nil {
 		return nil, err
 	}
 
+	if sysdb != nil && sysdb.IsReplica() {
+		prometheus.MustRegister(newSysdbCollector(log, sysdb.Stats))
+	}
+
 	listenAddress := fmt.Sprintf("0.0.0.0:%d", port)
 
 	srv := http.Server{Addr: listenAddress}
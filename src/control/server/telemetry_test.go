@@ -0,0 +1,116 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+func TestServer_sysdbCollector(t *testing.T) {
+	for name, tc := range map[string]struct {
+		stats    *raft.DatabaseStats
+		statsErr error
+		expVals  map[string]float64
+	}{
+		"stats fail": {
+			statsErr: errors.New("not a replica"),
+			expVals:  map[string]float64{},
+		},
+		"leader": {
+			stats: &raft.DatabaseStats{
+				State:             "Leader",
+				Term:              3,
+				CommitIndex:       120,
+				AppliedIndex:      118,
+				LastSnapshotIndex: 100,
+				SnapshotSize:      4096,
+				LeaderChanges:     2,
+				Commits:           20,
+				CommitLatencyAvg:  1500 * time.Microsecond,
+				CommitLatencyMax:  12 * time.Millisecond,
+			},
+			expVals: map[string]float64{
+				"server_sysdb_is_leader":                  1,
+				"server_sysdb_term":                       3,
+				"server_sysdb_commit_index":               120,
+				"server_sysdb_applied_index":              118,
+				"server_sysdb_applied_lag":                2,
+				"server_sysdb_last_snapshot_index":        100,
+				"server_sysdb_snapshot_size_bytes":        4096,
+				"server_sysdb_leader_changes_total":       2,
+				"server_sysdb_commits_total":              20,
+				"server_sysdb_commit_latency_avg_seconds": 0.0015,
+				"server_sysdb_commit_latency_max_seconds": 0.012,
+				"server_sysdb_last_contact_seconds":       0,
+			},
+		},
+		"follower never contacted": {
+			stats: &raft.DatabaseStats{
+				State:       "Follower",
+				LastContact: -1,
+			},
+			expVals: map[string]float64{
+				"server_sysdb_is_leader":                  0,
+				"server_sysdb_term":                       0,
+				"server_sysdb_commit_index":               0,
+				"server_sysdb_applied_index":              0,
+				"server_sysdb_applied_lag":                0,
+				"server_sysdb_last_snapshot_index":        0,
+				"server_sysdb_snapshot_size_bytes":        0,
+				"server_sysdb_leader_changes_total":       0,
+				"server_sysdb_commits_total":              0,
+				"server_sysdb_commit_latency_avg_seconds": 0,
+				"server_sysdb_commit_latency_max_seconds": 0,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			c := newSysdbCollector(log, func() (*raft.DatabaseStats, error) {
+				return tc.stats, tc.statsErr
+			})
+
+			ch := make(chan prometheus.Metric, 32)
+			c.Collect(ch)
+			close(ch)
+
+			gotVals := make(map[string]float64)
+			for m := range ch {
+				pm := new(dto.Metric)
+				if err := m.Write(pm); err != nil {
+					t.Fatal(err)
+				}
+
+				// Desc strings are of the form: Desc{fqName: "<name>", ...}
+				name := strings.Split(m.Desc().String(), `"`)[1]
+				switch {
+				case pm.Gauge != nil:
+					gotVals[name] = pm.Gauge.GetValue()
+				case pm.Counter != nil:
+					gotVals[name] = pm.Counter.GetValue()
+				}
+			}
+
+			if diff := cmp.Diff(tc.expVals, gotVals); diff != "" {
+				t.Fatalf("unexpected metrics (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
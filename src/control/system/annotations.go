@@ -0,0 +1,105 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxAnnotationKeyLength is the maximum length of an annotation key.
+	MaxAnnotationKeyLength = 64
+	// MaxAnnotationValueLength is the maximum length of an annotation value.
+	MaxAnnotationValueLength = 256
+	// MaxAnnotations is the maximum number of annotations on a single
+	// member or pool.
+	MaxAnnotations = 32
+)
+
+// ValidateAnnotation returns an error if the supplied key and value are not a
+// valid annotation. Keys may only contain alphanumeric characters and the
+// characters '.', '_' and '-'. Values are free-form text but may not contain
+// control characters so that they can be displayed in tables. An empty value
+// is valid and indicates that the annotation is to be removed.
+func ValidateAnnotation(key, value string) error {
+	if key == "" {
+		return errors.New("annotation key must not be empty")
+	}
+	if len(key) > MaxAnnotationKeyLength {
+		return errors.Errorf("annotation key %q exceeds maximum length of %d", key, MaxAnnotationKeyLength)
+	}
+	for _, r := range key {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '.', r == '_', r == '-':
+		default:
+			return errors.Errorf("annotation key %q contains invalid character %q", key, r)
+		}
+	}
+
+	if len(value) > MaxAnnotationValueLength {
+		return errors.Errorf("annotation %q value exceeds maximum length of %d", key, MaxAnnotationValueLength)
+	}
+	for _, r := range value {
+		if unicode.IsControl(r) {
+			return errors.Errorf("annotation %q value contains invalid character %q", key, r)
+		}
+	}
+
+	return nil
+}
+
+// UpdateAnnotations returns a new set of annotations with the supplied updates
+// applied to the current set, which is not modified. Annotations with an empty
+// value in the updates are removed. A nil map is returned if no annotations
+// remain.
+func UpdateAnnotations(cur, updates map[string]string) (map[string]string, error) {
+	out := make(map[string]string, len(cur)+len(updates))
+	for k, v := range cur {
+		out[k] = v
+	}
+	for k, v := range updates {
+		if err := ValidateAnnotation(k, v); err != nil {
+			return nil, err
+		}
+		if v == "" {
+			delete(out, k)
+			continue
+		}
+		out[k] = v
+	}
+
+	if len(out) > MaxAnnotations {
+		return nil, errors.Errorf("number of annotations exceeds maximum of %d", MaxAnnotations)
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+
+	return out, nil
+}
+
+// FormatAnnotations returns a single-line representation of the supplied
+// annotations, sorted by key.
+func FormatAnnotations(notes map[string]string) string {
+	keys := make([]string, 0, len(notes))
+	for k := range notes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + notes[k]
+	}
+
+	return strings.Join(pairs, ", ")
+}
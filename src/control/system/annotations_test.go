@@ -0,0 +1,133 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSystem_ValidateAnnotation(t *testing.T) {
+	for name, tc := range map[string]struct {
+		key    string
+		value  string
+		expErr error
+	}{
+		"empty key": {
+			value:  "x",
+			expErr: errors.New("must not be empty"),
+		},
+		"overlength key": {
+			key:    strings.Repeat("k", MaxAnnotationKeyLength+1),
+			value:  "x",
+			expErr: errors.New("maximum length"),
+		},
+		"invalid key": {
+			key:    "hw note",
+			value:  "x",
+			expErr: errors.New("invalid character"),
+		},
+		"overlength value": {
+			key:    "hw",
+			value:  strings.Repeat("v", MaxAnnotationValueLength+1),
+			expErr: errors.New("maximum length"),
+		},
+		"control character in value": {
+			key:    "hw",
+			value:  "DIMM\nreplaced",
+			expErr: errors.New("invalid character"),
+		},
+		"empty value": {
+			key: "hw",
+		},
+		"valid": {
+			key:   "hw.dimm_2-a",
+			value: "DIMM replaced 2024-05-01 12:00, see ticket #42",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, ValidateAnnotation(tc.key, tc.value))
+		})
+	}
+}
+
+func TestSystem_UpdateAnnotations(t *testing.T) {
+	tooMany := make(map[string]string)
+	for i := 0; i < MaxAnnotations+1; i++ {
+		tooMany[fmt.Sprintf("k%d", i)] = "v"
+	}
+
+	for name, tc := range map[string]struct {
+		cur      map[string]string
+		updates  map[string]string
+		expNotes map[string]string
+		expErr   error
+	}{
+		"add to empty": {
+			updates:  map[string]string{"hw": "DIMM replaced"},
+			expNotes: map[string]string{"hw": "DIMM replaced"},
+		},
+		"add and replace": {
+			cur:      map[string]string{"hw": "old", "owner": "alice"},
+			updates:  map[string]string{"hw": "new", "ticket": "42"},
+			expNotes: map[string]string{"hw": "new", "owner": "alice", "ticket": "42"},
+		},
+		"remove": {
+			cur:      map[string]string{"hw": "old", "owner": "alice"},
+			updates:  map[string]string{"hw": ""},
+			expNotes: map[string]string{"owner": "alice"},
+		},
+		"remove last": {
+			cur:     map[string]string{"hw": "old"},
+			updates: map[string]string{"hw": "", "unknown": ""},
+		},
+		"invalid update": {
+			cur:     map[string]string{"hw": "old"},
+			updates: map[string]string{"bad key": "x"},
+			expErr:  errors.New("invalid character"),
+		},
+		"too many": {
+			updates: tooMany,
+			expErr:  errors.New("exceeds maximum"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var orig map[string]string
+			if tc.cur != nil {
+				orig = make(map[string]string)
+				for k, v := range tc.cur {
+					orig[k] = v
+				}
+			}
+
+			gotNotes, gotErr := UpdateAnnotations(tc.cur, tc.updates)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if diff := cmp.Diff(orig, tc.cur); diff != "" {
+				t.Fatalf("current annotations modified (-want, +got):\n%s\n", diff)
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expNotes, gotNotes); diff != "" {
+				t.Fatalf("unexpected annotations (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_FormatAnnotations(t *testing.T) {
+	test.AssertEqual(t, "", FormatAnnotations(nil), "")
+	test.AssertEqual(t, "hw=DIMM replaced, owner=alice",
+		FormatAnnotations(map[string]string{"owner": "alice", "hw": "DIMM replaced"}), "")
+}
@@ -0,0 +1,144 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package convert provides typed conversions between native control plane
+// types and their protobuf equivalents.
+package convert
+
+import (
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// RankResults is an alias for protobuf RankResult message slice representing
+// the results of an action on a number of system members.
+type RankResults []*sharedpb.RankResult
+
+// FromNative converts system package type to protobuf equivalent.
+func (pb *RankResults) FromNative(native system.MemberResults) error {
+	return convert.Types(native, pb)
+}
+
+// ToNative converts pointer receiver alias type to system package equivalent.
+func (pb *RankResults) ToNative() (system.MemberResults, error) {
+	native := make(system.MemberResults, 0, len(*pb))
+	return native, convert.Types(pb, &native)
+}
+
+// PoolQueryResp is an alias for protobuf PoolQueryResp message representing
+// the information returned by a pool query.
+type PoolQueryResp mgmtpb.PoolQueryResp
+
+// FromNative converts control package type to protobuf equivalent.
+func (pb *PoolQueryResp) FromNative(native *control.PoolQueryResp) error {
+	*pb = PoolQueryResp{
+		Status:           native.Status,
+		Uuid:             native.UUID,
+		TotalTargets:     native.TotalTargets,
+		ActiveTargets:    native.ActiveTargets,
+		DisabledTargets:  native.DisabledTargets,
+		Version:          native.Version,
+		Leader:           native.Leader,
+		EnabledRanks:     native.EnabledRanks.String(),
+		DisabledRanks:    native.DisabledRanks.String(),
+		TotalEngines:     native.TotalEngines,
+		PoolLayoutVer:    native.PoolLayoutVer,
+		UpgradeLayoutVer: native.UpgradeLayoutVer,
+		SvcReps:          ranklist.RanksToUint32(native.ServiceReplicas),
+	}
+
+	if native.Rebuild != nil {
+		pb.Rebuild = &mgmtpb.PoolRebuildStatus{
+			Status:       native.Rebuild.Status,
+			State:        mgmtpb.PoolRebuildStatus_State(native.Rebuild.State),
+			Objects:      native.Rebuild.Objects,
+			Records:      native.Rebuild.Records,
+			Version:      native.Rebuild.Version,
+			Seconds:      native.Rebuild.Seconds,
+			TotalObjects: native.Rebuild.TotalObjects,
+			Size:         native.Rebuild.Size,
+			FailRank:     native.Rebuild.FailRank,
+		}
+	}
+
+	for _, ts := range native.TierStats {
+		pb.TierStats = append(pb.TierStats, &mgmtpb.StorageUsageStats{
+			Total:     ts.Total,
+			Free:      ts.Free,
+			Min:       ts.Min,
+			Max:       ts.Max,
+			Mean:      ts.Mean,
+			MediaType: mgmtpb.StorageMediaType(ts.MediaType),
+		})
+	}
+
+	return nil
+}
+
+// ToNative converts pointer receiver alias type to control package equivalent.
+func (pb *PoolQueryResp) ToNative() (*control.PoolQueryResp, error) {
+	native := new(control.PoolQueryResp)
+	return native, convert.Types(pb, native)
+}
+
+// AsProto converts pointer receiver alias type to protobuf type.
+func (pb *PoolQueryResp) AsProto() *mgmtpb.PoolQueryResp {
+	return (*mgmtpb.PoolQueryResp)(pb)
+}
+
+// StorageScanResp is an alias for protobuf StorageScanResp message
+// representing the storage scanned on a host.
+type StorageScanResp ctlpb.StorageScanResp
+
+// FromNative converts control package type to protobuf equivalent.
+func (pb *StorageScanResp) FromNative(native *control.HostStorage) error {
+	*pb = StorageScanResp{
+		Nvme:    &ctlpb.ScanNvmeResp{State: new(ctlpb.ResponseState)},
+		Scm:     &ctlpb.ScanScmResp{State: new(ctlpb.ResponseState)},
+		MemInfo: new(ctlpb.MemInfo),
+	}
+
+	if err := convert.Types(native.NvmeDevices, &pb.Nvme.Ctrlrs); err != nil {
+		return err
+	}
+	if err := convert.Types(native.ScmModules, &pb.Scm.Modules); err != nil {
+		return err
+	}
+	if err := convert.Types(native.ScmNamespaces, &pb.Scm.Namespaces); err != nil {
+		return err
+	}
+	return convert.Types(native.MemInfo, pb.MemInfo)
+}
+
+// ToNative converts pointer receiver alias type to control package equivalent.
+func (pb *StorageScanResp) ToNative() (*control.HostStorage, error) {
+	native := new(control.HostStorage)
+
+	if err := convert.Types(pb.Nvme.GetCtrlrs(), &native.NvmeDevices); err != nil {
+		return nil, err
+	}
+	if err := convert.Types(pb.Scm.GetModules(), &native.ScmModules); err != nil {
+		return nil, err
+	}
+	if err := convert.Types(pb.Scm.GetNamespaces(), &native.ScmNamespaces); err != nil {
+		return nil, err
+	}
+	if err := convert.Types(pb.MemInfo, &native.MemInfo); err != nil {
+		return nil, err
+	}
+
+	return native, nil
+}
+
+// AsProto converts pointer receiver alias type to protobuf type.
+func (pb *StorageScanResp) AsProto() *ctlpb.StorageScanResp {
+	return (*ctlpb.StorageScanResp)(pb)
+}
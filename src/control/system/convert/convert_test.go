@@ -0,0 +1,326 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+var allMemberStates = []system.MemberState{
+	system.MemberStateUnknown,
+	system.MemberStateAwaitFormat,
+	system.MemberStateStarting,
+	system.MemberStateReady,
+	system.MemberStateJoined,
+	system.MemberStateStopping,
+	system.MemberStateStopped,
+	system.MemberStateExcluded,
+	system.MemberStateErrored,
+	system.MemberStateUnresponsive,
+	system.MemberStateAdminExcluded,
+}
+
+func TestConvert_RankResults_FromNative(t *testing.T) {
+	var natives system.MemberResults
+	for i, state := range allMemberStates {
+		var err error
+		if i%2 == 0 {
+			err = errors.Errorf("rank %d failed", i)
+		}
+		result := system.MockMemberResult(ranklist.Rank(i), "test", err, state)
+		result.Addr = test.MockHostAddr(int32(i)).String()
+		natives = append(natives, result)
+	}
+
+	var pbs RankResults
+	if err := pbs.FromNative(natives); err != nil {
+		t.Fatal(err)
+	}
+
+	expPB := &sharedpb.RankResult{
+		Rank:    2,
+		Action:  "test",
+		Errored: true,
+		Msg:     "rank 2 failed",
+		State:   "starting",
+		Addr:    test.MockHostAddr(2).String(),
+	}
+	if diff := cmp.Diff(expPB, pbs[2], test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+	}
+
+	convertedNatives, err := pbs.ToNative()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(natives, convertedNatives); diff != "" {
+		t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestConvert_RankResults_RoundTrip(t *testing.T) {
+	var pbs RankResults
+	for i, state := range allMemberStates {
+		pb := &sharedpb.RankResult{
+			Rank:   uint32(i),
+			Action: "test",
+			State:  strings.ToLower(state.String()),
+			Addr:   test.MockHostAddr(int32(i)).String(),
+		}
+		if i%2 == 0 {
+			pb.Errored = true
+			pb.Msg = "failed"
+		}
+		pbs = append(pbs, pb)
+	}
+
+	natives, err := pbs.ToNative()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, len(pbs), len(natives), "unexpected number of results")
+
+	var convertedPBs RankResults
+	if err := convertedPBs.FromNative(natives); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(pbs, convertedPBs, test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+	}
+}
+
+func rankSetCmpOpt() []cmp.Option {
+	return []cmp.Option{
+		cmp.Transformer("RankSet", func(in *ranklist.RankSet) *[]ranklist.Rank {
+			if in == nil {
+				return nil
+			}
+
+			ranks := in.Ranks()
+			return &ranks
+		}),
+	}
+}
+
+func TestConvert_PoolQueryResp_ToNative(t *testing.T) {
+	pbResp := &mgmtpb.PoolQueryResp{
+		Status:          42,
+		Uuid:            test.MockUUID(),
+		TotalTargets:    1,
+		ActiveTargets:   2,
+		DisabledTargets: 3,
+		Rebuild: &mgmtpb.PoolRebuildStatus{
+			Status:       4,
+			State:        mgmtpb.PoolRebuildStatus_BUSY,
+			Objects:      5,
+			Records:      6,
+			TotalObjects: 22,
+			Size:         23,
+			Version:      24,
+			Seconds:      25,
+			FailRank:     26,
+		},
+		TierStats: []*mgmtpb.StorageUsageStats{
+			{
+				Total:     7,
+				Free:      8,
+				Min:       9,
+				Max:       10,
+				Mean:      11,
+				MediaType: mgmtpb.StorageMediaType_SCM,
+			},
+			{
+				Total:     12,
+				Free:      13,
+				Min:       14,
+				Max:       15,
+				Mean:      16,
+				MediaType: mgmtpb.StorageMediaType_NVME,
+			},
+		},
+		Version:          17,
+		Leader:           18,
+		EnabledRanks:     "[0-3,5]",
+		DisabledRanks:    "[4]",
+		TotalEngines:     19,
+		PoolLayoutVer:    20,
+		UpgradeLayoutVer: 21,
+	}
+	expResp := &control.PoolQueryResp{
+		Status: 42,
+		UUID:   test.MockUUID(),
+		PoolInfo: control.PoolInfo{
+			TotalTargets:    1,
+			ActiveTargets:   2,
+			DisabledTargets: 3,
+			Rebuild: &control.PoolRebuildStatus{
+				Status:       4,
+				State:        control.PoolRebuildStateBusy,
+				Objects:      5,
+				Records:      6,
+				TotalObjects: 22,
+				Size:         23,
+				Version:      24,
+				Seconds:      25,
+				FailRank:     26,
+			},
+			TierStats: []*control.StorageUsageStats{
+				{
+					Total:     7,
+					Free:      8,
+					Min:       9,
+					Max:       10,
+					Mean:      11,
+					MediaType: control.StorageMediaTypeScm,
+				},
+				{
+					Total:     12,
+					Free:      13,
+					Min:       14,
+					Max:       15,
+					Mean:      16,
+					MediaType: control.StorageMediaTypeNvme,
+				},
+			},
+			Version:          17,
+			Leader:           18,
+			EnabledRanks:     ranklist.MustCreateRankSet("[0-3,5]"),
+			DisabledRanks:    ranklist.MustCreateRankSet("[4]"),
+			TotalEngines:     19,
+			PoolLayoutVer:    20,
+			UpgradeLayoutVer: 21,
+		},
+	}
+
+	gotResp, err := (*PoolQueryResp)(pbResp).ToNative()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expResp, gotResp, rankSetCmpOpt()...); diff != "" {
+		t.Fatalf("Unexpected response (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestConvert_PoolQueryResp_RoundTrip(t *testing.T) {
+	pbResp := &mgmtpb.PoolQueryResp{
+		Status:          42,
+		Uuid:            test.MockUUID(),
+		TotalTargets:    1,
+		ActiveTargets:   2,
+		DisabledTargets: 3,
+		Rebuild: &mgmtpb.PoolRebuildStatus{
+			Status:       4,
+			State:        mgmtpb.PoolRebuildStatus_DONE,
+			Objects:      5,
+			Records:      6,
+			Version:      7,
+			Seconds:      8,
+			TotalObjects: 9,
+			Size:         10,
+			FailRank:     11,
+		},
+		TierStats: []*mgmtpb.StorageUsageStats{
+			{
+				Total:     12,
+				Free:      13,
+				Min:       14,
+				Max:       15,
+				Mean:      16,
+				MediaType: mgmtpb.StorageMediaType_SCM,
+			},
+			{
+				Total:     17,
+				Free:      18,
+				Min:       19,
+				Max:       20,
+				Mean:      21,
+				MediaType: mgmtpb.StorageMediaType_NVME,
+			},
+		},
+		Version:          22,
+		Leader:           23,
+		EnabledRanks:     "0-3,5",
+		DisabledRanks:    "4",
+		TotalEngines:     24,
+		PoolLayoutVer:    25,
+		UpgradeLayoutVer: 26,
+		SvcReps:          []uint32{0, 2, 5},
+	}
+
+	native, err := (*PoolQueryResp)(pbResp).ToNative()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotResp := new(PoolQueryResp)
+	if err := gotResp.FromNative(native); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(pbResp, gotResp.AsProto(), test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("Unexpected response (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestConvert_StorageScanResp_RoundTrip(t *testing.T) {
+	pbResp := &ctlpb.StorageScanResp{
+		Nvme: &ctlpb.ScanNvmeResp{
+			Ctrlrs: []*ctlpb.NvmeController{
+				pbUtil.MockNvmeController(1),
+				pbUtil.MockNvmeController(2),
+			},
+			State: new(ctlpb.ResponseState),
+		},
+		Scm: &ctlpb.ScanScmResp{
+			Modules: []*ctlpb.ScmModule{
+				pbUtil.MockScmModule(1),
+				pbUtil.MockScmModule(2),
+			},
+			Namespaces: []*ctlpb.ScmNamespace{
+				pbUtil.MockScmNamespace(1),
+				pbUtil.MockScmNamespace(2),
+			},
+			State: new(ctlpb.ResponseState),
+		},
+		MemInfo: &ctlpb.MemInfo{
+			HugepageSizeKb: 2048,
+			MemTotal:       1 << 20,
+			MemFree:        1 << 19,
+			MemAvailable:   1 << 18,
+		},
+	}
+
+	native, err := (*StorageScanResp)(pbResp).ToNative()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotResp := new(StorageScanResp)
+	if err := gotResp.FromNative(native); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(pbResp, gotResp.AsProto(), test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+	}
+}
@@ -186,6 +186,35 @@ func IsJoinFailure(err error) bool {
 	return ok
 }
 
+// ErrDuplicateMember indicates the failure of a Join request that conflicts
+// with a running member on another host, e.g. because the joining host was
+// cloned from the image of an existing member. If FabricURI is set, the
+// joining member's fabric URI is in use by the existing member, otherwise its
+// UUID is.
+type ErrDuplicateMember struct {
+	Rank      ranklist.Rank
+	UUID      uuid.UUID
+	FabricURI string
+	CurAddr   *net.TCPAddr
+	NewAddr   *net.TCPAddr
+}
+
+func (err *ErrDuplicateMember) Error() string {
+	if err.FabricURI != "" {
+		return fmt.Sprintf("can't join member from %s: fabric URI %s is in use by rank %d on %s (duplicate network configuration?)",
+			err.NewAddr, err.FabricURI, err.Rank, err.CurAddr)
+	}
+	return fmt.Sprintf("can't join member from %s: uuid %s is in use by rank %d on %s (cloned storage?)",
+		err.NewAddr, err.UUID, err.Rank, err.CurAddr)
+}
+
+// IsDuplicateMember returns a boolean indicating whether or not the
+// supplied error is an instance of ErrDuplicateMember.
+func IsDuplicateMember(err error) bool {
+	_, ok := errors.Cause(err).(*ErrDuplicateMember)
+	return ok
+}
+
 // ErrMemberNotFound indicates a failure to find a member with the
 // given search criterion.
 type ErrMemberNotFound struct {
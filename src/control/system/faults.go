@@ -26,13 +26,33 @@ func FaultBadFaultDomainDepth(domain *FaultDomain, expDepth int) *fault.Fault {
 		"reconfigure the fault domain with a depth consistent with other system members, and restart the server")
 }
 
-// FaultPoolLocked generates a fault indicating that the pool is locked.
-func FaultPoolLocked(poolUUID, lockID uuid.UUID, lockTime time.Time) *fault.Fault {
+// FaultPoolLocked generates a fault indicating that the pool is briefly locked
+// by a management service housekeeping operation.
+func FaultPoolLocked(poolUUID, lockID uuid.UUID, op string, lockTime time.Time) *fault.Fault {
 	return systemFault(code.SystemPoolLocked,
-		fmt.Sprintf("pool %s is locked (id: %s, time: %s)", poolUUID, lockID, common.FormatTime(lockTime)),
+		fmt.Sprintf("pool %s is locked by %s (id: %s, time: %s)", poolUUID, op, lockID, common.FormatTime(lockTime)),
 		"retry the pool operation")
 }
 
+// FaultPoolOpInProgress generates a fault indicating that a conflicting
+// operation is already in progress on the pool.
+func FaultPoolOpInProgress(poolUUID, lockID uuid.UUID, op string, startTime time.Time) *fault.Fault {
+	if op == "" {
+		op = "pool"
+	}
+	return systemFault(code.SystemPoolOpInProgress,
+		fmt.Sprintf("pool %s: %s operation in progress (id: %s, started: %s)",
+			poolUUID, op, lockID, common.FormatTime(startTime)),
+		"wait for the operation in progress to complete and retry the pool operation")
+}
+
+// IsPoolLocked returns true if the supplied error indicates that the pool
+// could not be locked because another operation holds the lock.
+func IsPoolLocked(err error) bool {
+	return fault.IsFaultCode(err, code.SystemPoolLocked) ||
+		fault.IsFaultCode(err, code.SystemPoolOpInProgress)
+}
+
 func systemFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "system",
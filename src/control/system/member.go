@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2019-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -162,16 +162,20 @@ func (ms MemberState) isTransitionIllegal(to MemberState) bool {
 // Member refers to a data-plane instance that is a member of this DAOS
 // system running on host with the control-plane listening at "Addr".
 type Member struct {
-	Rank           ranklist.Rank `json:"rank"`
-	Incarnation    uint64        `json:"incarnation"`
-	UUID           uuid.UUID     `json:"uuid"`
-	Addr           *net.TCPAddr  `json:"addr"`
-	FabricURI      string        `json:"fabric_uri"`
-	FabricContexts uint32        `json:"fabric_contexts"`
-	State          MemberState   `json:"-"`
-	Info           string        `json:"info"`
-	FaultDomain    *FaultDomain  `json:"fault_domain"`
-	LastUpdate     time.Time     `json:"last_update"`
+	Rank                ranklist.Rank `json:"rank"`
+	Incarnation         uint64        `json:"incarnation"`
+	UUID                uuid.UUID     `json:"uuid"`
+	Addr                *net.TCPAddr  `json:"addr"`
+	Alias               string        `json:"alias,omitempty"`
+	FabricURI           string        `json:"fabric_uri"`
+	FabricContexts      uint32        `json:"fabric_contexts"`
+	SecondaryFabricURIs []string      `json:"secondary_fabric_uris,omitempty"`
+	State               MemberState   `json:"-"`
+	Info                string        `json:"info"`
+	FaultDomain         *FaultDomain  `json:"fault_domain"`
+	LastUpdate          time.Time     `json:"last_update"`
+	// Annotations holds notes recorded on the member by administrators.
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // MarshalJSON marshals system.Member to JSON.
@@ -235,6 +239,31 @@ func (sm *Member) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MaxMemberAliasLength is the maximum length of a member display alias.
+const MaxMemberAliasLength = 64
+
+// ValidateMemberAlias returns an error if the supplied string is not a valid
+// member display alias. Aliases may only contain alphanumeric characters and
+// the characters '.', '_' and '-' so that they can be displayed in tables.
+func ValidateMemberAlias(alias string) error {
+	if alias == "" {
+		return errors.New("alias must not be empty")
+	}
+	if len(alias) > MaxMemberAliasLength {
+		return errors.Errorf("alias %q exceeds maximum length of %d", alias, MaxMemberAliasLength)
+	}
+	for _, r := range alias {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '.', r == '_', r == '-':
+		default:
+			return errors.Errorf("alias %q contains invalid character %q", alias, r)
+		}
+	}
+
+	return nil
+}
+
 func (sm *Member) String() string {
 	return fmt.Sprintf("%s/%d/%s", sm.Addr, sm.Rank, sm.State)
 }
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -29,6 +29,7 @@ import (
 type TCPResolver func(string, string) (*net.TCPAddr, error)
 
 type MemberStore interface {
+	CheckLeader() error
 	MemberCount(...MemberState) (int, error)
 	MemberRanks(...MemberState) ([]Rank, error)
 	FindMemberByRank(rank Rank) (*Member, error)
@@ -95,13 +96,14 @@ func (m *Membership) Count() (int, error) {
 
 // JoinRequest contains information needed for join membership update.
 type JoinRequest struct {
-	Rank           Rank
-	UUID           uuid.UUID
-	ControlAddr    *net.TCPAddr
-	FabricURI      string
-	FabricContexts uint32
-	FaultDomain    *FaultDomain
-	Incarnation    uint64
+	Rank                Rank
+	UUID                uuid.UUID
+	ControlAddr         *net.TCPAddr
+	FabricURI           string
+	FabricContexts      uint32
+	SecondaryFabricURIs []string
+	FaultDomain         *FaultDomain
+	Incarnation         uint64
 }
 
 // JoinResponse contains information returned from join membership update.
@@ -112,12 +114,59 @@ type JoinResponse struct {
 	MapVersion uint32
 }
 
+// checkDuplicateMember returns an error if the joining member conflicts with a
+// running member on another host. A member may rejoin from a different address
+// once it is no longer running, e.g. after its host has been renumbered.
+func (m *Membership) checkDuplicateMember(req *JoinRequest, curMember *Member) error {
+	if curMember != nil {
+		if curMember.State&AvailableMemberFilter != 0 && !common.CmpTCPAddr(curMember.Addr, req.ControlAddr) {
+			return &ErrDuplicateMember{
+				Rank:    curMember.Rank,
+				UUID:    curMember.UUID,
+				CurAddr: curMember.Addr,
+				NewAddr: req.ControlAddr,
+			}
+		}
+		return nil
+	}
+
+	if req.FabricURI == "" {
+		return nil
+	}
+	members, err := m.db.AllMembers()
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		// A member with the same UUID is the joining member itself.
+		if member.State&AvailableMemberFilter == 0 || member.FabricURI != req.FabricURI ||
+			member.UUID == req.UUID {
+			continue
+		}
+		return &ErrDuplicateMember{
+			Rank:      member.Rank,
+			UUID:      member.UUID,
+			FabricURI: member.FabricURI,
+			CurAddr:   member.Addr,
+			NewAddr:   req.ControlAddr,
+		}
+	}
+
+	return nil
+}
+
 // Join creates or updates an entry in the membership for the given
 // JoinRequest.
 func (m *Membership) Join(req *JoinRequest) (resp *JoinResponse, err error) {
 	m.Lock()
 	defer m.Unlock()
 
+	// Only the leader has an up-to-date view of the membership against
+	// which to check the request for conflicts with existing members.
+	if err := m.db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
 	resp = new(JoinResponse)
 	var curMember *Member
 	if !req.Rank.Equals(NilRank) {
@@ -150,6 +199,9 @@ func (m *Membership) Join(req *JoinRequest) (resp *JoinResponse, err error) {
 		if curMember.UUID != req.UUID {
 			return nil, ErrUuidChanged(req.UUID, curMember.UUID, curMember.Rank)
 		}
+		if err := m.checkDuplicateMember(req, curMember); err != nil {
+			return nil, err
+		}
 
 		if !curMember.FaultDomain.Equals(req.FaultDomain) {
 			m.log.Infof("fault domain for rank %d changed from %q to %q",
@@ -164,6 +216,7 @@ func (m *Membership) Join(req *JoinRequest) (resp *JoinResponse, err error) {
 		curMember.Addr = req.ControlAddr
 		curMember.FabricURI = req.FabricURI
 		curMember.FabricContexts = req.FabricContexts
+		curMember.SecondaryFabricURIs = req.SecondaryFabricURIs
 		curMember.FaultDomain = req.FaultDomain
 		curMember.Incarnation = req.Incarnation
 		if err := m.db.UpdateMember(curMember); err != nil {
@@ -186,16 +239,20 @@ func (m *Membership) Join(req *JoinRequest) (resp *JoinResponse, err error) {
 	if err := m.checkReqFaultDomain(req); err != nil {
 		return nil, err
 	}
+	if err := m.checkDuplicateMember(req, nil); err != nil {
+		return nil, err
+	}
 
 	newMember := &Member{
-		Rank:           req.Rank,
-		Incarnation:    req.Incarnation,
-		UUID:           req.UUID,
-		Addr:           req.ControlAddr,
-		FabricURI:      req.FabricURI,
-		FabricContexts: req.FabricContexts,
-		FaultDomain:    req.FaultDomain,
-		State:          MemberStateJoined,
+		Rank:                req.Rank,
+		Incarnation:         req.Incarnation,
+		UUID:                req.UUID,
+		Addr:                req.ControlAddr,
+		FabricURI:           req.FabricURI,
+		FabricContexts:      req.FabricContexts,
+		SecondaryFabricURIs: req.SecondaryFabricURIs,
+		FaultDomain:         req.FaultDomain,
+		State:               MemberStateJoined,
 	}
 	if err := m.db.AddMember(newMember); err != nil {
 		return nil, errors.Wrap(err, "failed to add new member")
@@ -210,6 +267,68 @@ func (m *Membership) Join(req *JoinRequest) (resp *JoinResponse, err error) {
 	return resp, nil
 }
 
+// SetAlias sets the display alias of the member with the given rank. An empty
+// alias clears any alias previously set. Aliases must be unique within the
+// system.
+func (m *Membership) SetAlias(rank Rank, alias string) (*Member, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	member, err := m.db.FindMemberByRank(rank)
+	if err != nil {
+		return nil, err
+	}
+
+	if alias != "" {
+		if err := ValidateMemberAlias(alias); err != nil {
+			return nil, err
+		}
+
+		members, err := m.db.AllMembers()
+		if err != nil {
+			return nil, err
+		}
+		for _, other := range members {
+			if other.Alias == alias && !other.Rank.Equals(rank) {
+				return nil, errors.Errorf("alias %q already in use by rank %d", alias, other.Rank)
+			}
+		}
+	}
+
+	member.Alias = alias
+	if err := m.db.UpdateMember(member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// SetAnnotations applies the supplied annotation updates to the member with
+// the given rank. Annotations with an empty value are removed.
+func (m *Membership) SetAnnotations(rank Rank, updates map[string]string) (*Member, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	member, err := m.db.FindMemberByRank(rank)
+	if err != nil {
+		return nil, err
+	}
+
+	// The member's current annotations may be shared with the database,
+	// so a new map is always created.
+	notes, err := UpdateAnnotations(member.Annotations, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	member.Annotations = notes
+	if err := m.db.UpdateMember(member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
 func (m *Membership) checkReqFaultDomain(req *JoinRequest) error {
 	currentDepth := m.db.FaultDomainTree().Depth()
 	newDepth := req.FaultDomain.NumLevels()
@@ -560,11 +679,67 @@ func (m *Membership) handleEngineFailure(evt *events.RASEvent) {
 	}
 }
 
+// handleEngineUnresponsive marks a joined member as unresponsive in response
+// to an engine_unresponsive event raised when its heartbeats expire.
+func (m *Membership) handleEngineUnresponsive(evt *events.RASEvent) {
+	m.Lock()
+	defer m.Unlock()
+
+	member, err := m.db.FindMemberByRank(Rank(evt.Rank))
+	if err != nil {
+		m.log.Errorf("member with rank %d not found", evt.Rank)
+		return
+	}
+
+	// Only joined members are expected to be heartbeating, any other state
+	// has already been set by a more specific event or request.
+	if member.State != MemberStateJoined {
+		m.log.Debugf("skipping unresponsive event for rank %d in state %s", member.Rank, member.State)
+		return
+	}
+	if member.Incarnation > evt.Incarnation {
+		m.log.Debugf("ignoring unresponsive event for previous incarnation of %d (%x < %x)", member.Rank, evt.Incarnation, member.Incarnation)
+		return
+	}
+
+	m.log.Infof("marking rank %d as %s: %s", member.Rank, MemberStateUnresponsive, evt.Msg)
+	member.State = MemberStateUnresponsive
+	member.Info = evt.Msg
+
+	if err := m.db.UpdateMember(member); err != nil {
+		m.log.Errorf("updating member with rank %d: %s", member.Rank, err)
+	}
+}
+
+// MarkRankResponsive returns an unresponsive member to the joined state once
+// its harness reports it alive again. Members in any other state are left
+// unchanged and false is returned.
+func (m *Membership) MarkRankResponsive(rank Rank) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	member, err := m.db.FindMemberByRank(rank)
+	if err != nil {
+		return false, err
+	}
+
+	if member.State != MemberStateUnresponsive {
+		return false, nil
+	}
+
+	m.log.Infof("marking rank %d as %s in response to heartbeat", rank, MemberStateJoined)
+	member.State = MemberStateJoined
+	member.Info = ""
+	return true, m.db.UpdateMember(member)
+}
+
 // OnEvent handles events on channel and updates member states accordingly.
 func (m *Membership) OnEvent(_ context.Context, evt *events.RASEvent) {
 	switch evt.ID {
 	case events.RASEngineDied:
 		m.handleEngineFailure(evt)
+	case events.RASEngineUnresponsive:
+		m.handleEngineUnresponsive(evt)
 	}
 }
 
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,6 +41,11 @@ func mockEvtEngineDied(t *testing.T, r uint32) *events.RASEvent {
 	return events.NewEngineDiedEvent("foo", 0, r, common.NormalExit, 1234)
 }
 
+func mockEvtEngineUnresponsive(t *testing.T, r uint32) *events.RASEvent {
+	t.Helper()
+	return events.NewEngineUnresponsiveEvent("foo", 0, r, 0, 15*time.Second)
+}
+
 func populateMembership(t *testing.T, log logging.Logger, members ...*Member) *Membership {
 	t.Helper()
 
@@ -689,6 +695,12 @@ func TestSystem_Membership_Join(t *testing.T) {
 	newUUID := uuid.New()
 	newMember := MockMember(t, 2, MemberStateJoined).WithFaultDomain(fd2)
 	newMemberShallowFD := MockMember(t, 3, MemberStateJoined).WithFaultDomain(shallowFD)
+	secondaryURIs := []string{"ofi+tcp://172.16.0.1:31416"}
+	secondaryURIMember := MockMember(t, 0, MemberStateJoined).WithFaultDomain(fd1)
+	secondaryURIMember.SecondaryFabricURIs = secondaryURIs
+	stoppedMember := MockMember(t, 0, MemberStateStopped).WithFaultDomain(fd1)
+	movedMember := MockMember(t, 0, MemberStateJoined).WithFaultDomain(fd1)
+	movedMember.Addr = newMember.Addr
 
 	expMapVer := uint32(len(defaultCurMembers) + 1)
 
@@ -720,6 +732,20 @@ func TestSystem_Membership_Join(t *testing.T) {
 				MapVersion: expMapVer,
 			},
 		},
+		"successful rejoin with nil rank restores recorded rank": {
+			req: &JoinRequest{
+				Rank:        NilRank,
+				UUID:        curMember.UUID,
+				ControlAddr: curMember.Addr,
+				FabricURI:   curMember.Addr.String(),
+				FaultDomain: curMember.FaultDomain,
+			},
+			expResp: &JoinResponse{
+				Member:     curMember,
+				PrevState:  curMember.State,
+				MapVersion: expMapVer,
+			},
+		},
 		"successful rejoin with different fault domain": {
 			req: &JoinRequest{
 				Rank:        curMember.Rank,
@@ -734,6 +760,68 @@ func TestSystem_Membership_Join(t *testing.T) {
 				MapVersion: expMapVer,
 			},
 		},
+		"successful rejoin with secondary URIs": {
+			req: &JoinRequest{
+				Rank:                curMember.Rank,
+				UUID:                curMember.UUID,
+				ControlAddr:         curMember.Addr,
+				FabricURI:           curMember.Addr.String(),
+				SecondaryFabricURIs: secondaryURIs,
+				FaultDomain:         curMember.FaultDomain,
+			},
+			expResp: &JoinResponse{
+				Member:     secondaryURIMember,
+				PrevState:  curMember.State,
+				MapVersion: expMapVer,
+			},
+		},
+		"cloned host joins with running member's uuid": {
+			req: &JoinRequest{
+				Rank:        curMember.Rank,
+				UUID:        curMember.UUID,
+				ControlAddr: newMember.Addr,
+				FabricURI:   newMember.FabricURI,
+				FaultDomain: curMember.FaultDomain,
+			},
+			expErr: errors.New("is in use by rank 0 on " + curMember.Addr.String() + " (cloned storage?)"),
+		},
+		"cloned host joins with running member's uuid; nil rank": {
+			req: &JoinRequest{
+				Rank:        NilRank,
+				UUID:        curMember.UUID,
+				ControlAddr: newMember.Addr,
+				FabricURI:   newMember.FabricURI,
+				FaultDomain: curMember.FaultDomain,
+			},
+			expErr: errors.New("cloned storage"),
+		},
+		"new member with running member's fabric URI": {
+			req: &JoinRequest{
+				Rank:        NilRank,
+				UUID:        newMember.UUID,
+				ControlAddr: newMember.Addr,
+				FabricURI:   curMember.FabricURI,
+				FaultDomain: newMember.FaultDomain,
+			},
+			expErr: errors.New("fabric URI " + curMember.FabricURI + " is in use by rank 0"),
+		},
+		"stopped member rejoins from new address": {
+			curMembers: []*Member{
+				stoppedMember,
+			},
+			req: &JoinRequest{
+				Rank:        stoppedMember.Rank,
+				UUID:        stoppedMember.UUID,
+				ControlAddr: newMember.Addr,
+				FabricURI:   stoppedMember.FabricURI,
+				FaultDomain: stoppedMember.FaultDomain,
+			},
+			expResp: &JoinResponse{
+				Member:     movedMember,
+				PrevState:  MemberStateStopped,
+				MapVersion: 2,
+			},
+		},
 		"rejoin with existing UUID and unknown rank": {
 			req: &JoinRequest{
 				Rank:        Rank(42),
@@ -893,6 +981,22 @@ func TestSystem_Membership_OnEvent(t *testing.T) {
 				MockMember(t, 3, MemberStateExcluded),
 			},
 		},
+		"joined member marked unresponsive": {
+			members: members,
+			event:   mockEvtEngineUnresponsive(t, 1),
+			expMembers: Members{
+				MockMember(t, 0, MemberStateJoined),
+				MockMember(t, 1, MemberStateUnresponsive).WithInfo(
+					"DAOS engine 0 (rank 1) missed heartbeats for 15s"),
+				MockMember(t, 2, MemberStateStopped),
+				MockMember(t, 3, MemberStateExcluded),
+			},
+		},
+		"unresponsive event ignored for stopped member": {
+			members:    members,
+			event:      mockEvtEngineUnresponsive(t, 2),
+			expMembers: members,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -968,6 +1072,172 @@ func TestSystem_Membership_MarkDead(t *testing.T) {
 	}
 }
 
+func TestSystem_Membership_MarkRankResponsive(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rank       Rank
+		expChanged bool
+		expState   MemberState
+		expErr     error
+	}{
+		"unknown member": {
+			rank:   42,
+			expErr: ErrMemberRankNotFound(42),
+		},
+		"unresponsive member": {
+			rank:       0,
+			expChanged: true,
+			expState:   MemberStateJoined,
+		},
+		"stopped member": {
+			rank:     1,
+			expState: MemberStateStopped,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)
+
+			ms := populateMembership(t, log,
+				MockMember(t, 0, MemberStateUnresponsive, "missed heartbeats"),
+				MockMember(t, 1, MemberStateStopped),
+			)
+
+			changed, gotErr := ms.MarkRankResponsive(tc.rank)
+			CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			AssertEqual(t, tc.expChanged, changed, "unexpected changed result")
+			m, err := ms.Get(tc.rank)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, tc.expState, m.State, "unexpected member state")
+			if changed {
+				AssertEqual(t, "", m.Info, "expected info to be cleared")
+			}
+		})
+	}
+}
+
+func TestSystem_Membership_SetAlias(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rank     Rank
+		alias    string
+		expErr   error
+		expAlias string
+	}{
+		"unknown member": {
+			rank:   42,
+			alias:  "node42",
+			expErr: ErrMemberRankNotFound(42),
+		},
+		"invalid alias": {
+			rank:   0,
+			alias:  "rack3 node7",
+			expErr: errors.New("invalid character"),
+		},
+		"overlength alias": {
+			rank:   0,
+			alias:  strings.Repeat("x", MaxMemberAliasLength+1),
+			expErr: errors.New("maximum length"),
+		},
+		"alias in use": {
+			rank:   0,
+			alias:  "rack3-node7",
+			expErr: errors.New("already in use by rank 1"),
+		},
+		"set alias": {
+			rank:     0,
+			alias:    "rack3-node6",
+			expAlias: "rack3-node6",
+		},
+		"reset same alias": {
+			rank:     1,
+			alias:    "rack3-node7",
+			expAlias: "rack3-node7",
+		},
+		"clear alias": {
+			rank: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)
+
+			aliased := MockMember(t, 1, MemberStateJoined)
+			aliased.Alias = "rack3-node7"
+			ms := populateMembership(t, log, MockMember(t, 0, MemberStateJoined), aliased)
+
+			_, gotErr := ms.SetAlias(tc.rank, tc.alias)
+			CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			m, err := ms.Get(tc.rank)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, tc.expAlias, m.Alias, "unexpected alias")
+		})
+	}
+}
+
+func TestSystem_Membership_SetAnnotations(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rank     Rank
+		updates  map[string]string
+		expErr   error
+		expNotes map[string]string
+	}{
+		"unknown member": {
+			rank:    42,
+			updates: map[string]string{"hw": "DIMM replaced"},
+			expErr:  ErrMemberRankNotFound(42),
+		},
+		"invalid key": {
+			rank:    0,
+			updates: map[string]string{"hw note": "DIMM replaced"},
+			expErr:  errors.New("invalid character"),
+		},
+		"add annotation": {
+			rank:     0,
+			updates:  map[string]string{"hw": "DIMM replaced 2024-05-01"},
+			expNotes: map[string]string{"hw": "DIMM replaced 2024-05-01"},
+		},
+		"update existing annotations": {
+			rank:     1,
+			updates:  map[string]string{"hw": "", "owner": "bob"},
+			expNotes: map[string]string{"owner": "bob"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)
+
+			annotated := MockMember(t, 1, MemberStateJoined)
+			annotated.Annotations = map[string]string{"hw": "fan replaced", "owner": "alice"}
+			ms := populateMembership(t, log, MockMember(t, 0, MemberStateJoined), annotated)
+
+			_, gotErr := ms.SetAnnotations(tc.rank, tc.updates)
+			CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			m, err := ms.Get(tc.rank)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expNotes, m.Annotations); diff != "" {
+				t.Fatalf("unexpected annotations (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestSystem_Membership_CompressedFaultDomainTree(t *testing.T) {
 	testMemberWithFaultDomain := func(rank Rank, faultDomain *FaultDomain) *Member {
 		return &Member{
@@ -26,6 +26,28 @@ const (
 	PoolServiceStateDestroying
 )
 
+const (
+	// PoolServiceHealthHealthy indicates that all pool service replicas
+	// are available and none have been excluded from the pool map.
+	PoolServiceHealthHealthy = "healthy"
+	// PoolServiceHealthDegraded indicates that the pool service is
+	// reachable but one or more of its replicas is unavailable.
+	PoolServiceHealthDegraded = "degraded"
+	// PoolServiceHealthUnavailable indicates that the pool service
+	// could not be reached.
+	PoolServiceHealthUnavailable = "unavailable"
+
+	// PoolReplicaStateLeader indicates that the replica is the raft leader.
+	PoolReplicaStateLeader = "leader"
+	// PoolReplicaStateFollower indicates that the replica is a reachable follower.
+	PoolReplicaStateFollower = "follower"
+	// PoolReplicaStateExcluded indicates that the replica rank has been
+	// excluded from the pool map.
+	PoolReplicaStateExcluded = "excluded"
+	// PoolReplicaStateDown indicates that the replica rank is not available.
+	PoolReplicaStateDown = "down"
+)
+
 type (
 	// PoolServiceState is used to represent the state of the pool service
 	PoolServiceState uint
@@ -48,10 +70,24 @@ type (
 		State      PoolServiceState
 		Replicas   []Rank
 		Storage    *PoolServiceStorage
+		Admins     []string // principals delegated pool administration
 		LastUpdate time.Time
+		// Annotations holds notes recorded on the pool by administrators.
+		Annotations map[string]string
 	}
 )
 
+// IsAdmin indicates whether the principal has been delegated administration
+// of the pool.
+func (ps *PoolService) IsAdmin(principal string) bool {
+	for _, admin := range ps.Admins {
+		if admin == principal {
+			return true
+		}
+	}
+	return false
+}
+
 // NewPoolService returns a properly-initialized *PoolService.
 func NewPoolService(uuid uuid.UUID, tierStorage []uint64, ranks []Rank) *PoolService {
 	rs := RankSetFromRanks(ranks)
@@ -98,6 +134,22 @@ func (pss *PoolServiceStorage) CurrentRanks() []Rank {
 	return pss.currentRanks.Ranks()
 }
 
+// WithAddedRanks returns a copy of the pool storage details with the supplied
+// ranks added to the set of current ranks, e.g. after the pool has been
+// extended.
+func (pss *PoolServiceStorage) WithAddedRanks(ranks ...Rank) *PoolServiceStorage {
+	rs := RankSetFromRanks(append(pss.CurrentRanks(), ranks...))
+
+	pss.Lock()
+	defer pss.Unlock()
+
+	return &PoolServiceStorage{
+		CreationRankStr:    pss.CreationRankStr,
+		CurrentRankStr:     rs.RangedString(),
+		PerRankTierStorage: pss.PerRankTierStorage,
+	}
+}
+
 // TotalSCM returns the total amount of SCM storage allocated to
 // the pool, calculated from the current set of ranks multiplied
 // by the per-rank SCM allocation made at creation time.
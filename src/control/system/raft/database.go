@@ -41,6 +41,7 @@ type (
 	raftService interface {
 		Apply([]byte, time.Duration) raft.ApplyFuture
 		AddVoter(raft.ServerID, raft.ServerAddress, uint64, time.Duration) raft.IndexFuture
+		AddNonvoter(raft.ServerID, raft.ServerAddress, uint64, time.Duration) raft.IndexFuture
 		RemoveServer(raft.ServerID, uint64, time.Duration) raft.IndexFuture
 		BootstrapCluster(raft.Configuration) raft.Future
 		Leader() raft.ServerAddress
@@ -49,6 +50,7 @@ type (
 		Barrier(time.Duration) raft.Future
 		Shutdown() raft.Future
 		State() raft.RaftState
+		Stats() map[string]string
 	}
 
 	// syncRaft provides a wrapper for synchronized access to the
@@ -86,6 +88,9 @@ type (
 		replicaAddr        *net.TCPAddr
 		raftTransport      raft.Transport
 		raft               syncRaft
+		logStore           raft.LogStore
+		snapshotStore      raft.SnapshotStore
+		stats              dbStats
 		raftLeaderNotifyCh chan bool
 		onLeadershipGained []onLeadershipGainedFn
 		onLeadershipLost   []onLeadershipLostFn
@@ -118,6 +123,9 @@ type (
 	RankEntry struct {
 		URI         string
 		Incarnation uint64
+		// SecondaryURIs are additional URIs that may be given to
+		// clients in place of URI.
+		SecondaryURIs []string
 	}
 
 	// RaftComponents holds the components required to start a raft instance.
@@ -270,13 +278,7 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 // isReplica returns true if the supplied address matches
 // a known replica address.
 func (db *Database) isReplica(ctrlAddr *net.TCPAddr) bool {
-	for _, candidate := range db.cfg.Replicas {
-		if common.CmpTCPAddr(ctrlAddr, candidate) {
-			return true
-		}
-	}
-
-	return false
+	return containsAddr(db.replicas(), ctrlAddr)
 }
 
 // SystemName returns the system name set in the configuration.
@@ -286,18 +288,18 @@ func (db *Database) SystemName() string {
 
 // LeaderQuery returns the system leader, if known.
 func (db *Database) LeaderQuery() (leader string, replicas []string, err error) {
-	if !db.IsReplica() {
-		return "", nil, &system.ErrNotReplica{db.cfg.stringReplicas()}
+	if !db.IsReplica() || !db.isReplica(db.replicaAddr) {
+		return "", nil, &system.ErrNotReplica{db.stringReplicas()}
 	}
 
-	return db.leaderHint(), db.cfg.stringReplicas(), nil
+	return db.leaderHint(), db.stringReplicas(), nil
 }
 
 // ReplicaAddr returns the system's replica address if
 // the system is configured as a MS replica.
 func (db *Database) ReplicaAddr() (*net.TCPAddr, error) {
 	if !db.IsReplica() {
-		return nil, &system.ErrNotReplica{db.cfg.stringReplicas()}
+		return nil, &system.ErrNotReplica{db.stringReplicas()}
 	}
 	return db.replicaAddr, nil
 }
@@ -310,7 +312,7 @@ func (db *Database) PeerAddrs() ([]*net.TCPAddr, error) {
 	}
 
 	var peers []*net.TCPAddr
-	for _, rep := range db.cfg.Replicas {
+	for _, rep := range db.replicas() {
 		if !common.CmpTCPAddr(myAddr, rep) {
 			peers = append(peers, rep)
 		}
@@ -336,10 +338,11 @@ func (db *Database) IsBootstrap() bool {
 }
 
 // CheckReplica returns an error if the node is not configured as a
-// replica or the service is not running.
+// replica, has been removed from the set of replicas or the service is
+// not running.
 func (db *Database) CheckReplica() error {
-	if !db.IsReplica() {
-		return &system.ErrNotReplica{db.cfg.stringReplicas()}
+	if !db.IsReplica() || !db.isReplica(db.replicaAddr) {
+		return &system.ErrNotReplica{db.stringReplicas()}
 	}
 
 	if db.initialized.IsFalse() {
@@ -354,7 +357,7 @@ func (db *Database) CheckReplica() error {
 func errNotSysLeader(svc raftService, db *Database) error {
 	return &system.ErrNotLeader{
 		LeaderHint: string(svc.Leader()),
-		Replicas:   db.cfg.stringReplicas(db.replicaAddr),
+		Replicas:   db.stringReplicas(db.replicaAddr),
 	}
 }
 
@@ -555,6 +558,7 @@ func (db *Database) GroupMap() (*GroupMap, error) {
 	db.data.RLock()
 	defer db.data.RUnlock()
 
+	replicas := db.currentReplicas()
 	gm := newGroupMap(db.data.MapVersion)
 	for _, srv := range db.data.Members.Ranks {
 		// Only members that have been auto-excluded or administratively
@@ -570,8 +574,12 @@ func (db *Database) GroupMap() (*GroupMap, error) {
 			db.log.Errorf("member has invalid rank (%d) or URI (%s)", srv.Rank, srv.FabricURI)
 			continue
 		}
-		gm.RankEntries[srv.Rank] = RankEntry{URI: srv.FabricURI, Incarnation: srv.Incarnation}
-		if db.isReplica(srv.Addr) {
+		gm.RankEntries[srv.Rank] = RankEntry{
+			URI:           srv.FabricURI,
+			Incarnation:   srv.Incarnation,
+			SecondaryURIs: srv.SecondaryFabricURIs,
+		}
+		if containsAddr(replicas, srv.Addr) {
 			gm.MSRanks = append(gm.MSRanks, srv.Rank)
 		}
 	}
@@ -924,10 +932,22 @@ func (db *Database) FindPoolServiceByLabel(label string) (*system.PoolService, e
 	return nil, system.ErrPoolLabelNotFound(label)
 }
 
-// TakePoolLock attempts to take a lock on the pool with the given UUID,
-// if the supplied context does not already contain a valid lock for that
-// pool.
-func (db *Database) TakePoolLock(ctx context.Context, poolUUID uuid.UUID) (*PoolLock, error) {
+// TakePoolLock attempts to take a lock on the pool with the given UUID on
+// behalf of the named operation, if the supplied context does not already
+// contain a valid lock for that pool. Conflicting operations are rejected
+// with an error identifying the operation in progress.
+func (db *Database) TakePoolLock(ctx context.Context, poolUUID uuid.UUID, op string) (*PoolLock, error) {
+	return db.takePoolLock(ctx, poolUUID, op, false)
+}
+
+// TakeBackgroundPoolLock is like TakePoolLock, but is intended for short-lived
+// housekeeping operations performed by the MS itself. Conflicting operations
+// are reported with a retryable error.
+func (db *Database) TakeBackgroundPoolLock(ctx context.Context, poolUUID uuid.UUID, op string) (*PoolLock, error) {
+	return db.takePoolLock(ctx, poolUUID, op, true)
+}
+
+func (db *Database) takePoolLock(ctx context.Context, poolUUID uuid.UUID, op string, bg bool) (*PoolLock, error) {
 	if err := db.CheckLeader(); err != nil {
 		return nil, err
 	}
@@ -940,7 +960,7 @@ func (db *Database) TakePoolLock(ctx context.Context, poolUUID uuid.UUID) (*Pool
 			return nil, err
 		}
 		// No lock in context, so create a new one.
-		return db.poolLocks.take(poolUUID)
+		return db.poolLocks.take(poolUUID, op, bg)
 	}
 
 	// Lock already exists in context, so verify that it's valid and for the same pool.
@@ -1059,7 +1079,7 @@ func (db *Database) handlePoolRepsUpdate(evt *events.RASEvent) {
 
 	// Attempt to take the lock first, to cut down on log spam.
 	ctx := context.Background()
-	lock, err := db.TakePoolLock(ctx, poolUUID)
+	lock, err := db.TakeBackgroundPoolLock(ctx, poolUUID, "PoolSvcReplicasUpdate")
 	if err != nil {
 		db.log.Errorf("failed to take lock for pool svc update: %s", err)
 		return
@@ -0,0 +1,253 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// A database backup is a portable, point-in-time copy of the system
+// membership, pool services and system attributes. Unlike a raft snapshot,
+// it does not depend on the raft directory layout or log indexes of the
+// replica that produced it, so it can be restored into a newly-formatted
+// MS in order to recover from the loss of all replicas. The payload is
+// protected by a checksum that is verified before anything is applied.
+
+// BackupFormatVersion indicates the current database backup format.
+const BackupFormatVersion = 1
+
+type (
+	// DatabaseBackup contains a checksummed copy of the system database.
+	DatabaseBackup struct {
+		FormatVersion uint            `json:"format_version"`
+		SystemName    string          `json:"system_name"`
+		SchemaVersion uint            `json:"schema_version"`
+		Created       time.Time       `json:"created"`
+		Members       int             `json:"members"`
+		Pools         int             `json:"pools"`
+		Checksum      string          `json:"checksum"`
+		Data          json.RawMessage `json:"data"`
+	}
+
+	// backupData is the payload of a database backup.
+	backupData struct {
+		NextRank   ranklist.Rank         `json:"next_rank"`
+		MapVersion uint32                `json:"map_version"`
+		Members    []*system.Member      `json:"members"`
+		Pools      []*system.PoolService `json:"pools"`
+		Attributes map[string]string     `json:"attributes"`
+	}
+
+	// RestoreReport contains the results of a database restore.
+	RestoreReport struct {
+		Members    int    `json:"members"`
+		Pools      int    `json:"pools"`
+		MapVersion uint32 `json:"map_version"`
+	}
+)
+
+func backupChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Validate checks that the backup is in a supported format and that its
+// payload matches the recorded checksum.
+func (b *DatabaseBackup) Validate() error {
+	if b == nil {
+		return errors.Errorf("nil %T", b)
+	}
+	if b.FormatVersion != BackupFormatVersion {
+		return errors.Errorf("unsupported backup format version %d (want %d)",
+			b.FormatVersion, BackupFormatVersion)
+	}
+	if b.SchemaVersion != CurrentSchemaVersion {
+		return errors.Errorf("backup schema version %d != %d",
+			b.SchemaVersion, CurrentSchemaVersion)
+	}
+	if len(b.Data) == 0 {
+		return errors.New("backup contains no data")
+	}
+	if sum := backupChecksum(b.Data); sum != b.Checksum {
+		return errors.Errorf("backup checksum mismatch (recorded %s, computed %s)",
+			b.Checksum, sum)
+	}
+
+	return nil
+}
+
+func (b *DatabaseBackup) decode() (*backupData, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	bd := new(backupData)
+	if err := json.Unmarshal(b.Data, bd); err != nil {
+		return nil, errors.Wrap(err, "failed to decode backup data")
+	}
+	for _, m := range bd.Members {
+		if m == nil || m.Addr == nil {
+			return nil, errors.New("backup contains an invalid member")
+		}
+	}
+	for _, ps := range bd.Pools {
+		if ps == nil {
+			return nil, errors.New("backup contains an invalid pool service")
+		}
+	}
+
+	return bd, nil
+}
+
+// Backup creates a portable copy of the current system database.
+func (db *Database) Backup() (*DatabaseBackup, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	bd := &backupData{
+		NextRank:   db.data.NextRank,
+		MapVersion: db.data.MapVersion,
+		Members:    make([]*system.Member, 0, len(db.data.Members.Uuids)),
+		Pools:      make([]*system.PoolService, 0, len(db.data.Pools.Uuids)),
+		Attributes: make(map[string]string, len(db.data.System.Attributes)),
+	}
+	for _, m := range db.data.Members.Uuids {
+		bd.Members = append(bd.Members, copyMember(m))
+	}
+	for _, ps := range db.data.Pools.Uuids {
+		bd.Pools = append(bd.Pools, copyPoolService(ps))
+	}
+	for k, v := range db.data.System.Attributes {
+		bd.Attributes[k] = v
+	}
+	db.data.RUnlock()
+
+	sort.Slice(bd.Members, func(i, j int) bool { return bd.Members[i].Rank < bd.Members[j].Rank })
+	sort.Slice(bd.Pools, func(i, j int) bool {
+		return bd.Pools[i].PoolUUID.String() < bd.Pools[j].PoolUUID.String()
+	})
+
+	data, err := json.Marshal(bd)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode backup data")
+	}
+
+	return &DatabaseBackup{
+		FormatVersion: BackupFormatVersion,
+		SystemName:    db.SystemName(),
+		SchemaVersion: CurrentSchemaVersion,
+		Created:       time.Now(),
+		Members:       len(bd.Members),
+		Pools:         len(bd.Pools),
+		Checksum:      backupChecksum(data),
+		Data:          data,
+	}, nil
+}
+
+// Restore replaces the contents of the system database with the contents
+// of the supplied backup. Unless force is set, the restore is refused if
+// the database already contains any members or pools.
+func (db *Database) Restore(backup *DatabaseBackup, force bool) (*RestoreReport, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
+	bd, err := backup.decode()
+	if err != nil {
+		return nil, err
+	}
+	if backup.SystemName != db.SystemName() {
+		return nil, errors.Errorf("backup is for system %q, not %q",
+			backup.SystemName, db.SystemName())
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	db.data.RLock()
+	curMembers, curPools := len(db.data.Members.Uuids), len(db.data.Pools.Uuids)
+	db.data.RUnlock()
+	if !force && (curMembers > 0 || curPools > 0) {
+		return nil, errors.Errorf("system database is not empty (%d members, %d pools)",
+			curMembers, curPools)
+	}
+
+	data, err := createRaftUpdate(raftOpRestoreDatabase, bd)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.submitRaftUpdate(data); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+	return &RestoreReport{
+		Members:    len(db.data.Members.Uuids),
+		Pools:      len(db.data.Pools.Uuids),
+		MapVersion: db.data.MapVersion,
+	}, nil
+}
+
+// applyRestore is responsible for replacing the database contents with
+// the contents of a backup.
+func (d *dbData) applyRestore(data []byte, panicFn func(error)) {
+	bd := new(backupData)
+	if err := json.Unmarshal(data, bd); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode database restore"))
+		return
+	}
+
+	members := &MemberDatabase{
+		Ranks:        make(MemberRankMap),
+		Uuids:        make(MemberUuidMap),
+		Addrs:        make(MemberAddrMap),
+		FaultDomains: system.NewFaultDomainTree(),
+	}
+	for _, m := range bd.Members {
+		members.addMember(m)
+	}
+	pools := &PoolDatabase{
+		Ranks:  make(PoolRankMap),
+		Uuids:  make(PoolUuidMap),
+		Labels: make(PoolLabelMap),
+	}
+	for _, ps := range bd.Pools {
+		pools.addService(ps)
+	}
+	attrs := bd.Attributes
+	if attrs == nil {
+		attrs = make(map[string]string)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.Members = members
+	d.Pools = pools
+	d.System.Attributes = attrs
+	if bd.NextRank > d.NextRank {
+		d.NextRank = bd.NextRank
+	}
+	// The map version must never go backwards, otherwise engines would
+	// ignore the restored group map.
+	if bd.MapVersion > d.MapVersion {
+		d.MapVersion = bd.MapVersion
+	}
+	d.MapVersion++
+}
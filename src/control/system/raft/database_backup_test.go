@@ -0,0 +1,177 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	. "github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	. "github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystem_Database_BackupRestore(t *testing.T) {
+	members := []*Member{
+		MockMember(t, 0, MemberStateJoined),
+		MockMember(t, 1, MemberStateJoined),
+	}
+	pool := &PoolService{
+		PoolUUID:  uuid.New(),
+		PoolLabel: "pool1",
+		State:     PoolServiceStateReady,
+		Replicas:  []Rank{0, 1},
+	}
+
+	populate := func(t *testing.T, db *Database) {
+		t.Helper()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		for _, m := range members {
+			if err := db.AddMember(m); err != nil {
+				t.Fatal(err)
+			}
+		}
+		lock, err := db.TakePoolLock(ctx, pool.PoolUUID, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer lock.Release()
+		if err := db.AddPoolService(lock.InContext(ctx), pool); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SetSystemAttrs(map[string]string{"foo": "bar"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		modifyFn  func(b *DatabaseBackup)
+		nonEmpty  bool
+		force     bool
+		expReport *RestoreReport
+		expErr    error
+	}{
+		"bad checksum": {
+			modifyFn: func(b *DatabaseBackup) {
+				b.Data = append(b.Data[:len(b.Data)-1], ' ')
+			},
+			expErr: errors.New("checksum mismatch"),
+		},
+		"bad format version": {
+			modifyFn: func(b *DatabaseBackup) {
+				b.FormatVersion = BackupFormatVersion + 1
+			},
+			expErr: errors.New("unsupported backup format"),
+		},
+		"wrong system": {
+			modifyFn: func(b *DatabaseBackup) {
+				b.SystemName = "other"
+			},
+			expErr: errors.New("backup is for system \"other\""),
+		},
+		"not empty": {
+			nonEmpty: true,
+			expErr:   errors.New("not empty (2 members, 1 pools)"),
+		},
+		"not empty; forced": {
+			nonEmpty: true,
+			force:    true,
+			expReport: &RestoreReport{
+				Members: 2,
+				Pools:   1,
+			},
+		},
+		"success": {
+			expReport: &RestoreReport{
+				Members: 2,
+				Pools:   1,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			srcDB := MockDatabase(t, log)
+			populate(t, srcDB)
+
+			backup, err := srcDB.Backup()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := backup.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			if tc.modifyFn != nil {
+				tc.modifyFn(backup)
+			}
+
+			dstDB := MockDatabase(t, log)
+			if tc.nonEmpty {
+				populate(t, dstDB)
+			}
+			startMapVer := dstDB.data.MapVersion
+
+			report, err := dstDB.Restore(backup, tc.force)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if report.MapVersion <= startMapVer || report.MapVersion <= srcDB.data.MapVersion {
+				t.Fatalf("map version %d did not advance (src %d, dst %d)",
+					report.MapVersion, srcDB.data.MapVersion, startMapVer)
+			}
+			tc.expReport.MapVersion = report.MapVersion
+			if diff := cmp.Diff(tc.expReport, report); diff != "" {
+				t.Fatalf("unexpected report (-want, +got):\n%s\n", diff)
+			}
+
+			gotMembers, err := dstDB.AllMembers()
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmpOpts := []cmp.Option{
+				cmpopts.SortSlices(func(a, b *Member) bool { return a.Rank < b.Rank }),
+				cmpopts.IgnoreFields(Member{}, "LastUpdate"),
+				cmp.Comparer(func(a, b *net.TCPAddr) bool { return a.String() == b.String() }),
+			}
+			if diff := cmp.Diff(members, gotMembers, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected members (-want, +got):\n%s\n", diff)
+			}
+
+			gotPool, err := dstDB.FindPoolServiceByLabel(pool.PoolLabel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, pool.PoolUUID, gotPool.PoolUUID, "unexpected pool UUID")
+
+			gotAttrs, err := dstDB.GetSystemAttrs(nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, "bar", gotAttrs["foo"], "unexpected system attribute")
+
+			report2 := &VerifyReport{}
+			dstDB.data.verify(report2)
+			if len(report2.Errors) != 0 {
+				t.Fatalf("restored database is inconsistent: %v", report2.Errors)
+			}
+		})
+	}
+}
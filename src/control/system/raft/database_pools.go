@@ -137,6 +137,9 @@ func (pdb *PoolDatabase) updateService(cur, new *system.PoolService) {
 
 	// TODO: Update svc rank map
 	cur.Replicas = new.Replicas
+	cur.Storage = new.Storage
+	cur.Admins = new.Admins
+	cur.Annotations = new.Annotations
 
 	if cur.PoolLabel != "" {
 		delete(pdb.Labels, cur.PoolLabel)
@@ -0,0 +1,173 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"net"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+)
+
+// currentReplicas returns the addresses of the current MS replicas. Changes
+// made to the replica set at runtime take precedence over the configured
+// replicas. The caller must hold the data lock.
+func (db *Database) currentReplicas() []*net.TCPAddr {
+	if len(db.data.System.Replicas) == 0 {
+		return db.cfg.Replicas
+	}
+
+	replicas := make([]*net.TCPAddr, 0, len(db.data.System.Replicas))
+	for _, rs := range db.data.System.Replicas {
+		addr, err := net.ResolveTCPAddr("tcp", rs)
+		if err != nil {
+			db.log.Errorf("invalid replica address %q: %s", rs, err)
+			continue
+		}
+		replicas = append(replicas, addr)
+	}
+
+	return replicas
+}
+
+// replicas returns the addresses of the current MS replicas.
+func (db *Database) replicas() []*net.TCPAddr {
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	return db.currentReplicas()
+}
+
+func containsAddr(addrs []*net.TCPAddr, addr *net.TCPAddr) bool {
+	for _, a := range addrs {
+		if common.CmpTCPAddr(a, addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stringReplicas returns the addresses of the current MS replicas as strings,
+// omitting any excluded addresses.
+func (db *Database) stringReplicas(excludeAddrs ...*net.TCPAddr) (replicas []string) {
+	for _, r := range db.replicas() {
+		if containsAddr(excludeAddrs, r) {
+			continue
+		}
+		replicas = append(replicas, r.String())
+	}
+	return
+}
+
+// AddReplica adds the control plane server at the supplied address to the set
+// of MS replicas. The server must be configured to run a MS replica and must
+// be a member of the system. The new replica is added as a raft nonvoter, so
+// that it receives updates without affecting the quorum until it has caught
+// up with the leader and is promoted to a voter with PromoteReplica.
+func (db *Database) AddReplica(addr *net.TCPAddr) ([]string, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	if addr == nil {
+		return nil, errors.New("nil replica address")
+	}
+	if containsAddr(db.replicas(), addr) {
+		return nil, errors.Errorf("%s is already a MS replica", addr)
+	}
+	if _, err := db.FindMembersByAddr(addr); err != nil {
+		return nil, errors.Wrapf(err, "%s is not a system member", addr)
+	}
+
+	replicas := append(db.stringReplicas(), addr.String())
+
+	db.log.Debugf("adding %s as a new raft nonvoter", addr)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.AddNonvoter(raft.ServerID(addr.String()), raft.ServerAddress(addr.String()), 0, 0).Error()
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to add %q as raft replica", addr)
+	}
+
+	if err := db.submitReplicasUpdate(replicas); err != nil {
+		return nil, err
+	}
+	db.log.Noticef("added MS replica %s as a nonvoter", addr)
+
+	return replicas, nil
+}
+
+// PromoteReplica promotes the MS replica at the supplied address to a raft
+// voter. Promoting a replica that is already a voter has no effect.
+func (db *Database) PromoteReplica(addr *net.TCPAddr) ([]string, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	if addr == nil {
+		return nil, errors.New("nil replica address")
+	}
+	if !containsAddr(db.replicas(), addr) {
+		return nil, errors.Errorf("%s is not a MS replica", addr)
+	}
+
+	db.log.Debugf("promoting %s to a raft voter", addr)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.AddVoter(raft.ServerID(addr.String()), raft.ServerAddress(addr.String()), 0, 0).Error()
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to promote %q to raft voter", addr)
+	}
+	db.log.Noticef("promoted MS replica %s to a voter", addr)
+
+	return db.stringReplicas(), nil
+}
+
+// RemoveReplica removes the control plane server at the supplied address from
+// the set of MS replicas. If the server is the current leader, it steps down
+// once it has been removed and one of the remaining replicas takes over.
+func (db *Database) RemoveReplica(addr *net.TCPAddr) ([]string, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	if addr == nil {
+		return nil, errors.New("nil replica address")
+	}
+	if !containsAddr(db.replicas(), addr) {
+		return nil, errors.Errorf("%s is not a MS replica", addr)
+	}
+
+	replicas := db.stringReplicas(addr)
+	if len(replicas) == 0 {
+		return nil, errors.Errorf("%s is the only MS replica", addr)
+	}
+
+	// Update the replica set before removing the voter so that the
+	// update is also applied by the replica being removed, which will
+	// then direct requests to the remaining replicas.
+	if err := db.submitReplicasUpdate(replicas); err != nil {
+		return nil, err
+	}
+
+	db.log.Debugf("removing %s as a raft voter", addr)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.RemoveServer(raft.ServerID(addr.String()), 0, 0).Error()
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to remove %q as a raft replica", addr)
+	}
+	db.log.Noticef("removed MS replica %s", addr)
+
+	return replicas, nil
+}
@@ -0,0 +1,189 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	. "github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	. "github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystem_Database_ReplicaChanges(t *testing.T) {
+	type replicaOp struct {
+		remove  bool
+		promote bool
+		addr    *net.TCPAddr
+	}
+
+	for name, tc := range map[string]struct {
+		ops         []replicaOp
+		expErr      error
+		expReplicas []string
+		expMSRanks  []Rank
+		expNotRep   bool
+		expServers  map[raft.ServerID]raft.ServerSuffrage
+	}{
+		"add replica": {
+			ops: []replicaOp{
+				{addr: MockControlAddr(t, 2)},
+			},
+			expReplicas: []string{"127.0.0.1:10001", "127.0.0.2:10001"},
+			expMSRanks:  []Rank{1, 2},
+			expServers: map[raft.ServerID]raft.ServerSuffrage{
+				"127.0.0.2:10001": raft.Nonvoter,
+			},
+		},
+		"promote replica": {
+			ops: []replicaOp{
+				{addr: MockControlAddr(t, 2)},
+				{promote: true, addr: MockControlAddr(t, 2)},
+			},
+			expReplicas: []string{"127.0.0.1:10001", "127.0.0.2:10001"},
+			expMSRanks:  []Rank{1, 2},
+			expServers: map[raft.ServerID]raft.ServerSuffrage{
+				"127.0.0.2:10001": raft.Voter,
+			},
+		},
+		"promote non-replica": {
+			ops: []replicaOp{
+				{promote: true, addr: MockControlAddr(t, 2)},
+			},
+			expErr: errors.New("not a MS replica"),
+		},
+		"add existing replica": {
+			ops: []replicaOp{
+				{addr: MockControlAddr(t, 1)},
+			},
+			expErr: errors.New("already a MS replica"),
+		},
+		"add non-member": {
+			ops: []replicaOp{
+				{addr: MockControlAddr(t, 3)},
+			},
+			expErr: errors.New("not a system member"),
+		},
+		"remove non-replica": {
+			ops: []replicaOp{
+				{remove: true, addr: MockControlAddr(t, 2)},
+			},
+			expErr: errors.New("not a MS replica"),
+		},
+		"remove only replica": {
+			ops: []replicaOp{
+				{remove: true, addr: MockControlAddr(t, 1)},
+			},
+			expErr: errors.New("only MS replica"),
+		},
+		"replace other replica": {
+			ops: []replicaOp{
+				{addr: MockControlAddr(t, 2)},
+				{addr: MockControlAddr(t, 0)},
+				{remove: true, addr: MockControlAddr(t, 2)},
+			},
+			expReplicas: []string{"127.0.0.1:10001", "127.0.0.0:10001"},
+			expMSRanks:  []Rank{0, 1},
+			expServers: map[raft.ServerID]raft.ServerSuffrage{
+				"127.0.0.0:10001": raft.Nonvoter,
+			},
+		},
+		"replace local replica": {
+			ops: []replicaOp{
+				{addr: MockControlAddr(t, 2)},
+				{remove: true, addr: MockControlAddr(t, 1)},
+			},
+			expReplicas: []string{"127.0.0.2:10001"},
+			expNotRep:   true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for i := uint32(0); i < 3; i++ {
+				if err := db.AddMember(MockMember(t, i, MemberStateJoined)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var gotReplicas []string
+			var gotErr error
+			for _, op := range tc.ops {
+				switch {
+				case op.remove:
+					gotReplicas, gotErr = db.RemoveReplica(op.addr)
+				case op.promote:
+					gotReplicas, gotErr = db.PromoteReplica(op.addr)
+				default:
+					gotReplicas, gotErr = db.AddReplica(op.addr)
+				}
+				if gotErr != nil {
+					break
+				}
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReplicas, gotReplicas); diff != "" {
+				t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expServers != nil {
+				svc, unlock, err := db.raft.getSvc()
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotServers := svc.(*mockRaftService).servers
+				unlock()
+				for id, exp := range tc.expServers {
+					got, found := gotServers[id]
+					if !found {
+						t.Fatalf("%s not added to raft", id)
+					}
+					test.AssertEqual(t, exp, got, "unexpected suffrage for "+string(id))
+				}
+			}
+
+			_, lqReplicas, err := db.LeaderQuery()
+			if tc.expNotRep {
+				var notRep *ErrNotReplica
+				if !errors.As(err, &notRep) {
+					t.Fatalf("expected ErrNotReplica, got %v", err)
+				}
+				if diff := cmp.Diff(tc.expReplicas, notRep.Replicas); diff != "" {
+					t.Fatalf("unexpected replica hints (-want, +got):\n%s\n", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expReplicas, lqReplicas); diff != "" {
+				t.Fatalf("unexpected leader query replicas (-want, +got):\n%s\n", diff)
+			}
+
+			gm, err := db.GroupMap()
+			if err != nil {
+				t.Fatal(err)
+			}
+			sortedRanks := RankSetFromRanks(gm.MSRanks).Ranks()
+			if diff := cmp.Diff(tc.expMSRanks, sortedRanks); diff != "" {
+				t.Fatalf("unexpected MS ranks (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
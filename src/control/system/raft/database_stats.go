@@ -0,0 +1,150 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+type (
+	// DatabaseStats contains statistics about the local replica of the
+	// system database, for use in diagnosing MS performance problems.
+	DatabaseStats struct {
+		Replica           string        `json:"replica"`
+		State             string        `json:"state"`
+		Leader            string        `json:"leader"`
+		Term              uint64        `json:"term"`
+		LastLogIndex      uint64        `json:"last_log_index"`
+		CommitIndex       uint64        `json:"commit_index"`
+		AppliedIndex      uint64        `json:"applied_index"`
+		LastSnapshotIndex uint64        `json:"last_snapshot_index"`
+		SnapshotSize      uint64        `json:"snapshot_size"`
+		LeaderChanges     uint64        `json:"leader_changes"`
+		Commits           uint64        `json:"commits"`
+		CommitLatencyAvg  time.Duration `json:"commit_latency_avg"`
+		CommitLatencyMax  time.Duration `json:"commit_latency_max"`
+		LastContact       time.Duration `json:"last_contact"` // -1 if never contacted
+	}
+
+	// dbStats tracks statistics about updates submitted to the
+	// database on this replica.
+	dbStats struct {
+		sync.Mutex
+		leaderChanges uint64
+		commits       uint64
+		commitTotal   time.Duration
+		commitMax     time.Duration
+	}
+)
+
+func (s *dbStats) recordCommit(elapsed time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.commits++
+	s.commitTotal += elapsed
+	if elapsed > s.commitMax {
+		s.commitMax = elapsed
+	}
+}
+
+func (s *dbStats) recordLeaderChange() {
+	s.Lock()
+	defer s.Unlock()
+
+	s.leaderChanges++
+}
+
+// observeLeaderChanges counts the leadership changes seen by the raft
+// instance until it is shut down.
+func (db *Database) observeLeaderChanges(r *raft.Raft) {
+	obsCh := make(chan raft.Observation, 1)
+	obs := raft.NewObserver(obsCh, false, func(o *raft.Observation) bool {
+		lo, ok := o.Data.(raft.LeaderObservation)
+		return ok && lo.LeaderAddr != ""
+	})
+	r.RegisterObserver(obs)
+
+	go func() {
+		for range obsCh {
+			db.stats.recordLeaderChange()
+		}
+	}()
+
+	db.OnRaftShutdown(func() error {
+		r.DeregisterObserver(obs)
+		close(obsCh)
+		return nil
+	})
+}
+
+func parseStat(stats map[string]string, key string) uint64 {
+	val, err := strconv.ParseUint(stats[key], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return val
+}
+
+// Stats returns statistics about the local replica of the system database.
+func (db *Database) Stats() (*DatabaseStats, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+
+	out := &DatabaseStats{
+		Replica:     db.replicaAddr.String(),
+		LastContact: -1,
+	}
+
+	var rs map[string]string
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		out.Leader = string(svc.Leader())
+		rs = svc.Stats()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	out.State = rs["state"]
+	out.Term = parseStat(rs, "term")
+	out.LastLogIndex = parseStat(rs, "last_log_index")
+	out.CommitIndex = parseStat(rs, "commit_index")
+	out.AppliedIndex = parseStat(rs, "applied_index")
+	out.LastSnapshotIndex = parseStat(rs, "last_snapshot_index")
+	// The leader reports "0", and a replica that has never been
+	// contacted by a leader reports "never".
+	if lc, err := time.ParseDuration(rs["last_contact"]); err == nil {
+		out.LastContact = lc
+	}
+
+	if db.snapshotStore != nil {
+		snaps, err := db.snapshotStore.List()
+		if err != nil {
+			return nil, err
+		}
+		// Snapshots are listed newest first.
+		if len(snaps) > 0 {
+			out.SnapshotSize = uint64(snaps[0].Size)
+		}
+	}
+
+	db.stats.Lock()
+	defer db.stats.Unlock()
+	out.LeaderChanges = db.stats.leaderChanges
+	out.Commits = db.stats.commits
+	out.CommitLatencyMax = db.stats.commitMax
+	if db.stats.commits > 0 {
+		out.CommitLatencyAvg = db.stats.commitTotal / time.Duration(db.stats.commits)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,116 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/raft"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestRaft_Database_Stats(t *testing.T) {
+	replicaAddr := common.LocalhostCtrlAddr()
+
+	for name, tc := range map[string]struct {
+		notReplica bool
+		state      raft.RaftState
+		raftStats  map[string]string
+		commits    []time.Duration
+		expStats   *DatabaseStats
+		expErr     error
+	}{
+		"not a replica": {
+			notReplica: true,
+			expErr:     &system.ErrNotReplica{},
+		},
+		"leader": {
+			state: raft.Leader,
+			raftStats: map[string]string{
+				"state":               "Leader",
+				"term":                "3",
+				"last_log_index":      "42",
+				"commit_index":        "42",
+				"applied_index":       "41",
+				"last_snapshot_index": "32",
+				"last_contact":        "0",
+			},
+			commits: []time.Duration{time.Millisecond, 3 * time.Millisecond},
+			expStats: &DatabaseStats{
+				Replica:           replicaAddr.String(),
+				State:             "Leader",
+				Term:              3,
+				LastLogIndex:      42,
+				CommitIndex:       42,
+				AppliedIndex:      41,
+				LastSnapshotIndex: 32,
+				Commits:           2,
+				CommitLatencyAvg:  2 * time.Millisecond,
+				CommitLatencyMax:  3 * time.Millisecond,
+			},
+		},
+		"follower never contacted": {
+			state: raft.Follower,
+			raftStats: map[string]string{
+				"state":        "Follower",
+				"last_contact": "never",
+			},
+			expStats: &DatabaseStats{
+				Replica:     replicaAddr.String(),
+				State:       "Follower",
+				LastContact: -1,
+			},
+		},
+		"follower": {
+			state: raft.Follower,
+			raftStats: map[string]string{
+				"state":        "Follower",
+				"last_contact": "150ms",
+			},
+			expStats: &DatabaseStats{
+				Replica:     replicaAddr.String(),
+				State:       "Follower",
+				LastContact: 150 * time.Millisecond,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var db *Database
+			if tc.notReplica {
+				db = MockDatabaseWithAddr(t, log, nil)
+			} else {
+				db = MockDatabaseWithAddr(t, log, replicaAddr)
+			}
+			db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+				State: tc.state,
+				Stats: tc.raftStats,
+			}, (*fsm)(db)))
+			for _, c := range tc.commits {
+				db.stats.recordCommit(c)
+			}
+
+			gotStats, gotErr := db.Stats()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expStats, gotStats); diff != "" {
+				t.Fatalf("unexpected stats (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
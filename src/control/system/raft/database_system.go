@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2022-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,5 +11,10 @@ type (
 	// that must be raft-replicated.
 	SystemDatabase struct {
 		Attributes map[string]string
+		// Replicas contains the addresses of the MS replicas, if
+		// they have been changed since the system was started
+		// with the configured set of replicas. It is omitted from the
+		// serialized database until the replica set is first changed.
+		Replicas []string `json:",omitempty"`
 	}
 )
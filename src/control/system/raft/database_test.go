@@ -764,7 +764,7 @@ func TestSystem_Database_OnEvent(t *testing.T) {
 
 			db := MockDatabase(t, log)
 			for _, ps := range tc.poolSvcs {
-				lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+				lock, err := db.TakePoolLock(ctx, ps.PoolUUID, "test")
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -848,7 +848,7 @@ func TestSystemDatabase_PoolServiceList(t *testing.T) {
 			ctx := context.Background()
 			db := MockDatabase(t, log)
 			for _, ps := range tc.poolSvcs {
-				lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+				lock, err := db.TakePoolLock(ctx, ps.PoolUUID, "test")
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -1061,7 +1061,7 @@ func TestDatabase_TakePoolLock(t *testing.T) {
 			ctx:          parentLock.InContext(context.Background()),
 			existingLock: wrongIdLock,
 			poolUUID:     mockUUID,
-			expErr:       errors.New("is locked"),
+			expErr:       errors.New("operation in progress"),
 		},
 		"parent lock for wrong pool": {
 			ctx:          wrongPoolLock.InContext(context.Background()),
@@ -1089,7 +1089,7 @@ func TestDatabase_TakePoolLock(t *testing.T) {
 				db.poolLocks.locks = make(map[uuid.UUID]*PoolLock)
 				db.poolLocks.locks[tc.existingLock.poolUUID] = tc.existingLock
 			}
-			gotLock, gotErr := db.TakePoolLock(tc.ctx, tc.poolUUID)
+			gotLock, gotErr := db.TakePoolLock(tc.ctx, tc.poolUUID, "test")
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
@@ -1103,3 +1103,198 @@ func TestDatabase_TakePoolLock(t *testing.T) {
 		})
 	}
 }
+
+// replicatedTestDatabases returns a set of databases that replicate
+// their state through raft instances connected by in-memory transports,
+// along with a function that shuts the raft instances down.
+func replicatedTestDatabases(t *testing.T, log logging.Logger, count int) ([]*Database, func()) {
+	t.Helper()
+
+	var replicas []*net.TCPAddr
+	for i := 0; i < count; i++ {
+		replicas = append(replicas, &net.TCPAddr{
+			IP:   net.IPv4(127, 0, 0, 1),
+			Port: build.DefaultControlPort + i,
+		})
+	}
+
+	dbs := make([]*Database, count)
+	transports := make([]*raft.InmemTransport, count)
+	bootstrapCfg := raft.Configuration{}
+	for i, addr := range replicas {
+		db, err := NewDatabase(log, &DatabaseConfig{Replicas: replicas})
+		if err != nil {
+			t.Fatal(err)
+		}
+		db.replicaAddr = addr
+		db.data.NextRank = 1
+		dbs[i] = db
+
+		_, transports[i] = raft.NewInmemTransport(db.serverAddress())
+		bootstrapCfg.Servers = append(bootstrapCfg.Servers, raft.Server{
+			ID:      raft.ServerID(addr.String()),
+			Address: raft.ServerAddress(addr.String()),
+		})
+	}
+	for _, t1 := range transports {
+		for _, t2 := range transports {
+			if t1 != t2 {
+				t1.Connect(t2.LocalAddr(), t2)
+			}
+		}
+	}
+
+	svcs := make([]*raft.Raft, 0, count)
+	for i, db := range dbs {
+		raftCfg := raft.DefaultConfig()
+		raftCfg.Logger = newHcLogger(log)
+		raftCfg.LocalID = raft.ServerID(db.replicaAddr.String())
+		raftCfg.HeartbeatTimeout = 50 * time.Millisecond
+		raftCfg.ElectionTimeout = 50 * time.Millisecond
+		raftCfg.LeaderLeaseTimeout = 50 * time.Millisecond
+		raftCfg.CommitTimeout = 5 * time.Millisecond
+
+		svc, err := raft.NewRaft(raftCfg, (*fsm)(db), raft.NewInmemStore(), raft.NewInmemStore(),
+			raft.NewInmemSnapshotStore(), transports[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := svc.BootstrapCluster(bootstrapCfg).Error(); err != nil {
+			t.Fatal(err)
+		}
+		db.raft.setSvc(svc)
+		db.initialized.SetTrue()
+		svcs = append(svcs, svc)
+	}
+
+	return dbs, func() {
+		for _, svc := range svcs {
+			svc.Shutdown()
+		}
+	}
+}
+
+// waitForSysLeader waits for one of the databases to be elected leader
+// and returns its index.
+func waitForSysLeader(ctx context.Context, t *testing.T, dbs []*Database) int {
+	t.Helper()
+	for {
+		for i, db := range dbs {
+			if db != nil && db.IsLeader() {
+				return i
+			}
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal(ctx.Err())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// waitForReplication waits for the check to succeed on all databases.
+func waitForReplication(ctx context.Context, t *testing.T, dbs []*Database, check func(*Database) error) {
+	t.Helper()
+	for _, db := range dbs {
+		if db == nil {
+			continue
+		}
+		for {
+			err := check(db)
+			if err == nil {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatalf("%s: %s", db.replicaAddr, err)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+}
+
+func TestSystem_Database_Replication(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	dbs, cleanup := replicatedTestDatabases(t, log, 3)
+	defer cleanup()
+	leader := waitForSysLeader(ctx, t, dbs)
+
+	// Followers refuse updates, with a hint that allows clients to
+	// find the current leader.
+	follower := dbs[(leader+1)%len(dbs)]
+	waitForReplication(ctx, t, []*Database{follower}, func(db *Database) error {
+		if db.leaderHint() == "" {
+			return errors.New("no leader hint")
+		}
+		return nil
+	})
+	err := follower.AddMember(MockMember(t, 1, MemberStateJoined))
+	if !IsNotLeader(err) {
+		t.Fatalf("expected not leader error from follower, got %v", err)
+	}
+	nle, ok := errors.Cause(err).(*ErrNotLeader)
+	if !ok {
+		t.Fatalf("unexpected error type %T", err)
+	}
+	test.AssertEqual(t, dbs[leader].replicaAddr.String(), nle.LeaderHint, "unexpected leader hint")
+
+	// Members and pools added on the leader are replicated to all of
+	// the access points.
+	member := MockMember(t, 1, MemberStateJoined)
+	if err := dbs[leader].AddMember(member); err != nil {
+		t.Fatal(err)
+	}
+	ps := &PoolService{
+		PoolUUID:  uuid.New(),
+		PoolLabel: "pool0001",
+		State:     PoolServiceStateReady,
+		Replicas:  []Rank{1},
+		Storage: &PoolServiceStorage{
+			CreationRankStr: "[1]",
+			CurrentRankStr:  "[1]",
+		},
+	}
+	lock, err := dbs[leader].TakePoolLock(ctx, ps.PoolUUID, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dbs[leader].AddPoolService(lock.InContext(ctx), ps); err != nil {
+		t.Fatal(err)
+	}
+	lock.Release()
+
+	waitForReplication(ctx, t, dbs, func(db *Database) error {
+		if _, err := db.FindMemberByUUID(member.UUID); err != nil {
+			return err
+		}
+		_, err := db.FindPoolServiceByUUID(ps.PoolUUID)
+		return err
+	})
+
+	// When the leader goes away, one of the remaining access points
+	// takes over with the replicated state and accepts updates.
+	if err := dbs[leader].raft.withReadLock(func(svc raftService) error {
+		return svc.Shutdown().Error()
+	}); err != nil {
+		t.Fatal(err)
+	}
+	dbs[leader] = nil
+
+	newLeader := waitForSysLeader(ctx, t, dbs)
+	if _, err := dbs[newLeader].FindPoolServiceByUUID(ps.PoolUUID); err != nil {
+		t.Fatal(err)
+	}
+	newMember := MockMember(t, 2, MemberStateJoined)
+	if err := dbs[newLeader].AddMember(newMember); err != nil {
+		t.Fatal(err)
+	}
+	waitForReplication(ctx, t, dbs, func(db *Database) error {
+		_, err := db.FindMemberByUUID(newMember.UUID)
+		return err
+	})
+}
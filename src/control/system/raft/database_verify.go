@@ -0,0 +1,227 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// The raft log is the write-ahead journal for all MS database mutations:
+// every membership and pool service change is persisted to the log (in a
+// transactional boltdb store) before it is applied to the in-memory
+// database, and the log is replayed on top of the latest snapshot at
+// startup. The functions in this file verify that the journal and the
+// resulting database state are intact.
+
+// VerifyReport contains the results of a system database verification.
+type VerifyReport struct {
+	Replica    string   `json:"replica"`
+	FirstIndex uint64   `json:"first_index"`
+	LastIndex  uint64   `json:"last_index"`
+	Entries    uint64   `json:"entries"`
+	Errors     []string `json:"errors"`
+	Warnings   []string `json:"warnings"`
+}
+
+func (vr *VerifyReport) addErrorf(format string, args ...interface{}) {
+	vr.Errors = append(vr.Errors, fmt.Sprintf(format, args...))
+}
+
+func (vr *VerifyReport) addWarningf(format string, args ...interface{}) {
+	vr.Warnings = append(vr.Warnings, fmt.Sprintf(format, args...))
+}
+
+// errUnknownRaftOp indicates a log entry with an operation that is not known
+// to this version, e.g. one written by a newer version during an upgrade. The
+// entry may be intact, so it is reported as a warning rather than an error.
+type errUnknownRaftOp raftOp
+
+func (e errUnknownRaftOp) Error() string {
+	return fmt.Sprintf("unknown operation %d", uint32(e))
+}
+
+// verifyLogEntry checks that a command log entry can be decoded into
+// an update that the FSM would be able to apply.
+func verifyLogEntry(l *raft.Log) error {
+	if l.Type != raft.LogCommand {
+		return nil
+	}
+
+	c := new(raftUpdate)
+	if err := json.Unmarshal(l.Data, c); err != nil {
+		return errors.Wrap(err, "failed to decode update")
+	}
+
+	var inner interface{}
+	switch c.Op {
+	case raftOpIncMapVer:
+		return nil
+	case raftOpAddMember, raftOpUpdateMember, raftOpRemoveMember:
+		m := new(memberUpdate)
+		if err := json.Unmarshal(c.Data, m); err != nil {
+			return errors.Wrapf(err, "failed to decode %s", c.Op)
+		}
+		if m.Member == nil {
+			return errors.Errorf("%s has no member", c.Op)
+		}
+		return nil
+	case raftOpAddPoolService, raftOpUpdatePoolService, raftOpRemovePoolService:
+		inner = new(system.PoolService)
+	case raftOpUpdateSystemAttrs:
+		inner = &map[string]string{}
+	case raftOpRestoreDatabase:
+		inner = new(backupData)
+	case raftOpUpdateReplicas:
+		inner = &[]string{}
+	default:
+		return errUnknownRaftOp(c.Op)
+	}
+
+	return errors.Wrapf(json.Unmarshal(c.Data, inner), "failed to decode %s", c.Op)
+}
+
+// verifyLogStore reads every entry in the log store and records any entries
+// that are unreadable or cannot be decoded. Entries that are compacted into a
+// snapshot while the log is being read are skipped.
+func verifyLogStore(store raft.LogStore, report *VerifyReport) error {
+	first, err := store.FirstIndex()
+	if err != nil {
+		return errors.Wrap(err, "failed to get first log index")
+	}
+	last, err := store.LastIndex()
+	if err != nil {
+		return errors.Wrap(err, "failed to get last log index")
+	}
+	report.FirstIndex = first
+	report.LastIndex = last
+
+	if last == 0 {
+		return nil
+	}
+
+	for idx := first; idx <= last; idx++ {
+		entry := new(raft.Log)
+		if err := store.GetLog(idx, entry); err != nil {
+			if errors.Is(err, raft.ErrLogNotFound) {
+				newFirst, fiErr := store.FirstIndex()
+				if fiErr == nil && idx < newFirst {
+					report.FirstIndex = newFirst
+					idx = newFirst - 1
+					continue
+				}
+			}
+			report.addErrorf("log entry %d: %s", idx, err)
+			continue
+		}
+		report.Entries++
+
+		if err := verifyLogEntry(entry); err != nil {
+			var opErr errUnknownRaftOp
+			if errors.As(err, &opErr) {
+				report.addWarningf("log entry %d: %s", idx, err)
+				continue
+			}
+			report.addErrorf("log entry %d: %s", idx, err)
+		}
+	}
+
+	return nil
+}
+
+// verify checks that the lookup indexes of the database are consistent with
+// each other. The caller must hold the read lock.
+func (d *dbData) verify(report *VerifyReport) {
+	if len(d.Members.Ranks) != len(d.Members.Uuids) {
+		report.addErrorf("member rank index has %d entries, UUID index has %d",
+			len(d.Members.Ranks), len(d.Members.Uuids))
+	}
+	for rank, m := range d.Members.Ranks {
+		if _, found := d.Members.Uuids[m.UUID]; !found {
+			report.addErrorf("member rank %d has unknown UUID %s", rank, m.UUID)
+		}
+	}
+
+	addrMembers := 0
+	for _, members := range d.Members.Addrs {
+		addrMembers += len(members)
+	}
+	if addrMembers != len(d.Members.Uuids) {
+		report.addErrorf("member address index has %d entries, UUID index has %d",
+			addrMembers, len(d.Members.Uuids))
+	}
+
+	for id, m := range d.Members.Uuids {
+		if m.UUID != id {
+			report.addErrorf("member %s is indexed under UUID %s", m.UUID, id)
+		}
+		if cur, found := d.Members.Ranks[m.Rank]; !found || cur.UUID != m.UUID {
+			report.addErrorf("member %s is missing from rank index (rank %d)", m.UUID, m.Rank)
+		}
+		if m.Addr == nil {
+			report.addErrorf("member %s has no address", m.UUID)
+			continue
+		}
+
+		var foundAddr bool
+		for _, am := range d.Members.Addrs[m.Addr.String()] {
+			if am.UUID == m.UUID {
+				foundAddr = true
+				break
+			}
+		}
+		if !foundAddr {
+			report.addErrorf("member %s is missing from address index (%s)", m.UUID, m.Addr)
+		}
+	}
+
+	for id, ps := range d.Pools.Uuids {
+		if ps.PoolUUID != id {
+			report.addErrorf("pool %s is indexed under UUID %s", ps.PoolUUID, id)
+		}
+		if ps.PoolLabel == "" {
+			continue
+		}
+		if cur, found := d.Pools.Labels[ps.PoolLabel]; !found || cur.PoolUUID != ps.PoolUUID {
+			report.addErrorf("pool %s is missing from label index (%q)", ps.PoolUUID, ps.PoolLabel)
+		}
+	}
+	for label, ps := range d.Pools.Labels {
+		if _, found := d.Pools.Uuids[ps.PoolUUID]; !found {
+			report.addErrorf("pool label %q has unknown UUID %s", label, ps.PoolUUID)
+		}
+	}
+}
+
+// Verify checks the integrity of the local replica's raft log and the
+// consistency of the database state that has been applied from it.
+func (db *Database) Verify() (*VerifyReport, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	if db.logStore == nil {
+		return nil, errors.New("raft log store is not initialized")
+	}
+
+	report := &VerifyReport{
+		Replica: db.replicaAddr.String(),
+	}
+	if err := verifyLogStore(db.logStore, report); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+	db.data.verify(report)
+
+	return report, nil
+}
@@ -0,0 +1,256 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	. "github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	. "github.com/daos-stack/daos/src/control/system"
+)
+
+func mockRaftLog(t *testing.T, idx uint64, op raftOp, inner interface{}) *raft.Log {
+	t.Helper()
+
+	data, err := createRaftUpdate(op, inner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &raft.Log{Index: idx, Type: raft.LogCommand, Data: data}
+}
+
+// compactingLogStore simulates raft compacting the log into a snapshot while
+// it is being read, by removing the entries up to compactTo once the first
+// entry has been read.
+type compactingLogStore struct {
+	*raft.InmemStore
+	compactTo uint64
+	compacted bool
+}
+
+func (s *compactingLogStore) GetLog(idx uint64, l *raft.Log) error {
+	if err := s.InmemStore.GetLog(idx, l); err != nil {
+		return err
+	}
+	if !s.compacted {
+		s.compacted = true
+		first, err := s.FirstIndex()
+		if err != nil {
+			return err
+		}
+		return s.DeleteRange(first, s.compactTo)
+	}
+	return nil
+}
+
+func TestSystem_verifyStartupLog(t *testing.T) {
+	for name, tc := range map[string]struct {
+		logs   func(t *testing.T) []*raft.Log
+		expLog string
+		expErr error
+	}{
+		"unknown operation": {
+			logs: func(t *testing.T) []*raft.Log {
+				return []*raft.Log{
+					mockRaftLog(t, 1, raftOpIncMapVer, nil),
+					mockRaftLog(t, 2, raftOp(42), nil),
+				}
+			},
+			expLog: "log entry 2: unknown operation 42",
+		},
+		"corrupted entry": {
+			logs: func(t *testing.T) []*raft.Log {
+				l := mockRaftLog(t, 1, raftOpIncMapVer, nil)
+				l.Data = l.Data[:len(l.Data)/2]
+				return []*raft.Log{l}
+			},
+			expLog: "log entry 1: failed to decode update",
+			expErr: errors.New("verification failed with 1 errors"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			store := raft.NewInmemStore()
+			if err := store.StoreLogs(tc.logs(t)); err != nil {
+				t.Fatal(err)
+			}
+
+			test.CmpErr(t, tc.expErr, verifyStartupLog(log, store))
+			if !strings.Contains(buf.String(), tc.expLog) {
+				t.Fatalf("expected %q to be logged", tc.expLog)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_Verify(t *testing.T) {
+	member := MockMember(t, 1, MemberStateJoined)
+	pool := &PoolService{
+		PoolUUID:  uuid.New(),
+		PoolLabel: "pool1",
+		State:     PoolServiceStateReady,
+		Replicas:  []Rank{1},
+	}
+	validLogs := func(t *testing.T) []*raft.Log {
+		return []*raft.Log{
+			{Index: 1, Type: raft.LogConfiguration},
+			mockRaftLog(t, 2, raftOpAddMember, &memberUpdate{Member: member}),
+			mockRaftLog(t, 3, raftOpAddPoolService, pool),
+			mockRaftLog(t, 4, raftOpIncMapVer, nil),
+			mockRaftLog(t, 5, raftOpUpdateSystemAttrs, map[string]string{"foo": "bar"}),
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		noLogStore bool
+		logs       func(t *testing.T) []*raft.Log
+		compactTo  uint64
+		corruptFn  func(d *dbData)
+		expReport  *VerifyReport
+		expErr     error
+	}{
+		"no log store": {
+			noLogStore: true,
+			expErr:     errors.New("not initialized"),
+		},
+		"empty log": {
+			expReport: &VerifyReport{},
+		},
+		"valid log": {
+			logs: validLogs,
+			expReport: &VerifyReport{
+				FirstIndex: 1,
+				LastIndex:  5,
+				Entries:    5,
+			},
+		},
+		"log compacted during verification": {
+			logs:      validLogs,
+			compactTo: 3,
+			expReport: &VerifyReport{
+				FirstIndex: 4,
+				LastIndex:  5,
+				Entries:    3,
+			},
+		},
+		"partially written entry": {
+			logs: func(t *testing.T) []*raft.Log {
+				logs := validLogs(t)
+				last := logs[len(logs)-1]
+				last.Data = last.Data[:len(last.Data)/2]
+				return logs
+			},
+			expReport: &VerifyReport{
+				FirstIndex: 1,
+				LastIndex:  5,
+				Entries:    5,
+				Errors: []string{
+					"log entry 5: failed to decode update: unexpected end of JSON input",
+				},
+			},
+		},
+		"unknown operation": {
+			logs: func(t *testing.T) []*raft.Log {
+				return []*raft.Log{mockRaftLog(t, 1, raftOp(42), nil)}
+			},
+			expReport: &VerifyReport{
+				FirstIndex: 1,
+				LastIndex:  1,
+				Entries:    1,
+				Warnings:   []string{"log entry 1: unknown operation 42"},
+			},
+		},
+		"member update without member": {
+			logs: func(t *testing.T) []*raft.Log {
+				return []*raft.Log{mockRaftLog(t, 1, raftOpUpdateMember, &memberUpdate{})}
+			},
+			expReport: &VerifyReport{
+				FirstIndex: 1,
+				LastIndex:  1,
+				Entries:    1,
+				Errors:     []string{"log entry 1: updateMember has no member"},
+			},
+		},
+		"inconsistent indexes": {
+			logs: validLogs,
+			corruptFn: func(d *dbData) {
+				delete(d.Members.Ranks, member.Rank)
+				delete(d.Pools.Labels, pool.PoolLabel)
+			},
+			expReport: &VerifyReport{
+				FirstIndex: 1,
+				LastIndex:  5,
+				Entries:    5,
+				Errors: []string{
+					"member rank index has 0 entries, UUID index has 1",
+					"member " + member.UUID.String() + " is missing from rank index (rank 1)",
+					"pool " + pool.PoolUUID.String() + " is missing from label index (\"pool1\")",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			db := MockDatabase(t, log)
+			if err := db.AddMember(member); err != nil {
+				t.Fatal(err)
+			}
+			lock, err := db.TakePoolLock(ctx, pool.PoolUUID, "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := db.AddPoolService(lock.InContext(ctx), pool); err != nil {
+				t.Fatal(err)
+			}
+			lock.Release()
+
+			if !tc.noLogStore {
+				store := raft.NewInmemStore()
+				if tc.logs != nil {
+					if err := store.StoreLogs(tc.logs(t)); err != nil {
+						t.Fatal(err)
+					}
+				}
+				db.logStore = store
+				if tc.compactTo != 0 {
+					db.logStore = &compactingLogStore{InmemStore: store, compactTo: tc.compactTo}
+				}
+			}
+			if tc.corruptFn != nil {
+				tc.corruptFn(db.data)
+			}
+
+			report, err := db.Verify()
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			tc.expReport.Replica = db.replicaAddr.String()
+			if diff := cmp.Diff(tc.expReport, report); diff != "" {
+				t.Fatalf("unexpected report (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
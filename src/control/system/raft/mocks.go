@@ -30,10 +30,12 @@ type (
 		ServerAddress         raft.ServerAddress
 		State                 raft.RaftState
 		LeadershipTransferErr error
+		Stats                 map[string]string
 	}
 	mockRaftService struct {
-		cfg mockRaftServiceConfig
-		fsm raft.FSM
+		cfg     mockRaftServiceConfig
+		fsm     raft.FSM
+		servers map[raft.ServerID]raft.ServerSuffrage
 	}
 )
 
@@ -47,11 +49,21 @@ func (mrs *mockRaftService) Apply(cmd []byte, timeout time.Duration) raft.ApplyF
 	return &mockRaftFuture{}
 }
 
-func (mr *mockRaftService) AddVoter(_ raft.ServerID, _ raft.ServerAddress, _ uint64, _ time.Duration) raft.IndexFuture {
+func (mr *mockRaftService) AddVoter(id raft.ServerID, _ raft.ServerAddress, _ uint64, _ time.Duration) raft.IndexFuture {
+	mr.servers[id] = raft.Voter
 	return &mockRaftFuture{}
 }
 
-func (mr *mockRaftService) RemoveServer(_ raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
+func (mr *mockRaftService) AddNonvoter(id raft.ServerID, _ raft.ServerAddress, _ uint64, _ time.Duration) raft.IndexFuture {
+	// As with raft, adding an existing voter as a nonvoter leaves it a voter.
+	if suffrage, found := mr.servers[id]; !found || suffrage != raft.Voter {
+		mr.servers[id] = raft.Nonvoter
+	}
+	return &mockRaftFuture{}
+}
+
+func (mr *mockRaftService) RemoveServer(id raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
+	delete(mr.servers, id)
 	return &mockRaftFuture{}
 }
 
@@ -83,6 +95,16 @@ func (mrs *mockRaftService) State() raft.RaftState {
 	return mrs.cfg.State
 }
 
+func (mrs *mockRaftService) Stats() map[string]string {
+	if mrs.cfg.Stats != nil {
+		return mrs.cfg.Stats
+	}
+	return map[string]string{
+		"state":        mrs.cfg.State.String(),
+		"last_contact": "0",
+	}
+}
+
 func (mrs *mockRaftService) Barrier(time.Duration) raft.Future {
 	return &mockRaftFuture{}
 }
@@ -97,8 +119,9 @@ func newMockRaftService(cfg *mockRaftServiceConfig, fsm raft.FSM) *mockRaftServi
 		cfg.LeaderCh = make(<-chan bool)
 	}
 	return &mockRaftService{
-		cfg: *cfg,
-		fsm: fsm,
+		cfg:     *cfg,
+		fsm:     fsm,
+		servers: make(map[raft.ServerID]raft.ServerSuffrage),
 	}
 }
 
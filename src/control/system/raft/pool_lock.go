@@ -28,6 +28,8 @@ type (
 	PoolLock struct {
 		id       uuid.UUID
 		poolUUID uuid.UUID
+		op       string
+		bg       bool
 		takenAt  time.Time
 		refCount int32
 		relOnce  sync.Once
@@ -138,10 +140,31 @@ func (pl *PoolLock) Release() {
 	pl.relOnce.Do(pl.release)
 }
 
-// take returns a new pool lock for the supplied pool UUID
-// if the pool is not already locked, otherwise it returns
-// an error.
-func (plm *poolLockMap) take(poolUUID uuid.UUID) (*PoolLock, error) {
+// ID returns the unique identifier of the operation holding the lock.
+func (pl *PoolLock) ID() uuid.UUID {
+	return pl.id
+}
+
+// Operation returns the name of the operation holding the lock.
+func (pl *PoolLock) Operation() string {
+	return pl.op
+}
+
+// conflictFault returns a fault identifying the operation holding the
+// lock. Locks held by background operations are only held briefly, so
+// the conflicting request may be retried; otherwise the request is
+// rejected because the operation in progress may take some time.
+func (pl *PoolLock) conflictFault() error {
+	if pl.bg {
+		return system.FaultPoolLocked(pl.poolUUID, pl.id, pl.op, pl.takenAt)
+	}
+	return system.FaultPoolOpInProgress(pl.poolUUID, pl.id, pl.op, pl.takenAt)
+}
+
+// take returns a new pool lock held by the named operation for the
+// supplied pool UUID if the pool is not already locked, otherwise
+// it returns an error identifying the operation holding the lock.
+func (plm *poolLockMap) take(poolUUID uuid.UUID, op string, bg bool) (*PoolLock, error) {
 	if poolUUID == uuid.Nil {
 		return nil, errors.New("nil pool UUID")
 	}
@@ -154,19 +177,21 @@ func (plm *poolLockMap) take(poolUUID uuid.UUID) (*PoolLock, error) {
 	}
 
 	if lock, exists := plm.locks[poolUUID]; exists {
-		return nil, system.FaultPoolLocked(poolUUID, lock.id, lock.takenAt)
+		return nil, lock.conflictFault()
 	}
 
 	lock := &PoolLock{
 		id:       uuid.New(),
 		poolUUID: poolUUID,
+		op:       op,
+		bg:       bg,
 		takenAt:  time.Now(),
 		release:  func() { plm.release(poolUUID) },
 	}
 	lock.addRef()
 	plm.locks[poolUUID] = lock
 
-	plm.log.Debugf("%s: lock taken (id: %s)", dbgUuidStr(poolUUID), dbgUuidStr(lock.id))
+	plm.log.Debugf("%s: lock taken by %s (id: %s)", dbgUuidStr(poolUUID), op, dbgUuidStr(lock.id))
 	return lock, nil
 }
 
@@ -203,7 +228,7 @@ func (plm *poolLockMap) checkLock(lock *PoolLock) error {
 	// in take() should prevent this from ever happening.
 	if pl, exists := plm.locks[lock.poolUUID]; exists {
 		if lock.id != pl.id {
-			return system.FaultPoolLocked(lock.poolUUID, pl.id, pl.takenAt)
+			return pl.conflictFault()
 		}
 	} else {
 		return errors.Errorf("pool %s: lock not found", lock.poolUUID)
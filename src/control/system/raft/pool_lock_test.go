@@ -218,11 +218,20 @@ func TestRaft_poolLockMap_take(t *testing.T) {
 		"already locked": {
 			plm: func() *poolLockMap {
 				plm := &poolLockMap{log: log}
-				plm.take(uuid0)
+				plm.take(uuid0, "PoolExtend", false)
 				return plm
 			}(),
 			poolToLock: uuid0,
-			expErr:     errors.New("locked"),
+			expErr:     errors.New("PoolExtend operation in progress"),
+		},
+		"already locked by background operation": {
+			plm: func() *poolLockMap {
+				plm := &poolLockMap{log: log}
+				plm.take(uuid0, "PoolSvcReplicasUpdate", true)
+				return plm
+			}(),
+			poolToLock: uuid0,
+			expErr:     errors.New("is locked by PoolSvcReplicasUpdate"),
 		},
 		"lock taken successfully": {
 			poolToLock: uuid0,
@@ -235,7 +244,7 @@ func TestRaft_poolLockMap_take(t *testing.T) {
 			}
 			defer test.ShowBufferOnFailure(t, buf)
 
-			gotLock, err := tc.plm.take(tc.poolToLock)
+			gotLock, err := tc.plm.take(tc.poolToLock, "test", false)
 			test.CmpErr(t, tc.expErr, err)
 			if tc.expErr != nil {
 				return
@@ -264,7 +273,7 @@ func TestRaft_poolLockMap_checkLock(t *testing.T) {
 		"locked, same id": {
 			plm: func() *poolLockMap {
 				plm := &poolLockMap{log: log}
-				plm.take(uuid0)
+				plm.take(uuid0, "test", false)
 				plm.locks[uuid0].id = lock0.id
 				return plm
 			}(),
@@ -273,12 +282,22 @@ func TestRaft_poolLockMap_checkLock(t *testing.T) {
 		"locked, different id": {
 			plm: func() *poolLockMap {
 				plm := &poolLockMap{log: log}
-				plm.take(uuid0)
+				plm.take(uuid0, "test", false)
+				plm.locks[uuid0].id = lock1.id
+				return plm
+			}(),
+			checkLock: lock0,
+			expErr:    errors.New("test operation in progress"),
+		},
+		"locked by background operation, different id": {
+			plm: func() *poolLockMap {
+				plm := &poolLockMap{log: log}
+				plm.take(uuid0, "test", true)
 				plm.locks[uuid0].id = lock1.id
 				return plm
 			}(),
 			checkLock: lock0,
-			expErr:    errors.New("locked"),
+			expErr:    errors.New("is locked by test"),
 		},
 		"not locked": {
 			checkLock: lock0,
@@ -46,6 +46,8 @@ const (
 	raftOpRemovePoolService
 	raftOpIncMapVer
 	raftOpUpdateSystemAttrs
+	raftOpRestoreDatabase
+	raftOpUpdateReplicas
 
 	sysDBFile = "daos_system.db"
 )
@@ -81,6 +83,8 @@ func (ro raftOp) String() string {
 		"removePoolService",
 		"incMapVer",
 		"updateSystemAttrs",
+		"restoreDatabase",
+		"updateReplicas",
 	}[ro]
 }
 
@@ -276,6 +280,29 @@ func (db *Database) ConfigureTransport(srv *grpc.Server, dialOpts ...grpc.DialOp
 	return nil
 }
 
+// verifyStartupLog verifies the raft log before it is replayed. Entries with
+// operations unknown to this version are logged, as they may be intact.
+func verifyStartupLog(log logging.Logger, store raft.LogStore) error {
+	report := new(VerifyReport)
+	if err := verifyLogStore(store, report); err != nil {
+		return errors.Wrap(err, "failed to verify raft log")
+	}
+	for _, msg := range report.Warnings {
+		log.Notice(msg)
+	}
+	if len(report.Errors) > 0 {
+		for _, msg := range report.Errors {
+			log.Error(msg)
+		}
+		return errors.Errorf("raft log verification failed with %d errors; "+
+			"restore the management service from a snapshot", len(report.Errors))
+	}
+	log.Debugf("verified %d raft log entries (%d-%d)", report.Entries,
+		report.FirstIndex, report.LastIndex)
+
+	return nil
+}
+
 // initRaft sets up the backing raft service for use. If the service has
 // already been bootstrapped, then it will start immediately. Otherwise,
 // it will need to be bootstrapped before it can be used.
@@ -294,6 +321,14 @@ func (db *Database) initRaft() error {
 		return errors.New("no raft transport configured")
 	}
 
+	// Verify the journal before it is replayed into the database, in
+	// order to avoid applying a partially-written or corrupted log.
+	if err := verifyStartupLog(db.log, cmps.LogStore); err != nil {
+		return err
+	}
+	db.logStore = cmps.LogStore
+	db.snapshotStore = cmps.SnapshotStore
+
 	// Rank 0 is reserved for the first instance on the bootstrap server.
 	// NB: This is a bit of a hack. It would be better to persist this
 	// as a log entry, but there isn't a safe way to guarantee that it's
@@ -323,6 +358,7 @@ func (db *Database) initRaft() error {
 	if err != nil {
 		return err
 	}
+	db.observeLeaderChanges(r)
 	db.raft.setSvc(r)
 	db.initialized.SetTrue()
 
@@ -460,10 +496,24 @@ func (db *Database) submitSystemAttrsUpdate(props map[string]string) error {
 	return db.submitRaftUpdate(data)
 }
 
+// submitReplicasUpdate submits the given MS replica set update to the raft
+// service.
+func (db *Database) submitReplicasUpdate(replicas []string) error {
+	data, err := createRaftUpdate(raftOpUpdateReplicas, replicas)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
 // submitRaftUpdate submits the serialized operation to the raft service.
 func (db *Database) submitRaftUpdate(data []byte) error {
 	return db.raft.withReadLock(func(svc raftService) error {
+		applyStart := time.Now()
 		err := svc.Apply(data, 0).Error()
+		if err == nil {
+			db.stats.recordCommit(time.Since(applyStart))
+		}
 
 		// In the case that leadership is lost while trying to
 		// apply an update, return a sentinel error that may
@@ -517,6 +567,10 @@ func (f *fsm) Apply(l *raft.Log) interface{} {
 		f.data.applyPoolUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	case raftOpUpdateSystemAttrs:
 		f.data.applySystemUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpRestoreDatabase:
+		f.data.applyRestore(c.Data, f.EmergencyShutdown)
+	case raftOpUpdateReplicas:
+		f.data.applyReplicasUpdate(c.Data, f.EmergencyShutdown)
 	default:
 		f.EmergencyShutdown(errors.Errorf("unhandled Apply operation: %d", c.Op))
 		return nil
@@ -623,6 +677,21 @@ func (d *dbData) applySystemUpdate(op raftOp, data []byte, panicFn func(error))
 	}
 }
 
+// applyReplicasUpdate is responsible for applying the MS replica set update
+// operation to the database.
+func (d *dbData) applyReplicasUpdate(data []byte, panicFn func(error)) {
+	var replicas []string
+	if err := json.Unmarshal(data, &replicas); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode replicas update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.System.Replicas = replicas
+}
+
 // Snapshot is called to support log compaction, so that we don't have to keep
 // every log entry from the start of the system. Instead, the raft service periodically
 // creates a point-in-time snapshot which can be used to restore the current state, or
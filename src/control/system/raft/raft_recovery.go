@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2022-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -216,13 +216,12 @@ var (
 	ErrNoRaftSnapshots = errors.New("no raft snapshots")
 )
 
-// GetLogEntries returns the log entries from the raft log via a channel which
-// is closed when there are no more entries to be read.
-func GetLogEntries(log logging.Logger, cfg *DatabaseConfig, maxEntries ...uint64) (<-chan *LogEntryDetails, error) {
+func openReadOnlyBoltDB(cfg *DatabaseConfig) (*boltdb.BoltStore, error) {
 	boltOpts := boltdb.Options{
 		Path: cfg.DBFilePath(),
 		BoltOptions: &bbolt.Options{
 			ReadOnly: true,
+			Timeout:  time.Second,
 		},
 	}
 	boltDB, err := boltdb.New(boltOpts)
@@ -230,16 +229,36 @@ func GetLogEntries(log logging.Logger, cfg *DatabaseConfig, maxEntries ...uint64
 		return nil, errors.Wrapf(err, "failed to open boltdb at %s", cfg.DBFilePath())
 	}
 
+	return boltDB, nil
+}
+
+// GetLogEntries returns the log entries from the raft log via a channel which
+// is closed when there are no more entries to be read.
+func GetLogEntries(log logging.Logger, cfg *DatabaseConfig, maxEntries ...uint64) (<-chan *LogEntryDetails, error) {
+	boltDB, err := openReadOnlyBoltDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	li, err := boltDB.LastIndex()
 	if err != nil {
+		boltDB.Close()
 		return nil, errors.Wrap(err, "failed to get last log index")
 	}
 
 	if li == 0 {
+		boltDB.Close()
 		return nil, ErrNoRaftLogEntries
 	}
-	minIndex := uint64(0)
-	if len(maxEntries) > 0 {
+	// Entries preceding the first index have been compacted into a
+	// snapshot and are no longer available.
+	fi, err := boltDB.FirstIndex()
+	if err != nil {
+		boltDB.Close()
+		return nil, errors.Wrap(err, "failed to get first log index")
+	}
+	minIndex := fi - 1
+	if len(maxEntries) > 0 && maxEntries[0] > 0 && maxEntries[0] < li-minIndex {
 		minIndex = li - maxEntries[0]
 	}
 
@@ -429,3 +448,148 @@ func ReadSnapshotInfo(path string) (*SnapshotDetails, error) {
 
 	return details, errors.Wrapf(details.DecodeSnapshot(data), "failed to decode snapshot data in %s", path)
 }
+
+// Keys used by the raft library to persist its state in the stable store.
+var (
+	keyCurrentTerm  = []byte("CurrentTerm")
+	keyLastVoteTerm = []byte("LastVoteTerm")
+	keyLastVoteCand = []byte("LastVoteCand")
+)
+
+// RaftState contains the persistent raft state of the local replica. The
+// raft library does not persist the identity of the leader, but a replica
+// votes for the leader of a term when it is elected, so the last vote is
+// normally for the most recent leader.
+type RaftState struct {
+	CurrentTerm   uint64 `json:"current_term"`
+	LastVoteTerm  uint64 `json:"last_vote_term"`
+	LastVoteCand  string `json:"last_vote_candidate"`
+	FirstLogIndex uint64 `json:"first_log_index"`
+	LastLogIndex  uint64 `json:"last_log_index"`
+}
+
+// GetRaftState returns the persistent raft state of the local replica.
+func GetRaftState(cfg *DatabaseConfig) (*RaftState, error) {
+	boltDB, err := openReadOnlyBoltDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer boltDB.Close()
+
+	getUint64 := func(key []byte) (uint64, error) {
+		val, err := boltDB.GetUint64(key)
+		if err != nil && !errors.Is(err, boltdb.ErrKeyNotFound) {
+			return 0, errors.Wrapf(err, "failed to get %s", key)
+		}
+		return val, nil
+	}
+
+	state := new(RaftState)
+	if state.CurrentTerm, err = getUint64(keyCurrentTerm); err != nil {
+		return nil, err
+	}
+	if state.LastVoteTerm, err = getUint64(keyLastVoteTerm); err != nil {
+		return nil, err
+	}
+	cand, err := boltDB.Get(keyLastVoteCand)
+	if err != nil && !errors.Is(err, boltdb.ErrKeyNotFound) {
+		return nil, errors.Wrapf(err, "failed to get %s", keyLastVoteCand)
+	}
+	state.LastVoteCand = string(cand)
+
+	if state.FirstLogIndex, err = boltDB.FirstIndex(); err != nil {
+		return nil, errors.Wrap(err, "failed to get first log index")
+	}
+	if state.LastLogIndex, err = boltDB.LastIndex(); err != nil {
+		return nil, errors.Wrap(err, "failed to get last log index")
+	}
+
+	return state, nil
+}
+
+type (
+	// SnapshotDump contains the metadata and database contents of a snapshot.
+	SnapshotDump struct {
+		ID    string          `json:"id"`
+		Index uint64          `json:"index"`
+		Term  uint64          `json:"term"`
+		Data  json.RawMessage `json:"data"`
+	}
+
+	// LogEntryDump contains the details of a raft log entry.
+	LogEntryDump struct {
+		Index     uint64          `json:"index"`
+		Term      uint64          `json:"term"`
+		Time      time.Time       `json:"time"`
+		Operation string          `json:"operation"`
+		Data      json.RawMessage `json:"data,omitempty"`
+	}
+
+	// ReplicaDump contains the persistent state of the local replica, for
+	// offline inspection.
+	ReplicaDump struct {
+		State      *RaftState      `json:"state"`
+		Snapshot   *SnapshotDump   `json:"snapshot,omitempty"`
+		LogEntries []*LogEntryDump `json:"log_entries"`
+	}
+)
+
+// DumpLocalReplica returns the persistent raft state, the latest snapshot and
+// up to maxEntries of the most recent log entries (all if zero) of the local
+// replica. Log entries are returned in index order.
+func DumpLocalReplica(log logging.Logger, cfg *DatabaseConfig, maxEntries uint64) (*ReplicaDump, error) {
+	state, err := GetRaftState(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get raft state")
+	}
+	dump := &ReplicaDump{
+		State:      state,
+		LogEntries: []*LogEntryDump{},
+	}
+
+	sInfo, err := GetLatestSnapshot(log, cfg)
+	switch {
+	case err == nil:
+		data, err := readSnapshotData(sInfo.Path)
+		if err != nil {
+			return nil, err
+		}
+		dump.Snapshot = &SnapshotDump{
+			ID:    sInfo.Metadata.ID,
+			Index: sInfo.Metadata.Index,
+			Term:  sInfo.Metadata.Term,
+			Data:  data,
+		}
+	case errors.Is(err, ErrNoRaftSnapshots):
+	default:
+		return nil, errors.Wrap(err, "failed to get latest snapshot")
+	}
+
+	entries, err := GetLogEntries(log, cfg, maxEntries)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrNoRaftLogEntries):
+		return dump, nil
+	default:
+		return nil, errors.Wrap(err, "failed to get log entries")
+	}
+
+	for entry := range entries {
+		led := &LogEntryDump{
+			Index:     entry.Log.Index,
+			Term:      entry.Log.Term,
+			Time:      entry.Time,
+			Operation: entry.Operation,
+		}
+		// Only command entries have data in database format.
+		if entry.Log.Type == raft.LogCommand {
+			led.Data = entry.Data
+		}
+		dump.LogEntries = append(dump.LogEntries, led)
+	}
+	sort.Slice(dump.LogEntries, func(i, j int) bool {
+		return dump.LogEntries[i].Index < dump.LogEntries[j].Index
+	})
+
+	return dump, nil
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2022-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +8,7 @@ package raft
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"net"
@@ -133,7 +134,7 @@ func Test_Raft_RegenerateFixtures(t *testing.T) {
 			},
 		}
 
-		lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+		lock, err := db.TakePoolLock(ctx, ps.PoolUUID, "test")
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -476,3 +477,75 @@ func Test_Raft_ReadSnapshotInfo(t *testing.T) {
 		})
 	}
 }
+
+func Test_Raft_GetRaftState(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	dbCfg := testDbCfg()
+	state, err := GetRaftState(dbCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if state.CurrentTerm == 0 {
+		t.Fatal("expected non-zero current term")
+	}
+	test.AssertEqual(t, state.CurrentTerm, state.LastVoteTerm, "unexpected last vote term")
+	// The only replica votes for itself.
+	test.AssertEqual(t, dbCfg.stringReplicas()[0], state.LastVoteCand, "unexpected last vote candidate")
+
+	logEntry, err := GetLastLogEntry(log, dbCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, logEntry.Log.Index, state.LastLogIndex, "unexpected last log index")
+}
+
+func Test_Raft_DumpLocalReplica(t *testing.T) {
+	for name, tc := range map[string]struct {
+		maxEntries uint64
+		expEntries int
+	}{
+		"all entries": {},
+		"limited entries": {
+			maxEntries: 3,
+			expEntries: 3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dbCfg := testDbCfg()
+			dump, err := DumpLocalReplica(log, dbCfg, tc.maxEntries)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			latest, err := GetLatestSnapshot(log, dbCfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dump.Snapshot == nil {
+				t.Fatal("expected snapshot in dump")
+			}
+			test.AssertEqual(t, latest.Metadata.Index, dump.Snapshot.Index, "unexpected snapshot")
+
+			expEntries := tc.expEntries
+			if expEntries == 0 {
+				expEntries = int(dump.State.LastLogIndex - dump.State.FirstLogIndex + 1)
+			}
+			test.AssertEqual(t, expEntries, len(dump.LogEntries), "unexpected number of log entries")
+
+			last := dump.LogEntries[len(dump.LogEntries)-1]
+			test.AssertEqual(t, dump.State.LastLogIndex, last.Index, "log entries not in index order")
+			test.AssertEqual(t, raftOpAddPoolService.String(), last.Operation, "unexpected operation")
+
+			// The dump must be representable as JSON.
+			if _, err := json.Marshal(dump); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
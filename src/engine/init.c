@@ -80,6 +80,12 @@ hwloc_bitmap_t	core_allocation_bitmap;
 hwloc_obj_t		numa_obj;
 /** number of cores in the given NUMA node */
 int			dss_num_cores_numa_node;
+/** comma-separated list of helper XS CPUs, set by "-X" option */
+static char	       *dss_helper_cpus_str;
+/** OS indexes of the CPUs to bind helper XS to, in XS start order */
+unsigned int	       *dss_helper_cpus;
+/** number of entries in dss_helper_cpus */
+unsigned int		dss_helper_cpus_nr;
 /** Module facility bitmask */
 static uint64_t		dss_mod_facs;
 /** Number of storage tiers: 2 for SCM and NVMe */
@@ -263,10 +269,13 @@ dss_tgt_nr_get(unsigned int ncores, unsigned int nr, bool oversubscribe)
 
 	/* Each system XS uses one core, and  with dss_tgt_offload_xs_nr
 	 * offload XS. Calculate the tgt_nr as the number of main XS based
-	 * on number of cores.
+	 * on number of cores. Helper XS bound to explicit CPUs (e.g. the
+	 * sibling hyperthreads of the target cores) don't consume cores.
 	 */
 retry:
-	tgt_nr = ncores - DAOS_TGT0_OFFSET - dss_tgt_offload_xs_nr;
+	tgt_nr = ncores - DAOS_TGT0_OFFSET;
+	if (dss_helper_cpus_nr == 0)
+		tgt_nr -= dss_tgt_offload_xs_nr;
 	if (tgt_nr <= 0)
 		tgt_nr = 1;
 
@@ -296,6 +305,57 @@ retry:
 	return tgt_nr;
 }
 
+/**
+ * Parse the comma-separated list of helper XS CPUs passed with "-X".
+ */
+static int
+dss_helper_cpus_parse(void)
+{
+	char		*str;
+	char		*cur;
+	char		*tok;
+	unsigned int	 nr = 1;
+	unsigned int	 i;
+	int		 rc = 0;
+
+	if (dss_helper_cpus_str == NULL)
+		return 0;
+
+	for (i = 0; dss_helper_cpus_str[i] != '\0'; i++)
+		if (dss_helper_cpus_str[i] == ',')
+			nr++;
+
+	D_ALLOC_ARRAY(dss_helper_cpus, nr);
+	if (dss_helper_cpus == NULL)
+		return -DER_NOMEM;
+
+	D_STRNDUP(str, dss_helper_cpus_str, strlen(dss_helper_cpus_str));
+	if (str == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	cur = str;
+	while ((tok = strsep(&cur, ",")) != NULL) {
+		char *end = NULL;
+
+		dss_helper_cpus[dss_helper_cpus_nr] = strtoul(tok, &end, 10);
+		if (*tok == '\0' || end == NULL || *end != '\0') {
+			D_ERROR("invalid helper CPU list \"%s\" (set by \"-X\" option)\n",
+				dss_helper_cpus_str);
+			D_GOTO(out, rc = -DER_INVAL);
+		}
+		dss_helper_cpus_nr++;
+	}
+	D_INFO("%u helper XS CPUs specified: %s\n", dss_helper_cpus_nr,
+	       dss_helper_cpus_str);
+out:
+	D_FREE(str);
+	if (rc != 0) {
+		D_FREE(dss_helper_cpus);
+		dss_helper_cpus_nr = 0;
+	}
+	return rc;
+}
+
 static int
 dss_topo_init()
 {
@@ -306,6 +366,11 @@ dss_topo_init()
 	int		k;
 	hwloc_obj_t	corenode;
 	bool            tgt_oversub = false;
+	int		rc;
+
+	rc = dss_helper_cpus_parse();
+	if (rc != 0)
+		return rc;
 
 	hwloc_topology_init(&dss_topo);
 	hwloc_topology_load(dss_topo);
@@ -910,6 +975,8 @@ server_fini(bool force)
 	D_INFO("dss_engine_metrics_fini() done\n");
 	d_tm_fini();
 	D_INFO("d_tm_fini() done\n");
+	D_FREE(dss_helper_cpus);
+	dss_helper_cpus_nr = 0;
 	daos_debug_fini();
 	D_INFO("daos_debug_fini() done\n");
 }
@@ -930,6 +997,8 @@ Options:\n\
       Number of targets to use (use all cores by default)\n\
   --xshelpernr=nhelpers, -x helpers\n\
       Number of helper XS -per vos target (default 1)\n\
+  --helper_cpus=cpus, -X cpus\n\
+      Comma-separated list of CPUs to bind helper XS to, in start order\n\
   --firstcore=firstcore, -f firstcore\n\
       index of first core for service thread (default 0)\n\
   --group=group, -g group\n\
@@ -988,6 +1057,7 @@ parse(int argc, char **argv)
 		{ "targets",		required_argument,	NULL,	't' },
 		{ "storage",		required_argument,	NULL,	's' },
 		{ "xshelpernr",		required_argument,	NULL,	'x' },
+		{ "helper_cpus",	required_argument,	NULL,	'X' },
 		{ "instance_idx",	required_argument,	NULL,	'I' },
 		{ "bypass_health_chk",	no_argument,		NULL,	'b' },
 		{ "storage_tiers",	required_argument,	NULL,	'T' },
@@ -998,7 +1068,7 @@ parse(int argc, char **argv)
 
 	/* load all of modules by default */
 	sprintf(modules, "%s", MODULE_LIST);
-	while ((c = getopt_long(argc, argv, "c:d:f:g:hi:m:n:p:r:H:t:s:x:I:bT:",
+	while ((c = getopt_long(argc, argv, "c:d:f:g:hi:m:n:p:r:H:t:s:x:X:I:bT:",
 				opts, NULL)) != -1) {
 		switch (c) {
 		case 'm':
@@ -1019,6 +1089,9 @@ parse(int argc, char **argv)
 			rc = arg_strtoul(optarg, &dss_tgt_offload_xs_nr,
 					 "\"-x\"");
 			break;
+		case 'X':
+			dss_helper_cpus_str = optarg;
+			break;
 		case 'f':
 			rc = arg_strtoul(optarg, &dss_core_offset, "\"-f\"");
 			break;
@@ -1110,6 +1183,17 @@ daos_register_sighand(int signo, void (*handler) (int, siginfo_t *, void *))
 		D_ERROR(__VA_ARGS__);                                                              \
 	} while (0)
 
+/* flush the ULTs stacks to the dump file once the triggered dump completes */
+static void
+abt_stacks_dumped(ABT_bool timed_out, void *arg)
+{
+	FILE *fp = arg;
+
+	if (timed_out == ABT_TRUE)
+		fprintf(fp, "=== Dump of ULTs stacks timed out\n");
+	fflush(fp);
+}
+
 /** This should be safe on Linux since tls is allocated on thread creation */
 #define MAX_BT_ENTRIES 256
 static __thread void *bt[MAX_BT_ENTRIES];
@@ -1293,6 +1377,7 @@ main(int argc, char **argv)
 		if (sig == SIGUSR1) {
 			D_INFO("got SIGUSR1, dumping Argobots infos and ULTs stacks\n");
 			dss_dump_ABT_state(abt_infos);
+			fflush(abt_infos);
 			continue;
 		}
 
@@ -1301,9 +1386,9 @@ main(int argc, char **argv)
 		 */
 		if (sig == SIGUSR2) {
 			D_INFO("got SIGUSR2, attempting to trigger dump of all Argobots ULTs stacks\n");
-			ABT_info_trigger_print_all_thread_stacks(abt_infos,
-								 10.0, NULL,
-								 NULL);
+			ABT_info_trigger_print_all_thread_stacks(abt_infos, 10.0,
+								 abt_stacks_dumped,
+								 abt_infos);
 			continue;
 		}
 
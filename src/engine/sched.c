@@ -137,6 +137,8 @@ struct sched_pool_info {
 	int			spi_gc_sleeping;
 	int			spi_ref;
 	uint32_t		spi_req_cnt;
+	/* CPU percentage for rebuild/reintegration, 0 means REBUILD_RATIO */
+	uint32_t		spi_rebuild_ratio;
 	struct stats_window	spi_stats_window;
 };
 
@@ -995,10 +997,11 @@ done:
  * internal sys ULTs will be throttled.
  */
 static void
-throttle_sys(struct stats_window *sw, uint32_t *kick, struct pressure_ratio *pr)
+throttle_sys(struct sched_pool_info *spi, uint32_t *kick, struct pressure_ratio *pr)
 {
-	uint64_t	*kicked_wts, io_wts, tot_wts, avail_wts;
-	unsigned int	 io_ratio;
+	struct stats_window	*sw = &spi->spi_stats_window;
+	uint64_t		*kicked_wts, io_wts, tot_wts, avail_wts;
+	unsigned int		 io_ratio;
 
 	kicked_wts = &sw->sw_kicked_wts[0];
 
@@ -1011,10 +1014,14 @@ throttle_sys(struct stats_window *sw, uint32_t *kick, struct pressure_ratio *pr)
 		return;
 
 	if (kicked_wts[SCHED_REQ_MIGRATE] != 0 || kick[SCHED_REQ_MIGRATE] != 0)
-		io_ratio = 100 - REBUILD_RATIO;
+		io_ratio = 100 - (spi->spi_rebuild_ratio ? spi->spi_rebuild_ratio : REBUILD_RATIO);
 	else
 		io_ratio = 100 - pr->pr_gc_ratio;
 
+	/* Rebuild is allowed to take all of the CPU, no throttling on sys ULTs */
+	if (io_ratio == 0)
+		return;
+
 	/* Calculate the target total weights based on IO weights and IO ratio */
 	tot_wts = io_wts * 100 / io_ratio;
 	if (tot_wts < SW_MIN_WEIGHTS)
@@ -1066,7 +1073,7 @@ process_pool_cb(d_list_t *rlink, void *arg)
 	pr = &pressure_gauge[press];
 
 	if (press == SCHED_SPACE_PRESS_NONE)
-		throttle_sys(&spi->spi_stats_window, &kick[SCHED_REQ_UPDATE], pr);
+		throttle_sys(spi, &kick[SCHED_REQ_UPDATE], pr);
 	else
 		throttle_io(info, spi, &kick[SCHED_REQ_UPDATE], pr);
 
@@ -1337,6 +1344,21 @@ sched_req_space_check(struct sched_request *req)
 	return check_space_pressure(dx, req->sr_pool_info);
 }
 
+int
+sched_set_rebuild_ratio(uuid_t pool_uuid, unsigned int ratio)
+{
+	struct dss_xstream	*dx = dss_current_xstream();
+	struct sched_pool_info	*spi;
+
+	D_ASSERT(ratio <= 100);
+	spi = cur_pool_info(&dx->dx_sched_info, pool_uuid);
+	if (spi == NULL)
+		return -DER_NOMEM;
+
+	spi->spi_rebuild_ratio = ratio;
+	return 0;
+}
+
 static void
 wakeup_all(struct dss_xstream *dx)
 {
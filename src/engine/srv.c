@@ -918,9 +918,13 @@ dss_start_xs_id(int xs_id)
 		D_DEBUG(DB_TRACE, "Using non-NUMA aware core allocation\n");
 		/*
 		 * All system XS will use the first core, but
-		 * the SWIM XS will use separate core if enough cores
+		 * the SWIM XS will use separate core if enough cores.
+		 * If helper XS are bound to explicit CPUs, they don't
+		 * consume cores and the main XS use consecutive cores.
 		 */
-		if (xs_id > 2)
+		if (dss_helper_cpus_nr > 0 && xs_id >= dss_sys_xs_nr)
+			xs_core_offset = DAOS_TGT0_OFFSET + dss_xs2tgt(xs_id);
+		else if (xs_id > 2)
 			xs_core_offset = xs_id - ((dss_core_nr > dss_tgt_nr) ? 1 : 2);
 		else if (xs_id == 1)
 			xs_core_offset = (dss_core_nr > dss_tgt_nr) ? 1 : 0;
@@ -943,6 +947,35 @@ dss_start_xs_id(int xs_id)
 	return 0;
 }
 
+/**
+ * Start the helper XS \a xs_id on the \a idx-th CPU passed with "-X".
+ */
+static int
+dss_start_helper_xs(int xs_id, int idx)
+{
+	hwloc_obj_t	obj;
+
+	D_ASSERT(idx < dss_helper_cpus_nr);
+	obj = hwloc_get_pu_obj_by_os_index(dss_topo, dss_helper_cpus[idx]);
+	if (obj == NULL) {
+		D_ERROR("helper CPU %u for XS %d not found in topology\n",
+			dss_helper_cpus[idx], xs_id);
+		return -DER_INVAL;
+	}
+
+	D_DEBUG(DB_TRACE, "Using CPU %u for helper XS %d\n",
+		dss_helper_cpus[idx], xs_id);
+	return dss_start_one_xstream(obj->cpuset, xs_id);
+}
+
+static int
+dss_start_offload_xs_id(int xs_id, int idx)
+{
+	if (dss_helper_cpus_nr > 0)
+		return dss_start_helper_xs(xs_id, idx);
+	return dss_start_xs_id(xs_id);
+}
+
 static int
 dss_xstreams_init(void)
 {
@@ -1016,11 +1049,16 @@ dss_xstreams_init(void)
 	}
 
 	/* start offload XS if any */
+	if (dss_helper_cpus_nr > 0 && dss_helper_cpus_nr < dss_tgt_offload_xs_nr) {
+		D_ERROR("%u helper XS CPUs specified for %u helper XS\n",
+			dss_helper_cpus_nr, dss_tgt_offload_xs_nr);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
 	if (dss_tgt_offload_xs_nr > 0) {
 		if (dss_helper_pool) {
 			for (i = 0; i < dss_tgt_offload_xs_nr; i++) {
 				xs_id = dss_sys_xs_nr + dss_tgt_nr + i;
-				rc = dss_start_xs_id(xs_id);
+				rc = dss_start_offload_xs_id(xs_id, i);
 				if (rc)
 					D_GOTO(out, rc);
 			}
@@ -1034,7 +1072,8 @@ dss_xstreams_init(void)
 				for (j = 0; j < dss_tgt_offload_xs_nr /
 						dss_tgt_nr; j++) {
 					xs_id = DSS_MAIN_XS_ID(i) + j + 1;
-					rc = dss_start_xs_id(xs_id);
+					rc = dss_start_offload_xs_id(xs_id,
+						i * (dss_tgt_offload_xs_nr / dss_tgt_nr) + j);
 					if (rc)
 						D_GOTO(out, rc);
 				}
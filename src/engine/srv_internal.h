@@ -124,6 +124,10 @@ extern unsigned int	dss_tgt_offload_xs_nr;
 extern unsigned int	dss_sys_xs_nr;
 /** Flag of helper XS as a pool */
 extern bool		dss_helper_pool;
+/** OS indexes of the CPUs to bind helper XS to, in XS start order */
+extern unsigned int	*dss_helper_cpus;
+/** number of entries in dss_helper_cpus */
+extern unsigned int	dss_helper_cpus_nr;
 
 /** Shadow dss_get_module_info */
 struct dss_module_info *get_module_info(void);
@@ -68,6 +68,12 @@ enum drpc_mgmt_method {
 	DRPC_METHOD_MGMT_POOL_UPGRADE		= 239,
 	DRPC_METHOD_MGMT_POOL_QUERY_TARGETS	= 240,
 	DRPC_METHOD_MGMT_LED_MANAGE		= 241,
+	DRPC_METHOD_MGMT_NOTIFY_JOB_START	= 242,
+	DRPC_METHOD_MGMT_NOTIFY_JOB_END		= 243,
+	DRPC_METHOD_MGMT_POOL_QUERY_AGGREGATION	= 244,
+	DRPC_METHOD_MGMT_POOL_SET_POLICY	= 245,
+	DRPC_METHOD_MGMT_CONT_CHECK		= 246,
+	DRPC_METHOD_MGMT_BLOBSTORE_USAGE	= 247,
 
 	NUM_DRPC_MGMT_METHODS			/* Must be last */
 };
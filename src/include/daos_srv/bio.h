@@ -739,6 +739,33 @@ bio_yield(void)
 int bio_get_dev_state(struct nvme_stats *dev_state,
 		      struct bio_xs_context *xs);
 
+/*
+ * Blobstore space usage seen from a single xstream, inquired from BIO.
+ */
+struct bio_bs_usage {
+	uuid_t			bbu_dev_id;
+	uint64_t		bbu_cluster_sz;
+	uint64_t		bbu_total_clusters;
+	uint64_t		bbu_free_clusters;
+	/* Clusters allocated to the blobs opened by the xstream */
+	uint64_t		bbu_used_clusters;
+	uint32_t		bbu_blobs;
+};
+
+/*
+ * Helper function to get the usage of the blobstore mapped to a given xstream
+ * and the clusters consumed by the blobs it has opened. Must be called on the
+ * xstream owning the context. Used for explaining NVMe capacity usage from the
+ * control plane command.
+ *
+ * \param xs		[IN]	xstream context
+ * \param usage		[OUT]	Blobstore usage
+ *
+ * \return			Zero on success, -DER_NONEXIST if no blobstore
+ *				is loaded for the xstream
+ */
+int bio_get_bs_usage(struct bio_xs_context *xs, struct bio_bs_usage *usage);
+
 /*
  * Helper function to get the internal blobstore state for a given xstream.
  * Used for daos_test validation in the daos_mgmt_get_bs_state() C API.
@@ -70,10 +70,13 @@ struct ds_cont_child {
 				 sc_stopping:1,
 				 sc_vos_agg_active:1,
 				 sc_ec_agg_active:1,
+				 sc_vos_aggregating:1,
 				 sc_scrubbing:1;
 	uint32_t		 sc_dtx_batched_gen;
 	/* Tracks the schedule request for aggregation ULT */
 	struct sched_request	*sc_agg_req;
+	/* HLC at which the last VOS aggregation pass completed (0 means never) */
+	uint64_t		 sc_agg_last_hlc;
 
 	/* Tracks the schedule request for EC aggregation ULT */
 	struct sched_request	*sc_ec_agg_req;
@@ -255,4 +258,18 @@ int ds_cont_ec_eph_delete(struct ds_pool *pool, uuid_t cont_uuid, int tgt_idx);
 
 void ds_cont_ec_timestamp_update(struct ds_cont_child *cont);
 
+/* VOS aggregation status of a pool's containers on the local engine */
+struct ds_cont_agg_status {
+	/* Lowest HAE of the pool's containers (0 means none aggregated yet) */
+	uint64_t	cas_hae;
+	/* HLC at which the last aggregation pass completed (0 means never) */
+	uint64_t	cas_last_hlc;
+	uint32_t	cas_running:1,	/* aggregation pass in progress */
+			cas_paused:1;	/* reclaim disabled or reintegrating */
+};
+
+int ds_cont_tgt_agg_query(uuid_t pool_uuid, struct ds_cont_agg_status *status);
+int ds_cont_tgt_list(uuid_t pool_uuid, uuid_t **conts, int *conts_nr);
+int ds_cont_tgt_destroy(uuid_t pool_uuid, uuid_t cont_uuid);
+
 #endif /* ___DAOS_SRV_CONTAINER_H_ */
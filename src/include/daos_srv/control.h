@@ -82,6 +82,10 @@ struct nvme_stats {
 	uint32_t	 bio_unmap_errs;
 	uint32_t	 checksum_errs;
 	uint16_t	 temperature; /* in Kelvin */
+	/* Spare capacity and wear, all in percent */
+	uint8_t		 avail_spare;
+	uint8_t		 avail_spare_thres;
+	uint8_t		 percent_used;
 	/* Critical warnings */
 	bool		 temp_warn;
 	bool		 avail_spare_warn;
@@ -334,6 +334,17 @@ bool sched_req_is_aborted(struct sched_request *req);
  */
 int sched_req_space_check(struct sched_request *req);
 
+/**
+ * Set the CPU percentage available to rebuild/reintegration ULTs of a pool
+ * on the current xstream while there is ongoing I/O.
+ *
+ * \param[in] pool_uuid	Pool UUID.
+ * \param[in] ratio	CPU percentage (1-100), 0 restores the default.
+ *
+ * \retval		0 on success, -DER_NOMEM on allocation failure.
+ */
+int sched_set_rebuild_ratio(uuid_t pool_uuid, unsigned int ratio);
+
 /**
  * Wrapper of ABT_cond_wait(), inform scheduler that it's going
  * to be blocked for a relative long time.
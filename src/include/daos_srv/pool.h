@@ -98,6 +98,12 @@ struct ds_pool {
 	uint64_t		sp_scrub_mode;
 	uint64_t		sp_scrub_freq_sec;
 	uint64_t		sp_scrub_thresh;
+	/**
+	 * Runtime hints for the scheduling of background tasks, set by the
+	 * administrator and not persistent, see ds_pool_tgt_set_policy().
+	 */
+	uint32_t		sp_scrub_rate;	/* % of the scrub rate, 0 = 100% */
+	uint32_t		sp_agg_paused:1;
 };
 
 int ds_pool_lookup(const uuid_t uuid, struct ds_pool **pool);
@@ -295,6 +301,16 @@ int dsc_pool_open(uuid_t pool_uuid, uuid_t pool_hdl_uuid,
 int dsc_pool_close(daos_handle_t ph);
 int ds_pool_tgt_discard(uuid_t pool_uuid, uint64_t epoch);
 
+/** Aggregation hint of ds_pool_tgt_set_policy() */
+enum ds_pool_agg_hint {
+	DS_POOL_AGG_UNCHANGED	= 0,
+	DS_POOL_AGG_ENABLED,
+	DS_POOL_AGG_DISABLED,
+};
+
+int ds_pool_tgt_set_policy(uuid_t pool_uuid, uint32_t aggregation, uint32_t rebuild_ratio,
+			   uint32_t scrub_rate, bool clear);
+
 int
 ds_pool_mark_upgrade_completed(uuid_t pool_uuid, int ret);
 
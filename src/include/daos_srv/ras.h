@@ -53,7 +53,14 @@
 	X(RAS_SWIM_RANK_ALIVE,		"swim_rank_alive")				\
 	X(RAS_SWIM_RANK_DEAD,		"swim_rank_dead")				\
 	X(RAS_SYSTEM_START_FAILED,	"system_start_failed")				\
-	X(RAS_SYSTEM_STOP_FAILED,	"system_stop_failed")
+	X(RAS_SYSTEM_STOP_FAILED,	"system_stop_failed")				\
+	X(RAS_ENGINE_UNRESPONSIVE,	"engine_unresponsive")				\
+	X(RAS_ENGINE_METADATA_CORRUPTED,	"engine_metadata_corrupted")			\
+	X(RAS_SYSTEM_REPLICAS_UPDATED,	"system_replicas_updated")			\
+	X(RAS_ENGINE_JOINED,		"engine_joined")				\
+	X(RAS_ENGINE_DUPLICATE,		"engine_duplicate")				\
+	X(RAS_DEVICE_UNPLUGGED,		"device_unplugged")				\
+	X(RAS_DEVICE_PLUGGED,		"device_plugged")
 
 /** Define RAS event enum */
 typedef enum {
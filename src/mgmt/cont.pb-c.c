@@ -97,6 +97,102 @@ void   mgmt__cont_set_owner_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__cont_set_owner_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__cont_check_req__init
+                     (Mgmt__ContCheckReq         *message)
+{
+  static const Mgmt__ContCheckReq init_value = MGMT__CONT_CHECK_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_check_req__get_packed_size
+                     (const Mgmt__ContCheckReq *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_check_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_check_req__pack
+                     (const Mgmt__ContCheckReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_check_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_check_req__pack_to_buffer
+                     (const Mgmt__ContCheckReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_check_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContCheckReq *
+       mgmt__cont_check_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContCheckReq *)
+     protobuf_c_message_unpack (&mgmt__cont_check_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_check_req__free_unpacked
+                     (Mgmt__ContCheckReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_check_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_check_resp__orphan__init
+                     (Mgmt__ContCheckResp__Orphan         *message)
+{
+  static const Mgmt__ContCheckResp__Orphan init_value = MGMT__CONT_CHECK_RESP__ORPHAN__INIT;
+  *message = init_value;
+}
+void   mgmt__cont_check_resp__init
+                     (Mgmt__ContCheckResp         *message)
+{
+  static const Mgmt__ContCheckResp init_value = MGMT__CONT_CHECK_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_check_resp__get_packed_size
+                     (const Mgmt__ContCheckResp *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_check_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_check_resp__pack
+                     (const Mgmt__ContCheckResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_check_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_check_resp__pack_to_buffer
+                     (const Mgmt__ContCheckResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_check_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContCheckResp *
+       mgmt__cont_check_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContCheckResp *)
+     protobuf_c_message_unpack (&mgmt__cont_check_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_check_resp__free_unpacked
+                     (Mgmt__ContCheckResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_check_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 static const ProtobufCFieldDescriptor mgmt__cont_set_owner_req__field_descriptors[6] =
 {
   {
@@ -238,3 +334,208 @@ const ProtobufCMessageDescriptor mgmt__cont_set_owner_resp__descriptor =
   (ProtobufCMessageInit) mgmt__cont_set_owner_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__cont_check_req__field_descriptors[4] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckReq, id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ContCheckReq, n_svc_ranks),   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "repair",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckReq, repair),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_check_req__field_indices_by_name[] = {
+  1,   /* field[1] = id */
+  3,   /* field[3] = repair */
+  2,   /* field[2] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__cont_check_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_check_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContCheckReq",
+  "ContCheckReq",
+  "Mgmt__ContCheckReq",
+  "mgmt",
+  sizeof(Mgmt__ContCheckReq),
+  4,
+  mgmt__cont_check_req__field_descriptors,
+  mgmt__cont_check_req__field_indices_by_name,
+  1,  mgmt__cont_check_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_check_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_check_resp__orphan__field_descriptors[4] =
+{
+  {
+    "uuid",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckResp__Orphan, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "label",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckResp__Orphan, label),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "reason",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckResp__Orphan, reason),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "removed",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckResp__Orphan, removed),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_check_resp__orphan__field_indices_by_name[] = {
+  1,   /* field[1] = label */
+  2,   /* field[2] = reason */
+  3,   /* field[3] = removed */
+  0,   /* field[0] = uuid */
+};
+static const ProtobufCIntRange mgmt__cont_check_resp__orphan__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_check_resp__orphan__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContCheckResp.Orphan",
+  "Orphan",
+  "Mgmt__ContCheckResp__Orphan",
+  "mgmt",
+  sizeof(Mgmt__ContCheckResp__Orphan),
+  4,
+  mgmt__cont_check_resp__orphan__field_descriptors,
+  mgmt__cont_check_resp__orphan__field_indices_by_name,
+  1,  mgmt__cont_check_resp__orphan__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_check_resp__orphan__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_check_resp__field_descriptors[2] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "orphans",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Mgmt__ContCheckResp, n_orphans),   /* quantifier_offset */
+    offsetof(Mgmt__ContCheckResp, orphans),
+    &mgmt__cont_check_resp__orphan__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_check_resp__field_indices_by_name[] = {
+  1,   /* field[1] = orphans */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__cont_check_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_check_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContCheckResp",
+  "ContCheckResp",
+  "Mgmt__ContCheckResp",
+  "mgmt",
+  sizeof(Mgmt__ContCheckResp),
+  2,
+  mgmt__cont_check_resp__field_descriptors,
+  mgmt__cont_check_resp__field_indices_by_name,
+  1,  mgmt__cont_check_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_check_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
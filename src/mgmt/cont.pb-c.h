@@ -17,6 +17,9 @@ PROTOBUF_C__BEGIN_DECLS
 
 typedef struct Mgmt__ContSetOwnerReq Mgmt__ContSetOwnerReq;
 typedef struct Mgmt__ContSetOwnerResp Mgmt__ContSetOwnerResp;
+typedef struct Mgmt__ContCheckReq Mgmt__ContCheckReq;
+typedef struct Mgmt__ContCheckResp Mgmt__ContCheckResp;
+typedef struct Mgmt__ContCheckResp__Orphan Mgmt__ContCheckResp__Orphan;
 
 
 /* --- enums --- */
@@ -77,6 +80,82 @@ struct  Mgmt__ContSetOwnerResp
     , 0 }
 
 
+/*
+ * ContCheckReq supplies parameters for a consistency check of a pool's
+ * container metadata.
+ */
+struct  Mgmt__ContCheckReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * uuid or label of pool
+   */
+  char *id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+  /*
+   * remove any orphaned container metadata found
+   */
+  protobuf_c_boolean repair;
+};
+#define MGMT__CONT_CHECK_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_check_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0 }
+
+
+struct  Mgmt__ContCheckResp__Orphan
+{
+  ProtobufCMessage base;
+  /*
+   * UUID of the container the metadata belongs to
+   */
+  char *uuid;
+  /*
+   * label of the container, if known
+   */
+  char *label;
+  /*
+   * description of the inconsistency found
+   */
+  char *reason;
+  /*
+   * orphaned metadata has been removed
+   */
+  protobuf_c_boolean removed;
+};
+#define MGMT__CONT_CHECK_RESP__ORPHAN__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_check_resp__orphan__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0 }
+
+
+/*
+ * ContCheckResp returns the orphaned container metadata found by a check.
+ */
+struct  Mgmt__ContCheckResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * orphaned container metadata
+   */
+  size_t n_orphans;
+  Mgmt__ContCheckResp__Orphan **orphans;
+};
+#define MGMT__CONT_CHECK_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_check_resp__descriptor) \
+    , 0, 0,NULL }
+
+
 /* Mgmt__ContSetOwnerReq methods */
 void   mgmt__cont_set_owner_req__init
                      (Mgmt__ContSetOwnerReq         *message);
@@ -115,6 +194,47 @@ Mgmt__ContSetOwnerResp *
 void   mgmt__cont_set_owner_resp__free_unpacked
                      (Mgmt__ContSetOwnerResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__ContCheckReq methods */
+void   mgmt__cont_check_req__init
+                     (Mgmt__ContCheckReq         *message);
+size_t mgmt__cont_check_req__get_packed_size
+                     (const Mgmt__ContCheckReq   *message);
+size_t mgmt__cont_check_req__pack
+                     (const Mgmt__ContCheckReq   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_check_req__pack_to_buffer
+                     (const Mgmt__ContCheckReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContCheckReq *
+       mgmt__cont_check_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_check_req__free_unpacked
+                     (Mgmt__ContCheckReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContCheckResp__Orphan methods */
+void   mgmt__cont_check_resp__orphan__init
+                     (Mgmt__ContCheckResp__Orphan         *message);
+/* Mgmt__ContCheckResp methods */
+void   mgmt__cont_check_resp__init
+                     (Mgmt__ContCheckResp         *message);
+size_t mgmt__cont_check_resp__get_packed_size
+                     (const Mgmt__ContCheckResp   *message);
+size_t mgmt__cont_check_resp__pack
+                     (const Mgmt__ContCheckResp   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_check_resp__pack_to_buffer
+                     (const Mgmt__ContCheckResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContCheckResp *
+       mgmt__cont_check_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_check_resp__free_unpacked
+                     (Mgmt__ContCheckResp *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Mgmt__ContSetOwnerReq_Closure)
@@ -123,6 +243,15 @@ typedef void (*Mgmt__ContSetOwnerReq_Closure)
 typedef void (*Mgmt__ContSetOwnerResp_Closure)
                  (const Mgmt__ContSetOwnerResp *message,
                   void *closure_data);
+typedef void (*Mgmt__ContCheckReq_Closure)
+                 (const Mgmt__ContCheckReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContCheckResp__Orphan_Closure)
+                 (const Mgmt__ContCheckResp__Orphan *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContCheckResp_Closure)
+                 (const Mgmt__ContCheckResp *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -131,6 +260,9 @@ typedef void (*Mgmt__ContSetOwnerResp_Closure)
 
 extern const ProtobufCMessageDescriptor mgmt__cont_set_owner_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__cont_set_owner_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_check_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_check_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_check_resp__orphan__descriptor;
 
 PROTOBUF_C__END_DECLS
 
@@ -1324,6 +1324,192 @@ void   mgmt__pool_upgrade_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_upgrade_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__pool_set_policy_req__init
+                     (Mgmt__PoolSetPolicyReq         *message)
+{
+  static const Mgmt__PoolSetPolicyReq init_value = MGMT__POOL_SET_POLICY_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_set_policy_req__get_packed_size
+                     (const Mgmt__PoolSetPolicyReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_set_policy_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_set_policy_req__pack
+                     (const Mgmt__PoolSetPolicyReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_set_policy_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_set_policy_req__pack_to_buffer
+                     (const Mgmt__PoolSetPolicyReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_set_policy_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolSetPolicyReq *
+       mgmt__pool_set_policy_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolSetPolicyReq *)
+     protobuf_c_message_unpack (&mgmt__pool_set_policy_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_set_policy_req__free_unpacked
+                     (Mgmt__PoolSetPolicyReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_set_policy_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_set_policy_resp__init
+                     (Mgmt__PoolSetPolicyResp         *message)
+{
+  static const Mgmt__PoolSetPolicyResp init_value = MGMT__POOL_SET_POLICY_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_set_policy_resp__get_packed_size
+                     (const Mgmt__PoolSetPolicyResp *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_set_policy_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_set_policy_resp__pack
+                     (const Mgmt__PoolSetPolicyResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_set_policy_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_set_policy_resp__pack_to_buffer
+                     (const Mgmt__PoolSetPolicyResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_set_policy_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolSetPolicyResp *
+       mgmt__pool_set_policy_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolSetPolicyResp *)
+     protobuf_c_message_unpack (&mgmt__pool_set_policy_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_set_policy_resp__free_unpacked
+                     (Mgmt__PoolSetPolicyResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_set_policy_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_query_aggregation_req__init
+                     (Mgmt__PoolQueryAggregationReq         *message)
+{
+  static const Mgmt__PoolQueryAggregationReq init_value = MGMT__POOL_QUERY_AGGREGATION_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_query_aggregation_req__get_packed_size
+                     (const Mgmt__PoolQueryAggregationReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_aggregation_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_query_aggregation_req__pack
+                     (const Mgmt__PoolQueryAggregationReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_aggregation_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_query_aggregation_req__pack_to_buffer
+                     (const Mgmt__PoolQueryAggregationReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_aggregation_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolQueryAggregationReq *
+       mgmt__pool_query_aggregation_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolQueryAggregationReq *)
+     protobuf_c_message_unpack (&mgmt__pool_query_aggregation_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_query_aggregation_req__free_unpacked
+                     (Mgmt__PoolQueryAggregationReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_query_aggregation_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_query_aggregation_resp__rank__init
+                     (Mgmt__PoolQueryAggregationResp__Rank         *message)
+{
+  static const Mgmt__PoolQueryAggregationResp__Rank init_value = MGMT__POOL_QUERY_AGGREGATION_RESP__RANK__INIT;
+  *message = init_value;
+}
+void   mgmt__pool_query_aggregation_resp__init
+                     (Mgmt__PoolQueryAggregationResp         *message)
+{
+  static const Mgmt__PoolQueryAggregationResp init_value = MGMT__POOL_QUERY_AGGREGATION_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_query_aggregation_resp__get_packed_size
+                     (const Mgmt__PoolQueryAggregationResp *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_aggregation_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_query_aggregation_resp__pack
+                     (const Mgmt__PoolQueryAggregationResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_aggregation_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_query_aggregation_resp__pack_to_buffer
+                     (const Mgmt__PoolQueryAggregationResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_aggregation_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolQueryAggregationResp *
+       mgmt__pool_query_aggregation_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolQueryAggregationResp *)
+     protobuf_c_message_unpack (&mgmt__pool_query_aggregation_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_query_aggregation_resp__free_unpacked
+                     (Mgmt__PoolQueryAggregationResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_query_aggregation_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   mgmt__pool_query_target_req__init
                      (Mgmt__PoolQueryTargetReq         *message)
 {
@@ -3189,7 +3375,7 @@ const ProtobufCEnumDescriptor mgmt__pool_rebuild_status__state__descriptor =
   mgmt__pool_rebuild_status__state__value_ranges,
   NULL,NULL,NULL,NULL   /* reserved[1234] */
 };
-static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descriptors[4] =
+static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descriptors[9] =
 {
   {
     "status",
@@ -3239,17 +3425,82 @@ static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descripto
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "version",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, version),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "seconds",
+    6,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, seconds),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "total_objects",
+    7,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, total_objects),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "size",
+    8,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, size),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "fail_rank",
+    9,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, fail_rank),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__pool_rebuild_status__field_indices_by_name[] = {
+  8,   /* field[8] = fail_rank */
   2,   /* field[2] = objects */
   3,   /* field[3] = records */
+  5,   /* field[5] = seconds */
+  7,   /* field[7] = size */
   1,   /* field[1] = state */
   0,   /* field[0] = status */
+  6,   /* field[6] = total_objects */
+  4,   /* field[4] = version */
 };
 static const ProtobufCIntRange mgmt__pool_rebuild_status__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 4 }
+  { 0, 9 }
 };
 const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor =
 {
@@ -3259,7 +3510,7 @@ const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor =
   "Mgmt__PoolRebuildStatus",
   "mgmt",
   sizeof(Mgmt__PoolRebuildStatus),
-  4,
+  9,
   mgmt__pool_rebuild_status__field_descriptors,
   mgmt__pool_rebuild_status__field_indices_by_name,
   1,  mgmt__pool_rebuild_status__number_ranges,
@@ -3896,6 +4147,421 @@ const ProtobufCMessageDescriptor mgmt__pool_upgrade_resp__descriptor =
   (ProtobufCMessageInit) mgmt__pool_upgrade_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCEnumValue mgmt__pool_set_policy_req__aggregation__enum_values_by_number[3] =
+{
+  { "UNCHANGED", "MGMT__POOL_SET_POLICY_REQ__AGGREGATION__UNCHANGED", 0 },
+  { "ENABLED", "MGMT__POOL_SET_POLICY_REQ__AGGREGATION__ENABLED", 1 },
+  { "DISABLED", "MGMT__POOL_SET_POLICY_REQ__AGGREGATION__DISABLED", 2 },
+};
+static const ProtobufCIntRange mgmt__pool_set_policy_req__aggregation__value_ranges[] = {
+{0, 0},{0, 3}
+};
+static const ProtobufCEnumValueIndex mgmt__pool_set_policy_req__aggregation__enum_values_by_name[3] =
+{
+  { "DISABLED", 2 },
+  { "ENABLED", 1 },
+  { "UNCHANGED", 0 },
+};
+const ProtobufCEnumDescriptor mgmt__pool_set_policy_req__aggregation__descriptor =
+{
+  PROTOBUF_C__ENUM_DESCRIPTOR_MAGIC,
+  "mgmt.PoolSetPolicyReq.Aggregation",
+  "Aggregation",
+  "Mgmt__PoolSetPolicyReq__Aggregation",
+  "mgmt",
+  3,
+  mgmt__pool_set_policy_req__aggregation__enum_values_by_number,
+  3,
+  mgmt__pool_set_policy_req__aggregation__enum_values_by_name,
+  1,
+  mgmt__pool_set_policy_req__aggregation__value_ranges,
+  NULL,NULL,NULL,NULL   /* reserved[1234] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_set_policy_req__field_descriptors[8] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolSetPolicyReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolSetPolicyReq, id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolSetPolicyReq, n_svc_ranks),   /* quantifier_offset */
+    offsetof(Mgmt__PoolSetPolicyReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "ranks",
+    4,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolSetPolicyReq, n_ranks),   /* quantifier_offset */
+    offsetof(Mgmt__PoolSetPolicyReq, ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "aggregation",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_ENUM,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolSetPolicyReq, aggregation),
+    &mgmt__pool_set_policy_req__aggregation__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "rebuild_throttle",
+    6,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolSetPolicyReq, rebuild_throttle),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "scrub_rate",
+    7,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolSetPolicyReq, scrub_rate),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "clear",
+    8,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolSetPolicyReq, clear),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_set_policy_req__field_indices_by_name[] = {
+  4,   /* field[4] = aggregation */
+  7,   /* field[7] = clear */
+  1,   /* field[1] = id */
+  3,   /* field[3] = ranks */
+  5,   /* field[5] = rebuild_throttle */
+  6,   /* field[6] = scrub_rate */
+  2,   /* field[2] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__pool_set_policy_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 8 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_set_policy_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolSetPolicyReq",
+  "PoolSetPolicyReq",
+  "Mgmt__PoolSetPolicyReq",
+  "mgmt",
+  sizeof(Mgmt__PoolSetPolicyReq),
+  8,
+  mgmt__pool_set_policy_req__field_descriptors,
+  mgmt__pool_set_policy_req__field_indices_by_name,
+  1,  mgmt__pool_set_policy_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_set_policy_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_set_policy_resp__field_descriptors[1] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolSetPolicyResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_set_policy_resp__field_indices_by_name[] = {
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__pool_set_policy_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_set_policy_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolSetPolicyResp",
+  "PoolSetPolicyResp",
+  "Mgmt__PoolSetPolicyResp",
+  "mgmt",
+  sizeof(Mgmt__PoolSetPolicyResp),
+  1,
+  mgmt__pool_set_policy_resp__field_descriptors,
+  mgmt__pool_set_policy_resp__field_indices_by_name,
+  1,  mgmt__pool_set_policy_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_set_policy_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_query_aggregation_req__field_descriptors[4] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryAggregationReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryAggregationReq, id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolQueryAggregationReq, n_svc_ranks),
+    offsetof(Mgmt__PoolQueryAggregationReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "ranks",
+    4,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolQueryAggregationReq, n_ranks),
+    offsetof(Mgmt__PoolQueryAggregationReq, ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_query_aggregation_req__field_indices_by_name[] = {
+  1,   /* field[1] = id */
+  3,   /* field[3] = ranks */
+  2,   /* field[2] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__pool_query_aggregation_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_query_aggregation_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolQueryAggregationReq",
+  "PoolQueryAggregationReq",
+  "Mgmt__PoolQueryAggregationReq",
+  "mgmt",
+  sizeof(Mgmt__PoolQueryAggregationReq),
+  4,
+  mgmt__pool_query_aggregation_req__field_descriptors,
+  mgmt__pool_query_aggregation_req__field_indices_by_name,
+  1,  mgmt__pool_query_aggregation_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_query_aggregation_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_query_aggregation_resp__rank__field_descriptors[4] =
+{
+  {
+    "rank",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryAggregationResp__Rank, rank),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "state",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryAggregationResp__Rank, state),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "lag",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryAggregationResp__Rank, lag),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "last_run",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryAggregationResp__Rank, last_run),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_query_aggregation_resp__rank__field_indices_by_name[] = {
+  2,   /* field[2] = lag */
+  3,   /* field[3] = last_run */
+  0,   /* field[0] = rank */
+  1,   /* field[1] = state */
+};
+static const ProtobufCIntRange mgmt__pool_query_aggregation_resp__rank__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_query_aggregation_resp__rank__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolQueryAggregationResp.Rank",
+  "Rank",
+  "Mgmt__PoolQueryAggregationResp__Rank",
+  "mgmt",
+  sizeof(Mgmt__PoolQueryAggregationResp__Rank),
+  4,
+  mgmt__pool_query_aggregation_resp__rank__field_descriptors,
+  mgmt__pool_query_aggregation_resp__rank__field_indices_by_name,
+  1,  mgmt__pool_query_aggregation_resp__rank__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_query_aggregation_resp__rank__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_query_aggregation_resp__field_descriptors[3] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryAggregationResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "uuid",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryAggregationResp, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "ranks",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Mgmt__PoolQueryAggregationResp, n_ranks),
+    offsetof(Mgmt__PoolQueryAggregationResp, ranks),
+    &mgmt__pool_query_aggregation_resp__rank__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_query_aggregation_resp__field_indices_by_name[] = {
+  2,   /* field[2] = ranks */
+  0,   /* field[0] = status */
+  1,   /* field[1] = uuid */
+};
+static const ProtobufCIntRange mgmt__pool_query_aggregation_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_query_aggregation_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolQueryAggregationResp",
+  "PoolQueryAggregationResp",
+  "Mgmt__PoolQueryAggregationResp",
+  "mgmt",
+  sizeof(Mgmt__PoolQueryAggregationResp),
+  3,
+  mgmt__pool_query_aggregation_resp__field_descriptors,
+  mgmt__pool_query_aggregation_resp__field_indices_by_name,
+  1,  mgmt__pool_query_aggregation_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_query_aggregation_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCFieldDescriptor mgmt__pool_query_target_req__field_descriptors[5] =
 {
   {
@@ -46,6 +46,11 @@ typedef struct _Mgmt__PoolGetPropReq Mgmt__PoolGetPropReq;
 typedef struct _Mgmt__PoolGetPropResp Mgmt__PoolGetPropResp;
 typedef struct _Mgmt__PoolUpgradeReq Mgmt__PoolUpgradeReq;
 typedef struct _Mgmt__PoolUpgradeResp Mgmt__PoolUpgradeResp;
+typedef struct _Mgmt__PoolSetPolicyReq Mgmt__PoolSetPolicyReq;
+typedef struct _Mgmt__PoolSetPolicyResp Mgmt__PoolSetPolicyResp;
+typedef struct _Mgmt__PoolQueryAggregationReq Mgmt__PoolQueryAggregationReq;
+typedef struct _Mgmt__PoolQueryAggregationResp Mgmt__PoolQueryAggregationResp;
+typedef struct _Mgmt__PoolQueryAggregationResp__Rank Mgmt__PoolQueryAggregationResp__Rank;
 typedef struct _Mgmt__PoolQueryTargetReq Mgmt__PoolQueryTargetReq;
 typedef struct _Mgmt__StorageTargetUsage Mgmt__StorageTargetUsage;
 typedef struct _Mgmt__PoolQueryTargetInfo Mgmt__PoolQueryTargetInfo;
@@ -60,6 +65,18 @@ typedef enum _Mgmt__PoolRebuildStatus__State {
   MGMT__POOL_REBUILD_STATUS__STATE__BUSY = 2
     PROTOBUF_C__FORCE_ENUM_TO_BE_INT_SIZE(MGMT__POOL_REBUILD_STATUS__STATE)
 } Mgmt__PoolRebuildStatus__State;
+typedef enum _Mgmt__PoolSetPolicyReq__Aggregation {
+  MGMT__POOL_SET_POLICY_REQ__AGGREGATION__UNCHANGED = 0,
+  /*
+   * Run aggregation as configured by the reclaim property
+   */
+  MGMT__POOL_SET_POLICY_REQ__AGGREGATION__ENABLED = 1,
+  /*
+   * Pause aggregation
+   */
+  MGMT__POOL_SET_POLICY_REQ__AGGREGATION__DISABLED = 2
+    PROTOBUF_C__FORCE_ENUM_TO_BE_INT_SIZE(MGMT__POOL_SET_POLICY_REQ__AGGREGATION)
+} Mgmt__PoolSetPolicyReq__Aggregation;
 typedef enum _Mgmt__PoolQueryTargetInfo__TargetType {
   MGMT__POOL_QUERY_TARGET_INFO__TARGET_TYPE__UNKNOWN = 0,
   /*
@@ -738,12 +755,38 @@ struct  _Mgmt__PoolRebuildStatus
    */
   int32_t status;
   Mgmt__PoolRebuildStatus__State state;
+  /*
+   * objects rebuilt so far
+   */
   uint64_t objects;
+  /*
+   * records rebuilt so far
+   */
   uint64_t records;
+  /*
+   * pool map version of the current or last rebuild
+   */
+  uint32_t version;
+  /*
+   * duration of the current or last rebuild
+   */
+  uint32_t seconds;
+  /*
+   * objects to be rebuilt, grows while busy
+   */
+  uint64_t total_objects;
+  /*
+   * bytes of data rebuilt
+   */
+  uint64_t size;
+  /*
+   * rank on which rebuild failed, if status is nonzero
+   */
+  int32_t fail_rank;
 };
 #define MGMT__POOL_REBUILD_STATUS__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_rebuild_status__descriptor) \
-    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0 }
+    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0, 0, 0, 0, 0, 0 }
 
 
 /*
@@ -977,6 +1020,149 @@ struct  _Mgmt__PoolUpgradeResp
     , 0 }
 
 
+/*
+ * PoolSetPolicyReq supplies runtime hints for the scheduling of engine
+ * background tasks on a pool's targets. The hints are not persistent and are
+ * lost when an engine is restarted.
+ */
+struct  _Mgmt__PoolSetPolicyReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * uuid or label of pool
+   */
+  char *id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+  /*
+   * Apply to targets on these ranks only (all if empty)
+   */
+  size_t n_ranks;
+  uint32_t *ranks;
+  Mgmt__PoolSetPolicyReq__Aggregation aggregation;
+  /*
+   * Percent of engine time available to rebuild (1-100, 0=unchanged)
+   */
+  uint32_t rebuild_throttle;
+  /*
+   * Percent of the configured scrubbing rate (1-100, 0=unchanged)
+   */
+  uint32_t scrub_rate;
+  /*
+   * Clear all hints before applying any new ones
+   */
+  protobuf_c_boolean clear;
+};
+#define MGMT__POOL_SET_POLICY_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_set_policy_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, MGMT__POOL_SET_POLICY_REQ__AGGREGATION__UNCHANGED, 0, 0, 0 }
+
+
+/*
+ * PoolSetPolicyResp returns the status of a pool policy update.
+ */
+struct  _Mgmt__PoolSetPolicyResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+};
+#define MGMT__POOL_SET_POLICY_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_set_policy_resp__descriptor) \
+    , 0 }
+
+
+/*
+ * PoolQueryAggregationReq requests the status of background aggregation on
+ * a pool's targets.
+ */
+struct  _Mgmt__PoolQueryAggregationReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * uuid or label of pool
+   */
+  char *id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+  /*
+   * engine ranks hosting the pool's targets
+   */
+  size_t n_ranks;
+  uint32_t *ranks;
+};
+#define MGMT__POOL_QUERY_AGGREGATION_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_query_aggregation_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL }
+
+
+struct  _Mgmt__PoolQueryAggregationResp__Rank
+{
+  ProtobufCMessage base;
+  /*
+   * engine rank
+   */
+  uint32_t rank;
+  /*
+   * aggregation state (idle, running, paused)
+   */
+  char *state;
+  /*
+   * seconds the oldest aggregated epoch trails the current time
+   */
+  uint64_t lag;
+  /*
+   * completion time of the last aggregation pass in seconds since the epoch (0=never)
+   */
+  uint64_t last_run;
+};
+#define MGMT__POOL_QUERY_AGGREGATION_RESP__RANK__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_query_aggregation_resp__rank__descriptor) \
+    , 0, (char *)protobuf_c_empty_string, 0, 0 }
+
+
+/*
+ * PoolQueryAggregationResp returns the aggregation status of the pool's
+ * targets on each engine rank.
+ */
+struct  _Mgmt__PoolQueryAggregationResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * pool uuid
+   */
+  char *uuid;
+  /*
+   * per-rank aggregation status
+   */
+  size_t n_ranks;
+  Mgmt__PoolQueryAggregationResp__Rank **ranks;
+};
+#define MGMT__POOL_QUERY_AGGREGATION_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_query_aggregation_resp__descriptor) \
+    , 0, (char *)protobuf_c_empty_string, 0,NULL }
+
+
 /*
  * PoolQueryTargetReq represents a pool query target(s) request.
  */
@@ -1642,6 +1828,85 @@ Mgmt__PoolUpgradeResp *
 void   mgmt__pool_upgrade_resp__free_unpacked
                      (Mgmt__PoolUpgradeResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__PoolSetPolicyReq methods */
+void   mgmt__pool_set_policy_req__init
+                     (Mgmt__PoolSetPolicyReq         *message);
+size_t mgmt__pool_set_policy_req__get_packed_size
+                     (const Mgmt__PoolSetPolicyReq   *message);
+size_t mgmt__pool_set_policy_req__pack
+                     (const Mgmt__PoolSetPolicyReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_set_policy_req__pack_to_buffer
+                     (const Mgmt__PoolSetPolicyReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolSetPolicyReq *
+       mgmt__pool_set_policy_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_set_policy_req__free_unpacked
+                     (Mgmt__PoolSetPolicyReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolSetPolicyResp methods */
+void   mgmt__pool_set_policy_resp__init
+                     (Mgmt__PoolSetPolicyResp         *message);
+size_t mgmt__pool_set_policy_resp__get_packed_size
+                     (const Mgmt__PoolSetPolicyResp   *message);
+size_t mgmt__pool_set_policy_resp__pack
+                     (const Mgmt__PoolSetPolicyResp   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_set_policy_resp__pack_to_buffer
+                     (const Mgmt__PoolSetPolicyResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolSetPolicyResp *
+       mgmt__pool_set_policy_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_set_policy_resp__free_unpacked
+                     (Mgmt__PoolSetPolicyResp *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolQueryAggregationReq methods */
+void   mgmt__pool_query_aggregation_req__init
+                     (Mgmt__PoolQueryAggregationReq         *message);
+size_t mgmt__pool_query_aggregation_req__get_packed_size
+                     (const Mgmt__PoolQueryAggregationReq   *message);
+size_t mgmt__pool_query_aggregation_req__pack
+                     (const Mgmt__PoolQueryAggregationReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_query_aggregation_req__pack_to_buffer
+                     (const Mgmt__PoolQueryAggregationReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolQueryAggregationReq *
+       mgmt__pool_query_aggregation_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_query_aggregation_req__free_unpacked
+                     (Mgmt__PoolQueryAggregationReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolQueryAggregationResp__Rank methods */
+void   mgmt__pool_query_aggregation_resp__rank__init
+                     (Mgmt__PoolQueryAggregationResp__Rank         *message);
+/* Mgmt__PoolQueryAggregationResp methods */
+void   mgmt__pool_query_aggregation_resp__init
+                     (Mgmt__PoolQueryAggregationResp         *message);
+size_t mgmt__pool_query_aggregation_resp__get_packed_size
+                     (const Mgmt__PoolQueryAggregationResp   *message);
+size_t mgmt__pool_query_aggregation_resp__pack
+                     (const Mgmt__PoolQueryAggregationResp   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_query_aggregation_resp__pack_to_buffer
+                     (const Mgmt__PoolQueryAggregationResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolQueryAggregationResp *
+       mgmt__pool_query_aggregation_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_query_aggregation_resp__free_unpacked
+                     (Mgmt__PoolQueryAggregationResp *message,
+                      ProtobufCAllocator *allocator);
 /* Mgmt__PoolQueryTargetReq methods */
 void   mgmt__pool_query_target_req__init
                      (Mgmt__PoolQueryTargetReq         *message);
@@ -1813,6 +2078,21 @@ typedef void (*Mgmt__PoolUpgradeReq_Closure)
 typedef void (*Mgmt__PoolUpgradeResp_Closure)
                  (const Mgmt__PoolUpgradeResp *message,
                   void *closure_data);
+typedef void (*Mgmt__PoolSetPolicyReq_Closure)
+                 (const Mgmt__PoolSetPolicyReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolSetPolicyResp_Closure)
+                 (const Mgmt__PoolSetPolicyResp *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolQueryAggregationReq_Closure)
+                 (const Mgmt__PoolQueryAggregationReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolQueryAggregationResp__Rank_Closure)
+                 (const Mgmt__PoolQueryAggregationResp__Rank *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolQueryAggregationResp_Closure)
+                 (const Mgmt__PoolQueryAggregationResp *message,
+                  void *closure_data);
 typedef void (*Mgmt__PoolQueryTargetReq_Closure)
                  (const Mgmt__PoolQueryTargetReq *message,
                   void *closure_data);
@@ -1864,6 +2144,12 @@ extern const ProtobufCMessageDescriptor mgmt__pool_get_prop_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_get_prop_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_upgrade_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_upgrade_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_set_policy_req__descriptor;
+extern const ProtobufCEnumDescriptor    mgmt__pool_set_policy_req__aggregation__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_set_policy_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_query_aggregation_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_query_aggregation_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_query_aggregation_resp__rank__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_query_target_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__storage_target_usage__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_query_target_info__descriptor;
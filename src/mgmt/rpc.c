@@ -20,6 +20,15 @@ crt_proc_struct_server_entry(crt_proc_t proc, crt_proc_op_t proc_op,
 	return crt_proc_server_entry(proc, data);
 }
 
+CRT_GEN_PROC_FUNC(mgmt_tgt_agg_status, DAOS_SEQ_MGMT_TGT_AGG_STATUS);
+
+static int
+crt_proc_struct_mgmt_tgt_agg_status(crt_proc_t proc, crt_proc_op_t proc_op,
+				    struct mgmt_tgt_agg_status *data)
+{
+	return crt_proc_mgmt_tgt_agg_status(proc, data);
+}
+
 CRT_RPC_DEFINE(mgmt_svc_rip, DAOS_ISEQ_MGMT_SVR_RIP, DAOS_OSEQ_MGMT_SVR_RIP)
 CRT_RPC_DEFINE(mgmt_params_set, DAOS_ISEQ_MGMT_PARAMS_SET,
 		DAOS_OSEQ_MGMT_PARAMS_SET)
@@ -40,6 +49,12 @@ CRT_RPC_DEFINE(mgmt_tgt_params_set, DAOS_ISEQ_MGMT_TGT_PARAMS_SET,
 		DAOS_OSEQ_MGMT_TGT_PARAMS_SET)
 CRT_RPC_DEFINE(mgmt_tgt_map_update, DAOS_ISEQ_MGMT_TGT_MAP_UPDATE,
 		DAOS_OSEQ_MGMT_TGT_MAP_UPDATE)
+CRT_RPC_DEFINE(mgmt_tgt_agg_query, DAOS_ISEQ_MGMT_TGT_AGG_QUERY,
+		DAOS_OSEQ_MGMT_TGT_AGG_QUERY)
+CRT_RPC_DEFINE(mgmt_tgt_policy_set, DAOS_ISEQ_MGMT_TGT_POLICY_SET,
+		DAOS_OSEQ_MGMT_TGT_POLICY_SET)
+CRT_RPC_DEFINE(mgmt_tgt_cont_check, DAOS_ISEQ_MGMT_TGT_CONT_CHECK,
+		DAOS_OSEQ_MGMT_TGT_CONT_CHECK)
 
 CRT_RPC_DEFINE(mgmt_get_bs_state, DAOS_ISEQ_MGMT_GET_BS_STATE,
 	       DAOS_OSEQ_MGMT_GET_BS_STATE)
@@ -64,7 +64,19 @@
 		&ds_mgmt_hdlr_tgt_map_update_co_ops),			\
 	X(MGMT_TGT_MARK,						\
 		0, &CQF_mgmt_mark,					\
-		ds_mgmt_tgt_mark_hdlr, NULL)
+		ds_mgmt_tgt_mark_hdlr, NULL),				\
+	X(MGMT_TGT_AGG_QUERY,						\
+		0, &CQF_mgmt_tgt_agg_query,				\
+		ds_mgmt_hdlr_tgt_agg_query,				\
+		&ds_mgmt_hdlr_tgt_agg_query_co_ops),			\
+	X(MGMT_TGT_POLICY_SET,						\
+		0, &CQF_mgmt_tgt_policy_set,				\
+		ds_mgmt_hdlr_tgt_policy_set,				\
+		&ds_mgmt_hdlr_tgt_policy_set_co_ops),			\
+	X(MGMT_TGT_CONT_CHECK,						\
+		0, &CQF_mgmt_tgt_cont_check,				\
+		ds_mgmt_hdlr_tgt_cont_check,				\
+		&ds_mgmt_hdlr_tgt_cont_check_co_ops)
 
 
 
@@ -203,6 +215,60 @@ CRT_RPC_DECLARE(mgmt_tgt_map_update, DAOS_ISEQ_MGMT_TGT_MAP_UPDATE,
 
 CRT_RPC_DECLARE(mgmt_mark, DAOS_ISEQ_MGMT_MARK, DAOS_OSEQ_MGMT_MARK)
 
+/* Aggregation state of a pool's targets on an engine rank */
+enum mgmt_tgt_agg_state {
+	MGMT_TGT_AGG_IDLE = 0,
+	MGMT_TGT_AGG_RUNNING,
+	MGMT_TGT_AGG_PAUSED,
+};
+
+#define DAOS_SEQ_MGMT_TGT_AGG_STATUS \
+	((d_rank_t)		(tas_rank)		CRT_VAR) \
+	((uint32_t)		(tas_state)		CRT_VAR) \
+	((uint64_t)		(tas_lag)		CRT_VAR) \
+	((uint64_t)		(tas_last_run)		CRT_VAR)
+
+CRT_GEN_STRUCT(mgmt_tgt_agg_status, DAOS_SEQ_MGMT_TGT_AGG_STATUS);
+
+#define DAOS_ISEQ_MGMT_TGT_AGG_QUERY /* input fields */	 \
+	((uuid_t)		(ta_pool_uuid)		CRT_VAR)
+
+#define DAOS_OSEQ_MGMT_TGT_AGG_QUERY /* output fields */	 \
+	((struct mgmt_tgt_agg_status) (ta_status)	CRT_ARRAY) \
+	((int32_t)		(ta_rc)			CRT_VAR)
+
+CRT_RPC_DECLARE(mgmt_tgt_agg_query, DAOS_ISEQ_MGMT_TGT_AGG_QUERY,
+		DAOS_OSEQ_MGMT_TGT_AGG_QUERY)
+
+/* Runtime hints for the scheduling of a pool's background tasks */
+#define DAOS_ISEQ_MGMT_TGT_POLICY_SET /* input fields */	 \
+	((uuid_t)		(tp_pool_uuid)		CRT_VAR) \
+	((uint32_t)		(tp_aggregation)	CRT_VAR) \
+	((uint32_t)		(tp_rebuild_ratio)	CRT_VAR) \
+	((uint32_t)		(tp_scrub_rate)		CRT_VAR) \
+	((uint32_t)		(tp_clear)		CRT_VAR)
+
+#define DAOS_OSEQ_MGMT_TGT_POLICY_SET /* output fields */	 \
+	((int32_t)		(tp_rc)			CRT_VAR)
+
+CRT_RPC_DECLARE(mgmt_tgt_policy_set, DAOS_ISEQ_MGMT_TGT_POLICY_SET,
+		DAOS_OSEQ_MGMT_TGT_POLICY_SET)
+
+/*
+ * Destroy the listed VOS containers of a pool, then return the containers
+ * that are left on the targets.
+ */
+#define DAOS_ISEQ_MGMT_TGT_CONT_CHECK /* input fields */	 \
+	((uuid_t)		(tc_pool_uuid)		CRT_VAR) \
+	((uuid_t)		(tc_destroy)		CRT_ARRAY)
+
+#define DAOS_OSEQ_MGMT_TGT_CONT_CHECK /* output fields */	 \
+	((uuid_t)		(tc_conts)		CRT_ARRAY) \
+	((int32_t)		(tc_rc)			CRT_VAR)
+
+CRT_RPC_DECLARE(mgmt_tgt_cont_check, DAOS_ISEQ_MGMT_TGT_CONT_CHECK,
+		DAOS_OSEQ_MGMT_TGT_CONT_CHECK)
+
 /* Get Blobstore State */
 #define DAOS_ISEQ_MGMT_GET_BS_STATE /* input fields */		 \
 	((uuid_t)		(bs_uuid)		CRT_VAR)
@@ -232,6 +232,243 @@ void   ctl__smd_dev_resp__free_unpacked
   assert(message->base.descriptor == &ctl__smd_dev_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   ctl__blobstore_usage_req__init
+                     (Ctl__BlobstoreUsageReq         *message)
+{
+  static const Ctl__BlobstoreUsageReq init_value = CTL__BLOBSTORE_USAGE_REQ__INIT;
+  *message = init_value;
+}
+size_t ctl__blobstore_usage_req__get_packed_size
+                     (const Ctl__BlobstoreUsageReq *message)
+{
+  assert(message->base.descriptor == &ctl__blobstore_usage_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t ctl__blobstore_usage_req__pack
+                     (const Ctl__BlobstoreUsageReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &ctl__blobstore_usage_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t ctl__blobstore_usage_req__pack_to_buffer
+                     (const Ctl__BlobstoreUsageReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &ctl__blobstore_usage_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Ctl__BlobstoreUsageReq *
+       ctl__blobstore_usage_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Ctl__BlobstoreUsageReq *)
+     protobuf_c_message_unpack (&ctl__blobstore_usage_req__descriptor,
+                                allocator, len, data);
+}
+void   ctl__blobstore_usage_req__free_unpacked
+                     (Ctl__BlobstoreUsageReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &ctl__blobstore_usage_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   ctl__blobstore_usage__target__init
+                     (Ctl__BlobstoreUsage__Target         *message)
+{
+  static const Ctl__BlobstoreUsage__Target init_value = CTL__BLOBSTORE_USAGE__TARGET__INIT;
+  *message = init_value;
+}
+void   ctl__blobstore_usage__init
+                     (Ctl__BlobstoreUsage         *message)
+{
+  static const Ctl__BlobstoreUsage init_value = CTL__BLOBSTORE_USAGE__INIT;
+  *message = init_value;
+}
+size_t ctl__blobstore_usage__get_packed_size
+                     (const Ctl__BlobstoreUsage *message)
+{
+  assert(message->base.descriptor == &ctl__blobstore_usage__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t ctl__blobstore_usage__pack
+                     (const Ctl__BlobstoreUsage *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &ctl__blobstore_usage__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t ctl__blobstore_usage__pack_to_buffer
+                     (const Ctl__BlobstoreUsage *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &ctl__blobstore_usage__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Ctl__BlobstoreUsage *
+       ctl__blobstore_usage__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Ctl__BlobstoreUsage *)
+     protobuf_c_message_unpack (&ctl__blobstore_usage__descriptor,
+                                allocator, len, data);
+}
+void   ctl__blobstore_usage__free_unpacked
+                     (Ctl__BlobstoreUsage *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &ctl__blobstore_usage__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   ctl__blobstore_usage_resp__init
+                     (Ctl__BlobstoreUsageResp         *message)
+{
+  static const Ctl__BlobstoreUsageResp init_value = CTL__BLOBSTORE_USAGE_RESP__INIT;
+  *message = init_value;
+}
+size_t ctl__blobstore_usage_resp__get_packed_size
+                     (const Ctl__BlobstoreUsageResp *message)
+{
+  assert(message->base.descriptor == &ctl__blobstore_usage_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t ctl__blobstore_usage_resp__pack
+                     (const Ctl__BlobstoreUsageResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &ctl__blobstore_usage_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t ctl__blobstore_usage_resp__pack_to_buffer
+                     (const Ctl__BlobstoreUsageResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &ctl__blobstore_usage_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Ctl__BlobstoreUsageResp *
+       ctl__blobstore_usage_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Ctl__BlobstoreUsageResp *)
+     protobuf_c_message_unpack (&ctl__blobstore_usage_resp__descriptor,
+                                allocator, len, data);
+}
+void   ctl__blobstore_usage_resp__free_unpacked
+                     (Ctl__BlobstoreUsageResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &ctl__blobstore_usage_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   ctl__blobstore_query_req__init
+                     (Ctl__BlobstoreQueryReq         *message)
+{
+  static const Ctl__BlobstoreQueryReq init_value = CTL__BLOBSTORE_QUERY_REQ__INIT;
+  *message = init_value;
+}
+size_t ctl__blobstore_query_req__get_packed_size
+                     (const Ctl__BlobstoreQueryReq *message)
+{
+  assert(message->base.descriptor == &ctl__blobstore_query_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t ctl__blobstore_query_req__pack
+                     (const Ctl__BlobstoreQueryReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &ctl__blobstore_query_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t ctl__blobstore_query_req__pack_to_buffer
+                     (const Ctl__BlobstoreQueryReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &ctl__blobstore_query_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Ctl__BlobstoreQueryReq *
+       ctl__blobstore_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Ctl__BlobstoreQueryReq *)
+     protobuf_c_message_unpack (&ctl__blobstore_query_req__descriptor,
+                                allocator, len, data);
+}
+void   ctl__blobstore_query_req__free_unpacked
+                     (Ctl__BlobstoreQueryReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &ctl__blobstore_query_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   ctl__blobstore_query_resp__rank_resp__init
+                     (Ctl__BlobstoreQueryResp__RankResp         *message)
+{
+  static const Ctl__BlobstoreQueryResp__RankResp init_value = CTL__BLOBSTORE_QUERY_RESP__RANK_RESP__INIT;
+  *message = init_value;
+}
+void   ctl__blobstore_query_resp__init
+                     (Ctl__BlobstoreQueryResp         *message)
+{
+  static const Ctl__BlobstoreQueryResp init_value = CTL__BLOBSTORE_QUERY_RESP__INIT;
+  *message = init_value;
+}
+size_t ctl__blobstore_query_resp__get_packed_size
+                     (const Ctl__BlobstoreQueryResp *message)
+{
+  assert(message->base.descriptor == &ctl__blobstore_query_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t ctl__blobstore_query_resp__pack
+                     (const Ctl__BlobstoreQueryResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &ctl__blobstore_query_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t ctl__blobstore_query_resp__pack_to_buffer
+                     (const Ctl__BlobstoreQueryResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &ctl__blobstore_query_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Ctl__BlobstoreQueryResp *
+       ctl__blobstore_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Ctl__BlobstoreQueryResp *)
+     protobuf_c_message_unpack (&ctl__blobstore_query_resp__descriptor,
+                                allocator, len, data);
+}
+void   ctl__blobstore_query_resp__free_unpacked
+                     (Ctl__BlobstoreQueryResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &ctl__blobstore_query_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   ctl__smd_pool_req__init
                      (Ctl__SmdPoolReq         *message)
 {
@@ -769,7 +1006,7 @@ const ProtobufCMessageDescriptor ctl__bio_health_req__descriptor =
   (ProtobufCMessageInit) ctl__bio_health_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor ctl__bio_health_resp__field_descriptors[43] =
+static const ProtobufCFieldDescriptor ctl__bio_health_resp__field_descriptors[46] =
 {
   {
     "timestamp",
@@ -1287,9 +1524,47 @@ static const ProtobufCFieldDescriptor ctl__bio_health_resp__field_descriptors[43
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "avail_spare",
+    47,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BioHealthResp, avail_spare),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "avail_spare_threshold",
+    48,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BioHealthResp, avail_spare_threshold),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "percentage_used",
+    49,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BioHealthResp, percentage_used),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned ctl__bio_health_resp__field_indices_by_name[] = {
   22,   /* field[22] = avail_bytes */
+  43,   /* field[43] = avail_spare */
+  44,   /* field[44] = avail_spare_threshold */
   15,   /* field[15] = avail_spare_warn */
   9,   /* field[9] = bio_read_errs */
   11,   /* field[11] = bio_unmap_errs */
@@ -1310,6 +1585,7 @@ static const unsigned ctl__bio_health_resp__field_indices_by_name[] = {
   7,   /* field[7] = media_errs */
   33,   /* field[33] = media_wear_raw */
   40,   /* field[40] = nand_bytes_written */
+  45,   /* field[45] = percentage_used */
   39,   /* field[39] = pll_lock_loss_cnt */
   4,   /* field[4] = power_cycles */
   5,   /* field[5] = power_on_hours */
@@ -1337,7 +1613,7 @@ static const ProtobufCIntRange ctl__bio_health_resp__number_ranges[2 + 1] =
 {
   { 3, 0 },
   { 5, 1 },
-  { 0, 43 }
+  { 0, 46 }
 };
 const ProtobufCMessageDescriptor ctl__bio_health_resp__descriptor =
 {
@@ -1347,7 +1623,7 @@ const ProtobufCMessageDescriptor ctl__bio_health_resp__descriptor =
   "Ctl__BioHealthResp",
   "ctl",
   sizeof(Ctl__BioHealthResp),
-  43,
+  46,
   ctl__bio_health_resp__field_descriptors,
   ctl__bio_health_resp__field_indices_by_name,
   2,  ctl__bio_health_resp__number_ranges,
@@ -1565,6 +1841,336 @@ const ProtobufCMessageDescriptor ctl__smd_dev_resp__descriptor =
   (ProtobufCMessageInit) ctl__smd_dev_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+#define ctl__blobstore_usage_req__field_descriptors NULL
+#define ctl__blobstore_usage_req__field_indices_by_name NULL
+#define ctl__blobstore_usage_req__number_ranges NULL
+const ProtobufCMessageDescriptor ctl__blobstore_usage_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "ctl.BlobstoreUsageReq",
+  "BlobstoreUsageReq",
+  "Ctl__BlobstoreUsageReq",
+  "ctl",
+  sizeof(Ctl__BlobstoreUsageReq),
+  0,
+  ctl__blobstore_usage_req__field_descriptors,
+  ctl__blobstore_usage_req__field_indices_by_name,
+  0,  ctl__blobstore_usage_req__number_ranges,
+  (ProtobufCMessageInit) ctl__blobstore_usage_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor ctl__blobstore_usage__target__field_descriptors[3] =
+{
+  {
+    "tgt_id",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsage__Target, tgt_id),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "used_clusters",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsage__Target, used_clusters),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "blobs",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsage__Target, blobs),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned ctl__blobstore_usage__target__field_indices_by_name[] = {
+  2,   /* field[2] = blobs */
+  0,   /* field[0] = tgt_id */
+  1,   /* field[1] = used_clusters */
+};
+static const ProtobufCIntRange ctl__blobstore_usage__target__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor ctl__blobstore_usage__target__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "ctl.BlobstoreUsage.Target",
+  "Target",
+  "Ctl__BlobstoreUsage__Target",
+  "ctl",
+  sizeof(Ctl__BlobstoreUsage__Target),
+  3,
+  ctl__blobstore_usage__target__field_descriptors,
+  ctl__blobstore_usage__target__field_indices_by_name,
+  1,  ctl__blobstore_usage__target__number_ranges,
+  (ProtobufCMessageInit) ctl__blobstore_usage__target__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor ctl__blobstore_usage__field_descriptors[5] =
+{
+  {
+    "dev_uuid",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsage, dev_uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "cluster_size",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsage, cluster_size),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "total_clusters",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsage, total_clusters),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "free_clusters",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsage, free_clusters),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "targets",
+    5,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Ctl__BlobstoreUsage, n_targets),   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsage, targets),
+    &ctl__blobstore_usage__target__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned ctl__blobstore_usage__field_indices_by_name[] = {
+  1,   /* field[1] = cluster_size */
+  0,   /* field[0] = dev_uuid */
+  3,   /* field[3] = free_clusters */
+  4,   /* field[4] = targets */
+  2,   /* field[2] = total_clusters */
+};
+static const ProtobufCIntRange ctl__blobstore_usage__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor ctl__blobstore_usage__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "ctl.BlobstoreUsage",
+  "BlobstoreUsage",
+  "Ctl__BlobstoreUsage",
+  "ctl",
+  sizeof(Ctl__BlobstoreUsage),
+  5,
+  ctl__blobstore_usage__field_descriptors,
+  ctl__blobstore_usage__field_indices_by_name,
+  1,  ctl__blobstore_usage__number_ranges,
+  (ProtobufCMessageInit) ctl__blobstore_usage__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor ctl__blobstore_usage_resp__field_descriptors[2] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsageResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "blobstores",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Ctl__BlobstoreUsageResp, n_blobstores),   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreUsageResp, blobstores),
+    &ctl__blobstore_usage__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned ctl__blobstore_usage_resp__field_indices_by_name[] = {
+  1,   /* field[1] = blobstores */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange ctl__blobstore_usage_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor ctl__blobstore_usage_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "ctl.BlobstoreUsageResp",
+  "BlobstoreUsageResp",
+  "Ctl__BlobstoreUsageResp",
+  "ctl",
+  sizeof(Ctl__BlobstoreUsageResp),
+  2,
+  ctl__blobstore_usage_resp__field_descriptors,
+  ctl__blobstore_usage_resp__field_indices_by_name,
+  1,  ctl__blobstore_usage_resp__number_ranges,
+  (ProtobufCMessageInit) ctl__blobstore_usage_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+#define ctl__blobstore_query_req__field_descriptors NULL
+#define ctl__blobstore_query_req__field_indices_by_name NULL
+#define ctl__blobstore_query_req__number_ranges NULL
+const ProtobufCMessageDescriptor ctl__blobstore_query_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "ctl.BlobstoreQueryReq",
+  "BlobstoreQueryReq",
+  "Ctl__BlobstoreQueryReq",
+  "ctl",
+  sizeof(Ctl__BlobstoreQueryReq),
+  0,
+  ctl__blobstore_query_req__field_descriptors,
+  ctl__blobstore_query_req__field_indices_by_name,
+  0,  ctl__blobstore_query_req__number_ranges,
+  (ProtobufCMessageInit) ctl__blobstore_query_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor ctl__blobstore_query_resp__rank_resp__field_descriptors[2] =
+{
+  {
+    "rank",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreQueryResp__RankResp, rank),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "blobstores",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Ctl__BlobstoreQueryResp__RankResp, n_blobstores),   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreQueryResp__RankResp, blobstores),
+    &ctl__blobstore_usage__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned ctl__blobstore_query_resp__rank_resp__field_indices_by_name[] = {
+  1,   /* field[1] = blobstores */
+  0,   /* field[0] = rank */
+};
+static const ProtobufCIntRange ctl__blobstore_query_resp__rank_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor ctl__blobstore_query_resp__rank_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "ctl.BlobstoreQueryResp.RankResp",
+  "RankResp",
+  "Ctl__BlobstoreQueryResp__RankResp",
+  "ctl",
+  sizeof(Ctl__BlobstoreQueryResp__RankResp),
+  2,
+  ctl__blobstore_query_resp__rank_resp__field_descriptors,
+  ctl__blobstore_query_resp__rank_resp__field_indices_by_name,
+  1,  ctl__blobstore_query_resp__rank_resp__number_ranges,
+  (ProtobufCMessageInit) ctl__blobstore_query_resp__rank_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor ctl__blobstore_query_resp__field_descriptors[1] =
+{
+  {
+    "ranks",
+    1,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Ctl__BlobstoreQueryResp, n_ranks),   /* quantifier_offset */
+    offsetof(Ctl__BlobstoreQueryResp, ranks),
+    &ctl__blobstore_query_resp__rank_resp__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned ctl__blobstore_query_resp__field_indices_by_name[] = {
+  0,   /* field[0] = ranks */
+};
+static const ProtobufCIntRange ctl__blobstore_query_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor ctl__blobstore_query_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "ctl.BlobstoreQueryResp",
+  "BlobstoreQueryResp",
+  "Ctl__BlobstoreQueryResp",
+  "ctl",
+  sizeof(Ctl__BlobstoreQueryResp),
+  1,
+  ctl__blobstore_query_resp__field_descriptors,
+  ctl__blobstore_query_resp__field_indices_by_name,
+  1,  ctl__blobstore_query_resp__number_ranges,
+  (ProtobufCMessageInit) ctl__blobstore_query_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 #define ctl__smd_pool_req__field_descriptors NULL
 #define ctl__smd_pool_req__field_indices_by_name NULL
 #define ctl__smd_pool_req__number_ranges NULL
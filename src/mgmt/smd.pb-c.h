@@ -20,6 +20,13 @@ typedef struct _Ctl__BioHealthResp Ctl__BioHealthResp;
 typedef struct _Ctl__SmdDevice Ctl__SmdDevice;
 typedef struct _Ctl__SmdDevReq Ctl__SmdDevReq;
 typedef struct _Ctl__SmdDevResp Ctl__SmdDevResp;
+typedef struct _Ctl__BlobstoreUsageReq Ctl__BlobstoreUsageReq;
+typedef struct _Ctl__BlobstoreUsage Ctl__BlobstoreUsage;
+typedef struct _Ctl__BlobstoreUsage__Target Ctl__BlobstoreUsage__Target;
+typedef struct _Ctl__BlobstoreUsageResp Ctl__BlobstoreUsageResp;
+typedef struct _Ctl__BlobstoreQueryReq Ctl__BlobstoreQueryReq;
+typedef struct _Ctl__BlobstoreQueryResp Ctl__BlobstoreQueryResp;
+typedef struct _Ctl__BlobstoreQueryResp__RankResp Ctl__BlobstoreQueryResp__RankResp;
 typedef struct _Ctl__SmdPoolReq Ctl__SmdPoolReq;
 typedef struct _Ctl__SmdPoolResp Ctl__SmdPoolResp;
 typedef struct _Ctl__SmdPoolResp__Pool Ctl__SmdPoolResp__Pool;
@@ -196,10 +203,25 @@ struct  _Ctl__BioHealthResp
    * blobstore cluster size in bytes
    */
   uint64_t cluster_size;
+  /*
+   * Standard SMART wear and spare attributes
+   */
+  /*
+   * remaining spare capacity, percent
+   */
+  uint32_t avail_spare;
+  /*
+   * spare capacity warning threshold, percent
+   */
+  uint32_t avail_spare_threshold;
+  /*
+   * estimated device life used, percent
+   */
+  uint32_t percentage_used;
 };
 #define CTL__BIO_HEALTH_RESP__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&ctl__bio_health_resp__descriptor) \
-    , 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, (char *)protobuf_c_empty_string, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0 }
+    , 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, (char *)protobuf_c_empty_string, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0 }
 
 
 /*
@@ -273,6 +295,126 @@ struct  _Ctl__SmdDevResp
     , 0, 0,NULL }
 
 
+struct  _Ctl__BlobstoreUsageReq
+{
+  ProtobufCMessage base;
+};
+#define CTL__BLOBSTORE_USAGE_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&ctl__blobstore_usage_req__descriptor) \
+     }
+
+
+struct  _Ctl__BlobstoreUsage__Target
+{
+  ProtobufCMessage base;
+  /*
+   * VOS target ID
+   */
+  int32_t tgt_id;
+  /*
+   * clusters allocated to the target's blobs
+   */
+  uint64_t used_clusters;
+  /*
+   * number of blobs owned by the target
+   */
+  uint32_t blobs;
+};
+#define CTL__BLOBSTORE_USAGE__TARGET__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&ctl__blobstore_usage__target__descriptor) \
+    , 0, 0, 0 }
+
+
+/*
+ * BlobstoreUsage describes the cluster allocation of a SPDK blobstore and
+ * the share of it used by each VOS target.
+ */
+struct  _Ctl__BlobstoreUsage
+{
+  ProtobufCMessage base;
+  /*
+   * UUID of blobstore
+   */
+  char *dev_uuid;
+  /*
+   * blobstore cluster size in bytes
+   */
+  uint64_t cluster_size;
+  /*
+   * total clusters in blobstore
+   */
+  uint64_t total_clusters;
+  /*
+   * unallocated clusters in blobstore
+   */
+  uint64_t free_clusters;
+  /*
+   * per-target usage
+   */
+  size_t n_targets;
+  Ctl__BlobstoreUsage__Target **targets;
+};
+#define CTL__BLOBSTORE_USAGE__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&ctl__blobstore_usage__descriptor) \
+    , (char *)protobuf_c_empty_string, 0, 0, 0, 0,NULL }
+
+
+struct  _Ctl__BlobstoreUsageResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  size_t n_blobstores;
+  Ctl__BlobstoreUsage **blobstores;
+};
+#define CTL__BLOBSTORE_USAGE_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&ctl__blobstore_usage_resp__descriptor) \
+    , 0, 0,NULL }
+
+
+struct  _Ctl__BlobstoreQueryReq
+{
+  ProtobufCMessage base;
+};
+#define CTL__BLOBSTORE_QUERY_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&ctl__blobstore_query_req__descriptor) \
+     }
+
+
+struct  _Ctl__BlobstoreQueryResp__RankResp
+{
+  ProtobufCMessage base;
+  /*
+   * Rank to which this response corresponds
+   */
+  uint32_t rank;
+  /*
+   * List of blobstores on the rank
+   */
+  size_t n_blobstores;
+  Ctl__BlobstoreUsage **blobstores;
+};
+#define CTL__BLOBSTORE_QUERY_RESP__RANK_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&ctl__blobstore_query_resp__rank_resp__descriptor) \
+    , 0, 0,NULL }
+
+
+struct  _Ctl__BlobstoreQueryResp
+{
+  ProtobufCMessage base;
+  /*
+   * List of per-rank responses
+   */
+  size_t n_ranks;
+  Ctl__BlobstoreQueryResp__RankResp **ranks;
+};
+#define CTL__BLOBSTORE_QUERY_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&ctl__blobstore_query_resp__descriptor) \
+    , 0,NULL }
+
+
 struct  _Ctl__SmdPoolReq
 {
   ProtobufCMessage base;
@@ -677,6 +819,107 @@ Ctl__SmdDevResp *
 void   ctl__smd_dev_resp__free_unpacked
                      (Ctl__SmdDevResp *message,
                       ProtobufCAllocator *allocator);
+/* Ctl__BlobstoreUsageReq methods */
+void   ctl__blobstore_usage_req__init
+                     (Ctl__BlobstoreUsageReq         *message);
+size_t ctl__blobstore_usage_req__get_packed_size
+                     (const Ctl__BlobstoreUsageReq   *message);
+size_t ctl__blobstore_usage_req__pack
+                     (const Ctl__BlobstoreUsageReq   *message,
+                      uint8_t             *out);
+size_t ctl__blobstore_usage_req__pack_to_buffer
+                     (const Ctl__BlobstoreUsageReq   *message,
+                      ProtobufCBuffer     *buffer);
+Ctl__BlobstoreUsageReq *
+       ctl__blobstore_usage_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   ctl__blobstore_usage_req__free_unpacked
+                     (Ctl__BlobstoreUsageReq *message,
+                      ProtobufCAllocator *allocator);
+/* Ctl__BlobstoreUsage__Target methods */
+void   ctl__blobstore_usage__target__init
+                     (Ctl__BlobstoreUsage__Target         *message);
+/* Ctl__BlobstoreUsage methods */
+void   ctl__blobstore_usage__init
+                     (Ctl__BlobstoreUsage         *message);
+size_t ctl__blobstore_usage__get_packed_size
+                     (const Ctl__BlobstoreUsage   *message);
+size_t ctl__blobstore_usage__pack
+                     (const Ctl__BlobstoreUsage   *message,
+                      uint8_t             *out);
+size_t ctl__blobstore_usage__pack_to_buffer
+                     (const Ctl__BlobstoreUsage   *message,
+                      ProtobufCBuffer     *buffer);
+Ctl__BlobstoreUsage *
+       ctl__blobstore_usage__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   ctl__blobstore_usage__free_unpacked
+                     (Ctl__BlobstoreUsage *message,
+                      ProtobufCAllocator *allocator);
+/* Ctl__BlobstoreUsageResp methods */
+void   ctl__blobstore_usage_resp__init
+                     (Ctl__BlobstoreUsageResp         *message);
+size_t ctl__blobstore_usage_resp__get_packed_size
+                     (const Ctl__BlobstoreUsageResp   *message);
+size_t ctl__blobstore_usage_resp__pack
+                     (const Ctl__BlobstoreUsageResp   *message,
+                      uint8_t             *out);
+size_t ctl__blobstore_usage_resp__pack_to_buffer
+                     (const Ctl__BlobstoreUsageResp   *message,
+                      ProtobufCBuffer     *buffer);
+Ctl__BlobstoreUsageResp *
+       ctl__blobstore_usage_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   ctl__blobstore_usage_resp__free_unpacked
+                     (Ctl__BlobstoreUsageResp *message,
+                      ProtobufCAllocator *allocator);
+/* Ctl__BlobstoreQueryReq methods */
+void   ctl__blobstore_query_req__init
+                     (Ctl__BlobstoreQueryReq         *message);
+size_t ctl__blobstore_query_req__get_packed_size
+                     (const Ctl__BlobstoreQueryReq   *message);
+size_t ctl__blobstore_query_req__pack
+                     (const Ctl__BlobstoreQueryReq   *message,
+                      uint8_t             *out);
+size_t ctl__blobstore_query_req__pack_to_buffer
+                     (const Ctl__BlobstoreQueryReq   *message,
+                      ProtobufCBuffer     *buffer);
+Ctl__BlobstoreQueryReq *
+       ctl__blobstore_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   ctl__blobstore_query_req__free_unpacked
+                     (Ctl__BlobstoreQueryReq *message,
+                      ProtobufCAllocator *allocator);
+/* Ctl__BlobstoreQueryResp__RankResp methods */
+void   ctl__blobstore_query_resp__rank_resp__init
+                     (Ctl__BlobstoreQueryResp__RankResp         *message);
+/* Ctl__BlobstoreQueryResp methods */
+void   ctl__blobstore_query_resp__init
+                     (Ctl__BlobstoreQueryResp         *message);
+size_t ctl__blobstore_query_resp__get_packed_size
+                     (const Ctl__BlobstoreQueryResp   *message);
+size_t ctl__blobstore_query_resp__pack
+                     (const Ctl__BlobstoreQueryResp   *message,
+                      uint8_t             *out);
+size_t ctl__blobstore_query_resp__pack_to_buffer
+                     (const Ctl__BlobstoreQueryResp   *message,
+                      ProtobufCBuffer     *buffer);
+Ctl__BlobstoreQueryResp *
+       ctl__blobstore_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   ctl__blobstore_query_resp__free_unpacked
+                     (Ctl__BlobstoreQueryResp *message,
+                      ProtobufCAllocator *allocator);
 /* Ctl__SmdPoolReq methods */
 void   ctl__smd_pool_req__init
                      (Ctl__SmdPoolReq         *message);
@@ -902,6 +1145,27 @@ typedef void (*Ctl__SmdDevReq_Closure)
 typedef void (*Ctl__SmdDevResp_Closure)
                  (const Ctl__SmdDevResp *message,
                   void *closure_data);
+typedef void (*Ctl__BlobstoreUsageReq_Closure)
+                 (const Ctl__BlobstoreUsageReq *message,
+                  void *closure_data);
+typedef void (*Ctl__BlobstoreUsage__Target_Closure)
+                 (const Ctl__BlobstoreUsage__Target *message,
+                  void *closure_data);
+typedef void (*Ctl__BlobstoreUsage_Closure)
+                 (const Ctl__BlobstoreUsage *message,
+                  void *closure_data);
+typedef void (*Ctl__BlobstoreUsageResp_Closure)
+                 (const Ctl__BlobstoreUsageResp *message,
+                  void *closure_data);
+typedef void (*Ctl__BlobstoreQueryReq_Closure)
+                 (const Ctl__BlobstoreQueryReq *message,
+                  void *closure_data);
+typedef void (*Ctl__BlobstoreQueryResp__RankResp_Closure)
+                 (const Ctl__BlobstoreQueryResp__RankResp *message,
+                  void *closure_data);
+typedef void (*Ctl__BlobstoreQueryResp_Closure)
+                 (const Ctl__BlobstoreQueryResp *message,
+                  void *closure_data);
 typedef void (*Ctl__SmdPoolReq_Closure)
                  (const Ctl__SmdPoolReq *message,
                   void *closure_data);
@@ -964,6 +1228,13 @@ extern const ProtobufCMessageDescriptor ctl__bio_health_resp__descriptor;
 extern const ProtobufCMessageDescriptor ctl__smd_device__descriptor;
 extern const ProtobufCMessageDescriptor ctl__smd_dev_req__descriptor;
 extern const ProtobufCMessageDescriptor ctl__smd_dev_resp__descriptor;
+extern const ProtobufCMessageDescriptor ctl__blobstore_usage_req__descriptor;
+extern const ProtobufCMessageDescriptor ctl__blobstore_usage__descriptor;
+extern const ProtobufCMessageDescriptor ctl__blobstore_usage__target__descriptor;
+extern const ProtobufCMessageDescriptor ctl__blobstore_usage_resp__descriptor;
+extern const ProtobufCMessageDescriptor ctl__blobstore_query_req__descriptor;
+extern const ProtobufCMessageDescriptor ctl__blobstore_query_resp__descriptor;
+extern const ProtobufCMessageDescriptor ctl__blobstore_query_resp__rank_resp__descriptor;
 extern const ProtobufCMessageDescriptor ctl__smd_pool_req__descriptor;
 extern const ProtobufCMessageDescriptor ctl__smd_pool_resp__descriptor;
 extern const ProtobufCMessageDescriptor ctl__smd_pool_resp__pool__descriptor;
@@ -38,6 +38,23 @@ static struct crt_corpc_ops ds_mgmt_hdlr_tgt_map_update_co_ops = {
 	.co_pre_forward	= ds_mgmt_tgt_map_update_pre_forward,
 };
 
+static struct crt_corpc_ops ds_mgmt_hdlr_tgt_agg_query_co_ops = {
+	.co_aggregate	= ds_mgmt_tgt_agg_query_aggregator,
+	.co_pre_forward	= NULL,
+	.co_post_reply	= ds_mgmt_tgt_agg_query_post_reply,
+};
+
+static struct crt_corpc_ops ds_mgmt_hdlr_tgt_policy_set_co_ops = {
+	.co_aggregate	= ds_mgmt_tgt_policy_set_aggregator,
+	.co_pre_forward	= NULL,
+};
+
+static struct crt_corpc_ops ds_mgmt_hdlr_tgt_cont_check_co_ops = {
+	.co_aggregate	= ds_mgmt_tgt_cont_check_aggregator,
+	.co_pre_forward	= NULL,
+	.co_post_reply	= ds_mgmt_tgt_cont_check_post_reply,
+};
+
 /* Define for cont_rpcs[] array population below.
  * See MGMT_PROTO_*_RPC_LIST macro definition
  */
@@ -108,6 +125,9 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_SMD_LIST_DEVS:
 		ds_mgmt_drpc_smd_list_devs(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_BLOBSTORE_USAGE:
+		ds_mgmt_drpc_blobstore_usage(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_SMD_LIST_POOLS:
 		ds_mgmt_drpc_smd_list_pools(drpc_req, drpc_resp);
 		break;
@@ -144,9 +164,18 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_POOL_QUERY_TARGETS:
 		ds_mgmt_drpc_pool_query_targets(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_POOL_QUERY_AGGREGATION:
+		ds_mgmt_drpc_pool_query_aggregation(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_POOL_SET_POLICY:
+		ds_mgmt_drpc_pool_set_policy(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_CONT_SET_OWNER:
 		ds_mgmt_drpc_cont_set_owner(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_CONT_CHECK:
+		ds_mgmt_drpc_cont_check(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_GROUP_UPDATE:
 		ds_mgmt_drpc_group_update(drpc_req, drpc_resp);
 		break;
@@ -81,3 +81,179 @@ out_prop:
 	daos_prop_free(prop);
 	return rc;
 }
+
+/*
+ * Send the MGMT_TGT_CONT_CHECK collective RPC to \a ranks to destroy the VOS
+ * containers in \a destroy, and return the containers left on the targets.
+ */
+static int
+cont_check_tgts(uuid_t pool_uuid, d_rank_list_t *ranks, uuid_t *destroy, int destroy_nr,
+		uuid_t **conts, int *conts_nr)
+{
+	crt_rpc_t			*tc_req;
+	crt_opcode_t			 opc;
+	struct mgmt_tgt_cont_check_in	*tc_in;
+	struct mgmt_tgt_cont_check_out	*tc_out = NULL;
+	int				 topo;
+	int				 rc;
+
+	topo = crt_tree_topo(CRT_TREE_KNOMIAL, 4);
+	opc = DAOS_RPC_OPCODE(MGMT_TGT_CONT_CHECK, DAOS_MGMT_MODULE,
+			      DAOS_MGMT_VERSION);
+	rc = crt_corpc_req_create(dss_get_module_info()->dmi_ctx, NULL,
+				  ranks, opc, NULL, NULL,
+				  CRT_RPC_FLAG_FILTER_INVERT, topo, &tc_req);
+	if (rc) {
+		D_ERROR(DF_UUID": corpc_req_create failed: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		return rc;
+	}
+
+	tc_in = crt_req_get(tc_req);
+	D_ASSERT(tc_in != NULL);
+	uuid_copy(tc_in->tc_pool_uuid, pool_uuid);
+	tc_in->tc_destroy.ca_arrays = destroy;
+	tc_in->tc_destroy.ca_count = destroy_nr;
+	rc = dss_rpc_send(tc_req);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": dss_rpc_send MGMT_TGT_CONT_CHECK: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		D_GOTO(decref, rc);
+	}
+
+	tc_out = crt_reply_get(tc_req);
+	rc = tc_out->tc_rc;
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to check containers: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		D_GOTO(decref, rc);
+	}
+
+	/* Hand the aggregated array over to the caller */
+	*conts = tc_out->tc_conts.ca_arrays;
+	*conts_nr = tc_out->tc_conts.ca_count;
+	tc_out->tc_conts.ca_arrays = NULL;
+	tc_out->tc_conts.ca_count = 0;
+
+decref:
+	if (tc_out)
+		D_FREE(tc_out->tc_conts.ca_arrays);
+
+	crt_req_decref(tc_req);
+	return rc;
+}
+
+static bool
+cont_uuid_found(uuid_t *conts, int conts_nr, uuid_t uuid)
+{
+	int	i;
+
+	for (i = 0; i < conts_nr; i++)
+		if (uuid_compare(conts[i], uuid) == 0)
+			return true;
+	return false;
+}
+
+/**
+ * Look for VOS containers on the pool's targets that the pool service does
+ * not know about, left behind by container destroys that did not complete.
+ *
+ * \param[in]	pool_uuid	UUID of the pool.
+ * \param[in]	svc_ranks	Ranks of pool svc replicas.
+ * \param[in]	repair		Destroy the orphaned containers that are found.
+ * \param[out]	orphans		Orphaned containers, allocated if any were
+ *				found. Caller frees with D_FREE().
+ * \param[out]	orphans_nr	Number of entries in \a orphans.
+ *
+ * \return	0		Success
+ *		Negative value	Error
+ */
+int
+ds_mgmt_cont_check(uuid_t pool_uuid, d_rank_list_t *svc_ranks, bool repair,
+		   struct mgmt_cont_orphan **orphans, size_t *orphans_nr)
+{
+	daos_pool_info_t		 pool_info = { .pi_bits = DPI_ENGINES_ENABLED };
+	d_rank_list_t			*ranks = NULL;
+	struct daos_pool_cont_info	*conts = NULL;
+	uint64_t			 conts_nr = 0;
+	uuid_t				*found = NULL;
+	int				 found_nr = 0;
+	uuid_t				*destroy = NULL;
+	int				 destroy_nr = 0;
+	uuid_t				*left = NULL;
+	int				 left_nr = 0;
+	struct mgmt_cont_orphan		*found_orphans = NULL;
+	uint64_t			 j;
+	int				 i;
+	int				 rc;
+
+	if (orphans == NULL || orphans_nr == NULL) {
+		D_ERROR("orphans or orphans_nr was NULL\n");
+		return -DER_INVAL;
+	}
+
+	D_DEBUG(DB_MGMT, "Checking containers of pool "DF_UUID"\n", DP_UUID(pool_uuid));
+
+	*orphans = NULL;
+	*orphans_nr = 0;
+
+	rc = ds_mgmt_pool_query(pool_uuid, svc_ranks, &ranks, &pool_info, NULL, NULL);
+	if (rc != 0)
+		goto out;
+
+	/*
+	 * The targets are listed before the pool service: a container is added
+	 * to the pool service before it is created on any target, so one that is
+	 * being created concurrently is never mistaken for an orphan.
+	 */
+	rc = cont_check_tgts(pool_uuid, ranks, NULL, 0, &found, &found_nr);
+	if (rc != 0)
+		goto out;
+
+	rc = ds_mgmt_pool_list_cont(pool_uuid, svc_ranks, &conts, &conts_nr);
+	if (rc != 0)
+		goto out;
+
+	if (found_nr == 0)
+		goto out;
+
+	D_ALLOC_ARRAY(destroy, found_nr);
+	if (destroy == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	for (i = 0; i < found_nr; i++) {
+		for (j = 0; j < conts_nr; j++)
+			if (uuid_compare(found[i], conts[j].pci_uuid) == 0)
+				break;
+		if (j == conts_nr)
+			uuid_copy(destroy[destroy_nr++], found[i]);
+	}
+
+	if (destroy_nr == 0)
+		goto out;
+
+	if (repair) {
+		rc = cont_check_tgts(pool_uuid, ranks, destroy, destroy_nr, &left, &left_nr);
+		if (rc != 0)
+			goto out;
+	}
+
+	D_ALLOC_ARRAY(found_orphans, destroy_nr);
+	if (found_orphans == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	for (i = 0; i < destroy_nr; i++) {
+		uuid_copy(found_orphans[i].mco_uuid, destroy[i]);
+		found_orphans[i].mco_removed = repair && !cont_uuid_found(left, left_nr, destroy[i]);
+	}
+
+	*orphans = found_orphans;
+	*orphans_nr = destroy_nr;
+out:
+	D_FREE(left);
+	D_FREE(destroy);
+	D_FREE(found);
+	D_FREE(conts);
+	d_rank_list_free(ranks);
+	return rc;
+}
@@ -1698,9 +1698,13 @@ pool_rebuild_status_from_info(Mgmt__PoolRebuildStatus *rebuild,
 			      struct daos_rebuild_status *info)
 {
 	rebuild->status = info->rs_errno;
+	rebuild->version = info->rs_version;
+	rebuild->seconds = info->rs_seconds;
 	if (rebuild->status == 0) {
 		rebuild->objects = info->rs_obj_nr;
 		rebuild->records = info->rs_rec_nr;
+		rebuild->total_objects = info->rs_toberb_obj_nr;
+		rebuild->size = info->rs_size;
 
 		if (info->rs_version == 0)
 			rebuild->state = MGMT__POOL_REBUILD_STATUS__STATE__IDLE;
@@ -1708,6 +1712,8 @@ pool_rebuild_status_from_info(Mgmt__PoolRebuildStatus *rebuild,
 			rebuild->state = MGMT__POOL_REBUILD_STATUS__STATE__DONE;
 		else
 			rebuild->state = MGMT__POOL_REBUILD_STATUS__STATE__BUSY;
+	} else {
+		rebuild->fail_rank = info->rs_fail_rank;
 	}
 }
 
@@ -1721,6 +1727,26 @@ pool_query_free_tier_stats(Mgmt__PoolQueryResp *resp)
 	resp->n_tier_stats = 0;
 }
 
+/* Stringify a list of ranks as a compressed set of rank ranges for display. */
+static int
+pool_query_ranks_str(uuid_t uuid, d_rank_list_t *ranks, bool enabled, char **str)
+{
+	d_rank_range_list_t	*range_list;
+	bool			 truncated;
+
+	range_list = d_rank_range_list_create_from_ranks(ranks);
+	if (range_list == NULL)
+		return -DER_NOMEM;
+	*str = d_rank_range_list_str(range_list, &truncated);
+	d_rank_range_list_free(range_list);
+	if (*str == NULL)
+		return -DER_NOMEM;
+
+	D_DEBUG(DB_MGMT, DF_UUID": %s ranks: %s%s\n", DP_UUID(uuid),
+		enabled ? "ENABLED" : "DISABLED", *str, truncated ? " ...(TRUNCATED)" : "");
+	return 0;
+}
+
 void
 ds_mgmt_drpc_pool_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -1733,11 +1759,12 @@ ds_mgmt_drpc_pool_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	Mgmt__PoolRebuildStatus	rebuild = MGMT__POOL_REBUILD_STATUS__INIT;
 	uuid_t			uuid;
 	daos_pool_info_t	pool_info = {0};
+	daos_pool_info_t	disabled_info = {0};
 	d_rank_list_t		*svc_ranks;
 	d_rank_list_t		*ranks;
-	d_rank_range_list_t	*range_list;
-	char			*range_list_str = NULL;
-	bool			truncated;
+	d_rank_list_t		*disabled_ranks;
+	char			*enabled_str = NULL;
+	char			*disabled_str = NULL;
 	size_t			len;
 	uint8_t			*body;
 
@@ -1760,7 +1787,6 @@ ds_mgmt_drpc_pool_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	if (svc_ranks == NULL)
 		D_GOTO(out, rc = -DER_NOMEM);
 
-	/* TODO (DAOS-10250) Enabled and disabled engines should be retrieve both if needed */
 	pool_info.pi_bits = req->include_enabled_ranks ? DPI_ALL : (DPI_ALL & ~DPI_ENGINES_ENABLED);
 	rc = ds_mgmt_pool_query(uuid, svc_ranks, &ranks, &pool_info, &resp.pool_layout_ver,
 				&resp.upgrade_layout_ver);
@@ -1770,15 +1796,30 @@ ds_mgmt_drpc_pool_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	}
 
 	/* Calculate and stringify rank ranges to return to control plane for display */
-	range_list = d_rank_range_list_create_from_ranks(ranks);
-	if (range_list == NULL)
-		D_GOTO(out_ranks, rc = -DER_NOMEM);
-	range_list_str = d_rank_range_list_str(range_list, &truncated);
-	if (range_list_str == NULL)
-		D_GOTO(out_ranges, rc = -DER_NOMEM);
-	D_DEBUG(DB_MGMT, DF_UUID": %s ranks: %s%s\n", DP_UUID(uuid),
-		pool_info.pi_bits & DPI_ENGINES_ENABLED ? "ENABLED" : "DISABLED", range_list_str,
-		truncated ? " ...(TRUNCATED)" : "");
+	if (req->include_enabled_ranks || req->include_disabled_ranks) {
+		rc = pool_query_ranks_str(uuid, ranks, req->include_enabled_ranks,
+					  req->include_enabled_ranks ? &enabled_str : &disabled_str);
+		if (rc != 0)
+			goto out_ranks;
+	}
+
+	/*
+	 * A pool query returns either the enabled or the disabled ranks, so a
+	 * second query is needed if both have been requested. No optional info
+	 * bits are set, so only the disabled ranks are retrieved.
+	 */
+	if (req->include_enabled_ranks && req->include_disabled_ranks) {
+		rc = ds_mgmt_pool_query(uuid, svc_ranks, &disabled_ranks, &disabled_info, NULL,
+					NULL);
+		if (rc != 0) {
+			D_ERROR("Failed to query the pool disabled ranks, rc=%d\n", rc);
+			goto out_ranks;
+		}
+		rc = pool_query_ranks_str(uuid, disabled_ranks, false, &disabled_str);
+		d_rank_list_free(disabled_ranks);
+		if (rc != 0)
+			goto out_ranks;
+	}
 
 	/* Populate the response */
 	resp.uuid = req->id;
@@ -1788,12 +1829,12 @@ ds_mgmt_drpc_pool_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	resp.total_engines = pool_info.pi_nnodes;
 	resp.leader = pool_info.pi_leader;
 	resp.version = pool_info.pi_map_ver;
-	resp.enabled_ranks = (req->include_enabled_ranks) ? range_list_str : "";
-	resp.disabled_ranks = (req->include_disabled_ranks) ? range_list_str : "";
+	resp.enabled_ranks = (enabled_str != NULL) ? enabled_str : "";
+	resp.disabled_ranks = (disabled_str != NULL) ? disabled_str : "";
 
 	D_ALLOC_ARRAY(resp.tier_stats, DAOS_MEDIA_MAX);
 	if (resp.tier_stats == NULL) {
-		D_GOTO(out_ranges, rc = -DER_NOMEM);
+		D_GOTO(out_ranks, rc = -DER_NOMEM);
 	}
 
 	storage_usage_stats_from_pool_space(&scm, &pool_info.pi_space,
@@ -1809,8 +1850,6 @@ ds_mgmt_drpc_pool_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	pool_rebuild_status_from_info(&rebuild, &pool_info.pi_rebuild_st);
 	resp.rebuild = &rebuild;
 
-out_ranges:
-	d_rank_range_list_free(range_list);
 out_ranks:
 	d_rank_list_free(ranks);
 out_svc_ranks:
@@ -1828,7 +1867,8 @@ out:
 		drpc_resp->body.data = body;
 	}
 
-	D_FREE(range_list_str);
+	D_FREE(enabled_str);
+	D_FREE(disabled_str);
 
 	mgmt__pool_query_req__free_unpacked(req, &alloc.alloc);
 
@@ -1950,6 +1990,173 @@ out:
 	D_FREE(resp_infos);
 }
 
+static char *
+agg_state_str(uint32_t state)
+{
+	switch (state) {
+	case MGMT_TGT_AGG_RUNNING:
+		return "running";
+	case MGMT_TGT_AGG_PAUSED:
+		return "paused";
+	default:
+		return "idle";
+	}
+}
+
+void
+ds_mgmt_drpc_pool_query_aggregation(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc			 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PoolQueryAggregationReq		*req;
+	Mgmt__PoolQueryAggregationResp		 resp = MGMT__POOL_QUERY_AGGREGATION_RESP__INIT;
+	Mgmt__PoolQueryAggregationResp__Rank	*resp_ranks = NULL;
+	uuid_t					 uuid;
+	d_rank_list_t				*ranks;
+	struct mgmt_tgt_agg_status		*statuses = NULL;
+	size_t					 statuses_nr = 0;
+	size_t					 i;
+	size_t					 len;
+	uint8_t					*body;
+	int					 rc = 0;
+
+	req = mgmt__pool_query_aggregation_req__unpack(&alloc.alloc, drpc_req->body.len,
+						       drpc_req->body.data);
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack pool query aggregation req\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to query aggregation of DAOS pool %s on %zu ranks\n", req->id,
+	       req->n_ranks);
+
+	if (uuid_parse(req->id, uuid) != 0) {
+		D_ERROR("Failed to parse pool uuid %s\n", req->id);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	ranks = uint32_array_to_rank_list(req->ranks, req->n_ranks);
+	if (ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_pool_query_aggregation(uuid, ranks, &statuses, &statuses_nr);
+	d_rank_list_free(ranks);
+	if (rc != 0) {
+		D_ERROR("ds_mgmt_pool_query_aggregation() failed, pool %s, "DF_RC"\n",
+			req->id, DP_RC(rc));
+		goto out;
+	}
+
+	resp.uuid = req->id;
+
+	/* Populate the response */
+	if (statuses_nr == 0)
+		goto out_statuses;
+
+	D_ALLOC_ARRAY(resp.ranks, statuses_nr);
+	if (resp.ranks == NULL)
+		D_GOTO(out_statuses, rc = -DER_NOMEM);
+
+	/* array of Mgmt__PoolQueryAggregationResp__Rank so we don't have to allocate individually */
+	D_ALLOC_ARRAY(resp_ranks, statuses_nr);
+	if (resp_ranks == NULL)
+		D_GOTO(out_statuses, rc = -DER_NOMEM);
+	resp.n_ranks = statuses_nr;
+
+	for (i = 0; i < statuses_nr; i++) {
+		resp.ranks[i] = &resp_ranks[i];
+		mgmt__pool_query_aggregation_resp__rank__init(resp.ranks[i]);
+
+		resp.ranks[i]->rank = statuses[i].tas_rank;
+		resp.ranks[i]->state = agg_state_str(statuses[i].tas_state);
+		resp.ranks[i]->lag = statuses[i].tas_lag;
+		resp.ranks[i]->last_run = statuses[i].tas_last_run;
+	}
+
+out_statuses:
+	D_FREE(statuses);
+out:
+	resp.status = rc;
+
+	len = mgmt__pool_query_aggregation_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__pool_query_aggregation_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__pool_query_aggregation_req__free_unpacked(req, &alloc.alloc);
+
+	D_FREE(resp.ranks);
+	D_FREE(resp_ranks);
+}
+
+void
+ds_mgmt_drpc_pool_set_policy(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc		 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PoolSetPolicyReq		*req;
+	Mgmt__PoolSetPolicyResp		 resp = MGMT__POOL_SET_POLICY_RESP__INIT;
+	uuid_t				 uuid;
+	d_rank_list_t			*ranks;
+	size_t				 len;
+	uint8_t				*body;
+	int				 rc = 0;
+
+	req = mgmt__pool_set_policy_req__unpack(&alloc.alloc, drpc_req->body.len,
+						drpc_req->body.data);
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack pool set policy req\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to set policy of DAOS pool %s on %zu ranks\n", req->id,
+	       req->n_ranks);
+
+	if (uuid_parse(req->id, uuid) != 0) {
+		D_ERROR("Failed to parse pool uuid %s\n", req->id);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	if (req->aggregation > MGMT__POOL_SET_POLICY_REQ__AGGREGATION__DISABLED ||
+	    req->rebuild_throttle > 100 || req->scrub_rate > 100) {
+		D_ERROR("Invalid policy for pool %s: aggregation %d, rebuild throttle %u, "
+			"scrub rate %u\n", req->id, req->aggregation, req->rebuild_throttle,
+			req->scrub_rate);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	ranks = uint32_array_to_rank_list(req->ranks, req->n_ranks);
+	if (ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_pool_set_policy(uuid, ranks, req->aggregation, req->rebuild_throttle,
+				     req->scrub_rate, req->clear);
+	d_rank_list_free(ranks);
+	if (rc != 0)
+		D_ERROR("ds_mgmt_pool_set_policy() failed, pool %s, "DF_RC"\n",
+			req->id, DP_RC(rc));
+
+out:
+	resp.status = rc;
+
+	len = mgmt__pool_set_policy_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__pool_set_policy_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__pool_set_policy_req__free_unpacked(req, &alloc.alloc);
+}
+
 void
 ds_mgmt_drpc_smd_list_devs(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -2019,6 +2226,140 @@ out:
 	D_FREE(resp);
 }
 
+void
+ds_mgmt_drpc_blobstore_usage(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc		 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Ctl__BlobstoreUsageReq		*req = NULL;
+	Ctl__BlobstoreUsageResp		 resp = CTL__BLOBSTORE_USAGE_RESP__INIT;
+	Ctl__BlobstoreUsage		*resp_bs = NULL;
+	Ctl__BlobstoreUsage__Target	*resp_tgts = NULL;
+	Ctl__BlobstoreUsage__Target	**tgt_ptrs = NULL;
+	char				(*uuid_strs)[DAOS_UUID_STR_SIZE] = NULL;
+	struct bio_bs_usage		*usages = NULL;
+	int				 usages_nr = 0;
+	int				*bs_idx = NULL;
+	Ctl__BlobstoreUsage		*bs;
+	uint8_t				*body;
+	size_t				 len;
+	int				 bs_nr = 0;
+	int				 tgts_nr = 0;
+	int				 i, j;
+	int				 rc = 0;
+
+	req = ctl__blobstore_usage_req__unpack(&alloc.alloc, drpc_req->body.len,
+					       drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (blobstore usage)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to query blobstore usage\n");
+
+	rc = ds_mgmt_blobstore_usage(&usages, &usages_nr);
+	if (rc != 0) {
+		D_ERROR("Failed to query blobstore usage: "DF_RC"\n", DP_RC(rc));
+		goto out;
+	}
+
+	D_ALLOC_ARRAY(bs_idx, usages_nr);
+	if (bs_idx == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	/* Group the targets by the blobstore they are mapped to */
+	for (i = 0; i < usages_nr; i++) {
+		bs_idx[i] = -1;
+		if (uuid_is_null(usages[i].bbu_dev_id))
+			continue;
+
+		for (j = 0; j < i; j++) {
+			if (bs_idx[j] >= 0 &&
+			    uuid_compare(usages[j].bbu_dev_id, usages[i].bbu_dev_id) == 0) {
+				bs_idx[i] = bs_idx[j];
+				break;
+			}
+		}
+		if (bs_idx[i] < 0)
+			bs_idx[i] = bs_nr++;
+		tgts_nr++;
+	}
+
+	if (bs_nr == 0)
+		goto out;
+
+	D_ALLOC_ARRAY(resp.blobstores, bs_nr);
+	D_ALLOC_ARRAY(resp_bs, bs_nr);
+	D_ALLOC_ARRAY(uuid_strs, bs_nr);
+	D_ALLOC_ARRAY(resp_tgts, tgts_nr);
+	D_ALLOC_ARRAY(tgt_ptrs, tgts_nr);
+	if (resp.blobstores == NULL || resp_bs == NULL || uuid_strs == NULL ||
+	    resp_tgts == NULL || tgt_ptrs == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+	resp.n_blobstores = bs_nr;
+
+	for (i = 0; i < bs_nr; i++) {
+		resp.blobstores[i] = &resp_bs[i];
+		ctl__blobstore_usage__init(resp.blobstores[i]);
+	}
+
+	/*
+	 * Targets are listed per blobstore in target ID order, blobstore wide
+	 * counters are identical for all the targets sharing the blobstore.
+	 */
+	tgts_nr = 0;
+	for (i = 0; i < bs_nr; i++) {
+		bs = resp.blobstores[i];
+		bs->targets = &tgt_ptrs[tgts_nr];
+
+		for (j = 0; j < usages_nr; j++) {
+			if (bs_idx[j] != i)
+				continue;
+
+			if (bs->n_targets == 0) {
+				uuid_unparse_lower(usages[j].bbu_dev_id, uuid_strs[i]);
+				bs->dev_uuid = uuid_strs[i];
+				bs->cluster_size = usages[j].bbu_cluster_sz;
+				bs->total_clusters = usages[j].bbu_total_clusters;
+				bs->free_clusters = usages[j].bbu_free_clusters;
+			}
+
+			tgt_ptrs[tgts_nr] = &resp_tgts[tgts_nr];
+			ctl__blobstore_usage__target__init(tgt_ptrs[tgts_nr]);
+			tgt_ptrs[tgts_nr]->tgt_id = j;
+			tgt_ptrs[tgts_nr]->used_clusters = usages[j].bbu_used_clusters;
+			tgt_ptrs[tgts_nr]->blobs = usages[j].bbu_blobs;
+			bs->n_targets++;
+			tgts_nr++;
+		}
+	}
+
+out:
+	if (rc != 0)
+		resp.n_blobstores = 0;
+	resp.status = rc;
+	len = ctl__blobstore_usage_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		ctl__blobstore_usage_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	ctl__blobstore_usage_req__free_unpacked(req, &alloc.alloc);
+
+	D_FREE(usages);
+	D_FREE(bs_idx);
+	D_FREE(uuid_strs);
+	D_FREE(tgt_ptrs);
+	D_FREE(resp_tgts);
+	D_FREE(resp_bs);
+	D_FREE(resp.blobstores);
+}
+
 void
 ds_mgmt_drpc_smd_list_pools(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -2167,6 +2508,9 @@ ds_mgmt_drpc_bio_health_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	resp->read_only_warn = stats.read_only_warn;
 	resp->dev_reliability_warn = stats.dev_reliability_warn;
 	resp->volatile_mem_warn = stats.volatile_mem_warn;
+	resp->avail_spare = stats.avail_spare;
+	resp->avail_spare_threshold = stats.avail_spare_thres;
+	resp->percentage_used = stats.percent_used;
 	resp->total_bytes = stats.total_bytes;
 	resp->avail_bytes = stats.avail_bytes;
 	resp->cluster_size = stats.cluster_size;
@@ -2457,3 +2801,90 @@ out:
 
 	mgmt__cont_set_owner_req__free_unpacked(req, &alloc.alloc);
 }
+
+void
+ds_mgmt_drpc_cont_check(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc		 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__ContCheckReq		*req = NULL;
+	Mgmt__ContCheckResp		 resp = MGMT__CONT_CHECK_RESP__INIT;
+	Mgmt__ContCheckResp__Orphan	*resp_orphans = NULL;
+	char				(*uuid_strs)[DAOS_UUID_STR_SIZE] = NULL;
+	struct mgmt_cont_orphan		*orphans = NULL;
+	size_t				 orphans_nr = 0;
+	uint8_t				*body;
+	size_t				 len;
+	size_t				 i;
+	uuid_t				 pool_uuid;
+	d_rank_list_t			*svc_ranks = NULL;
+	int				 rc = 0;
+
+	req = mgmt__cont_check_req__unpack(&alloc.alloc, drpc_req->body.len,
+					   drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (cont check)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to check containers of DAOS pool %s\n", req->id);
+
+	if (uuid_parse(req->id, pool_uuid) != 0) {
+		D_ERROR("Pool UUID is invalid\n");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_cont_check(pool_uuid, svc_ranks, req->repair, &orphans, &orphans_nr);
+	d_rank_list_free(svc_ranks);
+	if (rc != 0) {
+		D_ERROR("Container check failed: "DF_RC"\n", DP_RC(rc));
+		goto out;
+	}
+
+	if (orphans_nr == 0)
+		goto out;
+
+	D_ALLOC_ARRAY(resp.orphans, orphans_nr);
+	D_ALLOC_ARRAY(resp_orphans, orphans_nr);
+	D_ALLOC_ARRAY(uuid_strs, orphans_nr);
+	if (resp.orphans == NULL || resp_orphans == NULL || uuid_strs == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+	resp.n_orphans = orphans_nr;
+
+	for (i = 0; i < orphans_nr; i++) {
+		resp.orphans[i] = &resp_orphans[i];
+		mgmt__cont_check_resp__orphan__init(resp.orphans[i]);
+
+		uuid_unparse(orphans[i].mco_uuid, uuid_strs[i]);
+		resp.orphans[i]->uuid = uuid_strs[i];
+		/* The label was removed with the rest of the pool service metadata */
+		resp.orphans[i]->reason = "destroy did not complete";
+		resp.orphans[i]->removed = orphans[i].mco_removed;
+	}
+
+out:
+	if (rc != 0)
+		resp.n_orphans = 0;
+	resp.status = rc;
+	len = mgmt__cont_check_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__cont_check_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__cont_check_req__free_unpacked(req, &alloc.alloc);
+
+	D_FREE(orphans);
+	D_FREE(uuid_strs);
+	D_FREE(resp_orphans);
+	D_FREE(resp.orphans);
+}
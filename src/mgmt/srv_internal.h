@@ -101,11 +101,24 @@ int ds_mgmt_pool_query(uuid_t pool_uuid, d_rank_list_t *svc_ranks, d_rank_list_t
 		       uint32_t *upgrade_layout_ver);
 int ds_mgmt_pool_query_targets(uuid_t pool_uuid, d_rank_list_t *svc_ranks, d_rank_t rank,
 			       d_rank_list_t *tgts, daos_target_info_t **infos);
+int ds_mgmt_pool_query_aggregation(uuid_t pool_uuid, d_rank_list_t *ranks,
+				   struct mgmt_tgt_agg_status **statuses, size_t *statuses_nr);
+int ds_mgmt_pool_set_policy(uuid_t pool_uuid, d_rank_list_t *ranks, uint32_t aggregation,
+			    uint32_t rebuild_ratio, uint32_t scrub_rate, bool clear);
 
 int ds_mgmt_cont_set_owner(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 			   uuid_t cont_uuid, const char *user,
 			   const char *group);
 
+/* VOS container left on a pool's targets by a container destroy that did not complete */
+struct mgmt_cont_orphan {
+	uuid_t		mco_uuid;
+	bool		mco_removed;
+};
+
+int ds_mgmt_cont_check(uuid_t pool_uuid, d_rank_list_t *svc_ranks, bool repair,
+		       struct mgmt_cont_orphan **orphans, size_t *orphans_nr);
+
 /** srv_query.c */
 
 /* Device health stats from nvme_stats */
@@ -117,6 +130,7 @@ struct mgmt_bio_health {
 int ds_mgmt_bio_health_query(struct mgmt_bio_health *mbh, uuid_t uuid, char *tgt_id);
 int ds_mgmt_smd_list_devs(Ctl__SmdDevResp *resp);
 int ds_mgmt_smd_list_pools(Ctl__SmdPoolResp *resp);
+int ds_mgmt_blobstore_usage(struct bio_bs_usage **usages, int *usages_nr);
 int ds_mgmt_dev_set_faulty(uuid_t uuid, Ctl__DevManageResp *resp);
 int ds_mgmt_dev_manage_led(Ctl__LedManageReq *req, Ctl__DevManageResp *resp);
 int ds_mgmt_get_bs_state(uuid_t bs_uuid, int *bs_state);
@@ -137,6 +151,17 @@ void ds_mgmt_hdlr_tgt_map_update(crt_rpc_t *rpc);
 int ds_mgmt_tgt_map_update_aggregator(crt_rpc_t *source, crt_rpc_t *result,
 				      void *priv);
 void ds_mgmt_tgt_mark_hdlr(crt_rpc_t *rpc);
+void ds_mgmt_hdlr_tgt_agg_query(crt_rpc_t *rpc);
+int ds_mgmt_tgt_agg_query_aggregator(crt_rpc_t *source, crt_rpc_t *result,
+				     void *priv);
+int ds_mgmt_tgt_agg_query_post_reply(crt_rpc_t *rpc, void *priv);
+void ds_mgmt_hdlr_tgt_policy_set(crt_rpc_t *rpc);
+int ds_mgmt_tgt_policy_set_aggregator(crt_rpc_t *source, crt_rpc_t *result,
+				      void *priv);
+void ds_mgmt_hdlr_tgt_cont_check(crt_rpc_t *rpc);
+int ds_mgmt_tgt_cont_check_aggregator(crt_rpc_t *source, crt_rpc_t *result,
+				      void *priv);
+int ds_mgmt_tgt_cont_check_post_reply(crt_rpc_t *rpc, void *priv);
 
 /** srv_util.c */
 int ds_mgmt_group_update(struct server_entry *servers, int nservers, uint32_t version);
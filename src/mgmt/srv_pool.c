@@ -441,6 +441,140 @@ out:
 	return rc;
 }
 
+/**
+ * Queries the state of aggregation of a pool's targets on each of the given engine ranks.
+ *
+ * \param[in]		pool_uuid	UUID of the pool.
+ * \param[in]		ranks		Engine ranks hosting the pool's targets.
+ * \param[out]		statuses	Aggregation status of each responding rank.
+ *					Allocated if returning 0. Caller frees with D_FREE().
+ * \param[out]		statuses_nr	Number of entries in \a statuses.
+ *
+ * \return		0		Success
+ *			-DER_INVAL	Invalid inputs
+ *			Negative value	Other error
+ */
+int
+ds_mgmt_pool_query_aggregation(uuid_t pool_uuid, d_rank_list_t *ranks,
+			       struct mgmt_tgt_agg_status **statuses, size_t *statuses_nr)
+{
+	crt_rpc_t			*ta_req;
+	crt_opcode_t			opc;
+	struct mgmt_tgt_agg_query_in	*ta_in;
+	struct mgmt_tgt_agg_query_out	*ta_out = NULL;
+	int				topo;
+	int				rc;
+
+	if (ranks == NULL || statuses == NULL || statuses_nr == NULL) {
+		D_ERROR("ranks, statuses or statuses_nr was NULL\n");
+		return -DER_INVAL;
+	}
+
+	D_DEBUG(DB_MGMT, "Querying aggregation of pool "DF_UUID" on %u ranks\n",
+		DP_UUID(pool_uuid), ranks->rl_nr);
+
+	/* Collective RPC to all of targets of the pool */
+	topo = crt_tree_topo(CRT_TREE_KNOMIAL, 4);
+	opc = DAOS_RPC_OPCODE(MGMT_TGT_AGG_QUERY, DAOS_MGMT_MODULE,
+			      DAOS_MGMT_VERSION);
+	rc = crt_corpc_req_create(dss_get_module_info()->dmi_ctx, NULL,
+				  ranks, opc, NULL, NULL,
+				  CRT_RPC_FLAG_FILTER_INVERT, topo, &ta_req);
+	if (rc) {
+		D_ERROR(DF_UUID": corpc_req_create failed: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		return rc;
+	}
+
+	ta_in = crt_req_get(ta_req);
+	D_ASSERT(ta_in != NULL);
+	uuid_copy(ta_in->ta_pool_uuid, pool_uuid);
+	rc = dss_rpc_send(ta_req);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": dss_rpc_send MGMT_TGT_AGG_QUERY: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		D_GOTO(decref, rc);
+	}
+
+	ta_out = crt_reply_get(ta_req);
+	rc = ta_out->ta_rc;
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to query aggregation: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		D_GOTO(decref, rc);
+	}
+
+	/* Hand the aggregated array over to the caller */
+	*statuses = ta_out->ta_status.ca_arrays;
+	*statuses_nr = ta_out->ta_status.ca_count;
+	ta_out->ta_status.ca_arrays = NULL;
+	ta_out->ta_status.ca_count = 0;
+
+decref:
+	if (ta_out)
+		D_FREE(ta_out->ta_status.ca_arrays);
+
+	crt_req_decref(ta_req);
+	return rc;
+}
+
+int
+ds_mgmt_pool_set_policy(uuid_t pool_uuid, d_rank_list_t *ranks, uint32_t aggregation,
+			uint32_t rebuild_ratio, uint32_t scrub_rate, bool clear)
+{
+	crt_rpc_t			*tp_req;
+	crt_opcode_t			opc;
+	struct mgmt_tgt_policy_set_in	*tp_in;
+	struct mgmt_tgt_policy_set_out	*tp_out;
+	int				topo;
+	int				rc;
+
+	if (ranks == NULL) {
+		D_ERROR("ranks was NULL\n");
+		return -DER_INVAL;
+	}
+
+	D_DEBUG(DB_MGMT, "Setting policy of pool "DF_UUID" on %u ranks\n",
+		DP_UUID(pool_uuid), ranks->rl_nr);
+
+	/* Collective RPC to all of targets of the pool */
+	topo = crt_tree_topo(CRT_TREE_KNOMIAL, 4);
+	opc = DAOS_RPC_OPCODE(MGMT_TGT_POLICY_SET, DAOS_MGMT_MODULE,
+			      DAOS_MGMT_VERSION);
+	rc = crt_corpc_req_create(dss_get_module_info()->dmi_ctx, NULL,
+				  ranks, opc, NULL, NULL,
+				  CRT_RPC_FLAG_FILTER_INVERT, topo, &tp_req);
+	if (rc) {
+		D_ERROR(DF_UUID": corpc_req_create failed: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		return rc;
+	}
+
+	tp_in = crt_req_get(tp_req);
+	D_ASSERT(tp_in != NULL);
+	uuid_copy(tp_in->tp_pool_uuid, pool_uuid);
+	tp_in->tp_aggregation = aggregation;
+	tp_in->tp_rebuild_ratio = rebuild_ratio;
+	tp_in->tp_scrub_rate = scrub_rate;
+	tp_in->tp_clear = clear;
+	rc = dss_rpc_send(tp_req);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": dss_rpc_send MGMT_TGT_POLICY_SET: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		D_GOTO(decref, rc);
+	}
+
+	tp_out = crt_reply_get(tp_req);
+	rc = tp_out->tp_rc;
+	if (rc != 0)
+		D_ERROR(DF_UUID": failed to set policy: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+
+decref:
+	crt_req_decref(tp_req);
+	return rc;
+}
+
 static int
 get_access_props(uuid_t pool_uuid, d_rank_list_t *ranks, daos_prop_t **prop)
 {
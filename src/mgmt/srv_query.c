@@ -513,6 +513,56 @@ out:
 	return rc;
 }
 
+static int
+bio_query_bs_usage(void *arg)
+{
+	struct bio_bs_usage	*usages = arg;
+	struct dss_module_info	*info = dss_get_module_info();
+	int			 rc;
+
+	D_ASSERT(info != NULL);
+
+	/* Target isn't mapped to any NVMe device */
+	if (info->dmi_nvme_ctxt == NULL)
+		return 0;
+
+	rc = bio_get_bs_usage(info->dmi_nvme_ctxt, &usages[info->dmi_tgt_id]);
+	/* Blobstore isn't loaded, e.g. the device is faulty or being replaced */
+	if (rc == -DER_NONEXIST)
+		rc = 0;
+
+	return rc;
+}
+
+/*
+ * Query the blobstore usage on every target. The returned array is indexed
+ * by target ID, targets without a loaded blobstore are left with a NULL
+ * device UUID.
+ */
+int
+ds_mgmt_blobstore_usage(struct bio_bs_usage **usages, int *usages_nr)
+{
+	struct bio_bs_usage	*tgt_usages;
+	int			 rc;
+
+	D_DEBUG(DB_MGMT, "Querying blobstore usage\n");
+
+	D_ALLOC_ARRAY(tgt_usages, dss_tgt_nr);
+	if (tgt_usages == NULL)
+		return -DER_NOMEM;
+
+	rc = dss_thread_collective(bio_query_bs_usage, tgt_usages, 0);
+	if (rc != 0) {
+		D_ERROR("Failed to query blobstore usage: "DF_RC"\n", DP_RC(rc));
+		D_FREE(tgt_usages);
+		return rc;
+	}
+
+	*usages = tgt_usages;
+	*usages_nr = dss_tgt_nr;
+	return 0;
+}
+
 static void
 bio_faulty_state_set(void *arg)
 {
@@ -17,6 +17,7 @@
 
 #include <daos_srv/vos.h>
 #include <daos_srv/pool.h>
+#include <daos_srv/container.h>
 #include <daos_srv/daos_mgmt_srv.h>
 #include <daos_mgmt.h>
 
@@ -1153,3 +1154,222 @@ ds_mgmt_tgt_map_update_aggregator(crt_rpc_t *source, crt_rpc_t *result,
 	out_result->tm_rc += out_source->tm_rc;
 	return 0;
 }
+
+void
+ds_mgmt_hdlr_tgt_agg_query(crt_rpc_t *rpc)
+{
+	struct mgmt_tgt_agg_query_in	*ta_in = crt_req_get(rpc);
+	struct mgmt_tgt_agg_query_out	*ta_out = crt_reply_get(rpc);
+	struct mgmt_tgt_agg_status	*status;
+	struct ds_cont_agg_status	 cas = { 0 };
+	uint64_t			 now;
+	int				 rc;
+
+	D_ALLOC_PTR(status);
+	if (status == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = crt_group_rank(NULL, &status->tas_rank);
+	if (rc)
+		D_GOTO(out, rc);
+
+	rc = ds_cont_tgt_agg_query(ta_in->ta_pool_uuid, &cas);
+	if (rc) {
+		D_ERROR(DF_UUID": failed to query aggregation: "DF_RC"\n",
+			DP_UUID(ta_in->ta_pool_uuid), DP_RC(rc));
+		D_GOTO(out, rc);
+	}
+
+	if (cas.cas_paused)
+		status->tas_state = MGMT_TGT_AGG_PAUSED;
+	else if (cas.cas_running)
+		status->tas_state = MGMT_TGT_AGG_RUNNING;
+	else
+		status->tas_state = MGMT_TGT_AGG_IDLE;
+
+	now = d_hlc_get();
+	if (cas.cas_hae != 0 && cas.cas_hae < now)
+		status->tas_lag = d_hlc2sec(now - cas.cas_hae);
+	if (cas.cas_last_hlc != 0)
+		status->tas_last_run = d_hlc2unixnsec(cas.cas_last_hlc) / NSEC_PER_SEC;
+
+	ta_out->ta_status.ca_arrays = status;
+	ta_out->ta_status.ca_count  = 1;
+	status = NULL;
+out:
+	D_FREE(status);
+	ta_out->ta_rc = rc;
+	crt_reply_send(rpc);
+}
+
+int
+ds_mgmt_tgt_agg_query_post_reply(crt_rpc_t *rpc, void *priv)
+{
+	struct mgmt_tgt_agg_query_out	*ta_out;
+
+	ta_out = crt_reply_get(rpc);
+	D_FREE(ta_out->ta_status.ca_arrays);
+
+	return 0;
+}
+
+int
+ds_mgmt_tgt_agg_query_aggregator(crt_rpc_t *source, crt_rpc_t *result,
+				 void *priv)
+{
+	struct mgmt_tgt_agg_query_out	*ta_out;
+	struct mgmt_tgt_agg_query_out	*ret_out;
+	struct mgmt_tgt_agg_status	*new_status;
+	unsigned int			 src_nr;
+	unsigned int			 ret_nr;
+
+	ta_out = crt_reply_get(source);
+	src_nr = ta_out->ta_status.ca_count;
+
+	ret_out = crt_reply_get(result);
+	ret_nr = ret_out->ta_status.ca_count;
+
+	if (ta_out->ta_rc != 0)
+		ret_out->ta_rc = ta_out->ta_rc;
+	if (src_nr == 0)
+		return 0;
+
+	D_ALLOC_ARRAY(new_status, ret_nr + src_nr);
+	if (new_status == NULL)
+		return -DER_NOMEM;
+
+	if (ret_nr > 0)
+		memcpy(new_status, ret_out->ta_status.ca_arrays, ret_nr * sizeof(*new_status));
+	memcpy(&new_status[ret_nr], ta_out->ta_status.ca_arrays, src_nr * sizeof(*new_status));
+
+	D_FREE(ret_out->ta_status.ca_arrays);
+
+	ret_out->ta_status.ca_arrays = new_status;
+	ret_out->ta_status.ca_count = ret_nr + src_nr;
+	return 0;
+}
+
+void
+ds_mgmt_hdlr_tgt_policy_set(crt_rpc_t *rpc)
+{
+	struct mgmt_tgt_policy_set_in	*tp_in = crt_req_get(rpc);
+	struct mgmt_tgt_policy_set_out	*tp_out = crt_reply_get(rpc);
+	int				 rc;
+
+	rc = ds_pool_tgt_set_policy(tp_in->tp_pool_uuid, tp_in->tp_aggregation,
+				    tp_in->tp_rebuild_ratio, tp_in->tp_scrub_rate,
+				    tp_in->tp_clear != 0);
+	if (rc)
+		D_ERROR(DF_UUID": failed to set policy: "DF_RC"\n",
+			DP_UUID(tp_in->tp_pool_uuid), DP_RC(rc));
+
+	tp_out->tp_rc = rc;
+	crt_reply_send(rpc);
+}
+
+int
+ds_mgmt_tgt_policy_set_aggregator(crt_rpc_t *source, crt_rpc_t *result,
+				  void *priv)
+{
+	struct mgmt_tgt_policy_set_out	*tp_out = crt_reply_get(source);
+	struct mgmt_tgt_policy_set_out	*ret_out = crt_reply_get(result);
+
+	if (tp_out->tp_rc != 0)
+		ret_out->tp_rc = tp_out->tp_rc;
+	return 0;
+}
+
+void
+ds_mgmt_hdlr_tgt_cont_check(crt_rpc_t *rpc)
+{
+	struct mgmt_tgt_cont_check_in	*tc_in = crt_req_get(rpc);
+	struct mgmt_tgt_cont_check_out	*tc_out = crt_reply_get(rpc);
+	uuid_t				*destroy = tc_in->tc_destroy.ca_arrays;
+	uuid_t				*conts = NULL;
+	int				 conts_nr = 0;
+	int				 i;
+	int				 rc = 0;
+
+	for (i = 0; i < tc_in->tc_destroy.ca_count; i++) {
+		D_INFO(DF_CONT": destroying orphaned container\n",
+		       DP_CONT(tc_in->tc_pool_uuid, destroy[i]));
+		rc = ds_cont_tgt_destroy(tc_in->tc_pool_uuid, destroy[i]);
+		if (rc) {
+			D_ERROR(DF_CONT": failed to destroy container: "DF_RC"\n",
+				DP_CONT(tc_in->tc_pool_uuid, destroy[i]), DP_RC(rc));
+			D_GOTO(out, rc);
+		}
+	}
+
+	rc = ds_cont_tgt_list(tc_in->tc_pool_uuid, &conts, &conts_nr);
+	if (rc) {
+		D_ERROR(DF_UUID": failed to list containers: "DF_RC"\n",
+			DP_UUID(tc_in->tc_pool_uuid), DP_RC(rc));
+		D_GOTO(out, rc);
+	}
+
+	tc_out->tc_conts.ca_arrays = conts;
+	tc_out->tc_conts.ca_count = conts_nr;
+out:
+	tc_out->tc_rc = rc;
+	crt_reply_send(rpc);
+}
+
+int
+ds_mgmt_tgt_cont_check_post_reply(crt_rpc_t *rpc, void *priv)
+{
+	struct mgmt_tgt_cont_check_out	*tc_out;
+
+	tc_out = crt_reply_get(rpc);
+	D_FREE(tc_out->tc_conts.ca_arrays);
+
+	return 0;
+}
+
+int
+ds_mgmt_tgt_cont_check_aggregator(crt_rpc_t *source, crt_rpc_t *result,
+				  void *priv)
+{
+	struct mgmt_tgt_cont_check_out	*tc_out;
+	struct mgmt_tgt_cont_check_out	*ret_out;
+	uuid_t				*src_conts;
+	uuid_t				*new_conts;
+	unsigned int			 src_nr;
+	unsigned int			 ret_nr;
+	unsigned int			 i;
+	unsigned int			 j;
+
+	tc_out = crt_reply_get(source);
+	src_conts = tc_out->tc_conts.ca_arrays;
+	src_nr = tc_out->tc_conts.ca_count;
+
+	ret_out = crt_reply_get(result);
+	ret_nr = ret_out->tc_conts.ca_count;
+
+	if (tc_out->tc_rc != 0)
+		ret_out->tc_rc = tc_out->tc_rc;
+	if (src_nr == 0)
+		return 0;
+
+	D_ALLOC_ARRAY(new_conts, ret_nr + src_nr);
+	if (new_conts == NULL)
+		return -DER_NOMEM;
+
+	if (ret_nr > 0)
+		memcpy(new_conts, ret_out->tc_conts.ca_arrays, ret_nr * sizeof(*new_conts));
+
+	/* The same container is usually found on many ranks, only keep one entry */
+	for (i = 0; i < src_nr; i++) {
+		for (j = 0; j < ret_nr; j++)
+			if (uuid_compare(new_conts[j], src_conts[i]) == 0)
+				break;
+		if (j == ret_nr)
+			uuid_copy(new_conts[ret_nr++], src_conts[i]);
+	}
+
+	D_FREE(ret_out->tc_conts.ca_arrays);
+
+	ret_out->tc_conts.ca_arrays = new_conts;
+	ret_out->tc_conts.ca_count = ret_nr;
+	return 0;
+}
@@ -408,6 +408,41 @@ void mock_ds_mgmt_cont_set_owner_teardown(void)
 	D_FREE(ds_mgmt_cont_set_owner_group);
 }
 
+int			ds_mgmt_cont_check_return;
+uuid_t			ds_mgmt_cont_check_uuid;
+bool			ds_mgmt_cont_check_repair;
+struct mgmt_cont_orphan	ds_mgmt_cont_check_out[2];
+
+int
+ds_mgmt_cont_check(uuid_t pool_uuid, d_rank_list_t *svc_ranks, bool repair,
+		   struct mgmt_cont_orphan **orphans, size_t *orphans_nr)
+{
+	uuid_copy(ds_mgmt_cont_check_uuid, pool_uuid);
+	ds_mgmt_cont_check_repair = repair;
+
+	if (ds_mgmt_cont_check_return != 0)
+		return ds_mgmt_cont_check_return;
+
+	D_ALLOC_ARRAY(*orphans, ARRAY_SIZE(ds_mgmt_cont_check_out));
+	memcpy(*orphans, ds_mgmt_cont_check_out, sizeof(ds_mgmt_cont_check_out));
+	*orphans_nr = ARRAY_SIZE(ds_mgmt_cont_check_out);
+
+	return 0;
+}
+
+void
+mock_ds_mgmt_cont_check_setup(void)
+{
+	ds_mgmt_cont_check_return = 0;
+	uuid_clear(ds_mgmt_cont_check_uuid);
+	ds_mgmt_cont_check_repair = false;
+
+	uuid_parse("22222222-2222-2222-2222-222222222222", ds_mgmt_cont_check_out[0].mco_uuid);
+	ds_mgmt_cont_check_out[0].mco_removed = true;
+	uuid_parse("33333333-3333-3333-3333-333333333333", ds_mgmt_cont_check_out[1].mco_uuid);
+	ds_mgmt_cont_check_out[1].mco_removed = false;
+}
+
 int     ds_mgmt_target_update_return;
 uuid_t  ds_mgmt_target_update_uuid;
 int
@@ -537,6 +572,50 @@ ds_mgmt_smd_list_pools(Ctl__SmdPoolResp *resp)
 	return 0;
 }
 
+int			ds_mgmt_blobstore_usage_return;
+struct bio_bs_usage	ds_mgmt_blobstore_usage_out[4];
+
+int
+ds_mgmt_blobstore_usage(struct bio_bs_usage **usages, int *usages_nr)
+{
+	if (ds_mgmt_blobstore_usage_return != 0)
+		return ds_mgmt_blobstore_usage_return;
+
+	D_ALLOC_ARRAY(*usages, ARRAY_SIZE(ds_mgmt_blobstore_usage_out));
+	memcpy(*usages, ds_mgmt_blobstore_usage_out, sizeof(ds_mgmt_blobstore_usage_out));
+	*usages_nr = ARRAY_SIZE(ds_mgmt_blobstore_usage_out);
+
+	return 0;
+}
+
+void
+mock_ds_mgmt_blobstore_usage_setup(void)
+{
+	struct bio_bs_usage *out = ds_mgmt_blobstore_usage_out;
+
+	ds_mgmt_blobstore_usage_return = 0;
+	memset(out, 0, sizeof(ds_mgmt_blobstore_usage_out));
+
+	/* Targets 0 and 3 share a device, target 2 has no blobstore loaded */
+	uuid_parse("44444444-4444-4444-4444-444444444444", out[0].bbu_dev_id);
+	out[0].bbu_cluster_sz = 1 << 30;
+	out[0].bbu_total_clusters = 1024;
+	out[0].bbu_free_clusters = 512;
+	out[0].bbu_used_clusters = 200;
+	out[0].bbu_blobs = 2;
+
+	uuid_parse("55555555-5555-5555-5555-555555555555", out[1].bbu_dev_id);
+	out[1].bbu_cluster_sz = 1 << 30;
+	out[1].bbu_total_clusters = 2048;
+	out[1].bbu_free_clusters = 2000;
+	out[1].bbu_used_clusters = 40;
+	out[1].bbu_blobs = 1;
+
+	out[3] = out[0];
+	out[3].bbu_used_clusters = 300;
+	out[3].bbu_blobs = 3;
+}
+
 int	ds_mgmt_pool_upgrade_return;
 uuid_t  ds_mgmt_pool_upgrade_uuid;
 
@@ -554,6 +633,81 @@ mock_ds_mgmt_pool_upgrade_setup(void)
 	uuid_clear(ds_mgmt_pool_upgrade_uuid);
 }
 
+int				ds_mgmt_pool_query_aggregation_return;
+uuid_t				ds_mgmt_pool_query_aggregation_uuid;
+uint32_t			ds_mgmt_pool_query_aggregation_ranks_nr;
+struct mgmt_tgt_agg_status	ds_mgmt_pool_query_aggregation_out[2];
+
+int
+ds_mgmt_pool_query_aggregation(uuid_t pool_uuid, d_rank_list_t *ranks,
+			       struct mgmt_tgt_agg_status **statuses, size_t *statuses_nr)
+{
+	uuid_copy(ds_mgmt_pool_query_aggregation_uuid, pool_uuid);
+	ds_mgmt_pool_query_aggregation_ranks_nr = ranks->rl_nr;
+
+	/* If function is to return with an error, statuses will not be filled. */
+	if (ds_mgmt_pool_query_aggregation_return != 0)
+		return ds_mgmt_pool_query_aggregation_return;
+
+	D_ALLOC_ARRAY(*statuses, ARRAY_SIZE(ds_mgmt_pool_query_aggregation_out));
+	memcpy(*statuses, ds_mgmt_pool_query_aggregation_out,
+	       sizeof(ds_mgmt_pool_query_aggregation_out));
+	*statuses_nr = ARRAY_SIZE(ds_mgmt_pool_query_aggregation_out);
+
+	return 0;
+}
+
+void
+mock_ds_mgmt_pool_query_aggregation_setup(void)
+{
+	ds_mgmt_pool_query_aggregation_return = 0;
+	uuid_clear(ds_mgmt_pool_query_aggregation_uuid);
+	ds_mgmt_pool_query_aggregation_ranks_nr = 0;
+
+	ds_mgmt_pool_query_aggregation_out[0].tas_rank = 0;
+	ds_mgmt_pool_query_aggregation_out[0].tas_state = MGMT_TGT_AGG_RUNNING;
+	ds_mgmt_pool_query_aggregation_out[0].tas_lag = 45;
+	ds_mgmt_pool_query_aggregation_out[0].tas_last_run = 1685613600;
+	ds_mgmt_pool_query_aggregation_out[1].tas_rank = 1;
+	ds_mgmt_pool_query_aggregation_out[1].tas_state = MGMT_TGT_AGG_PAUSED;
+	ds_mgmt_pool_query_aggregation_out[1].tas_lag = 3600;
+	ds_mgmt_pool_query_aggregation_out[1].tas_last_run = 0;
+}
+
+int		ds_mgmt_pool_set_policy_return;
+uuid_t		ds_mgmt_pool_set_policy_uuid;
+uint32_t	ds_mgmt_pool_set_policy_ranks_nr;
+uint32_t	ds_mgmt_pool_set_policy_aggregation;
+uint32_t	ds_mgmt_pool_set_policy_rebuild_ratio;
+uint32_t	ds_mgmt_pool_set_policy_scrub_rate;
+bool		ds_mgmt_pool_set_policy_clear;
+
+int
+ds_mgmt_pool_set_policy(uuid_t pool_uuid, d_rank_list_t *ranks, uint32_t aggregation,
+			uint32_t rebuild_ratio, uint32_t scrub_rate, bool clear)
+{
+	uuid_copy(ds_mgmt_pool_set_policy_uuid, pool_uuid);
+	ds_mgmt_pool_set_policy_ranks_nr = ranks->rl_nr;
+	ds_mgmt_pool_set_policy_aggregation = aggregation;
+	ds_mgmt_pool_set_policy_rebuild_ratio = rebuild_ratio;
+	ds_mgmt_pool_set_policy_scrub_rate = scrub_rate;
+	ds_mgmt_pool_set_policy_clear = clear;
+
+	return ds_mgmt_pool_set_policy_return;
+}
+
+void
+mock_ds_mgmt_pool_set_policy_setup(void)
+{
+	ds_mgmt_pool_set_policy_return = 0;
+	uuid_clear(ds_mgmt_pool_set_policy_uuid);
+	ds_mgmt_pool_set_policy_ranks_nr = 0;
+	ds_mgmt_pool_set_policy_aggregation = 0;
+	ds_mgmt_pool_set_policy_rebuild_ratio = 0;
+	ds_mgmt_pool_set_policy_scrub_rate = 0;
+	ds_mgmt_pool_set_policy_clear = false;
+}
+
 int	ds_mgmt_dev_manage_led_return;
 uuid_t  ds_mgmt_dev_manage_led_uuid;
 
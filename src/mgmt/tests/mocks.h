@@ -144,6 +144,22 @@ extern char	*ds_mgmt_cont_set_owner_user;
 extern char	*ds_mgmt_cont_set_owner_group;
 void mock_ds_mgmt_cont_set_owner_setup(void);
 void mock_ds_mgmt_cont_set_owner_teardown(void);
+
+/*
+ * Mock ds_mgmt_cont_check
+ */
+extern int			ds_mgmt_cont_check_return;
+extern uuid_t			ds_mgmt_cont_check_uuid;
+extern bool			ds_mgmt_cont_check_repair;
+extern struct mgmt_cont_orphan	ds_mgmt_cont_check_out[2];
+void mock_ds_mgmt_cont_check_setup(void);
+
+/*
+ * Mock ds_mgmt_blobstore_usage
+ */
+extern int			ds_mgmt_blobstore_usage_return;
+extern struct bio_bs_usage	ds_mgmt_blobstore_usage_out[4];
+void mock_ds_mgmt_blobstore_usage_setup(void);
 void mock_ds_mgmt_pool_query_targets_gen_infos(uint32_t n_infos);
 
 /*
@@ -153,6 +169,27 @@ extern int	ds_mgmt_pool_upgrade_return;
 extern uuid_t	ds_mgmt_pool_upgrade_uuid;
 void mock_ds_mgmt_pool_upgrade_setup(void);
 
+/*
+ * Mock ds_mgmt_pool_query_aggregation
+ */
+extern int				ds_mgmt_pool_query_aggregation_return;
+extern uuid_t				ds_mgmt_pool_query_aggregation_uuid;
+extern uint32_t				ds_mgmt_pool_query_aggregation_ranks_nr;
+extern struct mgmt_tgt_agg_status	ds_mgmt_pool_query_aggregation_out[2];
+void mock_ds_mgmt_pool_query_aggregation_setup(void);
+
+/*
+ * Mock ds_mgmt_pool_set_policy
+ */
+extern int		ds_mgmt_pool_set_policy_return;
+extern uuid_t		ds_mgmt_pool_set_policy_uuid;
+extern uint32_t		ds_mgmt_pool_set_policy_ranks_nr;
+extern uint32_t		ds_mgmt_pool_set_policy_aggregation;
+extern uint32_t		ds_mgmt_pool_set_policy_rebuild_ratio;
+extern uint32_t		ds_mgmt_pool_set_policy_scrub_rate;
+extern bool		ds_mgmt_pool_set_policy_clear;
+void mock_ds_mgmt_pool_set_policy_setup(void);
+
 /*
  * Mock ds_mgmt_dev_manage_led
  */
@@ -110,14 +110,18 @@ test_mgmt_drpc_handlers_bad_call_payload(void **state)
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_delete_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_query);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_query_targets);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_query_aggregation);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_set_policy);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_smd_list_devs);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_smd_list_pools);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_blobstore_usage);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_bio_health_query);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_dev_set_faulty);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_dev_manage_led);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_dev_replace);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_list_cont);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_set_owner);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_check);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_group_update);
 }
 
@@ -1327,6 +1331,9 @@ init_test_rebuild_status(struct daos_rebuild_status *rebuild)
 {
 	rebuild->rs_obj_nr = 101;
 	rebuild->rs_rec_nr = 102;
+	rebuild->rs_toberb_obj_nr = 103;
+	rebuild->rs_size = 104;
+	rebuild->rs_seconds = 105;
 }
 
 static void
@@ -1353,6 +1360,11 @@ expect_rebuild_status(struct daos_rebuild_status *exp,
 	assert_int_equal(actual->status, exp->rs_errno);
 	assert_int_equal(actual->objects, exp->rs_obj_nr);
 	assert_int_equal(actual->records, exp->rs_rec_nr);
+	assert_int_equal(actual->total_objects, exp->rs_toberb_obj_nr);
+	assert_int_equal(actual->size, exp->rs_size);
+	assert_int_equal(actual->version, exp->rs_version);
+	assert_int_equal(actual->seconds, exp->rs_seconds);
+	assert_int_equal(actual->fail_rank, exp->rs_fail_rank);
 	assert_int_equal(actual->state, exp_state);
 }
 
@@ -1482,7 +1494,9 @@ test_drpc_pool_query_success_rebuild_err(void **state)
 
 	init_test_pool_info(&exp_info);
 	exp_info.pi_rebuild_st.rs_version = 1;
+	exp_info.pi_rebuild_st.rs_seconds = 30;
 	exp_info.pi_rebuild_st.rs_errno = -DER_MISC;
+	exp_info.pi_rebuild_st.rs_fail_rank = 3;
 
 	ds_mgmt_pool_query_info_out = exp_info;
 	/*
@@ -1491,6 +1505,8 @@ test_drpc_pool_query_success_rebuild_err(void **state)
 	 */
 	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_obj_nr = 42;
 	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_rec_nr = 999;
+	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_toberb_obj_nr = 43;
+	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_size = 1000;
 
 	setup_pool_query_drpc_call(&call, TEST_UUID);
 
@@ -1504,6 +1520,41 @@ test_drpc_pool_query_success_rebuild_err(void **state)
 	D_FREE(resp.body.data);
 }
 
+static void
+test_drpc_pool_query_enabled_and_disabled_ranks(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__PoolQueryReq	req = MGMT__POOL_QUERY_REQ__INIT;
+	Mgmt__PoolQueryResp	*pq_resp = NULL;
+	daos_pool_info_t	exp_info = {0};
+
+	init_test_pool_info(&exp_info);
+	ds_mgmt_pool_query_info_out = exp_info;
+
+	req.id = TEST_UUID;
+	req.include_enabled_ranks = true;
+	req.include_disabled_ranks = true;
+	pack_pool_query_req(&call, &req);
+
+	ds_mgmt_drpc_pool_query(&call, &resp);
+
+	/* The second query should only retrieve the disabled ranks */
+	assert_int_equal(ds_mgmt_pool_query_info_in.pi_bits, 0);
+
+	assert_int_equal(resp.status, DRPC__STATUS__SUCCESS);
+	pq_resp = mgmt__pool_query_resp__unpack(NULL, resp.body.len, resp.body.data);
+	assert_non_null(pq_resp);
+	assert_int_equal(pq_resp->status, 0);
+	assert_int_equal(pq_resp->total_targets, exp_info.pi_ntargets);
+	assert_string_equal(pq_resp->enabled_ranks, "[0-7]");
+	assert_string_equal(pq_resp->disabled_ranks, "[0-7]");
+
+	mgmt__pool_query_resp__free_unpacked(pq_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * Pool query targets test setup/teardown
  */
@@ -2521,6 +2572,252 @@ test_drpc_cont_set_owner_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * Container check test setup
+ */
+static int
+drpc_cont_check_setup(void **state)
+{
+	mock_ds_mgmt_cont_check_setup();
+	return 0;
+}
+
+/*
+ * dRPC container check tests
+ */
+static void
+setup_cont_check_drpc_call(Drpc__Call *call, char *uuid, bool repair)
+{
+	Mgmt__ContCheckReq	req = MGMT__CONT_CHECK_REQ__INIT;
+	size_t			len;
+	uint8_t			*body;
+
+	req.id = uuid;
+	req.repair = repair;
+
+	len = mgmt__cont_check_req__get_packed_size(&req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__cont_check_req__pack(&req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_cont_check_resp_with_error(Drpc__Response *resp, int expected_err)
+{
+	Mgmt__ContCheckResp	*cc_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	cc_resp = mgmt__cont_check_resp__unpack(NULL, resp->body.len, resp->body.data);
+	assert_non_null(cc_resp);
+	assert_int_equal(cc_resp->status, expected_err);
+	assert_int_equal(cc_resp->n_orphans, 0);
+
+	mgmt__cont_check_resp__free_unpacked(cc_resp, NULL);
+}
+
+static void
+test_drpc_cont_check_bad_uuid(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_cont_check_drpc_call(&call, "BAD", false);
+
+	ds_mgmt_drpc_cont_check(&call, &resp);
+
+	expect_drpc_cont_check_resp_with_error(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_check_mgmt_svc_fails(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_cont_check_drpc_call(&call, TEST_UUID, false);
+	ds_mgmt_cont_check_return = -DER_TIMEDOUT;
+
+	ds_mgmt_drpc_cont_check(&call, &resp);
+
+	expect_drpc_cont_check_resp_with_error(&resp, ds_mgmt_cont_check_return);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_check_success(void **state)
+{
+	Drpc__Call		 call = DRPC__CALL__INIT;
+	Drpc__Response		 resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContCheckResp	*cc_resp = NULL;
+	uuid_t			 exp_uuid;
+
+	setup_cont_check_drpc_call(&call, TEST_UUID, true);
+
+	ds_mgmt_drpc_cont_check(&call, &resp);
+
+	assert_int_equal(uuid_parse(TEST_UUID, exp_uuid), 0);
+	assert_int_equal(uuid_compare(exp_uuid, ds_mgmt_cont_check_uuid), 0);
+	assert_true(ds_mgmt_cont_check_repair);
+
+	assert_int_equal(resp.status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp.body.data);
+
+	cc_resp = mgmt__cont_check_resp__unpack(NULL, resp.body.len, resp.body.data);
+	assert_non_null(cc_resp);
+	assert_int_equal(cc_resp->status, 0);
+	assert_int_equal(cc_resp->n_orphans, ARRAY_SIZE(ds_mgmt_cont_check_out));
+
+	assert_string_equal(cc_resp->orphans[0]->uuid, "22222222-2222-2222-2222-222222222222");
+	assert_string_equal(cc_resp->orphans[0]->label, "");
+	assert_string_equal(cc_resp->orphans[0]->reason, "destroy did not complete");
+	assert_true(cc_resp->orphans[0]->removed);
+	assert_string_equal(cc_resp->orphans[1]->uuid, "33333333-3333-3333-3333-333333333333");
+	assert_false(cc_resp->orphans[1]->removed);
+
+	mgmt__cont_check_resp__free_unpacked(cc_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+/*
+ * Blobstore usage test setup
+ */
+static int
+drpc_blobstore_usage_setup(void **state)
+{
+	mock_ds_mgmt_blobstore_usage_setup();
+	return 0;
+}
+
+/*
+ * dRPC blobstore usage tests
+ */
+static void
+setup_blobstore_usage_drpc_call(Drpc__Call *call)
+{
+	Ctl__BlobstoreUsageReq	req = CTL__BLOBSTORE_USAGE_REQ__INIT;
+	size_t			len;
+	uint8_t			*body;
+
+	len = ctl__blobstore_usage_req__get_packed_size(&req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	ctl__blobstore_usage_req__pack(&req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static Ctl__BlobstoreUsageResp *
+unpack_blobstore_usage_resp(Drpc__Response *resp, int expected_err)
+{
+	Ctl__BlobstoreUsageResp	*bu_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	bu_resp = ctl__blobstore_usage_resp__unpack(NULL, resp->body.len, resp->body.data);
+	assert_non_null(bu_resp);
+	assert_int_equal(bu_resp->status, expected_err);
+
+	return bu_resp;
+}
+
+static void
+test_drpc_blobstore_usage_mgmt_svc_fails(void **state)
+{
+	Drpc__Call		 call = DRPC__CALL__INIT;
+	Drpc__Response		 resp = DRPC__RESPONSE__INIT;
+	Ctl__BlobstoreUsageResp	*bu_resp;
+
+	setup_blobstore_usage_drpc_call(&call);
+	ds_mgmt_blobstore_usage_return = -DER_TIMEDOUT;
+
+	ds_mgmt_drpc_blobstore_usage(&call, &resp);
+
+	bu_resp = unpack_blobstore_usage_resp(&resp, ds_mgmt_blobstore_usage_return);
+	assert_int_equal(bu_resp->n_blobstores, 0);
+
+	ctl__blobstore_usage_resp__free_unpacked(bu_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_blobstore_usage_no_blobstores(void **state)
+{
+	Drpc__Call		 call = DRPC__CALL__INIT;
+	Drpc__Response		 resp = DRPC__RESPONSE__INIT;
+	Ctl__BlobstoreUsageResp	*bu_resp;
+
+	setup_blobstore_usage_drpc_call(&call);
+	memset(ds_mgmt_blobstore_usage_out, 0, sizeof(ds_mgmt_blobstore_usage_out));
+
+	ds_mgmt_drpc_blobstore_usage(&call, &resp);
+
+	bu_resp = unpack_blobstore_usage_resp(&resp, 0);
+	assert_int_equal(bu_resp->n_blobstores, 0);
+
+	ctl__blobstore_usage_resp__free_unpacked(bu_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_blobstore_usage_success(void **state)
+{
+	Drpc__Call		 call = DRPC__CALL__INIT;
+	Drpc__Response		 resp = DRPC__RESPONSE__INIT;
+	Ctl__BlobstoreUsageResp	*bu_resp;
+	Ctl__BlobstoreUsage	*bs;
+
+	setup_blobstore_usage_drpc_call(&call);
+
+	ds_mgmt_drpc_blobstore_usage(&call, &resp);
+
+	bu_resp = unpack_blobstore_usage_resp(&resp, 0);
+	assert_int_equal(bu_resp->n_blobstores, 2);
+
+	/* Targets sharing a device are reported under a single blobstore */
+	bs = bu_resp->blobstores[0];
+	assert_string_equal(bs->dev_uuid, "44444444-4444-4444-4444-444444444444");
+	assert_int_equal(bs->cluster_size, 1 << 30);
+	assert_int_equal(bs->total_clusters, 1024);
+	assert_int_equal(bs->free_clusters, 512);
+	assert_int_equal(bs->n_targets, 2);
+	assert_int_equal(bs->targets[0]->tgt_id, 0);
+	assert_int_equal(bs->targets[0]->used_clusters, 200);
+	assert_int_equal(bs->targets[0]->blobs, 2);
+	assert_int_equal(bs->targets[1]->tgt_id, 3);
+	assert_int_equal(bs->targets[1]->used_clusters, 300);
+	assert_int_equal(bs->targets[1]->blobs, 3);
+
+	bs = bu_resp->blobstores[1];
+	assert_string_equal(bs->dev_uuid, "55555555-5555-5555-5555-555555555555");
+	assert_int_equal(bs->total_clusters, 2048);
+	assert_int_equal(bs->free_clusters, 2000);
+	assert_int_equal(bs->n_targets, 1);
+	assert_int_equal(bs->targets[0]->tgt_id, 1);
+	assert_int_equal(bs->targets[0]->used_clusters, 40);
+	assert_int_equal(bs->targets[0]->blobs, 1);
+
+	ctl__blobstore_usage_resp__free_unpacked(bu_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * Pool upgrade test setup
  */
@@ -2623,6 +2920,274 @@ test_drpc_pool_upgrade_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * Pool query aggregation test setup
+ */
+static int
+drpc_pool_query_aggregation_setup(void **state)
+{
+	mock_ds_mgmt_pool_query_aggregation_setup();
+	return 0;
+}
+
+/*
+ * dRPC pool query aggregation tests
+ */
+static void
+pack_pool_query_aggregation_req(Drpc__Call *call, Mgmt__PoolQueryAggregationReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__pool_query_aggregation_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__pool_query_aggregation_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+setup_pool_query_aggregation_drpc_call(Drpc__Call *call, char *uuid)
+{
+	Mgmt__PoolQueryAggregationReq	req = MGMT__POOL_QUERY_AGGREGATION_REQ__INIT;
+	uint32_t			ranks[] = {0, 1};
+
+	req.id = uuid;
+	req.n_ranks = ARRAY_SIZE(ranks);
+	req.ranks = ranks;
+	pack_pool_query_aggregation_req(call, &req);
+}
+
+static void
+expect_drpc_pool_query_aggregation_resp_with_error(Drpc__Response *resp, int expected_err)
+{
+	Mgmt__PoolQueryAggregationResp	*pqa_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	pqa_resp = mgmt__pool_query_aggregation_resp__unpack(NULL, resp->body.len,
+							     resp->body.data);
+	assert_non_null(pqa_resp);
+	assert_int_equal(pqa_resp->status, expected_err);
+	assert_int_equal(pqa_resp->n_ranks, 0);
+
+	mgmt__pool_query_aggregation_resp__free_unpacked(pqa_resp, NULL);
+}
+
+static void
+test_drpc_pool_query_aggregation_bad_uuid(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_pool_query_aggregation_drpc_call(&call, "BAD");
+
+	ds_mgmt_drpc_pool_query_aggregation(&call, &resp);
+
+	expect_drpc_pool_query_aggregation_resp_with_error(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_query_aggregation_mgmt_svc_fails(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_pool_query_aggregation_drpc_call(&call, TEST_UUID);
+	ds_mgmt_pool_query_aggregation_return = -DER_TIMEDOUT;
+
+	ds_mgmt_drpc_pool_query_aggregation(&call, &resp);
+
+	expect_drpc_pool_query_aggregation_resp_with_error(&resp,
+							   ds_mgmt_pool_query_aggregation_return);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_query_aggregation_success(void **state)
+{
+	Drpc__Call			 call = DRPC__CALL__INIT;
+	Drpc__Response			 resp = DRPC__RESPONSE__INIT;
+	Mgmt__PoolQueryAggregationResp	*pqa_resp = NULL;
+	uuid_t				 exp_uuid;
+	size_t				 i;
+
+	setup_pool_query_aggregation_drpc_call(&call, TEST_UUID);
+
+	ds_mgmt_drpc_pool_query_aggregation(&call, &resp);
+
+	assert_int_equal(uuid_parse(TEST_UUID, exp_uuid), 0);
+	assert_int_equal(uuid_compare(exp_uuid, ds_mgmt_pool_query_aggregation_uuid), 0);
+	assert_int_equal(ds_mgmt_pool_query_aggregation_ranks_nr, 2);
+
+	assert_int_equal(resp.status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp.body.data);
+
+	pqa_resp = mgmt__pool_query_aggregation_resp__unpack(NULL, resp.body.len, resp.body.data);
+	assert_non_null(pqa_resp);
+	assert_int_equal(pqa_resp->status, 0);
+	assert_string_equal(pqa_resp->uuid, TEST_UUID);
+	assert_int_equal(pqa_resp->n_ranks, ARRAY_SIZE(ds_mgmt_pool_query_aggregation_out));
+
+	for (i = 0; i < pqa_resp->n_ranks; i++) {
+		struct mgmt_tgt_agg_status *exp = &ds_mgmt_pool_query_aggregation_out[i];
+
+		assert_int_equal(pqa_resp->ranks[i]->rank, exp->tas_rank);
+		assert_int_equal(pqa_resp->ranks[i]->lag, exp->tas_lag);
+		assert_int_equal(pqa_resp->ranks[i]->last_run, exp->tas_last_run);
+	}
+	assert_string_equal(pqa_resp->ranks[0]->state, "running");
+	assert_string_equal(pqa_resp->ranks[1]->state, "paused");
+
+	mgmt__pool_query_aggregation_resp__free_unpacked(pqa_resp, NULL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+/*
+ * Pool set policy test setup
+ */
+static int
+drpc_pool_set_policy_setup(void **state)
+{
+	mock_ds_mgmt_pool_set_policy_setup();
+	return 0;
+}
+
+/*
+ * dRPC pool set policy tests
+ */
+static void
+pack_pool_set_policy_req(Drpc__Call *call, Mgmt__PoolSetPolicyReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__pool_set_policy_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__pool_set_policy_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+setup_pool_set_policy_drpc_call(Drpc__Call *call, char *uuid, uint32_t rebuild_throttle)
+{
+	Mgmt__PoolSetPolicyReq	req = MGMT__POOL_SET_POLICY_REQ__INIT;
+	uint32_t		ranks[] = {0, 1, 2};
+
+	req.id = uuid;
+	req.n_ranks = ARRAY_SIZE(ranks);
+	req.ranks = ranks;
+	req.aggregation = MGMT__POOL_SET_POLICY_REQ__AGGREGATION__DISABLED;
+	req.rebuild_throttle = rebuild_throttle;
+	req.scrub_rate = 50;
+	pack_pool_set_policy_req(call, &req);
+}
+
+static void
+expect_drpc_pool_set_policy_resp_with_error(Drpc__Response *resp, int expected_err)
+{
+	Mgmt__PoolSetPolicyResp	*psp_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	psp_resp = mgmt__pool_set_policy_resp__unpack(NULL, resp->body.len, resp->body.data);
+	assert_non_null(psp_resp);
+	assert_int_equal(psp_resp->status, expected_err);
+
+	mgmt__pool_set_policy_resp__free_unpacked(psp_resp, NULL);
+}
+
+static void
+test_drpc_pool_set_policy_bad_uuid(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_pool_set_policy_drpc_call(&call, "BAD", 25);
+
+	ds_mgmt_drpc_pool_set_policy(&call, &resp);
+
+	expect_drpc_pool_set_policy_resp_with_error(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_set_policy_bad_throttle(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_pool_set_policy_drpc_call(&call, TEST_UUID, 101);
+
+	ds_mgmt_drpc_pool_set_policy(&call, &resp);
+
+	expect_drpc_pool_set_policy_resp_with_error(&resp, -DER_INVAL);
+	assert_true(uuid_is_null(ds_mgmt_pool_set_policy_uuid));
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_set_policy_mgmt_svc_fails(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_pool_set_policy_drpc_call(&call, TEST_UUID, 25);
+	ds_mgmt_pool_set_policy_return = -DER_TIMEDOUT;
+
+	ds_mgmt_drpc_pool_set_policy(&call, &resp);
+
+	expect_drpc_pool_set_policy_resp_with_error(&resp, ds_mgmt_pool_set_policy_return);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_set_policy_success(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+	uuid_t		exp_uuid;
+
+	setup_pool_set_policy_drpc_call(&call, TEST_UUID, 25);
+
+	ds_mgmt_drpc_pool_set_policy(&call, &resp);
+
+	expect_drpc_pool_set_policy_resp_with_error(&resp, 0);
+
+	assert_int_equal(uuid_parse(TEST_UUID, exp_uuid), 0);
+	assert_int_equal(uuid_compare(exp_uuid, ds_mgmt_pool_set_policy_uuid), 0);
+	assert_int_equal(ds_mgmt_pool_set_policy_ranks_nr, 3);
+	assert_int_equal(ds_mgmt_pool_set_policy_aggregation,
+			 MGMT__POOL_SET_POLICY_REQ__AGGREGATION__DISABLED);
+	assert_int_equal(ds_mgmt_pool_set_policy_rebuild_ratio, 25);
+	assert_int_equal(ds_mgmt_pool_set_policy_scrub_rate, 50);
+	assert_false(ds_mgmt_pool_set_policy_clear);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * LED manage test setup
  */
@@ -2971,6 +3536,12 @@ test_drpc_dev_set_faulty_success(void **state)
 #define POOL_UPGRADE_TEST(x)	cmocka_unit_test_setup(x, \
 						drpc_upgrade_setup)
 
+#define QUERY_AGGREGATION_TEST(x)	cmocka_unit_test_setup(x, \
+						drpc_pool_query_aggregation_setup)
+
+#define SET_POLICY_TEST(x)	cmocka_unit_test_setup(x, \
+						drpc_pool_set_policy_setup)
+
 #define PING_RANK_TEST(x)	cmocka_unit_test(x)
 
 #define PREP_SHUTDOWN_TEST(x)	cmocka_unit_test(x)
@@ -2981,6 +3552,10 @@ test_drpc_dev_set_faulty_success(void **state)
 						drpc_cont_set_owner_setup, \
 						drpc_cont_set_owner_teardown)
 
+#define CONT_CHECK_TEST(x)	cmocka_unit_test_setup(x, drpc_cont_check_setup)
+
+#define BLOBSTORE_USAGE_TEST(x)	cmocka_unit_test_setup(x, drpc_blobstore_usage_setup)
+
 #define LED_MANAGE_TEST(x)	cmocka_unit_test_setup(x, drpc_dev_manage_led_setup)
 
 #define DEV_REPLACE_TEST(x)	cmocka_unit_test_setup(x, drpc_dev_replace_setup)
@@ -3036,6 +3611,7 @@ main(void)
 		QUERY_TEST(test_drpc_pool_query_success_rebuild_busy),
 		QUERY_TEST(test_drpc_pool_query_success_rebuild_done),
 		QUERY_TEST(test_drpc_pool_query_success_rebuild_err),
+		QUERY_TEST(test_drpc_pool_query_enabled_and_disabled_ranks),
 		QUERY_TARGETS_TEST(test_drpc_pool_query_targets_bad_uuid),
 		QUERY_TARGETS_TEST(test_drpc_pool_query_targets_mgmt_svc_fails),
 		QUERY_TARGETS_TEST(test_drpc_pool_query_targets_with_targets),
@@ -3050,9 +3626,22 @@ main(void)
 		CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_bad_pool_uuid),
 		CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_failed),
 		CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_success),
+		CONT_CHECK_TEST(test_drpc_cont_check_bad_uuid),
+		CONT_CHECK_TEST(test_drpc_cont_check_mgmt_svc_fails),
+		CONT_CHECK_TEST(test_drpc_cont_check_success),
+		BLOBSTORE_USAGE_TEST(test_drpc_blobstore_usage_mgmt_svc_fails),
+		BLOBSTORE_USAGE_TEST(test_drpc_blobstore_usage_no_blobstores),
+		BLOBSTORE_USAGE_TEST(test_drpc_blobstore_usage_success),
 		POOL_UPGRADE_TEST(test_drpc_pool_upgrade_bad_uuid),
 		POOL_UPGRADE_TEST(test_drpc_pool_upgrade_mgmt_svc_fails),
 		POOL_UPGRADE_TEST(test_drpc_pool_upgrade_success),
+		QUERY_AGGREGATION_TEST(test_drpc_pool_query_aggregation_bad_uuid),
+		QUERY_AGGREGATION_TEST(test_drpc_pool_query_aggregation_mgmt_svc_fails),
+		QUERY_AGGREGATION_TEST(test_drpc_pool_query_aggregation_success),
+		SET_POLICY_TEST(test_drpc_pool_set_policy_bad_uuid),
+		SET_POLICY_TEST(test_drpc_pool_set_policy_bad_throttle),
+		SET_POLICY_TEST(test_drpc_pool_set_policy_mgmt_svc_fails),
+		SET_POLICY_TEST(test_drpc_pool_set_policy_success),
 		LED_MANAGE_TEST(test_drpc_dev_manage_led_bad_tr_addr),
 		LED_MANAGE_TEST(test_drpc_dev_manage_led_fails),
 		LED_MANAGE_TEST(test_drpc_dev_manage_led_success),
@@ -1956,3 +1956,70 @@ out:
 	if (rc != 0 && arg != NULL)
 		tgt_discard_arg_free(arg);
 }
+
+struct pool_rebuild_ratio_arg {
+	uuid_t		pra_pool_uuid;
+	uint32_t	pra_ratio;
+};
+
+static int
+pool_set_rebuild_ratio_one(void *data)
+{
+	struct pool_rebuild_ratio_arg	*arg = data;
+
+	return sched_set_rebuild_ratio(arg->pra_pool_uuid, arg->pra_ratio);
+}
+
+/**
+ * Apply the administrator's hints for the scheduling of the pool's background
+ * tasks on this engine. The hints are kept in memory only, they are lost when
+ * the pool is stopped. A zero \a rebuild_ratio or \a scrub_rate leaves the
+ * current value unchanged, \a clear resets all the hints to the defaults
+ * before the others are applied.
+ */
+int
+ds_pool_tgt_set_policy(uuid_t pool_uuid, uint32_t aggregation, uint32_t rebuild_ratio,
+		       uint32_t scrub_rate, bool clear)
+{
+	struct pool_rebuild_ratio_arg	 arg;
+	struct ds_pool			*pool;
+	int				 rc;
+
+	rc = ds_pool_lookup(pool_uuid, &pool);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to look up pool: "DF_RC"\n", DP_UUID(pool_uuid),
+			DP_RC(rc));
+		return rc;
+	}
+
+	if (clear) {
+		pool->sp_agg_paused = 0;
+		pool->sp_scrub_rate = 0;
+	}
+
+	if (aggregation == DS_POOL_AGG_ENABLED)
+		pool->sp_agg_paused = 0;
+	else if (aggregation == DS_POOL_AGG_DISABLED)
+		pool->sp_agg_paused = 1;
+
+	if (scrub_rate != 0)
+		pool->sp_scrub_rate = scrub_rate;
+
+	if (clear || rebuild_ratio != 0) {
+		uuid_copy(arg.pra_pool_uuid, pool_uuid);
+		arg.pra_ratio = rebuild_ratio;
+		rc = dss_thread_collective(pool_set_rebuild_ratio_one, &arg, 0);
+		if (rc != 0) {
+			D_ERROR(DF_UUID": failed to set rebuild ratio: "DF_RC"\n",
+				DP_UUID(pool_uuid), DP_RC(rc));
+			goto out;
+		}
+	}
+
+	D_INFO(DF_UUID": policy set, aggregation %s, rebuild ratio %u, scrub rate %u\n",
+	       DP_UUID(pool_uuid), pool->sp_agg_paused ? "paused" : "enabled", rebuild_ratio,
+	       pool->sp_scrub_rate);
+out:
+	ds_pool_put(pool);
+	return rc;
+}
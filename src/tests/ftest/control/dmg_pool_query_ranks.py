@@ -71,27 +71,28 @@ class DmgPoolQueryRanks(ControlTestBase):
             "Invalid disabled_ranks field: want=[], got={}".format(
                 data['response']['disabled_ranks']))
 
-    def test_pool_query_ranks_error(self):
-        """Test that ranks state option are mutually exclusive.
+    def test_pool_query_ranks_combined(self):
+        """Test that ranks state options can be used together.
 
         Test Description:
-            Check that options '--show-enabled' and '--show-disabled" are mutually exclusive.
+            Check that options '--show-enabled' and '--show-disabled' may be used together.
 
         :avocado: tags=all,daily_regression
         :avocado: tags=vm
         :avocado: tags=dmg,control,pool_query,pool_query_ranks
-        :avocado: tags=DmgPoolQueryRanks,test_pool_query_ranks_error
+        :avocado: tags=DmgPoolQueryRanks,test_pool_query_ranks_combined
         """
-        self.log.info("Tests of pool query with incompatible options")
-
-        # Disable raising an exception if the dmg command fails
-        self.dmg.exit_status_exception = False
-        try:
-            data = self.dmg.pool_query(self.pool.identifier, show_enabled=True, show_disabled=True)
-            self.assertIsNotNone(data["error"], "Expected error not returned")
-            self.assertIn(r'may not be mixed with', str(data['error']), "Invalid error message")
-        finally:
-            self.dmg.exit_status_exception = True
+        self.log.info("Tests of pool query with both ranks state options")
+
+        data = self.dmg.pool_query(self.pool.identifier, show_enabled=True, show_disabled=True)
+        self.assertListEqual(
+            data['response']['enabled_ranks'], [0, 1, 2],
+            "Invalid enabled_ranks field: want=[0, 1, 2], got={}".format(
+                data['response']['enabled_ranks']))
+        self.assertListEqual(
+            data['response']['disabled_ranks'], [],
+            "Invalid disabled_ranks field: want=[], got={}".format(
+                data['response']['disabled_ranks']))
 
     def test_pool_query_ranks_mgmt(self):
         """Test the state of ranks after excluding and reintegrate them.
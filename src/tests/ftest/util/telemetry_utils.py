@@ -463,7 +463,8 @@ class TelemetryUtils():
         "engine_nvme_temp_crit_time"]
     ENGINE_NVME_RELIABILITY_METRICS = [
         "engine_nvme_reliability_avail_spare",
-        "engine_nvme_reliability_avail_spare_threshold"]
+        "engine_nvme_reliability_avail_spare_threshold",
+        "engine_nvme_reliability_percentage_used"]
     ENGINE_NVME_CRIT_WARN_METRICS = [
         "engine_nvme_reliability_avail_spare_warn",
         "engine_nvme_reliability_reliability_warn",
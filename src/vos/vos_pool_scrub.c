@@ -122,6 +122,11 @@ sc_mode(const struct scrub_ctx *ctx)
 static inline int
 sc_freq(const struct scrub_ctx *ctx)
 {
+	uint32_t rate = ctx->sc_pool->sp_scrub_rate;
+
+	/* A reduced scrub rate stretches the period over which the pool is scrubbed */
+	if (rate > 0 && rate < 100)
+		return ctx->sc_pool->sp_scrub_freq_sec * 100 / rate;
 	return ctx->sc_pool->sp_scrub_freq_sec;
 }
 
@@ -175,7 +180,7 @@ sc_frequency_time_over(struct scrub_ctx *ctx)
 {
 	struct timespec period_over = ctx->sc_pool_start_scrub;
 
-	d_timeinc(&period_over, SEC2NS(ctx->sc_pool->sp_scrub_freq_sec));
+	d_timeinc(&period_over, SEC2NS(sc_freq(ctx)));
 
 	return d_timeleft_ns(&period_over) <= 0;
 }
@@ -188,7 +193,7 @@ sc_get_ms_between_scrubs(struct scrub_ctx *ctx)
 	d_gettime(&now);
 
 	return get_ms_between_periods(ctx->sc_pool_start_scrub,
-				      now, ctx->sc_pool->sp_scrub_freq_sec,
+				      now, sc_freq(ctx),
 				      ctx->sc_pool_last_csum_calcs,
 				      ctx->sc_pool_csum_calcs - 1);
 }